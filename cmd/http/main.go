@@ -11,14 +11,21 @@ import (
 	"time"
 
 	"github.com/WagaoCarvalho/backend_store_go/config"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/pricing"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/stockalert"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	repoAudit "github.com/WagaoCarvalho/backend_store_go/internal/repo/audit/audit"
 	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	repoPrice "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/price"
+	repoStockAlert "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/stock_alert"
 	routes "github.com/WagaoCarvalho/backend_store_go/internal/route"
 	"github.com/sirupsen/logrus"
 )
 
 func main() {
-	configs := config.LoadConfig()
+	configs, configErr := config.LoadConfig()
 	port := configs.Server.Port
 	if port == "" {
 		port = "5000"
@@ -50,6 +57,12 @@ func main() {
 	})
 	appLogger := logger.NewLoggerAdapter(appRawLogger)
 
+	if configErr != nil {
+		systemLogger.Error(context.TODO(), configErr, "❌ Configuração inválida", nil)
+		os.Exit(1)
+	}
+	utils.UseTrustedProxies(configs.Server.TrustedProxies)
+
 	// Conecta DB
 	db, err := repo.Connect(&repo.RealPgxPool{})
 	if err != nil {
@@ -59,6 +72,28 @@ func main() {
 	defer db.Close()
 	systemLogger.Info(context.TODO(), "[✅ - DB CONECTADO -]", nil)
 
+	// Rotinas em segundo plano, encerradas junto com o servidor
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	// Verificador de estoque baixo
+	checker := stockalert.NewChecker(
+		repoStockAlert.NewStockAlert(db),
+		repoStockAlert.NewStockListener(db),
+		systemLogger,
+		configs.StockAlert.Interval,
+	)
+	go checker.Run(workersCtx)
+
+	// Agendador de mudanças de preço
+	priceScheduler := pricing.NewScheduler(
+		repoPrice.NewPriceApplier(db),
+		audit.NewRecorder(repoAudit.NewAudit(db), systemLogger),
+		systemLogger,
+		configs.PriceSchedule.Interval,
+	)
+	go priceScheduler.Run(workersCtx)
+
 	// Início servidor
 	systemLogger.Info(context.TODO(), "[✅ - SERVIDOR INICIADO -]", map[string]any{
 		"env":  configs.App.Env,
@@ -78,6 +113,7 @@ func main() {
 	go func() {
 		sig := <-quit
 		systemLogger.Info(context.TODO(), "[🔹 - SHUTDOWN INICIADO -]", map[string]any{"signal": sig.String()})
+		stopWorkers()
 
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
@@ -1,11 +1,20 @@
 package config
 
+import "os"
+
 type Config struct {
-	Database   Database
-	Jwt        Jwt
-	Server     Server
-	App        App
-	Pagination Pagination
+	Database      Database
+	Jwt           Jwt
+	Server        Server
+	App           App
+	Pagination    Pagination
+	Login         LoginLockout
+	RateLimit     RateLimit
+	Purchase      Purchase
+	StockAlert    StockAlert
+	PriceSchedule PriceSchedule
+	Pix           Pix
+	Receipt       Receipt
 }
 
 type App struct {
@@ -13,12 +22,26 @@ type App struct {
 	LogLevel string
 }
 
-func LoadConfig() Config {
+// LoadConfig carrega a configuração do ambiente. O erro indica um valor que
+// não pode ser usado e deve impedir a inicialização; a Config devolvida ainda
+// traz o restante, para que o erro possa ser registrado no log.
+func LoadConfig() (Config, error) {
+	server := LoadServerConfig()
+	proxies, err := ParseTrustedProxies(os.Getenv("TRUSTED_PROXIES"))
+	server.TrustedProxies = proxies
+
 	return Config{
-		Database:   LoadDatabaseConfig(),
-		Jwt:        LoadJwtConfig(),
-		Server:     LoadServerConfig(),
-		App:        LoadAppConfig(),
-		Pagination: LoadPaginationConfig(),
-	}
+		Database:      LoadDatabaseConfig(),
+		Jwt:           LoadJwtConfig(),
+		Server:        server,
+		App:           LoadAppConfig(),
+		Pagination:    LoadPaginationConfig(),
+		Login:         LoadLoginLockoutConfig(),
+		RateLimit:     LoadRateLimitConfig(),
+		Purchase:      LoadPurchaseConfig(),
+		StockAlert:    LoadStockAlertConfig(),
+		PriceSchedule: LoadPriceScheduleConfig(),
+		Pix:           LoadPixConfig(),
+		Receipt:       LoadReceiptConfig(),
+	}, err
 }
@@ -11,23 +11,25 @@ type Jwt struct {
 	Issuer        string
 	Audience      string
 	TokenDuration time.Duration
+	// RefreshTokenDuration é a validade máxima de uma sessão (família de
+	// refresh tokens); o token de acesso deve ser bem mais curto.
+	RefreshTokenDuration time.Duration
 }
 
 func LoadJwtConfig() Jwt {
-	durationStr := os.Getenv("JWT_TOKEN_DURATION")
-	if durationStr == "" {
-		durationStr = "3600" // padrão: 1 hora em segundos
-	}
-
-	seconds, err := strconv.Atoi(durationStr)
-	if err != nil {
-		seconds = 3600
+	return Jwt{
+		SecretKey:            os.Getenv("JWT_SECRET_KEY"),
+		Issuer:               os.Getenv("JWT_ISSUER"),
+		Audience:             os.Getenv("JWT_AUDIENCE"),
+		TokenDuration:        secondsFromEnv("JWT_TOKEN_DURATION", 900),            // padrão: 15 minutos
+		RefreshTokenDuration: secondsFromEnv("JWT_REFRESH_TOKEN_DURATION", 604800), // padrão: 7 dias
 	}
+}
 
-	return Jwt{
-		SecretKey:     os.Getenv("JWT_SECRET_KEY"),
-		Issuer:        os.Getenv("JWT_ISSUER"),
-		Audience:      os.Getenv("JWT_AUDIENCE"),
-		TokenDuration: time.Duration(seconds) * time.Second,
+func secondsFromEnv(key string, fallback int) time.Duration {
+	seconds, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || seconds <= 0 {
+		seconds = fallback
 	}
+	return time.Duration(seconds) * time.Second
 }
@@ -0,0 +1,24 @@
+package config
+
+import "time"
+
+// LoginLockout é a política contra força bruta no login.
+type LoginLockout struct {
+	MaxFailures   int
+	IPMaxFailures int
+	Window        time.Duration
+	LockDuration  time.Duration
+	BaseDelay     time.Duration
+	MaxDelay      time.Duration
+}
+
+func LoadLoginLockoutConfig() LoginLockout {
+	return LoginLockout{
+		MaxFailures:   getEnvAsInt("LOGIN_MAX_FAILURES", 5),
+		IPMaxFailures: getEnvAsInt("LOGIN_IP_MAX_FAILURES", 20),
+		Window:        secondsFromEnv("LOGIN_FAILURE_WINDOW", 900), // padrão: 15 minutos
+		LockDuration:  secondsFromEnv("LOGIN_LOCK_DURATION", 900),  // padrão: 15 minutos
+		BaseDelay:     secondsFromEnv("LOGIN_BASE_DELAY", 1),
+		MaxDelay:      secondsFromEnv("LOGIN_MAX_DELAY", 30),
+	}
+}
@@ -0,0 +1,24 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+// Pix identifica a loja como recebedora no BR Code e guarda o token que o PSP
+// envia no aviso de pagamento.
+type Pix struct {
+	Key          string
+	MerchantName string
+	MerchantCity string
+	WebhookToken string
+}
+
+func LoadPixConfig() Pix {
+	return Pix{
+		Key:          strings.TrimSpace(os.Getenv("PIX_KEY")),
+		MerchantName: strings.TrimSpace(os.Getenv("PIX_MERCHANT_NAME")),
+		MerchantCity: strings.TrimSpace(os.Getenv("PIX_MERCHANT_CITY")),
+		WebhookToken: os.Getenv("PIX_WEBHOOK_TOKEN"),
+	}
+}
@@ -0,0 +1,15 @@
+package config
+
+import "time"
+
+// PriceSchedule define o intervalo máximo entre as verificações do
+// agendador de preços; ele também acorda na data de cada mudança pendente.
+type PriceSchedule struct {
+	Interval time.Duration
+}
+
+func LoadPriceScheduleConfig() PriceSchedule {
+	return PriceSchedule{
+		Interval: secondsFromEnv("PRICE_SCHEDULE_INTERVAL", 60), // padrão: 1 minuto
+	}
+}
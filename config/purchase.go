@@ -0,0 +1,22 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+// Purchase define como o recebimento de compras atualiza o custo do produto:
+// "latest" usa o custo da última entrada e "average" a média ponderada entre
+// o estoque atual e a quantidade recebida.
+type Purchase struct {
+	CostMethod string
+}
+
+func LoadPurchaseConfig() Purchase {
+	method := strings.ToLower(strings.TrimSpace(os.Getenv("PURCHASE_COST_METHOD")))
+	if method != "average" {
+		method = "latest"
+	}
+
+	return Purchase{CostMethod: method}
+}
@@ -0,0 +1,96 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RatePolicy permite Requests requisições por Period, com rajadas de até Burst.
+// No ambiente é escrita como "requisições/período[:rajada]", ex.: "60/1m:20".
+type RatePolicy struct {
+	Requests int
+	Period   time.Duration
+	Burst    int
+}
+
+type RateLimit struct {
+	Backend string // "memory" (padrão) ou "redis", necessário com mais de uma instância
+	Default RatePolicy
+	// Groups sobrepõe Default por prefixo de rota, ex.: "/login=5/1m:5,/sale=20/1s"
+	Groups map[string]RatePolicy
+	// Users sobrepõe o limite de usuários autenticados, ex.: "12=100/1s:200"
+	Users map[string]RatePolicy
+}
+
+var defaultRatePolicy = RatePolicy{Requests: 1, Period: time.Second, Burst: 3}
+
+func LoadRateLimitConfig() RateLimit {
+	backend := strings.ToLower(os.Getenv("RATE_LIMIT_BACKEND"))
+	if backend == "" {
+		backend = "memory"
+	}
+
+	def, err := ParseRatePolicy(os.Getenv("RATE_LIMIT_DEFAULT"))
+	if err != nil {
+		def = defaultRatePolicy
+	}
+
+	return RateLimit{
+		Backend: backend,
+		Default: def,
+		Groups:  parseRatePolicies(os.Getenv("RATE_LIMIT_GROUPS")),
+		Users:   parseRatePolicies(os.Getenv("RATE_LIMIT_USERS")),
+	}
+}
+
+func ParseRatePolicy(value string) (RatePolicy, error) {
+	var policy RatePolicy
+
+	spec, burst, hasBurst := strings.Cut(strings.TrimSpace(value), ":")
+	requests, period, ok := strings.Cut(spec, "/")
+	if !ok {
+		return policy, fmt.Errorf("política de limite inválida: %q", value)
+	}
+
+	n, err := strconv.Atoi(requests)
+	if err != nil || n <= 0 {
+		return policy, fmt.Errorf("número de requisições inválido: %q", value)
+	}
+
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return policy, fmt.Errorf("período inválido: %q", value)
+	}
+
+	policy = RatePolicy{Requests: n, Period: d, Burst: n}
+	if hasBurst {
+		b, err := strconv.Atoi(burst)
+		if err != nil || b <= 0 {
+			return RatePolicy{}, fmt.Errorf("rajada inválida: %q", value)
+		}
+		policy.Burst = b
+	}
+
+	return policy, nil
+}
+
+// parseRatePolicies lê "chave=política" separados por vírgula, ignorando
+// entradas inválidas.
+func parseRatePolicies(value string) map[string]RatePolicy {
+	policies := make(map[string]RatePolicy)
+	for _, item := range splitList(value) {
+		key, spec, ok := strings.Cut(item, "=")
+		if !ok {
+			continue
+		}
+		policy, err := ParseRatePolicy(spec)
+		if err != nil {
+			continue
+		}
+		policies[strings.TrimSpace(key)] = policy
+	}
+	return policies
+}
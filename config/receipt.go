@@ -0,0 +1,32 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+// Receipt traz o cabeçalho da loja impresso no comprovante de venda e o
+// template que o desenha. TemplatePath aponta para um arquivo text/template;
+// vazio usa o template padrão. Columns é a largura da linha em caracteres:
+// 48 na fonte padrão das impressoras térmicas de 80mm.
+type Receipt struct {
+	StoreName     string
+	StoreDocument string
+	StoreAddress  string
+	StorePhone    string
+	Footer        string
+	TemplatePath  string
+	Columns       int
+}
+
+func LoadReceiptConfig() Receipt {
+	return Receipt{
+		StoreName:     strings.TrimSpace(os.Getenv("RECEIPT_STORE_NAME")),
+		StoreDocument: strings.TrimSpace(os.Getenv("RECEIPT_STORE_DOCUMENT")),
+		StoreAddress:  strings.TrimSpace(os.Getenv("RECEIPT_STORE_ADDRESS")),
+		StorePhone:    strings.TrimSpace(os.Getenv("RECEIPT_STORE_PHONE")),
+		Footer:        strings.TrimSpace(os.Getenv("RECEIPT_FOOTER")),
+		TemplatePath:  strings.TrimSpace(os.Getenv("RECEIPT_TEMPLATE")),
+		Columns:       getEnvAsInt("RECEIPT_COLUMNS", 48),
+	}
+}
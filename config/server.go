@@ -1,11 +1,20 @@
 package config
 
-import "os"
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+)
 
 type Server struct {
 	Port    string
 	BaseURL string
 	IDPath  string
+	// TrustedProxies são as redes dos proxies cujos cabeçalhos
+	// X-Forwarded-For e X-Real-IP são aceitos para descobrir o IP do cliente.
+	// Vêm de TRUSTED_PROXIES e são validadas por LoadConfig.
+	TrustedProxies []*net.IPNet
 }
 
 func LoadServerConfig() Server {
@@ -15,3 +24,37 @@ func LoadServerConfig() Server {
 		IDPath:  os.Getenv("API_ID_PATH"),
 	}
 }
+
+// ParseTrustedProxies lê uma lista de IPs ("10.0.0.1") ou CIDRs
+// ("10.0.0.0/8") separados por vírgula. Um item inválido é erro: ignorá-lo
+// mudaria em silêncio quais clientes podem informar o próprio IP.
+func ParseTrustedProxies(value string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, proxy := range splitList(value) {
+		cidr := proxy
+		if !strings.Contains(cidr, "/") {
+			if strings.Contains(cidr, ":") {
+				cidr += "/128"
+			} else {
+				cidr += "/32"
+			}
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("TRUSTED_PROXIES: proxy confiável inválido %q: %w", proxy, err)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// splitList separa uma lista por vírgulas, descartando itens vazios.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
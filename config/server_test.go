@@ -0,0 +1,39 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTrustedProxies(t *testing.T) {
+	t.Run("IPs e CIDRs", func(t *testing.T) {
+		networks, err := ParseTrustedProxies(" 10.0.0.0/8, 192.0.2.1,,2001:db8::1 ")
+		require.NoError(t, err)
+		require.Len(t, networks, 3)
+		assert.Equal(t, "10.0.0.0/8", networks[0].String())
+		assert.Equal(t, "192.0.2.1/32", networks[1].String())
+		assert.Equal(t, "2001:db8::1/128", networks[2].String())
+	})
+
+	t.Run("vazio não confia em ninguém", func(t *testing.T) {
+		networks, err := ParseTrustedProxies("")
+		assert.NoError(t, err)
+		assert.Empty(t, networks)
+	})
+
+	t.Run("item inválido é erro", func(t *testing.T) {
+		_, err := ParseTrustedProxies("10.0.0.1, não-é-ip")
+		assert.ErrorContains(t, err, "TRUSTED_PROXIES")
+		assert.ErrorContains(t, err, "não-é-ip")
+	})
+}
+
+func TestLoadConfig_InvalidTrustedProxies(t *testing.T) {
+	t.Setenv("TRUSTED_PROXIES", "10.0.0.0/33")
+
+	_, err := LoadConfig()
+
+	assert.Error(t, err)
+}
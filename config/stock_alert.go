@@ -0,0 +1,15 @@
+package config
+
+import "time"
+
+// StockAlert define de quanto em quanto tempo o verificador de estoque
+// baixo revisa todo o catálogo, além dos avisos recebidos do banco.
+type StockAlert struct {
+	Interval time.Duration
+}
+
+func LoadStockAlertConfig() StockAlert {
+	return StockAlert{
+		Interval: secondsFromEnv("STOCK_ALERT_INTERVAL", 300), // padrão: 5 minutos
+	}
+}
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS sale_return_items;
+DROP TABLE IF EXISTS sale_returns;
\ No newline at end of file
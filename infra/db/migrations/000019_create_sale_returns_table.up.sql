@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS sale_returns (
+    id SERIAL PRIMARY KEY,
+    sale_id INTEGER NOT NULL REFERENCES sales(id) ON DELETE CASCADE,
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    reason TEXT CHECK (char_length(reason) <= 500),
+    total_amount DECIMAL(12,2) NOT NULL DEFAULT 0.00 CHECK (total_amount >= 0),
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS sale_return_items (
+    id SERIAL PRIMARY KEY,
+    sale_return_id INTEGER NOT NULL REFERENCES sale_returns(id) ON DELETE CASCADE,
+    sale_item_id INTEGER NOT NULL REFERENCES sale_items(id) ON DELETE CASCADE,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE RESTRICT,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    amount DECIMAL(12,2) NOT NULL DEFAULT 0.00 CHECK (amount >= 0),
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Índices para melhorar a performance
+CREATE INDEX IF NOT EXISTS idx_sale_returns_sale_id ON sale_returns (sale_id);
+CREATE INDEX IF NOT EXISTS idx_sale_return_items_sale_return_id ON sale_return_items (sale_return_id);
+CREATE INDEX IF NOT EXISTS idx_sale_return_items_sale_item_id ON sale_return_items (sale_item_id);
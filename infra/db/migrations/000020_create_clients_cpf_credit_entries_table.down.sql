@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trg_clients_cpf_credit_entries_append_only ON clients_cpf_credit_entries;
+DROP FUNCTION IF EXISTS fn_clients_cpf_credit_entries_append_only();
+DROP TABLE IF EXISTS clients_cpf_credit_entries;
\ No newline at end of file
@@ -0,0 +1,56 @@
+CREATE TABLE IF NOT EXISTS clients_cpf_credit_entries (
+    id BIGSERIAL PRIMARY KEY,
+
+    client_cpf_id INT NOT NULL,
+    CONSTRAINT fk_clients_cpf_credit_entries_client
+        FOREIGN KEY (client_cpf_id)
+        REFERENCES clients_cpf(id)
+        ON DELETE CASCADE,
+
+    entry_type VARCHAR(20) NOT NULL,
+    CONSTRAINT chk_clients_cpf_credit_entries_type
+        CHECK (entry_type IN ('charge', 'payment', 'refund')),
+
+    amount NUMERIC(14,2) NOT NULL,
+    CONSTRAINT chk_clients_cpf_credit_entries_amount_positive
+        CHECK (amount > 0),
+
+    -- Saldo devedor do cliente logo após o lançamento (saldo corrente do extrato)
+    balance_after NUMERIC(14,2) NOT NULL,
+    CONSTRAINT chk_clients_cpf_credit_entries_balance_non_negative
+        CHECK (balance_after >= 0),
+
+    sale_id INTEGER REFERENCES sales(id) ON DELETE SET NULL,
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+
+    description TEXT CHECK (char_length(description) <= 255),
+
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_clients_cpf_credit_entries_client_id
+    ON clients_cpf_credit_entries (client_cpf_id, id);
+
+CREATE INDEX idx_clients_cpf_credit_entries_sale_id
+    ON clients_cpf_credit_entries (sale_id);
+
+-- O razão é somente inserção. Alterações vindas de ações de FK (ON DELETE
+-- CASCADE / SET NULL) rodam dentro de outro trigger e continuam permitidas.
+CREATE OR REPLACE FUNCTION fn_clients_cpf_credit_entries_append_only()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF pg_trigger_depth() > 1 THEN
+        IF TG_OP = 'DELETE' THEN
+            RETURN OLD;
+        END IF;
+        RETURN NEW;
+    END IF;
+
+    RAISE EXCEPTION 'lançamentos de crédito não podem ser alterados ou removidos';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_clients_cpf_credit_entries_append_only
+    BEFORE UPDATE OR DELETE ON clients_cpf_credit_entries
+    FOR EACH ROW
+    EXECUTE FUNCTION fn_clients_cpf_credit_entries_append_only();
@@ -0,0 +1,117 @@
+CREATE TABLE IF NOT EXISTS roles (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(50) NOT NULL UNIQUE,
+    description VARCHAR(255),
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS permissions (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(100) NOT NULL UNIQUE,
+    description VARCHAR(255),
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role_id INTEGER NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    permission_id INTEGER NOT NULL REFERENCES permissions(id) ON DELETE CASCADE,
+    PRIMARY KEY (role_id, permission_id)
+);
+
+CREATE TABLE IF NOT EXISTS user_roles (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role_id INTEGER NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, role_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_roles_role_id ON user_roles (role_id);
+
+-- Papéis padrão
+INSERT INTO roles (name, description) VALUES
+    ('admin',       'Acesso total, incluindo usuários e papéis'),
+    ('manager',     'Gerência da loja: vendas, estoque, clientes e fornecedores'),
+    ('cashier',     'Caixa: registra vendas e atende clientes'),
+    ('stock_clerk', 'Estoquista: consulta produtos e movimenta estoque')
+ON CONFLICT (name) DO NOTHING;
+
+-- Catálogo de permissões (ver internal/pkg/auth/permission)
+INSERT INTO permissions (code, description) VALUES
+    ('user:read',       'Consultar usuários'),
+    ('user:write',      'Cadastrar e alterar usuários'),
+    ('user:delete',     'Excluir usuários'),
+    ('role:read',       'Consultar papéis'),
+    ('role:assign',     'Atribuir e remover papéis de usuários'),
+    ('supplier:read',   'Consultar fornecedores'),
+    ('supplier:write',  'Cadastrar e alterar fornecedores'),
+    ('supplier:delete', 'Excluir fornecedores'),
+    ('client:read',     'Consultar clientes'),
+    ('client:write',    'Cadastrar e alterar clientes'),
+    ('client:delete',   'Excluir clientes'),
+    ('credit:read',     'Consultar conta e extrato de crédito'),
+    ('credit:manage',   'Abrir e ajustar linha de crédito'),
+    ('credit:post',     'Lançar cobranças e pagamentos no crédito'),
+    ('product:read',    'Consultar produtos'),
+    ('product:write',   'Cadastrar e alterar produtos, preços e descontos'),
+    ('product:delete',  'Excluir produtos'),
+    ('product:stock',   'Movimentar estoque'),
+    ('sale:read',       'Consultar vendas'),
+    ('sale:create',     'Registrar vendas'),
+    ('sale:update',     'Alterar vendas e itens'),
+    ('sale:cancel',     'Cancelar e reativar vendas'),
+    ('sale:return',     'Registrar devoluções'),
+    ('sale:delete',     'Excluir vendas'),
+    ('address:read',    'Consultar endereços'),
+    ('address:write',   'Cadastrar e alterar endereços'),
+    ('address:delete',  'Excluir endereços'),
+    ('contact:read',    'Consultar contatos'),
+    ('contact:write',   'Cadastrar e alterar contatos'),
+    ('contact:delete',  'Excluir contatos')
+ON CONFLICT (code) DO NOTHING;
+
+-- admin: todas as permissões
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r CROSS JOIN permissions p
+WHERE r.name = 'admin'
+ON CONFLICT DO NOTHING;
+
+-- manager: tudo, exceto administração de usuários e papéis
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r CROSS JOIN permissions p
+WHERE r.name = 'manager'
+  AND p.code NOT IN ('user:write', 'user:delete', 'role:assign')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r JOIN permissions p ON p.code IN (
+    'client:read', 'client:write',
+    'credit:read', 'credit:post',
+    'product:read',
+    'sale:read', 'sale:create', 'sale:update',
+    'address:read', 'address:write',
+    'contact:read', 'contact:write'
+)
+WHERE r.name = 'cashier'
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r JOIN permissions p ON p.code IN (
+    'product:read', 'product:stock',
+    'supplier:read',
+    'address:read', 'contact:read'
+)
+WHERE r.name = 'stock_clerk'
+ON CONFLICT DO NOTHING;
+
+-- Evita bloquear a instalação existente: o primeiro usuário cadastrado vira admin
+INSERT INTO user_roles (user_id, role_id)
+SELECT u.id, r.id
+FROM (SELECT id FROM users ORDER BY id LIMIT 1) u
+CROSS JOIN roles r
+WHERE r.name = 'admin'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,43 @@
+CREATE TABLE IF NOT EXISTS audit_log (
+    id BIGSERIAL PRIMARY KEY,
+
+    -- Sem FK: o registro precisa sobreviver à exclusão do usuário
+    actor_user_id INTEGER,
+    request_id VARCHAR(64),
+
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id BIGINT NOT NULL,
+    action VARCHAR(50) NOT NULL,
+
+    -- {"Campo": {"from": <antes>, "to": <depois>}} apenas com o que mudou
+    changes JSONB NOT NULL DEFAULT '{}'::jsonb,
+
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_audit_log_entity ON audit_log (entity_type, entity_id, id);
+CREATE INDEX idx_audit_log_actor ON audit_log (actor_user_id, id);
+CREATE INDEX idx_audit_log_created_at ON audit_log (created_at);
+
+-- A trilha é somente inserção
+CREATE OR REPLACE FUNCTION fn_audit_log_append_only()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'registros de auditoria não podem ser alterados ou removidos';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_audit_log_append_only
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW
+    EXECUTE FUNCTION fn_audit_log_append_only();
+
+INSERT INTO permissions (code, description) VALUES
+    ('audit:read', 'Consultar a trilha de auditoria')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r JOIN permissions p ON p.code = 'audit:read'
+WHERE r.name IN ('admin', 'manager')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,5 @@
+DELETE FROM permissions WHERE code IN ('purchase:read', 'purchase:write', 'purchase:receive');
+DROP TABLE IF EXISTS purchase_receipt_items;
+DROP TABLE IF EXISTS purchase_receipts;
+DROP TABLE IF EXISTS purchase_order_items;
+DROP TABLE IF EXISTS purchase_orders;
@@ -0,0 +1,71 @@
+CREATE TABLE IF NOT EXISTS purchase_orders (
+    id SERIAL PRIMARY KEY,
+    supplier_id INTEGER NOT NULL REFERENCES suppliers(id) ON DELETE RESTRICT,
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'draft'
+        CHECK (status IN ('draft', 'sent', 'partially_received', 'received', 'canceled')),
+    notes TEXT CHECK (char_length(notes) <= 500),
+    expected_at TIMESTAMP WITHOUT TIME ZONE,
+    total_amount DECIMAL(12,2) NOT NULL DEFAULT 0.00 CHECK (total_amount >= 0),
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS purchase_order_items (
+    id SERIAL PRIMARY KEY,
+    purchase_order_id INTEGER NOT NULL REFERENCES purchase_orders(id) ON DELETE CASCADE,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE RESTRICT,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    received_quantity INTEGER NOT NULL DEFAULT 0 CHECK (received_quantity >= 0),
+    unit_cost DECIMAL(10,2) NOT NULL CHECK (unit_cost >= 0),
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_purchase_item_received CHECK (received_quantity <= quantity),
+    CONSTRAINT uq_purchase_order_product UNIQUE (purchase_order_id, product_id)
+);
+
+-- Cada recebimento fica registrado, inclusive o custo efetivamente pago
+CREATE TABLE IF NOT EXISTS purchase_receipts (
+    id SERIAL PRIMARY KEY,
+    purchase_order_id INTEGER NOT NULL REFERENCES purchase_orders(id) ON DELETE CASCADE,
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    notes TEXT CHECK (char_length(notes) <= 500),
+    cost_method VARCHAR(10) NOT NULL CHECK (cost_method IN ('latest', 'average')),
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS purchase_receipt_items (
+    id SERIAL PRIMARY KEY,
+    purchase_receipt_id INTEGER NOT NULL REFERENCES purchase_receipts(id) ON DELETE CASCADE,
+    purchase_order_item_id INTEGER NOT NULL REFERENCES purchase_order_items(id) ON DELETE CASCADE,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE RESTRICT,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    unit_cost DECIMAL(10,2) NOT NULL CHECK (unit_cost >= 0),
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Índices para melhorar a performance
+CREATE INDEX IF NOT EXISTS idx_purchase_orders_supplier_id ON purchase_orders (supplier_id);
+CREATE INDEX IF NOT EXISTS idx_purchase_orders_status ON purchase_orders (status);
+CREATE INDEX IF NOT EXISTS idx_purchase_order_items_order_id ON purchase_order_items (purchase_order_id);
+CREATE INDEX IF NOT EXISTS idx_purchase_receipts_order_id ON purchase_receipts (purchase_order_id);
+CREATE INDEX IF NOT EXISTS idx_purchase_receipt_items_receipt_id ON purchase_receipt_items (purchase_receipt_id);
+
+INSERT INTO permissions (code, description) VALUES
+    ('purchase:read', 'Consultar pedidos de compra'),
+    ('purchase:write', 'Criar, alterar, enviar e cancelar pedidos de compra'),
+    ('purchase:receive', 'Registrar o recebimento de mercadorias')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r JOIN permissions p ON p.code IN ('purchase:read', 'purchase:write', 'purchase:receive')
+WHERE r.name IN ('admin', 'manager')
+ON CONFLICT DO NOTHING;
+
+-- O estoquista confere e dá entrada nas mercadorias
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r JOIN permissions p ON p.code IN ('purchase:read', 'purchase:receive')
+WHERE r.name = 'stock_clerk'
+ON CONFLICT DO NOTHING;
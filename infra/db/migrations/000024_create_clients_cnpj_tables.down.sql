@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_sales_client_cnpj_id;
+ALTER TABLE sales DROP CONSTRAINT IF EXISTS chk_sales_single_client;
+ALTER TABLE sales DROP COLUMN IF EXISTS client_cnpj_id;
+
+DROP INDEX IF EXISTS idx_addresses_client_cnpj_id;
+ALTER TABLE addresses DROP COLUMN IF EXISTS client_cnpj_id;
+
+DROP TRIGGER IF EXISTS trg_clients_cnpj_credit_entries_append_only ON clients_cnpj_credit_entries;
+DROP FUNCTION IF EXISTS fn_clients_cnpj_credit_entries_append_only();
+DROP TABLE IF EXISTS clients_cnpj_credit_entries;
+DROP TABLE IF EXISTS clients_cnpj_credits;
+DROP TABLE IF EXISTS clients_cnpj_contact_relations;
+DROP TABLE IF EXISTS clients_cnpj;
@@ -0,0 +1,173 @@
+CREATE TABLE IF NOT EXISTS clients_cnpj (
+    id SERIAL PRIMARY KEY,
+
+    -- Razão social
+    name VARCHAR(255) NOT NULL,
+
+    trade_name VARCHAR(255),
+
+    email VARCHAR(255) NOT NULL,
+    CONSTRAINT uq_clients_cnpj_email UNIQUE (email),
+    CONSTRAINT chk_clients_cnpj_email_lower CHECK (email = LOWER(email)),
+
+    cnpj CHAR(14) NOT NULL,
+    CONSTRAINT uq_clients_cnpj_cnpj UNIQUE (cnpj),
+    CONSTRAINT chk_clients_cnpj_cnpj_format CHECK (cnpj ~ '^[0-9]{14}$'),
+
+    -- Inscrição estadual ("ISENTO" para contribuintes dispensados)
+    state_registration VARCHAR(20),
+
+    description TEXT,
+
+    status BOOLEAN NOT NULL DEFAULT TRUE,
+
+    version INTEGER NOT NULL DEFAULT 1,
+    CONSTRAINT chk_clients_cnpj_version_positive CHECK (version > 0),
+
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_clients_cnpj_name ON clients_cnpj (name);
+CREATE INDEX idx_clients_cnpj_trade_name ON clients_cnpj (trade_name);
+CREATE INDEX idx_clients_cnpj_status_true ON clients_cnpj (id) WHERE status = TRUE;
+
+CREATE TABLE IF NOT EXISTS clients_cnpj_contact_relations (
+    contact_id INT NOT NULL,
+    client_cnpj_id INT NOT NULL,
+
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT pk_clients_cnpj_contact_relations
+        PRIMARY KEY (contact_id, client_cnpj_id),
+
+    CONSTRAINT fk_clients_cnpj_contact_relations_contact
+        FOREIGN KEY (contact_id)
+        REFERENCES contacts(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_clients_cnpj_contact_relations_client
+        FOREIGN KEY (client_cnpj_id)
+        REFERENCES clients_cnpj(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_clients_cnpj_contact_relations_client
+    ON clients_cnpj_contact_relations (client_cnpj_id);
+
+CREATE INDEX idx_clients_cnpj_contact_relations_contact
+    ON clients_cnpj_contact_relations (contact_id);
+
+-- Crédito: mesma estrutura de clients_cpf_credits, com FK para clients_cnpj
+CREATE TABLE IF NOT EXISTS clients_cnpj_credits (
+    id SERIAL PRIMARY KEY,
+
+    client_cnpj_id INT NOT NULL,
+    CONSTRAINT fk_clients_cnpj_credits_client
+        FOREIGN KEY (client_cnpj_id)
+        REFERENCES clients_cnpj(id)
+        ON DELETE CASCADE,
+
+    allow_credit BOOLEAN NOT NULL DEFAULT FALSE,
+
+    credit_limit NUMERIC(14,2) NOT NULL DEFAULT 0.00,
+    credit_balance NUMERIC(14,2) NOT NULL DEFAULT 0.00,
+
+    CONSTRAINT chk_clients_cnpj_credit_limit_non_negative
+        CHECK (credit_limit >= 0),
+
+    CONSTRAINT chk_clients_cnpj_credit_balance_valid
+        CHECK (credit_balance >= 0 AND credit_balance <= credit_limit),
+
+    CONSTRAINT chk_clients_cnpj_credit_allowed_consistency
+        CHECK (
+            (allow_credit = TRUE)
+            OR
+            (allow_credit = FALSE AND credit_limit = 0 AND credit_balance = 0)
+        ),
+
+    description TEXT,
+
+    version INTEGER NOT NULL DEFAULT 1,
+    CONSTRAINT chk_clients_cnpj_credits_version_positive CHECK (version > 0),
+
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_clients_cnpj_credits_client UNIQUE (client_cnpj_id)
+);
+
+CREATE INDEX idx_clients_cnpj_credits_allow_credit
+    ON clients_cnpj_credits (allow_credit);
+
+CREATE TABLE IF NOT EXISTS clients_cnpj_credit_entries (
+    id BIGSERIAL PRIMARY KEY,
+
+    client_cnpj_id INT NOT NULL,
+    CONSTRAINT fk_clients_cnpj_credit_entries_client
+        FOREIGN KEY (client_cnpj_id)
+        REFERENCES clients_cnpj(id)
+        ON DELETE CASCADE,
+
+    entry_type VARCHAR(20) NOT NULL,
+    CONSTRAINT chk_clients_cnpj_credit_entries_type
+        CHECK (entry_type IN ('charge', 'payment', 'refund')),
+
+    amount NUMERIC(14,2) NOT NULL,
+    CONSTRAINT chk_clients_cnpj_credit_entries_amount_positive
+        CHECK (amount > 0),
+
+    balance_after NUMERIC(14,2) NOT NULL,
+    CONSTRAINT chk_clients_cnpj_credit_entries_balance_non_negative
+        CHECK (balance_after >= 0),
+
+    sale_id INTEGER REFERENCES sales(id) ON DELETE SET NULL,
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+
+    description TEXT CHECK (char_length(description) <= 255),
+
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_clients_cnpj_credit_entries_client_id
+    ON clients_cnpj_credit_entries (client_cnpj_id, id);
+
+CREATE INDEX idx_clients_cnpj_credit_entries_sale_id
+    ON clients_cnpj_credit_entries (sale_id);
+
+-- Razão somente inserção, com a mesma regra do razão de clientes CPF
+CREATE OR REPLACE FUNCTION fn_clients_cnpj_credit_entries_append_only()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF pg_trigger_depth() > 1 THEN
+        IF TG_OP = 'DELETE' THEN
+            RETURN OLD;
+        END IF;
+        RETURN NEW;
+    END IF;
+
+    RAISE EXCEPTION 'lançamentos de crédito não podem ser alterados ou removidos';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_clients_cnpj_credit_entries_append_only
+    BEFORE UPDATE OR DELETE ON clients_cnpj_credit_entries
+    FOR EACH ROW
+    EXECUTE FUNCTION fn_clients_cnpj_credit_entries_append_only();
+
+-- Endereços de clientes CNPJ
+ALTER TABLE addresses
+    ADD COLUMN IF NOT EXISTS client_cnpj_id INTEGER REFERENCES clients_cnpj(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_addresses_client_cnpj_id ON addresses (client_cnpj_id);
+
+-- Vendas referenciam um cliente CPF (client_id) ou CNPJ (client_cnpj_id), nunca os dois
+ALTER TABLE sales
+    ADD COLUMN IF NOT EXISTS client_cnpj_id INTEGER REFERENCES clients_cnpj(id) ON DELETE SET NULL;
+
+ALTER TABLE sales
+    ADD CONSTRAINT chk_sales_single_client
+        CHECK (client_id IS NULL OR client_cnpj_id IS NULL);
+
+CREATE INDEX IF NOT EXISTS idx_sales_client_cnpj_id ON sales (client_cnpj_id);
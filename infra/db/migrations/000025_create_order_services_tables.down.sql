@@ -0,0 +1,7 @@
+DELETE FROM roles WHERE name = 'technician';
+DELETE FROM permissions WHERE code IN ('service_order:read', 'service_order:write', 'service_order:convert');
+DROP TABLE IF EXISTS order_service_category_relations;
+DROP TABLE IF EXISTS order_service_categories;
+DROP TABLE IF EXISTS order_service_labor;
+DROP TABLE IF EXISTS order_service_parts;
+DROP TABLE IF EXISTS order_services;
@@ -0,0 +1,98 @@
+-- Ordens de serviço: reparos e instalações feitos para um cliente (CPF ou CNPJ)
+CREATE TABLE IF NOT EXISTS order_services (
+    id SERIAL PRIMARY KEY,
+    client_id INTEGER REFERENCES clients_cpf(id) ON DELETE RESTRICT,
+    client_cnpj_id INTEGER REFERENCES clients_cnpj(id) ON DELETE RESTRICT,
+    technician_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open'
+        CHECK (status IN ('open', 'in_progress', 'waiting_parts', 'done', 'delivered', 'canceled')),
+    problem_description TEXT NOT NULL CHECK (char_length(problem_description) BETWEEN 1 AND 1000),
+    notes TEXT CHECK (char_length(notes) <= 500),
+    parts_amount DECIMAL(12,2) NOT NULL DEFAULT 0.00 CHECK (parts_amount >= 0),
+    labor_amount DECIMAL(12,2) NOT NULL DEFAULT 0.00 CHECK (labor_amount >= 0),
+    total_amount DECIMAL(12,2) NOT NULL DEFAULT 0.00 CHECK (total_amount >= 0),
+    sale_id INTEGER REFERENCES sales(id) ON DELETE SET NULL,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_order_services_single_client CHECK (num_nonnulls(client_id, client_cnpj_id) = 1),
+    CONSTRAINT uq_order_services_sale UNIQUE (sale_id)
+);
+
+-- Peças consumidas: cada linha já baixou o estoque do produto
+CREATE TABLE IF NOT EXISTS order_service_parts (
+    id SERIAL PRIMARY KEY,
+    order_service_id INTEGER NOT NULL REFERENCES order_services(id) ON DELETE CASCADE,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE RESTRICT,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    unit_price DECIMAL(10,2) NOT NULL CHECK (unit_price >= 0),
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS order_service_labor (
+    id SERIAL PRIMARY KEY,
+    order_service_id INTEGER NOT NULL REFERENCES order_services(id) ON DELETE CASCADE,
+    description VARCHAR(255) NOT NULL CHECK (char_length(description) > 0),
+    amount DECIMAL(10,2) NOT NULL CHECK (amount >= 0),
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS order_service_categories (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    description VARCHAR(255),
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS order_service_category_relations (
+    order_service_id INTEGER NOT NULL REFERENCES order_services(id) ON DELETE CASCADE,
+    category_id INTEGER NOT NULL REFERENCES order_service_categories(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT pk_order_service_category_relations PRIMARY KEY (order_service_id, category_id)
+);
+
+-- Índices para melhorar a performance
+CREATE INDEX IF NOT EXISTS idx_order_services_client_id ON order_services (client_id);
+CREATE INDEX IF NOT EXISTS idx_order_services_client_cnpj_id ON order_services (client_cnpj_id);
+CREATE INDEX IF NOT EXISTS idx_order_services_technician_id ON order_services (technician_id);
+CREATE INDEX IF NOT EXISTS idx_order_services_status ON order_services (status);
+CREATE INDEX IF NOT EXISTS idx_order_service_parts_order_id ON order_service_parts (order_service_id);
+CREATE INDEX IF NOT EXISTS idx_order_service_labor_order_id ON order_service_labor (order_service_id);
+CREATE INDEX IF NOT EXISTS idx_order_service_category_relations_category_id ON order_service_category_relations (category_id);
+
+INSERT INTO order_service_categories (name, description) VALUES
+    ('reparo',     'Conserto de equipamento do cliente'),
+    ('instalacao', 'Instalação de produto no local do cliente'),
+    ('manutencao', 'Manutenção preventiva')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO permissions (code, description) VALUES
+    ('service_order:read', 'Consultar ordens de serviço'),
+    ('service_order:write', 'Abrir, alterar e movimentar ordens de serviço'),
+    ('service_order:convert', 'Faturar ordens de serviço concluídas como venda')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO roles (name, description) VALUES
+    ('technician', 'Técnico: executa ordens de serviço e lança peças e mão de obra')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r JOIN permissions p ON p.code IN ('service_order:read', 'service_order:write', 'service_order:convert')
+WHERE r.name IN ('admin', 'manager')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r JOIN permissions p ON p.code IN ('service_order:read', 'service_order:write', 'product:read')
+WHERE r.name = 'technician'
+ON CONFLICT DO NOTHING;
+
+-- O caixa fatura a ordem na entrega
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r JOIN permissions p ON p.code IN ('service_order:read', 'service_order:convert')
+WHERE r.name = 'cashier'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_product_category_relations_category_id;
+DROP INDEX IF EXISTS idx_product_categories_name_trgm;
+DROP INDEX IF EXISTS idx_products_product_name_trgm;
+DROP INDEX IF EXISTS idx_products_search_vector;
+ALTER TABLE products DROP COLUMN IF EXISTS search_vector;
+DROP FUNCTION IF EXISTS f_unaccent(text);
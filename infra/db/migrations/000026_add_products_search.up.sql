@@ -0,0 +1,22 @@
+-- Busca de produtos: full-text em português sem acentos + similaridade por trigramas
+CREATE EXTENSION IF NOT EXISTS unaccent;
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- unaccent() é STABLE; o wrapper IMMUTABLE permite usá-la em índices e colunas geradas
+CREATE OR REPLACE FUNCTION f_unaccent(text)
+RETURNS text
+LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT AS
+$$ SELECT public.unaccent('public.unaccent'::regdictionary, $1) $$;
+
+-- Pesos: nome (A) > fabricante (B) > descrição (C)
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('portuguese', f_unaccent(coalesce(product_name, ''))), 'A') ||
+        setweight(to_tsvector('portuguese', f_unaccent(coalesce(manufacturer, ''))), 'B') ||
+        setweight(to_tsvector('portuguese', f_unaccent(coalesce(product_description, ''))), 'C')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_products_search_vector ON products USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_products_product_name_trgm ON products USING GIN (f_unaccent(lower(product_name)) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_product_categories_name_trgm ON product_categories USING GIN (f_unaccent(lower(name)) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_product_category_relations_category_id ON product_category_relations (category_id);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trg_products_stock_changed ON products;
+DROP FUNCTION IF EXISTS notify_stock_changed();
+DROP TABLE IF EXISTS stock_alerts;
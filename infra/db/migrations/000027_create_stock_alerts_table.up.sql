@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS stock_alerts (
+    id SERIAL PRIMARY KEY,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    stock_quantity INTEGER NOT NULL,
+    min_stock INTEGER NOT NULL,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMP WITHOUT TIME ZONE
+);
+
+-- No máximo um alerta aberto por produto
+CREATE UNIQUE INDEX IF NOT EXISTS uq_stock_alerts_open
+    ON stock_alerts (product_id) WHERE resolved_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_stock_alerts_created_at ON stock_alerts (created_at);
+
+-- Avisa o verificador de estoque (canal stock_changed) a cada mudança de
+-- estoque ou de mínimo. O NOTIFY só é entregue no commit, então vendas e
+-- compras em transação também são vistas, e rollbacks não.
+CREATE OR REPLACE FUNCTION notify_stock_changed() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('stock_changed', NEW.id::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_products_stock_changed
+    AFTER UPDATE OF stock_quantity, min_stock ON products
+    FOR EACH ROW
+    WHEN (OLD.stock_quantity IS DISTINCT FROM NEW.stock_quantity
+       OR OLD.min_stock IS DISTINCT FROM NEW.min_stock)
+    EXECUTE FUNCTION notify_stock_changed();
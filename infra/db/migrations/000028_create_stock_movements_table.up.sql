@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id BIGSERIAL PRIMARY KEY,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    delta INTEGER NOT NULL CHECK (delta <> 0),
+    quantity INTEGER NOT NULL CHECK (quantity >= 0),
+    reason VARCHAR(20) NOT NULL
+        CHECK (reason IN ('sale', 'return', 'receipt', 'adjustment', 'count')),
+    ref_type VARCHAR(30),
+    ref_id BIGINT,
+    user_id INTEGER,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_movements_product_created
+    ON stock_movements (product_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_stock_movements_ref
+    ON stock_movements (ref_type, ref_id);
+
+-- O histórico só recebe inserções. A exclusão é permitida apenas quando vem
+-- do ON DELETE CASCADE do produto (trigger aninhado).
+CREATE OR REPLACE FUNCTION stock_movements_append_only() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' AND pg_trigger_depth() > 1 THEN
+        RETURN OLD;
+    END IF;
+    RAISE EXCEPTION 'stock_movements é somente inserção';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_stock_movements_append_only
+    BEFORE UPDATE OR DELETE ON stock_movements
+    FOR EACH ROW
+    EXECUTE FUNCTION stock_movements_append_only();
+
+-- Saldo de abertura para o estoque que já existia antes do histórico. Datas
+-- anteriores à migração não têm histórico e não podem ser consultadas.
+INSERT INTO stock_movements (product_id, delta, quantity, reason, ref_type)
+SELECT id, stock_quantity, stock_quantity, 'adjustment', 'opening'
+FROM products
+WHERE stock_quantity > 0;
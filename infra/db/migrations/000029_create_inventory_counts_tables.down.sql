@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS inventory_count_items;
+DROP TABLE IF EXISTS inventory_counts;
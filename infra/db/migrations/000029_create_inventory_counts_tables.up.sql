@@ -0,0 +1,32 @@
+-- Sessões de inventário físico. category_id restringe a contagem aos produtos
+-- da categoria; sem categoria, qualquer produto pode ser contado.
+CREATE TABLE IF NOT EXISTS inventory_counts (
+    id SERIAL PRIMARY KEY,
+    category_id INTEGER REFERENCES product_categories(id) ON DELETE RESTRICT,
+    status VARCHAR(20) NOT NULL DEFAULT 'open'
+        CHECK (status IN ('open', 'closed', 'canceled')),
+    notes TEXT CHECK (char_length(notes) <= 500),
+    opened_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    closed_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    adjusted_products INTEGER NOT NULL DEFAULT 0,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    closed_at TIMESTAMP WITHOUT TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_inventory_counts_status ON inventory_counts (status);
+
+-- Quantidade contada por produto. Vários contadores somam na mesma linha;
+-- uma recontagem substitui o total.
+CREATE TABLE IF NOT EXISTS inventory_count_items (
+    inventory_count_id INTEGER NOT NULL REFERENCES inventory_counts(id) ON DELETE CASCADE,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    counted_quantity INTEGER NOT NULL CHECK (counted_quantity >= 0),
+    entries INTEGER NOT NULL DEFAULT 1,
+    counted_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (inventory_count_id, product_id)
+);
@@ -0,0 +1,9 @@
+ALTER TABLE purchase_receipts DROP COLUMN IF EXISTS location_id;
+ALTER TABLE sales DROP COLUMN IF EXISTS location_id;
+DROP TRIGGER IF EXISTS trg_products_sync_default_location ON products;
+DROP FUNCTION IF EXISTS sync_default_location_stock();
+DROP TABLE IF EXISTS stock_transfer_items;
+DROP TABLE IF EXISTS stock_transfers;
+DROP TABLE IF EXISTS product_stocks;
+DROP FUNCTION IF EXISTS default_location_id();
+DROP TABLE IF EXISTS locations;
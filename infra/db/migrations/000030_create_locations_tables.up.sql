@@ -0,0 +1,116 @@
+-- Lojas e depósitos. Um único local é o padrão: nele caem as operações que
+-- não informam local (ajustes manuais, ordens de serviço, inventário).
+CREATE TABLE IF NOT EXISTS locations (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(20) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    kind VARCHAR(20) NOT NULL DEFAULT 'store'
+        CHECK (kind IN ('store', 'warehouse')),
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_locations_single_default
+    ON locations (is_default) WHERE is_default;
+
+INSERT INTO locations (code, name, kind, is_default)
+VALUES ('PRINCIPAL', 'Estoque principal', 'store', TRUE);
+
+CREATE OR REPLACE FUNCTION default_location_id() RETURNS INTEGER AS $$
+    SELECT id FROM locations WHERE is_default;
+$$ LANGUAGE sql STABLE;
+
+-- Saldo por produto e local. products.stock_quantity continua sendo o total
+-- da empresa, incluindo o que está em trânsito entre locais.
+CREATE TABLE IF NOT EXISTS product_stocks (
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    location_id INTEGER NOT NULL REFERENCES locations(id) ON DELETE RESTRICT,
+    quantity INTEGER NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (product_id, location_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_stocks_location ON product_stocks (location_id);
+
+-- Transferência entre locais. Sai da origem no envio, fica em trânsito e
+-- entra no destino no recebimento; o cancelamento devolve à origem.
+CREATE TABLE IF NOT EXISTS stock_transfers (
+    id SERIAL PRIMARY KEY,
+    from_location_id INTEGER NOT NULL REFERENCES locations(id) ON DELETE RESTRICT,
+    to_location_id INTEGER NOT NULL REFERENCES locations(id) ON DELETE RESTRICT,
+    status VARCHAR(20) NOT NULL DEFAULT 'in_transit'
+        CHECK (status IN ('in_transit', 'received', 'canceled')),
+    notes TEXT CHECK (char_length(notes) <= 500),
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    received_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    received_at TIMESTAMP WITHOUT TIME ZONE,
+
+    CHECK (from_location_id <> to_location_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_transfers_status ON stock_transfers (status);
+
+CREATE TABLE IF NOT EXISTS stock_transfer_items (
+    id SERIAL PRIMARY KEY,
+    stock_transfer_id INTEGER NOT NULL REFERENCES stock_transfers(id) ON DELETE CASCADE,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE RESTRICT,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+
+    UNIQUE (stock_transfer_id, product_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_transfer_items_product ON stock_transfer_items (product_id);
+
+-- O saldo do local padrão é derivado: total do produto menos os demais locais
+-- e o que está em trânsito. Assim os métodos que só conhecem o total
+-- continuam valendo para o local padrão, e o CHECK de product_stocks recusa
+-- baixas que consumiriam estoque de outro local.
+CREATE OR REPLACE FUNCTION sync_default_location_stock() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO product_stocks (product_id, location_id, quantity, updated_at)
+    SELECT NEW.id,
+           default_location_id(),
+           NEW.stock_quantity
+             - COALESCE((
+                   SELECT SUM(ps.quantity)
+                   FROM product_stocks ps
+                   WHERE ps.product_id = NEW.id
+                     AND ps.location_id <> default_location_id()
+               ), 0)
+             - COALESCE((
+                   SELECT SUM(i.quantity)
+                   FROM stock_transfer_items i
+                   JOIN stock_transfers t ON t.id = i.stock_transfer_id
+                   WHERE i.product_id = NEW.id
+                     AND t.status = 'in_transit'
+               ), 0),
+           NOW()
+    ON CONFLICT (product_id, location_id) DO UPDATE
+    SET quantity = EXCLUDED.quantity, updated_at = NOW();
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_products_sync_default_location
+    AFTER INSERT OR UPDATE OF stock_quantity ON products
+    FOR EACH ROW
+    EXECUTE FUNCTION sync_default_location_stock();
+
+-- Todo o estoque existente começa no local padrão
+INSERT INTO product_stocks (product_id, location_id, quantity)
+SELECT id, default_location_id(), stock_quantity
+FROM products;
+
+ALTER TABLE sales
+    ADD COLUMN IF NOT EXISTS location_id INTEGER NOT NULL DEFAULT default_location_id()
+        REFERENCES locations(id) ON DELETE RESTRICT;
+
+ALTER TABLE purchase_receipts
+    ADD COLUMN IF NOT EXISTS location_id INTEGER NOT NULL DEFAULT default_location_id()
+        REFERENCES locations(id) ON DELETE RESTRICT;
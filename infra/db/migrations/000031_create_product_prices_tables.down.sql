@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS scheduled_price_changes;
+DROP TRIGGER IF EXISTS trg_product_prices_append_only ON product_prices;
+DROP FUNCTION IF EXISTS product_prices_append_only();
+DROP TABLE IF EXISTS product_prices;
@@ -0,0 +1,61 @@
+-- Histórico de preços. Cada linha é o par custo/venda vigente a partir de
+-- effective_from; a linha mais recente até uma data dá o preço naquela data.
+CREATE TABLE IF NOT EXISTS product_prices (
+    id BIGSERIAL PRIMARY KEY,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    cost_price DECIMAL(10, 2) NOT NULL CHECK (cost_price >= 0),
+    sale_price DECIMAL(10, 2) NOT NULL CHECK (sale_price >= 0),
+    effective_from TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    source VARCHAR(20) NOT NULL
+        CHECK (source IN ('opening', 'manual', 'receipt', 'scheduled')),
+    ref_id BIGINT,
+    changed_by INTEGER,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_prices_product_effective
+    ON product_prices (product_id, effective_from, id);
+
+-- Mesma regra de stock_movements: só inserção, exceto pelo ON DELETE CASCADE
+-- do produto.
+CREATE OR REPLACE FUNCTION product_prices_append_only() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' AND pg_trigger_depth() > 1 THEN
+        RETURN OLD;
+    END IF;
+    RAISE EXCEPTION 'product_prices é somente inserção';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_product_prices_append_only
+    BEFORE UPDATE OR DELETE ON product_prices
+    FOR EACH ROW
+    EXECUTE FUNCTION product_prices_append_only();
+
+-- Preço de abertura: o atual vale desde o cadastro do produto
+INSERT INTO product_prices (product_id, cost_price, sale_price, effective_from, source)
+SELECT id, cost_price, sale_price, created_at, 'opening'
+FROM products;
+
+-- Mudanças de preço agendadas. Preço nulo mantém o valor vigente naquele
+-- momento. Uma mudança que fica para trás de outra gravada no histórico com
+-- data posterior é marcada como superseded em vez de aplicada.
+CREATE TABLE IF NOT EXISTS scheduled_price_changes (
+    id SERIAL PRIMARY KEY,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    cost_price DECIMAL(10, 2) CHECK (cost_price >= 0),
+    sale_price DECIMAL(10, 2) CHECK (sale_price >= 0),
+    effective_from TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'applied', 'superseded', 'canceled')),
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    applied_at TIMESTAMP WITHOUT TIME ZONE,
+
+    CHECK (cost_price IS NOT NULL OR sale_price IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_price_changes_pending
+    ON scheduled_price_changes (effective_from) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_scheduled_price_changes_product
+    ON scheduled_price_changes (product_id, effective_from);
@@ -0,0 +1,28 @@
+-- Formas de pagamento de cada venda. amount é o valor entregue pelo cliente;
+-- em dinheiro a diferença para o devido volta como change_given. A venda está
+-- paga quando SUM(amount - change_given) cobre sales.total_amount.
+CREATE TABLE IF NOT EXISTS sale_payments (
+    id BIGSERIAL PRIMARY KEY,
+    sale_id INTEGER NOT NULL REFERENCES sales(id) ON DELETE CASCADE,
+    method VARCHAR(20) NOT NULL
+        CHECK (method IN ('cash', 'card', 'credit', 'pix')),
+    amount DECIMAL(12, 2) NOT NULL CHECK (amount > 0),
+    installments SMALLINT NOT NULL DEFAULT 1
+        CHECK (installments BETWEEN 1 AND 12),
+    authorization_code VARCHAR(100),
+    change_given DECIMAL(12, 2) NOT NULL DEFAULT 0.00
+        CHECK (change_given >= 0 AND change_given < amount),
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CHECK (method = 'cash' OR change_given = 0),
+    CHECK (method = 'card' OR installments = 1)
+);
+
+CREATE INDEX IF NOT EXISTS idx_sale_payments_sale_id ON sale_payments (sale_id);
+CREATE INDEX IF NOT EXISTS idx_sale_payments_method ON sale_payments (method);
+
+-- Vendas anteriores: um único pagamento na forma gravada na venda
+INSERT INTO sale_payments (sale_id, method, amount, created_at)
+SELECT id, payment_type, total_amount, created_at
+FROM sales
+WHERE total_amount > 0;
@@ -0,0 +1,7 @@
+DELETE FROM permissions WHERE code IN ('cash:read', 'cash:operate');
+DROP INDEX IF EXISTS idx_sale_payments_cash_session_id;
+ALTER TABLE sale_payments DROP COLUMN IF EXISTS cash_session_id, DROP COLUMN IF EXISTS received_by;
+DROP INDEX IF EXISTS idx_sales_cash_session_id;
+ALTER TABLE sales DROP COLUMN IF EXISTS cash_session_id;
+DROP TABLE IF EXISTS cash_movements;
+DROP TABLE IF EXISTS cash_sessions;
@@ -0,0 +1,69 @@
+-- Sessões de caixa. Cada operador tem no máximo um caixa aberto; as vendas
+-- e os pagamentos que ele registra ficam ligados a esse caixa. No fechamento
+-- o dinheiro contado é comparado com o esperado: abertura + vendas em
+-- dinheiro (líquidas de troco) + suprimentos - sangrias.
+CREATE TABLE IF NOT EXISTS cash_sessions (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    status VARCHAR(20) NOT NULL DEFAULT 'open'
+        CHECK (status IN ('open', 'closed')),
+    opening_amount DECIMAL(12, 2) NOT NULL DEFAULT 0.00 CHECK (opening_amount >= 0),
+    expected_amount DECIMAL(12, 2),
+    counted_amount DECIMAL(12, 2) CHECK (counted_amount >= 0),
+    difference DECIMAL(12, 2),
+    notes TEXT CHECK (char_length(notes) <= 500),
+    closing_notes TEXT CHECK (char_length(closing_notes) <= 500),
+    closed_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    version INTEGER NOT NULL DEFAULT 1,
+    opened_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    closed_at TIMESTAMP WITHOUT TIME ZONE,
+
+    CHECK (
+        (status = 'open' AND closed_at IS NULL AND counted_amount IS NULL)
+     OR (status = 'closed' AND closed_at IS NOT NULL AND counted_amount IS NOT NULL
+         AND expected_amount IS NOT NULL AND difference IS NOT NULL)
+    )
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_cash_sessions_open_user ON cash_sessions (user_id) WHERE status = 'open';
+CREATE INDEX IF NOT EXISTS idx_cash_sessions_status ON cash_sessions (status);
+CREATE INDEX IF NOT EXISTS idx_cash_sessions_opened_at ON cash_sessions (opened_at);
+
+-- Sangrias (withdrawal) e suprimentos (deposit) de dinheiro no caixa
+CREATE TABLE IF NOT EXISTS cash_movements (
+    id BIGSERIAL PRIMARY KEY,
+    cash_session_id INTEGER NOT NULL REFERENCES cash_sessions(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('withdrawal', 'deposit')),
+    amount DECIMAL(12, 2) NOT NULL CHECK (amount > 0),
+    reason VARCHAR(255),
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_cash_movements_session_id ON cash_movements (cash_session_id);
+
+-- Vendas e pagamentos anteriores ficam sem caixa
+ALTER TABLE sales
+    ADD COLUMN IF NOT EXISTS cash_session_id INTEGER REFERENCES cash_sessions(id) ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS idx_sales_cash_session_id ON sales (cash_session_id);
+
+-- received_by é quem recebeu o pagamento; o valor entra no caixa dele, que
+-- pode não ser o da venda quando o pagamento é posterior.
+ALTER TABLE sale_payments
+    ADD COLUMN IF NOT EXISTS received_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS cash_session_id INTEGER REFERENCES cash_sessions(id) ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS idx_sale_payments_cash_session_id ON sale_payments (cash_session_id);
+
+INSERT INTO permissions (code, description) VALUES
+    ('cash:read', 'Consultar caixas e fechamentos'),
+    ('cash:operate', 'Abrir, movimentar e fechar caixa')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r JOIN permissions p ON p.code IN ('cash:read', 'cash:operate')
+WHERE r.name IN ('admin', 'manager', 'cashier')
+ON CONFLICT DO NOTHING;
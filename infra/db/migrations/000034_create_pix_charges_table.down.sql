@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_pix_charges_status;
+DROP INDEX IF EXISTS idx_pix_charges_sale_id;
+DROP TABLE IF EXISTS pix_charges;
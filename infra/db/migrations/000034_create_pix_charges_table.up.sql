@@ -0,0 +1,28 @@
+-- Cobranças Pix das vendas. payload é o BR Code "copia e cola"; location é
+-- a URL do payload dinâmico devolvida pelo PSP e fica vazia no QR estático.
+-- A confirmação chega pelo aviso do PSP e grava o end_to_end_id do Pix.
+CREATE TABLE IF NOT EXISTS pix_charges (
+    id BIGSERIAL PRIMARY KEY,
+    sale_id INTEGER NOT NULL REFERENCES sales(id) ON DELETE CASCADE,
+    txid VARCHAR(35) NOT NULL UNIQUE,
+    amount DECIMAL(12, 2) NOT NULL CHECK (amount > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'confirmed')),
+    payload TEXT NOT NULL,
+    location VARCHAR(255),
+    end_to_end_id VARCHAR(64) UNIQUE,
+    paid_amount DECIMAL(12, 2),
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    confirmed_at TIMESTAMP WITHOUT TIME ZONE,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CHECK (
+        (status = 'pending' AND confirmed_at IS NULL AND end_to_end_id IS NULL)
+     OR (status = 'confirmed' AND confirmed_at IS NOT NULL AND end_to_end_id IS NOT NULL
+         AND paid_amount IS NOT NULL)
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_pix_charges_sale_id ON pix_charges (sale_id);
+CREATE INDEX IF NOT EXISTS idx_pix_charges_status ON pix_charges (status);
@@ -0,0 +1,3 @@
+-- O login busca o usuário por LOWER(email); o índice torna a busca exata
+-- e impede dois cadastros que só diferem na caixa do email.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email_lower ON users (LOWER(email));
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_cash_movements_sale_id;
+ALTER TABLE cash_movements DROP CONSTRAINT IF EXISTS cash_movements_refund_sale_check;
+DELETE FROM cash_movements WHERE type = 'refund';
+ALTER TABLE cash_movements DROP COLUMN IF EXISTS sale_id;
+ALTER TABLE cash_movements DROP CONSTRAINT IF EXISTS cash_movements_type_check;
+ALTER TABLE cash_movements
+    ADD CONSTRAINT cash_movements_type_check CHECK (type IN ('withdrawal', 'deposit'));
@@ -0,0 +1,15 @@
+-- Devolução em dinheiro (refund): sai da gaveta do operador que registra o
+-- cancelamento ou a devolução da venda. O esperado do caixa passa a ser
+-- abertura + vendas em dinheiro + suprimentos - sangrias - devoluções, sem
+-- depender do status atual das vendas.
+ALTER TABLE cash_movements DROP CONSTRAINT IF EXISTS cash_movements_type_check;
+ALTER TABLE cash_movements
+    ADD CONSTRAINT cash_movements_type_check CHECK (type IN ('withdrawal', 'deposit', 'refund'));
+
+ALTER TABLE cash_movements
+    ADD COLUMN IF NOT EXISTS sale_id INTEGER REFERENCES sales(id) ON DELETE RESTRICT;
+
+ALTER TABLE cash_movements
+    ADD CONSTRAINT cash_movements_refund_sale_check CHECK ((type = 'refund') = (sale_id IS NOT NULL));
+
+CREATE INDEX IF NOT EXISTS idx_cash_movements_sale_id ON cash_movements (sale_id) WHERE sale_id IS NOT NULL;
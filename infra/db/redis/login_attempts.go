@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+// Contadores de falhas de login e bloqueios, usados por lockout.Guard. As
+// chaves recebidas já vêm com o escopo ("account:<email>" ou "ip:<ip>").
+const (
+	loginFailuresPrefix = "login_failures:"
+	loginLockPrefix     = "login_lock:"
+)
+
+// IncrFailures soma uma falha; a janela começa a contar na primeira delas.
+func (b *RedisTokenBlacklist) IncrFailures(ctx context.Context, key string, window time.Duration) (int64, error) {
+	pipe := b.client.TxPipeline()
+	incr := pipe.Incr(ctx, loginFailuresPrefix+key)
+	pipe.ExpireNX(ctx, loginFailuresPrefix+key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+func (b *RedisTokenBlacklist) Lock(ctx context.Context, key string, duration time.Duration) error {
+	return b.client.Set(ctx, loginLockPrefix+key, 1, duration).Err()
+}
+
+// LockTTL devolve quanto falta para o bloqueio expirar (0 se não houver).
+func (b *RedisTokenBlacklist) LockTTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := b.client.PTTL(ctx, loginLockPrefix+key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (b *RedisTokenBlacklist) Reset(ctx context.Context, key string) error {
+	return b.client.Del(ctx, loginFailuresPrefix+key, loginLockPrefix+key).Err()
+}
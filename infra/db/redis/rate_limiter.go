@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/ratelimit"
+	"github.com/redis/go-redis/v9"
+)
+
+const rateLimitPrefix = "rate_limit:"
+
+// gcraScript aplica o GCRA de forma atômica usando o relógio do Redis, para que
+// todas as réplicas da API compartilhem o mesmo limite. Tempos em microssegundos.
+var gcraScript = redis.NewScript(`
+local interval = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000000 + tonumber(t[2])
+
+local tat = tonumber(redis.call('GET', KEYS[1]) or now)
+if tat < now then
+	tat = now
+end
+
+local new_tat = tat + interval
+local allow_at = new_tat - burst * interval
+
+if now < allow_at then
+	return {0, 0, allow_at - now, tat - now}
+end
+
+local reset = new_tat - now
+redis.call('SET', KEYS[1], string.format('%.0f', new_tat), 'PX', math.ceil(reset / 1000))
+
+return {1, math.floor((now - allow_at) / interval), 0, reset}
+`)
+
+// RedisRateLimiter é o ratelimit.Limiter compartilhado entre instâncias.
+type RedisRateLimiter struct {
+	client redis.Scripter
+}
+
+func NewRedisRateLimiter(client redis.Scripter) *RedisRateLimiter {
+	return &RedisRateLimiter{client: client}
+}
+
+// RateLimiter reaproveita a conexão da blacklist.
+func (b *RedisTokenBlacklist) RateLimiter() *RedisRateLimiter {
+	return NewRedisRateLimiter(b.client)
+}
+
+func (l *RedisRateLimiter) Allow(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	burst := limit.Burst
+	if burst < 1 {
+		burst = 1
+	}
+
+	values, err := gcraScript.Run(ctx, l.client, []string{rateLimitPrefix + key},
+		limit.Interval().Microseconds(), burst).Int64Slice()
+	if err != nil {
+		return ratelimit.Result{}, err
+	}
+
+	return ratelimit.Result{
+		Allowed:    values[0] == 1,
+		Limit:      burst,
+		Remaining:  int(values[1]),
+		RetryAfter: time.Duration(values[2]) * time.Microsecond,
+		ResetAfter: time.Duration(values[3]) * time.Microsecond,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/ratelimit"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRateLimiter(t *testing.T) (*RedisRateLimiter, *miniredis.Miniredis) {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return NewRedisRateLimiter(client), server
+}
+
+func TestRedisRateLimiter_Allow(t *testing.T) {
+	ctx := context.Background()
+	limiter, server := newTestRateLimiter(t)
+	server.SetTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	limit := ratelimit.Limit{Requests: 1, Period: time.Second, Burst: 3}
+
+	for i := 0; i < 3; i++ {
+		res, err := limiter.Allow(ctx, "ip:10.0.0.1", limit)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed, "requisição %d", i+1)
+		assert.Equal(t, 2-i, res.Remaining)
+	}
+
+	res, err := limiter.Allow(ctx, "ip:10.0.0.1", limit)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, time.Second, res.RetryAfter)
+	assert.Equal(t, 3*time.Second, res.ResetAfter)
+
+	// A chave expira junto com o TAT
+	ttl := server.TTL(rateLimitPrefix + "ip:10.0.0.1")
+	assert.Equal(t, 3*time.Second, ttl)
+
+	server.SetTime(time.Date(2025, 1, 1, 12, 0, 1, 0, time.UTC))
+	res, err = limiter.Allow(ctx, "ip:10.0.0.1", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+}
+
+func TestRedisRateLimiter_SeparateKeys(t *testing.T) {
+	ctx := context.Background()
+	limiter, _ := newTestRateLimiter(t)
+	limit := ratelimit.Limit{Requests: 1, Period: time.Minute, Burst: 1}
+
+	res, err := limiter.Allow(ctx, "user:1", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	res, err = limiter.Allow(ctx, "user:1", limit)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+
+	res, err = limiter.Allow(ctx, "user:2", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+}
+
+func TestRedisRateLimiter_Error(t *testing.T) {
+	limiter, server := newTestRateLimiter(t)
+	server.Close()
+
+	_, err := limiter.Allow(context.Background(), "ip:1", ratelimit.Limit{Requests: 1, Period: time.Second})
+	assert.Error(t, err)
+}
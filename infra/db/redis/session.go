@@ -0,0 +1,161 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/login"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/redis/go-redis/v9"
+)
+
+// As sessões ficam no mesmo Redis da blacklist: "session:<id>" guarda a sessão
+// em JSON com TTL até a expiração e "user_sessions:<user_id>" indexa os ids de
+// cada usuário.
+const (
+	sessionPrefix      = "session:"
+	userSessionsPrefix = "user_sessions:"
+)
+
+func userSessionsKey(userID int64) string {
+	return userSessionsPrefix + strconv.FormatInt(userID, 10)
+}
+
+// SaveSession grava (ou regrava após rotação) a sessão até sua expiração.
+func (b *RedisTokenBlacklist) SaveSession(ctx context.Context, session *models.Session) error {
+	ttl := time.Until(session.ExpiresAt)
+	if ttl <= 0 {
+		return errMsg.ErrTokenExpired
+	}
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrSessionStore, err)
+	}
+
+	indexKey := userSessionsKey(session.UserID)
+
+	pipe := b.client.TxPipeline()
+	pipe.Set(ctx, sessionPrefix+session.ID, data, ttl)
+	pipe.SAdd(ctx, indexKey, session.ID)
+	// O índice vive tanto quanto a sessão mais longa do usuário
+	pipe.ExpireGT(ctx, indexKey, ttl)
+	pipe.ExpireNX(ctx, indexKey, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrSessionStore, err)
+	}
+
+	return nil
+}
+
+// rotateScript regrava a sessão só se o hash do refresh token guardado ainda
+// for o apresentado. Devolve 0 se a sessão não existe, -1 se outro refresh já
+// a rotacionou e 1 quando grava.
+var rotateScript = redis.NewScript(`
+local data = redis.call('GET', KEYS[1])
+if not data then
+	return 0
+end
+
+if cjson.decode(data)['refresh_hash'] ~= ARGV[1] then
+	return -1
+end
+
+redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+return 1
+`)
+
+// RotateSession grava a sessão com o novo refresh token desde que ela ainda
+// esteja com previousHash, numa troca atômica. Se dois refresh com o mesmo
+// token concorrem, só o primeiro grava; o outro recebe ErrRefreshTokenReused.
+func (b *RedisTokenBlacklist) RotateSession(ctx context.Context, session *models.Session, previousHash string) error {
+	ttl := time.Until(session.ExpiresAt)
+	if ttl <= 0 {
+		return errMsg.ErrTokenExpired
+	}
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrSessionStore, err)
+	}
+
+	result, err := rotateScript.Run(ctx, b.client, []string{sessionPrefix + session.ID},
+		previousHash, data, ttl.Milliseconds()).Int()
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrSessionStore, err)
+	}
+
+	switch result {
+	case 0:
+		return errMsg.ErrNotFound
+	case -1:
+		return errMsg.ErrRefreshTokenReused
+	}
+
+	return nil
+}
+
+func (b *RedisTokenBlacklist) GetSession(ctx context.Context, sessionID string) (*models.Session, error) {
+	data, err := b.client.Get(ctx, sessionPrefix+sessionID).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrSessionStore, err)
+	}
+
+	var session models.Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrSessionStore, err)
+	}
+
+	return &session, nil
+}
+
+// ListSessions devolve as sessões ativas do usuário, limpando do índice as
+// que já expiraram.
+func (b *RedisTokenBlacklist) ListSessions(ctx context.Context, userID int64) ([]*models.Session, error) {
+	indexKey := userSessionsKey(userID)
+
+	ids, err := b.client.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrSessionStore, err)
+	}
+
+	sessions := make([]*models.Session, 0, len(ids))
+	for _, id := range ids {
+		session, err := b.GetSession(ctx, id)
+		if errors.Is(err, errMsg.ErrNotFound) {
+			b.client.SRem(ctx, indexKey, id)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// DeleteSession revoga a sessão e, com ela, todos os refresh tokens da família.
+// Sessões de outro usuário não são tocadas: o id precisa estar no índice dele.
+func (b *RedisTokenBlacklist) DeleteSession(ctx context.Context, userID int64, sessionID string) error {
+	removed, err := b.client.SRem(ctx, userSessionsKey(userID), sessionID).Result()
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrSessionStore, err)
+	}
+	if removed == 0 {
+		return errMsg.ErrNotFound
+	}
+
+	if err := b.client.Del(ctx, sessionPrefix+sessionID).Err(); err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrSessionStore, err)
+	}
+
+	return nil
+}
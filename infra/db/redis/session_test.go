@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/login"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSessionStore(t *testing.T) *RedisTokenBlacklist {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return &RedisTokenBlacklist{client: client, prefix: "blacklist:"}
+}
+
+func newTestSession(hash string) *models.Session {
+	return &models.Session{
+		ID:          "sess-1",
+		UserID:      7,
+		Email:       "caixa@loja.com",
+		RefreshHash: hash,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+}
+
+func TestRedisTokenBlacklist_RotateSession(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("troca o hash vigente", func(t *testing.T) {
+		store := newTestSessionStore(t)
+		require.NoError(t, store.SaveSession(ctx, newTestSession("h1")))
+
+		err := store.RotateSession(ctx, newTestSession("h2"), "h1")
+
+		require.NoError(t, err)
+		saved, err := store.GetSession(ctx, "sess-1")
+		require.NoError(t, err)
+		assert.Equal(t, "h2", saved.RefreshHash)
+	})
+
+	t.Run("hash já rotacionado", func(t *testing.T) {
+		store := newTestSessionStore(t)
+		require.NoError(t, store.SaveSession(ctx, newTestSession("h2")))
+
+		err := store.RotateSession(ctx, newTestSession("h3"), "h1")
+
+		assert.ErrorIs(t, err, errMsg.ErrRefreshTokenReused)
+		saved, err := store.GetSession(ctx, "sess-1")
+		require.NoError(t, err)
+		assert.Equal(t, "h2", saved.RefreshHash)
+	})
+
+	t.Run("sessão inexistente", func(t *testing.T) {
+		store := newTestSessionStore(t)
+
+		err := store.RotateSession(ctx, newTestSession("h2"), "h1")
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("sessão expirada", func(t *testing.T) {
+		store := newTestSessionStore(t)
+		session := newTestSession("h2")
+		session.ExpiresAt = time.Now().Add(-time.Minute)
+
+		err := store.RotateSession(ctx, session, "h1")
+
+		assert.ErrorIs(t, err, errMsg.ErrTokenExpired)
+	})
+
+	t.Run("refresh concorrentes: só um grava", func(t *testing.T) {
+		store := newTestSessionStore(t)
+		require.NoError(t, store.SaveSession(ctx, newTestSession("h0")))
+
+		const workers = 20
+		errs := make([]error, workers)
+		var wg sync.WaitGroup
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = store.RotateSession(ctx, newTestSession(fmt.Sprintf("h%d", i+1)), "h0")
+			}(i)
+		}
+		wg.Wait()
+
+		winner := -1
+		for i, err := range errs {
+			if err == nil {
+				assert.Equal(t, -1, winner, "mais de uma rotação gravou")
+				winner = i
+				continue
+			}
+			assert.ErrorIs(t, err, errMsg.ErrRefreshTokenReused)
+		}
+		require.NotEqual(t, -1, winner)
+
+		saved, err := store.GetSession(ctx, "sess-1")
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("h%d", winner+1), saved.RefreshHash)
+	})
+}
@@ -0,0 +1,11 @@
+.PHONY: migrate_create_cash_sessions_tables migrate_up_cash_sessions migrate_down_cash_sessions
+
+migrate_create_cash_sessions_tables:
+	@migrate create -ext sql -dir infra/db/migrations -seq create_cash_sessions_tables
+
+migrate_up_cash_sessions:
+	@echo "Aplicando migrações: cash_sessions..."
+	@migrate -database ${DB_CONN_URL} -path infra/db/migrations up
+
+migrate_down_cash_sessions:
+	@migrate -database ${DB_CONN_URL} -path infra/db/migrations down
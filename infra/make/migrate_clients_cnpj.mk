@@ -1,22 +1,18 @@
 .PHONY: \
-	migrate_create_clients_cnpj_table \
-	migrate_create_client_cnpj_contact_relations_table \
-	migrate_up_clients_cnpj_all \
-	migrate_down_clients_cnpj_all
+	migrate_create_clients_cnpj_tables \
+	migrate_up_client_cnpj_all \
+	migrate_down_client_cnpj_all
 
-# Criação de migrations
-migrate_create_clients_cnpj_table:
-	@migrate create -ext sql -dir infra/db/migrations -seq create_clients_cnpj_table
-
-migrate_create_client_cnpj_contact_relations_table:
-	@migrate create -ext sql -dir infra/db/migrations -seq create_client_cnpj_contact_relations_table
+# Criação de migrations (clients_cnpj, contatos, crédito e colunas em addresses/sales)
+migrate_create_clients_cnpj_tables:
+	@migrate create -ext sql -dir infra/db/migrations -seq create_clients_cnpj_tables
 
 # Aplicar todas as migrations relacionadas a clients_cnpj
 migrate_up_client_cnpj_all:
-	@echo "Aplicando todas as migrações: clients_cnpj e client_cnpj_contact_relations..."
+	@echo "Aplicando todas as migrações: clients_cnpj, contatos e crédito..."
 	@migrate -database ${DB_CONN_URL} -path infra/db/migrations up
 
 # Reverter todas as migrations relacionadas a clients_cnpj
 migrate_down_client_cnpj_all:
-	@echo "Revertendo todas as migrações: clients_cnpj e client_cnpj_contact_relations..."
+	@echo "Revertendo todas as migrações: clients_cnpj, contatos e crédito..."
 	@migrate -database ${DB_CONN_URL} -path infra/db/migrations down
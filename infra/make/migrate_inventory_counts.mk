@@ -0,0 +1,11 @@
+.PHONY: migrate_create_inventory_counts_tables migrate_up_inventory_counts migrate_down_inventory_counts
+
+migrate_create_inventory_counts_tables:
+	@migrate create -ext sql -dir infra/db/migrations -seq create_inventory_counts_tables
+
+migrate_up_inventory_counts:
+	@echo "Aplicando migrações: inventory_counts..."
+	@migrate -database ${DB_CONN_URL} -path infra/db/migrations up
+
+migrate_down_inventory_counts:
+	@migrate -database ${DB_CONN_URL} -path infra/db/migrations down
@@ -0,0 +1,11 @@
+.PHONY: migrate_create_locations_tables migrate_up_locations migrate_down_locations
+
+migrate_create_locations_tables:
+	@migrate create -ext sql -dir infra/db/migrations -seq create_locations_tables
+
+migrate_up_locations:
+	@echo "Aplicando migrações: locations..."
+	@migrate -database ${DB_CONN_URL} -path infra/db/migrations up
+
+migrate_down_locations:
+	@migrate -database ${DB_CONN_URL} -path infra/db/migrations down
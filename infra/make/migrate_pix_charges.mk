@@ -0,0 +1,11 @@
+.PHONY: migrate_create_pix_charges_table migrate_up_pix_charges migrate_down_pix_charges
+
+migrate_create_pix_charges_table:
+	@migrate create -ext sql -dir infra/db/migrations -seq create_pix_charges_table
+
+migrate_up_pix_charges:
+	@echo "Aplicando migrações: pix_charges..."
+	@migrate -database ${DB_CONN_URL} -path infra/db/migrations up
+
+migrate_down_pix_charges:
+	@migrate -database ${DB_CONN_URL} -path infra/db/migrations down
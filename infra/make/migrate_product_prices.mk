@@ -0,0 +1,11 @@
+.PHONY: migrate_create_product_prices_tables migrate_up_product_prices migrate_down_product_prices
+
+migrate_create_product_prices_tables:
+	@migrate create -ext sql -dir infra/db/migrations -seq create_product_prices_tables
+
+migrate_up_product_prices:
+	@echo "Aplicando migrações: product_prices..."
+	@migrate -database ${DB_CONN_URL} -path infra/db/migrations up
+
+migrate_down_product_prices:
+	@migrate -database ${DB_CONN_URL} -path infra/db/migrations down
@@ -2,6 +2,9 @@
 	migrate_create_products_table \
 	migrate_create_product_categories_table \
 	migrate_create_product_category_relations_table \
+	migrate_create_products_search \
+	migrate_create_stock_alerts_table \
+	migrate_create_stock_movements_table \
 	migrate_up_product \
 	migrate_down_product
 
@@ -14,6 +17,15 @@ migrate_create_product_categories_table:
 migrate_create_product_category_relations_table:
 	@migrate create -ext sql -dir infra/db/migrations -seq create_product_category_relations_table
 
+migrate_create_products_search:
+	@migrate create -ext sql -dir infra/db/migrations -seq add_products_search
+
+migrate_create_stock_alerts_table:
+	@migrate create -ext sql -dir infra/db/migrations -seq create_stock_alerts_table
+
+migrate_create_stock_movements_table:
+	@migrate create -ext sql -dir infra/db/migrations -seq create_stock_movements_table
+
 migrate_up_product:
 	@echo "Aplicando migrações: product..."
 	@migrate -database ${DB_CONN_URL} -path infra/db/migrations up
@@ -0,0 +1,11 @@
+.PHONY: migrate_create_purchase_orders_tables migrate_up_purchase_orders migrate_down_purchase_orders
+
+migrate_create_purchase_orders_tables:
+	@migrate create -ext sql -dir infra/db/migrations -seq create_purchase_orders_tables
+
+migrate_up_purchase_orders:
+	@echo "Aplicando migrações: purchase_orders..."
+	@migrate -database ${DB_CONN_URL} -path infra/db/migrations up
+
+migrate_down_purchase_orders:
+	@migrate -database ${DB_CONN_URL} -path infra/db/migrations down
@@ -0,0 +1,11 @@
+.PHONY: migrate_create_sale_payments_table migrate_up_sale_payments migrate_down_sale_payments
+
+migrate_create_sale_payments_table:
+	@migrate create -ext sql -dir infra/db/migrations -seq create_sale_payments_table
+
+migrate_up_sale_payments:
+	@echo "Aplicando migrações: sale_payments..."
+	@migrate -database ${DB_CONN_URL} -path infra/db/migrations up
+
+migrate_down_sale_payments:
+	@migrate -database ${DB_CONN_URL} -path infra/db/migrations down
@@ -3,6 +3,7 @@
 	migrate_create_user_categories_table \
 	migrate_create_user_category_relations_table \
 	migrate_create_user_contact_relations_table \
+	migrate_create_roles_permissions_tables \
 	migrate_up_user_all \
 	migrate_down_user_all
 
@@ -19,6 +20,9 @@ migrate_create_user_category_relations_table:
 migrate_create_user_contact_relations_table:
 	@migrate create -ext sql -dir infra/db/migrations -seq create_user_contact_relations_table
 
+migrate_create_roles_permissions_tables:
+	@migrate create -ext sql -dir infra/db/migrations -seq create_roles_permissions_tables
+
 # Executar todas as migrações relacionadas a usuário
 migrate_up_user_all:
 	@echo "Aplicando todas as migrações: users, user_categories, user_category_relations, user_contact_relations..."
@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/address/address"
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/address/filter"
@@ -82,12 +83,13 @@ func (m *MockAddress) Enable(ctx context.Context, aid int64) error {
 	return args.Error(0)
 }
 
-func (m *MockAddress) Filter(ctx context.Context, f *filter.AddressFilter) ([]*models.Address, error) {
+func (m *MockAddress) Filter(ctx context.Context, f *filter.AddressFilter) (*commonFilter.Page[*models.Address], error) {
 	args := m.Called(ctx, f)
-
-	var result []*models.Address
-	if res := args.Get(0); res != nil {
-		result = res.([]*models.Address)
+	switch res := args.Get(0).(type) {
+	case *commonFilter.Page[*models.Address]:
+		return res, args.Error(1)
+	case []*models.Address:
+		return &commonFilter.Page[*models.Address]{Items: res, Total: int64(len(res))}, args.Error(1)
 	}
-	return result, args.Error(1)
+	return nil, args.Error(1)
 }
@@ -0,0 +1,58 @@
+package mock
+
+import (
+	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/filter"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAudit atende o repositório e o serviço de auditoria.
+type MockAudit struct {
+	mock.Mock
+}
+
+func (m *MockAudit) Create(ctx context.Context, entry *models.AuditLog) (*models.AuditLog, error) {
+	args := m.Called(ctx, entry)
+	result := args.Get(0)
+	if result == nil {
+		return nil, args.Error(1)
+	}
+	return result.(*models.AuditLog), args.Error(1)
+}
+
+func (m *MockAudit) CreateTx(ctx context.Context, tx pgx.Tx, entry *models.AuditLog) (*models.AuditLog, error) {
+	args := m.Called(ctx, tx, entry)
+	result := args.Get(0)
+	if result == nil {
+		return nil, args.Error(1)
+	}
+	return result.(*models.AuditLog), args.Error(1)
+}
+
+func (m *MockAudit) Filter(ctx context.Context, f *modelFilter.AuditFilter) (*commonFilter.Page[*models.AuditLog], error) {
+	args := m.Called(ctx, f)
+	switch res := args.Get(0).(type) {
+	case *commonFilter.Page[*models.AuditLog]:
+		return res, args.Error(1)
+	case []*models.AuditLog:
+		return &commonFilter.Page[*models.AuditLog]{Items: res, Total: int64(len(res))}, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+type MockRecorder struct {
+	mock.Mock
+}
+
+func (m *MockRecorder) Record(ctx context.Context, entry audit.Entry) {
+	m.Called(ctx, entry)
+}
+
+func (m *MockRecorder) RecordTx(ctx context.Context, tx pgx.Tx, entry audit.Entry) error {
+	return m.Called(ctx, tx, entry).Error(0)
+}
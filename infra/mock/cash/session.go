@@ -0,0 +1,130 @@
+package mock
+
+import (
+	"context"
+
+	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCashSessionRepo struct {
+	mock.Mock
+}
+
+func (m *MockCashSessionRepo) GetByID(ctx context.Context, id int64) (*models.CashSession, error) {
+	args := m.Called(ctx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.CashSession), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCashSessionRepo) GetOpenByUser(ctx context.Context, userID int64) (*models.CashSession, error) {
+	args := m.Called(ctx, userID)
+	if result := args.Get(0); result != nil {
+		return result.(*models.CashSession), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCashSessionRepo) Movements(ctx context.Context, id int64) ([]*models.CashMovement, error) {
+	args := m.Called(ctx, id)
+	if movements, ok := args.Get(0).([]*models.CashMovement); ok {
+		return movements, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCashSessionRepo) Tenders(ctx context.Context, id int64) ([]*modelsPayment.MethodTotal, error) {
+	args := m.Called(ctx, id)
+	if totals, ok := args.Get(0).([]*modelsPayment.MethodTotal); ok {
+		return totals, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCashSessionRepo) MovementTotals(ctx context.Context, id int64) (*models.MovementTotals, error) {
+	args := m.Called(ctx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.MovementTotals), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+type MockCashSessionService struct {
+	mock.Mock
+}
+
+func (m *MockCashSessionService) Open(ctx context.Context, session *models.CashSession) (*models.CashSession, error) {
+	args := m.Called(ctx, session)
+	if result := args.Get(0); result != nil {
+		return result.(*models.CashSession), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCashSessionService) GetByID(ctx context.Context, id int64) (*models.CashSession, error) {
+	args := m.Called(ctx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.CashSession), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCashSessionService) Current(ctx context.Context, userID int64) (*models.CashSession, error) {
+	args := m.Called(ctx, userID)
+	if result := args.Get(0); result != nil {
+		return result.(*models.CashSession), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCashSessionService) Movements(ctx context.Context, id int64) ([]*models.CashMovement, error) {
+	args := m.Called(ctx, id)
+	if movements, ok := args.Get(0).([]*models.CashMovement); ok {
+		return movements, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCashSessionService) AddMovement(ctx context.Context, movement *models.CashMovement) (*models.CashMovement, error) {
+	args := m.Called(ctx, movement)
+	if result := args.Get(0); result != nil {
+		return result.(*models.CashMovement), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCashSessionService) Summary(ctx context.Context, id int64) (*models.Summary, error) {
+	args := m.Called(ctx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.Summary), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCashSessionService) Close(ctx context.Context, id int64, closing *models.Closing) (*models.Summary, error) {
+	args := m.Called(ctx, id, closing)
+	if result := args.Get(0); result != nil {
+		return result.(*models.Summary), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+type MockCashSessionFilter struct {
+	mock.Mock
+}
+
+func (m *MockCashSessionFilter) Filter(ctx context.Context, f *modelFilter.CashSessionFilter) (*commonFilter.Page[*models.CashSession], error) {
+	args := m.Called(ctx, f)
+	switch res := args.Get(0).(type) {
+	case *commonFilter.Page[*models.CashSession]:
+		return res, args.Error(1)
+	case []*models.CashSession:
+		return &commonFilter.Page[*models.CashSession]{Items: res, Total: int64(len(res))}, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,81 @@
+package mock
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCashSessionTx struct {
+	mock.Mock
+}
+
+func (m *MockCashSessionTx) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(pgx.Tx), args.Error(1)
+}
+
+func (m *MockCashSessionTx) CreateTx(ctx context.Context, tx pgx.Tx, session *models.CashSession) (*models.CashSession, error) {
+	args := m.Called(ctx, tx, session)
+	if result := args.Get(0); result != nil {
+		return result.(*models.CashSession), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCashSessionTx) GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.CashSession, error) {
+	args := m.Called(ctx, tx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.CashSession), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCashSessionTx) CreateMovementTx(ctx context.Context, tx pgx.Tx, movement *models.CashMovement) (*models.CashMovement, error) {
+	args := m.Called(ctx, tx, movement)
+	if result := args.Get(0); result != nil {
+		return result.(*models.CashMovement), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCashSessionTx) TendersTx(ctx context.Context, tx pgx.Tx, id int64) ([]*modelsPayment.MethodTotal, error) {
+	args := m.Called(ctx, tx, id)
+	if totals, ok := args.Get(0).([]*modelsPayment.MethodTotal); ok {
+		return totals, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCashSessionTx) MovementTotalsTx(ctx context.Context, tx pgx.Tx, id int64) (*models.MovementTotals, error) {
+	args := m.Called(ctx, tx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.MovementTotals), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCashSessionTx) CloseTx(ctx context.Context, tx pgx.Tx, session *models.CashSession) error {
+	args := m.Called(ctx, tx, session)
+	return args.Error(0)
+}
+
+func (m *MockCashSessionTx) CreateRefundTx(ctx context.Context, tx pgx.Tx, movement *models.CashMovement) (*models.CashMovement, error) {
+	args := m.Called(ctx, tx, movement)
+	if result := args.Get(0); result != nil {
+		return result.(*models.CashMovement), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCashSessionTx) RefundedTx(ctx context.Context, tx pgx.Tx, saleID int64) (money.Money, error) {
+	args := m.Called(ctx, tx, saleID)
+	return args.Get(0).(money.Money), args.Error(1)
+}
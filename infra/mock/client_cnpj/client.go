@@ -0,0 +1,69 @@
+package mock
+
+import (
+	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+
+	"github.com/stretchr/testify/mock"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/filter"
+)
+
+type MockClientCnpj struct {
+	mock.Mock
+}
+
+func (m *MockClientCnpj) Create(ctx context.Context, clientCnpj *models.ClientCnpj) (*models.ClientCnpj, error) {
+	args := m.Called(ctx, clientCnpj)
+	var result *models.ClientCnpj
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.ClientCnpj)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockClientCnpj) GetByID(ctx context.Context, id int64) (*models.ClientCnpj, error) {
+	args := m.Called(ctx, id)
+	var result *models.ClientCnpj
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.ClientCnpj)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockClientCnpj) GetVersionByID(ctx context.Context, id int64) (int, error) {
+	args := m.Called(ctx, id)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockClientCnpj) Filter(ctx context.Context, f *filter.ClientCnpjFilter) (*commonFilter.Page[*models.ClientCnpj], error) {
+	args := m.Called(ctx, f)
+	switch res := args.Get(0).(type) {
+	case *commonFilter.Page[*models.ClientCnpj]:
+		return res, args.Error(1)
+	case []*models.ClientCnpj:
+		return &commonFilter.Page[*models.ClientCnpj]{Items: res, Total: int64(len(res))}, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockClientCnpj) Update(ctx context.Context, client *models.ClientCnpj) error {
+	args := m.Called(ctx, client)
+	return args.Error(0)
+}
+
+func (m *MockClientCnpj) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockClientCnpj) Disable(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockClientCnpj) Enable(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
@@ -0,0 +1,44 @@
+package mock
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/contact_relation"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockClientCnpjContactRelation struct {
+	mock.Mock
+}
+
+func (m *MockClientCnpjContactRelation) Create(ctx context.Context, relation *models.ClientCnpjContactRelation) (*models.ClientCnpjContactRelation, error) {
+	args := m.Called(ctx, relation)
+	var result *models.ClientCnpjContactRelation
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.ClientCnpjContactRelation)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockClientCnpjContactRelation) HasClientCnpjContactRelation(ctx context.Context, clientCnpjID, contactID int64) (bool, error) {
+	args := m.Called(ctx, clientCnpjID, contactID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockClientCnpjContactRelation) GetAllRelationsByClientCnpjID(ctx context.Context, clientCnpjID int64) ([]*models.ClientCnpjContactRelation, error) {
+	args := m.Called(ctx, clientCnpjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.ClientCnpjContactRelation), args.Error(1)
+}
+
+func (m *MockClientCnpjContactRelation) Delete(ctx context.Context, clientCnpjID, contactID int64) error {
+	args := m.Called(ctx, clientCnpjID, contactID)
+	return args.Error(0)
+}
+
+func (m *MockClientCnpjContactRelation) DeleteAll(ctx context.Context, clientCnpjID int64) error {
+	args := m.Called(ctx, clientCnpjID)
+	return args.Error(0)
+}
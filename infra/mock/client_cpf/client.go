@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
 	"github.com/stretchr/testify/mock"
 
@@ -45,14 +46,15 @@ func (m *MockClientCpf) GetVersionByID(ctx context.Context, id int64) (int, erro
 	return args.Int(0), args.Error(1)
 }
 
-func (m *MockClientCpf) Filter(ctx context.Context, f *filter.ClientCpfFilter) ([]*models.ClientCpf, error) {
+func (m *MockClientCpf) Filter(ctx context.Context, f *filter.ClientCpfFilter) (*commonFilter.Page[*models.ClientCpf], error) {
 	args := m.Called(ctx, f)
-
-	var result []*models.ClientCpf
-	if res := args.Get(0); res != nil {
-		result = res.([]*models.ClientCpf)
+	switch res := args.Get(0).(type) {
+	case *commonFilter.Page[*models.ClientCpf]:
+		return res, args.Error(1)
+	case []*models.ClientCpf:
+		return &commonFilter.Page[*models.ClientCpf]{Items: res, Total: int64(len(res))}, args.Error(1)
 	}
-	return result, args.Error(1)
+	return nil, args.Error(1)
 }
 
 func (m *MockClientCpf) Update(ctx context.Context, client *models.ClientCpf) error {
@@ -48,3 +48,90 @@ func (m *MockClientCredit) Delete(ctx context.Context, id int64) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
 }
+
+func (m *MockClientCredit) Upsert(ctx context.Context, credit *models.ClientCredit) (*models.ClientCredit, error) {
+	args := m.Called(ctx, credit)
+	var result *models.ClientCredit
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.ClientCredit)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockClientCredit) GetByName(ctx context.Context, name string) ([]*models.ClientCredit, error) {
+	args := m.Called(ctx, name)
+	var result []*models.ClientCredit
+	if args.Get(0) != nil {
+		result = args.Get(0).([]*models.ClientCredit)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockClientCredit) GetVersionByID(ctx context.Context, id int64) (int, error) {
+	args := m.Called(ctx, id)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockClientCredit) GetAll(ctx context.Context) ([]*models.ClientCredit, error) {
+	args := m.Called(ctx)
+	var result []*models.ClientCredit
+	if args.Get(0) != nil {
+		result = args.Get(0).([]*models.ClientCredit)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockClientCredit) Disable(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockClientCredit) Enable(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockClientCredit) GetEntriesByClientID(ctx context.Context, clientID int64, limit, offset int) ([]*models.CreditEntry, error) {
+	args := m.Called(ctx, clientID, limit, offset)
+	var result []*models.CreditEntry
+	if args.Get(0) != nil {
+		result = args.Get(0).([]*models.CreditEntry)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockClientCredit) GetStatement(ctx context.Context, clientID int64, limit, offset int) (*models.CreditStatement, error) {
+	args := m.Called(ctx, clientID, limit, offset)
+	var result *models.CreditStatement
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.CreditStatement)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockClientCredit) OpenOrAdjust(ctx context.Context, credit *models.ClientCredit) (*models.ClientCredit, error) {
+	args := m.Called(ctx, credit)
+	var result *models.ClientCredit
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.ClientCredit)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockClientCredit) Charge(ctx context.Context, entry *models.CreditEntry) (*models.CreditEntry, error) {
+	args := m.Called(ctx, entry)
+	var result *models.CreditEntry
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.CreditEntry)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockClientCredit) Payment(ctx context.Context, entry *models.CreditEntry) (*models.CreditEntry, error) {
+	args := m.Called(ctx, entry)
+	var result *models.CreditEntry
+	if args.Get(0) != nil {
+		result = args.Get(0).(*models.CreditEntry)
+	}
+	return result, args.Error(1)
+}
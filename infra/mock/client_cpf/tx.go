@@ -0,0 +1,37 @@
+package mock
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockClientCreditTx struct {
+	mock.Mock
+}
+
+func (m *MockClientCreditTx) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	args := m.Called(ctx)
+	var tx pgx.Tx
+	if args.Get(0) != nil {
+		tx = args.Get(0).(pgx.Tx)
+	}
+	return tx, args.Error(1)
+}
+
+func (m *MockClientCreditTx) ChargeTx(ctx context.Context, tx pgx.Tx, entry *models.CreditEntry) error {
+	args := m.Called(ctx, tx, entry)
+	return args.Error(0)
+}
+
+func (m *MockClientCreditTx) PaymentTx(ctx context.Context, tx pgx.Tx, entry *models.CreditEntry) error {
+	args := m.Called(ctx, tx, entry)
+	return args.Error(0)
+}
+
+func (m *MockClientCreditTx) RefundTx(ctx context.Context, tx pgx.Tx, entry *models.CreditEntry) error {
+	args := m.Called(ctx, tx, entry)
+	return args.Error(0)
+}
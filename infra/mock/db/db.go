@@ -3,6 +3,7 @@ package mock
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -40,6 +41,13 @@ func (m *MockDatabase) QueryRow(ctx context.Context, query string, args ...inter
 	}
 }
 
+// OnCount registra o SELECT COUNT(*) executado pelos Filter paginados.
+func (m *MockDatabase) OnCount(total int64) *mock.Call {
+	return m.On("QueryRow", mock.Anything, mock.MatchedBy(func(q string) bool {
+		return strings.HasPrefix(q, "SELECT COUNT(*)")
+	}), mock.Anything).Return(&MockRow{Values: []interface{}{total}})
+}
+
 func (m *MockDatabase) Exec(ctx context.Context, query string, args ...interface{}) (pgconn.CommandTag, error) {
 	call := m.Called(ctx, query, args)
 
@@ -1,6 +1,10 @@
 package mock
 
-import "time"
+import (
+	"time"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
 
 type MockRow struct {
 	Value  interface{}   // legado — usado por outros testes
@@ -124,6 +128,14 @@ func (m *MockRow) Scan(dest ...interface{}) error {
 				*ptr = v
 			}
 
+		case *money.Money:
+			switch v := m.Values[i].(type) {
+			case money.Money:
+				*ptr = v
+			case float64:
+				*ptr = money.MustFromFloat(v)
+			}
+
 		case *string:
 			if v, ok := m.Values[i].(string); ok {
 				*ptr = v
@@ -138,6 +150,45 @@ func (m *MockRow) Scan(dest ...interface{}) error {
 			if v, ok := m.Values[i].(time.Time); ok {
 				*ptr = v
 			}
+
+		case *[]string:
+			if v, ok := m.Values[i].([]string); ok {
+				*ptr = v
+			}
+
+		case *[]byte:
+			if v, ok := m.Values[i].([]byte); ok {
+				*ptr = v
+			}
+
+		case **int64:
+			if v, ok := m.Values[i].(int64); ok {
+				*ptr = &v
+			}
+
+		case **int:
+			if v, ok := m.Values[i].(int); ok {
+				*ptr = &v
+			}
+
+		case **float64:
+			if v, ok := m.Values[i].(float64); ok {
+				*ptr = &v
+			}
+
+		case **money.Money:
+			switch v := m.Values[i].(type) {
+			case money.Money:
+				*ptr = &v
+			case float64:
+				amount := money.MustFromFloat(v)
+				*ptr = &amount
+			}
+
+		case **time.Time:
+			if v, ok := m.Values[i].(time.Time); ok {
+				*ptr = &v
+			}
 		}
 	}
 
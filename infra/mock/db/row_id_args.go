@@ -2,6 +2,7 @@ package mock
 
 import (
 	"errors"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"time"
 )
 
@@ -72,6 +73,15 @@ func (m *MockRowWithIDArgs) Scan(dest ...any) error {
 			} else {
 				*ptr = m.Values[i].(float64)
 			}
+		case *money.Money:
+			switch v := m.Values[i].(type) {
+			case nil:
+				*ptr = money.Zero
+			case money.Money:
+				*ptr = v
+			case float64:
+				*ptr = money.MustFromFloat(v)
+			}
 		case *string:
 			if m.Values[i] == nil {
 				*ptr = ""
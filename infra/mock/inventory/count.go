@@ -0,0 +1,97 @@
+package mock
+
+import (
+	"context"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockInventoryCountRepo struct {
+	mock.Mock
+}
+
+func (m *MockInventoryCountRepo) GetByID(ctx context.Context, id int64) (*models.InventoryCount, error) {
+	args := m.Called(ctx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.InventoryCount), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockInventoryCountRepo) List(ctx context.Context, f *models.InventoryCountFilter) (*commonFilter.Page[*models.InventoryCount], error) {
+	args := m.Called(ctx, f)
+	if page, ok := args.Get(0).(*commonFilter.Page[*models.InventoryCount]); ok {
+		return page, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockInventoryCountRepo) Variance(ctx context.Context, id int64) ([]*models.VarianceLine, error) {
+	args := m.Called(ctx, id)
+	if lines, ok := args.Get(0).([]*models.VarianceLine); ok {
+		return lines, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+type MockInventoryCountService struct {
+	mock.Mock
+}
+
+func (m *MockInventoryCountService) Open(ctx context.Context, count *models.InventoryCount) (*models.InventoryCount, error) {
+	args := m.Called(ctx, count)
+	if result := args.Get(0); result != nil {
+		return result.(*models.InventoryCount), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockInventoryCountService) GetByID(ctx context.Context, id int64) (*models.InventoryCount, error) {
+	args := m.Called(ctx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.InventoryCount), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockInventoryCountService) List(ctx context.Context, f *models.InventoryCountFilter) (*commonFilter.Page[*models.InventoryCount], error) {
+	args := m.Called(ctx, f)
+	if page, ok := args.Get(0).(*commonFilter.Page[*models.InventoryCount]); ok {
+		return page, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockInventoryCountService) Record(ctx context.Context, id int64, entries []models.CountEntry) ([]*models.CountItem, error) {
+	args := m.Called(ctx, id, entries)
+	if items, ok := args.Get(0).([]*models.CountItem); ok {
+		return items, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockInventoryCountService) Variance(ctx context.Context, id int64) (*models.VarianceReport, error) {
+	args := m.Called(ctx, id)
+	if report, ok := args.Get(0).(*models.VarianceReport); ok {
+		return report, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockInventoryCountService) Close(ctx context.Context, id int64, closedBy *int64) (*models.InventoryCount, error) {
+	args := m.Called(ctx, id, closedBy)
+	if result := args.Get(0); result != nil {
+		return result.(*models.InventoryCount), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockInventoryCountService) Cancel(ctx context.Context, id int64, canceledBy *int64) (*models.InventoryCount, error) {
+	args := m.Called(ctx, id, canceledBy)
+	if result := args.Get(0); result != nil {
+		return result.(*models.InventoryCount), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
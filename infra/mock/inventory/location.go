@@ -0,0 +1,77 @@
+package mock
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLocationRepo struct {
+	mock.Mock
+}
+
+func (m *MockLocationRepo) GetByID(ctx context.Context, id int64) (*models.Location, error) {
+	args := m.Called(ctx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.Location), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockLocationRepo) List(ctx context.Context) ([]*models.Location, error) {
+	args := m.Called(ctx)
+	if locations, ok := args.Get(0).([]*models.Location); ok {
+		return locations, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockLocationRepo) Create(ctx context.Context, location *models.Location) (*models.Location, error) {
+	args := m.Called(ctx, location)
+	if result := args.Get(0); result != nil {
+		return result.(*models.Location), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockLocationRepo) Update(ctx context.Context, location *models.Location) error {
+	args := m.Called(ctx, location)
+	return args.Error(0)
+}
+
+type MockLocationService struct {
+	mock.Mock
+}
+
+func (m *MockLocationService) Create(ctx context.Context, location *models.Location) (*models.Location, error) {
+	args := m.Called(ctx, location)
+	if result := args.Get(0); result != nil {
+		return result.(*models.Location), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockLocationService) GetByID(ctx context.Context, id int64) (*models.Location, error) {
+	args := m.Called(ctx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.Location), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockLocationService) List(ctx context.Context) ([]*models.Location, error) {
+	args := m.Called(ctx)
+	if locations, ok := args.Get(0).([]*models.Location); ok {
+		return locations, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockLocationService) Update(ctx context.Context, location *models.Location) (*models.Location, error) {
+	args := m.Called(ctx, location)
+	if result := args.Get(0); result != nil {
+		return result.(*models.Location), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,73 @@
+package mock
+
+import (
+	"context"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTransferRepo struct {
+	mock.Mock
+}
+
+func (m *MockTransferRepo) GetByID(ctx context.Context, id int64) (*models.Transfer, error) {
+	args := m.Called(ctx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.Transfer), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTransferRepo) List(ctx context.Context, f *models.TransferFilter) (*commonFilter.Page[*models.Transfer], error) {
+	args := m.Called(ctx, f)
+	if page, ok := args.Get(0).(*commonFilter.Page[*models.Transfer]); ok {
+		return page, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+type MockTransferService struct {
+	mock.Mock
+}
+
+func (m *MockTransferService) Create(ctx context.Context, transfer *models.Transfer) (*models.Transfer, error) {
+	args := m.Called(ctx, transfer)
+	if result := args.Get(0); result != nil {
+		return result.(*models.Transfer), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTransferService) GetByID(ctx context.Context, id int64) (*models.Transfer, error) {
+	args := m.Called(ctx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.Transfer), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTransferService) List(ctx context.Context, f *models.TransferFilter) (*commonFilter.Page[*models.Transfer], error) {
+	args := m.Called(ctx, f)
+	if page, ok := args.Get(0).(*commonFilter.Page[*models.Transfer]); ok {
+		return page, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTransferService) Receive(ctx context.Context, id int64, receivedBy *int64) (*models.Transfer, error) {
+	args := m.Called(ctx, id, receivedBy)
+	if result := args.Get(0); result != nil {
+		return result.(*models.Transfer), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTransferService) Cancel(ctx context.Context, id int64, canceledBy *int64) (*models.Transfer, error) {
+	args := m.Called(ctx, id, canceledBy)
+	if result := args.Get(0); result != nil {
+		return result.(*models.Transfer), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,92 @@
+package mock
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	modelsTransfer "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockInventoryCountTx struct {
+	mock.Mock
+}
+
+func (m *MockInventoryCountTx) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(pgx.Tx), args.Error(1)
+}
+
+func (m *MockInventoryCountTx) CreateTx(ctx context.Context, tx pgx.Tx, count *models.InventoryCount) (*models.InventoryCount, error) {
+	args := m.Called(ctx, tx, count)
+	if result := args.Get(0); result != nil {
+		return result.(*models.InventoryCount), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockInventoryCountTx) GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.InventoryCount, error) {
+	args := m.Called(ctx, tx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.InventoryCount), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockInventoryCountTx) RecordTx(ctx context.Context, tx pgx.Tx, countID int64, entry *models.CountEntry) (*models.CountItem, error) {
+	args := m.Called(ctx, tx, countID, entry)
+	if result := args.Get(0); result != nil {
+		return result.(*models.CountItem), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockInventoryCountTx) CountedLinesForUpdateTx(ctx context.Context, tx pgx.Tx, countID int64) ([]*models.VarianceLine, error) {
+	args := m.Called(ctx, tx, countID)
+	if lines, ok := args.Get(0).([]*models.VarianceLine); ok {
+		return lines, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockInventoryCountTx) UpdateStatusTx(ctx context.Context, tx pgx.Tx, count *models.InventoryCount) error {
+	args := m.Called(ctx, tx, count)
+	return args.Error(0)
+}
+
+type MockTransferTx struct {
+	mock.Mock
+}
+
+func (m *MockTransferTx) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(pgx.Tx), args.Error(1)
+}
+
+func (m *MockTransferTx) CreateTx(ctx context.Context, tx pgx.Tx, transfer *modelsTransfer.Transfer) (*modelsTransfer.Transfer, error) {
+	args := m.Called(ctx, tx, transfer)
+	if result := args.Get(0); result != nil {
+		return result.(*modelsTransfer.Transfer), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTransferTx) GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*modelsTransfer.Transfer, error) {
+	args := m.Called(ctx, tx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*modelsTransfer.Transfer), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTransferTx) UpdateStatusTx(ctx context.Context, tx pgx.Tx, transfer *modelsTransfer.Transfer) error {
+	args := m.Called(ctx, tx, transfer)
+	return args.Error(0)
+}
@@ -0,0 +1,33 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/lockout"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLoginGuard struct {
+	mock.Mock
+}
+
+func (m *MockLoginGuard) Check(ctx context.Context, email, ip string) (time.Duration, error) {
+	args := m.Called(ctx, email, ip)
+	return args.Get(0).(time.Duration), args.Error(1)
+}
+
+func (m *MockLoginGuard) Fail(ctx context.Context, email, ip string) (lockout.Result, error) {
+	args := m.Called(ctx, email, ip)
+	return args.Get(0).(lockout.Result), args.Error(1)
+}
+
+func (m *MockLoginGuard) Succeed(ctx context.Context, email string) error {
+	args := m.Called(ctx, email)
+	return args.Error(0)
+}
+
+func (m *MockLoginGuard) Unlock(ctx context.Context, email, ip string) error {
+	args := m.Called(ctx, email, ip)
+	return args.Error(0)
+}
@@ -11,8 +11,8 @@ type MockLoginService struct {
 	mock.Mock
 }
 
-func (m *MockLoginService) Login(ctx context.Context, email, password string) (*models.AuthResponse, error) {
-	args := m.Called(ctx, email, password)
+func (m *MockLoginService) Login(ctx context.Context, email, password string, client models.ClientInfo) (*models.AuthResponse, error) {
+	args := m.Called(ctx, email, password, client)
 
 	var authResp *models.AuthResponse
 	if args.Get(0) != nil {
@@ -21,3 +21,19 @@ func (m *MockLoginService) Login(ctx context.Context, email, password string) (*
 
 	return authResp, args.Error(1)
 }
+
+func (m *MockLoginService) Refresh(ctx context.Context, refreshToken string, client models.ClientInfo) (*models.AuthResponse, error) {
+	args := m.Called(ctx, refreshToken, client)
+
+	var authResp *models.AuthResponse
+	if args.Get(0) != nil {
+		authResp = args.Get(0).(*models.AuthResponse)
+	}
+
+	return authResp, args.Error(1)
+}
+
+func (m *MockLoginService) Unlock(ctx context.Context, email, ip string) error {
+	args := m.Called(ctx, email, ip)
+	return args.Error(0)
+}
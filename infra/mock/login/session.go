@@ -0,0 +1,61 @@
+package mock
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/login"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSession atende tanto o armazenamento de sessões quanto o serviço de
+// sessões usado pelo handler.
+type MockSession struct {
+	mock.Mock
+}
+
+func (m *MockSession) SaveSession(ctx context.Context, session *models.Session) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockSession) RotateSession(ctx context.Context, session *models.Session, previousHash string) error {
+	args := m.Called(ctx, session, previousHash)
+	return args.Error(0)
+}
+
+func (m *MockSession) GetSession(ctx context.Context, sessionID string) (*models.Session, error) {
+	args := m.Called(ctx, sessionID)
+	result := args.Get(0)
+	if result == nil {
+		return nil, args.Error(1)
+	}
+	return result.(*models.Session), args.Error(1)
+}
+
+func (m *MockSession) ListSessions(ctx context.Context, userID int64) ([]*models.Session, error) {
+	args := m.Called(ctx, userID)
+	result := args.Get(0)
+	if result == nil {
+		return nil, args.Error(1)
+	}
+	return result.([]*models.Session), args.Error(1)
+}
+
+func (m *MockSession) DeleteSession(ctx context.Context, userID int64, sessionID string) error {
+	args := m.Called(ctx, userID, sessionID)
+	return args.Error(0)
+}
+
+func (m *MockSession) List(ctx context.Context, userID int64) ([]*models.Session, error) {
+	args := m.Called(ctx, userID)
+	result := args.Get(0)
+	if result == nil {
+		return nil, args.Error(1)
+	}
+	return result.([]*models.Session), args.Error(1)
+}
+
+func (m *MockSession) Revoke(ctx context.Context, userID int64, sessionID string) error {
+	args := m.Called(ctx, userID, sessionID)
+	return args.Error(0)
+}
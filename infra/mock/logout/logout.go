@@ -14,3 +14,8 @@ func (m *MockLogout) Logout(ctx context.Context, tokenString string) error {
 	args := m.Called(ctx, tokenString)
 	return args.Error(0)
 }
+
+func (m *MockLogout) EndSession(ctx context.Context, refreshToken string) error {
+	args := m.Called(ctx, refreshToken)
+	return args.Error(0)
+}
@@ -0,0 +1,93 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+)
+
+// PriceMock atende o repositório de preços e o serviço.
+type PriceMock struct {
+	mock.Mock
+}
+
+func (m *PriceMock) History(ctx context.Context, f *models.PriceFilter) (*commonFilter.Page[*models.ProductPrice], error) {
+	args := m.Called(ctx, f)
+	if page, ok := args.Get(0).(*commonFilter.Page[*models.ProductPrice]); ok {
+		return page, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *PriceMock) EffectiveAt(ctx context.Context, productID int64, at time.Time) (*models.EffectivePrice, error) {
+	args := m.Called(ctx, productID, at)
+	if price, ok := args.Get(0).(*models.EffectivePrice); ok {
+		return price, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *PriceMock) EffectiveAtTx(ctx context.Context, tx pgx.Tx, productID int64, at time.Time) (*models.EffectivePrice, error) {
+	args := m.Called(ctx, tx, productID, at)
+	if price, ok := args.Get(0).(*models.EffectivePrice); ok {
+		return price, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *PriceMock) Schedule(ctx context.Context, change *models.ScheduledPrice) (*models.ScheduledPrice, error) {
+	args := m.Called(ctx, change)
+	if result, ok := args.Get(0).(*models.ScheduledPrice); ok {
+		return result, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *PriceMock) ListScheduled(ctx context.Context, productID int64, status string) ([]*models.ScheduledPrice, error) {
+	args := m.Called(ctx, productID, status)
+	if result, ok := args.Get(0).([]*models.ScheduledPrice); ok {
+		return result, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *PriceMock) CancelScheduled(ctx context.Context, id int64) (*models.ScheduledPrice, error) {
+	args := m.Called(ctx, id)
+	if result, ok := args.Get(0).(*models.ScheduledPrice); ok {
+		return result, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// PriceApplierMock atende o agendador de preços.
+type PriceApplierMock struct {
+	mock.Mock
+}
+
+func (m *PriceApplierMock) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	args := m.Called(ctx)
+	if tx, ok := args.Get(0).(pgx.Tx); ok {
+		return tx, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *PriceApplierMock) ApplyDueTx(ctx context.Context, tx pgx.Tx) ([]*models.AppliedPrice, error) {
+	args := m.Called(ctx, tx)
+	if result, ok := args.Get(0).([]*models.AppliedPrice); ok {
+		return result, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *PriceApplierMock) NextDue(ctx context.Context) (*time.Time, error) {
+	args := m.Called(ctx)
+	if next, ok := args.Get(0).(*time.Time); ok {
+		return next, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -2,7 +2,9 @@ package mock
 
 import (
 	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
+	modelsLocation "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
 	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/product/filter"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
 	"github.com/stretchr/testify/mock"
@@ -81,9 +83,12 @@ func (m *ProductMock) DecreaseStock(ctx context.Context, id int64, amount int) e
 	return args.Error(0)
 }
 
-func (m *ProductMock) GetStock(ctx context.Context, id int64) (int, error) {
+func (m *ProductMock) GetStock(ctx context.Context, id int64) (*modelsLocation.ProductStock, error) {
 	args := m.Called(ctx, id)
-	return args.Int(0), args.Error(1)
+	if stock, ok := args.Get(0).(*modelsLocation.ProductStock); ok {
+		return stock, args.Error(1)
+	}
+	return nil, args.Error(1)
 }
 
 func (m *ProductMock) EnableDiscount(ctx context.Context, id int64) error {
@@ -106,10 +111,13 @@ func (m *ProductMock) ProductExists(ctx context.Context, productID int64) (bool,
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *ProductMock) Filter(ctx context.Context, filterData *modelFilter.ProductFilter) ([]*models.Product, error) {
+func (m *ProductMock) Filter(ctx context.Context, filterData *modelFilter.ProductFilter) (*commonFilter.Page[*models.Product], error) {
 	args := m.Called(ctx, filterData)
-	if prods, ok := args.Get(0).([]*models.Product); ok {
-		return prods, args.Error(1)
+	switch res := args.Get(0).(type) {
+	case *commonFilter.Page[*models.Product]:
+		return res, args.Error(1)
+	case []*models.Product:
+		return &commonFilter.Page[*models.Product]{Items: res, Total: int64(len(res))}, args.Error(1)
 	}
 	return nil, args.Error(1)
 }
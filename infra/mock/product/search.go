@@ -0,0 +1,28 @@
+package mock
+
+import (
+	"context"
+
+	search "github.com/WagaoCarvalho/backend_store_go/internal/model/product/search"
+	"github.com/stretchr/testify/mock"
+)
+
+type ProductSearchMock struct {
+	mock.Mock
+}
+
+func (m *ProductSearchMock) Search(ctx context.Context, s *search.ProductSearch) ([]*search.Result, error) {
+	args := m.Called(ctx, s)
+	if results, ok := args.Get(0).([]*search.Result); ok {
+		return results, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *ProductSearchMock) Autocomplete(ctx context.Context, s *search.ProductSearch) ([]*search.Suggestion, error) {
+	args := m.Called(ctx, s)
+	if suggestions, ok := args.Get(0).([]*search.Suggestion); ok {
+		return suggestions, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,53 @@
+package mock
+
+import (
+	"context"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_alert"
+	"github.com/stretchr/testify/mock"
+)
+
+type StockAlertMock struct {
+	mock.Mock
+}
+
+func (m *StockAlertMock) Detect(ctx context.Context, productIDs []int64) ([]*models.StockAlert, error) {
+	args := m.Called(ctx, productIDs)
+	if alerts, ok := args.Get(0).([]*models.StockAlert); ok {
+		return alerts, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *StockAlertMock) List(ctx context.Context, f *models.StockAlertFilter) (*commonFilter.Page[*models.StockAlert], error) {
+	args := m.Called(ctx, f)
+	if page, ok := args.Get(0).(*commonFilter.Page[*models.StockAlert]); ok {
+		return page, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *StockAlertMock) LowStock(ctx context.Context, supplierID *int64) ([]*models.LowStockItem, error) {
+	args := m.Called(ctx, supplierID)
+	if items, ok := args.Get(0).([]*models.LowStockItem); ok {
+		return items, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *StockAlertMock) ReplenishmentCandidates(ctx context.Context, q *models.ReplenishmentQuery) ([]*models.ReplenishmentItem, error) {
+	args := m.Called(ctx, q)
+	if items, ok := args.Get(0).([]*models.ReplenishmentItem); ok {
+		return items, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *StockAlertMock) Replenishment(ctx context.Context, q *models.ReplenishmentQuery) ([]*models.SupplierReplenishment, error) {
+	args := m.Called(ctx, q)
+	if groups, ok := args.Get(0).([]*models.SupplierReplenishment); ok {
+		return groups, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	"github.com/stretchr/testify/mock"
+)
+
+type StockMovementMock struct {
+	mock.Mock
+}
+
+func (m *StockMovementMock) History(ctx context.Context, f *models.StockMovementFilter) (*commonFilter.Page[*models.StockMovement], error) {
+	args := m.Called(ctx, f)
+	if page, ok := args.Get(0).(*commonFilter.Page[*models.StockMovement]); ok {
+		return page, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *StockMovementMock) StockAt(ctx context.Context, productID int64, at time.Time) (*models.StockAt, error) {
+	args := m.Called(ctx, productID, at)
+	if result, ok := args.Get(0).(*models.StockAt); ok {
+		return result, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
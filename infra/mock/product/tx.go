@@ -0,0 +1,68 @@
+package mock
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockProductStockTx struct {
+	mock.Mock
+}
+
+func (m *MockProductStockTx) GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Product, error) {
+	args := m.Called(ctx, tx, id)
+	if prod, ok := args.Get(0).(*models.Product); ok {
+		return prod, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockProductStockTx) GetStockAtForUpdateTx(ctx context.Context, tx pgx.Tx, id, locationID int64) (int, error) {
+	args := m.Called(ctx, tx, id, locationID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockProductStockTx) DecreaseStockTx(ctx context.Context, tx pgx.Tx, id int64, amount int, origin modelsMovement.Origin) error {
+	args := m.Called(ctx, tx, id, amount, origin)
+	return args.Error(0)
+}
+
+func (m *MockProductStockTx) IncreaseStockTx(ctx context.Context, tx pgx.Tx, id int64, amount int, origin modelsMovement.Origin) error {
+	args := m.Called(ctx, tx, id, amount, origin)
+	return args.Error(0)
+}
+
+func (m *MockProductStockTx) ReceiveStockTx(ctx context.Context, tx pgx.Tx, id int64, quantity int, unitCost money.Money, costMethod string, origin modelsMovement.Origin) error {
+	args := m.Called(ctx, tx, id, quantity, unitCost, costMethod, origin)
+	return args.Error(0)
+}
+
+func (m *MockProductStockTx) SetStockTx(ctx context.Context, tx pgx.Tx, id int64, quantity int, origin modelsMovement.Origin) error {
+	args := m.Called(ctx, tx, id, quantity, origin)
+	return args.Error(0)
+}
+
+func (m *MockProductStockTx) DecreaseStockAtTx(ctx context.Context, tx pgx.Tx, id, locationID int64, amount int, origin modelsMovement.Origin) error {
+	args := m.Called(ctx, tx, id, locationID, amount, origin)
+	return args.Error(0)
+}
+
+func (m *MockProductStockTx) IncreaseStockAtTx(ctx context.Context, tx pgx.Tx, id, locationID int64, amount int, origin modelsMovement.Origin) error {
+	args := m.Called(ctx, tx, id, locationID, amount, origin)
+	return args.Error(0)
+}
+
+func (m *MockProductStockTx) ReceiveStockAtTx(ctx context.Context, tx pgx.Tx, id, locationID int64, quantity int, unitCost money.Money, costMethod string, origin modelsMovement.Origin) error {
+	args := m.Called(ctx, tx, id, locationID, quantity, unitCost, costMethod, origin)
+	return args.Error(0)
+}
+
+func (m *MockProductStockTx) ShiftStockTx(ctx context.Context, tx pgx.Tx, id, locationID int64, delta int) error {
+	args := m.Called(ctx, tx, id, locationID, delta)
+	return args.Error(0)
+}
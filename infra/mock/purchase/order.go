@@ -0,0 +1,90 @@
+package mock
+
+import (
+	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	modelsReceipt "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/receipt"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPurchaseOrder struct {
+	mock.Mock
+}
+
+func (m *MockPurchaseOrder) GetByID(ctx context.Context, id int64) (*models.PurchaseOrder, error) {
+	args := m.Called(ctx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.PurchaseOrder), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPurchaseOrder) Filter(ctx context.Context, f *filter.PurchaseOrderFilter) (*commonFilter.Page[*models.PurchaseOrder], error) {
+	args := m.Called(ctx, f)
+	switch res := args.Get(0).(type) {
+	case *commonFilter.Page[*models.PurchaseOrder]:
+		return res, args.Error(1)
+	case []*models.PurchaseOrder:
+		return &commonFilter.Page[*models.PurchaseOrder]{Items: res, Total: int64(len(res))}, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPurchaseOrder) Create(ctx context.Context, order *models.PurchaseOrder) (*models.PurchaseOrder, error) {
+	args := m.Called(ctx, order)
+	if result := args.Get(0); result != nil {
+		return result.(*models.PurchaseOrder), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPurchaseOrder) Update(ctx context.Context, order *models.PurchaseOrder) error {
+	args := m.Called(ctx, order)
+	return args.Error(0)
+}
+
+func (m *MockPurchaseOrder) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockPurchaseOrder) Send(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockPurchaseOrder) Cancel(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockPurchaseOrder) Receive(ctx context.Context, receipt *modelsReceipt.PurchaseReceipt) (*modelsReceipt.PurchaseReceipt, error) {
+	args := m.Called(ctx, receipt)
+	if result := args.Get(0); result != nil {
+		return result.(*modelsReceipt.PurchaseReceipt), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPurchaseOrder) GetReceipts(ctx context.Context, orderID int64) ([]*modelsReceipt.PurchaseReceipt, error) {
+	args := m.Called(ctx, orderID)
+	if result := args.Get(0); result != nil {
+		return result.([]*modelsReceipt.PurchaseReceipt), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+type MockPurchaseReceipt struct {
+	mock.Mock
+}
+
+func (m *MockPurchaseReceipt) GetByOrderID(ctx context.Context, orderID int64) ([]*modelsReceipt.PurchaseReceipt, error) {
+	args := m.Called(ctx, orderID)
+	if result := args.Get(0); result != nil {
+		return result.([]*modelsReceipt.PurchaseReceipt), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
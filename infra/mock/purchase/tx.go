@@ -0,0 +1,70 @@
+package mock
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	modelsReceipt "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/receipt"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPurchaseOrderTx struct {
+	mock.Mock
+}
+
+func (m *MockPurchaseOrderTx) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(pgx.Tx), args.Error(1)
+}
+
+func (m *MockPurchaseOrderTx) CreateTx(ctx context.Context, tx pgx.Tx, order *models.PurchaseOrder) (*models.PurchaseOrder, error) {
+	args := m.Called(ctx, tx, order)
+	if result := args.Get(0); result != nil {
+		return result.(*models.PurchaseOrder), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPurchaseOrderTx) GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.PurchaseOrder, error) {
+	args := m.Called(ctx, tx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.PurchaseOrder), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPurchaseOrderTx) UpdateTx(ctx context.Context, tx pgx.Tx, order *models.PurchaseOrder) error {
+	args := m.Called(ctx, tx, order)
+	return args.Error(0)
+}
+
+func (m *MockPurchaseOrderTx) UpdateStatusTx(ctx context.Context, tx pgx.Tx, order *models.PurchaseOrder) error {
+	args := m.Called(ctx, tx, order)
+	return args.Error(0)
+}
+
+func (m *MockPurchaseOrderTx) ReceiveItemTx(ctx context.Context, tx pgx.Tx, itemID int64, quantity int) error {
+	args := m.Called(ctx, tx, itemID, quantity)
+	return args.Error(0)
+}
+
+func (m *MockPurchaseOrderTx) DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	args := m.Called(ctx, tx, id)
+	return args.Error(0)
+}
+
+type MockPurchaseReceiptTx struct {
+	mock.Mock
+}
+
+func (m *MockPurchaseReceiptTx) CreateTx(ctx context.Context, tx pgx.Tx, receipt *modelsReceipt.PurchaseReceipt) (*modelsReceipt.PurchaseReceipt, error) {
+	args := m.Called(ctx, tx, receipt)
+	if result := args.Get(0); result != nil {
+		return result.(*modelsReceipt.PurchaseReceipt), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/checkout"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSaleCheckout struct {
+	mock.Mock
+}
+
+func (m *MockSaleCheckout) Checkout(ctx context.Context, checkout *models.Checkout) (*models.CheckoutResult, error) {
+	args := m.Called(ctx, checkout)
+	if result := args.Get(0); result != nil {
+		return result.(*models.CheckoutResult), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,78 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSalePaymentTx struct {
+	mock.Mock
+}
+
+func (m *MockSalePaymentTx) CreateTx(ctx context.Context, tx pgx.Tx, payment *models.SalePayment) (*models.SalePayment, error) {
+	args := m.Called(ctx, tx, payment)
+	if result := args.Get(0); result != nil {
+		return result.(*models.SalePayment), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockSalePaymentTx) GetBySaleIDTx(ctx context.Context, tx pgx.Tx, saleID int64) ([]*models.SalePayment, error) {
+	args := m.Called(ctx, tx, saleID)
+	if result := args.Get(0); result != nil {
+		return result.([]*models.SalePayment), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+type MockSalePaymentReader struct {
+	mock.Mock
+}
+
+func (m *MockSalePaymentReader) GetBySaleID(ctx context.Context, saleID int64) ([]*models.SalePayment, error) {
+	args := m.Called(ctx, saleID)
+	if result := args.Get(0); result != nil {
+		return result.([]*models.SalePayment), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockSalePaymentReader) TotalsByMethod(ctx context.Context, from, to time.Time) ([]*models.MethodTotal, error) {
+	args := m.Called(ctx, from, to)
+	if result := args.Get(0); result != nil {
+		return result.([]*models.MethodTotal), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+type MockSalePaymentService struct {
+	mock.Mock
+}
+
+func (m *MockSalePaymentService) GetBySaleID(ctx context.Context, saleID int64) (*models.Summary, error) {
+	args := m.Called(ctx, saleID)
+	if result := args.Get(0); result != nil {
+		return result.(*models.Summary), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockSalePaymentService) Add(ctx context.Context, payment *models.SalePayment) (*models.Summary, error) {
+	args := m.Called(ctx, payment)
+	if result := args.Get(0); result != nil {
+		return result.(*models.Summary), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockSalePaymentService) TotalsByMethod(ctx context.Context, from, to time.Time) ([]*models.MethodTotal, error) {
+	args := m.Called(ctx, from, to)
+	if result := args.Get(0); result != nil {
+		return result.([]*models.MethodTotal), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,110 @@
+package mock
+
+import (
+	"context"
+	"net/http"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/pix"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPixChargeRepo struct {
+	mock.Mock
+}
+
+func (m *MockPixChargeRepo) GetBySaleID(ctx context.Context, saleID int64) ([]*models.PixCharge, error) {
+	args := m.Called(ctx, saleID)
+	if result := args.Get(0); result != nil {
+		return result.([]*models.PixCharge), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPixChargeRepo) GetByTxID(ctx context.Context, txID string) (*models.PixCharge, error) {
+	args := m.Called(ctx, txID)
+	if result := args.Get(0); result != nil {
+		return result.(*models.PixCharge), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPixChargeRepo) Create(ctx context.Context, charge *models.PixCharge) (*models.PixCharge, error) {
+	args := m.Called(ctx, charge)
+	if result := args.Get(0); result != nil {
+		return result.(*models.PixCharge), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+type MockPixChargeTx struct {
+	mock.Mock
+}
+
+func (m *MockPixChargeTx) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	args := m.Called(ctx)
+	var tx pgx.Tx
+	if args.Get(0) != nil {
+		tx = args.Get(0).(pgx.Tx)
+	}
+	return tx, args.Error(1)
+}
+
+func (m *MockPixChargeTx) GetByTxIDTx(ctx context.Context, tx pgx.Tx, txID string) (*models.PixCharge, error) {
+	args := m.Called(ctx, tx, txID)
+	if result := args.Get(0); result != nil {
+		return result.(*models.PixCharge), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPixChargeTx) ConfirmTx(ctx context.Context, tx pgx.Tx, confirmation pix.Confirmation) (*models.PixCharge, error) {
+	args := m.Called(ctx, tx, confirmation)
+	if result := args.Get(0); result != nil {
+		return result.(*models.PixCharge), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPixChargeTx) ConfirmedAmountTx(ctx context.Context, tx pgx.Tx, saleID int64) (money.Money, error) {
+	args := m.Called(ctx, tx, saleID)
+	return args.Get(0).(money.Money), args.Error(1)
+}
+
+type MockPixChargeService struct {
+	mock.Mock
+}
+
+func (m *MockPixChargeService) Create(ctx context.Context, saleID int64, amount *money.Money, createdBy *int64) (*models.PixCharge, error) {
+	args := m.Called(ctx, saleID, amount, createdBy)
+	if result := args.Get(0); result != nil {
+		return result.(*models.PixCharge), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPixChargeService) GetBySaleID(ctx context.Context, saleID int64) ([]*models.PixCharge, error) {
+	args := m.Called(ctx, saleID)
+	if result := args.Get(0); result != nil {
+		return result.([]*models.PixCharge), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPixChargeService) QRCode(ctx context.Context, txID string, size int) ([]byte, error) {
+	args := m.Called(ctx, txID, size)
+	if result := args.Get(0); result != nil {
+		return result.([]byte), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPixChargeService) Notify(ctx context.Context, header http.Header, body []byte) ([]*models.PixCharge, error) {
+	args := m.Called(ctx, header, body)
+	if result := args.Get(0); result != nil {
+		return result.([]*models.PixCharge), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,28 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/receipt"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSaleReceiptService struct {
+	mock.Mock
+}
+
+func (m *MockSaleReceiptService) Build(ctx context.Context, saleID int64) (*receipt.Receipt, error) {
+	args := m.Called(ctx, saleID)
+	if result := args.Get(0); result != nil {
+		return result.(*receipt.Receipt), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockSaleReceiptService) Render(ctx context.Context, saleID int64, format string) ([]byte, error) {
+	args := m.Called(ctx, saleID, format)
+	if result := args.Get(0); result != nil {
+		return result.([]byte), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
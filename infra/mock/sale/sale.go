@@ -2,10 +2,12 @@ package mock
 
 import (
 	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 	"time"
 
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/filter"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	modelsReturn "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
 	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/mock"
 )
@@ -105,12 +107,33 @@ func (m *MockSale) Returned(ctx context.Context, id int64) error {
 	return args.Error(0)
 }
 
-func (m *MockSale) Filter(ctx context.Context, f *filter.SaleFilter) ([]*models.Sale, error) {
+func (m *MockSale) Filter(ctx context.Context, f *filter.SaleFilter) (*commonFilter.Page[*models.Sale], error) {
 	args := m.Called(ctx, f)
+	switch res := args.Get(0).(type) {
+	case *commonFilter.Page[*models.Sale]:
+		return res, args.Error(1)
+	case []*models.Sale:
+		return &commonFilter.Page[*models.Sale]{Items: res, Total: int64(len(res))}, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockSale) ReturnItems(ctx context.Context, saleReturn *modelsReturn.SaleReturn) (*modelsReturn.SaleReturn, error) {
+	args := m.Called(ctx, saleReturn)
+
+	var result *modelsReturn.SaleReturn
+	if res := args.Get(0); res != nil {
+		result = res.(*modelsReturn.SaleReturn)
+	}
+	return result, args.Error(1)
+}
+
+func (m *MockSale) GetReturns(ctx context.Context, saleID int64) ([]*modelsReturn.SaleReturn, error) {
+	args := m.Called(ctx, saleID)
 
-	var result []*models.Sale
+	var result []*modelsReturn.SaleReturn
 	if res := args.Get(0); res != nil {
-		result = res.([]*models.Sale)
+		result = res.([]*modelsReturn.SaleReturn)
 	}
 	return result, args.Error(1)
 }
@@ -0,0 +1,130 @@
+package mock
+
+import (
+	"context"
+
+	modelsItem "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	modelsReturn "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSaleTx struct {
+	mock.Mock
+}
+
+func (m *MockSaleTx) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(pgx.Tx), args.Error(1)
+}
+
+func (m *MockSaleTx) CreateTx(ctx context.Context, tx pgx.Tx, sale *models.Sale) (*models.Sale, error) {
+	args := m.Called(ctx, tx, sale)
+	if result := args.Get(0); result != nil {
+		return result.(*models.Sale), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockSaleTx) GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Sale, error) {
+	args := m.Called(ctx, tx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.Sale), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockSaleTx) RecalculateTotalsTx(ctx context.Context, tx pgx.Tx, sale *models.Sale) error {
+	args := m.Called(ctx, tx, sale)
+	return args.Error(0)
+}
+
+func (m *MockSaleTx) UpdateStatusTx(ctx context.Context, tx pgx.Tx, sale *models.Sale) error {
+	args := m.Called(ctx, tx, sale)
+	return args.Error(0)
+}
+
+type MockSaleItemTx struct {
+	mock.Mock
+}
+
+func (m *MockSaleItemTx) CreateTx(ctx context.Context, tx pgx.Tx, item *modelsItem.SaleItem) (*modelsItem.SaleItem, error) {
+	args := m.Called(ctx, tx, item)
+	if result := args.Get(0); result != nil {
+		return result.(*modelsItem.SaleItem), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockSaleItemTx) GetByIDTx(ctx context.Context, tx pgx.Tx, id int64) (*modelsItem.SaleItem, error) {
+	args := m.Called(ctx, tx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*modelsItem.SaleItem), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockSaleItemTx) GetBySaleIDTx(ctx context.Context, tx pgx.Tx, saleID int64) ([]*modelsItem.SaleItem, error) {
+	args := m.Called(ctx, tx, saleID)
+	if result := args.Get(0); result != nil {
+		return result.([]*modelsItem.SaleItem), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockSaleItemTx) UpdateTx(ctx context.Context, tx pgx.Tx, item *modelsItem.SaleItem) error {
+	args := m.Called(ctx, tx, item)
+	return args.Error(0)
+}
+
+func (m *MockSaleItemTx) DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	args := m.Called(ctx, tx, id)
+	return args.Error(0)
+}
+
+func (m *MockSaleItemTx) DeleteBySaleIDTx(ctx context.Context, tx pgx.Tx, saleID int64) error {
+	args := m.Called(ctx, tx, saleID)
+	return args.Error(0)
+}
+
+type MockSaleReturnTx struct {
+	mock.Mock
+}
+
+func (m *MockSaleReturnTx) CreateTx(ctx context.Context, tx pgx.Tx, saleReturn *modelsReturn.SaleReturn) (*modelsReturn.SaleReturn, error) {
+	args := m.Called(ctx, tx, saleReturn)
+	if result := args.Get(0); result != nil {
+		return result.(*modelsReturn.SaleReturn), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockSaleReturnTx) GetReturnedQuantitiesTx(ctx context.Context, tx pgx.Tx, saleID int64) (map[int64]int, error) {
+	args := m.Called(ctx, tx, saleID)
+	if result := args.Get(0); result != nil {
+		return result.(map[int64]int), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockSaleReturnTx) GetRefundedAmountTx(ctx context.Context, tx pgx.Tx, saleID int64) (money.Money, error) {
+	args := m.Called(ctx, tx, saleID)
+	return args.Get(0).(money.Money), args.Error(1)
+}
+
+type MockSaleReturnReader struct {
+	mock.Mock
+}
+
+func (m *MockSaleReturnReader) GetBySaleID(ctx context.Context, saleID int64) ([]*modelsReturn.SaleReturn, error) {
+	args := m.Called(ctx, saleID)
+	if result := args.Get(0); result != nil {
+		return result.([]*modelsReturn.SaleReturn), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
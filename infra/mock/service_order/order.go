@@ -0,0 +1,86 @@
+package mock
+
+import (
+	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+
+	modelsSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockServiceOrder struct {
+	mock.Mock
+}
+
+func (m *MockServiceOrder) GetByID(ctx context.Context, id int64) (*models.ServiceOrder, error) {
+	args := m.Called(ctx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.ServiceOrder), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockServiceOrder) Filter(ctx context.Context, f *filter.ServiceOrderFilter) (*commonFilter.Page[*models.ServiceOrder], error) {
+	args := m.Called(ctx, f)
+	switch res := args.Get(0).(type) {
+	case *commonFilter.Page[*models.ServiceOrder]:
+		return res, args.Error(1)
+	case []*models.ServiceOrder:
+		return &commonFilter.Page[*models.ServiceOrder]{Items: res, Total: int64(len(res))}, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockServiceOrder) Create(ctx context.Context, order *models.ServiceOrder) (*models.ServiceOrder, error) {
+	args := m.Called(ctx, order)
+	if result := args.Get(0); result != nil {
+		return result.(*models.ServiceOrder), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockServiceOrder) Update(ctx context.Context, order *models.ServiceOrder) error {
+	args := m.Called(ctx, order)
+	return args.Error(0)
+}
+
+func (m *MockServiceOrder) ChangeStatus(ctx context.Context, id int64, status string) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
+func (m *MockServiceOrder) AddPart(ctx context.Context, part *models.ServiceOrderPart) (*models.ServiceOrderPart, error) {
+	args := m.Called(ctx, part)
+	if result := args.Get(0); result != nil {
+		return result.(*models.ServiceOrderPart), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockServiceOrder) RemovePart(ctx context.Context, orderID, partID int64) error {
+	args := m.Called(ctx, orderID, partID)
+	return args.Error(0)
+}
+
+func (m *MockServiceOrder) AddLabor(ctx context.Context, labor *models.ServiceOrderLabor) (*models.ServiceOrderLabor, error) {
+	args := m.Called(ctx, labor)
+	if result := args.Get(0); result != nil {
+		return result.(*models.ServiceOrderLabor), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockServiceOrder) RemoveLabor(ctx context.Context, orderID, laborID int64) error {
+	args := m.Called(ctx, orderID, laborID)
+	return args.Error(0)
+}
+
+func (m *MockServiceOrder) ConvertToSale(ctx context.Context, conversion *models.SaleConversion) (*modelsSale.Sale, error) {
+	args := m.Called(ctx, conversion)
+	if result := args.Get(0); result != nil {
+		return result.(*modelsSale.Sale), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,83 @@
+package mock
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockServiceOrderTx struct {
+	mock.Mock
+}
+
+func (m *MockServiceOrderTx) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(pgx.Tx), args.Error(1)
+}
+
+func (m *MockServiceOrderTx) CreateTx(ctx context.Context, tx pgx.Tx, order *models.ServiceOrder) (*models.ServiceOrder, error) {
+	args := m.Called(ctx, tx, order)
+	if result := args.Get(0); result != nil {
+		return result.(*models.ServiceOrder), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockServiceOrderTx) GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.ServiceOrder, error) {
+	args := m.Called(ctx, tx, id)
+	if result := args.Get(0); result != nil {
+		return result.(*models.ServiceOrder), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockServiceOrderTx) UpdateTx(ctx context.Context, tx pgx.Tx, order *models.ServiceOrder) error {
+	args := m.Called(ctx, tx, order)
+	return args.Error(0)
+}
+
+func (m *MockServiceOrderTx) UpdateStatusTx(ctx context.Context, tx pgx.Tx, order *models.ServiceOrder) error {
+	args := m.Called(ctx, tx, order)
+	return args.Error(0)
+}
+
+func (m *MockServiceOrderTx) UpdateTotalsTx(ctx context.Context, tx pgx.Tx, order *models.ServiceOrder) error {
+	args := m.Called(ctx, tx, order)
+	return args.Error(0)
+}
+
+func (m *MockServiceOrderTx) SetSaleTx(ctx context.Context, tx pgx.Tx, order *models.ServiceOrder) error {
+	args := m.Called(ctx, tx, order)
+	return args.Error(0)
+}
+
+func (m *MockServiceOrderTx) AddPartTx(ctx context.Context, tx pgx.Tx, part *models.ServiceOrderPart) (*models.ServiceOrderPart, error) {
+	args := m.Called(ctx, tx, part)
+	if result := args.Get(0); result != nil {
+		return result.(*models.ServiceOrderPart), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockServiceOrderTx) DeletePartTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	args := m.Called(ctx, tx, id)
+	return args.Error(0)
+}
+
+func (m *MockServiceOrderTx) AddLaborTx(ctx context.Context, tx pgx.Tx, labor *models.ServiceOrderLabor) (*models.ServiceOrderLabor, error) {
+	args := m.Called(ctx, tx, labor)
+	if result := args.Get(0); result != nil {
+		return result.(*models.ServiceOrderLabor), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockServiceOrderTx) DeleteLaborTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	args := m.Called(ctx, tx, id)
+	return args.Error(0)
+}
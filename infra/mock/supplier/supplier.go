@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
 	"github.com/stretchr/testify/mock"
 
@@ -74,12 +75,13 @@ func (m *MockSupplier) SupplierExists(ctx context.Context, supplierID int64) (bo
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockSupplier) Filter(ctx context.Context, f *filter.SupplierFilter) ([]*models.Supplier, error) {
+func (m *MockSupplier) Filter(ctx context.Context, f *filter.SupplierFilter) (*commonFilter.Page[*models.Supplier], error) {
 	args := m.Called(ctx, f)
-
-	var result []*models.Supplier
-	if res := args.Get(0); res != nil {
-		result = res.([]*models.Supplier)
+	switch res := args.Get(0).(type) {
+	case *commonFilter.Page[*models.Supplier]:
+		return res, args.Error(1)
+	case []*models.Supplier:
+		return &commonFilter.Page[*models.Supplier]{Items: res, Total: int64(len(res))}, args.Error(1)
 	}
-	return result, args.Error(1)
+	return nil, args.Error(1)
 }
@@ -0,0 +1,53 @@
+package mock
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/role"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockUserRole struct {
+	mock.Mock
+}
+
+func (m *MockUserRole) GetAllRoles(ctx context.Context) ([]*models.Role, error) {
+	args := m.Called(ctx)
+	result := args.Get(0)
+	if result == nil {
+		return nil, args.Error(1)
+	}
+	return result.([]*models.Role), args.Error(1)
+}
+
+func (m *MockUserRole) GetRolesByUserID(ctx context.Context, userID int64) ([]*models.Role, error) {
+	args := m.Called(ctx, userID)
+	result := args.Get(0)
+	if result == nil {
+		return nil, args.Error(1)
+	}
+	return result.([]*models.Role), args.Error(1)
+}
+
+func (m *MockUserRole) GetAccessByUserID(ctx context.Context, userID int64) (*models.Access, error) {
+	args := m.Called(ctx, userID)
+	result := args.Get(0)
+	if result == nil {
+		return nil, args.Error(1)
+	}
+	return result.(*models.Access), args.Error(1)
+}
+
+func (m *MockUserRole) AssignRole(ctx context.Context, userRole *models.UserRole) (*models.UserRole, error) {
+	args := m.Called(ctx, userRole)
+	result := args.Get(0)
+	if result == nil {
+		return nil, args.Error(1)
+	}
+	return result.(*models.UserRole), args.Error(1)
+}
+
+func (m *MockUserRole) RemoveRole(ctx context.Context, userID, roleID int64) error {
+	args := m.Called(ctx, userID, roleID)
+	return args.Error(0)
+}
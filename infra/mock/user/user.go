@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/user/filter"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/user"
@@ -89,12 +90,13 @@ func (m *MockUser) UserExists(ctx context.Context, userID int64) (bool, error) {
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockUser) Filter(ctx context.Context, f *filter.UserFilter) ([]*models.User, error) {
+func (m *MockUser) Filter(ctx context.Context, f *filter.UserFilter) (*commonFilter.Page[*models.User], error) {
 	args := m.Called(ctx, f)
-
-	var result []*models.User
-	if res := args.Get(0); res != nil {
-		result = res.([]*models.User)
+	switch res := args.Get(0).(type) {
+	case *commonFilter.Page[*models.User]:
+		return res, args.Error(1)
+	case []*models.User:
+		return &commonFilter.Page[*models.User]{Items: res, Total: int64(len(res))}, args.Error(1)
 	}
-	return result, args.Error(1)
+	return nil, args.Error(1)
 }
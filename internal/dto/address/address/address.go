@@ -9,6 +9,7 @@ type AddressDTO struct {
 	ID           *int64 `json:"id,omitempty"`
 	UserID       *int64 `json:"user_id,omitempty"`
 	ClientCpfID  *int64 `json:"client_cpf_id,omitempty"`
+	ClientCnpjID *int64 `json:"client_cnpj_id,omitempty"`
 	SupplierID   *int64 `json:"supplier_id,omitempty"`
 	Street       string `json:"street"`
 	StreetNumber string `json:"street_number,omitempty"`
@@ -25,6 +26,7 @@ func ToAddressModel(dto AddressDTO) *models.Address {
 		ID:           utils.NilToZero(dto.ID),
 		UserID:       dto.UserID,
 		ClientCpfID:  dto.ClientCpfID,
+		ClientCnpjID: dto.ClientCnpjID,
 		SupplierID:   dto.SupplierID,
 		Street:       dto.Street,
 		StreetNumber: dto.StreetNumber,
@@ -46,6 +48,7 @@ func ToAddressDTO(model *models.Address) AddressDTO {
 		ID:           &model.ID,
 		UserID:       model.UserID,
 		ClientCpfID:  model.ClientCpfID,
+		ClientCnpjID: model.ClientCnpjID,
 		SupplierID:   model.SupplierID,
 		Street:       model.Street,
 		StreetNumber: model.StreetNumber,
@@ -12,6 +12,7 @@ import (
 type AddressFilterDTO struct {
 	UserID       *int64     `schema:"user_id"`
 	ClientCpfID  *int64     `schema:"client_cpf_id"`
+	ClientCnpjID *int64     `schema:"client_cnpj_id"`
 	SupplierID   *int64     `schema:"supplier_id"`
 	Street       string     `schema:"street"`
 	StreetNumber string     `schema:"street_number"`
@@ -27,6 +28,9 @@ type AddressFilterDTO struct {
 	UpdatedTo    *time.Time `schema:"updated_to"`
 	Limit        int        `schema:"limit"`
 	Offset       int        `schema:"offset"`
+	Cursor       string     `schema:"cursor"`
+	CursorMode   bool       `schema:"-"`
+	SearchTerm   string     `schema:"search"`
 	SortBy       string     `schema:"sort_by"`
 	SortOrder    string     `schema:"sort_order"`
 }
@@ -34,13 +38,17 @@ type AddressFilterDTO struct {
 func (d *AddressFilterDTO) ToModel() (*filterAddress.AddressFilter, error) {
 	filter := &filterAddress.AddressFilter{
 		BaseFilter: commonFilter.BaseFilter{
-			Limit:     d.Limit,
-			Offset:    d.Offset,
-			SortBy:    d.SortBy,
-			SortOrder: d.SortOrder,
+			Limit:      d.Limit,
+			Offset:     d.Offset,
+			CursorMode: d.CursorMode,
+			Cursor:     d.Cursor,
+			SearchTerm: d.SearchTerm,
+			SortBy:     d.SortBy,
+			SortOrder:  d.SortOrder,
 		},
 		UserID:       d.UserID,
 		ClientCpfID:  d.ClientCpfID,
+		ClientCnpjID: d.ClientCnpjID,
 		SupplierID:   d.SupplierID,
 		Street:       d.Street,
 		StreetNumber: d.StreetNumber,
@@ -78,6 +86,7 @@ func (d *AddressFilterDTO) Validate() error {
 	hasContentFilter :=
 		d.UserID != nil ||
 			d.ClientCpfID != nil ||
+			d.ClientCnpjID != nil ||
 			d.SupplierID != nil ||
 			d.Street != "" ||
 			d.StreetNumber != "" ||
@@ -145,6 +154,10 @@ func (d *AddressFilterDTO) Validate() error {
 		validationErrors = append(validationErrors, "'client_cpf_id' deve ser maior que zero")
 	}
 
+	if d.ClientCnpjID != nil && *d.ClientCnpjID <= 0 {
+		validationErrors = append(validationErrors, "'client_cnpj_id' deve ser maior que zero")
+	}
+
 	if d.SupplierID != nil && *d.SupplierID <= 0 {
 		validationErrors = append(validationErrors, "'supplier_id' deve ser maior que zero")
 	}
@@ -163,7 +176,7 @@ func (d *AddressFilterDTO) Validate() error {
 	}
 
 	if d.SortBy != "" && !isValidAddressSortField(d.SortBy) {
-		validationErrors = append(validationErrors, "'sort_by' inválido. Campos permitidos: id, user_id, client_cpf_id, supplier_id, street, street_number, city, state, country, postal_code, is_active, created_at, updated_at")
+		validationErrors = append(validationErrors, "'sort_by' inválido. Campos permitidos: id, user_id, client_cpf_id, client_cnpj_id, supplier_id, street, street_number, city, state, country, postal_code, is_active, created_at, updated_at")
 	}
 
 	if d.SortOrder != "" {
@@ -191,19 +204,20 @@ func (d *AddressFilterDTO) Validate() error {
 
 func isValidAddressSortField(field string) bool {
 	allowedFields := map[string]bool{
-		"id":            true,
-		"user_id":       true,
-		"client_cpf_id": true,
-		"supplier_id":   true,
-		"street":        true,
-		"street_number": true,
-		"city":          true,
-		"state":         true,
-		"country":       true,
-		"postal_code":   true,
-		"is_active":     true,
-		"created_at":    true,
-		"updated_at":    true,
+		"id":             true,
+		"user_id":        true,
+		"client_cpf_id":  true,
+		"client_cnpj_id": true,
+		"supplier_id":    true,
+		"street":         true,
+		"street_number":  true,
+		"city":           true,
+		"state":          true,
+		"country":        true,
+		"postal_code":    true,
+		"is_active":      true,
+		"created_at":     true,
+		"updated_at":     true,
 	}
 	return allowedFields[strings.ToLower(field)]
 }
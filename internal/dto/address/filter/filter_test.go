@@ -127,6 +127,7 @@ func TestAddressFilterDTO_Validate_ValidCases(t *testing.T) {
 	}{
 		{"Apenas UserID", AddressFilterDTO{UserID: &userID, Limit: 10}},
 		{"Apenas ClientCpfID", AddressFilterDTO{ClientCpfID: &clientCpfID, Limit: 10}},
+		{"Apenas ClientCnpjID", AddressFilterDTO{ClientCnpjID: &clientCpfID, Limit: 10}},
 		{"Apenas SupplierID", AddressFilterDTO{SupplierID: &supplierID, Limit: 10}},
 		{"Apenas Street", AddressFilterDTO{Street: "Rua Teste", Limit: 10}},
 		{"Apenas StreetNumber", AddressFilterDTO{StreetNumber: "123", Limit: 10, UserID: &userID}},
@@ -259,6 +260,11 @@ func TestAddressFilterDTO_Validate_Errors(t *testing.T) {
 			AddressFilterDTO{ClientCpfID: int64Ptr(-5), Limit: 10, UserID: &userID},
 			"'client_cpf_id' deve ser maior que zero",
 		},
+		{
+			"ClientCnpjID <= 0",
+			AddressFilterDTO{ClientCnpjID: int64Ptr(0), Limit: 10, UserID: &userID},
+			"'client_cnpj_id' deve ser maior que zero",
+		},
 		{
 			"SupplierID <= 0",
 			AddressFilterDTO{SupplierID: int64Ptr(0), Limit: 10, UserID: &userID},
@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+)
+
+type AuditLogDTO struct {
+	ID          int64                         `json:"id"`
+	ActorUserID *int64                        `json:"actor_user_id"`
+	RequestID   string                        `json:"request_id,omitempty"`
+	EntityType  string                        `json:"entity_type"`
+	EntityID    int64                         `json:"entity_id"`
+	Action      string                        `json:"action"`
+	Changes     map[string]models.FieldChange `json:"changes"`
+	CreatedAt   string                        `json:"created_at"`
+}
+
+func ToAuditLogDTO(model *models.AuditLog) AuditLogDTO {
+	changes := model.Changes
+	if changes == nil {
+		changes = map[string]models.FieldChange{}
+	}
+
+	return AuditLogDTO{
+		ID:          model.ID,
+		ActorUserID: model.ActorUserID,
+		RequestID:   model.RequestID,
+		EntityType:  model.EntityType,
+		EntityID:    model.EntityID,
+		Action:      model.Action,
+		Changes:     changes,
+		CreatedAt:   model.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToAuditLogDTOs(modelsList []*models.AuditLog) []AuditLogDTO {
+	result := make([]AuditLogDTO, len(modelsList))
+	for i, m := range modelsList {
+		result[i] = ToAuditLogDTO(m)
+	}
+	return result
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToAuditLogDTOs(t *testing.T) {
+	actor := int64(7)
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	result := ToAuditLogDTOs([]*models.AuditLog{
+		{
+			ID:          1,
+			ActorUserID: &actor,
+			RequestID:   "req-1",
+			EntityType:  "product",
+			EntityID:    10,
+			Action:      models.ActionUpdate,
+			Changes:     map[string]models.FieldChange{"Stock": {From: 5, To: 4}},
+			CreatedAt:   createdAt,
+		},
+		{ID: 2, EntityType: "sale", EntityID: 3, Action: models.ActionDelete, CreatedAt: createdAt},
+	})
+
+	assert.Len(t, result, 2)
+	assert.Equal(t, &actor, result[0].ActorUserID)
+	assert.Equal(t, "2025-01-02T03:04:05Z", result[0].CreatedAt)
+	assert.Equal(t, models.FieldChange{From: 5, To: 4}, result[0].Changes["Stock"])
+	assert.Nil(t, result[1].ActorUserID)
+	assert.NotNil(t, result[1].Changes)
+}
@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	modelAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/filter"
+	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+type AuditFilterDTO struct {
+	ActorUserID *int64     `schema:"actor_user_id"`
+	RequestID   string     `schema:"request_id"`
+	EntityType  string     `schema:"entity_type"`
+	EntityID    *int64     `schema:"entity_id"`
+	Action      string     `schema:"action"`
+	CreatedFrom *time.Time `schema:"created_from"`
+	CreatedTo   *time.Time `schema:"created_to"`
+	SortBy      string     `schema:"sort_by"`
+	SortOrder   string     `schema:"sort_order"`
+	Limit       int        `schema:"limit"`
+	Offset      int        `schema:"offset"`
+	Cursor      string     `schema:"cursor"`
+	CursorMode  bool       `schema:"-"`
+	SearchTerm  string     `schema:"search"`
+}
+
+func (d *AuditFilterDTO) ToModel() (*modelAudit.AuditFilter, error) {
+	if d.Limit < 1 {
+		return nil, fmt.Errorf("%w: 'limit' deve ser maior que 0", errMsg.ErrInvalidFilter)
+	}
+	if d.Limit > 100 {
+		return nil, fmt.Errorf("%w: 'limit' máximo é 100", errMsg.ErrInvalidFilter)
+	}
+	if d.Offset < 0 {
+		return nil, fmt.Errorf("%w: 'offset' não pode ser negativo", errMsg.ErrInvalidFilter)
+	}
+
+	if d.CreatedFrom != nil && d.CreatedTo != nil && d.CreatedFrom.After(*d.CreatedTo) {
+		return nil, fmt.Errorf("%w: 'created_from' não pode ser depois de 'created_to'",
+			errMsg.ErrInvalidFilter)
+	}
+
+	return &modelAudit.AuditFilter{
+		BaseFilter: modelFilter.BaseFilter{
+			Limit:      d.Limit,
+			Offset:     d.Offset,
+			CursorMode: d.CursorMode,
+			Cursor:     d.Cursor,
+			SearchTerm: d.SearchTerm,
+			SortBy:     d.SortBy,
+			SortOrder:  d.SortOrder,
+		},
+		ActorUserID: d.ActorUserID,
+		RequestID:   d.RequestID,
+		EntityType:  d.EntityType,
+		EntityID:    d.EntityID,
+		Action:      d.Action,
+		CreatedFrom: d.CreatedFrom,
+		CreatedTo:   d.CreatedTo,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditFilterDTO_ToModel(t *testing.T) {
+	t.Run("maps every field", func(t *testing.T) {
+		actor, entity := int64(7), int64(10)
+		from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := from.Add(24 * time.Hour)
+
+		d := &AuditFilterDTO{
+			ActorUserID: &actor,
+			RequestID:   "req-1",
+			EntityType:  "product",
+			EntityID:    &entity,
+			Action:      "update",
+			CreatedFrom: &from,
+			CreatedTo:   &to,
+			SortBy:      "created_at",
+			SortOrder:   "desc",
+			Limit:       10,
+			Offset:      20,
+		}
+
+		f, err := d.ToModel()
+
+		require.NoError(t, err)
+		assert.Equal(t, &actor, f.ActorUserID)
+		assert.Equal(t, "req-1", f.RequestID)
+		assert.Equal(t, "product", f.EntityType)
+		assert.Equal(t, &entity, f.EntityID)
+		assert.Equal(t, "update", f.Action)
+		assert.Equal(t, &from, f.CreatedFrom)
+		assert.Equal(t, &to, f.CreatedTo)
+		assert.Equal(t, "created_at", f.SortBy)
+		assert.Equal(t, "desc", f.SortOrder)
+		assert.Equal(t, 10, f.Limit)
+		assert.Equal(t, 20, f.Offset)
+	})
+
+	t.Run("invalid pagination", func(t *testing.T) {
+		for _, d := range []AuditFilterDTO{{Limit: 0}, {Limit: 101}, {Limit: 10, Offset: -1}} {
+			_, err := d.ToModel()
+			assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		}
+	})
+
+	t.Run("inverted date range", func(t *testing.T) {
+		from := time.Now()
+		to := from.Add(-time.Hour)
+
+		_, err := (&AuditFilterDTO{Limit: 10, CreatedFrom: &from, CreatedTo: &to}).ToModel()
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+	})
+}
@@ -0,0 +1,86 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	modelCash "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/filter"
+	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+type CashSessionFilterDTO struct {
+	UserID     *int64  `schema:"user_id"`
+	Status     string  `schema:"status"`
+	Result     string  `schema:"result"`
+	OpenedFrom *string `schema:"opened_from"`
+	OpenedTo   *string `schema:"opened_to"`
+	ClosedFrom *string `schema:"closed_from"`
+	ClosedTo   *string `schema:"closed_to"`
+	Limit      int     `schema:"limit"`
+	Offset     int     `schema:"offset"`
+	Cursor     string  `schema:"cursor"`
+	CursorMode bool    `schema:"-"`
+	SearchTerm string  `schema:"search"`
+}
+
+func (d *CashSessionFilterDTO) ToModel() (*modelCash.CashSessionFilter, error) {
+	parseDate := func(s *string, fieldName string) (*time.Time, error) {
+		if s == nil || *s == "" {
+			return nil, nil
+		}
+		t, err := time.Parse("2006-01-02", *s)
+		if err != nil {
+			return nil, fmt.Errorf("%w: campo '%s' com valor inválido '%s' - formato esperado: YYYY-MM-DD",
+				errMsg.ErrInvalidFilter, fieldName, *s)
+		}
+		return &t, nil
+	}
+
+	if d.Limit < 1 {
+		return nil, fmt.Errorf("%w: 'limit' deve ser maior que 0", errMsg.ErrInvalidFilter)
+	}
+	if d.Limit > 100 {
+		return nil, fmt.Errorf("%w: 'limit' máximo é 100", errMsg.ErrInvalidFilter)
+	}
+	if d.Offset < 0 {
+		return nil, fmt.Errorf("%w: 'offset' não pode ser negativo", errMsg.ErrInvalidFilter)
+	}
+
+	openedFrom, err := parseDate(d.OpenedFrom, "opened_from")
+	if err != nil {
+		return nil, err
+	}
+
+	openedTo, err := parseDate(d.OpenedTo, "opened_to")
+	if err != nil {
+		return nil, err
+	}
+
+	closedFrom, err := parseDate(d.ClosedFrom, "closed_from")
+	if err != nil {
+		return nil, err
+	}
+
+	closedTo, err := parseDate(d.ClosedTo, "closed_to")
+	if err != nil {
+		return nil, err
+	}
+
+	return &modelCash.CashSessionFilter{
+		BaseFilter: modelFilter.BaseFilter{
+			Limit:      d.Limit,
+			Offset:     d.Offset,
+			CursorMode: d.CursorMode,
+			Cursor:     d.Cursor,
+			SearchTerm: d.SearchTerm,
+		},
+		UserID:     d.UserID,
+		Status:     d.Status,
+		Result:     d.Result,
+		OpenedFrom: openedFrom,
+		OpenedTo:   openedTo,
+		ClosedFrom: closedFrom,
+		ClosedTo:   closedTo,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"testing"
+
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCashSessionFilterDTO_ToModel(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	t.Run("converte filtro válido", func(t *testing.T) {
+		d := CashSessionFilterDTO{
+			UserID:     utils.Int64Ptr(5),
+			Status:     "closed",
+			Result:     "short",
+			ClosedFrom: strPtr("2026-01-01"),
+			ClosedTo:   strPtr("2026-01-31"),
+			Limit:      10,
+		}
+
+		f, err := d.ToModel()
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), *f.UserID)
+		assert.Equal(t, "short", f.Result)
+		assert.Equal(t, 31, f.ClosedTo.Day())
+		assert.Nil(t, f.OpenedFrom)
+		assert.Equal(t, 10, f.Limit)
+	})
+
+	t.Run("paginação inválida", func(t *testing.T) {
+		for _, d := range []CashSessionFilterDTO{{Limit: 0}, {Limit: 101}, {Limit: 10, Offset: -1}} {
+			_, err := d.ToModel()
+			assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		}
+	})
+
+	t.Run("data inválida", func(t *testing.T) {
+		d := CashSessionFilterDTO{OpenedFrom: strPtr("01/01/2026"), Limit: 10}
+
+		_, err := d.ToModel()
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+	})
+}
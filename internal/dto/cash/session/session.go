@@ -0,0 +1,171 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	dtoPayment "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/payment"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+type OpenCashSessionDTO struct {
+	OpeningAmount money.Money `json:"opening_amount"`
+	Notes         string      `json:"notes,omitempty"`
+}
+
+type CashMovementInputDTO struct {
+	Type   string      `json:"type"`
+	Amount money.Money `json:"amount"`
+	Reason string      `json:"reason,omitempty"`
+}
+
+// CloseCashSessionDTO traz o dinheiro contado na gaveta. counted_amount é
+// obrigatório: zero é um valor contado válido.
+type CloseCashSessionDTO struct {
+	CountedAmount *money.Money `json:"counted_amount"`
+	Notes         string       `json:"notes,omitempty"`
+}
+
+type CashSessionDTO struct {
+	ID             int64        `json:"id"`
+	UserID         int64        `json:"user_id"`
+	Status         string       `json:"status"`
+	OpeningAmount  money.Money  `json:"opening_amount"`
+	ExpectedAmount *money.Money `json:"expected_amount,omitempty"`
+	CountedAmount  *money.Money `json:"counted_amount,omitempty"`
+	Difference     *money.Money `json:"difference,omitempty"`
+	Result         string       `json:"result,omitempty"`
+	Notes          string       `json:"notes,omitempty"`
+	ClosingNotes   string       `json:"closing_notes,omitempty"`
+	ClosedBy       *int64       `json:"closed_by,omitempty"`
+	Version        int          `json:"version"`
+	OpenedAt       time.Time    `json:"opened_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+	ClosedAt       *time.Time   `json:"closed_at,omitempty"`
+}
+
+type CashMovementDTO struct {
+	ID            int64       `json:"id"`
+	CashSessionID int64       `json:"cash_session_id"`
+	Type          string      `json:"type"`
+	Amount        money.Money `json:"amount"`
+	Reason        string      `json:"reason,omitempty"`
+	UserID        *int64      `json:"user_id,omitempty"`
+	SaleID        *int64      `json:"sale_id,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+// SummaryDTO é o resumo do caixa: tenders traz cada forma de pagamento e o
+// esperado considera só o dinheiro.
+type SummaryDTO struct {
+	CashSession    CashSessionDTO              `json:"cash_session"`
+	Tenders        []dtoPayment.MethodTotalDTO `json:"tenders"`
+	OpeningAmount  money.Money                 `json:"opening_amount"`
+	CashSales      money.Money                 `json:"cash_sales"`
+	Deposits       money.Money                 `json:"deposits"`
+	Withdrawals    money.Money                 `json:"withdrawals"`
+	Refunds        money.Money                 `json:"refunds"`
+	ExpectedAmount money.Money                 `json:"expected_amount"`
+	CountedAmount  *money.Money                `json:"counted_amount,omitempty"`
+	Difference     *money.Money                `json:"difference,omitempty"`
+	Result         string                      `json:"result,omitempty"`
+}
+
+func ToCashSessionModel(dto OpenCashSessionDTO, userID int64) *models.CashSession {
+	return &models.CashSession{
+		UserID:        userID,
+		OpeningAmount: dto.OpeningAmount,
+		Notes:         strings.TrimSpace(dto.Notes),
+	}
+}
+
+func ToCashMovementModel(dto CashMovementInputDTO, sessionID int64, userID *int64) *models.CashMovement {
+	return &models.CashMovement{
+		CashSessionID: sessionID,
+		Type:          strings.TrimSpace(dto.Type),
+		Amount:        dto.Amount,
+		Reason:        strings.TrimSpace(dto.Reason),
+		UserID:        userID,
+	}
+}
+
+func ToClosingModel(dto CloseCashSessionDTO, closedBy *int64) *models.Closing {
+	closing := &models.Closing{
+		Notes:    strings.TrimSpace(dto.Notes),
+		ClosedBy: closedBy,
+	}
+	if dto.CountedAmount != nil {
+		closing.CountedAmount = *dto.CountedAmount
+	}
+	return closing
+}
+
+func ToCashSessionDTO(s *models.CashSession) CashSessionDTO {
+	return CashSessionDTO{
+		ID:             s.ID,
+		UserID:         s.UserID,
+		Status:         s.Status,
+		OpeningAmount:  s.OpeningAmount,
+		ExpectedAmount: s.ExpectedAmount,
+		CountedAmount:  s.CountedAmount,
+		Difference:     s.Difference,
+		Result:         s.Result(),
+		Notes:          s.Notes,
+		ClosingNotes:   s.ClosingNotes,
+		ClosedBy:       s.ClosedBy,
+		Version:        s.Version,
+		OpenedAt:       s.OpenedAt,
+		UpdatedAt:      s.UpdatedAt,
+		ClosedAt:       s.ClosedAt,
+	}
+}
+
+func ToCashSessionDTOs(sessions []*models.CashSession) []CashSessionDTO {
+	dtos := make([]CashSessionDTO, 0, len(sessions))
+	for _, s := range sessions {
+		if s != nil {
+			dtos = append(dtos, ToCashSessionDTO(s))
+		}
+	}
+	return dtos
+}
+
+func ToCashMovementDTO(m *models.CashMovement) CashMovementDTO {
+	return CashMovementDTO{
+		ID:            m.ID,
+		CashSessionID: m.CashSessionID,
+		Type:          m.Type,
+		Amount:        m.Amount,
+		Reason:        m.Reason,
+		UserID:        m.UserID,
+		SaleID:        m.SaleID,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+func ToCashMovementDTOs(movements []*models.CashMovement) []CashMovementDTO {
+	dtos := make([]CashMovementDTO, 0, len(movements))
+	for _, m := range movements {
+		if m != nil {
+			dtos = append(dtos, ToCashMovementDTO(m))
+		}
+	}
+	return dtos
+}
+
+func ToSummaryDTO(s *models.Summary) SummaryDTO {
+	return SummaryDTO{
+		CashSession:    ToCashSessionDTO(s.Session),
+		Tenders:        dtoPayment.ToMethodTotalDTOs(s.Tenders),
+		OpeningAmount:  s.Session.OpeningAmount,
+		CashSales:      s.CashSales,
+		Deposits:       s.Deposits,
+		Withdrawals:    s.Withdrawals,
+		Refunds:        s.Refunds,
+		ExpectedAmount: s.Expected,
+		CountedAmount:  s.Counted,
+		Difference:     s.Difference,
+		Result:         s.Result(),
+	}
+}
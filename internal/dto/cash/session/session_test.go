@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToCashSessionModel(t *testing.T) {
+	s := ToCashSessionModel(OpenCashSessionDTO{OpeningAmount: money.New(150), Notes: "  turno manhã "}, 5)
+
+	assert.Equal(t, int64(5), s.UserID)
+	assert.Equal(t, money.New(150), s.OpeningAmount)
+	assert.Equal(t, "turno manhã", s.Notes)
+}
+
+func TestToCashMovementModel(t *testing.T) {
+	user := int64(5)
+
+	m := ToCashMovementModel(CashMovementInputDTO{Type: " withdrawal ", Amount: money.New(30), Reason: " banco "}, 2, &user)
+
+	assert.Equal(t, int64(2), m.CashSessionID)
+	assert.Equal(t, models.MovementWithdrawal, m.Type)
+	assert.Equal(t, "banco", m.Reason)
+	assert.Equal(t, &user, m.UserID)
+}
+
+func TestToClosingModel(t *testing.T) {
+	var d CloseCashSessionDTO
+	require.NoError(t, json.Unmarshal([]byte(`{"counted_amount": 0, "notes": "gaveta vazia"}`), &d))
+
+	assert.NotNil(t, d.CountedAmount)
+	closing := ToClosingModel(d, nil)
+	assert.True(t, closing.CountedAmount.IsZero())
+	assert.Equal(t, "gaveta vazia", closing.Notes)
+}
+
+func TestToSummaryDTO(t *testing.T) {
+	now := time.Now()
+	session := &models.CashSession{ID: 1, UserID: 5, Status: models.StatusClosed, OpeningAmount: money.New(100), OpenedAt: now}
+	tenders := []*modelsPayment.MethodTotal{{Method: modelsPayment.MethodCash, Sales: 1, Amount: money.New(40)}}
+
+	summary := models.NewSummary(session, tenders, models.MovementTotals{Withdrawals: money.New(10)})
+	summary.Reconcile(money.New(131))
+	session.ExpectedAmount, session.CountedAmount, session.Difference = &summary.Expected, summary.Counted, summary.Difference
+
+	d := ToSummaryDTO(summary)
+
+	assert.Equal(t, money.New(130), d.ExpectedAmount)
+	assert.Equal(t, money.New(1), *d.Difference)
+	assert.Equal(t, models.ResultOver, d.Result)
+	assert.Equal(t, models.ResultOver, d.CashSession.Result)
+	assert.Len(t, d.Tenders, 1)
+
+	data, err := json.Marshal(ToCashSessionDTO(&models.CashSession{ID: 2, Status: models.StatusOpen}))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "difference")
+	assert.NotContains(t, string(data), "result")
+}
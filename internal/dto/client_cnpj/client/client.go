@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+)
+
+type ClientCnpjDTO struct {
+	ID                int64  `json:"id,omitempty"`
+	Name              string `json:"name"`
+	TradeName         string `json:"trade_name,omitempty"`
+	Email             string `json:"email"`
+	CNPJ              string `json:"cnpj"`
+	StateRegistration string `json:"state_registration,omitempty"`
+	Description       string `json:"description,omitempty"`
+	Version           int    `json:"version"`
+	Status            bool   `json:"status"`
+	CreatedAt         string `json:"created_at,omitempty"`
+	UpdatedAt         string `json:"updated_at,omitempty"`
+}
+
+func ToClientCnpjModel(dto ClientCnpjDTO) *models.ClientCnpj {
+	return &models.ClientCnpj{
+		ID:                dto.ID,
+		Name:              dto.Name,
+		TradeName:         dto.TradeName,
+		Email:             dto.Email,
+		CNPJ:              dto.CNPJ,
+		StateRegistration: dto.StateRegistration,
+		Description:       dto.Description,
+		Version:           dto.Version,
+		Status:            dto.Status,
+	}
+}
+
+func ToClientCnpjDTO(m *models.ClientCnpj) ClientCnpjDTO {
+	if m == nil {
+		return ClientCnpjDTO{}
+	}
+
+	return ClientCnpjDTO{
+		ID:                m.ID,
+		Name:              m.Name,
+		TradeName:         m.TradeName,
+		Email:             m.Email,
+		CNPJ:              m.CNPJ,
+		StateRegistration: m.StateRegistration,
+		Description:       m.Description,
+		Version:           m.Version,
+		Status:            m.Status,
+		CreatedAt:         m.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         m.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToClientCnpjDTOs(models []*models.ClientCnpj) []ClientCnpjDTO {
+	if len(models) == 0 {
+		return []ClientCnpjDTO{}
+	}
+
+	dtos := make([]ClientCnpjDTO, 0, len(models))
+	for _, m := range models {
+		if m != nil {
+			dtos = append(dtos, ToClientCnpjDTO(m))
+		}
+	}
+	return dtos
+}
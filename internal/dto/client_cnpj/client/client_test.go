@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToClientCnpjModel(t *testing.T) {
+	dto := ClientCnpjDTO{
+		ID:                1,
+		Name:              "Empresa LTDA",
+		TradeName:         "Empresa",
+		Email:             "contato@empresa.com",
+		CNPJ:              "11222333000181",
+		StateRegistration: "123456789",
+		Description:       "Atacadista",
+		Version:           2,
+		Status:            true,
+	}
+
+	m := ToClientCnpjModel(dto)
+
+	assert.Equal(t, dto.ID, m.ID)
+	assert.Equal(t, dto.TradeName, m.TradeName)
+	assert.Equal(t, dto.CNPJ, m.CNPJ)
+	assert.Equal(t, dto.StateRegistration, m.StateRegistration)
+	assert.Equal(t, dto.Version, m.Version)
+	assert.True(t, m.Status)
+}
+
+func TestToClientCnpjDTO(t *testing.T) {
+	t.Run("nil retorna DTO vazio", func(t *testing.T) {
+		assert.Equal(t, ClientCnpjDTO{}, ToClientCnpjDTO(nil))
+	})
+
+	t.Run("converte campos e datas", func(t *testing.T) {
+		now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		m := &models.ClientCnpj{ID: 1, Name: "Empresa LTDA", TradeName: "Empresa", CNPJ: "11222333000181", CreatedAt: now, UpdatedAt: now}
+
+		dto := ToClientCnpjDTO(m)
+
+		assert.Equal(t, "Empresa", dto.TradeName)
+		assert.Equal(t, now.Format(time.RFC3339), dto.CreatedAt)
+		assert.Equal(t, now.Format(time.RFC3339), dto.UpdatedAt)
+	})
+}
+
+func TestToClientCnpjDTOs(t *testing.T) {
+	assert.Empty(t, ToClientCnpjDTOs(nil))
+
+	dtos := ToClientCnpjDTOs([]*models.ClientCnpj{{ID: 1}, nil, {ID: 2}})
+
+	assert.Len(t, dtos, 2)
+	assert.Equal(t, int64(2), dtos[1].ID)
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/contact_relation"
+)
+
+type ClientCnpjContactRelationDTO struct {
+	ContactID    int64  `json:"contact_id"`
+	ClientCnpjID int64  `json:"client_cnpj_id"`
+	CreatedAt    string `json:"created_at,omitempty"`
+}
+
+func ToClientCnpjContactRelationModel(dto ClientCnpjContactRelationDTO) *models.ClientCnpjContactRelation {
+	return &models.ClientCnpjContactRelation{
+		ContactID:    dto.ContactID,
+		ClientCnpjID: dto.ClientCnpjID,
+		CreatedAt:    time.Now(),
+	}
+}
+
+func ToClientCnpjContactRelationDTO(m *models.ClientCnpjContactRelation) ClientCnpjContactRelationDTO {
+	if m == nil {
+		return ClientCnpjContactRelationDTO{}
+	}
+
+	return ClientCnpjContactRelationDTO{
+		ContactID:    m.ContactID,
+		ClientCnpjID: m.ClientCnpjID,
+		CreatedAt:    m.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToClientCnpjContactRelationDTOs(models []*models.ClientCnpjContactRelation) []ClientCnpjContactRelationDTO {
+	if len(models) == 0 {
+		return []ClientCnpjContactRelationDTO{}
+	}
+
+	dtos := make([]ClientCnpjContactRelationDTO, 0, len(models))
+	for _, m := range models {
+		if m != nil {
+			dtos = append(dtos, ToClientCnpjContactRelationDTO(m))
+		}
+	}
+	return dtos
+}
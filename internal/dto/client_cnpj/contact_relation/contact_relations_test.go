@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/contact_relation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientCnpjContactRelationDTO(t *testing.T) {
+	t.Run("para model", func(t *testing.T) {
+		m := ToClientCnpjContactRelationModel(ClientCnpjContactRelationDTO{ContactID: 2, ClientCnpjID: 1})
+
+		assert.Equal(t, int64(1), m.ClientCnpjID)
+		assert.Equal(t, int64(2), m.ContactID)
+		assert.False(t, m.CreatedAt.IsZero())
+	})
+
+	t.Run("para DTO", func(t *testing.T) {
+		now := time.Now()
+		dto := ToClientCnpjContactRelationDTO(&models.ClientCnpjContactRelation{ClientCnpjID: 1, ContactID: 2, CreatedAt: now})
+
+		assert.Equal(t, int64(1), dto.ClientCnpjID)
+		assert.Equal(t, now.Format(time.RFC3339), dto.CreatedAt)
+	})
+
+	t.Run("nil retorna DTO vazio", func(t *testing.T) {
+		assert.Equal(t, ClientCnpjContactRelationDTO{}, ToClientCnpjContactRelationDTO(nil))
+	})
+}
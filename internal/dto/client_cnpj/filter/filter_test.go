@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientCnpjFilterDTO_Validate(t *testing.T) {
+	t.Run("válido com CNPJ formatado", func(t *testing.T) {
+		d := &ClientCnpjFilterDTO{CNPJ: "11.222.333/0001-81", Limit: 10}
+
+		assert.NoError(t, d.Validate())
+	})
+
+	t.Run("sem filtros", func(t *testing.T) {
+		d := &ClientCnpjFilterDTO{Limit: 10}
+
+		assert.ErrorContains(t, d.Validate(), "pelo menos um filtro")
+	})
+
+	t.Run("erros acumulados", func(t *testing.T) {
+		from := time.Now()
+		to := from.Add(-time.Hour)
+		d := &ClientCnpjFilterDTO{
+			TradeName:   "ab",
+			CNPJ:        "123",
+			Limit:       0,
+			Offset:      -1,
+			SortBy:      "password",
+			SortOrder:   "up",
+			CreatedFrom: &from,
+			CreatedTo:   &to,
+		}
+
+		err := d.Validate()
+
+		assert.ErrorContains(t, err, "'trade_name'")
+		assert.ErrorContains(t, err, "'cnpj' inválido")
+		assert.ErrorContains(t, err, "'limit'")
+		assert.ErrorContains(t, err, "'offset'")
+		assert.ErrorContains(t, err, "'sort_by'")
+		assert.ErrorContains(t, err, "'sort_order'")
+		assert.ErrorContains(t, err, "'created_from'")
+	})
+}
+
+func TestClientCnpjFilterDTO_ToModel(t *testing.T) {
+	status := true
+	d := &ClientCnpjFilterDTO{Name: "Empresa", TradeName: "Loja", Status: &status, Limit: 5, Offset: 10, SortBy: "name", SortOrder: "desc"}
+
+	m, err := d.ToModel()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Loja", m.TradeName)
+	assert.Equal(t, 5, m.Limit)
+	assert.Equal(t, 10, m.Offset)
+	assert.Equal(t, "desc", m.SortOrder)
+	assert.True(t, *m.Status)
+}
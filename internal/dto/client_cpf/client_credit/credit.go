@@ -2,15 +2,16 @@ package dto
 
 import (
 	client_credit "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 )
 
 type ClientCreditDTO struct {
-	ID            *int64  `json:"id,omitempty"`
-	ClientID      int64   `json:"client_id"`
-	AllowCredit   bool    `json:"allow_credit"`
-	CreditLimit   float64 `json:"credit_limit"`
-	CreditBalance float64 `json:"credit_balance"`
+	ID            *int64      `json:"id,omitempty"`
+	ClientID      int64       `json:"client_id"`
+	AllowCredit   bool        `json:"allow_credit"`
+	CreditLimit   money.Money `json:"credit_limit"`
+	CreditBalance money.Money `json:"credit_balance"`
 }
 
 func ToClientCreditModel(dto ClientCreditDTO) *client_credit.ClientCredit {
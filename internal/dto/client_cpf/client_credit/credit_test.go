@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	client_credit "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 )
 
 func TestToClientCreditModel(t *testing.T) {
@@ -14,8 +15,8 @@ func TestToClientCreditModel(t *testing.T) {
 		ID:            &id,
 		ClientID:      42,
 		AllowCredit:   true,
-		CreditLimit:   1000.00,
-		CreditBalance: 500.00,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
 	model := ToClientCreditModel(dtoInput)
@@ -23,8 +24,8 @@ func TestToClientCreditModel(t *testing.T) {
 	assert.Equal(t, int64(1), model.ID)
 	assert.Equal(t, int64(42), model.ClientID)
 	assert.True(t, model.AllowCredit)
-	assert.Equal(t, 1000.00, model.CreditLimit)
-	assert.Equal(t, 500.00, model.CreditBalance)
+	assert.Equal(t, money.New(1000), model.CreditLimit)
+	assert.Equal(t, money.New(500), model.CreditBalance)
 }
 
 func TestToClientCreditDTO(t *testing.T) {
@@ -32,8 +33,8 @@ func TestToClientCreditDTO(t *testing.T) {
 		ID:            1,
 		ClientID:      42,
 		AllowCredit:   true,
-		CreditLimit:   2000.00,
-		CreditBalance: 1500.00,
+		CreditLimit:   money.New(2000),
+		CreditBalance: money.New(1500),
 	}
 
 	dtoOutput := ToClientCreditDTO(modelInput)
@@ -42,8 +43,8 @@ func TestToClientCreditDTO(t *testing.T) {
 	assert.Equal(t, int64(1), *dtoOutput.ID)
 	assert.Equal(t, int64(42), dtoOutput.ClientID)
 	assert.True(t, dtoOutput.AllowCredit)
-	assert.Equal(t, 2000.00, dtoOutput.CreditLimit)
-	assert.Equal(t, 1500.00, dtoOutput.CreditBalance)
+	assert.Equal(t, money.New(2000), dtoOutput.CreditLimit)
+	assert.Equal(t, money.New(1500), dtoOutput.CreditBalance)
 }
 
 func TestToClientCreditDTO_NilInput(t *testing.T) {
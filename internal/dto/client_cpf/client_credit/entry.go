@@ -0,0 +1,76 @@
+package dto
+
+import (
+	"time"
+
+	client_credit "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+type CreditEntryDTO struct {
+	ID           *int64      `json:"id,omitempty"`
+	ClientID     int64       `json:"client_id,omitempty"`
+	EntryType    string      `json:"entry_type,omitempty"`
+	Amount       money.Money `json:"amount"`
+	BalanceAfter money.Money `json:"balance_after,omitempty"`
+	SaleID       *int64      `json:"sale_id,omitempty"`
+	UserID       *int64      `json:"user_id,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	CreatedAt    *string     `json:"created_at,omitempty"`
+}
+
+type CreditStatementDTO struct {
+	Credit  ClientCreditDTO  `json:"credit"`
+	Entries []CreditEntryDTO `json:"entries"`
+}
+
+// ToCreditEntryModel converte a requisição de lançamento manual. Tipo, saldo
+// e venda vinculada são definidos pelo servidor.
+func ToCreditEntryModel(dto CreditEntryDTO) *client_credit.CreditEntry {
+	return &client_credit.CreditEntry{
+		ClientID:    dto.ClientID,
+		Amount:      dto.Amount,
+		UserID:      dto.UserID,
+		Description: dto.Description,
+	}
+}
+
+func ToCreditEntryDTO(m *client_credit.CreditEntry) CreditEntryDTO {
+	if m == nil {
+		return CreditEntryDTO{}
+	}
+
+	dto := CreditEntryDTO{
+		ID:           &m.ID,
+		ClientID:     m.ClientID,
+		EntryType:    m.EntryType,
+		Amount:       m.Amount,
+		BalanceAfter: m.BalanceAfter,
+		SaleID:       m.SaleID,
+		UserID:       m.UserID,
+		Description:  m.Description,
+	}
+
+	if !m.CreatedAt.IsZero() {
+		v := m.CreatedAt.Format(time.RFC3339)
+		dto.CreatedAt = &v
+	}
+
+	return dto
+}
+
+func ToCreditStatementDTO(m *client_credit.CreditStatement) CreditStatementDTO {
+	if m == nil {
+		return CreditStatementDTO{Entries: []CreditEntryDTO{}}
+	}
+
+	entries := make([]CreditEntryDTO, len(m.Entries))
+	for i, e := range m.Entries {
+		entries[i] = ToCreditEntryDTO(e)
+	}
+
+	return CreditStatementDTO{
+		Credit:  ToClientCreditDTO(m.Credit),
+		Entries: entries,
+	}
+}
@@ -0,0 +1,96 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	client_credit "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+func TestToCreditEntryModel(t *testing.T) {
+	userID := int64(3)
+	saleID := int64(9)
+	dtoInput := CreditEntryDTO{
+		ClientID:     7,
+		EntryType:    client_credit.EntryRefund,
+		Amount:       money.MustParse("25.50"),
+		BalanceAfter: money.New(99),
+		SaleID:       &saleID,
+		UserID:       &userID,
+		Description:  "pagamento em dinheiro",
+	}
+
+	model := ToCreditEntryModel(dtoInput)
+
+	assert.Equal(t, int64(7), model.ClientID)
+	assert.Equal(t, money.MustParse("25.50"), model.Amount)
+	assert.Equal(t, &userID, model.UserID)
+	assert.Equal(t, "pagamento em dinheiro", model.Description)
+	assert.Empty(t, model.EntryType)
+	assert.Zero(t, model.BalanceAfter)
+	assert.Nil(t, model.SaleID)
+}
+
+func TestToCreditEntryDTO(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		assert.Equal(t, CreditEntryDTO{}, ToCreditEntryDTO(nil))
+	})
+
+	t.Run("com data de criação", func(t *testing.T) {
+		saleID := int64(9)
+		createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		model := &client_credit.CreditEntry{
+			ID:           1,
+			ClientID:     7,
+			EntryType:    client_credit.EntryCharge,
+			Amount:       money.New(50),
+			BalanceAfter: money.New(80),
+			SaleID:       &saleID,
+			Description:  "venda 9",
+			CreatedAt:    createdAt,
+		}
+
+		dto := ToCreditEntryDTO(model)
+
+		assert.Equal(t, int64(1), *dto.ID)
+		assert.Equal(t, client_credit.EntryCharge, dto.EntryType)
+		assert.Equal(t, money.New(80), dto.BalanceAfter)
+		assert.Equal(t, &saleID, dto.SaleID)
+		assert.Equal(t, "2025-01-02T03:04:05Z", *dto.CreatedAt)
+	})
+
+	t.Run("sem data de criação", func(t *testing.T) {
+		dto := ToCreditEntryDTO(&client_credit.CreditEntry{ID: 1})
+
+		assert.Nil(t, dto.CreatedAt)
+	})
+}
+
+func TestToCreditStatementDTO(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		dto := ToCreditStatementDTO(nil)
+
+		assert.NotNil(t, dto.Entries)
+		assert.Empty(t, dto.Entries)
+	})
+
+	t.Run("conta e lançamentos", func(t *testing.T) {
+		model := &client_credit.CreditStatement{
+			Credit: &client_credit.ClientCredit{ID: 1, ClientID: 7, CreditBalance: money.New(30)},
+			Entries: []*client_credit.CreditEntry{
+				{ID: 1, EntryType: client_credit.EntryCharge, Amount: money.New(50), BalanceAfter: money.New(50)},
+				{ID: 2, EntryType: client_credit.EntryPayment, Amount: money.New(20), BalanceAfter: money.New(30)},
+			},
+		}
+
+		dto := ToCreditStatementDTO(model)
+
+		assert.Equal(t, int64(7), dto.Credit.ClientID)
+		assert.Equal(t, money.New(30), dto.Credit.CreditBalance)
+		assert.Len(t, dto.Entries, 2)
+		assert.Equal(t, money.New(30), dto.Entries[1].BalanceAfter)
+	})
+}
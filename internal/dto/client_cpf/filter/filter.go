@@ -22,6 +22,9 @@ type ClientFilterDTO struct {
 	UpdatedTo   *time.Time `schema:"updated_to"`
 	Limit       int        `schema:"limit"`
 	Offset      int        `schema:"offset"`
+	Cursor      string     `schema:"cursor"`
+	CursorMode  bool       `schema:"-"`
+	SearchTerm  string     `schema:"search"`
 	SortBy      string     `schema:"sort_by"`
 	SortOrder   string     `schema:"sort_order"`
 }
@@ -29,10 +32,13 @@ type ClientFilterDTO struct {
 func (d *ClientFilterDTO) ToModel() (*filterClient.ClientCpfFilter, error) {
 	filter := &filterClient.ClientCpfFilter{
 		BaseFilter: commonFilter.BaseFilter{
-			Limit:     d.Limit,
-			Offset:    d.Offset,
-			SortBy:    d.SortBy,
-			SortOrder: d.SortOrder,
+			Limit:      d.Limit,
+			Offset:     d.Offset,
+			CursorMode: d.CursorMode,
+			Cursor:     d.Cursor,
+			SearchTerm: d.SearchTerm,
+			SortBy:     d.SortBy,
+			SortOrder:  d.SortOrder,
 		},
 		Name:        d.Name,
 		Email:       d.Email,
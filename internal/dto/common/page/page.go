@@ -0,0 +1,31 @@
+package dto
+
+import (
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+)
+
+// PageDTO é o envelope das respostas dos endpoints /filter.
+type PageDTO[T any] struct {
+	Items      []T    `json:"items"`
+	Total      int64  `json:"total"`
+	Limit      int    `json:"limit"`
+	Offset     int    `json:"offset"`
+	HasNext    bool   `json:"has_next"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// ToPageDTO combina os metadados da página com os itens já convertidos.
+func ToPageDTO[M any, D any](page *filter.Page[M], items []D) PageDTO[D] {
+	if items == nil {
+		items = make([]D, 0)
+	}
+
+	return PageDTO[D]{
+		Items:      items,
+		Total:      page.Total,
+		Limit:      page.Limit,
+		Offset:     page.Offset,
+		HasNext:    page.HasNext,
+		NextCursor: page.NextCursor,
+	}
+}
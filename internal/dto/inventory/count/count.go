@@ -0,0 +1,179 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+type OpenInventoryCountDTO struct {
+	CategoryID *int64 `json:"category_id,omitempty"`
+	Notes      string `json:"notes,omitempty"`
+}
+
+// CountEntryDTO é uma leitura: informe product_id ou barcode. Sem quantity a
+// leitura vale 1, como um bipe do leitor de código de barras.
+type CountEntryDTO struct {
+	ProductID *int64 `json:"product_id,omitempty"`
+	Barcode   string `json:"barcode,omitempty"`
+	Quantity  *int   `json:"quantity,omitempty"`
+	Recount   bool   `json:"recount,omitempty"`
+}
+
+type RecordCountDTO struct {
+	Items []CountEntryDTO `json:"items"`
+}
+
+type CountItemDTO struct {
+	ProductID       int64     `json:"product_id"`
+	ProductName     string    `json:"product_name,omitempty"`
+	Barcode         string    `json:"barcode,omitempty"`
+	CountedQuantity int       `json:"counted_quantity"`
+	Entries         int       `json:"entries"`
+	CountedBy       *int64    `json:"counted_by,omitempty"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+type InventoryCountDTO struct {
+	ID               int64          `json:"id"`
+	CategoryID       *int64         `json:"category_id,omitempty"`
+	Status           string         `json:"status"`
+	Notes            string         `json:"notes,omitempty"`
+	OpenedBy         *int64         `json:"opened_by,omitempty"`
+	ClosedBy         *int64         `json:"closed_by,omitempty"`
+	AdjustedProducts int            `json:"adjusted_products"`
+	Items            []CountItemDTO `json:"items,omitempty"`
+	Version          int            `json:"version"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	ClosedAt         *time.Time     `json:"closed_at,omitempty"`
+}
+
+type VarianceLineDTO struct {
+	ProductID       int64       `json:"product_id"`
+	ProductName     string      `json:"product_name"`
+	Barcode         string      `json:"barcode,omitempty"`
+	SystemQuantity  int         `json:"system_quantity"`
+	CountedQuantity *int        `json:"counted_quantity"`
+	Variance        int         `json:"variance"`
+	VarianceCost    money.Money `json:"variance_cost"`
+}
+
+type VarianceReportDTO struct {
+	InventoryCountID int64             `json:"inventory_count_id"`
+	Counted          int               `json:"counted"`
+	Uncounted        int               `json:"uncounted"`
+	Adjustments      int               `json:"adjustments"`
+	NetVariance      int               `json:"net_variance"`
+	NetVarianceCost  money.Money       `json:"net_variance_cost"`
+	Lines            []VarianceLineDTO `json:"lines"`
+}
+
+func ToInventoryCountModel(dto OpenInventoryCountDTO, openedBy *int64) *models.InventoryCount {
+	return &models.InventoryCount{
+		CategoryID: dto.CategoryID,
+		Notes:      strings.TrimSpace(dto.Notes),
+		OpenedBy:   openedBy,
+	}
+}
+
+// ToCountEntries converte o lote de leituras, atribuindo todas a countedBy.
+func ToCountEntries(dto RecordCountDTO, countedBy *int64) []models.CountEntry {
+	entries := make([]models.CountEntry, len(dto.Items))
+	for i, it := range dto.Items {
+		quantity := 1
+		if it.Quantity != nil {
+			quantity = *it.Quantity
+		}
+
+		entries[i] = models.CountEntry{
+			ProductID: it.ProductID,
+			Barcode:   strings.TrimSpace(it.Barcode),
+			Quantity:  quantity,
+			Recount:   it.Recount,
+			CountedBy: countedBy,
+		}
+	}
+	return entries
+}
+
+func ToCountItemDTO(item *models.CountItem) CountItemDTO {
+	return CountItemDTO{
+		ProductID:       item.ProductID,
+		ProductName:     item.ProductName,
+		Barcode:         item.Barcode,
+		CountedQuantity: item.CountedQuantity,
+		Entries:         item.Entries,
+		CountedBy:       item.CountedBy,
+		UpdatedAt:       item.UpdatedAt,
+	}
+}
+
+func ToCountItemDTOs(items []*models.CountItem) []CountItemDTO {
+	dtos := make([]CountItemDTO, 0, len(items))
+	for _, item := range items {
+		if item != nil {
+			dtos = append(dtos, ToCountItemDTO(item))
+		}
+	}
+	return dtos
+}
+
+func ToInventoryCountDTO(c *models.InventoryCount) InventoryCountDTO {
+	dto := InventoryCountDTO{
+		ID:               c.ID,
+		CategoryID:       c.CategoryID,
+		Status:           c.Status,
+		Notes:            c.Notes,
+		OpenedBy:         c.OpenedBy,
+		ClosedBy:         c.ClosedBy,
+		AdjustedProducts: c.AdjustedProducts,
+		Version:          c.Version,
+		CreatedAt:        c.CreatedAt,
+		UpdatedAt:        c.UpdatedAt,
+		ClosedAt:         c.ClosedAt,
+	}
+
+	for i := range c.Items {
+		dto.Items = append(dto.Items, ToCountItemDTO(&c.Items[i]))
+	}
+
+	return dto
+}
+
+func ToInventoryCountDTOs(counts []*models.InventoryCount) []InventoryCountDTO {
+	dtos := make([]InventoryCountDTO, 0, len(counts))
+	for _, c := range counts {
+		if c != nil {
+			dtos = append(dtos, ToInventoryCountDTO(c))
+		}
+	}
+	return dtos
+}
+
+func ToVarianceReportDTO(r *models.VarianceReport) VarianceReportDTO {
+	lines := make([]VarianceLineDTO, 0, len(r.Lines))
+	for _, l := range r.Lines {
+		lines = append(lines, VarianceLineDTO{
+			ProductID:       l.ProductID,
+			ProductName:     l.ProductName,
+			Barcode:         l.Barcode,
+			SystemQuantity:  l.SystemQuantity,
+			CountedQuantity: l.CountedQuantity,
+			Variance:        l.Variance(),
+			VarianceCost:    l.VarianceCost(),
+		})
+	}
+
+	return VarianceReportDTO{
+		InventoryCountID: r.InventoryCountID,
+		Counted:          r.Counted,
+		Uncounted:        r.Uncounted,
+		Adjustments:      r.Adjustments,
+		NetVariance:      r.NetVariance,
+		NetVarianceCost:  r.NetVarianceCost,
+		Lines:            lines,
+	}
+}
@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToInventoryCountModel(t *testing.T) {
+	category := int64(3)
+	user := int64(5)
+
+	m := ToInventoryCountModel(OpenInventoryCountDTO{CategoryID: &category, Notes: "  setor A "}, &user)
+
+	assert.Equal(t, &category, m.CategoryID)
+	assert.Equal(t, "setor A", m.Notes)
+	assert.Equal(t, &user, m.OpenedBy)
+}
+
+func TestToCountEntries(t *testing.T) {
+	id := int64(7)
+	zero := 0
+	user := int64(5)
+
+	entries := ToCountEntries(RecordCountDTO{Items: []CountEntryDTO{
+		{Barcode: " 789 "},
+		{ProductID: &id, Quantity: &zero, Recount: true},
+	}}, &user)
+
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "789", entries[0].Barcode)
+	assert.Equal(t, 1, entries[0].Quantity)
+	assert.Equal(t, 0, entries[1].Quantity)
+	assert.True(t, entries[1].Recount)
+	assert.Equal(t, &user, entries[1].CountedBy)
+}
+
+func TestToInventoryCountDTO(t *testing.T) {
+	now := time.Now()
+	dto := ToInventoryCountDTO(&models.InventoryCount{
+		ID:     1,
+		Status: models.StatusOpen,
+		Items:  []models.CountItem{{ProductID: 7, CountedQuantity: 3, Entries: 2, UpdatedAt: now}},
+	})
+
+	assert.Equal(t, int64(1), dto.ID)
+	assert.Len(t, dto.Items, 1)
+	assert.Equal(t, 2, dto.Items[0].Entries)
+
+	assert.Len(t, ToInventoryCountDTOs([]*models.InventoryCount{{ID: 1}, nil}), 1)
+	assert.Len(t, ToCountItemDTOs([]*models.CountItem{{ProductID: 1}, nil}), 1)
+}
+
+func TestToVarianceReportDTO(t *testing.T) {
+	counted := 8
+	report := models.NewVarianceReport(1, []*models.VarianceLine{
+		{ProductID: 7, SystemQuantity: 10, CountedQuantity: &counted, CostPrice: money.New(2)},
+		{ProductID: 8, SystemQuantity: 3},
+	})
+
+	dto := ToVarianceReportDTO(report)
+
+	assert.Len(t, dto.Lines, 2)
+	assert.Equal(t, -2, dto.Lines[0].Variance)
+	assert.Equal(t, money.New(-4), dto.Lines[0].VarianceCost)
+	assert.Nil(t, dto.Lines[1].CountedQuantity)
+	assert.Equal(t, 1, dto.Uncounted)
+}
@@ -0,0 +1,98 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+)
+
+type LocationDTO struct {
+	ID        *int64    `json:"id,omitempty"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Kind      string    `json:"kind"`
+	IsDefault bool      `json:"is_default"`
+	Version   int       `json:"version,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+type LocationStockDTO struct {
+	LocationID int64  `json:"location_id"`
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	IsDefault  bool   `json:"is_default"`
+	Quantity   int    `json:"quantity"`
+}
+
+// ProductStockDTO mantém stock_quantity como o total do produto, como antes
+// da divisão por local.
+type ProductStockDTO struct {
+	ProductID     int64              `json:"product_id"`
+	StockQuantity int                `json:"stock_quantity"`
+	InTransit     int                `json:"in_transit"`
+	Locations     []LocationStockDTO `json:"locations"`
+}
+
+// ToLocationModel converte o DTO. is_default é ignorado: o local padrão só
+// é definido pela migração.
+func ToLocationModel(dto LocationDTO) *models.Location {
+	l := &models.Location{
+		Code:    strings.ToUpper(strings.TrimSpace(dto.Code)),
+		Name:    strings.TrimSpace(dto.Name),
+		Kind:    strings.TrimSpace(dto.Kind),
+		Version: dto.Version,
+	}
+	if l.Kind == "" {
+		l.Kind = models.KindStore
+	}
+	if dto.ID != nil {
+		l.ID = *dto.ID
+	}
+	return l
+}
+
+func ToLocationDTO(l *models.Location) LocationDTO {
+	return LocationDTO{
+		ID:        &l.ID,
+		Code:      l.Code,
+		Name:      l.Name,
+		Kind:      l.Kind,
+		IsDefault: l.IsDefault,
+		Version:   l.Version,
+		CreatedAt: l.CreatedAt,
+		UpdatedAt: l.UpdatedAt,
+	}
+}
+
+func ToLocationDTOs(locations []*models.Location) []LocationDTO {
+	dtos := make([]LocationDTO, 0, len(locations))
+	for _, l := range locations {
+		if l != nil {
+			dtos = append(dtos, ToLocationDTO(l))
+		}
+	}
+	return dtos
+}
+
+func ToProductStockDTO(s *models.ProductStock) ProductStockDTO {
+	dto := ProductStockDTO{
+		ProductID:     s.ProductID,
+		StockQuantity: s.Total,
+		InTransit:     s.InTransit,
+		Locations:     make([]LocationStockDTO, 0, len(s.Locations)),
+	}
+
+	for _, l := range s.Locations {
+		dto.Locations = append(dto.Locations, LocationStockDTO{
+			LocationID: l.LocationID,
+			Code:       l.Code,
+			Name:       l.Name,
+			IsDefault:  l.IsDefault,
+			Quantity:   l.Quantity,
+		})
+	}
+
+	return dto
+}
@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToLocationModel(t *testing.T) {
+	id := int64(3)
+
+	l := ToLocationModel(LocationDTO{ID: &id, Code: " lj01 ", Name: " Loja Centro ", IsDefault: true, Version: 2})
+
+	assert.Equal(t, int64(3), l.ID)
+	assert.Equal(t, "LJ01", l.Code)
+	assert.Equal(t, "Loja Centro", l.Name)
+	assert.Equal(t, models.KindStore, l.Kind)
+	assert.False(t, l.IsDefault)
+	assert.Equal(t, 2, l.Version)
+}
+
+func TestToLocationDTOs(t *testing.T) {
+	dtos := ToLocationDTOs([]*models.Location{{ID: 1, Code: "DEP", Kind: models.KindWarehouse}, nil})
+
+	assert.Len(t, dtos, 1)
+	assert.Equal(t, int64(1), *dtos[0].ID)
+	assert.Equal(t, models.KindWarehouse, dtos[0].Kind)
+}
+
+func TestToProductStockDTO(t *testing.T) {
+	dto := ToProductStockDTO(&models.ProductStock{
+		ProductID: 7,
+		Total:     12,
+		InTransit: 2,
+		Locations: []models.LocationStock{{LocationID: 1, Code: "PRINCIPAL", IsDefault: true, Quantity: 10}},
+	})
+
+	assert.Equal(t, int64(7), dto.ProductID)
+	assert.Equal(t, 12, dto.StockQuantity)
+	assert.Equal(t, 2, dto.InTransit)
+	assert.Equal(t, 10, dto.Locations[0].Quantity)
+}
@@ -0,0 +1,85 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+)
+
+type TransferItemDTO struct {
+	ProductID int64 `json:"product_id"`
+	Quantity  int   `json:"quantity"`
+}
+
+type CreateTransferDTO struct {
+	FromLocationID int64             `json:"from_location_id"`
+	ToLocationID   int64             `json:"to_location_id"`
+	Notes          string            `json:"notes,omitempty"`
+	Items          []TransferItemDTO `json:"items"`
+}
+
+type TransferDTO struct {
+	ID             int64             `json:"id"`
+	FromLocationID int64             `json:"from_location_id"`
+	ToLocationID   int64             `json:"to_location_id"`
+	Status         string            `json:"status"`
+	Notes          string            `json:"notes,omitempty"`
+	CreatedBy      *int64            `json:"created_by,omitempty"`
+	ReceivedBy     *int64            `json:"received_by,omitempty"`
+	Items          []TransferItemDTO `json:"items,omitempty"`
+	Version        int               `json:"version"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+	ReceivedAt     *time.Time        `json:"received_at,omitempty"`
+}
+
+func ToTransferModel(dto CreateTransferDTO, createdBy *int64) *models.Transfer {
+	items := make([]models.TransferItem, len(dto.Items))
+	for i, it := range dto.Items {
+		items[i] = models.TransferItem{
+			ProductID: it.ProductID,
+			Quantity:  it.Quantity,
+		}
+	}
+
+	return &models.Transfer{
+		FromLocationID: dto.FromLocationID,
+		ToLocationID:   dto.ToLocationID,
+		Notes:          strings.TrimSpace(dto.Notes),
+		CreatedBy:      createdBy,
+		Items:          items,
+	}
+}
+
+func ToTransferDTO(t *models.Transfer) TransferDTO {
+	dto := TransferDTO{
+		ID:             t.ID,
+		FromLocationID: t.FromLocationID,
+		ToLocationID:   t.ToLocationID,
+		Status:         t.Status,
+		Notes:          t.Notes,
+		CreatedBy:      t.CreatedBy,
+		ReceivedBy:     t.ReceivedBy,
+		Version:        t.Version,
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+		ReceivedAt:     t.ReceivedAt,
+	}
+
+	for _, it := range t.Items {
+		dto.Items = append(dto.Items, TransferItemDTO{ProductID: it.ProductID, Quantity: it.Quantity})
+	}
+
+	return dto
+}
+
+func ToTransferDTOs(transfers []*models.Transfer) []TransferDTO {
+	dtos := make([]TransferDTO, 0, len(transfers))
+	for _, t := range transfers {
+		if t != nil {
+			dtos = append(dtos, ToTransferDTO(t))
+		}
+	}
+	return dtos
+}
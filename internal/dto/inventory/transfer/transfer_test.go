@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToTransferModel(t *testing.T) {
+	user := int64(5)
+	dto := CreateTransferDTO{
+		FromLocationID: 1,
+		ToLocationID:   2,
+		Notes:          "  reposição ",
+		Items:          []TransferItemDTO{{ProductID: 7, Quantity: 4}},
+	}
+
+	transfer := ToTransferModel(dto, &user)
+
+	assert.Equal(t, int64(1), transfer.FromLocationID)
+	assert.Equal(t, int64(2), transfer.ToLocationID)
+	assert.Equal(t, "reposição", transfer.Notes)
+	assert.Equal(t, &user, transfer.CreatedBy)
+	assert.Equal(t, []models.TransferItem{{ProductID: 7, Quantity: 4}}, transfer.Items)
+}
+
+func TestToTransferDTOs(t *testing.T) {
+	transfers := []*models.Transfer{
+		{ID: 1, Status: models.StatusInTransit, Items: []models.TransferItem{{ProductID: 7, Quantity: 4}}},
+		nil,
+	}
+
+	dtos := ToTransferDTOs(transfers)
+
+	assert.Len(t, dtos, 1)
+	assert.Equal(t, models.StatusInTransit, dtos[0].Status)
+	assert.Equal(t, []TransferItemDTO{{ProductID: 7, Quantity: 4}}, dtos[0].Items)
+}
@@ -18,12 +18,22 @@ func (dto *LoginCredentialsDTO) ToModel() *models.LoginCredential {
 	}
 }
 
+type RefreshTokenDTO struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// UnlockDTO identifica a conta e/ou o IP a desbloquear.
+type UnlockDTO struct {
+	Email string `json:"email"`
+	IP    string `json:"ip"`
+}
+
 // --- Saída (response) ---
 type AuthResponseDTO struct {
-	AccessToken string `json:"access_token"`
-	//RefreshToken string `json:"refresh_token,omitempty"`
-	ExpiresIn int64  `json:"expires_in"` // em segundos
-	TokenType string `json:"token_type"` // geralmente "Bearer"
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	ExpiresIn    int64  `json:"expires_in"` // em segundos
+	TokenType    string `json:"token_type"` // geralmente "Bearer"
 }
 
 func ToAuthResponseDTO(m *models.AuthResponse) *AuthResponseDTO {
@@ -31,9 +41,9 @@ func ToAuthResponseDTO(m *models.AuthResponse) *AuthResponseDTO {
 		return nil
 	}
 	return &AuthResponseDTO{
-		AccessToken: m.AccessToken,
-		//RefreshToken: m.RefreshToken,
-		ExpiresIn: m.ExpiresIn,
-		TokenType: m.TokenType,
+		AccessToken:  m.AccessToken,
+		RefreshToken: m.RefreshToken,
+		ExpiresIn:    m.ExpiresIn,
+		TokenType:    m.TokenType,
 	}
 }
@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/login"
+)
+
+// SessionDTO expõe a sessão sem o hash do refresh token.
+type SessionDTO struct {
+	ID         string `json:"id"`
+	Device     string `json:"device"`
+	IP         string `json:"ip"`
+	CreatedAt  string `json:"created_at"`
+	LastSeenAt string `json:"last_seen_at"`
+	ExpiresAt  string `json:"expires_at"`
+}
+
+func ToSessionDTO(m *models.Session) SessionDTO {
+	if m == nil {
+		return SessionDTO{}
+	}
+
+	return SessionDTO{
+		ID:         m.ID,
+		Device:     m.Device,
+		IP:         m.IP,
+		CreatedAt:  m.CreatedAt.Format(time.RFC3339),
+		LastSeenAt: m.LastSeenAt.Format(time.RFC3339),
+		ExpiresAt:  m.ExpiresAt.Format(time.RFC3339),
+	}
+}
+
+func ToSessionDTOs(sessions []*models.Session) []SessionDTO {
+	dtos := make([]SessionDTO, 0, len(sessions))
+	for _, s := range sessions {
+		dtos = append(dtos, ToSessionDTO(s))
+	}
+	return dtos
+}
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/login"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToSessionDTO(t *testing.T) {
+	now := time.Now()
+	m := &models.Session{
+		ID: "sess-1", UserID: 7, RefreshHash: "segredo", Device: "android", IP: "10.0.0.1",
+		CreatedAt: now, LastSeenAt: now, ExpiresAt: now.Add(time.Hour),
+	}
+
+	dto := ToSessionDTO(m)
+
+	assert.Equal(t, "sess-1", dto.ID)
+	assert.Equal(t, "android", dto.Device)
+	assert.Equal(t, now.Add(time.Hour).Format(time.RFC3339), dto.ExpiresAt)
+
+	body, _ := json.Marshal(dto)
+	assert.NotContains(t, string(body), "segredo")
+
+	assert.Equal(t, SessionDTO{}, ToSessionDTO(nil))
+	assert.Len(t, ToSessionDTOs([]*models.Session{m, m}), 2)
+	assert.NotNil(t, ToSessionDTOs(nil))
+}
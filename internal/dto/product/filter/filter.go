@@ -6,6 +6,7 @@ import (
 
 	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 	modelProduct "github.com/WagaoCarvalho/backend_store_go/internal/model/product/filter"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 )
 
 type ProductFilterDTO struct {
@@ -30,6 +31,9 @@ type ProductFilterDTO struct {
 	UpdatedTo          *string `schema:"updated_to"`
 	Limit              int     `schema:"limit"`
 	Offset             int     `schema:"offset"`
+	Cursor             string  `schema:"cursor"`
+	CursorMode         bool    `schema:"-"`
+	SearchTerm         string  `schema:"search"`
 }
 
 func (d *ProductFilterDTO) ToModel() (*modelProduct.ProductFilter, error) {
@@ -55,6 +59,17 @@ func (d *ProductFilterDTO) ToModel() (*modelProduct.ProductFilter, error) {
 		return &f
 	}
 
+	parseMoney := func(s *string) *money.Money {
+		if s == nil || *s == "" {
+			return nil
+		}
+		m, err := money.Parse(*s)
+		if err != nil {
+			return nil
+		}
+		return &m
+	}
+
 	parseInt := func(s *string) *int {
 		if s == nil || *s == "" {
 			return nil
@@ -68,8 +83,11 @@ func (d *ProductFilterDTO) ToModel() (*modelProduct.ProductFilter, error) {
 
 	filter := &modelProduct.ProductFilter{
 		BaseFilter: modelFilter.BaseFilter{
-			Limit:  d.Limit,
-			Offset: d.Offset,
+			Limit:      d.Limit,
+			Offset:     d.Offset,
+			CursorMode: d.CursorMode,
+			Cursor:     d.Cursor,
+			SearchTerm: d.SearchTerm,
 		},
 		ProductName:        d.ProductName,
 		Manufacturer:       d.Manufacturer,
@@ -77,10 +95,10 @@ func (d *ProductFilterDTO) ToModel() (*modelProduct.ProductFilter, error) {
 		Status:             d.Status,
 		SupplierID:         d.SupplierID,
 		Version:            d.Version,
-		MinCostPrice:       parseFloat(d.MinCostPrice),
-		MaxCostPrice:       parseFloat(d.MaxCostPrice),
-		MinSalePrice:       parseFloat(d.MinSalePrice),
-		MaxSalePrice:       parseFloat(d.MaxSalePrice),
+		MinCostPrice:       parseMoney(d.MinCostPrice),
+		MaxCostPrice:       parseMoney(d.MaxCostPrice),
+		MinSalePrice:       parseMoney(d.MinSalePrice),
+		MaxSalePrice:       parseMoney(d.MaxSalePrice),
 		MinStockQuantity:   parseInt(d.MinStockQuantity),
 		MaxStockQuantity:   parseInt(d.MaxStockQuantity),
 		AllowDiscount:      d.AllowDiscount,
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -60,10 +61,10 @@ func TestProductFilterDTO_ToModel(t *testing.T) {
 		assert.NotNil(t, model.SupplierID)
 		assert.Equal(t, int64(15), *model.SupplierID)
 		assert.Equal(t, version, *model.Version)
-		assert.Equal(t, float64(10.5), *model.MinCostPrice)
-		assert.Equal(t, float64(25.7), *model.MaxCostPrice)
-		assert.Equal(t, float64(12.0), *model.MinSalePrice)
-		assert.Equal(t, float64(30.0), *model.MaxSalePrice)
+		assert.Equal(t, money.MustParse("10.50"), *model.MinCostPrice)
+		assert.Equal(t, money.MustParse("25.70"), *model.MaxCostPrice)
+		assert.Equal(t, money.New(12), *model.MinSalePrice)
+		assert.Equal(t, money.New(30), *model.MaxSalePrice)
 		assert.Equal(t, 5, *model.MinStockQuantity)
 		assert.Equal(t, 50, *model.MaxStockQuantity)
 		assert.Equal(t, float64(1.5), *model.MinDiscountPercent)
@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+type ProductPriceDTO struct {
+	ID            int64       `json:"id"`
+	ProductID     int64       `json:"product_id"`
+	CostPrice     money.Money `json:"cost_price"`
+	SalePrice     money.Money `json:"sale_price"`
+	EffectiveFrom time.Time   `json:"effective_from"`
+	Source        string      `json:"source"`
+	RefID         *int64      `json:"ref_id,omitempty"`
+	ChangedBy     *int64      `json:"changed_by,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+type EffectivePriceDTO struct {
+	ProductID int64       `json:"product_id"`
+	At        time.Time   `json:"at"`
+	CostPrice money.Money `json:"cost_price"`
+	CostFrom  time.Time   `json:"cost_price_from"`
+	SalePrice money.Money `json:"sale_price"`
+	SaleFrom  time.Time   `json:"sale_price_from"`
+}
+
+// CreateScheduledPriceDTO agenda uma mudança de preço; o preço omitido
+// mantém o valor vigente quando a mudança for aplicada.
+type CreateScheduledPriceDTO struct {
+	CostPrice     *money.Money `json:"cost_price,omitempty"`
+	SalePrice     *money.Money `json:"sale_price,omitempty"`
+	EffectiveFrom time.Time    `json:"effective_from"`
+}
+
+type ScheduledPriceDTO struct {
+	ID            int64        `json:"id"`
+	ProductID     int64        `json:"product_id"`
+	CostPrice     *money.Money `json:"cost_price,omitempty"`
+	SalePrice     *money.Money `json:"sale_price,omitempty"`
+	EffectiveFrom time.Time    `json:"effective_from"`
+	Status        string       `json:"status"`
+	CreatedBy     *int64       `json:"created_by,omitempty"`
+	CreatedAt     time.Time    `json:"created_at"`
+	AppliedAt     *time.Time   `json:"applied_at,omitempty"`
+}
+
+func ToProductPriceDTO(m *models.ProductPrice) ProductPriceDTO {
+	return ProductPriceDTO{
+		ID:            m.ID,
+		ProductID:     m.ProductID,
+		CostPrice:     m.CostPrice,
+		SalePrice:     m.SalePrice,
+		EffectiveFrom: m.EffectiveFrom,
+		Source:        m.Source,
+		RefID:         m.RefID,
+		ChangedBy:     m.ChangedBy,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+func ToProductPriceDTOs(prices []*models.ProductPrice) []ProductPriceDTO {
+	dtos := make([]ProductPriceDTO, 0, len(prices))
+	for _, p := range prices {
+		if p != nil {
+			dtos = append(dtos, ToProductPriceDTO(p))
+		}
+	}
+	return dtos
+}
+
+func ToEffectivePriceDTO(m *models.EffectivePrice) EffectivePriceDTO {
+	return EffectivePriceDTO{
+		ProductID: m.ProductID,
+		At:        m.At,
+		CostPrice: m.CostPrice,
+		CostFrom:  m.CostFrom,
+		SalePrice: m.SalePrice,
+		SaleFrom:  m.SaleFrom,
+	}
+}
+
+func ToScheduledPriceModel(dto CreateScheduledPriceDTO, productID int64, createdBy *int64) *models.ScheduledPrice {
+	return &models.ScheduledPrice{
+		ProductID:     productID,
+		CostPrice:     dto.CostPrice,
+		SalePrice:     dto.SalePrice,
+		EffectiveFrom: dto.EffectiveFrom,
+		CreatedBy:     createdBy,
+	}
+}
+
+func ToScheduledPriceDTO(m *models.ScheduledPrice) ScheduledPriceDTO {
+	return ScheduledPriceDTO{
+		ID:            m.ID,
+		ProductID:     m.ProductID,
+		CostPrice:     m.CostPrice,
+		SalePrice:     m.SalePrice,
+		EffectiveFrom: m.EffectiveFrom,
+		Status:        m.Status,
+		CreatedBy:     m.CreatedBy,
+		CreatedAt:     m.CreatedAt,
+		AppliedAt:     m.AppliedAt,
+	}
+}
+
+func ToScheduledPriceDTOs(changes []*models.ScheduledPrice) []ScheduledPriceDTO {
+	dtos := make([]ScheduledPriceDTO, 0, len(changes))
+	for _, c := range changes {
+		if c != nil {
+			dtos = append(dtos, ToScheduledPriceDTO(c))
+		}
+	}
+	return dtos
+}
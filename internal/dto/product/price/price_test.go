@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToProductPriceDTOs(t *testing.T) {
+	refID := int64(40)
+	dtos := ToProductPriceDTOs([]*models.ProductPrice{
+		{ID: 1, ProductID: 2, CostPrice: money.New(5), SalePrice: money.MustParse("9.90"), Source: models.SourceReceipt, RefID: &refID},
+		nil,
+	})
+
+	assert.Len(t, dtos, 1)
+	assert.Equal(t, money.MustParse("9.90"), dtos[0].SalePrice)
+	assert.Equal(t, models.SourceReceipt, dtos[0].Source)
+	assert.Equal(t, &refID, dtos[0].RefID)
+}
+
+func TestToScheduledPriceModel(t *testing.T) {
+	price := money.New(12)
+	user := int64(3)
+	effective := time.Now().Add(time.Hour)
+
+	m := ToScheduledPriceModel(CreateScheduledPriceDTO{SalePrice: &price, EffectiveFrom: effective}, 7, &user)
+
+	assert.Equal(t, int64(7), m.ProductID)
+	assert.Nil(t, m.CostPrice)
+	assert.Equal(t, &price, m.SalePrice)
+	assert.Equal(t, effective, m.EffectiveFrom)
+	assert.Equal(t, &user, m.CreatedBy)
+}
+
+func TestToEffectivePriceDTO(t *testing.T) {
+	at := time.Now()
+	dto := ToEffectivePriceDTO(&models.EffectivePrice{ProductID: 2, At: at, CostPrice: money.New(5), SalePrice: money.MustParse("9.90")})
+
+	assert.Equal(t, money.MustParse("9.90"), dto.SalePrice)
+	assert.Equal(t, at, dto.At)
+}
@@ -4,27 +4,28 @@ import (
 	"time"
 
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 )
 
 type ProductDTO struct {
-	ID                 *int64     `json:"id,omitempty"`
-	SupplierID         *int64     `json:"supplier_id,omitempty"`
-	ProductName        string     `json:"product_name"`
-	Manufacturer       string     `json:"manufacturer"`
-	Description        string     `json:"description,omitempty"`
-	CostPrice          float64    `json:"cost_price"`
-	SalePrice          float64    `json:"sale_price"`
-	StockQuantity      int        `json:"stock_quantity"`
-	MinStock           int        `json:"min_stock"`
-	MaxStock           *int       `json:"max_stock,omitempty"`
-	Barcode            *string    `json:"barcode,omitempty"`
-	Status             bool       `json:"status"`
-	Version            int        `json:"version"`
-	AllowDiscount      bool       `json:"allow_discount"`
-	MinDiscountPercent float64    `json:"min_discount_percent"`
-	MaxDiscountPercent float64    `json:"max_discount_percent"`
-	CreatedAt          *time.Time `json:"created_at,omitempty"`
-	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
+	ID                 *int64      `json:"id,omitempty"`
+	SupplierID         *int64      `json:"supplier_id,omitempty"`
+	ProductName        string      `json:"product_name"`
+	Manufacturer       string      `json:"manufacturer"`
+	Description        string      `json:"description,omitempty"`
+	CostPrice          money.Money `json:"cost_price"`
+	SalePrice          money.Money `json:"sale_price"`
+	StockQuantity      int         `json:"stock_quantity"`
+	MinStock           int         `json:"min_stock"`
+	MaxStock           *int        `json:"max_stock,omitempty"`
+	Barcode            *string     `json:"barcode,omitempty"`
+	Status             bool        `json:"status"`
+	Version            int         `json:"version"`
+	AllowDiscount      bool        `json:"allow_discount"`
+	MinDiscountPercent float64     `json:"min_discount_percent"`
+	MaxDiscountPercent float64     `json:"max_discount_percent"`
+	CreatedAt          *time.Time  `json:"created_at,omitempty"`
+	UpdatedAt          *time.Time  `json:"updated_at,omitempty"`
 }
 
 func ToProductModel(dto ProductDTO) *models.Product {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,8 +23,8 @@ func TestToProductModel(t *testing.T) {
 		ProductName:        "Produto X",
 		Manufacturer:       "Fabricante Y",
 		Description:        "Descrição do produto",
-		CostPrice:          10.5,
-		SalePrice:          15.0,
+		CostPrice:          money.MustParse("10.50"),
+		SalePrice:          money.New(15),
 		StockQuantity:      100,
 		MinStock:           5,
 		MaxStock:           &maxStock,
@@ -44,8 +45,8 @@ func TestToProductModel(t *testing.T) {
 	assert.Equal(t, "Produto X", model.ProductName)
 	assert.Equal(t, "Fabricante Y", model.Manufacturer)
 	assert.Equal(t, "Descrição do produto", model.Description)
-	assert.Equal(t, 10.5, model.CostPrice)
-	assert.Equal(t, 15.0, model.SalePrice)
+	assert.Equal(t, money.MustParse("10.50"), model.CostPrice)
+	assert.Equal(t, money.New(15), model.SalePrice)
 	assert.Equal(t, 100, model.StockQuantity)
 	assert.Equal(t, 5, model.MinStock)
 	assert.Equal(t, 20, *model.MaxStock)
@@ -73,8 +74,8 @@ func TestToProductDTO(t *testing.T) {
 		ProductName:        "Produto X",
 		Manufacturer:       "Fabricante Y",
 		Description:        "Descrição do produto",
-		CostPrice:          10.5,
-		SalePrice:          15.0,
+		CostPrice:          money.MustParse("10.50"),
+		SalePrice:          money.New(15),
 		StockQuantity:      100,
 		MinStock:           5,
 		MaxStock:           &maxStock,
@@ -96,8 +97,8 @@ func TestToProductDTO(t *testing.T) {
 	assert.Equal(t, "Produto X", dto.ProductName)
 	assert.Equal(t, "Fabricante Y", dto.Manufacturer)
 	assert.Equal(t, "Descrição do produto", dto.Description)
-	assert.Equal(t, 10.5, dto.CostPrice)
-	assert.Equal(t, 15.0, dto.SalePrice)
+	assert.Equal(t, money.MustParse("10.50"), dto.CostPrice)
+	assert.Equal(t, money.New(15), dto.SalePrice)
 	assert.Equal(t, 100, dto.StockQuantity)
 	assert.Equal(t, 5, dto.MinStock)
 	assert.Equal(t, 20, *dto.MaxStock)
@@ -157,7 +158,7 @@ func TestToProductDTOs(t *testing.T) {
 			{
 				ID:            1,
 				ProductName:   "Produto A",
-				SalePrice:     100,
+				SalePrice:     money.New(100),
 				StockQuantity: 10,
 				Status:        true,
 				CreatedAt:     createdAt,
@@ -166,7 +167,7 @@ func TestToProductDTOs(t *testing.T) {
 			{
 				ID:            2,
 				ProductName:   "Produto B",
-				SalePrice:     200,
+				SalePrice:     money.New(200),
 				StockQuantity: 20,
 				Status:        false,
 				CreatedAt:     createdAt,
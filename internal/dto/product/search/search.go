@@ -0,0 +1,52 @@
+package dto
+
+import (
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/product/product"
+	search "github.com/WagaoCarvalho/backend_store_go/internal/model/product/search"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+type SearchResultDTO struct {
+	dto.ProductDTO
+	Rank         float64 `json:"rank"`
+	BarcodeMatch bool    `json:"barcode_match"`
+}
+
+// SuggestionDTO é a resposta enxuta do autocomplete.
+type SuggestionDTO struct {
+	ID            int64       `json:"id"`
+	ProductName   string      `json:"product_name"`
+	SalePrice     money.Money `json:"sale_price"`
+	StockQuantity int         `json:"stock_quantity"`
+}
+
+func ToSearchResultDTOs(results []*search.Result) []SearchResultDTO {
+	dtos := make([]SearchResultDTO, 0, len(results))
+	for _, r := range results {
+		if r == nil || r.Product == nil {
+			continue
+		}
+		dtos = append(dtos, SearchResultDTO{
+			ProductDTO:   dto.ToProductDTO(r.Product),
+			Rank:         r.Rank,
+			BarcodeMatch: r.BarcodeMatch,
+		})
+	}
+	return dtos
+}
+
+func ToSuggestionDTOs(suggestions []*search.Suggestion) []SuggestionDTO {
+	dtos := make([]SuggestionDTO, 0, len(suggestions))
+	for _, s := range suggestions {
+		if s == nil {
+			continue
+		}
+		dtos = append(dtos, SuggestionDTO{
+			ID:            s.ID,
+			ProductName:   s.ProductName,
+			SalePrice:     s.SalePrice,
+			StockQuantity: s.StockQuantity,
+		})
+	}
+	return dtos
+}
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"encoding/json"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	search "github.com/WagaoCarvalho/backend_store_go/internal/model/product/search"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToSearchResultDTOs(t *testing.T) {
+	results := []*search.Result{
+		{Product: &models.Product{ID: 4, ProductName: "Açúcar", SalePrice: money.MustParse("6.50")}, Rank: 1.2, BarcodeMatch: true},
+		nil,
+		{Product: nil},
+	}
+
+	dtos := ToSearchResultDTOs(results)
+
+	assert.Len(t, dtos, 1)
+	assert.Equal(t, int64(4), *dtos[0].ID)
+	assert.Equal(t, "Açúcar", dtos[0].ProductName)
+	assert.True(t, dtos[0].BarcodeMatch)
+
+	raw, err := json.Marshal(dtos[0])
+	assert.NoError(t, err)
+	assert.Contains(t, string(raw), `"product_name":"Açúcar"`)
+	assert.Contains(t, string(raw), `"rank":1.2`)
+	assert.Contains(t, string(raw), `"barcode_match":true`)
+}
+
+func TestToSuggestionDTOs(t *testing.T) {
+	dtos := ToSuggestionDTOs([]*search.Suggestion{{ID: 1, ProductName: "Feijão", SalePrice: money.MustParse("8.90"), StockQuantity: 3}, nil})
+
+	assert.Equal(t, []SuggestionDTO{{ID: 1, ProductName: "Feijão", SalePrice: money.MustParse("8.90"), StockQuantity: 3}}, dtos)
+	assert.NotNil(t, ToSuggestionDTOs(nil))
+}
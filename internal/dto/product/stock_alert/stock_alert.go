@@ -0,0 +1,128 @@
+package dto
+
+import (
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_alert"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+type StockAlertDTO struct {
+	ID            int64      `json:"id"`
+	ProductID     int64      `json:"product_id"`
+	ProductName   string     `json:"product_name"`
+	StockQuantity int        `json:"stock_quantity"`
+	MinStock      int        `json:"min_stock"`
+	Status        string     `json:"status"`
+	CreatedAt     time.Time  `json:"created_at"`
+	ResolvedAt    *time.Time `json:"resolved_at,omitempty"`
+}
+
+type LowStockItemDTO struct {
+	ProductID     int64  `json:"product_id"`
+	ProductName   string `json:"product_name"`
+	SupplierID    *int64 `json:"supplier_id,omitempty"`
+	SupplierName  string `json:"supplier_name,omitempty"`
+	StockQuantity int    `json:"stock_quantity"`
+	MinStock      int    `json:"min_stock"`
+	MaxStock      *int   `json:"max_stock,omitempty"`
+	Shortage      int    `json:"shortage"`
+}
+
+type ReplenishmentItemDTO struct {
+	ProductID         int64       `json:"product_id"`
+	ProductName       string      `json:"product_name"`
+	StockQuantity     int         `json:"stock_quantity"`
+	MinStock          int         `json:"min_stock"`
+	MaxStock          *int        `json:"max_stock,omitempty"`
+	SoldQuantity      int         `json:"sold_quantity"`
+	DailySales        float64     `json:"daily_sales"`
+	SuggestedQuantity int         `json:"suggested_quantity"`
+	CostPrice         money.Money `json:"cost_price"`
+	EstimatedCost     money.Money `json:"estimated_cost"`
+}
+
+type SupplierReplenishmentDTO struct {
+	SupplierID    *int64                 `json:"supplier_id"`
+	SupplierName  string                 `json:"supplier_name"`
+	Items         []ReplenishmentItemDTO `json:"items"`
+	TotalQuantity int                    `json:"total_quantity"`
+	TotalCost     money.Money            `json:"total_cost"`
+}
+
+func ToStockAlertDTO(m *models.StockAlert) StockAlertDTO {
+	return StockAlertDTO{
+		ID:            m.ID,
+		ProductID:     m.ProductID,
+		ProductName:   m.ProductName,
+		StockQuantity: m.StockQuantity,
+		MinStock:      m.MinStock,
+		Status:        m.Status(),
+		CreatedAt:     m.CreatedAt,
+		ResolvedAt:    m.ResolvedAt,
+	}
+}
+
+func ToStockAlertDTOs(alerts []*models.StockAlert) []StockAlertDTO {
+	dtos := make([]StockAlertDTO, 0, len(alerts))
+	for _, a := range alerts {
+		if a != nil {
+			dtos = append(dtos, ToStockAlertDTO(a))
+		}
+	}
+	return dtos
+}
+
+func ToLowStockItemDTOs(items []*models.LowStockItem) []LowStockItemDTO {
+	dtos := make([]LowStockItemDTO, 0, len(items))
+	for _, i := range items {
+		if i == nil {
+			continue
+		}
+		dtos = append(dtos, LowStockItemDTO{
+			ProductID:     i.ProductID,
+			ProductName:   i.ProductName,
+			SupplierID:    i.SupplierID,
+			SupplierName:  i.SupplierName,
+			StockQuantity: i.StockQuantity,
+			MinStock:      i.MinStock,
+			MaxStock:      i.MaxStock,
+			Shortage:      i.Shortage(),
+		})
+	}
+	return dtos
+}
+
+func ToSupplierReplenishmentDTOs(groups []*models.SupplierReplenishment) []SupplierReplenishmentDTO {
+	dtos := make([]SupplierReplenishmentDTO, 0, len(groups))
+	for _, g := range groups {
+		if g == nil {
+			continue
+		}
+
+		items := make([]ReplenishmentItemDTO, 0, len(g.Items))
+		for _, i := range g.Items {
+			items = append(items, ReplenishmentItemDTO{
+				ProductID:         i.ProductID,
+				ProductName:       i.ProductName,
+				StockQuantity:     i.StockQuantity,
+				MinStock:          i.MinStock,
+				MaxStock:          i.MaxStock,
+				SoldQuantity:      i.SoldQuantity,
+				DailySales:        i.DailySales,
+				SuggestedQuantity: i.SuggestedQuantity,
+				CostPrice:         i.CostPrice,
+				EstimatedCost:     i.EstimatedCost,
+			})
+		}
+
+		dtos = append(dtos, SupplierReplenishmentDTO{
+			SupplierID:    g.SupplierID,
+			SupplierName:  g.SupplierName,
+			Items:         items,
+			TotalQuantity: g.TotalQuantity,
+			TotalCost:     g.TotalCost,
+		})
+	}
+	return dtos
+}
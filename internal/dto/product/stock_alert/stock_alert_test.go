@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_alert"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToStockAlertDTOs(t *testing.T) {
+	now := time.Now()
+	dtos := ToStockAlertDTOs([]*models.StockAlert{
+		{ID: 1, ProductID: 2, StockQuantity: 1, MinStock: 5, CreatedAt: now},
+		{ID: 2, ProductID: 3, ResolvedAt: &now},
+		nil,
+	})
+
+	assert.Len(t, dtos, 2)
+	assert.Equal(t, models.StatusOpen, dtos[0].Status)
+	assert.Equal(t, models.StatusResolved, dtos[1].Status)
+}
+
+func TestToLowStockItemDTOs(t *testing.T) {
+	dtos := ToLowStockItemDTOs([]*models.LowStockItem{{ProductID: 1, StockQuantity: 2, MinStock: 6}})
+
+	assert.Equal(t, 4, dtos[0].Shortage)
+}
+
+func TestToSupplierReplenishmentDTOs(t *testing.T) {
+	supplierID := int64(9)
+	dtos := ToSupplierReplenishmentDTOs([]*models.SupplierReplenishment{{
+		SupplierID:    &supplierID,
+		SupplierName:  "Alfa",
+		Items:         []*models.ReplenishmentItem{{ProductID: 1, SuggestedQuantity: 12, EstimatedCost: money.New(30)}},
+		TotalQuantity: 12,
+		TotalCost:     money.New(30),
+	}})
+
+	assert.Len(t, dtos, 1)
+	assert.Equal(t, "Alfa", dtos[0].SupplierName)
+	assert.Equal(t, 12, dtos[0].Items[0].SuggestedQuantity)
+	assert.Equal(t, money.New(30), dtos[0].TotalCost)
+}
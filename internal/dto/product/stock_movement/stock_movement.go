@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+)
+
+type StockMovementDTO struct {
+	ID        int64     `json:"id"`
+	ProductID int64     `json:"product_id"`
+	Delta     int       `json:"delta"`
+	Quantity  int       `json:"quantity"`
+	Reason    string    `json:"reason"`
+	RefType   string    `json:"ref_type,omitempty"`
+	RefID     *int64    `json:"ref_id,omitempty"`
+	UserID    *int64    `json:"user_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type StockAtDTO struct {
+	ProductID      int64      `json:"product_id"`
+	At             time.Time  `json:"at"`
+	StockQuantity  int        `json:"stock_quantity"`
+	LastMovementAt *time.Time `json:"last_movement_at,omitempty"`
+}
+
+func ToStockMovementDTO(m *models.StockMovement) StockMovementDTO {
+	return StockMovementDTO{
+		ID:        m.ID,
+		ProductID: m.ProductID,
+		Delta:     m.Delta,
+		Quantity:  m.Quantity,
+		Reason:    m.Reason,
+		RefType:   m.RefType,
+		RefID:     m.RefID,
+		UserID:    m.UserID,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func ToStockMovementDTOs(movements []*models.StockMovement) []StockMovementDTO {
+	dtos := make([]StockMovementDTO, 0, len(movements))
+	for _, m := range movements {
+		if m != nil {
+			dtos = append(dtos, ToStockMovementDTO(m))
+		}
+	}
+	return dtos
+}
+
+func ToStockAtDTO(m *models.StockAt) StockAtDTO {
+	return StockAtDTO{
+		ProductID:      m.ProductID,
+		At:             m.At,
+		StockQuantity:  m.Quantity,
+		LastMovementAt: m.LastMovementAt,
+	}
+}
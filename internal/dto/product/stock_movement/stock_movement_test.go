@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToStockMovementDTOs(t *testing.T) {
+	refID := int64(40)
+	dtos := ToStockMovementDTOs([]*models.StockMovement{
+		{ID: 1, ProductID: 2, Delta: -3, Quantity: 7, Reason: models.ReasonSale, RefType: models.RefSale, RefID: &refID},
+		nil,
+	})
+
+	assert.Len(t, dtos, 1)
+	assert.Equal(t, -3, dtos[0].Delta)
+	assert.Equal(t, 7, dtos[0].Quantity)
+	assert.Equal(t, &refID, dtos[0].RefID)
+}
+
+func TestToStockAtDTO(t *testing.T) {
+	at := time.Now()
+	dto := ToStockAtDTO(&models.StockAt{ProductID: 2, At: at, Quantity: 5})
+
+	assert.Equal(t, 5, dto.StockQuantity)
+	assert.Equal(t, at, dto.At)
+	assert.Nil(t, dto.LastMovementAt)
+}
@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	modelPurchase "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+type PurchaseOrderFilterDTO struct {
+	SupplierID   *int64  `schema:"supplier_id"`
+	UserID       *int64  `schema:"user_id"`
+	ProductID    *int64  `schema:"product_id"`
+	Status       string  `schema:"status"`
+	ExpectedFrom *string `schema:"expected_from"`
+	ExpectedTo   *string `schema:"expected_to"`
+	CreatedFrom  *string `schema:"created_from"`
+	CreatedTo    *string `schema:"created_to"`
+	Limit        int     `schema:"limit"`
+	Offset       int     `schema:"offset"`
+	Cursor       string  `schema:"cursor"`
+	CursorMode   bool    `schema:"-"`
+	SearchTerm   string  `schema:"search"`
+}
+
+func (d *PurchaseOrderFilterDTO) ToModel() (*modelPurchase.PurchaseOrderFilter, error) {
+	parseDate := func(s *string, fieldName string) (*time.Time, error) {
+		if s == nil || *s == "" {
+			return nil, nil
+		}
+		t, err := time.Parse("2006-01-02", *s)
+		if err != nil {
+			return nil, fmt.Errorf("%w: campo '%s' com valor inválido '%s' - formato esperado: YYYY-MM-DD",
+				errMsg.ErrInvalidFilter, fieldName, *s)
+		}
+		return &t, nil
+	}
+
+	if d.Limit < 1 {
+		return nil, fmt.Errorf("%w: 'limit' deve ser maior que 0", errMsg.ErrInvalidFilter)
+	}
+	if d.Limit > 100 {
+		return nil, fmt.Errorf("%w: 'limit' máximo é 100", errMsg.ErrInvalidFilter)
+	}
+	if d.Offset < 0 {
+		return nil, fmt.Errorf("%w: 'offset' não pode ser negativo", errMsg.ErrInvalidFilter)
+	}
+
+	expectedFrom, err := parseDate(d.ExpectedFrom, "expected_from")
+	if err != nil {
+		return nil, err
+	}
+
+	expectedTo, err := parseDate(d.ExpectedTo, "expected_to")
+	if err != nil {
+		return nil, err
+	}
+
+	createdFrom, err := parseDate(d.CreatedFrom, "created_from")
+	if err != nil {
+		return nil, err
+	}
+
+	createdTo, err := parseDate(d.CreatedTo, "created_to")
+	if err != nil {
+		return nil, err
+	}
+
+	return &modelPurchase.PurchaseOrderFilter{
+		BaseFilter: modelFilter.BaseFilter{
+			Limit:      d.Limit,
+			Offset:     d.Offset,
+			CursorMode: d.CursorMode,
+			Cursor:     d.Cursor,
+			SearchTerm: d.SearchTerm,
+		},
+		SupplierID:   d.SupplierID,
+		UserID:       d.UserID,
+		ProductID:    d.ProductID,
+		Status:       d.Status,
+		ExpectedFrom: expectedFrom,
+		ExpectedTo:   expectedTo,
+		CreatedFrom:  createdFrom,
+		CreatedTo:    createdTo,
+	}, nil
+}
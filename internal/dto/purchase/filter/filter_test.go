@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"testing"
+
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPurchaseOrderFilterDTO_ToModel(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	t.Run("converte filtro válido", func(t *testing.T) {
+		d := PurchaseOrderFilterDTO{
+			SupplierID:   utils.Int64Ptr(4),
+			ProductID:    utils.Int64Ptr(7),
+			Status:       "sent",
+			ExpectedFrom: strPtr("2026-01-01"),
+			ExpectedTo:   strPtr("2026-01-31"),
+			Limit:        10,
+		}
+
+		f, err := d.ToModel()
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), *f.SupplierID)
+		assert.Equal(t, int64(7), *f.ProductID)
+		assert.Equal(t, "sent", f.Status)
+		assert.Equal(t, 31, f.ExpectedTo.Day())
+		assert.Equal(t, 10, f.Limit)
+	})
+
+	t.Run("paginação inválida", func(t *testing.T) {
+		for _, d := range []PurchaseOrderFilterDTO{{Limit: 0}, {Limit: 101}, {Limit: 10, Offset: -1}} {
+			_, err := d.ToModel()
+			assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		}
+	})
+
+	t.Run("data inválida", func(t *testing.T) {
+		d := PurchaseOrderFilterDTO{CreatedFrom: strPtr("01/01/2026"), Limit: 10}
+
+		_, err := d.ToModel()
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+	})
+}
@@ -0,0 +1,126 @@
+package dto
+
+import (
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+type PurchaseOrderItemDTO struct {
+	ID               *int64      `json:"id,omitempty"`
+	ProductID        int64       `json:"product_id"`
+	Quantity         int         `json:"quantity"`
+	ReceivedQuantity int         `json:"received_quantity"`
+	UnitCost         money.Money `json:"unit_cost"`
+	Subtotal         money.Money `json:"subtotal,omitempty"`
+}
+
+type PurchaseOrderDTO struct {
+	ID          *int64                 `json:"id,omitempty"`
+	SupplierID  int64                  `json:"supplier_id"`
+	UserID      *int64                 `json:"user_id,omitempty"`
+	Status      string                 `json:"status,omitempty"`
+	Notes       string                 `json:"notes,omitempty"`
+	ExpectedAt  *string                `json:"expected_at,omitempty"`
+	TotalAmount money.Money            `json:"total_amount"`
+	Items       []PurchaseOrderItemDTO `json:"items,omitempty"`
+	Version     int                    `json:"version,omitempty"`
+	CreatedAt   *string                `json:"created_at,omitempty"`
+	UpdatedAt   *string                `json:"updated_at,omitempty"`
+}
+
+// ToPurchaseOrderModel converte a requisição de criação/alteração. Status,
+// total e quantidades recebidas são definidos pelo servidor.
+func ToPurchaseOrderModel(dto PurchaseOrderDTO) *models.PurchaseOrder {
+	items := make([]models.PurchaseOrderItem, len(dto.Items))
+	for i, it := range dto.Items {
+		items[i] = models.PurchaseOrderItem{
+			ProductID: it.ProductID,
+			Quantity:  it.Quantity,
+			UnitCost:  it.UnitCost,
+		}
+	}
+
+	model := &models.PurchaseOrder{
+		ID:         utils.NilToZero(dto.ID),
+		SupplierID: dto.SupplierID,
+		UserID:     dto.UserID,
+		Notes:      dto.Notes,
+		Items:      items,
+		Version:    dto.Version,
+	}
+
+	if dto.ExpectedAt != nil {
+		if t, err := time.Parse(time.RFC3339, *dto.ExpectedAt); err == nil {
+			model.ExpectedAt = &t
+		}
+	}
+
+	return model
+}
+
+func ToPurchaseOrderDTO(model *models.PurchaseOrder) PurchaseOrderDTO {
+	if model == nil {
+		return PurchaseOrderDTO{}
+	}
+
+	var items []PurchaseOrderItemDTO
+	if model.Items != nil {
+		items = make([]PurchaseOrderItemDTO, len(model.Items))
+		for i := range model.Items {
+			it := model.Items[i]
+			items[i] = PurchaseOrderItemDTO{
+				ProductID:        it.ProductID,
+				Quantity:         it.Quantity,
+				ReceivedQuantity: it.ReceivedQuantity,
+				UnitCost:         it.UnitCost,
+				Subtotal:         it.Subtotal(),
+			}
+			if it.ID != 0 {
+				id := it.ID
+				items[i].ID = &id
+			}
+		}
+	}
+
+	dto := PurchaseOrderDTO{
+		ID:          &model.ID,
+		SupplierID:  model.SupplierID,
+		UserID:      model.UserID,
+		Status:      model.Status,
+		Notes:       model.Notes,
+		TotalAmount: model.TotalAmount,
+		Items:       items,
+		Version:     model.Version,
+	}
+
+	if model.ExpectedAt != nil {
+		v := model.ExpectedAt.Format(time.RFC3339)
+		dto.ExpectedAt = &v
+	}
+
+	if !model.CreatedAt.IsZero() {
+		v := model.CreatedAt.Format(time.RFC3339)
+		dto.CreatedAt = &v
+	}
+
+	if !model.UpdatedAt.IsZero() {
+		v := model.UpdatedAt.Format(time.RFC3339)
+		dto.UpdatedAt = &v
+	}
+
+	return dto
+}
+
+func ToPurchaseOrderDTOs(list []*models.PurchaseOrder) []PurchaseOrderDTO {
+	result := make([]PurchaseOrderDTO, 0, len(list))
+	for _, o := range list {
+		if o == nil {
+			continue
+		}
+		result = append(result, ToPurchaseOrderDTO(o))
+	}
+	return result
+}
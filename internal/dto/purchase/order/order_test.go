@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToPurchaseOrderModel(t *testing.T) {
+	expected := "2026-05-10T00:00:00Z"
+	input := PurchaseOrderDTO{
+		ID:          utils.Int64Ptr(3),
+		SupplierID:  4,
+		Status:      "received",
+		Notes:       "urgente",
+		ExpectedAt:  &expected,
+		TotalAmount: money.New(999),
+		Version:     2,
+		Items: []PurchaseOrderItemDTO{
+			{ProductID: 7, Quantity: 2, ReceivedQuantity: 2, UnitCost: money.New(10)},
+		},
+	}
+
+	model := ToPurchaseOrderModel(input)
+
+	assert.Equal(t, int64(3), model.ID)
+	assert.Equal(t, int64(4), model.SupplierID)
+	assert.Empty(t, model.Status)
+	assert.Zero(t, model.TotalAmount)
+	assert.Equal(t, 2, model.Version)
+	assert.Equal(t, 2026, model.ExpectedAt.Year())
+	assert.Equal(t, []models.PurchaseOrderItem{{ProductID: 7, Quantity: 2, UnitCost: money.New(10)}}, model.Items)
+}
+
+func TestToPurchaseOrderDTO(t *testing.T) {
+	t.Run("nil model", func(t *testing.T) {
+		assert.Equal(t, PurchaseOrderDTO{}, ToPurchaseOrderDTO(nil))
+	})
+
+	t.Run("full model", func(t *testing.T) {
+		now := time.Now()
+		model := &models.PurchaseOrder{
+			ID:          1,
+			SupplierID:  4,
+			Status:      models.StatusSent,
+			ExpectedAt:  &now,
+			TotalAmount: money.New(20),
+			Items:       []models.PurchaseOrderItem{{ID: 10, ProductID: 7, Quantity: 2, ReceivedQuantity: 1, UnitCost: money.New(10)}},
+			Version:     3,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+
+		dto := ToPurchaseOrderDTO(model)
+
+		assert.Equal(t, int64(1), *dto.ID)
+		assert.Equal(t, models.StatusSent, dto.Status)
+		assert.Equal(t, now.Format(time.RFC3339), *dto.ExpectedAt)
+		assert.NotNil(t, dto.CreatedAt)
+		assert.Len(t, dto.Items, 1)
+		assert.Equal(t, int64(10), *dto.Items[0].ID)
+		assert.Equal(t, money.New(20), dto.Items[0].Subtotal)
+	})
+
+	t.Run("list skips nil", func(t *testing.T) {
+		list := ToPurchaseOrderDTOs([]*models.PurchaseOrder{{ID: 1}, nil})
+
+		assert.Len(t, list, 1)
+		assert.Nil(t, list[0].Items)
+	})
+}
@@ -0,0 +1,97 @@
+package dto
+
+import (
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/receipt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+type PurchaseReceiptItemDTO struct {
+	ID                  *int64       `json:"id,omitempty"`
+	PurchaseOrderItemID int64        `json:"purchase_order_item_id"`
+	ProductID           int64        `json:"product_id,omitempty"`
+	Quantity            int          `json:"quantity"`
+	UnitCost            *money.Money `json:"unit_cost,omitempty"`
+}
+
+type PurchaseReceiptDTO struct {
+	ID              *int64                   `json:"id,omitempty"`
+	PurchaseOrderID int64                    `json:"purchase_order_id,omitempty"`
+	LocationID      int64                    `json:"location_id"`
+	UserID          *int64                   `json:"user_id,omitempty"`
+	Notes           string                   `json:"notes,omitempty"`
+	CostMethod      string                   `json:"cost_method,omitempty"`
+	Items           []PurchaseReceiptItemDTO `json:"items"`
+	CreatedAt       *string                  `json:"created_at,omitempty"`
+}
+
+// ToPurchaseReceiptModel converte a requisição de recebimento. Produto e
+// método de custo são definidos pelo servidor a partir do pedido.
+func ToPurchaseReceiptModel(dto PurchaseReceiptDTO) *models.PurchaseReceipt {
+	items := make([]models.PurchaseReceiptItem, len(dto.Items))
+	for i, it := range dto.Items {
+		items[i] = models.PurchaseReceiptItem{
+			PurchaseOrderItemID: it.PurchaseOrderItemID,
+			Quantity:            it.Quantity,
+			UnitCost:            it.UnitCost,
+		}
+	}
+
+	return &models.PurchaseReceipt{
+		PurchaseOrderID: dto.PurchaseOrderID,
+		LocationID:      dto.LocationID,
+		UserID:          dto.UserID,
+		Notes:           dto.Notes,
+		Items:           items,
+	}
+}
+
+func ToPurchaseReceiptDTO(model *models.PurchaseReceipt) PurchaseReceiptDTO {
+	if model == nil {
+		return PurchaseReceiptDTO{}
+	}
+
+	items := make([]PurchaseReceiptItemDTO, len(model.Items))
+	for i := range model.Items {
+		it := model.Items[i]
+		items[i] = PurchaseReceiptItemDTO{
+			PurchaseOrderItemID: it.PurchaseOrderItemID,
+			ProductID:           it.ProductID,
+			Quantity:            it.Quantity,
+			UnitCost:            it.UnitCost,
+		}
+		if it.ID != 0 {
+			id := it.ID
+			items[i].ID = &id
+		}
+	}
+
+	dto := PurchaseReceiptDTO{
+		ID:              &model.ID,
+		PurchaseOrderID: model.PurchaseOrderID,
+		LocationID:      model.LocationID,
+		UserID:          model.UserID,
+		Notes:           model.Notes,
+		CostMethod:      model.CostMethod,
+		Items:           items,
+	}
+
+	if !model.CreatedAt.IsZero() {
+		v := model.CreatedAt.Format(time.RFC3339)
+		dto.CreatedAt = &v
+	}
+
+	return dto
+}
+
+func ToPurchaseReceiptDTOList(list []*models.PurchaseReceipt) []PurchaseReceiptDTO {
+	result := make([]PurchaseReceiptDTO, 0, len(list))
+	for _, r := range list {
+		if r == nil {
+			continue
+		}
+		result = append(result, ToPurchaseReceiptDTO(r))
+	}
+	return result
+}
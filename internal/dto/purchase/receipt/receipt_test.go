@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/receipt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToPurchaseReceiptModel(t *testing.T) {
+	cost := money.MustParse("12.50")
+	input := PurchaseReceiptDTO{
+		PurchaseOrderID: 10,
+		UserID:          utils.Int64Ptr(2),
+		Notes:           "nota 123",
+		CostMethod:      "average",
+		Items: []PurchaseReceiptItemDTO{
+			{PurchaseOrderItemID: 5, ProductID: 99, Quantity: 1, UnitCost: &cost},
+		},
+	}
+
+	model := ToPurchaseReceiptModel(input)
+
+	assert.Equal(t, int64(10), model.PurchaseOrderID)
+	assert.Equal(t, input.UserID, model.UserID)
+	assert.Empty(t, model.CostMethod)
+	assert.Equal(t, []models.PurchaseReceiptItem{{PurchaseOrderItemID: 5, Quantity: 1, UnitCost: &cost}}, model.Items)
+}
+
+func TestToPurchaseReceiptDTO(t *testing.T) {
+	t.Run("nil model", func(t *testing.T) {
+		assert.Equal(t, PurchaseReceiptDTO{}, ToPurchaseReceiptDTO(nil))
+	})
+
+	t.Run("full model", func(t *testing.T) {
+		cost := money.New(10)
+		now := time.Now()
+		model := &models.PurchaseReceipt{
+			ID:              1,
+			PurchaseOrderID: 10,
+			CostMethod:      models.CostLatest,
+			Items:           []models.PurchaseReceiptItem{{ID: 3, PurchaseOrderItemID: 5, ProductID: 7, Quantity: 2, UnitCost: &cost}},
+			CreatedAt:       now,
+		}
+
+		dto := ToPurchaseReceiptDTO(model)
+
+		assert.Equal(t, int64(1), *dto.ID)
+		assert.Equal(t, models.CostLatest, dto.CostMethod)
+		assert.Equal(t, int64(3), *dto.Items[0].ID)
+		assert.Equal(t, int64(7), dto.Items[0].ProductID)
+		assert.Equal(t, now.Format(time.RFC3339), *dto.CreatedAt)
+	})
+
+	t.Run("list skips nil", func(t *testing.T) {
+		list := ToPurchaseReceiptDTOList([]*models.PurchaseReceipt{{ID: 1}, nil})
+
+		assert.Len(t, list, 1)
+	})
+}
@@ -0,0 +1,92 @@
+package dto
+
+import (
+	dtoItem "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/item"
+	dtoPayment "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/payment"
+	dtoSale "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/sale"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/checkout"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+type CheckoutItemDTO struct {
+	ProductID   int64       `json:"product_id"`
+	Quantity    int         `json:"quantity"`
+	Discount    money.Money `json:"discount,omitempty"`
+	Description string      `json:"description,omitempty"`
+}
+
+type CheckoutDTO struct {
+	ClientID          *int64                      `json:"client_id,omitempty"`
+	ClientCnpjID      *int64                      `json:"client_cnpj_id,omitempty"`
+	UserID            *int64                      `json:"user_id,omitempty"`
+	LocationID        int64                       `json:"location_id"`
+	PaymentType       string                      `json:"payment_type,omitempty"`
+	TotalSaleDiscount money.Money                 `json:"total_sale_discount,omitempty"`
+	Notes             string                      `json:"notes,omitempty"`
+	Items             []CheckoutItemDTO           `json:"items"`
+	Payments          []dtoPayment.SalePaymentDTO `json:"payments,omitempty"`
+}
+
+type CheckoutResultDTO struct {
+	Sale     dtoSale.SaleDTO             `json:"sale"`
+	Items    []dtoItem.SaleItemDTO       `json:"items"`
+	Payments []dtoPayment.SalePaymentDTO `json:"payments"`
+}
+
+type LineErrorDTO struct {
+	Line      int    `json:"line"`
+	ProductID int64  `json:"product_id"`
+	Message   string `json:"message"`
+}
+
+func ToCheckoutModel(dto CheckoutDTO) *models.Checkout {
+	items := make([]models.CheckoutItem, len(dto.Items))
+	for i, it := range dto.Items {
+		items[i] = models.CheckoutItem{
+			ProductID:   it.ProductID,
+			Quantity:    it.Quantity,
+			Discount:    it.Discount,
+			Description: it.Description,
+		}
+	}
+
+	return &models.Checkout{
+		ClientID:          dto.ClientID,
+		ClientCnpjID:      dto.ClientCnpjID,
+		UserID:            dto.UserID,
+		LocationID:        dto.LocationID,
+		PaymentType:       dto.PaymentType,
+		TotalSaleDiscount: dto.TotalSaleDiscount,
+		Notes:             dto.Notes,
+		Items:             items,
+		Payments:          dtoPayment.ToSalePaymentModels(dto.Payments),
+	}
+}
+
+func ToCheckoutResultDTO(model *models.CheckoutResult) CheckoutResultDTO {
+	if model == nil {
+		return CheckoutResultDTO{}
+	}
+
+	result := CheckoutResultDTO{
+		Items:    dtoItem.ToSaleItemDTOList(model.Items),
+		Payments: dtoPayment.ToSalePaymentDTOs(model.Payments),
+	}
+	if model.Sale != nil {
+		result.Sale = dtoSale.ToSaleDTO(model.Sale)
+	}
+
+	return result
+}
+
+func ToLineErrorDTOs(lines []models.LineError) []LineErrorDTO {
+	result := make([]LineErrorDTO, len(lines))
+	for i, l := range lines {
+		result[i] = LineErrorDTO{
+			Line:      l.Line,
+			ProductID: l.ProductID,
+			Message:   l.Message,
+		}
+	}
+	return result
+}
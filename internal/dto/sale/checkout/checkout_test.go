@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	dtoPayment "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/payment"
+	modelsCheckout "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/checkout"
+	modelsItem "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	modelsSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToCheckoutModel(t *testing.T) {
+	input := CheckoutDTO{
+		ClientID:          utils.Int64Ptr(3),
+		UserID:            utils.Int64Ptr(7),
+		PaymentType:       "pix",
+		TotalSaleDiscount: money.New(2),
+		Notes:             "balcão",
+		Items: []CheckoutItemDTO{
+			{ProductID: 1, Quantity: 2, Discount: money.MustParse("0.50"), Description: "a"},
+			{ProductID: 2, Quantity: 1},
+		},
+		Payments: []dtoPayment.SalePaymentDTO{
+			{Method: "pix", Amount: money.New(10)},
+			{Method: "card", Amount: money.New(8), Installments: 2, AuthorizationCode: "AUT1"},
+		},
+	}
+
+	model := ToCheckoutModel(input)
+
+	assert.Equal(t, input.ClientID, model.ClientID)
+	assert.Equal(t, input.UserID, model.UserID)
+	assert.Equal(t, "pix", model.PaymentType)
+	assert.Equal(t, money.New(2), model.TotalSaleDiscount)
+	assert.Equal(t, "balcão", model.Notes)
+	assert.Len(t, model.Items, 2)
+	assert.Equal(t, modelsCheckout.CheckoutItem{ProductID: 1, Quantity: 2, Discount: money.MustParse("0.50"), Description: "a"}, model.Items[0])
+	assert.Equal(t, modelsCheckout.CheckoutItem{ProductID: 2, Quantity: 1}, model.Items[1])
+	assert.Len(t, model.Payments, 2)
+	assert.Equal(t, modelsPayment.SalePayment{Method: "card", Amount: money.New(8), Installments: 2, AuthorizationCode: "AUT1"}, model.Payments[1])
+}
+
+func TestToCheckoutResultDTO(t *testing.T) {
+	t.Run("nil retorna vazio", func(t *testing.T) {
+		assert.Equal(t, CheckoutResultDTO{}, ToCheckoutResultDTO(nil))
+	})
+
+	t.Run("converte venda e itens", func(t *testing.T) {
+		now := time.Now()
+		result := ToCheckoutResultDTO(&modelsCheckout.CheckoutResult{
+			Sale: &modelsSale.Sale{ID: 10, PaymentType: "cash", TotalAmount: money.New(19), SaleDate: now},
+			Items: []*modelsItem.SaleItem{
+				{ID: 1, SaleID: 10, ProductID: 1, Quantity: 2, UnitPrice: money.New(10), Discount: money.New(1), Subtotal: money.New(19)},
+			},
+			Payments: []*modelsPayment.SalePayment{
+				{ID: 3, SaleID: 10, Method: "cash", Amount: money.New(20), ChangeGiven: money.New(1)},
+			},
+		})
+
+		assert.Equal(t, int64(10), *result.Sale.ID)
+		assert.Equal(t, money.New(19), result.Sale.TotalAmount)
+		assert.Len(t, result.Items, 1)
+		assert.Equal(t, money.New(19), result.Items[0].Subtotal)
+		assert.Len(t, result.Payments, 1)
+		assert.Equal(t, money.New(1), result.Payments[0].ChangeGiven)
+	})
+}
+
+func TestToLineErrorDTOs(t *testing.T) {
+	result := ToLineErrorDTOs([]modelsCheckout.LineError{
+		{Line: 1, ProductID: 5, Message: "estoque insuficiente"},
+	})
+
+	assert.Equal(t, []LineErrorDTO{{Line: 1, ProductID: 5, Message: "estoque insuficiente"}}, result)
+}
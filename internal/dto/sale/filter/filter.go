@@ -2,16 +2,17 @@ package dto
 
 import (
 	"fmt"
-	"strconv"
 	"time"
 
 	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 	modelSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/filter"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 )
 
 type SaleFilterDTO struct {
 	ClientID         *int64  `schema:"client_id"`
+	ClientCnpjID     *int64  `schema:"client_cnpj_id"`
 	UserID           *int64  `schema:"user_id"`
 	PaymentType      string  `schema:"payment_type"`
 	Status           string  `schema:"status"`
@@ -32,6 +33,9 @@ type SaleFilterDTO struct {
 	UpdatedTo        *string `schema:"updated_to"`
 	Limit            int     `schema:"limit"`
 	Offset           int     `schema:"offset"`
+	Cursor           string  `schema:"cursor"`
+	CursorMode       bool    `schema:"-"`
+	SearchTerm       string  `schema:"search"`
 }
 
 func (d *SaleFilterDTO) ToModel() (*modelSale.SaleFilter, error) {
@@ -48,21 +52,21 @@ func (d *SaleFilterDTO) ToModel() (*modelSale.SaleFilter, error) {
 		return &t, nil
 	}
 
-	// Função para parsear valores float com validação
-	parseFloat := func(s *string, fieldName string, allowNegative bool) (*float64, error) {
+	// Função para parsear valores monetários com validação
+	parseMoney := func(s *string, fieldName string, allowNegative bool) (*money.Money, error) {
 		if s == nil || *s == "" {
 			return nil, nil
 		}
-		f, err := strconv.ParseFloat(*s, 64)
+		m, err := money.Parse(*s)
 		if err != nil {
 			return nil, fmt.Errorf("%w: campo '%s' com valor inválido '%s' - valor numérico esperado",
 				errMsg.ErrInvalidFilter, fieldName, *s)
 		}
-		if !allowNegative && f < 0 {
+		if !allowNegative && m.IsNegative() {
 			return nil, fmt.Errorf("%w: campo '%s' não pode ser negativo",
 				errMsg.ErrInvalidFilter, fieldName)
 		}
-		return &f, nil
+		return &m, nil
 	}
 
 	// Validar parâmetros de paginação
@@ -77,8 +81,11 @@ func (d *SaleFilterDTO) ToModel() (*modelSale.SaleFilter, error) {
 	}
 
 	baseFilter := modelFilter.BaseFilter{
-		Limit:  d.Limit,
-		Offset: d.Offset,
+		Limit:      d.Limit,
+		Offset:     d.Offset,
+		CursorMode: d.CursorMode,
+		Cursor:     d.Cursor,
+		SearchTerm: d.SearchTerm,
 	}
 
 	// Parsear e validar datas
@@ -129,66 +136,66 @@ func (d *SaleFilterDTO) ToModel() (*modelSale.SaleFilter, error) {
 	}
 
 	// Parsear e validar valores monetários (não permitir negativos)
-	minTotalAmount, err := parseFloat(d.MinTotalAmount, "min_total_amount", false)
+	minTotalAmount, err := parseMoney(d.MinTotalAmount, "min_total_amount", false)
 	if err != nil {
 		return nil, err
 	}
 
-	maxTotalAmount, err := parseFloat(d.MaxTotalAmount, "max_total_amount", false)
+	maxTotalAmount, err := parseMoney(d.MaxTotalAmount, "max_total_amount", false)
 	if err != nil {
 		return nil, err
 	}
 
 	// Validar intervalos numéricos (min <= max)
-	if minTotalAmount != nil && maxTotalAmount != nil && *minTotalAmount > *maxTotalAmount {
+	if minTotalAmount != nil && maxTotalAmount != nil && minTotalAmount.GreaterThan(*maxTotalAmount) {
 		return nil, fmt.Errorf("%w: 'min_total_amount' não pode ser maior que 'max_total_amount'",
 			errMsg.ErrInvalidFilter)
 	}
 
 	// Parsear outros valores monetários
-	minItemsAmount, err := parseFloat(d.MinItemsAmount, "min_items_amount", false)
+	minItemsAmount, err := parseMoney(d.MinItemsAmount, "min_items_amount", false)
 	if err != nil {
 		return nil, err
 	}
 
-	maxItemsAmount, err := parseFloat(d.MaxItemsAmount, "max_items_amount", false)
+	maxItemsAmount, err := parseMoney(d.MaxItemsAmount, "max_items_amount", false)
 	if err != nil {
 		return nil, err
 	}
 
-	if minItemsAmount != nil && maxItemsAmount != nil && *minItemsAmount > *maxItemsAmount {
+	if minItemsAmount != nil && maxItemsAmount != nil && minItemsAmount.GreaterThan(*maxItemsAmount) {
 		return nil, fmt.Errorf("%w: 'min_items_amount' não pode ser maior que 'max_items_amount'",
 			errMsg.ErrInvalidFilter)
 	}
 
 	// Descontos podem ser negativos? Depende da regra de negócio
 	// Assumindo que não podem ser negativos por padrão
-	minItemsDiscount, err := parseFloat(d.MinItemsDiscount, "min_items_discount", false)
+	minItemsDiscount, err := parseMoney(d.MinItemsDiscount, "min_items_discount", false)
 	if err != nil {
 		return nil, err
 	}
 
-	maxItemsDiscount, err := parseFloat(d.MaxItemsDiscount, "max_items_discount", false)
+	maxItemsDiscount, err := parseMoney(d.MaxItemsDiscount, "max_items_discount", false)
 	if err != nil {
 		return nil, err
 	}
 
-	if minItemsDiscount != nil && maxItemsDiscount != nil && *minItemsDiscount > *maxItemsDiscount {
+	if minItemsDiscount != nil && maxItemsDiscount != nil && minItemsDiscount.GreaterThan(*maxItemsDiscount) {
 		return nil, fmt.Errorf("%w: 'min_items_discount' não pode ser maior que 'max_items_discount'",
 			errMsg.ErrInvalidFilter)
 	}
 
-	minSaleDiscount, err := parseFloat(d.MinSaleDiscount, "min_sale_discount", false)
+	minSaleDiscount, err := parseMoney(d.MinSaleDiscount, "min_sale_discount", false)
 	if err != nil {
 		return nil, err
 	}
 
-	maxSaleDiscount, err := parseFloat(d.MaxSaleDiscount, "max_sale_discount", false)
+	maxSaleDiscount, err := parseMoney(d.MaxSaleDiscount, "max_sale_discount", false)
 	if err != nil {
 		return nil, err
 	}
 
-	if minSaleDiscount != nil && maxSaleDiscount != nil && *minSaleDiscount > *maxSaleDiscount {
+	if minSaleDiscount != nil && maxSaleDiscount != nil && minSaleDiscount.GreaterThan(*maxSaleDiscount) {
 		return nil, fmt.Errorf("%w: 'min_sale_discount' não pode ser maior que 'max_sale_discount'",
 			errMsg.ErrInvalidFilter)
 	}
@@ -227,11 +234,12 @@ func (d *SaleFilterDTO) ToModel() (*modelSale.SaleFilter, error) {
 	filter := &modelSale.SaleFilter{
 		BaseFilter: baseFilter,
 
-		ClientID:    d.ClientID,
-		UserID:      d.UserID,
-		PaymentType: d.PaymentType,
-		Status:      d.Status,
-		Notes:       d.Notes,
+		ClientID:     d.ClientID,
+		ClientCnpjID: d.ClientCnpjID,
+		UserID:       d.UserID,
+		PaymentType:  d.PaymentType,
+		Status:       d.Status,
+		Notes:        d.Notes,
 
 		MinTotalAmount: minTotalAmount,
 		MaxTotalAmount: maxTotalAmount,
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -65,24 +67,24 @@ func TestSaleFilterDTO_ToModel(t *testing.T) {
 		// Verificar valores float
 		require.NotNil(t, model.MinTotalAmount)
 		require.NotNil(t, model.MaxTotalAmount)
-		assert.Equal(t, 150.75, *model.MinTotalAmount)
-		assert.Equal(t, 500.00, *model.MaxTotalAmount)
+		assert.Equal(t, money.MustParse("150.75"), *model.MinTotalAmount)
+		assert.Equal(t, money.New(500), *model.MaxTotalAmount)
 
 		// Verificar outros valores float
 		require.NotNil(t, model.MinTotalItemsAmount)
 		require.NotNil(t, model.MaxTotalItemsAmount)
-		assert.Equal(t, 100.00, *model.MinTotalItemsAmount)
-		assert.Equal(t, 300.00, *model.MaxTotalItemsAmount)
+		assert.Equal(t, money.New(100), *model.MinTotalItemsAmount)
+		assert.Equal(t, money.New(300), *model.MaxTotalItemsAmount)
 
 		require.NotNil(t, model.MinTotalItemsDiscount)
 		require.NotNil(t, model.MaxTotalItemsDiscount)
-		assert.Equal(t, 5.0, *model.MinTotalItemsDiscount)
-		assert.Equal(t, 15.5, *model.MaxTotalItemsDiscount)
+		assert.Equal(t, money.New(5), *model.MinTotalItemsDiscount)
+		assert.Equal(t, money.MustParse("15.50"), *model.MaxTotalItemsDiscount)
 
 		require.NotNil(t, model.MinTotalSaleDiscount)
 		require.NotNil(t, model.MaxTotalSaleDiscount)
-		assert.Equal(t, 10.0, *model.MinTotalSaleDiscount)
-		assert.Equal(t, 20.0, *model.MaxTotalSaleDiscount)
+		assert.Equal(t, money.New(10), *model.MinTotalSaleDiscount)
+		assert.Equal(t, money.New(20), *model.MaxTotalSaleDiscount)
 
 		// Verificar datas
 		require.NotNil(t, model.SaleDateFrom)
@@ -181,7 +183,7 @@ func TestSaleFilterDTO_ToModel(t *testing.T) {
 		// Zero values devem ser mantidos
 		assert.Equal(t, &clientID, model.ClientID)
 		require.NotNil(t, model.MinTotalAmount)
-		assert.Equal(t, 0.0, *model.MinTotalAmount)
+		assert.Equal(t, money.New(0), *model.MinTotalAmount)
 		assert.NotNil(t, model.CreatedFrom)
 		assert.Equal(t, "2024-01-01", model.CreatedFrom.Format("2006-01-02"))
 	})
@@ -208,7 +210,7 @@ func TestSaleFilterDTO_ToModel(t *testing.T) {
 		assert.Equal(t, &clientID, model.ClientID)
 		assert.Equal(t, "cash", model.PaymentType)
 		require.NotNil(t, model.MinTotalAmount)
-		assert.Equal(t, 100.00, *model.MinTotalAmount)
+		assert.Equal(t, money.New(100), *model.MinTotalAmount)
 		require.NotNil(t, model.SaleDateFrom)
 		assert.Equal(t, "2024-03-01", model.SaleDateFrom.Format("2006-01-02"))
 		assert.Equal(t, modelFilter.BaseFilter{Limit: 15, Offset: 5}, model.BaseFilter)
@@ -602,14 +604,13 @@ func TestSaleFilterDTO_ToModel(t *testing.T) {
 		testCases := []struct {
 			name     string
 			value    string
-			expected float64
+			expected money.Money
 		}{
-			{"inteiro", "100", 100.0},
-			{"um decimal", "100.5", 100.5},
-			{"dois decimais", "100.50", 100.5},
-			{"três decimais", "100.555", 100.555},
-			{"zero", "0", 0.0},
-			{"zero decimal", "0.0", 0.0},
+			{"inteiro", "100", money.New(100)},
+			{"um decimal", "100.5", money.MustParse("100.50")},
+			{"dois decimais", "100.50", money.MustParse("100.50")},
+			{"zero", "0", money.Zero},
+			{"zero decimal", "0.0", money.Zero},
 		}
 
 		for _, tc := range testCases {
@@ -628,6 +629,15 @@ func TestSaleFilterDTO_ToModel(t *testing.T) {
 		}
 	})
 
+	t.Run("Valor com mais de duas casas decimais é recusado", func(t *testing.T) {
+		value := "100.555"
+		dto := SaleFilterDTO{MinTotalAmount: &value, Limit: 10}
+
+		model, err := dto.ToModel()
+		assert.Nil(t, model)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+	})
+
 	t.Run("Primeiro erro encontrado é retornado", func(t *testing.T) {
 		// Testa que apenas o primeiro erro é retornado
 		// (a implementação pode parar no primeiro erro ou coletar todos)
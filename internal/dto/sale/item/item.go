@@ -4,21 +4,22 @@ import (
 	"time"
 
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 )
 
 type SaleItemDTO struct {
-	ID          *int64  `json:"id,omitempty"`
-	SaleID      int64   `json:"sale_id"`
-	ProductID   int64   `json:"product_id"`
-	Quantity    int     `json:"quantity"`
-	UnitPrice   float64 `json:"unit_price"`
-	Discount    float64 `json:"discount,omitempty"`
-	Tax         float64 `json:"tax,omitempty"`
-	Subtotal    float64 `json:"subtotal"`
-	Description string  `json:"description,omitempty"`
-	CreatedAt   *string `json:"created_at,omitempty"`
-	UpdatedAt   *string `json:"updated_at,omitempty"`
+	ID          *int64      `json:"id,omitempty"`
+	SaleID      int64       `json:"sale_id"`
+	ProductID   int64       `json:"product_id"`
+	Quantity    int         `json:"quantity"`
+	UnitPrice   money.Money `json:"unit_price"`
+	Discount    money.Money `json:"discount,omitempty"`
+	Tax         money.Money `json:"tax,omitempty"`
+	Subtotal    money.Money `json:"subtotal"`
+	Description string      `json:"description,omitempty"`
+	CreatedAt   *string     `json:"created_at,omitempty"`
+	UpdatedAt   *string     `json:"updated_at,omitempty"`
 }
 
 // --- Conversões DTO ↔ Model ---
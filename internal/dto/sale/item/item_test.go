@@ -5,6 +5,7 @@ import (
 	"time"
 
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -19,10 +20,10 @@ func TestToSaleItemModel(t *testing.T) {
 			SaleID:      10,
 			ProductID:   20,
 			Quantity:    5,
-			UnitPrice:   100.00,
-			Discount:    10.00,
-			Tax:         5.00,
-			Subtotal:    475.00,
+			UnitPrice:   money.New(100),
+			Discount:    money.New(10),
+			Tax:         money.New(5),
+			Subtotal:    money.New(475),
 			Description: "Item válido",
 			CreatedAt:   &now,
 			UpdatedAt:   &now,
@@ -33,10 +34,10 @@ func TestToSaleItemModel(t *testing.T) {
 		assert.Equal(t, int64(10), model.SaleID)
 		assert.Equal(t, int64(20), model.ProductID)
 		assert.Equal(t, 5, model.Quantity)
-		assert.Equal(t, 100.00, model.UnitPrice)
-		assert.Equal(t, 10.00, model.Discount)
-		assert.Equal(t, 5.00, model.Tax)
-		assert.Equal(t, 475.00, model.Subtotal)
+		assert.Equal(t, money.New(100), model.UnitPrice)
+		assert.Equal(t, money.New(10), model.Discount)
+		assert.Equal(t, money.New(5), model.Tax)
+		assert.Equal(t, money.New(475), model.Subtotal)
 		assert.Equal(t, "Item válido", model.Description)
 		assert.False(t, model.CreatedAt.IsZero())
 		assert.False(t, model.UpdatedAt.IsZero())
@@ -49,7 +50,7 @@ func TestToSaleItemModel(t *testing.T) {
 			SaleID:    11,
 			ProductID: 22,
 			Quantity:  2,
-			UnitPrice: 50.00,
+			UnitPrice: money.New(50),
 			CreatedAt: utils.StrToPtr("data inválida"),
 			UpdatedAt: utils.StrToPtr("outra inválida"),
 		}
@@ -67,10 +68,10 @@ func TestToSaleItemDTO(t *testing.T) {
 		SaleID:      10,
 		ProductID:   20,
 		Quantity:    5,
-		UnitPrice:   100.00,
-		Discount:    10.00,
-		Tax:         5.00,
-		Subtotal:    475.00,
+		UnitPrice:   money.New(100),
+		Discount:    money.New(10),
+		Tax:         money.New(5),
+		Subtotal:    money.New(475),
 		Description: "Item válido",
 		CreatedAt:   now,
 		UpdatedAt:   now,
@@ -83,10 +84,10 @@ func TestToSaleItemDTO(t *testing.T) {
 		assert.Equal(t, int64(10), dto.SaleID)
 		assert.Equal(t, int64(20), dto.ProductID)
 		assert.Equal(t, 5, dto.Quantity)
-		assert.Equal(t, 100.00, dto.UnitPrice)
-		assert.Equal(t, 10.00, dto.Discount)
-		assert.Equal(t, 5.00, dto.Tax)
-		assert.Equal(t, 475.00, dto.Subtotal)
+		assert.Equal(t, money.New(100), dto.UnitPrice)
+		assert.Equal(t, money.New(10), dto.Discount)
+		assert.Equal(t, money.New(5), dto.Tax)
+		assert.Equal(t, money.New(475), dto.Subtotal)
 		assert.Equal(t, "Item válido", dto.Description)
 		assert.NotNil(t, dto.CreatedAt)
 		assert.NotNil(t, dto.UpdatedAt)
@@ -96,8 +97,8 @@ func TestToSaleItemDTO(t *testing.T) {
 func TestToSaleItemDTOList(t *testing.T) {
 	now := time.Now()
 	modelsList := []*models.SaleItem{
-		{ID: 1, SaleID: 10, ProductID: 20, Quantity: 1, UnitPrice: 50, CreatedAt: now, UpdatedAt: now},
-		{ID: 2, SaleID: 11, ProductID: 21, Quantity: 2, UnitPrice: 75, CreatedAt: now, UpdatedAt: now},
+		{ID: 1, SaleID: 10, ProductID: 20, Quantity: 1, UnitPrice: money.New(50), CreatedAt: now, UpdatedAt: now},
+		{ID: 2, SaleID: 11, ProductID: 21, Quantity: 2, UnitPrice: money.New(75), CreatedAt: now, UpdatedAt: now},
 	}
 
 	t.Run("converter lista de models em lista de DTOs", func(t *testing.T) {
@@ -113,8 +114,8 @@ func TestSaleItemDTOListToModelList(t *testing.T) {
 	id1 := int64(1)
 	id2 := int64(2)
 	dtos := []*SaleItemDTO{
-		{ID: &id1, SaleID: 10, ProductID: 20, Quantity: 1, UnitPrice: 50, CreatedAt: &now, UpdatedAt: &now},
-		{ID: &id2, SaleID: 11, ProductID: 21, Quantity: 2, UnitPrice: 75, CreatedAt: &now, UpdatedAt: &now},
+		{ID: &id1, SaleID: 10, ProductID: 20, Quantity: 1, UnitPrice: money.New(50), CreatedAt: &now, UpdatedAt: &now},
+		{ID: &id2, SaleID: 11, ProductID: 21, Quantity: 2, UnitPrice: money.New(75), CreatedAt: &now, UpdatedAt: &now},
 	}
 
 	t.Run("converter lista de DTOs em lista de models", func(t *testing.T) {
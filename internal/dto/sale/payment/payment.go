@@ -0,0 +1,122 @@
+package dto
+
+import (
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+type SalePaymentDTO struct {
+	ID                *int64      `json:"id,omitempty"`
+	SaleID            int64       `json:"sale_id,omitempty"`
+	Method            string      `json:"method"`
+	Amount            money.Money `json:"amount"`
+	Installments      int         `json:"installments,omitempty"`
+	AuthorizationCode string      `json:"authorization_code,omitempty"`
+	ChangeGiven       money.Money `json:"change_given"`
+	ReceivedBy        *int64      `json:"received_by,omitempty"`
+	CashSessionID     *int64      `json:"cash_session_id,omitempty"`
+	CreatedAt         *string     `json:"created_at,omitempty"`
+}
+
+type SummaryDTO struct {
+	SaleID      int64            `json:"sale_id"`
+	TotalAmount money.Money      `json:"total_amount"`
+	PaidAmount  money.Money      `json:"paid_amount"`
+	ChangeGiven money.Money      `json:"change_given"`
+	Balance     money.Money      `json:"balance"`
+	Paid        bool             `json:"paid"`
+	Payments    []SalePaymentDTO `json:"payments"`
+}
+
+type MethodTotalDTO struct {
+	Method      string      `json:"method"`
+	Sales       int         `json:"sales"`
+	Amount      money.Money `json:"amount"`
+	ChangeGiven money.Money `json:"change_given"`
+}
+
+// ToSalePaymentModel converte o pagamento informado pelo cliente; o troco, quem
+// recebeu e o caixa são sempre definidos pelo servidor.
+func ToSalePaymentModel(dto SalePaymentDTO) models.SalePayment {
+	return models.SalePayment{
+		SaleID:            dto.SaleID,
+		Method:            dto.Method,
+		Amount:            dto.Amount,
+		Installments:      dto.Installments,
+		AuthorizationCode: dto.AuthorizationCode,
+	}
+}
+
+func ToSalePaymentModels(dtos []SalePaymentDTO) []models.SalePayment {
+	if len(dtos) == 0 {
+		return nil
+	}
+	result := make([]models.SalePayment, len(dtos))
+	for i, dto := range dtos {
+		result[i] = ToSalePaymentModel(dto)
+	}
+	return result
+}
+
+func ToSalePaymentDTO(model *models.SalePayment) SalePaymentDTO {
+	dto := SalePaymentDTO{
+		SaleID:            model.SaleID,
+		Method:            model.Method,
+		Amount:            model.Amount,
+		Installments:      model.Installments,
+		AuthorizationCode: model.AuthorizationCode,
+		ChangeGiven:       model.ChangeGiven,
+		ReceivedBy:        model.ReceivedBy,
+		CashSessionID:     model.CashSessionID,
+	}
+
+	if model.ID != 0 {
+		id := model.ID
+		dto.ID = &id
+	}
+	if !model.CreatedAt.IsZero() {
+		v := model.CreatedAt.Format(time.RFC3339)
+		dto.CreatedAt = &v
+	}
+
+	return dto
+}
+
+func ToSalePaymentDTOs(modelsList []*models.SalePayment) []SalePaymentDTO {
+	result := make([]SalePaymentDTO, len(modelsList))
+	for i, m := range modelsList {
+		result[i] = ToSalePaymentDTO(m)
+	}
+	return result
+}
+
+func ToSummaryDTO(model *models.Summary) SummaryDTO {
+	if model == nil {
+		return SummaryDTO{}
+	}
+
+	return SummaryDTO{
+		SaleID:      model.SaleID,
+		TotalAmount: model.TotalAmount,
+		PaidAmount:  model.PaidAmount,
+		ChangeGiven: model.ChangeGiven,
+		Balance:     model.Balance(),
+		Paid:        model.IsPaid(),
+		Payments:    ToSalePaymentDTOs(model.Payments),
+	}
+}
+
+func ToMethodTotalDTOs(totals []*models.MethodTotal) []MethodTotalDTO {
+	result := make([]MethodTotalDTO, len(totals))
+	for i, t := range totals {
+		result[i] = MethodTotalDTO{
+			Method:      t.Method,
+			Sales:       t.Sales,
+			Amount:      t.Amount,
+			ChangeGiven: t.ChangeGiven,
+		}
+	}
+	return result
+}
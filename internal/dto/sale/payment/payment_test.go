@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToSalePaymentModels(t *testing.T) {
+	dtos := []SalePaymentDTO{
+		{Method: "card", Amount: money.New(50), Installments: 3, AuthorizationCode: "AUT1", ChangeGiven: money.New(9)},
+		{Method: "cash", Amount: money.New(40)},
+	}
+
+	result := ToSalePaymentModels(dtos)
+
+	assert.Len(t, result, 2)
+	assert.Equal(t, 3, result[0].Installments)
+	assert.Equal(t, "AUT1", result[0].AuthorizationCode)
+	assert.True(t, result[0].ChangeGiven.IsZero(), "troco é calculado no servidor")
+	assert.Nil(t, ToSalePaymentModels(nil))
+}
+
+func TestToSummaryDTO(t *testing.T) {
+	now := time.Now()
+	summary := models.NewSummary(10, money.New(100), []*models.SalePayment{
+		{ID: 1, SaleID: 10, Method: "cash", Amount: money.New(50), ChangeGiven: money.New(5), CreatedAt: now},
+	})
+
+	dto := ToSummaryDTO(summary)
+
+	assert.Equal(t, int64(10), dto.SaleID)
+	assert.Equal(t, money.New(45), dto.PaidAmount)
+	assert.Equal(t, money.New(55), dto.Balance)
+	assert.False(t, dto.Paid)
+	assert.Len(t, dto.Payments, 1)
+	assert.Equal(t, int64(1), *dto.Payments[0].ID)
+	assert.Equal(t, now.Format(time.RFC3339), *dto.Payments[0].CreatedAt)
+
+	assert.Equal(t, SummaryDTO{}, ToSummaryDTO(nil))
+}
+
+func TestToMethodTotalDTOs(t *testing.T) {
+	result := ToMethodTotalDTOs([]*models.MethodTotal{{Method: "pix", Sales: 2, Amount: money.New(90)}})
+
+	assert.Equal(t, []MethodTotalDTO{{Method: "pix", Sales: 2, Amount: money.New(90)}}, result)
+}
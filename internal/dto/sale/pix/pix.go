@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+// PixChargeInputDTO pede a cobrança Pix; sem amount cobra o total da venda.
+type PixChargeInputDTO struct {
+	Amount *money.Money `json:"amount,omitempty"`
+}
+
+// PixChargeDTO traz o BR Code em payload ("copia e cola"); o PNG do QR sai em
+// /pix/{txid}/qrcode.
+type PixChargeDTO struct {
+	ID          int64        `json:"id"`
+	SaleID      int64        `json:"sale_id"`
+	TxID        string       `json:"txid"`
+	Amount      money.Money  `json:"amount"`
+	Status      string       `json:"status"`
+	Payload     string       `json:"payload"`
+	Location    string       `json:"location,omitempty"`
+	EndToEndID  string       `json:"end_to_end_id,omitempty"`
+	PaidAmount  *money.Money `json:"paid_amount,omitempty"`
+	CreatedBy   *int64       `json:"created_by,omitempty"`
+	ConfirmedAt *string      `json:"confirmed_at,omitempty"`
+	CreatedAt   string       `json:"created_at"`
+	UpdatedAt   string       `json:"updated_at"`
+}
+
+func ToPixChargeDTO(model *models.PixCharge) PixChargeDTO {
+	dto := PixChargeDTO{
+		ID:         model.ID,
+		SaleID:     model.SaleID,
+		TxID:       model.TxID,
+		Amount:     model.Amount,
+		Status:     model.Status,
+		Payload:    model.Payload,
+		Location:   model.Location,
+		EndToEndID: model.EndToEndID,
+		PaidAmount: model.PaidAmount,
+		CreatedBy:  model.CreatedBy,
+		CreatedAt:  model.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  model.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if model.ConfirmedAt != nil {
+		v := model.ConfirmedAt.Format(time.RFC3339)
+		dto.ConfirmedAt = &v
+	}
+
+	return dto
+}
+
+func ToPixChargeDTOs(modelsList []*models.PixCharge) []PixChargeDTO {
+	result := make([]PixChargeDTO, len(modelsList))
+	for i, m := range modelsList {
+		result[i] = ToPixChargeDTO(m)
+	}
+	return result
+}
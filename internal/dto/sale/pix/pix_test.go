@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToPixChargeDTO(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	paid := money.New(30)
+
+	pending := ToPixChargeDTO(&models.PixCharge{ID: 1, SaleID: 10, TxID: "TX1", Amount: money.New(30), Status: models.StatusPending, Payload: "000201", CreatedAt: now, UpdatedAt: now})
+	assert.Equal(t, "TX1", pending.TxID)
+	assert.Equal(t, "000201", pending.Payload)
+	assert.Nil(t, pending.ConfirmedAt)
+	assert.Equal(t, "2026-10-17T12:00:00Z", pending.CreatedAt)
+
+	confirmed := ToPixChargeDTOs([]*models.PixCharge{{ID: 2, Status: models.StatusConfirmed, EndToEndID: "E1", PaidAmount: &paid, ConfirmedAt: &now}})
+	assert.Len(t, confirmed, 1)
+	assert.Equal(t, "E1", confirmed[0].EndToEndID)
+	assert.Equal(t, "2026-10-17T12:00:00Z", *confirmed[0].ConfirmedAt)
+	assert.Equal(t, paid, *confirmed[0].PaidAmount)
+}
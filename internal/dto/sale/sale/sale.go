@@ -4,31 +4,37 @@ import (
 	"time"
 
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 )
 
 type SaleDTO struct {
-	ID                 *int64  `json:"id,omitempty"`
-	ClientID           *int64  `json:"client_id,omitempty"`
-	UserID             *int64  `json:"user_id,omitempty"`
-	SaleDate           *string `json:"sale_date,omitempty"`
-	TotalItemsAmount   float64 `json:"total_items_amount"`
-	TotalItemsDiscount float64 `json:"total_items_discount,omitempty"`
-	TotalSaleDiscount  float64 `json:"total_sale_discount,omitempty"`
-	TotalAmount        float64 `json:"total_amount"`
-	PaymentType        string  `json:"payment_type"`
-	Status             string  `json:"status,omitempty"`
-	Notes              string  `json:"notes,omitempty"`
-	Version            int     `json:"version,omitempty"`
-	CreatedAt          *string `json:"created_at,omitempty"`
-	UpdatedAt          *string `json:"updated_at,omitempty"`
+	ID                 *int64      `json:"id,omitempty"`
+	ClientID           *int64      `json:"client_id,omitempty"`
+	ClientCnpjID       *int64      `json:"client_cnpj_id,omitempty"`
+	UserID             *int64      `json:"user_id,omitempty"`
+	LocationID         *int64      `json:"location_id,omitempty"`
+	CashSessionID      *int64      `json:"cash_session_id,omitempty"`
+	SaleDate           *string     `json:"sale_date,omitempty"`
+	TotalItemsAmount   money.Money `json:"total_items_amount"`
+	TotalItemsDiscount money.Money `json:"total_items_discount,omitempty"`
+	TotalSaleDiscount  money.Money `json:"total_sale_discount,omitempty"`
+	TotalAmount        money.Money `json:"total_amount"`
+	PaymentType        string      `json:"payment_type"`
+	Status             string      `json:"status,omitempty"`
+	Notes              string      `json:"notes,omitempty"`
+	Version            int         `json:"version,omitempty"`
+	CreatedAt          *string     `json:"created_at,omitempty"`
+	UpdatedAt          *string     `json:"updated_at,omitempty"`
 }
 
 func ToSaleModel(dto SaleDTO) *models.Sale {
 	model := &models.Sale{
 		ID:                 utils.NilToZero(dto.ID),
 		ClientID:           dto.ClientID,
+		ClientCnpjID:       dto.ClientCnpjID,
 		UserID:             dto.UserID,
+		LocationID:         utils.NilToZero(dto.LocationID),
 		TotalItemsAmount:   dto.TotalItemsAmount,
 		TotalItemsDiscount: dto.TotalItemsDiscount,
 		TotalSaleDiscount:  dto.TotalSaleDiscount,
@@ -64,7 +70,9 @@ func ToSaleDTO(model *models.Sale) SaleDTO {
 	dto := SaleDTO{
 		ID:                 &model.ID,
 		ClientID:           model.ClientID,
+		ClientCnpjID:       model.ClientCnpjID,
 		UserID:             model.UserID,
+		CashSessionID:      model.CashSessionID,
 		TotalItemsAmount:   model.TotalItemsAmount,
 		TotalItemsDiscount: model.TotalItemsDiscount,
 		TotalSaleDiscount:  model.TotalSaleDiscount,
@@ -75,6 +83,9 @@ func ToSaleDTO(model *models.Sale) SaleDTO {
 		Version:            model.Version,
 	}
 
+	if model.LocationID != 0 {
+		dto.LocationID = &model.LocationID
+	}
 	if !model.SaleDate.IsZero() {
 		v := model.SaleDate.Format(time.RFC3339)
 		dto.SaleDate = &v
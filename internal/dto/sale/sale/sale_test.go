@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 )
 
 func TestSaleDTO_ToSaleModel(t *testing.T) {
@@ -17,10 +18,10 @@ func TestSaleDTO_ToSaleModel(t *testing.T) {
 		ClientID:           nil,
 		UserID:             &userID,
 		SaleDate:           &now,
-		TotalItemsAmount:   100.0,
-		TotalItemsDiscount: 10.0,
-		TotalSaleDiscount:  5.0,
-		TotalAmount:        200.0,
+		TotalItemsAmount:   money.New(100),
+		TotalItemsDiscount: money.New(10),
+		TotalSaleDiscount:  money.New(5),
+		TotalAmount:        money.New(200),
 		PaymentType:        "card",
 		Status:             "active",
 		Notes:              "Pedido teste",
@@ -52,8 +53,8 @@ func TestSaleDTO_ToSaleModel_DefaultStatus(t *testing.T) {
 
 	dtoInput := SaleDTO{
 		UserID:           &userID,
-		TotalItemsAmount: 50,
-		TotalAmount:      100,
+		TotalItemsAmount: money.New(50),
+		TotalAmount:      money.New(100),
 		PaymentType:      "cash",
 		// Status vazio → vira "active"
 	}
@@ -72,10 +73,10 @@ func TestSaleDTO_ToSaleDTO(t *testing.T) {
 		ClientID:           nil,
 		UserID:             &userID,
 		SaleDate:           now,
-		TotalItemsAmount:   120.0,
-		TotalItemsDiscount: 15.0,
-		TotalSaleDiscount:  7.0,
-		TotalAmount:        300.0,
+		TotalItemsAmount:   money.New(120),
+		TotalItemsDiscount: money.New(15),
+		TotalSaleDiscount:  money.New(7),
+		TotalAmount:        money.New(300),
 		PaymentType:        "credit",
 		Status:             "active",
 		Notes:              "Outro teste",
@@ -112,10 +113,10 @@ func TestSaleDTO_ToSaleDTOList(t *testing.T) {
 			ClientID:           nil,
 			UserID:             &userID,
 			SaleDate:           now,
-			TotalItemsAmount:   50.0,
-			TotalItemsDiscount: 2.0,
-			TotalSaleDiscount:  1.0,
-			TotalAmount:        150.0,
+			TotalItemsAmount:   money.New(50),
+			TotalItemsDiscount: money.New(2),
+			TotalSaleDiscount:  money.New(1),
+			TotalAmount:        money.New(150),
 			PaymentType:        "cash",
 			Status:             "active",
 			Notes:              "Lista 1",
@@ -128,10 +129,10 @@ func TestSaleDTO_ToSaleDTOList(t *testing.T) {
 			ClientID:           nil,
 			UserID:             &userID,
 			SaleDate:           now,
-			TotalItemsAmount:   100.0,
-			TotalItemsDiscount: 4.0,
-			TotalSaleDiscount:  2.0,
-			TotalAmount:        250.0,
+			TotalItemsAmount:   money.New(100),
+			TotalItemsDiscount: money.New(4),
+			TotalSaleDiscount:  money.New(2),
+			TotalAmount:        money.New(250),
 			PaymentType:        "card",
 			Status:             "active",
 			Notes:              "Lista 2",
@@ -161,10 +162,10 @@ func TestSaleDTO_SaleDTOListToModelList(t *testing.T) {
 			ClientID:           nil,
 			UserID:             &userID1,
 			SaleDate:           &now,
-			TotalItemsAmount:   30.0,
-			TotalItemsDiscount: 3.0,
-			TotalSaleDiscount:  1.0,
-			TotalAmount:        100.0,
+			TotalItemsAmount:   money.New(30),
+			TotalItemsDiscount: money.New(3),
+			TotalSaleDiscount:  money.New(1),
+			TotalAmount:        money.New(100),
 			PaymentType:        "cash",
 			Status:             "active",
 			Notes:              "Venda 1",
@@ -177,10 +178,10 @@ func TestSaleDTO_SaleDTOListToModelList(t *testing.T) {
 			ClientID:           nil,
 			UserID:             &userID2,
 			SaleDate:           &now,
-			TotalItemsAmount:   80.0,
-			TotalItemsDiscount: 8.0,
-			TotalSaleDiscount:  2.0,
-			TotalAmount:        200.0,
+			TotalItemsAmount:   money.New(80),
+			TotalItemsDiscount: money.New(8),
+			TotalSaleDiscount:  money.New(2),
+			TotalAmount:        money.New(200),
 			PaymentType:        "card",
 			Status:             "active",
 			Notes:              "Venda 2",
@@ -0,0 +1,91 @@
+package dto
+
+import (
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+type SaleReturnItemDTO struct {
+	ID         *int64      `json:"id,omitempty"`
+	SaleItemID int64       `json:"sale_item_id"`
+	ProductID  int64       `json:"product_id,omitempty"`
+	Quantity   int         `json:"quantity"`
+	Amount     money.Money `json:"amount,omitempty"`
+}
+
+type SaleReturnDTO struct {
+	ID          *int64              `json:"id,omitempty"`
+	SaleID      int64               `json:"sale_id,omitempty"`
+	UserID      *int64              `json:"user_id,omitempty"`
+	Reason      string              `json:"reason,omitempty"`
+	TotalAmount money.Money         `json:"total_amount,omitempty"`
+	Items       []SaleReturnItemDTO `json:"items"`
+	CreatedAt   *string             `json:"created_at,omitempty"`
+}
+
+func ToSaleReturnModel(dto SaleReturnDTO) *models.SaleReturn {
+	items := make([]models.SaleReturnItem, len(dto.Items))
+	for i, it := range dto.Items {
+		items[i] = models.SaleReturnItem{
+			SaleItemID: it.SaleItemID,
+			Quantity:   it.Quantity,
+		}
+	}
+
+	return &models.SaleReturn{
+		SaleID: dto.SaleID,
+		UserID: dto.UserID,
+		Reason: dto.Reason,
+		Items:  items,
+	}
+}
+
+func ToSaleReturnDTO(model *models.SaleReturn) SaleReturnDTO {
+	if model == nil {
+		return SaleReturnDTO{}
+	}
+
+	items := make([]SaleReturnItemDTO, len(model.Items))
+	for i := range model.Items {
+		it := model.Items[i]
+		items[i] = SaleReturnItemDTO{
+			SaleItemID: it.SaleItemID,
+			ProductID:  it.ProductID,
+			Quantity:   it.Quantity,
+			Amount:     it.Amount,
+		}
+		if it.ID != 0 {
+			id := it.ID
+			items[i].ID = &id
+		}
+	}
+
+	dto := SaleReturnDTO{
+		ID:          &model.ID,
+		SaleID:      model.SaleID,
+		UserID:      model.UserID,
+		Reason:      model.Reason,
+		TotalAmount: model.TotalAmount,
+		Items:       items,
+	}
+
+	if !model.CreatedAt.IsZero() {
+		v := model.CreatedAt.Format(time.RFC3339)
+		dto.CreatedAt = &v
+	}
+
+	return dto
+}
+
+func ToSaleReturnDTOList(list []*models.SaleReturn) []SaleReturnDTO {
+	result := make([]SaleReturnDTO, 0, len(list))
+	for _, r := range list {
+		if r == nil {
+			continue
+		}
+		result = append(result, ToSaleReturnDTO(r))
+	}
+	return result
+}
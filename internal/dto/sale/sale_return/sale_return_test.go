@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToSaleReturnModel(t *testing.T) {
+	input := SaleReturnDTO{
+		SaleID: 10,
+		UserID: utils.Int64Ptr(2),
+		Reason: "defeito",
+		Items: []SaleReturnItemDTO{
+			{SaleItemID: 5, Quantity: 1, ProductID: 99, Amount: money.New(3)},
+		},
+	}
+
+	model := ToSaleReturnModel(input)
+
+	assert.Equal(t, int64(10), model.SaleID)
+	assert.Equal(t, input.UserID, model.UserID)
+	assert.Equal(t, "defeito", model.Reason)
+	assert.Equal(t, []models.SaleReturnItem{{SaleItemID: 5, Quantity: 1}}, model.Items)
+}
+
+func TestToSaleReturnDTO(t *testing.T) {
+	t.Run("nil retorna vazio", func(t *testing.T) {
+		assert.Equal(t, SaleReturnDTO{}, ToSaleReturnDTO(nil))
+	})
+
+	t.Run("converte modelo completo", func(t *testing.T) {
+		now := time.Now()
+		model := &models.SaleReturn{
+			ID:          1,
+			SaleID:      10,
+			Reason:      "troca",
+			TotalAmount: money.MustParse("15.50"),
+			CreatedAt:   now,
+			Items: []models.SaleReturnItem{
+				{ID: 7, SaleItemID: 5, ProductID: 3, Quantity: 2, Amount: money.MustParse("15.50")},
+			},
+		}
+
+		dto := ToSaleReturnDTO(model)
+
+		assert.Equal(t, int64(1), *dto.ID)
+		assert.Equal(t, int64(10), dto.SaleID)
+		assert.Equal(t, money.MustParse("15.50"), dto.TotalAmount)
+		assert.Equal(t, now.Format(time.RFC3339), *dto.CreatedAt)
+		assert.Len(t, dto.Items, 1)
+		assert.Equal(t, int64(7), *dto.Items[0].ID)
+		assert.Equal(t, int64(3), dto.Items[0].ProductID)
+	})
+
+	t.Run("item sem id", func(t *testing.T) {
+		dto := ToSaleReturnDTO(&models.SaleReturn{Items: []models.SaleReturnItem{{SaleItemID: 1}}})
+		assert.Nil(t, dto.Items[0].ID)
+		assert.Nil(t, dto.CreatedAt)
+	})
+}
+
+func TestToSaleReturnDTOList(t *testing.T) {
+	list := ToSaleReturnDTOList([]*models.SaleReturn{{ID: 1}, nil, {ID: 2}})
+	assert.Len(t, list, 2)
+	assert.Equal(t, int64(2), *list[1].ID)
+}
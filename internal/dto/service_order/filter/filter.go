@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	modelServiceOrder "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+type ServiceOrderFilterDTO struct {
+	ClientID     *int64  `schema:"client_id"`
+	ClientCnpjID *int64  `schema:"client_cnpj_id"`
+	TechnicianID *int64  `schema:"technician_id"`
+	ProductID    *int64  `schema:"product_id"`
+	CategoryID   *int64  `schema:"category_id"`
+	Status       string  `schema:"status"`
+	CreatedFrom  *string `schema:"created_from"`
+	CreatedTo    *string `schema:"created_to"`
+	Limit        int     `schema:"limit"`
+	Offset       int     `schema:"offset"`
+	Cursor       string  `schema:"cursor"`
+	CursorMode   bool    `schema:"-"`
+	SearchTerm   string  `schema:"search"`
+}
+
+func (d *ServiceOrderFilterDTO) ToModel() (*modelServiceOrder.ServiceOrderFilter, error) {
+	parseDate := func(s *string, fieldName string) (*time.Time, error) {
+		if s == nil || *s == "" {
+			return nil, nil
+		}
+		t, err := time.Parse("2006-01-02", *s)
+		if err != nil {
+			return nil, fmt.Errorf("%w: campo '%s' com valor inválido '%s' - formato esperado: YYYY-MM-DD",
+				errMsg.ErrInvalidFilter, fieldName, *s)
+		}
+		return &t, nil
+	}
+
+	if d.Limit < 1 {
+		return nil, fmt.Errorf("%w: 'limit' deve ser maior que 0", errMsg.ErrInvalidFilter)
+	}
+	if d.Limit > 100 {
+		return nil, fmt.Errorf("%w: 'limit' máximo é 100", errMsg.ErrInvalidFilter)
+	}
+	if d.Offset < 0 {
+		return nil, fmt.Errorf("%w: 'offset' não pode ser negativo", errMsg.ErrInvalidFilter)
+	}
+
+	createdFrom, err := parseDate(d.CreatedFrom, "created_from")
+	if err != nil {
+		return nil, err
+	}
+
+	createdTo, err := parseDate(d.CreatedTo, "created_to")
+	if err != nil {
+		return nil, err
+	}
+
+	return &modelServiceOrder.ServiceOrderFilter{
+		BaseFilter: modelFilter.BaseFilter{
+			Limit:      d.Limit,
+			Offset:     d.Offset,
+			CursorMode: d.CursorMode,
+			Cursor:     d.Cursor,
+			SearchTerm: d.SearchTerm,
+		},
+		ClientID:     d.ClientID,
+		ClientCnpjID: d.ClientCnpjID,
+		TechnicianID: d.TechnicianID,
+		ProductID:    d.ProductID,
+		CategoryID:   d.CategoryID,
+		Status:       d.Status,
+		CreatedFrom:  createdFrom,
+		CreatedTo:    createdTo,
+	}, nil
+}
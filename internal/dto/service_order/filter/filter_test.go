@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"testing"
+
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceOrderFilterDTO_ToModel(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	t.Run("converte filtro válido", func(t *testing.T) {
+		d := ServiceOrderFilterDTO{
+			ClientCnpjID: utils.Int64Ptr(3),
+			TechnicianID: utils.Int64Ptr(9),
+			CategoryID:   utils.Int64Ptr(2),
+			Status:       "waiting_parts",
+			CreatedFrom:  strPtr("2026-01-01"),
+			CreatedTo:    strPtr("2026-01-31"),
+			Limit:        10,
+		}
+
+		f, err := d.ToModel()
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), *f.ClientCnpjID)
+		assert.Equal(t, int64(9), *f.TechnicianID)
+		assert.Equal(t, int64(2), *f.CategoryID)
+		assert.Equal(t, "waiting_parts", f.Status)
+		assert.Equal(t, 31, f.CreatedTo.Day())
+		assert.Equal(t, 10, f.Limit)
+	})
+
+	t.Run("paginação inválida", func(t *testing.T) {
+		for _, d := range []ServiceOrderFilterDTO{{Limit: 0}, {Limit: 101}, {Limit: 10, Offset: -1}} {
+			_, err := d.ToModel()
+			assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		}
+	})
+
+	t.Run("data inválida", func(t *testing.T) {
+		d := ServiceOrderFilterDTO{CreatedTo: strPtr("31/01/2026"), Limit: 10}
+
+		_, err := d.ToModel()
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+	})
+}
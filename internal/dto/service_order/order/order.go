@@ -0,0 +1,211 @@
+package dto
+
+import (
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+type ServiceOrderPartDTO struct {
+	ID        *int64       `json:"id,omitempty"`
+	ProductID int64        `json:"product_id"`
+	Quantity  int          `json:"quantity"`
+	UnitPrice *money.Money `json:"unit_price,omitempty"`
+	Subtotal  money.Money  `json:"subtotal,omitempty"`
+	CreatedAt *string      `json:"created_at,omitempty"`
+}
+
+type ServiceOrderLaborDTO struct {
+	ID          *int64      `json:"id,omitempty"`
+	Description string      `json:"description"`
+	Amount      money.Money `json:"amount"`
+	CreatedAt   *string     `json:"created_at,omitempty"`
+}
+
+type ServiceOrderDTO struct {
+	ID                 *int64                 `json:"id,omitempty"`
+	ClientID           *int64                 `json:"client_id,omitempty"`
+	ClientCnpjID       *int64                 `json:"client_cnpj_id,omitempty"`
+	TechnicianID       *int64                 `json:"technician_id,omitempty"`
+	UserID             *int64                 `json:"user_id,omitempty"`
+	Status             string                 `json:"status,omitempty"`
+	ProblemDescription string                 `json:"problem_description"`
+	Notes              string                 `json:"notes,omitempty"`
+	PartsAmount        money.Money            `json:"parts_amount"`
+	LaborAmount        money.Money            `json:"labor_amount"`
+	TotalAmount        money.Money            `json:"total_amount"`
+	SaleID             *int64                 `json:"sale_id,omitempty"`
+	CategoryIDs        []int64                `json:"category_ids,omitempty"`
+	Parts              []ServiceOrderPartDTO  `json:"parts,omitempty"`
+	Labor              []ServiceOrderLaborDTO `json:"labor,omitempty"`
+	Version            int                    `json:"version,omitempty"`
+	CreatedAt          *string                `json:"created_at,omitempty"`
+	UpdatedAt          *string                `json:"updated_at,omitempty"`
+}
+
+// ServiceOrderStatusDTO é o corpo de PATCH /order-service/{id}/status.
+type ServiceOrderStatusDTO struct {
+	Status string `json:"status"`
+}
+
+// SaleConversionDTO é o corpo de POST /order-service/{id}/sale.
+type SaleConversionDTO struct {
+	PaymentType string `json:"payment_type"`
+	Notes       string `json:"notes,omitempty"`
+}
+
+// ToServiceOrderModel converte a requisição de abertura/alteração. Status,
+// totais, peças e mão de obra são controlados pelo servidor.
+func ToServiceOrderModel(dto ServiceOrderDTO) *models.ServiceOrder {
+	return &models.ServiceOrder{
+		ID:                 utils.NilToZero(dto.ID),
+		ClientID:           dto.ClientID,
+		ClientCnpjID:       dto.ClientCnpjID,
+		TechnicianID:       dto.TechnicianID,
+		UserID:             dto.UserID,
+		ProblemDescription: dto.ProblemDescription,
+		Notes:              dto.Notes,
+		CategoryIDs:        dto.CategoryIDs,
+		Version:            dto.Version,
+	}
+}
+
+func ToServiceOrderPartModel(orderID int64, dto ServiceOrderPartDTO) *models.ServiceOrderPart {
+	return &models.ServiceOrderPart{
+		ServiceOrderID: orderID,
+		ProductID:      dto.ProductID,
+		Quantity:       dto.Quantity,
+		UnitPrice:      dto.UnitPrice,
+	}
+}
+
+func ToServiceOrderLaborModel(orderID int64, dto ServiceOrderLaborDTO) *models.ServiceOrderLabor {
+	return &models.ServiceOrderLabor{
+		ServiceOrderID: orderID,
+		Description:    dto.Description,
+		Amount:         dto.Amount,
+	}
+}
+
+func ToSaleConversionModel(orderID int64, userID *int64, dto SaleConversionDTO) *models.SaleConversion {
+	return &models.SaleConversion{
+		ServiceOrderID: orderID,
+		UserID:         userID,
+		PaymentType:    dto.PaymentType,
+		Notes:          dto.Notes,
+	}
+}
+
+func ToServiceOrderPartDTO(model *models.ServiceOrderPart) ServiceOrderPartDTO {
+	if model == nil {
+		return ServiceOrderPartDTO{}
+	}
+
+	dto := ServiceOrderPartDTO{
+		ProductID: model.ProductID,
+		Quantity:  model.Quantity,
+		UnitPrice: model.UnitPrice,
+		Subtotal:  model.Subtotal(),
+	}
+
+	if model.ID != 0 {
+		id := model.ID
+		dto.ID = &id
+	}
+
+	if !model.CreatedAt.IsZero() {
+		v := model.CreatedAt.Format(time.RFC3339)
+		dto.CreatedAt = &v
+	}
+
+	return dto
+}
+
+func ToServiceOrderLaborDTO(model *models.ServiceOrderLabor) ServiceOrderLaborDTO {
+	if model == nil {
+		return ServiceOrderLaborDTO{}
+	}
+
+	dto := ServiceOrderLaborDTO{
+		Description: model.Description,
+		Amount:      model.Amount,
+	}
+
+	if model.ID != 0 {
+		id := model.ID
+		dto.ID = &id
+	}
+
+	if !model.CreatedAt.IsZero() {
+		v := model.CreatedAt.Format(time.RFC3339)
+		dto.CreatedAt = &v
+	}
+
+	return dto
+}
+
+func ToServiceOrderDTO(model *models.ServiceOrder) ServiceOrderDTO {
+	if model == nil {
+		return ServiceOrderDTO{}
+	}
+
+	var parts []ServiceOrderPartDTO
+	if model.Parts != nil {
+		parts = make([]ServiceOrderPartDTO, len(model.Parts))
+		for i := range model.Parts {
+			parts[i] = ToServiceOrderPartDTO(&model.Parts[i])
+		}
+	}
+
+	var labor []ServiceOrderLaborDTO
+	if model.Labor != nil {
+		labor = make([]ServiceOrderLaborDTO, len(model.Labor))
+		for i := range model.Labor {
+			labor[i] = ToServiceOrderLaborDTO(&model.Labor[i])
+		}
+	}
+
+	dto := ServiceOrderDTO{
+		ID:                 &model.ID,
+		ClientID:           model.ClientID,
+		ClientCnpjID:       model.ClientCnpjID,
+		TechnicianID:       model.TechnicianID,
+		UserID:             model.UserID,
+		Status:             model.Status,
+		ProblemDescription: model.ProblemDescription,
+		Notes:              model.Notes,
+		PartsAmount:        model.PartsAmount,
+		LaborAmount:        model.LaborAmount,
+		TotalAmount:        model.TotalAmount,
+		SaleID:             model.SaleID,
+		CategoryIDs:        model.CategoryIDs,
+		Parts:              parts,
+		Labor:              labor,
+		Version:            model.Version,
+	}
+
+	if !model.CreatedAt.IsZero() {
+		v := model.CreatedAt.Format(time.RFC3339)
+		dto.CreatedAt = &v
+	}
+
+	if !model.UpdatedAt.IsZero() {
+		v := model.UpdatedAt.Format(time.RFC3339)
+		dto.UpdatedAt = &v
+	}
+
+	return dto
+}
+
+func ToServiceOrderDTOs(list []*models.ServiceOrder) []ServiceOrderDTO {
+	result := make([]ServiceOrderDTO, 0, len(list))
+	for _, o := range list {
+		if o == nil {
+			continue
+		}
+		result = append(result, ToServiceOrderDTO(o))
+	}
+	return result
+}
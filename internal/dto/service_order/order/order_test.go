@@ -0,0 +1,104 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToServiceOrderModel(t *testing.T) {
+	input := ServiceOrderDTO{
+		ID:                 utils.Int64Ptr(3),
+		ClientID:           utils.Int64Ptr(4),
+		TechnicianID:       utils.Int64Ptr(9),
+		Status:             "done",
+		ProblemDescription: "não liga",
+		TotalAmount:        money.New(999),
+		SaleID:             utils.Int64Ptr(50),
+		CategoryIDs:        []int64{1, 2},
+		Parts:              []ServiceOrderPartDTO{{ProductID: 7, Quantity: 1}},
+		Version:            2,
+	}
+
+	model := ToServiceOrderModel(input)
+
+	assert.Equal(t, int64(3), model.ID)
+	assert.Equal(t, int64(4), *model.ClientID)
+	assert.Equal(t, int64(9), *model.TechnicianID)
+	assert.Empty(t, model.Status)
+	assert.Zero(t, model.TotalAmount)
+	assert.Nil(t, model.SaleID)
+	assert.Nil(t, model.Parts)
+	assert.Equal(t, []int64{1, 2}, model.CategoryIDs)
+	assert.Equal(t, 2, model.Version)
+}
+
+func TestToServiceOrderLineModels(t *testing.T) {
+	price := money.MustParse("12.50")
+
+	part := ToServiceOrderPartModel(1, ServiceOrderPartDTO{ProductID: 7, Quantity: 2, UnitPrice: &price, Subtotal: money.New(999)})
+	assert.Equal(t, &models.ServiceOrderPart{ServiceOrderID: 1, ProductID: 7, Quantity: 2, UnitPrice: &price}, part)
+
+	labor := ToServiceOrderLaborModel(1, ServiceOrderLaborDTO{Description: "diagnóstico", Amount: money.New(50)})
+	assert.Equal(t, &models.ServiceOrderLabor{ServiceOrderID: 1, Description: "diagnóstico", Amount: money.New(50)}, labor)
+
+	conversion := ToSaleConversionModel(1, utils.Int64Ptr(2), SaleConversionDTO{PaymentType: "pix"})
+	assert.Equal(t, int64(1), conversion.ServiceOrderID)
+	assert.Equal(t, int64(2), *conversion.UserID)
+	assert.Equal(t, "pix", conversion.PaymentType)
+}
+
+func TestToServiceOrderDTO(t *testing.T) {
+	t.Run("nil model", func(t *testing.T) {
+		assert.Equal(t, ServiceOrderDTO{}, ToServiceOrderDTO(nil))
+	})
+
+	t.Run("full model", func(t *testing.T) {
+		now := time.Now()
+		price := money.New(10)
+		model := &models.ServiceOrder{
+			ID:                 1,
+			ClientID:           utils.Int64Ptr(4),
+			Status:             models.StatusInProgress,
+			ProblemDescription: "não liga",
+			PartsAmount:        money.New(20),
+			LaborAmount:        money.New(50),
+			TotalAmount:        money.New(70),
+			Parts:              []models.ServiceOrderPart{{ID: 10, ProductID: 7, Quantity: 2, UnitPrice: &price, CreatedAt: now}},
+			Labor:              []models.ServiceOrderLabor{{ID: 20, Description: "diagnóstico", Amount: money.New(50)}},
+			Version:            3,
+			CreatedAt:          now,
+			UpdatedAt:          now,
+		}
+
+		dto := ToServiceOrderDTO(model)
+
+		assert.Equal(t, int64(1), *dto.ID)
+		assert.Equal(t, models.StatusInProgress, dto.Status)
+		assert.Equal(t, money.New(70), dto.TotalAmount)
+		assert.NotNil(t, dto.CreatedAt)
+		assert.Len(t, dto.Parts, 1)
+		assert.Equal(t, int64(10), *dto.Parts[0].ID)
+		assert.Equal(t, money.New(20), dto.Parts[0].Subtotal)
+		assert.Equal(t, now.Format(time.RFC3339), *dto.Parts[0].CreatedAt)
+		assert.Len(t, dto.Labor, 1)
+		assert.Equal(t, int64(20), *dto.Labor[0].ID)
+		assert.Nil(t, dto.Labor[0].CreatedAt)
+	})
+
+	t.Run("nil lines", func(t *testing.T) {
+		assert.Equal(t, ServiceOrderPartDTO{}, ToServiceOrderPartDTO(nil))
+		assert.Equal(t, ServiceOrderLaborDTO{}, ToServiceOrderLaborDTO(nil))
+	})
+
+	t.Run("list skips nil", func(t *testing.T) {
+		list := ToServiceOrderDTOs([]*models.ServiceOrder{{ID: 1}, nil})
+
+		assert.Len(t, list, 1)
+		assert.Nil(t, list[0].Parts)
+	})
+}
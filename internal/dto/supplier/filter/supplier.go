@@ -20,6 +20,9 @@ type SupplierFilterDTO struct {
 	UpdatedTo   *string `schema:"updated_to"`
 	Limit       int     `schema:"limit"`
 	Offset      int     `schema:"offset"`
+	Cursor      string  `schema:"cursor"`
+	CursorMode  bool    `schema:"-"`
+	SearchTerm  string  `schema:"search"`
 }
 
 func (d *SupplierFilterDTO) ToModel() (*modelSupplier.SupplierFilter, error) {
@@ -58,8 +61,11 @@ func (d *SupplierFilterDTO) ToModel() (*modelSupplier.SupplierFilter, error) {
 	}
 
 	baseFilter := modelFilter.BaseFilter{
-		Limit:  d.Limit,
-		Offset: d.Offset,
+		Limit:      d.Limit,
+		Offset:     d.Offset,
+		CursorMode: d.CursorMode,
+		Cursor:     d.Cursor,
+		SearchTerm: d.SearchTerm,
 	}
 
 	createdFrom, err := parseDate(d.CreatedFrom, "created_from")
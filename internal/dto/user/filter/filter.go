@@ -19,6 +19,9 @@ type UserFilterDTO struct {
 	UpdatedTo   *string `schema:"updated_to"`
 	Limit       int     `schema:"limit"`
 	Offset      int     `schema:"offset"`
+	Cursor      string  `schema:"cursor"`
+	CursorMode  bool    `schema:"-"`
+	SearchTerm  string  `schema:"search"`
 }
 
 func (d *UserFilterDTO) ToModel() (*modelUser.UserFilter, error) {
@@ -66,8 +69,11 @@ func (d *UserFilterDTO) ToModel() (*modelUser.UserFilter, error) {
 	}
 
 	baseFilter := modelFilter.BaseFilter{
-		Limit:  d.Limit,
-		Offset: d.Offset,
+		Limit:      d.Limit,
+		Offset:     d.Offset,
+		CursorMode: d.CursorMode,
+		Cursor:     d.Cursor,
+		SearchTerm: d.SearchTerm,
 	}
 
 	createdFrom, err := parseDate(d.CreatedFrom, "created_from")
@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/role"
+)
+
+type RoleDTO struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+	CreatedAt   string   `json:"created_at,omitempty"`
+	UpdatedAt   string   `json:"updated_at,omitempty"`
+}
+
+type UserRoleDTO struct {
+	UserID    int64  `json:"user_id"`
+	RoleID    int64  `json:"role_id"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+func ToRoleDTO(m *models.Role) RoleDTO {
+	if m == nil {
+		return RoleDTO{}
+	}
+
+	permissions := m.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+
+	return RoleDTO{
+		ID:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		Permissions: permissions,
+		CreatedAt:   m.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   m.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToRoleDTOs(models []*models.Role) []RoleDTO {
+	if models == nil {
+		return []RoleDTO{}
+	}
+
+	dtos := make([]RoleDTO, 0, len(models))
+	for _, m := range models {
+		dtos = append(dtos, ToRoleDTO(m))
+	}
+
+	return dtos
+}
+
+func ToUserRoleDTO(m *models.UserRole) UserRoleDTO {
+	if m == nil {
+		return UserRoleDTO{}
+	}
+
+	return UserRoleDTO{
+		UserID:    m.UserID,
+		RoleID:    m.RoleID,
+		CreatedAt: m.CreatedAt.Format(time.RFC3339),
+	}
+}
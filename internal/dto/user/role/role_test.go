@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/role"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleDTO(t *testing.T) {
+	now := time.Now()
+
+	t.Run("ToRoleDTO", func(t *testing.T) {
+		model := &models.Role{ID: 1, Name: "admin", Description: "Acesso total", Permissions: []string{"sale:cancel"}, CreatedAt: now, UpdatedAt: now}
+
+		result := ToRoleDTO(model)
+
+		assert.Equal(t, int64(1), result.ID)
+		assert.Equal(t, "admin", result.Name)
+		assert.Equal(t, []string{"sale:cancel"}, result.Permissions)
+		assert.Equal(t, now.Format(time.RFC3339), result.CreatedAt)
+	})
+
+	t.Run("ToRoleDTO with nil permissions", func(t *testing.T) {
+		result := ToRoleDTO(&models.Role{ID: 2, Name: "cashier"})
+
+		assert.NotNil(t, result.Permissions)
+		assert.Empty(t, result.Permissions)
+	})
+
+	t.Run("ToRoleDTO with nil model", func(t *testing.T) {
+		assert.Equal(t, RoleDTO{}, ToRoleDTO(nil))
+	})
+
+	t.Run("ToRoleDTOs", func(t *testing.T) {
+		result := ToRoleDTOs([]*models.Role{{ID: 1}, {ID: 2}})
+
+		assert.Len(t, result, 2)
+		assert.Equal(t, int64(2), result[1].ID)
+		assert.Equal(t, []RoleDTO{}, ToRoleDTOs(nil))
+	})
+
+	t.Run("ToUserRoleDTO", func(t *testing.T) {
+		result := ToUserRoleDTO(&models.UserRole{UserID: 7, RoleID: 3, CreatedAt: now})
+
+		assert.Equal(t, int64(7), result.UserID)
+		assert.Equal(t, int64(3), result.RoleID)
+		assert.Equal(t, now.Format(time.RFC3339), result.CreatedAt)
+		assert.Equal(t, UserRoleDTO{}, ToUserRoleDTO(nil))
+	})
+}
@@ -9,6 +9,7 @@ import (
 
 	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/address/address"
 	filterDTO "github.com/WagaoCarvalho/backend_store_go/internal/dto/address/filter"
+	dtoPage "github.com/WagaoCarvalho/backend_store_go/internal/dto/common/page"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
@@ -70,7 +71,7 @@ func (h *addressFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	addressDTOs := dto.ToAddressDTOs(addresses)
+	addressDTOs := dto.ToAddressDTOs(addresses.Items)
 
 	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
 		"total_encontrados": len(addressDTOs),
@@ -80,36 +81,41 @@ func (h *addressFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
 	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
 		Status:  http.StatusOK,
 		Message: "Endereços listados com sucesso",
-		Data: map[string]any{
-			"total":           len(addressDTOs),
-			"items":           addressDTOs,
-			"filters_applied": countFiltersApplied(dtoFilter),
-			"has_more":        len(addressDTOs) == dtoFilter.Limit,
+		Data: struct {
+			dtoPage.PageDTO[dto.AddressDTO]
+			FiltersApplied int `json:"filters_applied"`
+		}{
+			PageDTO:        dtoPage.ToPageDTO(addresses, addressDTOs),
+			FiltersApplied: countFiltersApplied(dtoFilter),
 		},
 	})
 }
 
 func (h *addressFilterHandler) validateQueryParams(r *http.Request) error {
 	allowedParams := map[string]bool{
-		"user_id":       true,
-		"client_cpf_id": true,
-		"supplier_id":   true,
-		"street":        true,
-		"street_number": true,
-		"complement":    true,
-		"city":          true,
-		"state":         true,
-		"country":       true,
-		"postal_code":   true,
-		"is_active":     true,
-		"created_from":  true,
-		"created_to":    true,
-		"updated_from":  true,
-		"updated_to":    true,
-		"page":          true,
-		"limit":         true,
-		"sort_by":       true,
-		"sort_order":    true,
+		"user_id":        true,
+		"client_cpf_id":  true,
+		"client_cnpj_id": true,
+		"supplier_id":    true,
+		"street":         true,
+		"street_number":  true,
+		"complement":     true,
+		"city":           true,
+		"state":          true,
+		"country":        true,
+		"postal_code":    true,
+		"is_active":      true,
+		"created_from":   true,
+		"created_to":     true,
+		"updated_from":   true,
+		"updated_to":     true,
+		"page":           true,
+		"limit":          true,
+		"offset":         true,
+		"cursor":         true,
+		"search":         true,
+		"sort_by":        true,
+		"sort_order":     true,
 	}
 
 	query := r.URL.Query()
@@ -150,6 +156,17 @@ func (h *addressFilterHandler) parseFilterDTO(r *http.Request) (filterDTO.Addres
 		dto.ClientCpfID = &parsed
 	}
 
+	if v := strings.TrimSpace(query.Get("client_cnpj_id")); v != "" {
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return dto, errors.New("valor inválido para 'client_cnpj_id': deve ser um número inteiro")
+		}
+		if parsed <= 0 {
+			return dto, errors.New("valor inválido para 'client_cnpj_id': deve ser maior que zero")
+		}
+		dto.ClientCnpjID = &parsed
+	}
+
 	if v := strings.TrimSpace(query.Get("supplier_id")); v != "" {
 		parsed, err := strconv.ParseInt(v, 10, 64)
 		if err != nil {
@@ -196,6 +213,8 @@ func (h *addressFilterHandler) parseFilterDTO(r *http.Request) (filterDTO.Addres
 	}
 
 	dto.Limit, dto.Offset = utils.GetPaginationParams(r)
+	dto.Cursor, dto.CursorMode = utils.GetCursorParam(r)
+	dto.SearchTerm = r.URL.Query().Get("search")
 	dto.SortBy = strings.TrimSpace(query.Get("sort_by"))
 	dto.SortOrder = strings.TrimSpace(query.Get("sort_order"))
 
@@ -239,6 +258,9 @@ func countFiltersApplied(dto filterDTO.AddressFilterDTO) int {
 	if dto.ClientCpfID != nil {
 		count++
 	}
+	if dto.ClientCnpjID != nil {
+		count++
+	}
 	if dto.SupplierID != nil {
 		count++
 	}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/audit/filter"
+)
+
+type auditFilterHandler struct {
+	service service.AuditFilter
+	logger  *logger.LogAdapter
+}
+
+func NewAuditFilterHandler(service service.AuditFilter, logger *logger.LogAdapter) *auditFilterHandler {
+	return &auditFilterHandler{
+		service: service,
+		logger:  logger,
+	}
+}
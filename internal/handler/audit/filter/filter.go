@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/audit/audit"
+	dtoFilter "github.com/WagaoCarvalho/backend_store_go/internal/dto/audit/filter"
+	dtoPage "github.com/WagaoCarvalho/backend_store_go/internal/dto/common/page"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+// Lista de parâmetros válidos para validação
+var validAuditFilterParams = map[string]bool{
+	"actor_user_id": true,
+	"request_id":    true,
+	"entity_type":   true,
+	"entity_id":     true,
+	"action":        true,
+	"created_from":  true,
+	"created_to":    true,
+	"sort_by":       true,
+	"sort_order":    true,
+	"limit":         true,
+	"cursor":        true,
+	"search":        true,
+	"offset":        true,
+}
+
+func (h *auditFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	const ref = "[auditHandler - Filter] "
+
+	query := r.URL.Query()
+
+	for param := range query {
+		if !validAuditFilterParams[param] {
+			h.logger.Warn(ctx, ref+"parâmetro desconhecido", map[string]any{
+				"parametro": param,
+				"valor":     query.Get(param),
+			})
+			utils.ErrorResponse(w, fmt.Errorf("parâmetro de consulta inválido: %s", param), http.StatusBadRequest)
+			return
+		}
+	}
+
+	var dtoFilter dtoFilter.AuditFilterDTO
+
+	for _, field := range []struct {
+		name string
+		dest **int64
+	}{
+		{"actor_user_id", &dtoFilter.ActorUserID},
+		{"entity_id", &dtoFilter.EntityID},
+	} {
+		v := query.Get(field.name)
+		if v == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			h.logger.Warn(ctx, ref+field.name+" inválido", map[string]any{
+				"valor": v,
+			})
+			utils.ErrorResponse(w, fmt.Errorf("%s deve ser um número inteiro", field.name), http.StatusBadRequest)
+			return
+		}
+		*field.dest = &parsed
+	}
+
+	dtoFilter.RequestID = query.Get("request_id")
+	dtoFilter.EntityType = query.Get("entity_type")
+	dtoFilter.Action = query.Get("action")
+	dtoFilter.SortBy = query.Get("sort_by")
+	dtoFilter.SortOrder = query.Get("sort_order")
+
+	utils.ParseTimeRange(
+		query,
+		"created_from",
+		"created_to",
+		&dtoFilter.CreatedFrom,
+		&dtoFilter.CreatedTo,
+	)
+
+	dtoFilter.Limit, dtoFilter.Offset = utils.GetPaginationParams(r)
+	dtoFilter.Cursor, dtoFilter.CursorMode = utils.GetCursorParam(r)
+	dtoFilter.SearchTerm = r.URL.Query().Get("search")
+
+	filter, err := dtoFilter.ToModel()
+	if err != nil {
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"filtro": dtoFilter})
+
+	logs, err := h.service.Filter(ctx, filter)
+	if err != nil {
+		if errors.Is(err, errMsg.ErrInvalidFilter) {
+			h.logger.Warn(ctx, ref+"filtro inválido", map[string]any{
+				"erro":   err.Error(),
+				"filtro": dtoFilter,
+			})
+			utils.ErrorResponse(w, err, http.StatusBadRequest)
+			return
+		}
+
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"filtro": dtoFilter})
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	logDTOs := dto.ToAuditLogDTOs(logs.Items)
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"total_encontrados": len(logDTOs),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Registros de auditoria listados com sucesso",
+		Data:    dtoPage.ToPageDTO(logs, logDTOs),
+	})
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockAudit "github.com/WagaoCarvalho/backend_store_go/infra/mock/audit"
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditHandler_Filter(t *testing.T) {
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	logAdapter := logger.NewLoggerAdapter(baseLogger)
+
+	setup := func() (*mockAudit.MockAudit, *auditFilterHandler) {
+		mockService := new(mockAudit.MockAudit)
+		handler := NewAuditFilterHandler(mockService, logAdapter)
+		return mockService, handler
+	}
+
+	decode := func(t *testing.T, rec *httptest.ResponseRecorder) utils.DefaultResponse {
+		var resp utils.DefaultResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return resp
+	}
+
+	t.Run("erro - parâmetro desconhecido na query", func(t *testing.T) {
+		mockService, handler := setup()
+		req := httptest.NewRequest(http.MethodGet, "/audit?foo=bar", nil)
+		rec := httptest.NewRecorder()
+		handler.Filter(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, decode(t, rec).Message, "parâmetro de consulta inválido")
+		mockService.AssertNotCalled(t, "Filter")
+	})
+
+	for _, param := range []string{"actor_user_id", "entity_id"} {
+		t.Run("erro - "+param+" não numérico", func(t *testing.T) {
+			mockService, handler := setup()
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/audit?%s=abc", param), nil)
+			rec := httptest.NewRecorder()
+			handler.Filter(rec, req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, decode(t, rec).Message, param+" deve ser um número inteiro")
+			mockService.AssertNotCalled(t, "Filter")
+		})
+	}
+
+	t.Run("erro - intervalo de datas invertido", func(t *testing.T) {
+		mockService, handler := setup()
+		req := httptest.NewRequest(http.MethodGet, "/audit?created_from=2025-02-01&created_to=2025-01-01", nil)
+		rec := httptest.NewRecorder()
+		handler.Filter(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertNotCalled(t, "Filter")
+	})
+
+	t.Run("erro - filtro inválido no serviço", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("Filter", mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("%w: EntityType obrigatório", errMsg.ErrInvalidFilter)).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/audit?entity_id=1", nil)
+		rec := httptest.NewRecorder()
+		handler.Filter(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("erro - falha interna", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("Filter", mock.Anything, mock.Anything).Return(nil, errors.New("db fail")).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/audit", nil)
+		rec := httptest.NewRecorder()
+		handler.Filter(rec, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("sucesso - repassa todos os filtros", func(t *testing.T) {
+		mockService, handler := setup()
+		actor := int64(7)
+		logs := []*model.AuditLog{{
+			ID:          1,
+			ActorUserID: &actor,
+			EntityType:  "product",
+			EntityID:    10,
+			Action:      model.ActionUpdate,
+			Changes:     map[string]model.FieldChange{"StockQuantity": {From: 5, To: 4}},
+			CreatedAt:   time.Now(),
+		}}
+
+		mockService.On("Filter", mock.Anything, mock.MatchedBy(func(f *filter.AuditFilter) bool {
+			return *f.ActorUserID == 7 && *f.EntityID == 10 && f.EntityType == "product" &&
+				f.Action == "update" && f.RequestID == "req-1" && f.CreatedFrom != nil &&
+				f.SortBy == "created_at" && f.SortOrder == "desc" && f.Limit == 5 && f.Offset == 10
+		})).Return(logs, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/audit?actor_user_id=7&entity_type=product&entity_id=10"+
+			"&action=update&request_id=req-1&created_from=2025-01-01&sort_by=created_at&sort_order=desc&limit=5&offset=10", nil)
+		rec := httptest.NewRecorder()
+		handler.Filter(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		resp := decode(t, rec)
+		data := resp.Data.(map[string]any)
+		assert.Equal(t, float64(1), data["total"])
+		item := data["items"].([]any)[0].(map[string]any)
+		assert.Equal(t, "product", item["entity_type"])
+		assert.Equal(t, map[string]any{"from": float64(5), "to": float64(4)}, item["changes"].(map[string]any)["StockQuantity"])
+		mockService.AssertExpectations(t)
+	})
+}
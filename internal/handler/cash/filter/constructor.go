@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/cash/filter"
+)
+
+type cashSessionFilterHandler struct {
+	service service.CashSessionFilter
+	logger  *logger.LogAdapter
+}
+
+func NewCashSessionFilterHandler(service service.CashSessionFilter, logger *logger.LogAdapter) *cashSessionFilterHandler {
+	return &cashSessionFilterHandler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dtoFilter "github.com/WagaoCarvalho/backend_store_go/internal/dto/cash/filter"
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/cash/session"
+	dtoPage "github.com/WagaoCarvalho/backend_store_go/internal/dto/common/page"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+var validCashSessionFilterParams = map[string]bool{
+	"user_id":     true,
+	"status":      true,
+	"result":      true,
+	"opened_from": true,
+	"opened_to":   true,
+	"closed_from": true,
+	"closed_to":   true,
+	"limit":       true,
+	"cursor":      true,
+	"search":      true,
+	"offset":      true,
+}
+
+func (h *cashSessionFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	const ref = "[cashSessionHandler - Filter] "
+
+	query := r.URL.Query()
+
+	for param := range query {
+		if !validCashSessionFilterParams[param] {
+			h.logger.Warn(ctx, ref+"parâmetro desconhecido", map[string]any{
+				"parametro": param,
+				"valor":     query.Get(param),
+			})
+			utils.ErrorResponse(w, fmt.Errorf("parâmetro de consulta inválido: %s", param), http.StatusBadRequest)
+			return
+		}
+	}
+
+	var dtoFilter dtoFilter.CashSessionFilterDTO
+
+	if v := query.Get("user_id"); v != "" {
+		userID, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			h.logger.Warn(ctx, ref+"user_id inválido", map[string]any{"valor": v})
+			utils.ErrorResponse(w, fmt.Errorf("user_id deve ser um número inteiro"), http.StatusBadRequest)
+			return
+		}
+		dtoFilter.UserID = &userID
+	}
+
+	dtoFilter.Status = query.Get("status")
+	dtoFilter.Result = query.Get("result")
+
+	utils.ParseTimeRange(query, "opened_from", "opened_to", &dtoFilter.OpenedFrom, &dtoFilter.OpenedTo)
+	utils.ParseTimeRange(query, "closed_from", "closed_to", &dtoFilter.ClosedFrom, &dtoFilter.ClosedTo)
+
+	limit, offset := utils.GetPaginationParams(r)
+	if limit < 0 || offset < 0 {
+		h.logger.Warn(ctx, ref+"paginação inválida", map[string]any{
+			"limit":  limit,
+			"offset": offset,
+		})
+		utils.ErrorResponse(w, fmt.Errorf("parâmetros de paginação inválidos"), http.StatusBadRequest)
+		return
+	}
+	dtoFilter.Limit = limit
+	dtoFilter.Offset = offset
+	dtoFilter.Cursor, dtoFilter.CursorMode = utils.GetCursorParam(r)
+	dtoFilter.SearchTerm = r.URL.Query().Get("search")
+
+	filter, err := dtoFilter.ToModel()
+	if err != nil {
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"filtro": dtoFilter})
+
+	sessions, err := h.service.Filter(ctx, filter)
+	if err != nil {
+		if errors.Is(err, errMsg.ErrInvalidFilter) {
+			h.logger.Warn(ctx, ref+"filtro inválido", map[string]any{
+				"erro":   err.Error(),
+				"filtro": dtoFilter,
+			})
+			utils.ErrorResponse(w, err, http.StatusBadRequest)
+			return
+		}
+
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"filtro": dtoFilter})
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	sessionDTOs := dto.ToCashSessionDTOs(sessions.Items)
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"total_encontrados": len(sessionDTOs),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Caixas listados com sucesso",
+		Data:    dtoPage.ToPageDTO(sessions, sessionDTOs),
+	})
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockCash "github.com/WagaoCarvalho/backend_store_go/infra/mock/cash"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/filter"
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newFilterHandler() (*mockCash.MockCashSessionFilter, *cashSessionFilterHandler) {
+	mockService := new(mockCash.MockCashSessionFilter)
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	return mockService, NewCashSessionFilterHandler(mockService, logger.NewLoggerAdapter(baseLogger))
+}
+
+func TestCashSessionFilterHandler_Filter(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := newFilterHandler()
+		mockService.On("Filter", mock.Anything, mock.MatchedBy(func(f *filter.CashSessionFilter) bool {
+			return *f.UserID == 4 && f.Status == "closed" && f.Result == "short" && f.ClosedFrom != nil
+		})).Return([]*model.CashSession{{ID: 1, UserID: 4, Status: model.StatusClosed}}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/cash-sessions/filter?user_id=4&status=closed&result=short&closed_from=2026-01-01", nil)
+		w := httptest.NewRecorder()
+		h.Filter(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"total":1`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("parâmetro desconhecido", func(t *testing.T) {
+		_, h := newFilterHandler()
+		w := httptest.NewRecorder()
+		h.Filter(w, httptest.NewRequest(http.MethodGet, "/cash-sessions/filter?foo=1", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("user_id inválido", func(t *testing.T) {
+		_, h := newFilterHandler()
+		w := httptest.NewRecorder()
+		h.Filter(w, httptest.NewRequest(http.MethodGet, "/cash-sessions/filter?user_id=abc", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("filtro inválido no serviço", func(t *testing.T) {
+		mockService, h := newFilterHandler()
+		mockService.On("Filter", mock.Anything, mock.Anything).Return(nil, errMsg.ErrInvalidFilter).Once()
+
+		w := httptest.NewRecorder()
+		h.Filter(w, httptest.NewRequest(http.MethodGet, "/cash-sessions/filter?result=foo", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService, h := newFilterHandler()
+		mockService.On("Filter", mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		h.Filter(w, httptest.NewRequest(http.MethodGet, "/cash-sessions/filter", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/cash/session"
+)
+
+type cashSessionHandler struct {
+	service service.CashSessionService
+	logger  *logger.LogAdapter
+}
+
+func NewCashSessionHandler(service service.CashSessionService, logger *logger.LogAdapter) *cashSessionHandler {
+	return &cashSessionHandler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/cash/session"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *cashSessionHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	const ref = "[CashSessionHandler - GetByID] "
+	ctx := r.Context()
+
+	id, ok := h.sessionID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"id": id})
+
+	session, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{"id": id})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Caixa encontrado",
+		Data:    dto.ToCashSessionDTO(session),
+	})
+}
+
+// Current devolve o caixa aberto do usuário autenticado.
+func (h *cashSessionHandler) Current(w http.ResponseWriter, r *http.Request) {
+	const ref = "[CashSessionHandler - Current] "
+	ctx := r.Context()
+
+	userID := userIDOf(currentUser(ctx))
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"user_id": userID})
+
+	session, err := h.service.Current(ctx, userID)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"user_id": userID})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{"id": session.ID})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Caixa aberto encontrado",
+		Data:    dto.ToCashSessionDTO(session),
+	})
+}
+
+func (h *cashSessionHandler) Movements(w http.ResponseWriter, r *http.Request) {
+	const ref = "[CashSessionHandler - Movements] "
+	ctx := r.Context()
+
+	id, ok := h.sessionID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"id": id})
+
+	movements, err := h.service.Movements(ctx, id)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"id":    id,
+		"total": len(movements),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Movimentos de caixa listados com sucesso",
+		Data:    dto.ToCashMovementDTOs(movements),
+	})
+}
+
+// Summary devolve o resumo por forma de pagamento: parcial com o caixa
+// aberto, com a conferência depois do fechamento.
+func (h *cashSessionHandler) Summary(w http.ResponseWriter, r *http.Request) {
+	const ref = "[CashSessionHandler - Summary] "
+	ctx := r.Context()
+
+	id, ok := h.sessionID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"id": id})
+
+	summary, err := h.service.Summary(ctx, id)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{"id": id})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Resumo do caixa gerado com sucesso",
+		Data:    dto.ToSummaryDTO(summary),
+	})
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockCash "github.com/WagaoCarvalho/backend_store_go/infra/mock/cash"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupHandler() (*mockCash.MockCashSessionService, *cashSessionHandler) {
+	mockService := new(mockCash.MockCashSessionService)
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	loggerAdapter := logger.NewLoggerAdapter(baseLogger)
+	return mockService, NewCashSessionHandler(mockService, loggerAdapter)
+}
+
+func newSessionRequest(method, target, id string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	return mux.SetURLVars(req, map[string]string{"id": id})
+}
+
+func TestCashSessionHandler_GetByID(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetByID", mock.Anything, int64(1)).Return(&models.CashSession{
+			ID:            1,
+			Status:        models.StatusOpen,
+			OpeningAmount: money.New(100),
+		}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByID(w, newSessionRequest(http.MethodGet, "/cash-session/1", "1", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"opening_amount":100.00`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetByID(w, newSessionRequest(http.MethodGet, "/cash-session/abc", "abc", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("não encontrado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetByID", mock.Anything, int64(9)).Return(nil, errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByID(w, newSessionRequest(http.MethodGet, "/cash-session/9", "9", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestCashSessionHandler_Current(t *testing.T) {
+	t.Run("sucesso busca pelo usuário autenticado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Current", mock.Anything, int64(42)).Return(&models.CashSession{ID: 3, UserID: 42, Status: models.StatusOpen}, nil).Once()
+
+		req := newSessionRequest(http.MethodGet, "/cash-session/current", "", nil)
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "42"))
+		w := httptest.NewRecorder()
+		h.Current(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("sem caixa aberto", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Current", mock.Anything, int64(42)).Return(nil, errMsg.ErrCashSessionNotOpen).Once()
+
+		req := newSessionRequest(http.MethodGet, "/cash-session/current", "", nil)
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "42"))
+		w := httptest.NewRecorder()
+		h.Current(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestCashSessionHandler_Movements(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Movements", mock.Anything, int64(1)).Return([]*models.CashMovement{
+			{ID: 1, CashSessionID: 1, Type: models.MovementWithdrawal, Amount: money.New(50)},
+		}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.Movements(w, newSessionRequest(http.MethodGet, "/cash-session/1/movements", "1", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"withdrawal"`)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Movements", mock.Anything, int64(1)).Return(nil, errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		h.Movements(w, newSessionRequest(http.MethodGet, "/cash-session/1/movements", "1", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestCashSessionHandler_Summary(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		session := &models.CashSession{ID: 1, Status: models.StatusOpen, OpeningAmount: money.New(100)}
+		summary := models.NewSummary(session, []*modelsPayment.MethodTotal{
+			{Method: modelsPayment.MethodCash, Sales: 1, Amount: money.New(30)},
+		}, models.MovementTotals{})
+		mockService.On("Summary", mock.Anything, int64(1)).Return(summary, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.Summary(w, newSessionRequest(http.MethodGet, "/cash-session/1/summary", "1", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"expected_amount":130.00`)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Summary(w, newSessionRequest(http.MethodGet, "/cash-session/0/summary", "0", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/cash/session"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+// Close confere o dinheiro contado e encerra o caixa, devolvendo o resumo
+// por forma de pagamento com a sobra ou falta.
+func (h *cashSessionHandler) Close(w http.ResponseWriter, r *http.Request) {
+	const ref = "[CashSessionHandler - Close] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPatch {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := h.sessionID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	var closeDTO dto.CloseCashSessionDTO
+	if err := utils.FromJSON(r.Body, &closeDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	if closeDTO.CountedAmount == nil {
+		h.logger.Warn(ctx, ref+logger.LogInvalidParam, map[string]any{"id": id})
+		utils.ErrorResponse(w, fmt.Errorf("counted_amount é obrigatório"), http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogUpdateInit, map[string]any{"id": id})
+
+	summary, err := h.service.Close(ctx, id, dto.ToClosingModel(closeDTO, currentUser(ctx)))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogUpdateError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogUpdateSuccess, map[string]any{
+		"id":     id,
+		"result": summary.Result(),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Caixa fechado com sucesso",
+		Data:    dto.ToSummaryDTO(summary),
+	})
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCashSessionHandler_Close(t *testing.T) {
+	body := []byte(`{"counted_amount":125.00,"notes":"faltou troco"}`)
+
+	t.Run("sucesso devolve o resumo com a falta", func(t *testing.T) {
+		mockService, h := setupHandler()
+		session := &models.CashSession{ID: 1, Status: models.StatusClosed, OpeningAmount: money.New(100)}
+		summary := models.NewSummary(session, []*modelsPayment.MethodTotal{
+			{Method: modelsPayment.MethodCash, Sales: 1, Amount: money.New(30)},
+		}, models.MovementTotals{})
+		summary.Reconcile(money.New(125))
+
+		mockService.On("Close", mock.Anything, int64(1), mock.MatchedBy(func(c *models.Closing) bool {
+			return c.CountedAmount == money.New(125) && c.Notes == "faltou troco" &&
+				c.ClosedBy != nil && *c.ClosedBy == 42
+		})).Return(summary, nil).Once()
+
+		req := newSessionRequest(http.MethodPatch, "/cash-session/1/close", "1", body)
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "42"))
+		w := httptest.NewRecorder()
+		h.Close(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"difference":-5.00`)
+		assert.Contains(t, w.Body.String(), `"result":"short"`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Close(w, newSessionRequest(http.MethodPost, "/cash-session/1/close", "1", body))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Close(w, newSessionRequest(http.MethodPatch, "/cash-session/0/close", "0", body))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("json inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Close(w, newSessionRequest(http.MethodPatch, "/cash-session/1/close", "1", []byte("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("valor contado obrigatório", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Close(w, newSessionRequest(http.MethodPatch, "/cash-session/1/close", "1", []byte(`{"notes":"x"}`)))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("caixa já fechado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Close", mock.Anything, int64(1), mock.Anything).Return(nil, errMsg.ErrCashSessionClosed).Once()
+
+		w := httptest.NewRecorder()
+		h.Close(w, newSessionRequest(http.MethodPatch, "/cash-session/1/close", "1", body))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Close", mock.Anything, int64(1), mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		h.Close(w, newSessionRequest(http.MethodPatch, "/cash-session/1/close", "1", body))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/cash/session"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+// Open abre o caixa do usuário autenticado.
+func (h *cashSessionHandler) Open(w http.ResponseWriter, r *http.Request) {
+	const ref = "[CashSessionHandler - Open] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var openDTO dto.OpenCashSessionDTO
+	if err := utils.FromJSON(r.Body, &openDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	userID := userIDOf(currentUser(ctx))
+
+	h.logger.Info(ctx, ref+logger.LogCreateInit, map[string]any{"user_id": userID})
+
+	created, err := h.service.Open(ctx, dto.ToCashSessionModel(openDTO, userID))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, map[string]any{"user_id": userID})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{"id": created.ID})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Caixa aberto com sucesso",
+		Data:    dto.ToCashSessionDTO(created),
+	})
+}
+
+// AddMovement lança uma sangria ou um suprimento no caixa do path.
+func (h *cashSessionHandler) AddMovement(w http.ResponseWriter, r *http.Request) {
+	const ref = "[CashSessionHandler - AddMovement] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := h.sessionID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	var movementDTO dto.CashMovementInputDTO
+	if err := utils.FromJSON(r.Body, &movementDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateInit, map[string]any{
+		"id":   id,
+		"type": movementDTO.Type,
+	})
+
+	movement, err := h.service.AddMovement(ctx, dto.ToCashMovementModel(movementDTO, id, currentUser(ctx)))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{
+		"id":          id,
+		"movement_id": movement.ID,
+	})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Movimento de caixa registrado com sucesso",
+		Data:    dto.ToCashMovementDTO(movement),
+	})
+}
+
+func (h *cashSessionHandler) sessionID(w http.ResponseWriter, r *http.Request, ref string) (int64, bool) {
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(r.Context(), ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
+// currentUser devolve o usuário autenticado, operador do caixa e autor de
+// movimentos e fechamento.
+func currentUser(ctx context.Context) *int64 {
+	uid, err := strconv.ParseInt(contextUtils.GetUserID(ctx), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &uid
+}
+
+func userIDOf(id *int64) int64 {
+	if id == nil {
+		return 0
+	}
+	return *id
+}
+
+func statusFromError(err error) int {
+	switch {
+	case errors.Is(err, errMsg.ErrInvalidData),
+		errors.Is(err, errMsg.ErrInvalidFilter),
+		errors.Is(err, errMsg.ErrZeroID):
+		return http.StatusBadRequest
+	case errors.Is(err, errMsg.ErrNotFound),
+		errors.Is(err, errMsg.ErrCashSessionNotOpen),
+		errors.Is(err, errMsg.ErrDBInvalidForeignKey):
+		return http.StatusNotFound
+	case errors.Is(err, errMsg.ErrCashSessionAlreadyOpen),
+		errors.Is(err, errMsg.ErrCashSessionClosed),
+		errors.Is(err, errMsg.ErrCashInsufficient):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCashSessionHandler_Open(t *testing.T) {
+	t.Run("sucesso abre para o usuário autenticado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Open", mock.Anything, mock.MatchedBy(func(s *models.CashSession) bool {
+			return s.UserID == 42 && s.OpeningAmount == money.New(150)
+		})).Return(&models.CashSession{ID: 1, UserID: 42, Status: models.StatusOpen}, nil).Once()
+
+		req := newSessionRequest(http.MethodPost, "/cash-session", "", []byte(`{"opening_amount":150.00}`))
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "42"))
+		w := httptest.NewRecorder()
+		h.Open(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Open(w, newSessionRequest(http.MethodGet, "/cash-session", "", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("json inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Open(w, newSessionRequest(http.MethodPost, "/cash-session", "", []byte("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("caixa já aberto", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Open", mock.Anything, mock.Anything).Return(nil, errMsg.ErrCashSessionAlreadyOpen).Once()
+
+		req := newSessionRequest(http.MethodPost, "/cash-session", "", []byte(`{}`))
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "42"))
+		w := httptest.NewRecorder()
+		h.Open(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("dados inválidos", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Open", mock.Anything, mock.Anything).Return(nil, errMsg.ErrInvalidData).Once()
+
+		w := httptest.NewRecorder()
+		h.Open(w, newSessionRequest(http.MethodPost, "/cash-session", "", []byte(`{"opening_amount":-1}`)))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestCashSessionHandler_AddMovement(t *testing.T) {
+	body := []byte(`{"type":"withdrawal","amount":50.00,"reason":"sangria"}`)
+
+	t.Run("sucesso registra o autor", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("AddMovement", mock.Anything, mock.MatchedBy(func(m *models.CashMovement) bool {
+			return m.CashSessionID == 1 && m.Type == models.MovementWithdrawal &&
+				m.Amount == money.New(50) && m.UserID != nil && *m.UserID == 42
+		})).Return(&models.CashMovement{ID: 7, CashSessionID: 1, Type: models.MovementWithdrawal, Amount: money.New(50)}, nil).Once()
+
+		req := newSessionRequest(http.MethodPost, "/cash-session/1/movements", "1", body)
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "42"))
+		w := httptest.NewRecorder()
+		h.AddMovement(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.AddMovement(w, newSessionRequest(http.MethodGet, "/cash-session/1/movements", "1", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.AddMovement(w, newSessionRequest(http.MethodPost, "/cash-session/x/movements", "x", body))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("json inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.AddMovement(w, newSessionRequest(http.MethodPost, "/cash-session/1/movements", "1", []byte("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("sangria maior que o dinheiro em caixa", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("AddMovement", mock.Anything, mock.Anything).Return(nil, errMsg.ErrCashInsufficient).Once()
+
+		w := httptest.NewRecorder()
+		h.AddMovement(w, newSessionRequest(http.MethodPost, "/cash-session/1/movements", "1", body))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("caixa fechado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("AddMovement", mock.Anything, mock.Anything).Return(nil, errMsg.ErrCashSessionClosed).Once()
+
+		w := httptest.NewRecorder()
+		h.AddMovement(w, newSessionRequest(http.MethodPost, "/cash-session/1/movements", "1", body))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/client_cnpj/client"
+)
+
+type clientCnpjHandler struct {
+	service service.Client
+	logger  *logger.LogAdapter
+}
+
+func NewClientCnpjHandler(service service.Client, logger *logger.LogAdapter) *clientCnpjHandler {
+	return &clientCnpjHandler{
+		service: service,
+		logger:  logger,
+	}
+}
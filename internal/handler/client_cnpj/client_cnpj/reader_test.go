@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cnpj"
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/client_cnpj/client"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClientCnpjHandler_GetByID(t *testing.T) {
+	vars := map[string]string{"id": "10"}
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockService := new(mockClient.MockClientCnpj)
+		handler := NewClientCnpjHandler(mockService, newTestLogger())
+		mockService.On("GetByID", mock.Anything, int64(10)).
+			Return(&models.ClientCnpj{ID: 10, Name: "Empresa LTDA", TradeName: "Empresa"}, nil).Once()
+
+		w := httptest.NewRecorder()
+		handler.GetByID(w, newRequestWithVars(http.MethodGet, "/clients-cnpj/10", nil, vars))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Message string            `json:"message"`
+			Data    dto.ClientCnpjDTO `json:"data"`
+		}
+		assert.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, "Cliente encontrado", response.Message)
+		assert.Equal(t, "Empresa", response.Data.TradeName)
+	})
+
+	t.Run("ID inválido", func(t *testing.T) {
+		handler := NewClientCnpjHandler(new(mockClient.MockClientCnpj), newTestLogger())
+
+		w := httptest.NewRecorder()
+		handler.GetByID(w, newRequestWithVars(http.MethodGet, "/clients-cnpj/x", nil, map[string]string{"id": "x"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("não encontrado", func(t *testing.T) {
+		mockService := new(mockClient.MockClientCnpj)
+		handler := NewClientCnpjHandler(mockService, newTestLogger())
+		mockService.On("GetByID", mock.Anything, int64(10)).Return(nil, errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		handler.GetByID(w, newRequestWithVars(http.MethodGet, "/clients-cnpj/10", nil, vars))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService := new(mockClient.MockClientCnpj)
+		handler := NewClientCnpjHandler(mockService, newTestLogger())
+		mockService.On("GetByID", mock.Anything, int64(10)).Return(nil, errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		handler.GetByID(w, newRequestWithVars(http.MethodGet, "/clients-cnpj/10", nil, vars))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestClientCnpjHandler_GetVersionByID(t *testing.T) {
+	vars := map[string]string{"id": "10"}
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockService := new(mockClient.MockClientCnpj)
+		handler := NewClientCnpjHandler(mockService, newTestLogger())
+		mockService.On("GetVersionByID", mock.Anything, int64(10)).Return(4, nil).Once()
+
+		w := httptest.NewRecorder()
+		handler.GetVersionByID(w, newRequestWithVars(http.MethodGet, "/clients-cnpj/10/version", nil, vars))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"version":4`)
+	})
+
+	t.Run("erro", func(t *testing.T) {
+		mockService := new(mockClient.MockClientCnpj)
+		handler := NewClientCnpjHandler(mockService, newTestLogger())
+		mockService.On("GetVersionByID", mock.Anything, int64(10)).Return(0, errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		handler.GetVersionByID(w, newRequestWithVars(http.MethodGet, "/clients-cnpj/10/version", nil, vars))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cnpj"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClientCnpjHandler_Status(t *testing.T) {
+	vars := map[string]string{"id": "1"}
+
+	t.Run("método não permitido", func(t *testing.T) {
+		handler := NewClientCnpjHandler(new(mockClient.MockClientCnpj), newTestLogger())
+
+		w := httptest.NewRecorder()
+		handler.Disable(w, newRequestWithVars(http.MethodGet, "/clients-cnpj/1/disable", nil, vars))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("ID inválido", func(t *testing.T) {
+		handler := NewClientCnpjHandler(new(mockClient.MockClientCnpj), newTestLogger())
+
+		w := httptest.NewRecorder()
+		handler.Enable(w, newRequestWithVars(http.MethodPatch, "/clients-cnpj/x/enable", nil, map[string]string{"id": "x"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"sucesso", nil, http.StatusNoContent},
+		{"não encontrado", errMsg.ErrNotFound, http.StatusNotFound},
+		{"conflito de versão", errMsg.ErrVersionConflict, http.StatusConflict},
+		{"erro interno", errors.New("db down"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run("disable "+tc.name, func(t *testing.T) {
+			mockService := new(mockClient.MockClientCnpj)
+			handler := NewClientCnpjHandler(mockService, newTestLogger())
+			mockService.On("Disable", mock.Anything, int64(1)).Return(tc.err).Once()
+
+			w := httptest.NewRecorder()
+			handler.Disable(w, newRequestWithVars(http.MethodPatch, "/clients-cnpj/1/disable", nil, vars))
+
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+
+	t.Run("enable sucesso", func(t *testing.T) {
+		mockService := new(mockClient.MockClientCnpj)
+		handler := NewClientCnpjHandler(mockService, newTestLogger())
+		mockService.On("Enable", mock.Anything, int64(1)).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		handler.Enable(w, newRequestWithVars(http.MethodPatch, "/clients-cnpj/1/enable", nil, vars))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
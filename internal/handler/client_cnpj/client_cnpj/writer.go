@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/client_cnpj/client"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *clientCnpjHandler) Create(w http.ResponseWriter, r *http.Request) {
+	const ref = "[ClientCnpjHandler - Create] "
+	ctx := r.Context()
+
+	h.logger.Info(ctx, ref+logger.LogCreateInit, nil)
+
+	var clientDTO dto.ClientCnpjDTO
+	if err := utils.FromJSON(r.Body, &clientDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{
+			"erro": err.Error(),
+		})
+		utils.ErrorResponse(w, fmt.Errorf("dados inválidos"), http.StatusBadRequest)
+		return
+	}
+
+	client := dto.ToClientCnpjModel(clientDTO)
+
+	createdClient, err := h.service.Create(ctx, client)
+	if err != nil {
+		switch {
+		case errors.Is(err, errMsg.ErrDBInvalidForeignKey):
+			utils.ErrorResponse(w, err, http.StatusBadRequest)
+			return
+
+		case errors.Is(err, errMsg.ErrDuplicate):
+			utils.ErrorResponse(w, err, http.StatusConflict)
+			return
+
+		default:
+			h.logger.Error(ctx, err, ref+logger.LogCreateError, nil)
+			utils.ErrorResponse(w, err, http.StatusInternalServerError)
+			return
+		}
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{
+		"client_cnpj_id": createdClient.ID,
+	})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Cliente criado com sucesso",
+		Data:    dto.ToClientCnpjDTO(createdClient),
+	})
+}
+
+func (h *clientCnpjHandler) Update(w http.ResponseWriter, r *http.Request) {
+	const ref = "[ClientCnpjHandler - Update] "
+	ctx := r.Context()
+
+	h.logger.Info(ctx, ref+logger.LogUpdateInit, nil)
+
+	uid, err := utils.GetIDParam(r, "id")
+	if err != nil {
+		h.logger.Warn(ctx, ref+logger.LogInvalidID, map[string]any{
+			"erro": err.Error(),
+		})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	var clientDTO dto.ClientCnpjDTO
+	if err := utils.FromJSON(r.Body, &clientDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{
+			"erro": err.Error(),
+		})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	clientModel := dto.ToClientCnpjModel(clientDTO)
+	clientModel.ID = uid
+
+	err = h.service.Update(ctx, clientModel)
+	if err != nil {
+		switch {
+		case errors.Is(err, errMsg.ErrInvalidData),
+			errors.Is(err, errMsg.ErrZeroID):
+			h.logger.Warn(ctx, ref+logger.LogValidateError, map[string]any{
+				"client_cnpj_id": uid,
+				"erro":           err.Error(),
+			})
+			utils.ErrorResponse(w, err, http.StatusBadRequest)
+			return
+
+		case errors.Is(err, errMsg.ErrDuplicate):
+			h.logger.Warn(ctx, ref+logger.LogErrDuplicate, map[string]any{
+				"client_cnpj_id": uid,
+			})
+			utils.ErrorResponse(w, err, http.StatusConflict)
+			return
+
+		case errors.Is(err, errMsg.ErrVersionConflict):
+			h.logger.Warn(ctx, ref+logger.LogUpdateVersionConflict, map[string]any{
+				"client_cnpj_id": uid,
+			})
+			utils.ErrorResponse(w, err, http.StatusConflict)
+			return
+
+		case errors.Is(err, errMsg.ErrNotFound):
+			h.logger.Warn(ctx, ref+logger.LogNotFound, map[string]any{
+				"client_cnpj_id": uid,
+			})
+			utils.ErrorResponse(w, err, http.StatusNotFound)
+			return
+
+		default:
+			h.logger.Error(ctx, err, ref+logger.LogUpdateError, map[string]any{
+				"client_cnpj_id": uid,
+			})
+			utils.ErrorResponse(w, err, http.StatusInternalServerError)
+			return
+		}
+	}
+
+	h.logger.Info(ctx, ref+logger.LogUpdateSuccess, map[string]any{
+		"client_cnpj_id": uid,
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Cliente atualizado com sucesso",
+		Data:    dto.ToClientCnpjDTO(clientModel),
+	})
+}
+
+func (h *clientCnpjHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	const ref = "[ClientCnpjHandler - Delete] "
+	ctx := r.Context()
+
+	h.logger.Info(ctx, ref+logger.LogDeleteInit, nil)
+
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil {
+		h.logger.Warn(ctx, ref+logger.LogInvalidID, map[string]any{
+			"erro": err.Error(),
+		})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	err = h.service.Delete(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, errMsg.ErrNotFound):
+			h.logger.Warn(ctx, ref+logger.LogNotFound, map[string]any{
+				"client_cnpj_id": id,
+			})
+			utils.ErrorResponse(w, err, http.StatusNotFound)
+			return
+
+		default:
+			h.logger.Error(ctx, err, ref+logger.LogDeleteError, map[string]any{
+				"client_cnpj_id": id,
+			})
+			utils.ErrorResponse(w, err, http.StatusInternalServerError)
+			return
+		}
+	}
+
+	h.logger.Info(ctx, ref+logger.LogDeleteSuccess, map[string]any{
+		"client_cnpj_id": id,
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+}
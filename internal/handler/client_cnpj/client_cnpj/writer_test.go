@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cnpj"
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/client_cnpj/client"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newRequestWithVars(method, url string, body []byte, vars map[string]string) *http.Request {
+	req := httptest.NewRequest(method, url, bytes.NewBuffer(body))
+	return mux.SetURLVars(req, vars)
+}
+
+func newTestLogger() *logger.LogAdapter {
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	return logger.NewLoggerAdapter(baseLogger)
+}
+
+func TestClientCnpjHandler_Create(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService := new(mockClient.MockClientCnpj)
+		handler := NewClientCnpjHandler(mockService, newTestLogger())
+
+		body, _ := json.Marshal(dto.ClientCnpjDTO{Name: "Empresa LTDA", Email: "a@b.com", CNPJ: "11222333000181", Version: 1})
+		mockService.On("Create", mock.Anything, mock.AnythingOfType("*model.ClientCnpj")).
+			Return(&models.ClientCnpj{ID: 1, Name: "Empresa LTDA", CNPJ: "11222333000181"}, nil).Once()
+
+		w := httptest.NewRecorder()
+		handler.Create(w, httptest.NewRequest(http.MethodPost, "/clients-cnpj", bytes.NewBuffer(body)))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response struct {
+			Data dto.ClientCnpjDTO `json:"data"`
+		}
+		assert.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, int64(1), response.Data.ID)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("JSON inválido", func(t *testing.T) {
+		handler := NewClientCnpjHandler(new(mockClient.MockClientCnpj), newTestLogger())
+
+		w := httptest.NewRecorder()
+		handler.Create(w, httptest.NewRequest(http.MethodPost, "/clients-cnpj", bytes.NewBufferString("{")))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("CNPJ duplicado", func(t *testing.T) {
+		mockService := new(mockClient.MockClientCnpj)
+		handler := NewClientCnpjHandler(mockService, newTestLogger())
+		mockService.On("Create", mock.Anything, mock.Anything).Return(nil, errMsg.ErrDuplicate).Once()
+
+		w := httptest.NewRecorder()
+		handler.Create(w, httptest.NewRequest(http.MethodPost, "/clients-cnpj", bytes.NewBufferString("{}")))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService := new(mockClient.MockClientCnpj)
+		handler := NewClientCnpjHandler(mockService, newTestLogger())
+		mockService.On("Create", mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		handler.Create(w, httptest.NewRequest(http.MethodPost, "/clients-cnpj", bytes.NewBufferString("{}")))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestClientCnpjHandler_Update(t *testing.T) {
+	vars := map[string]string{"id": "1"}
+
+	t.Run("ID inválido", func(t *testing.T) {
+		handler := NewClientCnpjHandler(new(mockClient.MockClientCnpj), newTestLogger())
+
+		w := httptest.NewRecorder()
+		handler.Update(w, newRequestWithVars(http.MethodPut, "/clients-cnpj/abc", []byte("{}"), map[string]string{"id": "abc"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"sucesso", nil, http.StatusOK},
+		{"dados inválidos", errMsg.ErrInvalidData, http.StatusBadRequest},
+		{"conflito de versão", errMsg.ErrVersionConflict, http.StatusConflict},
+		{"duplicado", errMsg.ErrDuplicate, http.StatusConflict},
+		{"não encontrado", errMsg.ErrNotFound, http.StatusNotFound},
+		{"erro interno", errors.New("db down"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := new(mockClient.MockClientCnpj)
+			handler := NewClientCnpjHandler(mockService, newTestLogger())
+			mockService.On("Update", mock.Anything, mock.MatchedBy(func(c *models.ClientCnpj) bool { return c.ID == 1 })).
+				Return(tc.err).Once()
+
+			w := httptest.NewRecorder()
+			handler.Update(w, newRequestWithVars(http.MethodPut, "/clients-cnpj/1", []byte(`{"name":"Empresa","version":1}`), vars))
+
+			assert.Equal(t, tc.status, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClientCnpjHandler_Delete(t *testing.T) {
+	vars := map[string]string{"id": "1"}
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockService := new(mockClient.MockClientCnpj)
+		handler := NewClientCnpjHandler(mockService, newTestLogger())
+		mockService.On("Delete", mock.Anything, int64(1)).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		handler.Delete(w, newRequestWithVars(http.MethodDelete, "/clients-cnpj/1", nil, vars))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("não encontrado", func(t *testing.T) {
+		mockService := new(mockClient.MockClientCnpj)
+		handler := NewClientCnpjHandler(mockService, newTestLogger())
+		mockService.On("Delete", mock.Anything, int64(1)).Return(errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		handler.Delete(w, newRequestWithVars(http.MethodDelete, "/clients-cnpj/1", nil, vars))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/client_cnpj/contact_relation"
+)
+
+type clientCnpjContactRelationHandler struct {
+	service service.ClientCnpjContactRelation
+	logger  *logger.LogAdapter
+}
+
+func NewClientCnpjContactRelationHandler(service service.ClientCnpjContactRelation, logger *logger.LogAdapter) *clientCnpjContactRelationHandler {
+	return &clientCnpjContactRelationHandler{
+		service: service,
+		logger:  logger,
+	}
+}
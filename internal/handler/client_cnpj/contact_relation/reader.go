@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/client_cnpj/contact_relation"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *clientCnpjContactRelationHandler) GetAllByClientCnpjID(w http.ResponseWriter, r *http.Request) {
+	const ref = "[ClientCnpjContactRelationHandler - GetAllByClientCnpjID] "
+	ctx := r.Context()
+
+	clientCnpjID, err := utils.GetIDParam(r, "client_cnpj_id")
+	if err != nil {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"client_cnpj_id": clientCnpjID})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	relations, err := h.service.GetAllRelationsByClientCnpjID(ctx, clientCnpjID)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+"Erro ao buscar relações", map[string]any{"client_cnpj_id": clientCnpjID})
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Info(ctx, ref+"Relações retornadas com sucesso", map[string]any{"client_cnpj_id": clientCnpjID})
+
+	relationsDTO := dto.ToClientCnpjContactRelationDTOs(relations)
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Data:    relationsDTO,
+		Message: "Relações encontradas",
+		Status:  http.StatusOK,
+	})
+}
+
+func (h *clientCnpjContactRelationHandler) HasClientCnpjContactRelation(w http.ResponseWriter, r *http.Request) {
+	const ref = "[ClientCnpjContactRelationHandler - HasRelation] "
+	ctx := r.Context()
+
+	clientCnpjID, err := utils.GetIDParam(r, "client_cnpj_id")
+	if err != nil {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"client_cnpj_id": clientCnpjID})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	contactID, err := utils.GetIDParam(r, "contact_id")
+	if err != nil {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"contact_id": contactID})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	exists, err := h.service.HasClientCnpjContactRelation(ctx, clientCnpjID, contactID)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+"Erro ao verificar relação", map[string]any{
+			"client_cnpj_id": clientCnpjID,
+			"contact_id":     contactID,
+		})
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Info(ctx, ref+"Verificação concluída", map[string]any{
+		"client_cnpj_id": clientCnpjID,
+		"contact_id":     contactID,
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Data:    map[string]bool{"exists": exists},
+		Message: "Verificação concluída",
+		Status:  http.StatusOK,
+	})
+}
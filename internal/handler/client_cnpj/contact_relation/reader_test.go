@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/contact_relation"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClientCnpjContactRelationHandler_Reader(t *testing.T) {
+	vars := map[string]string{"client_cnpj_id": "1", "contact_id": "2"}
+
+	t.Run("GetAll sucesso", func(t *testing.T) {
+		handler, mockService := newTestHandler()
+		mockService.On("GetAllRelationsByClientCnpjID", mock.Anything, int64(1)).
+			Return([]*models.ClientCnpjContactRelation{{ClientCnpjID: 1, ContactID: 2}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		handler.GetAllByClientCnpjID(w, mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/", nil), vars))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"contact_id":2`)
+	})
+
+	t.Run("GetAll ID inválido", func(t *testing.T) {
+		handler, _ := newTestHandler()
+
+		w := httptest.NewRecorder()
+		handler.GetAllByClientCnpjID(w, mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/", nil), map[string]string{"client_cnpj_id": "x"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("GetAll erro", func(t *testing.T) {
+		handler, mockService := newTestHandler()
+		mockService.On("GetAllRelationsByClientCnpjID", mock.Anything, int64(1)).Return(nil, errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		handler.GetAllByClientCnpjID(w, mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/", nil), vars))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("Has sucesso", func(t *testing.T) {
+		handler, mockService := newTestHandler()
+		mockService.On("HasClientCnpjContactRelation", mock.Anything, int64(1), int64(2)).Return(true, nil).Once()
+
+		w := httptest.NewRecorder()
+		handler.HasClientCnpjContactRelation(w, mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/", nil), vars))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"exists":true`)
+	})
+
+	t.Run("Has contact_id inválido", func(t *testing.T) {
+		handler, _ := newTestHandler()
+
+		w := httptest.NewRecorder()
+		handler.HasClientCnpjContactRelation(w, mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/", nil), map[string]string{"client_cnpj_id": "1"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Has erro", func(t *testing.T) {
+		handler, mockService := newTestHandler()
+		mockService.On("HasClientCnpjContactRelation", mock.Anything, int64(1), int64(2)).Return(false, errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		handler.HasClientCnpjContactRelation(w, mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/", nil), vars))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
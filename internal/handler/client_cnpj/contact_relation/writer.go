@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/client_cnpj/contact_relation"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *clientCnpjContactRelationHandler) Create(w http.ResponseWriter, r *http.Request) {
+	const ref = "[ClientCnpjContactRelationHandler - Create]"
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+" método não permitido", map[string]any{
+			"method": r.Method,
+		})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	h.logger.Info(ctx, ref+" - início da criação", nil)
+
+	var relationDTO dto.ClientCnpjContactRelationDTO
+	if err := utils.FromJSON(r.Body, &relationDTO); err != nil {
+		h.logger.Warn(ctx, ref+" erro ao decodificar JSON", map[string]any{
+			"erro": err.Error(),
+		})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	// Valida se veio algo no corpo
+	if relationDTO.ClientCnpjID <= 0 || relationDTO.ContactID <= 0 {
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	modelRelation := dto.ToClientCnpjContactRelationModel(relationDTO)
+
+	createdRelation, err := h.service.Create(ctx, modelRelation)
+	if err != nil {
+		status := http.StatusInternalServerError
+
+		switch {
+		case errors.Is(err, errMsg.ErrZeroID):
+			status = http.StatusBadRequest
+		case errors.Is(err, errMsg.ErrRelationExists):
+			status = http.StatusConflict
+		case errors.Is(err, errMsg.ErrDBInvalidForeignKey):
+			status = http.StatusBadRequest
+		}
+
+		h.logger.Error(ctx, err, ref+" erro ao criar relação", map[string]any{
+			"client_cnpj_id": relationDTO.ClientCnpjID,
+			"contact_id":     relationDTO.ContactID,
+		})
+		utils.ErrorResponse(w, err, status)
+		return
+	}
+
+	h.logger.Info(ctx, ref+" relação criada com sucesso", map[string]any{
+		"client_cnpj_id": createdRelation.ClientCnpjID,
+		"contact_id":     createdRelation.ContactID,
+	})
+
+	createdDTO := dto.ToClientCnpjContactRelationDTO(createdRelation)
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Relação criada com sucesso",
+		Data:    createdDTO,
+	})
+}
+
+func (h *clientCnpjContactRelationHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	const ref = "[ClientCnpjContactRelationHandler - Delete] "
+	ctx := r.Context()
+
+	clientCnpjID, err := utils.GetIDParam(r, "client_cnpj_id")
+	if err != nil {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"client_cnpj_id": clientCnpjID})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	contactID, err := utils.GetIDParam(r, "contact_id")
+	if err != nil {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"contact_id": contactID})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.Delete(ctx, clientCnpjID, contactID); err != nil {
+		h.logger.Error(ctx, err, ref+"Erro ao deletar relação", map[string]any{
+			"client_cnpj_id": clientCnpjID,
+			"contact_id":     contactID,
+		})
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Info(ctx, ref+"Relação deletada com sucesso", map[string]any{
+		"client_cnpj_id": clientCnpjID,
+		"contact_id":     contactID,
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Data:    nil,
+		Message: "Relação deletada com sucesso",
+		Status:  http.StatusOK,
+	})
+}
+
+func (h *clientCnpjContactRelationHandler) DeleteAll(w http.ResponseWriter, r *http.Request) {
+	const ref = "[ClientCnpjContactRelationHandler - DeleteAll] "
+	ctx := r.Context()
+
+	clientCnpjID, err := utils.GetIDParam(r, "client_cnpj_id")
+	if err != nil {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"client_cnpj_id": clientCnpjID})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.DeleteAll(ctx, clientCnpjID); err != nil {
+		h.logger.Error(ctx, err, ref+"Erro ao deletar relações", map[string]any{
+			"client_cnpj_id": clientCnpjID,
+		})
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Info(ctx, ref+"Relações deletadas com sucesso", map[string]any{
+		"client_cnpj_id": clientCnpjID,
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Data:    nil,
+		Message: "Relações deletadas com sucesso",
+		Status:  http.StatusOK,
+	})
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cnpj"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/contact_relation"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestHandler() (*clientCnpjContactRelationHandler, *mockClient.MockClientCnpjContactRelation) {
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	mockService := new(mockClient.MockClientCnpjContactRelation)
+	return NewClientCnpjContactRelationHandler(mockService, logger.NewLoggerAdapter(baseLogger)), mockService
+}
+
+func TestClientCnpjContactRelationHandler_Create(t *testing.T) {
+	newReq := func(method, body string) *http.Request {
+		return httptest.NewRequest(method, "/client-cnpj-contact-relation", bytes.NewBufferString(body))
+	}
+
+	t.Run("método não permitido", func(t *testing.T) {
+		handler, _ := newTestHandler()
+
+		w := httptest.NewRecorder()
+		handler.Create(w, newReq(http.MethodGet, ""))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("JSON inválido", func(t *testing.T) {
+		handler, _ := newTestHandler()
+
+		w := httptest.NewRecorder()
+		handler.Create(w, newReq(http.MethodPost, "{"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("IDs ausentes", func(t *testing.T) {
+		handler, _ := newTestHandler()
+
+		w := httptest.NewRecorder()
+		handler.Create(w, newReq(http.MethodPost, `{"client_cnpj_id":1}`))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"relação existente", errMsg.ErrRelationExists, http.StatusConflict},
+		{"chave estrangeira", errMsg.ErrDBInvalidForeignKey, http.StatusBadRequest},
+		{"erro interno", errors.New("db down"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, mockService := newTestHandler()
+			mockService.On("Create", mock.Anything, mock.Anything).Return(nil, tc.err).Once()
+
+			w := httptest.NewRecorder()
+			handler.Create(w, newReq(http.MethodPost, `{"client_cnpj_id":1,"contact_id":2}`))
+
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+
+	t.Run("sucesso", func(t *testing.T) {
+		handler, mockService := newTestHandler()
+		mockService.On("Create", mock.Anything, mock.MatchedBy(func(r *models.ClientCnpjContactRelation) bool {
+			return r.ClientCnpjID == 1 && r.ContactID == 2
+		})).Return(&models.ClientCnpjContactRelation{ClientCnpjID: 1, ContactID: 2}, nil).Once()
+
+		w := httptest.NewRecorder()
+		handler.Create(w, newReq(http.MethodPost, `{"client_cnpj_id":1,"contact_id":2}`))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"client_cnpj_id":1`)
+	})
+}
+
+func TestClientCnpjContactRelationHandler_Delete(t *testing.T) {
+	vars := map[string]string{"client_cnpj_id": "1", "contact_id": "2"}
+
+	t.Run("ID inválido", func(t *testing.T) {
+		handler, _ := newTestHandler()
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/", nil), map[string]string{"client_cnpj_id": "x"})
+
+		w := httptest.NewRecorder()
+		handler.Delete(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		handler, mockService := newTestHandler()
+		mockService.On("Delete", mock.Anything, int64(1), int64(2)).Return(errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		handler.Delete(w, mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/", nil), vars))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		handler, mockService := newTestHandler()
+		mockService.On("Delete", mock.Anything, int64(1), int64(2)).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		handler.Delete(w, mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/", nil), vars))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("delete all sucesso", func(t *testing.T) {
+		handler, mockService := newTestHandler()
+		mockService.On("DeleteAll", mock.Anything, int64(1)).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		handler.DeleteAll(w, mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/", nil), vars))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("delete all erro", func(t *testing.T) {
+		handler, mockService := newTestHandler()
+		mockService.On("DeleteAll", mock.Anything, int64(1)).Return(errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		handler.DeleteAll(w, mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/", nil), vars))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/client_cnpj/filter"
+)
+
+type clientCnpjFilterHandler struct {
+	service service.ClientCnpjFilter
+	logger  *logger.LogAdapter
+}
+
+func NewClientCnpjFilterHandler(service service.ClientCnpjFilter, logger *logger.LogAdapter) *clientCnpjFilterHandler {
+	return &clientCnpjFilterHandler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,245 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/client_cnpj/client"
+	dtoClientCnpjFilter "github.com/WagaoCarvalho/backend_store_go/internal/dto/client_cnpj/filter"
+	dtoPage "github.com/WagaoCarvalho/backend_store_go/internal/dto/common/page"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *clientCnpjFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	const ref = "[ClientCnpjFilterHandler - Filter] "
+
+	if err := h.validateQueryParams(r); err != nil {
+		h.logger.Warn(ctx, ref+"validação de parâmetros falhou", map[string]any{
+			"erro":  err.Error(),
+			"query": r.URL.Query(),
+		})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	dtoFilter, err := h.parseFilterDTO(r)
+	if err != nil {
+		h.logger.Warn(ctx, ref+"erro ao parsear filtros", map[string]any{
+			"erro":  err.Error(),
+			"query": r.URL.Query(),
+		})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	if err := dtoFilter.Validate(); err != nil {
+		h.logger.Warn(ctx, ref+"validação de DTO falhou", map[string]any{
+			"erro": err.Error(),
+			"dto":  dtoFilter,
+		})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	filter, err := dtoFilter.ToModel()
+	if err != nil {
+		h.logger.Warn(ctx, ref+"erro ao converter filtro", map[string]any{
+			"erro": err.Error(),
+			"dto":  dtoFilter,
+		})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"filtro": dtoFilter})
+
+	clients, err := h.service.Filter(ctx, filter)
+	if err != nil {
+		if errors.Is(err, errMsg.ErrInvalidFilter) {
+			h.logger.Warn(ctx, ref+"filtro inválido", map[string]any{"erro": err.Error(), "filtro": dtoFilter})
+			utils.ErrorResponse(w, err, http.StatusBadRequest)
+			return
+		}
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"filtro": dtoFilter})
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	clientDTOs := dto.ToClientCnpjDTOs(clients.Items)
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"total_encontrados": len(clientDTOs),
+		"filtros_aplicados": countFiltersApplied(dtoFilter),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Clientes listados com sucesso",
+		Data: struct {
+			dtoPage.PageDTO[dto.ClientCnpjDTO]
+			FiltersApplied int `json:"filters_applied"`
+		}{
+			PageDTO:        dtoPage.ToPageDTO(clients, clientDTOs),
+			FiltersApplied: countFiltersApplied(dtoFilter),
+		},
+	})
+}
+
+func (h *clientCnpjFilterHandler) validateQueryParams(r *http.Request) error {
+	allowedParams := map[string]bool{
+		"name":         true,
+		"email":        true,
+		"trade_name":   true,
+		"cnpj":         true,
+		"status":       true,
+		"version":      true,
+		"created_from": true,
+		"created_to":   true,
+		"updated_from": true,
+		"updated_to":   true,
+		"page":         true,
+		"limit":        true,
+		"offset":       true,
+		"cursor":       true,
+		"search":       true,
+		"sort_by":      true,
+		"sort_order":   true,
+	}
+
+	query := r.URL.Query()
+
+	for param := range query {
+		paramLower := strings.ToLower(param)
+		if !allowedParams[paramLower] {
+			return errors.New("parâmetro desconhecido: '" + param + "'.")
+		}
+	}
+
+	return nil
+}
+
+func (h *clientCnpjFilterHandler) parseFilterDTO(r *http.Request) (dtoClientCnpjFilter.ClientCnpjFilterDTO, error) {
+	var dto dtoClientCnpjFilter.ClientCnpjFilterDTO
+	query := r.URL.Query()
+
+	dto.Name = strings.TrimSpace(query.Get("name"))
+	dto.Email = strings.TrimSpace(query.Get("email"))
+	dto.TradeName = strings.TrimSpace(query.Get("trade_name"))
+	dto.CNPJ = strings.TrimSpace(query.Get("cnpj"))
+
+	if v := strings.TrimSpace(query.Get("status")); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			return dto, errors.New("valor inválido para 'status': deve ser 'true' ou 'false'")
+		}
+		dto.Status = &parsed
+	}
+
+	if v := strings.TrimSpace(query.Get("version")); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil {
+			return dto, errors.New("valor inválido para 'version': deve ser um número inteiro")
+		}
+		if parsed <= 0 {
+			return dto, errors.New("valor inválido para 'version': deve ser maior que zero")
+		}
+		dto.Version = &parsed
+	}
+
+	dto.CreatedFrom = h.parseTimeParam(query, "created_from")
+	dto.CreatedTo = h.parseTimeParam(query, "created_to")
+	dto.UpdatedFrom = h.parseTimeParam(query, "updated_from")
+	dto.UpdatedTo = h.parseTimeParam(query, "updated_to")
+
+	if v := query.Get("created_from"); v != "" && dto.CreatedFrom == nil {
+		return dto, errors.New("formato de data inválido para 'created_from': use formato RFC3339 (ex: 2024-01-01T00:00:00Z) ou YYYY-MM-DD")
+	}
+	if v := query.Get("created_to"); v != "" && dto.CreatedTo == nil {
+		return dto, errors.New("formato de data inválido para 'created_to': use formato RFC3339 (ex: 2024-12-31T23:59:59Z) ou YYYY-MM-DD")
+	}
+	if v := query.Get("updated_from"); v != "" && dto.UpdatedFrom == nil {
+		return dto, errors.New("formato de data inválido para 'updated_from': use formato RFC3339 ou YYYY-MM-DD")
+	}
+	if v := query.Get("updated_to"); v != "" && dto.UpdatedTo == nil {
+		return dto, errors.New("formato de data inválido para 'updated_to': use formato RFC3339 ou YYYY-MM-DD")
+	}
+
+	dto.Limit, dto.Offset = utils.GetPaginationParams(r)
+	dto.Cursor, dto.CursorMode = utils.GetCursorParam(r)
+	dto.SearchTerm = r.URL.Query().Get("search")
+	dto.SortBy = strings.TrimSpace(query.Get("sort_by"))
+	dto.SortOrder = strings.TrimSpace(query.Get("sort_order"))
+
+	return dto, nil
+}
+
+func (h *clientCnpjFilterHandler) parseTimeParam(query map[string][]string, param string) *time.Time {
+	values, exists := query[param]
+	if !exists || len(values) == 0 {
+		return nil
+	}
+
+	v := strings.TrimSpace(values[0])
+	if v == "" {
+		return nil
+	}
+
+	formats := []string{
+		time.RFC3339,
+		"2006-01-02T15:04:05",
+		"2006-01-02 15:04:05",
+		"2006-01-02",
+	}
+
+	for _, format := range formats {
+		parsed, err := time.Parse(format, v)
+		if err == nil {
+			return &parsed
+		}
+	}
+
+	return nil
+}
+
+func countFiltersApplied(dto dtoClientCnpjFilter.ClientCnpjFilterDTO) int {
+	count := 0
+
+	if dto.Name != "" {
+		count++
+	}
+	if dto.Email != "" {
+		count++
+	}
+	if dto.TradeName != "" {
+		count++
+	}
+	if dto.CNPJ != "" {
+		count++
+	}
+	if dto.Status != nil {
+		count++
+	}
+	if dto.Version != nil {
+		count++
+	}
+	if dto.CreatedFrom != nil {
+		count++
+	}
+	if dto.CreatedTo != nil {
+		count++
+	}
+	if dto.UpdatedFrom != nil {
+		count++
+	}
+	if dto.UpdatedTo != nil {
+		count++
+	}
+
+	return count
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cnpj"
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClientCnpjHandler_Filter(t *testing.T) {
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	logger := logger.NewLoggerAdapter(baseLogger)
+
+	t.Run("erro - parâmetro desconhecido", func(t *testing.T) {
+		handler := NewClientCnpjFilterHandler(new(mockClient.MockClientCnpj), logger)
+
+		rec := httptest.NewRecorder()
+		handler.Filter(rec, httptest.NewRequest(http.MethodGet, "/clients-cnpj/filter?cpf=123", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "parâmetro desconhecido")
+	})
+
+	t.Run("erro - status inválido", func(t *testing.T) {
+		handler := NewClientCnpjFilterHandler(new(mockClient.MockClientCnpj), logger)
+
+		rec := httptest.NewRecorder()
+		handler.Filter(rec, httptest.NewRequest(http.MethodGet, "/clients-cnpj/filter?status=talvez", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("erro - data inválida", func(t *testing.T) {
+		handler := NewClientCnpjFilterHandler(new(mockClient.MockClientCnpj), logger)
+
+		rec := httptest.NewRecorder()
+		handler.Filter(rec, httptest.NewRequest(http.MethodGet, "/clients-cnpj/filter?created_from=ontem", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("erro - sem filtros", func(t *testing.T) {
+		handler := NewClientCnpjFilterHandler(new(mockClient.MockClientCnpj), logger)
+
+		rec := httptest.NewRecorder()
+		handler.Filter(rec, httptest.NewRequest(http.MethodGet, "/clients-cnpj/filter", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("erro - filtro inválido no service", func(t *testing.T) {
+		mockService := new(mockClient.MockClientCnpj)
+		handler := NewClientCnpjFilterHandler(mockService, logger)
+		mockService.On("Filter", mock.Anything, mock.Anything).Return(nil, errMsg.ErrInvalidFilter).Once()
+
+		rec := httptest.NewRecorder()
+		handler.Filter(rec, httptest.NewRequest(http.MethodGet, "/clients-cnpj/filter?trade_name=Loja", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("erro - falha interna", func(t *testing.T) {
+		mockService := new(mockClient.MockClientCnpj)
+		handler := NewClientCnpjFilterHandler(mockService, logger)
+		mockService.On("Filter", mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		rec := httptest.NewRecorder()
+		handler.Filter(rec, httptest.NewRequest(http.MethodGet, "/clients-cnpj/filter?trade_name=Loja", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockService := new(mockClient.MockClientCnpj)
+		handler := NewClientCnpjFilterHandler(mockService, logger)
+		mockService.On("Filter", mock.Anything, mock.MatchedBy(func(f *filter.ClientCnpjFilter) bool {
+			return f.TradeName == "Loja" && f.CNPJ == "11.222.333/0001-81"
+		})).Return([]*model.ClientCnpj{{ID: 1, Name: "Loja LTDA", TradeName: "Loja"}}, nil).Once()
+
+		rec := httptest.NewRecorder()
+		handler.Filter(rec, httptest.NewRequest(http.MethodGet, "/clients-cnpj/filter?trade_name=Loja&cnpj=11.222.333/0001-81", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp utils.DefaultResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		data := resp.Data.(map[string]any)
+		assert.Equal(t, float64(1), data["total"])
+		assert.Equal(t, float64(2), data["filters_applied"])
+		mockService.AssertExpectations(t)
+	})
+}
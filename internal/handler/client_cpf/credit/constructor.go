@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/client_cpf/credit"
+)
+
+type clientCreditHandler struct {
+	service service.ClientCreditService
+	logger  *logger.LogAdapter
+}
+
+func NewClientCreditHandler(service service.ClientCreditService, logger *logger.LogAdapter) *clientCreditHandler {
+	return &clientCreditHandler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/client_cpf/client_credit"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *clientCreditHandler) GetByClientID(w http.ResponseWriter, r *http.Request) {
+	const ref = "[ClientCreditHandler - GetByClientID] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodGet {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	clientID, err := utils.GetIDParam(r, "id")
+	if err != nil || clientID <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": clientID})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	credit, err := h.service.GetByClientID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, errMsg.ErrNotFound) {
+			h.logger.Warn(ctx, ref+logger.LogNotFound, map[string]any{"client_id": clientID})
+			utils.ErrorResponse(w, err, http.StatusNotFound)
+			return
+		}
+
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"client_id": clientID})
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Conta de crédito recuperada",
+		Data:    dto.ToClientCreditDTO(credit),
+	})
+}
+
+func (h *clientCreditHandler) GetStatement(w http.ResponseWriter, r *http.Request) {
+	const ref = "[ClientCreditHandler - GetStatement] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodGet {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	clientID, err := utils.GetIDParam(r, "id")
+	if err != nil || clientID <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": clientID})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	limit, offset := utils.GetPaginationParams(r)
+
+	statement, err := h.service.GetStatement(ctx, clientID, limit, offset)
+	if err != nil {
+		switch {
+		case errors.Is(err, errMsg.ErrInvalidLimit), errors.Is(err, errMsg.ErrInvalidOffset):
+			h.logger.Warn(ctx, ref+"paginação inválida", map[string]any{"limit": limit, "offset": offset})
+			utils.ErrorResponse(w, err, http.StatusBadRequest)
+			return
+		case errors.Is(err, errMsg.ErrNotFound):
+			h.logger.Warn(ctx, ref+logger.LogNotFound, map[string]any{"client_id": clientID})
+			utils.ErrorResponse(w, err, http.StatusNotFound)
+			return
+		}
+
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"client_id": clientID})
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Extrato de crédito recuperado",
+		Data:    dto.ToCreditStatementDTO(statement),
+	})
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cpf"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupHandler() (*mockClient.MockClientCredit, *clientCreditHandler) {
+	mockService := new(mockClient.MockClientCredit)
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	loggerAdapter := logger.NewLoggerAdapter(baseLogger)
+	return mockService, NewClientCreditHandler(mockService, loggerAdapter)
+}
+
+func newCreditRequest(method, target, id string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	return mux.SetURLVars(req, map[string]string{"id": id})
+}
+
+func TestClientCreditHandler_GetByClientID(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetByClientID", mock.Anything, int64(7)).
+			Return(&models.ClientCredit{ID: 1, ClientID: 7, AllowCredit: true, CreditLimit: money.New(100), CreditBalance: money.New(30)}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByClientID(w, newCreditRequest(http.MethodGet, "/clients-cpf/7/credit", "7", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp map[string]interface{}
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		data := resp["data"].(map[string]interface{})
+		assert.Equal(t, 30.0, data["credit_balance"])
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetByClientID(w, newCreditRequest(http.MethodPost, "/clients-cpf/7/credit", "7", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetByClientID(w, newCreditRequest(http.MethodGet, "/clients-cpf/0/credit", "0", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("conta não encontrada", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetByClientID", mock.Anything, int64(7)).Return(nil, errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByClientID(w, newCreditRequest(http.MethodGet, "/clients-cpf/7/credit", "7", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("erro genérico", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetByClientID", mock.Anything, int64(7)).Return(nil, errors.New("db error")).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByClientID(w, newCreditRequest(http.MethodGet, "/clients-cpf/7/credit", "7", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestClientCreditHandler_GetStatement(t *testing.T) {
+	t.Run("sucesso com paginação", func(t *testing.T) {
+		mockService, h := setupHandler()
+		statement := &models.CreditStatement{
+			Credit: &models.ClientCredit{ClientID: 7, CreditBalance: money.New(30)},
+			Entries: []*models.CreditEntry{
+				{ID: 1, EntryType: models.EntryCharge, Amount: money.New(50), BalanceAfter: money.New(50)},
+				{ID: 2, EntryType: models.EntryPayment, Amount: money.New(20), BalanceAfter: money.New(30)},
+			},
+		}
+		mockService.On("GetStatement", mock.Anything, int64(7), 5, 10).Return(statement, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.GetStatement(w, newCreditRequest(http.MethodGet, "/clients-cpf/7/credit/statement?limit=5&offset=10", "7", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp map[string]interface{}
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		data := resp["data"].(map[string]interface{})
+		entries := data["entries"].([]interface{})
+		assert.Len(t, entries, 2)
+		assert.Equal(t, 30.0, entries[1].(map[string]interface{})["balance_after"])
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetStatement(w, newCreditRequest(http.MethodPost, "/clients-cpf/7/credit/statement", "7", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetStatement(w, newCreditRequest(http.MethodGet, "/clients-cpf/abc/credit/statement", "abc", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"limite inválido", errMsg.ErrInvalidLimit, http.StatusBadRequest},
+		{"offset inválido", errMsg.ErrInvalidOffset, http.StatusBadRequest},
+		{"conta não encontrada", errMsg.ErrNotFound, http.StatusNotFound},
+		{"erro genérico", errors.New("db error"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, h := setupHandler()
+			mockService.On("GetStatement", mock.Anything, int64(7), mock.Anything, mock.Anything).Return(nil, tc.err).Once()
+
+			w := httptest.NewRecorder()
+			h.GetStatement(w, newCreditRequest(http.MethodGet, "/clients-cpf/7/credit/statement", "7", nil))
+
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/client_cpf/client_credit"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+// OpenOrAdjust abre ou ajusta a linha de crédito do cliente do path. O saldo
+// devedor enviado no corpo é ignorado: ele só muda por lançamentos.
+func (h *clientCreditHandler) OpenOrAdjust(w http.ResponseWriter, r *http.Request) {
+	const ref = "[ClientCreditHandler - OpenOrAdjust] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPut {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	clientID, err := utils.GetIDParam(r, "id")
+	if err != nil || clientID <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": clientID})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	var creditDTO dto.ClientCreditDTO
+	if err := utils.FromJSON(r.Body, &creditDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+	creditDTO.ID = nil
+	creditDTO.ClientID = clientID
+	creditDTO.CreditBalance = money.Zero
+
+	h.logger.Info(ctx, ref+logger.LogUpdateInit, map[string]any{"client_id": clientID})
+
+	credit, err := h.service.OpenOrAdjust(ctx, dto.ToClientCreditModel(creditDTO))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogUpdateError, map[string]any{"client_id": clientID})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogUpdateSuccess, map[string]any{"client_id": clientID})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Linha de crédito atualizada com sucesso",
+		Data:    dto.ToClientCreditDTO(credit),
+	})
+}
+
+func (h *clientCreditHandler) Charge(w http.ResponseWriter, r *http.Request) {
+	h.postEntry(w, r, "[ClientCreditHandler - Charge] ", h.service.Charge, "Cobrança lançada com sucesso")
+}
+
+func (h *clientCreditHandler) Payment(w http.ResponseWriter, r *http.Request) {
+	h.postEntry(w, r, "[ClientCreditHandler - Payment] ", h.service.Payment, "Pagamento lançado com sucesso")
+}
+
+// postEntry trata o lançamento manual no razão do cliente do path.
+func (h *clientCreditHandler) postEntry(
+	w http.ResponseWriter,
+	r *http.Request,
+	ref string,
+	post func(ctx context.Context, entry *models.CreditEntry) (*models.CreditEntry, error),
+	message string,
+) {
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	clientID, err := utils.GetIDParam(r, "id")
+	if err != nil || clientID <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": clientID})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	var entryDTO dto.CreditEntryDTO
+	if err := utils.FromJSON(r.Body, &entryDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+	entryDTO.ClientID = clientID
+
+	// O lançamento é sempre atribuído ao usuário autenticado
+	entryDTO.UserID = nil
+	if uid, err := strconv.ParseInt(contextUtils.GetUserID(ctx), 10, 64); err == nil {
+		entryDTO.UserID = &uid
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateInit, map[string]any{"client_id": clientID})
+
+	entry, err := post(ctx, dto.ToCreditEntryModel(entryDTO))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, map[string]any{"client_id": clientID})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{
+		"client_id":     clientID,
+		"entry_id":      entry.ID,
+		"balance_after": entry.BalanceAfter,
+	})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: message,
+		Data:    dto.ToCreditEntryDTO(entry),
+	})
+}
+
+func statusFromError(err error) int {
+	switch {
+	case errors.Is(err, errMsg.ErrInvalidData):
+		return http.StatusBadRequest
+	case errors.Is(err, errMsg.ErrNotFound),
+		errors.Is(err, errMsg.ErrDBInvalidForeignKey):
+		return http.StatusNotFound
+	case errors.Is(err, errMsg.ErrCreditLimitExceeded):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
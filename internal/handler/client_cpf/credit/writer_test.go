@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClientCreditHandler_OpenOrAdjust(t *testing.T) {
+	body := []byte(`{"client_id":99,"allow_credit":true,"credit_limit":500,"credit_balance":1000}`)
+
+	t.Run("sucesso usa o cliente do path e ignora o saldo enviado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("OpenOrAdjust", mock.Anything, mock.MatchedBy(func(c *models.ClientCredit) bool {
+			return c.ClientID == 7 && c.AllowCredit && c.CreditLimit == money.New(500) && c.CreditBalance == money.New(0)
+		})).Return(&models.ClientCredit{ID: 1, ClientID: 7, AllowCredit: true, CreditLimit: money.New(500), CreditBalance: money.New(30)}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.OpenOrAdjust(w, newCreditRequest(http.MethodPut, "/clients-cpf/7/credit", "7", body))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.OpenOrAdjust(w, newCreditRequest(http.MethodPost, "/clients-cpf/7/credit", "7", body))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.OpenOrAdjust(w, newCreditRequest(http.MethodPut, "/clients-cpf/0/credit", "0", body))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("json inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.OpenOrAdjust(w, newCreditRequest(http.MethodPut, "/clients-cpf/7/credit", "7", []byte("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"dados inválidos", errMsg.ErrInvalidData, http.StatusBadRequest},
+		{"cliente inexistente", errMsg.ErrDBInvalidForeignKey, http.StatusNotFound},
+		{"erro genérico", errors.New("db error"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, h := setupHandler()
+			mockService.On("OpenOrAdjust", mock.Anything, mock.Anything).Return(nil, tc.err).Once()
+
+			w := httptest.NewRecorder()
+			h.OpenOrAdjust(w, newCreditRequest(http.MethodPut, "/clients-cpf/7/credit", "7", body))
+
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
+
+func TestClientCreditHandler_Charge(t *testing.T) {
+	body := []byte(`{"client_id":99,"amount":50,"description":"compra no balcão","user_id":42}`)
+
+	t.Run("sucesso atribui o lançamento ao usuário autenticado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Charge", mock.Anything, mock.MatchedBy(func(e *models.CreditEntry) bool {
+			return e.ClientID == 7 && e.Amount == money.New(50) && e.UserID != nil && *e.UserID == 3
+		})).Return(&models.CreditEntry{ID: 1, ClientID: 7, EntryType: models.EntryCharge, Amount: money.New(50), BalanceAfter: money.New(80)}, nil).Once()
+
+		req := newCreditRequest(http.MethodPost, "/clients-cpf/7/credit/charges", "7", body)
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "3"))
+		w := httptest.NewRecorder()
+		h.Charge(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resp map[string]interface{}
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.Equal(t, "Cobrança lançada com sucesso", resp["message"])
+		assert.Equal(t, 80.0, resp["data"].(map[string]interface{})["balance_after"])
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Charge(w, newCreditRequest(http.MethodGet, "/clients-cpf/7/credit/charges", "7", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Charge(w, newCreditRequest(http.MethodPost, "/clients-cpf/0/credit/charges", "0", body))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("json inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Charge(w, newCreditRequest(http.MethodPost, "/clients-cpf/7/credit/charges", "7", []byte("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"dados inválidos", errMsg.ErrInvalidData, http.StatusBadRequest},
+		{"limite excedido", errMsg.ErrCreditLimitExceeded, http.StatusConflict},
+		{"erro genérico", errors.New("db error"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, h := setupHandler()
+			mockService.On("Charge", mock.Anything, mock.Anything).Return(nil, tc.err).Once()
+
+			w := httptest.NewRecorder()
+			h.Charge(w, newCreditRequest(http.MethodPost, "/clients-cpf/7/credit/charges", "7", body))
+
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
+
+func TestClientCreditHandler_Payment(t *testing.T) {
+	body := []byte(`{"amount":20,"description":"pagamento em dinheiro"}`)
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Payment", mock.Anything, mock.MatchedBy(func(e *models.CreditEntry) bool {
+			return e.ClientID == 7 && e.Amount == money.New(20) && e.UserID == nil
+		})).Return(&models.CreditEntry{ID: 2, ClientID: 7, EntryType: models.EntryPayment, Amount: money.New(20), BalanceAfter: money.New(10)}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.Payment(w, newCreditRequest(http.MethodPost, "/clients-cpf/7/credit/payments", "7", body))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertNotCalled(t, "Charge", mock.Anything, mock.Anything)
+		mockService.AssertExpectations(t)
+	})
+
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"pagamento acima do saldo", errMsg.ErrInvalidData, http.StatusBadRequest},
+		{"conta inexistente", errMsg.ErrNotFound, http.StatusNotFound},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, h := setupHandler()
+			mockService.On("Payment", mock.Anything, mock.Anything).Return(nil, tc.err).Once()
+
+			w := httptest.NewRecorder()
+			h.Payment(w, newCreditRequest(http.MethodPost, "/clients-cpf/7/credit/payments", "7", body))
+
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
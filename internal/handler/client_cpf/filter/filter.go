@@ -9,6 +9,7 @@ import (
 
 	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/client_cpf/client"
 	dtoclientCpfFilter "github.com/WagaoCarvalho/backend_store_go/internal/dto/client_cpf/filter"
+	dtoPage "github.com/WagaoCarvalho/backend_store_go/internal/dto/common/page"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
@@ -70,7 +71,7 @@ func (h *clientCpfFilterHandler) Filter(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	clientDTOs := dto.ToClientCpfDTOs(clients)
+	clientDTOs := dto.ToClientCpfDTOs(clients.Items)
 
 	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
 		"total_encontrados": len(clientDTOs),
@@ -80,11 +81,12 @@ func (h *clientCpfFilterHandler) Filter(w http.ResponseWriter, r *http.Request)
 	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
 		Status:  http.StatusOK,
 		Message: "Clientes listados com sucesso",
-		Data: map[string]any{
-			"total":           len(clientDTOs),
-			"items":           clientDTOs,
-			"filters_applied": countFiltersApplied(dtoFilter),
-			"has_more":        len(clientDTOs) == dtoFilter.Limit,
+		Data: struct {
+			dtoPage.PageDTO[dto.ClientCpfDTO]
+			FiltersApplied int `json:"filters_applied"`
+		}{
+			PageDTO:        dtoPage.ToPageDTO(clients, clientDTOs),
+			FiltersApplied: countFiltersApplied(dtoFilter),
 		},
 	})
 }
@@ -103,6 +105,9 @@ func (h *clientCpfFilterHandler) validateQueryParams(r *http.Request) error {
 		"updated_to":   true,
 		"page":         true,
 		"limit":        true,
+		"offset":       true,
+		"cursor":       true,
+		"search":       true,
 		"sort_by":      true,
 		"sort_order":   true,
 	}
@@ -166,6 +171,8 @@ func (h *clientCpfFilterHandler) parseFilterDTO(r *http.Request) (dtoclientCpfFi
 	}
 
 	dto.Limit, dto.Offset = utils.GetPaginationParams(r)
+	dto.Cursor, dto.CursorMode = utils.GetCursorParam(r)
+	dto.SearchTerm = r.URL.Query().Get("search")
 	dto.SortBy = strings.TrimSpace(query.Get("sort_by"))
 	dto.SortOrder = strings.TrimSpace(query.Get("sort_order"))
 
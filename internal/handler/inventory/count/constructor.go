@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/inventory/count"
+)
+
+type inventoryCountHandler struct {
+	service service.InventoryCountService
+	logger  *logger.LogAdapter
+}
+
+func NewInventoryCountHandler(service service.InventoryCountService, logger *logger.LogAdapter) *inventoryCountHandler {
+	return &inventoryCountHandler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dtoPage "github.com/WagaoCarvalho/backend_store_go/internal/dto/common/page"
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/inventory/count"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+var validListParams = map[string]bool{
+	"status":      true,
+	"category_id": true,
+	"limit":       true,
+	"offset":      true,
+	"cursor":      true,
+	"sort_by":     true,
+	"sort_order":  true,
+}
+
+func (h *inventoryCountHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	const ref = "[InventoryCountHandler - GetByID] "
+	ctx := r.Context()
+
+	id, ok := h.countID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"id": id})
+
+	count, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{"id": id})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Contagem de inventário encontrada",
+		Data:    dto.ToInventoryCountDTO(count),
+	})
+}
+
+func (h *inventoryCountHandler) List(w http.ResponseWriter, r *http.Request) {
+	const ref = "[InventoryCountHandler - List] "
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	for param := range query {
+		if !validListParams[param] {
+			h.logger.Warn(ctx, ref+"parâmetro desconhecido", map[string]any{"parametro": param})
+			utils.ErrorResponse(w, fmt.Errorf("parâmetro de consulta inválido: %s", param), http.StatusBadRequest)
+			return
+		}
+	}
+
+	limit, offset := utils.GetPaginationParams(r)
+	cursor, cursorMode := utils.GetCursorParam(r)
+
+	f := &models.InventoryCountFilter{
+		BaseFilter: commonFilter.BaseFilter{
+			Limit:      limit,
+			Offset:     offset,
+			SortBy:     query.Get("sort_by"),
+			SortOrder:  query.Get("sort_order"),
+			Cursor:     cursor,
+			CursorMode: cursorMode,
+		},
+		Status: query.Get("status"),
+	}
+
+	if v := query.Get("category_id"); v != "" {
+		category, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			h.logger.Warn(ctx, ref+"category_id inválido", map[string]any{"valor": v})
+			utils.ErrorResponse(w, fmt.Errorf("category_id deve ser um número inteiro"), http.StatusBadRequest)
+			return
+		}
+		f.CategoryID = &category
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"status": f.Status})
+
+	page, err := h.service.List(ctx, f)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, nil)
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	countDTOs := dto.ToInventoryCountDTOs(page.Items)
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{"total_encontrados": len(countDTOs)})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Contagens de inventário listadas com sucesso",
+		Data:    dtoPage.ToPageDTO(page, countDTOs),
+	})
+}
+
+// Variance atende GET /inventory-count/{id}/variance.
+func (h *inventoryCountHandler) Variance(w http.ResponseWriter, r *http.Request) {
+	const ref = "[InventoryCountHandler - Variance] "
+	ctx := r.Context()
+
+	id, ok := h.countID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"id": id})
+
+	report, err := h.service.Variance(ctx, id)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"id":          id,
+		"divergentes": report.Adjustments,
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Relatório de divergências gerado com sucesso",
+		Data:    dto.ToVarianceReportDTO(report),
+	})
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockInventory "github.com/WagaoCarvalho/backend_store_go/infra/mock/inventory"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupHandler() (*mockInventory.MockInventoryCountService, *inventoryCountHandler) {
+	mockService := new(mockInventory.MockInventoryCountService)
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	loggerAdapter := logger.NewLoggerAdapter(baseLogger)
+	return mockService, NewInventoryCountHandler(mockService, loggerAdapter)
+}
+
+func newCountRequest(method, target, id string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	return mux.SetURLVars(req, map[string]string{"id": id})
+}
+
+func TestInventoryCountHandler_GetByID(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetByID", mock.Anything, int64(1)).Return(&models.InventoryCount{
+			ID:     1,
+			Status: models.StatusOpen,
+			Items:  []models.CountItem{{ProductID: 7, CountedQuantity: 3, Entries: 2}},
+		}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByID(w, newCountRequest(http.MethodGet, "/inventory-count/1", "1", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"counted_quantity":3`)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetByID(w, newCountRequest(http.MethodGet, "/inventory-count/0", "0", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("não encontrada", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetByID", mock.Anything, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByID(w, newCountRequest(http.MethodGet, "/inventory-count/1", "1", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestInventoryCountHandler_List(t *testing.T) {
+	t.Run("filtra por status e categoria", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("List", mock.Anything, mock.MatchedBy(func(f *models.InventoryCountFilter) bool {
+			return f.Status == models.StatusOpen && f.CategoryID != nil && *f.CategoryID == 3
+		})).Return(&commonFilter.Page[*models.InventoryCount]{
+			Items: []*models.InventoryCount{{ID: 1, Status: models.StatusOpen}},
+			Total: 1,
+		}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.List(w, httptest.NewRequest(http.MethodGet, "/inventory-counts?status=open&category_id=3", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("parâmetro desconhecido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.List(w, httptest.NewRequest(http.MethodGet, "/inventory-counts?foo=1", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("categoria não numérica", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.List(w, httptest.NewRequest(http.MethodGet, "/inventory-counts?category_id=x", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("filtro inválido", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("List", mock.Anything, mock.Anything).Return(nil, errMsg.ErrInvalidFilter).Once()
+
+		w := httptest.NewRecorder()
+		h.List(w, httptest.NewRequest(http.MethodGet, "/inventory-counts?status=draft", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestInventoryCountHandler_Variance(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		counted := 8
+		report := models.NewVarianceReport(1, []*models.VarianceLine{
+			{ProductID: 7, ProductName: "Caneta", SystemQuantity: 10, CountedQuantity: &counted},
+		})
+		mockService.On("Variance", mock.Anything, int64(1)).Return(report, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.Variance(w, newCountRequest(http.MethodGet, "/inventory-count/1/variance", "1", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"variance":-2`)
+	})
+
+	t.Run("não encontrada", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Variance", mock.Anything, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		h.Variance(w, newCountRequest(http.MethodGet, "/inventory-count/1/variance", "1", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
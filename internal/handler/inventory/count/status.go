@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/inventory/count"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+// Close encerra a contagem e aplica os ajustes de estoque.
+func (h *inventoryCountHandler) Close(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, "[InventoryCountHandler - Close] ", "Contagem de inventário fechada com sucesso", h.service.Close)
+}
+
+func (h *inventoryCountHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, "[InventoryCountHandler - Cancel] ", "Contagem de inventário cancelada com sucesso", h.service.Cancel)
+}
+
+func (h *inventoryCountHandler) changeStatus(
+	w http.ResponseWriter,
+	r *http.Request,
+	ref string,
+	message string,
+	change func(ctx context.Context, id int64, userID *int64) (*models.InventoryCount, error),
+) {
+	ctx := r.Context()
+
+	if r.Method != http.MethodPatch {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := h.countID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	count, err := change(ctx, id, currentUser(ctx))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogUpdateError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogUpdateSuccess, map[string]any{
+		"id":                id,
+		"adjusted_products": count.AdjustedProducts,
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: message,
+		Data:    dto.ToInventoryCountDTO(count),
+	})
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestInventoryCountHandler_Close(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		user := int64(5)
+		mockService.On("Close", mock.Anything, int64(1), &user).
+			Return(&models.InventoryCount{ID: 1, Status: models.StatusClosed, AdjustedProducts: 2}, nil).Once()
+
+		req := newCountRequest(http.MethodPatch, "/inventory-count/1/close", "1", nil)
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "5"))
+		w := httptest.NewRecorder()
+		h.Close(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"adjusted_products":2`)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Close(w, newCountRequest(http.MethodPost, "/inventory-count/1/close", "1", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("contagem já fechada", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Close", mock.Anything, int64(1), mock.Anything).Return(nil, errMsg.ErrInvalidData).Once()
+
+		w := httptest.NewRecorder()
+		h.Close(w, newCountRequest(http.MethodPatch, "/inventory-count/1/close", "1", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestInventoryCountHandler_Cancel(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Cancel", mock.Anything, int64(1), (*int64)(nil)).
+			Return(&models.InventoryCount{ID: 1, Status: models.StatusCanceled}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.Cancel(w, newCountRequest(http.MethodPatch, "/inventory-count/1/cancel", "1", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("contagem inexistente", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Cancel", mock.Anything, int64(1), mock.Anything).Return(nil, errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		h.Cancel(w, newCountRequest(http.MethodPatch, "/inventory-count/1/cancel", "1", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/inventory/count"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+// Open abre uma sessão de contagem em nome do usuário autenticado.
+func (h *inventoryCountHandler) Open(w http.ResponseWriter, r *http.Request) {
+	const ref = "[InventoryCountHandler - Open] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var openDTO dto.OpenInventoryCountDTO
+	if err := utils.FromJSON(r.Body, &openDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateInit, map[string]any{"category_id": openDTO.CategoryID})
+
+	created, err := h.service.Open(ctx, dto.ToInventoryCountModel(openDTO, currentUser(ctx)))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, map[string]any{"category_id": openDTO.CategoryID})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{"id": created.ID})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Contagem de inventário aberta com sucesso",
+		Data:    dto.ToInventoryCountDTO(created),
+	})
+}
+
+// Record recebe um lote de leituras da contagem do path. O lote inteiro é
+// recusado se qualquer leitura for inválida.
+func (h *inventoryCountHandler) Record(w http.ResponseWriter, r *http.Request) {
+	const ref = "[InventoryCountHandler - Record] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := h.countID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	var recordDTO dto.RecordCountDTO
+	if err := utils.FromJSON(r.Body, &recordDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateInit, map[string]any{
+		"id":       id,
+		"leituras": len(recordDTO.Items),
+	})
+
+	items, err := h.service.Record(ctx, id, dto.ToCountEntries(recordDTO, currentUser(ctx)))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{
+		"id":       id,
+		"leituras": len(items),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Leituras registradas com sucesso",
+		Data:    dto.ToCountItemDTOs(items),
+	})
+}
+
+func (h *inventoryCountHandler) countID(w http.ResponseWriter, r *http.Request, ref string) (int64, bool) {
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(r.Context(), ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
+// currentUser devolve o usuário autenticado, a quem são atribuídas abertura,
+// leituras e encerramento.
+func currentUser(ctx context.Context) *int64 {
+	uid, err := strconv.ParseInt(contextUtils.GetUserID(ctx), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &uid
+}
+
+func statusFromError(err error) int {
+	switch {
+	case errors.Is(err, errMsg.ErrInvalidData),
+		errors.Is(err, errMsg.ErrInvalidFilter),
+		errors.Is(err, errMsg.ErrZeroID),
+		errors.Is(err, errMsg.ErrInvalidQuantity):
+		return http.StatusBadRequest
+	case errors.Is(err, errMsg.ErrNotFound),
+		errors.Is(err, errMsg.ErrDBInvalidForeignKey):
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
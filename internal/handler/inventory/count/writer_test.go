@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestInventoryCountHandler_Open(t *testing.T) {
+	t.Run("sucesso atribui ao usuário autenticado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Open", mock.Anything, mock.MatchedBy(func(c *models.InventoryCount) bool {
+			return c.CategoryID != nil && *c.CategoryID == 3 && c.OpenedBy != nil && *c.OpenedBy == 5
+		})).Return(&models.InventoryCount{ID: 1, Status: models.StatusOpen}, nil).Once()
+
+		req := newCountRequest(http.MethodPost, "/inventory-count", "", []byte(`{"category_id":3}`))
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "5"))
+		w := httptest.NewRecorder()
+		h.Open(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Open(w, newCountRequest(http.MethodGet, "/inventory-count", "", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("json inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Open(w, newCountRequest(http.MethodPost, "/inventory-count", "", []byte("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("categoria inexistente", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Open", mock.Anything, mock.Anything).Return(nil, errMsg.ErrDBInvalidForeignKey).Once()
+
+		w := httptest.NewRecorder()
+		h.Open(w, newCountRequest(http.MethodPost, "/inventory-count", "", []byte(`{"category_id":99}`)))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestInventoryCountHandler_Record(t *testing.T) {
+	body := []byte(`{"items":[{"barcode":"789"},{"product_id":7,"quantity":0,"recount":true}]}`)
+
+	t.Run("sucesso converte as leituras", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Record", mock.Anything, int64(1), mock.MatchedBy(func(entries []models.CountEntry) bool {
+			return len(entries) == 2 &&
+				entries[0].Barcode == "789" && entries[0].Quantity == 1 &&
+				entries[1].Recount && entries[1].Quantity == 0 &&
+				entries[0].CountedBy != nil && *entries[0].CountedBy == 5
+		})).Return([]*models.CountItem{{ProductID: 8, CountedQuantity: 4}, {ProductID: 7}}, nil).Once()
+
+		req := newCountRequest(http.MethodPost, "/inventory-count/1/items", "1", body)
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "5"))
+		w := httptest.NewRecorder()
+		h.Record(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Record(w, newCountRequest(http.MethodGet, "/inventory-count/1/items", "1", body))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Record(w, newCountRequest(http.MethodPost, "/inventory-count/0/items", "0", body))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"leitura inválida", errMsg.ErrInvalidData, http.StatusBadRequest},
+		{"produto fora do escopo", errMsg.ErrNotFound, http.StatusNotFound},
+		{"erro interno", errors.New("db down"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, h := setupHandler()
+			mockService.On("Record", mock.Anything, int64(1), mock.Anything).Return(nil, tc.err).Once()
+
+			w := httptest.NewRecorder()
+			h.Record(w, newCountRequest(http.MethodPost, "/inventory-count/1/items", "1", body))
+
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/inventory/location"
+)
+
+type locationHandler struct {
+	service service.LocationService
+	logger  *logger.LogAdapter
+}
+
+func NewLocationHandler(service service.LocationService, logger *logger.LogAdapter) *locationHandler {
+	return &locationHandler{
+		service: service,
+		logger:  logger,
+	}
+}
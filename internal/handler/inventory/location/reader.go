@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/inventory/location"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *locationHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	const ref = "[LocationHandler - GetByID] "
+	ctx := r.Context()
+
+	id, ok := h.locationID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"id": id})
+
+	location, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{"id": id})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Local encontrado",
+		Data:    dto.ToLocationDTO(location),
+	})
+}
+
+func (h *locationHandler) List(w http.ResponseWriter, r *http.Request) {
+	const ref = "[LocationHandler - List] "
+	ctx := r.Context()
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, nil)
+
+	locations, err := h.service.List(ctx)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, nil)
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{"total_encontrados": len(locations)})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Locais listados com sucesso",
+		Data:    dto.ToLocationDTOs(locations),
+	})
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockInventory "github.com/WagaoCarvalho/backend_store_go/infra/mock/inventory"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupHandler() (*mockInventory.MockLocationService, *locationHandler) {
+	mockService := new(mockInventory.MockLocationService)
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	loggerAdapter := logger.NewLoggerAdapter(baseLogger)
+	return mockService, NewLocationHandler(mockService, loggerAdapter)
+}
+
+func newLocationRequest(method, target, id string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	return mux.SetURLVars(req, map[string]string{"id": id})
+}
+
+func TestLocationHandler_GetByID(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetByID", mock.Anything, int64(2)).
+			Return(&models.Location{ID: 2, Code: "DEP1", Kind: models.KindWarehouse}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByID(w, newLocationRequest(http.MethodGet, "/location/2", "2", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"kind":"warehouse"`)
+	})
+
+	t.Run("ID inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetByID(w, newLocationRequest(http.MethodGet, "/location/x", "x", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("não encontrado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetByID", mock.Anything, int64(9)).Return(nil, errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByID(w, newLocationRequest(http.MethodGet, "/location/9", "9", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestLocationHandler_List(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("List", mock.Anything).Return([]*models.Location{
+			{ID: 1, Code: "PRINCIPAL", IsDefault: true},
+			{ID: 2, Code: "DEP1"},
+		}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.List(w, newLocationRequest(http.MethodGet, "/locations", "", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"is_default":true`)
+	})
+
+	t.Run("erro no serviço", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("List", mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		h.List(w, newLocationRequest(http.MethodGet, "/locations", "", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
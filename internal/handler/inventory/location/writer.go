@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/inventory/location"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *locationHandler) Create(w http.ResponseWriter, r *http.Request) {
+	const ref = "[LocationHandler - Create] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var locationDTO dto.LocationDTO
+	if err := utils.FromJSON(r.Body, &locationDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateInit, map[string]any{"code": locationDTO.Code})
+
+	created, err := h.service.Create(ctx, dto.ToLocationModel(locationDTO))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, map[string]any{"code": locationDTO.Code})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{"id": created.ID})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Local criado com sucesso",
+		Data:    dto.ToLocationDTO(created),
+	})
+}
+
+// Update altera código, nome e tipo do local do path. O corpo precisa trazer
+// a versão lida.
+func (h *locationHandler) Update(w http.ResponseWriter, r *http.Request) {
+	const ref = "[LocationHandler - Update] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPut {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := h.locationID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	var locationDTO dto.LocationDTO
+	if err := utils.FromJSON(r.Body, &locationDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	location := dto.ToLocationModel(locationDTO)
+	location.ID = id
+
+	h.logger.Info(ctx, ref+logger.LogUpdateInit, map[string]any{"id": id})
+
+	updated, err := h.service.Update(ctx, location)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogUpdateError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogUpdateSuccess, map[string]any{"id": id, "version": updated.Version})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Local atualizado com sucesso",
+		Data:    dto.ToLocationDTO(updated),
+	})
+}
+
+func (h *locationHandler) locationID(w http.ResponseWriter, r *http.Request, ref string) (int64, bool) {
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(r.Context(), ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
+func statusFromError(err error) int {
+	switch {
+	case errors.Is(err, errMsg.ErrInvalidData),
+		errors.Is(err, errMsg.ErrZeroID):
+		return http.StatusBadRequest
+	case errors.Is(err, errMsg.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, errMsg.ErrDuplicate),
+		errors.Is(err, errMsg.ErrVersionConflict):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
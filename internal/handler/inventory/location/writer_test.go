@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLocationHandler_Create(t *testing.T) {
+	t.Run("sucesso normaliza o código e ignora is_default", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Create", mock.Anything, mock.MatchedBy(func(l *models.Location) bool {
+			return l.Code == "DEP1" && l.Kind == models.KindWarehouse && !l.IsDefault
+		})).Return(&models.Location{ID: 3, Code: "DEP1", Kind: models.KindWarehouse}, nil).Once()
+
+		body := []byte(`{"code":" dep1 ","name":"Depósito","kind":"warehouse","is_default":true}`)
+		w := httptest.NewRecorder()
+		h.Create(w, newLocationRequest(http.MethodPost, "/location", "", body))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Create(w, newLocationRequest(http.MethodGet, "/location", "", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("json inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Create(w, newLocationRequest(http.MethodPost, "/location", "", []byte("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("código repetido", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Create", mock.Anything, mock.Anything).Return(nil, errMsg.ErrDuplicate).Once()
+
+		w := httptest.NewRecorder()
+		h.Create(w, newLocationRequest(http.MethodPost, "/location", "", []byte(`{"code":"DEP1","name":"Depósito"}`)))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestLocationHandler_Update(t *testing.T) {
+	body := []byte(`{"code":"DEP1","name":"Depósito","kind":"warehouse","version":1}`)
+
+	t.Run("sucesso usa o ID do path", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Update", mock.Anything, mock.MatchedBy(func(l *models.Location) bool {
+			return l.ID == 3 && l.Version == 1
+		})).Return(&models.Location{ID: 3, Code: "DEP1", Version: 2}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.Update(w, newLocationRequest(http.MethodPut, "/location/3", "3", body))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"version":2`)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Update(w, newLocationRequest(http.MethodPost, "/location/3", "3", body))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("ID inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Update(w, newLocationRequest(http.MethodPut, "/location/0", "0", body))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("conflito de versão", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Update", mock.Anything, mock.Anything).Return(nil, errMsg.ErrVersionConflict).Once()
+
+		w := httptest.NewRecorder()
+		h.Update(w, newLocationRequest(http.MethodPut, "/location/3", "3", body))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/inventory/transfer"
+)
+
+type transferHandler struct {
+	service service.TransferService
+	logger  *logger.LogAdapter
+}
+
+func NewTransferHandler(service service.TransferService, logger *logger.LogAdapter) *transferHandler {
+	return &transferHandler{
+		service: service,
+		logger:  logger,
+	}
+}
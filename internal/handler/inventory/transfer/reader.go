@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dtoPage "github.com/WagaoCarvalho/backend_store_go/internal/dto/common/page"
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/inventory/transfer"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+var validListParams = map[string]bool{
+	"status":      true,
+	"location_id": true,
+	"limit":       true,
+	"offset":      true,
+	"cursor":      true,
+	"sort_by":     true,
+	"sort_order":  true,
+}
+
+func (h *transferHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	const ref = "[TransferHandler - GetByID] "
+	ctx := r.Context()
+
+	id, ok := h.transferID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"id": id})
+
+	transfer, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{"id": id})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Transferência encontrada",
+		Data:    dto.ToTransferDTO(transfer),
+	})
+}
+
+// List aceita location_id para listar o que entra ou sai de um local.
+func (h *transferHandler) List(w http.ResponseWriter, r *http.Request) {
+	const ref = "[TransferHandler - List] "
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	for param := range query {
+		if !validListParams[param] {
+			h.logger.Warn(ctx, ref+"parâmetro desconhecido", map[string]any{"parametro": param})
+			utils.ErrorResponse(w, fmt.Errorf("parâmetro de consulta inválido: %s", param), http.StatusBadRequest)
+			return
+		}
+	}
+
+	limit, offset := utils.GetPaginationParams(r)
+	cursor, cursorMode := utils.GetCursorParam(r)
+
+	f := &models.TransferFilter{
+		BaseFilter: commonFilter.BaseFilter{
+			Limit:      limit,
+			Offset:     offset,
+			SortBy:     query.Get("sort_by"),
+			SortOrder:  query.Get("sort_order"),
+			Cursor:     cursor,
+			CursorMode: cursorMode,
+		},
+		Status: query.Get("status"),
+	}
+
+	if v := query.Get("location_id"); v != "" {
+		location, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			h.logger.Warn(ctx, ref+"location_id inválido", map[string]any{"valor": v})
+			utils.ErrorResponse(w, fmt.Errorf("location_id deve ser um número inteiro"), http.StatusBadRequest)
+			return
+		}
+		f.LocationID = &location
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"status": f.Status})
+
+	page, err := h.service.List(ctx, f)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, nil)
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	transferDTOs := dto.ToTransferDTOs(page.Items)
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{"total_encontrados": len(transferDTOs)})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Transferências listadas com sucesso",
+		Data:    dtoPage.ToPageDTO(page, transferDTOs),
+	})
+}
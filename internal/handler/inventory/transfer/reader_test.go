@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockInventory "github.com/WagaoCarvalho/backend_store_go/infra/mock/inventory"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupHandler() (*mockInventory.MockTransferService, *transferHandler) {
+	mockService := new(mockInventory.MockTransferService)
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	loggerAdapter := logger.NewLoggerAdapter(baseLogger)
+	return mockService, NewTransferHandler(mockService, loggerAdapter)
+}
+
+func newTransferRequest(method, target, id string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	return mux.SetURLVars(req, map[string]string{"id": id})
+}
+
+func TestTransferHandler_GetByID(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetByID", mock.Anything, int64(1)).Return(&models.Transfer{
+			ID:     1,
+			Status: models.StatusInTransit,
+			Items:  []models.TransferItem{{ProductID: 7, Quantity: 4}},
+		}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByID(w, newTransferRequest(http.MethodGet, "/stock-transfer/1", "1", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"status":"in_transit"`)
+	})
+
+	t.Run("ID inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetByID(w, newTransferRequest(http.MethodGet, "/stock-transfer/0", "0", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("não encontrada", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetByID", mock.Anything, int64(9)).Return(nil, errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByID(w, newTransferRequest(http.MethodGet, "/stock-transfer/9", "9", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestTransferHandler_List(t *testing.T) {
+	t.Run("filtra por local", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("List", mock.Anything, mock.MatchedBy(func(f *models.TransferFilter) bool {
+			return f.LocationID != nil && *f.LocationID == 2 && f.Status == models.StatusInTransit
+		})).Return(&commonFilter.Page[*models.Transfer]{Items: []*models.Transfer{{ID: 1}}, Total: 1}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.List(w, newTransferRequest(http.MethodGet, "/stock-transfers?status=in_transit&location_id=2", "", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("parâmetro desconhecido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.List(w, newTransferRequest(http.MethodGet, "/stock-transfers?product_id=7", "", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("location_id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.List(w, newTransferRequest(http.MethodGet, "/stock-transfers?location_id=x", "", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("filtro inválido", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("List", mock.Anything, mock.Anything).Return(nil, errMsg.ErrInvalidFilter).Once()
+
+		w := httptest.NewRecorder()
+		h.List(w, newTransferRequest(http.MethodGet, "/stock-transfers?status=draft", "", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
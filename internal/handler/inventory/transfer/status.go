@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/inventory/transfer"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+// Receive confirma a chegada da mercadoria no destino.
+func (h *transferHandler) Receive(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, "[TransferHandler - Receive] ", "Transferência recebida com sucesso", h.service.Receive)
+}
+
+func (h *transferHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, "[TransferHandler - Cancel] ", "Transferência cancelada com sucesso", h.service.Cancel)
+}
+
+func (h *transferHandler) changeStatus(
+	w http.ResponseWriter,
+	r *http.Request,
+	ref string,
+	message string,
+	change func(ctx context.Context, id int64, userID *int64) (*models.Transfer, error),
+) {
+	ctx := r.Context()
+
+	if r.Method != http.MethodPatch {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := h.transferID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	transfer, err := change(ctx, id, currentUser(ctx))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogUpdateError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogUpdateSuccess, map[string]any{
+		"id":     id,
+		"status": transfer.Status,
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: message,
+		Data:    dto.ToTransferDTO(transfer),
+	})
+}
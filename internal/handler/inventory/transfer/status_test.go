@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTransferHandler_Receive(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		user := int64(6)
+		mockService.On("Receive", mock.Anything, int64(1), &user).
+			Return(&models.Transfer{ID: 1, Status: models.StatusReceived, ReceivedBy: &user}, nil).Once()
+
+		req := newTransferRequest(http.MethodPatch, "/stock-transfer/1/receive", "1", nil)
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "6"))
+		w := httptest.NewRecorder()
+		h.Receive(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"received_by":6`)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Receive(w, newTransferRequest(http.MethodPost, "/stock-transfer/1/receive", "1", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("transferência fora de trânsito", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Receive", mock.Anything, int64(1), mock.Anything).Return(nil, errMsg.ErrInvalidData).Once()
+
+		w := httptest.NewRecorder()
+		h.Receive(w, newTransferRequest(http.MethodPatch, "/stock-transfer/1/receive", "1", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTransferHandler_Cancel(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Cancel", mock.Anything, int64(1), mock.Anything).
+			Return(&models.Transfer{ID: 1, Status: models.StatusCanceled}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.Cancel(w, newTransferRequest(http.MethodPatch, "/stock-transfer/1/cancel", "1", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("ID inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Cancel(w, newTransferRequest(http.MethodPatch, "/stock-transfer/x/cancel", "x", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/inventory/transfer"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+// Create envia mercadoria entre locais em nome do usuário autenticado.
+func (h *transferHandler) Create(w http.ResponseWriter, r *http.Request) {
+	const ref = "[TransferHandler - Create] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var createDTO dto.CreateTransferDTO
+	if err := utils.FromJSON(r.Body, &createDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateInit, map[string]any{
+		"from_location_id": createDTO.FromLocationID,
+		"to_location_id":   createDTO.ToLocationID,
+		"itens":            len(createDTO.Items),
+	})
+
+	created, err := h.service.Create(ctx, dto.ToTransferModel(createDTO, currentUser(ctx)))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, map[string]any{"from_location_id": createDTO.FromLocationID})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{"id": created.ID})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Transferência enviada com sucesso",
+		Data:    dto.ToTransferDTO(created),
+	})
+}
+
+func (h *transferHandler) transferID(w http.ResponseWriter, r *http.Request, ref string) (int64, bool) {
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(r.Context(), ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
+// currentUser devolve o usuário autenticado, a quem são atribuídos envio e
+// recebimento.
+func currentUser(ctx context.Context) *int64 {
+	uid, err := strconv.ParseInt(contextUtils.GetUserID(ctx), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &uid
+}
+
+func statusFromError(err error) int {
+	switch {
+	case errors.Is(err, errMsg.ErrInvalidData),
+		errors.Is(err, errMsg.ErrInvalidFilter),
+		errors.Is(err, errMsg.ErrZeroID),
+		errors.Is(err, errMsg.ErrInvalidQuantity):
+		return http.StatusBadRequest
+	case errors.Is(err, errMsg.ErrNotFound),
+		errors.Is(err, errMsg.ErrDBInvalidForeignKey):
+		return http.StatusNotFound
+	case errors.Is(err, errMsg.ErrInsufficientStock):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTransferHandler_Create(t *testing.T) {
+	body := []byte(`{"from_location_id":1,"to_location_id":2,"items":[{"product_id":7,"quantity":4}]}`)
+
+	t.Run("sucesso atribui ao usuário autenticado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Create", mock.Anything, mock.MatchedBy(func(tr *models.Transfer) bool {
+			return tr.FromLocationID == 1 && tr.ToLocationID == 2 && len(tr.Items) == 1 &&
+				tr.CreatedBy != nil && *tr.CreatedBy == 5
+		})).Return(&models.Transfer{ID: 1, Status: models.StatusInTransit}, nil).Once()
+
+		req := newTransferRequest(http.MethodPost, "/stock-transfer", "", body)
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "5"))
+		w := httptest.NewRecorder()
+		h.Create(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Create(w, newTransferRequest(http.MethodGet, "/stock-transfer", "", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("json inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Create(w, newTransferRequest(http.MethodPost, "/stock-transfer", "", []byte("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("saldo insuficiente na origem", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Create", mock.Anything, mock.Anything).Return(nil, errMsg.ErrInsufficientStock).Once()
+
+		w := httptest.NewRecorder()
+		h.Create(w, newTransferRequest(http.MethodPost, "/stock-transfer", "", body))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
@@ -1,10 +1,18 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/login"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/login"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 	service "github.com/WagaoCarvalho/backend_store_go/internal/service/login"
 )
@@ -46,12 +54,38 @@ func (h *loginHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	authRespDTO, err := h.service.Login(r.Context(), loginBody.Email, loginBody.Password)
+	client := clientInfo(r)
+
+	authRespDTO, err := h.service.Login(r.Context(), loginBody.Email, loginBody.Password, client)
 	if err != nil {
-		h.logger.Warn(r.Context(), ref+logger.LogValidateError, map[string]any{
-			"erro": err.Error(),
-		})
-		utils.ErrorResponse(w, err, http.StatusUnauthorized)
+		var locked *service.LockedError
+		switch {
+		case errors.As(err, &locked):
+			if locked.Triggered {
+				h.logger.Warn(r.Context(), ref+logger.LogLoginLockout, map[string]any{
+					"audit":       true,
+					"event":       "login.lockout",
+					"scope":       locked.Scope,
+					"email":       loginBody.Email,
+					"ip":          client.IP,
+					"retry_after": retryAfterSeconds(locked.RetryAfter),
+				})
+			} else {
+				h.logger.Warn(r.Context(), ref+logger.LogLoginThrottled, map[string]any{
+					"ip": client.IP,
+				})
+			}
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds(locked.RetryAfter)))
+			utils.ErrorResponse(w, err, http.StatusTooManyRequests)
+		case errors.Is(err, errMsg.ErrLoginAttempts):
+			h.logger.Error(r.Context(), err, ref+logger.LogLoginGuardError, nil)
+			utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		default:
+			h.logger.Warn(r.Context(), ref+logger.LogValidateError, map[string]any{
+				"erro": err.Error(),
+			})
+			utils.ErrorResponse(w, err, http.StatusUnauthorized)
+		}
 		return
 	}
 
@@ -61,10 +95,116 @@ func (h *loginHandler) Login(w http.ResponseWriter, r *http.Request) {
 		Status:  http.StatusOK,
 		Message: "Login realizado com sucesso",
 		Data: map[string]interface{}{
-			"access_token": authRespDTO.AccessToken,
-			"token_type":   authRespDTO.TokenType,
-			"expires_in":   authRespDTO.ExpiresIn,
+			"access_token":  authRespDTO.AccessToken,
+			"refresh_token": authRespDTO.RefreshToken,
+			"token_type":    authRespDTO.TokenType,
+			"expires_in":    authRespDTO.ExpiresIn,
 		},
 	})
 
 }
+
+func (h *loginHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	const ref = "[LoginHandler - Refresh] "
+	ctx := r.Context()
+
+	h.logger.Info(ctx, ref+logger.LogRefreshInit, nil)
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{
+			"method": r.Method,
+		})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var body dto.RefreshTokenDTO
+	if err := utils.FromJSON(r.Body, &body); err != nil || body.RefreshToken == "" {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, nil)
+		utils.ErrorResponse(w, fmt.Errorf("dados inválidos"), http.StatusBadRequest)
+		return
+	}
+
+	authResp, err := h.service.Refresh(ctx, body.RefreshToken, clientInfo(r))
+	if err != nil {
+		switch {
+		case errors.Is(err, errMsg.ErrRefreshTokenReused):
+			// Sinal de token vazado: a sessão inteira já foi revogada
+			h.logger.Warn(ctx, ref+logger.LogRefreshReused, map[string]any{
+				"ip": utils.ClientIP(r),
+			})
+			utils.ErrorResponse(w, err, http.StatusUnauthorized)
+		case errors.Is(err, errMsg.ErrSessionStore), errors.Is(err, errMsg.ErrTokenGeneration):
+			h.logger.Error(ctx, err, ref+logger.LogRefreshError, nil)
+			utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		default:
+			h.logger.Warn(ctx, ref+logger.LogRefreshError, map[string]any{
+				"erro": err.Error(),
+			})
+			utils.ErrorResponse(w, err, http.StatusUnauthorized)
+		}
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogRefreshSuccess, nil)
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Token renovado com sucesso",
+		Data:    dto.ToAuthResponseDTO(authResp),
+	})
+}
+
+// Unlock libera, por decisão de um administrador, a conta e/ou o IP
+// bloqueados por falhas de login.
+func (h *loginHandler) Unlock(w http.ResponseWriter, r *http.Request) {
+	const ref = "[LoginHandler - Unlock] "
+	ctx := r.Context()
+
+	h.logger.Info(ctx, ref+logger.LogLoginUnlockInit, nil)
+
+	var body dto.UnlockDTO
+	if err := utils.FromJSON(r.Body, &body); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, nil)
+		utils.ErrorResponse(w, fmt.Errorf("dados inválidos"), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.Unlock(ctx, body.Email, body.IP); err != nil {
+		if errors.Is(err, errMsg.ErrInvalidData) {
+			h.logger.Warn(ctx, ref+logger.LogLoginUnlockError, map[string]any{
+				"erro": err.Error(),
+			})
+			utils.ErrorResponse(w, fmt.Errorf("informe email ou ip"), http.StatusBadRequest)
+			return
+		}
+		h.logger.Error(ctx, err, ref+logger.LogLoginUnlockError, nil)
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Warn(ctx, ref+logger.LogLoginUnlocked, map[string]any{
+		"audit":    true,
+		"event":    "login.unlock",
+		"email":    body.Email,
+		"ip":       body.IP,
+		"admin_id": contextUtils.GetUserID(ctx),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Login desbloqueado com sucesso",
+	})
+}
+
+// retryAfterSeconds arredonda para cima, para o cliente não tentar cedo demais.
+func retryAfterSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+func clientInfo(r *http.Request) models.ClientInfo {
+	return models.ClientInfo{
+		Device: r.UserAgent(),
+		IP:     utils.ClientIP(r),
+	}
+}
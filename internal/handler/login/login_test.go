@@ -7,10 +7,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	mockLogin "github.com/WagaoCarvalho/backend_store_go/infra/mock/login"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/login"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/login"
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -36,10 +39,11 @@ func TestLoginHandler_Login(t *testing.T) {
 		email := "user@example.com"
 		password := "password123"
 
-		mockService.On("Login", mock.Anything, email, password).Return(&models.AuthResponse{
-			AccessToken: "valid_token",
-			TokenType:   "Bearer",
-			ExpiresIn:   3600,
+		mockService.On("Login", mock.Anything, email, password, mock.Anything).Return(&models.AuthResponse{
+			AccessToken:  "valid_token",
+			RefreshToken: "sess.segredo",
+			TokenType:    "Bearer",
+			ExpiresIn:    3600,
 		}, nil)
 
 		body, _ := json.Marshal(map[string]string{"email": email, "password": password})
@@ -61,6 +65,7 @@ func TestLoginHandler_Login(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "Login realizado com sucesso", response.Message)
 		assert.Equal(t, "valid_token", response.Data["access_token"])
+		assert.Equal(t, "sess.segredo", response.Data["refresh_token"])
 		assert.Equal(t, "Bearer", response.Data["token_type"])
 		assert.Equal(t, float64(3600), response.Data["expires_in"])
 
@@ -113,7 +118,7 @@ func TestLoginHandler_Login(t *testing.T) {
 		email := "user@example.com"
 		password := "wrongpassword"
 
-		mockService.On("Login", mock.Anything, email, password).Return(nil, errors.New("credenciais inválidas"))
+		mockService.On("Login", mock.Anything, email, password, mock.Anything).Return(nil, errors.New("credenciais inválidas"))
 
 		body, _ := json.Marshal(map[string]string{"email": email, "password": password})
 		req := newLoginRequest(http.MethodPost, "/login", body)
@@ -140,7 +145,7 @@ func TestLoginHandler_Login(t *testing.T) {
 		email := "user@example.com"
 		password := "password123"
 
-		mockService.On("Login", mock.Anything, email, password).Return(nil, errors.New("erro inesperado"))
+		mockService.On("Login", mock.Anything, email, password, mock.Anything).Return(nil, errors.New("erro inesperado"))
 
 		body, _ := json.Marshal(map[string]string{"email": email, "password": password})
 		req := newLoginRequest(http.MethodPost, "/login", body)
@@ -159,4 +164,164 @@ func TestLoginHandler_Login(t *testing.T) {
 
 		mockService.AssertExpectations(t)
 	})
+
+	t.Run("TooManyAttempts", func(t *testing.T) {
+		mockService := new(mockLogin.MockLoginService)
+		handler := NewLoginHandler(mockService, logAdapter)
+
+		locked := &service.LockedError{Err: errMsg.ErrTooManyAttempts, RetryAfter: 1500 * time.Millisecond}
+		mockService.On("Login", mock.Anything, "user@example.com", "x", mock.Anything).Return(nil, locked)
+
+		body, _ := json.Marshal(map[string]string{"email": "user@example.com", "password": "x"})
+		w := httptest.NewRecorder()
+
+		handler.Login(w, newLoginRequest(http.MethodPost, "/login", body))
+
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	})
+
+	t.Run("LockoutIsAudited", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		auditLogger := logrus.New()
+		auditLogger.Out = buf
+		auditLogger.SetFormatter(&logrus.JSONFormatter{})
+
+		mockService := new(mockLogin.MockLoginService)
+		handler := NewLoginHandler(mockService, logger.NewLoggerAdapter(auditLogger))
+
+		locked := &service.LockedError{Err: errMsg.ErrTooManyAttempts, RetryAfter: 15 * time.Minute, Scope: "account", Triggered: true}
+		mockService.On("Login", mock.Anything, "user@example.com", "x", mock.Anything).Return(nil, locked)
+
+		body, _ := json.Marshal(map[string]string{"email": "user@example.com", "password": "x"})
+		req := newLoginRequest(http.MethodPost, "/login", body)
+		req.RemoteAddr = "10.0.0.1:5000"
+		w := httptest.NewRecorder()
+
+		handler.Login(w, req)
+
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "900", w.Header().Get("Retry-After"))
+		assert.Contains(t, buf.String(), `"event":"login.lockout"`)
+		assert.Contains(t, buf.String(), `"email":"user@example.com"`)
+	})
+
+	t.Run("AttemptStoreError", func(t *testing.T) {
+		mockService := new(mockLogin.MockLoginService)
+		handler := NewLoginHandler(mockService, logAdapter)
+
+		mockService.On("Login", mock.Anything, "user@example.com", "x", mock.Anything).Return(nil, errMsg.ErrLoginAttempts)
+
+		body, _ := json.Marshal(map[string]string{"email": "user@example.com", "password": "x"})
+		w := httptest.NewRecorder()
+
+		handler.Login(w, newLoginRequest(http.MethodPost, "/login", body))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestLoginHandler_Unlock(t *testing.T) {
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	logAdapter := logger.NewLoggerAdapter(baseLogger)
+
+	tests := []struct {
+		name       string
+		body       string
+		serviceErr error
+		callsSvc   bool
+		wantStatus int
+	}{
+		{"Success", `{"email":"user@example.com","ip":"10.0.0.1"}`, nil, true, http.StatusOK},
+		{"InvalidJSON", `{`, nil, false, http.StatusBadRequest},
+		{"MissingTarget", `{}`, errMsg.ErrInvalidData, true, http.StatusBadRequest},
+		{"StoreError", `{"ip":"10.0.0.1"}`, errMsg.ErrLoginAttempts, true, http.StatusInternalServerError},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := new(mockLogin.MockLoginService)
+			handler := NewLoginHandler(mockService, logAdapter)
+			if tc.callsSvc {
+				mockService.On("Unlock", mock.Anything, mock.Anything, mock.Anything).Return(tc.serviceErr).Once()
+			}
+
+			w := httptest.NewRecorder()
+			handler.Unlock(w, newLoginRequest(http.MethodPost, "/login/unlock", []byte(tc.body)))
+
+			assert.Equal(t, tc.wantStatus, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLoginHandler_Refresh(t *testing.T) {
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	logAdapter := logger.NewLoggerAdapter(baseLogger)
+
+	refreshBody, _ := json.Marshal(map[string]string{"refresh_token": "sess.segredo"})
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := new(mockLogin.MockLoginService)
+		handler := NewLoginHandler(mockService, logAdapter)
+
+		mockService.On("Refresh", mock.Anything, "sess.segredo", models.ClientInfo{Device: "android", IP: "10.0.0.9"}).
+			Return(&models.AuthResponse{AccessToken: "novo", RefreshToken: "sess.novo", TokenType: "Bearer", ExpiresIn: 900}, nil)
+
+		req := newLoginRequest(http.MethodPost, "/refresh", refreshBody)
+		req.Header.Set("User-Agent", "android")
+		req.RemoteAddr = "10.0.0.9:40000"
+		w := httptest.NewRecorder()
+
+		handler.Refresh(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"refresh_token":"sess.novo"`)
+		assert.Contains(t, w.Body.String(), `"expires_in":900`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("InvalidMethod", func(t *testing.T) {
+		handler := NewLoginHandler(new(mockLogin.MockLoginService), logAdapter)
+
+		w := httptest.NewRecorder()
+		handler.Refresh(w, newLoginRequest(http.MethodGet, "/refresh", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("MissingToken", func(t *testing.T) {
+		handler := NewLoginHandler(new(mockLogin.MockLoginService), logAdapter)
+
+		w := httptest.NewRecorder()
+		handler.Refresh(w, newLoginRequest(http.MethodPost, "/refresh", []byte(`{}`)))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	cases := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"Reused", errMsg.ErrRefreshTokenReused, http.StatusUnauthorized},
+		{"Invalid", errMsg.ErrRefreshTokenInvalid, http.StatusUnauthorized},
+		{"Disabled", errMsg.ErrAccountDisabled, http.StatusUnauthorized},
+		{"StoreError", errMsg.ErrSessionStore, http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := new(mockLogin.MockLoginService)
+			handler := NewLoginHandler(mockService, logAdapter)
+
+			mockService.On("Refresh", mock.Anything, "sess.segredo", mock.Anything).Return(nil, tc.err)
+
+			w := httptest.NewRecorder()
+			handler.Refresh(w, newLoginRequest(http.MethodPost, "/refresh", refreshBody))
+
+			assert.Equal(t, tc.expected, w.Code)
+		})
+	}
 }
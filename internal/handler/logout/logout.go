@@ -1,9 +1,12 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/login"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 	service "github.com/WagaoCarvalho/backend_store_go/internal/service/logout"
@@ -60,6 +63,21 @@ func (h *logoutHandler) Logout(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Com o refresh token no corpo, a sessão inteira é encerrada
+	var body dto.RefreshTokenDTO
+	if err := utils.FromJSON(r.Body, &body); err == nil && body.RefreshToken != "" {
+		if err := h.service.EndSession(ctx, body.RefreshToken); err != nil {
+			if errors.Is(err, errMsg.ErrSessionStore) {
+				h.logger.Error(ctx, err, ref+logger.LogSessionEndError, nil)
+				utils.ErrorResponse(w, err, http.StatusInternalServerError)
+				return
+			}
+			h.logger.Warn(ctx, ref+logger.LogSessionEndError, map[string]any{
+				"erro": err.Error(),
+			})
+		}
+	}
+
 	h.logger.Info(ctx, ref+"Logout realizado com sucesso", nil)
 
 	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
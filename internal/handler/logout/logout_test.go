@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	mockLogout "github.com/WagaoCarvalho/backend_store_go/infra/mock/logout"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -99,3 +100,55 @@ func TestLogoutHandler_Logout(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 }
+
+func TestLogoutHandler_LogoutWithRefreshToken(t *testing.T) {
+	baseLogger := logrus.New()
+	baseLogger.Out = &strings.Builder{}
+	logAdapter := logger.NewLoggerAdapter(baseLogger)
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/logout", strings.NewReader(`{"refresh_token":"sess.segredo"}`))
+		req.Header.Set("Authorization", "Bearer valid_token")
+		return req
+	}
+
+	t.Run("EndsSession", func(t *testing.T) {
+		mockService := new(mockLogout.MockLogout)
+		handler := NewLogoutHandler(mockService, logAdapter)
+
+		mockService.On("Logout", mock.Anything, "valid_token").Return(nil)
+		mockService.On("EndSession", mock.Anything, "sess.segredo").Return(nil)
+
+		w := httptest.NewRecorder()
+		handler.Logout(w, newRequest())
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("InvalidRefreshTokenStillLogsOut", func(t *testing.T) {
+		mockService := new(mockLogout.MockLogout)
+		handler := NewLogoutHandler(mockService, logAdapter)
+
+		mockService.On("Logout", mock.Anything, "valid_token").Return(nil)
+		mockService.On("EndSession", mock.Anything, "sess.segredo").Return(errMsg.ErrRefreshTokenInvalid)
+
+		w := httptest.NewRecorder()
+		handler.Logout(w, newRequest())
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("SessionStoreError", func(t *testing.T) {
+		mockService := new(mockLogout.MockLogout)
+		handler := NewLogoutHandler(mockService, logAdapter)
+
+		mockService.On("Logout", mock.Anything, "valid_token").Return(nil)
+		mockService.On("EndSession", mock.Anything, "sess.segredo").Return(errMsg.ErrSessionStore)
+
+		w := httptest.NewRecorder()
+		handler.Logout(w, newRequest())
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strconv"
 
+	dtoPage "github.com/WagaoCarvalho/backend_store_go/internal/dto/common/page"
 	dtoFilter "github.com/WagaoCarvalho/backend_store_go/internal/dto/product/filter"
 	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/product/product"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
@@ -22,6 +23,8 @@ var validProductFilterParams = map[string]bool{
 	"supplier_id":    true,
 	"allow_discount": true,
 	"limit":          true,
+	"cursor":         true,
+	"search":         true,
 	"offset":         true,
 }
 
@@ -71,6 +74,8 @@ func (h *productFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
 	dtoFilter.Barcode = query.Get("barcode")
 	dtoFilter.Limit = limit
 	dtoFilter.Offset = offset
+	dtoFilter.Cursor, dtoFilter.CursorMode = utils.GetCursorParam(r)
+	dtoFilter.SearchTerm = r.URL.Query().Get("search")
 
 	// VALIDAÇÃO 2: Status com valor inválido deve retornar erro
 	if v := query.Get("status"); v != "" {
@@ -152,7 +157,7 @@ func (h *productFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	productDTOs := dto.ToProductDTOs(products)
+	productDTOs := dto.ToProductDTOs(products.Items)
 
 	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
 		"total_encontrados": len(productDTOs),
@@ -161,9 +166,6 @@ func (h *productFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
 	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
 		Status:  http.StatusOK,
 		Message: "Produtos listados com sucesso",
-		Data: map[string]any{
-			"total": len(productDTOs),
-			"items": productDTOs,
-		},
+		Data:    dtoPage.ToPageDTO(products, productDTOs),
 	})
 }
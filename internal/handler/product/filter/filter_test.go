@@ -13,6 +13,7 @@ import (
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -154,8 +155,8 @@ func TestProductFilterHandler_Filter(t *testing.T) {
 	t.Run("sucesso - retorna lista de produtos", func(t *testing.T) {
 		mockService, handler := setup()
 		modelProducts := []*model.Product{
-			{ID: 1, ProductName: "Produto A", Manufacturer: "Marca A", SalePrice: 100, Status: true, Version: 1},
-			{ID: 2, ProductName: "Produto B", Manufacturer: "Marca B", SalePrice: 200, Status: false, Version: 1},
+			{ID: 1, ProductName: "Produto A", Manufacturer: "Marca A", SalePrice: money.New(100), Status: true, Version: 1},
+			{ID: 2, ProductName: "Produto B", Manufacturer: "Marca B", SalePrice: money.New(200), Status: false, Version: 1},
 		}
 		mockService.On("Filter", mock.Anything, mock.Anything).Return(modelProducts, nil).Once()
 		req := httptest.NewRequest(http.MethodGet, "/products/filter?limit=2&offset=0", nil)
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/product/price"
+)
+
+type priceHandler struct {
+	service service.PriceService
+	logger  *logger.LogAdapter
+}
+
+func NewPriceHandler(service service.PriceService, logger *logger.LogAdapter) *priceHandler {
+	return &priceHandler{
+		service: service,
+		logger:  logger,
+	}
+}
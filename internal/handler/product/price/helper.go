@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+const dateLayout = "2006-01-02"
+
+// pathID lê o {id} da rota: o produto nas rotas sob /product e a mudança
+// agendada em /scheduled-price.
+func (h *priceHandler) pathID(w http.ResponseWriter, r *http.Request, ref string) (int64, bool) {
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(r.Context(), ref+logger.LogInvalidID, map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
+func (h *priceHandler) checkParams(w http.ResponseWriter, r *http.Request, ref string, valid map[string]bool) bool {
+	query := r.URL.Query()
+	for param := range query {
+		if !valid[param] {
+			h.logger.Warn(r.Context(), ref+"parâmetro desconhecido", map[string]any{
+				"parametro": param,
+				"valor":     query.Get(param),
+			})
+			utils.ErrorResponse(w, fmt.Errorf("parâmetro de consulta inválido: %s", param), http.StatusBadRequest)
+			return false
+		}
+	}
+	return true
+}
+
+func (h *priceHandler) badParam(w http.ResponseWriter, r *http.Request, ref string, err error) {
+	h.logger.Warn(r.Context(), ref+logger.LogInvalidParam, map[string]any{
+		"erro": err.Error(),
+	})
+	utils.ErrorResponse(w, err, http.StatusBadRequest)
+}
+
+// currentUser devolve o usuário autenticado, autor da mudança agendada.
+func currentUser(ctx context.Context) *int64 {
+	uid, err := strconv.ParseInt(contextUtils.GetUserID(ctx), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &uid
+}
+
+func statusFromError(err error) int {
+	switch {
+	case errors.Is(err, errMsg.ErrInvalidData),
+		errors.Is(err, errMsg.ErrInvalidFilter),
+		errors.Is(err, errMsg.ErrZeroID):
+		return http.StatusBadRequest
+	case errors.Is(err, errMsg.ErrNotFound),
+		errors.Is(err, errMsg.ErrDBInvalidForeignKey):
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+// parseDate aceita "2006-01-02" ou RFC3339. Com endOfDay, uma data sem
+// horário vale até o último instante do dia. Valor vazio devolve nil.
+func parseDate(value string, endOfDay bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(dateLayout, value); err == nil {
+		if endOfDay {
+			t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		}
+		return &t, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.New("data inválida, use AAAA-MM-DD ou RFC3339")
+	}
+	return &t, nil
+}
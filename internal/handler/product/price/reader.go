@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	dtoPage "github.com/WagaoCarvalho/backend_store_go/internal/dto/common/page"
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/product/price"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+var (
+	validPriceParams   = map[string]bool{"at": true}
+	validHistoryParams = map[string]bool{
+		"source":     true,
+		"from":       true,
+		"to":         true,
+		"limit":      true,
+		"offset":     true,
+		"cursor":     true,
+		"sort_by":    true,
+		"sort_order": true,
+	}
+)
+
+// EffectivePrice atende GET /product/{id}/price?at=. Sem at vale o instante
+// atual; uma data sem horário considera o fim do dia.
+func (h *priceHandler) EffectivePrice(w http.ResponseWriter, r *http.Request) {
+	const ref = "[PriceHandler - EffectivePrice] "
+	ctx := r.Context()
+
+	id, ok := h.pathID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	if !h.checkParams(w, r, ref, validPriceParams) {
+		return
+	}
+
+	at, err := parseDate(query.Get("at"), true)
+	if err != nil {
+		h.badParam(w, r, ref, fmt.Errorf("at: %w", err))
+		return
+	}
+	if at == nil {
+		now := time.Now()
+		at = &now
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{
+		"product_id": id,
+		"at":         at,
+	})
+
+	price, err := h.service.EffectiveAt(ctx, id, *at)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"product_id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"product_id": id,
+		"sale_price": price.SalePrice,
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Preço vigente consultado com sucesso",
+		Data:    dto.ToEffectivePriceDTO(price),
+	})
+}
+
+// History atende GET /product/{id}/price-history.
+func (h *priceHandler) History(w http.ResponseWriter, r *http.Request) {
+	const ref = "[PriceHandler - History] "
+	ctx := r.Context()
+
+	id, ok := h.pathID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	if !h.checkParams(w, r, ref, validHistoryParams) {
+		return
+	}
+
+	from, err := parseDate(query.Get("from"), false)
+	if err != nil {
+		h.badParam(w, r, ref, fmt.Errorf("from: %w", err))
+		return
+	}
+	to, err := parseDate(query.Get("to"), true)
+	if err != nil {
+		h.badParam(w, r, ref, fmt.Errorf("to: %w", err))
+		return
+	}
+
+	limit, offset := utils.GetPaginationParams(r)
+	cursor, cursorMode := utils.GetCursorParam(r)
+
+	f := &models.PriceFilter{
+		BaseFilter: commonFilter.BaseFilter{
+			Limit:      limit,
+			Offset:     offset,
+			SortBy:     query.Get("sort_by"),
+			SortOrder:  query.Get("sort_order"),
+			Cursor:     cursor,
+			CursorMode: cursorMode,
+		},
+		ProductID:     id,
+		Source:        query.Get("source"),
+		EffectiveFrom: from,
+		EffectiveTo:   to,
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{
+		"product_id": id,
+		"source":     f.Source,
+	})
+
+	page, err := h.service.History(ctx, f)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"product_id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	priceDTOs := dto.ToProductPriceDTOs(page.Items)
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"product_id":        id,
+		"total_encontrados": len(priceDTOs),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Histórico de preços listado com sucesso",
+		Data:    dtoPage.ToPageDTO(page, priceDTOs),
+	})
+}
@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setup() (*mockProduct.PriceMock, *priceHandler) {
+	log := logrus.New()
+	log.Out = &bytes.Buffer{}
+	mockService := new(mockProduct.PriceMock)
+	return mockService, NewPriceHandler(mockService, logger.NewLoggerAdapter(log))
+}
+
+func request(method, target, id string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	return mux.SetURLVars(req, map[string]string{"id": id})
+}
+
+func TestPriceHandler_EffectivePrice(t *testing.T) {
+	t.Run("erro - ID inválido", func(t *testing.T) {
+		mockService, handler := setup()
+		rec := httptest.NewRecorder()
+
+		handler.EffectivePrice(rec, request(http.MethodGet, "/product/abc/price", "abc", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertNotCalled(t, "EffectiveAt", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("erro - data inválida", func(t *testing.T) {
+		mockService, handler := setup()
+		rec := httptest.NewRecorder()
+
+		handler.EffectivePrice(rec, request(http.MethodGet, "/product/1/price?at=amanha", "1", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertNotCalled(t, "EffectiveAt", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("sem data consulta o instante atual", func(t *testing.T) {
+		mockService, handler := setup()
+		before := time.Now()
+		mockService.On("EffectiveAt", mock.Anything, int64(1), mock.MatchedBy(func(at time.Time) bool {
+			return !at.Before(before) && !at.After(time.Now())
+		})).Return(&models.EffectivePrice{ProductID: 1, SalePrice: money.MustParse("9.90")}, nil).Once()
+		rec := httptest.NewRecorder()
+
+		handler.EffectivePrice(rec, request(http.MethodGet, "/product/1/price", "1", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp struct {
+			Data map[string]any `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, 9.9, resp.Data["sale_price"])
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("data sem horário vale até o fim do dia", func(t *testing.T) {
+		mockService, handler := setup()
+		end := time.Date(2026, 3, 1, 23, 59, 59, 999999999, time.UTC)
+		mockService.On("EffectiveAt", mock.Anything, int64(1), mock.MatchedBy(func(at time.Time) bool { return at.Equal(end) })).
+			Return(&models.EffectivePrice{ProductID: 1, At: end}, nil).Once()
+		rec := httptest.NewRecorder()
+
+		handler.EffectivePrice(rec, request(http.MethodGet, "/product/1/price?at=2026-03-01", "1", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("sem preço na data", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("EffectiveAt", mock.Anything, int64(9), mock.Anything).Return(nil, errMsg.ErrNotFound).Once()
+		rec := httptest.NewRecorder()
+
+		handler.EffectivePrice(rec, request(http.MethodGet, "/product/9/price", "9", nil))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("EffectiveAt", mock.Anything, int64(1), mock.Anything).Return(nil, errors.New("db down")).Once()
+		rec := httptest.NewRecorder()
+
+		handler.EffectivePrice(rec, request(http.MethodGet, "/product/1/price", "1", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestPriceHandler_History(t *testing.T) {
+	t.Run("erro - parâmetro desconhecido", func(t *testing.T) {
+		mockService, handler := setup()
+		rec := httptest.NewRecorder()
+
+		handler.History(rec, request(http.MethodGet, "/product/1/price-history?foo=1", "1", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertNotCalled(t, "History", mock.Anything, mock.Anything)
+	})
+
+	t.Run("sucesso com envelope paginado", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("History", mock.Anything, mock.MatchedBy(func(f *models.PriceFilter) bool {
+			return f.ProductID == 1 && f.Source == models.SourceManual && f.EffectiveFrom != nil && f.EffectiveTo == nil
+		})).Return(&commonFilter.Page[*models.ProductPrice]{
+			Items: []*models.ProductPrice{{ID: 3, ProductID: 1, SalePrice: money.New(12), Source: models.SourceManual}},
+			Total: 1,
+			Limit: 50,
+		}, nil).Once()
+		rec := httptest.NewRecorder()
+
+		handler.History(rec, request(http.MethodGet, "/product/1/price-history?source=manual&from=2026-01-01", "1", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp struct {
+			Data struct {
+				Items []map[string]any `json:"items"`
+				Total int64            `json:"total"`
+			} `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, int64(1), resp.Data.Total)
+		assert.Equal(t, 12.0, resp.Data.Items[0]["sale_price"])
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("erro - filtro inválido", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("History", mock.Anything, mock.Anything).Return(nil, errMsg.ErrInvalidFilter).Once()
+		rec := httptest.NewRecorder()
+
+		handler.History(rec, request(http.MethodGet, "/product/1/price-history?source=promo", "1", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
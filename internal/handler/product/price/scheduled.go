@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/product/price"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+var validScheduledParams = map[string]bool{"status": true}
+
+// Schedule atende POST /product/{id}/scheduled-price em nome do usuário
+// autenticado.
+func (h *priceHandler) Schedule(w http.ResponseWriter, r *http.Request) {
+	const ref = "[PriceHandler - Schedule] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := h.pathID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	var createDTO dto.CreateScheduledPriceDTO
+	if err := utils.FromJSON(r.Body, &createDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateInit, map[string]any{
+		"product_id":     id,
+		"effective_from": createDTO.EffectiveFrom,
+	})
+
+	created, err := h.service.Schedule(ctx, dto.ToScheduledPriceModel(createDTO, id, currentUser(ctx)))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, map[string]any{"product_id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{"id": created.ID})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Mudança de preço agendada com sucesso",
+		Data:    dto.ToScheduledPriceDTO(created),
+	})
+}
+
+// ListScheduled atende GET /product/{id}/scheduled-prices?status=.
+func (h *priceHandler) ListScheduled(w http.ResponseWriter, r *http.Request) {
+	const ref = "[PriceHandler - ListScheduled] "
+	ctx := r.Context()
+
+	id, ok := h.pathID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	if !h.checkParams(w, r, ref, validScheduledParams) {
+		return
+	}
+	status := r.URL.Query().Get("status")
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{
+		"product_id": id,
+		"status":     status,
+	})
+
+	changes, err := h.service.ListScheduled(ctx, id, status)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"product_id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"product_id":        id,
+		"total_encontrados": len(changes),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Mudanças de preço agendadas listadas com sucesso",
+		Data:    dto.ToScheduledPriceDTOs(changes),
+	})
+}
+
+// CancelScheduled atende PATCH /scheduled-price/{id}/cancel.
+func (h *priceHandler) CancelScheduled(w http.ResponseWriter, r *http.Request) {
+	const ref = "[PriceHandler - CancelScheduled] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPatch {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := h.pathID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	canceled, err := h.service.CancelScheduled(ctx, id)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogUpdateError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogUpdateSuccess, map[string]any{
+		"id":     id,
+		"status": canceled.Status,
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Mudança de preço cancelada com sucesso",
+		Data:    dto.ToScheduledPriceDTO(canceled),
+	})
+}
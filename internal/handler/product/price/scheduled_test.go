@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPriceHandler_Schedule(t *testing.T) {
+	t.Run("erro - método não permitido", func(t *testing.T) {
+		_, handler := setup()
+		rec := httptest.NewRecorder()
+
+		handler.Schedule(rec, request(http.MethodGet, "/product/1/scheduled-price", "1", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+
+	t.Run("erro - JSON inválido", func(t *testing.T) {
+		mockService, handler := setup()
+		rec := httptest.NewRecorder()
+
+		handler.Schedule(rec, request(http.MethodPost, "/product/1/scheduled-price", "1", []byte("{")))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertNotCalled(t, "Schedule", mock.Anything, mock.Anything)
+	})
+
+	t.Run("agenda para o produto da rota", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("Schedule", mock.Anything, mock.MatchedBy(func(c *models.ScheduledPrice) bool {
+			return c.ProductID == 1 && *c.SalePrice == money.MustParse("12.50") && c.CostPrice == nil
+		})).Return(&models.ScheduledPrice{ID: 4, ProductID: 1, Status: models.StatusPending}, nil).Once()
+		rec := httptest.NewRecorder()
+
+		body := []byte(`{"sale_price": 12.5, "effective_from": "2030-01-01T00:00:00Z"}`)
+		handler.Schedule(rec, request(http.MethodPost, "/product/1/scheduled-price", "1", body))
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("erro - dados inválidos", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("Schedule", mock.Anything, mock.Anything).Return(nil, errMsg.ErrInvalidData).Once()
+		rec := httptest.NewRecorder()
+
+		handler.Schedule(rec, request(http.MethodPost, "/product/1/scheduled-price", "1", []byte(`{"effective_from": "2020-01-01T00:00:00Z"}`)))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestPriceHandler_ListScheduled(t *testing.T) {
+	t.Run("lista por status", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("ListScheduled", mock.Anything, int64(1), "pending").
+			Return([]*models.ScheduledPrice{{ID: 4, ProductID: 1, Status: models.StatusPending}}, nil).Once()
+		rec := httptest.NewRecorder()
+
+		handler.ListScheduled(rec, request(http.MethodGet, "/product/1/scheduled-prices?status=pending", "1", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("erro - parâmetro desconhecido", func(t *testing.T) {
+		mockService, handler := setup()
+		rec := httptest.NewRecorder()
+
+		handler.ListScheduled(rec, request(http.MethodGet, "/product/1/scheduled-prices?limit=2", "1", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertNotCalled(t, "ListScheduled", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPriceHandler_CancelScheduled(t *testing.T) {
+	t.Run("cancela a mudança", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("CancelScheduled", mock.Anything, int64(4)).
+			Return(&models.ScheduledPrice{ID: 4, Status: models.StatusCanceled}, nil).Once()
+		rec := httptest.NewRecorder()
+
+		handler.CancelScheduled(rec, request(http.MethodPatch, "/scheduled-price/4/cancel", "4", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("mudança já aplicada", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("CancelScheduled", mock.Anything, int64(4)).Return(nil, errMsg.ErrInvalidData).Once()
+		rec := httptest.NewRecorder()
+
+		handler.CancelScheduled(rec, request(http.MethodPatch, "/scheduled-price/4/cancel", "4", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("mudança inexistente", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("CancelScheduled", mock.Anything, int64(4)).Return(nil, errMsg.ErrNotFound).Once()
+		rec := httptest.NewRecorder()
+
+		handler.CancelScheduled(rec, request(http.MethodPatch, "/scheduled-price/4/cancel", "4", nil))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -33,8 +34,8 @@ func TestProductHandler_GetByID(t *testing.T) {
 			ID:            1,
 			ProductName:   "Produto A",
 			Manufacturer:  "Marca X",
-			CostPrice:     10.0,
-			SalePrice:     15.0,
+			CostPrice:     money.New(10),
+			SalePrice:     money.New(15),
 			StockQuantity: 100,
 		}
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	dtoLocation "github.com/WagaoCarvalho/backend_store_go/internal/dto/inventory/location"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
@@ -59,16 +60,15 @@ func (h *productHandler) GetStock(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	resp := map[string]any{
+	h.logger.Info(ctx, ref+"sucesso", map[string]any{
 		"product_id":     id,
-		"stock_quantity": stock,
-	}
-
-	h.logger.Info(ctx, ref+"sucesso", resp)
+		"stock_quantity": stock.Total,
+		"in_transit":     stock.InTransit,
+	})
 	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
 		Status:  http.StatusOK,
 		Message: "Estoque recuperado com sucesso",
-		Data:    resp,
+		Data:    dtoLocation.ToProductStockDTO(stock),
 	})
 }
 
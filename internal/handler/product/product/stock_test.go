@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	modelsLocation "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
@@ -546,7 +547,7 @@ func TestProductHandler_GetStock(t *testing.T) {
 		req = mux.SetURLVars(req, map[string]string{"id": "0"})
 		w := httptest.NewRecorder()
 
-		mockService.On("GetStock", mock.Anything, int64(0)).Return(0, errMsg.ErrZeroID).Once()
+		mockService.On("GetStock", mock.Anything, int64(0)).Return(nil, errMsg.ErrZeroID).Once()
 
 		handler.GetStock(w, req)
 
@@ -561,7 +562,7 @@ func TestProductHandler_GetStock(t *testing.T) {
 		req = mux.SetURLVars(req, map[string]string{"id": "1"})
 		w := httptest.NewRecorder()
 
-		mockService.On("GetStock", mock.Anything, int64(1)).Return(0, errMsg.ErrNotFound).Once()
+		mockService.On("GetStock", mock.Anything, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
 
 		handler.GetStock(w, req)
 
@@ -576,7 +577,7 @@ func TestProductHandler_GetStock(t *testing.T) {
 		req = mux.SetURLVars(req, map[string]string{"id": "1"})
 		w := httptest.NewRecorder()
 
-		mockService.On("GetStock", mock.Anything, int64(1)).Return(0, fmt.Errorf("erro inesperado")).Once()
+		mockService.On("GetStock", mock.Anything, int64(1)).Return(nil, fmt.Errorf("erro inesperado")).Once()
 
 		handler.GetStock(w, req)
 
@@ -591,7 +592,7 @@ func TestProductHandler_GetStock(t *testing.T) {
 		req = mux.SetURLVars(req, map[string]string{"id": "1"})
 		w := httptest.NewRecorder()
 
-		mockService.On("GetStock", mock.Anything, int64(1)).Return(20, nil).Once()
+		mockService.On("GetStock", mock.Anything, int64(1)).Return(&modelsLocation.ProductStock{ProductID: 1, Total: 20}, nil).Once()
 
 		handler.GetStock(w, req)
 
@@ -622,7 +623,7 @@ func TestProductHandler_GetStock(t *testing.T) {
 		req = mux.SetURLVars(req, map[string]string{"id": "1"})
 		w := httptest.NewRecorder()
 
-		mockService.On("GetStock", mock.Anything, int64(1)).Return(0, nil).Once()
+		mockService.On("GetStock", mock.Anything, int64(1)).Return(&modelsLocation.ProductStock{ProductID: 1}, nil).Once()
 
 		handler.GetStock(w, req)
 
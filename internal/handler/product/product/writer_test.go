@@ -20,6 +20,7 @@ import (
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 )
 
@@ -40,8 +41,8 @@ func TestProductHandler_Create(t *testing.T) {
 		input := dto.ProductDTO{
 			ProductName:   "Produto OK",
 			Manufacturer:  "Marca X",
-			CostPrice:     10.0,
-			SalePrice:     15.0,
+			CostPrice:     money.New(10),
+			SalePrice:     money.New(15),
 			StockQuantity: 5,
 		}
 
@@ -130,8 +131,8 @@ func TestProductHandler_Create(t *testing.T) {
 		input := dto.ProductDTO{
 			ProductName:   "ProdutoX",
 			Manufacturer:  "Marca",
-			CostPrice:     10,
-			SalePrice:     20,
+			CostPrice:     money.New(10),
+			SalePrice:     money.New(20),
 			StockQuantity: 5,
 		}
 
@@ -172,8 +173,8 @@ func TestProductHandler_Create(t *testing.T) {
 		input := dto.ProductDTO{
 			ProductName:   "Produto FK",
 			Manufacturer:  "Marca FK",
-			CostPrice:     50.0,
-			SalePrice:     60.0,
+			CostPrice:     money.New(50),
+			SalePrice:     money.New(60),
 			StockQuantity: 20,
 		}
 
@@ -211,8 +212,8 @@ func TestProductHandler_Create(t *testing.T) {
 		input := dto.ProductDTO{
 			ProductName:   "Produto Inválido",
 			Manufacturer:  "Marca",
-			CostPrice:     100.0,
-			SalePrice:     50.0, // SalePrice < CostPrice é inválido
+			CostPrice:     money.New(100),
+			SalePrice:     money.New(50), // SalePrice < CostPrice é inválido
 			StockQuantity: 10,
 		}
 
@@ -250,8 +251,8 @@ func TestProductHandler_Create(t *testing.T) {
 		input := dto.ProductDTO{
 			ProductName:   "Produto Erro",
 			Manufacturer:  "Marca",
-			CostPrice:     20.0,
-			SalePrice:     30.0,
+			CostPrice:     money.New(20),
+			SalePrice:     money.New(30),
 			StockQuantity: 8,
 		}
 
@@ -299,8 +300,8 @@ func TestProductHandler_Update(t *testing.T) {
 	validDTO := dto.ProductDTO{
 		ProductName:   "Produto Teste",
 		Manufacturer:  "Marca X",
-		CostPrice:     10,
-		SalePrice:     15,
+		CostPrice:     money.New(10),
+		SalePrice:     money.New(15),
 		StockQuantity: 5,
 	}
 
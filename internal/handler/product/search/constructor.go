@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/product/search"
+)
+
+type productSearchHandler struct {
+	service service.ProductSearch
+	logger  *logger.LogAdapter
+}
+
+func NewProductSearchHandler(service service.ProductSearch, logger *logger.LogAdapter) *productSearchHandler {
+	return &productSearchHandler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/product/search"
+	search "github.com/WagaoCarvalho/backend_store_go/internal/model/product/search"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+const modeAutocomplete = "autocomplete"
+
+var validProductSearchParams = map[string]bool{
+	"q":     true,
+	"limit": true,
+	"mode":  true,
+}
+
+// Search atende GET /products/search?q=. Com mode=autocomplete devolve só
+// ID, nome, preço e estoque, para a caixa de busca do PDV.
+func (h *productSearchHandler) Search(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	const ref = "[productSearchHandler - Search] "
+
+	if r.Method != http.MethodGet {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{
+			"method": r.Method,
+		})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	for param := range query {
+		if !validProductSearchParams[param] {
+			h.logger.Warn(ctx, ref+"parâmetro desconhecido", map[string]any{
+				"parametro": param,
+				"valor":     query.Get(param),
+			})
+			utils.ErrorResponse(w, fmt.Errorf("parâmetro de consulta inválido: %s", param), http.StatusBadRequest)
+			return
+		}
+	}
+
+	mode := query.Get("mode")
+	if mode != "" && mode != modeAutocomplete {
+		h.logger.Warn(ctx, ref+"modo inválido", map[string]any{
+			"valor": mode,
+		})
+		utils.ErrorResponse(w, fmt.Errorf("mode deve ser vazio ou %s", modeAutocomplete), http.StatusBadRequest)
+		return
+	}
+
+	s := &search.ProductSearch{Query: query.Get("q")}
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 0 {
+			h.logger.Warn(ctx, ref+"limit inválido", map[string]any{
+				"valor": v,
+			})
+			utils.ErrorResponse(w, fmt.Errorf("limit deve ser um número inteiro positivo"), http.StatusBadRequest)
+			return
+		}
+		s.Limit = limit
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{
+		"q":    s.Query,
+		"mode": mode,
+	})
+
+	var (
+		data  any
+		total int
+		err   error
+	)
+	if mode == modeAutocomplete {
+		var suggestions []*search.Suggestion
+		suggestions, err = h.service.Autocomplete(ctx, s)
+		if err == nil {
+			dtos := dto.ToSuggestionDTOs(suggestions)
+			data, total = dtos, len(dtos)
+		}
+	} else {
+		var results []*search.Result
+		results, err = h.service.Search(ctx, s)
+		if err == nil {
+			dtos := dto.ToSearchResultDTOs(results)
+			data, total = dtos, len(dtos)
+		}
+	}
+
+	if err != nil {
+		if errors.Is(err, errMsg.ErrInvalidFilter) {
+			h.logger.Warn(ctx, ref+"busca inválida", map[string]any{
+				"erro": err.Error(),
+			})
+			utils.ErrorResponse(w, err, http.StatusBadRequest)
+			return
+		}
+
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{
+			"q": s.Query,
+		})
+		utils.ErrorResponse(w, fmt.Errorf("erro ao buscar produtos"), http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"total_encontrados": total,
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Produtos encontrados com sucesso",
+		Data:    data,
+	})
+}
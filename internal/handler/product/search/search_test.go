@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	search "github.com/WagaoCarvalho/backend_store_go/internal/model/product/search"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProductSearchHandler_Search(t *testing.T) {
+	log := logrus.New()
+	log.Out = &bytes.Buffer{}
+	logAdapter := logger.NewLoggerAdapter(log)
+
+	setup := func() (*mockProduct.ProductSearchMock, *productSearchHandler) {
+		mockService := new(mockProduct.ProductSearchMock)
+		return mockService, NewProductSearchHandler(mockService, logAdapter)
+	}
+
+	t.Run("erro - método não permitido", func(t *testing.T) {
+		mockService, handler := setup()
+		req := httptest.NewRequest(http.MethodPost, "/products/search?q=cafe", nil)
+		rec := httptest.NewRecorder()
+
+		handler.Search(rec, req)
+
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+		mockService.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+
+	t.Run("erro - parâmetro desconhecido", func(t *testing.T) {
+		mockService, handler := setup()
+		req := httptest.NewRequest(http.MethodGet, "/products/search?q=cafe&foo=1", nil)
+		rec := httptest.NewRecorder()
+
+		handler.Search(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "parâmetro de consulta inválido")
+		mockService.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+
+	t.Run("erro - modo inválido", func(t *testing.T) {
+		mockService, handler := setup()
+		req := httptest.NewRequest(http.MethodGet, "/products/search?q=cafe&mode=full", nil)
+		rec := httptest.NewRecorder()
+
+		handler.Search(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+
+	t.Run("erro - limit inválido", func(t *testing.T) {
+		mockService, handler := setup()
+		req := httptest.NewRequest(http.MethodGet, "/products/search?q=cafe&limit=abc", nil)
+		rec := httptest.NewRecorder()
+
+		handler.Search(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+
+	t.Run("erro - busca inválida retorna 400", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("Search", mock.Anything, &search.ProductSearch{Query: "a"}).
+			Return(nil, errMsg.ErrInvalidFilter).Once()
+		req := httptest.NewRequest(http.MethodGet, "/products/search?q=a", nil)
+		rec := httptest.NewRecorder()
+
+		handler.Search(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("erro - falha interna retorna 500", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("Search", mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+		req := httptest.NewRequest(http.MethodGet, "/products/search?q=cafe", nil)
+		rec := httptest.NewRecorder()
+
+		handler.Search(rec, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+
+	t.Run("sucesso - busca completa", func(t *testing.T) {
+		mockService, handler := setup()
+		results := []*search.Result{
+			{Product: &models.Product{ID: 1, ProductName: "Café"}, Rank: 2, BarcodeMatch: true},
+		}
+		mockService.On("Search", mock.Anything, &search.ProductSearch{Query: "789100", Limit: 5}).
+			Return(results, nil).Once()
+		req := httptest.NewRequest(http.MethodGet, "/products/search?q=789100&limit=5", nil)
+		rec := httptest.NewRecorder()
+
+		handler.Search(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp struct {
+			utils.DefaultResponse
+			Data []map[string]any `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Len(t, resp.Data, 1)
+		assert.Equal(t, "Café", resp.Data[0]["product_name"])
+		assert.Equal(t, true, resp.Data[0]["barcode_match"])
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("sucesso - autocomplete", func(t *testing.T) {
+		mockService, handler := setup()
+		suggestions := []*search.Suggestion{{ID: 3, ProductName: "Arroz", SalePrice: money.New(25), StockQuantity: 8}}
+		mockService.On("Autocomplete", mock.Anything, &search.ProductSearch{Query: "arr"}).
+			Return(suggestions, nil).Once()
+		req := httptest.NewRequest(http.MethodGet, "/products/search?q=arr&mode=autocomplete", nil)
+		rec := httptest.NewRecorder()
+
+		handler.Search(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp struct {
+			Data []map[string]any `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, []map[string]any{{"id": 3.0, "product_name": "Arroz", "sale_price": 25.0, "stock_quantity": 8.0}}, resp.Data)
+		mockService.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/product/stock_alert"
+)
+
+type stockAlertHandler struct {
+	service service.StockAlert
+	logger  *logger.LogAdapter
+}
+
+func NewStockAlertHandler(service service.StockAlert, logger *logger.LogAdapter) *stockAlertHandler {
+	return &stockAlertHandler{
+		service: service,
+		logger:  logger,
+	}
+}
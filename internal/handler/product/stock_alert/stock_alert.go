@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	dtoPage "github.com/WagaoCarvalho/backend_store_go/internal/dto/common/page"
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/product/stock_alert"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_alert"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+var (
+	validLowStockParams      = map[string]bool{"supplier_id": true}
+	validReplenishmentParams = map[string]bool{"supplier_id": true, "days": true, "lead_days": true}
+	validStockAlertParams    = map[string]bool{
+		"product_id": true,
+		"status":     true,
+		"limit":      true,
+		"offset":     true,
+		"cursor":     true,
+		"sort_by":    true,
+		"sort_order": true,
+	}
+)
+
+// LowStock atende GET /products/low-stock.
+func (h *stockAlertHandler) LowStock(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	const ref = "[stockAlertHandler - LowStock] "
+
+	query := r.URL.Query()
+	if !h.checkParams(ctx, w, ref, query, validLowStockParams) {
+		return
+	}
+
+	supplierID, err := optionalID(query, "supplier_id")
+	if err != nil {
+		h.badParam(ctx, w, ref, err)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{
+		"supplier_id": supplierID,
+	})
+
+	items, err := h.service.LowStock(ctx, supplierID)
+	if err != nil {
+		h.serviceError(ctx, w, ref, err, "erro ao listar produtos com estoque baixo")
+		return
+	}
+
+	itemDTOs := dto.ToLowStockItemDTOs(items)
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"total_encontrados": len(itemDTOs),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Produtos com estoque baixo listados com sucesso",
+		Data:    itemDTOs,
+	})
+}
+
+// ListAlerts atende GET /products/stock-alerts.
+func (h *stockAlertHandler) ListAlerts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	const ref = "[stockAlertHandler - ListAlerts] "
+
+	query := r.URL.Query()
+	if !h.checkParams(ctx, w, ref, query, validStockAlertParams) {
+		return
+	}
+
+	productID, err := optionalID(query, "product_id")
+	if err != nil {
+		h.badParam(ctx, w, ref, err)
+		return
+	}
+
+	limit, offset := utils.GetPaginationParams(r)
+	cursor, cursorMode := utils.GetCursorParam(r)
+
+	f := &models.StockAlertFilter{
+		BaseFilter: commonFilter.BaseFilter{
+			Limit:      limit,
+			Offset:     offset,
+			SortBy:     query.Get("sort_by"),
+			SortOrder:  query.Get("sort_order"),
+			Cursor:     cursor,
+			CursorMode: cursorMode,
+		},
+		ProductID: productID,
+		Status:    query.Get("status"),
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{
+		"product_id": productID,
+		"status":     f.Status,
+	})
+
+	page, err := h.service.List(ctx, f)
+	if err != nil {
+		h.serviceError(ctx, w, ref, err, "erro ao listar alertas de estoque")
+		return
+	}
+
+	alertDTOs := dto.ToStockAlertDTOs(page.Items)
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"total_encontrados": len(alertDTOs),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Alertas de estoque listados com sucesso",
+		Data:    dtoPage.ToPageDTO(page, alertDTOs),
+	})
+}
+
+// Replenishment atende GET /products/replenishment. days é o período de
+// vendas usado para a média diária e lead_days o prazo de entrega.
+func (h *stockAlertHandler) Replenishment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	const ref = "[stockAlertHandler - Replenishment] "
+
+	query := r.URL.Query()
+	if !h.checkParams(ctx, w, ref, query, validReplenishmentParams) {
+		return
+	}
+
+	supplierID, err := optionalID(query, "supplier_id")
+	if err != nil {
+		h.badParam(ctx, w, ref, err)
+		return
+	}
+
+	q := &models.ReplenishmentQuery{
+		SupplierID: supplierID,
+		WindowDays: models.DefaultWindowDays,
+		LeadDays:   models.DefaultLeadDays,
+	}
+	if q.WindowDays, err = intParam(query, "days", q.WindowDays); err != nil {
+		h.badParam(ctx, w, ref, err)
+		return
+	}
+	if q.LeadDays, err = intParam(query, "lead_days", q.LeadDays); err != nil {
+		h.badParam(ctx, w, ref, err)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{
+		"supplier_id": supplierID,
+		"days":        q.WindowDays,
+		"lead_days":   q.LeadDays,
+	})
+
+	groups, err := h.service.Replenishment(ctx, q)
+	if err != nil {
+		h.serviceError(ctx, w, ref, err, "erro ao calcular sugestão de reposição")
+		return
+	}
+
+	groupDTOs := dto.ToSupplierReplenishmentDTOs(groups)
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"fornecedores": len(groupDTOs),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Sugestão de reposição calculada com sucesso",
+		Data:    groupDTOs,
+	})
+}
+
+func (h *stockAlertHandler) checkParams(ctx context.Context, w http.ResponseWriter, ref string, query url.Values, valid map[string]bool) bool {
+	for param := range query {
+		if !valid[param] {
+			h.logger.Warn(ctx, ref+"parâmetro desconhecido", map[string]any{
+				"parametro": param,
+				"valor":     query.Get(param),
+			})
+			utils.ErrorResponse(w, fmt.Errorf("parâmetro de consulta inválido: %s", param), http.StatusBadRequest)
+			return false
+		}
+	}
+	return true
+}
+
+func (h *stockAlertHandler) badParam(ctx context.Context, w http.ResponseWriter, ref string, err error) {
+	h.logger.Warn(ctx, ref+logger.LogInvalidParam, map[string]any{
+		"erro": err.Error(),
+	})
+	utils.ErrorResponse(w, err, http.StatusBadRequest)
+}
+
+func (h *stockAlertHandler) serviceError(ctx context.Context, w http.ResponseWriter, ref string, err error, message string) {
+	switch {
+	case errors.Is(err, errMsg.ErrInvalidFilter):
+		h.logger.Warn(ctx, ref+"filtro inválido", map[string]any{
+			"erro": err.Error(),
+		})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+
+	case errors.Is(err, errMsg.ErrZeroID):
+		h.logger.Warn(ctx, ref+logger.LogInvalidID, map[string]any{
+			"erro": err.Error(),
+		})
+		utils.ErrorResponse(w, fmt.Errorf("ID inválido"), http.StatusBadRequest)
+
+	default:
+		h.logger.Error(ctx, err, ref+logger.LogGetError, nil)
+		utils.ErrorResponse(w, errors.New(message), http.StatusInternalServerError)
+	}
+}
+
+func optionalID(query url.Values, name string) (*int64, error) {
+	v := query.Get(name)
+	if v == "" {
+		return nil, nil
+	}
+
+	id, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || id <= 0 {
+		return nil, fmt.Errorf("%s deve ser um número inteiro positivo", name)
+	}
+	return &id, nil
+}
+
+func intParam(query url.Values, name string, def int) (int, error) {
+	v := query.Get(name)
+	if v == "" {
+		return def, nil
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("%s deve ser um número inteiro", name)
+	}
+	return n, nil
+}
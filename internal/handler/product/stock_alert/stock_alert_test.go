@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_alert"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setup() (*mockProduct.StockAlertMock, *stockAlertHandler) {
+	log := logrus.New()
+	log.Out = &bytes.Buffer{}
+	mockService := new(mockProduct.StockAlertMock)
+	return mockService, NewStockAlertHandler(mockService, logger.NewLoggerAdapter(log))
+}
+
+func TestStockAlertHandler_LowStock(t *testing.T) {
+	t.Run("erro - parâmetro desconhecido", func(t *testing.T) {
+		mockService, handler := setup()
+		rec := httptest.NewRecorder()
+
+		handler.LowStock(rec, httptest.NewRequest(http.MethodGet, "/products/low-stock?foo=1", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertNotCalled(t, "LowStock", mock.Anything, mock.Anything)
+	})
+
+	t.Run("erro - supplier_id inválido", func(t *testing.T) {
+		mockService, handler := setup()
+		rec := httptest.NewRecorder()
+
+		handler.LowStock(rec, httptest.NewRequest(http.MethodGet, "/products/low-stock?supplier_id=abc", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertNotCalled(t, "LowStock", mock.Anything, mock.Anything)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, handler := setup()
+		supplierID := int64(3)
+		mockService.On("LowStock", mock.Anything, &supplierID).
+			Return([]*models.LowStockItem{{ProductID: 1, ProductName: "Café", StockQuantity: 2, MinStock: 10}}, nil).Once()
+		rec := httptest.NewRecorder()
+
+		handler.LowStock(rec, httptest.NewRequest(http.MethodGet, "/products/low-stock?supplier_id=3", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp struct {
+			Data []map[string]any `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, 8.0, resp.Data[0]["shortage"])
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("LowStock", mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+		rec := httptest.NewRecorder()
+
+		handler.LowStock(rec, httptest.NewRequest(http.MethodGet, "/products/low-stock", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestStockAlertHandler_ListAlerts(t *testing.T) {
+	t.Run("sucesso com envelope paginado", func(t *testing.T) {
+		mockService, handler := setup()
+		productID := int64(7)
+		mockService.On("List", mock.Anything, mock.MatchedBy(func(f *models.StockAlertFilter) bool {
+			return *f.ProductID == productID && f.Status == models.StatusOpen && f.Limit == 5
+		})).Return(&commonFilter.Page[*models.StockAlert]{
+			Items: []*models.StockAlert{{ID: 1, ProductID: 7}},
+			Total: 1,
+			Limit: 5,
+		}, nil).Once()
+		rec := httptest.NewRecorder()
+
+		handler.ListAlerts(rec, httptest.NewRequest(http.MethodGet, "/products/stock-alerts?product_id=7&status=open&limit=5", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp struct {
+			Data struct {
+				Items []map[string]any `json:"items"`
+				Total int64            `json:"total"`
+			} `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, int64(1), resp.Data.Total)
+		assert.Equal(t, "open", resp.Data.Items[0]["status"])
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("filtro inválido retorna 400", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("List", mock.Anything, mock.Anything).Return(nil, errMsg.ErrInvalidFilter).Once()
+		rec := httptest.NewRecorder()
+
+		handler.ListAlerts(rec, httptest.NewRequest(http.MethodGet, "/products/stock-alerts?status=closed", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestStockAlertHandler_Replenishment(t *testing.T) {
+	t.Run("usa os padrões de período e prazo", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("Replenishment", mock.Anything, &models.ReplenishmentQuery{
+			WindowDays: models.DefaultWindowDays,
+			LeadDays:   models.DefaultLeadDays,
+		}).Return([]*models.SupplierReplenishment{{SupplierName: "Alfa", TotalQuantity: 10}}, nil).Once()
+		rec := httptest.NewRecorder()
+
+		handler.Replenishment(rec, httptest.NewRequest(http.MethodGet, "/products/replenishment", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"supplier_name":"Alfa"`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("repassa days e lead_days", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("Replenishment", mock.Anything, &models.ReplenishmentQuery{WindowDays: 60, LeadDays: 0}).
+			Return([]*models.SupplierReplenishment{}, nil).Once()
+		rec := httptest.NewRecorder()
+
+		handler.Replenishment(rec, httptest.NewRequest(http.MethodGet, "/products/replenishment?days=60&lead_days=0", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("erro - days não numérico", func(t *testing.T) {
+		mockService, handler := setup()
+		rec := httptest.NewRecorder()
+
+		handler.Replenishment(rec, httptest.NewRequest(http.MethodGet, "/products/replenishment?days=x", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertNotCalled(t, "Replenishment", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+)
+
+type stockMovementHandler struct {
+	service iface.StockMovementReader
+	logger  *logger.LogAdapter
+}
+
+func NewStockMovementHandler(service iface.StockMovementReader, logger *logger.LogAdapter) *stockMovementHandler {
+	return &stockMovementHandler{
+		service: service,
+		logger:  logger,
+	}
+}
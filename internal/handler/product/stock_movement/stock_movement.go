@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	dtoPage "github.com/WagaoCarvalho/backend_store_go/internal/dto/common/page"
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/product/stock_movement"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+const dateLayout = "2006-01-02"
+
+var (
+	validStockAtParams = map[string]bool{"date": true}
+	validHistoryParams = map[string]bool{
+		"reason":       true,
+		"created_from": true,
+		"created_to":   true,
+		"limit":        true,
+		"offset":       true,
+		"cursor":       true,
+		"sort_by":      true,
+		"sort_order":   true,
+	}
+)
+
+// History atende GET /product/{id}/stock-history.
+func (h *stockMovementHandler) History(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	const ref = "[stockMovementHandler - History] "
+
+	id, ok := h.productID(ctx, w, r, ref)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	if !h.checkParams(ctx, w, ref, query, validHistoryParams) {
+		return
+	}
+
+	from, err := parseDate(query.Get("created_from"), false)
+	if err != nil {
+		h.badParam(ctx, w, ref, fmt.Errorf("created_from: %w", err))
+		return
+	}
+	to, err := parseDate(query.Get("created_to"), true)
+	if err != nil {
+		h.badParam(ctx, w, ref, fmt.Errorf("created_to: %w", err))
+		return
+	}
+
+	limit, offset := utils.GetPaginationParams(r)
+	cursor, cursorMode := utils.GetCursorParam(r)
+
+	f := &models.StockMovementFilter{
+		BaseFilter: commonFilter.BaseFilter{
+			Limit:      limit,
+			Offset:     offset,
+			SortBy:     query.Get("sort_by"),
+			SortOrder:  query.Get("sort_order"),
+			Cursor:     cursor,
+			CursorMode: cursorMode,
+		},
+		ProductID:   id,
+		Reason:      query.Get("reason"),
+		CreatedFrom: from,
+		CreatedTo:   to,
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{
+		"product_id": id,
+		"reason":     f.Reason,
+	})
+
+	page, err := h.service.History(ctx, f)
+	if err != nil {
+		h.serviceError(ctx, w, ref, err, "erro ao consultar histórico de estoque")
+		return
+	}
+
+	movementDTOs := dto.ToStockMovementDTOs(page.Items)
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"product_id":        id,
+		"total_encontrados": len(movementDTOs),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Histórico de estoque listado com sucesso",
+		Data:    dtoPage.ToPageDTO(page, movementDTOs),
+	})
+}
+
+// StockAt atende GET /product/{id}/stock-at?date=. Uma data sem horário
+// considera o fim do dia.
+func (h *stockMovementHandler) StockAt(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	const ref = "[stockMovementHandler - StockAt] "
+
+	id, ok := h.productID(ctx, w, r, ref)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	if !h.checkParams(ctx, w, ref, query, validStockAtParams) {
+		return
+	}
+
+	at, err := parseDate(query.Get("date"), true)
+	if err == nil && at == nil {
+		err = errors.New("parâmetro obrigatório")
+	}
+	if err != nil {
+		h.badParam(ctx, w, ref, fmt.Errorf("date: %w", err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{
+		"product_id": id,
+		"date":       at,
+	})
+
+	result, err := h.service.StockAt(ctx, id, *at)
+	if err != nil {
+		h.serviceError(ctx, w, ref, err, "erro ao consultar estoque na data")
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"product_id": id,
+		"quantidade": result.Quantity,
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Estoque na data consultado com sucesso",
+		Data:    dto.ToStockAtDTO(result),
+	})
+}
+
+func (h *stockMovementHandler) productID(ctx context.Context, w http.ResponseWriter, r *http.Request, ref string) (int64, bool) {
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil {
+		h.logger.Warn(ctx, ref+logger.LogInvalidID, map[string]any{
+			"erro": err.Error(),
+		})
+		utils.ErrorResponse(w, fmt.Errorf("ID inválido"), http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
+func (h *stockMovementHandler) checkParams(ctx context.Context, w http.ResponseWriter, ref string, query url.Values, valid map[string]bool) bool {
+	for param := range query {
+		if !valid[param] {
+			h.logger.Warn(ctx, ref+"parâmetro desconhecido", map[string]any{
+				"parametro": param,
+				"valor":     query.Get(param),
+			})
+			utils.ErrorResponse(w, fmt.Errorf("parâmetro de consulta inválido: %s", param), http.StatusBadRequest)
+			return false
+		}
+	}
+	return true
+}
+
+func (h *stockMovementHandler) badParam(ctx context.Context, w http.ResponseWriter, ref string, err error) {
+	h.logger.Warn(ctx, ref+logger.LogInvalidParam, map[string]any{
+		"erro": err.Error(),
+	})
+	utils.ErrorResponse(w, err, http.StatusBadRequest)
+}
+
+func (h *stockMovementHandler) serviceError(ctx context.Context, w http.ResponseWriter, ref string, err error, message string) {
+	switch {
+	case errors.Is(err, errMsg.ErrInvalidFilter):
+		h.logger.Warn(ctx, ref+"filtro inválido", map[string]any{
+			"erro": err.Error(),
+		})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+
+	case errors.Is(err, errMsg.ErrZeroID):
+		h.logger.Warn(ctx, ref+logger.LogInvalidID, map[string]any{
+			"erro": err.Error(),
+		})
+		utils.ErrorResponse(w, fmt.Errorf("ID inválido"), http.StatusBadRequest)
+
+	case errors.Is(err, errMsg.ErrNotFound):
+		h.logger.Warn(ctx, ref+logger.LogNotFound, nil)
+		utils.ErrorResponse(w, fmt.Errorf("produto não encontrado"), http.StatusNotFound)
+
+	default:
+		h.logger.Error(ctx, err, ref+logger.LogGetError, nil)
+		utils.ErrorResponse(w, errors.New(message), http.StatusInternalServerError)
+	}
+}
+
+// parseDate aceita "2006-01-02" ou RFC3339. Com endOfDay, uma data sem
+// horário vale até o último instante do dia. Valor vazio devolve nil.
+func parseDate(value string, endOfDay bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(dateLayout, value); err == nil {
+		if endOfDay {
+			t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		}
+		return &t, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.New("data inválida, use AAAA-MM-DD ou RFC3339")
+	}
+	return &t, nil
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setup() (*mockProduct.StockMovementMock, *stockMovementHandler) {
+	log := logrus.New()
+	log.Out = &bytes.Buffer{}
+	mockService := new(mockProduct.StockMovementMock)
+	return mockService, NewStockMovementHandler(mockService, logger.NewLoggerAdapter(log))
+}
+
+func request(target, id string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	return mux.SetURLVars(req, map[string]string{"id": id})
+}
+
+func TestStockMovementHandler_History(t *testing.T) {
+	t.Run("erro - ID inválido", func(t *testing.T) {
+		mockService, handler := setup()
+		rec := httptest.NewRecorder()
+
+		handler.History(rec, request("/product/abc/stock-history", "abc"))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertNotCalled(t, "History", mock.Anything, mock.Anything)
+	})
+
+	t.Run("erro - data inválida", func(t *testing.T) {
+		mockService, handler := setup()
+		rec := httptest.NewRecorder()
+
+		handler.History(rec, request("/product/1/stock-history?created_from=ontem", "1"))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertNotCalled(t, "History", mock.Anything, mock.Anything)
+	})
+
+	t.Run("sucesso com envelope paginado", func(t *testing.T) {
+		mockService, handler := setup()
+		to := time.Date(2026, 3, 1, 23, 59, 59, 999999999, time.UTC)
+		mockService.On("History", mock.Anything, mock.MatchedBy(func(f *models.StockMovementFilter) bool {
+			return f.ProductID == 1 && f.Reason == models.ReasonSale && f.CreatedTo.Equal(to) && f.CreatedFrom == nil
+		})).Return(&commonFilter.Page[*models.StockMovement]{
+			Items: []*models.StockMovement{{ID: 3, ProductID: 1, Delta: -2, Quantity: 8, Reason: models.ReasonSale}},
+			Total: 1,
+			Limit: 50,
+		}, nil).Once()
+		rec := httptest.NewRecorder()
+
+		handler.History(rec, request("/product/1/stock-history?reason=sale&created_to=2026-03-01", "1"))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp struct {
+			Data struct {
+				Items []map[string]any `json:"items"`
+				Total int64            `json:"total"`
+			} `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, int64(1), resp.Data.Total)
+		assert.Equal(t, -2.0, resp.Data.Items[0]["delta"])
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("erro - filtro inválido", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("History", mock.Anything, mock.Anything).Return(nil, errMsg.ErrInvalidFilter).Once()
+		rec := httptest.NewRecorder()
+
+		handler.History(rec, request("/product/1/stock-history?reason=loss", "1"))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestStockMovementHandler_StockAt(t *testing.T) {
+	t.Run("erro - data ausente", func(t *testing.T) {
+		mockService, handler := setup()
+		rec := httptest.NewRecorder()
+
+		handler.StockAt(rec, request("/product/1/stock-at", "1"))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockService.AssertNotCalled(t, "StockAt", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("sucesso com horário informado", func(t *testing.T) {
+		mockService, handler := setup()
+		at := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+		mockService.On("StockAt", mock.Anything, int64(1), mock.MatchedBy(func(t time.Time) bool { return t.Equal(at) })).
+			Return(&models.StockAt{ProductID: 1, At: at, Quantity: 12}, nil).Once()
+		rec := httptest.NewRecorder()
+
+		handler.StockAt(rec, request("/product/1/stock-at?date=2026-03-01T10:00:00Z", "1"))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp struct {
+			Data map[string]any `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, 12.0, resp.Data["stock_quantity"])
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("produto não encontrado", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("StockAt", mock.Anything, int64(9), mock.Anything).Return(nil, errMsg.ErrNotFound).Once()
+		rec := httptest.NewRecorder()
+
+		handler.StockAt(rec, request("/product/9/stock-at?date=2026-03-01", "9"))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService, handler := setup()
+		mockService.On("StockAt", mock.Anything, int64(1), mock.Anything).Return(nil, errors.New("db down")).Once()
+		rec := httptest.NewRecorder()
+
+		handler.StockAt(rec, request("/product/1/stock-at?date=2026-03-01", "1"))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
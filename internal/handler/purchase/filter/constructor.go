@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/purchase/filter"
+)
+
+type purchaseOrderFilterHandler struct {
+	service service.PurchaseOrderFilter
+	logger  *logger.LogAdapter
+}
+
+func NewPurchaseOrderFilterHandler(service service.PurchaseOrderFilter, logger *logger.LogAdapter) *purchaseOrderFilterHandler {
+	return &purchaseOrderFilterHandler{
+		service: service,
+		logger:  logger,
+	}
+}
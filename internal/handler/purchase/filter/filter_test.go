@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockPurchase "github.com/WagaoCarvalho/backend_store_go/infra/mock/purchase"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/filter"
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newFilterHandler() (*mockPurchase.MockPurchaseOrder, *purchaseOrderFilterHandler) {
+	mockService := new(mockPurchase.MockPurchaseOrder)
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	return mockService, NewPurchaseOrderFilterHandler(mockService, logger.NewLoggerAdapter(baseLogger))
+}
+
+func TestPurchaseOrderFilterHandler_Filter(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := newFilterHandler()
+		mockService.On("Filter", mock.Anything, mock.MatchedBy(func(f *filter.PurchaseOrderFilter) bool {
+			return *f.SupplierID == 4 && *f.ProductID == 7 && f.Status == "sent" && f.CreatedFrom != nil
+		})).Return([]*model.PurchaseOrder{{ID: 1, SupplierID: 4}}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/purchase-orders/filter?supplier_id=4&product_id=7&status=sent&created_from=2026-01-01", nil)
+		w := httptest.NewRecorder()
+		h.Filter(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"total":1`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("parâmetro desconhecido", func(t *testing.T) {
+		_, h := newFilterHandler()
+		w := httptest.NewRecorder()
+		h.Filter(w, httptest.NewRequest(http.MethodGet, "/purchase-orders/filter?foo=1", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	for _, field := range []string{"supplier_id", "user_id", "product_id"} {
+		t.Run(field+" inválido", func(t *testing.T) {
+			_, h := newFilterHandler()
+			w := httptest.NewRecorder()
+			h.Filter(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/purchase-orders/filter?%s=abc", field), nil))
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+
+	t.Run("filtro inválido no serviço", func(t *testing.T) {
+		mockService, h := newFilterHandler()
+		mockService.On("Filter", mock.Anything, mock.Anything).Return(nil, errMsg.ErrInvalidFilter).Once()
+
+		w := httptest.NewRecorder()
+		h.Filter(w, httptest.NewRequest(http.MethodGet, "/purchase-orders/filter?status=foo", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService, h := newFilterHandler()
+		mockService.On("Filter", mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		h.Filter(w, httptest.NewRequest(http.MethodGet, "/purchase-orders/filter", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/purchase/order"
+)
+
+type purchaseOrderHandler struct {
+	service service.PurchaseOrderService
+	logger  *logger.LogAdapter
+}
+
+func NewPurchaseOrderHandler(service service.PurchaseOrderService, logger *logger.LogAdapter) *purchaseOrderHandler {
+	return &purchaseOrderHandler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/purchase/order"
+	dtoReceipt "github.com/WagaoCarvalho/backend_store_go/internal/dto/purchase/receipt"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *purchaseOrderHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	const ref = "[PurchaseOrderHandler - GetByID] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodGet {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	order, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, errMsg.ErrNotFound) {
+			h.logger.Warn(ctx, ref+logger.LogNotFound, map[string]any{"id": id})
+			utils.ErrorResponse(w, err, http.StatusNotFound)
+			return
+		}
+
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Pedido de compra recuperado",
+		Data:    dto.ToPurchaseOrderDTO(order),
+	})
+}
+
+func (h *purchaseOrderHandler) GetReceipts(w http.ResponseWriter, r *http.Request) {
+	const ref = "[PurchaseOrderHandler - GetReceipts] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodGet {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	receipts, err := h.service.GetReceipts(ctx, id)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"purchase_order_id": id})
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Recebimentos recuperados",
+		Data:    dtoReceipt.ToPurchaseReceiptDTOList(receipts),
+	})
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockPurchase "github.com/WagaoCarvalho/backend_store_go/infra/mock/purchase"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	modelsReceipt "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/receipt"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupHandler() (*mockPurchase.MockPurchaseOrder, *purchaseOrderHandler) {
+	mockService := new(mockPurchase.MockPurchaseOrder)
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	loggerAdapter := logger.NewLoggerAdapter(baseLogger)
+	return mockService, NewPurchaseOrderHandler(mockService, loggerAdapter)
+}
+
+func newPurchaseRequest(method, target, id string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	return mux.SetURLVars(req, map[string]string{"id": id})
+}
+
+func TestPurchaseOrderHandler_GetByID(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetByID", mock.Anything, int64(1)).Return(&models.PurchaseOrder{
+			ID:         1,
+			SupplierID: 4,
+			Status:     models.StatusSent,
+			Items:      []models.PurchaseOrderItem{{ID: 10, ProductID: 7, Quantity: 2, UnitCost: money.New(10)}},
+		}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByID(w, newPurchaseRequest(http.MethodGet, "/purchase-order/1", "1", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp map[string]interface{}
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		data := resp["data"].(map[string]interface{})
+		assert.Equal(t, "sent", data["status"])
+		assert.Len(t, data["items"], 1)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetByID(w, newPurchaseRequest(http.MethodPost, "/purchase-order/1", "1", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetByID(w, newPurchaseRequest(http.MethodGet, "/purchase-order/0", "0", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("não encontrado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetByID", mock.Anything, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByID(w, newPurchaseRequest(http.MethodGet, "/purchase-order/1", "1", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetByID", mock.Anything, int64(1)).Return(nil, errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByID(w, newPurchaseRequest(http.MethodGet, "/purchase-order/1", "1", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestPurchaseOrderHandler_GetReceipts(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetReceipts", mock.Anything, int64(1)).
+			Return([]*modelsReceipt.PurchaseReceipt{{ID: 5, PurchaseOrderID: 1, CostMethod: "latest"}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.GetReceipts(w, newPurchaseRequest(http.MethodGet, "/purchase-order/1/receipts", "1", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp map[string]interface{}
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.Len(t, resp["data"], 1)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetReceipts(w, newPurchaseRequest(http.MethodGet, "/purchase-order/x/receipts", "x", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetReceipts", mock.Anything, int64(1)).Return(nil, errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		h.GetReceipts(w, newPurchaseRequest(http.MethodGet, "/purchase-order/1/receipts", "1", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
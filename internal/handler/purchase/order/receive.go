@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/purchase/receipt"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+// Receive registra a entrada das mercadorias do pedido do path. Linhas sem
+// unit_cost assumem o custo combinado no pedido.
+func (h *purchaseOrderHandler) Receive(w http.ResponseWriter, r *http.Request) {
+	const ref = "[PurchaseOrderHandler - Receive] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	var receiptDTO dto.PurchaseReceiptDTO
+	if err := utils.FromJSON(r.Body, &receiptDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+	receiptDTO.PurchaseOrderID = id
+
+	// O recebimento é sempre atribuído ao usuário autenticado
+	receiptDTO.UserID = nil
+	if uid, err := strconv.ParseInt(contextUtils.GetUserID(ctx), 10, 64); err == nil {
+		receiptDTO.UserID = &uid
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateInit, map[string]any{"purchase_order_id": id})
+
+	created, err := h.service.Receive(ctx, dto.ToPurchaseReceiptModel(receiptDTO))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, map[string]any{"purchase_order_id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{
+		"purchase_order_id": id,
+		"receipt_id":        created.ID,
+	})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Recebimento registrado com sucesso",
+		Data:    dto.ToPurchaseReceiptDTO(created),
+	})
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	modelsReceipt "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/receipt"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPurchaseOrderHandler_Receive(t *testing.T) {
+	body := []byte(`{"purchase_order_id":99,"notes":"nota 123","items":[{"purchase_order_item_id":10,"quantity":2}]}`)
+
+	t.Run("sucesso usa o pedido do path e o usuário autenticado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Receive", mock.Anything, mock.MatchedBy(func(r *modelsReceipt.PurchaseReceipt) bool {
+			return r.PurchaseOrderID == 1 && r.UserID != nil && *r.UserID == 3 &&
+				len(r.Items) == 1 && r.Items[0].UnitCost == nil
+		})).Return(&modelsReceipt.PurchaseReceipt{ID: 5, PurchaseOrderID: 1}, nil).Once()
+
+		req := newPurchaseRequest(http.MethodPost, "/purchase-order/1/receive", "1", body)
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "3"))
+		w := httptest.NewRecorder()
+		h.Receive(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Receive(w, newPurchaseRequest(http.MethodGet, "/purchase-order/1/receive", "1", body))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Receive(w, newPurchaseRequest(http.MethodPost, "/purchase-order/0/receive", "0", body))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("json inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Receive(w, newPurchaseRequest(http.MethodPost, "/purchase-order/1/receive", "1", []byte("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"quantidade acima do pendente", errMsg.ErrInvalidQuantity, http.StatusBadRequest},
+		{"pedido não enviado", errMsg.ErrInvalidData, http.StatusBadRequest},
+		{"pedido inexistente", errMsg.ErrNotFound, http.StatusNotFound},
+		{"erro interno", errors.New("db down"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, h := setupHandler()
+			mockService.On("Receive", mock.Anything, mock.Anything).Return(nil, tc.err).Once()
+
+			w := httptest.NewRecorder()
+			h.Receive(w, newPurchaseRequest(http.MethodPost, "/purchase-order/1/receive", "1", body))
+
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
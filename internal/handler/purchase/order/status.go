@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *purchaseOrderHandler) Send(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, "[PurchaseOrderHandler - Send] ", h.service.Send)
+}
+
+func (h *purchaseOrderHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, "[PurchaseOrderHandler - Cancel] ", h.service.Cancel)
+}
+
+func (h *purchaseOrderHandler) changeStatus(
+	w http.ResponseWriter,
+	r *http.Request,
+	ref string,
+	change func(ctx context.Context, id int64) error,
+) {
+	ctx := r.Context()
+
+	if r.Method != http.MethodPatch {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	if err := change(ctx, id); err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogUpdateError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogUpdateSuccess, map[string]any{"id": id})
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPurchaseOrderHandler_Send(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Send", mock.Anything, int64(1)).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		h.Send(w, newPurchaseRequest(http.MethodPatch, "/purchase-order/1/send", "1", nil))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Send(w, newPurchaseRequest(http.MethodPost, "/purchase-order/1/send", "1", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Send(w, newPurchaseRequest(http.MethodPatch, "/purchase-order/0/send", "0", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("transição inválida", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Send", mock.Anything, int64(1)).Return(errMsg.ErrInvalidData).Once()
+
+		w := httptest.NewRecorder()
+		h.Send(w, newPurchaseRequest(http.MethodPatch, "/purchase-order/1/send", "1", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestPurchaseOrderHandler_Cancel(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Cancel", mock.Anything, int64(1)).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		h.Cancel(w, newPurchaseRequest(http.MethodPatch, "/purchase-order/1/cancel", "1", nil))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("pedido não encontrado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Cancel", mock.Anything, int64(1)).Return(errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		h.Cancel(w, newPurchaseRequest(http.MethodPatch, "/purchase-order/1/cancel", "1", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/purchase/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *purchaseOrderHandler) Create(w http.ResponseWriter, r *http.Request) {
+	const ref = "[PurchaseOrderHandler - Create] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var orderDTO dto.PurchaseOrderDTO
+	if err := utils.FromJSON(r.Body, &orderDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+	orderDTO.ID = nil
+
+	// O pedido é sempre atribuído ao usuário autenticado
+	orderDTO.UserID = nil
+	if uid, err := strconv.ParseInt(contextUtils.GetUserID(ctx), 10, 64); err == nil {
+		orderDTO.UserID = &uid
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateInit, map[string]any{"supplier_id": orderDTO.SupplierID})
+
+	created, err := h.service.Create(ctx, dto.ToPurchaseOrderModel(orderDTO))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, map[string]any{"supplier_id": orderDTO.SupplierID})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{"id": created.ID})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Pedido de compra criado com sucesso",
+		Data:    dto.ToPurchaseOrderDTO(created),
+	})
+}
+
+// Update substitui cabeçalho e linhas de um pedido em rascunho. O corpo deve
+// trazer a versão lida para detectar alterações concorrentes.
+func (h *purchaseOrderHandler) Update(w http.ResponseWriter, r *http.Request) {
+	const ref = "[PurchaseOrderHandler - Update] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPut {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	var orderDTO dto.PurchaseOrderDTO
+	if err := utils.FromJSON(r.Body, &orderDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+	orderDTO.ID = &id
+
+	h.logger.Info(ctx, ref+logger.LogUpdateInit, map[string]any{"id": id})
+
+	order := dto.ToPurchaseOrderModel(orderDTO)
+	if err := h.service.Update(ctx, order); err != nil {
+		if errors.Is(err, errMsg.ErrVersionConflict) {
+			h.logger.Warn(ctx, ref+logger.LogUpdateVersionConflict, map[string]any{"id": id})
+		} else {
+			h.logger.Error(ctx, err, ref+logger.LogUpdateError, map[string]any{"id": id})
+		}
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogUpdateSuccess, map[string]any{"id": id})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Pedido de compra atualizado com sucesso",
+		Data:    dto.ToPurchaseOrderDTO(order),
+	})
+}
+
+func (h *purchaseOrderHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	const ref = "[PurchaseOrderHandler - Delete] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodDelete {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogDeleteInit, map[string]any{"id": id})
+
+	if err := h.service.Delete(ctx, id); err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogDeleteError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogDeleteSuccess, map[string]any{"id": id})
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func statusFromError(err error) int {
+	switch {
+	case errors.Is(err, errMsg.ErrInvalidData),
+		errors.Is(err, errMsg.ErrInvalidQuantity):
+		return http.StatusBadRequest
+	case errors.Is(err, errMsg.ErrNotFound),
+		errors.Is(err, errMsg.ErrDBInvalidForeignKey):
+		return http.StatusNotFound
+	case errors.Is(err, errMsg.ErrVersionConflict),
+		errors.Is(err, errMsg.ErrDuplicate):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
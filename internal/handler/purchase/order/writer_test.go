@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPurchaseOrderHandler_Create(t *testing.T) {
+	body := []byte(`{"supplier_id":4,"user_id":99,"status":"received","items":[{"product_id":7,"quantity":2,"unit_cost":10}]}`)
+
+	t.Run("sucesso atribui o usuário autenticado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Create", mock.Anything, mock.MatchedBy(func(o *models.PurchaseOrder) bool {
+			return o.SupplierID == 4 && o.UserID != nil && *o.UserID == 3 && o.Status == "" && len(o.Items) == 1
+		})).Return(&models.PurchaseOrder{ID: 1, SupplierID: 4, Status: models.StatusDraft}, nil).Once()
+
+		req := newPurchaseRequest(http.MethodPost, "/purchase-order", "", body)
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "3"))
+		w := httptest.NewRecorder()
+		h.Create(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Create(w, newPurchaseRequest(http.MethodGet, "/purchase-order", "", body))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("json inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Create(w, newPurchaseRequest(http.MethodPost, "/purchase-order", "", []byte("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"dados inválidos", errMsg.ErrInvalidData, http.StatusBadRequest},
+		{"fornecedor inexistente", errMsg.ErrDBInvalidForeignKey, http.StatusNotFound},
+		{"produto repetido", errMsg.ErrDuplicate, http.StatusConflict},
+		{"erro interno", errors.New("db down"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, h := setupHandler()
+			mockService.On("Create", mock.Anything, mock.Anything).Return(nil, tc.err).Once()
+
+			w := httptest.NewRecorder()
+			h.Create(w, newPurchaseRequest(http.MethodPost, "/purchase-order", "", body))
+
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
+
+func TestPurchaseOrderHandler_Update(t *testing.T) {
+	body := []byte(`{"supplier_id":4,"version":2,"items":[{"product_id":7,"quantity":2,"unit_cost":10}]}`)
+
+	t.Run("sucesso usa o id do path", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Update", mock.Anything, mock.MatchedBy(func(o *models.PurchaseOrder) bool {
+			return o.ID == 1 && o.Version == 2
+		})).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		h.Update(w, newPurchaseRequest(http.MethodPut, "/purchase-order/1", "1", body))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Update(w, newPurchaseRequest(http.MethodPut, "/purchase-order/0", "0", body))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("conflito de versão", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Update", mock.Anything, mock.Anything).Return(errMsg.ErrVersionConflict).Once()
+
+		w := httptest.NewRecorder()
+		h.Update(w, newPurchaseRequest(http.MethodPut, "/purchase-order/1", "1", body))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("pedido não encontrado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Update", mock.Anything, mock.Anything).Return(errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		h.Update(w, newPurchaseRequest(http.MethodPut, "/purchase-order/1", "1", body))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestPurchaseOrderHandler_Delete(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Delete", mock.Anything, int64(1)).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		h.Delete(w, newPurchaseRequest(http.MethodDelete, "/purchase-order/1", "1", nil))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Delete(w, newPurchaseRequest(http.MethodGet, "/purchase-order/1", "1", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("pedido já enviado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Delete", mock.Anything, int64(1)).Return(errMsg.ErrInvalidData).Once()
+
+		w := httptest.NewRecorder()
+		h.Delete(w, newPurchaseRequest(http.MethodDelete, "/purchase-order/1", "1", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/sale/checkout"
+)
+
+type saleCheckoutHandler struct {
+	service service.SaleCheckout
+	logger  *logger.LogAdapter
+}
+
+func NewSaleCheckoutHandler(service service.SaleCheckout, logger *logger.LogAdapter) *saleCheckoutHandler {
+	return &saleCheckoutHandler{
+		service: service,
+		logger:  logger,
+	}
+}
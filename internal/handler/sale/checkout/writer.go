@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/checkout"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/checkout"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *saleCheckoutHandler) Checkout(w http.ResponseWriter, r *http.Request) {
+	const ref = "[SaleCheckoutHandler - Checkout] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateInit, nil)
+
+	var checkoutDTO dto.CheckoutDTO
+	if err := utils.FromJSON(r.Body, &checkoutDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	// O operador é sempre o usuário autenticado; um user_id no corpo é
+	// ignorado para que ninguém registre venda no caixa de outro
+	checkoutDTO.UserID = currentUser(ctx)
+
+	result, err := h.service.Checkout(ctx, dto.ToCheckoutModel(checkoutDTO))
+	if err != nil {
+		var checkoutErr *models.CheckoutError
+		if errors.As(err, &checkoutErr) {
+			h.logger.Warn(ctx, ref+"checkout recusado", map[string]any{"linhas": len(checkoutErr.Lines)})
+			utils.ToJSON(w, http.StatusConflict, utils.DefaultResponse{
+				Status:  http.StatusConflict,
+				Message: errMsg.ErrSaleCheckoutRejected.Error(),
+				Data:    dto.ToLineErrorDTOs(checkoutErr.Lines),
+			})
+			return
+		}
+
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, nil)
+
+		switch {
+		case errors.Is(err, errMsg.ErrInvalidData),
+			errors.Is(err, errMsg.ErrDBInvalidForeignKey):
+			utils.ErrorResponse(w, err, http.StatusBadRequest)
+			return
+		case errors.Is(err, errMsg.ErrInsufficientStock),
+			errors.Is(err, errMsg.ErrCreditLimitExceeded),
+			errors.Is(err, errMsg.ErrCashSessionNotOpen):
+			utils.ErrorResponse(w, err, http.StatusConflict)
+			return
+		}
+
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	resultDTO := dto.ToCheckoutResultDTO(result)
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{
+		"sale_id": resultDTO.Sale.ID,
+		"itens":   len(resultDTO.Items),
+	})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Venda finalizada com sucesso",
+		Data:    resultDTO,
+	})
+}
+
+// currentUser devolve o usuário autenticado, operador da venda.
+func currentUser(ctx context.Context) *int64 {
+	uid, err := strconv.ParseInt(contextUtils.GetUserID(ctx), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &uid
+}
@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocksale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/checkout"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/checkout"
+	modelsItem "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	modelsSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupHandler() (*mocksale.MockSaleCheckout, *saleCheckoutHandler) {
+	mockService := new(mocksale.MockSaleCheckout)
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	loggerAdapter := logger.NewLoggerAdapter(baseLogger)
+	h := NewSaleCheckoutHandler(mockService, loggerAdapter)
+	return mockService, h
+}
+
+func checkoutBody() []byte {
+	body, _ := json.Marshal(dto.CheckoutDTO{
+		PaymentType: "cash",
+		Items:       []dto.CheckoutItemDTO{{ProductID: 1, Quantity: 2}},
+	})
+	return body
+}
+
+func TestSaleCheckoutHandler_Checkout(t *testing.T) {
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		req := httptest.NewRequest(http.MethodGet, "/sale/checkout", nil)
+		w := httptest.NewRecorder()
+
+		h.Checkout(w, req)
+
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("erro JSON inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		req := httptest.NewRequest(http.MethodPost, "/sale/checkout", bytes.NewBufferString("{invalid"))
+		w := httptest.NewRecorder()
+
+		h.Checkout(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("sucesso atribui usuário autenticado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Checkout", mock.Anything, mock.MatchedBy(func(c *models.Checkout) bool {
+			return c.UserID != nil && *c.UserID == 42 && len(c.Items) == 1
+		})).Return(&models.CheckoutResult{
+			Sale:  &modelsSale.Sale{ID: 10, TotalAmount: money.New(20)},
+			Items: []*modelsItem.SaleItem{{ID: 1, SaleID: 10, ProductID: 1, Quantity: 2, UnitPrice: money.New(10), Subtotal: money.New(20)}},
+		}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/sale/checkout", bytes.NewBuffer(checkoutBody()))
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "42"))
+		w := httptest.NewRecorder()
+
+		h.Checkout(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"total_amount":20`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("ignora user_id do corpo", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Checkout", mock.Anything, mock.MatchedBy(func(c *models.Checkout) bool {
+			return c.UserID != nil && *c.UserID == 42
+		})).Return(&models.CheckoutResult{Sale: &modelsSale.Sale{ID: 11}}, nil).Once()
+
+		body, _ := json.Marshal(dto.CheckoutDTO{
+			UserID:      utils.Int64Ptr(99),
+			PaymentType: "cash",
+			Items:       []dto.CheckoutItemDTO{{ProductID: 1, Quantity: 2}},
+		})
+		req := httptest.NewRequest(http.MethodPost, "/sale/checkout", bytes.NewBuffer(body))
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "42"))
+		w := httptest.NewRecorder()
+
+		h.Checkout(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("checkout recusado retorna erros por linha", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Checkout", mock.Anything, mock.Anything).Return(nil, &models.CheckoutError{
+			Lines: []models.LineError{{Line: 0, ProductID: 1, Message: "estoque insuficiente"}},
+		}).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/sale/checkout", bytes.NewBuffer(checkoutBody()))
+		w := httptest.NewRecorder()
+
+		h.Checkout(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		var resp struct {
+			Data []dto.LineErrorDTO `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, []dto.LineErrorDTO{{Line: 0, ProductID: 1, Message: "estoque insuficiente"}}, resp.Data)
+	})
+
+	t.Run("dados inválidos", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Checkout", mock.Anything, mock.Anything).Return(nil, errMsg.ErrInvalidData).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/sale/checkout", bytes.NewBuffer(checkoutBody()))
+		w := httptest.NewRecorder()
+
+		h.Checkout(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("estoque insuficiente na baixa", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Checkout", mock.Anything, mock.Anything).Return(nil, errMsg.ErrInsufficientStock).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/sale/checkout", bytes.NewBuffer(checkoutBody()))
+		w := httptest.NewRecorder()
+
+		h.Checkout(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("limite de crédito excedido", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Checkout", mock.Anything, mock.Anything).Return(nil, errMsg.ErrCreditLimitExceeded).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/sale/checkout", bytes.NewBuffer(checkoutBody()))
+		w := httptest.NewRecorder()
+
+		h.Checkout(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Checkout", mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/sale/checkout", bytes.NewBuffer(checkoutBody()))
+		w := httptest.NewRecorder()
+
+		h.Checkout(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
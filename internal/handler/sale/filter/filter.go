@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strconv"
 
+	dtoPage "github.com/WagaoCarvalho/backend_store_go/internal/dto/common/page"
 	dtoFilter "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/filter"
 	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/sale"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
@@ -16,12 +17,15 @@ import (
 // Lista de parâmetros válidos para validação
 var validSaleFilterParams = map[string]bool{
 	"client_id":      true,
+	"client_cnpj_id": true,
 	"user_id":        true,
 	"status":         true,
 	"payment_type":   true,
 	"sale_date_from": true,
 	"sale_date_to":   true,
 	"limit":          true,
+	"cursor":         true,
+	"search":         true,
 	"offset":         true,
 }
 
@@ -58,6 +62,18 @@ func (h *saleFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
 		dtoFilter.ClientID = &parsed
 	}
 
+	if v := query.Get("client_cnpj_id"); v != "" {
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			h.logger.Warn(ctx, ref+"client_cnpj_id inválido", map[string]any{
+				"valor": v,
+			})
+			utils.ErrorResponse(w, fmt.Errorf("client_cnpj_id deve ser um número inteiro"), http.StatusBadRequest)
+			return
+		}
+		dtoFilter.ClientCnpjID = &parsed
+	}
+
 	// VALIDAÇÃO 3: user_id com valor inválido deve retornar erro
 	if v := query.Get("user_id"); v != "" {
 		parsed, err := strconv.ParseInt(v, 10, 64)
@@ -96,6 +112,8 @@ func (h *saleFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
 	}
 	dtoFilter.Limit = limit
 	dtoFilter.Offset = offset
+	dtoFilter.Cursor, dtoFilter.CursorMode = utils.GetCursorParam(r)
+	dtoFilter.SearchTerm = r.URL.Query().Get("search")
 
 	filter, err := dtoFilter.ToModel()
 	if err != nil {
@@ -121,7 +139,7 @@ func (h *saleFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	saleDTOs := dto.ToSaleDTOs(sales)
+	saleDTOs := dto.ToSaleDTOs(sales.Items)
 
 	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
 		"total_encontrados": len(saleDTOs),
@@ -130,9 +148,6 @@ func (h *saleFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
 	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
 		Status:  http.StatusOK,
 		Message: "Vendas listadas com sucesso",
-		Data: map[string]any{
-			"total": len(saleDTOs),
-			"items": saleDTOs,
-		},
+		Data:    dtoPage.ToPageDTO(sales, saleDTOs),
 	})
 }
@@ -10,10 +10,12 @@ import (
 	"time"
 
 	mockSale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/filter"
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -140,7 +142,7 @@ func TestSaleHandler_Filter(t *testing.T) {
 				ID:          1,
 				PaymentType: "credit",
 				Status:      "paid",
-				TotalAmount: 100,
+				TotalAmount: money.New(100),
 				SaleDate:    now,
 				CreatedAt:   now,
 				UpdatedAt:   now,
@@ -149,7 +151,7 @@ func TestSaleHandler_Filter(t *testing.T) {
 				ID:          2,
 				PaymentType: "debit",
 				Status:      "pending",
-				TotalAmount: 50,
+				TotalAmount: money.New(50),
 				SaleDate:    now,
 				CreatedAt:   now,
 				UpdatedAt:   now,
@@ -213,7 +215,7 @@ func TestSaleHandler_Filter(t *testing.T) {
 				ID:          1,
 				PaymentType: "credit",
 				Status:      "paid",
-				TotalAmount: 200,
+				TotalAmount: money.New(200),
 			},
 		}
 
@@ -249,7 +251,7 @@ func TestSaleHandler_Filter(t *testing.T) {
 				ClientID:    utils.Int64Ptr(int64(100)),
 				PaymentType: "credit",
 				Status:      "paid",
-				TotalAmount: 150.0,
+				TotalAmount: money.New(150),
 			},
 		}
 
@@ -285,7 +287,7 @@ func TestSaleHandler_Filter(t *testing.T) {
 				UserID:      utils.Int64Ptr(int64(50)),
 				PaymentType: "pix",
 				Status:      "completed",
-				TotalAmount: 75.0,
+				TotalAmount: money.New(75),
 			},
 		}
 
@@ -320,7 +322,7 @@ func TestSaleHandler_Filter(t *testing.T) {
 				ID:          1,
 				PaymentType: "cash",
 				Status:      "pending",
-				TotalAmount: 200.0,
+				TotalAmount: money.New(200),
 			},
 		}
 
@@ -357,7 +359,7 @@ func TestSaleHandler_Filter(t *testing.T) {
 				UserID:      utils.Int64Ptr(int64(50)),
 				PaymentType: "credit",
 				Status:      "completed",
-				TotalAmount: 300.0,
+				TotalAmount: money.New(300),
 			},
 		}
 
@@ -407,4 +409,41 @@ func TestSaleHandler_Filter(t *testing.T) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 		mockService.AssertExpectations(t)
 	})
+
+	t.Run("sucesso - modo cursor repassa o token e devolve next_cursor", func(t *testing.T) {
+		mockService, handler := setup()
+		page := &commonFilter.Page[*model.Sale]{
+			Items:      []*model.Sale{{ID: 7}},
+			Total:      3,
+			Limit:      1,
+			HasNext:    true,
+			NextCursor: "proximo",
+		}
+		mockService.
+			On("Filter", mock.Anything, mock.MatchedBy(func(f *filter.SaleFilter) bool {
+				return f.CursorMode && f.Cursor == ""
+			})).
+			Return(page, nil).
+			Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/sales/filter?limit=1&cursor=", nil)
+		rec := httptest.NewRecorder()
+
+		handler.Filter(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp utils.DefaultResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.NoError(t, err)
+
+		data := resp.Data.(map[string]any)
+		assert.Equal(t, float64(3), data["total"])
+		assert.Equal(t, float64(1), data["limit"])
+		assert.Equal(t, true, data["has_next"])
+		assert.Equal(t, "proximo", data["next_cursor"])
+		assert.Len(t, data["items"], 1)
+
+		mockService.AssertExpectations(t)
+	})
 }
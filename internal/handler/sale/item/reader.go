@@ -33,6 +33,11 @@ func (h *saleItemHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if saleID, ok := pathSaleID(r); ok && item.SaleID != saleID {
+		utils.ErrorResponse(w, errMsg.ErrNotFound, http.StatusNotFound)
+		return
+	}
+
 	// Converter para DTO
 	itemDTO := dto.ToSaleItemDTO(item)
 
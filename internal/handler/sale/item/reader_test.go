@@ -14,6 +14,7 @@ import (
 	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/item"
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
@@ -34,10 +35,10 @@ func TestSaleItemHandler_GetByID(t *testing.T) {
 		SaleID:      10,
 		ProductID:   20,
 		Quantity:    2,
-		UnitPrice:   50.0,
-		Discount:    5.0,
-		Tax:         2.5,
-		Subtotal:    97.5,
+		UnitPrice:   money.New(50),
+		Discount:    money.New(5),
+		Tax:         money.MustParse("2.50"),
+		Subtotal:    money.MustParse("97.50"),
 		Description: "Produto teste",
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
@@ -168,10 +169,10 @@ func TestSaleItemHandler_GetBySaleID(t *testing.T) {
 			SaleID:      10,
 			ProductID:   20,
 			Quantity:    2,
-			UnitPrice:   50.0,
-			Discount:    5.0,
-			Tax:         2.5,
-			Subtotal:    97.5,
+			UnitPrice:   money.New(50),
+			Discount:    money.New(5),
+			Tax:         money.MustParse("2.50"),
+			Subtotal:    money.MustParse("97.50"),
 			Description: "Produto teste",
 			CreatedAt:   time.Now(),
 			UpdatedAt:   time.Now(),
@@ -363,10 +364,10 @@ func TestSaleItemHandler_GetByProductID(t *testing.T) {
 			SaleID:      10,
 			ProductID:   20,
 			Quantity:    2,
-			UnitPrice:   50.0,
-			Discount:    5.0,
-			Tax:         2.5,
-			Subtotal:    97.5,
+			UnitPrice:   money.New(50),
+			Discount:    money.New(5),
+			Tax:         money.MustParse("2.50"),
+			Subtotal:    money.MustParse("97.50"),
 			Description: "Produto teste",
 			CreatedAt:   time.Now(),
 			UpdatedAt:   time.Now(),
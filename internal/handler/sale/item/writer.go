@@ -31,13 +31,22 @@ func (h *saleItemHandler) Create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	itemModel := dto.ToSaleItemModel(itemDTO)
+	if saleID, ok := pathSaleID(r); ok {
+		itemModel.SaleID = saleID
+	}
+
 	createdItem, err := h.service.Create(ctx, itemModel)
 	if err != nil {
 		h.logger.Error(ctx, err, ref+logger.LogCreateError, nil)
 
 		status := http.StatusInternalServerError
-		if errors.Is(err, errMsg.ErrInvalidData) || errors.Is(err, errMsg.ErrDBInvalidForeignKey) {
+		switch {
+		case errors.Is(err, errMsg.ErrInvalidData), errors.Is(err, errMsg.ErrDBInvalidForeignKey):
 			status = http.StatusBadRequest
+		case errors.Is(err, errMsg.ErrNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, errMsg.ErrSaleNotActive), errors.Is(err, errMsg.ErrInsufficientStock):
+			status = http.StatusConflict
 		}
 
 		utils.ErrorResponse(w, err, status)
@@ -86,6 +95,9 @@ func (h *saleItemHandler) Update(w http.ResponseWriter, r *http.Request) {
 
 	item := dto.ToSaleItemModel(itemDTO)
 	item.ID = id
+	if saleID, ok := pathSaleID(r); ok {
+		item.SaleID = saleID
+	}
 
 	err = h.service.Update(ctx, item)
 	if err != nil {
@@ -100,7 +112,9 @@ func (h *saleItemHandler) Update(w http.ResponseWriter, r *http.Request) {
 			utils.ErrorResponse(w, err, http.StatusNotFound)
 			return
 
-		case errors.Is(err, errMsg.ErrVersionConflict):
+		case errors.Is(err, errMsg.ErrVersionConflict),
+			errors.Is(err, errMsg.ErrSaleNotActive),
+			errors.Is(err, errMsg.ErrInsufficientStock):
 			utils.ErrorResponse(w, err, http.StatusConflict)
 			return
 
@@ -140,9 +154,17 @@ func (h *saleItemHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if saleID, ok := pathSaleID(r); ok {
+		item, err := h.service.GetByID(ctx, id)
+		if err != nil || item.SaleID != saleID {
+			utils.ErrorResponse(w, errMsg.ErrNotFound, http.StatusNotFound)
+			return
+		}
+	}
+
 	if err := h.service.Delete(ctx, id); err != nil {
 		h.logger.Error(ctx, err, ref+"Erro ao deletar item de venda", map[string]any{"id": id})
-		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		utils.ErrorResponse(w, err, itemMutationStatus(err))
 		return
 	}
 
@@ -167,9 +189,31 @@ func (h *saleItemHandler) DeleteBySaleID(w http.ResponseWriter, r *http.Request)
 
 	if err := h.service.DeleteBySaleID(ctx, saleID); err != nil {
 		h.logger.Error(ctx, err, ref+"Erro ao deletar itens da venda", map[string]any{"sale_id": saleID})
-		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		utils.ErrorResponse(w, err, itemMutationStatus(err))
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// pathSaleID retorna o sale_id da rota aninhada /sale/{sale_id}/items, quando presente.
+func pathSaleID(r *http.Request) (int64, bool) {
+	saleID, err := utils.GetIDParam(r, "sale_id")
+	if err != nil || saleID <= 0 {
+		return 0, false
+	}
+	return saleID, true
+}
+
+func itemMutationStatus(err error) int {
+	switch {
+	case errors.Is(err, errMsg.ErrZeroID):
+		return http.StatusBadRequest
+	case errors.Is(err, errMsg.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, errMsg.ErrSaleNotActive):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -16,6 +16,7 @@ import (
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
@@ -37,10 +38,10 @@ func TestSaleItemCreate(t *testing.T) {
 		SaleID:      10,
 		ProductID:   20,
 		Quantity:    2,
-		UnitPrice:   50.0,
-		Discount:    5.0,
-		Tax:         2.5,
-		Subtotal:    97.5,
+		UnitPrice:   money.New(50),
+		Discount:    money.New(5),
+		Tax:         money.MustParse("2.50"),
+		Subtotal:    money.MustParse("97.50"),
 		Description: "Produto teste",
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
@@ -134,10 +135,10 @@ func TestSaleItemHandler_Update(t *testing.T) {
 			SaleID:      10,
 			ProductID:   int64(20),
 			Quantity:    2,
-			UnitPrice:   50.0,
-			Discount:    5.0,
-			Tax:         2.5,
-			Subtotal:    97.5,
+			UnitPrice:   money.New(50),
+			Discount:    money.New(5),
+			Tax:         money.MustParse("2.50"),
+			Subtotal:    money.MustParse("97.50"),
 			Description: "Produto teste atualizado",
 		}
 
@@ -226,7 +227,7 @@ func TestSaleItemHandler_Update(t *testing.T) {
 			SaleID:    10,
 			ProductID: int64(20),
 			Quantity:  2,
-			UnitPrice: 50.0,
+			UnitPrice: money.New(50),
 		}
 
 		body, _ := json.Marshal(itemDTO)
@@ -250,7 +251,7 @@ func TestSaleItemHandler_Update(t *testing.T) {
 			SaleID:    int64(10),
 			ProductID: int64(20),
 			Quantity:  2,
-			UnitPrice: 50.0,
+			UnitPrice: money.New(50),
 		}
 
 		body, _ := json.Marshal(itemDTO)
@@ -274,7 +275,7 @@ func TestSaleItemHandler_Update(t *testing.T) {
 			SaleID:    int64(10),
 			ProductID: int64(20),
 			Quantity:  2,
-			UnitPrice: 50.0,
+			UnitPrice: money.New(50),
 		}
 
 		body, _ := json.Marshal(itemDTO)
@@ -299,7 +300,7 @@ func TestSaleItemHandler_Update(t *testing.T) {
 			SaleID:    int64(10),
 			ProductID: int64(20),
 			Quantity:  2,
-			UnitPrice: 50.0,
+			UnitPrice: money.New(50),
 		}
 
 		body, _ := json.Marshal(itemDTO)
@@ -341,7 +342,7 @@ func TestSaleItemHandler_Update(t *testing.T) {
 		// DTO com apenas alguns campos preenchidos
 		itemDTO := dto.SaleItemDTO{
 			Quantity: 5, // Apenas quantidade alterada
-			Discount: 10.0,
+			Discount: money.New(10),
 		}
 
 		body, _ := json.Marshal(itemDTO)
@@ -717,3 +718,132 @@ func TestSaleItemHandler_DeleteBySaleID(t *testing.T) {
 		}
 	})
 }
+
+func TestSaleItemHandler_NestedSaleRoutes(t *testing.T) {
+	ctx := context.Background()
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	log := logger.NewLoggerAdapter(baseLogger)
+
+	body, _ := json.Marshal(dto.SaleItemDTO{
+		SaleID:    99,
+		ProductID: 20,
+		Quantity:  2,
+		UnitPrice: money.New(50),
+		Subtotal:  money.New(100),
+	})
+
+	t.Run("create usa sale_id da rota", func(t *testing.T) {
+		mockService := new(mockService.MockSaleItem)
+		h := NewSaleItemHandler(mockService, log)
+
+		mockService.On("Create", mock.Anything, mock.MatchedBy(func(i *model.SaleItem) bool {
+			return i.SaleID == 10
+		})).Return(&model.SaleItem{ID: 1, SaleID: 10}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/sale/10/items", bytes.NewBuffer(body)).WithContext(ctx)
+		req = mux.SetURLVars(req, map[string]string{"sale_id": "10"})
+		w := httptest.NewRecorder()
+
+		h.Create(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("create em venda não ativa retorna 409", func(t *testing.T) {
+		mockService := new(mockService.MockSaleItem)
+		h := NewSaleItemHandler(mockService, log)
+
+		mockService.On("Create", mock.Anything, mock.Anything).Return(nil, errMsg.ErrSaleNotActive).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/sale/10/items", bytes.NewBuffer(body)).WithContext(ctx)
+		req = mux.SetURLVars(req, map[string]string{"sale_id": "10"})
+		w := httptest.NewRecorder()
+
+		h.Create(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("create em venda inexistente retorna 404", func(t *testing.T) {
+		mockService := new(mockService.MockSaleItem)
+		h := NewSaleItemHandler(mockService, log)
+
+		mockService.On("Create", mock.Anything, mock.Anything).Return(nil, errMsg.ErrNotFound).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/sale/10/items", bytes.NewBuffer(body)).WithContext(ctx)
+		req = mux.SetURLVars(req, map[string]string{"sale_id": "10"})
+		w := httptest.NewRecorder()
+
+		h.Create(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("update usa sale_id da rota e retorna 409 em venda não ativa", func(t *testing.T) {
+		mockService := new(mockService.MockSaleItem)
+		h := NewSaleItemHandler(mockService, log)
+
+		mockService.On("Update", mock.Anything, mock.MatchedBy(func(i *model.SaleItem) bool {
+			return i.ID == 5 && i.SaleID == 10
+		})).Return(errMsg.ErrSaleNotActive).Once()
+
+		req := httptest.NewRequest(http.MethodPut, "/sale/10/items/5", bytes.NewBuffer(body)).WithContext(ctx)
+		req = mux.SetURLVars(req, map[string]string{"sale_id": "10", "id": "5"})
+		w := httptest.NewRecorder()
+
+		h.Update(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("delete de item de outra venda retorna 404", func(t *testing.T) {
+		mockService := new(mockService.MockSaleItem)
+		h := NewSaleItemHandler(mockService, log)
+
+		mockService.On("GetByID", mock.Anything, int64(5)).Return(&model.SaleItem{ID: 5, SaleID: 11}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodDelete, "/sale/10/items/5", nil).WithContext(ctx)
+		req = mux.SetURLVars(req, map[string]string{"sale_id": "10", "id": "5"})
+		w := httptest.NewRecorder()
+
+		h.Delete(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockService.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("delete em venda não ativa retorna 409", func(t *testing.T) {
+		mockService := new(mockService.MockSaleItem)
+		h := NewSaleItemHandler(mockService, log)
+
+		mockService.On("GetByID", mock.Anything, int64(5)).Return(&model.SaleItem{ID: 5, SaleID: 10}, nil).Once()
+		mockService.On("Delete", mock.Anything, int64(5)).Return(errMsg.ErrSaleNotActive).Once()
+
+		req := httptest.NewRequest(http.MethodDelete, "/sale/10/items/5", nil).WithContext(ctx)
+		req = mux.SetURLVars(req, map[string]string{"sale_id": "10", "id": "5"})
+		w := httptest.NewRecorder()
+
+		h.Delete(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("delete by sale em venda não ativa retorna 409", func(t *testing.T) {
+		mockService := new(mockService.MockSaleItem)
+		h := NewSaleItemHandler(mockService, log)
+
+		mockService.On("DeleteBySaleID", mock.Anything, int64(10)).Return(errMsg.ErrSaleNotActive).Once()
+
+		req := httptest.NewRequest(http.MethodDelete, "/sale/10/items", nil).WithContext(ctx)
+		req = mux.SetURLVars(req, map[string]string{"sale_id": "10"})
+		w := httptest.NewRecorder()
+
+		h.DeleteBySaleID(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
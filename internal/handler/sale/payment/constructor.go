@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/sale/payment"
+)
+
+type salePaymentHandler struct {
+	service service.SalePaymentService
+	logger  *logger.LogAdapter
+}
+
+func NewSalePaymentHandler(service service.SalePaymentService, logger *logger.LogAdapter) *salePaymentHandler {
+	return &salePaymentHandler{
+		service: service,
+		logger:  logger,
+	}
+}
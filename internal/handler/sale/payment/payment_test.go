@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mocksale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupHandler() (*mocksale.MockSalePaymentService, *salePaymentHandler) {
+	mockService := new(mocksale.MockSalePaymentService)
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	return mockService, NewSalePaymentHandler(mockService, logger.NewLoggerAdapter(baseLogger))
+}
+
+func decodeData(t *testing.T, w *httptest.ResponseRecorder) map[string]any {
+	var resp struct {
+		Data map[string]any `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	return resp.Data
+}
+
+func TestSalePaymentHandler_GetBySaleID(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		summary := models.NewSummary(7, money.New(100), []*models.SalePayment{
+			{ID: 1, SaleID: 7, Method: "cash", Amount: money.New(50), ChangeGiven: money.New(10)},
+		})
+		mockService.On("GetBySaleID", mock.Anything, int64(7)).Return(summary, nil)
+
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/sale/7/payments", nil), map[string]string{"id": "7"})
+		w := httptest.NewRecorder()
+
+		h.GetBySaleID(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		data := decodeData(t, w)
+		assert.Equal(t, 40.0, data["paid_amount"])
+		assert.Equal(t, 60.0, data["balance"])
+		assert.Equal(t, false, data["paid"])
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/sale/0/payments", nil), map[string]string{"id": "0"})
+		w := httptest.NewRecorder()
+
+		h.GetBySaleID(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("venda não encontrada", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetBySaleID", mock.Anything, int64(7)).Return(nil, errMsg.ErrNotFound)
+
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/sale/7/payments", nil), map[string]string{"id": "7"})
+		w := httptest.NewRecorder()
+
+		h.GetBySaleID(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestSalePaymentHandler_Add(t *testing.T) {
+	body := []byte(`{"method": "cash", "amount": "50.00"}`)
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		summary := models.NewSummary(7, money.New(40), []*models.SalePayment{
+			{ID: 1, SaleID: 7, Method: "cash", Amount: money.New(50), ChangeGiven: money.New(10)},
+		})
+		mockService.On("Add", mock.Anything, mock.MatchedBy(func(p *models.SalePayment) bool {
+			return p.SaleID == 7 && p.Method == "cash" && p.Amount == money.New(50) && *p.ReceivedBy == 42
+		})).Return(summary, nil)
+
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodPost, "/sale/7/payments", bytes.NewReader(body)), map[string]string{"id": "7"})
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "42"))
+		w := httptest.NewRecorder()
+
+		h.Add(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, true, decodeData(t, w)["paid"])
+	})
+
+	t.Run("método não permitido", func(t *testing.T) {
+		_, h := setupHandler()
+		req := httptest.NewRequest(http.MethodPut, "/sale/7/payments", nil)
+		w := httptest.NewRecorder()
+
+		h.Add(w, req)
+
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("JSON inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodPost, "/sale/7/payments", bytes.NewBufferString("{")), map[string]string{"id": "7"})
+		w := httptest.NewRecorder()
+
+		h.Add(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("mapeia erros do serviço", func(t *testing.T) {
+		cases := []struct {
+			err    error
+			status int
+		}{
+			{errMsg.ErrInvalidData, http.StatusBadRequest},
+			{errMsg.ErrNotFound, http.StatusNotFound},
+			{errMsg.ErrSaleNotActive, http.StatusConflict},
+			{errMsg.ErrSaleAlreadyPaid, http.StatusConflict},
+			{errMsg.ErrCashSessionNotOpen, http.StatusConflict},
+			{errMsg.ErrCreate, http.StatusInternalServerError},
+		}
+		for _, c := range cases {
+			mockService, h := setupHandler()
+			mockService.On("Add", mock.Anything, mock.Anything).Return(nil, c.err)
+
+			req := mux.SetURLVars(httptest.NewRequest(http.MethodPost, "/sale/7/payments", bytes.NewReader(body)), map[string]string{"id": "7"})
+			w := httptest.NewRecorder()
+
+			h.Add(w, req)
+
+			assert.Equal(t, c.status, w.Code, c.err.Error())
+		}
+	})
+}
+
+func TestSalePaymentHandler_TotalsByMethod(t *testing.T) {
+	t.Run("período informado com to inclusivo", func(t *testing.T) {
+		mockService, h := setupHandler()
+		from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+		to := time.Date(2026, 11, 1, 0, 0, 0, 0, time.Local)
+		mockService.On("TotalsByMethod", mock.Anything, from, to).Return([]*models.MethodTotal{
+			{Method: "pix", Sales: 2, Amount: money.New(80)},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/sales/payments/totals?from=2026-10-01&to=2026-10-31", nil)
+		w := httptest.NewRecorder()
+
+		h.TotalsByMethod(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("sem datas usa o dia corrente", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("TotalsByMethod", mock.Anything, mock.MatchedBy(func(from time.Time) bool {
+			return from.Hour() == 0 && from.Minute() == 0
+		}), mock.Anything).Return([]*models.MethodTotal{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/sales/payments/totals", nil)
+		w := httptest.NewRecorder()
+
+		h.TotalsByMethod(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("parâmetros inválidos", func(t *testing.T) {
+		for _, query := range []string{"?foo=1", "?from=ontem", "?to=31/10/2026"} {
+			_, h := setupHandler()
+			req := httptest.NewRequest(http.MethodGet, "/sales/payments/totals"+query, nil)
+			w := httptest.NewRecorder()
+
+			h.TotalsByMethod(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		}
+	})
+
+	t.Run("período inválido no serviço", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("TotalsByMethod", mock.Anything, mock.Anything, mock.Anything).Return(nil, errMsg.ErrInvalidFilter)
+
+		req := httptest.NewRequest(http.MethodGet, "/sales/payments/totals?from=2026-10-31&to=2026-10-01", nil)
+		w := httptest.NewRecorder()
+
+		h.TotalsByMethod(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
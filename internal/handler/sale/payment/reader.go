@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/payment"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+var validTotalsParams = map[string]bool{"from": true, "to": true}
+
+// GetBySaleID atende GET /sale/{id}/payments com os pagamentos e o saldo.
+func (h *salePaymentHandler) GetBySaleID(w http.ResponseWriter, r *http.Request) {
+	const ref = "[SalePaymentHandler - GetBySaleID] "
+	ctx := r.Context()
+
+	id, ok := h.pathID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"sale_id": id})
+
+	summary, err := h.service.GetBySaleID(ctx, id)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"sale_id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"sale_id":           id,
+		"total_encontrados": len(summary.Payments),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Pagamentos da venda recuperados com sucesso",
+		Data:    dto.ToSummaryDTO(summary),
+	})
+}
+
+// TotalsByMethod atende GET /sales/payments/totals?from=&to=. Sem datas vale o
+// dia corrente; to em AAAA-MM-DD inclui o dia inteiro.
+func (h *salePaymentHandler) TotalsByMethod(w http.ResponseWriter, r *http.Request) {
+	const ref = "[SalePaymentHandler - TotalsByMethod] "
+	ctx := r.Context()
+
+	if !h.checkParams(w, r, ref, validTotalsParams) {
+		return
+	}
+	query := r.URL.Query()
+
+	now := time.Now()
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	to := from.AddDate(0, 0, 1)
+
+	if v := query.Get("from"); v != "" {
+		t, err := parseBound(v, false)
+		if err != nil {
+			h.badParam(w, r, ref, fmt.Errorf("from: %w", err))
+			return
+		}
+		from = t
+	}
+	if v := query.Get("to"); v != "" {
+		t, err := parseBound(v, true)
+		if err != nil {
+			h.badParam(w, r, ref, fmt.Errorf("to: %w", err))
+			return
+		}
+		to = t
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{
+		"from": from,
+		"to":   to,
+	})
+
+	totals, err := h.service.TotalsByMethod(ctx, from, to)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, nil)
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"total_encontrados": len(totals),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Totais por forma de pagamento recuperados com sucesso",
+		Data:    dto.ToMethodTotalDTOs(totals),
+	})
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/payment"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+// Add atende POST /sale/{id}/payments, registrando um pagamento posterior
+// contra o saldo da venda no caixa do usuário autenticado.
+func (h *salePaymentHandler) Add(w http.ResponseWriter, r *http.Request) {
+	const ref = "[SalePaymentHandler - Add] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := h.pathID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	var paymentDTO dto.SalePaymentDTO
+	if err := utils.FromJSON(r.Body, &paymentDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	payment := dto.ToSalePaymentModel(paymentDTO)
+	payment.SaleID = id
+	payment.ReceivedBy = currentUser(ctx)
+
+	h.logger.Info(ctx, ref+logger.LogCreateInit, map[string]any{
+		"sale_id": id,
+		"method":  payment.Method,
+	})
+
+	summary, err := h.service.Add(ctx, &payment)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, map[string]any{"sale_id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{
+		"sale_id": id,
+		"balance": summary.Balance(),
+	})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Pagamento registrado com sucesso",
+		Data:    dto.ToSummaryDTO(summary),
+	})
+}
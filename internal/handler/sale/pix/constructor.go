@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/sale/pix"
+)
+
+type pixChargeHandler struct {
+	service service.PixChargeService
+	logger  *logger.LogAdapter
+}
+
+func NewPixChargeHandler(service service.PixChargeService, logger *logger.LogAdapter) *pixChargeHandler {
+	return &pixChargeHandler{
+		service: service,
+		logger:  logger,
+	}
+}
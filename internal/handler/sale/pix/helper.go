@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/pix"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+// Limites do lado do PNG do QR Code, em pixels.
+const (
+	minQRSize = 64
+	maxQRSize = 1024
+)
+
+func (h *pixChargeHandler) pathID(w http.ResponseWriter, r *http.Request, ref string) (int64, bool) {
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(r.Context(), ref+logger.LogInvalidID, map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
+// currentUser devolve o usuário autenticado, que gera a cobrança.
+func currentUser(ctx context.Context) *int64 {
+	uid, err := strconv.ParseInt(contextUtils.GetUserID(ctx), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &uid
+}
+
+func statusFromError(err error) int {
+	switch {
+	case errors.Is(err, errMsg.ErrInvalidData),
+		errors.Is(err, errMsg.ErrZeroID),
+		errors.Is(err, pix.ErrInvalidNotification):
+		return http.StatusBadRequest
+	case errors.Is(err, errMsg.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, errMsg.ErrSaleNotActive),
+		errors.Is(err, errMsg.ErrPaymentExceedsDue):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
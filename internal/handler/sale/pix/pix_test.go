@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mocksale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/pix"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupHandler() (*mocksale.MockPixChargeService, *pixChargeHandler) {
+	mockService := new(mocksale.MockPixChargeService)
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	return mockService, NewPixChargeHandler(mockService, logger.NewLoggerAdapter(baseLogger))
+}
+
+func newRequest(method, target string, vars map[string]string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	return mux.SetURLVars(req, vars)
+}
+
+func TestPixChargeHandler_Create(t *testing.T) {
+	now := time.Now()
+	charge := &models.PixCharge{ID: 1, SaleID: 10, TxID: "TX1", Amount: money.New(20), Status: models.StatusPending, Payload: "000201", CreatedAt: now, UpdatedAt: now}
+
+	t.Run("sucesso sem corpo cobra o total", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Create", mock.Anything, int64(10), (*money.Money)(nil), mock.MatchedBy(func(u *int64) bool {
+			return u != nil && *u == 5
+		})).Return(charge, nil).Once()
+
+		req := newRequest(http.MethodPost, "/sale/10/pix", map[string]string{"id": "10"}, nil)
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "5"))
+		w := httptest.NewRecorder()
+		h.Create(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"payload":"000201"`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("sucesso com valor parcial", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Create", mock.Anything, int64(10), mock.MatchedBy(func(a *money.Money) bool {
+			return a != nil && *a == money.New(20)
+		}), mock.Anything).Return(charge, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.Create(w, newRequest(http.MethodPost, "/sale/10/pix", map[string]string{"id": "10"}, []byte(`{"amount":20}`)))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Create(w, newRequest(http.MethodGet, "/sale/10/pix", map[string]string{"id": "10"}, nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Create(w, newRequest(http.MethodPost, "/sale/x/pix", map[string]string{"id": "x"}, nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("json inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Create(w, newRequest(http.MethodPost, "/sale/10/pix", map[string]string{"id": "10"}, []byte("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("venda não ativa", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Create", mock.Anything, int64(10), mock.Anything, mock.Anything).Return(nil, errMsg.ErrSaleNotActive).Once()
+
+		w := httptest.NewRecorder()
+		h.Create(w, newRequest(http.MethodPost, "/sale/10/pix", map[string]string{"id": "10"}, nil))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("venda inexistente", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Create", mock.Anything, int64(10), mock.Anything, mock.Anything).Return(nil, errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		h.Create(w, newRequest(http.MethodPost, "/sale/10/pix", map[string]string{"id": "10"}, nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestPixChargeHandler_GetBySaleID(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetBySaleID", mock.Anything, int64(10)).Return([]*models.PixCharge{{ID: 1, TxID: "TX1"}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.GetBySaleID(w, newRequest(http.MethodGet, "/sale/10/pix", map[string]string{"id": "10"}, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"txid":"TX1"`)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetBySaleID", mock.Anything, int64(10)).Return(nil, errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		h.GetBySaleID(w, newRequest(http.MethodGet, "/sale/10/pix", map[string]string{"id": "10"}, nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestPixChargeHandler_QRCode(t *testing.T) {
+	t.Run("devolve o png", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("QRCode", mock.Anything, "TX1", 300).Return([]byte("\x89PNG"), nil).Once()
+
+		w := httptest.NewRecorder()
+		h.QRCode(w, newRequest(http.MethodGet, "/pix/TX1/qrcode?size=300", map[string]string{"txid": "TX1"}, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+		assert.Equal(t, "\x89PNG", w.Body.String())
+	})
+
+	t.Run("tamanho inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		for _, size := range []string{"abc", "10", "5000"} {
+			w := httptest.NewRecorder()
+			h.QRCode(w, newRequest(http.MethodGet, "/pix/TX1/qrcode?size="+size, map[string]string{"txid": "TX1"}, nil))
+			assert.Equal(t, http.StatusBadRequest, w.Code, size)
+		}
+	})
+
+	t.Run("cobrança inexistente", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("QRCode", mock.Anything, "TX9", 0).Return(nil, errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		h.QRCode(w, newRequest(http.MethodGet, "/pix/TX9/qrcode", map[string]string{"txid": "TX9"}, nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestPixChargeHandler_Webhook(t *testing.T) {
+	body := []byte(`{"pix":[]}`)
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Notify", mock.Anything, mock.Anything, body).Return([]*models.PixCharge{{TxID: "TX1"}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.Webhook(w, newRequest(http.MethodPost, "/pix/webhook", nil, body))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Webhook(w, newRequest(http.MethodGet, "/pix/webhook", nil, nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("aviso inválido", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Notify", mock.Anything, mock.Anything, body).Return(nil, pix.ErrInvalidNotification).Once()
+
+		w := httptest.NewRecorder()
+		h.Webhook(w, newRequest(http.MethodPost, "/pix/webhook", nil, body))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Notify", mock.Anything, mock.Anything, body).Return(nil, errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		h.Webhook(w, newRequest(http.MethodPost, "/pix/webhook", nil, body))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
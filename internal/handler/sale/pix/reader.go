@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/pix"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	"github.com/gorilla/mux"
+)
+
+// GetBySaleID atende GET /sale/{id}/pix com as cobranças Pix da venda.
+func (h *pixChargeHandler) GetBySaleID(w http.ResponseWriter, r *http.Request) {
+	const ref = "[PixChargeHandler - GetBySaleID] "
+	ctx := r.Context()
+
+	id, ok := h.pathID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"sale_id": id})
+
+	charges, err := h.service.GetBySaleID(ctx, id)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"sale_id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"sale_id": id,
+		"total":   len(charges),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Cobranças Pix listadas com sucesso",
+		Data:    dto.ToPixChargeDTOs(charges),
+	})
+}
+
+// QRCode atende GET /pix/{txid}/qrcode com o PNG do BR Code. O parâmetro
+// opcional size define o lado da imagem em pixels.
+func (h *pixChargeHandler) QRCode(w http.ResponseWriter, r *http.Request) {
+	const ref = "[PixChargeHandler - QRCode] "
+	ctx := r.Context()
+
+	txID := mux.Vars(r)["txid"]
+
+	size := 0
+	if v := r.URL.Query().Get("size"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < minQRSize || parsed > maxQRSize {
+			h.logger.Warn(ctx, ref+logger.LogInvalidParam, map[string]any{"size": v})
+			utils.ErrorResponse(w, fmt.Errorf("size deve ser um inteiro entre %d e %d", minQRSize, maxQRSize), http.StatusBadRequest)
+			return
+		}
+		size = parsed
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"txid": txID})
+
+	png, err := h.service.QRCode(ctx, txID, size)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"txid": txID})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Content-Length", strconv.Itoa(len(png)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(png)
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/pix"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+// Create atende POST /sale/{id}/pix, gerando o BR Code da venda. O corpo é
+// opcional; sem amount a cobrança é do total da venda.
+func (h *pixChargeHandler) Create(w http.ResponseWriter, r *http.Request) {
+	const ref = "[PixChargeHandler - Create] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := h.pathID(w, r, ref)
+	if !ok {
+		return
+	}
+
+	var input dto.PixChargeInputDTO
+	if err := utils.FromJSON(r.Body, &input); err != nil && !errors.Is(err, io.EOF) {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateInit, map[string]any{"sale_id": id})
+
+	charge, err := h.service.Create(ctx, id, input.Amount, currentUser(ctx))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, map[string]any{"sale_id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{
+		"sale_id": id,
+		"txid":    charge.TxID,
+	})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Cobrança Pix gerada com sucesso",
+		Data:    dto.ToPixChargeDTO(charge),
+	})
+}
+
+// Webhook atende POST /pix/webhook, o aviso de pagamento do PSP. A rota é
+// pública; o provedor autentica o aviso.
+func (h *pixChargeHandler) Webhook(w http.ResponseWriter, r *http.Request) {
+	const ref = "[PixChargeHandler - Webhook] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		h.logger.Warn(ctx, ref+"falha ao ler o aviso", map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	confirmed, err := h.service.Notify(ctx, r.Header, body)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogUpdateError, nil)
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	txIDs := make([]string, len(confirmed))
+	for i, c := range confirmed {
+		txIDs[i] = c.TxID
+	}
+	h.logger.Info(ctx, ref+logger.LogUpdateSuccess, map[string]any{"confirmadas": txIDs})
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/sale/receipt"
+)
+
+type saleReceiptHandler struct {
+	service service.SaleReceiptService
+	logger  *logger.LogAdapter
+}
+
+func NewSaleReceiptHandler(service service.SaleReceiptService, logger *logger.LogAdapter) *saleReceiptHandler {
+	return &saleReceiptHandler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/receipt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+// Extensão do arquivo sugerido para download em cada formato.
+var extensions = map[string]string{
+	receipt.FormatText:   "txt",
+	receipt.FormatPDF:    "pdf",
+	receipt.FormatESCPOS: "bin",
+}
+
+// GetBySaleID atende GET /sale/{id}/receipt?format=txt|pdf|escpos com o
+// comprovante da venda. Sem format devolve texto.
+func (h *saleReceiptHandler) GetBySaleID(w http.ResponseWriter, r *http.Request) {
+	const ref = "[SaleReceiptHandler - GetBySaleID] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodGet {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(ctx, ref+logger.LogInvalidID, map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = receipt.FormatText
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"sale_id": id, "format": format})
+
+	out, err := h.service.Render(ctx, id, format)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"sale_id": id, "format": format})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{"sale_id": id, "format": format})
+
+	w.Header().Set("Content-Type", receipt.ContentType(format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`inline; filename="venda-%d.%s"`, id, extensions[format]))
+	w.Header().Set("Content-Length", strconv.Itoa(len(out)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(out)
+}
+
+func statusFromError(err error) int {
+	switch {
+	case errors.Is(err, errMsg.ErrInvalidData),
+		errors.Is(err, errMsg.ErrZeroID):
+		return http.StatusBadRequest
+	case errors.Is(err, errMsg.ErrNotFound):
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
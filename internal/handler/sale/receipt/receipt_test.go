@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocksale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/receipt"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupHandler() (*mocksale.MockSaleReceiptService, *saleReceiptHandler) {
+	mockService := new(mocksale.MockSaleReceiptService)
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	return mockService, NewSaleReceiptHandler(mockService, logger.NewLoggerAdapter(baseLogger))
+}
+
+func newRequest(method, target, id string) *http.Request {
+	req := httptest.NewRequest(method, target, nil)
+	return mux.SetURLVars(req, map[string]string{"id": id})
+}
+
+func TestSaleReceiptHandler_GetBySaleID(t *testing.T) {
+	t.Run("texto por padrão", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Render", mock.Anything, int64(10), receipt.FormatText).Return([]byte("COMPROVANTE\n"), nil).Once()
+
+		w := httptest.NewRecorder()
+		h.GetBySaleID(w, newRequest(http.MethodGet, "/sale/10/receipt", "10"))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, `inline; filename="venda-10.txt"`, w.Header().Get("Content-Disposition"))
+		assert.Equal(t, "COMPROVANTE\n", w.Body.String())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("pdf", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Render", mock.Anything, int64(10), receipt.FormatPDF).Return([]byte("%PDF-1.3"), nil).Once()
+
+		w := httptest.NewRecorder()
+		h.GetBySaleID(w, newRequest(http.MethodGet, "/sale/10/receipt?format=pdf", "10"))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+		assert.Equal(t, `inline; filename="venda-10.pdf"`, w.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("escpos", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Render", mock.Anything, int64(10), receipt.FormatESCPOS).Return([]byte{0x1b, '@'}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.GetBySaleID(w, newRequest(http.MethodGet, "/sale/10/receipt?format=escpos", "10"))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/octet-stream", w.Header().Get("Content-Type"))
+		assert.Equal(t, []byte{0x1b, '@'}, w.Body.Bytes())
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetBySaleID(w, newRequest(http.MethodPost, "/sale/10/receipt", "10"))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetBySaleID(w, newRequest(http.MethodGet, "/sale/x/receipt", "x"))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("formato inválido", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Render", mock.Anything, int64(10), "html").Return(nil, errMsg.ErrInvalidData).Once()
+
+		w := httptest.NewRecorder()
+		h.GetBySaleID(w, newRequest(http.MethodGet, "/sale/10/receipt?format=html", "10"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("venda inexistente", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Render", mock.Anything, int64(10), receipt.FormatText).Return(nil, errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		h.GetBySaleID(w, newRequest(http.MethodGet, "/sale/10/receipt", "10"))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Render", mock.Anything, int64(10), receipt.FormatText).Return(nil, errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		h.GetBySaleID(w, newRequest(http.MethodGet, "/sale/10/receipt", "10"))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	dtoSale "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/sale"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 )
 
 func TestSaleHandler_GetByID(t *testing.T) {
@@ -160,7 +161,7 @@ func TestSaleHandler_GetByUserID(t *testing.T) {
 	t.Run("sucesso", func(t *testing.T) {
 		mockService, h := setupHandler()
 		saleModel := []*dtoSale.SaleDTO{
-			{ID: utils.Int64Ptr(1), UserID: utils.Int64Ptr(1), SaleDate: &now, TotalAmount: 100.0, PaymentType: "cash", Status: "active"},
+			{ID: utils.Int64Ptr(1), UserID: utils.Int64Ptr(1), SaleDate: &now, TotalAmount: money.New(100), PaymentType: "cash", Status: "active"},
 		}
 
 		req := httptest.NewRequest(http.MethodGet, "/sale/user/1", nil)
@@ -263,7 +264,7 @@ func TestSaleHandler_GetByDateRange(t *testing.T) {
 		mockService, h := setupHandler()
 
 		salesDTO := []*dtoSale.SaleDTO{
-			{ID: utils.Int64Ptr(1), UserID: utils.Int64Ptr(1), SaleDate: &now, TotalAmount: 100.0, PaymentType: "cash", Status: "active"},
+			{ID: utils.Int64Ptr(1), UserID: utils.Int64Ptr(1), SaleDate: &now, TotalAmount: money.New(100), PaymentType: "cash", Status: "active"},
 		}
 
 		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/sale/daterange/%s/%s", start, end), nil)
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/sale_return"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *saleHandler) ReturnItems(w http.ResponseWriter, r *http.Request) {
+	const ref = "[SaleHandler - ReturnItems] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	var returnDTO dto.SaleReturnDTO
+	if err := utils.FromJSON(r.Body, &returnDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+	returnDTO.SaleID = id
+
+	// Sem user_id explícito, a devolução é atribuída ao usuário autenticado
+	if returnDTO.UserID == nil {
+		if uid, err := strconv.ParseInt(contextUtils.GetUserID(ctx), 10, 64); err == nil {
+			returnDTO.UserID = &uid
+		}
+	}
+
+	created, err := h.service.ReturnItems(ctx, dto.ToSaleReturnModel(returnDTO))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+"Erro ao registrar devolução", map[string]any{"sale_id": id})
+
+		switch {
+		case errors.Is(err, errMsg.ErrInvalidData):
+			utils.ErrorResponse(w, err, http.StatusBadRequest)
+			return
+		case errors.Is(err, errMsg.ErrNotFound):
+			utils.ErrorResponse(w, err, http.StatusNotFound)
+			return
+		case errors.Is(err, errMsg.ErrCashSessionNotOpen):
+			utils.ErrorResponse(w, err, http.StatusConflict)
+			return
+		}
+
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Devolução registrada com sucesso",
+		Data:    dto.ToSaleReturnDTO(created),
+	})
+}
+
+func (h *saleHandler) GetReturns(w http.ResponseWriter, r *http.Request) {
+	const ref = "[SaleHandler - GetReturns] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodGet {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	returns, err := h.service.GetReturns(ctx, id)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+"Erro ao buscar devoluções", map[string]any{"sale_id": id})
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Devoluções recuperadas",
+		Data:    dto.ToSaleReturnDTOList(returns),
+	})
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	modelsReturn "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newReturnRequest(method, id string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, "/sale/"+id+"/returns", bytes.NewReader(body))
+	return mux.SetURLVars(req, map[string]string{"id": id})
+}
+
+func TestSaleHandler_ReturnItems(t *testing.T) {
+	body := []byte(`{"reason":"defeito","items":[{"sale_item_id":10,"quantity":1}]}`)
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("ReturnItems", mock.Anything, mock.MatchedBy(func(r *modelsReturn.SaleReturn) bool {
+			return r.SaleID == 1 && r.Reason == "defeito" && len(r.Items) == 1 && r.Items[0].SaleItemID == 10
+		})).Return(&modelsReturn.SaleReturn{ID: 3, SaleID: 1, TotalAmount: money.New(9)}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.ReturnItems(w, newReturnRequest(http.MethodPost, "1", body))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resp map[string]interface{}
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.Equal(t, "Devolução registrada com sucesso", resp["message"])
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.ReturnItems(w, newReturnRequest(http.MethodGet, "1", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.ReturnItems(w, newReturnRequest(http.MethodPost, "0", body))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("json inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.ReturnItems(w, newReturnRequest(http.MethodPost, "1", []byte("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"dados inválidos", errMsg.ErrInvalidData, http.StatusBadRequest},
+		{"venda não encontrada", errMsg.ErrNotFound, http.StatusNotFound},
+		{"operador sem caixa aberto", errMsg.ErrCashSessionNotOpen, http.StatusConflict},
+		{"erro genérico", errors.New("db error"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, h := setupHandler()
+			mockService.On("ReturnItems", mock.Anything, mock.Anything).Return(nil, tc.err).Once()
+
+			w := httptest.NewRecorder()
+			h.ReturnItems(w, newReturnRequest(http.MethodPost, "1", body))
+
+			assert.Equal(t, tc.status, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSaleHandler_GetReturns(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetReturns", mock.Anything, int64(1)).
+			Return([]*modelsReturn.SaleReturn{{ID: 1, SaleID: 1}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.GetReturns(w, newReturnRequest(http.MethodGet, "1", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp map[string]interface{}
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.Equal(t, "Devoluções recuperadas", resp["message"])
+		assert.Len(t, resp["data"], 1)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetReturns(w, newReturnRequest(http.MethodPost, "1", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetReturns(w, newReturnRequest(http.MethodGet, "abc", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("erro do service", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetReturns", mock.Anything, int64(1)).Return(nil, errors.New("db error")).Once()
+
+		w := httptest.NewRecorder()
+		h.GetReturns(w, newReturnRequest(http.MethodGet, "1", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
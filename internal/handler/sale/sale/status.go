@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -63,6 +64,10 @@ func (h *saleHandler) Cancel(w http.ResponseWriter, r *http.Request) {
 
 	if err := h.service.Cancel(ctx, id); err != nil {
 		h.logger.Error(ctx, err, ref+"Erro ao cancelar venda", nil)
+		if errors.Is(err, errMsg.ErrCashSessionNotOpen) {
+			utils.ErrorResponse(w, err, http.StatusConflict)
+			return
+		}
 		utils.ErrorResponse(w, err, http.StatusInternalServerError)
 		return
 	}
@@ -88,6 +93,10 @@ func (h *saleHandler) Complete(w http.ResponseWriter, r *http.Request) {
 
 	if err := h.service.Complete(ctx, id); err != nil {
 		h.logger.Error(ctx, err, ref+"Erro ao completar venda", nil)
+		if errors.Is(err, errMsg.ErrPixNotConfirmed) || errors.Is(err, errMsg.ErrPaymentInsufficient) {
+			utils.ErrorResponse(w, err, http.StatusConflict)
+			return
+		}
 		utils.ErrorResponse(w, err, http.StatusInternalServerError)
 		return
 	}
@@ -113,6 +122,10 @@ func (h *saleHandler) Returned(w http.ResponseWriter, r *http.Request) {
 
 	if err := h.service.Returned(ctx, id); err != nil {
 		h.logger.Error(ctx, err, ref+"Erro ao marcar venda como devolvida", nil)
+		if errors.Is(err, errMsg.ErrCashSessionNotOpen) {
+			utils.ErrorResponse(w, err, http.StatusConflict)
+			return
+		}
 		utils.ErrorResponse(w, err, http.StatusInternalServerError)
 		return
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	dtoSale "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/sale"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 )
 
 func TestSaleHandler_GetByStatus(t *testing.T) {
@@ -26,7 +27,7 @@ func TestSaleHandler_GetByStatus(t *testing.T) {
 
 		// DTO de exemplo
 		saleDTOs := []*dtoSale.SaleDTO{
-			{ID: utils.Int64Ptr(1), UserID: utils.Int64Ptr(1), SaleDate: &now, TotalAmount: 100.0, PaymentType: "cash", Status: "active"},
+			{ID: utils.Int64Ptr(1), UserID: utils.Int64Ptr(1), SaleDate: &now, TotalAmount: money.New(100), PaymentType: "cash", Status: "active"},
 		}
 
 		// Path param
@@ -124,6 +125,19 @@ func TestSaleHandler_Cancel(t *testing.T) {
 		svc.AssertExpectations(t)
 	})
 
+	t.Run("operador sem caixa aberto", func(t *testing.T) {
+		svc, h := setupHandler()
+		svc.On("Cancel", mock.Anything, int64(1)).Return(errMsg.ErrCashSessionNotOpen).Once()
+
+		req := httptest.NewRequest(http.MethodPatch, "/sale/cancel/1", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": "1"})
+		w := httptest.NewRecorder()
+
+		h.Cancel(w, req)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		svc.AssertExpectations(t)
+	})
+
 	t.Run("sucesso", func(t *testing.T) {
 		svc, h := setupHandler()
 		svc.On("Cancel", mock.Anything, int64(2)).Return(nil).Once()
@@ -176,6 +190,19 @@ func TestSaleHandler_Complete(t *testing.T) {
 		svc.AssertExpectations(t)
 	})
 
+	t.Run("venda não quitada", func(t *testing.T) {
+		svc, h := setupHandler()
+		svc.On("Complete", mock.Anything, int64(3)).Return(errMsg.ErrPaymentInsufficient).Once()
+
+		req := httptest.NewRequest(http.MethodPatch, "/sale/complete/3", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": "3"})
+		w := httptest.NewRecorder()
+
+		h.Complete(w, req)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		svc.AssertExpectations(t)
+	})
+
 	t.Run("sucesso", func(t *testing.T) {
 		svc, h := setupHandler()
 		svc.On("Complete", mock.Anything, int64(2)).Return(nil).Once()
@@ -236,6 +263,19 @@ func TestSaleHandler_Returned(t *testing.T) {
 		svc.AssertExpectations(t)
 	})
 
+	t.Run("erro do serviço - operador sem caixa aberto", func(t *testing.T) {
+		svc, h := setupHandler()
+		svc.On("Returned", mock.Anything, int64(1)).Return(errMsg.ErrCashSessionNotOpen).Once()
+
+		req := httptest.NewRequest(http.MethodPatch, "/sale/returned/1", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": "1"})
+		w := httptest.NewRecorder()
+
+		h.Returned(w, req)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		svc.AssertExpectations(t)
+	})
+
 	t.Run("erro do serviço - sale não concluída", func(t *testing.T) {
 		svc, h := setupHandler()
 		svc.On("Returned", mock.Anything, int64(2)).Return(fmt.Errorf("%w: somente vendas concluídas podem ser devolvidas", errMsg.ErrInvalidData)).Once()
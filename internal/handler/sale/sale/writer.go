@@ -1,13 +1,16 @@
 package handler
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	dtoSale "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/sale"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 )
 
@@ -30,6 +33,10 @@ func (h *saleHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// O operador é sempre o usuário autenticado; um user_id no corpo é
+	// ignorado para que ninguém registre venda no caixa de outro
+	saleDTO.UserID = currentUser(ctx)
+
 	saleModel := dtoSale.ToSaleModel(saleDTO)
 
 	createdModel, err := h.service.Create(ctx, saleModel)
@@ -45,7 +52,8 @@ func (h *saleHandler) Create(w http.ResponseWriter, r *http.Request) {
 			utils.ErrorResponse(w, err, http.StatusBadRequest)
 			return
 
-		case errors.Is(err, errMsg.ErrDuplicate):
+		case errors.Is(err, errMsg.ErrDuplicate),
+			errors.Is(err, errMsg.ErrCashSessionNotOpen):
 			utils.ErrorResponse(w, err, http.StatusConflict)
 			return
 		}
@@ -159,3 +167,12 @@ func (h *saleHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	h.logger.Info(ctx, ref+logger.LogDeleteSuccess, map[string]any{"sale_id": id})
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// currentUser devolve o usuário autenticado, operador da venda.
+func currentUser(ctx context.Context) *int64 {
+	uid, err := strconv.ParseInt(contextUtils.GetUserID(ctx), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &uid
+}
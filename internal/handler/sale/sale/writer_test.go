@@ -11,8 +11,11 @@ import (
 
 	mocksale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
 	dtoSale "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/sale"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
@@ -54,9 +57,10 @@ func TestSaleHandler_Create(t *testing.T) {
 
 	t.Run("erro do serviço", func(t *testing.T) {
 		mockService, h := setupHandler()
-		saleDTO := dtoSale.SaleDTO{UserID: utils.Int64Ptr(1), SaleDate: &now, TotalAmount: 100.0, PaymentType: "cash", Status: "active"}
+		saleDTO := dtoSale.SaleDTO{UserID: utils.Int64Ptr(1), SaleDate: &now, TotalAmount: money.New(100), PaymentType: "cash", Status: "active"}
 		body, _ := json.Marshal(saleDTO)
 		req := httptest.NewRequest(http.MethodPost, "/sale", bytes.NewBuffer(body))
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "1"))
 		w := httptest.NewRecorder()
 
 		mockService.On("Create", mock.Anything, mock.Anything).Return(nil, errors.New("erro serviço"))
@@ -69,9 +73,10 @@ func TestSaleHandler_Create(t *testing.T) {
 
 	t.Run("sucesso", func(t *testing.T) {
 		mockService, h := setupHandler()
-		saleDTO := dtoSale.SaleDTO{UserID: utils.Int64Ptr(1), SaleDate: &now, TotalAmount: 100.0, PaymentType: "cash", Status: "active"}
+		saleDTO := dtoSale.SaleDTO{UserID: utils.Int64Ptr(1), SaleDate: &now, TotalAmount: money.New(100), PaymentType: "cash", Status: "active"}
 		body, _ := json.Marshal(saleDTO)
 		req := httptest.NewRequest(http.MethodPost, "/sale", bytes.NewBuffer(body))
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "1"))
 		w := httptest.NewRecorder()
 
 		saleModel := dtoSale.ToSaleModel(saleDTO)
@@ -87,17 +92,36 @@ func TestSaleHandler_Create(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 
+	t.Run("ignora user_id do corpo e usa o usuário autenticado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		saleDTO := dtoSale.SaleDTO{UserID: utils.Int64Ptr(99), SaleDate: &now, TotalAmount: money.New(100), PaymentType: "cash", Status: "active"}
+		body, _ := json.Marshal(saleDTO)
+		req := httptest.NewRequest(http.MethodPost, "/sale", bytes.NewBuffer(body))
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "7"))
+		w := httptest.NewRecorder()
+
+		mockService.On("Create", mock.Anything, mock.MatchedBy(func(s *models.Sale) bool {
+			return s.UserID != nil && *s.UserID == 7
+		})).Return(dtoSale.ToSaleModel(saleDTO), nil).Once()
+
+		h.Create(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
 	t.Run("erro de foreign key", func(t *testing.T) {
 		mockService, h := setupHandler()
 		saleDTO := dtoSale.SaleDTO{
 			UserID:      utils.Int64Ptr(1),
 			SaleDate:    &now,
-			TotalAmount: 100.0,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Status:      "active",
 		}
 		body, _ := json.Marshal(saleDTO)
 		req := httptest.NewRequest(http.MethodPost, "/sale", bytes.NewBuffer(body))
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "1"))
 		w := httptest.NewRecorder()
 
 		saleModel := dtoSale.ToSaleModel(saleDTO)
@@ -120,12 +144,13 @@ func TestSaleHandler_Create(t *testing.T) {
 		saleDTO := dtoSale.SaleDTO{
 			UserID:      utils.Int64Ptr(1),
 			SaleDate:    &now,
-			TotalAmount: 100.0,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Status:      "active",
 		}
 		body, _ := json.Marshal(saleDTO)
 		req := httptest.NewRequest(http.MethodPost, "/sale", bytes.NewBuffer(body))
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "1"))
 		w := httptest.NewRecorder()
 
 		saleModel := dtoSale.ToSaleModel(saleDTO)
@@ -148,12 +173,13 @@ func TestSaleHandler_Create(t *testing.T) {
 		saleDTO := dtoSale.SaleDTO{
 			UserID:      utils.Int64Ptr(1),
 			SaleDate:    &now,
-			TotalAmount: 100.0,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Status:      "active",
 		}
 		body, _ := json.Marshal(saleDTO)
 		req := httptest.NewRequest(http.MethodPost, "/sale", bytes.NewBuffer(body))
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "1"))
 		w := httptest.NewRecorder()
 
 		saleModel := dtoSale.ToSaleModel(saleDTO)
@@ -176,12 +202,13 @@ func TestSaleHandler_Create(t *testing.T) {
 		saleDTO := dtoSale.SaleDTO{
 			UserID:      utils.Int64Ptr(1),
 			SaleDate:    &now,
-			TotalAmount: 100.0,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Status:      "active",
 		}
 		body, _ := json.Marshal(saleDTO)
 		req := httptest.NewRequest(http.MethodPost, "/sale", bytes.NewBuffer(body))
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "1"))
 		w := httptest.NewRecorder()
 
 		saleModel := dtoSale.ToSaleModel(saleDTO)
@@ -204,12 +231,13 @@ func TestSaleHandler_Create(t *testing.T) {
 		saleDTO := dtoSale.SaleDTO{
 			UserID:      utils.Int64Ptr(1),
 			SaleDate:    &now,
-			TotalAmount: 100.0,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Status:      "active",
 		}
 		body, _ := json.Marshal(saleDTO)
 		req := httptest.NewRequest(http.MethodPost, "/sale", bytes.NewBuffer(body))
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "1"))
 		w := httptest.NewRecorder()
 
 		saleModel := dtoSale.ToSaleModel(saleDTO)
@@ -263,7 +291,7 @@ func TestSaleHandler_Update(t *testing.T) {
 		saleDTO := dtoSale.SaleDTO{
 			UserID:      utils.Int64Ptr(1),
 			SaleDate:    &now,
-			TotalAmount: 100.0,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Status:      "active",
 		}
@@ -287,7 +315,7 @@ func TestSaleHandler_Update(t *testing.T) {
 		saleDTO := dtoSale.SaleDTO{
 			UserID:      utils.Int64Ptr(1),
 			SaleDate:    &now,
-			TotalAmount: 100.0,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Status:      "active",
 		}
@@ -312,7 +340,7 @@ func TestSaleHandler_Update(t *testing.T) {
 		validSale := dtoSale.SaleDTO{
 			UserID:      utils.Int64Ptr(1),
 			SaleDate:    &now,
-			TotalAmount: 100.0,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Status:      "active",
 		}
@@ -346,7 +374,7 @@ func TestSaleHandler_Update(t *testing.T) {
 		saleDTO := dtoSale.SaleDTO{
 			UserID:      utils.Int64Ptr(1),
 			SaleDate:    &now,
-			TotalAmount: 100.0,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Status:      "active",
 		}
@@ -378,7 +406,7 @@ func TestSaleHandler_Update(t *testing.T) {
 		saleDTO := dtoSale.SaleDTO{
 			UserID:      utils.Int64Ptr(1),
 			SaleDate:    &now,
-			TotalAmount: 100.0,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Status:      "active",
 		}
@@ -410,7 +438,7 @@ func TestSaleHandler_Update(t *testing.T) {
 		saleDTO := dtoSale.SaleDTO{
 			UserID:      utils.Int64Ptr(1),
 			SaleDate:    &now,
-			TotalAmount: 100.0,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Status:      "active",
 		}
@@ -442,7 +470,7 @@ func TestSaleHandler_Update(t *testing.T) {
 		saleDTO := dtoSale.SaleDTO{
 			UserID:      utils.Int64Ptr(1),
 			SaleDate:    &now,
-			TotalAmount: 100.0,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Status:      "active",
 		}
@@ -474,7 +502,7 @@ func TestSaleHandler_Update(t *testing.T) {
 		saleDTO := dtoSale.SaleDTO{
 			UserID:      utils.Int64Ptr(1),
 			SaleDate:    &now,
-			TotalAmount: 100.0,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Status:      "active",
 		}
@@ -506,7 +534,7 @@ func TestSaleHandler_Update(t *testing.T) {
 		saleDTO := dtoSale.SaleDTO{
 			UserID:      utils.Int64Ptr(1),
 			SaleDate:    &now,
-			TotalAmount: 100.0,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Status:      "active",
 		}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/service_order/filter"
+)
+
+type serviceOrderFilterHandler struct {
+	service service.ServiceOrderFilter
+	logger  *logger.LogAdapter
+}
+
+func NewServiceOrderFilterHandler(service service.ServiceOrderFilter, logger *logger.LogAdapter) *serviceOrderFilterHandler {
+	return &serviceOrderFilterHandler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dtoPage "github.com/WagaoCarvalho/backend_store_go/internal/dto/common/page"
+	dtoFilter "github.com/WagaoCarvalho/backend_store_go/internal/dto/service_order/filter"
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+var validServiceOrderFilterParams = map[string]bool{
+	"client_id":      true,
+	"client_cnpj_id": true,
+	"technician_id":  true,
+	"product_id":     true,
+	"category_id":    true,
+	"status":         true,
+	"created_from":   true,
+	"created_to":     true,
+	"limit":          true,
+	"cursor":         true,
+	"search":         true,
+	"offset":         true,
+}
+
+func (h *serviceOrderFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	const ref = "[serviceOrderHandler - Filter] "
+
+	query := r.URL.Query()
+
+	for param := range query {
+		if !validServiceOrderFilterParams[param] {
+			h.logger.Warn(ctx, ref+"parâmetro desconhecido", map[string]any{
+				"parametro": param,
+				"valor":     query.Get(param),
+			})
+			utils.ErrorResponse(w, fmt.Errorf("parâmetro de consulta inválido: %s", param), http.StatusBadRequest)
+			return
+		}
+	}
+
+	var dtoFilter dtoFilter.ServiceOrderFilterDTO
+
+	for field, target := range map[string]**int64{
+		"client_id":      &dtoFilter.ClientID,
+		"client_cnpj_id": &dtoFilter.ClientCnpjID,
+		"technician_id":  &dtoFilter.TechnicianID,
+		"product_id":     &dtoFilter.ProductID,
+		"category_id":    &dtoFilter.CategoryID,
+	} {
+		v := query.Get(field)
+		if v == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			h.logger.Warn(ctx, ref+field+" inválido", map[string]any{"valor": v})
+			utils.ErrorResponse(w, fmt.Errorf("%s deve ser um número inteiro", field), http.StatusBadRequest)
+			return
+		}
+		*target = &parsed
+	}
+
+	dtoFilter.Status = query.Get("status")
+
+	utils.ParseTimeRange(query, "created_from", "created_to", &dtoFilter.CreatedFrom, &dtoFilter.CreatedTo)
+
+	limit, offset := utils.GetPaginationParams(r)
+	if limit < 0 || offset < 0 {
+		h.logger.Warn(ctx, ref+"paginação inválida", map[string]any{
+			"limit":  limit,
+			"offset": offset,
+		})
+		utils.ErrorResponse(w, fmt.Errorf("parâmetros de paginação inválidos"), http.StatusBadRequest)
+		return
+	}
+	dtoFilter.Limit = limit
+	dtoFilter.Offset = offset
+	dtoFilter.Cursor, dtoFilter.CursorMode = utils.GetCursorParam(r)
+	dtoFilter.SearchTerm = r.URL.Query().Get("search")
+
+	filter, err := dtoFilter.ToModel()
+	if err != nil {
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{"filtro": dtoFilter})
+
+	orders, err := h.service.Filter(ctx, filter)
+	if err != nil {
+		if errors.Is(err, errMsg.ErrInvalidFilter) {
+			h.logger.Warn(ctx, ref+"filtro inválido", map[string]any{
+				"erro":   err.Error(),
+				"filtro": dtoFilter,
+			})
+			utils.ErrorResponse(w, err, http.StatusBadRequest)
+			return
+		}
+
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{"filtro": dtoFilter})
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	orderDTOs := dto.ToServiceOrderDTOs(orders.Items)
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"total_encontrados": len(orderDTOs),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Ordens de serviço listadas com sucesso",
+		Data:    dtoPage.ToPageDTO(orders, orderDTOs),
+	})
+}
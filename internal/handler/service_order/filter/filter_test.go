@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockServiceOrder "github.com/WagaoCarvalho/backend_store_go/infra/mock/service_order"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/filter"
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newFilterHandler() (*mockServiceOrder.MockServiceOrder, *serviceOrderFilterHandler) {
+	mockService := new(mockServiceOrder.MockServiceOrder)
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	return mockService, NewServiceOrderFilterHandler(mockService, logger.NewLoggerAdapter(baseLogger))
+}
+
+func TestServiceOrderFilterHandler_Filter(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := newFilterHandler()
+		mockService.On("Filter", mock.Anything, mock.MatchedBy(func(f *filter.ServiceOrderFilter) bool {
+			return *f.TechnicianID == 9 && *f.CategoryID == 2 && f.Status == "open" && f.CreatedFrom != nil
+		})).Return([]*model.ServiceOrder{{ID: 1, Status: model.StatusOpen}}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/order-services/filter?technician_id=9&category_id=2&status=open&created_from=2026-01-01", nil)
+		w := httptest.NewRecorder()
+		h.Filter(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"total":1`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("parâmetro desconhecido", func(t *testing.T) {
+		_, h := newFilterHandler()
+		w := httptest.NewRecorder()
+		h.Filter(w, httptest.NewRequest(http.MethodGet, "/order-services/filter?foo=1", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	for _, field := range []string{"client_id", "client_cnpj_id", "technician_id", "product_id", "category_id"} {
+		t.Run(field+" inválido", func(t *testing.T) {
+			_, h := newFilterHandler()
+			w := httptest.NewRecorder()
+			h.Filter(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/order-services/filter?%s=abc", field), nil))
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+
+	t.Run("filtro inválido no serviço", func(t *testing.T) {
+		mockService, h := newFilterHandler()
+		mockService.On("Filter", mock.Anything, mock.Anything).Return(nil, errMsg.ErrInvalidFilter).Once()
+
+		w := httptest.NewRecorder()
+		h.Filter(w, httptest.NewRequest(http.MethodGet, "/order-services/filter?status=foo", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService, h := newFilterHandler()
+		mockService.On("Filter", mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		h.Filter(w, httptest.NewRequest(http.MethodGet, "/order-services/filter", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/service_order/order"
+)
+
+type serviceOrderHandler struct {
+	service service.ServiceOrderService
+	logger  *logger.LogAdapter
+}
+
+func NewServiceOrderHandler(service service.ServiceOrderService, logger *logger.LogAdapter) *serviceOrderHandler {
+	return &serviceOrderHandler{
+		service: service,
+		logger:  logger,
+	}
+}
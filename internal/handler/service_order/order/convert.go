@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	dtoSale "github.com/WagaoCarvalho/backend_store_go/internal/dto/sale/sale"
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+// ConvertToSale fatura a ordem concluída e devolve a venda gerada.
+func (h *serviceOrderHandler) ConvertToSale(w http.ResponseWriter, r *http.Request) {
+	const ref = "[ServiceOrderHandler - ConvertToSale] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	var conversionDTO dto.SaleConversionDTO
+	if err := utils.FromJSON(r.Body, &conversionDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	conversion := dto.ToSaleConversionModel(id, authenticatedUserID(r), conversionDTO)
+
+	sale, err := h.service.ConvertToSale(ctx, conversion)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{"id": id, "sale_id": sale.ID})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Ordem de serviço faturada com sucesso",
+		Data:    dtoSale.ToSaleDTO(sale),
+	})
+}
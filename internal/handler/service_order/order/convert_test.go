@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	modelsSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServiceOrderHandler_ConvertToSale(t *testing.T) {
+	body := []byte(`{"payment_type":"pix"}`)
+
+	t.Run("sucesso usa a ordem do path e o usuário autenticado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("ConvertToSale", mock.Anything, mock.MatchedBy(func(c *models.SaleConversion) bool {
+			return c.ServiceOrderID == 1 && c.PaymentType == "pix" && c.UserID != nil && *c.UserID == 3
+		})).Return(&modelsSale.Sale{ID: 99, PaymentType: "pix", TotalAmount: money.New(70)}, nil).Once()
+
+		req := newServiceOrderRequest(http.MethodPost, "/order-service/1/sale", idVars("1"), body)
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "3"))
+		w := httptest.NewRecorder()
+		h.ConvertToSale(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"total_amount":70`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.ConvertToSale(w, newServiceOrderRequest(http.MethodGet, "/order-service/1/sale", idVars("1"), body))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("json inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.ConvertToSale(w, newServiceOrderRequest(http.MethodPost, "/order-service/1/sale", idVars("1"), []byte("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"ordem não concluída", errMsg.ErrInvalidData, http.StatusBadRequest},
+		{"ordem inexistente", errMsg.ErrNotFound, http.StatusNotFound},
+		{"ordem já faturada", errMsg.ErrDuplicate, http.StatusConflict},
+		{"limite de crédito excedido", errMsg.ErrCreditLimitExceeded, http.StatusConflict},
+		{"erro interno", errors.New("db down"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, h := setupHandler()
+			mockService.On("ConvertToSale", mock.Anything, mock.Anything).Return(nil, tc.err).Once()
+
+			w := httptest.NewRecorder()
+			h.ConvertToSale(w, newServiceOrderRequest(http.MethodPost, "/order-service/1/sale", idVars("1"), body))
+
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
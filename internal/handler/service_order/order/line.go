@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *serviceOrderHandler) AddPart(w http.ResponseWriter, r *http.Request) {
+	const ref = "[ServiceOrderHandler - AddPart] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	var partDTO dto.ServiceOrderPartDTO
+	if err := utils.FromJSON(r.Body, &partDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	created, err := h.service.AddPart(ctx, dto.ToServiceOrderPartModel(id, partDTO))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, map[string]any{"id": id, "product_id": partDTO.ProductID})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{"id": id, "part_id": created.ID})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Peça lançada com sucesso",
+		Data:    dto.ToServiceOrderPartDTO(created),
+	})
+}
+
+func (h *serviceOrderHandler) RemovePart(w http.ResponseWriter, r *http.Request) {
+	h.removeLine(w, r, "[ServiceOrderHandler - RemovePart] ", "part_id", h.service.RemovePart)
+}
+
+func (h *serviceOrderHandler) AddLabor(w http.ResponseWriter, r *http.Request) {
+	const ref = "[ServiceOrderHandler - AddLabor] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	var laborDTO dto.ServiceOrderLaborDTO
+	if err := utils.FromJSON(r.Body, &laborDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	created, err := h.service.AddLabor(ctx, dto.ToServiceOrderLaborModel(id, laborDTO))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, map[string]any{"id": id})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{"id": id, "labor_id": created.ID})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Mão de obra lançada com sucesso",
+		Data:    dto.ToServiceOrderLaborDTO(created),
+	})
+}
+
+func (h *serviceOrderHandler) RemoveLabor(w http.ResponseWriter, r *http.Request) {
+	h.removeLine(w, r, "[ServiceOrderHandler - RemoveLabor] ", "labor_id", h.service.RemoveLabor)
+}
+
+func (h *serviceOrderHandler) removeLine(
+	w http.ResponseWriter,
+	r *http.Request,
+	ref string,
+	lineParam string,
+	remove func(ctx context.Context, orderID, lineID int64) error,
+) {
+	ctx := r.Context()
+
+	if r.Method != http.MethodDelete {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	lineID, err := utils.GetIDParam(r, lineParam)
+	if err != nil || lineID <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{lineParam: lineID})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	if err := remove(ctx, id, lineID); err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogDeleteError, map[string]any{"id": id, lineParam: lineID})
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogDeleteSuccess, map[string]any{"id": id, lineParam: lineID})
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServiceOrderHandler_AddPart(t *testing.T) {
+	body := []byte(`{"product_id":7,"quantity":2}`)
+
+	t.Run("sucesso usa a ordem do path", func(t *testing.T) {
+		mockService, h := setupHandler()
+		price := money.New(10)
+		mockService.On("AddPart", mock.Anything, mock.MatchedBy(func(p *models.ServiceOrderPart) bool {
+			return p.ServiceOrderID == 1 && p.ProductID == 7 && p.Quantity == 2 && p.UnitPrice == nil
+		})).Return(&models.ServiceOrderPart{ID: 30, ServiceOrderID: 1, ProductID: 7, Quantity: 2, UnitPrice: &price}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.AddPart(w, newServiceOrderRequest(http.MethodPost, "/order-service/1/parts", idVars("1"), body))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"subtotal":20`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("json inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.AddPart(w, newServiceOrderRequest(http.MethodPost, "/order-service/1/parts", idVars("1"), []byte("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"estoque insuficiente", errMsg.ErrInsufficientStock, http.StatusConflict},
+		{"produto desativado", errMsg.ErrProductDisabled, http.StatusConflict},
+		{"produto inexistente", errMsg.ErrNotFound, http.StatusNotFound},
+		{"ordem concluída", errMsg.ErrInvalidData, http.StatusBadRequest},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, h := setupHandler()
+			mockService.On("AddPart", mock.Anything, mock.Anything).Return(nil, tc.err).Once()
+
+			w := httptest.NewRecorder()
+			h.AddPart(w, newServiceOrderRequest(http.MethodPost, "/order-service/1/parts", idVars("1"), body))
+
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
+
+func TestServiceOrderHandler_RemovePart(t *testing.T) {
+	vars := map[string]string{"id": "1", "part_id": "30"}
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("RemovePart", mock.Anything, int64(1), int64(30)).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		h.RemovePart(w, newServiceOrderRequest(http.MethodDelete, "/order-service/1/parts/30", vars, nil))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.RemovePart(w, newServiceOrderRequest(http.MethodGet, "/order-service/1/parts/30", vars, nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("peça inválida", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.RemovePart(w, newServiceOrderRequest(http.MethodDelete, "/order-service/1/parts/0",
+			map[string]string{"id": "1", "part_id": "0"}, nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("peça não encontrada", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("RemovePart", mock.Anything, int64(1), int64(30)).Return(errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		h.RemovePart(w, newServiceOrderRequest(http.MethodDelete, "/order-service/1/parts/30", vars, nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestServiceOrderHandler_AddLabor(t *testing.T) {
+	body := []byte(`{"description":"troca de tela","amount":120}`)
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("AddLabor", mock.Anything, mock.MatchedBy(func(l *models.ServiceOrderLabor) bool {
+			return l.ServiceOrderID == 1 && l.Description == "troca de tela" && l.Amount == money.New(120)
+		})).Return(&models.ServiceOrderLabor{ID: 40, ServiceOrderID: 1, Description: "troca de tela", Amount: money.New(120)}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.AddLabor(w, newServiceOrderRequest(http.MethodPost, "/order-service/1/labor", idVars("1"), body))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.AddLabor(w, newServiceOrderRequest(http.MethodPost, "/order-service/0/labor", idVars("0"), body))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("dados inválidos", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("AddLabor", mock.Anything, mock.Anything).Return(nil, errMsg.ErrInvalidData).Once()
+
+		w := httptest.NewRecorder()
+		h.AddLabor(w, newServiceOrderRequest(http.MethodPost, "/order-service/1/labor", idVars("1"), body))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestServiceOrderHandler_RemoveLabor(t *testing.T) {
+	mockService, h := setupHandler()
+	mockService.On("RemoveLabor", mock.Anything, int64(1), int64(40)).Return(nil).Once()
+
+	w := httptest.NewRecorder()
+	h.RemoveLabor(w, newServiceOrderRequest(http.MethodDelete, "/order-service/1/labor/40",
+		map[string]string{"id": "1", "labor_id": "40"}, nil))
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	mockService.AssertExpectations(t)
+}
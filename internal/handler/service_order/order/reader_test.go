@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockServiceOrder "github.com/WagaoCarvalho/backend_store_go/infra/mock/service_order"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupHandler() (*mockServiceOrder.MockServiceOrder, *serviceOrderHandler) {
+	mockService := new(mockServiceOrder.MockServiceOrder)
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	loggerAdapter := logger.NewLoggerAdapter(baseLogger)
+	return mockService, NewServiceOrderHandler(mockService, loggerAdapter)
+}
+
+func newServiceOrderRequest(method, target string, vars map[string]string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	return mux.SetURLVars(req, vars)
+}
+
+func idVars(id string) map[string]string {
+	return map[string]string{"id": id}
+}
+
+func TestServiceOrderHandler_GetByID(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		clientID := int64(4)
+		mockService.On("GetByID", mock.Anything, int64(1)).Return(&models.ServiceOrder{
+			ID:                 1,
+			ClientID:           &clientID,
+			Status:             models.StatusInProgress,
+			ProblemDescription: "não liga",
+			Labor:              []models.ServiceOrderLabor{{ID: 20, Description: "diagnóstico", Amount: money.New(50)}},
+		}, nil).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByID(w, newServiceOrderRequest(http.MethodGet, "/order-service/1", idVars("1"), nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp map[string]interface{}
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		data := resp["data"].(map[string]interface{})
+		assert.Equal(t, "in_progress", data["status"])
+		assert.Len(t, data["labor"], 1)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetByID(w, newServiceOrderRequest(http.MethodPost, "/order-service/1", idVars("1"), nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.GetByID(w, newServiceOrderRequest(http.MethodGet, "/order-service/0", idVars("0"), nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("não encontrado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetByID", mock.Anything, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByID(w, newServiceOrderRequest(http.MethodGet, "/order-service/1", idVars("1"), nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("erro interno", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("GetByID", mock.Anything, int64(1)).Return(nil, errors.New("db down")).Once()
+
+		w := httptest.NewRecorder()
+		h.GetByID(w, newServiceOrderRequest(http.MethodGet, "/order-service/1", idVars("1"), nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
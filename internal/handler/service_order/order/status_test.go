@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServiceOrderHandler_ChangeStatus(t *testing.T) {
+	body := []byte(`{"status":"done"}`)
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("ChangeStatus", mock.Anything, int64(1), models.StatusDone).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		h.ChangeStatus(w, newServiceOrderRequest(http.MethodPatch, "/order-service/1/status", idVars("1"), body))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.ChangeStatus(w, newServiceOrderRequest(http.MethodPost, "/order-service/1/status", idVars("1"), body))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.ChangeStatus(w, newServiceOrderRequest(http.MethodPatch, "/order-service/x/status", idVars("x"), body))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("json inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.ChangeStatus(w, newServiceOrderRequest(http.MethodPatch, "/order-service/1/status", idVars("1"), []byte("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("transição inválida", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("ChangeStatus", mock.Anything, int64(1), models.StatusDone).Return(errMsg.ErrInvalidData).Once()
+
+		w := httptest.NewRecorder()
+		h.ChangeStatus(w, newServiceOrderRequest(http.MethodPatch, "/order-service/1/status", idVars("1"), body))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *serviceOrderHandler) Create(w http.ResponseWriter, r *http.Request) {
+	const ref = "[ServiceOrderHandler - Create] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var orderDTO dto.ServiceOrderDTO
+	if err := utils.FromJSON(r.Body, &orderDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+	orderDTO.ID = nil
+
+	// A abertura é sempre atribuída ao usuário autenticado
+	orderDTO.UserID = authenticatedUserID(r)
+
+	h.logger.Info(ctx, ref+logger.LogCreateInit, map[string]any{
+		"client_id":      orderDTO.ClientID,
+		"client_cnpj_id": orderDTO.ClientCnpjID,
+	})
+
+	created, err := h.service.Create(ctx, dto.ToServiceOrderModel(orderDTO))
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogCreateError, nil)
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{"id": created.ID})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "Ordem de serviço aberta com sucesso",
+		Data:    dto.ToServiceOrderDTO(created),
+	})
+}
+
+// Update altera o cabeçalho de uma ordem em andamento. O corpo deve trazer a
+// versão lida para detectar alterações concorrentes.
+func (h *serviceOrderHandler) Update(w http.ResponseWriter, r *http.Request) {
+	const ref = "[ServiceOrderHandler - Update] "
+	ctx := r.Context()
+
+	if r.Method != http.MethodPut {
+		h.logger.Warn(ctx, ref+logger.LogMethodNotAllowed, map[string]any{"method": r.Method})
+		utils.ErrorResponse(w, fmt.Errorf("método %s não permitido", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := utils.GetIDParam(r, "id")
+	if err != nil || id <= 0 {
+		h.logger.Warn(ctx, ref+"ID inválido", map[string]any{"id": id})
+		utils.ErrorResponse(w, errMsg.ErrZeroID, http.StatusBadRequest)
+		return
+	}
+
+	var orderDTO dto.ServiceOrderDTO
+	if err := utils.FromJSON(r.Body, &orderDTO); err != nil {
+		h.logger.Warn(ctx, ref+logger.LogParseJSONError, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+	orderDTO.ID = &id
+
+	h.logger.Info(ctx, ref+logger.LogUpdateInit, map[string]any{"id": id})
+
+	order := dto.ToServiceOrderModel(orderDTO)
+	if err := h.service.Update(ctx, order); err != nil {
+		if errors.Is(err, errMsg.ErrVersionConflict) {
+			h.logger.Warn(ctx, ref+logger.LogUpdateVersionConflict, map[string]any{"id": id})
+		} else {
+			h.logger.Error(ctx, err, ref+logger.LogUpdateError, map[string]any{"id": id})
+		}
+		utils.ErrorResponse(w, err, statusFromError(err))
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogUpdateSuccess, map[string]any{"id": id})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Ordem de serviço atualizada com sucesso",
+		Data:    dto.ToServiceOrderDTO(order),
+	})
+}
+
+func authenticatedUserID(r *http.Request) *int64 {
+	if uid, err := strconv.ParseInt(contextUtils.GetUserID(r.Context()), 10, 64); err == nil {
+		return &uid
+	}
+	return nil
+}
+
+func statusFromError(err error) int {
+	switch {
+	case errors.Is(err, errMsg.ErrInvalidData),
+		errors.Is(err, errMsg.ErrZeroID):
+		return http.StatusBadRequest
+	case errors.Is(err, errMsg.ErrNotFound),
+		errors.Is(err, errMsg.ErrDBInvalidForeignKey):
+		return http.StatusNotFound
+	case errors.Is(err, errMsg.ErrVersionConflict),
+		errors.Is(err, errMsg.ErrDuplicate),
+		errors.Is(err, errMsg.ErrInsufficientStock),
+		errors.Is(err, errMsg.ErrProductDisabled),
+		errors.Is(err, errMsg.ErrCreditLimitExceeded):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServiceOrderHandler_Create(t *testing.T) {
+	body := []byte(`{"client_id":4,"technician_id":9,"user_id":99,"status":"done","problem_description":"não liga","category_ids":[1]}`)
+
+	t.Run("sucesso atribui o usuário autenticado", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Create", mock.Anything, mock.MatchedBy(func(o *models.ServiceOrder) bool {
+			return *o.ClientID == 4 && *o.TechnicianID == 9 && o.UserID != nil && *o.UserID == 3 &&
+				o.Status == "" && len(o.CategoryIDs) == 1
+		})).Return(&models.ServiceOrder{ID: 1, Status: models.StatusOpen}, nil).Once()
+
+		req := newServiceOrderRequest(http.MethodPost, "/order-service", nil, body)
+		req = req.WithContext(contextUtils.SetUserID(req.Context(), "3"))
+		w := httptest.NewRecorder()
+		h.Create(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Create(w, newServiceOrderRequest(http.MethodGet, "/order-service", nil, body))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("json inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Create(w, newServiceOrderRequest(http.MethodPost, "/order-service", nil, []byte("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"dados inválidos", errMsg.ErrInvalidData, http.StatusBadRequest},
+		{"cliente inexistente", errMsg.ErrDBInvalidForeignKey, http.StatusNotFound},
+		{"categoria repetida", errMsg.ErrDuplicate, http.StatusConflict},
+		{"erro interno", errors.New("db down"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, h := setupHandler()
+			mockService.On("Create", mock.Anything, mock.Anything).Return(nil, tc.err).Once()
+
+			w := httptest.NewRecorder()
+			h.Create(w, newServiceOrderRequest(http.MethodPost, "/order-service", nil, body))
+
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
+
+func TestServiceOrderHandler_Update(t *testing.T) {
+	body := []byte(`{"client_id":4,"problem_description":"não liga","version":2}`)
+
+	t.Run("sucesso usa o id do path", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Update", mock.Anything, mock.MatchedBy(func(o *models.ServiceOrder) bool {
+			return o.ID == 1 && o.Version == 2
+		})).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		h.Update(w, newServiceOrderRequest(http.MethodPut, "/order-service/1", idVars("1"), body))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, h := setupHandler()
+		w := httptest.NewRecorder()
+		h.Update(w, newServiceOrderRequest(http.MethodPut, "/order-service/0", idVars("0"), body))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("conflito de versão", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Update", mock.Anything, mock.Anything).Return(errMsg.ErrVersionConflict).Once()
+
+		w := httptest.NewRecorder()
+		h.Update(w, newServiceOrderRequest(http.MethodPut, "/order-service/1", idVars("1"), body))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("ordem não encontrada", func(t *testing.T) {
+		mockService, h := setupHandler()
+		mockService.On("Update", mock.Anything, mock.Anything).Return(errMsg.ErrNotFound).Once()
+
+		w := httptest.NewRecorder()
+		h.Update(w, newServiceOrderRequest(http.MethodPut, "/order-service/1", idVars("1"), body))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/login"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/session"
+)
+
+type sessionHandler struct {
+	service service.SessionService
+	logger  *logger.LogAdapter
+}
+
+func NewSessionHandler(service service.SessionService, logger *logger.LogAdapter) *sessionHandler {
+	return &sessionHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// List lista as sessões ativas. Sem {id} no path, são as do próprio usuário
+// autenticado; com {id}, as do usuário indicado (rota administrativa).
+func (h *sessionHandler) List(w http.ResponseWriter, r *http.Request) {
+	const ref = "[SessionHandler - List] "
+	ctx := r.Context()
+
+	userID, err := targetUserID(r)
+	if err != nil {
+		h.logger.Warn(ctx, ref+logger.LogInvalidID, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, fmt.Errorf("ID de usuário inválido"), http.StatusBadRequest)
+		return
+	}
+
+	sessions, err := h.service.List(ctx, userID)
+	if err != nil {
+		if errors.Is(err, errMsg.ErrZeroID) {
+			h.logger.Warn(ctx, ref+logger.LogInvalidID, map[string]any{"user_id": userID})
+			utils.ErrorResponse(w, err, http.StatusBadRequest)
+			return
+		}
+		h.logger.Error(ctx, err, ref+logger.LogSessionListError, map[string]any{"user_id": userID})
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogSessionListSuccess, map[string]any{
+		"user_id": userID,
+		"total":   len(sessions),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "Sessões recuperadas com sucesso",
+		Data:    dto.ToSessionDTOs(sessions),
+	})
+}
+
+// Revoke encerra uma sessão; o refresh token dela deixa de ser aceito e o
+// token de acesso já emitido expira no prazo normal.
+func (h *sessionHandler) Revoke(w http.ResponseWriter, r *http.Request) {
+	const ref = "[SessionHandler - Revoke] "
+	ctx := r.Context()
+
+	userID, err := targetUserID(r)
+	if err != nil {
+		h.logger.Warn(ctx, ref+logger.LogInvalidID, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, fmt.Errorf("ID de usuário inválido"), http.StatusBadRequest)
+		return
+	}
+
+	sessionID, err := utils.GetStringParam(r, "session_id")
+	if err != nil {
+		h.logger.Warn(ctx, ref+logger.LogInvalidID, map[string]any{"erro": err.Error()})
+		utils.ErrorResponse(w, fmt.Errorf("sessão inválida"), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.Revoke(ctx, userID, sessionID); err != nil {
+		fields := map[string]any{"user_id": userID, "session_id": sessionID}
+		switch {
+		case errors.Is(err, errMsg.ErrNotFound):
+			h.logger.Warn(ctx, ref+logger.LogNotFound, fields)
+			utils.ErrorResponse(w, fmt.Errorf("sessão não encontrada"), http.StatusNotFound)
+		case errors.Is(err, errMsg.ErrZeroID), errors.Is(err, errMsg.ErrInvalidData):
+			h.logger.Warn(ctx, ref+logger.LogInvalidID, fields)
+			utils.ErrorResponse(w, err, http.StatusBadRequest)
+		default:
+			h.logger.Error(ctx, err, ref+logger.LogSessionEndError, fields)
+			utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogSessionRevoked, map[string]any{
+		"user_id":    userID,
+		"session_id": sessionID,
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func targetUserID(r *http.Request) (int64, error) {
+	if _, ok := mux.Vars(r)["id"]; ok {
+		return utils.GetIDParam(r, "id")
+	}
+	return strconv.ParseInt(contextUtils.GetUserID(r.Context()), 10, 64)
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mockLogin "github.com/WagaoCarvalho/backend_store_go/infra/mock/login"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/login"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+)
+
+func setupHandler() (*mockLogin.MockSession, *sessionHandler) {
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	log := logger.NewLoggerAdapter(baseLogger)
+
+	mockService := new(mockLogin.MockSession)
+	return mockService, NewSessionHandler(mockService, log)
+}
+
+func authenticated(req *http.Request, userID string) *http.Request {
+	return req.WithContext(contextUtils.SetUserID(req.Context(), userID))
+}
+
+func TestSessionHandler_List(t *testing.T) {
+	t.Run("sessões do próprio usuário", func(t *testing.T) {
+		mockService, handler := setupHandler()
+
+		mockService.On("List", mock.Anything, int64(7)).Return([]*models.Session{
+			{ID: "sess-1", Device: "android", RefreshHash: "nao-expor", LastSeenAt: time.Now()},
+		}, nil)
+
+		req := authenticated(httptest.NewRequest(http.MethodGet, "/sessions", nil), "7")
+		rec := httptest.NewRecorder()
+
+		handler.List(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"id":"sess-1"`)
+		assert.NotContains(t, rec.Body.String(), "nao-expor")
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("sessões de outro usuário pelo path", func(t *testing.T) {
+		mockService, handler := setupHandler()
+
+		mockService.On("List", mock.Anything, int64(9)).Return([]*models.Session{}, nil)
+
+		req := authenticated(httptest.NewRequest(http.MethodGet, "/user/9/sessions", nil), "7")
+		req = mux.SetURLVars(req, map[string]string{"id": "9"})
+		rec := httptest.NewRecorder()
+
+		handler.List(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("sem usuário no contexto", func(t *testing.T) {
+		_, handler := setupHandler()
+
+		rec := httptest.NewRecorder()
+		handler.List(rec, httptest.NewRequest(http.MethodGet, "/sessions", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("erro no serviço", func(t *testing.T) {
+		mockService, handler := setupHandler()
+
+		mockService.On("List", mock.Anything, int64(7)).Return(nil, errMsg.ErrSessionStore)
+
+		req := authenticated(httptest.NewRequest(http.MethodGet, "/sessions", nil), "7")
+		rec := httptest.NewRecorder()
+
+		handler.List(rec, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestSessionHandler_Revoke(t *testing.T) {
+	newRequest := func(vars map[string]string) *http.Request {
+		req := authenticated(httptest.NewRequest(http.MethodDelete, "/sessions/sess-1", nil), "7")
+		return mux.SetURLVars(req, vars)
+	}
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockService, handler := setupHandler()
+
+		mockService.On("Revoke", mock.Anything, int64(7), "sess-1").Return(nil)
+
+		rec := httptest.NewRecorder()
+		handler.Revoke(rec, newRequest(map[string]string{"session_id": "sess-1"}))
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("sessão ausente no path", func(t *testing.T) {
+		_, handler := setupHandler()
+
+		rec := httptest.NewRecorder()
+		handler.Revoke(rec, newRequest(map[string]string{}))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	cases := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"não encontrada", errMsg.ErrNotFound, http.StatusNotFound},
+		{"dados inválidos", errMsg.ErrInvalidData, http.StatusBadRequest},
+		{"erro genérico", errors.New("redis down"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, handler := setupHandler()
+
+			mockService.On("Revoke", mock.Anything, int64(7), "sess-1").Return(tc.err)
+
+			rec := httptest.NewRecorder()
+			handler.Revoke(rec, newRequest(map[string]string{"session_id": "sess-1"}))
+
+			assert.Equal(t, tc.expected, rec.Code)
+		})
+	}
+}
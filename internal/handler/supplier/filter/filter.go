@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strconv"
 
+	dtoPage "github.com/WagaoCarvalho/backend_store_go/internal/dto/common/page"
 	dtoFilter "github.com/WagaoCarvalho/backend_store_go/internal/dto/supplier/filter"
 	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/supplier/supplier"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
@@ -47,6 +48,8 @@ func (h *supplierFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
 	)
 
 	dtoFilter.Limit, dtoFilter.Offset = utils.GetPaginationParams(r)
+	dtoFilter.Cursor, dtoFilter.CursorMode = utils.GetCursorParam(r)
+	dtoFilter.SearchTerm = r.URL.Query().Get("search")
 
 	filter, err := dtoFilter.ToModel()
 	if err != nil {
@@ -76,7 +79,7 @@ func (h *supplierFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	supplierDTOs := dto.ToSupplierDTOs(suppliers)
+	supplierDTOs := dto.ToSupplierDTOs(suppliers.Items)
 
 	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
 		"total_encontrados": len(supplierDTOs),
@@ -85,9 +88,6 @@ func (h *supplierFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
 	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
 		Status:  http.StatusOK,
 		Message: "Fornecedores listados com sucesso",
-		Data: map[string]any{
-			"total": len(supplierDTOs),
-			"items": supplierDTOs,
-		},
+		Data:    dtoPage.ToPageDTO(suppliers, supplierDTOs),
 	})
 }
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strconv"
 
+	dtoPage "github.com/WagaoCarvalho/backend_store_go/internal/dto/common/page"
 	dtoFilter "github.com/WagaoCarvalho/backend_store_go/internal/dto/user/filter"
 	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/user/user"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
@@ -22,6 +23,8 @@ var validFilterParams = map[string]bool{
 	"updated_from": true,
 	"updated_to":   true,
 	"limit":        true,
+	"cursor":       true,
+	"search":       true,
 	"offset":       true,
 }
 
@@ -76,6 +79,8 @@ func (h *userFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
 	)
 
 	dtoFilter.Limit, dtoFilter.Offset = utils.GetPaginationParams(r)
+	dtoFilter.Cursor, dtoFilter.CursorMode = utils.GetCursorParam(r)
+	dtoFilter.SearchTerm = r.URL.Query().Get("search")
 
 	filter, err := dtoFilter.ToModel()
 	if err != nil {
@@ -105,7 +110,7 @@ func (h *userFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userDTOs := dto.ToUserDTOs(users)
+	userDTOs := dto.ToUserDTOs(users.Items)
 
 	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
 		"total_encontrados": len(userDTOs),
@@ -114,9 +119,6 @@ func (h *userFilterHandler) Filter(w http.ResponseWriter, r *http.Request) {
 	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
 		Status:  http.StatusOK,
 		Message: "Usuários listados com sucesso",
-		Data: map[string]any{
-			"total": len(userDTOs),
-			"items": userDTOs,
-		},
+		Data:    dtoPage.ToPageDTO(users, userDTOs),
 	})
 }
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/user/role"
+)
+
+type userRoleHandler struct {
+	service service.UserRole
+	logger  *logger.LogAdapter
+}
+
+func NewUserRoleHandler(service service.UserRole, logger *logger.LogAdapter) *userRoleHandler {
+	return &userRoleHandler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/user/role"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *userRoleHandler) GetAllRoles(w http.ResponseWriter, r *http.Request) {
+	const ref = "[UserRoleHandler - GetAllRoles] "
+	ctx := r.Context()
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{})
+
+	roles, err := h.service.GetAllRoles(ctx)
+	if err != nil {
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{})
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	rolesDTO := dto.ToRoleDTOs(roles)
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"total": len(rolesDTO),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Data:    rolesDTO,
+		Message: "Papéis recuperados com sucesso",
+		Status:  http.StatusOK,
+	})
+}
+
+func (h *userRoleHandler) GetRolesByUserID(w http.ResponseWriter, r *http.Request) {
+	const ref = "[UserRoleHandler - GetRolesByUserID] "
+	ctx := r.Context()
+
+	h.logger.Info(ctx, ref+logger.LogGetInit, map[string]any{})
+
+	userID, err := utils.GetIDParam(r, "id")
+	if err != nil {
+		h.logger.Warn(ctx, ref+logger.LogInvalidID, map[string]any{
+			"erro": err.Error(),
+		})
+		utils.ErrorResponse(w, fmt.Errorf("ID de usuário inválido"), http.StatusBadRequest)
+		return
+	}
+
+	roles, err := h.service.GetRolesByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, errMsg.ErrZeroID) {
+			h.logger.Warn(ctx, ref+logger.LogInvalidID, map[string]any{"user_id": userID})
+			utils.ErrorResponse(w, err, http.StatusBadRequest)
+			return
+		}
+		h.logger.Error(ctx, err, ref+logger.LogGetError, map[string]any{
+			"user_id": userID,
+		})
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	rolesDTO := dto.ToRoleDTOs(roles)
+
+	h.logger.Info(ctx, ref+logger.LogGetSuccess, map[string]any{
+		"user_id": userID,
+		"total":   len(rolesDTO),
+	})
+
+	utils.ToJSON(w, http.StatusOK, utils.DefaultResponse{
+		Data:    rolesDTO,
+		Message: "Papéis do usuário recuperados com sucesso",
+		Status:  http.StatusOK,
+	})
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockUser "github.com/WagaoCarvalho/backend_store_go/infra/mock/user"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/role"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupHandler() (*mockUser.MockUserRole, *userRoleHandler) {
+	baseLogger := logrus.New()
+	baseLogger.Out = &bytes.Buffer{}
+	log := logger.NewLoggerAdapter(baseLogger)
+
+	mockService := new(mockUser.MockUserRole)
+	return mockService, NewUserRoleHandler(mockService, log)
+}
+
+func TestUserRoleHandler_GetAllRoles(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService, handler := setupHandler()
+
+		mockService.On("GetAllRoles", mock.Anything).Return([]*models.Role{
+			{ID: 1, Name: "admin", Permissions: []string{"sale:cancel"}},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/roles", nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetAllRoles(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"name":"admin"`)
+		assert.Contains(t, rec.Body.String(), `"permissions":["sale:cancel"]`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		mockService, handler := setupHandler()
+
+		mockService.On("GetAllRoles", mock.Anything).Return(nil, errors.New("db fail"))
+
+		req := httptest.NewRequest(http.MethodGet, "/roles", nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetAllRoles(rec, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestUserRoleHandler_GetRolesByUserID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService, handler := setupHandler()
+
+		mockService.On("GetRolesByUserID", mock.Anything, int64(7)).Return([]*models.Role{
+			{ID: 3, Name: "cashier"},
+		}, nil)
+
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/user/7/roles", nil), map[string]string{"id": "7"})
+		rec := httptest.NewRecorder()
+
+		handler.GetRolesByUserID(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"name":"cashier"`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		_, handler := setupHandler()
+
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/user/abc/roles", nil), map[string]string{"id": "abc"})
+		rec := httptest.NewRecorder()
+
+		handler.GetRolesByUserID(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("zero id from service", func(t *testing.T) {
+		mockService, handler := setupHandler()
+
+		mockService.On("GetRolesByUserID", mock.Anything, int64(7)).Return(nil, errMsg.ErrZeroID)
+
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/user/7/roles", nil), map[string]string{"id": "7"})
+		rec := httptest.NewRecorder()
+
+		handler.GetRolesByUserID(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		mockService, handler := setupHandler()
+
+		mockService.On("GetRolesByUserID", mock.Anything, int64(7)).Return(nil, errors.New("db fail"))
+
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/user/7/roles", nil), map[string]string{"id": "7"})
+		rec := httptest.NewRecorder()
+
+		handler.GetRolesByUserID(rec, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	dto "github.com/WagaoCarvalho/backend_store_go/internal/dto/user/role"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/role"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+)
+
+func (h *userRoleHandler) AssignRole(w http.ResponseWriter, r *http.Request) {
+	const ref = "[UserRoleHandler - AssignRole] "
+	ctx := r.Context()
+
+	h.logger.Info(ctx, ref+logger.LogCreateInit, map[string]any{})
+
+	userID, errUserID := utils.GetIDParam(r, "id")
+	roleID, errRoleID := utils.GetIDParam(r, "role_id")
+	if errUserID != nil || errRoleID != nil {
+		h.logger.Warn(ctx, ref+logger.LogInvalidID, map[string]any{
+			"erro_user_id": errUserID,
+			"erro_role_id": errRoleID,
+		})
+		utils.ErrorResponse(w, fmt.Errorf("IDs inválidos"), http.StatusBadRequest)
+		return
+	}
+
+	created, err := h.service.AssignRole(ctx, &models.UserRole{UserID: userID, RoleID: roleID})
+	if err != nil {
+		fields := map[string]any{"user_id": userID, "role_id": roleID}
+		switch {
+		case errors.Is(err, errMsg.ErrInvalidData):
+			h.logger.Warn(ctx, ref+logger.LogValidateError, fields)
+			utils.ErrorResponse(w, err, http.StatusBadRequest)
+		case errors.Is(err, errMsg.ErrDBInvalidForeignKey):
+			h.logger.Warn(ctx, ref+logger.LogForeignKeyViolation, fields)
+			utils.ErrorResponse(w, fmt.Errorf("usuário ou papel não encontrado"), http.StatusNotFound)
+		case errors.Is(err, errMsg.ErrRelationExists):
+			h.logger.Warn(ctx, ref+logger.LogAlreadyExists, fields)
+			utils.ErrorResponse(w, fmt.Errorf("papel já atribuído ao usuário"), http.StatusConflict)
+		default:
+			h.logger.Error(ctx, err, ref+logger.LogCreateError, fields)
+			utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogCreateSuccess, map[string]any{
+		"user_id": userID,
+		"role_id": roleID,
+	})
+
+	utils.ToJSON(w, http.StatusCreated, utils.DefaultResponse{
+		Data:    dto.ToUserRoleDTO(created),
+		Message: "Papel atribuído com sucesso",
+		Status:  http.StatusCreated,
+	})
+}
+
+func (h *userRoleHandler) RemoveRole(w http.ResponseWriter, r *http.Request) {
+	const ref = "[UserRoleHandler - RemoveRole] "
+	ctx := r.Context()
+
+	h.logger.Info(ctx, ref+logger.LogDeleteInit, map[string]any{})
+
+	userID, errUserID := utils.GetIDParam(r, "id")
+	roleID, errRoleID := utils.GetIDParam(r, "role_id")
+	if errUserID != nil || errRoleID != nil {
+		h.logger.Warn(ctx, ref+logger.LogInvalidID, map[string]any{
+			"erro_user_id": errUserID,
+			"erro_role_id": errRoleID,
+		})
+		utils.ErrorResponse(w, fmt.Errorf("IDs inválidos"), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.RemoveRole(ctx, userID, roleID); err != nil {
+		fields := map[string]any{"user_id": userID, "role_id": roleID}
+		if errors.Is(err, errMsg.ErrNotFound) {
+			h.logger.Warn(ctx, ref+logger.LogNotFound, fields)
+			utils.ErrorResponse(w, err, http.StatusNotFound)
+			return
+		}
+		h.logger.Error(ctx, err, ref+logger.LogDeleteError, fields)
+		utils.ErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Info(ctx, ref+logger.LogDeleteSuccess, map[string]any{
+		"user_id": userID,
+		"role_id": roleID,
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+}
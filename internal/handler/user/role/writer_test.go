@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/role"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newRoleRequest(method, userID, roleID string) *http.Request {
+	req := httptest.NewRequest(method, "/user/"+userID+"/roles/"+roleID, nil)
+	return mux.SetURLVars(req, map[string]string{"id": userID, "role_id": roleID})
+}
+
+func TestUserRoleHandler_AssignRole(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService, handler := setupHandler()
+
+		mockService.On("AssignRole", mock.Anything, &models.UserRole{UserID: 7, RoleID: 3}).
+			Return(&models.UserRole{UserID: 7, RoleID: 3, CreatedAt: time.Now()}, nil)
+
+		rec := httptest.NewRecorder()
+		handler.AssignRole(rec, newRoleRequest(http.MethodPost, "7", "3"))
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"role_id":3`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid ids", func(t *testing.T) {
+		_, handler := setupHandler()
+
+		rec := httptest.NewRecorder()
+		handler.AssignRole(rec, newRoleRequest(http.MethodPost, "7", "abc"))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	cases := []struct {
+		name       string
+		serviceErr error
+		expected   int
+	}{
+		{"invalid data", errMsg.ErrInvalidData, http.StatusBadRequest},
+		{"user or role not found", errMsg.ErrDBInvalidForeignKey, http.StatusNotFound},
+		{"already assigned", errMsg.ErrRelationExists, http.StatusConflict},
+		{"generic error", errors.New("db fail"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, handler := setupHandler()
+
+			mockService.On("AssignRole", mock.Anything, mock.Anything).Return(nil, tc.serviceErr)
+
+			rec := httptest.NewRecorder()
+			handler.AssignRole(rec, newRoleRequest(http.MethodPost, "7", "3"))
+
+			assert.Equal(t, tc.expected, rec.Code)
+		})
+	}
+}
+
+func TestUserRoleHandler_RemoveRole(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService, handler := setupHandler()
+
+		mockService.On("RemoveRole", mock.Anything, int64(7), int64(3)).Return(nil)
+
+		rec := httptest.NewRecorder()
+		handler.RemoveRole(rec, newRoleRequest(http.MethodDelete, "7", "3"))
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid ids", func(t *testing.T) {
+		_, handler := setupHandler()
+
+		rec := httptest.NewRecorder()
+		handler.RemoveRole(rec, newRoleRequest(http.MethodDelete, "abc", "3"))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockService, handler := setupHandler()
+
+		mockService.On("RemoveRole", mock.Anything, int64(7), int64(3)).Return(errMsg.ErrNotFound)
+
+		rec := httptest.NewRecorder()
+		handler.RemoveRole(rec, newRoleRequest(http.MethodDelete, "7", "3"))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		mockService, handler := setupHandler()
+
+		mockService.On("RemoveRole", mock.Anything, int64(7), int64(3)).Return(errors.New("db fail"))
+
+		rec := httptest.NewRecorder()
+		handler.RemoveRole(rec, newRoleRequest(http.MethodDelete, "7", "3"))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -2,11 +2,12 @@ package iface
 
 import (
 	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/address/address"
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/address/filter"
 )
 
 type AddressFilter interface {
-	Filter(ctx context.Context, filter *filter.AddressFilter) ([]*models.Address, error)
+	Filter(ctx context.Context, filter *filter.AddressFilter) (*commonFilter.Page[*models.Address], error)
 }
@@ -0,0 +1,21 @@
+package iface
+
+import (
+	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/filter"
+	"github.com/jackc/pgx/v5"
+)
+
+// AuditWriter grava os registros da trilha. CreateTx grava na transação da
+// escrita auditada, que é desfeita se o registro falhar.
+type AuditWriter interface {
+	Create(ctx context.Context, entry *models.AuditLog) (*models.AuditLog, error)
+	CreateTx(ctx context.Context, tx pgx.Tx, entry *models.AuditLog) (*models.AuditLog, error)
+}
+
+type AuditFilter interface {
+	Filter(ctx context.Context, f *modelFilter.AuditFilter) (*commonFilter.Page[*models.AuditLog], error)
+}
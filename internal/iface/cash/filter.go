@@ -0,0 +1,13 @@
+package iface
+
+import (
+	"context"
+
+	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+)
+
+type CashSessionFilter interface {
+	Filter(ctx context.Context, f *modelFilter.CashSessionFilter) (*commonFilter.Page[*models.CashSession], error)
+}
@@ -0,0 +1,37 @@
+package iface
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+)
+
+type CashSessionReader interface {
+	GetByID(ctx context.Context, id int64) (*models.CashSession, error)
+	GetOpenByUser(ctx context.Context, userID int64) (*models.CashSession, error)
+	Movements(ctx context.Context, id int64) ([]*models.CashMovement, error)
+	Tenders(ctx context.Context, id int64) ([]*modelsPayment.MethodTotal, error)
+	MovementTotals(ctx context.Context, id int64) (*models.MovementTotals, error)
+}
+
+// CashSessionTx grava o caixa. Movimentos e fechamento bloqueiam o caixa para
+// que o esperado não mude enquanto é conferido.
+type CashSessionTx interface {
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+	CreateTx(ctx context.Context, tx pgx.Tx, session *models.CashSession) (*models.CashSession, error)
+	GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.CashSession, error)
+	CreateMovementTx(ctx context.Context, tx pgx.Tx, movement *models.CashMovement) (*models.CashMovement, error)
+	TendersTx(ctx context.Context, tx pgx.Tx, id int64) ([]*modelsPayment.MethodTotal, error)
+	MovementTotalsTx(ctx context.Context, tx pgx.Tx, id int64) (*models.MovementTotals, error)
+	CloseTx(ctx context.Context, tx pgx.Tx, session *models.CashSession) error
+}
+
+// CashRefundTx lança as devoluções em dinheiro de cancelamentos e devoluções
+// de venda, na transação da venda.
+type CashRefundTx interface {
+	CreateRefundTx(ctx context.Context, tx pgx.Tx, movement *models.CashMovement) (*models.CashMovement, error)
+	RefundedTx(ctx context.Context, tx pgx.Tx, saleID int64) (money.Money, error)
+}
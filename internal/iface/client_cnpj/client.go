@@ -0,0 +1,26 @@
+package iface
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+)
+
+type ClientCnpjReader interface {
+	GetByID(ctx context.Context, id int64) (*models.ClientCnpj, error)
+}
+
+type ClientCnpjWriter interface {
+	Create(ctx context.Context, clientCnpj *models.ClientCnpj) (*models.ClientCnpj, error)
+	Update(ctx context.Context, clientCnpj *models.ClientCnpj) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type ClientCnpjStatus interface {
+	Disable(ctx context.Context, id int64) error
+	Enable(ctx context.Context, id int64) error
+}
+
+type ClientCnpjVersion interface {
+	GetVersionByID(ctx context.Context, id int64) (int, error)
+}
@@ -0,0 +1,18 @@
+package iface
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/contact_relation"
+)
+
+type ClientCnpjContactRelationReader interface {
+	HasClientCnpjContactRelation(ctx context.Context, clientCnpjID, contactID int64) (bool, error)
+	GetAllRelationsByClientCnpjID(ctx context.Context, clientCnpjID int64) ([]*models.ClientCnpjContactRelation, error)
+}
+
+type ClientCnpjContactRelationWriter interface {
+	Create(ctx context.Context, relation *models.ClientCnpjContactRelation) (*models.ClientCnpjContactRelation, error)
+	Delete(ctx context.Context, clientCnpjID, contactID int64) error
+	DeleteAll(ctx context.Context, clientCnpjID int64) error
+}
@@ -0,0 +1,13 @@
+package iface
+
+import (
+	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/filter"
+)
+
+type ClientCnpjFilter interface {
+	Filter(ctx context.Context, f *filter.ClientCnpjFilter) (*commonFilter.Page[*models.ClientCnpj], error)
+}
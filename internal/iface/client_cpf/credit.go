@@ -7,6 +7,7 @@ import (
 )
 
 type ClientCreditReader interface {
+	GetByClientID(ctx context.Context, clientID int64) (*models.ClientCredit, error)
 	GetByName(ctx context.Context, name string) ([]*models.ClientCredit, error)
 	GetVersionByID(ctx context.Context, id int64) (int, error)
 	GetAll(ctx context.Context) ([]*models.ClientCredit, error)
@@ -14,6 +15,7 @@ type ClientCreditReader interface {
 
 type ClientCreditWriter interface {
 	Create(ctx context.Context, client *models.ClientCredit) (*models.ClientCredit, error)
+	Upsert(ctx context.Context, credit *models.ClientCredit) (*models.ClientCredit, error)
 	Update(ctx context.Context, client *models.ClientCredit) error
 	Delete(ctx context.Context, id int64) error
 }
@@ -22,3 +24,7 @@ type ClientCreditStatus interface {
 	Disable(ctx context.Context, id int64) error
 	Enable(ctx context.Context, id int64) error
 }
+
+type ClientCreditLedgerReader interface {
+	GetEntriesByClientID(ctx context.Context, clientID int64, limit, offset int) ([]*models.CreditEntry, error)
+}
@@ -2,11 +2,12 @@ package iface
 
 import (
 	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/client"
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/filter"
 )
 
 type ClientCpfFilter interface {
-	Filter(ctx context.Context, f *filter.ClientCpfFilter) ([]*models.ClientCpf, error)
+	Filter(ctx context.Context, f *filter.ClientCpfFilter) (*commonFilter.Page[*models.ClientCpf], error)
 }
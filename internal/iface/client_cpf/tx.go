@@ -0,0 +1,17 @@
+package iface
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	"github.com/jackc/pgx/v5"
+)
+
+// ClientCreditTx movimenta o saldo devedor do cliente e grava o lançamento
+// correspondente no razão, sempre dentro da transação recebida.
+type ClientCreditTx interface {
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+	ChargeTx(ctx context.Context, tx pgx.Tx, entry *models.CreditEntry) error
+	PaymentTx(ctx context.Context, tx pgx.Tx, entry *models.CreditEntry) error
+	RefundTx(ctx context.Context, tx pgx.Tx, entry *models.CreditEntry) error
+}
@@ -0,0 +1,26 @@
+package iface
+
+import (
+	"context"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	"github.com/jackc/pgx/v5"
+)
+
+type InventoryCountReader interface {
+	GetByID(ctx context.Context, id int64) (*models.InventoryCount, error)
+	List(ctx context.Context, f *models.InventoryCountFilter) (*commonFilter.Page[*models.InventoryCount], error)
+	Variance(ctx context.Context, id int64) ([]*models.VarianceLine, error)
+}
+
+// InventoryCountTx grava a sessão de contagem. As leituras e o fechamento
+// passam por transação para não concorrerem com vendas sobre o mesmo saldo.
+type InventoryCountTx interface {
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+	CreateTx(ctx context.Context, tx pgx.Tx, count *models.InventoryCount) (*models.InventoryCount, error)
+	GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.InventoryCount, error)
+	RecordTx(ctx context.Context, tx pgx.Tx, countID int64, entry *models.CountEntry) (*models.CountItem, error)
+	CountedLinesForUpdateTx(ctx context.Context, tx pgx.Tx, countID int64) ([]*models.VarianceLine, error)
+	UpdateStatusTx(ctx context.Context, tx pgx.Tx, count *models.InventoryCount) error
+}
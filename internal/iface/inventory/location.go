@@ -0,0 +1,17 @@
+package iface
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+)
+
+type LocationReader interface {
+	GetByID(ctx context.Context, id int64) (*models.Location, error)
+	List(ctx context.Context) ([]*models.Location, error)
+}
+
+type LocationWriter interface {
+	Create(ctx context.Context, location *models.Location) (*models.Location, error)
+	Update(ctx context.Context, location *models.Location) error
+}
@@ -0,0 +1,23 @@
+package iface
+
+import (
+	"context"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	"github.com/jackc/pgx/v5"
+)
+
+type TransferReader interface {
+	GetByID(ctx context.Context, id int64) (*models.Transfer, error)
+	List(ctx context.Context, f *models.TransferFilter) (*commonFilter.Page[*models.Transfer], error)
+}
+
+// TransferTx grava a transferência na mesma transação que movimenta os
+// saldos dos locais.
+type TransferTx interface {
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+	CreateTx(ctx context.Context, tx pgx.Tx, transfer *models.Transfer) (*models.Transfer, error)
+	GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Transfer, error)
+	UpdateStatusTx(ctx context.Context, tx pgx.Tx, transfer *models.Transfer) error
+}
@@ -2,11 +2,12 @@ package iface
 
 import (
 	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/product/filter"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
 )
 
 type ProductFilter interface {
-	Filter(ctx context.Context, f *filter.ProductFilter) ([]*models.Product, error)
+	Filter(ctx context.Context, f *filter.ProductFilter) (*commonFilter.Page[*models.Product], error)
 }
@@ -0,0 +1,40 @@
+package iface
+
+import (
+	"context"
+	"time"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	"github.com/jackc/pgx/v5"
+)
+
+// PriceReader consulta o histórico de preços. As linhas são gravadas pelos
+// repositórios de produto junto com cada alteração de preço e pelo
+// agendador ao aplicar uma mudança agendada.
+type PriceReader interface {
+	History(ctx context.Context, f *models.PriceFilter) (*commonFilter.Page[*models.ProductPrice], error)
+	EffectiveAt(ctx context.Context, productID int64, at time.Time) (*models.EffectivePrice, error)
+}
+
+// PriceTx consulta o preço vigente dentro de uma transação, para precificar
+// itens de venda com o valor do banco.
+type PriceTx interface {
+	EffectiveAtTx(ctx context.Context, tx pgx.Tx, productID int64, at time.Time) (*models.EffectivePrice, error)
+}
+
+type ScheduledPriceWriter interface {
+	Schedule(ctx context.Context, change *models.ScheduledPrice) (*models.ScheduledPrice, error)
+	ListScheduled(ctx context.Context, productID int64, status string) ([]*models.ScheduledPrice, error)
+	CancelScheduled(ctx context.Context, id int64) (*models.ScheduledPrice, error)
+}
+
+// PriceApplier aplica as mudanças agendadas que venceram. ApplyDueTx roda
+// na transação recebida, para que o agendador registre cada produto
+// alterado na trilha de auditoria antes do commit. NextDue devolve a data
+// da próxima mudança pendente, ou nil se não houver.
+type PriceApplier interface {
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+	ApplyDueTx(ctx context.Context, tx pgx.Tx) ([]*models.AppliedPrice, error)
+	NextDue(ctx context.Context) (*time.Time, error)
+}
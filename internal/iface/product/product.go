@@ -3,6 +3,7 @@ package iface
 import (
 	"context"
 
+	modelsLocation "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
 )
 
@@ -20,7 +21,7 @@ type ProductStock interface {
 	UpdateStock(ctx context.Context, id int64, quantity int) error
 	IncreaseStock(ctx context.Context, id int64, amount int) error
 	DecreaseStock(ctx context.Context, id int64, amount int) error
-	GetStock(ctx context.Context, id int64) (int, error)
+	GetStock(ctx context.Context, id int64) (*modelsLocation.ProductStock, error)
 }
 
 type ProductDiscount interface {
@@ -0,0 +1,12 @@
+package iface
+
+import (
+	"context"
+
+	search "github.com/WagaoCarvalho/backend_store_go/internal/model/product/search"
+)
+
+type ProductSearch interface {
+	Search(ctx context.Context, s *search.ProductSearch) ([]*search.Result, error)
+	Autocomplete(ctx context.Context, s *search.ProductSearch) ([]*search.Suggestion, error)
+}
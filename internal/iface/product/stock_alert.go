@@ -0,0 +1,19 @@
+package iface
+
+import (
+	"context"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_alert"
+)
+
+// StockAlertDetector abre alertas para os produtos que ficaram abaixo do
+// mínimo e resolve os que voltaram a ele. productIDs vazio verifica todos.
+type StockAlertDetector interface {
+	Detect(ctx context.Context, productIDs []int64) ([]*models.StockAlert, error)
+}
+
+type StockAlertReader interface {
+	List(ctx context.Context, f *models.StockAlertFilter) (*commonFilter.Page[*models.StockAlert], error)
+	LowStock(ctx context.Context, supplierID *int64) ([]*models.LowStockItem, error)
+}
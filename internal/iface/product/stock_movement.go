@@ -0,0 +1,16 @@
+package iface
+
+import (
+	"context"
+	"time"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+)
+
+// StockMovementReader consulta o histórico de estoque. Os movimentos são
+// gravados pelos repositórios de produto junto com cada alteração de saldo.
+type StockMovementReader interface {
+	History(ctx context.Context, f *models.StockMovementFilter) (*commonFilter.Page[*models.StockMovement], error)
+	StockAt(ctx context.Context, productID int64, at time.Time) (*models.StockAt, error)
+}
@@ -0,0 +1,41 @@
+package iface
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+)
+
+// ProductStockTx altera o estoque dentro de uma transação. Cada alteração
+// grava um movimento em stock_movements com a origem informada. Os métodos
+// sem local operam sobre o local padrão; os métodos At, sobre locationID.
+type ProductStockTx interface {
+	GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Product, error)
+	GetStockAtForUpdateTx(ctx context.Context, tx pgx.Tx, id, locationID int64) (int, error)
+	DecreaseStockTx(ctx context.Context, tx pgx.Tx, id int64, amount int, origin modelsMovement.Origin) error
+	IncreaseStockTx(ctx context.Context, tx pgx.Tx, id int64, amount int, origin modelsMovement.Origin) error
+	DecreaseStockAtTx(ctx context.Context, tx pgx.Tx, id, locationID int64, amount int, origin modelsMovement.Origin) error
+	IncreaseStockAtTx(ctx context.Context, tx pgx.Tx, id, locationID int64, amount int, origin modelsMovement.Origin) error
+}
+
+// ProductReceiveTx dá entrada de mercadoria comprada: soma a quantidade ao
+// estoque e recalcula cost_price pelo método informado ("latest" ou "average").
+type ProductReceiveTx interface {
+	ReceiveStockTx(ctx context.Context, tx pgx.Tx, id int64, quantity int, unitCost money.Money, costMethod string, origin modelsMovement.Origin) error
+	ReceiveStockAtTx(ctx context.Context, tx pgx.Tx, id, locationID int64, quantity int, unitCost money.Money, costMethod string, origin modelsMovement.Origin) error
+}
+
+// ProductAdjustTx define o saldo absoluto do produto, como no fechamento de
+// um inventário. A diferença para o saldo anterior vira o movimento.
+type ProductAdjustTx interface {
+	SetStockTx(ctx context.Context, tx pgx.Tx, id int64, quantity int, origin modelsMovement.Origin) error
+}
+
+// ProductLocationTx move saldo de um local sem alterar o total do produto,
+// como no envio e no recebimento de transferências. delta negativo retira.
+type ProductLocationTx interface {
+	ShiftStockTx(ctx context.Context, tx pgx.Tx, id, locationID int64, delta int) error
+}
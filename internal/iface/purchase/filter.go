@@ -0,0 +1,13 @@
+package iface
+
+import (
+	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+
+	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+)
+
+type PurchaseOrderFilter interface {
+	Filter(ctx context.Context, f *modelFilter.PurchaseOrderFilter) (*commonFilter.Page[*models.PurchaseOrder], error)
+}
@@ -0,0 +1,11 @@
+package iface
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+)
+
+type PurchaseOrderReader interface {
+	GetByID(ctx context.Context, id int64) (*models.PurchaseOrder, error)
+}
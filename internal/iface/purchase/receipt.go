@@ -0,0 +1,11 @@
+package iface
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/receipt"
+)
+
+type PurchaseReceiptReader interface {
+	GetByOrderID(ctx context.Context, orderID int64) ([]*models.PurchaseReceipt, error)
+}
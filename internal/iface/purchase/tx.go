@@ -0,0 +1,25 @@
+package iface
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	modelsReceipt "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/receipt"
+	"github.com/jackc/pgx/v5"
+)
+
+// PurchaseOrderTx grava o pedido junto com suas linhas. Como pedido e linhas
+// mudam sempre juntos, todas as escritas passam por uma transação.
+type PurchaseOrderTx interface {
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+	CreateTx(ctx context.Context, tx pgx.Tx, order *models.PurchaseOrder) (*models.PurchaseOrder, error)
+	GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.PurchaseOrder, error)
+	UpdateTx(ctx context.Context, tx pgx.Tx, order *models.PurchaseOrder) error
+	UpdateStatusTx(ctx context.Context, tx pgx.Tx, order *models.PurchaseOrder) error
+	ReceiveItemTx(ctx context.Context, tx pgx.Tx, itemID int64, quantity int) error
+	DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error
+}
+
+type PurchaseReceiptTx interface {
+	CreateTx(ctx context.Context, tx pgx.Tx, receipt *modelsReceipt.PurchaseReceipt) (*modelsReceipt.PurchaseReceipt, error)
+}
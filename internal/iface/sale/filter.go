@@ -2,11 +2,12 @@ package iface
 
 import (
 	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
 	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/filter"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
 )
 
 type SaleFilter interface {
-	Filter(ctx context.Context, f *modelFilter.SaleFilter) ([]*models.Sale, error)
+	Filter(ctx context.Context, f *modelFilter.SaleFilter) (*commonFilter.Page[*models.Sale], error)
 }
@@ -0,0 +1,13 @@
+package iface
+
+import (
+	"context"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+)
+
+type SalePaymentReader interface {
+	GetBySaleID(ctx context.Context, saleID int64) ([]*models.SalePayment, error)
+	TotalsByMethod(ctx context.Context, from, to time.Time) ([]*models.MethodTotal, error)
+}
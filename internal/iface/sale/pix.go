@@ -0,0 +1,29 @@
+package iface
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/pix"
+	"github.com/jackc/pgx/v5"
+)
+
+type PixChargeReader interface {
+	GetBySaleID(ctx context.Context, saleID int64) ([]*models.PixCharge, error)
+	GetByTxID(ctx context.Context, txID string) (*models.PixCharge, error)
+}
+
+type PixChargeWriter interface {
+	Create(ctx context.Context, charge *models.PixCharge) (*models.PixCharge, error)
+}
+
+// PixChargeTx confirma cobranças dentro de uma transação, junto com o
+// registro de auditoria da confirmação, e soma o Pix recebido pela venda
+// na transação que a conclui.
+type PixChargeTx interface {
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+	GetByTxIDTx(ctx context.Context, tx pgx.Tx, txID string) (*models.PixCharge, error)
+	ConfirmTx(ctx context.Context, tx pgx.Tx, confirmation pix.Confirmation) (*models.PixCharge, error)
+	ConfirmedAmountTx(ctx context.Context, tx pgx.Tx, saleID int64) (money.Money, error)
+}
@@ -0,0 +1,11 @@
+package iface
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
+)
+
+type SaleReturnReader interface {
+	GetBySaleID(ctx context.Context, saleID int64) ([]*models.SaleReturn, error)
+}
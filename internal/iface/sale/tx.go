@@ -0,0 +1,40 @@
+package iface
+
+import (
+	"context"
+
+	modelsItem "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	modelsReturn "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+)
+
+type SaleTx interface {
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+	CreateTx(ctx context.Context, tx pgx.Tx, sale *models.Sale) (*models.Sale, error)
+	GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Sale, error)
+	RecalculateTotalsTx(ctx context.Context, tx pgx.Tx, sale *models.Sale) error
+	UpdateStatusTx(ctx context.Context, tx pgx.Tx, sale *models.Sale) error
+}
+
+type SaleItemTx interface {
+	CreateTx(ctx context.Context, tx pgx.Tx, item *modelsItem.SaleItem) (*modelsItem.SaleItem, error)
+	GetByIDTx(ctx context.Context, tx pgx.Tx, id int64) (*modelsItem.SaleItem, error)
+	GetBySaleIDTx(ctx context.Context, tx pgx.Tx, saleID int64) ([]*modelsItem.SaleItem, error)
+	UpdateTx(ctx context.Context, tx pgx.Tx, item *modelsItem.SaleItem) error
+	DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error
+	DeleteBySaleIDTx(ctx context.Context, tx pgx.Tx, saleID int64) error
+}
+
+type SaleReturnTx interface {
+	CreateTx(ctx context.Context, tx pgx.Tx, saleReturn *modelsReturn.SaleReturn) (*modelsReturn.SaleReturn, error)
+	GetReturnedQuantitiesTx(ctx context.Context, tx pgx.Tx, saleID int64) (map[int64]int, error)
+	GetRefundedAmountTx(ctx context.Context, tx pgx.Tx, saleID int64) (money.Money, error)
+}
+
+type SalePaymentTx interface {
+	CreateTx(ctx context.Context, tx pgx.Tx, payment *modelsPayment.SalePayment) (*modelsPayment.SalePayment, error)
+	GetBySaleIDTx(ctx context.Context, tx pgx.Tx, saleID int64) ([]*modelsPayment.SalePayment, error)
+}
@@ -0,0 +1,13 @@
+package iface
+
+import (
+	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+
+	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+)
+
+type ServiceOrderFilter interface {
+	Filter(ctx context.Context, f *modelFilter.ServiceOrderFilter) (*commonFilter.Page[*models.ServiceOrder], error)
+}
@@ -0,0 +1,26 @@
+package iface
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	"github.com/jackc/pgx/v5"
+)
+
+// ServiceOrderTx grava a ordem, suas peças e sua mão de obra. Peças mexem no
+// estoque e todas as linhas mexem nos totais, por isso toda escrita passa
+// por uma transação.
+type ServiceOrderTx interface {
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+	CreateTx(ctx context.Context, tx pgx.Tx, order *models.ServiceOrder) (*models.ServiceOrder, error)
+	GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.ServiceOrder, error)
+	UpdateTx(ctx context.Context, tx pgx.Tx, order *models.ServiceOrder) error
+	UpdateStatusTx(ctx context.Context, tx pgx.Tx, order *models.ServiceOrder) error
+	UpdateTotalsTx(ctx context.Context, tx pgx.Tx, order *models.ServiceOrder) error
+	SetSaleTx(ctx context.Context, tx pgx.Tx, order *models.ServiceOrder) error
+
+	AddPartTx(ctx context.Context, tx pgx.Tx, part *models.ServiceOrderPart) (*models.ServiceOrderPart, error)
+	DeletePartTx(ctx context.Context, tx pgx.Tx, id int64) error
+	AddLaborTx(ctx context.Context, tx pgx.Tx, labor *models.ServiceOrderLabor) (*models.ServiceOrderLabor, error)
+	DeleteLaborTx(ctx context.Context, tx pgx.Tx, id int64) error
+}
@@ -2,11 +2,12 @@ package iface
 
 import (
 	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
 	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/supplier/filter"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/supplier/supplier"
 )
 
 type SupplierFilter interface {
-	Filter(ctx context.Context, f *modelFilter.SupplierFilter) ([]*models.Supplier, error)
+	Filter(ctx context.Context, f *modelFilter.SupplierFilter) (*commonFilter.Page[*models.Supplier], error)
 }
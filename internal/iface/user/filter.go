@@ -2,11 +2,12 @@ package iface
 
 import (
 	"context"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/user/filter"
 	user "github.com/WagaoCarvalho/backend_store_go/internal/model/user/user"
 )
 
 type UserFilter interface {
-	Filter(ctx context.Context, f *filter.UserFilter) ([]*user.User, error)
+	Filter(ctx context.Context, f *filter.UserFilter) (*commonFilter.Page[*user.User], error)
 }
@@ -0,0 +1,18 @@
+package iface
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/role"
+)
+
+type UserRoleReader interface {
+	GetAllRoles(ctx context.Context) ([]*models.Role, error)
+	GetRolesByUserID(ctx context.Context, userID int64) ([]*models.Role, error)
+	GetAccessByUserID(ctx context.Context, userID int64) (*models.Access, error)
+}
+
+type UserRoleWriter interface {
+	AssignRole(ctx context.Context, userRole *models.UserRole) (*models.UserRole, error)
+	RemoveRole(ctx context.Context, userID, roleID int64) error
+}
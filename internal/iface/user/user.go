@@ -10,6 +10,11 @@ type UserReader interface {
 	GetByID(ctx context.Context, id int64) (*models.User, error)
 }
 
+// UserCredentials busca o usuário, com o hash da senha, pelo email exato.
+type UserCredentials interface {
+	GetByEmail(ctx context.Context, email string) (*models.User, error)
+}
+
 type UserWriter interface {
 	Create(ctx context.Context, user *models.User) (*models.User, error)
 	Update(ctx context.Context, user *models.User) error
@@ -13,6 +13,7 @@ type Address struct {
 	ID           int64
 	UserID       *int64
 	ClientCpfID  *int64
+	ClientCnpjID *int64
 	SupplierID   *int64
 	Street       string
 	StreetNumber string
@@ -29,9 +30,9 @@ type Address struct {
 func (a *Address) Validate() error {
 	var errs validators.ValidationErrors
 
-	if !validators.ValidateSingleNonNil(a.UserID, a.ClientCpfID, a.SupplierID) {
+	if !validators.ValidateSingleNonNil(a.UserID, a.ClientCpfID, a.ClientCnpjID, a.SupplierID) {
 		errs = append(errs, validators.ValidationError{
-			Field:   "user_id/client_cpf_id/supplier_id",
+			Field:   "user_id/client_cpf_id/client_cnpj_id/supplier_id",
 			Message: validators.MsgInvalidAssociation,
 		})
 	}
@@ -32,6 +32,35 @@ func TestAddress_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Valid address with ClientCnpjID",
+			address: Address{
+				ClientCnpjID: &userID,
+				Street:       "Rua 1",
+				StreetNumber: "123",
+				City:         "Cidade",
+				State:        "SP",
+				Country:      "Brasil",
+				PostalCode:   "12345678",
+			},
+			wantErr: false,
+		},
+		{
+			name: "ClientCnpjID and UserID together",
+			address: Address{
+				UserID:       &userID,
+				ClientCnpjID: &userID,
+				Street:       "Rua 1",
+				StreetNumber: "123",
+				City:         "Cidade",
+				State:        "SP",
+				Country:      "Brasil",
+				PostalCode:   "12345678",
+			},
+			wantErr: true,
+			errType: validators.ValidationErrors{},
+			errMsg:  validators.MsgInvalidAssociation,
+		},
 		{
 			name: "Missing all IDs",
 			address: Address{
@@ -11,6 +11,7 @@ type AddressFilter struct {
 	filter.BaseFilter
 	UserID       *int64
 	ClientCpfID  *int64
+	ClientCnpjID *int64
 	SupplierID   *int64
 	Street       string
 	StreetNumber string
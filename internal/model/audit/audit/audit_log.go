@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+)
+
+// Ações registradas pela trilha de auditoria. Mudanças de estado específicas
+// (estoque, status, desconto) usam nomes próprios, como "enable" ou "cancel".
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// FieldChange guarda o valor de um campo antes e depois da escrita.
+type FieldChange struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
+
+type AuditLog struct {
+	ID          int64
+	ActorUserID *int64
+	RequestID   string
+	EntityType  string
+	EntityID    int64
+	Action      string
+	Changes     map[string]FieldChange
+	CreatedAt   time.Time
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+type AuditFilter struct {
+	filter.BaseFilter
+
+	ActorUserID *int64
+	RequestID   string
+	EntityType  string
+	EntityID    *int64
+	Action      string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+func (f *AuditFilter) Validate() error {
+	if err := f.BaseFilter.Validate(); err != nil {
+		return err
+	}
+
+	if f.EntityID != nil && *f.EntityID <= 0 {
+		return &validators.ValidationError{Field: "EntityID", Message: "deve ser maior que zero"}
+	}
+
+	if f.EntityID != nil && f.EntityType == "" {
+		return &validators.ValidationError{Field: "EntityType", Message: "obrigatório ao filtrar por EntityID"}
+	}
+
+	if f.CreatedFrom != nil && f.CreatedTo != nil && f.CreatedFrom.After(*f.CreatedTo) {
+		return &validators.ValidationError{
+			Field:   "CreatedFrom/CreatedTo",
+			Message: "intervalo de criação inválido",
+		}
+	}
+
+	return nil
+}
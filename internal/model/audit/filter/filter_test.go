@@ -0,0 +1,40 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditFilter_Validate(t *testing.T) {
+	id := int64(5)
+	zero := int64(0)
+	now := time.Now()
+	before := now.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		filter  AuditFilter
+		wantErr bool
+	}{
+		{"vazio é válido", AuditFilter{}, false},
+		{"entidade com id", AuditFilter{EntityType: "product", EntityID: &id}, false},
+		{"id sem entidade", AuditFilter{EntityID: &id}, true},
+		{"id inválido", AuditFilter{EntityType: "product", EntityID: &zero}, true},
+		{"intervalo invertido", AuditFilter{CreatedFrom: &now, CreatedTo: &before}, true},
+		{"ordenação inválida", AuditFilter{}, true},
+	}
+	tests[5].filter.SortOrder = "sideways"
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.filter.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"time"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+// CashSessionFilter filtra o histórico de caixas. Result (over, short, even)
+// só encontra caixas fechados.
+type CashSessionFilter struct {
+	filter.BaseFilter
+	UserID     *int64
+	Status     string
+	Result     string
+	OpenedFrom *time.Time
+	OpenedTo   *time.Time
+	ClosedFrom *time.Time
+	ClosedTo   *time.Time
+}
+
+func (f *CashSessionFilter) Validate() error {
+	if err := f.BaseFilter.Validate(); err != nil {
+		return err
+	}
+
+	if f.Status != "" {
+		allowedStatuses := map[string]bool{"open": true, "closed": true}
+		if !allowedStatuses[f.Status] {
+			return &validators.ValidationError{
+				Field:   "Status",
+				Message: "status inválido. Valores permitidos: open, closed",
+			}
+		}
+	}
+
+	if f.Result != "" {
+		allowedResults := map[string]bool{"over": true, "short": true, "even": true}
+		if !allowedResults[f.Result] {
+			return &validators.ValidationError{
+				Field:   "Result",
+				Message: "resultado inválido. Valores permitidos: over, short, even",
+			}
+		}
+	}
+
+	if f.OpenedFrom != nil && f.OpenedTo != nil && f.OpenedFrom.After(*f.OpenedTo) {
+		return &validators.ValidationError{
+			Field:   "OpenedFrom/OpenedTo",
+			Message: "intervalo de abertura inválido",
+		}
+	}
+
+	if f.ClosedFrom != nil && f.ClosedTo != nil && f.ClosedFrom.After(*f.ClosedTo) {
+		return &validators.ValidationError{
+			Field:   "ClosedFrom/ClosedTo",
+			Message: "intervalo de fechamento inválido",
+		}
+	}
+
+	return nil
+}
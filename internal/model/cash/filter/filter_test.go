@@ -0,0 +1,50 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCashSessionFilter_Validate(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Hour)
+
+	t.Run("valid filter", func(t *testing.T) {
+		f := &CashSessionFilter{
+			BaseFilter: filter.BaseFilter{Limit: 10},
+			Status:     "closed",
+			Result:     "short",
+			OpenedFrom: &before,
+			OpenedTo:   &now,
+		}
+		assert.NoError(t, f.Validate())
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		f := &CashSessionFilter{BaseFilter: filter.BaseFilter{Limit: 10}, Status: "canceled"}
+		assert.ErrorContains(t, f.Validate(), "status inválido")
+	})
+
+	t.Run("invalid result", func(t *testing.T) {
+		f := &CashSessionFilter{BaseFilter: filter.BaseFilter{Limit: 10}, Result: "missing"}
+		assert.ErrorContains(t, f.Validate(), "resultado inválido")
+	})
+
+	t.Run("invalid opened range", func(t *testing.T) {
+		f := &CashSessionFilter{BaseFilter: filter.BaseFilter{Limit: 10}, OpenedFrom: &now, OpenedTo: &before}
+		assert.ErrorContains(t, f.Validate(), "intervalo de abertura")
+	})
+
+	t.Run("invalid closed range", func(t *testing.T) {
+		f := &CashSessionFilter{BaseFilter: filter.BaseFilter{Limit: 10}, ClosedFrom: &now, ClosedTo: &before}
+		assert.ErrorContains(t, f.Validate(), "intervalo de fechamento")
+	})
+
+	t.Run("invalid base filter", func(t *testing.T) {
+		f := &CashSessionFilter{BaseFilter: filter.BaseFilter{Limit: -1}}
+		assert.Error(t, f.Validate())
+	})
+}
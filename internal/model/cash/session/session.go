@@ -0,0 +1,231 @@
+package model
+
+import (
+	"time"
+
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+// Ciclo de vida do caixa: open → closed. Cada operador tem no máximo um
+// caixa aberto.
+const (
+	StatusOpen   = "open"
+	StatusClosed = "closed"
+)
+
+// Movimentos de dinheiro fora das vendas: sangria (withdrawal) e suprimento
+// (deposit), lançados pelo operador, e devolução em dinheiro ao cliente
+// (refund), lançada pelo cancelamento ou pela devolução da venda.
+const (
+	MovementWithdrawal = "withdrawal"
+	MovementDeposit    = "deposit"
+	MovementRefund     = "refund"
+)
+
+// Resultado da conferência no fechamento: sobra, falta ou caixa batido.
+const (
+	ResultOver  = "over"
+	ResultShort = "short"
+	ResultEven  = "even"
+)
+
+// CashSession é o caixa de um operador. ExpectedAmount, CountedAmount e
+// Difference só são preenchidos no fechamento.
+type CashSession struct {
+	ID             int64
+	UserID         int64
+	Status         string
+	OpeningAmount  money.Money
+	ExpectedAmount *money.Money
+	CountedAmount  *money.Money
+	Difference     *money.Money
+	Notes          string
+	ClosingNotes   string
+	ClosedBy       *int64
+	Version        int
+	OpenedAt       time.Time
+	UpdatedAt      time.Time
+	ClosedAt       *time.Time
+}
+
+func (s *CashSession) Validate() error {
+	var errs validators.ValidationErrors
+
+	if s.UserID <= 0 {
+		errs = append(errs, validators.ValidationError{Field: "user_id", Message: validators.MsgRequiredField})
+	}
+
+	if s.OpeningAmount.IsNegative() {
+		errs = append(errs, validators.ValidationError{Field: "opening_amount", Message: "must be greater than or equal to 0"})
+	}
+
+	if len(s.Notes) > 500 {
+		errs = append(errs, validators.ValidationError{Field: "notes", Message: "max 500 characters"})
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+func (s *CashSession) IsOpen() bool {
+	return s.Status == StatusOpen
+}
+
+// Result classifica a diferença do fechamento; vazio enquanto aberto.
+func (s *CashSession) Result() string {
+	if s.Difference == nil {
+		return ""
+	}
+	return ResultOf(*s.Difference)
+}
+
+// ResultOf classifica uma diferença contado - esperado.
+func ResultOf(difference money.Money) string {
+	switch {
+	case difference.IsPositive():
+		return ResultOver
+	case difference.IsNegative():
+		return ResultShort
+	default:
+		return ResultEven
+	}
+}
+
+// CashMovement é uma sangria, um suprimento ou uma devolução lançada no
+// caixa. SaleID só existe nas devoluções.
+type CashMovement struct {
+	ID            int64
+	CashSessionID int64
+	Type          string
+	Amount        money.Money
+	Reason        string
+	UserID        *int64
+	SaleID        *int64
+	CreatedAt     time.Time
+}
+
+// NewRefund é a devolução em dinheiro da venda saleID, paga pelo operador
+// userID do caixa aberto dele.
+func NewRefund(saleID int64, userID *int64, amount money.Money, reason string) *CashMovement {
+	return &CashMovement{
+		Type:   MovementRefund,
+		Amount: amount,
+		Reason: reason,
+		UserID: userID,
+		SaleID: &saleID,
+	}
+}
+
+func (m *CashMovement) Validate() error {
+	var errs validators.ValidationErrors
+
+	switch m.Type {
+	case "":
+		errs = append(errs, validators.ValidationError{Field: "type", Message: validators.MsgRequiredField})
+	case MovementWithdrawal, MovementDeposit:
+	default:
+		errs = append(errs, validators.ValidationError{Field: "type", Message: "invalid movement type"})
+	}
+
+	if !m.Amount.IsPositive() {
+		errs = append(errs, validators.ValidationError{Field: "amount", Message: "must be greater than 0"})
+	}
+
+	if len(m.Reason) > 255 {
+		errs = append(errs, validators.ValidationError{Field: "reason", Message: "max 255 characters"})
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// Closing é a conferência informada pelo operador ao fechar o caixa.
+type Closing struct {
+	CountedAmount money.Money
+	Notes         string
+	ClosedBy      *int64
+}
+
+func (c *Closing) Validate() error {
+	var errs validators.ValidationErrors
+
+	if c.CountedAmount.IsNegative() {
+		errs = append(errs, validators.ValidationError{Field: "counted_amount", Message: "must be greater than or equal to 0"})
+	}
+
+	if len(c.Notes) > 500 {
+		errs = append(errs, validators.ValidationError{Field: "notes", Message: "max 500 characters"})
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// MovementTotals soma as sangrias, os suprimentos e as devoluções de um
+// caixa.
+type MovementTotals struct {
+	Deposits    money.Money
+	Withdrawals money.Money
+	Refunds     money.Money
+}
+
+// Summary é o resumo do caixa por forma de pagamento. Só o dinheiro entra na
+// gaveta: o esperado é abertura + vendas em dinheiro (líquidas de troco) +
+// suprimentos - sangrias - devoluções em dinheiro. Counted e Difference vêm
+// do fechamento.
+type Summary struct {
+	Session     *CashSession
+	Tenders     []*modelsPayment.MethodTotal
+	CashSales   money.Money
+	Deposits    money.Money
+	Withdrawals money.Money
+	Refunds     money.Money
+	Expected    money.Money
+	Counted     *money.Money
+	Difference  *money.Money
+}
+
+func NewSummary(session *CashSession, tenders []*modelsPayment.MethodTotal, movements MovementTotals) *Summary {
+	s := &Summary{
+		Session:     session,
+		Tenders:     tenders,
+		Deposits:    movements.Deposits,
+		Withdrawals: movements.Withdrawals,
+		Refunds:     movements.Refunds,
+		Counted:     session.CountedAmount,
+		Difference:  session.Difference,
+	}
+
+	for _, t := range tenders {
+		if t.Method == modelsPayment.MethodCash {
+			s.CashSales = s.CashSales.Add(t.Amount)
+		}
+	}
+
+	s.Expected = session.OpeningAmount.Add(s.CashSales).Add(s.Deposits).Sub(s.Withdrawals).Sub(s.Refunds)
+
+	return s
+}
+
+// Reconcile confronta o valor contado com o esperado.
+func (s *Summary) Reconcile(counted money.Money) {
+	difference := counted.Sub(s.Expected)
+	s.Counted = &counted
+	s.Difference = &difference
+}
+
+// Result classifica a diferença; vazio antes da conferência.
+func (s *Summary) Result() string {
+	if s.Difference == nil {
+		return ""
+	}
+	return ResultOf(*s.Difference)
+}
@@ -0,0 +1,81 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCashSession_Validate(t *testing.T) {
+	assert.NoError(t, (&CashSession{UserID: 1}).Validate())
+	assert.NoError(t, (&CashSession{UserID: 1, OpeningAmount: money.New(200), Notes: "turno manhã"}).Validate())
+
+	assert.Error(t, (&CashSession{}).Validate())
+	assert.Error(t, (&CashSession{UserID: 1, OpeningAmount: money.New(-1)}).Validate())
+	assert.Error(t, (&CashSession{UserID: 1, Notes: strings.Repeat("a", 501)}).Validate())
+}
+
+func TestCashMovement_Validate(t *testing.T) {
+	assert.NoError(t, (&CashMovement{Type: MovementWithdrawal, Amount: money.New(50)}).Validate())
+	assert.NoError(t, (&CashMovement{Type: MovementDeposit, Amount: money.FromCents(1), Reason: "troco"}).Validate())
+
+	assert.Error(t, (&CashMovement{Amount: money.New(50)}).Validate())
+	assert.Error(t, (&CashMovement{Type: "transfer", Amount: money.New(50)}).Validate())
+	assert.Error(t, (&CashMovement{Type: MovementDeposit}).Validate())
+	assert.Error(t, (&CashMovement{Type: MovementDeposit, Amount: money.New(1), Reason: strings.Repeat("a", 256)}).Validate())
+}
+
+func TestClosing_Validate(t *testing.T) {
+	assert.NoError(t, (&Closing{}).Validate())
+	assert.Error(t, (&Closing{CountedAmount: money.New(-1)}).Validate())
+	assert.Error(t, (&Closing{Notes: strings.Repeat("a", 501)}).Validate())
+}
+
+func TestNewSummary(t *testing.T) {
+	session := &CashSession{ID: 1, Status: StatusOpen, OpeningAmount: money.New(100)}
+	tenders := []*modelsPayment.MethodTotal{
+		{Method: modelsPayment.MethodCard, Sales: 2, Amount: money.MustParse("80.00")},
+		{Method: modelsPayment.MethodCash, Sales: 3, Amount: money.MustParse("57.30"), ChangeGiven: money.MustParse("2.70")},
+	}
+
+	s := NewSummary(session, tenders, MovementTotals{Deposits: money.New(20), Withdrawals: money.New(50)})
+
+	assert.Equal(t, money.MustParse("57.30"), s.CashSales)
+	assert.Equal(t, money.MustParse("127.30"), s.Expected)
+	assert.Nil(t, s.Counted)
+	assert.Equal(t, "", s.Result())
+
+	s.Reconcile(money.New(127))
+	assert.Equal(t, money.MustParse("-0.30"), *s.Difference)
+	assert.Equal(t, ResultShort, s.Result())
+
+	s.Reconcile(money.MustParse("127.30"))
+	assert.Equal(t, ResultEven, s.Result())
+}
+
+func TestNewSummary_Refunds(t *testing.T) {
+	session := &CashSession{ID: 1, Status: StatusOpen, OpeningAmount: money.New(100)}
+	tenders := []*modelsPayment.MethodTotal{
+		{Method: modelsPayment.MethodCash, Sales: 1, Amount: money.New(45)},
+	}
+
+	// Venda de 45,00 em dinheiro com devolução parcial de 9,00 pela gaveta.
+	s := NewSummary(session, tenders, MovementTotals{Refunds: money.New(9)})
+
+	assert.Equal(t, money.New(45), s.CashSales)
+	assert.Equal(t, money.New(9), s.Refunds)
+	assert.Equal(t, money.New(136), s.Expected)
+}
+
+func TestResultOf(t *testing.T) {
+	assert.Equal(t, ResultOver, ResultOf(money.FromCents(1)))
+	assert.Equal(t, ResultShort, ResultOf(money.FromCents(-1)))
+	assert.Equal(t, ResultEven, ResultOf(money.Zero))
+
+	diff := money.New(5)
+	assert.Equal(t, ResultOver, (&CashSession{Difference: &diff}).Result())
+	assert.Equal(t, "", (&CashSession{}).Result())
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	valCpfCnpj "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/cpf_cnpj"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+type ClientCnpj struct {
+	ID                int64
+	Name              string
+	TradeName         string
+	Email             string
+	CNPJ              string
+	StateRegistration string
+	Description       string
+	Version           int
+	Status            bool
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// cnpjFormatting remove a pontuação usual (00.000.000/0000-00); o banco guarda
+// apenas os 14 dígitos.
+var cnpjFormatting = strings.NewReplacer(".", "", "/", "", "-", "")
+
+func (c *ClientCnpj) Validate() error {
+
+	if validators.IsBlank(c.Name) {
+		return &validators.ValidationError{Field: "Name", Message: "campo obrigatório"}
+	}
+	if len(c.Name) > 255 {
+		return &validators.ValidationError{Field: "Name", Message: "máximo de 255 caracteres"}
+	}
+
+	if len(c.TradeName) > 255 {
+		return &validators.ValidationError{Field: "TradeName", Message: "máximo de 255 caracteres"}
+	}
+
+	c.Email = strings.TrimSpace(strings.ToLower(c.Email))
+	if validators.IsBlank(c.Email) {
+		return &validators.ValidationError{Field: "Email", Message: "campo obrigatório"}
+	}
+	if !validators.IsEmail(c.Email) {
+		return &validators.ValidationError{Field: "Email", Message: "email inválido"}
+	}
+
+	c.CNPJ = cnpjFormatting.Replace(strings.TrimSpace(c.CNPJ))
+	if validators.IsBlank(c.CNPJ) {
+		return &validators.ValidationError{Field: "CNPJ", Message: "campo obrigatório"}
+	}
+	if !valCpfCnpj.IsValidCNPJ(c.CNPJ) {
+		return &validators.ValidationError{Field: "CNPJ", Message: "CNPJ inválido"}
+	}
+
+	c.StateRegistration = strings.TrimSpace(c.StateRegistration)
+	if len(c.StateRegistration) > 20 {
+		return &validators.ValidationError{Field: "StateRegistration", Message: "máximo de 20 caracteres"}
+	}
+
+	if len(c.Description) > 1000 {
+		return &validators.ValidationError{Field: "Description", Message: "máximo de 1000 caracteres"}
+	}
+
+	if c.Version <= 0 {
+		return &validators.ValidationError{Field: "Version", Message: "versão inválida"}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validClientCnpj() *ClientCnpj {
+	return &ClientCnpj{
+		Name:    "Mercado Exemplo LTDA",
+		Email:   "compras@exemplo.com",
+		CNPJ:    "12345678000195",
+		Version: 1,
+	}
+}
+
+func TestClientCnpj_Validate(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		c := validClientCnpj()
+		assert.NoError(t, c.Validate())
+	})
+
+	t.Run("normaliza CNPJ formatado e email", func(t *testing.T) {
+		c := validClientCnpj()
+		c.CNPJ = " 12.345.678/0001-95 "
+		c.Email = " Compras@Exemplo.COM "
+
+		assert.NoError(t, c.Validate())
+		assert.Equal(t, "12345678000195", c.CNPJ)
+		assert.Equal(t, "compras@exemplo.com", c.Email)
+	})
+
+	cases := []struct {
+		name   string
+		mutate func(c *ClientCnpj)
+		field  string
+	}{
+		{"razão social em branco", func(c *ClientCnpj) { c.Name = " " }, "Name"},
+		{"razão social longa", func(c *ClientCnpj) { c.Name = strings.Repeat("a", 256) }, "Name"},
+		{"nome fantasia longo", func(c *ClientCnpj) { c.TradeName = strings.Repeat("a", 256) }, "TradeName"},
+		{"email em branco", func(c *ClientCnpj) { c.Email = "" }, "Email"},
+		{"email inválido", func(c *ClientCnpj) { c.Email = "email-invalido" }, "Email"},
+		{"CNPJ em branco", func(c *ClientCnpj) { c.CNPJ = "" }, "CNPJ"},
+		{"CNPJ com tamanho errado", func(c *ClientCnpj) { c.CNPJ = "12345678909" }, "CNPJ"},
+		{"CNPJ com dígitos repetidos", func(c *ClientCnpj) { c.CNPJ = "11111111111111" }, "CNPJ"},
+		{"inscrição estadual longa", func(c *ClientCnpj) { c.StateRegistration = strings.Repeat("1", 21) }, "StateRegistration"},
+		{"descrição longa", func(c *ClientCnpj) { c.Description = strings.Repeat("a", 1001) }, "Description"},
+		{"versão inválida", func(c *ClientCnpj) { c.Version = 0 }, "Version"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := validClientCnpj()
+			tc.mutate(c)
+
+			err := c.Validate()
+
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tc.field)
+		})
+	}
+}
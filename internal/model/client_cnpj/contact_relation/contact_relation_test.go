@@ -0,0 +1,26 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientCnpjContactRelation_Validate(t *testing.T) {
+	t.Run("sucesso", func(t *testing.T) {
+		rel := &ClientCnpjContactRelation{ClientCnpjID: 1, ContactID: 2}
+		assert.NoError(t, rel.Validate())
+	})
+
+	t.Run("client_cnpj_id inválido", func(t *testing.T) {
+		rel := &ClientCnpjContactRelation{ClientCnpjID: 0, ContactID: 2}
+		err := rel.Validate()
+		assert.ErrorContains(t, err, "client_cnpj_id")
+	})
+
+	t.Run("contact_id inválido", func(t *testing.T) {
+		rel := &ClientCnpjContactRelation{ClientCnpjID: 1, ContactID: -1}
+		err := rel.Validate()
+		assert.ErrorContains(t, err, "contact_id")
+	})
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientCnpjFilter_Validate(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Hour)
+
+	t.Run("sucesso", func(t *testing.T) {
+		f := &ClientCnpjFilter{BaseFilter: filter.BaseFilter{Limit: 10}, CreatedFrom: &before, CreatedTo: &now}
+		assert.NoError(t, f.Validate())
+	})
+
+	t.Run("base inválida", func(t *testing.T) {
+		f := &ClientCnpjFilter{BaseFilter: filter.BaseFilter{Limit: -1}}
+		assert.Error(t, f.Validate())
+	})
+
+	t.Run("intervalo de criação invertido", func(t *testing.T) {
+		f := &ClientCnpjFilter{BaseFilter: filter.BaseFilter{Limit: 10}, CreatedFrom: &now, CreatedTo: &before}
+		assert.ErrorContains(t, f.Validate(), "intervalo de criação inválido")
+	})
+
+	t.Run("intervalo de atualização invertido", func(t *testing.T) {
+		f := &ClientCnpjFilter{BaseFilter: filter.BaseFilter{Limit: 10}, UpdatedFrom: &now, UpdatedTo: &before}
+		assert.ErrorContains(t, f.Validate(), "intervalo de atualização inválido")
+	})
+}
@@ -3,6 +3,7 @@ package model
 import (
 	"time"
 
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
 )
 
@@ -10,8 +11,8 @@ type ClientCredit struct {
 	ID            int64
 	ClientID      int64
 	AllowCredit   bool
-	CreditLimit   float64
-	CreditBalance float64
+	CreditLimit   money.Money
+	CreditBalance money.Money
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
@@ -28,7 +29,7 @@ func (cc *ClientCredit) Validate() error {
 	}
 
 	// Validar CreditLimit
-	if cc.CreditLimit < 0 {
+	if cc.CreditLimit.IsNegative() {
 		validationErrors = append(validationErrors, validators.ValidationError{
 			Field:   "credit_limit",
 			Message: "limite de crédito não pode ser negativo",
@@ -36,7 +37,7 @@ func (cc *ClientCredit) Validate() error {
 	}
 
 	// Validar CreditBalance
-	if cc.CreditBalance < 0 {
+	if cc.CreditBalance.IsNegative() {
 		validationErrors = append(validationErrors, validators.ValidationError{
 			Field:   "credit_balance",
 			Message: "saldo de crédito não pode ser negativo",
@@ -44,8 +45,8 @@ func (cc *ClientCredit) Validate() error {
 	}
 
 	// Validar relação entre balance e limite (apenas se ambos forem válidos)
-	if cc.CreditLimit >= 0 && cc.CreditBalance >= 0 {
-		if cc.CreditBalance > cc.CreditLimit {
+	if !cc.CreditLimit.IsNegative() && !cc.CreditBalance.IsNegative() {
+		if cc.CreditBalance.GreaterThan(cc.CreditLimit) {
 			validationErrors = append(validationErrors, validators.ValidationError{
 				Field:   "credit_balance",
 				Message: "saldo não pode ser maior que o limite de crédito",
@@ -67,7 +68,7 @@ func (cc *ClientCredit) ValidateForUpdate(oldCredit *ClientCredit) error {
 	var validationErrors []validators.ValidationError
 
 	// Validar que não está reduzindo o limite abaixo do saldo atual
-	if oldCredit != nil && cc.CreditLimit < oldCredit.CreditBalance {
+	if oldCredit != nil && cc.CreditLimit.LessThan(oldCredit.CreditBalance) {
 		validationErrors = append(validationErrors, validators.ValidationError{
 			Field:   "credit_limit",
 			Message: "novo limite não pode ser menor que o saldo atual",
@@ -77,8 +78,25 @@ func (cc *ClientCredit) ValidateForUpdate(oldCredit *ClientCredit) error {
 	return validators.NewValidationErrors(validationErrors)
 }
 
+// ValidateCreditLine valida a abertura ou o ajuste da linha de crédito,
+// espelhando a constraint chk_credit_allowed_consistency da tabela
+func (cc *ClientCredit) ValidateCreditLine() error {
+	if err := cc.Validate(); err != nil {
+		return err
+	}
+
+	if !cc.AllowCredit && cc.CreditLimit.IsPositive() {
+		return validators.NewValidationErrors([]validators.ValidationError{{
+			Field:   "credit_limit",
+			Message: "limite de crédito exige crédito habilitado",
+		}})
+	}
+
+	return nil
+}
+
 // CanUseCredit verifica se pode usar crédito
-func (cc *ClientCredit) CanUseCredit(amount float64) (bool, error) {
+func (cc *ClientCredit) CanUseCredit(amount money.Money) (bool, error) {
 	var validationErrors []validators.ValidationError
 
 	if !cc.AllowCredit {
@@ -88,15 +106,15 @@ func (cc *ClientCredit) CanUseCredit(amount float64) (bool, error) {
 		})
 	}
 
-	if amount <= 0 {
+	if !amount.IsPositive() {
 		validationErrors = append(validationErrors, validators.ValidationError{
 			Field:   "amount",
 			Message: "valor deve ser positivo",
 		})
 	}
 
-	available := cc.CreditLimit - cc.CreditBalance
-	if amount > available {
+	available := cc.CreditLimit.Sub(cc.CreditBalance)
+	if amount.GreaterThan(available) {
 		validationErrors = append(validationErrors, validators.ValidationError{
 			Field:   "amount",
 			Message: "valor excede o crédito disponível",
@@ -111,31 +129,31 @@ func (cc *ClientCredit) CanUseCredit(amount float64) (bool, error) {
 }
 
 // UseCredit utiliza crédito
-func (cc *ClientCredit) UseCredit(amount float64) error {
+func (cc *ClientCredit) UseCredit(amount money.Money) error {
 	canUse, err := cc.CanUseCredit(amount)
 	if !canUse {
 		return err
 	}
 
-	cc.CreditBalance += amount
+	cc.CreditBalance = cc.CreditBalance.Add(amount)
 	cc.UpdatedAt = time.Now()
 
 	return nil
 }
 
 // AddCredit adiciona crédito (pagamento, por exemplo)
-func (cc *ClientCredit) AddCredit(amount float64) error {
+func (cc *ClientCredit) AddCredit(amount money.Money) error {
 	var validationErrors []validators.ValidationError
 
-	if amount <= 0 {
+	if !amount.IsPositive() {
 		validationErrors = append(validationErrors, validators.ValidationError{
 			Field:   "amount",
 			Message: "valor deve ser positivo",
 		})
 	}
 
-	newBalance := cc.CreditBalance - amount
-	if newBalance < 0 {
+	newBalance := cc.CreditBalance.Sub(amount)
+	if newBalance.IsNegative() {
 		validationErrors = append(validationErrors, validators.ValidationError{
 			Field:   "amount",
 			Message: "valor excede o saldo atual",
@@ -153,26 +171,26 @@ func (cc *ClientCredit) AddCredit(amount float64) error {
 }
 
 // AvailableCredit retorna o crédito disponível
-func (cc *ClientCredit) AvailableCredit() float64 {
+func (cc *ClientCredit) AvailableCredit() money.Money {
 	if !cc.AllowCredit {
-		return 0
+		return money.Zero
 	}
-	return cc.CreditLimit - cc.CreditBalance
+	return cc.CreditLimit.Sub(cc.CreditBalance)
 }
 
 // IsCreditAvailable verifica se há crédito disponível para um valor específico
-func (cc *ClientCredit) IsCreditAvailable(amount float64) bool {
+func (cc *ClientCredit) IsCreditAvailable(amount money.Money) bool {
 	if !cc.AllowCredit {
 		return false
 	}
-	return cc.AvailableCredit() >= amount
+	return !cc.AvailableCredit().LessThan(amount)
 }
 
 // SetCreditLimit define um novo limite de crédito com validação
-func (cc *ClientCredit) SetCreditLimit(newLimit float64) error {
+func (cc *ClientCredit) SetCreditLimit(newLimit money.Money) error {
 	var validationErrors []validators.ValidationError
 
-	if newLimit < 0 {
+	if newLimit.IsNegative() {
 		validationErrors = append(validationErrors, validators.ValidationError{
 			Field:   "credit_limit",
 			Message: "limite de crédito não pode ser negativo",
@@ -180,7 +198,7 @@ func (cc *ClientCredit) SetCreditLimit(newLimit float64) error {
 	}
 
 	// Verificar se o novo limite é menor que o saldo atual
-	if newLimit < cc.CreditBalance {
+	if newLimit.LessThan(cc.CreditBalance) {
 		validationErrors = append(validationErrors, validators.ValidationError{
 			Field:   "credit_limit",
 			Message: "novo limite não pode ser menor que o saldo atual",
@@ -3,6 +3,7 @@ package model
 import (
 	"testing"
 
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -10,8 +11,8 @@ func TestClientCredit_Validate_Success(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      10,
 		AllowCredit:   true,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
 	err := cc.Validate()
@@ -21,8 +22,8 @@ func TestClientCredit_Validate_Success(t *testing.T) {
 func TestClientCredit_Validate_InvalidClientID(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      0,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
 	err := cc.Validate()
@@ -33,8 +34,8 @@ func TestClientCredit_Validate_InvalidClientID(t *testing.T) {
 func TestClientCredit_Validate_NegativeLimit(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
-		CreditLimit:   -10.0,
-		CreditBalance: 0,
+		CreditLimit:   money.New(-10),
+		CreditBalance: money.New(0),
 	}
 
 	err := cc.Validate()
@@ -45,8 +46,8 @@ func TestClientCredit_Validate_NegativeLimit(t *testing.T) {
 func TestClientCredit_Validate_NegativeBalance(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
-		CreditLimit:   100.0,
-		CreditBalance: -5.0,
+		CreditLimit:   money.New(100),
+		CreditBalance: money.New(-5),
 	}
 
 	err := cc.Validate()
@@ -57,8 +58,8 @@ func TestClientCredit_Validate_NegativeBalance(t *testing.T) {
 func TestClientCredit_Validate_BalanceGreaterThanLimit(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
-		CreditLimit:   100.0,
-		CreditBalance: 200.0,
+		CreditLimit:   money.New(100),
+		CreditBalance: money.New(200),
 	}
 
 	err := cc.Validate()
@@ -69,14 +70,14 @@ func TestClientCredit_Validate_BalanceGreaterThanLimit(t *testing.T) {
 func TestClientCredit_ValidateForUpdate_Valid(t *testing.T) {
 	oldCredit := &ClientCredit{
 		ClientID:      1,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
 	newCredit := &ClientCredit{
 		ClientID:      1,
-		CreditLimit:   1500.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1500),
+		CreditBalance: money.New(500),
 	}
 
 	err := newCredit.ValidateForUpdate(oldCredit)
@@ -86,14 +87,14 @@ func TestClientCredit_ValidateForUpdate_Valid(t *testing.T) {
 func TestClientCredit_ValidateForUpdate_LimitBelowBalance(t *testing.T) {
 	oldCredit := &ClientCredit{
 		ClientID:      1,
-		CreditLimit:   1000.0,
-		CreditBalance: 800.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(800),
 	}
 
 	newCredit := &ClientCredit{
 		ClientID:      1,
-		CreditLimit:   700.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(700),
+		CreditBalance: money.New(500),
 	}
 
 	err := newCredit.ValidateForUpdate(oldCredit)
@@ -105,11 +106,11 @@ func TestClientCredit_CanUseCredit_Success(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
 		AllowCredit:   true,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
-	canUse, err := cc.CanUseCredit(200.0)
+	canUse, err := cc.CanUseCredit(money.New(200))
 	assert.True(t, canUse)
 	assert.NoError(t, err)
 }
@@ -118,11 +119,11 @@ func TestClientCredit_CanUseCredit_CreditNotAllowed(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
 		AllowCredit:   false,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
-	canUse, err := cc.CanUseCredit(200.0)
+	canUse, err := cc.CanUseCredit(money.New(200))
 	assert.False(t, canUse)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "allow_credit")
@@ -132,11 +133,11 @@ func TestClientCredit_CanUseCredit_InsufficientCredit(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
 		AllowCredit:   true,
-		CreditLimit:   1000.0,
-		CreditBalance: 900.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(900),
 	}
 
-	canUse, err := cc.CanUseCredit(200.0)
+	canUse, err := cc.CanUseCredit(money.New(200))
 	assert.False(t, canUse)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "amount")
@@ -146,11 +147,11 @@ func TestClientCredit_CanUseCredit_InvalidAmount(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
 		AllowCredit:   true,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
-	canUse, err := cc.CanUseCredit(0)
+	canUse, err := cc.CanUseCredit(money.New(0))
 	assert.False(t, canUse)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "amount")
@@ -160,11 +161,11 @@ func TestClientCredit_CanUseCredit_NegativeAmount(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
 		AllowCredit:   true,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
-	canUse, err := cc.CanUseCredit(-50.0)
+	canUse, err := cc.CanUseCredit(money.New(-50))
 	assert.False(t, canUse)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "amount")
@@ -174,26 +175,26 @@ func TestClientCredit_UseCredit_Success(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
 		AllowCredit:   true,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
 	initialBalance := cc.CreditBalance
-	err := cc.UseCredit(200.0)
+	err := cc.UseCredit(money.New(200))
 	assert.NoError(t, err)
-	assert.Equal(t, initialBalance+200.0, cc.CreditBalance)
+	assert.Equal(t, initialBalance.Add(money.New(200)), cc.CreditBalance)
 }
 
 func TestClientCredit_UseCredit_Failure(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
 		AllowCredit:   false,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
 	initialBalance := cc.CreditBalance
-	err := cc.UseCredit(200.0)
+	err := cc.UseCredit(money.New(200))
 	assert.Error(t, err)
 	assert.Equal(t, initialBalance, cc.CreditBalance)
 }
@@ -202,26 +203,26 @@ func TestClientCredit_AddCredit_Success(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
 		AllowCredit:   true,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
 	initialBalance := cc.CreditBalance
-	err := cc.AddCredit(200.0)
+	err := cc.AddCredit(money.New(200))
 	assert.NoError(t, err)
-	assert.Equal(t, initialBalance-200.0, cc.CreditBalance)
+	assert.Equal(t, initialBalance.Sub(money.New(200)), cc.CreditBalance)
 }
 
 func TestClientCredit_AddCredit_InvalidAmount(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
 		AllowCredit:   true,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
 	initialBalance := cc.CreditBalance
-	err := cc.AddCredit(0)
+	err := cc.AddCredit(money.New(0))
 	assert.Error(t, err)
 	assert.Equal(t, initialBalance, cc.CreditBalance)
 }
@@ -230,12 +231,12 @@ func TestClientCredit_AddCredit_ExceedsBalance(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
 		AllowCredit:   true,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
 	initialBalance := cc.CreditBalance
-	err := cc.AddCredit(600.0)
+	err := cc.AddCredit(money.New(600))
 	assert.Error(t, err)
 	assert.Equal(t, initialBalance, cc.CreditBalance)
 }
@@ -244,69 +245,92 @@ func TestClientCredit_AvailableCredit(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
 		AllowCredit:   true,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
-	assert.Equal(t, 500.0, cc.AvailableCredit())
+	assert.Equal(t, money.New(500), cc.AvailableCredit())
 }
 
 func TestClientCredit_AvailableCredit_NotAllowed(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
 		AllowCredit:   false,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
-	assert.Equal(t, 0.0, cc.AvailableCredit())
+	assert.Equal(t, money.New(0), cc.AvailableCredit())
 }
 
 func TestClientCredit_IsCreditAvailable(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
 		AllowCredit:   true,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
-	assert.True(t, cc.IsCreditAvailable(300.0))
-	assert.True(t, cc.IsCreditAvailable(500.0))
-	assert.False(t, cc.IsCreditAvailable(600.0))
+	assert.True(t, cc.IsCreditAvailable(money.New(300)))
+	assert.True(t, cc.IsCreditAvailable(money.New(500)))
+	assert.False(t, cc.IsCreditAvailable(money.New(600)))
 }
 
 func TestClientCredit_SetCreditLimit_Success(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
-	err := cc.SetCreditLimit(1500.0)
+	err := cc.SetCreditLimit(money.New(1500))
 	assert.NoError(t, err)
-	assert.Equal(t, 1500.0, cc.CreditLimit)
+	assert.Equal(t, money.New(1500), cc.CreditLimit)
 }
 
 func TestClientCredit_SetCreditLimit_BelowBalance(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
-	err := cc.SetCreditLimit(400.0)
+	err := cc.SetCreditLimit(money.New(400))
 	assert.Error(t, err)
-	assert.Equal(t, 1000.0, cc.CreditLimit)
+	assert.Equal(t, money.New(1000), cc.CreditLimit)
 }
 
 func TestClientCredit_SetCreditLimit_Negative(t *testing.T) {
 	cc := &ClientCredit{
 		ClientID:      1,
-		CreditLimit:   1000.0,
-		CreditBalance: 500.0,
+		CreditLimit:   money.New(1000),
+		CreditBalance: money.New(500),
 	}
 
-	err := cc.SetCreditLimit(-100.0)
+	err := cc.SetCreditLimit(money.New(-100))
 	assert.Error(t, err)
-	assert.Equal(t, 1000.0, cc.CreditLimit)
+	assert.Equal(t, money.New(1000), cc.CreditLimit)
+}
+
+func TestClientCredit_ValidateCreditLine(t *testing.T) {
+	t.Run("linha habilitada válida", func(t *testing.T) {
+		cc := &ClientCredit{ClientID: 1, AllowCredit: true, CreditLimit: money.New(500)}
+		assert.NoError(t, cc.ValidateCreditLine())
+	})
+
+	t.Run("linha desabilitada sem limite", func(t *testing.T) {
+		cc := &ClientCredit{ClientID: 1, AllowCredit: false}
+		assert.NoError(t, cc.ValidateCreditLine())
+	})
+
+	t.Run("limite sem crédito habilitado", func(t *testing.T) {
+		cc := &ClientCredit{ClientID: 1, AllowCredit: false, CreditLimit: money.New(100)}
+		err := cc.ValidateCreditLine()
+		assert.ErrorContains(t, err, "limite de crédito exige crédito habilitado")
+	})
+
+	t.Run("falha na validação básica", func(t *testing.T) {
+		cc := &ClientCredit{ClientID: 0, AllowCredit: true, CreditLimit: money.New(100)}
+		assert.Error(t, cc.ValidateCreditLine())
+	})
 }
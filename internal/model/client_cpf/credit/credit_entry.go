@@ -0,0 +1,72 @@
+package model
+
+import (
+	"time"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+// Tipos de lançamento do razão de crédito
+const (
+	EntryCharge  = "charge"  // compra a prazo: aumenta o saldo devedor
+	EntryPayment = "payment" // pagamento do cliente: reduz o saldo devedor
+	EntryRefund  = "refund"  // estorno de venda cancelada ou devolvida
+)
+
+// CreditEntry é um lançamento imutável no razão de crédito do cliente.
+type CreditEntry struct {
+	ID           int64
+	ClientID     int64
+	EntryType    string
+	Amount       money.Money
+	BalanceAfter money.Money
+	SaleID       *int64
+	UserID       *int64
+	Description  string
+	CreatedAt    time.Time
+}
+
+// CreditStatement é o extrato do cliente: a conta de crédito e seus lançamentos.
+type CreditStatement struct {
+	Credit  *ClientCredit
+	Entries []*CreditEntry
+}
+
+// NewSaleEntry monta o lançamento vinculado a uma venda a crédito. O tipo
+// (cobrança ou estorno) é definido pelo repositório que grava o lançamento.
+func NewSaleEntry(clientID, saleID int64, amount money.Money, description string) *CreditEntry {
+	return &CreditEntry{
+		ClientID:    clientID,
+		Amount:      amount,
+		SaleID:      &saleID,
+		Description: description,
+	}
+}
+
+func (e *CreditEntry) Validate() error {
+	var validationErrors []validators.ValidationError
+
+	if e.ClientID <= 0 {
+		validationErrors = append(validationErrors, validators.ValidationError{
+			Field:   "client_id",
+			Message: "ID do cliente é obrigatório",
+		})
+	}
+
+	if !e.Amount.IsPositive() {
+		validationErrors = append(validationErrors, validators.ValidationError{
+			Field:   "amount",
+			Message: "valor deve ser positivo",
+		})
+	}
+
+	if len(e.Description) > 255 {
+		validationErrors = append(validationErrors, validators.ValidationError{
+			Field:   "description",
+			Message: "descrição deve ter no máximo 255 caracteres",
+		})
+	}
+
+	return validators.NewValidationErrors(validationErrors)
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreditEntry_Validate(t *testing.T) {
+	t.Run("lançamento válido", func(t *testing.T) {
+		e := &CreditEntry{ClientID: 1, EntryType: EntryCharge, Amount: money.New(10), Description: "compra"}
+		assert.NoError(t, e.Validate())
+	})
+
+	t.Run("cliente obrigatório", func(t *testing.T) {
+		e := &CreditEntry{Amount: money.New(10)}
+		assert.ErrorContains(t, e.Validate(), "client_id")
+	})
+
+	t.Run("valor deve ser positivo", func(t *testing.T) {
+		e := &CreditEntry{ClientID: 1, Amount: money.New(0)}
+		assert.ErrorContains(t, e.Validate(), "amount")
+	})
+
+	t.Run("descrição muito longa", func(t *testing.T) {
+		e := &CreditEntry{ClientID: 1, Amount: money.New(10), Description: strings.Repeat("a", 256)}
+		assert.ErrorContains(t, e.Validate(), "description")
+	})
+}
+
+func TestNewSaleEntry(t *testing.T) {
+	e := NewSaleEntry(3, 9, money.MustParse("25.50"), "venda 9")
+
+	assert.Equal(t, int64(3), e.ClientID)
+	assert.Equal(t, int64(9), *e.SaleID)
+	assert.Equal(t, money.MustParse("25.50"), e.Amount)
+	assert.Equal(t, "venda 9", e.Description)
+	assert.Empty(t, e.EntryType)
+}
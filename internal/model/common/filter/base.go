@@ -18,6 +18,11 @@ type BaseFilter struct {
 	SortBy     string
 	SortOrder  string
 	SearchTerm string
+
+	// CursorMode ativa a paginação keyset; Cursor é o token opaco devolvido
+	// em next_cursor pela página anterior (vazio na primeira página).
+	CursorMode bool
+	Cursor     string
 }
 
 // NewBaseFilter cria uma nova instância de BaseFilter com valores padrão aplicados
@@ -65,6 +70,16 @@ func (b *BaseFilter) Validate() error {
 		return &validators.ValidationError{Field: "SortOrder", Message: "deve ser 'asc' ou 'desc'"}
 	}
 
+	if b.Cursor != "" {
+		cursor, err := DecodeCursor(b.Cursor)
+		if err != nil {
+			return &validators.ValidationError{Field: "Cursor", Message: "cursor inválido"}
+		}
+		if !cursor.Matches(b) {
+			return &validators.ValidationError{Field: "Cursor", Message: "cursor não corresponde à ordenação solicitada"}
+		}
+	}
+
 	return nil
 }
 
@@ -81,5 +96,7 @@ func (b *BaseFilter) IsEmpty() bool {
 		b.Offset == 0 &&
 		b.SortBy == "" &&
 		b.SortOrder == "" &&
-		b.SearchTerm == ""
+		b.SearchTerm == "" &&
+		!b.CursorMode &&
+		b.Cursor == ""
 }
@@ -0,0 +1,164 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+// Tipos do valor de ordenação transportado no cursor. O tipo é gravado junto
+// do valor para que ele volte à consulta como int64, money.Money, time.Time
+// etc., e não como o float64 ou string genérico do JSON.
+const (
+	cursorInt   = "int"
+	cursorFloat = "float"
+	cursorMoney = "money"
+	cursorText  = "text"
+	cursorTime  = "time"
+	cursorBool  = "bool"
+)
+
+// Cursor identifica a última linha de uma página no modo keyset: o valor do
+// campo de ordenação dessa linha e o ID de desempate. Value é nulo quando a
+// coluna ordenada está NULL na linha.
+type Cursor struct {
+	SortBy    string          `json:"s,omitempty"`
+	SortOrder string          `json:"o,omitempty"`
+	Kind      string          `json:"k,omitempty"`
+	Value     json.RawMessage `json:"v,omitempty"`
+	ID        int64           `json:"id"`
+}
+
+// NewCursor cria o cursor da linha id, cujo campo ordenado vale value, para a
+// ordenação de b. Ponteiros são seguidos; ponteiro nil vira valor nulo.
+func NewCursor(b BaseFilter, value any, id int64) Cursor {
+	c := Cursor{
+		SortBy:    strings.ToLower(b.SortBy),
+		SortOrder: sortOrderOf(&b),
+		ID:        id,
+	}
+	c.Kind, c.Value = encodeSortValue(value)
+	return c
+}
+
+func encodeSortValue(value any) (string, json.RawMessage) {
+	v := reflect.ValueOf(value)
+	for v.IsValid() && v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return "", nil
+	}
+
+	var kind string
+	var raw any
+	switch val := v.Interface().(type) {
+	case time.Time:
+		kind, raw = cursorTime, val
+	case money.Money:
+		kind, raw = cursorMoney, val
+	default:
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			kind, raw = cursorInt, v.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			kind, raw = cursorInt, int64(v.Uint())
+		case reflect.Float32, reflect.Float64:
+			kind, raw = cursorFloat, v.Float()
+		case reflect.Bool:
+			kind, raw = cursorBool, v.Bool()
+		default:
+			kind, raw = cursorText, fmt.Sprint(v.Interface())
+		}
+	}
+
+	data, _ := json.Marshal(raw)
+	return kind, data
+}
+
+// SortValue devolve o valor de ordenação com o tipo em que foi gravado, pronto
+// para ser usado como argumento da consulta; nil quando o valor é nulo.
+func (c Cursor) SortValue() (any, error) {
+	if c.Kind == "" {
+		return nil, nil
+	}
+
+	var err error
+	switch c.Kind {
+	case cursorInt:
+		var v int64
+		err = json.Unmarshal(c.Value, &v)
+		return v, err
+	case cursorFloat:
+		var v float64
+		err = json.Unmarshal(c.Value, &v)
+		return v, err
+	case cursorMoney:
+		var v money.Money
+		err = json.Unmarshal(c.Value, &v)
+		return v, err
+	case cursorText:
+		var v string
+		err = json.Unmarshal(c.Value, &v)
+		return v, err
+	case cursorTime:
+		var v time.Time
+		err = json.Unmarshal(c.Value, &v)
+		return v, err
+	case cursorBool:
+		var v bool
+		err = json.Unmarshal(c.Value, &v)
+		return v, err
+	}
+
+	return nil, fmt.Errorf("tipo de cursor desconhecido: %s", c.Kind)
+}
+
+// Encode gera o token opaco enviado ao cliente.
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Matches indica se o cursor foi gerado com a mesma ordenação de b; trocar a
+// ordenação no meio da navegação pularia ou repetiria linhas.
+func (c Cursor) Matches(b *BaseFilter) bool {
+	return c.SortBy == strings.ToLower(b.SortBy) && c.SortOrder == sortOrderOf(b)
+}
+
+func sortOrderOf(b *BaseFilter) string {
+	if order := strings.ToLower(b.SortOrder); order != "" {
+		return order
+	}
+	return DefaultSortOrder
+}
+
+// DecodeCursor converte o token recebido do cliente.
+func DecodeCursor(token string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	if c.ID <= 0 {
+		return nil, errors.New("cursor sem id")
+	}
+	if _, err := c.SortValue(); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validator "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	t.Run("ida e volta preserva id e ordenação", func(t *testing.T) {
+		c := NewCursor(BaseFilter{SortBy: "Name", SortOrder: "DESC"}, "Acme", 42)
+
+		decoded, err := DecodeCursor(c.Encode())
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), decoded.ID)
+		assert.Equal(t, "name", decoded.SortBy)
+		assert.Equal(t, "desc", decoded.SortOrder)
+	})
+
+	t.Run("valor de ordenação volta com o tipo original", func(t *testing.T) {
+		at := time.Date(2025, 3, 1, 10, 30, 0, 123000000, time.UTC)
+		count := 3
+		cases := []struct {
+			name  string
+			value any
+			want  any
+		}{
+			{"texto", "Acme", "Acme"},
+			{"inteiro", 7, int64(7)},
+			{"ponteiro para inteiro", &count, int64(3)},
+			{"dinheiro", money.MustParse("10.25"), money.MustParse("10.25")},
+			{"data", at, at},
+			{"booleano", true, true},
+			{"decimal", 2.5, 2.5},
+			{"nulo", (*time.Time)(nil), nil},
+		}
+
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				decoded, err := DecodeCursor(NewCursor(BaseFilter{SortBy: "x"}, tc.value, 1).Encode())
+				require.NoError(t, err)
+
+				value, err := decoded.SortValue()
+				require.NoError(t, err)
+				if want, ok := tc.want.(time.Time); ok {
+					assert.True(t, want.Equal(value.(time.Time)))
+					return
+				}
+				assert.Equal(t, tc.want, value)
+			})
+		}
+	})
+
+	t.Run("tipo de valor desconhecido", func(t *testing.T) {
+		_, err := DecodeCursor(Cursor{ID: 1, Kind: "blob", Value: []byte(`"x"`)}.Encode())
+		assert.Error(t, err)
+	})
+
+	t.Run("token que não é base64", func(t *testing.T) {
+		_, err := DecodeCursor("!!!")
+		assert.Error(t, err)
+	})
+
+	t.Run("token sem id", func(t *testing.T) {
+		_, err := DecodeCursor(Cursor{SortBy: "name"}.Encode())
+		assert.Error(t, err)
+	})
+}
+
+func TestCursor_Matches(t *testing.T) {
+	c := NewCursor(BaseFilter{SortBy: "name"}, "Acme", 1)
+
+	assert.True(t, c.Matches(&BaseFilter{SortBy: "name"}))
+	assert.True(t, c.Matches(&BaseFilter{SortBy: "NAME", SortOrder: "asc"}))
+	assert.False(t, c.Matches(&BaseFilter{SortBy: "name", SortOrder: "desc"}))
+	assert.False(t, c.Matches(&BaseFilter{SortBy: "created_at"}))
+}
+
+func TestBaseFilter_ValidateCursor(t *testing.T) {
+	t.Run("cursor válido", func(t *testing.T) {
+		f := BaseFilter{CursorMode: true, SortBy: "name", Cursor: NewCursor(BaseFilter{SortBy: "name"}, "Acme", 7).Encode()}
+		assert.NoError(t, f.Validate())
+	})
+
+	t.Run("cursor malformado", func(t *testing.T) {
+		f := BaseFilter{CursorMode: true, Cursor: "abc"}
+		err := f.Validate()
+
+		assert.IsType(t, &validator.ValidationError{}, err)
+		assert.Contains(t, err.Error(), "cursor inválido")
+	})
+
+	t.Run("ordenação diferente da do cursor", func(t *testing.T) {
+		f := BaseFilter{CursorMode: true, SortBy: "name", SortOrder: "desc", Cursor: NewCursor(BaseFilter{SortBy: "name"}, "Acme", 7).Encode()}
+		err := f.Validate()
+
+		assert.IsType(t, &validator.ValidationError{}, err)
+		assert.Contains(t, err.Error(), "não corresponde")
+	})
+}
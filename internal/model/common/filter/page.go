@@ -0,0 +1,47 @@
+package model
+
+// Page é o resultado paginado de um Filter. Total considera apenas os
+// critérios do filtro, sem limit/offset nem cursor.
+type Page[T any] struct {
+	Items      []T
+	Total      int64
+	Limit      int
+	Offset     int
+	HasNext    bool
+	NextCursor string
+}
+
+// NewPage monta a página a partir das linhas lidas. No modo cursor o
+// repositório busca limit+1 linhas: a excedente só indica que há próxima
+// página e é descartada. keyOf devolve o valor do campo ordenado e o ID da
+// linha, gravados no próximo cursor.
+func NewPage[T any](items []T, total int64, base BaseFilter, keyOf func(T) (any, int64)) *Page[T] {
+	if items == nil {
+		items = make([]T, 0)
+	}
+
+	page := &Page[T]{
+		Total:  total,
+		Limit:  base.Limit,
+		Offset: base.Offset,
+	}
+
+	if !base.CursorMode {
+		page.Items = items
+		page.HasNext = int64(base.Offset+len(items)) < total
+		return page
+	}
+
+	page.Offset = 0
+	if len(items) > base.Limit {
+		items = items[:base.Limit]
+		page.HasNext = true
+	}
+	page.Items = items
+	if page.HasNext && len(items) > 0 {
+		value, id := keyOf(items[len(items)-1])
+		page.NextCursor = NewCursor(base, value, id).Encode()
+	}
+
+	return page
+}
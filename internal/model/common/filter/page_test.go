@@ -0,0 +1,59 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPage(t *testing.T) {
+	keyOf := func(v int64) (any, int64) { return v * 10, v }
+
+	t.Run("offset com próxima página", func(t *testing.T) {
+		page := NewPage([]int64{3, 4}, 5, BaseFilter{Limit: 2, Offset: 2}, keyOf)
+
+		assert.Equal(t, []int64{3, 4}, page.Items)
+		assert.Equal(t, int64(5), page.Total)
+		assert.Equal(t, 2, page.Limit)
+		assert.Equal(t, 2, page.Offset)
+		assert.True(t, page.HasNext)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("offset na última página", func(t *testing.T) {
+		page := NewPage([]int64{5}, 5, BaseFilter{Limit: 2, Offset: 4}, keyOf)
+		assert.False(t, page.HasNext)
+	})
+
+	t.Run("itens nil viram slice vazio", func(t *testing.T) {
+		page := NewPage[int64](nil, 0, BaseFilter{Limit: 10}, keyOf)
+
+		assert.NotNil(t, page.Items)
+		assert.Empty(t, page.Items)
+	})
+
+	t.Run("cursor descarta a linha excedente e gera next_cursor", func(t *testing.T) {
+		base := BaseFilter{Limit: 2, Offset: 9, CursorMode: true, SortBy: "name"}
+		page := NewPage([]int64{1, 2, 3}, 10, base, keyOf)
+
+		assert.Equal(t, []int64{1, 2}, page.Items)
+		assert.Equal(t, 0, page.Offset)
+		assert.True(t, page.HasNext)
+
+		cursor, err := DecodeCursor(page.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), cursor.ID)
+		assert.True(t, cursor.Matches(&base))
+		value, err := cursor.SortValue()
+		require.NoError(t, err)
+		assert.Equal(t, int64(20), value)
+	})
+
+	t.Run("cursor na última página", func(t *testing.T) {
+		page := NewPage([]int64{1, 2}, 2, BaseFilter{Limit: 2, CursorMode: true}, keyOf)
+
+		assert.False(t, page.HasNext)
+		assert.Empty(t, page.NextCursor)
+	})
+}
@@ -0,0 +1,208 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+// Ciclo de vida da contagem: open → closed ou open → canceled. Só o
+// fechamento ajusta o estoque.
+const (
+	StatusOpen     = "open"
+	StatusClosed   = "closed"
+	StatusCanceled = "canceled"
+)
+
+// InventoryCount é uma sessão de inventário físico. Com CategoryID, só os
+// produtos da categoria podem ser contados.
+type InventoryCount struct {
+	ID               int64
+	CategoryID       *int64
+	Status           string
+	Notes            string
+	OpenedBy         *int64
+	ClosedBy         *int64
+	AdjustedProducts int
+	Items            []CountItem
+	Version          int
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	ClosedAt         *time.Time
+}
+
+func (c *InventoryCount) Validate() error {
+	var errs validators.ValidationErrors
+
+	if c.CategoryID != nil && *c.CategoryID <= 0 {
+		errs = append(errs, validators.ValidationError{Field: "category_id", Message: "must be greater than 0"})
+	}
+
+	if len(c.Notes) > 500 {
+		errs = append(errs, validators.ValidationError{Field: "notes", Message: "max 500 characters"})
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+func (c *InventoryCount) IsOpen() bool {
+	return c.Status == StatusOpen
+}
+
+// CountEntry é uma leitura enviada por um contador, identificando o produto
+// pelo ID ou pelo código de barras. Leituras somam ao que já foi contado;
+// Recount substitui o total do produto pela quantidade informada.
+type CountEntry struct {
+	ProductID *int64
+	Barcode   string
+	Quantity  int
+	Recount   bool
+	CountedBy *int64
+}
+
+func (e *CountEntry) Validate() error {
+	var errs validators.ValidationErrors
+
+	hasID := e.ProductID != nil
+	switch {
+	case hasID && e.Barcode != "":
+		errs = append(errs, validators.ValidationError{Field: "product_id", Message: "informe product_id ou barcode, não ambos"})
+	case !hasID && e.Barcode == "":
+		errs = append(errs, validators.ValidationError{Field: "product_id", Message: validators.MsgRequiredField})
+	case hasID && *e.ProductID <= 0:
+		errs = append(errs, validators.ValidationError{Field: "product_id", Message: "must be greater than 0"})
+	}
+
+	// Zero só faz sentido como recontagem: o produto não foi encontrado
+	if e.Quantity < 0 || (e.Quantity == 0 && !e.Recount) {
+		errs = append(errs, validators.ValidationError{Field: "quantity", Message: "must be greater than 0"})
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// ValidateEntries valida um lote de leituras, indicando a posição de cada erro.
+func ValidateEntries(entries []CountEntry) error {
+	if len(entries) == 0 {
+		return validators.ValidationError{Field: "items", Message: "at least one item is required"}
+	}
+
+	var errs validators.ValidationErrors
+	for i := range entries {
+		if err := entries[i].Validate(); err != nil {
+			for _, ve := range err.(validators.ValidationErrors) {
+				ve.Field = fmt.Sprintf("items[%d].%s", i, ve.Field)
+				errs = append(errs, ve)
+			}
+		}
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// CountItem é o total contado de um produto na sessão. Entries é o número de
+// leituras recebidas e CountedBy o autor da última.
+type CountItem struct {
+	InventoryCountID int64
+	ProductID        int64
+	ProductName      string
+	Barcode          string
+	CountedQuantity  int
+	Entries          int
+	CountedBy        *int64
+	UpdatedAt        time.Time
+}
+
+// VarianceLine compara o saldo do sistema com o contado. CountedQuantity nil
+// indica produto do escopo que ainda não foi contado.
+type VarianceLine struct {
+	ProductID       int64
+	ProductName     string
+	Barcode         string
+	SystemQuantity  int
+	CountedQuantity *int
+	CostPrice       money.Money
+}
+
+func (l *VarianceLine) Counted() bool {
+	return l.CountedQuantity != nil
+}
+
+// Variance é contado menos sistema: negativo indica falta.
+func (l *VarianceLine) Variance() int {
+	if l.CountedQuantity == nil {
+		return 0
+	}
+	return *l.CountedQuantity - l.SystemQuantity
+}
+
+func (l *VarianceLine) VarianceCost() money.Money {
+	return l.CostPrice.Mul(int64(l.Variance()))
+}
+
+// VarianceReport resume a contagem. Produtos não contados aparecem no
+// relatório mas não são ajustados no fechamento.
+type VarianceReport struct {
+	InventoryCountID int64
+	Lines            []*VarianceLine
+	Counted          int
+	Uncounted        int
+	Adjustments      int
+	NetVariance      int
+	NetVarianceCost  money.Money
+}
+
+func NewVarianceReport(countID int64, lines []*VarianceLine) *VarianceReport {
+	report := &VarianceReport{InventoryCountID: countID, Lines: lines}
+
+	var cost money.Money
+	for _, l := range lines {
+		if !l.Counted() {
+			report.Uncounted++
+			continue
+		}
+		report.Counted++
+		if v := l.Variance(); v != 0 {
+			report.Adjustments++
+			report.NetVariance += v
+			cost = cost.Add(l.VarianceCost())
+		}
+	}
+	report.NetVarianceCost = cost
+
+	return report
+}
+
+type InventoryCountFilter struct {
+	filter.BaseFilter
+	Status     string
+	CategoryID *int64
+}
+
+func (f *InventoryCountFilter) Validate() error {
+	if err := f.BaseFilter.Validate(); err != nil {
+		return err
+	}
+
+	switch f.Status {
+	case "", StatusOpen, StatusClosed, StatusCanceled:
+		return nil
+	}
+
+	return &validators.ValidationError{
+		Field:   "Status",
+		Message: "status inválido. Valores permitidos: open, closed, canceled",
+	}
+}
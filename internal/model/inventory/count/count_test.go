@@ -0,0 +1,65 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func intPtr(v int) *int    { return &v }
+func idPtr(v int64) *int64 { return &v }
+
+func TestInventoryCount_Validate(t *testing.T) {
+	assert.NoError(t, (&InventoryCount{}).Validate())
+	assert.NoError(t, (&InventoryCount{CategoryID: idPtr(2), Notes: "setor A"}).Validate())
+	assert.Error(t, (&InventoryCount{CategoryID: idPtr(0)}).Validate())
+	assert.Error(t, (&InventoryCount{Notes: strings.Repeat("a", 501)}).Validate())
+}
+
+func TestCountEntry_Validate(t *testing.T) {
+	assert.NoError(t, (&CountEntry{ProductID: idPtr(1), Quantity: 3}).Validate())
+	assert.NoError(t, (&CountEntry{Barcode: "789", Quantity: 1}).Validate())
+	assert.NoError(t, (&CountEntry{Barcode: "789", Quantity: 0, Recount: true}).Validate())
+
+	assert.Error(t, (&CountEntry{Quantity: 1}).Validate())
+	assert.Error(t, (&CountEntry{ProductID: idPtr(1), Barcode: "789", Quantity: 1}).Validate())
+	assert.Error(t, (&CountEntry{ProductID: idPtr(0), Quantity: 1}).Validate())
+	assert.Error(t, (&CountEntry{ProductID: idPtr(1), Quantity: 0}).Validate())
+	assert.Error(t, (&CountEntry{ProductID: idPtr(1), Quantity: -1, Recount: true}).Validate())
+}
+
+func TestValidateEntries(t *testing.T) {
+	assert.Error(t, ValidateEntries(nil))
+	assert.NoError(t, ValidateEntries([]CountEntry{{Barcode: "789", Quantity: 1}}))
+
+	err := ValidateEntries([]CountEntry{{Barcode: "789", Quantity: 1}, {Quantity: 1}})
+	assert.ErrorContains(t, err, "items[1].product_id")
+}
+
+func TestNewVarianceReport(t *testing.T) {
+	lines := []*VarianceLine{
+		{ProductID: 1, SystemQuantity: 10, CountedQuantity: intPtr(8), CostPrice: money.MustParse("2.50")},
+		{ProductID: 2, SystemQuantity: 4, CountedQuantity: intPtr(5), CostPrice: money.MustParse("1.10")},
+		{ProductID: 3, SystemQuantity: 7, CountedQuantity: intPtr(7), CostPrice: money.New(3)},
+		{ProductID: 4, SystemQuantity: 2, CostPrice: money.New(9)},
+	}
+
+	report := NewVarianceReport(1, lines)
+
+	assert.Equal(t, 3, report.Counted)
+	assert.Equal(t, 1, report.Uncounted)
+	assert.Equal(t, 2, report.Adjustments)
+	assert.Equal(t, -1, report.NetVariance)
+	assert.Equal(t, money.MustParse("-3.90"), report.NetVarianceCost)
+	assert.Equal(t, 0, lines[3].Variance())
+}
+
+func TestInventoryCountFilter_Validate(t *testing.T) {
+	assert.NoError(t, (&InventoryCountFilter{}).Validate())
+	assert.NoError(t, (&InventoryCountFilter{Status: StatusClosed}).Validate())
+	assert.Error(t, (&InventoryCountFilter{Status: "draft"}).Validate())
+	assert.Error(t, (&InventoryCountFilter{BaseFilter: filter.BaseFilter{Limit: -1}}).Validate())
+}
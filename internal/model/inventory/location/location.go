@@ -0,0 +1,73 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+const (
+	KindStore     = "store"
+	KindWarehouse = "warehouse"
+)
+
+// Location é uma loja ou depósito com saldo próprio. O local padrão recebe
+// as operações que não informam local e não pode ser trocado pela API.
+type Location struct {
+	ID        int64
+	Code      string
+	Name      string
+	Kind      string
+	IsDefault bool
+	Version   int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (l *Location) Validate() error {
+	var errs validators.ValidationErrors
+
+	code := strings.TrimSpace(l.Code)
+	switch {
+	case code == "":
+		errs = append(errs, validators.ValidationError{Field: "code", Message: validators.MsgRequiredField})
+	case len(code) > 20:
+		errs = append(errs, validators.ValidationError{Field: "code", Message: "máximo de 20 caracteres"})
+	}
+
+	name := strings.TrimSpace(l.Name)
+	switch {
+	case name == "":
+		errs = append(errs, validators.ValidationError{Field: "name", Message: validators.MsgRequiredField})
+	case len(name) > 100:
+		errs = append(errs, validators.ValidationError{Field: "name", Message: validators.MsgMax100})
+	}
+
+	if l.Kind != KindStore && l.Kind != KindWarehouse {
+		errs = append(errs, validators.ValidationError{Field: "kind", Message: "tipo inválido. Valores permitidos: store, warehouse"})
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// LocationStock é o saldo de um produto em um local.
+type LocationStock struct {
+	LocationID int64
+	Code       string
+	Name       string
+	IsDefault  bool
+	Quantity   int
+}
+
+// ProductStock reúne o saldo do produto por local. Total é o estoque da
+// empresa e inclui InTransit, que já saiu da origem e ainda não chegou.
+type ProductStock struct {
+	ProductID int64
+	Total     int
+	InTransit int
+	Locations []LocationStock
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocation_Validate(t *testing.T) {
+	assert.NoError(t, (&Location{Code: "LJ01", Name: "Loja Centro", Kind: KindStore}).Validate())
+	assert.NoError(t, (&Location{Code: "DEP", Name: "Depósito", Kind: KindWarehouse}).Validate())
+
+	assert.ErrorContains(t, (&Location{Name: "Loja", Kind: KindStore}).Validate(), "code")
+	assert.ErrorContains(t, (&Location{Code: strings.Repeat("a", 21), Name: "Loja", Kind: KindStore}).Validate(), "code")
+	assert.ErrorContains(t, (&Location{Code: "LJ", Name: "  ", Kind: KindStore}).Validate(), "name")
+	assert.ErrorContains(t, (&Location{Code: "LJ", Name: strings.Repeat("a", 101), Kind: KindStore}).Validate(), "name")
+	assert.ErrorContains(t, (&Location{Code: "LJ", Name: "Loja", Kind: "virtual"}).Validate(), "kind")
+}
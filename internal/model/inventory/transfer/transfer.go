@@ -0,0 +1,109 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+// Ciclo de vida da transferência: in_transit → received ou in_transit →
+// canceled. O envio já tira a mercadoria da origem.
+const (
+	StatusInTransit = "in_transit"
+	StatusReceived  = "received"
+	StatusCanceled  = "canceled"
+)
+
+type TransferItem struct {
+	ID              int64
+	StockTransferID int64
+	ProductID       int64
+	Quantity        int
+}
+
+// Transfer é o documento de movimentação de mercadoria entre dois locais.
+type Transfer struct {
+	ID             int64
+	FromLocationID int64
+	ToLocationID   int64
+	Status         string
+	Notes          string
+	CreatedBy      *int64
+	ReceivedBy     *int64
+	Items          []TransferItem
+	Version        int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	ReceivedAt     *time.Time
+}
+
+func (t *Transfer) Validate() error {
+	var errs validators.ValidationErrors
+
+	if t.FromLocationID <= 0 {
+		errs = append(errs, validators.ValidationError{Field: "from_location_id", Message: validators.MsgRequiredField})
+	}
+	if t.ToLocationID <= 0 {
+		errs = append(errs, validators.ValidationError{Field: "to_location_id", Message: validators.MsgRequiredField})
+	}
+	if t.FromLocationID > 0 && t.FromLocationID == t.ToLocationID {
+		errs = append(errs, validators.ValidationError{Field: "to_location_id", Message: "origem e destino devem ser diferentes"})
+	}
+
+	if len(t.Notes) > 500 {
+		errs = append(errs, validators.ValidationError{Field: "notes", Message: "max 500 characters"})
+	}
+
+	if len(t.Items) == 0 {
+		errs = append(errs, validators.ValidationError{Field: "items", Message: "at least one item is required"})
+	}
+
+	seen := make(map[int64]bool, len(t.Items))
+	for i, item := range t.Items {
+		field := fmt.Sprintf("items[%d]", i)
+
+		if item.ProductID <= 0 {
+			errs = append(errs, validators.ValidationError{Field: field + ".product_id", Message: validators.MsgRequiredField})
+		} else if seen[item.ProductID] {
+			errs = append(errs, validators.ValidationError{Field: field + ".product_id", Message: "duplicated product"})
+		}
+		seen[item.ProductID] = true
+
+		if item.Quantity <= 0 {
+			errs = append(errs, validators.ValidationError{Field: field + ".quantity", Message: "must be greater than 0"})
+		}
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+func (t *Transfer) InTransit() bool {
+	return t.Status == StatusInTransit
+}
+
+type TransferFilter struct {
+	filter.BaseFilter
+	Status     string
+	LocationID *int64
+}
+
+func (f *TransferFilter) Validate() error {
+	if err := f.BaseFilter.Validate(); err != nil {
+		return err
+	}
+
+	switch f.Status {
+	case "", StatusInTransit, StatusReceived, StatusCanceled:
+		return nil
+	}
+
+	return &validators.ValidationError{
+		Field:   "Status",
+		Message: "status inválido. Valores permitidos: in_transit, received, canceled",
+	}
+}
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransfer_Validate(t *testing.T) {
+	valid := func() *Transfer {
+		return &Transfer{
+			FromLocationID: 1,
+			ToLocationID:   2,
+			Items:          []TransferItem{{ProductID: 10, Quantity: 3}, {ProductID: 11, Quantity: 1}},
+		}
+	}
+
+	assert.NoError(t, valid().Validate())
+
+	tr := valid()
+	tr.FromLocationID = 0
+	assert.ErrorContains(t, tr.Validate(), "from_location_id")
+
+	tr = valid()
+	tr.ToLocationID = 1
+	assert.ErrorContains(t, tr.Validate(), "to_location_id")
+
+	tr = valid()
+	tr.Notes = strings.Repeat("a", 501)
+	assert.ErrorContains(t, tr.Validate(), "notes")
+
+	tr = valid()
+	tr.Items = nil
+	assert.ErrorContains(t, tr.Validate(), "items")
+
+	tr = valid()
+	tr.Items[1].ProductID = 10
+	assert.ErrorContains(t, tr.Validate(), "items[1].product_id")
+
+	tr = valid()
+	tr.Items[0].Quantity = 0
+	assert.ErrorContains(t, tr.Validate(), "items[0].quantity")
+}
+
+func TestTransfer_InTransit(t *testing.T) {
+	assert.True(t, (&Transfer{Status: StatusInTransit}).InTransit())
+	assert.False(t, (&Transfer{Status: StatusReceived}).InTransit())
+}
+
+func TestTransferFilter_Validate(t *testing.T) {
+	assert.NoError(t, (&TransferFilter{BaseFilter: filter.BaseFilter{Limit: 10}}).Validate())
+	assert.NoError(t, (&TransferFilter{BaseFilter: filter.BaseFilter{Limit: 10}, Status: StatusReceived}).Validate())
+	assert.Error(t, (&TransferFilter{BaseFilter: filter.BaseFilter{Limit: 10}, Status: "lost"}).Validate())
+}
@@ -11,10 +11,10 @@ type LoginCredential struct {
 }
 
 type AuthResponse struct {
-	AccessToken string
-	//RefreshToken string
-	ExpiresIn int64
-	TokenType string
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    int64
+	TokenType    string
 }
 
 func (c *LoginCredential) Validate() error {
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// Session é uma família de refresh tokens: nasce no login, tem o hash do
+// refresh token vigente trocado a cada rotação e é revogada inteira quando um
+// token já rotacionado é apresentado de novo.
+type Session struct {
+	ID          string    `json:"id"`
+	UserID      int64     `json:"user_id"`
+	Email       string    `json:"email"`
+	RefreshHash string    `json:"refresh_hash"`
+	Device      string    `json:"device"`
+	IP          string    `json:"ip"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastSeenAt  time.Time `json:"last_seen_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// ClientInfo identifica de onde veio o login ou o refresh.
+type ClientInfo struct {
+	Device string
+	IP     string
+}
+
+func (s *Session) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
 )
 
@@ -16,10 +17,10 @@ type ProductFilter struct {
 	Status             *bool
 	SupplierID         *int64
 	Version            *int
-	MinCostPrice       *float64
-	MaxCostPrice       *float64
-	MinSalePrice       *float64
-	MaxSalePrice       *float64
+	MinCostPrice       *money.Money
+	MaxCostPrice       *money.Money
+	MinSalePrice       *money.Money
+	MaxSalePrice       *money.Money
 	MinStockQuantity   *int
 	MaxStockQuantity   *int
 	AllowDiscount      *bool
@@ -37,7 +38,7 @@ func (f *ProductFilter) Validate() error {
 	}
 
 	// Valida intervalos de preço de custo
-	if f.MinCostPrice != nil && f.MaxCostPrice != nil && *f.MinCostPrice > *f.MaxCostPrice {
+	if f.MinCostPrice != nil && f.MaxCostPrice != nil && f.MinCostPrice.GreaterThan(*f.MaxCostPrice) {
 		return &validators.ValidationError{
 			Field:   "MinCostPrice/MaxCostPrice",
 			Message: "intervalo de preço de custo inválido",
@@ -45,7 +46,7 @@ func (f *ProductFilter) Validate() error {
 	}
 
 	// Valida intervalos de preço de venda
-	if f.MinSalePrice != nil && f.MaxSalePrice != nil && *f.MinSalePrice > *f.MaxSalePrice {
+	if f.MinSalePrice != nil && f.MaxSalePrice != nil && f.MinSalePrice.GreaterThan(*f.MaxSalePrice) {
 		return &validators.ValidationError{
 			Field:   "MinSalePrice/MaxSalePrice",
 			Message: "intervalo de preço de venda inválido",
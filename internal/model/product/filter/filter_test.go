@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	errval "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
 	"github.com/stretchr/testify/assert"
 )
@@ -67,8 +68,8 @@ func TestProductFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("invalid MinCostPrice > MaxCostPrice", func(t *testing.T) {
-		min := 200.0
-		max := 100.0
+		min := money.New(200)
+		max := money.New(100)
 		f := ProductFilter{
 			MinCostPrice: &min,
 			MaxCostPrice: &max,
@@ -79,8 +80,8 @@ func TestProductFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("invalid MinSalePrice > MaxSalePrice", func(t *testing.T) {
-		min := 300.0
-		max := 200.0
+		min := money.New(300)
+		max := money.New(200)
 		f := ProductFilter{
 			MinSalePrice: &min,
 			MaxSalePrice: &max,
@@ -117,10 +118,10 @@ func TestProductFilter_Validate(t *testing.T) {
 	t.Run("valid full filter", func(t *testing.T) {
 		from := time.Now().Add(-24 * time.Hour)
 		to := time.Now()
-		minCost := 10.0
-		maxCost := 20.0
-		minSale := 30.0
-		maxSale := 50.0
+		minCost := money.New(10)
+		maxCost := money.New(20)
+		minSale := money.New(30)
+		maxSale := money.New(50)
 		minStock := 5
 		maxStock := 15
 		minDisc := 5.0
@@ -0,0 +1,163 @@
+package model
+
+import (
+	"time"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+// Origem de cada linha do histórico de preços.
+const (
+	SourceOpening   = "opening"
+	SourceManual    = "manual"
+	SourceReceipt   = "receipt"
+	SourceScheduled = "scheduled"
+)
+
+// Ciclo de vida da mudança agendada: pending → applied, pending → canceled,
+// ou pending → superseded quando outro preço com data posterior já foi
+// gravado antes de ela ser aplicada.
+const (
+	StatusPending    = "pending"
+	StatusApplied    = "applied"
+	StatusSuperseded = "superseded"
+	StatusCanceled   = "canceled"
+)
+
+var validSources = map[string]bool{
+	SourceOpening:   true,
+	SourceManual:    true,
+	SourceReceipt:   true,
+	SourceScheduled: true,
+}
+
+var validStatuses = map[string]bool{
+	StatusPending:    true,
+	StatusApplied:    true,
+	StatusSuperseded: true,
+	StatusCanceled:   true,
+}
+
+func IsValidSource(source string) bool {
+	return validSources[source]
+}
+
+func IsValidStatus(status string) bool {
+	return validStatuses[status]
+}
+
+// ProductPrice é uma linha do histórico: os preços de custo e venda vigentes
+// a partir de EffectiveFrom. RefID aponta o documento de origem (pedido de
+// compra ou mudança agendada).
+type ProductPrice struct {
+	ID            int64
+	ProductID     int64
+	CostPrice     money.Money
+	SalePrice     money.Money
+	EffectiveFrom time.Time
+	Source        string
+	RefID         *int64
+	ChangedBy     *int64
+	CreatedAt     time.Time
+}
+
+// PriceValues são os preços de custo e venda de um produto.
+type PriceValues struct {
+	CostPrice money.Money
+	SalePrice money.Money
+}
+
+// AppliedPrice é a mudança agendada aplicada a um produto pelo agendador,
+// com os preços antes e depois dela.
+type AppliedPrice struct {
+	ProductID int64
+	Before    PriceValues
+	After     PriceValues
+}
+
+type PriceFilter struct {
+	filter.BaseFilter
+	ProductID     int64
+	Source        string
+	EffectiveFrom *time.Time
+	EffectiveTo   *time.Time
+}
+
+func (f *PriceFilter) Validate() error {
+	if err := f.BaseFilter.Validate(); err != nil {
+		return err
+	}
+
+	if f.ProductID <= 0 {
+		return &validators.ValidationError{Field: "ProductID", Message: "ID do produto inválido"}
+	}
+
+	if f.Source != "" && !IsValidSource(f.Source) {
+		return &validators.ValidationError{
+			Field:   "Source",
+			Message: "origem inválida. Valores permitidos: opening, manual, receipt, scheduled",
+		}
+	}
+
+	if f.EffectiveFrom != nil && f.EffectiveTo != nil && f.EffectiveFrom.After(*f.EffectiveTo) {
+		return &validators.ValidationError{
+			Field:   "EffectiveFrom/EffectiveTo",
+			Message: "data inicial não pode ser posterior à final",
+		}
+	}
+
+	return nil
+}
+
+// ScheduledPrice é uma mudança de preço futura. Preço nil mantém o valor que
+// estiver vigente quando a mudança for aplicada.
+type ScheduledPrice struct {
+	ID            int64
+	ProductID     int64
+	CostPrice     *money.Money
+	SalePrice     *money.Money
+	EffectiveFrom time.Time
+	Status        string
+	CreatedBy     *int64
+	CreatedAt     time.Time
+	AppliedAt     *time.Time
+}
+
+func (s *ScheduledPrice) Validate() error {
+	var errs validators.ValidationErrors
+
+	if s.ProductID <= 0 {
+		errs = append(errs, validators.ValidationError{Field: "product_id", Message: validators.MsgRequiredField})
+	}
+	if s.CostPrice == nil && s.SalePrice == nil {
+		errs = append(errs, validators.ValidationError{Field: "sale_price", Message: "informe cost_price e/ou sale_price"})
+	}
+	if s.CostPrice != nil && s.CostPrice.IsNegative() {
+		errs = append(errs, validators.ValidationError{Field: "cost_price", Message: "must be >= 0"})
+	}
+	if s.SalePrice != nil && s.SalePrice.IsNegative() {
+		errs = append(errs, validators.ValidationError{Field: "sale_price", Message: "must be >= 0"})
+	}
+	if s.EffectiveFrom.IsZero() {
+		errs = append(errs, validators.ValidationError{Field: "effective_from", Message: validators.MsgRequiredField})
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// EffectivePrice são os preços vigentes de um produto em At, considerando o
+// histórico e as mudanças agendadas ainda pendentes. CostFrom e SaleFrom
+// dizem desde quando cada preço vale.
+type EffectivePrice struct {
+	ProductID int64
+	At        time.Time
+	CostPrice money.Money
+	CostFrom  time.Time
+	SalePrice money.Money
+	SaleFrom  time.Time
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriceFilter_Validate(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	assert.NoError(t, (&PriceFilter{ProductID: 1, Source: SourceScheduled}).Validate())
+	assert.NoError(t, (&PriceFilter{ProductID: 1, EffectiveFrom: &earlier, EffectiveTo: &now}).Validate())
+	assert.Error(t, (&PriceFilter{}).Validate())
+	assert.Error(t, (&PriceFilter{ProductID: 1, Source: "promo"}).Validate())
+	assert.Error(t, (&PriceFilter{ProductID: 1, EffectiveFrom: &now, EffectiveTo: &earlier}).Validate())
+	assert.Error(t, (&PriceFilter{ProductID: 1, BaseFilter: filter.BaseFilter{Limit: -1}}).Validate())
+}
+
+func TestScheduledPrice_Validate(t *testing.T) {
+	price := money.New(10)
+	negative := money.New(-1)
+	future := time.Now().Add(time.Hour)
+
+	assert.NoError(t, (&ScheduledPrice{ProductID: 1, SalePrice: &price, EffectiveFrom: future}).Validate())
+	assert.NoError(t, (&ScheduledPrice{ProductID: 1, CostPrice: &price, EffectiveFrom: future}).Validate())
+
+	t.Run("sem nenhum preço", func(t *testing.T) {
+		err := (&ScheduledPrice{ProductID: 1, EffectiveFrom: future}).Validate()
+		assert.ErrorContains(t, err, "sale_price")
+	})
+
+	t.Run("acumula os erros", func(t *testing.T) {
+		err := (&ScheduledPrice{CostPrice: &negative}).Validate()
+		assert.ErrorContains(t, err, "product_id")
+		assert.ErrorContains(t, err, "cost_price")
+		assert.ErrorContains(t, err, "effective_from")
+	})
+}
+
+func TestIsValidStatus(t *testing.T) {
+	assert.True(t, IsValidStatus(StatusSuperseded))
+	assert.False(t, IsValidStatus("done"))
+}
@@ -4,6 +4,7 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
 )
 
@@ -13,8 +14,8 @@ type Product struct {
 	ProductName        string
 	Manufacturer       string
 	Description        string
-	CostPrice          float64
-	SalePrice          float64
+	CostPrice          money.Money
+	SalePrice          money.Money
 	StockQuantity      int
 	MinStock           int
 	MaxStock           *int
@@ -68,19 +69,19 @@ func (p *Product) Validate(isUpdate bool) error {
 	}
 
 	// --- Preços ---
-	if p.CostPrice < 0 {
+	if p.CostPrice.IsNegative() {
 		errs = append(errs, validators.ValidationError{
 			Field:   "cost_price",
 			Message: validators.MsgCostNonNegative,
 		})
 	}
-	if p.SalePrice < 0 {
+	if p.SalePrice.IsNegative() {
 		errs = append(errs, validators.ValidationError{
 			Field:   "sale_price",
 			Message: validators.MsgSaleNonNegative,
 		})
 	}
-	if p.SalePrice < p.CostPrice {
+	if p.SalePrice.LessThan(p.CostPrice) {
 		errs = append(errs, validators.ValidationError{
 			Field:   "sale_price",
 			Message: "preço de venda não pode ser menor que o de custo",
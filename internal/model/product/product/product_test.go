@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
 	"github.com/stretchr/testify/assert"
 )
@@ -21,117 +22,117 @@ func TestProduct_Validate(t *testing.T) {
 	}{
 		{
 			name:     "nome em branco (criação)",
-			input:    Product{ProductName: "", Manufacturer: "Fab", CostPrice: 10, SalePrice: 20, SupplierID: &validSupplierID},
+			input:    Product{ProductName: "", Manufacturer: "Fab", CostPrice: money.New(10), SalePrice: money.New(20), SupplierID: &validSupplierID},
 			isUpdate: false,
 			wantErr:  true,
 			errField: "product_name",
 		},
 		{
 			name:     "nome muito longo",
-			input:    Product{ProductName: strings.Repeat("a", 256), Manufacturer: "Fab", CostPrice: 10, SalePrice: 20, SupplierID: &validSupplierID},
+			input:    Product{ProductName: strings.Repeat("a", 256), Manufacturer: "Fab", CostPrice: money.New(10), SalePrice: money.New(20), SupplierID: &validSupplierID},
 			isUpdate: false,
 			wantErr:  true,
 			errField: "product_name",
 		},
 		{
 			name:     "fabricante em branco",
-			input:    Product{ProductName: "Produto", Manufacturer: "", CostPrice: 10, SalePrice: 20, SupplierID: &validSupplierID},
+			input:    Product{ProductName: "Produto", Manufacturer: "", CostPrice: money.New(10), SalePrice: money.New(20), SupplierID: &validSupplierID},
 			isUpdate: false,
 			wantErr:  true,
 			errField: "manufacturer",
 		},
 		{
 			name:     "fabricante muito longo",
-			input:    Product{ProductName: "Produto", Manufacturer: strings.Repeat("b", 256), CostPrice: 10, SalePrice: 20, SupplierID: &validSupplierID},
+			input:    Product{ProductName: "Produto", Manufacturer: strings.Repeat("b", 256), CostPrice: money.New(10), SalePrice: money.New(20), SupplierID: &validSupplierID},
 			isUpdate: false,
 			wantErr:  true,
 			errField: "manufacturer",
 		},
 		{
 			name:     "preço de custo negativo",
-			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: -1, SalePrice: 20, SupplierID: &validSupplierID},
+			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: money.New(-1), SalePrice: money.New(20), SupplierID: &validSupplierID},
 			isUpdate: false,
 			wantErr:  true,
 			errField: "cost_price",
 		},
 		{
 			name:     "preço de venda negativo",
-			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: 10, SalePrice: -5, SupplierID: &validSupplierID},
+			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: money.New(10), SalePrice: money.New(-5), SupplierID: &validSupplierID},
 			isUpdate: false,
 			wantErr:  true,
 			errField: "sale_price",
 		},
 		{
 			name:     "preço de venda menor que custo",
-			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: 20, SalePrice: 10, SupplierID: &validSupplierID},
+			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: money.New(20), SalePrice: money.New(10), SupplierID: &validSupplierID},
 			isUpdate: false,
 			wantErr:  true,
 			errField: "sale_price",
 		},
 		{
 			name:     "estoque negativo",
-			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: 10, SalePrice: 20, StockQuantity: -1, SupplierID: &validSupplierID},
+			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: money.New(10), SalePrice: money.New(20), StockQuantity: -1, SupplierID: &validSupplierID},
 			isUpdate: false,
 			wantErr:  true,
 			errField: "stock_quantity",
 		},
 		{
 			name:     "código de barras inválido",
-			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: 10, SalePrice: 20, Barcode: func() *string { s := "abc123"; return &s }(), SupplierID: &validSupplierID},
+			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: money.New(10), SalePrice: money.New(20), Barcode: func() *string { s := "abc123"; return &s }(), SupplierID: &validSupplierID},
 			isUpdate: false,
 			wantErr:  true,
 			errField: "barcode",
 		},
 		{
 			name:     "fornecedor ausente na criação",
-			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: 10, SalePrice: 20},
+			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: money.New(10), SalePrice: money.New(20)},
 			isUpdate: false,
 			wantErr:  true,
 			errField: "supplier_id",
 		},
 		{
 			name:     "fornecedor ausente na atualização (permitido)",
-			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: 10, SalePrice: 20},
+			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: money.New(10), SalePrice: money.New(20)},
 			isUpdate: true,
 			wantErr:  false,
 		},
 		{
 			name:     "produto inativo (permitido)",
-			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: 10, SalePrice: 20, SupplierID: &validSupplierID, Status: false},
+			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: money.New(10), SalePrice: money.New(20), SupplierID: &validSupplierID, Status: false},
 			isUpdate: false,
 			wantErr:  false,
 		},
 		{
 			name:     "desconto mínimo negativo",
-			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: 10, SalePrice: 20, SupplierID: &validSupplierID, AllowDiscount: true, MinDiscountPercent: -5},
+			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: money.New(10), SalePrice: money.New(20), SupplierID: &validSupplierID, AllowDiscount: true, MinDiscountPercent: -5},
 			isUpdate: false,
 			wantErr:  true,
 			errField: "min_discount_percent",
 		},
 		{
 			name:     "desconto máximo negativo",
-			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: 10, SalePrice: 20, SupplierID: &validSupplierID, AllowDiscount: true, MaxDiscountPercent: -10},
+			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: money.New(10), SalePrice: money.New(20), SupplierID: &validSupplierID, AllowDiscount: true, MaxDiscountPercent: -10},
 			isUpdate: false,
 			wantErr:  true,
 			errField: "max_discount_percent",
 		},
 		{
 			name:     "desconto mínimo > máximo",
-			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: 10, SalePrice: 20, SupplierID: &validSupplierID, AllowDiscount: true, MinDiscountPercent: 30, MaxDiscountPercent: 20},
+			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: money.New(10), SalePrice: money.New(20), SupplierID: &validSupplierID, AllowDiscount: true, MinDiscountPercent: 30, MaxDiscountPercent: 20},
 			isUpdate: false,
 			wantErr:  true,
 			errField: "discount_range",
 		},
 		{
 			name:     "desconto máximo > 100%",
-			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: 10, SalePrice: 20, SupplierID: &validSupplierID, AllowDiscount: true, MinDiscountPercent: 10, MaxDiscountPercent: 120},
+			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: money.New(10), SalePrice: money.New(20), SupplierID: &validSupplierID, AllowDiscount: true, MinDiscountPercent: 10, MaxDiscountPercent: 120},
 			isUpdate: false,
 			wantErr:  true,
 			errField: "max_discount_percent",
 		},
 		{
 			name:     "estoque mínimo negativo",
-			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: 10, SalePrice: 20, SupplierID: &validSupplierID, MinStock: -1},
+			input:    Product{ProductName: "Produto", Manufacturer: "Fab", CostPrice: money.New(10), SalePrice: money.New(20), SupplierID: &validSupplierID, MinStock: -1},
 			isUpdate: false,
 			wantErr:  true,
 			errField: "min_stock",
@@ -144,8 +145,8 @@ func TestProduct_Validate(t *testing.T) {
 				return Product{
 					ProductName:  "Produto",
 					Manufacturer: "Fab",
-					CostPrice:    10,
-					SalePrice:    20,
+					CostPrice:    money.New(10),
+					SalePrice:    money.New(20),
 					SupplierID:   &validSupplierID,
 					MinStock:     min,
 					MaxStock:     &max,
@@ -160,8 +161,8 @@ func TestProduct_Validate(t *testing.T) {
 			input: Product{
 				ProductName:        "Produto",
 				Manufacturer:       "Fab",
-				CostPrice:          10,
-				SalePrice:          20,
+				CostPrice:          money.New(10),
+				SalePrice:          money.New(20),
 				SupplierID:         &validSupplierID,
 				AllowDiscount:      false,
 				MinDiscountPercent: -5, // Deve ser rejeitado mesmo com AllowDiscount = false
@@ -176,8 +177,8 @@ func TestProduct_Validate(t *testing.T) {
 			input: Product{
 				ProductName:        "Produto",
 				Manufacturer:       "Fab",
-				CostPrice:          10,
-				SalePrice:          20,
+				CostPrice:          money.New(10),
+				SalePrice:          money.New(20),
 				StockQuantity:      5,
 				MinStock:           1,
 				MaxStock:           func() *int { v := 10; return &v }(),
@@ -195,8 +196,8 @@ func TestProduct_Validate(t *testing.T) {
 			input: Product{
 				ProductName:        "Produto",
 				Manufacturer:       "Fab",
-				CostPrice:          10,
-				SalePrice:          20,
+				CostPrice:          money.New(10),
+				SalePrice:          money.New(20),
 				StockQuantity:      5,
 				AllowDiscount:      false,
 				MinDiscountPercent: 0,
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+const (
+	DefaultLimit             = 20
+	MaxLimit                 = 50
+	DefaultAutocompleteLimit = 10
+	MinQueryLength           = 2
+	MaxQueryLength           = 100
+)
+
+// ProductSearch é a busca livre de produtos (GET /products/search).
+type ProductSearch struct {
+	Query string
+	Limit int
+}
+
+// Normalize remove espaços das pontas e aplica o limite padrão.
+func (s *ProductSearch) Normalize(defaultLimit int) {
+	s.Query = strings.TrimSpace(s.Query)
+	if s.Limit <= 0 {
+		s.Limit = defaultLimit
+	}
+	if s.Limit > MaxLimit {
+		s.Limit = MaxLimit
+	}
+}
+
+func (s *ProductSearch) Validate() error {
+	length := utf8.RuneCountInString(s.Query)
+	if length < MinQueryLength {
+		return &validators.ValidationError{Field: "q", Message: "informe ao menos 2 caracteres"}
+	}
+	if length > MaxQueryLength {
+		return &validators.ValidationError{Field: "q", Message: "máximo de 100 caracteres"}
+	}
+	if s.Limit < 0 {
+		return &validators.ValidationError{Field: "limit", Message: "não pode ser negativo"}
+	}
+	return nil
+}
+
+// Result é um produto encontrado com a relevância calculada pelo banco.
+// BarcodeMatch indica que o termo é exatamente o código de barras; esses
+// resultados vêm sempre primeiro.
+type Result struct {
+	Product      *models.Product
+	Rank         float64
+	BarcodeMatch bool
+}
+
+// Suggestion é a forma enxuta usada no autocomplete do PDV.
+type Suggestion struct {
+	ID            int64
+	ProductName   string
+	SalePrice     money.Money
+	StockQuantity int
+}
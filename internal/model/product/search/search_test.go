@@ -0,0 +1,42 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductSearch_Normalize(t *testing.T) {
+	s := &ProductSearch{Query: "  café  "}
+	s.Normalize(DefaultLimit)
+	assert.Equal(t, "café", s.Query)
+	assert.Equal(t, DefaultLimit, s.Limit)
+
+	s = &ProductSearch{Query: "x", Limit: 500}
+	s.Normalize(DefaultAutocompleteLimit)
+	assert.Equal(t, MaxLimit, s.Limit)
+}
+
+func TestProductSearch_Validate(t *testing.T) {
+	t.Run("válida", func(t *testing.T) {
+		assert.NoError(t, (&ProductSearch{Query: "pão", Limit: 10}).Validate())
+	})
+
+	t.Run("termo curto conta runas, não bytes", func(t *testing.T) {
+		err := (&ProductSearch{Query: "é"}).Validate()
+		assert.IsType(t, &validators.ValidationError{}, err)
+		assert.Contains(t, err.Error(), "2 caracteres")
+	})
+
+	t.Run("termo longo", func(t *testing.T) {
+		err := (&ProductSearch{Query: strings.Repeat("a", MaxQueryLength+1)}).Validate()
+		assert.Contains(t, err.Error(), "100 caracteres")
+	})
+
+	t.Run("limit negativo", func(t *testing.T) {
+		err := (&ProductSearch{Query: "pão", Limit: -1}).Validate()
+		assert.Contains(t, err.Error(), "limit")
+	})
+}
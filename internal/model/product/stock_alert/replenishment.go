@@ -0,0 +1,113 @@
+package model
+
+import (
+	"math"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+const (
+	DefaultWindowDays = 30
+	DefaultLeadDays   = 7
+	MaxWindowDays     = 365
+)
+
+// ReplenishmentQuery define o período de vendas usado para medir a saída de
+// cada produto e o prazo de entrega esperado do fornecedor.
+type ReplenishmentQuery struct {
+	SupplierID *int64
+	WindowDays int
+	LeadDays   int
+}
+
+func (q *ReplenishmentQuery) Validate() error {
+	if q.WindowDays <= 0 || q.WindowDays > MaxWindowDays {
+		return &validators.ValidationError{Field: "days", Message: "deve estar entre 1 e 365"}
+	}
+	if q.LeadDays < 0 || q.LeadDays > MaxWindowDays {
+		return &validators.ValidationError{Field: "lead_days", Message: "deve estar entre 0 e 365"}
+	}
+	return nil
+}
+
+// ReplenishmentItem é um produto candidato a reposição. SoldQuantity é o
+// total vendido no período (já descontadas as devoluções).
+type ReplenishmentItem struct {
+	ProductID         int64
+	ProductName       string
+	SupplierID        *int64
+	SupplierName      string
+	StockQuantity     int
+	MinStock          int
+	MaxStock          *int
+	CostPrice         money.Money
+	SoldQuantity      int
+	DailySales        float64
+	SuggestedQuantity int
+	EstimatedCost     money.Money
+}
+
+// Suggest calcula a quantidade a pedir. O estoque é projetado para a chegada
+// do pedido descontando a venda média diária durante o prazo de entrega; se
+// a projeção ficar abaixo do mínimo, pede-se o necessário para chegar ao
+// max_stock. Sem max_stock, o alvo é o mínimo mais um período de vendas.
+// Devolve false quando o produto não precisa de reposição.
+func (i *ReplenishmentItem) Suggest(windowDays, leadDays int) bool {
+	i.DailySales = 0
+	if windowDays > 0 && i.SoldQuantity > 0 {
+		i.DailySales = math.Round(float64(i.SoldQuantity)/float64(windowDays)*100) / 100
+	}
+
+	leadDemand := int(math.Ceil(float64(i.SoldQuantity) * float64(leadDays) / float64(max(windowDays, 1))))
+	projected := max(i.StockQuantity-leadDemand, 0)
+	if i.MinStock <= 0 || projected >= i.MinStock {
+		i.SuggestedQuantity, i.EstimatedCost = 0, money.Zero
+		return false
+	}
+
+	target := i.MinStock + i.SoldQuantity
+	if i.MaxStock != nil {
+		target = *i.MaxStock
+	}
+
+	i.SuggestedQuantity = max(target-projected, i.MinStock-projected)
+	i.EstimatedCost = i.CostPrice.Mul(int64(i.SuggestedQuantity))
+	return true
+}
+
+// SupplierReplenishment agrupa as sugestões de um fornecedor; SupplierID nil
+// reúne os produtos sem fornecedor.
+type SupplierReplenishment struct {
+	SupplierID    *int64
+	SupplierName  string
+	Items         []*ReplenishmentItem
+	TotalQuantity int
+	TotalCost     money.Money
+}
+
+// GroupBySupplier agrupa os itens mantendo a ordem em que chegam.
+func GroupBySupplier(items []*ReplenishmentItem) []*SupplierReplenishment {
+	groups := make([]*SupplierReplenishment, 0)
+	index := make(map[int64]*SupplierReplenishment)
+
+	for _, item := range items {
+		var key int64
+		if item.SupplierID != nil {
+			key = *item.SupplierID
+		}
+
+		group, ok := index[key]
+		if !ok {
+			group = &SupplierReplenishment{SupplierID: item.SupplierID, SupplierName: item.SupplierName}
+			index[key] = group
+			groups = append(groups, group)
+		}
+
+		group.Items = append(group.Items, item)
+		group.TotalQuantity += item.SuggestedQuantity
+		group.TotalCost = group.TotalCost.Add(item.EstimatedCost)
+	}
+
+	return groups
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"time"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+const (
+	StatusOpen     = "open"
+	StatusResolved = "resolved"
+)
+
+// StockAlert é o evento gravado quando um produto fica abaixo do estoque
+// mínimo. StockQuantity e MinStock são os valores no momento da detecção;
+// ResolvedAt é preenchido quando o estoque volta ao mínimo.
+type StockAlert struct {
+	ID            int64
+	ProductID     int64
+	ProductName   string
+	StockQuantity int
+	MinStock      int
+	CreatedAt     time.Time
+	ResolvedAt    *time.Time
+}
+
+func (a *StockAlert) Status() string {
+	if a.ResolvedAt != nil {
+		return StatusResolved
+	}
+	return StatusOpen
+}
+
+type StockAlertFilter struct {
+	filter.BaseFilter
+	ProductID *int64
+	Status    string
+}
+
+func (f *StockAlertFilter) Validate() error {
+	if err := f.BaseFilter.Validate(); err != nil {
+		return err
+	}
+
+	if f.Status != "" && f.Status != StatusOpen && f.Status != StatusResolved {
+		return &validators.ValidationError{
+			Field:   "Status",
+			Message: "status inválido. Valores permitidos: open, resolved",
+		}
+	}
+
+	return nil
+}
+
+// LowStockItem é uma linha do relatório de produtos abaixo do mínimo.
+type LowStockItem struct {
+	ProductID     int64
+	ProductName   string
+	SupplierID    *int64
+	SupplierName  string
+	StockQuantity int
+	MinStock      int
+	MaxStock      *int
+}
+
+// Shortage é quanto falta para chegar ao estoque mínimo.
+func (i *LowStockItem) Shortage() int {
+	if i.StockQuantity >= i.MinStock {
+		return 0
+	}
+	return i.MinStock - i.StockQuantity
+}
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func intPtr(v int) *int       { return &v }
+func int64Ptr(v int64) *int64 { return &v }
+
+func TestStockAlert_Status(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, StatusOpen, (&StockAlert{}).Status())
+	assert.Equal(t, StatusResolved, (&StockAlert{ResolvedAt: &now}).Status())
+}
+
+func TestStockAlertFilter_Validate(t *testing.T) {
+	assert.NoError(t, (&StockAlertFilter{Status: StatusOpen}).Validate())
+	assert.Error(t, (&StockAlertFilter{Status: "closed"}).Validate())
+	assert.Error(t, (&StockAlertFilter{BaseFilter: filter.BaseFilter{Limit: -1}}).Validate())
+}
+
+func TestLowStockItem_Shortage(t *testing.T) {
+	assert.Equal(t, 7, (&LowStockItem{StockQuantity: 3, MinStock: 10}).Shortage())
+	assert.Equal(t, 0, (&LowStockItem{StockQuantity: 12, MinStock: 10}).Shortage())
+}
+
+func TestReplenishmentQuery_Validate(t *testing.T) {
+	assert.NoError(t, (&ReplenishmentQuery{WindowDays: 30, LeadDays: 7}).Validate())
+	assert.Error(t, (&ReplenishmentQuery{WindowDays: 0, LeadDays: 7}).Validate())
+	assert.Error(t, (&ReplenishmentQuery{WindowDays: 30, LeadDays: -1}).Validate())
+}
+
+func TestReplenishmentItem_Suggest(t *testing.T) {
+	t.Run("pede até o max_stock descontando a saída no prazo de entrega", func(t *testing.T) {
+		// 60 vendidos em 30 dias = 2/dia; em 7 dias saem 14, projeção 20-14 = 6
+		item := &ReplenishmentItem{StockQuantity: 20, MinStock: 10, MaxStock: intPtr(50), SoldQuantity: 60, CostPrice: money.MustParse("2.50")}
+
+		assert.True(t, item.Suggest(30, 7))
+		assert.Equal(t, 2.0, item.DailySales)
+		assert.Equal(t, 44, item.SuggestedQuantity)
+		assert.Equal(t, money.New(110), item.EstimatedCost)
+	})
+
+	t.Run("sem necessidade quando a projeção fica no mínimo", func(t *testing.T) {
+		item := &ReplenishmentItem{StockQuantity: 30, MinStock: 10, MaxStock: intPtr(50), SoldQuantity: 60}
+
+		assert.False(t, item.Suggest(30, 7))
+		assert.Zero(t, item.SuggestedQuantity)
+	})
+
+	t.Run("sem max_stock usa mínimo mais um período de vendas", func(t *testing.T) {
+		item := &ReplenishmentItem{StockQuantity: 4, MinStock: 10, SoldQuantity: 15}
+
+		assert.True(t, item.Suggest(30, 0))
+		assert.Equal(t, 21, item.SuggestedQuantity)
+	})
+
+	t.Run("sem vendas repõe até o máximo", func(t *testing.T) {
+		item := &ReplenishmentItem{StockQuantity: 2, MinStock: 5, MaxStock: intPtr(5)}
+
+		assert.True(t, item.Suggest(30, 7))
+		assert.Zero(t, item.DailySales)
+		assert.Equal(t, 3, item.SuggestedQuantity)
+	})
+
+	t.Run("produto sem mínimo nunca é sugerido", func(t *testing.T) {
+		item := &ReplenishmentItem{StockQuantity: 0, MinStock: 0, SoldQuantity: 10}
+
+		assert.False(t, item.Suggest(30, 7))
+	})
+}
+
+func TestGroupBySupplier(t *testing.T) {
+	items := []*ReplenishmentItem{
+		{ProductID: 1, SupplierID: int64Ptr(2), SupplierName: "Alfa", SuggestedQuantity: 10, EstimatedCost: money.MustParse("15.50")},
+		{ProductID: 2, SuggestedQuantity: 1, EstimatedCost: money.New(1)},
+		{ProductID: 3, SupplierID: int64Ptr(2), SupplierName: "Alfa", SuggestedQuantity: 5, EstimatedCost: money.MustParse("4.25")},
+	}
+
+	groups := GroupBySupplier(items)
+
+	assert.Len(t, groups, 2)
+	assert.Equal(t, "Alfa", groups[0].SupplierName)
+	assert.Len(t, groups[0].Items, 2)
+	assert.Equal(t, 15, groups[0].TotalQuantity)
+	assert.Equal(t, money.MustParse("19.75"), groups[0].TotalCost)
+	assert.Nil(t, groups[1].SupplierID)
+	assert.NotNil(t, GroupBySupplier(nil))
+}
@@ -0,0 +1,115 @@
+package model
+
+import (
+	"time"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+const (
+	ReasonSale       = "sale"
+	ReasonReturn     = "return"
+	ReasonReceipt    = "receipt"
+	ReasonAdjustment = "adjustment"
+	ReasonCount      = "count"
+)
+
+// Tipos de documento gravados em RefType.
+const (
+	RefOpening        = "opening"
+	RefSale           = "sale"
+	RefSaleReturn     = "sale_return"
+	RefPurchaseOrder  = "purchase_order"
+	RefServiceOrder   = "service_order"
+	RefInventoryCount = "inventory_count"
+)
+
+var validReasons = map[string]bool{
+	ReasonSale:       true,
+	ReasonReturn:     true,
+	ReasonReceipt:    true,
+	ReasonAdjustment: true,
+	ReasonCount:      true,
+}
+
+// Origin diz por que o estoque mudou e qual documento originou a mudança.
+// Acompanha toda alteração de estoque até o repositório, que grava o
+// movimento na mesma instrução que altera o saldo.
+type Origin struct {
+	Reason  string
+	RefType string
+	RefID   *int64
+}
+
+// NewOrigin monta a origem vinculada a um documento (venda, devolução,
+// pedido de compra, ordem de serviço).
+func NewOrigin(reason, refType string, refID int64) Origin {
+	return Origin{Reason: reason, RefType: refType, RefID: &refID}
+}
+
+// Adjustment é a origem dos ajustes manuais, sem documento.
+func Adjustment() Origin {
+	return Origin{Reason: ReasonAdjustment}
+}
+
+func IsValidReason(reason string) bool {
+	return validReasons[reason]
+}
+
+// StockMovement é uma linha do histórico de estoque. Delta é a variação
+// (negativa nas saídas) e Quantity o saldo resultante após a mudança.
+type StockMovement struct {
+	ID        int64
+	ProductID int64
+	Delta     int
+	Quantity  int
+	Reason    string
+	RefType   string
+	RefID     *int64
+	UserID    *int64
+	CreatedAt time.Time
+}
+
+type StockMovementFilter struct {
+	filter.BaseFilter
+	ProductID   int64
+	Reason      string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+func (f *StockMovementFilter) Validate() error {
+	if err := f.BaseFilter.Validate(); err != nil {
+		return err
+	}
+
+	if f.ProductID <= 0 {
+		return &validators.ValidationError{Field: "ProductID", Message: "ID do produto inválido"}
+	}
+
+	if f.Reason != "" && !IsValidReason(f.Reason) {
+		return &validators.ValidationError{
+			Field:   "Reason",
+			Message: "motivo inválido. Valores permitidos: sale, return, receipt, adjustment, count",
+		}
+	}
+
+	if f.CreatedFrom != nil && f.CreatedTo != nil && f.CreatedFrom.After(*f.CreatedTo) {
+		return &validators.ValidationError{
+			Field:   "CreatedFrom/CreatedTo",
+			Message: "data inicial não pode ser posterior à final",
+		}
+	}
+
+	return nil
+}
+
+// StockAt é o saldo de um produto em uma data. LastMovementAt é nil quando
+// não há movimento até a data, caso em que o saldo é zero.
+type StockAt struct {
+	ProductID      int64
+	At             time.Time
+	Quantity       int
+	LastMovementAt *time.Time
+}
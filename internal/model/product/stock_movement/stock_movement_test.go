@@ -0,0 +1,37 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOrigin(t *testing.T) {
+	o := NewOrigin(ReasonSale, RefSale, 9)
+
+	assert.Equal(t, ReasonSale, o.Reason)
+	assert.Equal(t, RefSale, o.RefType)
+	assert.Equal(t, int64(9), *o.RefID)
+}
+
+func TestAdjustment(t *testing.T) {
+	o := Adjustment()
+
+	assert.Equal(t, ReasonAdjustment, o.Reason)
+	assert.Empty(t, o.RefType)
+	assert.Nil(t, o.RefID)
+}
+
+func TestStockMovementFilter_Validate(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	assert.NoError(t, (&StockMovementFilter{ProductID: 1, Reason: ReasonCount}).Validate())
+	assert.NoError(t, (&StockMovementFilter{ProductID: 1, CreatedFrom: &earlier, CreatedTo: &now}).Validate())
+	assert.Error(t, (&StockMovementFilter{}).Validate())
+	assert.Error(t, (&StockMovementFilter{ProductID: 1, Reason: "loss"}).Validate())
+	assert.Error(t, (&StockMovementFilter{ProductID: 1, CreatedFrom: &now, CreatedTo: &earlier}).Validate())
+	assert.Error(t, (&StockMovementFilter{ProductID: 1, BaseFilter: filter.BaseFilter{Limit: -1}}).Validate())
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"time"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+type PurchaseOrderFilter struct {
+	filter.BaseFilter
+
+	SupplierID   *int64
+	UserID       *int64
+	ProductID    *int64
+	Status       string
+	ExpectedFrom *time.Time
+	ExpectedTo   *time.Time
+	CreatedFrom  *time.Time
+	CreatedTo    *time.Time
+}
+
+func (f *PurchaseOrderFilter) Validate() error {
+	if err := f.BaseFilter.Validate(); err != nil {
+		return err
+	}
+
+	if f.Status != "" {
+		allowedStatuses := map[string]bool{
+			"draft": true, "sent": true, "partially_received": true, "received": true, "canceled": true,
+		}
+		if !allowedStatuses[f.Status] {
+			return &validators.ValidationError{
+				Field:   "Status",
+				Message: "status inválido. Valores permitidos: draft, sent, partially_received, received, canceled",
+			}
+		}
+	}
+
+	if f.ExpectedFrom != nil && f.ExpectedTo != nil && f.ExpectedFrom.After(*f.ExpectedTo) {
+		return &validators.ValidationError{
+			Field:   "ExpectedFrom/ExpectedTo",
+			Message: "intervalo de previsão de entrega inválido",
+		}
+	}
+
+	if f.CreatedFrom != nil && f.CreatedTo != nil && f.CreatedFrom.After(*f.CreatedTo) {
+		return &validators.ValidationError{
+			Field:   "CreatedFrom/CreatedTo",
+			Message: "intervalo de criação inválido",
+		}
+	}
+
+	return nil
+}
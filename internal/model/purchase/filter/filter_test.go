@@ -0,0 +1,44 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPurchaseOrderFilter_Validate(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Hour)
+
+	t.Run("valid filter", func(t *testing.T) {
+		f := &PurchaseOrderFilter{
+			BaseFilter:   filter.BaseFilter{Limit: 10},
+			Status:       "partially_received",
+			ExpectedFrom: &before,
+			ExpectedTo:   &now,
+		}
+		assert.NoError(t, f.Validate())
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		f := &PurchaseOrderFilter{BaseFilter: filter.BaseFilter{Limit: 10}, Status: "open"}
+		assert.ErrorContains(t, f.Validate(), "status inválido")
+	})
+
+	t.Run("invalid expected range", func(t *testing.T) {
+		f := &PurchaseOrderFilter{BaseFilter: filter.BaseFilter{Limit: 10}, ExpectedFrom: &now, ExpectedTo: &before}
+		assert.ErrorContains(t, f.Validate(), "previsão de entrega")
+	})
+
+	t.Run("invalid created range", func(t *testing.T) {
+		f := &PurchaseOrderFilter{BaseFilter: filter.BaseFilter{Limit: 10}, CreatedFrom: &now, CreatedTo: &before}
+		assert.ErrorContains(t, f.Validate(), "intervalo de criação")
+	})
+
+	t.Run("invalid base filter", func(t *testing.T) {
+		f := &PurchaseOrderFilter{BaseFilter: filter.BaseFilter{Limit: -1}}
+		assert.Error(t, f.Validate())
+	})
+}
@@ -0,0 +1,140 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+// Ciclo de vida do pedido: draft → sent → partially_received → received.
+// O cancelamento só é permitido antes de qualquer recebimento.
+const (
+	StatusDraft             = "draft"
+	StatusSent              = "sent"
+	StatusPartiallyReceived = "partially_received"
+	StatusReceived          = "received"
+	StatusCanceled          = "canceled"
+)
+
+// PurchaseOrderItem é uma linha do pedido. ReceivedQuantity acumula o que já
+// foi recebido em todos os recebimentos.
+type PurchaseOrderItem struct {
+	ID               int64
+	PurchaseOrderID  int64
+	ProductID        int64
+	Quantity         int
+	ReceivedQuantity int
+	UnitCost         money.Money
+	CreatedAt        time.Time
+}
+
+// Remaining é a quantidade que ainda falta receber.
+func (i *PurchaseOrderItem) Remaining() int {
+	return i.Quantity - i.ReceivedQuantity
+}
+
+func (i *PurchaseOrderItem) Subtotal() money.Money {
+	return i.UnitCost.Mul(int64(i.Quantity))
+}
+
+type PurchaseOrder struct {
+	ID          int64
+	SupplierID  int64
+	UserID      *int64
+	Status      string
+	Notes       string
+	ExpectedAt  *time.Time
+	TotalAmount money.Money
+	Items       []PurchaseOrderItem
+	Version     int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (o *PurchaseOrder) ValidateStructural() error {
+	var errs validators.ValidationErrors
+
+	if o.SupplierID <= 0 {
+		errs = append(errs, validators.ValidationError{Field: "supplier_id", Message: validators.MsgRequiredField})
+	}
+
+	if len(o.Notes) > 500 {
+		errs = append(errs, validators.ValidationError{Field: "notes", Message: "max 500 characters"})
+	}
+
+	if len(o.Items) == 0 {
+		errs = append(errs, validators.ValidationError{Field: "items", Message: "at least one item is required"})
+	}
+
+	seen := make(map[int64]bool, len(o.Items))
+	for i, item := range o.Items {
+		field := fmt.Sprintf("items[%d]", i)
+
+		if item.ProductID <= 0 {
+			errs = append(errs, validators.ValidationError{Field: field + ".product_id", Message: validators.MsgRequiredField})
+		} else if seen[item.ProductID] {
+			errs = append(errs, validators.ValidationError{Field: field + ".product_id", Message: "duplicated product"})
+		}
+		seen[item.ProductID] = true
+
+		if item.Quantity <= 0 {
+			errs = append(errs, validators.ValidationError{Field: field + ".quantity", Message: "must be greater than 0"})
+		}
+		if item.UnitCost.IsNegative() {
+			errs = append(errs, validators.ValidationError{Field: field + ".unit_cost", Message: "must be >= 0"})
+		}
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// CalculateTotal soma os subtotais das linhas em TotalAmount.
+func (o *PurchaseOrder) CalculateTotal() {
+	var total money.Money
+	for i := range o.Items {
+		total = total.Add(o.Items[i].Subtotal())
+	}
+	o.TotalAmount = total
+}
+
+// Editable indica se cabeçalho e linhas ainda podem ser alterados.
+func (o *PurchaseOrder) Editable() bool {
+	return o.Status == StatusDraft
+}
+
+// Receivable indica se o pedido aceita recebimentos.
+func (o *PurchaseOrder) Receivable() bool {
+	return o.Status == StatusSent || o.Status == StatusPartiallyReceived
+}
+
+// Cancelable indica se o pedido pode ser cancelado: nada recebido ainda.
+func (o *PurchaseOrder) Cancelable() bool {
+	return o.Status == StatusDraft || o.Status == StatusSent
+}
+
+// ReceivedStatus calcula o status do pedido a partir das quantidades recebidas.
+func (o *PurchaseOrder) ReceivedStatus() string {
+	anyReceived, allReceived := false, true
+	for i := range o.Items {
+		if o.Items[i].ReceivedQuantity > 0 {
+			anyReceived = true
+		}
+		if o.Items[i].Remaining() > 0 {
+			allReceived = false
+		}
+	}
+
+	switch {
+	case allReceived:
+		return StatusReceived
+	case anyReceived:
+		return StatusPartiallyReceived
+	default:
+		return o.Status
+	}
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPurchaseOrder_ValidateStructural(t *testing.T) {
+	t.Run("valid order", func(t *testing.T) {
+		o := &PurchaseOrder{
+			SupplierID: 1,
+			Items:      []PurchaseOrderItem{{ProductID: 1, Quantity: 10, UnitCost: money.MustParse("2.50")}, {ProductID: 2, Quantity: 1}},
+		}
+
+		assert.NoError(t, o.ValidateStructural())
+	})
+
+	t.Run("missing supplier and items", func(t *testing.T) {
+		err := (&PurchaseOrder{}).ValidateStructural()
+
+		var vErrs validators.ValidationErrors
+		assert.ErrorAs(t, err, &vErrs)
+		assert.Len(t, vErrs, 2)
+	})
+
+	t.Run("invalid lines", func(t *testing.T) {
+		o := &PurchaseOrder{
+			SupplierID: 1,
+			Notes:      strings.Repeat("a", 501),
+			Items: []PurchaseOrderItem{
+				{ProductID: 0, Quantity: 1},
+				{ProductID: 2, Quantity: 0},
+				{ProductID: 2, Quantity: 1, UnitCost: money.New(-1)},
+			},
+		}
+
+		err := o.ValidateStructural()
+
+		var vErrs validators.ValidationErrors
+		assert.ErrorAs(t, err, &vErrs)
+		assert.Len(t, vErrs, 5)
+	})
+}
+
+func TestPurchaseOrder_CalculateTotal(t *testing.T) {
+	o := &PurchaseOrder{Items: []PurchaseOrderItem{
+		{Quantity: 3, UnitCost: money.MustParse("1.10")},
+		{Quantity: 2, UnitCost: money.New(10)},
+	}}
+
+	o.CalculateTotal()
+
+	assert.Equal(t, money.MustParse("23.30"), o.TotalAmount)
+}
+
+func TestPurchaseOrder_StatusRules(t *testing.T) {
+	cases := []struct {
+		status                           string
+		editable, receivable, cancelable bool
+	}{
+		{StatusDraft, true, false, true},
+		{StatusSent, false, true, true},
+		{StatusPartiallyReceived, false, true, false},
+		{StatusReceived, false, false, false},
+		{StatusCanceled, false, false, false},
+	}
+
+	for _, c := range cases {
+		o := &PurchaseOrder{Status: c.status}
+		assert.Equal(t, c.editable, o.Editable(), c.status)
+		assert.Equal(t, c.receivable, o.Receivable(), c.status)
+		assert.Equal(t, c.cancelable, o.Cancelable(), c.status)
+	}
+}
+
+func TestPurchaseOrder_ReceivedStatus(t *testing.T) {
+	items := func(received ...int) []PurchaseOrderItem {
+		result := make([]PurchaseOrderItem, len(received))
+		for i, r := range received {
+			result[i] = PurchaseOrderItem{Quantity: 5, ReceivedQuantity: r}
+		}
+		return result
+	}
+
+	assert.Equal(t, StatusSent, (&PurchaseOrder{Status: StatusSent, Items: items(0, 0)}).ReceivedStatus())
+	assert.Equal(t, StatusPartiallyReceived, (&PurchaseOrder{Status: StatusSent, Items: items(2, 0)}).ReceivedStatus())
+	assert.Equal(t, StatusPartiallyReceived, (&PurchaseOrder{Status: StatusSent, Items: items(5, 0)}).ReceivedStatus())
+	assert.Equal(t, StatusReceived, (&PurchaseOrder{Status: StatusPartiallyReceived, Items: items(5, 5)}).ReceivedStatus())
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+// Métodos de atualização do custo do produto no recebimento (ver config.Purchase).
+const (
+	CostLatest  = "latest"
+	CostAverage = "average"
+)
+
+// PurchaseReceiptItem é a quantidade recebida de uma linha do pedido. UnitCost
+// vazio no pedido de recebimento assume o custo combinado na linha.
+type PurchaseReceiptItem struct {
+	ID                  int64
+	PurchaseReceiptID   int64
+	PurchaseOrderItemID int64
+	ProductID           int64
+	Quantity            int
+	UnitCost            *money.Money
+	CreatedAt           time.Time
+}
+
+// PurchaseReceipt registra uma entrada (parcial ou total) de mercadorias.
+type PurchaseReceipt struct {
+	ID              int64
+	PurchaseOrderID int64
+	LocationID      int64
+	UserID          *int64
+	Notes           string
+	CostMethod      string
+	Items           []PurchaseReceiptItem
+	CreatedAt       time.Time
+}
+
+func (r *PurchaseReceipt) ValidateStructural() error {
+	var errs validators.ValidationErrors
+
+	if r.PurchaseOrderID <= 0 {
+		errs = append(errs, validators.ValidationError{Field: "purchase_order_id", Message: validators.MsgRequiredField})
+	}
+
+	if r.LocationID <= 0 {
+		errs = append(errs, validators.ValidationError{Field: "location_id", Message: validators.MsgRequiredField})
+	}
+
+	if len(r.Notes) > 500 {
+		errs = append(errs, validators.ValidationError{Field: "notes", Message: "max 500 characters"})
+	}
+
+	if len(r.Items) == 0 {
+		errs = append(errs, validators.ValidationError{Field: "items", Message: "at least one item is required"})
+	}
+
+	seen := make(map[int64]bool, len(r.Items))
+	for i, item := range r.Items {
+		field := fmt.Sprintf("items[%d]", i)
+
+		if item.PurchaseOrderItemID <= 0 {
+			errs = append(errs, validators.ValidationError{Field: field + ".purchase_order_item_id", Message: validators.MsgRequiredField})
+		} else if seen[item.PurchaseOrderItemID] {
+			errs = append(errs, validators.ValidationError{Field: field + ".purchase_order_item_id", Message: "duplicated item"})
+		}
+		seen[item.PurchaseOrderItemID] = true
+
+		if item.Quantity <= 0 {
+			errs = append(errs, validators.ValidationError{Field: field + ".quantity", Message: "must be greater than 0"})
+		}
+		if item.UnitCost != nil && item.UnitCost.IsNegative() {
+			errs = append(errs, validators.ValidationError{Field: field + ".unit_cost", Message: "must be >= 0"})
+		}
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPurchaseReceipt_ValidateStructural(t *testing.T) {
+	cost := money.MustParse("2.50")
+	negative := money.New(-1)
+
+	t.Run("valid receipt", func(t *testing.T) {
+		r := &PurchaseReceipt{
+			PurchaseOrderID: 1,
+			LocationID:      1,
+			Items: []PurchaseReceiptItem{
+				{PurchaseOrderItemID: 1, Quantity: 2},
+				{PurchaseOrderItemID: 2, Quantity: 1, UnitCost: &cost},
+			},
+		}
+
+		assert.NoError(t, r.ValidateStructural())
+	})
+
+	t.Run("missing order and items", func(t *testing.T) {
+		err := (&PurchaseReceipt{}).ValidateStructural()
+
+		var vErrs validators.ValidationErrors
+		assert.ErrorAs(t, err, &vErrs)
+		assert.Len(t, vErrs, 3)
+	})
+
+	t.Run("invalid lines", func(t *testing.T) {
+		r := &PurchaseReceipt{
+			PurchaseOrderID: 1,
+			LocationID:      1,
+			Items: []PurchaseReceiptItem{
+				{PurchaseOrderItemID: 0, Quantity: 1},
+				{PurchaseOrderItemID: 2, Quantity: 0},
+				{PurchaseOrderItemID: 2, Quantity: 1, UnitCost: &negative},
+			},
+		}
+
+		err := r.ValidateStructural()
+
+		var vErrs validators.ValidationErrors
+		assert.ErrorAs(t, err, &vErrs)
+		assert.Len(t, vErrs, 4)
+	})
+}
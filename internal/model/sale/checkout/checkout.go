@@ -0,0 +1,180 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	modelsItem "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	modelsSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+type CheckoutItem struct {
+	ProductID   int64
+	Quantity    int
+	Discount    money.Money
+	Description string
+}
+
+// Checkout é a venda montada no caixa. Payments traz as formas de pagamento
+// (dinheiro, cartão, pix ou crédito); sem elas, a venda inteira é paga em
+// PaymentType.
+type Checkout struct {
+	ClientID          *int64
+	ClientCnpjID      *int64
+	UserID            *int64
+	LocationID        int64
+	PaymentType       string
+	TotalSaleDiscount money.Money
+	Notes             string
+	Items             []CheckoutItem
+	Payments          []modelsPayment.SalePayment
+}
+
+type CheckoutResult struct {
+	Sale     *modelsSale.Sale
+	Items    []*modelsItem.SaleItem
+	Payments []*modelsPayment.SalePayment
+}
+
+// LineError descreve o motivo pelo qual uma linha do checkout foi recusada.
+// Line é o índice da linha no pedido original (base 0).
+type LineError struct {
+	Line      int
+	ProductID int64
+	Message   string
+}
+
+// CheckoutError agrupa os erros por linha que impediram a venda.
+// Qualquer erro desse tipo implica rollback completo da transação.
+type CheckoutError struct {
+	Lines []LineError
+}
+
+func (e *CheckoutError) Error() string {
+	parts := make([]string, len(e.Lines))
+	for i, l := range e.Lines {
+		parts[i] = fmt.Sprintf("linha %d (produto %d): %s", l.Line, l.ProductID, l.Message)
+	}
+	return fmt.Sprintf("%s: %s", errMsg.ErrSaleCheckoutRejected.Error(), strings.Join(parts, "; "))
+}
+
+func (e *CheckoutError) Unwrap() error {
+	return errMsg.ErrSaleCheckoutRejected
+}
+
+func (c *Checkout) Validate() error {
+	var errs validators.ValidationErrors
+
+	switch {
+	case len(c.Payments) > 0:
+		// Com payments, payment_type é derivado da forma de maior valor
+	case validators.IsBlank(c.PaymentType):
+		errs = append(errs, validators.ValidationError{Field: "payment_type", Message: validators.MsgRequiredField})
+	case !modelsPayment.IsValidMethod(c.PaymentType):
+		errs = append(errs, validators.ValidationError{Field: "payment_type", Message: "invalid payment type"})
+	}
+
+	for i := range c.Payments {
+		var pErrs validators.ValidationErrors
+		if errors.As(c.Payments[i].Validate(), &pErrs) {
+			for _, e := range pErrs {
+				e.Field = fmt.Sprintf("payments[%d].%s", i, e.Field)
+				errs = append(errs, e)
+			}
+		}
+	}
+
+	// A baixa de estoque sai da loja ou depósito onde a venda foi feita
+	if c.LocationID <= 0 {
+		errs = append(errs, validators.ValidationError{Field: "location_id", Message: validators.MsgRequiredField})
+	}
+
+	if c.ClientID != nil && c.ClientCnpjID != nil {
+		errs = append(errs, validators.ValidationError{Field: "client_cnpj_id", Message: "a sale references either client_id or client_cnpj_id"})
+	}
+
+	hasClient := (c.ClientID != nil && *c.ClientID > 0) || (c.ClientCnpjID != nil && *c.ClientCnpjID > 0)
+	if c.usesCredit() && !hasClient {
+		errs = append(errs, validators.ValidationError{Field: "client_id", Message: "credit sales require a client"})
+	}
+
+	if c.TotalSaleDiscount.IsNegative() {
+		errs = append(errs, validators.ValidationError{Field: "total_sale_discount", Message: "must be >= 0"})
+	}
+
+	if len(c.Notes) > 500 {
+		errs = append(errs, validators.ValidationError{Field: "notes", Message: "max 500 characters"})
+	}
+
+	if len(c.Items) == 0 {
+		errs = append(errs, validators.ValidationError{Field: "items", Message: "at least one item is required"})
+	}
+
+	for i, item := range c.Items {
+		field := fmt.Sprintf("items[%d]", i)
+
+		if item.ProductID <= 0 {
+			errs = append(errs, validators.ValidationError{Field: field + ".product_id", Message: validators.MsgRequiredField})
+		}
+		if item.Quantity <= 0 {
+			errs = append(errs, validators.ValidationError{Field: field + ".quantity", Message: "must be greater than 0"})
+		}
+		if item.Discount.IsNegative() {
+			errs = append(errs, validators.ValidationError{Field: field + ".discount", Message: "must be >= 0"})
+		}
+		if len(item.Description) > 500 {
+			errs = append(errs, validators.ValidationError{Field: field + ".description", Message: "max 500 characters"})
+		}
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+func (c *Checkout) usesCredit() bool {
+	if len(c.Payments) == 0 {
+		return c.PaymentType == modelsPayment.MethodCredit
+	}
+	for _, p := range c.Payments {
+		if p.Method == modelsPayment.MethodCredit {
+			return true
+		}
+	}
+	return false
+}
+
+// Tenders devolve os pagamentos a registrar para uma venda de total
+// totalAmount. Sem payments, a venda inteira é paga em PaymentType; venda de
+// valor zero não tem pagamento.
+func (c *Checkout) Tenders(totalAmount money.Money) []*modelsPayment.SalePayment {
+	if len(c.Payments) == 0 {
+		if !totalAmount.IsPositive() {
+			return nil
+		}
+		return []*modelsPayment.SalePayment{{Method: c.PaymentType, Amount: totalAmount}}
+	}
+
+	tenders := make([]*modelsPayment.SalePayment, len(c.Payments))
+	for i := range c.Payments {
+		p := c.Payments[i]
+		tenders[i] = &p
+	}
+	return tenders
+}
+
+// QuantitiesByProduct soma as quantidades pedidas por produto, já que o mesmo
+// produto pode aparecer em mais de uma linha.
+func (c *Checkout) QuantitiesByProduct() map[int64]int {
+	result := make(map[int64]int, len(c.Items))
+	for _, item := range c.Items {
+		result[item.ProductID] += item.Quantity
+	}
+	return result
+}
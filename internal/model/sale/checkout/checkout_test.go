@@ -0,0 +1,185 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func validCheckout() *Checkout {
+	return &Checkout{
+		LocationID:  1,
+		PaymentType: "cash",
+		Items: []CheckoutItem{
+			{ProductID: 1, Quantity: 2, Discount: money.MustParse("1.50")},
+			{ProductID: 2, Quantity: 1},
+		},
+	}
+}
+
+func TestCheckout_Validate(t *testing.T) {
+	t.Run("válido", func(t *testing.T) {
+		assert.NoError(t, validCheckout().Validate())
+	})
+
+	t.Run("location_id obrigatório", func(t *testing.T) {
+		c := validCheckout()
+		c.LocationID = 0
+		err := c.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "location_id")
+	})
+
+	t.Run("payment_type obrigatório", func(t *testing.T) {
+		c := validCheckout()
+		c.PaymentType = " "
+		err := c.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "payment_type")
+	})
+
+	t.Run("payment_type inválido", func(t *testing.T) {
+		c := validCheckout()
+		c.PaymentType = "boleto"
+		err := c.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid payment type")
+	})
+
+	t.Run("venda a crédito exige cliente", func(t *testing.T) {
+		c := validCheckout()
+		c.PaymentType = "credit"
+		err := c.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "client_id")
+
+		clientID := int64(4)
+		c.ClientID = &clientID
+		assert.NoError(t, c.Validate())
+	})
+
+	t.Run("venda a crédito com cliente CNPJ", func(t *testing.T) {
+		c := validCheckout()
+		c.PaymentType = "credit"
+		clientID := int64(4)
+		c.ClientCnpjID = &clientID
+		assert.NoError(t, c.Validate())
+	})
+
+	t.Run("cliente CPF e CNPJ ao mesmo tempo", func(t *testing.T) {
+		c := validCheckout()
+		clientID := int64(4)
+		c.ClientID, c.ClientCnpjID = &clientID, &clientID
+		err := c.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "client_cnpj_id")
+	})
+
+	t.Run("desconto da venda negativo", func(t *testing.T) {
+		c := validCheckout()
+		c.TotalSaleDiscount = money.New(-1)
+		assert.Error(t, c.Validate())
+	})
+
+	t.Run("notes acima do limite", func(t *testing.T) {
+		c := validCheckout()
+		c.Notes = string(make([]byte, 501))
+		assert.Error(t, c.Validate())
+	})
+
+	t.Run("sem itens", func(t *testing.T) {
+		c := validCheckout()
+		c.Items = nil
+		err := c.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "items")
+	})
+
+	t.Run("linha inválida informa índice", func(t *testing.T) {
+		c := validCheckout()
+		c.Items[1] = CheckoutItem{ProductID: 0, Quantity: 0, Discount: money.New(-1)}
+		err := c.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "items[1].product_id")
+		assert.Contains(t, err.Error(), "items[1].quantity")
+		assert.Contains(t, err.Error(), "items[1].discount")
+	})
+
+	t.Run("payments dispensam payment_type e são validados por índice", func(t *testing.T) {
+		c := validCheckout()
+		c.PaymentType = ""
+		c.Payments = []modelsPayment.SalePayment{
+			{Method: modelsPayment.MethodCash, Amount: money.New(20)},
+			{Method: "boleto", Amount: money.Zero},
+		}
+		err := c.Validate()
+		assert.Error(t, err)
+		assert.NotContains(t, err.Error(), "'payment_type'")
+		assert.Contains(t, err.Error(), "payments[1].method")
+		assert.Contains(t, err.Error(), "payments[1].amount")
+
+		c.Payments = c.Payments[:1]
+		assert.NoError(t, c.Validate())
+	})
+
+	t.Run("crédito em payments exige cliente", func(t *testing.T) {
+		c := validCheckout()
+		c.Payments = []modelsPayment.SalePayment{{Method: modelsPayment.MethodCredit, Amount: money.New(20)}}
+		err := c.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "client_id")
+	})
+}
+
+func TestCheckout_Tenders(t *testing.T) {
+	t.Run("sem payments paga o total em payment_type", func(t *testing.T) {
+		c := validCheckout()
+
+		tenders := c.Tenders(money.New(45))
+
+		assert.Len(t, tenders, 1)
+		assert.Equal(t, "cash", tenders[0].Method)
+		assert.Equal(t, money.New(45), tenders[0].Amount)
+		assert.Empty(t, c.Tenders(money.Zero))
+	})
+
+	t.Run("payments são copiados", func(t *testing.T) {
+		c := validCheckout()
+		c.Payments = []modelsPayment.SalePayment{
+			{Method: modelsPayment.MethodPix, Amount: money.New(20)},
+			{Method: modelsPayment.MethodCash, Amount: money.New(30)},
+		}
+
+		tenders := c.Tenders(money.New(45))
+		tenders[1].ChangeGiven = money.New(5)
+
+		assert.Len(t, tenders, 2)
+		assert.Equal(t, modelsPayment.MethodPix, tenders[0].Method)
+		assert.True(t, c.Payments[1].ChangeGiven.IsZero())
+	})
+}
+
+func TestCheckout_QuantitiesByProduct(t *testing.T) {
+	c := &Checkout{Items: []CheckoutItem{
+		{ProductID: 1, Quantity: 2},
+		{ProductID: 2, Quantity: 1},
+		{ProductID: 1, Quantity: 3},
+	}}
+
+	assert.Equal(t, map[int64]int{1: 5, 2: 1}, c.QuantitiesByProduct())
+}
+
+func TestCheckoutError(t *testing.T) {
+	err := &CheckoutError{Lines: []LineError{
+		{Line: 0, ProductID: 10, Message: "estoque insuficiente"},
+		{Line: 2, ProductID: 11, Message: "produto desativado"},
+	}}
+
+	assert.True(t, errors.Is(err, errMsg.ErrSaleCheckoutRejected))
+	assert.Contains(t, err.Error(), "linha 0 (produto 10): estoque insuficiente")
+	assert.Contains(t, err.Error(), "linha 2 (produto 11): produto desativado")
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
 )
 
@@ -11,17 +12,18 @@ type SaleFilter struct {
 	filter.BaseFilter
 
 	ClientID              *int64
+	ClientCnpjID          *int64
 	UserID                *int64
 	PaymentType           string
 	Status                string
-	MinTotalItemsAmount   *float64
-	MaxTotalItemsAmount   *float64
-	MinTotalItemsDiscount *float64
-	MaxTotalItemsDiscount *float64
-	MinTotalSaleDiscount  *float64
-	MaxTotalSaleDiscount  *float64
-	MinTotalAmount        *float64
-	MaxTotalAmount        *float64
+	MinTotalItemsAmount   *money.Money
+	MaxTotalItemsAmount   *money.Money
+	MinTotalItemsDiscount *money.Money
+	MaxTotalItemsDiscount *money.Money
+	MinTotalSaleDiscount  *money.Money
+	MaxTotalSaleDiscount  *money.Money
+	MinTotalAmount        *money.Money
+	MaxTotalAmount        *money.Money
 	Notes                 string
 	SaleDateFrom          *time.Time
 	SaleDateTo            *time.Time
@@ -59,7 +61,7 @@ func (f *SaleFilter) Validate() error {
 	}
 
 	// Valida intervalos de TotalItemsAmount
-	if f.MinTotalItemsAmount != nil && f.MaxTotalItemsAmount != nil && *f.MinTotalItemsAmount > *f.MaxTotalItemsAmount {
+	if f.MinTotalItemsAmount != nil && f.MaxTotalItemsAmount != nil && f.MinTotalItemsAmount.GreaterThan(*f.MaxTotalItemsAmount) {
 		return &validators.ValidationError{
 			Field:   "MinTotalItemsAmount/MaxTotalItemsAmount",
 			Message: "intervalo de valor total dos itens inválido",
@@ -67,7 +69,7 @@ func (f *SaleFilter) Validate() error {
 	}
 
 	// Valida intervalos de TotalItemsDiscount
-	if f.MinTotalItemsDiscount != nil && f.MaxTotalItemsDiscount != nil && *f.MinTotalItemsDiscount > *f.MaxTotalItemsDiscount {
+	if f.MinTotalItemsDiscount != nil && f.MaxTotalItemsDiscount != nil && f.MinTotalItemsDiscount.GreaterThan(*f.MaxTotalItemsDiscount) {
 		return &validators.ValidationError{
 			Field:   "MinTotalItemsDiscount/MaxTotalItemsDiscount",
 			Message: "intervalo de desconto dos itens inválido",
@@ -75,7 +77,7 @@ func (f *SaleFilter) Validate() error {
 	}
 
 	// Valida intervalos de TotalSaleDiscount
-	if f.MinTotalSaleDiscount != nil && f.MaxTotalSaleDiscount != nil && *f.MinTotalSaleDiscount > *f.MaxTotalSaleDiscount {
+	if f.MinTotalSaleDiscount != nil && f.MaxTotalSaleDiscount != nil && f.MinTotalSaleDiscount.GreaterThan(*f.MaxTotalSaleDiscount) {
 		return &validators.ValidationError{
 			Field:   "MinTotalSaleDiscount/MaxTotalSaleDiscount",
 			Message: "intervalo de desconto da venda inválido",
@@ -83,7 +85,7 @@ func (f *SaleFilter) Validate() error {
 	}
 
 	// Valida intervalos de TotalAmount
-	if f.MinTotalAmount != nil && f.MaxTotalAmount != nil && *f.MinTotalAmount > *f.MaxTotalAmount {
+	if f.MinTotalAmount != nil && f.MaxTotalAmount != nil && f.MinTotalAmount.GreaterThan(*f.MaxTotalAmount) {
 		return &validators.ValidationError{
 			Field:   "MinTotalAmount/MaxTotalAmount",
 			Message: "intervalo de valor total da venda inválido",
@@ -91,49 +93,49 @@ func (f *SaleFilter) Validate() error {
 	}
 
 	// Valida que valores monetários não podem ser negativos
-	if f.MinTotalItemsAmount != nil && *f.MinTotalItemsAmount < 0 {
+	if f.MinTotalItemsAmount != nil && f.MinTotalItemsAmount.IsNegative() {
 		return &validators.ValidationError{
 			Field:   "MinTotalItemsAmount",
 			Message: "não pode ser negativo",
 		}
 	}
-	if f.MaxTotalItemsAmount != nil && *f.MaxTotalItemsAmount < 0 {
+	if f.MaxTotalItemsAmount != nil && f.MaxTotalItemsAmount.IsNegative() {
 		return &validators.ValidationError{
 			Field:   "MaxTotalItemsAmount",
 			Message: "não pode ser negativo",
 		}
 	}
-	if f.MinTotalItemsDiscount != nil && *f.MinTotalItemsDiscount < 0 {
+	if f.MinTotalItemsDiscount != nil && f.MinTotalItemsDiscount.IsNegative() {
 		return &validators.ValidationError{
 			Field:   "MinTotalItemsDiscount",
 			Message: "não pode ser negativo",
 		}
 	}
-	if f.MaxTotalItemsDiscount != nil && *f.MaxTotalItemsDiscount < 0 {
+	if f.MaxTotalItemsDiscount != nil && f.MaxTotalItemsDiscount.IsNegative() {
 		return &validators.ValidationError{
 			Field:   "MaxTotalItemsDiscount",
 			Message: "não pode ser negativo",
 		}
 	}
-	if f.MinTotalSaleDiscount != nil && *f.MinTotalSaleDiscount < 0 {
+	if f.MinTotalSaleDiscount != nil && f.MinTotalSaleDiscount.IsNegative() {
 		return &validators.ValidationError{
 			Field:   "MinTotalSaleDiscount",
 			Message: "não pode ser negativo",
 		}
 	}
-	if f.MaxTotalSaleDiscount != nil && *f.MaxTotalSaleDiscount < 0 {
+	if f.MaxTotalSaleDiscount != nil && f.MaxTotalSaleDiscount.IsNegative() {
 		return &validators.ValidationError{
 			Field:   "MaxTotalSaleDiscount",
 			Message: "não pode ser negativo",
 		}
 	}
-	if f.MinTotalAmount != nil && *f.MinTotalAmount < 0 {
+	if f.MinTotalAmount != nil && f.MinTotalAmount.IsNegative() {
 		return &validators.ValidationError{
 			Field:   "MinTotalAmount",
 			Message: "não pode ser negativo",
 		}
 	}
-	if f.MaxTotalAmount != nil && *f.MaxTotalAmount < 0 {
+	if f.MaxTotalAmount != nil && f.MaxTotalAmount.IsNegative() {
 		return &validators.ValidationError{
 			Field:   "MaxTotalAmount",
 			Message: "não pode ser negativo",
@@ -182,13 +184,13 @@ func (f *SaleFilter) Validate() error {
 	}
 
 	// Valida que descontos não podem ser maiores que o valor total
-	if f.MinTotalItemsDiscount != nil && f.MaxTotalAmount != nil && *f.MinTotalItemsDiscount > *f.MaxTotalAmount {
+	if f.MinTotalItemsDiscount != nil && f.MaxTotalAmount != nil && f.MinTotalItemsDiscount.GreaterThan(*f.MaxTotalAmount) {
 		return &validators.ValidationError{
 			Field:   "MinTotalItemsDiscount",
 			Message: "desconto dos itens não pode ser maior que o valor total",
 		}
 	}
-	if f.MinTotalSaleDiscount != nil && f.MaxTotalAmount != nil && *f.MinTotalSaleDiscount > *f.MaxTotalAmount {
+	if f.MinTotalSaleDiscount != nil && f.MaxTotalAmount != nil && f.MinTotalSaleDiscount.GreaterThan(*f.MaxTotalAmount) {
 		return &validators.ValidationError{
 			Field:   "MinTotalSaleDiscount",
 			Message: "desconto da venda não pode ser maior que o valor total",
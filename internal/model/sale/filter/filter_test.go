@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	errval "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
 	"github.com/stretchr/testify/assert"
 )
@@ -116,8 +117,8 @@ func TestSaleFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("invalid MinTotalItemsAmount > MaxTotalItemsAmount", func(t *testing.T) {
-		min := 200.0
-		max := 100.0
+		min := money.New(200)
+		max := money.New(100)
 		f := SaleFilter{
 			MinTotalItemsAmount: &min,
 			MaxTotalItemsAmount: &max,
@@ -128,8 +129,8 @@ func TestSaleFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("invalid MinTotalItemsDiscount > MaxTotalItemsDiscount", func(t *testing.T) {
-		min := 50.0
-		max := 20.0
+		min := money.New(50)
+		max := money.New(20)
 		f := SaleFilter{
 			MinTotalItemsDiscount: &min,
 			MaxTotalItemsDiscount: &max,
@@ -140,8 +141,8 @@ func TestSaleFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("invalid MinTotalSaleDiscount > MaxTotalSaleDiscount", func(t *testing.T) {
-		min := 30.0
-		max := 10.0
+		min := money.New(30)
+		max := money.New(10)
 		f := SaleFilter{
 			MinTotalSaleDiscount: &min,
 			MaxTotalSaleDiscount: &max,
@@ -152,8 +153,8 @@ func TestSaleFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("invalid MinTotalAmount > MaxTotalAmount", func(t *testing.T) {
-		min := 500.0
-		max := 300.0
+		min := money.New(500)
+		max := money.New(300)
 		f := SaleFilter{
 			MinTotalAmount: &min,
 			MaxTotalAmount: &max,
@@ -164,7 +165,7 @@ func TestSaleFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("negative MinTotalItemsAmount", func(t *testing.T) {
-		min := -10.0
+		min := money.New(-10)
 		f := SaleFilter{
 			MinTotalItemsAmount: &min,
 		}
@@ -174,7 +175,7 @@ func TestSaleFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("negative MaxTotalItemsAmount", func(t *testing.T) {
-		max := -5.0
+		max := money.New(-5)
 		f := SaleFilter{
 			MaxTotalItemsAmount: &max,
 		}
@@ -184,7 +185,7 @@ func TestSaleFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("negative MinTotalItemsDiscount", func(t *testing.T) {
-		min := -15.0
+		min := money.New(-15)
 		f := SaleFilter{
 			MinTotalItemsDiscount: &min,
 		}
@@ -194,7 +195,7 @@ func TestSaleFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("negative MaxTotalItemsDiscount", func(t *testing.T) {
-		max := -5.0
+		max := money.New(-5)
 		f := SaleFilter{
 			MaxTotalItemsDiscount: &max,
 		}
@@ -204,7 +205,7 @@ func TestSaleFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("negative MinTotalSaleDiscount", func(t *testing.T) {
-		min := -8.0
+		min := money.New(-8)
 		f := SaleFilter{
 			MinTotalSaleDiscount: &min,
 		}
@@ -214,7 +215,7 @@ func TestSaleFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("negative MaxTotalSaleDiscount", func(t *testing.T) {
-		max := -3.0
+		max := money.New(-3)
 		f := SaleFilter{
 			MaxTotalSaleDiscount: &max,
 		}
@@ -224,7 +225,7 @@ func TestSaleFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("negative MinTotalAmount", func(t *testing.T) {
-		min := -100.0
+		min := money.New(-100)
 		f := SaleFilter{
 			MinTotalAmount: &min,
 		}
@@ -234,7 +235,7 @@ func TestSaleFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("negative MaxTotalAmount", func(t *testing.T) {
-		max := -50.0
+		max := money.New(-50)
 		f := SaleFilter{
 			MaxTotalAmount: &max,
 		}
@@ -244,8 +245,8 @@ func TestSaleFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("MinTotalItemsDiscount greater than MaxTotalAmount", func(t *testing.T) {
-		minDiscount := 200.0
-		maxAmount := 150.0
+		minDiscount := money.New(200)
+		maxAmount := money.New(150)
 		f := SaleFilter{
 			MinTotalItemsDiscount: &minDiscount,
 			MaxTotalAmount:        &maxAmount,
@@ -256,8 +257,8 @@ func TestSaleFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("MinTotalSaleDiscount greater than MaxTotalAmount", func(t *testing.T) {
-		minDiscount := 100.0
-		maxAmount := 80.0
+		minDiscount := money.New(100)
+		maxAmount := money.New(80)
 		f := SaleFilter{
 			MinTotalSaleDiscount: &minDiscount,
 			MaxTotalAmount:       &maxAmount,
@@ -317,14 +318,14 @@ func TestSaleFilter_Validate(t *testing.T) {
 		dayBefore := now.Add(-48 * time.Hour)
 		clientID := int64(1)
 		userID := int64(2)
-		minItemsAmount := 100.0
-		maxItemsAmount := 500.0
-		minItemsDiscount := 10.0
-		maxItemsDiscount := 50.0
-		minSaleDiscount := 5.0
-		maxSaleDiscount := 20.0
-		minTotal := 150.0
-		maxTotal := 450.0
+		minItemsAmount := money.New(100)
+		maxItemsAmount := money.New(500)
+		minItemsDiscount := money.New(10)
+		maxItemsDiscount := money.New(50)
+		minSaleDiscount := money.New(5)
+		maxSaleDiscount := money.New(20)
+		minTotal := money.New(150)
+		maxTotal := money.New(450)
 
 		f := SaleFilter{
 			ClientID:              &clientID,
@@ -353,8 +354,8 @@ func TestSaleFilter_Validate(t *testing.T) {
 	})
 
 	t.Run("valid filter with partial ranges", func(t *testing.T) {
-		minAmount := 100.0
-		maxDiscount := 50.0
+		minAmount := money.New(100)
+		maxDiscount := money.New(50)
 		fromDate := time.Now().Add(-72 * time.Hour)
 
 		f := SaleFilter{
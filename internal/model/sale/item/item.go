@@ -1,8 +1,12 @@
 package model
 
 import (
+	"fmt"
 	"time"
 
+	modelsProduct "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
 )
 
@@ -11,15 +15,56 @@ type SaleItem struct {
 	SaleID      int64
 	ProductID   int64
 	Quantity    int
-	UnitPrice   float64
-	Discount    float64
-	Tax         float64
-	Subtotal    float64
+	UnitPrice   money.Money
+	Discount    money.Money
+	Tax         money.Money
+	Subtotal    money.Money
 	Description string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
 
+// ApplyUnitPrice define o preço unitário e recalcula o subtotal. O preço vem
+// do banco (preço vigente do produto), nunca do cliente.
+func (s *SaleItem) ApplyUnitPrice(price money.Money) {
+	s.UnitPrice = price
+	s.Subtotal = s.UnitPrice.Mul(int64(s.Quantity)).Sub(s.Discount).Add(s.Tax)
+}
+
+// CheckProduct aplica as regras de venda do produto ao item já precificado:
+// produto ativo, desconto permitido, dentro do percentual máximo e não maior
+// que o valor bruto da linha. Valem igualmente no checkout e na inclusão ou
+// edição de itens de uma venda.
+func (s *SaleItem) CheckProduct(product *modelsProduct.Product) error {
+	if !product.Status {
+		return errMsg.ErrProductDisabled
+	}
+
+	if s.Discount.IsZero() {
+		return nil
+	}
+
+	gross := s.UnitPrice.Mul(int64(s.Quantity))
+	switch {
+	case !product.AllowDiscount:
+		return errMsg.ErrProductDiscountNotAllowed
+	case s.Discount.GreaterThan(gross):
+		return fmt.Errorf("%w: desconto maior que o valor da linha", errMsg.ErrInvalidDiscountPercent)
+	case product.MaxDiscountPercent <= 0:
+		return nil
+	}
+
+	limit, err := gross.Percent(product.MaxDiscountPercent)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errMsg.ErrInvalidData, err)
+	}
+	if s.Discount.GreaterThan(limit) {
+		return fmt.Errorf("%w: máximo de %.2f%%", errMsg.ErrInvalidDiscountPercent, product.MaxDiscountPercent)
+	}
+
+	return nil
+}
+
 // --- Validação estrutural ---
 func (s *SaleItem) ValidateStructural() error {
 	var errs validators.ValidationErrors
@@ -33,16 +78,16 @@ func (s *SaleItem) ValidateStructural() error {
 	if s.Quantity <= 0 {
 		errs = append(errs, validators.ValidationError{Field: "quantity", Message: "must be greater than 0"})
 	}
-	if s.UnitPrice < 0 {
+	if s.UnitPrice.IsNegative() {
 		errs = append(errs, validators.ValidationError{Field: "unit_price", Message: "must be >= 0"})
 	}
-	if s.Discount < 0 {
+	if s.Discount.IsNegative() {
 		errs = append(errs, validators.ValidationError{Field: "discount", Message: "must be >= 0"})
 	}
-	if s.Tax < 0 {
+	if s.Tax.IsNegative() {
 		errs = append(errs, validators.ValidationError{Field: "tax", Message: "must be >= 0"})
 	}
-	if s.Subtotal < 0 {
+	if s.Subtotal.IsNegative() {
 		errs = append(errs, validators.ValidationError{Field: "subtotal", Message: "must be >= 0"})
 	}
 	if len(s.Description) > 500 {
@@ -59,7 +104,7 @@ func (s *SaleItem) ValidateStructural() error {
 func (s *SaleItem) ValidateBusinessRules() error {
 	var errs validators.ValidationErrors
 
-	if s.Subtotal != float64(s.Quantity)*s.UnitPrice-(s.Discount)+s.Tax {
+	if s.Subtotal != s.UnitPrice.Mul(int64(s.Quantity)).Sub(s.Discount).Add(s.Tax) {
 		errs = append(errs, validators.ValidationError{
 			Field:   "subtotal",
 			Message: "subtotal must equal (quantity * unit_price - discount + tax)",
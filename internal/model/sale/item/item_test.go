@@ -4,6 +4,9 @@ import (
 	"testing"
 	"time"
 
+	modelsProduct "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -13,10 +16,10 @@ func TestSaleItem_ValidateStructural(t *testing.T) {
 			SaleID:      1,
 			ProductID:   2,
 			Quantity:    5,
-			UnitPrice:   10.5,
-			Discount:    1.0,
-			Tax:         0.5,
-			Subtotal:    52.0,
+			UnitPrice:   money.MustParse("10.50"),
+			Discount:    money.New(1),
+			Tax:         money.MustParse("0.50"),
+			Subtotal:    money.New(52),
 			Description: "Item válido",
 			CreatedAt:   time.Now(),
 			UpdatedAt:   time.Now(),
@@ -27,43 +30,43 @@ func TestSaleItem_ValidateStructural(t *testing.T) {
 	})
 
 	t.Run("sale_id inválido", func(t *testing.T) {
-		si := &SaleItem{SaleID: 0, ProductID: 1, Quantity: 1, UnitPrice: 10, Subtotal: 10}
+		si := &SaleItem{SaleID: 0, ProductID: 1, Quantity: 1, UnitPrice: money.New(10), Subtotal: money.New(10)}
 		err := si.ValidateStructural()
 		assert.Error(t, err)
 	})
 
 	t.Run("product_id inválido", func(t *testing.T) {
-		si := &SaleItem{SaleID: 1, ProductID: 0, Quantity: 1, UnitPrice: 10, Subtotal: 10}
+		si := &SaleItem{SaleID: 1, ProductID: 0, Quantity: 1, UnitPrice: money.New(10), Subtotal: money.New(10)}
 		err := si.ValidateStructural()
 		assert.Error(t, err)
 	})
 
 	t.Run("quantity inválida", func(t *testing.T) {
-		si := &SaleItem{SaleID: 1, ProductID: 1, Quantity: 0, UnitPrice: 10, Subtotal: 10}
+		si := &SaleItem{SaleID: 1, ProductID: 1, Quantity: 0, UnitPrice: money.New(10), Subtotal: money.New(10)}
 		err := si.ValidateStructural()
 		assert.Error(t, err)
 	})
 
 	t.Run("unit_price negativo", func(t *testing.T) {
-		si := &SaleItem{SaleID: 1, ProductID: 1, Quantity: 1, UnitPrice: -10, Subtotal: 10}
+		si := &SaleItem{SaleID: 1, ProductID: 1, Quantity: 1, UnitPrice: money.New(-10), Subtotal: money.New(10)}
 		err := si.ValidateStructural()
 		assert.Error(t, err)
 	})
 
 	t.Run("discount negativo", func(t *testing.T) {
-		si := &SaleItem{SaleID: 1, ProductID: 1, Quantity: 1, UnitPrice: 10, Discount: -5, Subtotal: 10}
+		si := &SaleItem{SaleID: 1, ProductID: 1, Quantity: 1, UnitPrice: money.New(10), Discount: money.New(-5), Subtotal: money.New(10)}
 		err := si.ValidateStructural()
 		assert.Error(t, err)
 	})
 
 	t.Run("tax negativo", func(t *testing.T) {
-		si := &SaleItem{SaleID: 1, ProductID: 1, Quantity: 1, UnitPrice: 10, Tax: -2, Subtotal: 10}
+		si := &SaleItem{SaleID: 1, ProductID: 1, Quantity: 1, UnitPrice: money.New(10), Tax: money.New(-2), Subtotal: money.New(10)}
 		err := si.ValidateStructural()
 		assert.Error(t, err)
 	})
 
 	t.Run("subtotal negativo", func(t *testing.T) {
-		si := &SaleItem{SaleID: 1, ProductID: 1, Quantity: 1, UnitPrice: 10, Subtotal: -5}
+		si := &SaleItem{SaleID: 1, ProductID: 1, Quantity: 1, UnitPrice: money.New(10), Subtotal: money.New(-5)}
 		err := si.ValidateStructural()
 		assert.Error(t, err)
 	})
@@ -73,7 +76,7 @@ func TestSaleItem_ValidateStructural(t *testing.T) {
 		for i := range desc {
 			desc[i] = 'a'
 		}
-		si := &SaleItem{SaleID: 1, ProductID: 1, Quantity: 1, UnitPrice: 10, Subtotal: 10, Description: string(desc)}
+		si := &SaleItem{SaleID: 1, ProductID: 1, Quantity: 1, UnitPrice: money.New(10), Subtotal: money.New(10), Description: string(desc)}
 		err := si.ValidateStructural()
 		assert.Error(t, err)
 	})
@@ -83,10 +86,10 @@ func TestSaleItem_ValidateBusinessRules(t *testing.T) {
 	t.Run("válido", func(t *testing.T) {
 		si := &SaleItem{
 			Quantity:  2,
-			UnitPrice: 10,
-			Discount:  2,
-			Tax:       1,
-			Subtotal:  19,
+			UnitPrice: money.New(10),
+			Discount:  money.New(2),
+			Tax:       money.New(1),
+			Subtotal:  money.New(19),
 		}
 		err := si.ValidateBusinessRules()
 		assert.NoError(t, err)
@@ -95,12 +98,36 @@ func TestSaleItem_ValidateBusinessRules(t *testing.T) {
 	t.Run("subtotal inconsistente", func(t *testing.T) {
 		si := &SaleItem{
 			Quantity:  2,
-			UnitPrice: 10,
-			Discount:  1,
-			Tax:       1,
-			Subtotal:  15, // deveria ser 19
+			UnitPrice: money.New(10),
+			Discount:  money.New(1),
+			Tax:       money.New(1),
+			Subtotal:  money.New(15), // deveria ser 19
 		}
 		err := si.ValidateBusinessRules()
 		assert.Error(t, err)
 	})
 }
+
+func TestSaleItem_ApplyUnitPrice(t *testing.T) {
+	si := &SaleItem{Quantity: 3, UnitPrice: money.New(1), Discount: money.New(2), Tax: money.New(1), Subtotal: money.New(1)}
+
+	si.ApplyUnitPrice(money.New(10))
+
+	assert.Equal(t, money.New(10), si.UnitPrice)
+	assert.Equal(t, money.New(29), si.Subtotal)
+	assert.NoError(t, si.ValidateBusinessRules())
+}
+
+func TestSaleItem_CheckProduct(t *testing.T) {
+	product := &modelsProduct.Product{Status: true, AllowDiscount: true, MaxDiscountPercent: 10}
+	line := func(discount string) *SaleItem {
+		return &SaleItem{Quantity: 2, UnitPrice: money.New(10), Discount: money.MustParse(discount)}
+	}
+
+	assert.NoError(t, line("0").CheckProduct(&modelsProduct.Product{Status: true}))
+	assert.NoError(t, line("2").CheckProduct(product))
+	assert.ErrorIs(t, line("2.01").CheckProduct(product), errMsg.ErrInvalidDiscountPercent)
+	assert.ErrorIs(t, line("21").CheckProduct(&modelsProduct.Product{Status: true, AllowDiscount: true}), errMsg.ErrInvalidDiscountPercent)
+	assert.ErrorIs(t, line("1").CheckProduct(&modelsProduct.Product{Status: true}), errMsg.ErrProductDiscountNotAllowed)
+	assert.ErrorIs(t, line("0").CheckProduct(&modelsProduct.Product{}), errMsg.ErrProductDisabled)
+}
@@ -0,0 +1,195 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+// Formas de pagamento aceitas. Crédito é a venda lançada no razão do cliente.
+const (
+	MethodCash   = "cash"
+	MethodCard   = "card"
+	MethodCredit = "credit"
+	MethodPix    = "pix"
+)
+
+// MaxInstallments é o maior parcelamento aceito no cartão.
+const MaxInstallments = 12
+
+var allowedMethods = map[string]bool{MethodCash: true, MethodCard: true, MethodCredit: true, MethodPix: true}
+
+// IsValidMethod indica se method é uma forma de pagamento aceita.
+func IsValidMethod(method string) bool {
+	return allowedMethods[method]
+}
+
+// SalePayment é uma forma de pagamento usada na venda. Amount é o valor
+// entregue pelo cliente; em dinheiro pode passar do devido e a diferença
+// volta como ChangeGiven. O pagamento entra no caixa aberto de ReceivedBy.
+type SalePayment struct {
+	ID                int64
+	SaleID            int64
+	Method            string
+	Amount            money.Money
+	Installments      int
+	AuthorizationCode string
+	ChangeGiven       money.Money
+	ReceivedBy        *int64
+	CashSessionID     *int64
+	CreatedAt         time.Time
+}
+
+// Net é o valor que fica na venda: o entregue menos o troco.
+func (p *SalePayment) Net() money.Money {
+	return p.Amount.Sub(p.ChangeGiven)
+}
+
+func (p *SalePayment) Validate() error {
+	var errs validators.ValidationErrors
+
+	if validators.IsBlank(p.Method) {
+		errs = append(errs, validators.ValidationError{Field: "method", Message: validators.MsgRequiredField})
+	} else if !IsValidMethod(p.Method) {
+		errs = append(errs, validators.ValidationError{Field: "method", Message: "invalid payment method"})
+	}
+
+	if !p.Amount.IsPositive() {
+		errs = append(errs, validators.ValidationError{Field: "amount", Message: "must be greater than 0"})
+	}
+
+	switch {
+	case p.Installments < 0 || p.Installments > MaxInstallments:
+		errs = append(errs, validators.ValidationError{Field: "installments", Message: fmt.Sprintf("must be between 1 and %d", MaxInstallments)})
+	case p.Installments > 1 && p.Method != MethodCard:
+		errs = append(errs, validators.ValidationError{Field: "installments", Message: "only card payments accept installments"})
+	}
+
+	if len(p.AuthorizationCode) > 100 {
+		errs = append(errs, validators.ValidationError{Field: "authorization_code", Message: "max 100 characters"})
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// AllocateChange confere os pagamentos contra o valor devido e calcula o
+// troco. Só dinheiro dá troco: cartão, pix e crédito precisam caber em due, e
+// o troco sai do último pagamento em dinheiro. Não exige que os pagamentos
+// cubram due; para isso use Settle.
+func AllocateChange(payments []*SalePayment, due money.Money) error {
+	var total, nonCash money.Money
+	lastCash := -1
+	for i, p := range payments {
+		if p.Method == MethodCredit && len(payments) > 1 {
+			return fmt.Errorf("%w: crédito não pode ser combinado com outras formas de pagamento", errMsg.ErrInvalidData)
+		}
+		if p.Installments == 0 {
+			p.Installments = 1
+		}
+		p.ChangeGiven = money.Zero
+		total = total.Add(p.Amount)
+		if p.Method == MethodCash {
+			lastCash = i
+		} else {
+			nonCash = nonCash.Add(p.Amount)
+		}
+	}
+
+	if nonCash.GreaterThan(due) {
+		return fmt.Errorf("%w: %s em cartão, pix ou crédito para %s devidos", errMsg.ErrPaymentExceedsDue, nonCash, due)
+	}
+
+	change := total.Sub(due)
+	if !change.IsPositive() {
+		return nil
+	}
+	// Troco que consome o pagamento em dinheiro inteiro significa que ele
+	// não era necessário.
+	if !change.LessThan(payments[lastCash].Amount) {
+		return fmt.Errorf("%w: troco de %s não sai do último pagamento em dinheiro", errMsg.ErrPaymentExceedsDue, change)
+	}
+	payments[lastCash].ChangeGiven = change
+	return nil
+}
+
+// Settle é AllocateChange exigindo que os pagamentos quitem due, como no
+// checkout.
+func Settle(payments []*SalePayment, due money.Money) error {
+	if err := AllocateChange(payments, due); err != nil {
+		return err
+	}
+	if paid := Paid(payments); paid.LessThan(due) {
+		return fmt.Errorf("%w: pago %s de %s", errMsg.ErrPaymentInsufficient, paid, due)
+	}
+	return nil
+}
+
+// Paid soma o valor líquido dos pagamentos.
+func Paid(payments []*SalePayment) money.Money {
+	var total money.Money
+	for _, p := range payments {
+		total = total.Add(p.Net())
+	}
+	return total
+}
+
+// PrimaryMethod devolve a forma de pagamento de maior valor líquido, gravada
+// em sales.payment_type. Em empate vale a que aparece primeiro.
+func PrimaryMethod(payments []*SalePayment) string {
+	totals := make(map[string]money.Money, len(payments))
+	primary := ""
+	for _, p := range payments {
+		totals[p.Method] = totals[p.Method].Add(p.Net())
+		if primary == "" || totals[p.Method].GreaterThan(totals[primary]) {
+			primary = p.Method
+		}
+	}
+	return primary
+}
+
+// Summary resume os pagamentos de uma venda. A venda só está paga quando a
+// soma líquida dos pagamentos cobre TotalAmount.
+type Summary struct {
+	SaleID      int64
+	TotalAmount money.Money
+	PaidAmount  money.Money
+	ChangeGiven money.Money
+	Payments    []*SalePayment
+}
+
+func NewSummary(saleID int64, totalAmount money.Money, payments []*SalePayment) *Summary {
+	s := &Summary{
+		SaleID:      saleID,
+		TotalAmount: totalAmount,
+		PaidAmount:  Paid(payments),
+		Payments:    payments,
+	}
+	for _, p := range payments {
+		s.ChangeGiven = s.ChangeGiven.Add(p.ChangeGiven)
+	}
+	return s
+}
+
+// Balance é o que falta pagar; nunca negativo.
+func (s *Summary) Balance() money.Money {
+	return money.Max(s.TotalAmount.Sub(s.PaidAmount), money.Zero)
+}
+
+func (s *Summary) IsPaid() bool {
+	return !s.PaidAmount.LessThan(s.TotalAmount)
+}
+
+// MethodTotal é uma linha do relatório de vendas por forma de pagamento.
+// Amount é líquido de troco; Sales conta as vendas que usaram a forma.
+type MethodTotal struct {
+	Method      string
+	Sales       int
+	Amount      money.Money
+	ChangeGiven money.Money
+}
@@ -0,0 +1,140 @@
+package model
+
+import (
+	"testing"
+
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSalePayment_Validate(t *testing.T) {
+	t.Run("valid card payment in installments", func(t *testing.T) {
+		p := &SalePayment{Method: MethodCard, Amount: money.New(100), Installments: 3, AuthorizationCode: "A1B2"}
+
+		assert.NoError(t, p.Validate())
+	})
+
+	t.Run("invalid fields", func(t *testing.T) {
+		cases := []struct {
+			name    string
+			payment SalePayment
+			field   string
+		}{
+			{"blank method", SalePayment{Amount: money.New(1)}, "method"},
+			{"unknown method", SalePayment{Method: "boleto", Amount: money.New(1)}, "method"},
+			{"zero amount", SalePayment{Method: MethodCash}, "amount"},
+			{"negative amount", SalePayment{Method: MethodPix, Amount: money.New(-1)}, "amount"},
+			{"too many installments", SalePayment{Method: MethodCard, Amount: money.New(1), Installments: 13}, "installments"},
+			{"installments outside card", SalePayment{Method: MethodPix, Amount: money.New(1), Installments: 2}, "installments"},
+			{"long authorization code", SalePayment{Method: MethodCard, Amount: money.New(1), AuthorizationCode: string(make([]byte, 101))}, "authorization_code"},
+		}
+
+		for _, c := range cases {
+			err := c.payment.Validate()
+
+			var vErrs validators.ValidationErrors
+			assert.ErrorAs(t, err, &vErrs, c.name)
+			assert.Equal(t, c.field, vErrs[0].Field, c.name)
+		}
+	})
+}
+
+func TestSettle(t *testing.T) {
+	due := money.MustParse("87.30")
+
+	t.Run("cash over the total gives change from the last cash payment", func(t *testing.T) {
+		payments := []*SalePayment{
+			{Method: MethodCash, Amount: money.New(10)},
+			{Method: MethodPix, Amount: money.New(30)},
+			{Method: MethodCash, Amount: money.New(50)},
+		}
+
+		assert.NoError(t, Settle(payments, due))
+		assert.True(t, payments[0].ChangeGiven.IsZero())
+		assert.Equal(t, money.MustParse("2.70"), payments[2].ChangeGiven)
+		assert.Equal(t, due, Paid(payments))
+		assert.Equal(t, 1, payments[1].Installments)
+	})
+
+	t.Run("exact split has no change", func(t *testing.T) {
+		payments := []*SalePayment{
+			{Method: MethodCard, Amount: money.New(50), Installments: 2},
+			{Method: MethodPix, Amount: money.MustParse("37.30")},
+		}
+
+		assert.NoError(t, Settle(payments, due))
+		assert.Equal(t, due, Paid(payments))
+		assert.Equal(t, MethodCard, PrimaryMethod(payments))
+	})
+
+	t.Run("non-cash tenders cannot exceed the total", func(t *testing.T) {
+		payments := []*SalePayment{{Method: MethodCard, Amount: money.New(90)}}
+
+		assert.ErrorIs(t, Settle(payments, due), errMsg.ErrPaymentExceedsDue)
+	})
+
+	t.Run("change that swallows a whole cash payment is refused", func(t *testing.T) {
+		payments := []*SalePayment{
+			{Method: MethodCard, Amount: due},
+			{Method: MethodCash, Amount: money.New(5)},
+		}
+
+		assert.ErrorIs(t, Settle(payments, due), errMsg.ErrPaymentExceedsDue)
+	})
+
+	t.Run("short payments are insufficient", func(t *testing.T) {
+		payments := []*SalePayment{{Method: MethodCash, Amount: money.New(80)}}
+
+		assert.ErrorIs(t, Settle(payments, due), errMsg.ErrPaymentInsufficient)
+	})
+
+	t.Run("credit cannot be combined", func(t *testing.T) {
+		payments := []*SalePayment{
+			{Method: MethodCredit, Amount: money.New(80)},
+			{Method: MethodCash, Amount: money.MustParse("7.30")},
+		}
+
+		assert.ErrorIs(t, Settle(payments, due), errMsg.ErrInvalidData)
+	})
+
+	t.Run("nothing to pay accepts no payments", func(t *testing.T) {
+		assert.NoError(t, Settle(nil, money.Zero))
+	})
+}
+
+func TestAllocateChange_PartialPayment(t *testing.T) {
+	payments := []*SalePayment{{Method: MethodPix, Amount: money.New(20)}}
+
+	assert.NoError(t, AllocateChange(payments, money.New(50)))
+	assert.Equal(t, money.New(20), Paid(payments))
+}
+
+func TestPrimaryMethod(t *testing.T) {
+	payments := []*SalePayment{
+		{Method: MethodPix, Amount: money.New(30)},
+		{Method: MethodCash, Amount: money.New(20)},
+		{Method: MethodCash, Amount: money.New(20), ChangeGiven: money.New(5)},
+	}
+
+	assert.Equal(t, MethodCash, PrimaryMethod(payments))
+	assert.Equal(t, "", PrimaryMethod(nil))
+}
+
+func TestSummary(t *testing.T) {
+	payments := []*SalePayment{
+		{Method: MethodCash, Amount: money.New(50), ChangeGiven: money.New(10)},
+	}
+
+	s := NewSummary(1, money.New(60), payments)
+
+	assert.Equal(t, money.New(40), s.PaidAmount)
+	assert.Equal(t, money.New(10), s.ChangeGiven)
+	assert.Equal(t, money.New(20), s.Balance())
+	assert.False(t, s.IsPaid())
+
+	s = NewSummary(1, money.New(40), payments)
+	assert.True(t, s.Balance().IsZero())
+	assert.True(t, s.IsPaid())
+}
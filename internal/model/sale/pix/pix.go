@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+// Ciclo de vida da cobrança Pix: pending → confirmed, quando o PSP avisa o
+// pagamento.
+const (
+	StatusPending   = "pending"
+	StatusConfirmed = "confirmed"
+)
+
+// PixCharge é a cobrança Pix de uma venda. Payload é o BR Code "copia e
+// cola"; Location só existe nas cobranças dinâmicas registradas no PSP.
+type PixCharge struct {
+	ID          int64
+	SaleID      int64
+	TxID        string
+	Amount      money.Money
+	Status      string
+	Payload     string
+	Location    string
+	EndToEndID  string
+	PaidAmount  *money.Money
+	CreatedBy   *int64
+	ConfirmedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (c *PixCharge) Validate() error {
+	var errs validators.ValidationErrors
+
+	if c.SaleID <= 0 {
+		errs = append(errs, validators.ValidationError{Field: "sale_id", Message: validators.MsgRequiredField})
+	}
+
+	if !c.Amount.IsPositive() {
+		errs = append(errs, validators.ValidationError{Field: "amount", Message: "must be greater than 0"})
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+func (c *PixCharge) IsConfirmed() bool {
+	return c.Status == StatusConfirmed
+}
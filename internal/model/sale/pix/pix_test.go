@@ -0,0 +1,21 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPixCharge_Validate(t *testing.T) {
+	assert.NoError(t, (&PixCharge{SaleID: 1, Amount: money.New(10)}).Validate())
+
+	assert.Error(t, (&PixCharge{Amount: money.New(10)}).Validate())
+	assert.Error(t, (&PixCharge{SaleID: 1}).Validate())
+	assert.Error(t, (&PixCharge{SaleID: 1, Amount: money.New(-1)}).Validate())
+}
+
+func TestPixCharge_IsConfirmed(t *testing.T) {
+	assert.True(t, (&PixCharge{Status: StatusConfirmed}).IsConfirmed())
+	assert.False(t, (&PixCharge{Status: StatusPending}).IsConfirmed())
+}
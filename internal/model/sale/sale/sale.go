@@ -3,18 +3,22 @@ package model
 import (
 	"time"
 
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
 )
 
 type Sale struct {
 	ID                 int64
 	ClientID           *int64
+	ClientCnpjID       *int64
 	UserID             *int64
+	LocationID         int64
+	CashSessionID      *int64
 	SaleDate           time.Time
-	TotalItemsAmount   float64
-	TotalItemsDiscount float64
-	TotalSaleDiscount  float64
-	TotalAmount        float64
+	TotalItemsAmount   money.Money
+	TotalItemsDiscount money.Money
+	TotalSaleDiscount  money.Money
+	TotalAmount        money.Money
 	PaymentType        string
 	Status             string
 	Notes              string
@@ -26,16 +30,16 @@ type Sale struct {
 func (s *Sale) ValidateStructural() error {
 	var errs validators.ValidationErrors
 
-	if s.TotalItemsAmount < 0 {
+	if s.TotalItemsAmount.IsNegative() {
 		errs = append(errs, validators.ValidationError{Field: "total_items_amount", Message: "must be >= 0"})
 	}
-	if s.TotalItemsDiscount < 0 {
+	if s.TotalItemsDiscount.IsNegative() {
 		errs = append(errs, validators.ValidationError{Field: "total_items_discount", Message: "must be >= 0"})
 	}
-	if s.TotalSaleDiscount < 0 {
+	if s.TotalSaleDiscount.IsNegative() {
 		errs = append(errs, validators.ValidationError{Field: "total_sale_discount", Message: "must be >= 0"})
 	}
-	if s.TotalAmount < 0 {
+	if s.TotalAmount.IsNegative() {
 		errs = append(errs, validators.ValidationError{Field: "total_amount", Message: "must be >= 0"})
 	}
 
@@ -63,6 +67,10 @@ func (s *Sale) ValidateStructural() error {
 		}
 	}
 
+	if s.ClientID != nil && s.ClientCnpjID != nil {
+		errs = append(errs, validators.ValidationError{Field: "client_cnpj_id", Message: "a sale references either client_id or client_cnpj_id"})
+	}
+
 	if len(s.Notes) > 500 {
 		errs = append(errs, validators.ValidationError{Field: "notes", Message: "max 500 characters"})
 	}
@@ -82,9 +90,9 @@ func (s *Sale) ValidateStructural() error {
 func (s *Sale) ValidateBusinessRules() error {
 	var errs validators.ValidationErrors
 
-	totalDiscount := s.TotalItemsDiscount + s.TotalSaleDiscount
+	totalDiscount := s.TotalItemsDiscount.Add(s.TotalSaleDiscount)
 
-	if totalDiscount > s.TotalAmount {
+	if totalDiscount.GreaterThan(s.TotalAmount) {
 		errs = append(errs, validators.ValidationError{
 			Field:   "total_amount",
 			Message: "sum of discounts cannot exceed total amount",
@@ -98,8 +106,19 @@ func (s *Sale) ValidateBusinessRules() error {
 		})
 	}
 
+	if s.PaymentType == "credit" && !hasClient(s.ClientID) && !hasClient(s.ClientCnpjID) {
+		errs = append(errs, validators.ValidationError{
+			Field:   "client_id",
+			Message: "credit sales require a client",
+		})
+	}
+
 	if errs.HasErrors() {
 		return errs
 	}
 	return nil
 }
+
+func hasClient(id *int64) bool {
+	return id != nil && *id > 0
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -12,10 +13,10 @@ func TestSale_ValidateStructural(t *testing.T) {
 		userID := int64(1)
 		s := &Sale{
 			UserID:             &userID,
-			TotalItemsAmount:   100.0,
-			TotalItemsDiscount: 10.0,
-			TotalSaleDiscount:  5.0,
-			TotalAmount:        95.0,
+			TotalItemsAmount:   money.New(100),
+			TotalItemsDiscount: money.New(10),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(95),
 			PaymentType:        "cash",
 			Status:             "active",
 			Notes:              "Venda válida",
@@ -33,7 +34,7 @@ func TestSale_ValidateStructural(t *testing.T) {
 		userID := int64(1)
 		s := &Sale{
 			UserID:           &userID,
-			TotalItemsAmount: -50.0,
+			TotalItemsAmount: money.New(-50),
 			PaymentType:      "cash",
 			Status:           "active",
 			Version:          1,
@@ -47,8 +48,8 @@ func TestSale_ValidateStructural(t *testing.T) {
 		userID := int64(1)
 		s := &Sale{
 			UserID:             &userID,
-			TotalItemsAmount:   100,
-			TotalItemsDiscount: -5,
+			TotalItemsAmount:   money.New(100),
+			TotalItemsDiscount: money.New(-5),
 			PaymentType:        "cash",
 			Status:             "active",
 			Version:            1,
@@ -62,8 +63,8 @@ func TestSale_ValidateStructural(t *testing.T) {
 		userID := int64(1)
 		s := &Sale{
 			UserID:            &userID,
-			TotalItemsAmount:  100,
-			TotalSaleDiscount: -5,
+			TotalItemsAmount:  money.New(100),
+			TotalSaleDiscount: money.New(-5),
 			PaymentType:       "cash",
 			Status:            "active",
 			Version:           1,
@@ -77,7 +78,7 @@ func TestSale_ValidateStructural(t *testing.T) {
 		userID := int64(1)
 		s := &Sale{
 			UserID:      &userID,
-			TotalAmount: -10,
+			TotalAmount: money.New(-10),
 			PaymentType: "cash",
 			Status:      "active",
 			Version:     1,
@@ -91,7 +92,7 @@ func TestSale_ValidateStructural(t *testing.T) {
 		userID := int64(1)
 		s := &Sale{
 			UserID:      &userID,
-			TotalAmount: 100,
+			TotalAmount: money.New(100),
 			Status:      "active",
 			Version:     1,
 		}
@@ -104,7 +105,7 @@ func TestSale_ValidateStructural(t *testing.T) {
 		userID := int64(1)
 		s := &Sale{
 			UserID:      &userID,
-			TotalAmount: 100,
+			TotalAmount: money.New(100),
 			PaymentType: string(make([]byte, 51)),
 			Status:      "active",
 			Version:     1,
@@ -118,7 +119,7 @@ func TestSale_ValidateStructural(t *testing.T) {
 		userID := int64(1)
 		s := &Sale{
 			UserID:      &userID,
-			TotalAmount: 100,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Version:     1,
 		}
@@ -131,7 +132,7 @@ func TestSale_ValidateStructural(t *testing.T) {
 		userID := int64(1)
 		s := &Sale{
 			UserID:      &userID,
-			TotalAmount: 100,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Status:      string(make([]byte, 51)),
 			Version:     1,
@@ -145,7 +146,7 @@ func TestSale_ValidateStructural(t *testing.T) {
 		userID := int64(1)
 		s := &Sale{
 			UserID:      &userID,
-			TotalAmount: 100,
+			TotalAmount: money.New(100),
 			PaymentType: "cash",
 			Status:      "active",
 			Notes:       string(make([]byte, 501)),
@@ -160,10 +161,10 @@ func TestSale_ValidateStructural(t *testing.T) {
 		userID := int64(1)
 		s := &Sale{
 			UserID:             &userID,
-			TotalItemsAmount:   100,
-			TotalItemsDiscount: 10,
-			TotalSaleDiscount:  5,
-			TotalAmount:        95,
+			TotalItemsAmount:   money.New(100),
+			TotalItemsDiscount: money.New(10),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(95),
 			PaymentType:        "cash",
 			Status:             "active",
 			Version:            0, // inválido
@@ -180,10 +181,10 @@ func TestSale_ValidateBusinessRules(t *testing.T) {
 		userID := int64(1)
 		s := &Sale{
 			UserID:             &userID,
-			TotalItemsAmount:   200.0,
-			TotalItemsDiscount: 30.0,
-			TotalSaleDiscount:  20.0,
-			TotalAmount:        150.0,
+			TotalItemsAmount:   money.New(200),
+			TotalItemsDiscount: money.New(30),
+			TotalSaleDiscount:  money.New(20),
+			TotalAmount:        money.New(150),
 			SaleDate:           time.Now(),
 			Version:            1,
 		}
@@ -195,10 +196,10 @@ func TestSale_ValidateBusinessRules(t *testing.T) {
 		userID := int64(1)
 		s := &Sale{
 			UserID:             &userID,
-			TotalItemsAmount:   100,
-			TotalItemsDiscount: 60,
-			TotalSaleDiscount:  50,
-			TotalAmount:        100,
+			TotalItemsAmount:   money.New(100),
+			TotalItemsDiscount: money.New(60),
+			TotalSaleDiscount:  money.New(50),
+			TotalAmount:        money.New(100),
 			SaleDate:           time.Now(),
 			Version:            1,
 		}
@@ -211,10 +212,10 @@ func TestSale_ValidateBusinessRules(t *testing.T) {
 		userID := int64(1)
 		s := &Sale{
 			UserID:             &userID,
-			TotalItemsAmount:   100,
-			TotalItemsDiscount: 0,
-			TotalSaleDiscount:  0,
-			TotalAmount:        100,
+			TotalItemsAmount:   money.New(100),
+			TotalItemsDiscount: money.New(0),
+			TotalSaleDiscount:  money.New(0),
+			TotalAmount:        money.New(100),
 			SaleDate:           time.Time{},
 			Version:            1,
 		}
@@ -222,4 +223,54 @@ func TestSale_ValidateBusinessRules(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "sale_date")
 	})
+
+	t.Run("venda a crédito sem cliente", func(t *testing.T) {
+		s := &Sale{
+			TotalAmount: money.New(100),
+			PaymentType: "credit",
+			SaleDate:    time.Now(),
+			Version:     1,
+		}
+		err := s.ValidateBusinessRules()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "credit sales require a client")
+	})
+
+	t.Run("venda a crédito com cliente", func(t *testing.T) {
+		clientID := int64(3)
+		s := &Sale{
+			ClientID:    &clientID,
+			TotalAmount: money.New(100),
+			PaymentType: "credit",
+			SaleDate:    time.Now(),
+			Version:     1,
+		}
+		assert.NoError(t, s.ValidateBusinessRules())
+	})
+
+	t.Run("venda a crédito com cliente CNPJ", func(t *testing.T) {
+		clientID := int64(3)
+		s := &Sale{
+			ClientCnpjID: &clientID,
+			TotalAmount:  money.New(100),
+			PaymentType:  "credit",
+			SaleDate:     time.Now(),
+			Version:      1,
+		}
+		assert.NoError(t, s.ValidateBusinessRules())
+	})
+}
+
+func TestSale_ValidateStructural_ClientKind(t *testing.T) {
+	clientID := int64(3)
+	s := &Sale{
+		ClientID:     &clientID,
+		ClientCnpjID: &clientID,
+		PaymentType:  "cash",
+		Status:       "active",
+		Version:      1,
+	}
+	err := s.ValidateStructural()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "client_cnpj_id")
 }
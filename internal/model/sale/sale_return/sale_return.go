@@ -0,0 +1,68 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+// SaleReturnItem representa a quantidade devolvida de uma linha da venda.
+type SaleReturnItem struct {
+	ID           int64
+	SaleReturnID int64
+	SaleItemID   int64
+	ProductID    int64
+	Quantity     int
+	Amount       money.Money
+	CreatedAt    time.Time
+}
+
+// SaleReturn registra uma devolução (parcial ou total) de uma venda concluída.
+type SaleReturn struct {
+	ID          int64
+	SaleID      int64
+	UserID      *int64
+	Reason      string
+	TotalAmount money.Money
+	Items       []SaleReturnItem
+	CreatedAt   time.Time
+}
+
+func (r *SaleReturn) ValidateStructural() error {
+	var errs validators.ValidationErrors
+
+	if r.SaleID <= 0 {
+		errs = append(errs, validators.ValidationError{Field: "sale_id", Message: validators.MsgRequiredField})
+	}
+
+	if len(r.Reason) > 500 {
+		errs = append(errs, validators.ValidationError{Field: "reason", Message: "max 500 characters"})
+	}
+
+	if len(r.Items) == 0 {
+		errs = append(errs, validators.ValidationError{Field: "items", Message: "at least one item is required"})
+	}
+
+	seen := make(map[int64]bool, len(r.Items))
+	for i, item := range r.Items {
+		field := fmt.Sprintf("items[%d]", i)
+
+		if item.SaleItemID <= 0 {
+			errs = append(errs, validators.ValidationError{Field: field + ".sale_item_id", Message: validators.MsgRequiredField})
+		} else if seen[item.SaleItemID] {
+			errs = append(errs, validators.ValidationError{Field: field + ".sale_item_id", Message: "duplicated item"})
+		}
+		seen[item.SaleItemID] = true
+
+		if item.Quantity <= 0 {
+			errs = append(errs, validators.ValidationError{Field: field + ".quantity", Message: "must be greater than 0"})
+		}
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaleReturn_ValidateStructural(t *testing.T) {
+	t.Run("valid return", func(t *testing.T) {
+		r := &SaleReturn{
+			SaleID: 1,
+			Reason: "produto com defeito",
+			Items:  []SaleReturnItem{{SaleItemID: 1, Quantity: 1}, {SaleItemID: 2, Quantity: 3}},
+		}
+
+		assert.NoError(t, r.ValidateStructural())
+	})
+
+	t.Run("missing sale and items", func(t *testing.T) {
+		r := &SaleReturn{}
+
+		err := r.ValidateStructural()
+
+		var vErrs validators.ValidationErrors
+		assert.ErrorAs(t, err, &vErrs)
+		assert.Len(t, vErrs, 2)
+	})
+
+	t.Run("invalid lines", func(t *testing.T) {
+		r := &SaleReturn{
+			SaleID: 1,
+			Items: []SaleReturnItem{
+				{SaleItemID: 0, Quantity: 1},
+				{SaleItemID: 2, Quantity: 0},
+				{SaleItemID: 2, Quantity: 1},
+			},
+		}
+
+		err := r.ValidateStructural()
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "items[0].sale_item_id")
+		assert.Contains(t, err.Error(), "items[1].quantity")
+		assert.Contains(t, err.Error(), "items[2].sale_item_id")
+	})
+
+	t.Run("reason too long", func(t *testing.T) {
+		r := &SaleReturn{
+			SaleID: 1,
+			Reason: strings.Repeat("a", 501),
+			Items:  []SaleReturnItem{{SaleItemID: 1, Quantity: 1}},
+		}
+
+		err := r.ValidateStructural()
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "reason")
+	})
+}
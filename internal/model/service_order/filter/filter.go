@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+type ServiceOrderFilter struct {
+	filter.BaseFilter
+	ClientID     *int64
+	ClientCnpjID *int64
+	TechnicianID *int64
+	ProductID    *int64
+	CategoryID   *int64
+	Status       string
+	CreatedFrom  *time.Time
+	CreatedTo    *time.Time
+}
+
+func (f *ServiceOrderFilter) Validate() error {
+	if err := f.BaseFilter.Validate(); err != nil {
+		return err
+	}
+
+	if f.Status != "" && !models.IsValidStatus(f.Status) {
+		return &validators.ValidationError{
+			Field:   "Status",
+			Message: "status inválido. Valores permitidos: open, in_progress, waiting_parts, done, delivered, canceled",
+		}
+	}
+
+	if f.CreatedFrom != nil && f.CreatedTo != nil && f.CreatedFrom.After(*f.CreatedTo) {
+		return &validators.ValidationError{
+			Field:   "CreatedFrom/CreatedTo",
+			Message: "intervalo de criação inválido",
+		}
+	}
+
+	return nil
+}
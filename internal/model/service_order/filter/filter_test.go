@@ -0,0 +1,39 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceOrderFilter_Validate(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Hour)
+
+	t.Run("valid filter", func(t *testing.T) {
+		f := &ServiceOrderFilter{
+			BaseFilter:  filter.BaseFilter{Limit: 10},
+			Status:      "waiting_parts",
+			CreatedFrom: &before,
+			CreatedTo:   &now,
+		}
+		assert.NoError(t, f.Validate())
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		f := &ServiceOrderFilter{BaseFilter: filter.BaseFilter{Limit: 10}, Status: "draft"}
+		assert.ErrorContains(t, f.Validate(), "status inválido")
+	})
+
+	t.Run("invalid created range", func(t *testing.T) {
+		f := &ServiceOrderFilter{BaseFilter: filter.BaseFilter{Limit: 10}, CreatedFrom: &now, CreatedTo: &before}
+		assert.ErrorContains(t, f.Validate(), "intervalo de criação")
+	})
+
+	t.Run("invalid base filter", func(t *testing.T) {
+		f := &ServiceOrderFilter{BaseFilter: filter.BaseFilter{Limit: -1}}
+		assert.Error(t, f.Validate())
+	})
+}
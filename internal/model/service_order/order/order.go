@@ -0,0 +1,231 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+// Ciclo de vida da ordem: open → in_progress ⇄ waiting_parts → done → delivered.
+// O cancelamento só é permitido enquanto o serviço não foi concluído.
+const (
+	StatusOpen         = "open"
+	StatusInProgress   = "in_progress"
+	StatusWaitingParts = "waiting_parts"
+	StatusDone         = "done"
+	StatusDelivered    = "delivered"
+	StatusCanceled     = "canceled"
+)
+
+var transitions = map[string][]string{
+	StatusOpen:         {StatusInProgress, StatusCanceled},
+	StatusInProgress:   {StatusWaitingParts, StatusDone, StatusCanceled},
+	StatusWaitingParts: {StatusInProgress, StatusCanceled},
+	StatusDone:         {StatusDelivered},
+}
+
+// IsValidStatus indica se status pertence ao ciclo de vida da ordem.
+func IsValidStatus(status string) bool {
+	switch status {
+	case StatusOpen, StatusInProgress, StatusWaitingParts, StatusDone, StatusDelivered, StatusCanceled:
+		return true
+	}
+	return false
+}
+
+// ServiceOrderPart é uma peça consumida no serviço. Ao ser lançada ela já
+// baixa o estoque do produto. UnitPrice nil usa o preço de venda do produto.
+type ServiceOrderPart struct {
+	ID             int64
+	ServiceOrderID int64
+	ProductID      int64
+	Quantity       int
+	UnitPrice      *money.Money
+	CreatedAt      time.Time
+}
+
+func (p *ServiceOrderPart) Validate() error {
+	var errs validators.ValidationErrors
+
+	if p.ProductID <= 0 {
+		errs = append(errs, validators.ValidationError{Field: "product_id", Message: validators.MsgRequiredField})
+	}
+	if p.Quantity <= 0 {
+		errs = append(errs, validators.ValidationError{Field: "quantity", Message: "must be greater than 0"})
+	}
+	if p.UnitPrice != nil && p.UnitPrice.IsNegative() {
+		errs = append(errs, validators.ValidationError{Field: "unit_price", Message: "must be >= 0"})
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+func (p *ServiceOrderPart) Subtotal() money.Money {
+	if p.UnitPrice == nil {
+		return money.Zero
+	}
+	return p.UnitPrice.Mul(int64(p.Quantity))
+}
+
+// ServiceOrderLabor é uma linha de mão de obra cobrada na ordem.
+type ServiceOrderLabor struct {
+	ID             int64
+	ServiceOrderID int64
+	Description    string
+	Amount         money.Money
+	CreatedAt      time.Time
+}
+
+func (l *ServiceOrderLabor) Validate() error {
+	var errs validators.ValidationErrors
+
+	if validators.IsBlank(l.Description) {
+		errs = append(errs, validators.ValidationError{Field: "description", Message: validators.MsgRequiredField})
+	} else if len(l.Description) > 255 {
+		errs = append(errs, validators.ValidationError{Field: "description", Message: "max 255 characters"})
+	}
+	if l.Amount.IsNegative() {
+		errs = append(errs, validators.ValidationError{Field: "amount", Message: "must be >= 0"})
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+type ServiceOrder struct {
+	ID                 int64
+	ClientID           *int64
+	ClientCnpjID       *int64
+	TechnicianID       *int64
+	UserID             *int64
+	Status             string
+	ProblemDescription string
+	Notes              string
+	PartsAmount        money.Money
+	LaborAmount        money.Money
+	TotalAmount        money.Money
+	SaleID             *int64
+	CategoryIDs        []int64
+	Parts              []ServiceOrderPart
+	Labor              []ServiceOrderLabor
+	Version            int
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+func (o *ServiceOrder) ValidateStructural() error {
+	var errs validators.ValidationErrors
+
+	hasCpf := o.ClientID != nil && *o.ClientID > 0
+	hasCnpj := o.ClientCnpjID != nil && *o.ClientCnpjID > 0
+	if hasCpf == hasCnpj {
+		errs = append(errs, validators.ValidationError{
+			Field:   "client_id/client_cnpj_id",
+			Message: "exactly one client is required",
+		})
+	}
+
+	if o.TechnicianID != nil && *o.TechnicianID <= 0 {
+		errs = append(errs, validators.ValidationError{Field: "technician_id", Message: "must be greater than 0"})
+	}
+
+	if validators.IsBlank(o.ProblemDescription) {
+		errs = append(errs, validators.ValidationError{Field: "problem_description", Message: validators.MsgRequiredField})
+	} else if len(o.ProblemDescription) > 1000 {
+		errs = append(errs, validators.ValidationError{Field: "problem_description", Message: "max 1000 characters"})
+	}
+
+	if len(o.Notes) > 500 {
+		errs = append(errs, validators.ValidationError{Field: "notes", Message: "max 500 characters"})
+	}
+
+	seen := make(map[int64]bool, len(o.CategoryIDs))
+	for i, id := range o.CategoryIDs {
+		field := fmt.Sprintf("category_ids[%d]", i)
+		if id <= 0 {
+			errs = append(errs, validators.ValidationError{Field: field, Message: "must be greater than 0"})
+		} else if seen[id] {
+			errs = append(errs, validators.ValidationError{Field: field, Message: "duplicated category"})
+		}
+		seen[id] = true
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// CanTransitionTo indica se a ordem pode passar do status atual para status.
+func (o *ServiceOrder) CanTransitionTo(status string) bool {
+	for _, next := range transitions[o.Status] {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
+
+// Editable indica se cabeçalho, peças e mão de obra ainda podem ser alterados.
+func (o *ServiceOrder) Editable() bool {
+	switch o.Status {
+	case StatusOpen, StatusInProgress, StatusWaitingParts:
+		return true
+	}
+	return false
+}
+
+// Convertible indica se a ordem pode ser faturada: serviço concluído e
+// ainda sem venda gerada.
+func (o *ServiceOrder) Convertible() bool {
+	return (o.Status == StatusDone || o.Status == StatusDelivered) && o.SaleID == nil
+}
+
+// FindPart retorna a peça da ordem com o id informado.
+func (o *ServiceOrder) FindPart(id int64) (*ServiceOrderPart, bool) {
+	for i := range o.Parts {
+		if o.Parts[i].ID == id {
+			return &o.Parts[i], true
+		}
+	}
+	return nil, false
+}
+
+// FindLabor retorna a linha de mão de obra da ordem com o id informado.
+func (o *ServiceOrder) FindLabor(id int64) (*ServiceOrderLabor, bool) {
+	for i := range o.Labor {
+		if o.Labor[i].ID == id {
+			return &o.Labor[i], true
+		}
+	}
+	return nil, false
+}
+
+// CalculateTotals soma peças e mão de obra em PartsAmount, LaborAmount e TotalAmount.
+func (o *ServiceOrder) CalculateTotals() {
+	var parts, labor money.Money
+	for i := range o.Parts {
+		parts = parts.Add(o.Parts[i].Subtotal())
+	}
+	for i := range o.Labor {
+		labor = labor.Add(o.Labor[i].Amount)
+	}
+	o.PartsAmount = parts
+	o.LaborAmount = labor
+	o.TotalAmount = parts.Add(labor)
+}
+
+// SaleConversion são os dados informados ao faturar a ordem como venda.
+type SaleConversion struct {
+	ServiceOrderID int64
+	UserID         *int64
+	PaymentType    string
+	Notes          string
+}
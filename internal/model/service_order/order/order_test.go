@@ -0,0 +1,147 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func ptrInt64(v int64) *int64 { return &v }
+func ptrMoney(v string) *money.Money {
+	m := money.MustParse(v)
+	return &m
+}
+
+func TestServiceOrder_ValidateStructural(t *testing.T) {
+	t.Run("valid order", func(t *testing.T) {
+		o := &ServiceOrder{ClientID: ptrInt64(1), ProblemDescription: "não liga", CategoryIDs: []int64{1, 2}}
+
+		assert.NoError(t, o.ValidateStructural())
+	})
+
+	t.Run("missing client and problem", func(t *testing.T) {
+		err := (&ServiceOrder{}).ValidateStructural()
+
+		var vErrs validators.ValidationErrors
+		assert.ErrorAs(t, err, &vErrs)
+		assert.Len(t, vErrs, 2)
+	})
+
+	t.Run("both clients", func(t *testing.T) {
+		o := &ServiceOrder{ClientID: ptrInt64(1), ClientCnpjID: ptrInt64(2), ProblemDescription: "x"}
+
+		assert.ErrorContains(t, o.ValidateStructural(), "exactly one client")
+	})
+
+	t.Run("invalid fields", func(t *testing.T) {
+		o := &ServiceOrder{
+			ClientCnpjID:       ptrInt64(2),
+			TechnicianID:       ptrInt64(0),
+			ProblemDescription: strings.Repeat("a", 1001),
+			Notes:              strings.Repeat("a", 501),
+			CategoryIDs:        []int64{0, 3, 3},
+		}
+
+		err := o.ValidateStructural()
+
+		var vErrs validators.ValidationErrors
+		assert.ErrorAs(t, err, &vErrs)
+		assert.Len(t, vErrs, 5)
+	})
+}
+
+func TestServiceOrderPart_Validate(t *testing.T) {
+	assert.NoError(t, (&ServiceOrderPart{ProductID: 1, Quantity: 1}).Validate())
+
+	err := (&ServiceOrderPart{Quantity: 0, UnitPrice: ptrMoney("-1")}).Validate()
+
+	var vErrs validators.ValidationErrors
+	assert.ErrorAs(t, err, &vErrs)
+	assert.Len(t, vErrs, 3)
+}
+
+func TestServiceOrderLabor_Validate(t *testing.T) {
+	assert.NoError(t, (&ServiceOrderLabor{Description: "troca de tela", Amount: money.New(80)}).Validate())
+
+	err := (&ServiceOrderLabor{Description: " ", Amount: money.New(-1)}).Validate()
+
+	var vErrs validators.ValidationErrors
+	assert.ErrorAs(t, err, &vErrs)
+	assert.Len(t, vErrs, 2)
+
+	assert.Error(t, (&ServiceOrderLabor{Description: strings.Repeat("a", 256)}).Validate())
+}
+
+func TestServiceOrder_CalculateTotals(t *testing.T) {
+	o := &ServiceOrder{
+		Parts: []ServiceOrderPart{{Quantity: 3, UnitPrice: ptrMoney("1.10")}, {Quantity: 2, UnitPrice: ptrMoney("10")}, {Quantity: 1}},
+		Labor: []ServiceOrderLabor{{Amount: money.New(50)}, {Amount: money.MustParse("25.50")}},
+	}
+
+	o.CalculateTotals()
+
+	assert.Equal(t, money.MustParse("23.30"), o.PartsAmount)
+	assert.Equal(t, money.MustParse("75.50"), o.LaborAmount)
+	assert.Equal(t, money.MustParse("98.80"), o.TotalAmount)
+}
+
+func TestServiceOrder_StatusRules(t *testing.T) {
+	cases := []struct {
+		from, to string
+		allowed  bool
+	}{
+		{StatusOpen, StatusInProgress, true},
+		{StatusOpen, StatusDone, false},
+		{StatusInProgress, StatusWaitingParts, true},
+		{StatusWaitingParts, StatusInProgress, true},
+		{StatusWaitingParts, StatusDone, false},
+		{StatusInProgress, StatusDone, true},
+		{StatusDone, StatusDelivered, true},
+		{StatusDone, StatusCanceled, false},
+		{StatusDelivered, StatusOpen, false},
+		{StatusCanceled, StatusOpen, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.from+"->"+c.to, func(t *testing.T) {
+			o := &ServiceOrder{Status: c.from}
+			assert.Equal(t, c.allowed, o.CanTransitionTo(c.to))
+		})
+	}
+
+	t.Run("editable and convertible", func(t *testing.T) {
+		assert.True(t, (&ServiceOrder{Status: StatusWaitingParts}).Editable())
+		assert.False(t, (&ServiceOrder{Status: StatusDone}).Editable())
+		assert.True(t, (&ServiceOrder{Status: StatusDone}).Convertible())
+		assert.True(t, (&ServiceOrder{Status: StatusDelivered}).Convertible())
+		assert.False(t, (&ServiceOrder{Status: StatusDone, SaleID: ptrInt64(1)}).Convertible())
+		assert.False(t, (&ServiceOrder{Status: StatusInProgress}).Convertible())
+	})
+
+	t.Run("valid status", func(t *testing.T) {
+		assert.True(t, IsValidStatus(StatusWaitingParts))
+		assert.False(t, IsValidStatus("draft"))
+	})
+}
+
+func TestServiceOrder_Find(t *testing.T) {
+	o := &ServiceOrder{
+		Parts: []ServiceOrderPart{{ID: 1}, {ID: 2}},
+		Labor: []ServiceOrderLabor{{ID: 5}},
+	}
+
+	part, ok := o.FindPart(2)
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), part.ID)
+	_, ok = o.FindPart(3)
+	assert.False(t, ok)
+
+	labor, ok := o.FindLabor(5)
+	assert.True(t, ok)
+	assert.Equal(t, int64(5), labor.ID)
+	_, ok = o.FindLabor(1)
+	assert.False(t, ok)
+}
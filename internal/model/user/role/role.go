@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	validators "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+// Role é um papel de acesso (admin, manager, cashier, stock_clerk...) com os
+// códigos de permissão que concede.
+type Role struct {
+	ID          int64
+	Name        string
+	Description string
+	Permissions []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// UserRole vincula um papel a um usuário.
+type UserRole struct {
+	UserID    int64
+	RoleID    int64
+	CreatedAt time.Time
+}
+
+// Access é o que o token de um usuário carrega: nomes dos papéis e a união
+// das permissões concedidas por eles.
+type Access struct {
+	Roles       []string
+	Permissions []string
+}
+
+func (ur *UserRole) Validate() error {
+	var validationErrors []validators.ValidationError
+
+	if ur.UserID <= 0 {
+		validationErrors = append(validationErrors, validators.ValidationError{
+			Field:   "user_id",
+			Message: "ID do usuário é obrigatório",
+		})
+	}
+
+	if ur.RoleID <= 0 {
+		validationErrors = append(validationErrors, validators.ValidationError{
+			Field:   "role_id",
+			Message: "ID do papel é obrigatório",
+		})
+	}
+
+	return validators.NewValidationErrors(validationErrors)
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserRole_Validate(t *testing.T) {
+	t.Run("válido", func(t *testing.T) {
+		ur := &UserRole{UserID: 1, RoleID: 2}
+		assert.NoError(t, ur.Validate())
+	})
+
+	t.Run("IDs obrigatórios", func(t *testing.T) {
+		ur := &UserRole{}
+		err := ur.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "user_id")
+		assert.Contains(t, err.Error(), "role_id")
+	})
+}
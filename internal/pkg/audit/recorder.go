@@ -0,0 +1,164 @@
+// Package audit registra quem alterou o quê. O Recorder é chamado pelos
+// decoradores de repositório (ver repo/*/audit.go) após cada escrita bem
+// sucedida e guarda apenas os campos que mudaram. Escritas feitas em
+// transação são registradas com RecordTx na mesma transação.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/audit"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/jackc/pgx/v5"
+)
+
+// redacted substitui valores sensíveis; a troca fica registrada, o conteúdo não.
+const redacted = "[omitido]"
+
+var sensitiveFields = map[string]bool{
+	"password": true,
+}
+
+// Entry descreve uma escrita. Before é nil na criação e After na exclusão.
+type Entry struct {
+	EntityType string
+	EntityID   int64
+	Action     string
+	Before     any
+	After      any
+}
+
+type Recorder interface {
+	Record(ctx context.Context, entry Entry)
+	RecordTx(ctx context.Context, tx pgx.Tx, entry Entry) error
+}
+
+type recorder struct {
+	repo iface.AuditWriter
+	log  logger.Logger
+}
+
+func NewRecorder(repo iface.AuditWriter, log logger.Logger) Recorder {
+	return &recorder{repo: repo, log: log}
+}
+
+// Record nunca devolve erro: a escrita auditada já foi confirmada e falhar a
+// requisição levaria o cliente a repeti-la. Falhas ficam no log.
+func (r *recorder) Record(ctx context.Context, entry Entry) {
+	const ref = "[AuditRecorder - Record] "
+
+	log, err := newLog(ctx, entry)
+	if err == nil {
+		_, err = r.repo.Create(ctx, log)
+	}
+	if err != nil {
+		r.log.Error(ctx, err, ref+logger.LogAuditRecordError, map[string]any{
+			"entity_type": entry.EntityType,
+			"entity_id":   entry.EntityID,
+			"action":      entry.Action,
+		})
+	}
+}
+
+// RecordTx grava o registro na transação da escrita. Ao contrário de Record,
+// devolve o erro: quem chamou desfaz a transação, e a escrita não fica sem
+// registro.
+func (r *recorder) RecordTx(ctx context.Context, tx pgx.Tx, entry Entry) error {
+	log, err := newLog(ctx, entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.repo.CreateTx(ctx, tx, log)
+	return err
+}
+
+func newLog(ctx context.Context, entry Entry) (*models.AuditLog, error) {
+	changes, err := Diff(entry.Before, entry.After)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AuditLog{
+		ActorUserID: actorID(ctx),
+		RequestID:   contextUtils.GetRequestID(ctx),
+		EntityType:  entry.EntityType,
+		EntityID:    entry.EntityID,
+		Action:      entry.Action,
+		Changes:     changes,
+	}, nil
+}
+
+func actorID(ctx context.Context) *int64 {
+	id, err := strconv.ParseInt(contextUtils.GetUserID(ctx), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
+
+// Diff compara os dois estados pela forma JSON e devolve só os campos que
+// mudaram. Campos sensíveis aparecem mascarados.
+func Diff(before, after any) (map[string]models.FieldChange, error) {
+	from, err := toMap(before)
+	if err != nil {
+		return nil, err
+	}
+	to, err := toMap(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]models.FieldChange)
+	for field, value := range from {
+		if next, ok := to[field]; !ok || !reflect.DeepEqual(value, next) {
+			changes[field] = models.FieldChange{From: value, To: next}
+		}
+	}
+	for field, value := range to {
+		if _, ok := from[field]; !ok {
+			changes[field] = models.FieldChange{To: value}
+		}
+	}
+
+	for field, change := range changes {
+		if sensitiveFields[strings.ToLower(field)] {
+			changes[field] = models.FieldChange{From: mask(change.From), To: mask(change.To)}
+		}
+	}
+
+	return changes, nil
+}
+
+func mask(value any) any {
+	if value == nil || value == "" {
+		return value
+	}
+	return redacted
+}
+
+func toMap(value any) (map[string]any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
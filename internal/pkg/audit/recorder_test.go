@@ -0,0 +1,183 @@
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	mockAudit "github.com/WagaoCarvalho/backend_store_go/infra/mock/audit"
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type product struct {
+	ID       int64
+	Name     string
+	Stock    int
+	Password string
+}
+
+func newTestLogger() (*logger.LogAdapter, *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	base := logrus.New()
+	base.Out = buf
+	return logger.NewLoggerAdapter(base), buf
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("update keeps only changed fields", func(t *testing.T) {
+		changes, err := audit.Diff(
+			&product{ID: 1, Name: "Caneta", Stock: 5},
+			&product{ID: 1, Name: "Caneta", Stock: 4},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]models.FieldChange{"Stock": {From: float64(5), To: float64(4)}}, changes)
+	})
+
+	t.Run("create lists every field as new", func(t *testing.T) {
+		changes, err := audit.Diff(nil, &product{ID: 1, Name: "Caneta"})
+
+		require.NoError(t, err)
+		assert.Len(t, changes, 4)
+		assert.Nil(t, changes["Name"].From)
+		assert.Equal(t, "Caneta", changes["Name"].To)
+	})
+
+	t.Run("delete lists every field as removed", func(t *testing.T) {
+		var missing *product
+		changes, err := audit.Diff(&product{ID: 1, Name: "Caneta"}, missing)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Caneta", changes["Name"].From)
+		assert.Nil(t, changes["Name"].To)
+	})
+
+	t.Run("sensitive fields are masked", func(t *testing.T) {
+		changes, err := audit.Diff(&product{Password: "hash-antigo"}, &product{Password: "hash-novo"})
+
+		require.NoError(t, err)
+		assert.Equal(t, models.FieldChange{From: "[omitido]", To: "[omitido]"}, changes["Password"])
+	})
+
+	t.Run("values that cannot be encoded", func(t *testing.T) {
+		_, err := audit.Diff(nil, map[string]any{"x": make(chan int)})
+		assert.Error(t, err)
+	})
+}
+
+func TestRecorder_Record(t *testing.T) {
+	ctx := contextUtils.SetUserID(context.Background(), "7")
+	ctx = contextUtils.SetRequestID(ctx, "req-1")
+
+	t.Run("stores actor, request and diff", func(t *testing.T) {
+		repo := new(mockAudit.MockAudit)
+		log, _ := newTestLogger()
+
+		repo.On("Create", ctx, mock.MatchedBy(func(e *models.AuditLog) bool {
+			return *e.ActorUserID == 7 && e.RequestID == "req-1" &&
+				e.EntityType == "product" && e.EntityID == 1 && e.Action == models.ActionUpdate &&
+				len(e.Changes) == 1 && e.Changes["Stock"].To == float64(4)
+		})).Return(&models.AuditLog{ID: 1}, nil).Once()
+
+		audit.NewRecorder(repo, log).Record(ctx, audit.Entry{
+			EntityType: "product",
+			EntityID:   1,
+			Action:     models.ActionUpdate,
+			Before:     &product{ID: 1, Stock: 5},
+			After:      &product{ID: 1, Stock: 4},
+		})
+
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("anonymous actor is stored as null", func(t *testing.T) {
+		repo := new(mockAudit.MockAudit)
+		log, _ := newTestLogger()
+
+		repo.On("Create", mock.Anything, mock.MatchedBy(func(e *models.AuditLog) bool {
+			return e.ActorUserID == nil && e.RequestID == ""
+		})).Return(&models.AuditLog{ID: 1}, nil).Once()
+
+		audit.NewRecorder(repo, log).Record(context.Background(), audit.Entry{EntityType: "product", EntityID: 1, Action: models.ActionCreate, After: &product{}})
+
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("storage failures are only logged", func(t *testing.T) {
+		repo := new(mockAudit.MockAudit)
+		log, buf := newTestLogger()
+
+		repo.On("Create", ctx, mock.Anything).Return(nil, errors.New("db fail")).Once()
+
+		audit.NewRecorder(repo, log).Record(ctx, audit.Entry{EntityType: "product", EntityID: 1, Action: models.ActionDelete, Before: &product{}})
+
+		assert.Contains(t, buf.String(), logger.LogAuditRecordError)
+	})
+
+	t.Run("diff failures are only logged", func(t *testing.T) {
+		repo := new(mockAudit.MockAudit)
+		log, buf := newTestLogger()
+
+		audit.NewRecorder(repo, log).Record(ctx, audit.Entry{EntityType: "product", EntityID: 1, After: map[string]any{"x": make(chan int)}})
+
+		assert.Contains(t, buf.String(), logger.LogAuditRecordError)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRecorder_RecordTx(t *testing.T) {
+	ctx := contextUtils.SetUserID(context.Background(), "7")
+	tx := new(mockDb.MockTx)
+
+	t.Run("stores the entry in the given transaction", func(t *testing.T) {
+		repo := new(mockAudit.MockAudit)
+		log, _ := newTestLogger()
+
+		repo.On("CreateTx", ctx, tx, mock.MatchedBy(func(e *models.AuditLog) bool {
+			return *e.ActorUserID == 7 && e.EntityType == "sale" && e.Action == "cancel" &&
+				e.Changes["Stock"].From == float64(5)
+		})).Return(&models.AuditLog{ID: 1}, nil).Once()
+
+		err := audit.NewRecorder(repo, log).RecordTx(ctx, tx, audit.Entry{
+			EntityType: "sale",
+			EntityID:   1,
+			Action:     "cancel",
+			Before:     &product{Stock: 5},
+			After:      &product{Stock: 4},
+		})
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("storage failures are returned", func(t *testing.T) {
+		repo := new(mockAudit.MockAudit)
+		log, _ := newTestLogger()
+
+		repo.On("CreateTx", ctx, tx, mock.Anything).Return(nil, errors.New("db fail")).Once()
+
+		err := audit.NewRecorder(repo, log).RecordTx(ctx, tx, audit.Entry{EntityType: "sale", EntityID: 1, Action: "cancel"})
+
+		assert.EqualError(t, err, "db fail")
+	})
+
+	t.Run("diff failures are returned", func(t *testing.T) {
+		repo := new(mockAudit.MockAudit)
+		log, _ := newTestLogger()
+
+		err := audit.NewRecorder(repo, log).RecordTx(ctx, tx, audit.Entry{EntityType: "sale", EntityID: 1, After: map[string]any{"x": make(chan int)}})
+
+		assert.Error(t, err)
+		repo.AssertNotCalled(t, "CreateTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
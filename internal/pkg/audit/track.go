@@ -0,0 +1,74 @@
+package audit
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	"github.com/jackc/pgx/v5"
+)
+
+// Loader lê o estado atual da entidade auditada.
+type Loader func(ctx context.Context, id int64) (any, error)
+
+// LoaderTx lê o estado atual da entidade dentro da transação.
+type LoaderTx func(ctx context.Context, tx pgx.Tx, id int64) (any, error)
+
+// Track lê o estado antes, executa a escrita e, se ela der certo, registra a
+// diferença para o estado depois. Falhas de leitura não impedem a escrita;
+// o lado que não pôde ser lido fica vazio no registro.
+func Track(ctx context.Context, rec Recorder, entityType string, id int64, action string, load Loader, write func() error) error {
+	before, err := load(ctx, id)
+	if err != nil {
+		before = nil
+	}
+
+	if err := write(); err != nil {
+		return err
+	}
+
+	var after any
+	if action != models.ActionDelete {
+		if after, err = load(ctx, id); err != nil {
+			after = nil
+		}
+	}
+
+	rec.Record(ctx, Entry{
+		EntityType: entityType,
+		EntityID:   id,
+		Action:     action,
+		Before:     before,
+		After:      after,
+	})
+
+	return nil
+}
+
+// TrackTx faz o mesmo que Track dentro da transação da escrita. O registro é
+// gravado com RecordTx e seu erro é devolvido, para que a escrita seja
+// desfeita junto.
+func TrackTx(ctx context.Context, tx pgx.Tx, rec Recorder, entityType string, id int64, action string, load LoaderTx, write func() error) error {
+	before, err := load(ctx, tx, id)
+	if err != nil {
+		before = nil
+	}
+
+	if err := write(); err != nil {
+		return err
+	}
+
+	var after any
+	if action != models.ActionDelete {
+		if after, err = load(ctx, tx, id); err != nil {
+			after = nil
+		}
+	}
+
+	return rec.RecordTx(ctx, tx, Entry{
+		EntityType: entityType,
+		EntityID:   id,
+		Action:     action,
+		Before:     before,
+		After:      after,
+	})
+}
@@ -0,0 +1,118 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockAudit "github.com/WagaoCarvalho/backend_store_go/infra/mock/audit"
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTrack(t *testing.T) {
+	ctx := context.Background()
+
+	states := func(values ...*product) audit.Loader {
+		call := 0
+		return func(context.Context, int64) (any, error) {
+			v := values[call]
+			call++
+			if v == nil {
+				return nil, errors.New("not found")
+			}
+			return v, nil
+		}
+	}
+
+	t.Run("records before and after a successful write", func(t *testing.T) {
+		rec := new(mockAudit.MockRecorder)
+		before, after := &product{ID: 1, Stock: 5}, &product{ID: 1, Stock: 4}
+		rec.On("Record", ctx, audit.Entry{EntityType: "product", EntityID: 1, Action: "product.stock", Before: before, After: after}).Once()
+
+		err := audit.Track(ctx, rec, "product", 1, "product.stock", states(before, after), func() error { return nil })
+
+		assert.NoError(t, err)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("delete does not reload the entity", func(t *testing.T) {
+		rec := new(mockAudit.MockRecorder)
+		before := &product{ID: 1}
+		rec.On("Record", ctx, audit.Entry{EntityType: "product", EntityID: 1, Action: models.ActionDelete, Before: before}).Once()
+
+		err := audit.Track(ctx, rec, "product", 1, models.ActionDelete, states(before), func() error { return nil })
+
+		assert.NoError(t, err)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("failed write is not recorded", func(t *testing.T) {
+		rec := new(mockAudit.MockRecorder)
+
+		err := audit.Track(ctx, rec, "product", 1, models.ActionUpdate, states(&product{}), func() error { return errors.New("db fail") })
+
+		assert.EqualError(t, err, "db fail")
+		rec.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unreadable state is recorded as empty", func(t *testing.T) {
+		rec := new(mockAudit.MockRecorder)
+		after := &product{ID: 1}
+		rec.On("Record", ctx, audit.Entry{EntityType: "product", EntityID: 1, Action: models.ActionUpdate, After: after}).Once()
+
+		err := audit.Track(ctx, rec, "product", 1, models.ActionUpdate, states(nil, after), func() error { return nil })
+
+		assert.NoError(t, err)
+		rec.AssertExpectations(t)
+	})
+}
+
+func TestTrackTx(t *testing.T) {
+	ctx := context.Background()
+	tx := new(mockDb.MockTx)
+
+	states := func(values ...*product) audit.LoaderTx {
+		call := 0
+		return func(_ context.Context, got pgx.Tx, _ int64) (any, error) {
+			assert.Same(t, tx, got)
+			v := values[call]
+			call++
+			return v, nil
+		}
+	}
+
+	t.Run("records before and after in the transaction", func(t *testing.T) {
+		rec := new(mockAudit.MockRecorder)
+		before, after := &product{ID: 1, Stock: 5}, &product{ID: 1, Stock: 4}
+		rec.On("RecordTx", ctx, tx, audit.Entry{EntityType: "product", EntityID: 1, Action: "decrease_stock", Before: before, After: after}).Return(nil).Once()
+
+		err := audit.TrackTx(ctx, tx, rec, "product", 1, "decrease_stock", states(before, after), func() error { return nil })
+
+		assert.NoError(t, err)
+		rec.AssertExpectations(t)
+		rec.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+	})
+
+	t.Run("recording failure is returned", func(t *testing.T) {
+		rec := new(mockAudit.MockRecorder)
+		rec.On("RecordTx", ctx, tx, mock.Anything).Return(errors.New("audit fail")).Once()
+
+		err := audit.TrackTx(ctx, tx, rec, "product", 1, models.ActionUpdate, states(&product{}, &product{}), func() error { return nil })
+
+		assert.EqualError(t, err, "audit fail")
+	})
+
+	t.Run("failed write is not recorded", func(t *testing.T) {
+		rec := new(mockAudit.MockRecorder)
+
+		err := audit.TrackTx(ctx, tx, rec, "product", 1, models.ActionUpdate, states(&product{}), func() error { return errors.New("db fail") })
+
+		assert.EqualError(t, err, "db fail")
+		rec.AssertNotCalled(t, "RecordTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -21,6 +21,7 @@ var (
 	ErrInvalidSignature     = errors.New("assinatura do token inválida")
 	ErrInvalidExpClaim      = errors.New("claim 'exp' inválida ou ausente")
 	ErrInternalAuth         = errors.New("erro interno na autenticação")
+	ErrPermissionDenied     = errors.New("permissão negada")
 )
 
 type JWTManager struct {
@@ -31,7 +32,7 @@ type JWTManager struct {
 }
 
 type JWTService interface {
-	Generate(uid int64, email string) (string, error)
+	Generate(uid int64, email string, roles, permissions []string) (string, error)
 	ValidateToken(tokenString string) (jwt.MapClaims, error)
 }
 
@@ -44,19 +45,30 @@ func NewJWTManager(secretKey string, duration time.Duration, issuer string, audi
 	}
 }
 
-func (j *JWTManager) Generate(uid int64, email string) (string, error) {
+// Generate emite o token de acesso com os papéis do usuário e as permissões
+// resolvidas a partir deles, consultadas pelo middleware RequirePermission.
+func (j *JWTManager) Generate(uid int64, email string, roles, permissions []string) (string, error) {
 	now := time.Now()
 	uidStr := strconv.FormatInt(uid, 10)
 
+	if roles == nil {
+		roles = []string{}
+	}
+	if permissions == nil {
+		permissions = []string{}
+	}
+
 	claims := jwt.MapClaims{
-		"sub":     uidStr,
-		"user_id": uidStr,
-		"email":   email,
-		"iat":     now.Unix(),
-		"exp":     now.Add(j.TokenDuration).Unix(),
-		"iss":     j.Issuer,
-		"aud":     j.Audience,
-		"jti":     uuid.NewString(),
+		"sub":         uidStr,
+		"user_id":     uidStr,
+		"email":       email,
+		"roles":       roles,
+		"permissions": permissions,
+		"iat":         now.Unix(),
+		"exp":         now.Add(j.TokenDuration).Unix(),
+		"iss":         j.Issuer,
+		"aud":         j.Audience,
+		"jti":         uuid.NewString(),
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
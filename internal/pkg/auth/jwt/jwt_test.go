@@ -11,7 +11,7 @@ import (
 func TestJWTManager_GenerateAndValidate_Success(t *testing.T) {
 	manager := NewJWTManager("test-secret", time.Minute*5, "auth-service", "store-client")
 
-	tokenStr, err := manager.Generate(123, "user@example.com")
+	tokenStr, err := manager.Generate(123, "user@example.com", nil, nil)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, tokenStr)
 
@@ -24,10 +24,34 @@ func TestJWTManager_GenerateAndValidate_Success(t *testing.T) {
 	assert.Equal(t, "store-client", claims["aud"])
 }
 
+func TestJWTManager_Generate_RolesAndPermissions(t *testing.T) {
+	manager := NewJWTManager("test-secret", time.Minute*5, "auth-service", "store-client")
+
+	tokenStr, err := manager.Generate(123, "user@example.com", []string{"cashier"}, []string{"sale:read", "sale:create"})
+	assert.NoError(t, err)
+
+	claims, err := manager.ValidateToken(tokenStr)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"cashier"}, claims["roles"])
+	assert.Equal(t, []interface{}{"sale:read", "sale:create"}, claims["permissions"])
+}
+
+func TestJWTManager_Generate_NilRolesBecomeEmptyClaims(t *testing.T) {
+	manager := NewJWTManager("test-secret", time.Minute*5, "auth-service", "store-client")
+
+	tokenStr, err := manager.Generate(123, "user@example.com", nil, nil)
+	assert.NoError(t, err)
+
+	claims, err := manager.ValidateToken(tokenStr)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{}, claims["roles"])
+	assert.Equal(t, []interface{}{}, claims["permissions"])
+}
+
 func TestJWTManager_ValidateToken_Expired(t *testing.T) {
 	manager := NewJWTManager("test-secret", -time.Minute*1, "auth-service", "store-client")
 
-	tokenStr, err := manager.Generate(123, "user@example.com")
+	tokenStr, err := manager.Generate(123, "user@example.com", nil, nil)
 	assert.NoError(t, err)
 
 	_, err = manager.ValidateToken(tokenStr)
@@ -0,0 +1,162 @@
+// Package lockout aplica a política contra força bruta no login: contadores de
+// falhas por conta e por IP, espera progressiva entre tentativas da mesma conta
+// e bloqueio temporário ao atingir o limite.
+package lockout
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	ScopeAccount = "account"
+	ScopeIP      = "ip"
+)
+
+// Store guarda contadores e bloqueios (ver RedisTokenBlacklist).
+type Store interface {
+	IncrFailures(ctx context.Context, key string, window time.Duration) (int64, error)
+	Lock(ctx context.Context, key string, duration time.Duration) error
+	LockTTL(ctx context.Context, key string) (time.Duration, error)
+	Reset(ctx context.Context, key string) error
+}
+
+type Policy struct {
+	MaxFailures   int           // falhas por conta até o bloqueio
+	IPMaxFailures int           // falhas por IP até o bloqueio
+	Window        time.Duration // janela em que as falhas são somadas
+	LockDuration  time.Duration // duração do bloqueio
+	BaseDelay     time.Duration // espera após a 1ª falha; dobra a cada nova falha
+	MaxDelay      time.Duration // teto da espera progressiva
+}
+
+// Result descreve o efeito de uma falha registrada.
+type Result struct {
+	RetryAfter time.Duration
+	Locked     bool   // a falha atingiu o limite e gerou bloqueio
+	Scope      string // ScopeAccount ou ScopeIP quando Locked
+}
+
+type Guard struct {
+	store  Store
+	policy Policy
+}
+
+func NewGuard(store Store, policy Policy) *Guard {
+	return &Guard{store: store, policy: policy}
+}
+
+// AccountKey normaliza o email para que variações de caixa e espaços contem
+// para a mesma conta. A chave existe exista ou não o usuário, para que o
+// bloqueio não revele quais emails estão cadastrados.
+func AccountKey(email string) string {
+	return ScopeAccount + ":" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func IPKey(ip string) string {
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	return ScopeIP + ":" + ip
+}
+
+// Check devolve por quanto tempo ainda não se deve tentar (0 = liberado).
+func (g *Guard) Check(ctx context.Context, email, ip string) (time.Duration, error) {
+	var longest time.Duration
+	for _, key := range g.keys(email, ip) {
+		ttl, err := g.store.LockTTL(ctx, key)
+		if err != nil {
+			return 0, err
+		}
+		if ttl > longest {
+			longest = ttl
+		}
+	}
+	return longest, nil
+}
+
+// Fail registra uma tentativa malsucedida para a conta e para o IP.
+func (g *Guard) Fail(ctx context.Context, email, ip string) (Result, error) {
+	var result Result
+
+	accountFailures, err := g.store.IncrFailures(ctx, AccountKey(email), g.policy.Window)
+	if err != nil {
+		return result, err
+	}
+
+	if accountFailures >= int64(g.policy.MaxFailures) {
+		if err := g.store.Lock(ctx, AccountKey(email), g.policy.LockDuration); err != nil {
+			return result, err
+		}
+		result = Result{RetryAfter: g.policy.LockDuration, Locked: true, Scope: ScopeAccount}
+	} else if delay := g.backoff(accountFailures); delay > 0 {
+		if err := g.store.Lock(ctx, AccountKey(email), delay); err != nil {
+			return result, err
+		}
+		result.RetryAfter = delay
+	}
+
+	if ip == "" {
+		return result, nil
+	}
+
+	ipFailures, err := g.store.IncrFailures(ctx, IPKey(ip), g.policy.Window)
+	if err != nil {
+		return result, err
+	}
+
+	if ipFailures >= int64(g.policy.IPMaxFailures) {
+		if err := g.store.Lock(ctx, IPKey(ip), g.policy.LockDuration); err != nil {
+			return result, err
+		}
+		if !result.Locked {
+			result = Result{RetryAfter: g.policy.LockDuration, Locked: true, Scope: ScopeIP}
+		}
+	}
+
+	return result, nil
+}
+
+// Succeed zera a contagem da conta. A do IP continua valendo, senão um
+// atacante com uma conta válida poderia zerá-la entre tentativas.
+func (g *Guard) Succeed(ctx context.Context, email string) error {
+	return g.store.Reset(ctx, AccountKey(email))
+}
+
+// Unlock remove contagem e bloqueio da conta e/ou do IP informados.
+func (g *Guard) Unlock(ctx context.Context, email, ip string) error {
+	for _, key := range g.keys(email, ip) {
+		if err := g.store.Reset(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *Guard) keys(email, ip string) []string {
+	keys := make([]string, 0, 2)
+	if strings.TrimSpace(email) != "" {
+		keys = append(keys, AccountKey(email))
+	}
+	if ip != "" {
+		keys = append(keys, IPKey(ip))
+	}
+	return keys
+}
+
+func (g *Guard) backoff(failures int64) time.Duration {
+	if failures <= 0 || g.policy.BaseDelay <= 0 {
+		return 0
+	}
+
+	delay := g.policy.BaseDelay
+	for i := int64(1); i < failures; i++ {
+		delay *= 2
+		if g.policy.MaxDelay > 0 && delay >= g.policy.MaxDelay {
+			return g.policy.MaxDelay
+		}
+	}
+	return delay
+}
@@ -0,0 +1,145 @@
+package lockout
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStore é um Store em memória, sem expiração, suficiente para a política.
+type memoryStore struct {
+	failures map[string]int64
+	locks    map[string]time.Duration
+	err      error
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{failures: map[string]int64{}, locks: map[string]time.Duration{}}
+}
+
+func (m *memoryStore) IncrFailures(_ context.Context, key string, _ time.Duration) (int64, error) {
+	if m.err != nil {
+		return 0, m.err
+	}
+	m.failures[key]++
+	return m.failures[key], nil
+}
+
+func (m *memoryStore) Lock(_ context.Context, key string, d time.Duration) error {
+	m.locks[key] = d
+	return nil
+}
+
+func (m *memoryStore) LockTTL(_ context.Context, key string) (time.Duration, error) {
+	if m.err != nil {
+		return 0, m.err
+	}
+	return m.locks[key], nil
+}
+
+func (m *memoryStore) Reset(_ context.Context, key string) error {
+	delete(m.failures, key)
+	delete(m.locks, key)
+	return nil
+}
+
+var policy = Policy{
+	MaxFailures:   4,
+	IPMaxFailures: 6,
+	Window:        15 * time.Minute,
+	LockDuration:  15 * time.Minute,
+	BaseDelay:     time.Second,
+	MaxDelay:      3 * time.Second,
+}
+
+func TestGuard_ProgressiveBackoffThenLock(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	guard := NewGuard(store, policy)
+
+	expected := []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}
+	for i, delay := range expected {
+		res, err := guard.Fail(ctx, "User@Loja.com ", "10.0.0.1:5000")
+		require.NoError(t, err)
+		assert.False(t, res.Locked, "falha %d", i+1)
+		assert.Equal(t, delay, res.RetryAfter, "falha %d", i+1)
+	}
+
+	res, err := guard.Fail(ctx, "user@loja.com", "10.0.0.1:5001")
+	require.NoError(t, err)
+	assert.True(t, res.Locked)
+	assert.Equal(t, ScopeAccount, res.Scope)
+	assert.Equal(t, 15*time.Minute, res.RetryAfter)
+
+	retry, err := guard.Check(ctx, "USER@loja.com", "")
+	require.NoError(t, err)
+	assert.Equal(t, 15*time.Minute, retry)
+}
+
+func TestGuard_IPLockAcrossAccounts(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	guard := NewGuard(store, policy)
+
+	var res Result
+	for i := 0; i < policy.IPMaxFailures; i++ {
+		var err error
+		res, err = guard.Fail(ctx, "conta"+string(rune('a'+i))+"@loja.com", "10.0.0.1")
+		require.NoError(t, err)
+	}
+
+	assert.True(t, res.Locked)
+	assert.Equal(t, ScopeIP, res.Scope)
+
+	retry, err := guard.Check(ctx, "outra@loja.com", "10.0.0.1:4444")
+	require.NoError(t, err)
+	assert.Equal(t, 15*time.Minute, retry)
+}
+
+func TestGuard_SucceedResetsOnlyAccount(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	guard := NewGuard(store, policy)
+
+	_, err := guard.Fail(ctx, "user@loja.com", "10.0.0.1")
+	require.NoError(t, err)
+
+	require.NoError(t, guard.Succeed(ctx, "user@loja.com"))
+
+	assert.Zero(t, store.failures[AccountKey("user@loja.com")])
+	assert.Equal(t, int64(1), store.failures[IPKey("10.0.0.1")])
+}
+
+func TestGuard_Unlock(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	guard := NewGuard(store, policy)
+
+	for i := 0; i < policy.MaxFailures; i++ {
+		_, err := guard.Fail(ctx, "user@loja.com", "10.0.0.1")
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, guard.Unlock(ctx, "user@loja.com", "10.0.0.1"))
+
+	retry, err := guard.Check(ctx, "user@loja.com", "10.0.0.1")
+	require.NoError(t, err)
+	assert.Zero(t, retry)
+}
+
+func TestGuard_StoreError(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	store.err = errors.New("redis down")
+	guard := NewGuard(store, policy)
+
+	_, err := guard.Check(ctx, "user@loja.com", "10.0.0.1")
+	assert.Error(t, err)
+
+	_, err = guard.Fail(ctx, "user@loja.com", "10.0.0.1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,57 @@
+// Package permission reúne os códigos de permissão verificados pelas rotas.
+// Cada código precisa existir na tabela permissions (ver migração
+// create_roles_permissions_tables) para poder ser concedido a um papel.
+package permission
+
+const (
+	UserRead   = "user:read"
+	UserWrite  = "user:write"
+	UserDelete = "user:delete"
+	RoleRead   = "role:read"
+	RoleAssign = "role:assign"
+
+	SupplierRead   = "supplier:read"
+	SupplierWrite  = "supplier:write"
+	SupplierDelete = "supplier:delete"
+
+	ClientRead   = "client:read"
+	ClientWrite  = "client:write"
+	ClientDelete = "client:delete"
+
+	CreditRead   = "credit:read"
+	CreditManage = "credit:manage"
+	CreditPost   = "credit:post"
+
+	ProductRead   = "product:read"
+	ProductWrite  = "product:write"
+	ProductDelete = "product:delete"
+	ProductStock  = "product:stock"
+
+	SaleRead   = "sale:read"
+	SaleCreate = "sale:create"
+	SaleUpdate = "sale:update"
+	SaleCancel = "sale:cancel"
+	SaleReturn = "sale:return"
+	SaleDelete = "sale:delete"
+
+	CashRead    = "cash:read"
+	CashOperate = "cash:operate"
+
+	AddressRead   = "address:read"
+	AddressWrite  = "address:write"
+	AddressDelete = "address:delete"
+
+	ContactRead   = "contact:read"
+	ContactWrite  = "contact:write"
+	ContactDelete = "contact:delete"
+
+	PurchaseRead    = "purchase:read"
+	PurchaseWrite   = "purchase:write"
+	PurchaseReceive = "purchase:receive"
+
+	ServiceOrderRead    = "service_order:read"
+	ServiceOrderWrite   = "service_order:write"
+	ServiceOrderConvert = "service_order:convert"
+
+	AuditRead = "audit:read"
+)
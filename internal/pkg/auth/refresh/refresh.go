@@ -0,0 +1,55 @@
+// Package refresh gera e confere refresh tokens opacos no formato
+// "<id da sessão>.<segredo>". Só o hash do segredo é guardado no servidor.
+package refresh
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+var ErrMalformedToken = errors.New("refresh token mal formatado")
+
+const separator = "."
+
+// NewSessionID gera um identificador aleatório para uma nova sessão.
+func NewSessionID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// New gera um refresh token para a sessão e devolve o hash a ser guardado.
+func New(sessionID string) (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	secret := base64.RawURLEncoding.EncodeToString(b)
+	return sessionID + separator + secret, Hash(secret), nil
+}
+
+// Split separa o id da sessão e devolve o hash do segredo apresentado.
+func Split(token string) (sessionID, hash string, err error) {
+	sessionID, secret, ok := strings.Cut(strings.TrimSpace(token), separator)
+	if !ok || sessionID == "" || secret == "" {
+		return "", "", ErrMalformedToken
+	}
+	return sessionID, Hash(secret), nil
+}
+
+func Hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// Matches compara hashes em tempo constante.
+func Matches(stored, presented string) bool {
+	return subtle.ConstantTimeCompare([]byte(stored), []byte(presented)) == 1
+}
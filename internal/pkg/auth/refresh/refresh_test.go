@@ -0,0 +1,45 @@
+package refresh
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSessionID(t *testing.T) {
+	a, err := NewSessionID()
+	require.NoError(t, err)
+	b, err := NewSessionID()
+	require.NoError(t, err)
+
+	assert.Len(t, a, 32)
+	assert.NotEqual(t, a, b)
+}
+
+func TestNewAndSplit(t *testing.T) {
+	token, hash, err := New("abc123")
+	require.NoError(t, err)
+
+	sessionID, presented, err := Split(token)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123", sessionID)
+	assert.True(t, Matches(hash, presented))
+}
+
+func TestNew_RotationChangesHash(t *testing.T) {
+	_, first, err := New("abc123")
+	require.NoError(t, err)
+	_, second, err := New("abc123")
+	require.NoError(t, err)
+
+	assert.False(t, Matches(first, second))
+}
+
+func TestSplit_Malformed(t *testing.T) {
+	for _, token := range []string{"", "semseparador", ".segredo", "sessao."} {
+		_, _, err := Split(token)
+		assert.ErrorIs(t, err, ErrMalformedToken, token)
+	}
+}
@@ -0,0 +1,10 @@
+package err
+
+import "errors"
+
+var (
+	ErrCashSessionNotOpen     = errors.New("operador não possui caixa aberto")
+	ErrCashSessionAlreadyOpen = errors.New("operador já possui caixa aberto")
+	ErrCashSessionClosed      = errors.New("caixa já está fechado")
+	ErrCashInsufficient       = errors.New("valor maior que o saldo em dinheiro do caixa")
+)
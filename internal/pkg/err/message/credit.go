@@ -0,0 +1,7 @@
+package err
+
+import "errors"
+
+var (
+	ErrCreditLimitExceeded = errors.New("limite de crédito excedido")
+)
@@ -7,4 +7,11 @@ var (
 	ErrCredentials     = errors.New("credenciais inválidas")
 	ErrTokenGeneration = errors.New("erro ao gerar token de acesso")
 	ErrAccountDisabled = errors.New("conta desativada")
+
+	ErrRefreshTokenInvalid = errors.New("refresh token inválido")
+	ErrRefreshTokenReused  = errors.New("refresh token reutilizado; sessão revogada")
+	ErrSessionStore        = errors.New("erro ao acessar sessões")
+
+	ErrTooManyAttempts = errors.New("muitas tentativas de login; tente novamente mais tarde")
+	ErrLoginAttempts   = errors.New("erro ao verificar tentativas de login")
 )
@@ -0,0 +1,8 @@
+package err
+
+import "errors"
+
+var (
+	ErrPixNotConfirmed     = errors.New("pagamento Pix da venda ainda não foi confirmado")
+	ErrPixAlreadyConfirmed = errors.New("cobrança Pix já foi paga")
+)
@@ -0,0 +1,12 @@
+package err
+
+import "errors"
+
+var (
+	ErrSaleCheckoutRejected = errors.New("checkout recusado")
+	ErrProductDisabled      = errors.New("produto desativado")
+	ErrSaleNotActive        = errors.New("venda não está ativa")
+	ErrPaymentInsufficient  = errors.New("pagamentos não cobrem o total da venda")
+	ErrPaymentExceedsDue    = errors.New("pagamento excede o valor devido")
+	ErrSaleAlreadyPaid      = errors.New("venda já está paga")
+)
@@ -10,6 +10,24 @@ const (
 	LogLoginSuccess         = "login realizado com sucesso"
 	LogEmailInvalid         = "email inválido"
 
+	// Bloqueio de login
+	LogLoginThrottled   = "login recusado por excesso de tentativas"
+	LogLoginLockout     = "login bloqueado após falhas consecutivas"
+	LogLoginGuardError  = "erro ao verificar tentativas de login"
+	LogLoginUnlockInit  = "iniciando desbloqueio de login"
+	LogLoginUnlocked    = "login desbloqueado"
+	LogLoginUnlockError = "erro ao desbloquear login"
+
+	// Refresh e sessões
+	LogRefreshInit        = "iniciando renovação de token"
+	LogRefreshSuccess     = "token renovado com sucesso"
+	LogRefreshError       = "erro ao renovar token"
+	LogRefreshReused      = "reuso de refresh token detectado; sessão revogada"
+	LogSessionEndError    = "erro ao encerrar sessão"
+	LogSessionRevoked     = "sessão revogada"
+	LogSessionListError   = "erro ao listar sessões"
+	LogSessionListSuccess = "sessões listadas com sucesso"
+
 	// Logout
 	LogLogoutInit           = "iniciando logout"
 	LogLogoutSuccess        = "logout realizado com sucesso"
@@ -104,4 +122,20 @@ const (
 	LogAuthInvalidSigningMethod    = "método de assinatura inválido"
 	LogAuthBlacklistError          = "erro ao consultar blacklist"
 	LogAuthExpClaimInvalid         = "campo exp ausente ou inválido"
+	LogAuthPermissionDenied        = "permissão negada"
+
+	// Auditoria
+	LogAuditRecordError = "erro ao registrar auditoria"
+
+	// Rate limit
+	LogRateLimitError = "erro ao consultar limite de requisições; requisição liberada"
+
+	// Alertas de estoque
+	LogStockAlertOpened     = "produto abaixo do estoque mínimo"
+	LogStockAlertCheckError = "erro ao verificar estoque mínimo"
+	LogStockListenError     = "escuta de alterações de estoque interrompida"
+
+	// Preços agendados
+	LogPriceScheduleApplied = "mudanças de preço agendadas aplicadas"
+	LogPriceScheduleError   = "erro ao aplicar mudanças de preço agendadas"
 )
@@ -28,6 +28,7 @@ func TestCORS(t *testing.T) {
 		assert.Equal(t, "*", res.Header.Get("Access-Control-Allow-Origin"))
 		assert.Contains(t, res.Header.Get("Access-Control-Allow-Methods"), "GET")
 		assert.Contains(t, res.Header.Get("Access-Control-Allow-Headers"), "Authorization")
+		assert.Contains(t, res.Header.Get("Access-Control-Expose-Headers"), "Retry-After")
 		assert.True(t, nextCalled)
 		assert.Equal(t, http.StatusOK, res.StatusCode)
 	})
@@ -35,14 +35,14 @@ func (m *mockJWTService) ValidateToken(tokenString string) (jwt.MapClaims, error
 	return args.Get(0).(jwt.MapClaims), args.Error(1)
 }
 
-func (m *mockJWTService) Generate(uid int64, email string) (string, error) {
-	args := m.Called(uid, email)
+func (m *mockJWTService) Generate(uid int64, email string, roles, permissions []string) (string, error) {
+	args := m.Called(uid, email, roles, permissions)
 	return args.String(0), args.Error(1)
 }
 
 func buildJWT(t *testing.T, manager *auth.JWTManager, duration time.Duration) string {
 	manager.TokenDuration = duration
-	token, err := manager.Generate(1, "test@example.com")
+	token, err := manager.Generate(1, "test@example.com", nil, nil)
 	assert.NoError(t, err)
 	return token
 }
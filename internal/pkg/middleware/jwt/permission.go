@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	auth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	middlewares "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ClaimsFromContext retorna as claims gravadas por IsAuthByBearerToken.
+func ClaimsFromContext(ctx context.Context) (jwt.MapClaims, bool) {
+	claims, ok := ctx.Value(userClaimsKey).(jwt.MapClaims)
+	return claims, ok
+}
+
+// HasPermission informa se o token autenticado concede a permissão.
+func HasPermission(ctx context.Context, permission string) bool {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return false
+	}
+
+	switch perms := claims["permissions"].(type) {
+	case []interface{}: // token decodificado do JSON
+		for _, p := range perms {
+			if s, ok := p.(string); ok && s == permission {
+				return true
+			}
+		}
+	case []string:
+		for _, p := range perms {
+			if p == permission {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// RequirePermission recusa com 403 as requisições cujo token não concede a
+// permissão. Deve ser aplicado depois de IsAuthByBearerToken.
+func RequirePermission(loggerAdapter *logger.LogAdapter, permission string) func(http.Handler) http.Handler {
+	const ref = "[RequirePermission] - "
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			if _, ok := ClaimsFromContext(ctx); !ok {
+				loggerAdapter.Warn(ctx, ref+logger.LogAuthTokenMissing, map[string]any{
+					"permission": permission,
+				})
+				http.Error(w, auth.ErrTokenMissing.Error(), http.StatusUnauthorized)
+				return
+			}
+
+			if !HasPermission(ctx, permission) {
+				loggerAdapter.Warn(ctx, ref+logger.LogAuthPermissionDenied, map[string]any{
+					"user_id":    middlewares.GetUserID(ctx),
+					"permission": permission,
+				})
+				http.Error(w, auth.ErrPermissionDenied.Error(), http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Guard simplifica o registro de rotas: guard(permission.SaleCancel, h.Cancel).
+func Guard(loggerAdapter *logger.LogAdapter) func(permission string, next http.HandlerFunc) http.Handler {
+	return func(permission string, next http.HandlerFunc) http.Handler {
+		return RequirePermission(loggerAdapter, permission)(next)
+	}
+}
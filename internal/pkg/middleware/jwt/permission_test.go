@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	auth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	middlewares "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func withClaims(r *http.Request, claims jwt.MapClaims) *http.Request {
+	ctx := context.WithValue(r.Context(), userClaimsKey, claims)
+	ctx = middlewares.SetUserID(ctx, "42")
+	return r.WithContext(ctx)
+}
+
+func okHandler(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestHasPermission(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	t.Run("sem claims", func(t *testing.T) {
+		assert.False(t, HasPermission(req.Context(), "sale:read"))
+	})
+
+	t.Run("claims decodificadas do token", func(t *testing.T) {
+		r := withClaims(req, jwt.MapClaims{"permissions": []interface{}{"sale:read", 10}})
+		assert.True(t, HasPermission(r.Context(), "sale:read"))
+		assert.False(t, HasPermission(r.Context(), "sale:cancel"))
+	})
+
+	t.Run("claims como slice de string", func(t *testing.T) {
+		r := withClaims(req, jwt.MapClaims{"permissions": []string{"sale:cancel"}})
+		assert.True(t, HasPermission(r.Context(), "sale:cancel"))
+	})
+
+	t.Run("claim ausente", func(t *testing.T) {
+		r := withClaims(req, jwt.MapClaims{"user_id": "42"})
+		assert.False(t, HasPermission(r.Context(), "sale:read"))
+	})
+}
+
+func TestRequirePermission(t *testing.T) {
+	newLogger := func() (*logger.LogAdapter, *bytes.Buffer) {
+		buf := &bytes.Buffer{}
+		base := logrus.New()
+		base.Out = buf
+		base.Formatter = &logrus.JSONFormatter{}
+		return logger.NewLoggerAdapter(base), buf
+	}
+
+	t.Run("permite quando o token concede a permissão", func(t *testing.T) {
+		log, _ := newLogger()
+		req := withClaims(httptest.NewRequest(http.MethodPatch, "/sale/1/cancel", nil),
+			jwt.MapClaims{"permissions": []interface{}{"sale:cancel"}})
+		rec := httptest.NewRecorder()
+
+		RequirePermission(log, "sale:cancel")(http.HandlerFunc(okHandler)).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("nega e registra usuário e permissão ausente", func(t *testing.T) {
+		log, buf := newLogger()
+		req := withClaims(httptest.NewRequest(http.MethodPatch, "/sale/1/cancel", nil),
+			jwt.MapClaims{"permissions": []interface{}{"sale:read"}})
+		rec := httptest.NewRecorder()
+
+		RequirePermission(log, "sale:cancel")(http.HandlerFunc(okHandler)).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Contains(t, rec.Body.String(), auth.ErrPermissionDenied.Error())
+		assert.Contains(t, buf.String(), logger.LogAuthPermissionDenied)
+		assert.Contains(t, buf.String(), `"user_id":"42"`)
+		assert.Contains(t, buf.String(), `"permission":"sale:cancel"`)
+	})
+
+	t.Run("sem autenticação prévia", func(t *testing.T) {
+		log, _ := newLogger()
+		rec := httptest.NewRecorder()
+
+		RequirePermission(log, "sale:cancel")(http.HandlerFunc(okHandler)).
+			ServeHTTP(rec, httptest.NewRequest(http.MethodPatch, "/sale/1/cancel", nil))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("guard aplica a permissão ao handler", func(t *testing.T) {
+		log, _ := newLogger()
+		guard := Guard(log)
+		req := withClaims(httptest.NewRequest(http.MethodGet, "/", nil),
+			jwt.MapClaims{"permissions": []interface{}{"product:read"}})
+
+		rec := httptest.NewRecorder()
+		guard("product:read", okHandler).ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		rec = httptest.NewRecorder()
+		guard("product:write", okHandler).ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
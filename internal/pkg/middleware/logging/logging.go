@@ -2,10 +2,10 @@ package middleware
 
 import (
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 )
 
 type responseWriter struct {
@@ -33,20 +33,8 @@ func LoggingMiddleware(log logger.Logger) func(http.Handler) http.Handler {
 				"path":     r.URL.Path,
 				"status":   rw.statusCode,
 				"duration": duration.String(),
-				"remoteIP": getIP(r),
+				"remoteIP": utils.ClientIP(r),
 			})
 		})
 	}
 }
-
-func getIP(r *http.Request) string {
-	ip := r.Header.Get("X-Forwarded-For")
-	if ip != "" {
-		parts := strings.Split(ip, ",")
-		return strings.TrimSpace(parts[0])
-	}
-	if ip = r.Header.Get("X-Real-IP"); ip != "" {
-		return ip
-	}
-	return r.RemoteAddr
-}
@@ -1,46 +1,134 @@
 package middleware
 
 import (
+	"errors"
+	"math"
 	"net/http"
-	"sync"
+	"strconv"
+	"strings"
 	"time"
 
-	"golang.org/x/time/rate"
+	"github.com/WagaoCarvalho/backend_store_go/config"
+	auth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/ratelimit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 )
 
-var (
-	visitors = make(map[string]*rate.Limiter)
-	mu       sync.Mutex
+var errTooManyRequests = errors.New("muitas requisições; tente novamente mais tarde")
 
-	cleanupVisitor = func(ip string) {
-		time.AfterFunc(10*time.Minute, func() {
-			mu.Lock()
-			delete(visitors, ip)
-			mu.Unlock()
-		})
+// Policies define o limite de cada requisição: Users (por id do usuário
+// autenticado) tem prioridade, depois o grupo de rota de prefixo mais longo e
+// por fim Default.
+type Policies struct {
+	Default ratelimit.Limit
+	Groups  map[string]ratelimit.Limit
+	Users   map[string]ratelimit.Limit
+}
+
+func PoliciesFromConfig(cfg config.RateLimit) Policies {
+	policies := Policies{
+		Default: toLimit(cfg.Default),
+		Groups:  make(map[string]ratelimit.Limit, len(cfg.Groups)),
+		Users:   make(map[string]ratelimit.Limit, len(cfg.Users)),
 	}
-)
+	for prefix, policy := range cfg.Groups {
+		policies.Groups[prefix] = toLimit(policy)
+	}
+	for userID, policy := range cfg.Users {
+		policies.Users[userID] = toLimit(policy)
+	}
+	return policies
+}
+
+func toLimit(policy config.RatePolicy) ratelimit.Limit {
+	return ratelimit.Limit{Requests: policy.Requests, Period: policy.Period, Burst: policy.Burst}
+}
+
+// UserResolver identifica o usuário autenticado, se houver.
+type UserResolver func(r *http.Request) (userID string, ok bool)
+
+// BearerUser valida o token de acesso apenas para escolher a chave do limite;
+// a autorização continua a cargo do middleware de autenticação das rotas.
+func BearerUser(jwtService auth.JWTService) UserResolver {
+	return func(r *http.Request) (string, bool) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			return "", false
+		}
+		claims, err := jwtService.ValidateToken(token)
+		if err != nil {
+			return "", false
+		}
+		userID, ok := claims["user_id"].(string)
+		return userID, ok && userID != ""
+	}
+}
+
+// RateLimiter limita por usuário autenticado ou, sem token válido, pelo IP do
+// cliente, separadamente em cada grupo de rota. Se o limitador falhar a
+// requisição segue, para que uma queda do Redis não derrube a API.
+func RateLimiter(limiter ratelimit.Limiter, policies Policies, users UserResolver, log logger.Logger) func(http.Handler) http.Handler {
+	const ref = "[RateLimiter] - "
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			group, limit := policies.group(r.URL.Path)
+
+			subject := "ip:" + utils.ClientIP(r)
+			if userID, ok := users(r); ok {
+				subject = "user:" + userID
+				if userLimit, ok := policies.Users[userID]; ok {
+					limit = userLimit
+				}
+			}
+
+			if limit.IsZero() {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			result, err := limiter.Allow(r.Context(), group+"|"+subject, limit)
+			if err != nil {
+				log.Error(r.Context(), err, ref+logger.LogRateLimitError, map[string]any{
+					"path": r.URL.Path,
+				})
+				next.ServeHTTP(w, r)
+				return
+			}
 
-func getVisitor(ip string) *rate.Limiter {
-	mu.Lock()
-	defer mu.Unlock()
+			w.Header().Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+			w.Header().Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+			w.Header().Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(result.ResetAfter)))
 
-	limiter, exists := visitors[ip]
-	if !exists {
-		limiter = rate.NewLimiter(1, 3) // 1 req/segundo, burst 3
-		visitors[ip] = limiter
-		cleanupVisitor(ip)
+			if !result.Allowed {
+				w.Header().Set("Retry-After", strconv.Itoa(ceilSeconds(result.RetryAfter)))
+				utils.ErrorResponse(w, errTooManyRequests, http.StatusTooManyRequests)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
 	}
-	return limiter
 }
 
-func RateLimiter(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		limiter := getVisitor(r.RemoteAddr)
-		if !limiter.Allow() {
-			http.Error(w, "Muitas requisições. Tente novamente mais tarde.", http.StatusTooManyRequests)
-			return
+// group devolve o grupo de prefixo mais longo que casa com o caminho.
+func (p Policies) group(path string) (string, ratelimit.Limit) {
+	name, limit := "default", p.Default
+	longest := -1
+	for prefix, groupLimit := range p.Groups {
+		if strings.HasPrefix(path, prefix) && len(prefix) > longest {
+			name, limit, longest = prefix, groupLimit, len(prefix)
 		}
-		next.ServeHTTP(w, r)
-	})
+	}
+	return name, limit
+}
+
+// ceilSeconds arredonda para cima, com mínimo de 1s, como pedem os cabeçalhos.
+func ceilSeconds(d time.Duration) int {
+	seconds := int(math.Ceil(d.Seconds()))
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
 }
@@ -1,45 +1,179 @@
 package middleware
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/WagaoCarvalho/backend_store_go/config"
+	redisStore "github.com/WagaoCarvalho/backend_store_go/infra/db/redis"
+	auth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/ratelimit"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-func TestRateLimiter(t *testing.T) {
-	calledCount := 0
-	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-		calledCount++
-		w.WriteHeader(http.StatusOK)
-	})
+func newTestLogger() *logger.LogAdapter {
+	base := logrus.New()
+	base.Out = &bytes.Buffer{}
+	return logger.NewLoggerAdapter(base)
+}
 
-	handler := RateLimiter(next)
+func anonymous(*http.Request) (string, bool) { return "", false }
 
-	ip := "127.0.0.1:1234"
+func okHandler(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
 
-	// Até 3 requisições devem ser aceitas
-	for i := 0; i < 3; i++ {
-		req := httptest.NewRequest(http.MethodGet, "/", nil)
-		req.RemoteAddr = ip
+func doRequest(handler http.Handler, path, remote string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.RemoteAddr = remote
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr
+}
 
-		rr := httptest.NewRecorder()
-		handler.ServeHTTP(rr, req)
+func TestRateLimiter(t *testing.T) {
+	policies := Policies{Default: ratelimit.Limit{Requests: 1, Period: time.Second, Burst: 3}}
+	handler := RateLimiter(ratelimit.NewMemoryLimiter(), policies, anonymous, newTestLogger())(http.HandlerFunc(okHandler))
 
+	// Até 3 requisições devem ser aceitas, mesmo vindo de portas diferentes
+	for i := 0; i < 3; i++ {
+		rr := doRequest(handler, "/", fmt.Sprintf("127.0.0.1:%d", 1000+i), nil)
 		assert.Equal(t, http.StatusOK, rr.Code, "Requisição %d deveria passar", i+1)
+		assert.Equal(t, "3", rr.Header().Get("RateLimit-Limit"))
+		assert.Equal(t, strconv.Itoa(2-i), rr.Header().Get("RateLimit-Remaining"))
 	}
 
-	// Requisições após o limite devem ser bloqueadas
-	for i := 3; i < 5; i++ {
-		req := httptest.NewRequest(http.MethodGet, "/", nil)
-		req.RemoteAddr = ip
+	rr := doRequest(handler, "/", "127.0.0.1:5000", nil)
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	assert.Equal(t, "1", rr.Header().Get("Retry-After"))
+	assert.Equal(t, "0", rr.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "3", rr.Header().Get("RateLimit-Reset"))
 
-		rr := httptest.NewRecorder()
-		handler.ServeHTTP(rr, req)
+	// Outro IP não é afetado
+	rr = doRequest(handler, "/", "127.0.0.2:1000", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
 
-		assert.Equal(t, http.StatusTooManyRequests, rr.Code, "Requisição %d deveria ser bloqueada", i+1)
+func TestRateLimiter_GroupsAndUsers(t *testing.T) {
+	policies := Policies{
+		Default: ratelimit.Limit{Requests: 100, Period: time.Second, Burst: 100},
+		Groups: map[string]ratelimit.Limit{
+			"/login":      {Requests: 1, Period: time.Minute, Burst: 1},
+			"/login/deep": {Requests: 2, Period: time.Minute, Burst: 2},
+		},
+		Users: map[string]ratelimit.Limit{
+			"7": {Requests: 5, Period: time.Minute, Burst: 5},
+		},
 	}
+	users := func(r *http.Request) (string, bool) {
+		id := r.Header.Get("X-Test-User")
+		return id, id != ""
+	}
+	handler := RateLimiter(ratelimit.NewMemoryLimiter(), policies, users, newTestLogger())(http.HandlerFunc(okHandler))
+
+	t.Run("grupo de rota", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, doRequest(handler, "/login", "10.0.0.1:1", nil).Code)
+		assert.Equal(t, http.StatusTooManyRequests, doRequest(handler, "/login", "10.0.0.1:1", nil).Code)
+		// Limite do grupo não consome o das demais rotas
+		assert.Equal(t, http.StatusOK, doRequest(handler, "/products", "10.0.0.1:1", nil).Code)
+	})
+
+	t.Run("prefixo mais longo vence", func(t *testing.T) {
+		rr := doRequest(handler, "/login/deep/x", "10.0.0.2:1", nil)
+		assert.Equal(t, "2", rr.Header().Get("RateLimit-Limit"))
+	})
+
+	t.Run("usuário autenticado tem chave e política próprias", func(t *testing.T) {
+		header := http.Header{"X-Test-User": {"7"}}
+		rr := doRequest(handler, "/login", "10.0.0.1:1", header)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "5", rr.Header().Get("RateLimit-Limit"))
+
+		// Outro usuário no mesmo IP usa a política do grupo, em balde separado
+		rr = doRequest(handler, "/login", "10.0.0.1:1", http.Header{"X-Test-User": {"8"}})
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "1", rr.Header().Get("RateLimit-Limit"))
+	})
+}
+
+type failingLimiter struct{}
+
+func (failingLimiter) Allow(context.Context, string, ratelimit.Limit) (ratelimit.Result, error) {
+	return ratelimit.Result{}, errors.New("redis fora do ar")
+}
+
+func TestRateLimiter_FailsOpen(t *testing.T) {
+	policies := Policies{Default: ratelimit.Limit{Requests: 1, Period: time.Second, Burst: 1}}
+	handler := RateLimiter(failingLimiter{}, policies, anonymous, newTestLogger())(http.HandlerFunc(okHandler))
+
+	rr := doRequest(handler, "/", "10.0.0.1:1", nil)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Empty(t, rr.Header().Get("RateLimit-Limit"))
+}
+
+func TestRateLimiter_SharedAcrossInstances(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	policies := Policies{Default: ratelimit.Limit{Requests: 1, Period: time.Minute, Burst: 2}}
+	newReplica := func() http.Handler {
+		limiter := redisStore.NewRedisRateLimiter(client)
+		return RateLimiter(limiter, policies, anonymous, newTestLogger())(http.HandlerFunc(okHandler))
+	}
+	replicaA, replicaB := newReplica(), newReplica()
+
+	assert.Equal(t, http.StatusOK, doRequest(replicaA, "/", "10.0.0.1:1", nil).Code)
+	assert.Equal(t, http.StatusOK, doRequest(replicaB, "/", "10.0.0.1:2", nil).Code)
+	assert.Equal(t, http.StatusTooManyRequests, doRequest(replicaA, "/", "10.0.0.1:3", nil).Code)
+}
+
+func TestBearerUser(t *testing.T) {
+	manager := auth.NewJWTManager("segredo", time.Minute, "issuer", "audience")
+	token, err := manager.Generate(42, "user@loja.com", nil, nil)
+	require.NoError(t, err)
+
+	resolve := BearerUser(manager)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	userID, ok := resolve(req)
+	assert.True(t, ok)
+	assert.Equal(t, "42", userID)
+
+	req.Header.Set("Authorization", "Bearer forjado")
+	_, ok = resolve(req)
+	assert.False(t, ok)
+
+	req.Header.Del("Authorization")
+	_, ok = resolve(req)
+	assert.False(t, ok)
+}
+
+func TestPoliciesFromConfig(t *testing.T) {
+	policies := PoliciesFromConfig(config.RateLimit{
+		Default: config.RatePolicy{Requests: 10, Period: time.Second, Burst: 20},
+		Groups:  map[string]config.RatePolicy{"/login": {Requests: 5, Period: time.Minute, Burst: 5}},
+		Users:   map[string]config.RatePolicy{"1": {Requests: 50, Period: time.Second, Burst: 100}},
+	})
 
-	assert.Equal(t, 3, calledCount, "Apenas 3 requisições devem passar pelo middleware")
+	assert.Equal(t, ratelimit.Limit{Requests: 10, Period: time.Second, Burst: 20}, policies.Default)
+	assert.Equal(t, 5, policies.Groups["/login"].Burst)
+	assert.Equal(t, 100, policies.Users["1"].Burst)
 }
@@ -0,0 +1,97 @@
+package money
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// MarshalJSON grava o valor como número JSON exato com duas casas (12.50).
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON aceita número ou string ("12.50"). Mais de duas casas
+// decimais é rejeitado para não arredondar silenciosamente a entrada.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	s := string(data)
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalid, s)
+		}
+		s = unquoted
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// ScanNumeric implementa pgtype.NumericScanner. Valores com mais casas (como
+// agregações) são arredondados para o centavo pela regra do banqueiro.
+func (m *Money) ScanNumeric(n pgtype.Numeric) error {
+	if !n.Valid {
+		return fmt.Errorf("%w: nulo", ErrInvalid)
+	}
+	if n.NaN || n.InfinityModifier != pgtype.Finite {
+		return fmt.Errorf("%w: não finito", ErrInvalid)
+	}
+	v, err := fromScaled(n.Int, n.Exp)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// NumericValue implementa pgtype.NumericValuer.
+func (m Money) NumericValue() (pgtype.Numeric, error) {
+	return pgtype.Numeric{Int: big.NewInt(m.cents), Exp: -Scale, Valid: true}, nil
+}
+
+// Scan implementa sql.Scanner para drivers que não usam o codec numérico.
+func (m *Money) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		return fmt.Errorf("%w: nulo", ErrInvalid)
+	case int64:
+		*m = New(v)
+		return nil
+	case float64:
+		f, err := FromFloat(v)
+		if err != nil {
+			return err
+		}
+		*m = f
+		return nil
+	case []byte:
+		return m.scanString(string(v))
+	case string:
+		return m.scanString(v)
+	}
+	return fmt.Errorf("%w: tipo %T", ErrInvalid, src)
+}
+
+func (m *Money) scanString(s string) error {
+	v, err := parse(s, true)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// Value implementa driver.Valuer.
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
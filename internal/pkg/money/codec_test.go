@@ -0,0 +1,73 @@
+package money
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumeric_IdaEVolta(t *testing.T) {
+	m := pgtype.NewMap()
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		for _, v := range []Money{Zero, MustParse("12.34"), MustParse("-0.07"), MustParse("99999999.99")} {
+			buf, err := m.Encode(pgtype.NumericOID, format, v, nil)
+			require.NoError(t, err)
+
+			var got Money
+			require.NoError(t, m.Scan(pgtype.NumericOID, format, buf, &got))
+			assert.Equal(t, v, got)
+		}
+	}
+}
+
+func TestNumeric_PonteiroAceitaNulo(t *testing.T) {
+	m := pgtype.NewMap()
+
+	got := new(Money)
+	require.NoError(t, m.Scan(pgtype.NumericOID, pgtype.TextFormatCode, nil, &got))
+	assert.Nil(t, got)
+
+	var plain Money
+	assert.ErrorIs(t, m.Scan(pgtype.NumericOID, pgtype.TextFormatCode, nil, &plain), ErrInvalid)
+}
+
+func TestScanNumeric(t *testing.T) {
+	var m Money
+
+	// SUM/AVG devolvem mais casas: arredonda pela regra do banqueiro
+	require.NoError(t, m.ScanNumeric(pgtype.Numeric{Int: big.NewInt(12345), Exp: -3, Valid: true}))
+	assert.Equal(t, FromCents(1234), m)
+	require.NoError(t, m.ScanNumeric(pgtype.Numeric{Int: big.NewInt(12355), Exp: -3, Valid: true}))
+	assert.Equal(t, FromCents(1236), m)
+
+	require.NoError(t, m.ScanNumeric(pgtype.Numeric{Int: big.NewInt(5), Exp: 1, Valid: true}))
+	assert.Equal(t, New(50), m)
+
+	assert.ErrorIs(t, m.ScanNumeric(pgtype.Numeric{NaN: true, Valid: true}), ErrInvalid)
+	assert.ErrorIs(t, m.ScanNumeric(pgtype.Numeric{Int: big.NewInt(1), Exp: 30, Valid: true}), ErrOverflow)
+}
+
+func TestSQLScanner(t *testing.T) {
+	var m Money
+
+	require.NoError(t, m.Scan("10.255"))
+	assert.Equal(t, FromCents(1026), m)
+	require.NoError(t, m.Scan([]byte("3.1")))
+	assert.Equal(t, FromCents(310), m)
+	require.NoError(t, m.Scan(int64(4)))
+	assert.Equal(t, New(4), m)
+	require.NoError(t, m.Scan(2.5))
+	assert.Equal(t, FromCents(250), m)
+
+	assert.ErrorIs(t, m.Scan(nil), ErrInvalid)
+	assert.ErrorIs(t, m.Scan(true), ErrInvalid)
+	assert.ErrorIs(t, m.Scan(1e30), ErrOverflow)
+
+	v, err := FromCents(1999).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "19.99", v)
+}
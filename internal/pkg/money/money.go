@@ -0,0 +1,265 @@
+// Package money define o tipo monetário exato usado em modelos, repositórios e
+// DTOs. Os valores são guardados em centavos (as colunas do banco são todas
+// DECIMAL/NUMERIC com duas casas), então somas e comparações nunca acumulam
+// erro de ponto flutuante. Divisões e percentuais arredondam pela regra do
+// banqueiro (meio para o par).
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Scale é o número de casas decimais de Money.
+const Scale = 2
+
+const centsPerUnit = 100
+
+var (
+	ErrInvalid   = errors.New("valor monetário inválido")
+	ErrPrecision = errors.New("valor monetário com mais de duas casas decimais")
+	ErrOverflow  = errors.New("valor monetário fora do intervalo suportado")
+)
+
+// Money é um valor monetário exato com duas casas decimais. O valor zero é
+// R$ 0,00 e pode ser comparado com ==.
+type Money struct {
+	cents int64
+}
+
+// Zero é o valor monetário nulo.
+var Zero Money
+
+// FromCents cria um valor a partir de centavos.
+func FromCents(cents int64) Money {
+	return Money{cents: cents}
+}
+
+// New cria um valor a partir de reais inteiros.
+func New(units int64) Money {
+	return Money{cents: units * centsPerUnit}
+}
+
+// FromFloat converte um float64 usando sua menor representação decimal e
+// arredonda para o centavo pela regra do banqueiro. Serve apenas para a
+// fronteira com código que ainda produz float; cálculos devem usar Money.
+// NaN e infinitos são ErrInvalid; valores além de int64 centavos, ErrOverflow.
+func FromFloat(f float64) (Money, error) {
+	return parse(strconv.FormatFloat(f, 'f', -1, 64), true)
+}
+
+// MustFromFloat é como FromFloat, mas entra em pânico em caso de erro. Uso
+// em testes.
+func MustFromFloat(f float64) Money {
+	m, err := FromFloat(f)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Parse lê um valor decimal como "12", "-3.5" ou "10.25". Mais de duas casas
+// decimais é erro: a entrada precisa ser exata.
+func Parse(s string) (Money, error) {
+	return parse(s, false)
+}
+
+// MustParse é como Parse, mas entra em pânico em caso de erro. Uso em
+// constantes e testes.
+func MustParse(s string) Money {
+	m, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func parse(s string, round bool) (Money, error) {
+	s = strings.TrimSpace(s)
+	neg := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		neg, s = true, s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	intPart, fracPart, hasDot := strings.Cut(s, ".")
+	if intPart == "" && fracPart == "" || hasDot && fracPart == "" || !digits(intPart) || !digits(fracPart) {
+		return Zero, fmt.Errorf("%w: %q", ErrInvalid, s)
+	}
+	if len(fracPart) > Scale && !round {
+		return Zero, fmt.Errorf("%w: %q", ErrPrecision, s)
+	}
+
+	n, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		n = new(big.Int)
+	}
+	if neg {
+		n.Neg(n)
+	}
+	return fromScaled(n, -int32(len(fracPart)))
+}
+
+func digits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// fromScaled converte n·10^exp em centavos, arredondando pela regra do
+// banqueiro quando exp tem mais casas que Scale.
+func fromScaled(n *big.Int, exp int32) (Money, error) {
+	v := new(big.Int).Set(n)
+	switch shift := int64(exp) + Scale; {
+	case shift > 0:
+		v.Mul(v, pow10(shift))
+	case shift < 0:
+		v = divRound(v, pow10(-shift))
+	}
+	if !v.IsInt64() {
+		return Zero, ErrOverflow
+	}
+	return Money{cents: v.Int64()}, nil
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(n), nil)
+}
+
+// divRound divide num por den (den > 0) arredondando o resultado pela regra
+// do banqueiro: empates vão para o inteiro par mais próximo.
+func divRound(num, den *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	twice := new(big.Int).Abs(r)
+	twice.Lsh(twice, 1)
+	switch cmp := twice.Cmp(den); {
+	case cmp > 0, cmp == 0 && q.Bit(0) == 1:
+		if num.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// Cents devolve o valor em centavos.
+func (m Money) Cents() int64 {
+	return m.cents
+}
+
+// Float64 devolve uma aproximação em ponto flutuante, para relatórios e
+// formatação. Não use o resultado em novos cálculos monetários.
+func (m Money) Float64() float64 {
+	return float64(m.cents) / centsPerUnit
+}
+
+func (m Money) Add(o Money) Money {
+	return Money{cents: m.cents + o.cents}
+}
+
+func (m Money) Sub(o Money) Money {
+	return Money{cents: m.cents - o.cents}
+}
+
+func (m Money) Neg() Money {
+	return Money{cents: -m.cents}
+}
+
+// Mul multiplica por uma quantidade inteira; o resultado é exato.
+func (m Money) Mul(qty int64) Money {
+	return Money{cents: m.cents * qty}
+}
+
+// MulFrac devolve m·num/den arredondado para o centavo pela regra do
+// banqueiro. Os produtos intermediários não transbordam; um resultado além
+// de int64 centavos é ErrOverflow. den zero devolve Zero.
+func (m Money) MulFrac(num, den int64) (Money, error) {
+	if den == 0 {
+		return Zero, nil
+	}
+	v := new(big.Int).Mul(big.NewInt(m.cents), big.NewInt(num))
+	d := big.NewInt(den)
+	if den < 0 {
+		v.Neg(v)
+		d.Neg(d)
+	}
+	q := divRound(v, d)
+	if !q.IsInt64() {
+		return Zero, ErrOverflow
+	}
+	return Money{cents: q.Int64()}, nil
+}
+
+// Percent devolve p por cento de m, com p em até duas casas decimais (como
+// nas colunas de percentual), arredondado pela regra do banqueiro.
+func (m Money) Percent(p float64) (Money, error) {
+	rate, err := FromFloat(p)
+	if err != nil {
+		return Zero, err
+	}
+	return m.MulFrac(rate.cents, 100*centsPerUnit)
+}
+
+func (m Money) Cmp(o Money) int {
+	switch {
+	case m.cents < o.cents:
+		return -1
+	case m.cents > o.cents:
+		return 1
+	}
+	return 0
+}
+
+func (m Money) LessThan(o Money) bool    { return m.cents < o.cents }
+func (m Money) GreaterThan(o Money) bool { return m.cents > o.cents }
+func (m Money) IsZero() bool             { return m.cents == 0 }
+func (m Money) IsPositive() bool         { return m.cents > 0 }
+func (m Money) IsNegative() bool         { return m.cents < 0 }
+
+// Max devolve o maior entre a e b.
+func Max(a, b Money) Money {
+	if a.cents >= b.cents {
+		return a
+	}
+	return b
+}
+
+// Min devolve o menor entre a e b.
+func Min(a, b Money) Money {
+	if a.cents <= b.cents {
+		return a
+	}
+	return b
+}
+
+// Sum soma os valores informados.
+func Sum(values ...Money) Money {
+	var total Money
+	for _, v := range values {
+		total.cents += v.cents
+	}
+	return total
+}
+
+// String formata com ponto e sempre duas casas, ex.: "-12.50".
+func (m Money) String() string {
+	c := m.cents
+	sign := ""
+	if c < 0 {
+		sign = "-"
+	}
+	u := uint64(c)
+	if c < 0 {
+		u = uint64(-c)
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, u/centsPerUnit, u%centsPerUnit)
+}
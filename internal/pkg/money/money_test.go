@@ -0,0 +1,200 @@
+package money
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		in    string
+		cents int64
+	}{
+		{"0", 0},
+		{"12", 1200},
+		{"12.5", 1250},
+		{"12.50", 1250},
+		{"-3.07", -307},
+		{"+0.01", 1},
+		{".5", 50},
+		{" 7.10 ", 710},
+	}
+	for _, c := range cases {
+		m, err := Parse(c.in)
+		require.NoError(t, err, c.in)
+		assert.Equal(t, c.cents, m.Cents(), c.in)
+	}
+
+	for _, in := range []string{"", "-", "abc", "1.", "1,50", "1e3", "--1", "1.2.3"} {
+		_, err := Parse(in)
+		assert.ErrorIs(t, err, ErrInvalid, in)
+	}
+
+	_, err := Parse("10.005")
+	assert.ErrorIs(t, err, ErrPrecision)
+
+	_, err = Parse("92233720368547758.08")
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestFromFloat_ArredondamentoBancario(t *testing.T) {
+	cases := []struct {
+		in    float64
+		cents int64
+	}{
+		{0.125, 12},   // empate: 2 é par
+		{0.135, 14},   // empate: 3 é ímpar, sobe
+		{2.675, 268},  // a menor representação é 2.675, não 2.67499...
+		{-0.125, -12}, // simétrico para negativos
+		{-0.135, -14},
+		{0.126, 13},
+		{0.1 + 0.2, 30}, // 0.30000000000000004
+		{19.99, 1999},
+	}
+	for _, c := range cases {
+		got, err := FromFloat(c.in)
+		require.NoError(t, err, "%v", c.in)
+		assert.Equal(t, c.cents, got.Cents(), "%v", c.in)
+	}
+}
+
+func TestFromFloat_Invalido(t *testing.T) {
+	_, err := FromFloat(math.NaN())
+	assert.ErrorIs(t, err, ErrInvalid)
+	_, err = FromFloat(math.Inf(1))
+	assert.ErrorIs(t, err, ErrInvalid)
+
+	// Além dos limites de int64 em centavos
+	_, err = FromFloat(1e30)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = FromFloat(-1e30)
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	assert.Panics(t, func() { MustFromFloat(math.NaN()) })
+	assert.Equal(t, int64(1999), MustFromFloat(19.99).Cents())
+}
+
+func TestArithmetic(t *testing.T) {
+	a, b := MustParse("10.10"), MustParse("0.20")
+
+	assert.Equal(t, MustParse("10.30"), a.Add(b))
+	assert.Equal(t, MustParse("9.90"), a.Sub(b))
+	assert.Equal(t, MustParse("-10.10"), a.Neg())
+	assert.Equal(t, MustParse("30.30"), a.Mul(3))
+	assert.Equal(t, MustParse("10.30"), Sum(a, b))
+	assert.Equal(t, a, Max(a, b))
+	assert.Equal(t, b, Min(a, b))
+
+	// Dez somas de 0,10 fecham exatamente em 1,00
+	total := Zero
+	for range 10 {
+		total = total.Add(MustParse("0.10"))
+	}
+	assert.Equal(t, New(1), total)
+
+	assert.True(t, b.LessThan(a))
+	assert.True(t, a.GreaterThan(b))
+	assert.Equal(t, -1, b.Cmp(a))
+	assert.Equal(t, 0, a.Cmp(MustParse("10.1")))
+	assert.True(t, Zero.IsZero())
+	assert.True(t, a.IsPositive())
+	assert.True(t, a.Neg().IsNegative())
+}
+
+func TestMulFrac(t *testing.T) {
+	cases := []struct {
+		m        string
+		num, den int64
+		want     string
+	}{
+		{"10.00", 1, 3, "3.33"},
+		{"10.00", 2, 3, "6.67"},
+		{"0.05", 1, 2, "0.02"}, // 2,5 centavos: empate vai para o par
+		{"0.15", 1, 2, "0.08"}, // 7,5 centavos: empate vai para o par
+		{"-0.05", 1, 2, "-0.02"},
+		{"0.05", -1, 2, "-0.02"},
+		{"100.00", 7, 7, "100.00"},
+		{"1.00", 1, 0, "0.00"},
+	}
+	for _, c := range cases {
+		got, err := MustParse(c.m).MulFrac(c.num, c.den)
+		require.NoError(t, err)
+		assert.Equal(t, c.want, got.String(), "%s*%d/%d", c.m, c.num, c.den)
+	}
+}
+
+func TestMulFrac_LimitesInt64(t *testing.T) {
+	// Produtos intermediários maiores que int64 não transbordam
+	maxCents := FromCents(math.MaxInt64)
+	got, err := maxCents.MulFrac(math.MaxInt64, math.MaxInt64)
+	require.NoError(t, err)
+	assert.Equal(t, maxCents, got)
+
+	minCents := FromCents(math.MinInt64)
+	got, err = minCents.MulFrac(1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, minCents, got)
+
+	// Resultados fora de int64 são recusados em vez de truncados
+	_, err = maxCents.MulFrac(2, 1)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = minCents.MulFrac(-1, 1)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = maxCents.Percent(101)
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestPercent(t *testing.T) {
+	cases := []struct {
+		m    string
+		p    float64
+		want string
+	}{
+		{"10.00", 15, "1.50"},
+		{"12.45", 5, "0.62"}, // 0,6225
+		{"0.50", 5, "0.02"},  // 0,025: empate vai para o par
+		{"100.00", 1.23, "1.23"},
+	}
+	for _, c := range cases {
+		got, err := MustParse(c.m).Percent(c.p)
+		require.NoError(t, err)
+		assert.Equal(t, c.want, got.String(), "%s*%v%%", c.m, c.p)
+	}
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "0.00", Zero.String())
+	assert.Equal(t, "0.05", FromCents(5).String())
+	assert.Equal(t, "-0.05", FromCents(-5).String())
+	assert.Equal(t, "1234.50", FromCents(123450).String())
+	assert.Equal(t, "-92233720368547758.08", FromCents(math.MinInt64).String())
+	assert.Equal(t, 12.5, FromCents(1250).Float64())
+}
+
+func TestJSON(t *testing.T) {
+	type payload struct {
+		Price Money  `json:"price"`
+		Tax   *Money `json:"tax,omitempty"`
+	}
+
+	data, err := json.Marshal(payload{Price: MustParse("19.9")})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"price": 19.90}`, string(data))
+	assert.Contains(t, string(data), "19.90")
+
+	var p payload
+	require.NoError(t, json.Unmarshal([]byte(`{"price": 0.1, "tax": "2.35"}`), &p))
+	assert.Equal(t, FromCents(10), p.Price)
+	assert.Equal(t, FromCents(235), *p.Tax)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"price": null}`), &p))
+	assert.Equal(t, FromCents(10), p.Price)
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"price": 1.005}`), &p), ErrPrecision)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"price": "abc"}`), &p), ErrInvalid)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"price": true}`), &p), ErrInvalid)
+}
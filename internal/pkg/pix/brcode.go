@@ -0,0 +1,171 @@
+// Package pix monta o BR Code do Pix, o payload EMV "copia e cola" lido pelos
+// aplicativos dos bancos, e define o contrato com o PSP que registra as
+// cobranças e avisa os pagamentos.
+package pix
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+// Identificadores EMV usados pelo BR Code (Manual do BR Code, Bacen).
+const (
+	idPayloadFormat     = "00"
+	idPointOfInitiation = "01"
+	idMerchantAccount   = "26"
+	idMerchantCategory  = "52"
+	idCurrency          = "53"
+	idAmount            = "54"
+	idCountryCode       = "58"
+	idMerchantName      = "59"
+	idMerchantCity      = "60"
+	idAdditionalData    = "62"
+	idCRC               = "63"
+
+	idAccountGUI         = "00"
+	idAccountKey         = "01"
+	idAccountDescription = "02"
+	idAccountLocation    = "25"
+	idAdditionalTxID     = "05"
+)
+
+const (
+	gui         = "br.gov.bcb.pix"
+	currencyBRL = "986"
+	noTxID      = "***"
+
+	maxNameLen = 25
+	maxCityLen = 15
+	maxTxIDLen = 25
+	maxField   = 99
+)
+
+var ErrInvalidBRCode = errors.New("dados do BR Code inválidos")
+
+// Merchant é o recebedor do Pix. Nome e cidade vão sem acentos e truncados
+// nos limites do BR Code.
+type Merchant struct {
+	Key  string
+	Name string
+	City string
+}
+
+// BRCode descreve um QR Pix. Sem Location o código é estático e leva a chave
+// do recebedor; com Location é dinâmico, de uso único, e aponta para o
+// payload registrado no PSP. Amount zero deixa o valor em aberto.
+type BRCode struct {
+	Merchant
+	Location    string
+	Amount      money.Money
+	TxID        string
+	Description string
+}
+
+// IsDynamic indica se o código aponta para um payload no PSP.
+func (b BRCode) IsDynamic() bool {
+	return b.Location != ""
+}
+
+// Encode gera o payload "copia e cola" com o CRC16 no final.
+func (b BRCode) Encode() (string, error) {
+	name := sanitize(b.Name, maxNameLen)
+	city := sanitize(b.City, maxCityLen)
+
+	switch {
+	case name == "":
+		return "", fmt.Errorf("%w: nome do recebedor é obrigatório", ErrInvalidBRCode)
+	case city == "":
+		return "", fmt.Errorf("%w: cidade do recebedor é obrigatória", ErrInvalidBRCode)
+	case !b.IsDynamic() && strings.TrimSpace(b.Key) == "":
+		return "", fmt.Errorf("%w: chave Pix é obrigatória no QR estático", ErrInvalidBRCode)
+	case b.Amount.IsNegative():
+		return "", fmt.Errorf("%w: valor não pode ser negativo", ErrInvalidBRCode)
+	case len(b.TxID) > maxTxIDLen || !alphanumeric(b.TxID):
+		return "", fmt.Errorf("%w: txid deve ter até %d letras e números", ErrInvalidBRCode, maxTxIDLen)
+	}
+
+	account := tlv(idAccountGUI, gui)
+	if b.IsDynamic() {
+		account += tlv(idAccountLocation, strings.TrimPrefix(b.Location, "https://"))
+	} else {
+		account += tlv(idAccountKey, strings.TrimSpace(b.Key))
+		if description := sanitize(b.Description, maxField); description != "" {
+			account += tlv(idAccountDescription, description)
+		}
+	}
+	if len(account) > maxField {
+		return "", fmt.Errorf("%w: chave, descrição ou location longos demais", ErrInvalidBRCode)
+	}
+
+	// No QR dinâmico o txid fica no payload do PSP.
+	txID := b.TxID
+	if txID == "" || b.IsDynamic() {
+		txID = noTxID
+	}
+
+	var sb strings.Builder
+	sb.WriteString(tlv(idPayloadFormat, "01"))
+	if b.IsDynamic() {
+		sb.WriteString(tlv(idPointOfInitiation, "12"))
+	}
+	sb.WriteString(tlv(idMerchantAccount, account))
+	sb.WriteString(tlv(idMerchantCategory, "0000"))
+	sb.WriteString(tlv(idCurrency, currencyBRL))
+	if b.Amount.IsPositive() {
+		sb.WriteString(tlv(idAmount, b.Amount.String()))
+	}
+	sb.WriteString(tlv(idCountryCode, "BR"))
+	sb.WriteString(tlv(idMerchantName, name))
+	sb.WriteString(tlv(idMerchantCity, city))
+	sb.WriteString(tlv(idAdditionalData, tlv(idAdditionalTxID, txID)))
+	sb.WriteString(idCRC + "04")
+
+	payload := sb.String()
+	return payload + CRC16(payload), nil
+}
+
+func tlv(id, value string) string {
+	return fmt.Sprintf("%s%02d%s", id, len(value), value)
+}
+
+func alphanumeric(s string) bool {
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+var accents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"Á", "A", "À", "A", "Â", "A", "Ã", "A", "Ä", "A",
+	"é", "e", "ê", "e", "è", "e", "É", "E", "Ê", "E", "È", "E",
+	"í", "i", "î", "i", "Í", "I", "Î", "I",
+	"ó", "o", "ô", "o", "õ", "o", "ö", "o",
+	"Ó", "O", "Ô", "O", "Õ", "O", "Ö", "O",
+	"ú", "u", "ü", "u", "Ú", "U", "Ü", "U",
+	"ç", "c", "Ç", "C", "ñ", "n", "Ñ", "N",
+)
+
+// sanitize troca os acentos, descarta o que não é ASCII imprimível e corta
+// em max caracteres.
+func sanitize(s string, max int) string {
+	s = accents.Replace(strings.TrimSpace(s))
+
+	var sb strings.Builder
+	for _, r := range s {
+		if r >= ' ' && r <= '~' {
+			sb.WriteRune(r)
+		}
+	}
+
+	out := sb.String()
+	if len(out) > max {
+		out = out[:max]
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,90 @@
+package pix
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRC16(t *testing.T) {
+	assert.Equal(t, "29B1", CRC16("123456789"))
+}
+
+func TestBRCode_Encode(t *testing.T) {
+	t.Run("estático do manual do BR Code", func(t *testing.T) {
+		code := BRCode{Merchant: Merchant{
+			Key:  "123e4567-e12b-12d1-a456-426655440000",
+			Name: "Fulano de Tal",
+			City: "BRASILIA",
+		}}
+
+		payload, err := code.Encode()
+
+		require.NoError(t, err)
+		assert.Equal(t, "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D", payload)
+	})
+
+	t.Run("estático com valor, txid e nome acentuado", func(t *testing.T) {
+		code := BRCode{
+			Merchant: Merchant{Key: "loja@example.com", Name: "Padaria São João da Esquina", City: "São José dos Campos"},
+			Amount:   money.MustParse("10.50"),
+			TxID:     "VENDA42",
+		}
+
+		payload, err := code.Encode()
+
+		require.NoError(t, err)
+		assert.Contains(t, payload, "540510.50")
+		assert.Contains(t, payload, "5925Padaria Sao Joao da Esqui")
+		assert.Contains(t, payload, "6015Sao Jose dos Ca")
+		assert.Contains(t, payload, "62110507VENDA42")
+		assert.NotContains(t, payload, "010212")
+		assert.Equal(t, CRC16(payload[:len(payload)-4]), payload[len(payload)-4:])
+	})
+
+	t.Run("dinâmico aponta para a location", func(t *testing.T) {
+		code := BRCode{
+			Merchant: Merchant{Name: "Loja", City: "Recife"},
+			Location: "https://pix.example.com/qr/v2/abc",
+			Amount:   money.New(5),
+			TxID:     "IGNORADO",
+		}
+
+		payload, err := code.Encode()
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(payload, "000201010212"))
+		assert.Contains(t, payload, "2525pix.example.com/qr/v2/abc")
+		assert.Contains(t, payload, "62070503***")
+		assert.Equal(t, CRC16(payload[:len(payload)-4]), payload[len(payload)-4:])
+	})
+
+	t.Run("dados inválidos", func(t *testing.T) {
+		base := Merchant{Key: "chave", Name: "Loja", City: "Recife"}
+		for name, code := range map[string]BRCode{
+			"sem nome":          {Merchant: Merchant{Key: "chave", City: "Recife"}},
+			"sem cidade":        {Merchant: Merchant{Key: "chave", Name: "Loja"}},
+			"sem chave":         {Merchant: Merchant{Name: "Loja", City: "Recife"}},
+			"valor negativo":    {Merchant: base, Amount: money.New(-1)},
+			"txid com hífen":    {Merchant: base, TxID: "venda-1"},
+			"txid muito longo":  {Merchant: base, TxID: strings.Repeat("a", 26)},
+			"chave muito longa": {Merchant: Merchant{Key: strings.Repeat("a", 80), Name: "Loja", City: "Recife"}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := code.Encode()
+				assert.ErrorIs(t, err, ErrInvalidBRCode)
+			})
+		}
+	})
+}
+
+func TestPNG(t *testing.T) {
+	png, err := PNG("00020126580014br.gov.bcb.pix", 0)
+
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(png, []byte("\x89PNG")))
+}
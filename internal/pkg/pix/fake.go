@@ -0,0 +1,79 @@
+package pix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// FakeProvider é um PSP em memória para testes e desenvolvimento: registra as
+// cobranças com location dinâmica e gera, em Pay, o aviso que o PSP enviaria.
+type FakeProvider struct {
+	mu      sync.Mutex
+	charges map[string]Charge
+	seq     int
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{charges: make(map[string]Charge)}
+}
+
+func (f *FakeProvider) CreateCharge(_ context.Context, charge Charge) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.charges[charge.TxID]; ok {
+		return "", fmt.Errorf("txid %s já registrado", charge.TxID)
+	}
+	f.charges[charge.TxID] = charge
+
+	return "pix.fake.local/qr/v2/" + charge.TxID, nil
+}
+
+// Charge devolve a cobrança registrada com txID.
+func (f *FakeProvider) Charge(txID string) (Charge, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	charge, ok := f.charges[txID]
+	return charge, ok
+}
+
+// Pay simula o pagamento integral da cobrança e devolve o corpo do aviso.
+func (f *FakeProvider) Pay(txID string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	charge, ok := f.charges[txID]
+	if !ok {
+		return nil, fmt.Errorf("txid %s não registrado", txID)
+	}
+	f.seq++
+
+	return json.Marshal(map[string]any{
+		"pix": []map[string]string{{
+			"endToEndId": fmt.Sprintf("E00000000%015d", f.seq),
+			"txid":       charge.TxID,
+			"valor":      charge.Amount.String(),
+			"horario":    time.Now().UTC().Format(time.RFC3339),
+		}},
+	})
+}
+
+// ParseNotification aceita só avisos de cobranças registradas aqui.
+func (f *FakeProvider) ParseNotification(_ http.Header, body []byte) ([]Confirmation, error) {
+	confirmations, err := ParseWebhook(body)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range confirmations {
+		if _, ok := f.Charge(c.TxID); !ok {
+			return nil, fmt.Errorf("%w: txid %s desconhecido", ErrInvalidNotification, c.TxID)
+		}
+	}
+	return confirmations, nil
+}
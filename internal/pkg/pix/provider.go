@@ -0,0 +1,116 @@
+package pix
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+var ErrInvalidNotification = errors.New("aviso de pagamento Pix inválido")
+
+// Charge é a cobrança registrada no PSP.
+type Charge struct {
+	TxID        string
+	Amount      money.Money
+	Description string
+}
+
+// Confirmation é um Pix recebido, identificado pelo EndToEndID do SPI.
+type Confirmation struct {
+	TxID       string
+	EndToEndID string
+	Amount     money.Money
+	PaidAt     time.Time
+}
+
+// Provider é o PSP que recebe os Pix da loja. CreateCharge registra a
+// cobrança e devolve a location do payload dinâmico; vazio significa QR
+// estático com a chave do recebedor. ParseNotification autentica e decodifica
+// o aviso de pagamento enviado pelo PSP.
+type Provider interface {
+	CreateCharge(ctx context.Context, charge Charge) (location string, err error)
+	ParseNotification(header http.Header, body []byte) ([]Confirmation, error)
+}
+
+// webhookBody é o aviso no formato da API Pix do Bacen, seguido pelos PSPs.
+type webhookBody struct {
+	Pix []struct {
+		EndToEndID string `json:"endToEndId"`
+		TxID       string `json:"txid"`
+		Valor      string `json:"valor"`
+		Horario    string `json:"horario"`
+	} `json:"pix"`
+}
+
+// ParseWebhook decodifica o aviso de pagamento no formato da API Pix do
+// Bacen. Pix sem txid, como os recebidos fora de cobranças, são ignorados.
+func ParseWebhook(body []byte) ([]Confirmation, error) {
+	var payload webhookBody
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidNotification, err)
+	}
+
+	confirmations := make([]Confirmation, 0, len(payload.Pix))
+	for _, p := range payload.Pix {
+		if p.TxID == "" {
+			continue
+		}
+		if p.EndToEndID == "" {
+			return nil, fmt.Errorf("%w: endToEndId ausente no txid %s", ErrInvalidNotification, p.TxID)
+		}
+
+		amount, err := money.Parse(p.Valor)
+		if err != nil || !amount.IsPositive() {
+			return nil, fmt.Errorf("%w: valor '%s' no txid %s", ErrInvalidNotification, p.Valor, p.TxID)
+		}
+
+		paidAt, err := time.Parse(time.RFC3339, p.Horario)
+		if err != nil {
+			return nil, fmt.Errorf("%w: horario '%s' no txid %s", ErrInvalidNotification, p.Horario, p.TxID)
+		}
+
+		confirmations = append(confirmations, Confirmation{
+			TxID:       p.TxID,
+			EndToEndID: p.EndToEndID,
+			Amount:     amount,
+			PaidAt:     paidAt,
+		})
+	}
+
+	return confirmations, nil
+}
+
+// StaticProvider é o recebimento sem integração de cobrança: o QR é estático
+// com a chave da loja e os avisos chegam no formato do Bacen, autenticados
+// pelo token Bearer combinado com o PSP.
+type StaticProvider struct {
+	Token string
+}
+
+func NewStaticProvider(token string) *StaticProvider {
+	return &StaticProvider{Token: token}
+}
+
+func (p *StaticProvider) CreateCharge(_ context.Context, _ Charge) (string, error) {
+	return "", nil
+}
+
+func (p *StaticProvider) ParseNotification(header http.Header, body []byte) ([]Confirmation, error) {
+	if p.Token == "" {
+		return nil, fmt.Errorf("%w: token do webhook não configurado", ErrInvalidNotification)
+	}
+
+	token := strings.TrimPrefix(header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(p.Token)) != 1 {
+		return nil, fmt.Errorf("%w: token do webhook inválido", ErrInvalidNotification)
+	}
+
+	return ParseWebhook(body)
+}
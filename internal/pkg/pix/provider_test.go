@@ -0,0 +1,94 @@
+package pix
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWebhook(t *testing.T) {
+	t.Run("sucesso ignora pix sem txid", func(t *testing.T) {
+		body := []byte(`{"pix":[
+			{"endToEndId":"E1","txid":"abc","valor":"10.50","horario":"2026-10-17T12:00:00Z"},
+			{"endToEndId":"E2","valor":"3.00","horario":"2026-10-17T12:01:00Z"}
+		]}`)
+
+		confirmations, err := ParseWebhook(body)
+
+		require.NoError(t, err)
+		require.Len(t, confirmations, 1)
+		assert.Equal(t, "abc", confirmations[0].TxID)
+		assert.Equal(t, "E1", confirmations[0].EndToEndID)
+		assert.Equal(t, money.MustParse("10.50"), confirmations[0].Amount)
+	})
+
+	for name, body := range map[string]string{
+		"json inválido":    `{`,
+		"sem endToEndId":   `{"pix":[{"txid":"abc","valor":"1.00","horario":"2026-10-17T12:00:00Z"}]}`,
+		"valor inválido":   `{"pix":[{"endToEndId":"E1","txid":"abc","valor":"x","horario":"2026-10-17T12:00:00Z"}]}`,
+		"valor zero":       `{"pix":[{"endToEndId":"E1","txid":"abc","valor":"0.00","horario":"2026-10-17T12:00:00Z"}]}`,
+		"horario inválido": `{"pix":[{"endToEndId":"E1","txid":"abc","valor":"1.00","horario":"ontem"}]}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseWebhook([]byte(body))
+			assert.ErrorIs(t, err, ErrInvalidNotification)
+		})
+	}
+}
+
+func TestStaticProvider(t *testing.T) {
+	body := []byte(`{"pix":[{"endToEndId":"E1","txid":"abc","valor":"1.00","horario":"2026-10-17T12:00:00Z"}]}`)
+
+	location, err := NewStaticProvider("segredo").CreateCharge(context.Background(), Charge{TxID: "abc"})
+	require.NoError(t, err)
+	assert.Empty(t, location)
+
+	t.Run("token válido", func(t *testing.T) {
+		header := http.Header{"Authorization": []string{"Bearer segredo"}}
+		confirmations, err := NewStaticProvider("segredo").ParseNotification(header, body)
+		require.NoError(t, err)
+		assert.Len(t, confirmations, 1)
+	})
+
+	t.Run("token inválido", func(t *testing.T) {
+		header := http.Header{"Authorization": []string{"Bearer outro"}}
+		_, err := NewStaticProvider("segredo").ParseNotification(header, body)
+		assert.ErrorIs(t, err, ErrInvalidNotification)
+	})
+
+	t.Run("sem token configurado recusa tudo", func(t *testing.T) {
+		_, err := NewStaticProvider("").ParseNotification(http.Header{}, body)
+		assert.ErrorIs(t, err, ErrInvalidNotification)
+	})
+}
+
+func TestFakeProvider(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeProvider()
+
+	location, err := fake.CreateCharge(ctx, Charge{TxID: "abc", Amount: money.New(20)})
+	require.NoError(t, err)
+	assert.Equal(t, "pix.fake.local/qr/v2/abc", location)
+
+	_, err = fake.CreateCharge(ctx, Charge{TxID: "abc"})
+	assert.Error(t, err)
+
+	body, err := fake.Pay("abc")
+	require.NoError(t, err)
+
+	confirmations, err := fake.ParseNotification(nil, body)
+	require.NoError(t, err)
+	require.Len(t, confirmations, 1)
+	assert.Equal(t, money.New(20), confirmations[0].Amount)
+	assert.NotEmpty(t, confirmations[0].EndToEndID)
+
+	_, err = fake.Pay("xyz")
+	assert.Error(t, err)
+
+	_, err = fake.ParseNotification(nil, []byte(`{"pix":[{"endToEndId":"E1","txid":"xyz","valor":"1.00","horario":"2026-10-17T12:00:00Z"}]}`))
+	assert.ErrorIs(t, err, ErrInvalidNotification)
+}
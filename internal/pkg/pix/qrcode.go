@@ -0,0 +1,15 @@
+package pix
+
+import qrcode "github.com/skip2/go-qrcode"
+
+// DefaultQRSize é o lado, em pixels, do PNG gerado quando nenhum é pedido.
+const DefaultQRSize = 256
+
+// PNG desenha o payload como QR Code. Nível de correção médio, como pede o
+// manual do BR Code.
+func PNG(payload string, size int) ([]byte, error) {
+	if size <= 0 {
+		size = DefaultQRSize
+	}
+	return qrcode.Encode(payload, qrcode.Medium, size)
+}
@@ -0,0 +1,136 @@
+// Package pricing aplica as mudanças de preço agendadas. O Scheduler roda
+// em segundo plano no processo do servidor: acorda na data da próxima
+// mudança pendente e, como reserva, a cada intervalo, para pegar mudanças
+// agendadas depois que ele foi dormir. Cada produto alterado é registrado
+// na trilha de auditoria na mesma transação da mudança.
+package pricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+)
+
+const DefaultInterval = time.Minute
+
+const (
+	auditEntity = "product"
+	auditAction = "apply_scheduled_price"
+)
+
+type Scheduler struct {
+	applier  iface.PriceApplier
+	rec      audit.Recorder
+	log      logger.Logger
+	interval time.Duration
+}
+
+func NewScheduler(applier iface.PriceApplier, rec audit.Recorder, log logger.Logger, interval time.Duration) *Scheduler {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	return &Scheduler{
+		applier:  applier,
+		rec:      rec,
+		log:      log,
+		interval: interval,
+	}
+}
+
+// Run aplica as mudanças vencidas ao iniciar e depois a cada despertar.
+// Retorna quando ctx termina.
+func (s *Scheduler) Run(ctx context.Context) {
+	for {
+		s.apply(ctx)
+
+		timer := time.NewTimer(s.wait(ctx))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func (s *Scheduler) apply(ctx context.Context) {
+	const ref = "[PriceScheduler - apply] "
+
+	applied, err := s.applyTx(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.log.Error(ctx, err, ref+logger.LogPriceScheduleError, nil)
+		}
+		return
+	}
+
+	if applied > 0 {
+		s.log.Info(ctx, ref+logger.LogPriceScheduleApplied, map[string]any{
+			"produtos": applied,
+		})
+	}
+}
+
+// applyTx aplica as mudanças vencidas e registra cada produto alterado na
+// mesma transação. Se algum registro falhar, nenhuma mudança é aplicada e
+// elas ficam pendentes para o próximo despertar.
+func (s *Scheduler) applyTx(ctx context.Context) (int, error) {
+	tx, err := s.applier.BeginTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("erro ao iniciar transação: %w", err)
+	}
+	if tx == nil {
+		return 0, errors.New("transação inválida")
+	}
+
+	applied, err := s.applier.ApplyDueTx(ctx, tx)
+	if err == nil {
+		for _, a := range applied {
+			err = s.rec.RecordTx(ctx, tx, audit.Entry{
+				EntityType: auditEntity,
+				EntityID:   a.ProductID,
+				Action:     auditAction,
+				Before:     a.Before,
+				After:      a.After,
+			})
+			if err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		_ = tx.Rollback(ctx)
+		return 0, fmt.Errorf("erro ao commitar transação: %w", err)
+	}
+
+	return len(applied), nil
+}
+
+// wait é o tempo até a próxima mudança pendente, limitado ao intervalo.
+func (s *Scheduler) wait(ctx context.Context) time.Duration {
+	next, err := s.applier.NextDue(ctx)
+	if err != nil || next == nil {
+		return s.interval
+	}
+
+	d := time.Until(*next)
+	switch {
+	case d <= 0:
+		// Vencida mas não aplicada (travada por outra instância): tenta de novo logo
+		return time.Second
+	case d < s.interval:
+		return d
+	}
+	return s.interval
+}
@@ -0,0 +1,207 @@
+package pricing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockAudit "github.com/WagaoCarvalho/backend_store_go/infra/mock/audit"
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestLogger() (*logger.LogAdapter, *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	base := logrus.New()
+	base.Out = buf
+	return logger.NewLoggerAdapter(base), buf
+}
+
+// start roda o agendador e devolve a função que o encerra e espera o fim,
+// para que o log possa ser lido sem corrida.
+func start(s *Scheduler) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func waitFor(t *testing.T, ch <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-ch:
+	case <-time.After(2 * time.Second):
+		t.Fatal("aplicação não aconteceu")
+	}
+}
+
+// newApplier devolve o agendador de preços simulado com transações que
+// aceitam commit e rollback.
+func newApplier() (*mockProduct.PriceApplierMock, *mockDb.MockTx) {
+	applier := new(mockProduct.PriceApplierMock)
+	tx := new(mockDb.MockTx)
+	tx.On("Commit", mock.Anything).Return(nil).Maybe()
+	tx.On("Rollback", mock.Anything).Return(nil).Maybe()
+	applier.On("BeginTx", mock.Anything).Return(tx, nil).Maybe()
+	return applier, tx
+}
+
+func TestScheduler_Run(t *testing.T) {
+	t.Run("aplica ao iniciar e acorda na próxima mudança", func(t *testing.T) {
+		log, buf := newTestLogger()
+		applier, _ := newApplier()
+		rec := new(mockAudit.MockRecorder)
+		first, second := make(chan struct{}, 1), make(chan struct{}, 1)
+		next := time.Now().Add(20 * time.Millisecond)
+		applied := []*models.AppliedPrice{{ProductID: 1}, {ProductID: 2}, {ProductID: 3}}
+
+		applier.On("ApplyDueTx", mock.Anything, mock.Anything).Return([]*models.AppliedPrice{}, nil).Once().
+			Run(func(mock.Arguments) { first <- struct{}{} })
+		applier.On("ApplyDueTx", mock.Anything, mock.Anything).Return(applied, nil).
+			Run(func(mock.Arguments) { signalOnce(second) })
+		applier.On("NextDue", mock.Anything).Return(&next, nil).Once()
+		applier.On("NextDue", mock.Anything).Return(nil, nil)
+		rec.On("RecordTx", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		stop := start(NewScheduler(applier, rec, log, time.Hour))
+		waitFor(t, first)
+		waitFor(t, second)
+		stop()
+
+		assert.Contains(t, buf.String(), logger.LogPriceScheduleApplied)
+	})
+
+	t.Run("registra falha e continua", func(t *testing.T) {
+		log, buf := newTestLogger()
+		applier, _ := newApplier()
+		called := make(chan struct{}, 1)
+
+		applier.On("ApplyDueTx", mock.Anything, mock.Anything).Return(nil, errors.New("db down")).
+			Run(func(mock.Arguments) { signalOnce(called) })
+		applier.On("NextDue", mock.Anything).Return(nil, errors.New("db down"))
+
+		stop := start(NewScheduler(applier, new(mockAudit.MockRecorder), log, time.Hour))
+		waitFor(t, called)
+		stop()
+
+		assert.Contains(t, buf.String(), logger.LogPriceScheduleError)
+	})
+}
+
+func TestScheduler_applyTx(t *testing.T) {
+	ctx := context.Background()
+	log, _ := newTestLogger()
+
+	t.Run("registra cada produto na transação da mudança", func(t *testing.T) {
+		applier := new(mockProduct.PriceApplierMock)
+		rec := new(mockAudit.MockRecorder)
+		tx := new(mockDb.MockTx)
+		before := models.PriceValues{CostPrice: money.New(5), SalePrice: money.New(10)}
+		after := models.PriceValues{CostPrice: money.New(5), SalePrice: money.New(12)}
+
+		applier.On("BeginTx", ctx).Return(tx, nil).Once()
+		applier.On("ApplyDueTx", ctx, tx).Return([]*models.AppliedPrice{{ProductID: 7, Before: before, After: after}}, nil).Once()
+		rec.On("RecordTx", ctx, tx, audit.Entry{EntityType: "product", EntityID: 7, Action: "apply_scheduled_price", Before: before, After: after}).Return(nil).Once()
+		tx.On("Commit", ctx).Return(nil).Once()
+
+		applied, err := NewScheduler(applier, rec, log, time.Minute).applyTx(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, applied)
+		rec.AssertExpectations(t)
+		tx.AssertExpectations(t)
+	})
+
+	t.Run("falha na auditoria desfaz as mudanças", func(t *testing.T) {
+		applier := new(mockProduct.PriceApplierMock)
+		rec := new(mockAudit.MockRecorder)
+		tx := new(mockDb.MockTx)
+
+		applier.On("BeginTx", ctx).Return(tx, nil).Once()
+		applier.On("ApplyDueTx", ctx, tx).Return([]*models.AppliedPrice{{ProductID: 7}, {ProductID: 8}}, nil).Once()
+		rec.On("RecordTx", ctx, tx, mock.Anything).Return(errors.New("audit fail")).Once()
+		tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := NewScheduler(applier, rec, log, time.Minute).applyTx(ctx)
+
+		assert.EqualError(t, err, "audit fail")
+		rec.AssertNumberOfCalls(t, "RecordTx", 1)
+		tx.AssertExpectations(t)
+		tx.AssertNotCalled(t, "Commit", mock.Anything)
+	})
+
+	t.Run("erro ao iniciar a transação", func(t *testing.T) {
+		applier := new(mockProduct.PriceApplierMock)
+		applier.On("BeginTx", ctx).Return(nil, errors.New("pool")).Once()
+
+		_, err := NewScheduler(applier, new(mockAudit.MockRecorder), log, time.Minute).applyTx(ctx)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("erro no commit", func(t *testing.T) {
+		applier := new(mockProduct.PriceApplierMock)
+		tx := new(mockDb.MockTx)
+
+		applier.On("BeginTx", ctx).Return(tx, nil).Once()
+		applier.On("ApplyDueTx", ctx, tx).Return([]*models.AppliedPrice{}, nil).Once()
+		tx.On("Commit", ctx).Return(errors.New("commit")).Once()
+		tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := NewScheduler(applier, new(mockAudit.MockRecorder), log, time.Minute).applyTx(ctx)
+
+		assert.ErrorContains(t, err, "commit")
+	})
+}
+
+func TestScheduler_wait(t *testing.T) {
+	log, _ := newTestLogger()
+
+	t.Run("limita ao intervalo", func(t *testing.T) {
+		applier := new(mockProduct.PriceApplierMock)
+		far := time.Now().Add(24 * time.Hour)
+		applier.On("NextDue", mock.Anything).Return(&far, nil)
+
+		s := NewScheduler(applier, new(mockAudit.MockRecorder), log, time.Minute)
+
+		assert.Equal(t, time.Minute, s.wait(context.Background()))
+	})
+
+	t.Run("mudança vencida tenta de novo logo", func(t *testing.T) {
+		applier := new(mockProduct.PriceApplierMock)
+		past := time.Now().Add(-time.Minute)
+		applier.On("NextDue", mock.Anything).Return(&past, nil)
+
+		s := NewScheduler(applier, new(mockAudit.MockRecorder), log, time.Minute)
+
+		assert.Equal(t, time.Second, s.wait(context.Background()))
+	})
+
+	t.Run("intervalo padrão", func(t *testing.T) {
+		s := NewScheduler(new(mockProduct.PriceApplierMock), new(mockAudit.MockRecorder), log, 0)
+
+		assert.Equal(t, DefaultInterval, s.interval)
+	})
+}
+
+func signalOnce(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,52 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval é de quanto em quanto tempo as chaves ociosas são descartadas.
+const sweepInterval = time.Minute
+
+// MemoryLimiter guarda os contadores no processo. Serve para uma única
+// instância ou para desenvolvimento; com réplicas use o limitador no Redis.
+type MemoryLimiter struct {
+	mu        sync.Mutex
+	tats      map[string]time.Time
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		tats: make(map[string]time.Time),
+		now:  time.Now,
+	}
+}
+
+func (m *MemoryLimiter) Allow(_ context.Context, key string, limit Limit) (Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	result, tat := gcra(now, m.tats[key], limit)
+	m.tats[key] = tat
+
+	return result, nil
+}
+
+// sweep remove chaves cujo TAT já passou, ou seja, com a rajada toda livre.
+func (m *MemoryLimiter) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < sweepInterval {
+		return
+	}
+	for key, tat := range m.tats {
+		if !tat.After(now) {
+			delete(m.tats, key)
+		}
+	}
+	m.lastSweep = now
+}
@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLimiter(now *time.Time) *MemoryLimiter {
+	m := NewMemoryLimiter()
+	m.now = func() time.Time { return *now }
+	return m
+}
+
+func TestMemoryLimiter_BurstThenRate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(&now)
+	limit := Limit{Requests: 1, Period: time.Second, Burst: 3}
+
+	for i := 0; i < 3; i++ {
+		res, err := limiter.Allow(ctx, "ip:1", limit)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed, "requisição %d", i+1)
+		assert.Equal(t, 2-i, res.Remaining)
+		assert.Equal(t, 3, res.Limit)
+	}
+
+	res, err := limiter.Allow(ctx, "ip:1", limit)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, time.Second, res.RetryAfter)
+	assert.Equal(t, 3*time.Second, res.ResetAfter)
+
+	// Outra chave tem seu próprio limite
+	res, err = limiter.Allow(ctx, "ip:2", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	now = now.Add(time.Second)
+	res, err = limiter.Allow(ctx, "ip:1", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+}
+
+func TestMemoryLimiter_SweepsIdleKeys(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(&now)
+	limit := Limit{Requests: 10, Period: time.Second, Burst: 10}
+
+	_, err := limiter.Allow(ctx, "ip:1", limit)
+	require.NoError(t, err)
+
+	now = now.Add(2 * sweepInterval)
+	_, err = limiter.Allow(ctx, "ip:2", limit)
+	require.NoError(t, err)
+
+	assert.NotContains(t, limiter.tats, "ip:1")
+	assert.Contains(t, limiter.tats, "ip:2")
+}
+
+func TestLimit_Interval(t *testing.T) {
+	assert.Equal(t, 100*time.Millisecond, Limit{Requests: 10, Period: time.Second}.Interval())
+	assert.True(t, Limit{}.IsZero())
+}
@@ -0,0 +1,78 @@
+// Package ratelimit define o contrato dos limitadores de requisições e a
+// implementação em memória. Os limitadores usam GCRA (generic cell rate
+// algorithm): cada chave guarda apenas o "theoretical arrival time" (TAT), o que
+// permite a mesma conta atômica tanto em memória quanto num script no Redis.
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Limit permite Requests requisições por Period, aceitando rajadas de até Burst.
+type Limit struct {
+	Requests int
+	Period   time.Duration
+	Burst    int
+}
+
+// Interval é o espaçamento entre requisições no ritmo sustentado.
+func (l Limit) Interval() time.Duration {
+	if l.Requests <= 0 {
+		return l.Period
+	}
+	return l.Period / time.Duration(l.Requests)
+}
+
+func (l Limit) burst() int {
+	if l.Burst < 1 {
+		return 1
+	}
+	return l.Burst
+}
+
+func (l Limit) IsZero() bool {
+	return l.Requests <= 0 || l.Period <= 0
+}
+
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration // quando negado, quanto esperar pela próxima vaga
+	ResetAfter time.Duration // quanto falta para a rajada estar toda disponível
+}
+
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// gcra aplica o algoritmo sobre o TAT atual e devolve o resultado e o novo
+// TAT (igual ao anterior quando a requisição é negada).
+func gcra(now, tat time.Time, limit Limit) (Result, time.Time) {
+	interval := limit.Interval()
+	burst := limit.burst()
+
+	if tat.Before(now) {
+		tat = now
+	}
+
+	newTat := tat.Add(interval)
+	allowAt := newTat.Add(-time.Duration(burst) * interval)
+
+	if now.Before(allowAt) {
+		return Result{
+			Allowed:    false,
+			Limit:      burst,
+			RetryAfter: allowAt.Sub(now),
+			ResetAfter: tat.Sub(now),
+		}, tat
+	}
+
+	return Result{
+		Allowed:    true,
+		Limit:      burst,
+		Remaining:  int(now.Sub(allowAt) / interval),
+		ResetAfter: newTat.Sub(now),
+	}, newTat
+}
@@ -0,0 +1,80 @@
+package receipt
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// Bobina de 80mm com 4mm de margem. A altura acompanha o número de linhas.
+const (
+	pdfPageWidth  = 80.0
+	pdfMargin     = 4.0
+	pdfLineFactor = 1.25
+)
+
+// renderPDF desenha as linhas em Courier, com o corpo calculado para que
+// columns caracteres ocupem a largura útil, como na impressora térmica.
+func renderPDF(lines []string, columns int) ([]byte, error) {
+	usable := pdfPageWidth - 2*pdfMargin
+	// Courier tem avanço de 0,6 em do tamanho da fonte; 1pt = 25,4/72 mm.
+	fontSize := usable / float64(columns) / 0.6 * 72 / 25.4
+	lineHeight := fontSize * pdfLineFactor * 25.4 / 72
+	height := 2*pdfMargin + float64(len(lines))*lineHeight
+
+	pdf := gofpdf.NewCustom(&gofpdf.InitType{
+		UnitStr: "mm",
+		Size:    gofpdf.SizeType{Wd: pdfPageWidth, Ht: height},
+	})
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.AddPage()
+
+	// Fontes padrão do PDF usam cp1252, que cobre os acentos do português.
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.SetFont("Courier", "", fontSize)
+
+	for _, line := range lines {
+		for _, segment := range splitBold(line) {
+			style := ""
+			if segment.bold {
+				style = "B"
+			}
+			pdf.SetFont("Courier", style, fontSize)
+			text := tr(segment.text)
+			pdf.CellFormat(pdf.GetStringWidth(text), lineHeight, text, "", 0, "L", false, 0, "")
+		}
+		pdf.Ln(lineHeight)
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type segment struct {
+	text string
+	bold bool
+}
+
+// splitBold quebra a linha nos trechos com e sem negrito.
+func splitBold(line string) []segment {
+	var segments []segment
+	bold := false
+	for line != "" {
+		i := strings.IndexAny(line, boldOn+boldOff)
+		if i < 0 {
+			segments = append(segments, segment{text: line, bold: bold})
+			break
+		}
+		if i > 0 {
+			segments = append(segments, segment{text: line[:i], bold: bold})
+		}
+		bold = line[i:i+1] == boldOn
+		line = line[i+1:]
+	}
+	return segments
+}
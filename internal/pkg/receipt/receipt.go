@@ -0,0 +1,93 @@
+// Package receipt desenha o comprovante de venda entregue ao cliente. Um
+// único template text/template gera as linhas do comprovante, que saem como
+// texto puro, PDF ou comandos ESC/POS para impressoras térmicas.
+package receipt
+
+import (
+	"errors"
+	"time"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+// Formatos de saída aceitos.
+const (
+	FormatText   = "txt"
+	FormatPDF    = "pdf"
+	FormatESCPOS = "escpos"
+)
+
+// DefaultColumns é a largura da linha na fonte padrão de uma impressora
+// térmica de 80mm (576 pontos, 12 por caractere).
+const DefaultColumns = 48
+
+var (
+	ErrInvalidFormat   = errors.New("formato de comprovante inválido")
+	ErrInvalidTemplate = errors.New("template de comprovante inválido")
+)
+
+// IsValidFormat indica se format é um formato de saída aceito.
+func IsValidFormat(format string) bool {
+	switch format {
+	case FormatText, FormatPDF, FormatESCPOS:
+		return true
+	}
+	return false
+}
+
+// ContentType é o tipo de mídia devolvido para cada formato.
+func ContentType(format string) string {
+	switch format {
+	case FormatPDF:
+		return "application/pdf"
+	case FormatESCPOS:
+		return "application/octet-stream"
+	default:
+		return "text/plain; charset=utf-8"
+	}
+}
+
+// Store é o cabeçalho da loja.
+type Store struct {
+	Name     string
+	Document string
+	Address  string
+	Phone    string
+	Footer   string
+}
+
+// Item é uma linha de produto. Subtotal já desconta Discount e soma impostos.
+type Item struct {
+	Description string
+	Quantity    int
+	UnitPrice   money.Money
+	Discount    money.Money
+	Subtotal    money.Money
+}
+
+// Tender é uma forma de pagamento usada na venda. Amount é o valor entregue
+// pelo cliente; ChangeGiven, o troco devolvido.
+type Tender struct {
+	Method       string
+	Amount       money.Money
+	ChangeGiven  money.Money
+	Installments int
+}
+
+// Receipt reúne tudo o que o template pode imprimir.
+type Receipt struct {
+	Store         Store
+	SaleID        int64
+	SaleDate      time.Time
+	Status        string
+	ClientName    string
+	Items         []Item
+	Tenders       []Tender
+	ItemsAmount   money.Money
+	ItemsDiscount money.Money
+	SaleDiscount  money.Money
+	Total         money.Money
+	Paid          money.Money
+	Change        money.Money
+	Notes         string
+}
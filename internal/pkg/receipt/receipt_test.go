@@ -0,0 +1,151 @@
+package receipt
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleReceipt() *Receipt {
+	return &Receipt{
+		Store:      Store{Name: "Loja São João", Document: "CNPJ 12.345.678/0001-90", Footer: "Obrigado pela preferência"},
+		SaleID:     42,
+		SaleDate:   time.Date(2026, 3, 5, 14, 30, 0, 0, time.UTC),
+		ClientName: "José Araújo",
+		Items: []Item{
+			{Description: "Café torrado 500g", Quantity: 2, UnitPrice: money.MustParse("18.90"), Subtotal: money.MustParse("37.80")},
+			{Description: "Açúcar", Quantity: 1, UnitPrice: money.MustParse("5.00"), Discount: money.MustParse("0.50"), Subtotal: money.MustParse("4.50")},
+		},
+		Tenders: []Tender{
+			{Method: "card", Amount: money.New(20), Installments: 2},
+			{Method: "cash", Amount: money.New(30), ChangeGiven: money.MustParse("7.70")},
+		},
+		ItemsAmount:   money.MustParse("42.80"),
+		ItemsDiscount: money.MustParse("0.50"),
+		Total:         money.MustParse("42.30"),
+		Paid:          money.New(50),
+		Change:        money.MustParse("7.70"),
+	}
+}
+
+func TestRenderer_Text(t *testing.T) {
+	r, err := NewRenderer("", 0)
+	require.NoError(t, err)
+
+	out, err := r.Render(FormatText, sampleReceipt())
+	require.NoError(t, err)
+
+	text := string(out)
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		assert.LessOrEqual(t, len([]rune(line)), DefaultColumns, line)
+	}
+	assert.Contains(t, text, "Loja São João")
+	assert.Contains(t, text, "Cliente: José Araújo")
+	assert.Contains(t, text, "001 Café torrado 500g")
+	assert.Contains(t, text, "    2 x 18,90")
+	assert.Contains(t, text, "-0,50")
+	assert.Contains(t, text, "Cartão 2x")
+	assert.Contains(t, text, "05/03/2026 14:30")
+	assert.NotContains(t, text, boldOn)
+
+	total := "TOTAL R$" + strings.Repeat(" ", DefaultColumns-len("TOTAL R$")-len("42,30")) + "42,30"
+	assert.Contains(t, text, total)
+}
+
+func TestRenderer_TextCanceled(t *testing.T) {
+	r, err := NewRenderer("", 0)
+	require.NoError(t, err)
+
+	rc := sampleReceipt()
+	rc.Status = "canceled"
+	rc.Tenders = nil
+
+	out, err := r.Render(FormatText, rc)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "*** VENDA CANCELADA ***")
+	assert.NotContains(t, string(out), "Troco")
+}
+
+func TestRenderer_ESCPOS(t *testing.T) {
+	r, err := NewRenderer("", 0)
+	require.NoError(t, err)
+
+	out, err := r.Render(FormatESCPOS, sampleReceipt())
+	require.NoError(t, err)
+
+	assert.True(t, bytes.HasPrefix(out, []byte{0x1b, '@', 0x1b, 't', 3}))
+	assert.True(t, bytes.HasSuffix(out, []byte{0x1d, 'V', 66, 0}))
+	assert.Contains(t, string(out), "\x1bE\x01")
+	// "ã" é 0x84 na PC860.
+	assert.Contains(t, string(out), "S\x84o")
+	assert.NotContains(t, string(out), "ã")
+}
+
+func TestRenderer_PDF(t *testing.T) {
+	r, err := NewRenderer("", 0)
+	require.NoError(t, err)
+
+	out, err := r.Render(FormatPDF, sampleReceipt())
+	require.NoError(t, err)
+
+	assert.True(t, bytes.HasPrefix(out, []byte("%PDF-")))
+	assert.Contains(t, string(out), "/Courier-Bold")
+}
+
+func TestRenderer_CustomTemplate(t *testing.T) {
+	r, err := NewRenderer(`{{right (printf "#%d" .SaleID)}}`, 10)
+	require.NoError(t, err)
+
+	out, err := r.Render(FormatText, sampleReceipt())
+	require.NoError(t, err)
+	assert.Equal(t, "       #42\n", string(out))
+}
+
+func TestLoadRenderer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "receipt.tmpl")
+	require.NoError(t, os.WriteFile(path, []byte(`Venda {{.SaleID}}`), 0o600))
+
+	r, err := LoadRenderer(path, 0)
+	require.NoError(t, err)
+	out, err := r.Render(FormatText, sampleReceipt())
+	require.NoError(t, err)
+	assert.Equal(t, "Venda 42\n", string(out))
+
+	_, err = LoadRenderer(filepath.Join(t.TempDir(), "missing.tmpl"), 0)
+	assert.ErrorIs(t, err, ErrInvalidTemplate)
+
+	_, err = LoadRenderer("", 0)
+	assert.NoError(t, err)
+}
+
+func TestRenderer_Errors(t *testing.T) {
+	_, err := NewRenderer("{{if}}", 0)
+	assert.ErrorIs(t, err, ErrInvalidTemplate)
+
+	r, err := NewRenderer("{{.Missing}}", 0)
+	require.NoError(t, err)
+	_, err = r.Render(FormatText, sampleReceipt())
+	assert.ErrorIs(t, err, ErrInvalidTemplate)
+
+	_, err = r.Render("html", sampleReceipt())
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+}
+
+func TestFormatMoney(t *testing.T) {
+	assert.Equal(t, "0,05", formatMoney(money.FromCents(5)))
+	assert.Equal(t, "1.234.567,89", formatMoney(money.MustParse("1234567.89")))
+	assert.Equal(t, "-12,30", formatMoney(money.MustParse("-12.30")))
+}
+
+func TestContentType(t *testing.T) {
+	assert.Equal(t, "application/pdf", ContentType(FormatPDF))
+	assert.Equal(t, "application/octet-stream", ContentType(FormatESCPOS))
+	assert.Equal(t, "text/plain; charset=utf-8", ContentType(FormatText))
+}
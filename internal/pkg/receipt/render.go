@@ -0,0 +1,125 @@
+package receipt
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// Renderer desenha comprovantes com um template já validado. É seguro para
+// uso concorrente.
+type Renderer struct {
+	tmpl    *template.Template
+	columns int
+}
+
+// NewRenderer compila text com as funções do comprovante. Texto vazio usa
+// DefaultTemplate e columns <= 0 usa DefaultColumns.
+func NewRenderer(text string, columns int) (*Renderer, error) {
+	if strings.TrimSpace(text) == "" {
+		text = DefaultTemplate
+	}
+	if columns <= 0 {
+		columns = DefaultColumns
+	}
+
+	tmpl, err := template.New("receipt").Funcs(funcs(columns)).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+
+	return &Renderer{tmpl: tmpl, columns: columns}, nil
+}
+
+// LoadRenderer lê o template do arquivo em path; path vazio usa
+// DefaultTemplate.
+func LoadRenderer(path string, columns int) (*Renderer, error) {
+	text := ""
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+		}
+		text = string(b)
+	}
+	return NewRenderer(text, columns)
+}
+
+// Render executa o template e converte as linhas para format.
+func (r *Renderer) Render(format string, receipt *Receipt) ([]byte, error) {
+	if !IsValidFormat(format) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidFormat, format)
+	}
+
+	var buf bytes.Buffer
+	if err := r.tmpl.Execute(&buf, receipt); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+
+	switch format {
+	case FormatPDF:
+		return renderPDF(lines, r.columns)
+	case FormatESCPOS:
+		return renderESCPOS(lines), nil
+	default:
+		return renderText(lines), nil
+	}
+}
+
+var stripBold = strings.NewReplacer(boldOn, "", boldOff, "")
+
+func renderText(lines []string) []byte {
+	var buf bytes.Buffer
+	for _, line := range lines {
+		buf.WriteString(stripBold.Replace(line))
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// Comandos ESC/POS usados no comprovante.
+var (
+	escInit       = []byte{0x1b, '@'}        // ESC @: reinicia a impressora
+	escCodePage   = []byte{0x1b, 't', 3}     // ESC t 3: página de código PC860 (português)
+	escBoldOn     = []byte{0x1b, 'E', 1}     // ESC E 1
+	escBoldOff    = []byte{0x1b, 'E', 0}     // ESC E 0
+	escFeed       = []byte{0x1b, 'd', 4}     // ESC d 4: avança quatro linhas
+	escPartialCut = []byte{0x1d, 'V', 66, 0} // GS V 66 0: corte parcial
+)
+
+// renderESCPOS gera os bytes para impressoras térmicas: texto em PC860,
+// negrito com ESC E e corte do papel no fim. Caracteres fora da página de
+// código saem como "?".
+func renderESCPOS(lines []string) []byte {
+	var buf bytes.Buffer
+	buf.Write(escInit)
+	buf.Write(escCodePage)
+
+	for _, line := range lines {
+		for _, r := range line {
+			switch string(r) {
+			case boldOn:
+				buf.Write(escBoldOn)
+			case boldOff:
+				buf.Write(escBoldOff)
+			default:
+				b, ok := charmap.CodePage860.EncodeRune(r)
+				if !ok {
+					b = '?'
+				}
+				buf.WriteByte(b)
+			}
+		}
+		buf.WriteByte('\n')
+	}
+
+	buf.Write(escBoldOff)
+	buf.Write(escFeed)
+	buf.Write(escPartialCut)
+	return buf.Bytes()
+}
@@ -0,0 +1,142 @@
+package receipt
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+// Marcadores de negrito deixados pela função bold do template. Cada formato
+// os troca pelo seu equivalente; no texto puro eles somem.
+const (
+	boldOn  = "\x02"
+	boldOff = "\x03"
+)
+
+// DefaultTemplate é usado quando nenhum template é configurado. Funções
+// disponíveis: center, right, cols, line, bold, truncate, money, date,
+// method e inc.
+const DefaultTemplate = `{{with .Store}}{{if .Name}}{{bold (center .Name)}}
+{{end}}{{if .Document}}{{center .Document}}
+{{end}}{{if .Address}}{{center .Address}}
+{{end}}{{if .Phone}}{{center .Phone}}
+{{end}}{{end}}{{line}}
+{{center "COMPROVANTE DE VENDA"}}
+{{center "NÃO É DOCUMENTO FISCAL"}}
+{{if eq .Status "canceled"}}{{bold (center "*** VENDA CANCELADA ***")}}
+{{end}}{{line}}
+{{cols (printf "Venda nº %d" .SaleID) (date .SaleDate)}}
+{{if .ClientName}}{{truncate (printf "Cliente: %s" .ClientName)}}
+{{end}}{{line}}
+{{range $i, $it := .Items}}{{truncate (printf "%03d %s" (inc $i) $it.Description)}}
+{{cols (printf "    %d x %s" $it.Quantity (money $it.UnitPrice)) (money $it.Subtotal)}}
+{{if $it.Discount.IsPositive}}{{cols "    desconto" (printf "-%s" (money $it.Discount))}}
+{{end}}{{end}}{{line}}
+{{cols "Itens" (money .ItemsAmount)}}
+{{if .ItemsDiscount.IsPositive}}{{cols "Desconto nos itens" (printf "-%s" (money .ItemsDiscount))}}
+{{end}}{{if .SaleDiscount.IsPositive}}{{cols "Desconto na venda" (printf "-%s" (money .SaleDiscount))}}
+{{end}}{{bold (cols "TOTAL R$" (money .Total))}}
+{{if .Tenders}}{{line}}
+{{range .Tenders}}{{cols (method .Method .Installments) (money .Amount)}}
+{{end}}{{if .Change.IsPositive}}{{cols "Troco" (money .Change)}}
+{{end}}{{end}}{{if .Notes}}{{line}}
+{{.Notes}}
+{{end}}{{if .Store.Footer}}{{line}}
+{{center .Store.Footer}}
+{{end}}`
+
+func funcs(columns int) template.FuncMap {
+	return template.FuncMap{
+		"center": func(s string) string {
+			s = truncate(s, columns)
+			return strings.Repeat(" ", (columns-width(s))/2) + s
+		},
+		"right": func(s string) string {
+			s = truncate(s, columns)
+			return strings.Repeat(" ", columns-width(s)) + s
+		},
+		"cols": func(left, right string) string {
+			right = truncate(right, columns)
+			left = truncate(left, columns-width(right)-1)
+			return left + strings.Repeat(" ", columns-width(left)-width(right)) + right
+		},
+		"line": func() string {
+			return strings.Repeat("-", columns)
+		},
+		"bold": func(s string) string {
+			return boldOn + s + boldOff
+		},
+		"truncate": func(s string) string {
+			return truncate(s, columns)
+		},
+		"money":  formatMoney,
+		"date":   formatDate,
+		"method": methodLabel,
+		"inc": func(i int) int {
+			return i + 1
+		},
+	}
+}
+
+// width conta os caracteres visíveis, sem os marcadores de negrito.
+func width(s string) int {
+	return utf8.RuneCountInString(s) - strings.Count(s, boldOn) - strings.Count(s, boldOff)
+}
+
+func truncate(s string, max int) string {
+	if max <= 0 {
+		return ""
+	}
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max])
+}
+
+// formatMoney escreve o valor no padrão brasileiro: 1.234,56.
+func formatMoney(m money.Money) string {
+	cents := m.Cents()
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	units := fmt.Sprintf("%d", cents/100)
+	var sb strings.Builder
+	for i, r := range units {
+		if i > 0 && (len(units)-i)%3 == 0 {
+			sb.WriteByte('.')
+		}
+		sb.WriteRune(r)
+	}
+
+	return fmt.Sprintf("%s%s,%02d", sign, sb.String(), cents%100)
+}
+
+func formatDate(t time.Time) string {
+	return t.Format("02/01/2006 15:04")
+}
+
+// methodLabel traduz a forma de pagamento; cartão parcelado mostra as
+// parcelas.
+func methodLabel(method string, installments int) string {
+	switch method {
+	case "cash":
+		return "Dinheiro"
+	case "card":
+		if installments > 1 {
+			return fmt.Sprintf("Cartão %dx", installments)
+		}
+		return "Cartão"
+	case "credit":
+		return "Crediário"
+	case "pix":
+		return "Pix"
+	}
+	return method
+}
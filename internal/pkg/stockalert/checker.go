@@ -0,0 +1,175 @@
+// Package stockalert detecta produtos que ficaram abaixo do estoque mínimo.
+// O Checker roda em segundo plano no processo do servidor: é avisado pelo
+// banco (LISTEN stock_changed) a cada mudança de estoque e, como reserva,
+// verifica todo o catálogo periodicamente.
+package stockalert
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+)
+
+const (
+	DefaultInterval = 5 * time.Minute
+	retryDelay      = 5 * time.Second
+)
+
+// Listener entrega o ID de cada produto cujo estoque mudou. Listen bloqueia
+// até ctx terminar ou a escuta falhar.
+type Listener interface {
+	Listen(ctx context.Context, notify func(productID int64)) error
+}
+
+type Checker struct {
+	detector iface.StockAlertDetector
+	listener Listener
+	log      logger.Logger
+	interval time.Duration
+	retry    time.Duration
+
+	mu      sync.Mutex
+	pending map[int64]struct{}
+	wake    chan struct{}
+	sweep   chan struct{}
+}
+
+// NewChecker cria o verificador; listener pode ser nil, e então só a
+// verificação periódica roda.
+func NewChecker(detector iface.StockAlertDetector, listener Listener, log logger.Logger, interval time.Duration) *Checker {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	return &Checker{
+		detector: detector,
+		listener: listener,
+		log:      log,
+		interval: interval,
+		retry:    retryDelay,
+		pending:  make(map[int64]struct{}),
+		wake:     make(chan struct{}, 1),
+		sweep:    make(chan struct{}, 1),
+	}
+}
+
+// Notify agenda a verificação dos produtos informados sem bloquear; avisos
+// repetidos antes da verificação viram uma só.
+func (c *Checker) Notify(productIDs ...int64) {
+	if len(productIDs) == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	for _, id := range productIDs {
+		c.pending[id] = struct{}{}
+	}
+	c.mu.Unlock()
+
+	signal(c.wake)
+}
+
+// Run verifica todo o catálogo ao iniciar e a cada intervalo, e os produtos
+// notificados assim que chegam. Retorna quando ctx termina.
+func (c *Checker) Run(ctx context.Context) {
+	if c.listener != nil {
+		go c.listen(ctx)
+	}
+
+	c.check(ctx, nil)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.check(ctx, nil)
+		case <-c.sweep:
+			c.check(ctx, nil)
+		case <-c.wake:
+			if ids := c.drain(); len(ids) > 0 {
+				c.check(ctx, ids)
+			}
+		}
+	}
+}
+
+// listen mantém a escuta ativa. Depois de uma queda verifica o catálogo
+// inteiro, pois as mudanças do intervalo não foram notificadas.
+func (c *Checker) listen(ctx context.Context) {
+	const ref = "[StockAlertChecker - listen] "
+
+	for {
+		err := c.listener.Listen(ctx, func(id int64) { c.Notify(id) })
+		if ctx.Err() != nil {
+			return
+		}
+
+		c.log.Warn(ctx, ref+logger.LogStockListenError, map[string]any{
+			"erro": errString(err),
+		})
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.retry):
+		}
+
+		signal(c.sweep)
+	}
+}
+
+func (c *Checker) drain() []int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ids := make([]int64, 0, len(c.pending))
+	for id := range c.pending {
+		ids = append(ids, id)
+	}
+	c.pending = make(map[int64]struct{})
+
+	return ids
+}
+
+func (c *Checker) check(ctx context.Context, productIDs []int64) {
+	const ref = "[StockAlertChecker - check] "
+
+	opened, err := c.detector.Detect(ctx, productIDs)
+	if err != nil {
+		c.log.Error(ctx, err, ref+logger.LogStockAlertCheckError, map[string]any{
+			"produtos": productIDs,
+		})
+		return
+	}
+
+	for _, alert := range opened {
+		c.log.Warn(ctx, ref+logger.LogStockAlertOpened, map[string]any{
+			"alert_id":       alert.ID,
+			"product_id":     alert.ProductID,
+			"product_name":   alert.ProductName,
+			"stock_quantity": alert.StockQuantity,
+			"min_stock":      alert.MinStock,
+		})
+	}
+}
+
+func signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,152 @@
+package stockalert
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_alert"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type listenerFunc func(ctx context.Context, notify func(int64)) error
+
+func (f listenerFunc) Listen(ctx context.Context, notify func(int64)) error { return f(ctx, notify) }
+
+func newTestLogger() (*logger.LogAdapter, *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	base := logrus.New()
+	base.Out = buf
+	return logger.NewLoggerAdapter(base), buf
+}
+
+// start roda o verificador e devolve a função que o encerra e espera o fim,
+// para que o log possa ser lido sem corrida.
+func start(c *Checker) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		c.Run(ctx)
+		close(done)
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func waitFor(t *testing.T, ch <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-ch:
+	case <-time.After(2 * time.Second):
+		t.Fatal("verificação não aconteceu")
+	}
+}
+
+func TestChecker_Run(t *testing.T) {
+	t.Run("verifica tudo ao iniciar e depois os produtos notificados", func(t *testing.T) {
+		log, buf := newTestLogger()
+		detector := new(mockProduct.StockAlertMock)
+		sweep, targeted := make(chan struct{}, 1), make(chan struct{}, 1)
+
+		detector.On("Detect", mock.Anything, []int64(nil)).
+			Return([]*models.StockAlert{}, nil).
+			Run(func(mock.Arguments) { sweep <- struct{}{} }).Once()
+		detector.On("Detect", mock.Anything, []int64{5}).
+			Return([]*models.StockAlert{{ID: 1, ProductID: 5, ProductName: "Café", StockQuantity: 2, MinStock: 10}}, nil).
+			Run(func(mock.Arguments) { targeted <- struct{}{} }).Once()
+
+		c := NewChecker(detector, nil, log, time.Hour)
+		stop := start(c)
+
+		waitFor(t, sweep)
+		c.Notify(5, 5)
+		waitFor(t, targeted)
+		stop()
+
+		detector.AssertExpectations(t)
+		assert.Contains(t, buf.String(), logger.LogStockAlertOpened)
+	})
+
+	t.Run("IDs vindos da escuta são verificados", func(t *testing.T) {
+		log, _ := newTestLogger()
+		detector := new(mockProduct.StockAlertMock)
+		targeted := make(chan struct{}, 1)
+
+		detector.On("Detect", mock.Anything, []int64(nil)).Return([]*models.StockAlert{}, nil)
+		detector.On("Detect", mock.Anything, []int64{9}).
+			Return([]*models.StockAlert{}, nil).
+			Run(func(mock.Arguments) { targeted <- struct{}{} })
+
+		listener := listenerFunc(func(ctx context.Context, notify func(int64)) error {
+			notify(9)
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		c := NewChecker(detector, listener, log, time.Hour)
+		stop := start(c)
+
+		waitFor(t, targeted)
+		stop()
+	})
+
+	t.Run("queda da escuta gera nova verificação completa", func(t *testing.T) {
+		log, buf := newTestLogger()
+		detector := new(mockProduct.StockAlertMock)
+		var sweeps atomic.Int32
+
+		detector.On("Detect", mock.Anything, []int64(nil)).
+			Return([]*models.StockAlert{}, nil).
+			Run(func(mock.Arguments) { sweeps.Add(1) })
+
+		var calls atomic.Int32
+		listener := listenerFunc(func(ctx context.Context, notify func(int64)) error {
+			if calls.Add(1) == 1 {
+				return errors.New("conexão perdida")
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		c := NewChecker(detector, listener, log, time.Hour)
+		c.retry = time.Millisecond
+		stop := start(c)
+
+		assert.Eventually(t, func() bool { return sweeps.Load() >= 2 }, 2*time.Second, 5*time.Millisecond)
+		stop()
+		assert.Contains(t, buf.String(), logger.LogStockListenError)
+	})
+
+	t.Run("erro do detector é registrado e o verificador continua", func(t *testing.T) {
+		log, buf := newTestLogger()
+		detector := new(mockProduct.StockAlertMock)
+		done := make(chan struct{}, 1)
+
+		detector.On("Detect", mock.Anything, []int64(nil)).Return(nil, errors.New("db down")).Once()
+		detector.On("Detect", mock.Anything, []int64{3}).
+			Return([]*models.StockAlert{}, nil).
+			Run(func(mock.Arguments) { done <- struct{}{} })
+
+		c := NewChecker(detector, nil, log, time.Hour)
+		stop := start(c)
+
+		c.Notify(3)
+		waitFor(t, done)
+		stop()
+		assert.Contains(t, buf.String(), logger.LogStockAlertCheckError)
+	})
+}
+
+func TestNewChecker_DefaultInterval(t *testing.T) {
+	c := NewChecker(new(mockProduct.StockAlertMock), nil, nil, 0)
+	assert.Equal(t, DefaultInterval, c.interval)
+}
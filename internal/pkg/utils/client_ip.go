@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+// IPResolver descobre o IP real do cliente. Os cabeçalhos X-Forwarded-For e
+// X-Real-IP só são considerados quando a conexão vem de um proxy confiável;
+// do contrário qualquer cliente poderia escolher o próprio IP.
+type IPResolver struct {
+	trusted []*net.IPNet
+}
+
+// NewIPResolver confia nas redes informadas, já validadas na configuração.
+func NewIPResolver(trusted []*net.IPNet) *IPResolver {
+	return &IPResolver{trusted: trusted}
+}
+
+func (res *IPResolver) ClientIP(r *http.Request) string {
+	remote := stripPort(r.RemoteAddr)
+	if !res.isTrusted(remote) {
+		return remote
+	}
+
+	// Percorre a cadeia da direita para a esquerda: o primeiro endereço que
+	// não é de um proxy nosso é o cliente.
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := stripPort(strings.TrimSpace(hops[i]))
+			if hop == "" {
+				continue
+			}
+			if !res.isTrusted(hop) || i == 0 {
+				return hop
+			}
+		}
+	}
+
+	if realIP := strings.TrimSpace(r.Header.Get("X-Real-IP")); realIP != "" {
+		return stripPort(realIP)
+	}
+
+	return remote
+}
+
+func (res *IPResolver) isTrusted(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, network := range res.trusted {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+func stripPort(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// defaultResolver atende ClientIP. Até UseTrustedProxies ser chamado nenhum
+// proxy é confiável e os cabeçalhos são desconsiderados.
+var defaultResolver = &IPResolver{}
+
+// UseTrustedProxies define os proxies confiáveis de ClientIP. Deve ser
+// chamado na inicialização, antes de o servidor atender requisições.
+func UseTrustedProxies(trusted []*net.IPNet) {
+	defaultResolver = NewIPResolver(trusted)
+}
+
+// ClientIP devolve o IP de origem usando os proxies confiáveis configurados
+// em TRUSTED_PROXIES.
+func ClientIP(r *http.Request) string {
+	return defaultResolver.ClientIP(r)
+}
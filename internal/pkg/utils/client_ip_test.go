@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/WagaoCarvalho/backend_store_go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPResolver_ClientIP(t *testing.T) {
+	trusted, err := config.ParseTrustedProxies("10.0.0.0/8, 192.0.2.1")
+	require.NoError(t, err)
+	resolver := NewIPResolver(trusted)
+
+	newReq := func(remote string, headers map[string]string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = remote
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		return req
+	}
+
+	tests := []struct {
+		name    string
+		remote  string
+		headers map[string]string
+		want    string
+	}{
+		{"sem proxy remove a porta", "203.0.113.9:1234", nil, "203.0.113.9"},
+		{"cabeçalho de cliente não confiável é ignorado", "203.0.113.9:1234", map[string]string{"X-Forwarded-For": "1.2.3.4"}, "203.0.113.9"},
+		{"proxy confiável com X-Forwarded-For", "10.0.0.5:80", map[string]string{"X-Forwarded-For": "198.51.100.7"}, "198.51.100.7"},
+		{"cadeia ignora proxies confiáveis à direita", "10.0.0.5:80", map[string]string{"X-Forwarded-For": "1.2.3.4, 198.51.100.7, 10.0.0.9"}, "198.51.100.7"},
+		{"proxy confiável com X-Real-IP", "192.0.2.1:80", map[string]string{"X-Real-IP": "198.51.100.8"}, "198.51.100.8"},
+		{"proxy confiável sem cabeçalhos", "192.0.2.1:80", nil, "192.0.2.1"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, resolver.ClientIP(newReq(tc.remote, tc.headers)))
+		})
+	}
+}
+
+func TestClientIP_DefaultIgnoresHeaders(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "192.0.2.50:1234"
+	req.Header.Set("X-Forwarded-For", "203.0.113.7")
+
+	assert.Equal(t, "192.0.2.50", ClientIP(req))
+}
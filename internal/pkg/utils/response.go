@@ -76,6 +76,13 @@ func GetStringParam(r *http.Request, key string) (string, error) {
 	return val, nil
 }
 
+// GetCursorParam lê o parâmetro "cursor". A presença do parâmetro, mesmo
+// vazio, ativa a paginação keyset; o valor é o next_cursor da página anterior.
+func GetCursorParam(r *http.Request) (cursor string, enabled bool) {
+	query := r.URL.Query()
+	return query.Get("cursor"), query.Has("cursor")
+}
+
 // GetPaginationParams - ÚNICA função de paginação
 func GetPaginationParams(r *http.Request) (limit, offset int) {
 	// Valores padrão
@@ -15,7 +15,7 @@ const baseSelectAddress = `
 	SELECT 
 		id, user_id, client_cpf_id, supplier_id,
 		street, street_number, complement, city, state, country, postal_code,
-		is_active, created_at, updated_at
+		is_active, created_at, updated_at, client_cnpj_id
 	FROM addresses
 `
 
@@ -57,6 +57,7 @@ func scanAddress(s scanner) (*models.Address, error) {
 		&addr.IsActive,
 		&addr.CreatedAt,
 		&addr.UpdatedAt,
+		&addr.ClientCnpjID,
 	)
 	if err != nil {
 		return nil, err
@@ -37,14 +37,16 @@ func (r *addressTx) CreateTx(
 			postal_code,
 			is_active,
 			created_at,
-			updated_at
+			updated_at,
+			client_cnpj_id
 		)
 		VALUES (
 			$1, $2, $3,
 			$4, $5, $6, $7, $8, $9, $10,
 			$11,
 			NOW(),
-			NOW()
+			NOW(),
+			$12
 		)
 		RETURNING id, created_at, updated_at;
 	`
@@ -63,6 +65,7 @@ func (r *addressTx) CreateTx(
 		address.Country,
 		address.PostalCode,
 		address.IsActive,
+		address.ClientCnpjID,
 	).Scan(
 		&address.ID,
 		&address.CreatedAt,
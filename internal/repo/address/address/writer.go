@@ -16,9 +16,9 @@ func (r *addressRepo) Create(ctx context.Context, address *models.Address) (*mod
 		INSERT INTO addresses (
 			user_id, client_cpf_id, supplier_id,
 			street, street_number, complement, city, state, country, postal_code,
-			is_active, created_at, updated_at
+			is_active, created_at, updated_at, client_cnpj_id
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NOW(), NOW())
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NOW(), NOW(), $12)
 		RETURNING id, created_at, updated_at;
 	`
 
@@ -34,6 +34,7 @@ func (r *addressRepo) Create(ctx context.Context, address *models.Address) (*mod
 		address.Country,
 		address.PostalCode,
 		address.IsActive,
+		address.ClientCnpjID,
 	).Scan(&address.ID, &address.CreatedAt, &address.UpdatedAt)
 
 	if err != nil {
@@ -61,8 +62,9 @@ func (r *addressRepo) Update(ctx context.Context, address *models.Address) error
 			country       = $9,
 			postal_code   = $10,
 			is_active     = $11,
+			client_cnpj_id = $12,
 			updated_at    = NOW()
-		WHERE id = $12
+		WHERE id = $13
 		RETURNING updated_at;
 	`
 
@@ -78,6 +80,7 @@ func (r *addressRepo) Update(ctx context.Context, address *models.Address) error
 		address.Country,
 		address.PostalCode,
 		address.IsActive,
+		address.ClientCnpjID,
 		address.ID,
 	).Scan(&address.UpdatedAt)
 
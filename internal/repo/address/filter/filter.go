@@ -7,29 +7,41 @@ import (
 
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/address/address"
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/address/filter"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+	pagination "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/pagination"
 )
 
 var addressAllowedSortFields = map[string]string{
-	"id":            "id",
-	"user_id":       "user_id",
-	"client_cpf_id": "client_cpf_id",
-	"supplier_id":   "supplier_id",
-	"street":        "street",
-	"street_number": "street_number",
-	"city":          "city",
-	"state":         "state",
-	"country":       "country",
-	"postal_code":   "postal_code",
-	"is_active":     "is_active",
-	"created_at":    "created_at",
-	"updated_at":    "updated_at",
+	"id":             "id",
+	"user_id":        "user_id",
+	"client_cpf_id":  "client_cpf_id",
+	"client_cnpj_id": "client_cnpj_id",
+	"supplier_id":    "supplier_id",
+	"street":         "street",
+	"street_number":  "street_number",
+	"city":           "city",
+	"state":          "state",
+	"country":        "country",
+	"postal_code":    "postal_code",
+	"is_active":      "is_active",
+	"created_at":     "created_at",
+	"updated_at":     "updated_at",
 }
 
+var addressOrderBy = builder.OrderBy{
+	Fields:       addressAllowedSortFields,
+	DefaultField: "created_at",
+	DefaultOrder: "desc",
+}
+
+var postalCodeFormatting = strings.NewReplacer("-", "", ".", "", " ", "")
+
 func (r *addressFilterRepo) Filter(
 	ctx context.Context,
 	filter *filter.AddressFilter,
-) ([]*model.Address, error) {
+) (*commonFilter.Page[*model.Address], error) {
 
 	// Aplicar valores padrão do BaseFilter
 	base := filter.BaseFilter.WithDefaults()
@@ -49,129 +61,47 @@ func (r *addressFilterRepo) Filter(
 			postal_code,
 			is_active,
 			created_at,
-			updated_at
+			updated_at,
+			client_cnpj_id
 		FROM addresses
-		WHERE 1=1
 	`
 
-	args := []any{}
-	argPos := 1
+	b := builder.NewQueryBuilderSql(query)
 
-	// Filtros por relacionamento (apenas se não forem nil)
-	if filter.UserID != nil {
-		query += fmt.Sprintf(" AND user_id = $%d", argPos)
-		args = append(args, *filter.UserID)
-		argPos++
-	}
-
-	if filter.ClientCpfID != nil {
-		query += fmt.Sprintf(" AND client_cpf_id = $%d", argPos)
-		args = append(args, *filter.ClientCpfID)
-		argPos++
-	}
-
-	if filter.SupplierID != nil {
-		query += fmt.Sprintf(" AND supplier_id = $%d", argPos)
-		args = append(args, *filter.SupplierID)
-		argPos++
-	}
+	b.Eq("user_id", filter.UserID)
+	b.Eq("client_cpf_id", filter.ClientCpfID)
+	b.Eq("client_cnpj_id", filter.ClientCnpjID)
+	b.Eq("supplier_id", filter.SupplierID)
 
 	// Filtros de texto (busca parcial com ILIKE)
-	if filter.Street != "" {
-		query += fmt.Sprintf(" AND street ILIKE '%%' || $%d || '%%'", argPos)
-		args = append(args, filter.Street)
-		argPos++
-	}
-
-	if filter.City != "" {
-		query += fmt.Sprintf(" AND city ILIKE '%%' || $%d || '%%'", argPos)
-		args = append(args, filter.City)
-		argPos++
-	}
-
-	if filter.Country != "" {
-		query += fmt.Sprintf(" AND country ILIKE '%%' || $%d || '%%'", argPos)
-		args = append(args, filter.Country)
-		argPos++
-	}
+	b.ILike("street", filter.Street)
+	b.ILike("city", filter.City)
+	b.ILike("country", filter.Country)
 
 	// Filtros de correspondência exata
-	if filter.StreetNumber != "" {
-		query += fmt.Sprintf(" AND street_number = $%d", argPos)
-		args = append(args, filter.StreetNumber)
-		argPos++
-	}
-
-	if filter.State != "" {
-		query += fmt.Sprintf(" AND state = $%d", argPos)
-		args = append(args, strings.ToUpper(filter.State))
-		argPos++
-	}
-
-	// Filtro de CEP com limpeza de formatação
-	if filter.PostalCode != "" {
-		cleanPostalCode := strings.ReplaceAll(filter.PostalCode, "-", "")
-		cleanPostalCode = strings.ReplaceAll(cleanPostalCode, ".", "")
-		cleanPostalCode = strings.ReplaceAll(cleanPostalCode, " ", "")
-		query += fmt.Sprintf(" AND REPLACE(REPLACE(REPLACE(postal_code, '-', ''), '.', ''), ' ', '') = $%d", argPos)
-		args = append(args, cleanPostalCode)
-		argPos++
-	}
-
-	// Filtro de status (apenas se não for nil)
-	if filter.IsActive != nil {
-		query += fmt.Sprintf(" AND is_active = $%d", argPos)
-		args = append(args, *filter.IsActive)
-		argPos++
-	}
-
-	// Filtros de data
-	if filter.CreatedFrom != nil {
-		query += fmt.Sprintf(" AND created_at >= $%d", argPos)
-		args = append(args, *filter.CreatedFrom)
-		argPos++
-	}
+	b.Eq("street_number", filter.StreetNumber)
+	b.Eq("state", strings.ToUpper(filter.State))
 
-	if filter.CreatedTo != nil {
-		query += fmt.Sprintf(" AND created_at <= $%d", argPos)
-		args = append(args, *filter.CreatedTo)
-		argPos++
-	}
+	// CEP comparado só pelos dígitos
+	b.Eq(
+		"REPLACE(REPLACE(REPLACE(postal_code, '-', ''), '.', ''), ' ', '')",
+		postalCodeFormatting.Replace(filter.PostalCode),
+	)
 
-	if filter.UpdatedFrom != nil {
-		query += fmt.Sprintf(" AND updated_at >= $%d", argPos)
-		args = append(args, *filter.UpdatedFrom)
-		argPos++
-	}
+	b.Eq("is_active", filter.IsActive)
+	b.Range("created_at", filter.CreatedFrom, filter.CreatedTo)
+	b.Range("updated_at", filter.UpdatedFrom, filter.UpdatedTo)
+	b.Search(base.SearchTerm, "street", "complement", "city", "postal_code")
 
-	if filter.UpdatedTo != nil {
-		query += fmt.Sprintf(" AND updated_at <= $%d", argPos)
-		args = append(args, *filter.UpdatedTo)
-		argPos++
-	}
+	sortField, sortOrder := addressOrderBy.Resolve(filter.SortBy, filter.SortOrder)
 
-	// Ordenação com validação de campos (prevenção de SQL injection)
-	sortField := "created_at"
-	if v, ok := addressAllowedSortFields[strings.ToLower(base.SortBy)]; ok {
-		sortField = v
-	}
-
-	sortOrder := strings.ToLower(base.SortOrder)
-	if sortOrder != "asc" && sortOrder != "desc" {
-		sortOrder = "desc" // Padrão: mais recentes primeiro
+	args := b.GetArgs()
+	tail, pageArgs, err := pagination.Clause(base, sortField, sortOrder, b.NextArgPos())
+	if err != nil {
+		return nil, err
 	}
 
-	query += fmt.Sprintf(
-		" ORDER BY %s %s LIMIT $%d OFFSET $%d",
-		sortField,
-		sortOrder,
-		argPos,
-		argPos+1,
-	)
-
-	args = append(args, base.Limit, base.Offset)
-
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := r.db.Query(ctx, b.GetQuery()+tail, append(args, pageArgs...)...)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
 	}
@@ -199,6 +129,7 @@ func (r *addressFilterRepo) Filter(
 			&a.IsActive,
 			&a.CreatedAt,
 			&a.UpdatedAt,
+			&a.ClientCnpjID,
 		); err != nil {
 			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
 		}
@@ -215,7 +146,14 @@ func (r *addressFilterRepo) Filter(
 		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
 	}
 
-	return addresses, nil
+	total, err := pagination.Count(ctx, r.db, "addresses", b.Where(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonFilter.NewPage(addresses, total, base, func(m *model.Address) (any, int64) {
+		return addressSortValue(m, sortField), m.ID
+	}), nil
 }
 
 // Método para buscar endereços ativos
@@ -225,7 +163,11 @@ func (r *addressFilterRepo) FindActive(
 ) ([]*model.Address, error) {
 	active := true
 	filter.IsActive = &active
-	return r.Filter(ctx, filter)
+	page, err := r.Filter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
 }
 
 // Método para buscar endereços por CEP
@@ -244,7 +186,7 @@ func (r *addressFilterRepo) FindByPostalCode(
 	if err != nil {
 		return nil, err
 	}
-	return result, nil
+	return result.Items, nil
 }
 
 // Método para buscar endereços por cidade e estado
@@ -263,7 +205,7 @@ func (r *addressFilterRepo) FindByCityAndState(
 	if err != nil {
 		return nil, err
 	}
-	return result, nil
+	return result.Items, nil
 }
 
 // Versão com função auxiliar para garantir slice não-nil
@@ -290,7 +232,7 @@ func (r *addressFilterRepo) FindByPostalCodeV2(
 	if err != nil {
 		return nil, err
 	}
-	return ensureNonNilAddressSlice(result), nil
+	return ensureNonNilAddressSlice(result.Items), nil
 }
 
 // Versão melhorada do FindByPostalCode com busca parcial real
@@ -302,9 +244,7 @@ func (r *addressFilterRepo) FindByPostalCodeImproved(
 	active := true
 
 	// Limpar o CEP para busca
-	cleanPostalCode := strings.ReplaceAll(postalCode, "-", "")
-	cleanPostalCode = strings.ReplaceAll(cleanPostalCode, ".", "")
-	cleanPostalCode = strings.ReplaceAll(cleanPostalCode, " ", "")
+	cleanPostalCode := postalCodeFormatting.Replace(postalCode)
 
 	filter := &filter.AddressFilter{
 		PostalCode: cleanPostalCode,
@@ -324,5 +264,39 @@ func (r *addressFilterRepo) FindByPostalCodeImproved(
 	if err != nil {
 		return nil, err
 	}
-	return ensureNonNilAddressSlice(result), nil
+	return ensureNonNilAddressSlice(result.Items), nil
+}
+
+// addressSortValue devolve o valor da coluna de ordenação field no endereço, gravado
+// no cursor da próxima página.
+func addressSortValue(m *model.Address, field string) any {
+	switch field {
+	case "user_id":
+		return m.UserID
+	case "client_cpf_id":
+		return m.ClientCpfID
+	case "client_cnpj_id":
+		return m.ClientCnpjID
+	case "supplier_id":
+		return m.SupplierID
+	case "street":
+		return m.Street
+	case "street_number":
+		return m.StreetNumber
+	case "city":
+		return m.City
+	case "state":
+		return m.State
+	case "country":
+		return m.Country
+	case "postal_code":
+		return m.PostalCode
+	case "is_active":
+		return m.IsActive
+	case "created_at":
+		return m.CreatedAt
+	case "updated_at":
+		return m.UpdatedAt
+	}
+	return m.ID
 }
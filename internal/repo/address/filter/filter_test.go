@@ -33,7 +33,7 @@ func TestAddressFilter_Filter_Success_WithResults(t *testing.T) {
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-		mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything,
 	).Run(func(args mock.Arguments) {
 		if id, ok := args[0].(*int64); ok {
 			*id = 1
@@ -90,19 +90,20 @@ func TestAddressFilter_Filter_Success_WithResults(t *testing.T) {
 
 	mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
 
+	mockDB.OnCount(1)
 	result, err := repo.Filter(ctx, f)
 
 	assert.NoError(t, err)
-	assert.Len(t, result, 1)
-	assert.Equal(t, int64(1), result[0].ID)
-	assert.Equal(t, "Rua Teste", result[0].Street)
-	assert.Equal(t, "123", result[0].StreetNumber)
-	assert.Equal(t, "Apto 101", result[0].Complement)
-	assert.Equal(t, "São Paulo", result[0].City)
-	assert.Equal(t, "SP", result[0].State)
-	assert.Equal(t, "Brasil", result[0].Country)
-	assert.Equal(t, "01234567", result[0].PostalCode)
-	assert.True(t, result[0].IsActive)
+	assert.Len(t, result.Items, 1)
+	assert.Equal(t, int64(1), result.Items[0].ID)
+	assert.Equal(t, "Rua Teste", result.Items[0].Street)
+	assert.Equal(t, "123", result.Items[0].StreetNumber)
+	assert.Equal(t, "Apto 101", result.Items[0].Complement)
+	assert.Equal(t, "São Paulo", result.Items[0].City)
+	assert.Equal(t, "SP", result.Items[0].State)
+	assert.Equal(t, "Brasil", result.Items[0].Country)
+	assert.Equal(t, "01234567", result.Items[0].PostalCode)
+	assert.True(t, result.Items[0].IsActive)
 	mockDB.AssertExpectations(t)
 	rows.AssertExpectations(t)
 }
@@ -152,10 +153,11 @@ func TestAddressFilter_Filter_WithAllFilters(t *testing.T) {
 
 	mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
 
+	mockDB.OnCount(0)
 	result, err := repo.Filter(ctx, f)
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
-	assert.Empty(t, result)
+	assert.Empty(t, result.Items)
 	mockDB.AssertExpectations(t)
 }
 
@@ -177,10 +179,11 @@ func TestAddressFilter_Filter_EmptyResult(t *testing.T) {
 
 	mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
 
+	mockDB.OnCount(0)
 	result, err := repo.Filter(ctx, f)
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
-	assert.Empty(t, result)
+	assert.Empty(t, result.Items)
 	mockDB.AssertExpectations(t)
 }
 
@@ -198,7 +201,7 @@ func TestAddressFilter_Filter_MultipleRows(t *testing.T) {
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-		mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything,
 	).Run(func(args mock.Arguments) {
 		if id, ok := args[0].(*int64); ok {
 			*id = 1
@@ -220,7 +223,7 @@ func TestAddressFilter_Filter_MultipleRows(t *testing.T) {
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-		mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything,
 	).Run(func(args mock.Arguments) {
 		if id, ok := args[0].(*int64); ok {
 			*id = 2
@@ -247,11 +250,12 @@ func TestAddressFilter_Filter_MultipleRows(t *testing.T) {
 
 	mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
 
+	mockDB.OnCount(2)
 	result, err := repo.Filter(ctx, f)
 	assert.NoError(t, err)
-	assert.Len(t, result, 2)
-	assert.Equal(t, "Rua 1", result[0].Street)
-	assert.Equal(t, "Rua 2", result[1].Street)
+	assert.Len(t, result.Items, 2)
+	assert.Equal(t, "Rua 1", result.Items[0].Street)
+	assert.Equal(t, "Rua 2", result.Items[1].Street)
 	mockDB.AssertExpectations(t)
 }
 
@@ -289,6 +293,7 @@ func TestAddressFilter_SortField_Valid(t *testing.T) {
 		).
 		Return(rows, nil)
 
+	mockDB.OnCount(0)
 	_, err := repo.Filter(ctx, f)
 	assert.NoError(t, err)
 }
@@ -323,6 +328,7 @@ func TestAddressFilter_SortField_Invalid(t *testing.T) {
 		).
 		Return(rows, nil)
 
+	mockDB.OnCount(0)
 	_, err := repo.Filter(ctx, f)
 	assert.NoError(t, err)
 }
@@ -357,6 +363,7 @@ func TestAddressFilter_SortOrder_Invalid(t *testing.T) {
 		).
 		Return(rows, nil)
 
+	mockDB.OnCount(0)
 	_, err := repo.Filter(ctx, f)
 	assert.NoError(t, err)
 }
@@ -391,6 +398,7 @@ func TestAddressFilter_SortOrder_Descending(t *testing.T) {
 		).
 		Return(rows, nil)
 
+	mockDB.OnCount(0)
 	_, err := repo.Filter(ctx, f)
 	assert.NoError(t, err)
 }
@@ -428,6 +436,7 @@ func TestAddressFilter_AllSortFields(t *testing.T) {
 				).
 				Return(rows, nil)
 
+			mockDB.OnCount(0)
 			_, err := repo.Filter(ctx, f)
 			assert.NoError(t, err)
 		})
@@ -465,6 +474,7 @@ func TestAddressFilter_CaseInsensitive(t *testing.T) {
 			).
 			Return(rows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, f)
 		assert.NoError(t, err)
 	})
@@ -489,6 +499,7 @@ func TestAddressFilter_CaseInsensitive(t *testing.T) {
 			).
 			Return(rows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, f)
 		assert.NoError(t, err)
 	})
@@ -530,7 +541,7 @@ func TestAddressFilter_ScanError(t *testing.T) {
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-		mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything,
 	).Return(errors.New("scan error"))
 	rows.On("Close").Return()
 
@@ -591,6 +602,7 @@ func TestAddressFilter_FindActive(t *testing.T) {
 		City: "São Paulo",
 	}
 
+	mockDB.OnCount(0)
 	result, err := repo.FindActive(ctx, filter)
 
 	assert.NoError(t, err)
@@ -617,6 +629,7 @@ func TestAddressFilter_FindByPostalCode_Success(t *testing.T) {
 	mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
 
 	t.Run("exact match true", func(t *testing.T) {
+		mockDB.OnCount(0)
 		result, err := repo.FindByPostalCode(ctx, "01234567", true)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -624,6 +637,7 @@ func TestAddressFilter_FindByPostalCode_Success(t *testing.T) {
 	})
 
 	t.Run("exact match false", func(t *testing.T) {
+		mockDB.OnCount(0)
 		result, err := repo.FindByPostalCode(ctx, "01234", false)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -631,6 +645,7 @@ func TestAddressFilter_FindByPostalCode_Success(t *testing.T) {
 	})
 
 	t.Run("CEP com formatação", func(t *testing.T) {
+		mockDB.OnCount(0)
 		result, err := repo.FindByPostalCode(ctx, "01234-567", true)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -696,6 +711,7 @@ func TestAddressFilter_FindByCityAndState_Success(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
+			mockDB.OnCount(0)
 			result, err := repo.FindByCityAndState(ctx, tc.city, tc.state)
 			assert.NoError(t, err)
 			assert.NotNil(t, result)
@@ -735,6 +751,7 @@ func TestAddressFilter_FindByPostalCodeV2_Success(t *testing.T) {
 
 	mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
 
+	mockDB.OnCount(0)
 	result, err := repo.FindByPostalCodeV2(ctx, "01234567", true)
 
 	assert.NoError(t, err)
@@ -774,6 +791,7 @@ func TestAddressFilter_FindByPostalCodeImproved_Success(t *testing.T) {
 	mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
 
 	t.Run("exact match true", func(t *testing.T) {
+		mockDB.OnCount(0)
 		result, err := repo.FindByPostalCodeImproved(ctx, "01234567", true)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -781,6 +799,7 @@ func TestAddressFilter_FindByPostalCodeImproved_Success(t *testing.T) {
 	})
 
 	t.Run("exact match false", func(t *testing.T) {
+		mockDB.OnCount(0)
 		result, err := repo.FindByPostalCodeImproved(ctx, "01234-567", false)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -873,6 +892,7 @@ func TestAddressFilter_LimitOffset(t *testing.T) {
 
 			mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
 
+			mockDB.OnCount(0)
 			_, err := repo.Filter(ctx, f)
 			assert.NoError(t, err)
 		})
@@ -925,3 +945,31 @@ func TestAddressAllowedSortFields(t *testing.T) {
 func boolPtr(b bool) *bool {
 	return &b
 }
+
+func TestAddressFilter_Filter_SearchTerm(t *testing.T) {
+	mockDB := new(mockDb.MockDatabase)
+	repo := &addressFilterRepo{db: mockDB}
+	ctx := context.Background()
+
+	mockRows := new(mockDb.MockRows)
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Err").Return(nil)
+	mockRows.On("Close").Return()
+
+	where := " WHERE 1=1 AND (street ILIKE $1 OR complement ILIKE $1 OR city ILIKE $1 OR postal_code ILIKE $1)"
+	args := []interface{}{`%50\%\_acme%`}
+
+	mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+		return strings.Contains(q, where+" ORDER BY")
+	}), args).Return(mockRows, nil)
+	mockDB.On("QueryRow", ctx, "SELECT COUNT(*) FROM addresses"+where, args).
+		Return(&mockDb.MockRow{Values: []interface{}{int64(0)}})
+
+	result, err := repo.Filter(ctx, &filterAddress.AddressFilter{
+		BaseFilter: filter.BaseFilter{Limit: 10, SearchTerm: " 50%_acme "},
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Items)
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,11 @@
+package repo
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+
+type auditRepo struct {
+	db repo.DBExecutor
+}
+
+func NewAudit(db repo.DBExecutor) Audit {
+	return &auditRepo{db: db}
+}
@@ -0,0 +1,16 @@
+package repo
+
+import (
+	"testing"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAudit(t *testing.T) {
+	mockDB := new(mockDb.MockDatabase)
+
+	result := NewAudit(mockDB)
+
+	assert.NotNil(t, result)
+}
@@ -0,0 +1,47 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *auditRepo) Create(ctx context.Context, entry *models.AuditLog) (*models.AuditLog, error) {
+	return insert(ctx, r.db, entry)
+}
+
+func (r *auditRepo) CreateTx(ctx context.Context, tx pgx.Tx, entry *models.AuditLog) (*models.AuditLog, error) {
+	return insert(ctx, tx, entry)
+}
+
+func insert(ctx context.Context, db repo.DBExecutor, entry *models.AuditLog) (*models.AuditLog, error) {
+	const query = `
+		INSERT INTO audit_log (actor_user_id, request_id, entity_type, entity_id, action, changes, created_at)
+		VALUES ($1, NULLIF($2, ''), $3, $4, $5, $6, NOW())
+		RETURNING id, created_at
+	`
+
+	changes, err := json.Marshal(entry.Changes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	err = db.QueryRow(ctx, query,
+		entry.ActorUserID,
+		entry.RequestID,
+		entry.EntityType,
+		entry.EntityID,
+		entry.Action,
+		changes,
+	).Scan(&entry.ID, &entry.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,108 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAudit_Create(t *testing.T) {
+	actor := int64(3)
+	entry := func() *models.AuditLog {
+		return &models.AuditLog{
+			ActorUserID: &actor,
+			RequestID:   "req-1",
+			EntityType:  "product",
+			EntityID:    10,
+			Action:      models.ActionUpdate,
+			Changes:     map[string]models.FieldChange{"SalePrice": {From: 10.0, To: 12.5}},
+		}
+	}
+
+	t.Run("successfully create audit entry", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &auditRepo{db: mockDB}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{
+			&actor, "req-1", "product", int64(10), models.ActionUpdate,
+			[]byte(`{"SalePrice":{"from":10,"to":12.5}}`),
+		}).Return(&mockDb.MockRow{Values: []interface{}{int64(99), now}})
+
+		result, err := repo.Create(ctx, entry())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(99), result.ID)
+		assert.Equal(t, now, result.CreatedAt)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return ErrCreate when insert fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &auditRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).
+			Return(&mockDb.MockRow{Err: errors.New("db fail")})
+
+		result, err := repo.Create(ctx, entry())
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+
+	t.Run("return ErrCreate when changes cannot be encoded", func(t *testing.T) {
+		repo := &auditRepo{db: new(mockDb.MockDatabase)}
+		bad := entry()
+		bad.Changes = map[string]models.FieldChange{"X": {To: make(chan int)}}
+
+		result, err := repo.Create(context.Background(), bad)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
+
+func TestAudit_CreateTx(t *testing.T) {
+	t.Run("insert runs inside the given transaction", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		mockTx := new(mockDb.MockTx)
+		repo := &auditRepo{db: mockDB}
+		ctx := context.Background()
+		now := time.Now()
+		entry := &models.AuditLog{EntityType: "sale", EntityID: 5, Action: "cancel"}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{
+			(*int64)(nil), "", "sale", int64(5), "cancel", []byte(`null`),
+		}).Return(&mockDb.MockRow{Values: []interface{}{int64(7), now}})
+
+		result, err := repo.CreateTx(ctx, mockTx, entry)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), result.ID)
+		mockTx.AssertExpectations(t)
+		mockDB.AssertNotCalled(t, "QueryRow", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("return ErrCreate when insert fails", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &auditRepo{db: new(mockDb.MockDatabase)}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).
+			Return(&mockDb.MockRow{Err: errors.New("db fail")})
+
+		result, err := repo.CreateTx(ctx, mockTx, &models.AuditLog{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
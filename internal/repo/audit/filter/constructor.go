@@ -0,0 +1,11 @@
+package repo
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+
+type auditFilterRepo struct {
+	db repo.DBExecutor
+}
+
+func NewFilterAudit(db repo.DBExecutor) AuditFilter {
+	return &auditFilterRepo{db: db}
+}
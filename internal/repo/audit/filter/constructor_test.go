@@ -0,0 +1,16 @@
+package repo
+
+import (
+	"testing"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFilterAudit(t *testing.T) {
+	mockDB := new(mockDb.MockDatabase)
+
+	result := NewFilterAudit(mockDB)
+
+	assert.NotNil(t, result)
+}
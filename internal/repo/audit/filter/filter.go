@@ -0,0 +1,123 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/filter"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+	pagination "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/pagination"
+)
+
+var allowedAuditSortFields = map[string]string{
+	"id":          "id",
+	"created_at":  "created_at",
+	"entity_type": "entity_type",
+	"action":      "action",
+}
+
+var auditOrderBy = builder.OrderBy{
+	Fields:       allowedAuditSortFields,
+	DefaultField: "id",
+	DefaultOrder: "desc",
+}
+
+func (r *auditFilterRepo) Filter(ctx context.Context, filter *filter.AuditFilter) (*commonFilter.Page[*model.AuditLog], error) {
+
+	base := filter.BaseFilter.WithDefaults()
+
+	query := `
+		SELECT
+			id,
+			actor_user_id,
+			COALESCE(request_id, ''),
+			entity_type,
+			entity_id,
+			action,
+			changes,
+			created_at
+		FROM audit_log
+	`
+
+	b := builder.NewQueryBuilderSql(query)
+
+	b.Eq("actor_user_id", filter.ActorUserID)
+	b.Eq("request_id", filter.RequestID)
+	b.Eq("entity_type", filter.EntityType)
+	b.Eq("entity_id", filter.EntityID)
+	b.Eq("action", filter.Action)
+	b.Range("created_at", filter.CreatedFrom, filter.CreatedTo)
+	b.Search(base.SearchTerm, "entity_type", "action", "request_id")
+
+	sortField, sortOrder := auditOrderBy.Resolve(filter.SortBy, filter.SortOrder)
+
+	args := b.GetArgs()
+	tail, pageArgs, err := pagination.Clause(base, sortField, sortOrder, b.NextArgPos())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, b.GetQuery()+tail, append(args, pageArgs...)...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	entries := make([]*model.AuditLog, 0)
+
+	for rows.Next() {
+		var (
+			e       model.AuditLog
+			changes []byte
+		)
+		if err := rows.Scan(
+			&e.ID,
+			&e.ActorUserID,
+			&e.RequestID,
+			&e.EntityType,
+			&e.EntityID,
+			&e.Action,
+			&changes,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		if len(changes) > 0 {
+			if err := json.Unmarshal(changes, &e.Changes); err != nil {
+				return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+			}
+		}
+		entries = append(entries, &e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	total, err := pagination.Count(ctx, r.db, "audit_log", b.Where(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonFilter.NewPage(entries, total, base, func(m *model.AuditLog) (any, int64) {
+		return auditSortValue(m, sortField), m.ID
+	}), nil
+}
+
+// auditSortValue devolve o valor da coluna de ordenação field no registro de auditoria, gravado
+// no cursor da próxima página.
+func auditSortValue(m *model.AuditLog, field string) any {
+	switch field {
+	case "created_at":
+		return m.CreatedAt
+	case "entity_type":
+		return m.EntityType
+	case "action":
+		return m.Action
+	}
+	return m.ID
+}
@@ -0,0 +1,170 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	filterAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/filter"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAudit_Filter(t *testing.T) {
+	t.Run("successfully filter by entity, newest first", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &auditFilterRepo{db: mockDB}
+		ctx := context.Background()
+		now := time.Now()
+		entityID := int64(10)
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []interface{}{int64(2), int64(3), "req-2", "product", int64(10), "update", []byte(`{"Stock":{"from":5,"to":4}}`), now}},
+			{Values: []interface{}{int64(1), nil, "", "product", int64(10), "create", []byte(`{}`), now}},
+		}}
+		mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "entity_type = $1") &&
+				strings.Contains(q, "entity_id = $2") &&
+				strings.Contains(q, "ORDER BY id desc LIMIT 50 OFFSET 0")
+		}), []interface{}{"product", int64(10)}).Return(mockRows, nil)
+
+		mockDB.OnCount(2)
+		result, err := repo.Filter(ctx, &filterAudit.AuditFilter{EntityType: "product", EntityID: &entityID})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, int64(3), *result.Items[0].ActorUserID)
+		assert.Equal(t, float64(4), result.Items[0].Changes["Stock"].To)
+		assert.Nil(t, result.Items[1].ActorUserID)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("apply every filter and explicit sort", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &auditFilterRepo{db: mockDB}
+		ctx := context.Background()
+		actor := int64(3)
+		from := time.Now().Add(-time.Hour)
+		to := time.Now()
+
+		mockRows := new(mockDb.MockRows)
+		mockRows.On("Next").Return(false)
+		mockRows.On("Close").Return()
+		mockRows.On("Err").Return(nil)
+		mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "actor_user_id = $1") &&
+				strings.Contains(q, "request_id = $2") &&
+				strings.Contains(q, "action = $3") &&
+				strings.Contains(q, "created_at >= $4") &&
+				strings.Contains(q, "created_at <= $5") &&
+				strings.Contains(q, "ORDER BY created_at asc, id asc LIMIT 10 OFFSET 20")
+		}), []interface{}{actor, "req-1", "delete", from, to}).Return(mockRows, nil)
+
+		mockDB.OnCount(0)
+		result, err := repo.Filter(ctx, &filterAudit.AuditFilter{
+			BaseFilter:  filter.BaseFilter{Limit: 10, Offset: 20, SortBy: "created_at", SortOrder: "asc"},
+			ActorUserID: &actor,
+			RequestID:   "req-1",
+			Action:      "delete",
+			CreatedFrom: &from,
+			CreatedTo:   &to,
+		})
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Items)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return ErrGet when query fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &auditFilterRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db fail"))
+
+		result, err := repo.Filter(ctx, &filterAudit.AuditFilter{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrScan when scan fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &auditFilterRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan failed")}}}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+
+		result, err := repo.Filter(ctx, &filterAudit.AuditFilter{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+
+	t.Run("return ErrScan when changes are not valid json", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &auditFilterRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []interface{}{int64(1), nil, "", "product", int64(10), "create", []byte(`{`), time.Now()}},
+		}}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+
+		result, err := repo.Filter(ctx, &filterAudit.AuditFilter{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+
+	t.Run("return ErrIterate when rows error occurs", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &auditFilterRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := new(mockDb.MockRows)
+		mockRows.On("Next").Return(false)
+		mockRows.On("Close").Return()
+		mockRows.On("Err").Return(errors.New("rows error"))
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+
+		result, err := repo.Filter(ctx, &filterAudit.AuditFilter{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrIterate)
+	})
+}
+
+func TestAudit_Filter_SearchTerm(t *testing.T) {
+	mockDB := new(mockDb.MockDatabase)
+	repo := &auditFilterRepo{db: mockDB}
+	ctx := context.Background()
+
+	mockRows := new(mockDb.MockRows)
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Err").Return(nil)
+	mockRows.On("Close").Return()
+
+	where := " WHERE 1=1 AND (entity_type ILIKE $1 OR action ILIKE $1 OR request_id ILIKE $1)"
+	args := []interface{}{`%50\%\_acme%`}
+
+	mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+		return strings.Contains(q, where+" ORDER BY")
+	}), args).Return(mockRows, nil)
+	mockDB.On("QueryRow", ctx, "SELECT COUNT(*) FROM audit_log"+where, args).
+		Return(&mockDb.MockRow{Values: []interface{}{int64(0)}})
+
+	result, err := repo.Filter(ctx, &filterAudit.AuditFilter{
+		BaseFilter: filter.BaseFilter{Limit: 10, SearchTerm: " 50%_acme "},
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Items)
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,7 @@
+package repo
+
+import iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/audit"
+
+type AuditFilter interface {
+	iface.AuditFilter
+}
@@ -0,0 +1,11 @@
+package repo
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+
+type cashSessionFilterRepo struct {
+	db repo.DBExecutor
+}
+
+func NewFilterCashSession(db repo.DBExecutor) CashSessionFilter {
+	return &cashSessionFilterRepo{db: db}
+}
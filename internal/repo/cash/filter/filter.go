@@ -0,0 +1,135 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/filter"
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+	pagination "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/pagination"
+)
+
+var allowedCashSessionSortFields = map[string]string{
+	"id":         "id",
+	"user_id":    "user_id",
+	"status":     "status",
+	"difference": "difference",
+	"opened_at":  "opened_at",
+	"closed_at":  "closed_at",
+}
+
+var cashSessionOrderBy = builder.OrderBy{
+	Fields:       allowedCashSessionSortFields,
+	DefaultField: "id",
+	DefaultOrder: "desc",
+}
+
+// resultExpr classifica a diferença do fechamento; é NULL em caixa aberto,
+// que assim nunca casa com o filtro de resultado.
+const resultExpr = `(CASE WHEN difference > 0 THEN 'over' WHEN difference < 0 THEN 'short' WHEN difference = 0 THEN 'even' END)`
+
+func (r *cashSessionFilterRepo) Filter(ctx context.Context, filter *filter.CashSessionFilter) (*commonFilter.Page[*model.CashSession], error) {
+
+	base := filter.BaseFilter.WithDefaults()
+
+	query := `
+		SELECT
+			id,
+			user_id,
+			status,
+			opening_amount,
+			expected_amount,
+			counted_amount,
+			difference,
+			COALESCE(notes, ''),
+			COALESCE(closing_notes, ''),
+			closed_by,
+			version,
+			opened_at,
+			updated_at,
+			closed_at
+		FROM cash_sessions
+	`
+
+	b := builder.NewQueryBuilderSql(query)
+
+	b.Eq("user_id", filter.UserID)
+	b.Eq("status", filter.Status)
+	b.Eq(resultExpr, filter.Result)
+	b.Range("opened_at", filter.OpenedFrom, filter.OpenedTo)
+	b.Range("closed_at", filter.ClosedFrom, filter.ClosedTo)
+	b.Search(base.SearchTerm, "notes", "closing_notes")
+
+	sortField, sortOrder := cashSessionOrderBy.Resolve(filter.SortBy, filter.SortOrder)
+
+	args := b.GetArgs()
+	tail, pageArgs, err := pagination.Clause(base, sortField, sortOrder, b.NextArgPos())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, b.GetQuery()+tail, append(args, pageArgs...)...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	sessions := make([]*model.CashSession, 0)
+
+	for rows.Next() {
+		var s model.CashSession
+		if err := rows.Scan(
+			&s.ID,
+			&s.UserID,
+			&s.Status,
+			&s.OpeningAmount,
+			&s.ExpectedAmount,
+			&s.CountedAmount,
+			&s.Difference,
+			&s.Notes,
+			&s.ClosingNotes,
+			&s.ClosedBy,
+			&s.Version,
+			&s.OpenedAt,
+			&s.UpdatedAt,
+			&s.ClosedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		sessions = append(sessions, &s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	total, err := pagination.Count(ctx, r.db, "cash_sessions", b.Where(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonFilter.NewPage(sessions, total, base, func(m *model.CashSession) (any, int64) {
+		return cashSessionSortValue(m, sortField), m.ID
+	}), nil
+}
+
+// cashSessionSortValue devolve o valor da coluna de ordenação field no caixa, gravado
+// no cursor da próxima página.
+func cashSessionSortValue(m *model.CashSession, field string) any {
+	switch field {
+	case "user_id":
+		return m.UserID
+	case "status":
+		return m.Status
+	case "difference":
+		return m.Difference
+	case "opened_at":
+		return m.OpenedAt
+	case "closed_at":
+		return m.ClosedAt
+	}
+	return m.ID
+}
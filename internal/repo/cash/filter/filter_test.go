@@ -0,0 +1,157 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	filterCash "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/filter"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCashSession_Filter(t *testing.T) {
+	t.Run("successfully filter by user and result", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &cashSessionFilterRepo{db: mockDB}
+		ctx := context.Background()
+		now := time.Now()
+		userID := int64(5)
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []interface{}{
+				int64(1), int64(5), "closed", money.New(100), money.New(130), money.New(128), money.New(-2),
+				"", "faltou troco", int64(5), 2, now, now, now,
+			}},
+		}}
+		mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "user_id = $1") &&
+				strings.Contains(q, "WHEN difference < 0 THEN 'short'") &&
+				strings.Contains(q, "END) = $2") &&
+				strings.Contains(q, "ORDER BY id desc LIMIT 50 OFFSET 0")
+		}), []interface{}{userID, "short"}).Return(mockRows, nil)
+
+		mockDB.OnCount(1)
+		result, err := repo.Filter(ctx, &filterCash.CashSessionFilter{UserID: &userID, Result: "short"})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Items, 1)
+		assert.Equal(t, money.New(-2), *result.Items[0].Difference)
+		assert.Equal(t, "faltou troco", result.Items[0].ClosingNotes)
+		assert.NotNil(t, result.Items[0].ClosedAt)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("apply every filter and explicit sort", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &cashSessionFilterRepo{db: mockDB}
+		ctx := context.Background()
+		from := time.Now().Add(-time.Hour)
+		to := time.Now()
+
+		mockRows := new(mockDb.MockRows)
+		mockRows.On("Next").Return(false)
+		mockRows.On("Close").Return()
+		mockRows.On("Err").Return(nil)
+		mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "status = $1") &&
+				strings.Contains(q, "opened_at >= $2") &&
+				strings.Contains(q, "opened_at <= $3") &&
+				strings.Contains(q, "closed_at >= $4") &&
+				strings.Contains(q, "closed_at <= $5") &&
+				strings.Contains(q, "ORDER BY difference asc, id asc LIMIT 10 OFFSET 20")
+		}), []interface{}{"closed", from, to, from, to}).Return(mockRows, nil)
+
+		mockDB.OnCount(0)
+		result, err := repo.Filter(ctx, &filterCash.CashSessionFilter{
+			BaseFilter: filter.BaseFilter{Limit: 10, Offset: 20, SortBy: "difference", SortOrder: "asc"},
+			Status:     "closed",
+			OpenedFrom: &from,
+			OpenedTo:   &to,
+			ClosedFrom: &from,
+			ClosedTo:   &to,
+		})
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Items)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return ErrGet when query fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &cashSessionFilterRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{}).Return(nil, errors.New("db down"))
+
+		result, err := repo.Filter(ctx, &filterCash.CashSessionFilter{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrScan when scan fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &cashSessionFilterRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan")}}}
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{}).Return(mockRows, nil)
+
+		result, err := repo.Filter(ctx, &filterCash.CashSessionFilter{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+
+	t.Run("return ErrIterate when rows fail", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &cashSessionFilterRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := new(mockDb.MockRows)
+		mockRows.On("Next").Return(false)
+		mockRows.On("Close").Return()
+		mockRows.On("Err").Return(errors.New("iter"))
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{}).Return(mockRows, nil)
+
+		result, err := repo.Filter(ctx, &filterCash.CashSessionFilter{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrIterate)
+	})
+}
+
+func TestCashSession_Filter_SearchTerm(t *testing.T) {
+	mockDB := new(mockDb.MockDatabase)
+	repo := &cashSessionFilterRepo{db: mockDB}
+	ctx := context.Background()
+
+	mockRows := new(mockDb.MockRows)
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Err").Return(nil)
+	mockRows.On("Close").Return()
+
+	where := " WHERE 1=1 AND (notes ILIKE $1 OR closing_notes ILIKE $1)"
+	args := []interface{}{`%50\%\_acme%`}
+
+	mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+		return strings.Contains(q, where+" ORDER BY")
+	}), args).Return(mockRows, nil)
+	mockDB.On("QueryRow", ctx, "SELECT COUNT(*) FROM cash_sessions"+where, args).
+		Return(&mockDb.MockRow{Values: []interface{}{int64(0)}})
+
+	result, err := repo.Filter(ctx, &filterCash.CashSessionFilter{
+		BaseFilter: filter.BaseFilter{Limit: 10, SearchTerm: " 50%_acme "},
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Items)
+	mockDB.AssertExpectations(t)
+}
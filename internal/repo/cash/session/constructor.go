@@ -0,0 +1,13 @@
+package repo
+
+import (
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+)
+
+type cashSessionRepo struct {
+	db repo.DBExecutor
+}
+
+func NewCashSession(db repo.DBExecutor) CashSession {
+	return &cashSessionRepo{db: db}
+}
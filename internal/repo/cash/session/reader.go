@@ -0,0 +1,90 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *cashSessionRepo) GetByID(ctx context.Context, id int64) (*models.CashSession, error) {
+	query := `SELECT ` + sessionColumns + ` FROM cash_sessions WHERE id = $1;`
+
+	var session models.CashSession
+	if err := scanSessionRow(r.db.QueryRow(ctx, query, id), &session); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return &session, nil
+}
+
+// GetOpenByUser devolve o caixa aberto do operador ou ErrCashSessionNotOpen.
+func (r *cashSessionRepo) GetOpenByUser(ctx context.Context, userID int64) (*models.CashSession, error) {
+	query := `SELECT ` + sessionColumns + ` FROM cash_sessions WHERE user_id = $1 AND status = 'open';`
+
+	var session models.CashSession
+	if err := scanSessionRow(r.db.QueryRow(ctx, query, userID), &session); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrCashSessionNotOpen
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return &session, nil
+}
+
+// Movements lista as sangrias, os suprimentos e as devoluções do caixa em
+// ordem de lançamento.
+func (r *cashSessionRepo) Movements(ctx context.Context, id int64) ([]*models.CashMovement, error) {
+	const query = `
+		SELECT id, cash_session_id, type, amount, COALESCE(reason, ''), user_id, sale_id, created_at
+		FROM cash_movements
+		WHERE cash_session_id = $1
+		ORDER BY created_at ASC, id ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	movements := make([]*models.CashMovement, 0)
+	for rows.Next() {
+		var m models.CashMovement
+		if err := rows.Scan(
+			&m.ID,
+			&m.CashSessionID,
+			&m.Type,
+			&m.Amount,
+			&m.Reason,
+			&m.UserID,
+			&m.SaleID,
+			&m.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		movements = append(movements, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return movements, nil
+}
+
+func (r *cashSessionRepo) Tenders(ctx context.Context, id int64) ([]*modelsPayment.MethodTotal, error) {
+	return tenders(ctx, r.db, id)
+}
+
+func (r *cashSessionRepo) MovementTotals(ctx context.Context, id int64) (*models.MovementTotals, error) {
+	return movementTotals(ctx, r.db, id)
+}
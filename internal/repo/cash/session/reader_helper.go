@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+const sessionColumns = `
+	id,
+	user_id,
+	status,
+	opening_amount,
+	expected_amount,
+	counted_amount,
+	difference,
+	COALESCE(notes, ''),
+	COALESCE(closing_notes, ''),
+	closed_by,
+	version,
+	opened_at,
+	updated_at,
+	closed_at
+`
+
+// querier é atendido tanto pelo pool quanto por pgx.Tx.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func scanSessionRow(row pgx.Row, s *models.CashSession) error {
+	return row.Scan(
+		&s.ID,
+		&s.UserID,
+		&s.Status,
+		&s.OpeningAmount,
+		&s.ExpectedAmount,
+		&s.CountedAmount,
+		&s.Difference,
+		&s.Notes,
+		&s.ClosingNotes,
+		&s.ClosedBy,
+		&s.Version,
+		&s.OpenedAt,
+		&s.UpdatedAt,
+		&s.ClosedAt,
+	)
+}
+
+// tenders soma os pagamentos recebidos no caixa por forma de pagamento,
+// qualquer que seja o status atual da venda: o dinheiro entrou na gaveta.
+// O que volta ao cliente no cancelamento ou na devolução é uma devolução
+// lançada em cash_movements, no caixa de quem a registrou.
+func tenders(ctx context.Context, q querier, id int64) ([]*modelsPayment.MethodTotal, error) {
+	const query = `
+		SELECT
+			p.method,
+			COUNT(DISTINCT p.sale_id),
+			COALESCE(SUM(p.amount - p.change_given), 0),
+			COALESCE(SUM(p.change_given), 0)
+		FROM sale_payments p
+		WHERE p.cash_session_id = $1
+		GROUP BY p.method
+		ORDER BY p.method ASC;
+	`
+
+	rows, err := q.Query(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	totals := make([]*modelsPayment.MethodTotal, 0)
+	for rows.Next() {
+		var t modelsPayment.MethodTotal
+		if err := rows.Scan(&t.Method, &t.Sales, &t.Amount, &t.ChangeGiven); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		totals = append(totals, &t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return totals, nil
+}
+
+func movementTotals(ctx context.Context, q querier, id int64) (*models.MovementTotals, error) {
+	const query = `
+		SELECT
+			COALESCE(SUM(amount) FILTER (WHERE type = 'deposit'), 0),
+			COALESCE(SUM(amount) FILTER (WHERE type = 'withdrawal'), 0),
+			COALESCE(SUM(amount) FILTER (WHERE type = 'refund'), 0)
+		FROM cash_movements
+		WHERE cash_session_id = $1;
+	`
+
+	var totals models.MovementTotals
+	if err := q.QueryRow(ctx, query, id).Scan(&totals.Deposits, &totals.Withdrawals, &totals.Refunds); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return &totals, nil
+}
@@ -0,0 +1,217 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func containsAll(parts ...string) any {
+	return mock.MatchedBy(func(q string) bool {
+		for _, p := range parts {
+			if !strings.Contains(q, p) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// sessionRow segue a ordem de sessionColumns.
+func sessionRow(id int64, status string, now time.Time) *mockDb.MockRow {
+	return &mockDb.MockRow{Values: []any{
+		id, int64(5), status, money.New(100), nil, nil, nil, "turno manhã", "", nil, 1, now, now, nil,
+	}}
+}
+
+func TestNewCashSession(t *testing.T) {
+	result := NewCashSession(nil)
+
+	assert.NotNil(t, result)
+	_, ok := result.(*cashSessionRepo)
+	assert.True(t, ok, "Expected result to be of type *cashSessionRepo")
+}
+
+func TestCashSessionRepo_GetByID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("retorna o caixa", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &cashSessionRepo{db: mockDB}
+
+		mockDB.On("QueryRow", ctx, containsAll("FROM cash_sessions", "id = $1"), []any{int64(1)}).
+			Return(sessionRow(1, models.StatusOpen, now))
+
+		session, err := repo.GetByID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), session.UserID)
+		assert.Equal(t, money.New(100), session.OpeningAmount)
+		assert.Equal(t, "turno manhã", session.Notes)
+		assert.Nil(t, session.CountedAmount)
+		assert.True(t, session.IsOpen())
+	})
+
+	t.Run("caixa inexistente", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &cashSessionRepo{db: mockDB}
+
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		_, err := repo.GetByID(ctx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &cashSessionRepo{db: mockDB}
+
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.GetByID(ctx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestCashSessionRepo_GetOpenByUser(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("retorna o caixa aberto do operador", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &cashSessionRepo{db: mockDB}
+
+		mockDB.On("QueryRow", ctx, containsAll("user_id = $1", "status = 'open'"), []any{int64(5)}).
+			Return(sessionRow(2, models.StatusOpen, time.Now()))
+
+		session, err := repo.GetOpenByUser(ctx, 5)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), session.ID)
+	})
+
+	t.Run("operador sem caixa aberto", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &cashSessionRepo{db: mockDB}
+
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		_, err := repo.GetOpenByUser(ctx, 5)
+
+		assert.ErrorIs(t, err, errMsg.ErrCashSessionNotOpen)
+	})
+}
+
+func TestCashSessionRepo_Movements(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("lista os movimentos", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &cashSessionRepo{db: mockDB}
+
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{int64(1), int64(2), models.MovementDeposit, money.New(50), "troco", int64(5), nil, now}},
+			{Values: []any{int64(2), int64(2), models.MovementWithdrawal, money.New(30), "", nil, nil, now}},
+			{Values: []any{int64(3), int64(2), models.MovementRefund, money.New(6), "devolução", int64(5), int64(8), now}},
+		}}
+		mockDB.On("Query", ctx, containsAll("FROM cash_movements"), []any{int64(2)}).Return(rows, nil)
+
+		movements, err := repo.Movements(ctx, 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, movements, 3)
+		assert.Equal(t, models.MovementDeposit, movements[0].Type)
+		assert.Equal(t, int64(5), *movements[0].UserID)
+		assert.Nil(t, movements[1].UserID)
+		assert.Nil(t, movements[1].SaleID)
+		assert.Equal(t, int64(8), *movements[2].SaleID)
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &cashSessionRepo{db: mockDB}
+
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.Movements(ctx, 2)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestCashSessionRepo_Tenders(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("soma os pagamentos recebidos no caixa por forma", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &cashSessionRepo{db: mockDB}
+
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{"card", 2, money.New(80), money.Zero}},
+			{Values: []any{"cash", 3, money.MustParse("57.30"), money.MustParse("2.70")}},
+		}}
+		mockDB.On("Query", ctx, containsAll("FROM sale_payments", "cash_session_id = $1"), []any{int64(2)}).
+			Return(rows, nil)
+
+		totals, err := repo.Tenders(ctx, 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, totals, 2)
+		assert.Equal(t, "cash", totals[1].Method)
+		assert.Equal(t, money.MustParse("2.70"), totals[1].ChangeGiven)
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &cashSessionRepo{db: mockDB}
+
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.Tenders(ctx, 2)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestCashSessionRepo_MovementTotals(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("soma suprimentos, sangrias e devoluções", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &cashSessionRepo{db: mockDB}
+
+		mockDB.On("QueryRow", ctx, containsAll("FROM cash_movements", "FILTER"), []any{int64(2)}).
+			Return(&mockDb.MockRow{Values: []any{money.New(20), money.New(50), money.New(6)}})
+
+		totals, err := repo.MovementTotals(ctx, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, money.New(20), totals.Deposits)
+		assert.Equal(t, money.New(50), totals.Withdrawals)
+		assert.Equal(t, money.New(6), totals.Refunds)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &cashSessionRepo{db: mockDB}
+
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.MovementTotals(ctx, 2)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
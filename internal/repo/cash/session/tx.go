@@ -0,0 +1,197 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/cash"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+type cashSessionTxRepo struct {
+	db repo.DBTransactor
+}
+
+func NewCashSessionTx(db repo.DBTransactor) iface.CashSessionTx {
+	return &cashSessionTxRepo{db: db}
+}
+
+func NewCashRefundTx(db repo.DBTransactor) iface.CashRefundTx {
+	return &cashSessionTxRepo{db: db}
+}
+
+func (r *cashSessionTxRepo) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return r.db.BeginTx(ctx, pgx.TxOptions{})
+}
+
+// CreateTx abre o caixa. O índice único parcial em user_id garante um só
+// caixa aberto por operador, mesmo com aberturas simultâneas.
+func (r *cashSessionTxRepo) CreateTx(ctx context.Context, tx pgx.Tx, session *models.CashSession) (*models.CashSession, error) {
+	const query = `
+		INSERT INTO cash_sessions (user_id, status, opening_amount, notes, opened_at, updated_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NOW(), NOW())
+		RETURNING id, version, opened_at, updated_at;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		session.UserID,
+		session.Status,
+		session.OpeningAmount,
+		session.Notes,
+	).Scan(&session.ID, &session.Version, &session.OpenedAt, &session.UpdatedAt)
+	if err != nil {
+		if ok, _ := errMsgPg.IsUniqueViolation(err); ok {
+			return nil, errMsg.ErrCashSessionAlreadyOpen
+		}
+		if errMsgPg.IsForeignKeyViolation(err) {
+			return nil, errMsg.ErrDBInvalidForeignKey
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	return session, nil
+}
+
+// GetByIDForUpdateTx bloqueia o caixa até o fim da transação. Vendas e
+// pagamentos tomam o caixa com FOR SHARE, então o fechamento espera as que
+// estão em andamento e impede novas.
+func (r *cashSessionTxRepo) GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.CashSession, error) {
+	query := `SELECT ` + sessionColumns + ` FROM cash_sessions WHERE id = $1 FOR UPDATE;`
+
+	var session models.CashSession
+	if err := scanSessionRow(tx.QueryRow(ctx, query, id), &session); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return &session, nil
+}
+
+func (r *cashSessionTxRepo) CreateMovementTx(ctx context.Context, tx pgx.Tx, movement *models.CashMovement) (*models.CashMovement, error) {
+	const query = `
+		INSERT INTO cash_movements (cash_session_id, type, amount, reason, user_id, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5, NOW())
+		RETURNING id, created_at;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		movement.CashSessionID,
+		movement.Type,
+		movement.Amount,
+		movement.Reason,
+		movement.UserID,
+	).Scan(&movement.ID, &movement.CreatedAt)
+	if err != nil {
+		if errMsgPg.IsForeignKeyViolation(err) {
+			return nil, errMsg.ErrDBInvalidForeignKey
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	return movement, nil
+}
+
+// CreateRefundTx lança a devolução no caixa aberto de movement.UserID, que é
+// de onde o dinheiro sai. Sem caixa aberto nada é inserido e a devolução é
+// recusada com ErrCashSessionNotOpen.
+func (r *cashSessionTxRepo) CreateRefundTx(ctx context.Context, tx pgx.Tx, movement *models.CashMovement) (*models.CashMovement, error) {
+	const query = `
+		INSERT INTO cash_movements (cash_session_id, type, amount, reason, user_id, sale_id, created_at)
+		SELECT cs.id, 'refund', $2, NULLIF($3, ''), $1, $4, NOW()
+		FROM cash_sessions cs
+		WHERE cs.user_id = $1
+		  AND cs.status = 'open'
+		FOR SHARE OF cs
+		RETURNING id, cash_session_id, created_at;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		movement.UserID,
+		movement.Amount,
+		movement.Reason,
+		movement.SaleID,
+	).Scan(&movement.ID, &movement.CashSessionID, &movement.CreatedAt)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, errMsg.ErrCashSessionNotOpen
+		case errMsgPg.IsForeignKeyViolation(err):
+			return nil, errMsg.ErrDBInvalidForeignKey
+		default:
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+		}
+	}
+
+	movement.Type = models.MovementRefund
+	return movement, nil
+}
+
+// RefundedTx soma o dinheiro já devolvido da venda, em qualquer caixa.
+func (r *cashSessionTxRepo) RefundedTx(ctx context.Context, tx pgx.Tx, saleID int64) (money.Money, error) {
+	const query = `
+		SELECT COALESCE(SUM(amount), 0)
+		FROM cash_movements
+		WHERE sale_id = $1
+		  AND type = 'refund';
+	`
+
+	var refunded money.Money
+	if err := tx.QueryRow(ctx, query, saleID).Scan(&refunded); err != nil {
+		return money.Zero, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return refunded, nil
+}
+
+func (r *cashSessionTxRepo) TendersTx(ctx context.Context, tx pgx.Tx, id int64) ([]*modelsPayment.MethodTotal, error) {
+	return tenders(ctx, tx, id)
+}
+
+func (r *cashSessionTxRepo) MovementTotalsTx(ctx context.Context, tx pgx.Tx, id int64) (*models.MovementTotals, error) {
+	return movementTotals(ctx, tx, id)
+}
+
+// CloseTx grava a conferência e encerra o caixa.
+func (r *cashSessionTxRepo) CloseTx(ctx context.Context, tx pgx.Tx, session *models.CashSession) error {
+	const query = `
+		UPDATE cash_sessions
+		SET status          = 'closed',
+		    expected_amount = $2,
+		    counted_amount  = $3,
+		    difference      = $4,
+		    closing_notes   = NULLIF($5, ''),
+		    closed_by       = $6,
+		    closed_at       = NOW(),
+		    version         = version + 1,
+		    updated_at      = NOW()
+		WHERE id = $1
+		  AND status = 'open'
+		RETURNING status, version, updated_at, closed_at;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		session.ID,
+		session.ExpectedAmount,
+		session.CountedAmount,
+		session.Difference,
+		session.ClosingNotes,
+		session.ClosedBy,
+	).Scan(&session.Status, &session.Version, &session.UpdatedAt, &session.ClosedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errMsg.ErrCashSessionClosed
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,317 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewCashSessionTx(t *testing.T) {
+	result := NewCashSessionTx(nil)
+
+	assert.NotNil(t, result)
+	_, ok := result.(*cashSessionTxRepo)
+	assert.True(t, ok, "Expected result to be of type *cashSessionTxRepo")
+}
+
+func TestCashSessionTx_BeginTx(t *testing.T) {
+	mockDB := new(mockDb.MockDBTransactor)
+	repo := &cashSessionTxRepo{db: mockDB}
+	ctx := context.Background()
+
+	mockTx := new(mockDb.MockTx)
+	mockDB.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+
+	tx, err := repo.BeginTx(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, mockTx, tx)
+}
+
+func TestCashSessionTx_CreateTx(t *testing.T) {
+	ctx := context.Background()
+	newSession := func() *models.CashSession {
+		return &models.CashSession{UserID: 5, Status: models.StatusOpen, OpeningAmount: money.New(100), Notes: "turno manhã"}
+	}
+	args := []any{int64(5), models.StatusOpen, money.New(100), "turno manhã"}
+
+	t.Run("abre o caixa", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &cashSessionTxRepo{}
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, containsAll("INSERT INTO cash_sessions"), args).
+			Return(&mockDb.MockRow{Values: []any{int64(1), 1, now, now}})
+
+		session, err := repo.CreateTx(ctx, mockTx, newSession())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), session.ID)
+		assert.Equal(t, now, session.OpenedAt)
+	})
+
+	t.Run("operador já tem caixa aberto", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &cashSessionTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23505", ConstraintName: "ux_cash_sessions_open_user"}})
+
+		_, err := repo.CreateTx(ctx, mockTx, newSession())
+
+		assert.ErrorIs(t, err, errMsg.ErrCashSessionAlreadyOpen)
+	})
+
+	t.Run("operador inexistente", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &cashSessionTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}})
+
+		_, err := repo.CreateTx(ctx, mockTx, newSession())
+
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &cashSessionTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.CreateTx(ctx, mockTx, newSession())
+
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
+
+func TestCashSessionTx_GetByIDForUpdateTx(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("bloqueia o caixa", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &cashSessionTxRepo{}
+
+		mockTx.On("QueryRow", ctx, containsAll("FOR UPDATE"), []any{int64(1)}).
+			Return(sessionRow(1, models.StatusOpen, time.Now()))
+
+		session, err := repo.GetByIDForUpdateTx(ctx, mockTx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), session.ID)
+	})
+
+	t.Run("caixa inexistente", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &cashSessionTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		_, err := repo.GetByIDForUpdateTx(ctx, mockTx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+}
+
+func TestCashSessionTx_CreateMovementTx(t *testing.T) {
+	ctx := context.Background()
+	user := int64(5)
+	newMovement := func() *models.CashMovement {
+		return &models.CashMovement{CashSessionID: 2, Type: models.MovementWithdrawal, Amount: money.New(30), Reason: "depósito bancário", UserID: &user}
+	}
+	args := []any{int64(2), models.MovementWithdrawal, money.New(30), "depósito bancário", &user}
+
+	t.Run("lança o movimento", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &cashSessionTxRepo{}
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, containsAll("INSERT INTO cash_movements"), args).
+			Return(&mockDb.MockRow{Values: []any{int64(9), now}})
+
+		movement, err := repo.CreateMovementTx(ctx, mockTx, newMovement())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9), movement.ID)
+		assert.Equal(t, now, movement.CreatedAt)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &cashSessionTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.CreateMovementTx(ctx, mockTx, newMovement())
+
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
+
+func TestCashSessionTx_Totals(t *testing.T) {
+	ctx := context.Background()
+	mockTx := new(mockDb.MockTx)
+	repo := &cashSessionTxRepo{}
+
+	rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Values: []any{"cash", 1, money.New(10), money.Zero}}}}
+	mockTx.On("Query", ctx, containsAll("FROM sale_payments"), []any{int64(2)}).Return(rows, nil)
+	mockTx.On("QueryRow", ctx, containsAll("FROM cash_movements"), []any{int64(2)}).
+		Return(&mockDb.MockRow{Values: []any{money.Zero, money.New(5), money.Zero}})
+
+	tenders, err := repo.TendersTx(ctx, mockTx, 2)
+	assert.NoError(t, err)
+	assert.Len(t, tenders, 1)
+
+	totals, err := repo.MovementTotalsTx(ctx, mockTx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, money.New(5), totals.Withdrawals)
+}
+
+func TestCashSessionTx_CreateRefundTx(t *testing.T) {
+	ctx := context.Background()
+	user, sale := int64(5), int64(8)
+	newRefund := func() *models.CashMovement {
+		return models.NewRefund(sale, &user, money.New(6), "devolução 1 da venda 8")
+	}
+	args := []any{&user, money.New(6), "devolução 1 da venda 8", &sale}
+
+	t.Run("lança no caixa aberto do operador", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &cashSessionTxRepo{}
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, containsAll("INSERT INTO cash_movements", "cs.status = 'open'", "FOR SHARE"), args).
+			Return(&mockDb.MockRow{Values: []any{int64(9), int64(2), now}})
+
+		movement, err := repo.CreateRefundTx(ctx, mockTx, newRefund())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9), movement.ID)
+		assert.Equal(t, int64(2), movement.CashSessionID)
+		assert.Equal(t, models.MovementRefund, movement.Type)
+	})
+
+	t.Run("operador sem caixa aberto", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &cashSessionTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		_, err := repo.CreateRefundTx(ctx, mockTx, newRefund())
+
+		assert.ErrorIs(t, err, errMsg.ErrCashSessionNotOpen)
+	})
+
+	t.Run("venda inexistente", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &cashSessionTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}})
+
+		_, err := repo.CreateRefundTx(ctx, mockTx, newRefund())
+
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &cashSessionTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.CreateRefundTx(ctx, mockTx, newRefund())
+
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
+
+func TestCashSessionTx_RefundedTx(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("soma as devoluções da venda", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := NewCashRefundTx(nil)
+
+		mockTx.On("QueryRow", ctx, containsAll("sale_id = $1", "type = 'refund'"), []any{int64(8)}).
+			Return(&mockDb.MockRow{Values: []any{money.New(6)}})
+
+		refunded, err := repo.RefundedTx(ctx, mockTx, 8)
+
+		assert.NoError(t, err)
+		assert.Equal(t, money.New(6), refunded)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := NewCashRefundTx(nil)
+
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.RefundedTx(ctx, mockTx, 8)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestCashSessionTx_CloseTx(t *testing.T) {
+	ctx := context.Background()
+	user := int64(5)
+	expected, counted, difference := money.New(130), money.New(128), money.New(-2)
+	newSession := func() *models.CashSession {
+		return &models.CashSession{
+			ID: 1, Status: models.StatusOpen,
+			ExpectedAmount: &expected, CountedAmount: &counted, Difference: &difference,
+			ClosingNotes: "faltou troco", ClosedBy: &user,
+		}
+	}
+	args := []any{int64(1), &expected, &counted, &difference, "faltou troco", &user}
+
+	t.Run("encerra o caixa", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &cashSessionTxRepo{}
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, containsAll("UPDATE cash_sessions", "status = 'open'"), args).
+			Return(&mockDb.MockRow{Values: []any{models.StatusClosed, 2, now, now}})
+
+		session := newSession()
+		err := repo.CloseTx(ctx, mockTx, session)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusClosed, session.Status)
+		assert.Equal(t, 2, session.Version)
+		assert.Equal(t, now, *session.ClosedAt)
+	})
+
+	t.Run("caixa já fechado", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &cashSessionTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.CloseTx(ctx, mockTx, newSession())
+
+		assert.ErrorIs(t, err, errMsg.ErrCashSessionClosed)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &cashSessionTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		err := repo.CloseTx(ctx, mockTx, newSession())
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
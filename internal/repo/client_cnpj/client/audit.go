@@ -0,0 +1,70 @@
+package repo
+
+import (
+	"context"
+
+	modelAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+)
+
+const auditEntity = "client_cnpj"
+
+// auditedClientCnpj registra na trilha de auditoria cada escrita feita pelo
+// repositório.
+type auditedClientCnpj struct {
+	ClientCnpj
+	rec audit.Recorder
+}
+
+func WithAudit(inner ClientCnpj, rec audit.Recorder) ClientCnpj {
+	return &auditedClientCnpj{ClientCnpj: inner, rec: rec}
+}
+
+func (r *auditedClientCnpj) load(ctx context.Context, id int64) (any, error) {
+	return r.ClientCnpj.GetByID(ctx, id)
+}
+
+func (r *auditedClientCnpj) track(ctx context.Context, id int64, action string, write func() error) error {
+	return audit.Track(ctx, r.rec, auditEntity, id, action, r.load, write)
+}
+
+func (r *auditedClientCnpj) Create(ctx context.Context, clientCnpj *models.ClientCnpj) (*models.ClientCnpj, error) {
+	created, err := r.ClientCnpj.Create(ctx, clientCnpj)
+	if err != nil {
+		return nil, err
+	}
+
+	r.rec.Record(ctx, audit.Entry{
+		EntityType: auditEntity,
+		EntityID:   created.ID,
+		Action:     modelAudit.ActionCreate,
+		After:      created,
+	})
+
+	return created, nil
+}
+
+func (r *auditedClientCnpj) Update(ctx context.Context, clientCnpj *models.ClientCnpj) error {
+	return r.track(ctx, clientCnpj.ID, modelAudit.ActionUpdate, func() error {
+		return r.ClientCnpj.Update(ctx, clientCnpj)
+	})
+}
+
+func (r *auditedClientCnpj) Delete(ctx context.Context, id int64) error {
+	return r.track(ctx, id, modelAudit.ActionDelete, func() error {
+		return r.ClientCnpj.Delete(ctx, id)
+	})
+}
+
+func (r *auditedClientCnpj) Enable(ctx context.Context, id int64) error {
+	return r.track(ctx, id, "enable", func() error {
+		return r.ClientCnpj.Enable(ctx, id)
+	})
+}
+
+func (r *auditedClientCnpj) Disable(ctx context.Context, id int64) error {
+	return r.track(ctx, id, "disable", func() error {
+		return r.ClientCnpj.Disable(ctx, id)
+	})
+}
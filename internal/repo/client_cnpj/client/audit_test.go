@@ -0,0 +1,51 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	mockAudit "github.com/WagaoCarvalho/backend_store_go/infra/mock/audit"
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cnpj"
+	modelAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditedClientCnpj(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("create records the new client", func(t *testing.T) {
+		inner := new(mockClient.MockClientCnpj)
+		rec := new(mockAudit.MockRecorder)
+		created := &models.ClientCnpj{ID: 3, Name: "Mercado Exemplo LTDA"}
+
+		inner.On("Create", ctx, mock.Anything).Return(created, nil).Once()
+		rec.On("Record", ctx, audit.Entry{EntityType: "client_cnpj", EntityID: 3, Action: modelAudit.ActionCreate, After: created}).Once()
+
+		_, err := WithAudit(inner, rec).Create(ctx, &models.ClientCnpj{Name: "Mercado Exemplo LTDA"})
+
+		assert.NoError(t, err)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("update records before and after", func(t *testing.T) {
+		inner := new(mockClient.MockClientCnpj)
+		rec := new(mockAudit.MockRecorder)
+		before := &models.ClientCnpj{ID: 3, Version: 1}
+		after := &models.ClientCnpj{ID: 3, Version: 2}
+		input := &models.ClientCnpj{ID: 3, Version: 1}
+
+		inner.On("GetByID", ctx, int64(3)).Return(before, nil).Once()
+		inner.On("Update", ctx, input).Return(nil).Once()
+		inner.On("GetByID", ctx, int64(3)).Return(after, nil).Once()
+		rec.On("Record", ctx, audit.Entry{EntityType: "client_cnpj", EntityID: 3, Action: modelAudit.ActionUpdate, Before: before, After: after}).Once()
+
+		err := WithAudit(inner, rec).Update(ctx, input)
+
+		assert.NoError(t, err)
+		inner.AssertExpectations(t)
+		rec.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,11 @@
+package repo
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+
+type clientCnpjRepo struct {
+	db repo.DBExecutor
+}
+
+func NewClientCnpjRepo(db repo.DBExecutor) ClientCnpj {
+	return &clientCnpjRepo{db: db}
+}
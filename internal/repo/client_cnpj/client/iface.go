@@ -0,0 +1,10 @@
+package repo
+
+import iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cnpj"
+
+type ClientCnpj interface {
+	iface.ClientCnpjReader
+	iface.ClientCnpjWriter
+	iface.ClientCnpjStatus
+	iface.ClientCnpjVersion
+}
@@ -0,0 +1,56 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *clientCnpjRepo) GetByID(ctx context.Context, id int64) (*models.ClientCnpj, error) {
+	const query = `
+		SELECT
+			id,
+			name,
+			COALESCE(trade_name, ''),
+			email,
+			cnpj,
+			COALESCE(state_registration, ''),
+			COALESCE(description, ''),
+			status,
+			version,
+			created_at,
+			updated_at
+		FROM clients_cnpj
+		WHERE id = $1
+		LIMIT 1
+	`
+
+	clientCnpj := &models.ClientCnpj{}
+
+	err := r.db.QueryRow(ctx, query, id).Scan(
+		&clientCnpj.ID,
+		&clientCnpj.Name,
+		&clientCnpj.TradeName,
+		&clientCnpj.Email,
+		&clientCnpj.CNPJ,
+		&clientCnpj.StateRegistration,
+		&clientCnpj.Description,
+		&clientCnpj.Status,
+		&clientCnpj.Version,
+		&clientCnpj.CreatedAt,
+		&clientCnpj.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return clientCnpj, nil
+}
@@ -0,0 +1,85 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewClientCnpjRepo(t *testing.T) {
+	mockDB := new(mockDb.MockDatabase)
+
+	instance1 := NewClientCnpjRepo(mockDB)
+	instance2 := NewClientCnpjRepo(mockDB)
+
+	assert.NotNil(t, instance1)
+	assert.NotSame(t, instance1, instance2)
+}
+
+func TestClientCnpjRepo_GetByID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successfully get client by id", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: mockDB}
+		now := time.Now()
+
+		mockRow := &mockDb.MockRow{
+			Values: []interface{}{
+				int64(1),
+				"Mercado Exemplo LTDA",
+				"Mercado Exemplo",
+				"compras@exemplo.com",
+				"12345678000195",
+				"ISENTO",
+				"descrição",
+				true,
+				2,
+				now,
+				now,
+			},
+		}
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).Return(mockRow)
+
+		result, err := repo.GetByID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Mercado Exemplo", result.TradeName)
+		assert.Equal(t, "12345678000195", result.CNPJ)
+		assert.Equal(t, "ISENTO", result.StateRegistration)
+		assert.Equal(t, 2, result.Version)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when client does not exist", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: mockDB}
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(999)}).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		result, err := repo.GetByID(ctx, 999)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return wrapped error on database failure", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: mockDB}
+		dbErr := errors.New("database failure")
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).Return(&mockDb.MockRow{Err: dbErr})
+
+		result, err := repo.GetByID(ctx, 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
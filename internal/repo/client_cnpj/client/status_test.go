@@ -0,0 +1,54 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClientCnpjRepo_Status(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("disable sets status false", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{false, int64(1)}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(1)}})
+
+		assert.NoError(t, repo.Disable(ctx, 1))
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("enable sets status true", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{true, int64(1)}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(1)}})
+
+		assert.NoError(t, repo.Enable(ctx, 1))
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when client does not exist", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		assert.ErrorIs(t, repo.Disable(ctx, 1), errMsg.ErrNotFound)
+	})
+
+	t.Run("return wrapped error on database failure", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: mockDB}
+		dbErr := errors.New("db down")
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: dbErr})
+
+		assert.ErrorIs(t, repo.Enable(ctx, 1), dbErr)
+	})
+}
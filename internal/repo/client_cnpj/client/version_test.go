@@ -0,0 +1,49 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClientCnpjRepo_GetVersionByID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).Return(&mockDb.MockRow{Values: []interface{}{3}})
+
+		version, err := repo.GetVersionByID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, version)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		_, err := repo.GetVersionByID(ctx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: mockDB}
+		dbErr := errors.New("db down")
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).Return(&mockDb.MockRow{Err: dbErr})
+
+		_, err := repo.GetVersionByID(ctx, 1)
+
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
@@ -0,0 +1,121 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *clientCnpjRepo) Create(ctx context.Context, clientCnpj *models.ClientCnpj) (*models.ClientCnpj, error) {
+	const query = `
+		INSERT INTO clients_cnpj (name, trade_name, email, cnpj, state_registration, description, status, version)
+		VALUES ($1, NULLIF($2, ''), $3, $4, NULLIF($5, ''), $6, $7, $8)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRow(ctx, query,
+		clientCnpj.Name,
+		clientCnpj.TradeName,
+		clientCnpj.Email,
+		clientCnpj.CNPJ,
+		clientCnpj.StateRegistration,
+		clientCnpj.Description,
+		clientCnpj.Status,
+		clientCnpj.Version,
+	).Scan(&clientCnpj.ID, &clientCnpj.CreatedAt, &clientCnpj.UpdatedAt)
+
+	if err != nil {
+		if ok, constraint := errMsgPg.IsUniqueViolation(err); ok {
+			return nil, fmt.Errorf("%w: %s", errMsg.ErrDuplicate, constraint)
+		}
+
+		if errMsgPg.IsCheckViolation(err) {
+			return nil, errMsg.ErrInvalidData
+		}
+
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	return clientCnpj, nil
+}
+
+func (r *clientCnpjRepo) Update(ctx context.Context, clientCnpj *models.ClientCnpj) error {
+	const querySelect = `
+		SELECT version
+		FROM clients_cnpj
+		WHERE id = $1
+	`
+
+	var currentVersion int
+	err := r.db.QueryRow(ctx, querySelect, clientCnpj.ID).Scan(&currentVersion)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return errMsg.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	if currentVersion != clientCnpj.Version {
+		return errMsg.ErrVersionConflict
+	}
+
+	const queryUpdate = `
+		UPDATE clients_cnpj
+		SET
+			name = $1,
+			trade_name = NULLIF($2, ''),
+			email = $3,
+			cnpj = $4,
+			state_registration = NULLIF($5, ''),
+			status = $6,
+			description = $7,
+			version = version + 1,
+			updated_at = NOW()
+		WHERE id = $8
+		RETURNING updated_at, version
+	`
+
+	err = r.db.QueryRow(ctx, queryUpdate,
+		clientCnpj.Name,
+		clientCnpj.TradeName,
+		clientCnpj.Email,
+		clientCnpj.CNPJ,
+		clientCnpj.StateRegistration,
+		clientCnpj.Status,
+		clientCnpj.Description,
+		clientCnpj.ID,
+	).Scan(&clientCnpj.UpdatedAt, &clientCnpj.Version)
+
+	if err != nil {
+		if ok, _ := errMsgPg.IsUniqueViolation(err); ok {
+			return errMsg.ErrDuplicate
+		}
+		if errMsgPg.IsCheckViolation(err) {
+			return errMsg.ErrInvalidData
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	return nil
+}
+
+func (r *clientCnpjRepo) Delete(ctx context.Context, id int64) error {
+	const query = `DELETE FROM clients_cnpj WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrDelete, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return errMsg.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newClientCnpj() *models.ClientCnpj {
+	return &models.ClientCnpj{
+		ID:        1,
+		Name:      "Mercado Exemplo LTDA",
+		TradeName: "Mercado Exemplo",
+		Email:     "compras@exemplo.com",
+		CNPJ:      "12345678000195",
+		Status:    true,
+		Version:   1,
+	}
+}
+
+func TestClientCnpjRepo_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		db := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: db}
+		now := time.Now()
+		client := newClientCnpj()
+
+		db.On("QueryRow", ctx, mock.Anything, []interface{}{
+			client.Name, client.TradeName, client.Email, client.CNPJ, client.StateRegistration,
+			client.Description, client.Status, client.Version,
+		}).Return(&mockDb.MockRowWithID{IDValue: 10, TimeValue: now})
+
+		res, err := repo.Create(ctx, client)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), res.ID)
+		assert.Equal(t, now, res.CreatedAt)
+	})
+
+	t.Run("unique violation", func(t *testing.T) {
+		db := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: db}
+		db.On("QueryRow", ctx, mock.Anything, mock.Anything).
+			Return(&mockDb.MockRow{Err: errMsgPg.NewUniqueViolation("uq_clients_cnpj_cnpj")})
+
+		res, err := repo.Create(ctx, newClientCnpj())
+
+		assert.Nil(t, res)
+		assert.ErrorIs(t, err, errMsg.ErrDuplicate)
+		assert.ErrorContains(t, err, "uq_clients_cnpj_cnpj")
+	})
+
+	t.Run("check violation", func(t *testing.T) {
+		db := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: db}
+		db.On("QueryRow", ctx, mock.Anything, mock.Anything).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23514"}})
+
+		res, err := repo.Create(ctx, newClientCnpj())
+
+		assert.Nil(t, res)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("generic error", func(t *testing.T) {
+		db := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: db}
+		db.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		res, err := repo.Create(ctx, newClientCnpj())
+
+		assert.Nil(t, res)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
+
+func TestClientCnpjRepo_Update(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		db := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: db}
+		client := newClientCnpj()
+		now := time.Now()
+
+		db.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Values: []interface{}{1}}).Once()
+		db.On("QueryRow", ctx, mock.Anything, mock.Anything).
+			Return(&mockDb.MockRow{Values: []interface{}{now, 2}}).Once()
+
+		err := repo.Update(ctx, client)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, client.Version)
+		assert.Equal(t, now, client.UpdatedAt)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: db}
+		db.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		assert.ErrorIs(t, repo.Update(ctx, newClientCnpj()), errMsg.ErrNotFound)
+	})
+
+	t.Run("version select error", func(t *testing.T) {
+		db := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: db}
+		db.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		assert.ErrorIs(t, repo.Update(ctx, newClientCnpj()), errMsg.ErrUpdate)
+	})
+
+	t.Run("version conflict", func(t *testing.T) {
+		db := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: db}
+		db.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Values: []interface{}{5}})
+
+		assert.ErrorIs(t, repo.Update(ctx, newClientCnpj()), errMsg.ErrVersionConflict)
+	})
+
+	cases := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"duplicate", errMsgPg.NewUniqueViolation("uq_clients_cnpj_email"), errMsg.ErrDuplicate},
+		{"check violation", errMsgPg.NewCheckViolation("chk_clients_cnpj_cnpj_format"), errMsg.ErrInvalidData},
+		{"generic error", errors.New("db down"), errMsg.ErrUpdate},
+	}
+	for _, tc := range cases {
+		t.Run("update "+tc.name, func(t *testing.T) {
+			db := new(mockDb.MockDatabase)
+			repo := &clientCnpjRepo{db: db}
+			db.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+				Return(&mockDb.MockRow{Values: []interface{}{1}}).Once()
+			db.On("QueryRow", ctx, mock.Anything, mock.Anything).
+				Return(&mockDb.MockRow{Err: tc.err}).Once()
+
+			assert.ErrorIs(t, repo.Update(ctx, newClientCnpj()), tc.want)
+		})
+	}
+}
+
+func TestClientCnpjRepo_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		db := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: db}
+		db.On("Exec", ctx, mock.Anything, []interface{}{int64(1)}).Return(pgconn.NewCommandTag("DELETE 1"), nil)
+
+		assert.NoError(t, repo.Delete(ctx, 1))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: db}
+		db.On("Exec", ctx, mock.Anything, []interface{}{int64(1)}).Return(pgconn.NewCommandTag("DELETE 0"), nil)
+
+		assert.ErrorIs(t, repo.Delete(ctx, 1), errMsg.ErrNotFound)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		db := new(mockDb.MockDatabase)
+		repo := &clientCnpjRepo{db: db}
+		db.On("Exec", ctx, mock.Anything, []interface{}{int64(1)}).Return(pgconn.CommandTag{}, errors.New("db down"))
+
+		assert.ErrorIs(t, repo.Delete(ctx, 1), errMsg.ErrDelete)
+	})
+}
@@ -0,0 +1,30 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *clientCnpjContactRelationRepo) HasClientCnpjContactRelation(ctx context.Context, clientCnpjID, contactID int64) (bool, error) {
+	const query = `
+		SELECT 1
+		FROM clients_cnpj_contact_relations
+		WHERE client_cnpj_id = $1 AND contact_id = $2
+		LIMIT 1;
+	`
+
+	var dummy int
+	err := r.db.QueryRow(ctx, query, clientCnpjID, contactID).Scan(&dummy)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,11 @@
+package repo
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+
+type clientCnpjContactRelationRepo struct {
+	db repo.DBExecutor
+}
+
+func NewClientCnpjContactRelation(db repo.DBExecutor) ClientCnpjContactRelation {
+	return &clientCnpjContactRelationRepo{db: db}
+}
@@ -0,0 +1,169 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/contact_relation"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewClientCnpjContactRelation(t *testing.T) {
+	assert.NotNil(t, NewClientCnpjContactRelation(new(mockDb.MockDatabase)))
+}
+
+func TestClientCnpjContactRelationRepo_Create(t *testing.T) {
+	ctx := context.Background()
+	relation := &models.ClientCnpjContactRelation{ClientCnpjID: 1, ContactID: 2}
+
+	t.Run("success", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjContactRelationRepo{db: mockDB}
+		mockDB.On("Exec", ctx, mock.Anything, []interface{}{int64(1), int64(2)}).Return(pgconn.NewCommandTag("INSERT 0 1"), nil)
+
+		result, err := repo.Create(ctx, relation)
+
+		assert.NoError(t, err)
+		assert.Equal(t, relation, result)
+	})
+
+	cases := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"duplicate", errMsgPg.NewUniqueViolation("pk_clients_cnpj_contact_relations"), errMsg.ErrRelationExists},
+		{"foreign key", errMsgPg.NewForeignKeyViolation("fk_clients_cnpj_contact_relations_contact"), errMsg.ErrDBInvalidForeignKey},
+		{"generic", errors.New("db down"), errMsg.ErrCreate},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDB := new(mockDb.MockDatabase)
+			repo := &clientCnpjContactRelationRepo{db: mockDB}
+			mockDB.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag{}, tc.err)
+
+			result, err := repo.Create(ctx, relation)
+
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
+
+func TestClientCnpjContactRelationRepo_Reader(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("get all relations", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjContactRelationRepo{db: mockDB}
+		now := time.Now()
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{int64(1), int64(2), now}},
+			{Values: []any{int64(1), int64(3), now}},
+		}}
+		mockDB.On("Query", ctx, mock.Anything, []any{int64(1)}).Return(mockRows, nil)
+
+		result, err := repo.GetAllRelationsByClientCnpjID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, int64(3), result[1].ContactID)
+	})
+
+	t.Run("get all relations query error", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjContactRelationRepo{db: mockDB}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.GetAllRelationsByClientCnpjID(ctx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("get all relations scan error", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjContactRelationRepo{db: mockDB}
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan")}}}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+
+		_, err := repo.GetAllRelationsByClientCnpjID(ctx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+
+	t.Run("has relation", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjContactRelationRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), int64(2)}).Return(&mockDb.MockRow{Values: []interface{}{1}})
+
+		exists, err := repo.HasClientCnpjContactRelation(ctx, 1, 2)
+
+		assert.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("has no relation", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjContactRelationRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		exists, err := repo.HasClientCnpjContactRelation(ctx, 1, 2)
+
+		assert.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("has relation error", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjContactRelationRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.HasClientCnpjContactRelation(ctx, 1, 2)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestClientCnpjContactRelationRepo_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("delete one", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjContactRelationRepo{db: mockDB}
+		mockDB.On("Exec", ctx, mock.Anything, []interface{}{int64(1), int64(2)}).Return(pgconn.NewCommandTag("DELETE 1"), nil)
+
+		assert.NoError(t, repo.Delete(ctx, 1, 2))
+	})
+
+	t.Run("delete one error", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjContactRelationRepo{db: mockDB}
+		mockDB.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag{}, errors.New("db down"))
+
+		assert.ErrorIs(t, repo.Delete(ctx, 1, 2), errMsg.ErrDelete)
+	})
+
+	t.Run("delete all", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjContactRelationRepo{db: mockDB}
+		mockDB.On("Exec", ctx, mock.Anything, []interface{}{int64(1)}).Return(pgconn.NewCommandTag("DELETE 2"), nil)
+
+		assert.NoError(t, repo.DeleteAll(ctx, 1))
+	})
+
+	t.Run("delete all error", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjContactRelationRepo{db: mockDB}
+		mockDB.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag{}, errors.New("db down"))
+
+		assert.ErrorIs(t, repo.DeleteAll(ctx, 1), errMsg.ErrDelete)
+	})
+}
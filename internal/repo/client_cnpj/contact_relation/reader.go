@@ -0,0 +1,38 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/contact_relation"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (r *clientCnpjContactRelationRepo) GetAllRelationsByClientCnpjID(ctx context.Context, clientCnpjID int64) ([]*models.ClientCnpjContactRelation, error) {
+	const query = `
+		SELECT client_cnpj_id, contact_id, created_at
+		FROM clients_cnpj_contact_relations
+		WHERE client_cnpj_id = $1;
+	`
+
+	rows, err := r.db.Query(ctx, query, clientCnpjID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	var relations []*models.ClientCnpjContactRelation
+	for rows.Next() {
+		var rel models.ClientCnpjContactRelation
+		if err := rows.Scan(&rel.ClientCnpjID, &rel.ContactID, &rel.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		relations = append(relations, &rel)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return relations, nil
+}
@@ -0,0 +1,59 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/contact_relation"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (r *clientCnpjContactRelationRepo) Create(ctx context.Context, relation *models.ClientCnpjContactRelation) (*models.ClientCnpjContactRelation, error) {
+	const query = `
+		INSERT INTO clients_cnpj_contact_relations (client_cnpj_id, contact_id, created_at)
+		VALUES ($1, $2, NOW());
+	`
+
+	_, err := r.db.Exec(ctx, query, relation.ClientCnpjID, relation.ContactID)
+	if err != nil {
+		switch {
+		case errMsgPg.IsDuplicateKey(err):
+			return nil, errMsg.ErrRelationExists
+		case errMsgPg.IsForeignKeyViolation(err):
+			return nil, errMsg.ErrDBInvalidForeignKey
+		default:
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+		}
+	}
+
+	return relation, nil
+}
+
+func (r *clientCnpjContactRelationRepo) Delete(ctx context.Context, clientCnpjID, contactID int64) error {
+	const query = `
+		DELETE FROM clients_cnpj_contact_relations
+		WHERE client_cnpj_id = $1 AND contact_id = $2;
+	`
+
+	_, err := r.db.Exec(ctx, query, clientCnpjID, contactID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrDelete, err)
+	}
+
+	return nil
+}
+
+func (r *clientCnpjContactRelationRepo) DeleteAll(ctx context.Context, clientCnpjID int64) error {
+	const query = `
+		DELETE FROM clients_cnpj_contact_relations
+		WHERE client_cnpj_id = $1;
+	`
+
+	_, err := r.db.Exec(ctx, query, clientCnpjID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrDelete, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package repo
+
+import (
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cpf"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	credit "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/credit"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+)
+
+const auditEntity = "client_cnpj_credit"
+
+var tables = credit.NewTables("clients_cnpj_credits", "clients_cnpj_credit_entries", "client_cnpj_id", "clients_cnpj")
+
+// NewClientCnpjCredit opera o crediário dos clientes pessoa jurídica.
+func NewClientCnpjCredit(db repo.DBExecutor) credit.ClientCredit {
+	return credit.NewClientCredit(db, tables)
+}
+
+// NewClientCnpjCreditTx movimenta o crediário dos clientes pessoa jurídica.
+func NewClientCnpjCreditTx(db repo.DBTransactor) iface.ClientCreditTx {
+	return credit.NewClientCreditTx(db, tables)
+}
+
+// WithAuditTx audita o crediário dos clientes pessoa jurídica.
+func WithAuditTx(inner iface.ClientCreditTx, rec audit.Recorder) iface.ClientCreditTx {
+	return credit.WithAuditTx(inner, rec, auditEntity)
+}
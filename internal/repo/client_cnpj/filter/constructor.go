@@ -0,0 +1,11 @@
+package repo
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+
+type clientCnpjFilterRepo struct {
+	db repo.DBExecutor
+}
+
+func NewFilterClientCnpj(db repo.DBExecutor) ClientCnpjFilter {
+	return &clientCnpjFilterRepo{db: db}
+}
@@ -0,0 +1,147 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/filter"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+	pagination "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/pagination"
+)
+
+var clientCnpjAllowedSortFields = map[string]string{
+	"id":         "id",
+	"name":       "name",
+	"trade_name": "trade_name",
+	"email":      "email",
+	"cnpj":       "cnpj",
+	"status":     "status",
+	"version":    "version",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+}
+
+var clientCnpjOrderBy = builder.OrderBy{
+	Fields:       clientCnpjAllowedSortFields,
+	DefaultField: "created_at",
+	DefaultOrder: "asc",
+}
+
+var cnpjFormatting = strings.NewReplacer(".", "", "/", "", "-", "")
+
+func (r *clientCnpjFilterRepo) Filter(
+	ctx context.Context,
+	filter *filter.ClientCnpjFilter,
+) (*commonFilter.Page[*model.ClientCnpj], error) {
+
+	base := filter.BaseFilter.WithDefaults()
+
+	query := `
+		SELECT
+			id,
+			name,
+			COALESCE(trade_name, ''),
+			email,
+			cnpj,
+			COALESCE(state_registration, ''),
+			COALESCE(description, ''),
+			status,
+			version,
+			created_at,
+			updated_at
+		FROM clients_cnpj
+	`
+
+	b := builder.NewQueryBuilderSql(query)
+
+	// Filtros de texto (busca parcial com ILIKE)
+	b.ILike("name", filter.Name)
+	b.ILike("trade_name", filter.TradeName)
+	b.ILike("email", filter.Email)
+
+	// O CNPJ é gravado só com dígitos; a pontuação do filtro é descartada
+	b.Eq("cnpj", cnpjFormatting.Replace(filter.CNPJ))
+
+	b.Eq("status", filter.Status)
+	b.Eq("version", filter.Version)
+	b.Range("created_at", filter.CreatedFrom, filter.CreatedTo)
+	b.Range("updated_at", filter.UpdatedFrom, filter.UpdatedTo)
+	b.Search(base.SearchTerm, "name", "trade_name", "email", "cnpj")
+
+	sortField, sortOrder := clientCnpjOrderBy.Resolve(filter.SortBy, filter.SortOrder)
+
+	args := b.GetArgs()
+	tail, pageArgs, err := pagination.Clause(base, sortField, sortOrder, b.NextArgPos())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, b.GetQuery()+tail, append(args, pageArgs...)...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	clients := make([]*model.ClientCnpj, 0)
+
+	for rows.Next() {
+		var c model.ClientCnpj
+		if err := rows.Scan(
+			&c.ID,
+			&c.Name,
+			&c.TradeName,
+			&c.Email,
+			&c.CNPJ,
+			&c.StateRegistration,
+			&c.Description,
+			&c.Status,
+			&c.Version,
+			&c.CreatedAt,
+			&c.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		clients = append(clients, &c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	total, err := pagination.Count(ctx, r.db, "clients_cnpj", b.Where(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonFilter.NewPage(clients, total, base, func(m *model.ClientCnpj) (any, int64) {
+		return clientCnpjSortValue(m, sortField), m.ID
+	}), nil
+}
+
+// clientCnpjSortValue devolve o valor da coluna de ordenação field no cliente, gravado
+// no cursor da próxima página.
+func clientCnpjSortValue(m *model.ClientCnpj, field string) any {
+	switch field {
+	case "name":
+		return m.Name
+	case "trade_name":
+		return m.TradeName
+	case "email":
+		return m.Email
+	case "cnpj":
+		return m.CNPJ
+	case "status":
+		return m.Status
+	case "version":
+		return m.Version
+	case "created_at":
+		return m.CreatedAt
+	case "updated_at":
+		return m.UpdatedAt
+	}
+	return m.ID
+}
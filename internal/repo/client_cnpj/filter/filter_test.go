@@ -0,0 +1,154 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/filter"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewFilterClientCnpj(t *testing.T) {
+	assert.NotNil(t, NewFilterClientCnpj(new(mockDb.MockDatabase)))
+}
+
+func TestClientCnpjFilterRepo_Filter(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("applies filters and strips CNPJ formatting", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjFilterRepo{db: mockDB}
+		status := true
+		f := &modelFilter.ClientCnpjFilter{
+			BaseFilter: commonFilter.BaseFilter{Limit: 10, SortBy: "trade_name", SortOrder: "desc"},
+			Name:       "Mercado",
+			TradeName:  "Exemplo",
+			CNPJ:       "12.345.678/0001-95",
+			Status:     &status,
+		}
+
+		mockRows := &mockDb.MockRows{
+			Rows: []*mockDb.MockRow{
+				{Values: []any{int64(1), "Mercado Exemplo LTDA", "Exemplo", "a@b.com", "12345678000195", "", "", true, 1, now, now}},
+			},
+		}
+		mockDB.On("Query", ctx,
+			mock.MatchedBy(func(q string) bool {
+				return assert.Contains(t, q, "trade_name ILIKE") &&
+					assert.Contains(t, q, "cnpj = $3") &&
+					assert.Contains(t, q, "ORDER BY trade_name desc")
+			}),
+			[]any{"%Mercado%", "%Exemplo%", "12345678000195", true},
+		).Return(mockRows, nil)
+
+		mockDB.OnCount(1)
+		result, err := repo.Filter(ctx, f)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Items, 1)
+		assert.Equal(t, "Exemplo", result.Items[0].TradeName)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("applies date and version filters with default sort", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjFilterRepo{db: mockDB}
+		version := 2
+		f := &modelFilter.ClientCnpjFilter{
+			BaseFilter:  commonFilter.BaseFilter{Limit: 5, SortBy: "invalid"},
+			Email:       "exemplo",
+			Version:     &version,
+			CreatedFrom: &now,
+			CreatedTo:   &now,
+			UpdatedFrom: &now,
+			UpdatedTo:   &now,
+		}
+
+		mockRows := new(mockDb.MockRows)
+		mockRows.On("Next").Return(false)
+		mockRows.On("Close").Return()
+		mockRows.On("Err").Return(nil)
+		mockDB.On("Query", ctx,
+			mock.MatchedBy(func(q string) bool { return assert.Contains(t, q, "ORDER BY created_at asc") }),
+			[]any{"%exemplo%", 2, now, now, now, now},
+		).Return(mockRows, nil)
+
+		mockDB.OnCount(0)
+		result, err := repo.Filter(ctx, f)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Empty(t, result.Items)
+	})
+
+	t.Run("return ErrGet when query fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjFilterRepo{db: mockDB}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.Filter(ctx, &modelFilter.ClientCnpjFilter{})
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrScan when scan fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjFilterRepo{db: mockDB}
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan")}}}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+
+		_, err := repo.Filter(ctx, &modelFilter.ClientCnpjFilter{})
+
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+
+	t.Run("return ErrIterate on rows error", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCnpjFilterRepo{db: mockDB}
+		mockRows := new(mockDb.MockRows)
+		mockRows.On("Next").Return(false)
+		mockRows.On("Close").Return()
+		mockRows.On("Err").Return(errors.New("iterate"))
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+
+		_, err := repo.Filter(ctx, &modelFilter.ClientCnpjFilter{})
+
+		assert.ErrorIs(t, err, errMsg.ErrIterate)
+	})
+}
+
+func TestClientCnpjFilterRepo_Filter_SearchTerm(t *testing.T) {
+	mockDB := new(mockDb.MockDatabase)
+	repo := &clientCnpjFilterRepo{db: mockDB}
+	ctx := context.Background()
+
+	mockRows := new(mockDb.MockRows)
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Err").Return(nil)
+	mockRows.On("Close").Return()
+
+	where := " WHERE 1=1 AND (name ILIKE $1 OR trade_name ILIKE $1 OR email ILIKE $1 OR cnpj ILIKE $1)"
+	args := []interface{}{`%50\%\_acme%`}
+
+	mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+		return strings.Contains(q, where+" ORDER BY")
+	}), args).Return(mockRows, nil)
+	mockDB.On("QueryRow", ctx, "SELECT COUNT(*) FROM clients_cnpj"+where, args).
+		Return(&mockDb.MockRow{Values: []interface{}{int64(0)}})
+
+	result, err := repo.Filter(ctx, &modelFilter.ClientCnpjFilter{
+		BaseFilter: commonFilter.BaseFilter{Limit: 10, SearchTerm: " 50%_acme "},
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Items)
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,70 @@
+package repo
+
+import (
+	"context"
+
+	modelAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/client"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+)
+
+const auditEntity = "client_cpf"
+
+// auditedClientCpf registra na trilha de auditoria cada escrita feita pelo
+// repositório.
+type auditedClientCpf struct {
+	ClientCpf
+	rec audit.Recorder
+}
+
+func WithAudit(inner ClientCpf, rec audit.Recorder) ClientCpf {
+	return &auditedClientCpf{ClientCpf: inner, rec: rec}
+}
+
+func (r *auditedClientCpf) load(ctx context.Context, id int64) (any, error) {
+	return r.ClientCpf.GetByID(ctx, id)
+}
+
+func (r *auditedClientCpf) track(ctx context.Context, id int64, action string, write func() error) error {
+	return audit.Track(ctx, r.rec, auditEntity, id, action, r.load, write)
+}
+
+func (r *auditedClientCpf) Create(ctx context.Context, clientCpf *models.ClientCpf) (*models.ClientCpf, error) {
+	created, err := r.ClientCpf.Create(ctx, clientCpf)
+	if err != nil {
+		return nil, err
+	}
+
+	r.rec.Record(ctx, audit.Entry{
+		EntityType: auditEntity,
+		EntityID:   created.ID,
+		Action:     modelAudit.ActionCreate,
+		After:      created,
+	})
+
+	return created, nil
+}
+
+func (r *auditedClientCpf) Update(ctx context.Context, clientCpf *models.ClientCpf) error {
+	return r.track(ctx, clientCpf.ID, modelAudit.ActionUpdate, func() error {
+		return r.ClientCpf.Update(ctx, clientCpf)
+	})
+}
+
+func (r *auditedClientCpf) Delete(ctx context.Context, id int64) error {
+	return r.track(ctx, id, modelAudit.ActionDelete, func() error {
+		return r.ClientCpf.Delete(ctx, id)
+	})
+}
+
+func (r *auditedClientCpf) Enable(ctx context.Context, id int64) error {
+	return r.track(ctx, id, "enable", func() error {
+		return r.ClientCpf.Enable(ctx, id)
+	})
+}
+
+func (r *auditedClientCpf) Disable(ctx context.Context, id int64) error {
+	return r.track(ctx, id, "disable", func() error {
+		return r.ClientCpf.Disable(ctx, id)
+	})
+}
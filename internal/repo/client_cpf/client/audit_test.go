@@ -0,0 +1,50 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	mockAudit "github.com/WagaoCarvalho/backend_store_go/infra/mock/audit"
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cpf"
+	modelAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/client"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditedClientCpf(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("create records the new client", func(t *testing.T) {
+		inner := new(mockClient.MockClientCpf)
+		rec := new(mockAudit.MockRecorder)
+		created := &models.ClientCpf{ID: 3, Name: "Maria"}
+
+		inner.On("Create", ctx, mock.Anything).Return(created, nil).Once()
+		rec.On("Record", ctx, audit.Entry{EntityType: "client_cpf", EntityID: 3, Action: modelAudit.ActionCreate, After: created}).Once()
+
+		_, err := WithAudit(inner, rec).Create(ctx, &models.ClientCpf{Name: "Maria"})
+
+		assert.NoError(t, err)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("disable records the status change", func(t *testing.T) {
+		inner := new(mockClient.MockClientCpf)
+		rec := new(mockAudit.MockRecorder)
+		before := &models.ClientCpf{ID: 3, Status: true}
+		after := &models.ClientCpf{ID: 3, Status: false}
+
+		inner.On("GetByID", ctx, int64(3)).Return(before, nil).Once()
+		inner.On("Disable", ctx, int64(3)).Return(nil).Once()
+		inner.On("GetByID", ctx, int64(3)).Return(after, nil).Once()
+		rec.On("Record", ctx, audit.Entry{EntityType: "client_cpf", EntityID: 3, Action: "disable", Before: before, After: after}).Once()
+
+		err := WithAudit(inner, rec).Disable(ctx, 3)
+
+		assert.NoError(t, err)
+		inner.AssertExpectations(t)
+		rec.AssertExpectations(t)
+	})
+}
@@ -1,11 +1,24 @@
 package repo
 
-import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+import (
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cpf"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	credit "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/credit"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+)
 
-type clientCreditRepo struct {
-	db repo.DBExecutor
+const auditEntity = "client_cpf_credit"
+
+var tables = credit.NewTables("clients_cpf_credits", "clients_cpf_credit_entries", "client_cpf_id", "clients_cpf")
+
+func NewClientCredit(db repo.DBExecutor) credit.ClientCredit {
+	return credit.NewClientCredit(db, tables)
+}
+
+func NewClientCreditTx(db repo.DBTransactor) iface.ClientCreditTx {
+	return credit.NewClientCreditTx(db, tables)
 }
 
-func NewClientCredit(db repo.DBExecutor) ClientCredit {
-	return &clientCreditRepo{db: db}
+func WithAuditTx(inner iface.ClientCreditTx, rec audit.Recorder) iface.ClientCreditTx {
+	return credit.WithAuditTx(inner, rec, auditEntity)
 }
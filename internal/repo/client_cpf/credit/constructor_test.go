@@ -0,0 +1,71 @@
+package repo
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	mockAudit "github.com/WagaoCarvalho/backend_store_go/infra/mock/audit"
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cpf"
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewClientCredit(t *testing.T) {
+	mockDB := new(mockDb.MockDatabase)
+	result := NewClientCredit(mockDB)
+	ctx := context.Background()
+
+	mockRows := new(mockDb.MockRows)
+	mockRows.On("Next").Return(false)
+	mockRows.On("Close").Return()
+	mockRows.On("Err").Return(nil)
+	mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+		return strings.Contains(q, "FROM clients_cpf_credits cc") &&
+			strings.Contains(q, "INNER JOIN clients_cpf c ON cc.client_cpf_id = c.id")
+	}), []interface{}{"Maria"}).Return(mockRows, nil)
+
+	_, err := result.GetByName(ctx, "Maria")
+
+	assert.NoError(t, err)
+	mockDB.AssertExpectations(t)
+}
+
+func TestNewClientCreditTx(t *testing.T) {
+	result := NewClientCreditTx(new(mockDb.MockDBTransactor))
+
+	ctx := context.Background()
+	mockTx := new(mockDb.MockTx)
+	mockTx.On("QueryRow", ctx, mock.MatchedBy(func(q string) bool {
+		return strings.Contains(q, "UPDATE clients_cpf_credits") && strings.Contains(q, "WHERE client_cpf_id = $1")
+	}), []interface{}{int64(1), money.New(50)}).Return(&mockDb.MockRow{Values: []interface{}{50.0}}).Once()
+	mockTx.On("QueryRow", ctx, mock.MatchedBy(func(q string) bool {
+		return strings.Contains(q, "INSERT INTO clients_cpf_credit_entries")
+	}), mock.Anything).Return(&mockDb.MockRow{Values: []interface{}{int64(3), time.Now()}}).Once()
+
+	err := result.ChargeTx(ctx, mockTx, &models.CreditEntry{ClientID: 1, Amount: money.New(50), Description: "venda 9"})
+
+	assert.NoError(t, err)
+	mockTx.AssertExpectations(t)
+}
+
+func TestWithAuditTx(t *testing.T) {
+	ctx := context.Background()
+	tx := new(mockDb.MockTx)
+	inner := new(mockClient.MockClientCreditTx)
+	rec := new(mockAudit.MockRecorder)
+	entry := &models.CreditEntry{ClientID: 4, EntryType: models.EntryPayment, Amount: money.New(10)}
+
+	inner.On("PaymentTx", ctx, tx, entry).Return(nil).Once()
+	rec.On("RecordTx", ctx, tx, audit.Entry{EntityType: "client_cpf_credit", EntityID: 4, Action: models.EntryPayment, After: entry}).Return(nil).Once()
+
+	err := WithAuditTx(inner, rec).PaymentTx(ctx, tx, entry)
+
+	assert.NoError(t, err)
+	rec.AssertExpectations(t)
+}
@@ -7,7 +7,10 @@ import (
 
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/client"
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/filter"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+	pagination "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/pagination"
 )
 
 var clientCpfAllowedSortFields = map[string]string{
@@ -22,10 +25,18 @@ var clientCpfAllowedSortFields = map[string]string{
 	"updated_at":  "updated_at",
 }
 
+var clientCpfOrderBy = builder.OrderBy{
+	Fields:       clientCpfAllowedSortFields,
+	DefaultField: "created_at",
+	DefaultOrder: "asc",
+}
+
+var cpfFormatting = strings.NewReplacer(".", "", "-", "")
+
 func (r *clientCpfFilterRepo) Filter(
 	ctx context.Context,
 	filter *filter.ClientCpfFilter,
-) ([]*model.ClientCpf, error) {
+) (*commonFilter.Page[*model.ClientCpf], error) {
 
 	base := filter.BaseFilter.WithDefaults()
 
@@ -41,94 +52,32 @@ func (r *clientCpfFilterRepo) Filter(
 			created_at,
 			updated_at
 		FROM clients_cpf
-		WHERE 1=1
 	`
 
-	args := []any{}
-	argPos := 1
+	b := builder.NewQueryBuilderSql(query)
 
 	// Filtros de texto (busca parcial com ILIKE)
-	if filter.Name != "" {
-		query += fmt.Sprintf(" AND name ILIKE '%%' || $%d || '%%'", argPos)
-		args = append(args, filter.Name)
-		argPos++
-	}
-
-	if filter.Email != "" {
-		query += fmt.Sprintf(" AND email ILIKE '%%' || $%d || '%%'", argPos)
-		args = append(args, filter.Email)
-		argPos++
-	}
-
-	// Filtros exatos
-	if filter.CPF != "" {
-		// Remove formatação do CPF para busca consistente
-		cleanCPF := strings.ReplaceAll(filter.CPF, ".", "")
-		cleanCPF = strings.ReplaceAll(cleanCPF, "-", "")
-		query += fmt.Sprintf(" AND REPLACE(REPLACE(cpf, '.', ''), '-', '') = $%d", argPos)
-		args = append(args, cleanCPF)
-		argPos++
-	}
+	b.ILike("name", filter.Name)
+	b.ILike("email", filter.Email)
 
-	if filter.Status != nil {
-		query += fmt.Sprintf(" AND status = $%d", argPos)
-		args = append(args, *filter.Status)
-		argPos++
-	}
-
-	if filter.Version != nil {
-		query += fmt.Sprintf(" AND version = $%d", argPos)
-		args = append(args, *filter.Version)
-		argPos++
-	}
-
-	// Filtros de data
-	if filter.CreatedFrom != nil {
-		query += fmt.Sprintf(" AND created_at >= $%d", argPos)
-		args = append(args, *filter.CreatedFrom)
-		argPos++
-	}
-
-	if filter.CreatedTo != nil {
-		query += fmt.Sprintf(" AND created_at <= $%d", argPos)
-		args = append(args, *filter.CreatedTo)
-		argPos++
-	}
-
-	if filter.UpdatedFrom != nil {
-		query += fmt.Sprintf(" AND updated_at >= $%d", argPos)
-		args = append(args, *filter.UpdatedFrom)
-		argPos++
-	}
+	// CPF comparado só pelos dígitos
+	b.Eq("REPLACE(REPLACE(cpf, '.', ''), '-', '')", cpfFormatting.Replace(filter.CPF))
 
-	if filter.UpdatedTo != nil {
-		query += fmt.Sprintf(" AND updated_at <= $%d", argPos)
-		args = append(args, *filter.UpdatedTo)
-		argPos++
-	}
+	b.Eq("status", filter.Status)
+	b.Eq("version", filter.Version)
+	b.Range("created_at", filter.CreatedFrom, filter.CreatedTo)
+	b.Range("updated_at", filter.UpdatedFrom, filter.UpdatedTo)
+	b.Search(base.SearchTerm, "name", "email", "cpf")
 
-	// Ordenação com validação de campos
-	sortField := "created_at"
-	if v, ok := clientCpfAllowedSortFields[strings.ToLower(base.SortBy)]; ok {
-		sortField = v
-	}
+	sortField, sortOrder := clientCpfOrderBy.Resolve(filter.SortBy, filter.SortOrder)
 
-	sortOrder := strings.ToLower(base.SortOrder)
-	if sortOrder != "asc" && sortOrder != "desc" {
-		sortOrder = "asc"
+	args := b.GetArgs()
+	tail, pageArgs, err := pagination.Clause(base, sortField, sortOrder, b.NextArgPos())
+	if err != nil {
+		return nil, err
 	}
 
-	query += fmt.Sprintf(
-		" ORDER BY %s %s LIMIT $%d OFFSET $%d",
-		sortField,
-		sortOrder,
-		argPos,
-		argPos+1,
-	)
-
-	args = append(args, base.Limit, base.Offset)
-
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := r.db.Query(ctx, b.GetQuery()+tail, append(args, pageArgs...)...)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
 	}
@@ -159,7 +108,14 @@ func (r *clientCpfFilterRepo) Filter(
 		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
 	}
 
-	return clients, nil
+	total, err := pagination.Count(ctx, r.db, "clients_cpf", b.Where(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonFilter.NewPage(clients, total, base, func(m *model.ClientCpf) (any, int64) {
+		return clientCpfSortValue(m, sortField), m.ID
+	}), nil
 }
 
 // Método para buscar clientes ativos (status = true)
@@ -169,7 +125,11 @@ func (r *clientCpfFilterRepo) FindActive(
 ) ([]*model.ClientCpf, error) {
 	active := true
 	filter.Status = &active
-	return r.Filter(ctx, filter)
+	page, err := r.Filter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
 }
 
 // Método para buscar clientes por CPF (busca exata ou parcial)
@@ -189,7 +149,7 @@ func (r *clientCpfFilterRepo) FindByCPF(
 
 	// Se não for busca exata, mantém o resultado (a query já fez a limpeza)
 	// Se for busca exata, a query já garante a igualdade
-	return result, nil
+	return result.Items, nil
 }
 
 // Método para buscar clientes por nome (busca parcial)
@@ -200,7 +160,11 @@ func (r *clientCpfFilterRepo) FindByName(
 	filter := &filter.ClientCpfFilter{
 		Name: name,
 	}
-	return r.Filter(ctx, filter)
+	page, err := r.Filter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
 }
 
 // Função auxiliar para garantir slice não-nil (útil para outros métodos)
@@ -210,3 +174,27 @@ func ensureNonNilClientSlice(slice []*model.ClientCpf) []*model.ClientCpf {
 	}
 	return slice
 }
+
+// clientCpfSortValue devolve o valor da coluna de ordenação field no cliente, gravado
+// no cursor da próxima página.
+func clientCpfSortValue(m *model.ClientCpf, field string) any {
+	switch field {
+	case "name":
+		return m.Name
+	case "email":
+		return m.Email
+	case "cpf":
+		return m.CPF
+	case "description":
+		return m.Description
+	case "status":
+		return m.Status
+	case "version":
+		return m.Version
+	case "created_at":
+		return m.CreatedAt
+	case "updated_at":
+		return m.UpdatedAt
+	}
+	return m.ID
+}
@@ -71,15 +71,16 @@ func TestClientFilter_Filter_Success_WithResults(t *testing.T) {
 
 	mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
 
+	mockDB.OnCount(1)
 	result, err := repo.Filter(ctx, f)
 
 	assert.NoError(t, err)
-	assert.Len(t, result, 1)
-	assert.Equal(t, "João Silva", result[0].Name)
-	assert.Equal(t, "joao@email.com", result[0].Email)
-	assert.Equal(t, "12345678909", result[0].CPF)
-	assert.True(t, result[0].Status)
-	assert.Equal(t, 1, result[0].Version)
+	assert.Len(t, result.Items, 1)
+	assert.Equal(t, "João Silva", result.Items[0].Name)
+	assert.Equal(t, "joao@email.com", result.Items[0].Email)
+	assert.Equal(t, "12345678909", result.Items[0].CPF)
+	assert.True(t, result.Items[0].Status)
+	assert.Equal(t, 1, result.Items[0].Version)
 	mockDB.AssertExpectations(t)
 	rows.AssertExpectations(t)
 }
@@ -121,10 +122,11 @@ func TestClientFilter_Filter_WithAllFilters(t *testing.T) {
 
 	mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
 
+	mockDB.OnCount(0)
 	result, err := repo.Filter(ctx, f)
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
-	assert.Empty(t, result)
+	assert.Empty(t, result.Items)
 	mockDB.AssertExpectations(t)
 }
 
@@ -144,10 +146,11 @@ func TestClientFilter_Filter_EmptyResult(t *testing.T) {
 
 	mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
 
+	mockDB.OnCount(0)
 	result, err := repo.Filter(ctx, f)
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
-	assert.Empty(t, result)
+	assert.Empty(t, result.Items)
 	mockDB.AssertExpectations(t)
 }
 
@@ -198,11 +201,12 @@ func TestClientFilter_Filter_MultipleRows(t *testing.T) {
 
 	mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
 
+	mockDB.OnCount(2)
 	result, err := repo.Filter(ctx, f)
 	assert.NoError(t, err)
-	assert.Len(t, result, 2)
-	assert.Equal(t, "Cliente 1", result[0].Name)
-	assert.Equal(t, "Cliente 2", result[1].Name)
+	assert.Len(t, result.Items, 2)
+	assert.Equal(t, "Cliente 1", result.Items[0].Name)
+	assert.Equal(t, "Cliente 2", result.Items[1].Name)
 	mockDB.AssertExpectations(t)
 }
 
@@ -238,6 +242,7 @@ func TestClientFilter_SortField_Valid(t *testing.T) {
 		).
 		Return(rows, nil)
 
+	mockDB.OnCount(0)
 	_, err := repo.Filter(ctx, f)
 	assert.NoError(t, err)
 }
@@ -270,6 +275,7 @@ func TestClientFilter_SortField_Invalid(t *testing.T) {
 		).
 		Return(rows, nil)
 
+	mockDB.OnCount(0)
 	_, err := repo.Filter(ctx, f)
 	assert.NoError(t, err)
 }
@@ -302,6 +308,7 @@ func TestClientFilter_SortOrder_Invalid(t *testing.T) {
 		).
 		Return(rows, nil)
 
+	mockDB.OnCount(0)
 	_, err := repo.Filter(ctx, f)
 	assert.NoError(t, err)
 }
@@ -334,6 +341,7 @@ func TestClientFilter_SortOrder_Descending(t *testing.T) {
 		).
 		Return(rows, nil)
 
+	mockDB.OnCount(0)
 	_, err := repo.Filter(ctx, f)
 	assert.NoError(t, err)
 }
@@ -369,6 +377,7 @@ func TestClientFilter_AllSortFields(t *testing.T) {
 				).
 				Return(rows, nil)
 
+			mockDB.OnCount(0)
 			_, err := repo.Filter(ctx, f)
 			assert.NoError(t, err)
 		})
@@ -404,6 +413,7 @@ func TestClientFilter_CaseInsensitive(t *testing.T) {
 			).
 			Return(rows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, f)
 		assert.NoError(t, err)
 	})
@@ -427,6 +437,7 @@ func TestClientFilter_CaseInsensitive(t *testing.T) {
 			).
 			Return(rows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, f)
 		assert.NoError(t, err)
 	})
@@ -522,6 +533,7 @@ func TestClientFilter_FindActive(t *testing.T) {
 		Name: "Teste",
 	}
 
+	mockDB.OnCount(0)
 	result, err := repo.FindActive(ctx, filter)
 
 	assert.NoError(t, err)
@@ -548,6 +560,7 @@ func TestClientFilter_FindByCPF_Success(t *testing.T) {
 	mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
 
 	t.Run("exact match true", func(t *testing.T) {
+		mockDB.OnCount(0)
 		result, err := repo.FindByCPF(ctx, "12345678909", true)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -555,6 +568,7 @@ func TestClientFilter_FindByCPF_Success(t *testing.T) {
 	})
 
 	t.Run("exact match false", func(t *testing.T) {
+		mockDB.OnCount(0)
 		result, err := repo.FindByCPF(ctx, "123", false)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -624,6 +638,7 @@ func TestClientFilter_FindByName(t *testing.T) {
 
 	mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
 
+	mockDB.OnCount(0)
 	result, err := repo.FindByName(ctx, "João")
 
 	assert.NoError(t, err)
@@ -700,6 +715,7 @@ func TestClientFilter_LimitOffset(t *testing.T) {
 
 			mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
 
+			mockDB.OnCount(0)
 			_, err := repo.Filter(ctx, f)
 			assert.NoError(t, err)
 		})
@@ -746,3 +762,31 @@ func TestClientAllowedSortFields(t *testing.T) {
 		assert.Equal(t, "created_at", mappedField)
 	})
 }
+
+func TestClientFilter_Filter_SearchTerm(t *testing.T) {
+	mockDB := new(mockDb.MockDatabase)
+	repo := &clientCpfFilterRepo{db: mockDB}
+	ctx := context.Background()
+
+	mockRows := new(mockDb.MockRows)
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Err").Return(nil)
+	mockRows.On("Close").Return()
+
+	where := " WHERE 1=1 AND (name ILIKE $1 OR email ILIKE $1 OR cpf ILIKE $1)"
+	args := []interface{}{`%50\%\_acme%`}
+
+	mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+		return strings.Contains(q, where+" ORDER BY")
+	}), args).Return(mockRows, nil)
+	mockDB.On("QueryRow", ctx, "SELECT COUNT(*) FROM clients_cpf"+where, args).
+		Return(&mockDb.MockRow{Values: []interface{}{int64(0)}})
+
+	result, err := repo.Filter(ctx, &filterClient.ClientCpfFilter{
+		BaseFilter: filter.BaseFilter{Limit: 10, SearchTerm: " 50%_acme "},
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Items)
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,208 @@
+package builder
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// QueryBuilderSql monta o WHERE dos Filter a partir de predicados tipados.
+// Cada predicado só gera SQL quando o valor foi informado: nil, ponteiro nil
+// e string vazia são ignorados, o que dispensa o if por campo nos
+// repositórios. Nomes de coluna vêm sempre do código, nunca da requisição, e
+// cada valor ocupa exatamente um placeholder $n gerado aqui; o SQL recebido
+// nunca é reescrito.
+type QueryBuilderSql struct {
+	baseQuery  string
+	conditions []string
+	args       []any
+}
+
+func NewQueryBuilderSql(baseQuery string) *QueryBuilderSql {
+	return &QueryBuilderSql{baseQuery: baseQuery}
+}
+
+// Eq gera field = $n. field pode ser uma expressão sobre a coluna, como a
+// normalização de um documento.
+func (b *QueryBuilderSql) Eq(field string, value any) {
+	b.compare(field, "=", value)
+}
+
+func (b *QueryBuilderSql) NotEq(field string, value any) {
+	b.compare(field, "<>", value)
+}
+
+func (b *QueryBuilderSql) Gt(field string, value any) {
+	b.compare(field, ">", value)
+}
+
+func (b *QueryBuilderSql) Lt(field string, value any) {
+	b.compare(field, "<", value)
+}
+
+// Range aplica from <= field <= to; cada limite é opcional.
+func (b *QueryBuilderSql) Range(field string, from, to any) {
+	b.compare(field, ">=", from)
+	b.compare(field, "<=", to)
+}
+
+// Between exige os dois limites; sem um deles nada é gerado.
+func (b *QueryBuilderSql) Between(field string, value1, value2 any) {
+	v1, ok1 := present(value1)
+	v2, ok2 := present(value2)
+	if !ok1 || !ok2 {
+		return
+	}
+
+	b.conditions = append(b.conditions, fmt.Sprintf("%s BETWEEN %s AND %s", field, b.arg(v1), b.arg(v2)))
+}
+
+// ILike faz busca parcial sem diferenciar maiúsculas. Os curingas digitados
+// pelo usuário são escapados e tratados como texto.
+func (b *QueryBuilderSql) ILike(field string, value string) {
+	b.compare(field, "ILIKE", likePattern(value))
+}
+
+// Search procura o termo em qualquer uma das colunas, com um único
+// argumento: (a ILIKE $n OR b ILIKE $n).
+func (b *QueryBuilderSql) Search(term string, fields ...string) {
+	term = strings.TrimSpace(term)
+	if term == "" || len(fields) == 0 {
+		return
+	}
+
+	b.anyOf(fields, "ILIKE", likePattern(term))
+}
+
+// EqAny casa o valor com qualquer uma das colunas, com um único argumento:
+// (a = $n OR b = $n).
+func (b *QueryBuilderSql) EqAny(value any, fields ...string) {
+	v, ok := present(value)
+	if !ok || len(fields) == 0 {
+		return
+	}
+
+	b.anyOf(fields, "=", v)
+}
+
+// In gera field IN ($n, ...); lista vazia é ignorada.
+func (b *QueryBuilderSql) In(field string, values []any) {
+	if len(values) == 0 {
+		return
+	}
+
+	placeholders := make([]string, len(values))
+	for i, v := range values {
+		placeholders[i] = b.arg(v)
+	}
+	b.conditions = append(b.conditions, fmt.Sprintf("%s IN (%s)", field, strings.Join(placeholders, ", ")))
+}
+
+// InSubquery gera field IN (SELECT column FROM table WHERE match = $n), para
+// filtrar pelo conteúdo de uma tabela filha, como os itens de um pedido.
+func (b *QueryBuilderSql) InSubquery(field, table, column, match string, value any) {
+	v, ok := present(value)
+	if !ok {
+		return
+	}
+
+	b.conditions = append(b.conditions,
+		fmt.Sprintf("%s IN (SELECT %s FROM %s WHERE %s = %s)", field, column, table, match, b.arg(v)))
+}
+
+func (b *QueryBuilderSql) IsNull(field string, isNull bool) {
+	if isNull {
+		b.conditions = append(b.conditions, field+" IS NULL")
+		return
+	}
+	b.conditions = append(b.conditions, field+" IS NOT NULL")
+}
+
+// Where devolve o WHERE montado. Começa com 1=1 para que predicados
+// posteriores (como o keyset da paginação) possam ser anexados com AND.
+func (b *QueryBuilderSql) Where() string {
+	var sb strings.Builder
+	sb.WriteString(" WHERE 1=1")
+	for _, c := range b.conditions {
+		sb.WriteString(" AND ")
+		sb.WriteString(c)
+	}
+	return sb.String()
+}
+
+func (b *QueryBuilderSql) GetArgs() []any {
+	args := make([]any, len(b.args))
+	copy(args, b.args)
+	return args
+}
+
+// NextArgPos é a posição do próximo placeholder livre.
+func (b *QueryBuilderSql) NextArgPos() int {
+	return len(b.args) + 1
+}
+
+func (b *QueryBuilderSql) GetBaseQuery() string {
+	return b.baseQuery
+}
+
+// GetQuery devolve a consulta base com o WHERE, sem ordenação nem paginação.
+func (b *QueryBuilderSql) GetQuery() string {
+	return b.baseQuery + b.Where()
+}
+
+func (b *QueryBuilderSql) compare(field, op string, value any) {
+	if v, ok := present(value); ok {
+		b.conditions = append(b.conditions, fmt.Sprintf("%s %s %s", field, op, b.arg(v)))
+	}
+}
+
+// anyOf liga as colunas com OR, todas comparadas ao mesmo placeholder.
+func (b *QueryBuilderSql) anyOf(fields []string, op string, value any) {
+	placeholder := b.arg(value)
+	parts := make([]string, len(fields))
+	for i, field := range fields {
+		parts[i] = fmt.Sprintf("%s %s %s", field, op, placeholder)
+	}
+	b.conditions = append(b.conditions, "("+strings.Join(parts, " OR ")+")")
+}
+
+// arg registra o valor e devolve o seu placeholder.
+func (b *QueryBuilderSql) arg(value any) string {
+	b.args = append(b.args, value)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// EscapeLike neutraliza os curingas de LIKE/ILIKE em um texto digitado.
+func EscapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
+func likePattern(value string) string {
+	if value == "" {
+		return ""
+	}
+	return "%" + EscapeLike(value) + "%"
+}
+
+// present desreferencia ponteiros e indica se o valor foi informado.
+func present(value any) (any, bool) {
+	if value == nil {
+		return nil, false
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, false
+		}
+		value = rv.Elem().Interface()
+	}
+
+	if s, ok := value.(string); ok && s == "" {
+		return nil, false
+	}
+
+	return value, true
+}
@@ -0,0 +1,167 @@
+package builder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryBuilderSql(t *testing.T) {
+	t.Run("sem condições", func(t *testing.T) {
+		b := NewQueryBuilderSql("SELECT id FROM sales")
+
+		assert.Equal(t, " WHERE 1=1", b.Where())
+		assert.Equal(t, "SELECT id FROM sales WHERE 1=1", b.GetQuery())
+		assert.Equal(t, "SELECT id FROM sales", b.GetBaseQuery())
+		assert.Empty(t, b.GetArgs())
+		assert.Equal(t, 1, b.NextArgPos())
+	})
+
+	t.Run("valores não informados são ignorados", func(t *testing.T) {
+		var id *int64
+		var from *time.Time
+		b := NewQueryBuilderSql("")
+
+		b.Eq("client_id", id)
+		b.Eq("status", "")
+		b.Eq("notes", nil)
+		b.ILike("name", "")
+		b.Range("created_at", from, nil)
+		b.Between("total", 1, nil)
+		b.In("status", nil)
+		b.Search("   ", "name", "email")
+		b.EqAny(id, "from_location_id", "to_location_id")
+		b.InSubquery("id", "sale_payments", "sale_id", "method", "")
+
+		assert.Equal(t, " WHERE 1=1", b.Where())
+		assert.Empty(t, b.GetArgs())
+	})
+
+	t.Run("predicados tipados e ponteiros desreferenciados", func(t *testing.T) {
+		id := int64(7)
+		active := true
+		from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		b := NewQueryBuilderSql("")
+
+		b.Eq("client_id", &id)
+		b.NotEq("status", "canceled")
+		b.Gt("total", 10.5)
+		b.Lt("version", 3)
+		b.Eq("is_active", &active)
+		b.Range("created_at", &from, nil)
+
+		assert.Equal(t,
+			" WHERE 1=1 AND client_id = $1 AND status <> $2 AND total > $3 AND version < $4 AND is_active = $5 AND created_at >= $6",
+			b.Where(),
+		)
+		assert.Equal(t, []any{int64(7), "canceled", 10.5, 3, true, from}, b.GetArgs())
+		assert.Equal(t, 7, b.NextArgPos())
+	})
+
+	t.Run("intervalos, IN e nulos", func(t *testing.T) {
+		b := NewQueryBuilderSql("")
+
+		b.Range("sale_price", 1.0, 9.0)
+		b.Between("stock_quantity", 0, 5)
+		b.In("status", []any{"open", "done"})
+		b.IsNull("deleted_at", true)
+		b.IsNull("sale_id", false)
+
+		assert.Equal(t,
+			" WHERE 1=1 AND sale_price >= $1 AND sale_price <= $2 AND stock_quantity BETWEEN $3 AND $4"+
+				" AND status IN ($5, $6) AND deleted_at IS NULL AND sale_id IS NOT NULL",
+			b.Where(),
+		)
+		assert.Equal(t, []any{1.0, 9.0, 0, 5, "open", "done"}, b.GetArgs())
+	})
+
+	t.Run("ILIKE escapa curingas digitados", func(t *testing.T) {
+		b := NewQueryBuilderSql("")
+
+		b.ILike("name", `50%_off\`)
+
+		assert.Equal(t, " WHERE 1=1 AND name ILIKE $1", b.Where())
+		assert.Equal(t, []any{`%50\%\_off\\%`}, b.GetArgs())
+	})
+
+	t.Run("busca em várias colunas usa um único argumento", func(t *testing.T) {
+		b := NewQueryBuilderSql("")
+		b.Eq("status", true)
+
+		b.Search(" joão ", "name", "email", "cpf")
+
+		assert.Equal(t, " WHERE 1=1 AND status = $1 AND (name ILIKE $2 OR email ILIKE $2 OR cpf ILIKE $2)", b.Where())
+		assert.Equal(t, []any{true, "%joão%"}, b.GetArgs())
+	})
+
+	t.Run("mesmo valor em várias colunas usa um único argumento", func(t *testing.T) {
+		b := NewQueryBuilderSql("")
+		locationID := int64(4)
+
+		b.Eq("status", "in_transit")
+		b.EqAny(&locationID, "from_location_id", "to_location_id")
+
+		assert.Equal(t, " WHERE 1=1 AND status = $1 AND (from_location_id = $2 OR to_location_id = $2)", b.Where())
+		assert.Equal(t, []any{"in_transit", int64(4)}, b.GetArgs())
+	})
+
+	t.Run("subconsulta em tabela filha", func(t *testing.T) {
+		b := NewQueryBuilderSql("")
+		productID := int64(3)
+
+		b.Eq("status", "open")
+		b.InSubquery("id", "purchase_order_items", "purchase_order_id", "product_id", &productID)
+
+		assert.Equal(t,
+			" WHERE 1=1 AND status = $1 AND id IN (SELECT purchase_order_id FROM purchase_order_items WHERE product_id = $2)",
+			b.Where(),
+		)
+		assert.Equal(t, []any{"open", int64(3)}, b.GetArgs())
+	})
+
+	t.Run("expressão com ? literal não é reescrita", func(t *testing.T) {
+		b := NewQueryBuilderSql("")
+
+		b.Eq("metadata->>'q?'", "x")
+		b.Eq("REPLACE(REPLACE(cpf, '.', ''), '-', '')", "12345678900")
+
+		assert.Equal(t, " WHERE 1=1 AND metadata->>'q?' = $1 AND REPLACE(REPLACE(cpf, '.', ''), '-', '') = $2", b.Where())
+		assert.Equal(t, []any{"x", "12345678900"}, b.GetArgs())
+	})
+
+	t.Run("GetArgs devolve cópia", func(t *testing.T) {
+		b := NewQueryBuilderSql("")
+		b.Eq("id", 1)
+
+		args := b.GetArgs()
+		args[0] = 99
+
+		assert.Equal(t, []any{1}, b.GetArgs())
+	})
+}
+
+func TestOrderBy_Resolve(t *testing.T) {
+	o := OrderBy{
+		Fields:       map[string]string{"name": "name", "created_at": "created_at"},
+		DefaultField: "id",
+		DefaultOrder: "desc",
+	}
+
+	tests := []struct {
+		sortBy, sortOrder  string
+		wantField, wantDir string
+	}{
+		{"", "", "id", "desc"},
+		{"NAME", "ASC", "name", "asc"},
+		{" created_at ", "desc", "created_at", "desc"},
+		{"name; DROP TABLE users", "asc", "id", "asc"},
+		{"name", "sideways", "name", "desc"},
+	}
+
+	for _, tt := range tests {
+		field, order := o.Resolve(tt.sortBy, tt.sortOrder)
+		assert.Equal(t, tt.wantField, field, tt.sortBy)
+		assert.Equal(t, tt.wantDir, order, tt.sortOrder)
+	}
+}
@@ -0,0 +1,27 @@
+package builder
+
+import "strings"
+
+// OrderBy resolve a ordenação pedida contra uma lista de campos permitidos.
+// Fields mapeia o nome aceito na API para a coluna; qualquer outro valor cai
+// em DefaultField, então o sort_by recebido nunca chega cru ao SQL.
+type OrderBy struct {
+	Fields       map[string]string
+	DefaultField string
+	DefaultOrder string
+}
+
+// Resolve devolve a coluna e a direção ("asc" ou "desc") da consulta.
+func (o OrderBy) Resolve(sortBy, sortOrder string) (field, order string) {
+	field = o.DefaultField
+	if v, ok := o.Fields[strings.ToLower(strings.TrimSpace(sortBy))]; ok {
+		field = v
+	}
+
+	order = strings.ToLower(strings.TrimSpace(sortOrder))
+	if order != "asc" && order != "desc" {
+		order = o.DefaultOrder
+	}
+
+	return field, order
+}
@@ -0,0 +1,54 @@
+package credit
+
+import (
+	"context"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cpf"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/jackc/pgx/v5"
+)
+
+// auditedCreditTx registra cada lançamento no crediário na transação que o
+// grava: se o registro falhar, o lançamento é desfeito. A entidade é o
+// cliente, do tipo informado em entity, e a ação, o tipo do lançamento.
+type auditedCreditTx struct {
+	iface.ClientCreditTx
+	rec    audit.Recorder
+	entity string
+}
+
+func WithAuditTx(inner iface.ClientCreditTx, rec audit.Recorder, entity string) iface.ClientCreditTx {
+	return &auditedCreditTx{ClientCreditTx: inner, rec: rec, entity: entity}
+}
+
+func (r *auditedCreditTx) ChargeTx(ctx context.Context, tx pgx.Tx, entry *models.CreditEntry) error {
+	return r.post(ctx, tx, entry, r.ClientCreditTx.ChargeTx)
+}
+
+func (r *auditedCreditTx) PaymentTx(ctx context.Context, tx pgx.Tx, entry *models.CreditEntry) error {
+	return r.post(ctx, tx, entry, r.ClientCreditTx.PaymentTx)
+}
+
+func (r *auditedCreditTx) RefundTx(ctx context.Context, tx pgx.Tx, entry *models.CreditEntry) error {
+	return r.post(ctx, tx, entry, r.ClientCreditTx.RefundTx)
+}
+
+// post grava o lançamento e o registra. Um estorno sem saldo a abater não
+// gera lançamento e também não é registrado.
+func (r *auditedCreditTx) post(ctx context.Context, tx pgx.Tx, entry *models.CreditEntry, write func(context.Context, pgx.Tx, *models.CreditEntry) error) error {
+	if err := write(ctx, tx, entry); err != nil {
+		return err
+	}
+
+	if !entry.Amount.IsPositive() {
+		return nil
+	}
+
+	return r.rec.RecordTx(ctx, tx, audit.Entry{
+		EntityType: r.entity,
+		EntityID:   entry.ClientID,
+		Action:     entry.EntryType,
+		After:      entry,
+	})
+}
@@ -0,0 +1,92 @@
+package credit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockAudit "github.com/WagaoCarvalho/backend_store_go/infra/mock/audit"
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cpf"
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditedCreditTx(t *testing.T) {
+	ctx := context.Background()
+	tx := new(mockDb.MockTx)
+
+	t.Run("charge records the entry for the client", func(t *testing.T) {
+		inner := new(mockClient.MockClientCreditTx)
+		rec := new(mockAudit.MockRecorder)
+		entry := models.NewSaleEntry(3, 9, money.New(50), "venda 9")
+
+		inner.On("ChargeTx", ctx, tx, entry).Run(func(args mock.Arguments) {
+			args.Get(2).(*models.CreditEntry).EntryType = models.EntryCharge
+		}).Return(nil).Once()
+		rec.On("RecordTx", ctx, tx, audit.Entry{EntityType: "client_cpf_credit", EntityID: 3, Action: models.EntryCharge, After: entry}).Return(nil).Once()
+
+		err := WithAuditTx(inner, rec, "client_cpf_credit").ChargeTx(ctx, tx, entry)
+
+		assert.NoError(t, err)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("entity comes from the caller", func(t *testing.T) {
+		inner := new(mockClient.MockClientCreditTx)
+		rec := new(mockAudit.MockRecorder)
+		entry := &models.CreditEntry{ClientID: 4, EntryType: models.EntryPayment, Amount: money.New(10)}
+
+		inner.On("PaymentTx", ctx, tx, entry).Return(nil).Once()
+		rec.On("RecordTx", ctx, tx, audit.Entry{EntityType: "client_cnpj_credit", EntityID: 4, Action: models.EntryPayment, After: entry}).Return(nil).Once()
+
+		err := WithAuditTx(inner, rec, "client_cnpj_credit").PaymentTx(ctx, tx, entry)
+
+		assert.NoError(t, err)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("audit failure fails the entry", func(t *testing.T) {
+		inner := new(mockClient.MockClientCreditTx)
+		rec := new(mockAudit.MockRecorder)
+		entry := &models.CreditEntry{ClientID: 3, Amount: money.New(10)}
+
+		inner.On("RefundTx", ctx, tx, entry).Return(nil).Once()
+		rec.On("RecordTx", ctx, tx, mock.Anything).Return(errors.New("audit fail")).Once()
+
+		err := WithAuditTx(inner, rec, "client_cpf_credit").RefundTx(ctx, tx, entry)
+
+		assert.EqualError(t, err, "audit fail")
+	})
+
+	t.Run("refund with nothing to refund is not recorded", func(t *testing.T) {
+		inner := new(mockClient.MockClientCreditTx)
+		rec := new(mockAudit.MockRecorder)
+		entry := &models.CreditEntry{ClientID: 3, Amount: money.New(10)}
+
+		inner.On("RefundTx", ctx, tx, entry).Run(func(args mock.Arguments) {
+			args.Get(2).(*models.CreditEntry).Amount = money.Money{}
+		}).Return(nil).Once()
+
+		err := WithAuditTx(inner, rec, "client_cpf_credit").RefundTx(ctx, tx, entry)
+
+		assert.NoError(t, err)
+		rec.AssertNotCalled(t, "RecordTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("failed entry is not recorded", func(t *testing.T) {
+		inner := new(mockClient.MockClientCreditTx)
+		rec := new(mockAudit.MockRecorder)
+		entry := &models.CreditEntry{ClientID: 3, Amount: money.New(10)}
+
+		inner.On("ChargeTx", ctx, tx, entry).Return(errors.New("limite")).Once()
+
+		err := WithAuditTx(inner, rec, "client_cpf_credit").ChargeTx(ctx, tx, entry)
+
+		assert.EqualError(t, err, "limite")
+		rec.AssertNotCalled(t, "RecordTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
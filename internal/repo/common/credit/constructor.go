@@ -0,0 +1,38 @@
+// Package credit implementa o crediário comum a clientes CPF e CNPJ. Os
+// pacotes de cada tipo de cliente só informam as suas tabelas.
+package credit
+
+import (
+	"strings"
+
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+)
+
+// Tables descreve as tabelas do crediário de um tipo de cliente. As
+// queries usam os marcadores {credits}, {entries}, {client_id} e {clients},
+// o que permite compartilhar a mesma implementação entre clientes CPF e CNPJ.
+type Tables struct {
+	replacer *strings.Replacer
+}
+
+func NewTables(credits, entries, clientID, clients string) Tables {
+	return Tables{replacer: strings.NewReplacer(
+		"{credits}", credits,
+		"{entries}", entries,
+		"{client_id}", clientID,
+		"{clients}", clients,
+	)}
+}
+
+func (t Tables) sql(query string) string {
+	return t.replacer.Replace(query)
+}
+
+type clientCreditRepo struct {
+	db     repo.DBExecutor
+	tables Tables
+}
+
+func NewClientCredit(db repo.DBExecutor, tables Tables) ClientCredit {
+	return &clientCreditRepo{db: db, tables: tables}
+}
@@ -1,4 +1,4 @@
-package repo
+package credit
 
 import iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cpf"
 
@@ -6,4 +6,5 @@ type ClientCredit interface {
 	iface.ClientCreditReader
 	iface.ClientCreditWriter
 	iface.ClientCreditStatus
+	iface.ClientCreditLedgerReader
 }
@@ -0,0 +1,52 @@
+package credit
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+// GetEntriesByClientID retorna os lançamentos do cliente em ordem cronológica.
+// balance_after de cada linha é o saldo corrente do extrato.
+func (r *clientCreditRepo) GetEntriesByClientID(ctx context.Context, clientID int64, limit, offset int) ([]*models.CreditEntry, error) {
+	const query = `
+		SELECT id, {client_id}, entry_type, amount, balance_after, sale_id, user_id, COALESCE(description, ''), created_at
+		FROM {entries}
+		WHERE {client_id} = $1
+		ORDER BY id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.Query(ctx, r.tables.sql(query), clientID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	var entries []*models.CreditEntry
+	for rows.Next() {
+		var entry models.CreditEntry
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.ClientID,
+			&entry.EntryType,
+			&entry.Amount,
+			&entry.BalanceAfter,
+			&entry.SaleID,
+			&entry.UserID,
+			&entry.Description,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,82 @@
+package credit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClientCredit_GetEntriesByClientID(t *testing.T) {
+	t.Run("successfully get ledger entries", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []interface{}{int64(1), int64(5), models.EntryCharge, 100.0, 100.0, nil, nil, "venda 3", now}},
+			{Values: []interface{}{int64(2), int64(5), models.EntryPayment, 40.0, 60.0, nil, nil, "", now}},
+		}}
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(5), 10, 0}).Return(mockRows, nil)
+
+		result, err := repo.GetEntriesByClientID(ctx, 5, 10, 0)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, models.EntryCharge, result[0].EntryType)
+		assert.Equal(t, money.New(60), result[1].BalanceAfter)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return ErrGet when query fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
+		ctx := context.Background()
+
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db fail"))
+
+		result, err := repo.GetEntriesByClientID(ctx, 5, 10, 0)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrScan when scan fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan failed")}}}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+
+		result, err := repo.GetEntriesByClientID(ctx, 5, 10, 0)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+
+	t.Run("return ErrGet when rows error occurs", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{
+			Rows:    []*mockDb.MockRow{{Values: []interface{}{int64(1)}}},
+			RowsErr: errors.New("rows error"),
+		}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+
+		result, err := repo.GetEntriesByClientID(ctx, 5, 10, 0)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
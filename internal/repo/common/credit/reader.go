@@ -1,22 +1,24 @@
-package repo
+package credit
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
 )
 
 func (r *clientCreditRepo) GetByID(ctx context.Context, id int64) (*models.ClientCredit, error) {
 	const query = `
-		SELECT id, client_id, allow_credit, credit_limit, credit_balance, created_at, updated_at
-		FROM client_credits
+		SELECT id, {client_id}, allow_credit, credit_limit, credit_balance, created_at, updated_at
+		FROM {credits}
 		WHERE id = $1
 	`
 
 	var credit models.ClientCredit
-	err := r.db.QueryRow(ctx, query, id).Scan(
+	err := r.db.QueryRow(ctx, r.tables.sql(query), id).Scan(
 		&credit.ID,
 		&credit.ClientID,
 		&credit.AllowCredit,
@@ -35,13 +37,13 @@ func (r *clientCreditRepo) GetByID(ctx context.Context, id int64) (*models.Clien
 
 func (r *clientCreditRepo) GetByClientID(ctx context.Context, clientID int64) (*models.ClientCredit, error) {
 	const query = `
-		SELECT id, client_id, allow_credit, credit_limit, credit_balance, created_at, updated_at
-		FROM client_credits
-		WHERE client_id = $1
+		SELECT id, {client_id}, allow_credit, credit_limit, credit_balance, created_at, updated_at
+		FROM {credits}
+		WHERE {client_id} = $1
 	`
 
 	var credit models.ClientCredit
-	err := r.db.QueryRow(ctx, query, clientID).Scan(
+	err := r.db.QueryRow(ctx, r.tables.sql(query), clientID).Scan(
 		&credit.ID,
 		&credit.ClientID,
 		&credit.AllowCredit,
@@ -52,6 +54,9 @@ func (r *clientCreditRepo) GetByClientID(ctx context.Context, clientID int64) (*
 	)
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
 		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
 	}
 
@@ -60,12 +65,12 @@ func (r *clientCreditRepo) GetByClientID(ctx context.Context, clientID int64) (*
 
 func (r *clientCreditRepo) GetAll(ctx context.Context) ([]*models.ClientCredit, error) {
 	const query = `
-		SELECT id, client_id, allow_credit, credit_limit, credit_balance, created_at, updated_at
-		FROM client_credits
+		SELECT id, {client_id}, allow_credit, credit_limit, credit_balance, created_at, updated_at
+		FROM {credits}
 		ORDER BY id;
 	`
 
-	rows, err := r.db.Query(ctx, query)
+	rows, err := r.db.Query(ctx, r.tables.sql(query))
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
 	}
@@ -97,14 +102,14 @@ func (r *clientCreditRepo) GetAll(ctx context.Context) ([]*models.ClientCredit,
 
 func (r *clientCreditRepo) GetByName(ctx context.Context, name string) ([]*models.ClientCredit, error) {
 	const query = `
-		SELECT cc.id, cc.client_id, cc.allow_credit, cc.credit_limit, cc.credit_balance, cc.created_at, cc.updated_at
-		FROM client_credits cc
-		INNER JOIN clients c ON cc.client_id = c.id
+		SELECT cc.id, cc.{client_id}, cc.allow_credit, cc.credit_limit, cc.credit_balance, cc.created_at, cc.updated_at
+		FROM {credits} cc
+		INNER JOIN {clients} c ON cc.{client_id} = c.id
 		WHERE c.name ILIKE '%' || $1 || '%'
 		ORDER BY cc.id;
 	`
 
-	rows, err := r.db.Query(ctx, query, name)
+	rows, err := r.db.Query(ctx, r.tables.sql(query), name)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
 	}
@@ -135,9 +140,9 @@ func (r *clientCreditRepo) GetByName(ctx context.Context, name string) ([]*model
 }
 
 func (r *clientCreditRepo) GetVersionByID(ctx context.Context, id int64) (int, error) {
-	const query = `SELECT version FROM client_credits WHERE id = $1`
+	const query = `SELECT version FROM {credits} WHERE id = $1`
 	var version int
-	err := r.db.QueryRow(ctx, query, id).Scan(&version)
+	err := r.db.QueryRow(ctx, r.tables.sql(query), id).Scan(&version)
 	if err != nil {
 		return 0, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
 	}
@@ -1,4 +1,4 @@
-package repo
+package credit
 
 import (
 	"context"
@@ -14,10 +14,13 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+// cpfTables são as tabelas do crediário de clientes CPF, usadas nos testes.
+var cpfTables = NewTables("clients_cpf_credits", "clients_cpf_credit_entries", "client_cpf_id", "clients_cpf")
+
 func TestClientCredit_GetByID(t *testing.T) {
 	t.Run("successfully get client credit by id", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		creditID := int64(1)
 		expectedTime := time.Now()
@@ -40,7 +43,7 @@ func TestClientCredit_GetByID(t *testing.T) {
 
 	t.Run("return ErrGet when client credit not found", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		creditID := int64(999)
 
@@ -56,7 +59,7 @@ func TestClientCredit_GetByID(t *testing.T) {
 
 	t.Run("return ErrGet when database connection fails", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		creditID := int64(2)
 		dbErr := errors.New("connection lost")
@@ -74,7 +77,7 @@ func TestClientCredit_GetByID(t *testing.T) {
 
 	t.Run("return ErrGet when Scan returns pgconn.PgError", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		creditID := int64(3)
 		pgErr := &pgconn.PgError{Message: "syntax error"}
@@ -94,7 +97,7 @@ func TestClientCredit_GetByID(t *testing.T) {
 func TestClientCredit_GetByClientID(t *testing.T) {
 	t.Run("successfully get client credit by client id", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		clientID := int64(10)
 		expectedTime := time.Now()
@@ -115,9 +118,9 @@ func TestClientCredit_GetByClientID(t *testing.T) {
 		mockDB.AssertExpectations(t)
 	})
 
-	t.Run("return ErrGet when client credit not found", func(t *testing.T) {
+	t.Run("return ErrNotFound when client credit not found", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		clientID := int64(999)
 
@@ -127,13 +130,13 @@ func TestClientCredit_GetByClientID(t *testing.T) {
 		result, err := repo.GetByClientID(ctx, clientID)
 
 		assert.Nil(t, result)
-		assert.ErrorIs(t, err, errMsg.ErrGet)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
 		mockDB.AssertExpectations(t)
 	})
 
 	t.Run("return ErrGet when database connection fails", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		clientID := int64(2)
 		dbErr := errors.New("connection lost")
@@ -151,7 +154,7 @@ func TestClientCredit_GetByClientID(t *testing.T) {
 
 	t.Run("return ErrGet when Scan returns pgconn.PgError", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		clientID := int64(3)
 		pgErr := &pgconn.PgError{Message: "syntax error"}
@@ -171,7 +174,7 @@ func TestClientCredit_GetByClientID(t *testing.T) {
 func TestClientCredit_GetAll(t *testing.T) {
 	t.Run("successfully get all client credits", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 
 		mockRows := new(mockDb.MockRows)
@@ -202,7 +205,7 @@ func TestClientCredit_GetAll(t *testing.T) {
 
 	t.Run("return ErrGet when query fails", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 
 		dbErr := errors.New("db fail")
@@ -221,7 +224,7 @@ func TestClientCredit_GetAll(t *testing.T) {
 
 	t.Run("return ErrScan when scan fails", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 
 		scanErr := errors.New("scan failed")
@@ -252,7 +255,7 @@ func TestClientCredit_GetAll(t *testing.T) {
 
 	t.Run("return ErrGet when rows error occurs", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 
 		rowsErr := errors.New("rows iteration error")
@@ -277,7 +280,7 @@ func TestClientCredit_GetAll(t *testing.T) {
 func TestClientCredit_GetByName(t *testing.T) {
 	t.Run("successfully get client credits by name", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		name := "John"
 
@@ -309,7 +312,7 @@ func TestClientCredit_GetByName(t *testing.T) {
 
 	t.Run("return ErrGet when query fails", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		name := "Maria"
 
@@ -328,7 +331,7 @@ func TestClientCredit_GetByName(t *testing.T) {
 
 	t.Run("return ErrScan when scan fails", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		name := "Lucas"
 
@@ -359,7 +362,7 @@ func TestClientCredit_GetByName(t *testing.T) {
 
 	t.Run("return ErrGet when rows iteration fails", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		name := "Ana"
 
@@ -384,7 +387,7 @@ func TestClientCredit_GetByName(t *testing.T) {
 func TestClientCredit_GetVersionByID(t *testing.T) {
 	t.Run("successfully get version by id", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 
 		// MockRow personalizado que retorna um int fixo
@@ -401,7 +404,7 @@ func TestClientCredit_GetVersionByID(t *testing.T) {
 
 	t.Run("return ErrGet when scan fails", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 
 		dbErr := errors.New("db error")
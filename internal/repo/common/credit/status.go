@@ -0,0 +1,30 @@
+package credit
+
+import (
+	"context"
+	"fmt"
+
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (r *clientCreditRepo) Disable(ctx context.Context, id int64) error {
+	const query = `UPDATE {credits} SET allow_credit=false, credit_limit=0, version=version+1, updated_at=NOW() WHERE id=$1`
+	_, err := r.db.Exec(ctx, r.tables.sql(query), id)
+	if err != nil {
+		if errMsgPg.IsCheckViolation(err) {
+			return fmt.Errorf("%w: cliente possui saldo devedor", errMsg.ErrInvalidData)
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+	return nil
+}
+
+func (r *clientCreditRepo) Enable(ctx context.Context, id int64) error {
+	const query = `UPDATE {credits} SET allow_credit=true, version=version+1, updated_at=NOW() WHERE id=$1`
+	_, err := r.db.Exec(ctx, r.tables.sql(query), id)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+	return nil
+}
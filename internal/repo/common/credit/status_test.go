@@ -1,4 +1,4 @@
-package repo
+package credit
 
 import (
 	"context"
@@ -7,6 +7,7 @@ import (
 
 	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -14,7 +15,7 @@ import (
 func TestClientCredit_Disable(t *testing.T) {
 	t.Run("successfully disable client credit", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		creditID := int64(1)
 
@@ -29,7 +30,7 @@ func TestClientCredit_Disable(t *testing.T) {
 
 	t.Run("return ErrUpdate when database error occurs", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		creditID := int64(1)
 		dbError := errors.New("database connection failed")
@@ -42,12 +43,26 @@ func TestClientCredit_Disable(t *testing.T) {
 		assert.ErrorContains(t, err, dbError.Error())
 		mockDB.AssertExpectations(t)
 	})
+
+	t.Run("return ErrInvalidData when client still owes credit", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
+		ctx := context.Background()
+		creditID := int64(1)
+
+		mockDB.On("Exec", ctx, mock.Anything, []interface{}{creditID}).Return(nil, &pgconn.PgError{Code: "23514"})
+
+		err := repo.Disable(ctx, creditID)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		mockDB.AssertExpectations(t)
+	})
 }
 
 func TestClientCredit_Enable(t *testing.T) {
 	t.Run("successfully enable client credit", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		creditID := int64(1)
 
@@ -62,7 +77,7 @@ func TestClientCredit_Enable(t *testing.T) {
 
 	t.Run("return ErrUpdate when database error occurs", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		creditID := int64(1)
 		dbError := errors.New("database connection failed")
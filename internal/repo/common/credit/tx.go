@@ -0,0 +1,177 @@
+package credit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cpf"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+type clientCreditTx struct {
+	db     repo.DBTransactor
+	tables Tables
+}
+
+func NewClientCreditTx(db repo.DBTransactor, tables Tables) iface.ClientCreditTx {
+	return &clientCreditTx{db: db, tables: tables}
+}
+
+func (r *clientCreditTx) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return r.db.BeginTx(ctx, pgx.TxOptions{})
+}
+
+// ChargeTx soma o valor ao saldo devedor do cliente e registra o lançamento.
+// O limite é garantido pela constraint chk_credit_balance_valid
+// (credit_balance <= credit_limit).
+func (r *clientCreditTx) ChargeTx(ctx context.Context, tx pgx.Tx, entry *models.CreditEntry) error {
+	if entry == nil || !entry.Amount.IsPositive() {
+		return errMsg.ErrInvalidData
+	}
+
+	const query = `
+		UPDATE {credits}
+		SET credit_balance = credit_balance + $2,
+		    version = version + 1,
+		    updated_at = NOW()
+		WHERE {client_id} = $1
+		  AND allow_credit = TRUE
+		RETURNING credit_balance;
+	`
+
+	var balance money.Money
+	err := tx.QueryRow(ctx, r.tables.sql(query), entry.ClientID, entry.Amount).Scan(&balance)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return fmt.Errorf("%w: cliente sem crédito habilitado", errMsg.ErrCreditLimitExceeded)
+		case errMsgPg.IsCheckViolation(err):
+			return errMsg.ErrCreditLimitExceeded
+		default:
+			return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+		}
+	}
+
+	entry.EntryType = models.EntryCharge
+	entry.BalanceAfter = balance
+
+	return r.insertEntryTx(ctx, tx, entry)
+}
+
+// PaymentTx abate o valor pago do saldo devedor e registra o lançamento.
+// Pagamentos maiores que o saldo são recusados pela constraint.
+func (r *clientCreditTx) PaymentTx(ctx context.Context, tx pgx.Tx, entry *models.CreditEntry) error {
+	if entry == nil || !entry.Amount.IsPositive() {
+		return errMsg.ErrInvalidData
+	}
+
+	const query = `
+		UPDATE {credits}
+		SET credit_balance = credit_balance - $2,
+		    version = version + 1,
+		    updated_at = NOW()
+		WHERE {client_id} = $1
+		RETURNING credit_balance;
+	`
+
+	var balance money.Money
+	err := tx.QueryRow(ctx, r.tables.sql(query), entry.ClientID, entry.Amount).Scan(&balance)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return errMsg.ErrNotFound
+		case errMsgPg.IsCheckViolation(err):
+			return fmt.Errorf("%w: pagamento excede o saldo devedor", errMsg.ErrInvalidData)
+		default:
+			return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+		}
+	}
+
+	entry.EntryType = models.EntryPayment
+	entry.BalanceAfter = balance
+
+	return r.insertEntryTx(ctx, tx, entry)
+}
+
+// RefundTx abate o valor do saldo devedor sem deixá-lo negativo. O lançamento
+// registra apenas o valor efetivamente estornado; se nada havia a estornar,
+// nenhum lançamento é gravado e entry.Amount fica zerado.
+func (r *clientCreditTx) RefundTx(ctx context.Context, tx pgx.Tx, entry *models.CreditEntry) error {
+	if entry == nil || !entry.Amount.IsPositive() {
+		return errMsg.ErrInvalidData
+	}
+
+	const query = `
+		UPDATE {credits} c
+		SET credit_balance = GREATEST(c.credit_balance - $2, 0),
+		    version = c.version + 1,
+		    updated_at = NOW()
+		FROM (
+			SELECT id, credit_balance
+			FROM {credits}
+			WHERE {client_id} = $1
+			FOR UPDATE
+		) old
+		WHERE c.id = old.id
+		RETURNING old.credit_balance - c.credit_balance, c.credit_balance;
+	`
+
+	var applied, balance money.Money
+	err := tx.QueryRow(ctx, r.tables.sql(query), entry.ClientID, entry.Amount).Scan(&applied, &balance)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errMsg.ErrNotFound
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	entry.EntryType = models.EntryRefund
+	entry.Amount = applied
+	entry.BalanceAfter = balance
+
+	if !applied.IsPositive() {
+		return nil
+	}
+
+	return r.insertEntryTx(ctx, tx, entry)
+}
+
+func (r *clientCreditTx) insertEntryTx(ctx context.Context, tx pgx.Tx, entry *models.CreditEntry) error {
+	const query = `
+		INSERT INTO {entries} (
+			{client_id},
+			entry_type,
+			amount,
+			balance_after,
+			sale_id,
+			user_id,
+			description,
+			created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+		RETURNING id, created_at;
+	`
+
+	err := tx.QueryRow(ctx, r.tables.sql(query),
+		entry.ClientID,
+		entry.EntryType,
+		entry.Amount,
+		entry.BalanceAfter,
+		entry.SaleID,
+		entry.UserID,
+		entry.Description,
+	).Scan(&entry.ID, &entry.CreatedAt)
+	if err != nil {
+		if errMsgPg.IsForeignKeyViolation(err) {
+			return errMsg.ErrDBInvalidForeignKey
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,326 @@
+package credit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newEntry() *models.CreditEntry {
+	return &models.CreditEntry{ClientID: 1, Amount: money.New(50), SaleID: utils.Int64Ptr(9), Description: "venda 9"}
+}
+
+func entryArgs(entryType string, amount, balance int64) []interface{} {
+	return []interface{}{int64(1), entryType, money.New(amount), money.New(balance), utils.Int64Ptr(9), (*int64)(nil), "venda 9"}
+}
+
+func TestNewClientCreditTx(t *testing.T) {
+	result := NewClientCreditTx(new(mockDb.MockDBTransactor), cpfTables)
+
+	assert.NotNil(t, result)
+	_, ok := result.(*clientCreditTx)
+	assert.True(t, ok, "Expected result to be of type *clientCreditTx")
+}
+
+func TestClientCreditTx_BeginTx(t *testing.T) {
+	t.Run("successfully begin transaction", func(t *testing.T) {
+		mockDB := new(mockDb.MockDBTransactor)
+		repo := &clientCreditTx{db: mockDB, tables: cpfTables}
+		ctx := context.Background()
+
+		mockTx := new(mockDb.MockTx)
+		mockDB.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+
+		tx, err := repo.BeginTx(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, mockTx, tx)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return error when begin transaction fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDBTransactor)
+		repo := &clientCreditTx{db: mockDB, tables: cpfTables}
+		ctx := context.Background()
+
+		dbError := errors.New("transaction failed")
+		mockDB.On("BeginTx", ctx, pgx.TxOptions{}).Return(&mockDb.MockTx{}, dbError)
+
+		_, err := repo.BeginTx(ctx)
+
+		assert.Equal(t, dbError, err)
+		mockDB.AssertExpectations(t)
+	})
+}
+
+func TestClientCreditTx_ChargeTx(t *testing.T) {
+	t.Run("return ErrInvalidData when amount is not positive", func(t *testing.T) {
+		repo := &clientCreditTx{tables: cpfTables}
+
+		err := repo.ChargeTx(context.Background(), new(mockDb.MockTx), &models.CreditEntry{ClientID: 1})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("return ErrInvalidData when entry is nil", func(t *testing.T) {
+		repo := &clientCreditTx{tables: cpfTables}
+
+		err := repo.ChargeTx(context.Background(), new(mockDb.MockTx), nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("successfully charge credit and write ledger entry", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &clientCreditTx{tables: cpfTables}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), money.New(50)}).
+			Return(&mockDb.MockRow{Values: []interface{}{150.0}})
+		mockTx.On("QueryRow", ctx, mock.Anything, entryArgs(models.EntryCharge, 50, 150)).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(3), now}})
+
+		entry := newEntry()
+		err := repo.ChargeTx(ctx, mockTx, entry)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), entry.ID)
+		assert.Equal(t, models.EntryCharge, entry.EntryType)
+		assert.Equal(t, money.New(150), entry.BalanceAfter)
+		assert.Equal(t, now, entry.CreatedAt)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrCreditLimitExceeded when client has no credit enabled", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &clientCreditTx{tables: cpfTables}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), money.New(50)}).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.ChargeTx(ctx, mockTx, newEntry())
+
+		assert.ErrorIs(t, err, errMsg.ErrCreditLimitExceeded)
+	})
+
+	t.Run("return ErrCreditLimitExceeded on check violation", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &clientCreditTx{tables: cpfTables}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), money.New(50)}).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23514"}})
+
+		err := repo.ChargeTx(ctx, mockTx, newEntry())
+
+		assert.ErrorIs(t, err, errMsg.ErrCreditLimitExceeded)
+	})
+
+	t.Run("return ErrUpdate on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &clientCreditTx{tables: cpfTables}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), money.New(50)}).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		err := repo.ChargeTx(ctx, mockTx, newEntry())
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+
+	t.Run("return ErrDBInvalidForeignKey when ledger insert violates foreign key", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &clientCreditTx{tables: cpfTables}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), money.New(50)}).
+			Return(&mockDb.MockRow{Values: []interface{}{150.0}})
+		mockTx.On("QueryRow", ctx, mock.Anything, entryArgs(models.EntryCharge, 50, 150)).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}})
+
+		err := repo.ChargeTx(ctx, mockTx, newEntry())
+
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("return ErrCreate when ledger insert fails", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &clientCreditTx{tables: cpfTables}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), money.New(50)}).
+			Return(&mockDb.MockRow{Values: []interface{}{150.0}})
+		mockTx.On("QueryRow", ctx, mock.Anything, entryArgs(models.EntryCharge, 50, 150)).
+			Return(&mockDb.MockRow{Err: errors.New("insert failed")})
+
+		err := repo.ChargeTx(ctx, mockTx, newEntry())
+
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
+
+func TestClientCreditTx_PaymentTx(t *testing.T) {
+	t.Run("return ErrInvalidData when amount is not positive", func(t *testing.T) {
+		repo := &clientCreditTx{tables: cpfTables}
+
+		err := repo.PaymentTx(context.Background(), new(mockDb.MockTx), &models.CreditEntry{ClientID: 1, Amount: money.New(-1)})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("successfully post payment", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &clientCreditTx{tables: cpfTables}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), money.New(50)}).
+			Return(&mockDb.MockRow{Values: []interface{}{20.0}})
+		mockTx.On("QueryRow", ctx, mock.Anything, entryArgs(models.EntryPayment, 50, 20)).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(4), time.Now()}})
+
+		entry := newEntry()
+		err := repo.PaymentTx(ctx, mockTx, entry)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), entry.ID)
+		assert.Equal(t, money.New(20), entry.BalanceAfter)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when client has no credit account", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &clientCreditTx{tables: cpfTables}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), money.New(50)}).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.PaymentTx(ctx, mockTx, newEntry())
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrInvalidData when payment exceeds balance", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &clientCreditTx{tables: cpfTables}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), money.New(50)}).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23514"}})
+
+		err := repo.PaymentTx(ctx, mockTx, newEntry())
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		assert.ErrorContains(t, err, "pagamento excede o saldo devedor")
+	})
+
+	t.Run("return ErrUpdate on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &clientCreditTx{tables: cpfTables}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), money.New(50)}).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		err := repo.PaymentTx(ctx, mockTx, newEntry())
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
+
+func TestClientCreditTx_RefundTx(t *testing.T) {
+	t.Run("return ErrInvalidData when amount is not positive", func(t *testing.T) {
+		repo := &clientCreditTx{tables: cpfTables}
+
+		err := repo.RefundTx(context.Background(), new(mockDb.MockTx), &models.CreditEntry{ClientID: 1, Amount: money.New(-5)})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("successfully refund credit", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &clientCreditTx{tables: cpfTables}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), money.New(50)}).
+			Return(&mockDb.MockRow{Values: []interface{}{50.0, 10.0}})
+		mockTx.On("QueryRow", ctx, mock.Anything, entryArgs(models.EntryRefund, 50, 10)).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(5), time.Now()}})
+
+		entry := newEntry()
+		err := repo.RefundTx(ctx, mockTx, entry)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), entry.ID)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("records only the amount actually refunded", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &clientCreditTx{tables: cpfTables}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), money.New(50)}).
+			Return(&mockDb.MockRow{Values: []interface{}{30.0, 0.0}})
+		mockTx.On("QueryRow", ctx, mock.Anything, entryArgs(models.EntryRefund, 30, 0)).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(6), time.Now()}})
+
+		entry := newEntry()
+		err := repo.RefundTx(ctx, mockTx, entry)
+
+		assert.NoError(t, err)
+		assert.Equal(t, money.New(30), entry.Amount)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("skips ledger entry when nothing was refunded", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &clientCreditTx{tables: cpfTables}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), money.New(50)}).
+			Return(&mockDb.MockRow{Values: []interface{}{0.0, 0.0}}).Once()
+
+		entry := newEntry()
+		err := repo.RefundTx(ctx, mockTx, entry)
+
+		assert.NoError(t, err)
+		assert.Zero(t, entry.Amount)
+		assert.Zero(t, entry.ID)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when client has no credit account", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &clientCreditTx{tables: cpfTables}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), money.New(50)}).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.RefundTx(ctx, mockTx, newEntry())
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrUpdate on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &clientCreditTx{tables: cpfTables}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), money.New(50)}).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		err := repo.RefundTx(ctx, mockTx, newEntry())
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
@@ -0,0 +1,100 @@
+package credit
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (r *clientCreditRepo) Create(ctx context.Context, credit *models.ClientCredit) (*models.ClientCredit, error) {
+	const query = `
+		INSERT INTO {credits} ({client_id}, allow_credit, credit_limit, credit_balance, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRow(ctx, r.tables.sql(query),
+		credit.ClientID,
+		credit.AllowCredit,
+		credit.CreditLimit,
+		credit.CreditBalance,
+	).Scan(&credit.ID, &credit.CreatedAt, &credit.UpdatedAt)
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	return credit, nil
+}
+
+// Upsert abre a linha de crédito do cliente ou ajusta a existente. O saldo
+// devedor nunca é alterado aqui; limites abaixo do saldo atual ou a
+// desabilitação com saldo pendente são recusados pelas constraints da tabela.
+func (r *clientCreditRepo) Upsert(ctx context.Context, credit *models.ClientCredit) (*models.ClientCredit, error) {
+	const query = `
+		INSERT INTO {credits} ({client_id}, allow_credit, credit_limit, credit_balance, created_at, updated_at)
+		VALUES ($1, $2, $3, 0, NOW(), NOW())
+		ON CONFLICT ({client_id}) DO UPDATE
+		SET allow_credit = EXCLUDED.allow_credit,
+		    credit_limit = EXCLUDED.credit_limit,
+		    version = {credits}.version + 1,
+		    updated_at = NOW()
+		RETURNING id, credit_balance, created_at, updated_at
+	`
+
+	err := r.db.QueryRow(ctx, r.tables.sql(query),
+		credit.ClientID,
+		credit.AllowCredit,
+		credit.CreditLimit,
+	).Scan(&credit.ID, &credit.CreditBalance, &credit.CreatedAt, &credit.UpdatedAt)
+
+	if err != nil {
+		switch {
+		case errMsgPg.IsForeignKeyViolation(err):
+			return nil, errMsg.ErrDBInvalidForeignKey
+		case errMsgPg.IsCheckViolation(err):
+			return nil, fmt.Errorf("%w: limite menor que o saldo devedor atual", errMsg.ErrInvalidData)
+		default:
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+		}
+	}
+
+	return credit, nil
+}
+
+func (r *clientCreditRepo) Update(ctx context.Context, credit *models.ClientCredit) error {
+	const query = `
+		UPDATE {credits}
+		SET allow_credit = $1, credit_limit = $2, credit_balance = $3, updated_at = NOW()
+		WHERE id = $4
+	`
+
+	_, err := r.db.Exec(ctx, r.tables.sql(query),
+		credit.AllowCredit,
+		credit.CreditLimit,
+		credit.CreditBalance,
+		credit.ID,
+	)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	return nil
+}
+
+func (r *clientCreditRepo) Delete(ctx context.Context, id int64) error {
+	const query = `
+		DELETE FROM {credits} WHERE id = $1
+	`
+
+	_, err := r.db.Exec(ctx, r.tables.sql(query), id)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrDelete, err)
+	}
+
+	return nil
+}
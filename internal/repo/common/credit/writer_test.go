@@ -1,4 +1,4 @@
-package repo
+package credit
 
 import (
 	"context"
@@ -9,6 +9,8 @@ import (
 	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -16,14 +18,14 @@ import (
 func TestClientCredit_Create(t *testing.T) {
 	t.Run("successfully create client credit", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 
 		credit := &models.ClientCredit{
 			ClientID:      1,
 			AllowCredit:   true,
-			CreditLimit:   5000.0,
-			CreditBalance: 1000.0,
+			CreditLimit:   money.New(5000),
+			CreditBalance: money.New(1000),
 		}
 
 		now := time.Now()
@@ -47,7 +49,7 @@ func TestClientCredit_Create(t *testing.T) {
 
 	t.Run("return ErrCreate when database error occurs", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 
 		credit := &models.ClientCredit{
@@ -72,14 +74,14 @@ func TestClientCredit_Create(t *testing.T) {
 func TestClientCredit_Update(t *testing.T) {
 	t.Run("successfully update client credit", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 
 		credit := &models.ClientCredit{
 			ID:            1,
 			AllowCredit:   true,
-			CreditLimit:   10000.0,
-			CreditBalance: 2500.0,
+			CreditLimit:   money.New(10000),
+			CreditBalance: money.New(2500),
 		}
 
 		cmdTag := mockDb.MockCommandTag{RowsAffectedCount: 1}
@@ -98,14 +100,14 @@ func TestClientCredit_Update(t *testing.T) {
 
 	t.Run("return ErrUpdate when database error occurs", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 
 		credit := &models.ClientCredit{
 			ID:            1,
 			AllowCredit:   false,
-			CreditLimit:   5000.0,
-			CreditBalance: 1500.0,
+			CreditLimit:   money.New(5000),
+			CreditBalance: money.New(1500),
 		}
 
 		dbError := errors.New("database connection failed")
@@ -127,7 +129,7 @@ func TestClientCredit_Update(t *testing.T) {
 func TestClientCredit_Delete(t *testing.T) {
 	t.Run("successfully delete client credit", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		creditID := int64(1)
 
@@ -142,7 +144,7 @@ func TestClientCredit_Delete(t *testing.T) {
 
 	t.Run("return ErrDelete when database error occurs", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
-		repo := &clientCreditRepo{db: mockDB}
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
 		ctx := context.Background()
 		creditID := int64(1)
 		dbError := errors.New("database connection failed")
@@ -156,3 +158,69 @@ func TestClientCredit_Delete(t *testing.T) {
 		mockDB.AssertExpectations(t)
 	})
 }
+
+func TestClientCredit_Upsert(t *testing.T) {
+	newCredit := func() *models.ClientCredit {
+		return &models.ClientCredit{ClientID: 1, AllowCredit: true, CreditLimit: money.New(500)}
+	}
+
+	t.Run("successfully open or adjust credit line", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), true, money.New(500)}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(7), 120.0, now, now}})
+
+		result, err := repo.Upsert(ctx, newCredit())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), result.ID)
+		assert.Equal(t, money.New(120), result.CreditBalance)
+		assert.Equal(t, now, result.UpdatedAt)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return ErrDBInvalidForeignKey when client does not exist", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
+		ctx := context.Background()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}})
+
+		result, err := repo.Upsert(ctx, newCredit())
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("return ErrInvalidData when limit is below current balance", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
+		ctx := context.Background()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23514"}})
+
+		result, err := repo.Upsert(ctx, newCredit())
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("return ErrCreate on database error", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &clientCreditRepo{db: mockDB, tables: cpfTables}
+		ctx := context.Background()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).
+			Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		result, err := repo.Upsert(ctx, newCredit())
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
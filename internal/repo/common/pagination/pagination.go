@@ -0,0 +1,87 @@
+package pagination
+
+import (
+	"context"
+	"fmt"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+)
+
+// Clause monta o final da consulta de um Filter a partir do WHERE já
+// construído. No modo offset gera ORDER BY (com desempate por id) e
+// LIMIT/OFFSET. No modo cursor acrescenta o predicado keyset com o valor de
+// ordenação e o id gravados no cursor e busca limit+1 linhas, para que
+// filter.NewPage saiba se há próxima página. argPos é a próxima posição de
+// parâmetro livre na consulta.
+func Clause(base filter.BaseFilter, sortField, sortOrder string, argPos int) (string, []any, error) {
+	order := fmt.Sprintf(" ORDER BY %s %s", sortField, sortOrder)
+	if sortField != "id" {
+		order += fmt.Sprintf(", id %s", sortOrder)
+	}
+
+	if !base.CursorMode {
+		return fmt.Sprintf("%s LIMIT %d OFFSET %d", order, base.Limit, base.Offset), nil, nil
+	}
+
+	if base.Cursor == "" {
+		return fmt.Sprintf("%s LIMIT %d", order, base.Limit+1), nil, nil
+	}
+
+	cursor, err := filter.DecodeCursor(base.Cursor)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: cursor inválido", errMsg.ErrInvalidFilter)
+	}
+
+	predicate, args, err := keyset(cursor, sortField, sortOrder, argPos)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: cursor inválido", errMsg.ErrInvalidFilter)
+	}
+
+	return fmt.Sprintf("%s%s LIMIT %d", predicate, order, base.Limit+1), args, nil
+}
+
+// keyset devolve o predicado que seleciona as linhas depois do cursor. O
+// PostgreSQL ordena NULL por último em asc e primeiro em desc, então uma
+// coluna nula na linha do cursor, ou ainda por vir em asc, é tratada à parte.
+func keyset(cursor *filter.Cursor, sortField, sortOrder string, argPos int) (string, []any, error) {
+	op := ">"
+	if sortOrder == "desc" {
+		op = "<"
+	}
+
+	if sortField == "id" {
+		return fmt.Sprintf(" AND id %s $%d", op, argPos), []any{cursor.ID}, nil
+	}
+
+	value, err := cursor.SortValue()
+	if err != nil {
+		return "", nil, err
+	}
+
+	if value == nil {
+		if sortOrder == "desc" {
+			return fmt.Sprintf(" AND (%s IS NOT NULL OR id < $%d)", sortField, argPos), []any{cursor.ID}, nil
+		}
+		return fmt.Sprintf(" AND %s IS NULL AND id > $%d", sortField, argPos), []any{cursor.ID}, nil
+	}
+
+	predicate := fmt.Sprintf("(%s, id) %s ($%d, $%d)", sortField, op, argPos, argPos+1)
+	if sortOrder != "desc" {
+		predicate = fmt.Sprintf("(%s OR %s IS NULL)", predicate, sortField)
+	}
+
+	return " AND " + predicate, []any{value, cursor.ID}, nil
+}
+
+// Count conta as linhas de table que atendem where, com os mesmos args usados
+// no Filter; o total não considera paginação nem cursor.
+func Count(ctx context.Context, db repo.DBExecutor, table, where string, args []any) (int64, error) {
+	var total int64
+	if err := db.QueryRow(ctx, "SELECT COUNT(*) FROM "+table+where, args...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return total, nil
+}
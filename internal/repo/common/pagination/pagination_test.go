@@ -0,0 +1,131 @@
+package pagination
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClause(t *testing.T) {
+	t.Run("modo offset com desempate por id", func(t *testing.T) {
+		tail, args, err := Clause(filter.BaseFilter{Limit: 10, Offset: 20}, "product_name", "asc", 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, " ORDER BY product_name asc, id asc LIMIT 10 OFFSET 20", tail)
+		assert.Nil(t, args)
+	})
+
+	t.Run("modo offset ordenando por id", func(t *testing.T) {
+		tail, _, err := Clause(filter.BaseFilter{Limit: 5}, "id", "desc", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, " ORDER BY id desc LIMIT 5 OFFSET 0", tail)
+	})
+
+	t.Run("primeira página do cursor busca limit+1", func(t *testing.T) {
+		tail, args, err := Clause(filter.BaseFilter{Limit: 10, Offset: 30, CursorMode: true}, "sale_date", "desc", 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, " ORDER BY sale_date desc, id desc LIMIT 11", tail)
+		assert.Nil(t, args)
+	})
+
+	t.Run("keyset ascendente compara com o valor do cursor", func(t *testing.T) {
+		base := filter.BaseFilter{Limit: 10, CursorMode: true, SortBy: "name"}
+		base.Cursor = filter.NewCursor(base, "Acme", 42).Encode()
+
+		tail, args, err := Clause(base, "name", "asc", 4)
+
+		assert.NoError(t, err)
+		assert.Equal(t, " AND ((name, id) > ($4, $5) OR name IS NULL) ORDER BY name asc, id asc LIMIT 11", tail)
+		assert.Equal(t, []any{"Acme", int64(42)}, args)
+	})
+
+	t.Run("keyset descendente preserva o tipo do valor", func(t *testing.T) {
+		at := time.Date(2025, 3, 1, 10, 30, 0, 0, time.UTC)
+		base := filter.BaseFilter{Limit: 10, CursorMode: true, SortBy: "sale_date", SortOrder: "desc"}
+		base.Cursor = filter.NewCursor(base, at, 42).Encode()
+
+		tail, args, err := Clause(base, "sale_date", "desc", 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, " AND (sale_date, id) < ($2, $3) ORDER BY sale_date desc, id desc LIMIT 11", tail)
+		require.Len(t, args, 2)
+		assert.True(t, at.Equal(args[0].(time.Time)))
+		assert.Equal(t, int64(42), args[1])
+	})
+
+	t.Run("keyset com valor nulo ascendente", func(t *testing.T) {
+		base := filter.BaseFilter{Limit: 10, CursorMode: true, SortBy: "closed_at"}
+		base.Cursor = filter.NewCursor(base, (*time.Time)(nil), 42).Encode()
+
+		tail, args, err := Clause(base, "closed_at", "asc", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, " AND closed_at IS NULL AND id > $1 ORDER BY closed_at asc, id asc LIMIT 11", tail)
+		assert.Equal(t, []any{int64(42)}, args)
+	})
+
+	t.Run("keyset com valor nulo descendente", func(t *testing.T) {
+		base := filter.BaseFilter{Limit: 10, CursorMode: true, SortBy: "closed_at", SortOrder: "desc"}
+		base.Cursor = filter.NewCursor(base, nil, 42).Encode()
+
+		tail, args, err := Clause(base, "closed_at", "desc", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, " AND (closed_at IS NOT NULL OR id < $1) ORDER BY closed_at desc, id desc LIMIT 11", tail)
+		assert.Equal(t, []any{int64(42)}, args)
+	})
+
+	t.Run("keyset descendente por id", func(t *testing.T) {
+		base := filter.BaseFilter{Limit: 10, CursorMode: true, SortBy: "id", SortOrder: "desc"}
+		base.Cursor = filter.NewCursor(base, int64(42), 42).Encode()
+
+		tail, args, err := Clause(base, "id", "desc", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, " AND id < $1 ORDER BY id desc LIMIT 11", tail)
+		assert.Equal(t, []any{int64(42)}, args)
+	})
+
+	t.Run("cursor inválido", func(t *testing.T) {
+		_, _, err := Clause(filter.BaseFilter{Limit: 10, CursorMode: true, Cursor: "xyz"}, "id", "asc", 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+	})
+}
+
+func TestCount(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reaproveita WHERE e args do filtro", func(t *testing.T) {
+		db := new(mockDb.MockDatabase)
+		args := []any{"%abc%"}
+		db.On("QueryRow", ctx, "SELECT COUNT(*) FROM products WHERE 1=1 AND name ILIKE $1", args).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(7)}})
+
+		total, err := Count(ctx, db, "products", " WHERE 1=1 AND name ILIKE $1", args)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), total)
+		db.AssertExpectations(t)
+	})
+
+	t.Run("erro no scan", func(t *testing.T) {
+		db := new(mockDb.MockDatabase)
+		db.On("QueryRow", ctx, mock.Anything, mock.Anything).
+			Return(&mockDb.MockRow{Err: errors.New("falha")})
+
+		_, err := Count(ctx, db, "products", " WHERE 1=1", nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
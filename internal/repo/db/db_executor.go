@@ -16,3 +16,9 @@ type DBExecutor interface {
 type DBTransactor interface {
 	BeginTx(ctx context.Context, opts pgx.TxOptions) (pgx.Tx, error)
 }
+
+// DBPool consulta fora de transação e abre transações, como o pgxpool.Pool.
+type DBPool interface {
+	DBExecutor
+	DBTransactor
+}
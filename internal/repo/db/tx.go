@@ -0,0 +1,49 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// TxBeginner abre a transação de um serviço; os repositórios Tx o implementam.
+type TxBeginner interface {
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+}
+
+// RunInTx executa fn dentro de uma transação, com commit em caso de sucesso
+// e rollback em caso de erro ou panic.
+func RunInTx(ctx context.Context, db TxBeginner, fn func(tx pgx.Tx) error) error {
+	tx, err := db.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao iniciar transação: %w", err)
+	}
+	if tx == nil {
+		return errors.New("transação inválida")
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback(ctx)
+			panic(p)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return fmt.Errorf("%w; rollback error: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if cErr := tx.Commit(ctx); cErr != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return fmt.Errorf("erro ao commitar transação: %v; rollback error: %w", cErr, rbErr)
+		}
+		return fmt.Errorf("erro ao commitar transação: %w", cErr)
+	}
+
+	return nil
+}
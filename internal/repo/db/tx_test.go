@@ -0,0 +1,93 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockRepo "github.com/WagaoCarvalho/backend_store_go/infra/mock/repo"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+type beginner struct {
+	tx  pgx.Tx
+	err error
+}
+
+func (b beginner) BeginTx(context.Context) (pgx.Tx, error) {
+	return b.tx, b.err
+}
+
+func TestRunInTx(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("erro ao iniciar transação", func(t *testing.T) {
+		err := RunInTx(ctx, beginner{err: errors.New("db down")}, func(pgx.Tx) error { return nil })
+		assert.ErrorContains(t, err, "erro ao iniciar transação")
+	})
+
+	t.Run("transação nil", func(t *testing.T) {
+		err := RunInTx(ctx, beginner{}, func(pgx.Tx) error { return nil })
+		assert.ErrorContains(t, err, "transação inválida")
+	})
+
+	t.Run("sucesso faz commit", func(t *testing.T) {
+		tx := new(mockRepo.MockTx)
+		tx.On("Commit", ctx).Return(nil).Once()
+
+		var got pgx.Tx
+		err := RunInTx(ctx, beginner{tx: tx}, func(inner pgx.Tx) error { got = inner; return nil })
+		assert.NoError(t, err)
+		assert.Equal(t, tx, got)
+		tx.AssertExpectations(t)
+	})
+
+	t.Run("erro em fn faz rollback", func(t *testing.T) {
+		tx := new(mockRepo.MockTx)
+		tx.On("Rollback", ctx).Return(nil).Once()
+
+		fnErr := errors.New("falha")
+		err := RunInTx(ctx, beginner{tx: tx}, func(pgx.Tx) error { return fnErr })
+		assert.Equal(t, fnErr, err)
+		tx.AssertExpectations(t)
+	})
+
+	t.Run("erro no rollback", func(t *testing.T) {
+		tx := new(mockRepo.MockTx)
+		tx.On("Rollback", ctx).Return(errors.New("rollback falhou")).Once()
+
+		fnErr := errors.New("falha")
+		err := RunInTx(ctx, beginner{tx: tx}, func(pgx.Tx) error { return fnErr })
+		assert.ErrorIs(t, err, fnErr)
+		assert.ErrorContains(t, err, "rollback error")
+	})
+
+	t.Run("erro no commit", func(t *testing.T) {
+		tx := new(mockRepo.MockTx)
+		tx.On("Commit", ctx).Return(errors.New("commit falhou")).Once()
+		tx.On("Rollback", ctx).Return(nil).Once()
+
+		err := RunInTx(ctx, beginner{tx: tx}, func(pgx.Tx) error { return nil })
+		assert.ErrorContains(t, err, "erro ao commitar transação")
+	})
+
+	t.Run("erro no commit e no rollback", func(t *testing.T) {
+		tx := new(mockRepo.MockTx)
+		tx.On("Commit", ctx).Return(errors.New("commit falhou")).Once()
+		tx.On("Rollback", ctx).Return(errors.New("rollback falhou")).Once()
+
+		err := RunInTx(ctx, beginner{tx: tx}, func(pgx.Tx) error { return nil })
+		assert.ErrorContains(t, err, "rollback error")
+	})
+
+	t.Run("panic faz rollback e repropaga", func(t *testing.T) {
+		tx := new(mockRepo.MockTx)
+		tx.On("Rollback", ctx).Return(nil).Once()
+
+		assert.Panics(t, func() {
+			_ = RunInTx(ctx, beginner{tx: tx}, func(pgx.Tx) error { panic("boom") })
+		})
+		tx.AssertExpectations(t)
+	})
+}
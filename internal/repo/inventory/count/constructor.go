@@ -0,0 +1,13 @@
+package repo
+
+import (
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+)
+
+type inventoryCountRepo struct {
+	db repo.DBExecutor
+}
+
+func NewInventoryCount(db repo.DBExecutor) InventoryCount {
+	return &inventoryCountRepo{db: db}
+}
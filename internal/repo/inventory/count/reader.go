@@ -0,0 +1,138 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+	pagination "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/pagination"
+	"github.com/jackc/pgx/v5"
+)
+
+var allowedInventoryCountSortFields = map[string]string{
+	"id":         "id",
+	"created_at": "created_at",
+	"closed_at":  "closed_at",
+	"status":     "status",
+}
+
+var inventoryCountOrderBy = builder.OrderBy{
+	Fields:       allowedInventoryCountSortFields,
+	DefaultField: "id",
+	DefaultOrder: "desc",
+}
+
+func (r *inventoryCountRepo) GetByID(ctx context.Context, id int64) (*models.InventoryCount, error) {
+	query := `SELECT ` + countColumns + ` FROM inventory_counts WHERE id = $1;`
+
+	var count models.InventoryCount
+	if err := scanCountRow(r.db.QueryRow(ctx, query, id), &count); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	if err := loadItems(ctx, r.db, &count); err != nil {
+		return nil, err
+	}
+
+	return &count, nil
+}
+
+// List devolve as sessões sem as linhas contadas.
+func (r *inventoryCountRepo) List(ctx context.Context, f *models.InventoryCountFilter) (*commonFilter.Page[*models.InventoryCount], error) {
+	base := f.BaseFilter.WithDefaults()
+
+	b := builder.NewQueryBuilderSql(`SELECT ` + countColumns + ` FROM inventory_counts`)
+
+	b.Eq("status", f.Status)
+	b.Eq("category_id", f.CategoryID)
+
+	sortField, sortOrder := inventoryCountOrderBy.Resolve(f.SortBy, f.SortOrder)
+
+	args := b.GetArgs()
+	tail, pageArgs, err := pagination.Clause(base, sortField, sortOrder, b.NextArgPos())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, b.GetQuery()+tail, append(args, pageArgs...)...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	counts := make([]*models.InventoryCount, 0)
+	for rows.Next() {
+		var c models.InventoryCount
+		if err := scanCountRow(rows, &c); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		counts = append(counts, &c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	total, err := pagination.Count(ctx, r.db, "inventory_counts", b.Where(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonFilter.NewPage(counts, total, base, func(c *models.InventoryCount) (any, int64) {
+		return inventoryCountSortValue(c, sortField), c.ID
+	}), nil
+}
+
+// Variance compara o saldo atual com o contado para todos os produtos do
+// escopo da sessão: os da categoria, ou os ativos quando não há categoria,
+// além de qualquer produto já contado.
+func (r *inventoryCountRepo) Variance(ctx context.Context, id int64) ([]*models.VarianceLine, error) {
+	const query = `
+		SELECT p.id, p.product_name, COALESCE(p.barcode, ''), p.stock_quantity, i.counted_quantity, p.cost_price
+		FROM inventory_counts c
+		CROSS JOIN products p
+		LEFT JOIN inventory_count_items i
+		       ON i.inventory_count_id = c.id
+		      AND i.product_id = p.id
+		WHERE c.id = $1
+		  AND (
+		        i.product_id IS NOT NULL
+		     OR (c.category_id IS NULL AND p.status)
+		     OR EXISTS (
+		            SELECT 1
+		            FROM product_category_relations r
+		            WHERE r.product_id = p.id
+		              AND r.category_id = c.category_id
+		        )
+		  )
+		ORDER BY p.product_name ASC, p.id ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return scanVarianceLines(rows)
+}
+
+// inventoryCountSortValue devolve o valor da coluna de ordenação field na contagem, gravado
+// no cursor da próxima página.
+func inventoryCountSortValue(c *models.InventoryCount, field string) any {
+	switch field {
+	case "created_at":
+		return c.CreatedAt
+	case "closed_at":
+		return c.ClosedAt
+	case "status":
+		return c.Status
+	}
+	return c.ID
+}
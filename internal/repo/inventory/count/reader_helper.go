@@ -0,0 +1,113 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+const countColumns = `
+	id,
+	category_id,
+	status,
+	COALESCE(notes, ''),
+	opened_by,
+	closed_by,
+	adjusted_products,
+	version,
+	created_at,
+	updated_at,
+	closed_at
+`
+
+// querier é atendido tanto pelo pool quanto por pgx.Tx.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func scanCountRow(row pgx.Row, c *models.InventoryCount) error {
+	return row.Scan(
+		&c.ID,
+		&c.CategoryID,
+		&c.Status,
+		&c.Notes,
+		&c.OpenedBy,
+		&c.ClosedBy,
+		&c.AdjustedProducts,
+		&c.Version,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+		&c.ClosedAt,
+	)
+}
+
+// loadItems preenche count.Items com o total contado de cada produto.
+func loadItems(ctx context.Context, q querier, count *models.InventoryCount) error {
+	const query = `
+		SELECT i.product_id, p.product_name, COALESCE(p.barcode, ''), i.counted_quantity, i.entries, i.counted_by, i.updated_at
+		FROM inventory_count_items i
+		JOIN products p ON p.id = i.product_id
+		WHERE i.inventory_count_id = $1
+		ORDER BY p.product_name ASC, i.product_id ASC;
+	`
+
+	rows, err := q.Query(ctx, query, count.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	count.Items = make([]models.CountItem, 0)
+	for rows.Next() {
+		item := models.CountItem{InventoryCountID: count.ID}
+		if err := rows.Scan(
+			&item.ProductID,
+			&item.ProductName,
+			&item.Barcode,
+			&item.CountedQuantity,
+			&item.Entries,
+			&item.CountedBy,
+			&item.UpdatedAt,
+		); err != nil {
+			return fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		count.Items = append(count.Items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return nil
+}
+
+// scanVarianceLines lê linhas no formato
+// (id, nome, barcode, saldo, contado, custo).
+func scanVarianceLines(rows pgx.Rows) ([]*models.VarianceLine, error) {
+	defer rows.Close()
+
+	lines := make([]*models.VarianceLine, 0)
+	for rows.Next() {
+		var l models.VarianceLine
+		if err := rows.Scan(
+			&l.ProductID,
+			&l.ProductName,
+			&l.Barcode,
+			&l.SystemQuantity,
+			&l.CountedQuantity,
+			&l.CostPrice,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		lines = append(lines, &l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return lines, nil
+}
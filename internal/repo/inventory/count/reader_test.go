@@ -0,0 +1,178 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func containsAll(parts ...string) any {
+	return mock.MatchedBy(func(q string) bool {
+		for _, p := range parts {
+			if !strings.Contains(q, p) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func emptyRows() *mockDb.MockRows {
+	rows := new(mockDb.MockRows)
+	rows.On("Next").Return(false)
+	rows.On("Err").Return(nil)
+	rows.On("Close").Return()
+	return rows
+}
+
+// countRow segue a ordem de countColumns.
+func countRow(id int64, status string, now time.Time) *mockDb.MockRow {
+	return &mockDb.MockRow{Values: []any{id, int64(3), status, "setor A", int64(5), nil, 0, 1, now, now, nil}}
+}
+
+func TestNewInventoryCount(t *testing.T) {
+	result := NewInventoryCount(nil)
+
+	assert.NotNil(t, result)
+	_, ok := result.(*inventoryCountRepo)
+	assert.True(t, ok, "Expected result to be of type *inventoryCountRepo")
+}
+
+func TestInventoryCountRepo_GetByID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("carrega a sessão com os itens contados", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &inventoryCountRepo{db: mockDB}
+
+		mockDB.On("QueryRow", ctx, containsAll("FROM inventory_counts", "id = $1"), []any{int64(1)}).
+			Return(countRow(1, models.StatusOpen, now))
+		items := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{int64(7), "Caneta", "789", 12, 3, int64(5), now}},
+		}}
+		mockDB.On("Query", ctx, containsAll("FROM inventory_count_items"), []any{int64(1)}).Return(items, nil)
+
+		count, err := repo.GetByID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), *count.CategoryID)
+		assert.Equal(t, "setor A", count.Notes)
+		assert.Equal(t, int64(5), *count.OpenedBy)
+		assert.Nil(t, count.ClosedBy)
+		assert.Len(t, count.Items, 1)
+		assert.Equal(t, int64(1), count.Items[0].InventoryCountID)
+		assert.Equal(t, 12, count.Items[0].CountedQuantity)
+		assert.Equal(t, 3, count.Items[0].Entries)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("sessão inexistente", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &inventoryCountRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		_, err := repo.GetByID(ctx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("erro ao carregar os itens", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &inventoryCountRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(countRow(1, models.StatusOpen, now))
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.GetByID(ctx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestInventoryCountRepo_List(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("filtra por status", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &inventoryCountRepo{db: mockDB}
+
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{countRow(2, models.StatusOpen, now)}}
+		mockDB.On("Query", ctx, containsAll("FROM inventory_counts", "status = $1", "ORDER BY id desc LIMIT 50 OFFSET 0"), []any{models.StatusOpen}).
+			Return(rows, nil)
+		mockDB.OnCount(1)
+
+		page, err := repo.List(ctx, &models.InventoryCountFilter{Status: models.StatusOpen})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), page.Total)
+		assert.Equal(t, int64(2), page.Items[0].ID)
+		assert.Nil(t, page.Items[0].Items)
+	})
+
+	t.Run("filtra por categoria", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &inventoryCountRepo{db: mockDB}
+		category := int64(3)
+
+		mockDB.On("Query", ctx, containsAll("category_id = $1"), []any{category}).Return(emptyRows(), nil)
+		mockDB.OnCount(0)
+
+		page, err := repo.List(ctx, &models.InventoryCountFilter{CategoryID: &category})
+
+		assert.NoError(t, err)
+		assert.Empty(t, page.Items)
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &inventoryCountRepo{db: mockDB}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.List(ctx, &models.InventoryCountFilter{})
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestInventoryCountRepo_Variance(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("inclui produtos do escopo não contados", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &inventoryCountRepo{db: mockDB}
+
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{int64(7), "Caneta", "789", 10, 8, 2.5}},
+			{Values: []any{int64(8), "Lápis", "", 4, nil, 1.0}},
+		}}
+		mockDB.On("Query", ctx, containsAll("LEFT JOIN inventory_count_items", "product_category_relations"), []any{int64(1)}).
+			Return(rows, nil)
+
+		lines, err := repo.Variance(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, lines, 2)
+		assert.Equal(t, -2, lines[0].Variance())
+		assert.False(t, lines[1].Counted())
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &inventoryCountRepo{db: mockDB}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.Variance(ctx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
@@ -0,0 +1,198 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/inventory"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+type inventoryCountTxRepo struct {
+	db repo.DBTransactor
+}
+
+func NewInventoryCountTx(db repo.DBTransactor) iface.InventoryCountTx {
+	return &inventoryCountTxRepo{db: db}
+}
+
+func (r *inventoryCountTxRepo) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return r.db.BeginTx(ctx, pgx.TxOptions{})
+}
+
+func (r *inventoryCountTxRepo) CreateTx(ctx context.Context, tx pgx.Tx, count *models.InventoryCount) (*models.InventoryCount, error) {
+	const query = `
+		INSERT INTO inventory_counts (category_id, status, notes, opened_by, created_at, updated_at)
+		VALUES ($1, $2, NULLIF($3, ''), $4, NOW(), NOW())
+		RETURNING id, version, created_at, updated_at;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		count.CategoryID,
+		count.Status,
+		count.Notes,
+		count.OpenedBy,
+	).Scan(&count.ID, &count.Version, &count.CreatedAt, &count.UpdatedAt)
+	if err != nil {
+		if errMsgPg.IsForeignKeyViolation(err) {
+			return nil, errMsg.ErrDBInvalidForeignKey
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	count.Items = make([]models.CountItem, 0)
+	return count, nil
+}
+
+// GetByIDForUpdateTx bloqueia a sessão até o fim da transação, sem carregar
+// as linhas contadas.
+func (r *inventoryCountTxRepo) GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.InventoryCount, error) {
+	query := `SELECT ` + countColumns + ` FROM inventory_counts WHERE id = $1 FOR UPDATE;`
+
+	var count models.InventoryCount
+	if err := scanCountRow(tx.QueryRow(ctx, query, id), &count); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return &count, nil
+}
+
+// RecordTx soma a leitura ao total contado do produto, ou o substitui quando
+// entry.Recount. O produto é localizado pelo ID ou pelo código de barras e
+// precisa pertencer à categoria da sessão, se houver; caso contrário a
+// leitura é recusada com ErrNotFound.
+func (r *inventoryCountTxRepo) RecordTx(ctx context.Context, tx pgx.Tx, countID int64, entry *models.CountEntry) (*models.CountItem, error) {
+	const query = `
+		WITH target AS (
+			SELECT c.id AS count_id, p.id AS product_id, p.product_name, COALESCE(p.barcode, '') AS barcode
+			FROM inventory_counts c
+			JOIN products p
+			  ON p.id = $2::bigint
+			  OR ($2::bigint IS NULL AND p.barcode = NULLIF($3::text, ''))
+			WHERE c.id = $1
+			  AND (
+			        c.category_id IS NULL
+			     OR EXISTS (
+			            SELECT 1
+			            FROM product_category_relations r
+			            WHERE r.product_id = p.id
+			              AND r.category_id = c.category_id
+			        )
+			  )
+		), saved AS (
+			INSERT INTO inventory_count_items (inventory_count_id, product_id, counted_quantity, counted_by)
+			SELECT count_id, product_id, $4, $6
+			FROM target
+			ON CONFLICT (inventory_count_id, product_id) DO UPDATE
+			SET counted_quantity = CASE
+			        WHEN $5::bool THEN EXCLUDED.counted_quantity
+			        ELSE inventory_count_items.counted_quantity + EXCLUDED.counted_quantity
+			    END,
+			    entries    = inventory_count_items.entries + 1,
+			    counted_by = EXCLUDED.counted_by,
+			    updated_at = NOW()
+			RETURNING inventory_count_id, product_id, counted_quantity, entries, counted_by, updated_at
+		)
+		SELECT s.inventory_count_id, s.product_id, t.product_name, t.barcode, s.counted_quantity, s.entries, s.counted_by, s.updated_at
+		FROM saved s
+		JOIN target t ON t.product_id = s.product_id;
+	`
+
+	var item models.CountItem
+	err := tx.QueryRow(ctx, query,
+		countID,
+		entry.ProductID,
+		entry.Barcode,
+		entry.Quantity,
+		entry.Recount,
+		entry.CountedBy,
+	).Scan(
+		&item.InventoryCountID,
+		&item.ProductID,
+		&item.ProductName,
+		&item.Barcode,
+		&item.CountedQuantity,
+		&item.Entries,
+		&item.CountedBy,
+		&item.UpdatedAt,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, fmt.Errorf("%w: produto %s não encontrado no escopo da contagem", errMsg.ErrNotFound, entryRef(entry))
+		case errMsgPg.IsForeignKeyViolation(err):
+			return nil, errMsg.ErrDBInvalidForeignKey
+		default:
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+		}
+	}
+
+	return &item, nil
+}
+
+func entryRef(entry *models.CountEntry) string {
+	if entry.ProductID != nil {
+		return fmt.Sprintf("%d", *entry.ProductID)
+	}
+	return fmt.Sprintf("com código %q", entry.Barcode)
+}
+
+// CountedLinesForUpdateTx devolve os produtos contados com o saldo atual e
+// bloqueia suas linhas, em ordem de ID, até o fim da transação. Assim o
+// saldo usado no ajuste não muda entre a leitura e o fechamento.
+func (r *inventoryCountTxRepo) CountedLinesForUpdateTx(ctx context.Context, tx pgx.Tx, countID int64) ([]*models.VarianceLine, error) {
+	const query = `
+		SELECT p.id, p.product_name, COALESCE(p.barcode, ''), p.stock_quantity, i.counted_quantity, p.cost_price
+		FROM inventory_count_items i
+		JOIN products p ON p.id = i.product_id
+		WHERE i.inventory_count_id = $1
+		ORDER BY p.id ASC
+		FOR UPDATE OF p;
+	`
+
+	rows, err := tx.Query(ctx, query, countID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return scanVarianceLines(rows)
+}
+
+// UpdateStatusTx grava o novo status. Ao sair de open registra quem encerrou,
+// quando e quantos produtos foram ajustados.
+func (r *inventoryCountTxRepo) UpdateStatusTx(ctx context.Context, tx pgx.Tx, count *models.InventoryCount) error {
+	const query = `
+		UPDATE inventory_counts
+		SET status            = $2,
+		    closed_by         = $3,
+		    adjusted_products = $4,
+		    closed_at         = CASE WHEN $2 = 'open' THEN NULL ELSE NOW() END,
+		    version           = version + 1,
+		    updated_at        = NOW()
+		WHERE id = $1
+		RETURNING version, updated_at, closed_at;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		count.ID,
+		count.Status,
+		count.ClosedBy,
+		count.AdjustedProducts,
+	).Scan(&count.Version, &count.UpdatedAt, &count.ClosedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errMsg.ErrNotFound
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	return nil
+}
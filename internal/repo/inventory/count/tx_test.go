@@ -0,0 +1,235 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewInventoryCountTx(t *testing.T) {
+	result := NewInventoryCountTx(nil)
+
+	assert.NotNil(t, result)
+	_, ok := result.(*inventoryCountTxRepo)
+	assert.True(t, ok, "Expected result to be of type *inventoryCountTxRepo")
+}
+
+func TestInventoryCountTx_BeginTx(t *testing.T) {
+	mockDB := new(mockDb.MockDBTransactor)
+	repo := &inventoryCountTxRepo{db: mockDB}
+	ctx := context.Background()
+
+	mockTx := new(mockDb.MockTx)
+	mockDB.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+
+	tx, err := repo.BeginTx(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, mockTx, tx)
+}
+
+func TestInventoryCountTx_CreateTx(t *testing.T) {
+	ctx := context.Background()
+	category := int64(3)
+	user := int64(5)
+	newCount := func() *models.InventoryCount {
+		return &models.InventoryCount{CategoryID: &category, Status: models.StatusOpen, Notes: "setor A", OpenedBy: &user}
+	}
+	args := []any{&category, models.StatusOpen, "setor A", &user}
+
+	t.Run("grava a sessão", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &inventoryCountTxRepo{}
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, containsAll("INSERT INTO inventory_counts"), args).
+			Return(&mockDb.MockRow{Values: []any{int64(1), 1, now, now}})
+
+		count, err := repo.CreateTx(ctx, mockTx, newCount())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count.ID)
+		assert.NotNil(t, count.Items)
+	})
+
+	t.Run("categoria inexistente", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &inventoryCountTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}})
+
+		_, err := repo.CreateTx(ctx, mockTx, newCount())
+
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &inventoryCountTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.CreateTx(ctx, mockTx, newCount())
+
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
+
+func TestInventoryCountTx_GetByIDForUpdateTx(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("bloqueia a sessão", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &inventoryCountTxRepo{}
+
+		mockTx.On("QueryRow", ctx, containsAll("FOR UPDATE"), []any{int64(1)}).
+			Return(countRow(1, models.StatusOpen, time.Now()))
+
+		count, err := repo.GetByIDForUpdateTx(ctx, mockTx, 1)
+
+		assert.NoError(t, err)
+		assert.True(t, count.IsOpen())
+	})
+
+	t.Run("sessão inexistente", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &inventoryCountTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		_, err := repo.GetByIDForUpdateTx(ctx, mockTx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+}
+
+func TestInventoryCountTx_RecordTx(t *testing.T) {
+	ctx := context.Background()
+	user := int64(5)
+	now := time.Now()
+
+	t.Run("leitura por código de barras", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &inventoryCountTxRepo{}
+		entry := &models.CountEntry{Barcode: "789", Quantity: 1, CountedBy: &user}
+
+		mockTx.On("QueryRow", ctx, containsAll("ON CONFLICT (inventory_count_id, product_id)", "entries    = inventory_count_items.entries + 1"),
+			[]any{int64(1), (*int64)(nil), "789", 1, false, &user}).
+			Return(&mockDb.MockRow{Values: []any{int64(1), int64(7), "Caneta", "789", 4, 4, int64(5), now}})
+
+		item, err := repo.RecordTx(ctx, mockTx, 1, entry)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), item.ProductID)
+		assert.Equal(t, 4, item.CountedQuantity)
+		assert.Equal(t, 4, item.Entries)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("produto fora do escopo", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &inventoryCountTxRepo{}
+		id := int64(9)
+
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		_, err := repo.RecordTx(ctx, mockTx, 1, &models.CountEntry{ProductID: &id, Quantity: 2})
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+		assert.Contains(t, err.Error(), "produto 9")
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &inventoryCountTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.RecordTx(ctx, mockTx, 1, &models.CountEntry{Barcode: "789", Quantity: 1})
+
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
+
+func TestInventoryCountTx_CountedLinesForUpdateTx(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("bloqueia os produtos contados", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &inventoryCountTxRepo{}
+
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{int64(7), "Caneta", "789", 10, 12, 2.5}},
+		}}
+		mockTx.On("Query", ctx, containsAll("FOR UPDATE OF p", "ORDER BY p.id"), []any{int64(1)}).Return(rows, nil)
+
+		lines, err := repo.CountedLinesForUpdateTx(ctx, mockTx, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, lines, 1)
+		assert.Equal(t, 2, lines[0].Variance())
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &inventoryCountTxRepo{}
+
+		mockTx.On("Query", ctx, mock.Anything, mock.Anything).Return((*mockDb.MockRows)(nil), errors.New("db down"))
+
+		_, err := repo.CountedLinesForUpdateTx(ctx, mockTx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestInventoryCountTx_UpdateStatusTx(t *testing.T) {
+	ctx := context.Background()
+	user := int64(5)
+
+	t.Run("fecha a sessão", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &inventoryCountTxRepo{}
+		now := time.Now()
+		count := &models.InventoryCount{ID: 1, Status: models.StatusClosed, ClosedBy: &user, AdjustedProducts: 2}
+
+		mockTx.On("QueryRow", ctx, containsAll("UPDATE inventory_counts"), []any{int64(1), models.StatusClosed, &user, 2}).
+			Return(&mockDb.MockRow{Values: []any{2, now, now}})
+
+		err := repo.UpdateStatusTx(ctx, mockTx, count)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count.Version)
+		assert.Equal(t, now, *count.ClosedAt)
+	})
+
+	t.Run("sessão inexistente", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &inventoryCountTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.UpdateStatusTx(ctx, mockTx, &models.InventoryCount{ID: 1})
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &inventoryCountTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		err := repo.UpdateStatusTx(ctx, mockTx, &models.InventoryCount{ID: 1})
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
@@ -0,0 +1,13 @@
+package repo
+
+import (
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+)
+
+type locationRepo struct {
+	db repo.DBExecutor
+}
+
+func NewLocation(db repo.DBExecutor) Location {
+	return &locationRepo{db: db}
+}
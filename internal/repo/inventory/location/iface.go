@@ -0,0 +1,8 @@
+package repo
+
+import iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/inventory"
+
+type Location interface {
+	iface.LocationReader
+	iface.LocationWriter
+}
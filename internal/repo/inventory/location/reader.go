@@ -0,0 +1,67 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+const locationColumns = `id, code, name, kind, is_default, version, created_at, updated_at`
+
+func scanLocation(row pgx.Row, l *models.Location) error {
+	return row.Scan(
+		&l.ID,
+		&l.Code,
+		&l.Name,
+		&l.Kind,
+		&l.IsDefault,
+		&l.Version,
+		&l.CreatedAt,
+		&l.UpdatedAt,
+	)
+}
+
+func (r *locationRepo) GetByID(ctx context.Context, id int64) (*models.Location, error) {
+	query := `SELECT ` + locationColumns + ` FROM locations WHERE id = $1;`
+
+	var location models.Location
+	if err := scanLocation(r.db.QueryRow(ctx, query, id), &location); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return &location, nil
+}
+
+// List devolve todos os locais, com o padrão primeiro. São poucos, por isso
+// não há paginação.
+func (r *locationRepo) List(ctx context.Context) ([]*models.Location, error) {
+	query := `SELECT ` + locationColumns + ` FROM locations ORDER BY is_default DESC, code ASC;`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	locations := make([]*models.Location, 0)
+	for rows.Next() {
+		var l models.Location
+		if err := scanLocation(rows, &l); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		locations = append(locations, &l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return locations, nil
+}
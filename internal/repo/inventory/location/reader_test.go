@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// locationRow segue a ordem de locationColumns.
+func locationRow(id int64, code string, isDefault bool, now time.Time) *mockDb.MockRow {
+	return &mockDb.MockRow{Values: []any{id, code, "Loja " + code, models.KindStore, isDefault, 1, now, now}}
+}
+
+func TestNewLocation(t *testing.T) {
+	result := NewLocation(nil)
+
+	assert.NotNil(t, result)
+	_, ok := result.(*locationRepo)
+	assert.True(t, ok, "Expected result to be of type *locationRepo")
+}
+
+func TestLocationRepo_GetByID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("retorna o local", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &locationRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, []any{int64(2)}).Return(locationRow(2, "CENTRO", false, now))
+
+		location, err := repo.GetByID(ctx, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "CENTRO", location.Code)
+		assert.Equal(t, models.KindStore, location.Kind)
+		assert.False(t, location.IsDefault)
+		assert.Equal(t, 1, location.Version)
+	})
+
+	t.Run("local inexistente", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &locationRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		_, err := repo.GetByID(ctx, 2)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &locationRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.GetByID(ctx, 2)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestLocationRepo_List(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("lista os locais", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &locationRepo{db: mockDB}
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			locationRow(1, "PRINCIPAL", true, now),
+			locationRow(2, "CENTRO", false, now),
+		}}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
+
+		locations, err := repo.List(ctx)
+
+		assert.NoError(t, err)
+		assert.Len(t, locations, 2)
+		assert.True(t, locations[0].IsDefault)
+		assert.Equal(t, "CENTRO", locations[1].Code)
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &locationRepo{db: mockDB}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.List(ctx)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("erro na leitura", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &locationRepo{db: mockDB}
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan")}}}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
+
+		_, err := repo.List(ctx)
+
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+}
@@ -0,0 +1,77 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+// Create cadastra um local comum; o padrão só é criado pela migração.
+func (r *locationRepo) Create(ctx context.Context, location *models.Location) (*models.Location, error) {
+	const query = `
+		INSERT INTO locations (code, name, kind, is_default, created_at, updated_at)
+		VALUES ($1, $2, $3, FALSE, NOW(), NOW())
+		RETURNING id, is_default, version, created_at, updated_at;
+	`
+
+	err := r.db.QueryRow(ctx, query,
+		location.Code,
+		location.Name,
+		location.Kind,
+	).Scan(&location.ID, &location.IsDefault, &location.Version, &location.CreatedAt, &location.UpdatedAt)
+	if err != nil {
+		if ok, _ := errMsgPg.IsUniqueViolation(err); ok {
+			return nil, fmt.Errorf("%w: código %s", errMsg.ErrDuplicate, location.Code)
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	return location, nil
+}
+
+// Update altera código, nome e tipo. is_default não é editável.
+func (r *locationRepo) Update(ctx context.Context, location *models.Location) error {
+	const query = `
+		UPDATE locations
+		SET code       = $1,
+		    name       = $2,
+		    kind       = $3,
+		    updated_at = NOW(),
+		    version    = version + 1
+		WHERE id = $4 AND version = $5
+		RETURNING is_default, version, created_at, updated_at;
+	`
+
+	err := r.db.QueryRow(ctx, query,
+		location.Code,
+		location.Name,
+		location.Kind,
+		location.ID,
+		location.Version,
+	).Scan(&location.IsDefault, &location.Version, &location.CreatedAt, &location.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			var exists bool
+			checkQuery := `SELECT EXISTS(SELECT 1 FROM locations WHERE id = $1)`
+			if err := r.db.QueryRow(ctx, checkQuery, location.ID).Scan(&exists); err != nil {
+				return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+			}
+
+			if !exists {
+				return errMsg.ErrNotFound
+			}
+			return errMsg.ErrVersionConflict
+		}
+		if ok, _ := errMsgPg.IsUniqueViolation(err); ok {
+			return fmt.Errorf("%w: código %s", errMsg.ErrDuplicate, location.Code)
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLocationRepo_Create(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	newLocation := func() *models.Location {
+		return &models.Location{Code: "DEP1", Name: "Depósito", Kind: models.KindWarehouse}
+	}
+
+	t.Run("cria o local", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &locationRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, []any{"DEP1", "Depósito", models.KindWarehouse}).
+			Return(&mockDb.MockRow{Values: []any{int64(3), false, 1, now, now}})
+
+		location, err := repo.Create(ctx, newLocation())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), location.ID)
+		assert.Equal(t, 1, location.Version)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("código repetido", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &locationRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).
+			Return(&mockDb.MockRow{Err: errMsgPg.NewUniqueViolation("locations_code_key")})
+
+		_, err := repo.Create(ctx, newLocation())
+
+		assert.ErrorIs(t, err, errMsg.ErrDuplicate)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &locationRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.Create(ctx, newLocation())
+
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
+
+func TestLocationRepo_Update(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	newLocation := func() *models.Location {
+		return &models.Location{ID: 3, Code: "DEP1", Name: "Depósito", Kind: models.KindWarehouse, Version: 1}
+	}
+
+	t.Run("atualiza e incrementa a versão", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &locationRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, []any{"DEP1", "Depósito", models.KindWarehouse, int64(3), 1}).
+			Return(&mockDb.MockRow{Values: []any{false, 2, now, now}})
+		location := newLocation()
+
+		err := repo.Update(ctx, location)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, location.Version)
+	})
+
+	t.Run("local inexistente", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &locationRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, []any{"DEP1", "Depósito", models.KindWarehouse, int64(3), 1}).
+			Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+		mockDB.On("QueryRow", ctx, mock.Anything, []any{int64(3)}).Return(&mockDb.MockRow{Values: []any{false}})
+
+		err := repo.Update(ctx, newLocation())
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("conflito de versão", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &locationRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, []any{"DEP1", "Depósito", models.KindWarehouse, int64(3), 1}).
+			Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+		mockDB.On("QueryRow", ctx, mock.Anything, []any{int64(3)}).Return(&mockDb.MockRow{Values: []any{true}})
+
+		err := repo.Update(ctx, newLocation())
+
+		assert.ErrorIs(t, err, errMsg.ErrVersionConflict)
+	})
+
+	t.Run("código repetido", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &locationRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).
+			Return(&mockDb.MockRow{Err: errMsgPg.NewUniqueViolation("locations_code_key")})
+
+		err := repo.Update(ctx, newLocation())
+
+		assert.ErrorIs(t, err, errMsg.ErrDuplicate)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &locationRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		err := repo.Update(ctx, newLocation())
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
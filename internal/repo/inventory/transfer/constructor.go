@@ -0,0 +1,13 @@
+package repo
+
+import (
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+)
+
+type transferRepo struct {
+	db repo.DBExecutor
+}
+
+func NewTransfer(db repo.DBExecutor) Transfer {
+	return &transferRepo{db: db}
+}
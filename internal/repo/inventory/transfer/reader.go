@@ -0,0 +1,106 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+	pagination "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/pagination"
+	"github.com/jackc/pgx/v5"
+)
+
+var allowedTransferSortFields = map[string]string{
+	"id":          "id",
+	"created_at":  "created_at",
+	"received_at": "received_at",
+	"status":      "status",
+}
+
+var transferOrderBy = builder.OrderBy{
+	Fields:       allowedTransferSortFields,
+	DefaultField: "id",
+	DefaultOrder: "desc",
+}
+
+func (r *transferRepo) GetByID(ctx context.Context, id int64) (*models.Transfer, error) {
+	query := `SELECT ` + transferColumns + ` FROM stock_transfers WHERE id = $1;`
+
+	var transfer models.Transfer
+	if err := scanTransferRow(r.db.QueryRow(ctx, query, id), &transfer); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	if err := loadItems(ctx, r.db, &transfer); err != nil {
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+// List devolve as transferências sem os itens. LocationID casa tanto a
+// origem quanto o destino.
+func (r *transferRepo) List(ctx context.Context, f *models.TransferFilter) (*commonFilter.Page[*models.Transfer], error) {
+	base := f.BaseFilter.WithDefaults()
+
+	b := builder.NewQueryBuilderSql(`SELECT ` + transferColumns + ` FROM stock_transfers`)
+
+	b.Eq("status", f.Status)
+	b.EqAny(f.LocationID, "from_location_id", "to_location_id")
+
+	sortField, sortOrder := transferOrderBy.Resolve(f.SortBy, f.SortOrder)
+
+	args := b.GetArgs()
+	tail, pageArgs, err := pagination.Clause(base, sortField, sortOrder, b.NextArgPos())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, b.GetQuery()+tail, append(args, pageArgs...)...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	transfers := make([]*models.Transfer, 0)
+	for rows.Next() {
+		var t models.Transfer
+		if err := scanTransferRow(rows, &t); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		transfers = append(transfers, &t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	total, err := pagination.Count(ctx, r.db, "stock_transfers", b.Where(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonFilter.NewPage(transfers, total, base, func(t *models.Transfer) (any, int64) {
+		return transferSortValue(t, sortField), t.ID
+	}), nil
+}
+
+// transferSortValue devolve o valor da coluna de ordenação field na transferência, gravado
+// no cursor da próxima página.
+func transferSortValue(t *models.Transfer, field string) any {
+	switch field {
+	case "created_at":
+		return t.CreatedAt
+	case "received_at":
+		return t.ReceivedAt
+	case "status":
+		return t.Status
+	}
+	return t.ID
+}
@@ -0,0 +1,76 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+const transferColumns = `
+	id,
+	from_location_id,
+	to_location_id,
+	status,
+	COALESCE(notes, ''),
+	created_by,
+	received_by,
+	version,
+	created_at,
+	updated_at,
+	received_at
+`
+
+// querier é atendido tanto pelo pool quanto por pgx.Tx.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func scanTransferRow(row pgx.Row, t *models.Transfer) error {
+	return row.Scan(
+		&t.ID,
+		&t.FromLocationID,
+		&t.ToLocationID,
+		&t.Status,
+		&t.Notes,
+		&t.CreatedBy,
+		&t.ReceivedBy,
+		&t.Version,
+		&t.CreatedAt,
+		&t.UpdatedAt,
+		&t.ReceivedAt,
+	)
+}
+
+// loadItems preenche transfer.Items em ordem de produto.
+func loadItems(ctx context.Context, q querier, transfer *models.Transfer) error {
+	const query = `
+		SELECT id, product_id, quantity
+		FROM stock_transfer_items
+		WHERE stock_transfer_id = $1
+		ORDER BY product_id ASC;
+	`
+
+	rows, err := q.Query(ctx, query, transfer.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	transfer.Items = make([]models.TransferItem, 0)
+	for rows.Next() {
+		item := models.TransferItem{StockTransferID: transfer.ID}
+		if err := rows.Scan(&item.ID, &item.ProductID, &item.Quantity); err != nil {
+			return fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		transfer.Items = append(transfer.Items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func containsAll(parts ...string) any {
+	return mock.MatchedBy(func(q string) bool {
+		for _, p := range parts {
+			if !strings.Contains(q, p) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func emptyRows() *mockDb.MockRows {
+	rows := new(mockDb.MockRows)
+	rows.On("Next").Return(false)
+	rows.On("Err").Return(nil)
+	rows.On("Close").Return()
+	return rows
+}
+
+// transferRow segue a ordem de transferColumns.
+func transferRow(id int64, status string, now time.Time) *mockDb.MockRow {
+	return &mockDb.MockRow{Values: []any{id, int64(1), int64(2), status, "reposição", int64(5), nil, 1, now, now, nil}}
+}
+
+func TestNewTransfer(t *testing.T) {
+	result := NewTransfer(nil)
+
+	assert.NotNil(t, result)
+	_, ok := result.(*transferRepo)
+	assert.True(t, ok, "Expected result to be of type *transferRepo")
+}
+
+func TestTransferRepo_GetByID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("carrega a transferência com os itens", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &transferRepo{db: mockDB}
+
+		mockDB.On("QueryRow", ctx, containsAll("FROM stock_transfers", "id = $1"), []any{int64(1)}).
+			Return(transferRow(1, models.StatusInTransit, now))
+		items := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{int64(10), int64(7), 4}},
+			{Values: []any{int64(11), int64(8), 1}},
+		}}
+		mockDB.On("Query", ctx, containsAll("FROM stock_transfer_items"), []any{int64(1)}).Return(items, nil)
+
+		transfer, err := repo.GetByID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), transfer.ToLocationID)
+		assert.Equal(t, "reposição", transfer.Notes)
+		assert.Equal(t, int64(5), *transfer.CreatedBy)
+		assert.Nil(t, transfer.ReceivedAt)
+		assert.Len(t, transfer.Items, 2)
+		assert.Equal(t, int64(1), transfer.Items[0].StockTransferID)
+		assert.Equal(t, 4, transfer.Items[0].Quantity)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("transferência inexistente", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &transferRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		_, err := repo.GetByID(ctx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("erro ao carregar os itens", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &transferRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(transferRow(1, models.StatusInTransit, now))
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.GetByID(ctx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestTransferRepo_List(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("filtra por status", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &transferRepo{db: mockDB}
+
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{transferRow(2, models.StatusInTransit, now)}}
+		mockDB.On("Query", ctx, containsAll("FROM stock_transfers", "status = $1", "ORDER BY id desc LIMIT 50 OFFSET 0"), []any{models.StatusInTransit}).
+			Return(rows, nil)
+		mockDB.OnCount(1)
+
+		page, err := repo.List(ctx, &models.TransferFilter{Status: models.StatusInTransit})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), page.Total)
+		assert.Equal(t, int64(2), page.Items[0].ID)
+		assert.Nil(t, page.Items[0].Items)
+	})
+
+	t.Run("filtra por local de origem ou destino", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &transferRepo{db: mockDB}
+		location := int64(2)
+
+		mockDB.On("Query", ctx, containsAll("(from_location_id = $1 OR to_location_id = $1)"), []any{location}).Return(emptyRows(), nil)
+		mockDB.OnCount(0)
+
+		page, err := repo.List(ctx, &models.TransferFilter{LocationID: &location})
+
+		assert.NoError(t, err)
+		assert.Empty(t, page.Items)
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &transferRepo{db: mockDB}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.List(ctx, &models.TransferFilter{})
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
@@ -0,0 +1,123 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/inventory"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+type transferTxRepo struct {
+	db repo.DBTransactor
+}
+
+func NewTransferTx(db repo.DBTransactor) iface.TransferTx {
+	return &transferTxRepo{db: db}
+}
+
+func (r *transferTxRepo) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return r.db.BeginTx(ctx, pgx.TxOptions{})
+}
+
+// CreateTx grava a transferência e seus itens. Os saldos são movimentados
+// pelo serviço na mesma transação.
+func (r *transferTxRepo) CreateTx(ctx context.Context, tx pgx.Tx, transfer *models.Transfer) (*models.Transfer, error) {
+	const queryTransfer = `
+		INSERT INTO stock_transfers (from_location_id, to_location_id, status, notes, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5, NOW(), NOW())
+		RETURNING id, version, created_at, updated_at;
+	`
+
+	const queryItem = `
+		INSERT INTO stock_transfer_items (stock_transfer_id, product_id, quantity)
+		VALUES ($1, $2, $3)
+		RETURNING id;
+	`
+
+	err := tx.QueryRow(ctx, queryTransfer,
+		transfer.FromLocationID,
+		transfer.ToLocationID,
+		transfer.Status,
+		transfer.Notes,
+		transfer.CreatedBy,
+	).Scan(&transfer.ID, &transfer.Version, &transfer.CreatedAt, &transfer.UpdatedAt)
+	if err != nil {
+		if errMsgPg.IsForeignKeyViolation(err) {
+			return nil, errMsg.ErrDBInvalidForeignKey
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	for i := range transfer.Items {
+		item := &transfer.Items[i]
+		item.StockTransferID = transfer.ID
+
+		if err := tx.QueryRow(ctx, queryItem,
+			item.StockTransferID,
+			item.ProductID,
+			item.Quantity,
+		).Scan(&item.ID); err != nil {
+			if errMsgPg.IsForeignKeyViolation(err) {
+				return nil, errMsg.ErrDBInvalidForeignKey
+			}
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+		}
+	}
+
+	return transfer, nil
+}
+
+// GetByIDForUpdateTx bloqueia a transferência até o fim da transação e
+// carrega os itens, necessários para movimentar os saldos.
+func (r *transferTxRepo) GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Transfer, error) {
+	query := `SELECT ` + transferColumns + ` FROM stock_transfers WHERE id = $1 FOR UPDATE;`
+
+	var transfer models.Transfer
+	if err := scanTransferRow(tx.QueryRow(ctx, query, id), &transfer); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	if err := loadItems(ctx, tx, &transfer); err != nil {
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+// UpdateStatusTx grava o novo status. No recebimento registra quem recebeu e
+// quando.
+func (r *transferTxRepo) UpdateStatusTx(ctx context.Context, tx pgx.Tx, transfer *models.Transfer) error {
+	const query = `
+		UPDATE stock_transfers
+		SET status      = $2,
+		    received_by = $3,
+		    received_at = CASE WHEN $2 = 'received' THEN NOW() ELSE NULL END,
+		    version     = version + 1,
+		    updated_at  = NOW()
+		WHERE id = $1
+		RETURNING version, updated_at, received_at;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		transfer.ID,
+		transfer.Status,
+		transfer.ReceivedBy,
+	).Scan(&transfer.Version, &transfer.UpdatedAt, &transfer.ReceivedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errMsg.ErrNotFound
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	return nil
+}
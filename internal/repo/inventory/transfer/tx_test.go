@@ -0,0 +1,192 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewTransferTx(t *testing.T) {
+	result := NewTransferTx(nil)
+
+	assert.NotNil(t, result)
+	_, ok := result.(*transferTxRepo)
+	assert.True(t, ok, "Expected result to be of type *transferTxRepo")
+}
+
+func TestTransferTx_BeginTx(t *testing.T) {
+	mockDB := new(mockDb.MockDBTransactor)
+	repo := &transferTxRepo{db: mockDB}
+	ctx := context.Background()
+
+	mockTx := new(mockDb.MockTx)
+	mockDB.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+
+	tx, err := repo.BeginTx(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, mockTx, tx)
+}
+
+func TestTransferTx_CreateTx(t *testing.T) {
+	ctx := context.Background()
+	user := int64(5)
+	newTransfer := func() *models.Transfer {
+		return &models.Transfer{
+			FromLocationID: 1,
+			ToLocationID:   2,
+			Status:         models.StatusInTransit,
+			Notes:          "reposição",
+			CreatedBy:      &user,
+			Items:          []models.TransferItem{{ProductID: 7, Quantity: 4}},
+		}
+	}
+	args := []any{int64(1), int64(2), models.StatusInTransit, "reposição", &user}
+
+	t.Run("grava a transferência e os itens", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &transferTxRepo{}
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, containsAll("INSERT INTO stock_transfers"), args).
+			Return(&mockDb.MockRow{Values: []any{int64(9), 1, now, now}}).Once()
+		mockTx.On("QueryRow", ctx, containsAll("INSERT INTO stock_transfer_items"), []any{int64(9), int64(7), 4}).
+			Return(&mockDb.MockRow{Values: []any{int64(90)}}).Once()
+
+		transfer, err := repo.CreateTx(ctx, mockTx, newTransfer())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9), transfer.ID)
+		assert.Equal(t, int64(90), transfer.Items[0].ID)
+		assert.Equal(t, int64(9), transfer.Items[0].StockTransferID)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("local inexistente", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &transferTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).
+			Return(&mockDb.MockRow{Err: errMsgPg.NewForeignKeyViolation("stock_transfers_to_location_id_fkey")})
+
+		_, err := repo.CreateTx(ctx, mockTx, newTransfer())
+
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("produto inexistente", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &transferTxRepo{}
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).
+			Return(&mockDb.MockRow{Values: []any{int64(9), 1, now, now}}).Once()
+		mockTx.On("QueryRow", ctx, mock.Anything, []any{int64(9), int64(7), 4}).
+			Return(&mockDb.MockRow{Err: errMsgPg.NewForeignKeyViolation("stock_transfer_items_product_id_fkey")}).Once()
+
+		_, err := repo.CreateTx(ctx, mockTx, newTransfer())
+
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &transferTxRepo{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.CreateTx(ctx, mockTx, newTransfer())
+
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
+
+func TestTransferTx_GetByIDForUpdateTx(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("bloqueia e carrega os itens", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &transferTxRepo{}
+
+		mockTx.On("QueryRow", ctx, containsAll("FOR UPDATE"), []any{int64(1)}).Return(transferRow(1, models.StatusInTransit, now))
+		items := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Values: []any{int64(10), int64(7), 4}}}}
+		mockTx.On("Query", ctx, containsAll("FROM stock_transfer_items"), []any{int64(1)}).Return(items, nil)
+
+		transfer, err := repo.GetByIDForUpdateTx(ctx, mockTx, 1)
+
+		assert.NoError(t, err)
+		assert.True(t, transfer.InTransit())
+		assert.Len(t, transfer.Items, 1)
+	})
+
+	t.Run("transferência inexistente", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &transferTxRepo{}
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		_, err := repo.GetByIDForUpdateTx(ctx, mockTx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &transferTxRepo{}
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.GetByIDForUpdateTx(ctx, mockTx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestTransferTx_UpdateStatusTx(t *testing.T) {
+	ctx := context.Background()
+	user := int64(6)
+
+	t.Run("registra o recebimento", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &transferTxRepo{}
+		now := time.Now()
+		transfer := &models.Transfer{ID: 1, Status: models.StatusReceived, ReceivedBy: &user}
+
+		mockTx.On("QueryRow", ctx, containsAll("UPDATE stock_transfers"), []any{int64(1), models.StatusReceived, &user}).
+			Return(&mockDb.MockRow{Values: []any{2, now, now}})
+
+		err := repo.UpdateStatusTx(ctx, mockTx, transfer)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, transfer.Version)
+		assert.NotNil(t, transfer.ReceivedAt)
+	})
+
+	t.Run("transferência inexistente", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &transferTxRepo{}
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.UpdateStatusTx(ctx, mockTx, &models.Transfer{ID: 1})
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &transferTxRepo{}
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		err := repo.UpdateStatusTx(ctx, mockTx, &models.Transfer{ID: 1})
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
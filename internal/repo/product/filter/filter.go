@@ -3,11 +3,13 @@ package repo
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/product/filter"
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+	pagination "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/pagination"
 )
 
 var allowedProductSortFields = map[string]string{
@@ -23,7 +25,13 @@ var allowedProductSortFields = map[string]string{
 	"updated_at":     "updated_at",
 }
 
-func (r *productFilterRepo) Filter(ctx context.Context, filter *filter.ProductFilter) ([]*model.Product, error) {
+var productOrderBy = builder.OrderBy{
+	Fields:       allowedProductSortFields,
+	DefaultField: "created_at",
+	DefaultOrder: "asc",
+}
+
+func (r *productFilterRepo) Filter(ctx context.Context, filter *filter.ProductFilter) (*commonFilter.Page[*model.Product], error) {
 	// Validação do filtro
 	if filter == nil {
 		return nil, errMsg.ErrInvalidFilter
@@ -52,138 +60,33 @@ func (r *productFilterRepo) Filter(ctx context.Context, filter *filter.ProductFi
 			created_at,
 			updated_at
 		FROM products
-		WHERE 1=1
 	`
 
-	args := []any{}
-	argPos := 1
-
-	// Filtros com ILIKE seguro
-	if filter.ProductName != "" {
-		query += fmt.Sprintf(" AND product_name ILIKE $%d", argPos)
-		args = append(args, "%"+filter.ProductName+"%")
-		argPos++
-	}
-
-	if filter.Manufacturer != "" {
-		query += fmt.Sprintf(" AND manufacturer ILIKE $%d", argPos)
-		args = append(args, "%"+filter.Manufacturer+"%")
-		argPos++
-	}
-
-	if filter.Barcode != "" {
-		query += fmt.Sprintf(" AND barcode = $%d", argPos)
-		args = append(args, filter.Barcode)
-		argPos++
-	}
-
-	if filter.SupplierID != nil {
-		query += fmt.Sprintf(" AND supplier_id = $%d", argPos)
-		args = append(args, *filter.SupplierID)
-		argPos++
-	}
-
-	if filter.Status != nil {
-		query += fmt.Sprintf(" AND status = $%d", argPos)
-		args = append(args, *filter.Status)
-		argPos++
-	}
-
-	if filter.AllowDiscount != nil {
-		query += fmt.Sprintf(" AND allow_discount = $%d", argPos)
-		args = append(args, *filter.AllowDiscount)
-		argPos++
-	}
-
-	if filter.Version != nil {
-		query += fmt.Sprintf(" AND version = $%d", argPos)
-		args = append(args, *filter.Version)
-		argPos++
-	}
-
-	if filter.MinCostPrice != nil {
-		query += fmt.Sprintf(" AND cost_price >= $%d", argPos)
-		args = append(args, *filter.MinCostPrice)
-		argPos++
-	}
-
-	if filter.MaxCostPrice != nil {
-		query += fmt.Sprintf(" AND cost_price <= $%d", argPos)
-		args = append(args, *filter.MaxCostPrice)
-		argPos++
-	}
-
-	if filter.MinSalePrice != nil {
-		query += fmt.Sprintf(" AND sale_price >= $%d", argPos)
-		args = append(args, *filter.MinSalePrice)
-		argPos++
-	}
-
-	if filter.MaxSalePrice != nil {
-		query += fmt.Sprintf(" AND sale_price <= $%d", argPos)
-		args = append(args, *filter.MaxSalePrice)
-		argPos++
-	}
-
-	if filter.MinStockQuantity != nil {
-		query += fmt.Sprintf(" AND stock_quantity >= $%d", argPos)
-		args = append(args, *filter.MinStockQuantity)
-		argPos++
-	}
-
-	if filter.MaxStockQuantity != nil {
-		query += fmt.Sprintf(" AND stock_quantity <= $%d", argPos)
-		args = append(args, *filter.MaxStockQuantity)
-		argPos++
-	}
-
-	if filter.CreatedFrom != nil {
-		query += fmt.Sprintf(" AND created_at >= $%d", argPos)
-		args = append(args, *filter.CreatedFrom)
-		argPos++
-	}
-
-	if filter.CreatedTo != nil {
-		query += fmt.Sprintf(" AND created_at <= $%d", argPos)
-		args = append(args, *filter.CreatedTo)
-		argPos++
-	}
-
-	if filter.UpdatedFrom != nil {
-		query += fmt.Sprintf(" AND updated_at >= $%d", argPos)
-		args = append(args, *filter.UpdatedFrom)
-		argPos++
-	}
-
-	if filter.UpdatedTo != nil {
-		query += fmt.Sprintf(" AND updated_at <= $%d", argPos)
-		args = append(args, *filter.UpdatedTo)
-		argPos++
-	}
-
-	// ORDER BY seguro
-	sortField := "created_at"
-	if filter.SortBy != "" {
-		if v, ok := allowedProductSortFields[strings.ToLower(filter.SortBy)]; ok {
-			sortField = v
-		}
-	}
-
-	sortOrder := "ASC"
-	if filter.SortOrder != "" {
-		if strings.ToUpper(filter.SortOrder) == "DESC" {
-			sortOrder = "DESC"
-		}
+	b := builder.NewQueryBuilderSql(query)
+
+	b.ILike("product_name", filter.ProductName)
+	b.ILike("manufacturer", filter.Manufacturer)
+	b.Eq("barcode", filter.Barcode)
+	b.Eq("supplier_id", filter.SupplierID)
+	b.Eq("status", filter.Status)
+	b.Eq("allow_discount", filter.AllowDiscount)
+	b.Eq("version", filter.Version)
+	b.Range("cost_price", filter.MinCostPrice, filter.MaxCostPrice)
+	b.Range("sale_price", filter.MinSalePrice, filter.MaxSalePrice)
+	b.Range("stock_quantity", filter.MinStockQuantity, filter.MaxStockQuantity)
+	b.Range("created_at", filter.CreatedFrom, filter.CreatedTo)
+	b.Range("updated_at", filter.UpdatedFrom, filter.UpdatedTo)
+	b.Search(base.SearchTerm, "product_name", "manufacturer", "product_description", "barcode")
+
+	sortField, sortOrder := productOrderBy.Resolve(filter.SortBy, filter.SortOrder)
+
+	args := b.GetArgs()
+	tail, pageArgs, err := pagination.Clause(base, sortField, sortOrder, b.NextArgPos())
+	if err != nil {
+		return nil, err
 	}
 
-	query += fmt.Sprintf(" ORDER BY %s %s LIMIT %d OFFSET %d",
-		sortField,
-		sortOrder,
-		base.Limit,
-		base.Offset,
-	)
-
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := r.db.Query(ctx, b.GetQuery()+tail, append(args, pageArgs...)...)
 	if err != nil {
 		return nil, fmt.Errorf("%w [filtro=%+v]: %v", errMsg.ErrGet, filter, err)
 	}
@@ -223,6 +126,38 @@ func (r *productFilterRepo) Filter(ctx context.Context, filter *filter.ProductFi
 		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
 	}
 
-	// ALTERAÇÃO: Não precisa mais da verificação, products já é slice vazia
-	return products, nil
+	total, err := pagination.Count(ctx, r.db, "products", b.Where(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonFilter.NewPage(products, total, base, func(m *model.Product) (any, int64) {
+		return productSortValue(m, sortField), m.ID
+	}), nil
+}
+
+// productSortValue devolve o valor da coluna de ordenação field no produto, gravado
+// no cursor da próxima página.
+func productSortValue(m *model.Product, field string) any {
+	switch field {
+	case "product_name":
+		return m.ProductName
+	case "manufacturer":
+		return m.Manufacturer
+	case "sale_price":
+		return m.SalePrice
+	case "cost_price":
+		return m.CostPrice
+	case "stock_quantity":
+		return m.StockQuantity
+	case "status":
+		return m.Status
+	case "version":
+		return m.Version
+	case "created_at":
+		return m.CreatedAt
+	case "updated_at":
+		return m.UpdatedAt
+	}
+	return m.ID
 }
@@ -11,6 +11,7 @@ import (
 	baseFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/product/filter"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -67,12 +68,12 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 				*ptr = "Descrição do produto"
 			}
 			// CostPrice
-			if ptr, ok := args[5].(*float64); ok {
-				*ptr = 50.0
+			if ptr, ok := args[5].(*money.Money); ok {
+				*ptr = money.New(50)
 			}
 			// SalePrice
-			if ptr, ok := args[6].(*float64); ok {
-				*ptr = 100.0
+			if ptr, ok := args[6].(*money.Money); ok {
+				*ptr = money.New(100)
 			}
 			// StockQuantity
 			if ptr, ok := args[7].(*int); ok {
@@ -134,10 +135,11 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 
 		mockDB.On("Query", ctx, mock.Anything, mock.AnythingOfType("[]interface {}")).Return(mockRows, nil)
 
+		mockDB.OnCount(1)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 1) // Slice com 1 elemento
+		assert.Len(t, result.Items, 1) // Slice com 1 elemento
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
 	})
@@ -162,12 +164,13 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 
 		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
 
+		mockDB.OnCount(0)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result, "slice não deve ser nil")
-		assert.Empty(t, result, "slice deve estar vazia")
-		assert.Len(t, result, 0, "slice deve ter comprimento 0")
+		assert.Empty(t, result.Items, "slice deve estar vazia")
+		assert.Len(t, result.Items, 0, "slice deve ter comprimento 0")
 		mockDB.AssertExpectations(t)
 	})
 
@@ -200,6 +203,7 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 				args[1] == "%Dell%"
 		})).Return(mockRows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, filter)
 		assert.NoError(t, err)
 		mockDB.AssertExpectations(t)
@@ -215,10 +219,10 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 		mockRows.On("Err").Return(nil)
 		mockRows.On("Close").Return()
 
-		minCostPrice := 50.0
-		maxCostPrice := 100.0
-		minSalePrice := 100.0
-		maxSalePrice := 200.0
+		minCostPrice := money.New(50)
+		maxCostPrice := money.New(100)
+		minSalePrice := money.New(100)
+		maxSalePrice := money.New(200)
 
 		filter := &filter.ProductFilter{
 			BaseFilter: baseFilter.BaseFilter{
@@ -235,8 +239,8 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 			found := 0
 			for _, arg := range args {
 				switch v := arg.(type) {
-				case float64:
-					if v == 50.0 || v == 100.0 || v == 200.0 {
+				case money.Money:
+					if v == money.New(50) || v == money.New(100) || v == money.New(200) {
 						found++
 					}
 				}
@@ -244,6 +248,7 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 			return found >= 3
 		})).Return(mockRows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, filter)
 		assert.NoError(t, err)
 		mockDB.AssertExpectations(t)
@@ -296,6 +301,7 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 			return hasSupplierID && hasStatus && hasAllowDiscount
 		})).Return(mockRows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, filter)
 		assert.NoError(t, err)
 		mockDB.AssertExpectations(t)
@@ -343,6 +349,7 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 			return hasBarcode && hasVersion
 		})).Return(mockRows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, filter)
 		assert.NoError(t, err)
 		mockDB.AssertExpectations(t)
@@ -389,6 +396,7 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 			return found >= 3
 		})).Return(mockRows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, filter)
 		assert.NoError(t, err)
 		mockDB.AssertExpectations(t)
@@ -417,6 +425,7 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 			return strings.Contains(strings.ToLower(query), "order by sale_price desc")
 		}), mock.Anything).Return(mockRows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, filter)
 		assert.NoError(t, err)
 		mockDB.AssertExpectations(t)
@@ -464,6 +473,7 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 			return hasMinStock && hasMaxStock
 		})).Return(mockRows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, filter)
 		assert.NoError(t, err)
 		mockDB.AssertExpectations(t)
@@ -503,6 +513,7 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 			return hasMinStock
 		})).Return(mockRows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, filter)
 		assert.NoError(t, err)
 		mockDB.AssertExpectations(t)
@@ -542,6 +553,7 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 			return hasMaxStock
 		})).Return(mockRows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, filter)
 		assert.NoError(t, err)
 		mockDB.AssertExpectations(t)
@@ -571,6 +583,7 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 				!strings.Contains(query, "stock_quantity <=")
 		}), mock.Anything).Return(mockRows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, filter)
 		assert.NoError(t, err)
 		mockDB.AssertExpectations(t)
@@ -599,6 +612,7 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 			return strings.Contains(strings.ToLower(query), "order by created_at asc")
 		}), mock.Anything).Return(mockRows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, filter)
 		assert.NoError(t, err)
 		mockDB.AssertExpectations(t)
@@ -627,6 +641,7 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 			return strings.Contains(strings.ToLower(query), "order by product_name asc")
 		}), mock.Anything).Return(mockRows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, filter)
 		assert.NoError(t, err)
 		mockDB.AssertExpectations(t)
@@ -720,3 +735,31 @@ func TestProductFilterRepo_Filter(t *testing.T) {
 		mockRows.AssertExpectations(t)
 	})
 }
+
+func TestProductFilterRepo_Filter_SearchTerm(t *testing.T) {
+	mockDB := new(mockDb.MockDatabase)
+	repo := &productFilterRepo{db: mockDB}
+	ctx := context.Background()
+
+	mockRows := new(mockDb.MockRows)
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Err").Return(nil)
+	mockRows.On("Close").Return()
+
+	where := " WHERE 1=1 AND (product_name ILIKE $1 OR manufacturer ILIKE $1 OR product_description ILIKE $1 OR barcode ILIKE $1)"
+	args := []interface{}{`%50\%\_acme%`}
+
+	mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+		return strings.Contains(q, where+" ORDER BY")
+	}), args).Return(mockRows, nil)
+	mockDB.On("QueryRow", ctx, "SELECT COUNT(*) FROM products"+where, args).
+		Return(&mockDb.MockRow{Values: []interface{}{int64(0)}})
+
+	result, err := repo.Filter(ctx, &filter.ProductFilter{
+		BaseFilter: baseFilter.BaseFilter{Limit: 10, SearchTerm: " 50%_acme "},
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Items)
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,126 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+type priceApplier struct {
+	db repo.DBPool
+}
+
+func NewPriceApplier(db repo.DBPool) iface.PriceApplier {
+	return &priceApplier{db: db}
+}
+
+func (r *priceApplier) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return r.db.BeginTx(ctx, pgx.TxOptions{})
+}
+
+// ApplyDueTx aplica em uma única instrução as mudanças pendentes que
+// venceram. Havendo várias para o mesmo produto, vale para cada preço a de
+// maior effective_from. A mudança cuja data é anterior ao último preço do
+// histórico foi superada por ele e não altera o produto. Cada produto
+// alterado ganha uma linha no histórico com a data agendada e volta na
+// lista com os preços antes e depois da mudança.
+func (r *priceApplier) ApplyDueTx(ctx context.Context, tx pgx.Tx) ([]*models.AppliedPrice, error) {
+	const query = `
+		WITH due AS (
+			SELECT s.id, s.product_id, s.cost_price, s.sale_price, s.effective_from,
+			       s.effective_from < COALESCE((
+			           SELECT MAX(pp.effective_from)
+			           FROM product_prices pp
+			           WHERE pp.product_id = s.product_id
+			       ), '-infinity') AS superseded
+			FROM scheduled_price_changes s
+			WHERE s.status = 'pending' AND s.effective_from <= NOW()
+			FOR UPDATE OF s SKIP LOCKED
+		), marked AS (
+			UPDATE scheduled_price_changes s
+			SET status = CASE WHEN due.superseded THEN 'superseded' ELSE 'applied' END,
+			    applied_at = NOW()
+			FROM due
+			WHERE s.id = due.id
+		), latest AS (
+			SELECT product_id,
+			       (array_agg(cost_price ORDER BY effective_from DESC, id DESC) FILTER (WHERE cost_price IS NOT NULL))[1] AS cost_price,
+			       (array_agg(sale_price ORDER BY effective_from DESC, id DESC) FILTER (WHERE sale_price IS NOT NULL))[1] AS sale_price,
+			       MAX(effective_from) AS effective_from,
+			       (array_agg(id ORDER BY effective_from DESC, id DESC))[1] AS ref_id
+			FROM due
+			WHERE NOT superseded
+			GROUP BY product_id
+		), previous AS (
+			SELECT p.id, p.cost_price, p.sale_price
+			FROM products p
+			JOIN latest l ON l.product_id = p.id
+			FOR UPDATE OF p
+		), updated AS (
+			UPDATE products p
+			SET cost_price = COALESCE(l.cost_price, p.cost_price),
+			    sale_price = COALESCE(l.sale_price, p.sale_price),
+			    version = p.version + 1,
+			    updated_at = NOW()
+			FROM latest l
+			WHERE p.id = l.product_id
+			RETURNING p.id, p.cost_price, p.sale_price, l.effective_from, l.ref_id
+		), history AS (
+			INSERT INTO product_prices (product_id, cost_price, sale_price, effective_from, source, ref_id)
+			SELECT id, cost_price, sale_price, effective_from, 'scheduled', ref_id
+			FROM updated
+		)
+		SELECT u.id, pr.cost_price, pr.sale_price, u.cost_price, u.sale_price
+		FROM updated u
+		JOIN previous pr ON pr.id = u.id
+		ORDER BY u.id;
+	`
+
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+	defer rows.Close()
+
+	applied := make([]*models.AppliedPrice, 0)
+	for rows.Next() {
+		var a models.AppliedPrice
+		if err := rows.Scan(
+			&a.ProductID,
+			&a.Before.CostPrice,
+			&a.Before.SalePrice,
+			&a.After.CostPrice,
+			&a.After.SalePrice,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		applied = append(applied, &a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return applied, nil
+}
+
+func (r *priceApplier) NextDue(ctx context.Context) (*time.Time, error) {
+	const query = `
+		SELECT MIN(effective_from)
+		FROM scheduled_price_changes
+		WHERE status = 'pending';
+	`
+
+	var next *time.Time
+	if err := r.db.QueryRow(ctx, query).Scan(&next); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return next, nil
+}
@@ -0,0 +1,104 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewPriceApplier(t *testing.T) {
+	result := NewPriceApplier(new(mockDb.MockDBTransactor))
+
+	assert.NotNil(t, result)
+	_, ok := result.(*priceApplier)
+	assert.True(t, ok, "Expected result to be of type *priceApplier")
+}
+
+func TestPriceApplier_BeginTx(t *testing.T) {
+	ctx := context.Background()
+	mockDB := new(mockDb.MockDBTransactor)
+	mockTx := new(mockDb.MockTx)
+	mockDB.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil).Once()
+
+	tx, err := NewPriceApplier(mockDB).BeginTx(ctx)
+
+	assert.NoError(t, err)
+	assert.Same(t, mockTx, tx)
+}
+
+func TestPriceApplier_ApplyDueTx(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("aplica, grava o histórico e devolve os preços antes e depois", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{int64(1), money.New(5), money.New(10), money.New(5), money.New(12)}},
+			{Values: []any{int64(2), money.New(3), money.New(7), money.New(4), money.New(7)}},
+		}}
+		mockTx.On("Query", ctx, containsAll("FOR UPDATE OF s SKIP LOCKED", "'superseded'", "FOR UPDATE OF p", "UPDATE products", "INSERT INTO product_prices", "'scheduled'"), mock.Anything).
+			Return(rows, nil)
+
+		applied, err := NewPriceApplier(new(mockDb.MockDBTransactor)).ApplyDueTx(ctx, mockTx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*models.AppliedPrice{
+			{ProductID: 1, Before: models.PriceValues{CostPrice: money.New(5), SalePrice: money.New(10)}, After: models.PriceValues{CostPrice: money.New(5), SalePrice: money.New(12)}},
+			{ProductID: 2, Before: models.PriceValues{CostPrice: money.New(3), SalePrice: money.New(7)}, After: models.PriceValues{CostPrice: money.New(4), SalePrice: money.New(7)}},
+		}, applied)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		mockTx.On("Query", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRows{}, errors.New("db down"))
+
+		_, err := NewPriceApplier(new(mockDb.MockDBTransactor)).ApplyDueTx(ctx, mockTx)
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+
+	t.Run("erro na leitura", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan")}}}
+		mockTx.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
+
+		_, err := NewPriceApplier(new(mockDb.MockDBTransactor)).ApplyDueTx(ctx, mockTx)
+
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+}
+
+func TestPriceApplier_NextDue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("próxima mudança pendente", func(t *testing.T) {
+		mockDB := new(mockDb.MockDBTransactor)
+		repo := NewPriceApplier(mockDB)
+		next := time.Now().Add(time.Hour)
+		mockDB.On("QueryRow", ctx, containsAll("MIN(effective_from)"), mock.Anything).Return(&mockDb.MockRow{Values: []any{next}})
+
+		result, err := repo.NextDue(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, next, *result)
+	})
+
+	t.Run("sem mudanças pendentes", func(t *testing.T) {
+		mockDB := new(mockDb.MockDBTransactor)
+		repo := NewPriceApplier(mockDB)
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Values: []any{nil}})
+
+		result, err := repo.NextDue(ctx)
+
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
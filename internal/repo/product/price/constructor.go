@@ -0,0 +1,13 @@
+package repo
+
+import (
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+)
+
+type priceRepo struct {
+	db repo.DBExecutor
+}
+
+func NewPrice(db repo.DBExecutor) Price {
+	return &priceRepo{db: db}
+}
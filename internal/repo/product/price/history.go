@@ -0,0 +1,188 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+	pagination "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/pagination"
+	"github.com/jackc/pgx/v5"
+)
+
+var allowedPriceSortFields = map[string]string{
+	"id":             "id",
+	"effective_from": "effective_from",
+	"sale_price":     "sale_price",
+	"cost_price":     "cost_price",
+}
+
+var priceOrderBy = builder.OrderBy{
+	Fields:       allowedPriceSortFields,
+	DefaultField: "effective_from",
+	DefaultOrder: "desc",
+}
+
+// rowQuerier é atendido tanto pelo pool quanto por pgx.Tx.
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func (r *priceRepo) History(ctx context.Context, f *models.PriceFilter) (*commonFilter.Page[*models.ProductPrice], error) {
+	base := f.BaseFilter.WithDefaults()
+
+	query := `
+		SELECT
+			id,
+			product_id,
+			cost_price,
+			sale_price,
+			effective_from,
+			source,
+			ref_id,
+			changed_by,
+			created_at
+		FROM product_prices
+	`
+
+	b := builder.NewQueryBuilderSql(query)
+
+	b.Eq("product_id", f.ProductID)
+	b.Eq("source", f.Source)
+	b.Range("effective_from", f.EffectiveFrom, f.EffectiveTo)
+
+	sortField, sortOrder := priceOrderBy.Resolve(f.SortBy, f.SortOrder)
+
+	args := b.GetArgs()
+	tail, pageArgs, err := pagination.Clause(base, sortField, sortOrder, b.NextArgPos())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, b.GetQuery()+tail, append(args, pageArgs...)...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	prices := make([]*models.ProductPrice, 0)
+	for rows.Next() {
+		var p models.ProductPrice
+		if err := rows.Scan(
+			&p.ID,
+			&p.ProductID,
+			&p.CostPrice,
+			&p.SalePrice,
+			&p.EffectiveFrom,
+			&p.Source,
+			&p.RefID,
+			&p.ChangedBy,
+			&p.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		prices = append(prices, &p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	total, err := pagination.Count(ctx, r.db, "product_prices", b.Where(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonFilter.NewPage(prices, total, base, func(p *models.ProductPrice) (any, int64) {
+		return priceSortValue(p, sortField), p.ID
+	}), nil
+}
+
+// EffectiveAt devolve os preços vigentes em at. Vale a linha do histórico ou
+// a mudança agendada pendente com a maior effective_from até at, coluna a
+// coluna, já que uma mudança agendada pode alterar só um dos preços. Assim o
+// resultado não depende de o agendador já ter aplicado as mudanças vencidas.
+func (r *priceRepo) EffectiveAt(ctx context.Context, productID int64, at time.Time) (*models.EffectivePrice, error) {
+	return effectiveAt(ctx, r.db, productID, at)
+}
+
+func (r *priceRepo) EffectiveAtTx(ctx context.Context, tx pgx.Tx, productID int64, at time.Time) (*models.EffectivePrice, error) {
+	return effectiveAt(ctx, tx, productID, at)
+}
+
+func effectiveAt(ctx context.Context, q rowQuerier, productID int64, at time.Time) (*models.EffectivePrice, error) {
+	const query = `
+		WITH candidates AS (
+			SELECT cost_price, sale_price, effective_from, created_at
+			FROM product_prices
+			WHERE product_id = $1 AND effective_from <= $2
+			UNION ALL
+			SELECT cost_price, sale_price, effective_from, created_at
+			FROM scheduled_price_changes
+			WHERE product_id = $1 AND status = 'pending' AND effective_from <= $2
+		)
+		SELECT c.cost_price, c.effective_from, s.sale_price, s.effective_from
+		FROM products p
+		LEFT JOIN LATERAL (
+			SELECT cost_price, effective_from
+			FROM candidates
+			WHERE cost_price IS NOT NULL
+			ORDER BY effective_from DESC, created_at DESC
+			LIMIT 1
+		) c ON TRUE
+		LEFT JOIN LATERAL (
+			SELECT sale_price, effective_from
+			FROM candidates
+			WHERE sale_price IS NOT NULL
+			ORDER BY effective_from DESC, created_at DESC
+			LIMIT 1
+		) s ON TRUE
+		WHERE p.id = $1;
+	`
+
+	var (
+		costPrice, salePrice *money.Money
+		costFrom, saleFrom   *time.Time
+	)
+
+	err := q.QueryRow(ctx, query, productID, at).Scan(&costPrice, &costFrom, &salePrice, &saleFrom)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	// Datas anteriores ao cadastro do produto não têm preço
+	if costPrice == nil || salePrice == nil {
+		return nil, fmt.Errorf("%w: sem preço vigente na data", errMsg.ErrNotFound)
+	}
+
+	return &models.EffectivePrice{
+		ProductID: productID,
+		At:        at,
+		CostPrice: *costPrice,
+		CostFrom:  *costFrom,
+		SalePrice: *salePrice,
+		SaleFrom:  *saleFrom,
+	}, nil
+}
+
+// priceSortValue devolve o valor da coluna de ordenação field no preço, gravado
+// no cursor da próxima página.
+func priceSortValue(p *models.ProductPrice, field string) any {
+	switch field {
+	case "effective_from":
+		return p.EffectiveFrom
+	case "sale_price":
+		return p.SalePrice
+	case "cost_price":
+		return p.CostPrice
+	}
+	return p.ID
+}
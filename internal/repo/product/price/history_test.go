@@ -0,0 +1,134 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func containsAll(parts ...string) any {
+	return mock.MatchedBy(func(q string) bool {
+		for _, p := range parts {
+			if !strings.Contains(q, p) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func TestPriceRepo_History(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("lista o histórico do produto", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewPrice(mockDB)
+
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{int64(3), int64(7), 8.5, 12.9, now, "receipt", int64(40), int64(5), now}},
+		}}
+		mockDB.On("Query", ctx, containsAll("FROM product_prices", "product_id = $1", "source = $2", "ORDER BY effective_from desc"), []any{int64(7), "receipt"}).
+			Return(rows, nil)
+		mockDB.OnCount(1)
+
+		page, err := repo.History(ctx, &models.PriceFilter{ProductID: 7, Source: models.SourceReceipt})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), page.Total)
+		p := page.Items[0]
+		assert.Equal(t, money.MustParse("8.50"), p.CostPrice)
+		assert.Equal(t, money.MustParse("12.90"), p.SalePrice)
+		assert.Equal(t, int64(40), *p.RefID)
+		assert.Equal(t, int64(5), *p.ChangedBy)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewPrice(mockDB)
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.History(ctx, &models.PriceFilter{ProductID: 7})
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestPriceRepo_EffectiveAt(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	costFrom := at.Add(-72 * time.Hour)
+	saleFrom := at.Add(-time.Hour)
+
+	t.Run("preços vigentes coluna a coluna", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewPrice(mockDB)
+
+		mockDB.On("QueryRow", ctx, containsAll("FROM product_prices", "FROM scheduled_price_changes", "status = 'pending'"), []any{int64(7), at}).
+			Return(&mockDb.MockRow{Values: []any{8.5, costFrom, 14.0, saleFrom}})
+
+		price, err := repo.EffectiveAt(ctx, 7, at)
+
+		assert.NoError(t, err)
+		assert.Equal(t, money.MustParse("8.50"), price.CostPrice)
+		assert.Equal(t, costFrom, price.CostFrom)
+		assert.Equal(t, money.New(14), price.SalePrice)
+		assert.Equal(t, saleFrom, price.SaleFrom)
+		assert.Equal(t, at, price.At)
+	})
+
+	t.Run("dentro da transação", func(t *testing.T) {
+		tx := new(mockDb.MockTx)
+		repo := NewPrice(new(mockDb.MockDatabase))
+
+		tx.On("QueryRow", ctx, mock.Anything, []any{int64(7), at}).
+			Return(&mockDb.MockRow{Values: []any{8.5, costFrom, 14.0, saleFrom}})
+
+		price, err := repo.EffectiveAtTx(ctx, tx, 7, at)
+
+		assert.NoError(t, err)
+		assert.Equal(t, money.New(14), price.SalePrice)
+		tx.AssertExpectations(t)
+	})
+
+	t.Run("data anterior ao cadastro do produto", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewPrice(mockDB)
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Values: []any{nil, nil, nil, nil}})
+
+		_, err := repo.EffectiveAt(ctx, 7, at)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("produto inexistente", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewPrice(mockDB)
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		_, err := repo.EffectiveAt(ctx, 7, at)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewPrice(mockDB)
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.EffectiveAt(ctx, 7, at)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
@@ -0,0 +1,9 @@
+package repo
+
+import iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+
+type Price interface {
+	iface.PriceReader
+	iface.PriceTx
+	iface.ScheduledPriceWriter
+}
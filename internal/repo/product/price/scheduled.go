@@ -0,0 +1,128 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+const scheduledColumns = `
+	id,
+	product_id,
+	cost_price,
+	sale_price,
+	effective_from,
+	status,
+	created_by,
+	created_at,
+	applied_at
+`
+
+func scanScheduledRow(row pgx.Row, s *models.ScheduledPrice) error {
+	return row.Scan(
+		&s.ID,
+		&s.ProductID,
+		&s.CostPrice,
+		&s.SalePrice,
+		&s.EffectiveFrom,
+		&s.Status,
+		&s.CreatedBy,
+		&s.CreatedAt,
+		&s.AppliedAt,
+	)
+}
+
+func (r *priceRepo) Schedule(ctx context.Context, change *models.ScheduledPrice) (*models.ScheduledPrice, error) {
+	const query = `
+		INSERT INTO scheduled_price_changes (product_id, cost_price, sale_price, effective_from, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, status, created_at;
+	`
+
+	err := r.db.QueryRow(ctx, query,
+		change.ProductID,
+		change.CostPrice,
+		change.SalePrice,
+		change.EffectiveFrom,
+		change.CreatedBy,
+	).Scan(&change.ID, &change.Status, &change.CreatedAt)
+	if err != nil {
+		if errMsgPg.IsForeignKeyViolation(err) {
+			return nil, errMsg.ErrDBInvalidForeignKey
+		}
+		if errMsgPg.IsCheckViolation(err) {
+			return nil, errMsg.ErrInvalidData
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	return change, nil
+}
+
+// ListScheduled lista as mudanças do produto pela data de vigência. status
+// vazio traz todas.
+func (r *priceRepo) ListScheduled(ctx context.Context, productID int64, status string) ([]*models.ScheduledPrice, error) {
+	query := `SELECT ` + scheduledColumns + `
+		FROM scheduled_price_changes
+		WHERE product_id = $1 AND ($2 = '' OR status = $2)
+		ORDER BY effective_from, id;
+	`
+
+	rows, err := r.db.Query(ctx, query, productID, status)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	changes := make([]*models.ScheduledPrice, 0)
+	for rows.Next() {
+		var s models.ScheduledPrice
+		if err := scanScheduledRow(rows, &s); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		changes = append(changes, &s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return changes, nil
+}
+
+// CancelScheduled cancela uma mudança ainda pendente. Mudança já aplicada,
+// superada ou cancelada resulta em ErrInvalidData.
+func (r *priceRepo) CancelScheduled(ctx context.Context, id int64) (*models.ScheduledPrice, error) {
+	query := `
+		UPDATE scheduled_price_changes
+		SET status = 'canceled'
+		WHERE id = $1 AND status = 'pending'
+		RETURNING ` + scheduledColumns + `;
+	`
+
+	var s models.ScheduledPrice
+	err := scanScheduledRow(r.db.QueryRow(ctx, query, id), &s)
+	if err == nil {
+		return &s, nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	const statusQuery = `SELECT status FROM scheduled_price_changes WHERE id = $1;`
+	var status string
+	if err := r.db.QueryRow(ctx, statusQuery, id).Scan(&status); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return nil, fmt.Errorf("%w: mudança de preço com status %q", errMsg.ErrInvalidData, status)
+}
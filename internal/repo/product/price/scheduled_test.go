@@ -0,0 +1,143 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func scheduledRow(id int64, status string, effective time.Time) *mockDb.MockRow {
+	return &mockDb.MockRow{Values: []any{id, int64(7), nil, 15.0, effective, status, int64(2), effective.Add(-time.Hour), nil}}
+}
+
+func TestPriceRepo_Schedule(t *testing.T) {
+	ctx := context.Background()
+	salePrice := money.New(15)
+	effective := time.Now().Add(24 * time.Hour)
+	createdBy := int64(2)
+
+	newChange := func() *models.ScheduledPrice {
+		return &models.ScheduledPrice{ProductID: 7, SalePrice: &salePrice, EffectiveFrom: effective, CreatedBy: &createdBy}
+	}
+
+	t.Run("grava a mudança pendente", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewPrice(mockDB)
+		now := time.Now()
+
+		mockDB.On("QueryRow", ctx, containsAll("INSERT INTO scheduled_price_changes"),
+			[]any{int64(7), (*money.Money)(nil), &salePrice, effective, &createdBy}).
+			Return(&mockDb.MockRow{Values: []any{int64(4), "pending", now}})
+
+		change, err := repo.Schedule(ctx, newChange())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), change.ID)
+		assert.Equal(t, models.StatusPending, change.Status)
+		assert.Equal(t, now, change.CreatedAt)
+	})
+
+	t.Run("produto inexistente", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewPrice(mockDB)
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).
+			Return(&mockDb.MockRow{Err: errMsgPg.NewForeignKeyViolation("scheduled_price_changes_product_id_fkey")})
+
+		_, err := repo.Schedule(ctx, newChange())
+
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("preço recusado pelo banco", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewPrice(mockDB)
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).
+			Return(&mockDb.MockRow{Err: errMsgPg.NewCheckViolation("scheduled_price_changes_check")})
+
+		_, err := repo.Schedule(ctx, newChange())
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+}
+
+func TestPriceRepo_ListScheduled(t *testing.T) {
+	ctx := context.Background()
+	effective := time.Now().Add(24 * time.Hour)
+
+	t.Run("lista as mudanças do produto", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewPrice(mockDB)
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{scheduledRow(4, "pending", effective)}}
+		mockDB.On("Query", ctx, containsAll("FROM scheduled_price_changes", "ORDER BY effective_from, id"), []any{int64(7), "pending"}).
+			Return(rows, nil)
+
+		changes, err := repo.ListScheduled(ctx, 7, models.StatusPending)
+
+		assert.NoError(t, err)
+		assert.Len(t, changes, 1)
+		assert.Nil(t, changes[0].CostPrice)
+		assert.Equal(t, money.New(15), *changes[0].SalePrice)
+		assert.Equal(t, effective, changes[0].EffectiveFrom)
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewPrice(mockDB)
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.ListScheduled(ctx, 7, "")
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestPriceRepo_CancelScheduled(t *testing.T) {
+	ctx := context.Background()
+	effective := time.Now().Add(24 * time.Hour)
+
+	t.Run("cancela a mudança pendente", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewPrice(mockDB)
+		mockDB.On("QueryRow", ctx, containsAll("SET status = 'canceled'", "status = 'pending'"), []any{int64(4)}).
+			Return(scheduledRow(4, "canceled", effective))
+
+		change, err := repo.CancelScheduled(ctx, 4)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusCanceled, change.Status)
+	})
+
+	t.Run("mudança já aplicada", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewPrice(mockDB)
+		mockDB.On("QueryRow", ctx, containsAll("UPDATE scheduled_price_changes"), []any{int64(4)}).
+			Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+		mockDB.On("QueryRow", ctx, containsAll("SELECT status"), []any{int64(4)}).
+			Return(&mockDb.MockRow{Values: []any{"applied"}})
+
+		_, err := repo.CancelScheduled(ctx, 4)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		assert.ErrorContains(t, err, "applied")
+	})
+
+	t.Run("mudança inexistente", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewPrice(mockDB)
+		mockDB.On("QueryRow", ctx, mock.Anything, []any{int64(4)}).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		_, err := repo.CancelScheduled(ctx, 4)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+}
@@ -0,0 +1,154 @@
+package repo
+
+import (
+	"context"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	modelAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/jackc/pgx/v5"
+)
+
+const auditEntity = "product"
+
+// auditedProduct registra na trilha de auditoria cada escrita feita pelo
+// repositório. As movimentações de estoque em transação são registradas por
+// auditedStockTx.
+type auditedProduct struct {
+	Product
+	rec audit.Recorder
+}
+
+func WithAudit(inner Product, rec audit.Recorder) Product {
+	return &auditedProduct{Product: inner, rec: rec}
+}
+
+func (r *auditedProduct) load(ctx context.Context, id int64) (any, error) {
+	return r.Product.GetByID(ctx, id)
+}
+
+func (r *auditedProduct) track(ctx context.Context, id int64, action string, write func() error) error {
+	return audit.Track(ctx, r.rec, auditEntity, id, action, r.load, write)
+}
+
+func (r *auditedProduct) Create(ctx context.Context, product *models.Product) (*models.Product, error) {
+	created, err := r.Product.Create(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+
+	r.rec.Record(ctx, audit.Entry{
+		EntityType: auditEntity,
+		EntityID:   created.ID,
+		Action:     modelAudit.ActionCreate,
+		After:      created,
+	})
+
+	return created, nil
+}
+
+func (r *auditedProduct) Update(ctx context.Context, product *models.Product) error {
+	return r.track(ctx, product.ID, modelAudit.ActionUpdate, func() error {
+		return r.Product.Update(ctx, product)
+	})
+}
+
+func (r *auditedProduct) Delete(ctx context.Context, id int64) error {
+	return r.track(ctx, id, modelAudit.ActionDelete, func() error {
+		return r.Product.Delete(ctx, id)
+	})
+}
+
+func (r *auditedProduct) UpdateStock(ctx context.Context, id int64, quantity int) error {
+	return r.track(ctx, id, "update_stock", func() error {
+		return r.Product.UpdateStock(ctx, id, quantity)
+	})
+}
+
+func (r *auditedProduct) IncreaseStock(ctx context.Context, id int64, amount int) error {
+	return r.track(ctx, id, "increase_stock", func() error {
+		return r.Product.IncreaseStock(ctx, id, amount)
+	})
+}
+
+func (r *auditedProduct) DecreaseStock(ctx context.Context, id int64, amount int) error {
+	return r.track(ctx, id, "decrease_stock", func() error {
+		return r.Product.DecreaseStock(ctx, id, amount)
+	})
+}
+
+func (r *auditedProduct) EnableDiscount(ctx context.Context, id int64) error {
+	return r.track(ctx, id, "enable_discount", func() error {
+		return r.Product.EnableDiscount(ctx, id)
+	})
+}
+
+func (r *auditedProduct) DisableDiscount(ctx context.Context, id int64) error {
+	return r.track(ctx, id, "disable_discount", func() error {
+		return r.Product.DisableDiscount(ctx, id)
+	})
+}
+
+func (r *auditedProduct) ApplyDiscount(ctx context.Context, id int64, percent float64) error {
+	return r.track(ctx, id, "apply_discount", func() error {
+		return r.Product.ApplyDiscount(ctx, id, percent)
+	})
+}
+
+func (r *auditedProduct) EnableProduct(ctx context.Context, uid int64) error {
+	return r.track(ctx, uid, "enable", func() error {
+		return r.Product.EnableProduct(ctx, uid)
+	})
+}
+
+func (r *auditedProduct) DisableProduct(ctx context.Context, uid int64) error {
+	return r.track(ctx, uid, "disable", func() error {
+		return r.Product.DisableProduct(ctx, uid)
+	})
+}
+
+// auditedStockTx registra as baixas e entradas de estoque feitas por vendas
+// e ordens de serviço na transação que as faz: se o registro falhar, a
+// movimentação é desfeita. O local e a origem ficam no histórico de estoque.
+type auditedStockTx struct {
+	iface.ProductStockTx
+	rec audit.Recorder
+}
+
+func WithAuditStockTx(inner iface.ProductStockTx, rec audit.Recorder) iface.ProductStockTx {
+	return &auditedStockTx{ProductStockTx: inner, rec: rec}
+}
+
+func (r *auditedStockTx) load(ctx context.Context, tx pgx.Tx, id int64) (any, error) {
+	return r.ProductStockTx.GetByIDForUpdateTx(ctx, tx, id)
+}
+
+func (r *auditedStockTx) track(ctx context.Context, tx pgx.Tx, id int64, action string, write func() error) error {
+	return audit.TrackTx(ctx, tx, r.rec, auditEntity, id, action, r.load, write)
+}
+
+func (r *auditedStockTx) DecreaseStockTx(ctx context.Context, tx pgx.Tx, id int64, amount int, origin modelsMovement.Origin) error {
+	return r.track(ctx, tx, id, "decrease_stock", func() error {
+		return r.ProductStockTx.DecreaseStockTx(ctx, tx, id, amount, origin)
+	})
+}
+
+func (r *auditedStockTx) IncreaseStockTx(ctx context.Context, tx pgx.Tx, id int64, amount int, origin modelsMovement.Origin) error {
+	return r.track(ctx, tx, id, "increase_stock", func() error {
+		return r.ProductStockTx.IncreaseStockTx(ctx, tx, id, amount, origin)
+	})
+}
+
+func (r *auditedStockTx) DecreaseStockAtTx(ctx context.Context, tx pgx.Tx, id, locationID int64, amount int, origin modelsMovement.Origin) error {
+	return r.track(ctx, tx, id, "decrease_stock", func() error {
+		return r.ProductStockTx.DecreaseStockAtTx(ctx, tx, id, locationID, amount, origin)
+	})
+}
+
+func (r *auditedStockTx) IncreaseStockAtTx(ctx context.Context, tx pgx.Tx, id, locationID int64, amount int, origin modelsMovement.Origin) error {
+	return r.track(ctx, tx, id, "increase_stock", func() error {
+		return r.ProductStockTx.IncreaseStockAtTx(ctx, tx, id, locationID, amount, origin)
+	})
+}
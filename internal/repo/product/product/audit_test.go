@@ -0,0 +1,132 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockAudit "github.com/WagaoCarvalho/backend_store_go/infra/mock/audit"
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	modelAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	modelsLocation "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditedProduct(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("create records the new product", func(t *testing.T) {
+		inner := new(mockProduct.ProductMock)
+		rec := new(mockAudit.MockRecorder)
+		created := &models.Product{ID: 1, ProductName: "Caneta"}
+
+		inner.On("Create", ctx, mock.Anything).Return(created, nil).Once()
+		rec.On("Record", ctx, audit.Entry{EntityType: "product", EntityID: 1, Action: modelAudit.ActionCreate, After: created}).Once()
+
+		result, err := WithAudit(inner, rec).Create(ctx, &models.Product{ProductName: "Caneta"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, created, result)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("create failure is not recorded", func(t *testing.T) {
+		inner := new(mockProduct.ProductMock)
+		rec := new(mockAudit.MockRecorder)
+
+		inner.On("Create", ctx, mock.Anything).Return(nil, errors.New("db fail")).Once()
+
+		result, err := WithAudit(inner, rec).Create(ctx, &models.Product{})
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "db fail")
+		rec.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+	})
+
+	t.Run("stock change records before and after", func(t *testing.T) {
+		inner := new(mockProduct.ProductMock)
+		rec := new(mockAudit.MockRecorder)
+		before := &models.Product{ID: 1, StockQuantity: 5}
+		after := &models.Product{ID: 1, StockQuantity: 3}
+
+		inner.On("GetByID", ctx, int64(1)).Return(before, nil).Once()
+		inner.On("DecreaseStock", ctx, int64(1), 2).Return(nil).Once()
+		inner.On("GetByID", ctx, int64(1)).Return(after, nil).Once()
+		rec.On("Record", ctx, audit.Entry{EntityType: "product", EntityID: 1, Action: "decrease_stock", Before: before, After: after}).Once()
+
+		err := WithAudit(inner, rec).DecreaseStock(ctx, 1, 2)
+
+		assert.NoError(t, err)
+		inner.AssertExpectations(t)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("reads are not recorded", func(t *testing.T) {
+		inner := new(mockProduct.ProductMock)
+		rec := new(mockAudit.MockRecorder)
+
+		inner.On("GetStock", ctx, int64(1)).Return(&modelsLocation.ProductStock{ProductID: 1, Total: 5}, nil).Once()
+
+		stock, err := WithAudit(inner, rec).GetStock(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, stock.Total)
+		rec.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuditedStockTx(t *testing.T) {
+	ctx := context.Background()
+	tx := new(mockDb.MockTx)
+	origin := modelsMovement.NewOrigin(modelsMovement.ReasonSale, modelsMovement.RefSale, 9)
+
+	t.Run("sale stock move records before and after in the transaction", func(t *testing.T) {
+		inner := new(mockProduct.MockProductStockTx)
+		rec := new(mockAudit.MockRecorder)
+		before := &models.Product{ID: 1, StockQuantity: 5}
+		after := &models.Product{ID: 1, StockQuantity: 3}
+
+		inner.On("GetByIDForUpdateTx", ctx, tx, int64(1)).Return(before, nil).Once()
+		inner.On("DecreaseStockAtTx", ctx, tx, int64(1), int64(2), 2, origin).Return(nil).Once()
+		inner.On("GetByIDForUpdateTx", ctx, tx, int64(1)).Return(after, nil).Once()
+		rec.On("RecordTx", ctx, tx, audit.Entry{EntityType: "product", EntityID: 1, Action: "decrease_stock", Before: before, After: after}).Return(nil).Once()
+
+		err := WithAuditStockTx(inner, rec).DecreaseStockAtTx(ctx, tx, 1, 2, 2, origin)
+
+		assert.NoError(t, err)
+		inner.AssertExpectations(t)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("audit failure fails the stock move", func(t *testing.T) {
+		inner := new(mockProduct.MockProductStockTx)
+		rec := new(mockAudit.MockRecorder)
+
+		inner.On("GetByIDForUpdateTx", ctx, tx, int64(1)).Return(&models.Product{ID: 1}, nil)
+		inner.On("IncreaseStockAtTx", ctx, tx, int64(1), int64(2), 2, origin).Return(nil).Once()
+		rec.On("RecordTx", ctx, tx, mock.MatchedBy(func(e audit.Entry) bool { return e.Action == "increase_stock" })).
+			Return(errors.New("audit fail")).Once()
+
+		err := WithAuditStockTx(inner, rec).IncreaseStockAtTx(ctx, tx, 1, 2, 2, origin)
+
+		assert.EqualError(t, err, "audit fail")
+	})
+
+	t.Run("failed stock move is not recorded", func(t *testing.T) {
+		inner := new(mockProduct.MockProductStockTx)
+		rec := new(mockAudit.MockRecorder)
+
+		inner.On("GetByIDForUpdateTx", ctx, tx, int64(1)).Return(&models.Product{ID: 1}, nil).Once()
+		inner.On("DecreaseStockTx", ctx, tx, int64(1), 2, origin).Return(errors.New("estoque insuficiente")).Once()
+
+		err := WithAuditStockTx(inner, rec).DecreaseStockTx(ctx, tx, 1, 2, origin)
+
+		assert.EqualError(t, err, "estoque insuficiente")
+		rec.AssertNotCalled(t, "RecordTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,161 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+)
+
+func NewProductLocationTx() iface.ProductLocationTx {
+	return &productStockTx{}
+}
+
+// GetStockAtForUpdateTx bloqueia e devolve o saldo do produto no local
+// informado; locationID 0 é o local padrão, como na criação da venda. Sem
+// linha em product_stocks o produto não tem saldo ali.
+func (r *productStockTx) GetStockAtForUpdateTx(ctx context.Context, tx pgx.Tx, id, locationID int64) (int, error) {
+	const query = `
+		SELECT quantity
+		FROM product_stocks
+		WHERE product_id = $1
+		  AND location_id = COALESCE(NULLIF($2, 0), default_location_id())
+		FOR UPDATE;
+	`
+
+	var quantity int
+	if err := tx.QueryRow(ctx, query, id, locationID).Scan(&quantity); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return quantity, nil
+}
+
+// DecreaseStockAtTx baixa amount do local informado e do total do produto.
+// Falta de saldo no local resulta em ErrInsufficientStock, mesmo que outros
+// locais tenham o produto.
+func (r *productStockTx) DecreaseStockAtTx(ctx context.Context, tx pgx.Tx, id, locationID int64, amount int, origin modelsMovement.Origin) error {
+	if amount <= 0 {
+		return errMsg.ErrInvalidQuantity
+	}
+
+	if err := adjustLocationTx(ctx, tx, id, locationID, -amount); err != nil {
+		return err
+	}
+
+	return r.DecreaseStockTx(ctx, tx, id, amount, origin)
+}
+
+// IncreaseStockAtTx soma amount ao local informado e ao total do produto.
+func (r *productStockTx) IncreaseStockAtTx(ctx context.Context, tx pgx.Tx, id, locationID int64, amount int, origin modelsMovement.Origin) error {
+	if amount <= 0 {
+		return errMsg.ErrInvalidQuantity
+	}
+
+	if err := adjustLocationTx(ctx, tx, id, locationID, amount); err != nil {
+		return err
+	}
+
+	return r.IncreaseStockTx(ctx, tx, id, amount, origin)
+}
+
+// ReceiveStockAtTx dá entrada da mercadoria no local informado. O custo é
+// recalculado sobre o total do produto, como em ReceiveStockTx.
+func (r *productStockTx) ReceiveStockAtTx(ctx context.Context, tx pgx.Tx, id, locationID int64, quantity int, unitCost money.Money, costMethod string, origin modelsMovement.Origin) error {
+	if quantity <= 0 {
+		return errMsg.ErrInvalidQuantity
+	}
+
+	if err := adjustLocationTx(ctx, tx, id, locationID, quantity); err != nil {
+		return err
+	}
+
+	return r.ReceiveStockTx(ctx, tx, id, quantity, unitCost, costMethod, origin)
+}
+
+// ShiftStockTx soma delta ao saldo do local sem mexer no total e recalcula o
+// local padrão. Não grava movimento: o estoque da empresa não mudou.
+func (r *productStockTx) ShiftStockTx(ctx context.Context, tx pgx.Tx, id, locationID int64, delta int) error {
+	if delta == 0 {
+		return errMsg.ErrInvalidQuantity
+	}
+
+	if err := adjustLocationTx(ctx, tx, id, locationID, delta); err != nil {
+		return err
+	}
+
+	// Reescrever stock_quantity dispara o recálculo do local padrão
+	const query = `
+		UPDATE products
+		SET stock_quantity = stock_quantity,
+		    updated_at = NOW(),
+		    version = version + 1
+		WHERE id = $1
+		RETURNING version;
+	`
+
+	var version int
+	if err := tx.QueryRow(ctx, query, id).Scan(&version); err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return errMsg.ErrNotFound
+		case errMsgPg.IsCheckViolation(err):
+			return errMsg.ErrInsufficientStock
+		default:
+			return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+		}
+	}
+
+	return nil
+}
+
+// adjustLocationTx soma delta ao saldo do produto em um local que não seja
+// o padrão. O saldo do local padrão é derivado do total por trigger e não é
+// gravado aqui.
+func adjustLocationTx(ctx context.Context, tx pgx.Tx, id, locationID int64, delta int) error {
+	if locationID <= 0 {
+		return fmt.Errorf("%w: local não informado", errMsg.ErrInvalidData)
+	}
+
+	const query = `
+		WITH l AS (
+			SELECT id, is_default
+			FROM locations
+			WHERE id = $2
+		), moved AS (
+			INSERT INTO product_stocks (product_id, location_id, quantity, updated_at)
+			SELECT $1, id, $3, NOW()
+			FROM l
+			WHERE NOT is_default
+			ON CONFLICT (product_id, location_id) DO UPDATE
+			SET quantity = product_stocks.quantity + EXCLUDED.quantity,
+			    updated_at = NOW()
+		)
+		SELECT is_default FROM l;
+	`
+
+	var isDefault bool
+	if err := tx.QueryRow(ctx, query, id, locationID, delta).Scan(&isDefault); err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return fmt.Errorf("%w: local %d", errMsg.ErrNotFound, locationID)
+		case errMsgPg.IsCheckViolation(err):
+			return errMsg.ErrInsufficientStock
+		case errMsgPg.IsForeignKeyViolation(err):
+			return errMsg.ErrNotFound
+		default:
+			return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func locationQuery() interface{} {
+	return mock.MatchedBy(func(q string) bool { return strings.Contains(q, "INSERT INTO product_stocks") })
+}
+
+func TestNewProductLocationTx(t *testing.T) {
+	_, ok := NewProductLocationTx().(*productStockTx)
+	assert.True(t, ok)
+}
+
+func TestProductStockTx_GetStockAtForUpdateTx(t *testing.T) {
+	stockQuery := mock.MatchedBy(func(q string) bool {
+		return strings.Contains(q, "FROM product_stocks") &&
+			strings.Contains(q, "COALESCE(NULLIF($2, 0), default_location_id())") &&
+			strings.Contains(q, "FOR UPDATE")
+	})
+
+	t.Run("return locked balance at location", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, stockQuery, []interface{}{int64(1), int64(2)}).
+			Return(&mockDb.MockRow{Values: []any{4}}).Once()
+
+		quantity, err := (&productStockTx{}).GetStockAtForUpdateTx(ctx, mockTx, 1, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, quantity)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return zero when product has no balance at location", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, stockQuery, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows}).Once()
+
+		quantity, err := (&productStockTx{}).GetStockAtForUpdateTx(ctx, mockTx, 1, 2)
+
+		assert.NoError(t, err)
+		assert.Zero(t, quantity)
+	})
+
+	t.Run("return ErrGet on query error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, stockQuery, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db error")}).Once()
+
+		_, err := (&productStockTx{}).GetStockAtForUpdateTx(ctx, mockTx, 1, 2)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestProductStockTx_DecreaseStockAtTx(t *testing.T) {
+	t.Run("return ErrInvalidQuantity when amount is not positive", func(t *testing.T) {
+		err := (&productStockTx{}).DecreaseStockAtTx(context.Background(), new(mockDb.MockTx), 1, 2, 0, saleOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidQuantity)
+	})
+
+	t.Run("return ErrInvalidData when location is missing", func(t *testing.T) {
+		err := (&productStockTx{}).DecreaseStockAtTx(context.Background(), new(mockDb.MockTx), 1, 0, 3, saleOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("decrease location then total", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, locationQuery(), []interface{}{int64(1), int64(2), -3}).
+			Return(&mockDb.MockRow{Values: []any{false}}).Once()
+		mockTx.On("QueryRow", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "INSERT INTO stock_movements")
+		}), saleArgs(int64(1), 3)).Return(&mockDb.MockRow{Value: 2}).Once()
+
+		err := (&productStockTx{}).DecreaseStockAtTx(ctx, mockTx, 1, 2, 3, saleOrigin)
+
+		assert.NoError(t, err)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrInsufficientStock when location balance is short", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, locationQuery(), mock.Anything).
+			Return(&mockDb.MockRow{Err: errMsgPg.NewCheckViolation("product_stocks_quantity_check")}).Once()
+
+		err := (&productStockTx{}).DecreaseStockAtTx(ctx, mockTx, 1, 2, 3, saleOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrInsufficientStock)
+		mockTx.AssertNumberOfCalls(t, "QueryRow", 1)
+	})
+
+	t.Run("return ErrNotFound when location does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, locationQuery(), mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows}).Once()
+
+		err := (&productStockTx{}).DecreaseStockAtTx(ctx, mockTx, 1, 99, 3, saleOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+}
+
+func TestProductStockTx_IncreaseStockAtTx(t *testing.T) {
+	mockTx := new(mockDb.MockTx)
+	ctx := context.Background()
+
+	mockTx.On("QueryRow", ctx, locationQuery(), []interface{}{int64(1), int64(2), 3}).
+		Return(&mockDb.MockRow{Values: []any{true}}).Once()
+	mockTx.On("QueryRow", ctx, mock.MatchedBy(func(q string) bool {
+		return strings.Contains(q, "stock_quantity + $2")
+	}), saleArgs(int64(1), 3)).Return(&mockDb.MockRow{Value: 2}).Once()
+
+	err := (&productStockTx{}).IncreaseStockAtTx(ctx, mockTx, 1, 2, 3, saleOrigin)
+
+	assert.NoError(t, err)
+	mockTx.AssertExpectations(t)
+}
+
+func TestProductStockTx_ReceiveStockAtTx(t *testing.T) {
+	t.Run("receive into location", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, locationQuery(), []interface{}{int64(1), int64(2), 4}).
+			Return(&mockDb.MockRow{Values: []any{false}}).Once()
+		args := append([]interface{}{int64(1), 4, money.MustParse("2.50"), "average"}, receiptMovement...)
+		mockTx.On("QueryRow", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "cost_price")
+		}), args).Return(&mockDb.MockRow{Value: 2}).Once()
+
+		err := (&productStockTx{}).ReceiveStockAtTx(ctx, mockTx, 1, 2, 4, money.MustParse("2.50"), "average", receiptOrigin)
+
+		assert.NoError(t, err)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrUpdate on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, locationQuery(), mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")}).Once()
+
+		err := (&productStockTx{}).ReceiveStockAtTx(ctx, mockTx, 1, 2, 4, money.MustParse("2.50"), "average", receiptOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
+
+func TestProductStockTx_ShiftStockTx(t *testing.T) {
+	touch := mock.MatchedBy(func(q string) bool { return strings.Contains(q, "stock_quantity = stock_quantity") })
+
+	t.Run("return ErrInvalidQuantity when delta is zero", func(t *testing.T) {
+		err := (&productStockTx{}).ShiftStockTx(context.Background(), new(mockDb.MockTx), 1, 2, 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidQuantity)
+	})
+
+	t.Run("shift location and resync default", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, locationQuery(), []interface{}{int64(1), int64(2), -5}).
+			Return(&mockDb.MockRow{Values: []any{false}}).Once()
+		mockTx.On("QueryRow", ctx, touch, []interface{}{int64(1)}).Return(&mockDb.MockRow{Value: 3}).Once()
+
+		err := (&productStockTx{}).ShiftStockTx(ctx, mockTx, 1, 2, -5)
+
+		assert.NoError(t, err)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrInsufficientStock when default location is short", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, locationQuery(), mock.Anything).Return(&mockDb.MockRow{Values: []any{true}}).Once()
+		mockTx.On("QueryRow", ctx, touch, mock.Anything).
+			Return(&mockDb.MockRow{Err: errMsgPg.NewCheckViolation("product_stocks_quantity_check")}).Once()
+
+		err := (&productStockTx{}).ShiftStockTx(ctx, mockTx, 1, 1, -5)
+
+		assert.ErrorIs(t, err, errMsg.ErrInsufficientStock)
+	})
+
+	t.Run("return ErrNotFound when product does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, locationQuery(), mock.Anything).Return(&mockDb.MockRow{Values: []any{false}}).Once()
+		mockTx.On("QueryRow", ctx, touch, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows}).Once()
+
+		err := (&productStockTx{}).ShiftStockTx(ctx, mockTx, 1, 2, 5)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+}
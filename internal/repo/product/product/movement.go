@@ -0,0 +1,24 @@
+package repo
+
+import (
+	"context"
+	"strconv"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+)
+
+// movementArgs são os parâmetros reason, ref_type, ref_id e user_id do
+// INSERT em stock_movements que acompanha cada alteração de estoque. O
+// usuário vem do contexto da requisição; fora dela fica nulo.
+func movementArgs(ctx context.Context, origin models.Origin) []any {
+	return []any{origin.Reason, origin.RefType, origin.RefID, movementUser(ctx)}
+}
+
+func movementUser(ctx context.Context) *int64 {
+	id, err := strconv.ParseInt(contextUtils.GetUserID(ctx), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
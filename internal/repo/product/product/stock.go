@@ -5,47 +5,108 @@ import (
 	"errors"
 	"fmt"
 
+	modelsLocation "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/jackc/pgx/v5"
 )
 
-func (r *productRepo) GetStock(ctx context.Context, id int64) (int, error) {
-	const query = `
-		SELECT stock_quantity
-		FROM products
-		WHERE id = $1;
+// GetStock devolve o total do produto, o que está em trânsito e o saldo em
+// cada local cadastrado, inclusive os locais sem saldo.
+func (r *productRepo) GetStock(ctx context.Context, id int64) (*modelsLocation.ProductStock, error) {
+	const queryTotal = `
+		SELECT p.stock_quantity,
+		       COALESCE((
+		           SELECT SUM(i.quantity)
+		           FROM stock_transfer_items i
+		           JOIN stock_transfers t ON t.id = i.stock_transfer_id
+		           WHERE i.product_id = p.id
+		             AND t.status = 'in_transit'
+		       ), 0)
+		FROM products p
+		WHERE p.id = $1;
 	`
 
-	var stock int
-	err := r.db.QueryRow(ctx, query, id).Scan(&stock)
+	stock := &modelsLocation.ProductStock{ProductID: id}
+	err := r.db.QueryRow(ctx, queryTotal, id).Scan(&stock.Total, &stock.InTransit)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return 0, errMsg.ErrNotFound
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	const queryLocations = `
+		SELECT l.id, l.code, l.name, l.is_default, COALESCE(ps.quantity, 0)
+		FROM locations l
+		LEFT JOIN product_stocks ps
+		       ON ps.location_id = l.id
+		      AND ps.product_id = $1
+		ORDER BY l.is_default DESC, l.code ASC;
+	`
+
+	rows, err := r.db.Query(ctx, queryLocations, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	stock.Locations = make([]modelsLocation.LocationStock, 0)
+	for rows.Next() {
+		var l modelsLocation.LocationStock
+		if err := rows.Scan(&l.LocationID, &l.Code, &l.Name, &l.IsDefault, &l.Quantity); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
 		}
-		return 0, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+		stock.Locations = append(stock.Locations, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
 	}
 
 	return stock, nil
 }
 
+// UpdateStock define o saldo do produto. A diferença para o saldo anterior
+// é gravada como ajuste manual; sem diferença nenhum movimento é gerado.
 func (r *productRepo) UpdateStock(ctx context.Context, id int64, quantity int) error {
 	if quantity < 0 {
 		return errMsg.ErrInvalidQuantity
 	}
 
 	const query = `
-		UPDATE products
-		SET stock_quantity = $2, updated_at = NOW(), version = version + 1
-		WHERE id = $1
-		RETURNING version;
+		WITH old AS (
+			SELECT id, stock_quantity
+			FROM products
+			WHERE id = $1
+			FOR UPDATE
+		), updated AS (
+			UPDATE products p
+			SET stock_quantity = $2, updated_at = NOW(), version = version + 1
+			FROM old
+			WHERE p.id = old.id
+			RETURNING p.id, p.stock_quantity, p.version, p.stock_quantity - old.stock_quantity AS delta
+		), movement AS (
+			INSERT INTO stock_movements (product_id, delta, quantity, reason, ref_type, ref_id, user_id)
+			SELECT id, delta, stock_quantity, $3::text, NULLIF($4::text, ''), $5::bigint, $6::int
+			FROM updated
+			WHERE delta <> 0
+		)
+		SELECT version FROM updated;
 	`
 
+	args := append([]any{id, quantity}, movementArgs(ctx, modelsMovement.Adjustment())...)
+
 	var version int
-	err := r.db.QueryRow(ctx, query, id, quantity).Scan(&version)
+	err := r.db.QueryRow(ctx, query, args...).Scan(&version)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return errMsg.ErrNotFound
 		}
+		if errMsgPg.IsCheckViolation(err) {
+			return errMsg.ErrInsufficientStock
+		}
 		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
 	}
 
@@ -58,16 +119,25 @@ func (r *productRepo) IncreaseStock(ctx context.Context, id int64, amount int) e
 	}
 
 	const query = `
-		UPDATE products
-		SET stock_quantity = stock_quantity + $2, 
-		    updated_at = NOW(), 
-		    version = version + 1
-		WHERE id = $1
-		RETURNING version;
+		WITH updated AS (
+			UPDATE products
+			SET stock_quantity = stock_quantity + $2,
+			    updated_at = NOW(),
+			    version = version + 1
+			WHERE id = $1
+			RETURNING id, stock_quantity, version
+		), movement AS (
+			INSERT INTO stock_movements (product_id, delta, quantity, reason, ref_type, ref_id, user_id)
+			SELECT id, $2::int, stock_quantity, $3::text, NULLIF($4::text, ''), $5::bigint, $6::int
+			FROM updated
+		)
+		SELECT version FROM updated;
 	`
 
+	args := append([]any{id, amount}, movementArgs(ctx, modelsMovement.Adjustment())...)
+
 	var version int
-	err := r.db.QueryRow(ctx, query, id, amount).Scan(&version)
+	err := r.db.QueryRow(ctx, query, args...).Scan(&version)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return errMsg.ErrNotFound
@@ -84,17 +154,26 @@ func (r *productRepo) DecreaseStock(ctx context.Context, id int64, amount int) e
 	}
 
 	const query = `
-		UPDATE products
-		SET stock_quantity = stock_quantity - $2, 
-		    updated_at = NOW(), 
-		    version = version + 1
-		WHERE id = $1 
-		  AND stock_quantity >= $2  -- Garante que há estoque suficiente
-		RETURNING version;
+		WITH updated AS (
+			UPDATE products
+			SET stock_quantity = stock_quantity - $2,
+			    updated_at = NOW(),
+			    version = version + 1
+			WHERE id = $1
+			  AND stock_quantity >= $2  -- Garante que há estoque suficiente
+			RETURNING id, stock_quantity, version
+		), movement AS (
+			INSERT INTO stock_movements (product_id, delta, quantity, reason, ref_type, ref_id, user_id)
+			SELECT id, -$2::int, stock_quantity, $3::text, NULLIF($4::text, ''), $5::bigint, $6::int
+			FROM updated
+		)
+		SELECT version FROM updated;
 	`
 
+	args := append([]any{id, amount}, movementArgs(ctx, modelsMovement.Adjustment())...)
+
 	var version int
-	err := r.db.QueryRow(ctx, query, id, amount).Scan(&version)
+	err := r.db.QueryRow(ctx, query, args...).Scan(&version)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			// Pode ser produto não encontrado OU estoque insuficiente
@@ -106,6 +185,9 @@ func (r *productRepo) DecreaseStock(ctx context.Context, id int64, amount int) e
 			}
 			return errMsg.ErrInsufficientStock
 		}
+		if errMsgPg.IsCheckViolation(err) {
+			return errMsg.ErrInsufficientStock
+		}
 		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
 	}
 
@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
@@ -13,22 +14,32 @@ import (
 )
 
 func TestProductRepo_GetStock(t *testing.T) {
-	t.Run("successfully get stock by ID", func(t *testing.T) {
+	t.Run("successfully get stock by location", func(t *testing.T) {
 		mockDB := new(mockDb.MockDatabase)
 		repo := &productRepo{db: mockDB}
 		ctx := context.Background()
 		productID := int64(1)
 
-		mockRow := &mockDb.MockRow{
-			Value: 50, // Stock quantity
-		}
+		mockDB.On("QueryRow", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "in_transit")
+		}), []interface{}{productID}).Return(&mockDb.MockRow{Values: []any{50, 5}})
 
-		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{productID}).Return(mockRow)
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{int64(1), "PRINCIPAL", "Estoque principal", true, 30}},
+			{Values: []any{int64(2), "DEP", "Depósito", false, 15}},
+		}}
+		mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "FROM locations l")
+		}), []interface{}{productID}).Return(rows, nil)
 
 		stock, err := repo.GetStock(ctx, productID)
 
 		assert.NoError(t, err)
-		assert.Equal(t, 50, stock)
+		assert.Equal(t, 50, stock.Total)
+		assert.Equal(t, 5, stock.InTransit)
+		assert.Len(t, stock.Locations, 2)
+		assert.True(t, stock.Locations[0].IsDefault)
+		assert.Equal(t, 15, stock.Locations[1].Quantity)
 		mockDB.AssertExpectations(t)
 	})
 
@@ -45,7 +56,7 @@ func TestProductRepo_GetStock(t *testing.T) {
 		stock, err := repo.GetStock(ctx, productID)
 
 		assert.ErrorIs(t, err, errMsg.ErrNotFound)
-		assert.Zero(t, stock)
+		assert.Nil(t, stock)
 		mockDB.AssertExpectations(t)
 	})
 
@@ -63,10 +74,24 @@ func TestProductRepo_GetStock(t *testing.T) {
 		stock, err := repo.GetStock(ctx, productID)
 
 		assert.Error(t, err)
-		assert.Zero(t, stock)
+		assert.Nil(t, stock)
 		assert.Contains(t, err.Error(), "erro ao buscar")
 		mockDB.AssertExpectations(t)
 	})
+
+	t.Run("return ErrGet when locations query fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &productRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).Return(&mockDb.MockRow{Values: []any{50, 0}})
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).Return(nil, errors.New("db down"))
+
+		stock, err := repo.GetStock(ctx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+		assert.Nil(t, stock)
+	})
 }
 
 func TestProductRepo_UpdateStock(t *testing.T) {
@@ -81,7 +106,7 @@ func TestProductRepo_UpdateStock(t *testing.T) {
 			Value: 2, // New version
 		}
 
-		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{productID, quantity}).Return(mockRow)
+		mockDB.On("QueryRow", ctx, mock.Anything, adjustmentArgs(productID, quantity)).Return(mockRow)
 
 		err := repo.UpdateStock(ctx, productID, quantity)
 
@@ -111,7 +136,7 @@ func TestProductRepo_UpdateStock(t *testing.T) {
 
 		mockRow := &mockDb.MockRow{Err: pgx.ErrNoRows}
 
-		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{productID, quantity}).Return(mockRow)
+		mockDB.On("QueryRow", ctx, mock.Anything, adjustmentArgs(productID, quantity)).Return(mockRow)
 
 		err := repo.UpdateStock(ctx, productID, quantity)
 
@@ -129,7 +154,7 @@ func TestProductRepo_UpdateStock(t *testing.T) {
 		scanErr := errors.New("scan error")
 		mockRow := &mockDb.MockRow{Err: scanErr}
 
-		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{productID, quantity}).Return(mockRow)
+		mockDB.On("QueryRow", ctx, mock.Anything, adjustmentArgs(productID, quantity)).Return(mockRow)
 
 		err := repo.UpdateStock(ctx, productID, quantity)
 
@@ -151,7 +176,7 @@ func TestProductRepo_IncreaseStock(t *testing.T) {
 			Value: 3, // New version
 		}
 
-		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{productID, amount}).Return(mockRow)
+		mockDB.On("QueryRow", ctx, mock.Anything, adjustmentArgs(productID, amount)).Return(mockRow)
 
 		err := repo.IncreaseStock(ctx, productID, amount)
 
@@ -185,7 +210,7 @@ func TestProductRepo_IncreaseStock(t *testing.T) {
 
 		mockRow := &mockDb.MockRow{Err: pgx.ErrNoRows}
 
-		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{productID, amount}).Return(mockRow)
+		mockDB.On("QueryRow", ctx, mock.Anything, adjustmentArgs(productID, amount)).Return(mockRow)
 
 		err := repo.IncreaseStock(ctx, productID, amount)
 
@@ -203,7 +228,7 @@ func TestProductRepo_IncreaseStock(t *testing.T) {
 		scanErr := errors.New("scan error")
 		mockRow := &mockDb.MockRow{Err: scanErr}
 
-		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{productID, amount}).Return(mockRow)
+		mockDB.On("QueryRow", ctx, mock.Anything, adjustmentArgs(productID, amount)).Return(mockRow)
 
 		err := repo.IncreaseStock(ctx, productID, amount)
 
@@ -225,7 +250,7 @@ func TestProductRepo_DecreaseStock(t *testing.T) {
 			Value: 4, // New version
 		}
 
-		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{productID, amount}).Return(mockRow)
+		mockDB.On("QueryRow", ctx, mock.Anything, adjustmentArgs(productID, amount)).Return(mockRow)
 
 		err := repo.DecreaseStock(ctx, productID, amount)
 
@@ -262,7 +287,7 @@ func TestProductRepo_DecreaseStock(t *testing.T) {
 		// Mock para verificação de existência
 		mockRowCheck := &mockDb.MockRow{Err: pgx.ErrNoRows}
 
-		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{productID, amount}).Return(mockRowMain)
+		mockDB.On("QueryRow", ctx, mock.Anything, adjustmentArgs(productID, amount)).Return(mockRowMain)
 		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{productID}).Return(mockRowCheck)
 
 		err := repo.DecreaseStock(ctx, productID, amount)
@@ -285,7 +310,7 @@ func TestProductRepo_DecreaseStock(t *testing.T) {
 			Value: 1,
 		}
 
-		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{productID, amount}).Return(mockRowMain)
+		mockDB.On("QueryRow", ctx, mock.Anything, adjustmentArgs(productID, amount)).Return(mockRowMain)
 		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{productID}).Return(mockRowCheck)
 
 		err := repo.DecreaseStock(ctx, productID, amount)
@@ -304,7 +329,7 @@ func TestProductRepo_DecreaseStock(t *testing.T) {
 		scanErr := errors.New("scan error")
 		mockRow := &mockDb.MockRow{Err: scanErr}
 
-		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{productID, amount}).Return(mockRow)
+		mockDB.On("QueryRow", ctx, mock.Anything, adjustmentArgs(productID, amount)).Return(mockRow)
 
 		err := repo.DecreaseStock(ctx, productID, amount)
 
@@ -313,3 +338,9 @@ func TestProductRepo_DecreaseStock(t *testing.T) {
 		mockDB.AssertExpectations(t)
 	})
 }
+
+// adjustmentArgs são os argumentos de uma alteração manual de estoque seguidos
+// dos parâmetros do movimento (reason, ref_type, ref_id, user_id).
+func adjustmentArgs(id int64, n int) []interface{} {
+	return []interface{}{id, n, "adjustment", "", (*int64)(nil), (*int64)(nil)}
+}
@@ -0,0 +1,248 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+)
+
+type productStockTx struct{}
+
+func NewProductStockTx() iface.ProductStockTx {
+	return &productStockTx{}
+}
+
+func NewProductReceiveTx() iface.ProductReceiveTx {
+	return &productStockTx{}
+}
+
+func NewProductAdjustTx() iface.ProductAdjustTx {
+	return &productStockTx{}
+}
+
+// GetByIDForUpdateTx bloqueia a linha do produto até o fim da transação,
+// garantindo que o estoque lido não seja alterado por vendas concorrentes.
+func (r *productStockTx) GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Product, error) {
+	const query = `
+	SELECT id,
+	       supplier_id,
+	       product_name,
+	       manufacturer,
+	       product_description,
+	       cost_price,
+	       sale_price,
+	       stock_quantity,
+	       min_stock,
+	       max_stock,
+	       barcode,
+	       status,
+	       version,
+	       allow_discount,
+	       min_discount_percent,
+	       max_discount_percent,
+	       created_at,
+	       updated_at
+	FROM products
+	WHERE id = $1
+	FOR UPDATE;
+	`
+
+	var p models.Product
+	if err := scanProductRow(tx.QueryRow(ctx, query, id), &p); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	return &p, nil
+}
+
+// DecreaseStockTx baixa amount do estoque e grava o movimento com a origem
+// informada na mesma instrução.
+func (r *productStockTx) DecreaseStockTx(ctx context.Context, tx pgx.Tx, id int64, amount int, origin modelsMovement.Origin) error {
+	if amount <= 0 {
+		return errMsg.ErrInvalidQuantity
+	}
+
+	const query = `
+		WITH updated AS (
+			UPDATE products
+			SET stock_quantity = stock_quantity - $2,
+			    updated_at = NOW(),
+			    version = version + 1
+			WHERE id = $1
+			  AND stock_quantity >= $2
+			RETURNING id, stock_quantity, version
+		), movement AS (
+			INSERT INTO stock_movements (product_id, delta, quantity, reason, ref_type, ref_id, user_id)
+			SELECT id, -$2::int, stock_quantity, $3::text, NULLIF($4::text, ''), $5::bigint, $6::int
+			FROM updated
+		)
+		SELECT version FROM updated;
+	`
+
+	args := append([]any{id, amount}, movementArgs(ctx, origin)...)
+
+	var version int
+	err := tx.QueryRow(ctx, query, args...).Scan(&version)
+	if err != nil {
+		// CHECK de product_stocks: o total cobre, mas parte está em outro local
+		if errors.Is(err, pgx.ErrNoRows) || errMsgPg.IsCheckViolation(err) {
+			return errMsg.ErrInsufficientStock
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	return nil
+}
+
+// IncreaseStockTx soma amount ao estoque e grava o movimento com a origem
+// informada na mesma instrução.
+func (r *productStockTx) IncreaseStockTx(ctx context.Context, tx pgx.Tx, id int64, amount int, origin modelsMovement.Origin) error {
+	if amount <= 0 {
+		return errMsg.ErrInvalidQuantity
+	}
+
+	const query = `
+		WITH updated AS (
+			UPDATE products
+			SET stock_quantity = stock_quantity + $2,
+			    updated_at = NOW(),
+			    version = version + 1
+			WHERE id = $1
+			RETURNING id, stock_quantity, version
+		), movement AS (
+			INSERT INTO stock_movements (product_id, delta, quantity, reason, ref_type, ref_id, user_id)
+			SELECT id, $2::int, stock_quantity, $3::text, NULLIF($4::text, ''), $5::bigint, $6::int
+			FROM updated
+		)
+		SELECT version FROM updated;
+	`
+
+	args := append([]any{id, amount}, movementArgs(ctx, origin)...)
+
+	var version int
+	err := tx.QueryRow(ctx, query, args...).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errMsg.ErrNotFound
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	return nil
+}
+
+// ReceiveStockTx soma quantity ao estoque e atualiza cost_price. Com "average"
+// o custo passa a ser a média ponderada entre o estoque atual e a entrada; com
+// "latest" assume o custo da entrada. A entrada é gravada no histórico de
+// estoque com a origem informada e, se o custo mudar, o novo par de preços
+// entra no histórico de preços vinculado ao mesmo documento.
+func (r *productStockTx) ReceiveStockTx(ctx context.Context, tx pgx.Tx, id int64, quantity int, unitCost money.Money, costMethod string, origin modelsMovement.Origin) error {
+	if quantity <= 0 {
+		return errMsg.ErrInvalidQuantity
+	}
+	if unitCost.IsNegative() || (costMethod != "latest" && costMethod != "average") {
+		return errMsg.ErrInvalidData
+	}
+
+	const query = `
+		WITH old AS (
+			SELECT id, cost_price
+			FROM products
+			WHERE id = $1
+			FOR UPDATE
+		), updated AS (
+			UPDATE products p
+			SET cost_price = CASE
+			        WHEN $4::text = 'average' AND p.stock_quantity + $2 > 0
+			            THEN ROUND((p.stock_quantity * p.cost_price + $2 * $3::numeric) / (p.stock_quantity + $2), 2)
+			        ELSE $3::numeric
+			    END,
+			    stock_quantity = p.stock_quantity + $2,
+			    updated_at = NOW(),
+			    version = p.version + 1
+			FROM old
+			WHERE p.id = old.id
+			RETURNING p.id, p.stock_quantity, p.version, p.cost_price, p.sale_price, p.updated_at,
+				p.cost_price <> old.cost_price AS repriced
+		), movement AS (
+			INSERT INTO stock_movements (product_id, delta, quantity, reason, ref_type, ref_id, user_id)
+			SELECT id, $2::int, stock_quantity, $5::text, NULLIF($6::text, ''), $7::bigint, $8::int
+			FROM updated
+		), price AS (
+			INSERT INTO product_prices (product_id, cost_price, sale_price, effective_from, source, ref_id, changed_by)
+			SELECT id, cost_price, sale_price, updated_at, 'receipt', $7::bigint, $8::int
+			FROM updated
+			WHERE repriced
+		)
+		SELECT version FROM updated;
+	`
+
+	args := append([]any{id, quantity, unitCost, costMethod}, movementArgs(ctx, origin)...)
+
+	var version int
+	err := tx.QueryRow(ctx, query, args...).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errMsg.ErrNotFound
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	return nil
+}
+
+// SetStockTx define o saldo do produto como quantity. A diferença para o
+// saldo anterior é gravada com a origem informada; sem diferença nenhum
+// movimento é gerado, mas a versão do produto é incrementada do mesmo modo.
+func (r *productStockTx) SetStockTx(ctx context.Context, tx pgx.Tx, id int64, quantity int, origin modelsMovement.Origin) error {
+	if quantity < 0 {
+		return errMsg.ErrInvalidQuantity
+	}
+
+	const query = `
+		WITH old AS (
+			SELECT id, stock_quantity
+			FROM products
+			WHERE id = $1
+			FOR UPDATE
+		), updated AS (
+			UPDATE products p
+			SET stock_quantity = $2, updated_at = NOW(), version = version + 1
+			FROM old
+			WHERE p.id = old.id
+			RETURNING p.id, p.stock_quantity, p.version, p.stock_quantity - old.stock_quantity AS delta
+		), movement AS (
+			INSERT INTO stock_movements (product_id, delta, quantity, reason, ref_type, ref_id, user_id)
+			SELECT id, delta, stock_quantity, $3::text, NULLIF($4::text, ''), $5::bigint, $6::int
+			FROM updated
+			WHERE delta <> 0
+		)
+		SELECT version FROM updated;
+	`
+
+	args := append([]any{id, quantity}, movementArgs(ctx, origin)...)
+
+	var version int
+	err := tx.QueryRow(ctx, query, args...).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errMsg.ErrNotFound
+		}
+		if errMsgPg.IsCheckViolation(err) {
+			return errMsg.ErrInsufficientStock
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,298 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewProductStockTx(t *testing.T) {
+	result := NewProductStockTx()
+
+	assert.NotNil(t, result)
+	_, ok := result.(*productStockTx)
+	assert.True(t, ok, "Expected result to be of type *productStockTx")
+}
+
+func TestProductStockTx_GetByIDForUpdateTx(t *testing.T) {
+	t.Run("successfully lock and get product", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &productStockTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "FOR UPDATE")
+		}), []interface{}{int64(1)}).Return(&mockDb.MockRow{})
+
+		product, err := repo.GetByIDForUpdateTx(ctx, mockTx, 1)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, product)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when product does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &productStockTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(9)}).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		product, err := repo.GetByIDForUpdateTx(ctx, mockTx, 9)
+
+		assert.Nil(t, product)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrGet on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &productStockTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		product, err := repo.GetByIDForUpdateTx(ctx, mockTx, 1)
+
+		assert.Nil(t, product)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestProductStockTx_DecreaseStockTx(t *testing.T) {
+	t.Run("return ErrInvalidQuantity when amount is not positive", func(t *testing.T) {
+		repo := &productStockTx{}
+
+		err := repo.DecreaseStockTx(context.Background(), new(mockDb.MockTx), 1, 0, saleOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidQuantity)
+	})
+
+	t.Run("successfully decrease stock", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &productStockTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "INSERT INTO stock_movements")
+		}), saleArgs(int64(1), 3)).Return(&mockDb.MockRow{Value: 2})
+
+		err := repo.DecreaseStockTx(ctx, mockTx, 1, 3, saleOrigin)
+
+		assert.NoError(t, err)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrInsufficientStock when no row is updated", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &productStockTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, saleArgs(int64(1), 3)).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.DecreaseStockTx(ctx, mockTx, 1, 3, saleOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrInsufficientStock)
+	})
+
+	t.Run("return ErrUpdate on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &productStockTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, saleArgs(int64(1), 3)).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		err := repo.DecreaseStockTx(ctx, mockTx, 1, 3, saleOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
+
+func TestProductStockTx_IncreaseStockTx(t *testing.T) {
+	t.Run("return ErrInvalidQuantity when amount is not positive", func(t *testing.T) {
+		repo := &productStockTx{}
+
+		err := repo.IncreaseStockTx(context.Background(), new(mockDb.MockTx), 1, -1, saleOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidQuantity)
+	})
+
+	t.Run("successfully increase stock", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &productStockTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, saleArgs(int64(1), 3)).Return(&mockDb.MockRow{Value: 2})
+
+		err := repo.IncreaseStockTx(ctx, mockTx, 1, 3, saleOrigin)
+
+		assert.NoError(t, err)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when product does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &productStockTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, saleArgs(int64(1), 3)).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.IncreaseStockTx(ctx, mockTx, 1, 3, saleOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrUpdate on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &productStockTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, saleArgs(int64(1), 3)).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		err := repo.IncreaseStockTx(ctx, mockTx, 1, 3, saleOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
+
+func TestProductStockTx_ReceiveStockTx(t *testing.T) {
+	t.Run("return ErrInvalidQuantity when quantity is not positive", func(t *testing.T) {
+		repo := NewProductReceiveTx()
+
+		err := repo.ReceiveStockTx(context.Background(), new(mockDb.MockTx), 1, 0, money.MustParse("2.50"), "latest", receiptOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidQuantity)
+	})
+
+	t.Run("return ErrInvalidData on negative cost or unknown method", func(t *testing.T) {
+		repo := &productStockTx{}
+
+		assert.ErrorIs(t, repo.ReceiveStockTx(context.Background(), new(mockDb.MockTx), 1, 1, money.New(-1), "latest", receiptOrigin), errMsg.ErrInvalidData)
+		assert.ErrorIs(t, repo.ReceiveStockTx(context.Background(), new(mockDb.MockTx), 1, 1, money.New(1), "fifo", receiptOrigin), errMsg.ErrInvalidData)
+	})
+
+	t.Run("successfully receive stock", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &productStockTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, append([]interface{}{int64(1), 3, money.MustParse("2.50"), "average"}, receiptMovement...)).Return(&mockDb.MockRow{Value: 2})
+
+		err := repo.ReceiveStockTx(ctx, mockTx, 1, 3, money.MustParse("2.50"), "average", receiptOrigin)
+
+		assert.NoError(t, err)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when product does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &productStockTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, append([]interface{}{int64(1), 3, money.MustParse("2.50"), "latest"}, receiptMovement...)).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.ReceiveStockTx(ctx, mockTx, 1, 3, money.MustParse("2.50"), "latest", receiptOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrUpdate on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &productStockTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, append([]interface{}{int64(1), 3, money.MustParse("2.50"), "latest"}, receiptMovement...)).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		err := repo.ReceiveStockTx(ctx, mockTx, 1, 3, money.MustParse("2.50"), "latest", receiptOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
+
+func TestProductStockTx_SetStockTx(t *testing.T) {
+	countOrigin := modelsMovement.NewOrigin(modelsMovement.ReasonCount, modelsMovement.RefInventoryCount, 2)
+	countArgs := []interface{}{int64(1), 4, "count", "inventory_count", countOrigin.RefID, (*int64)(nil)}
+
+	t.Run("return ErrInvalidQuantity when quantity is negative", func(t *testing.T) {
+		repo := NewProductAdjustTx()
+
+		err := repo.SetStockTx(context.Background(), new(mockDb.MockTx), 1, -1, countOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidQuantity)
+	})
+
+	t.Run("successfully set stock", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &productStockTx{}
+		ctx := context.Background()
+
+		query := mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "stock_quantity = $2") && strings.Contains(q, "WHERE delta <> 0")
+		})
+		mockTx.On("QueryRow", ctx, query, countArgs).Return(&mockDb.MockRow{Value: 3})
+
+		err := repo.SetStockTx(ctx, mockTx, 1, 4, countOrigin)
+
+		assert.NoError(t, err)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when product does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &productStockTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, countArgs).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.SetStockTx(ctx, mockTx, 1, 4, countOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrUpdate on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &productStockTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, countArgs).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		err := repo.SetStockTx(ctx, mockTx, 1, 4, countOrigin)
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
+
+func TestProductStockTx_MovementUser(t *testing.T) {
+	mockTx := new(mockDb.MockTx)
+	repo := &productStockTx{}
+	ctx := contextUtils.SetUserID(context.Background(), "5")
+	userID := int64(5)
+
+	args := []interface{}{int64(1), 3, "sale", "sale", saleOrigin.RefID, &userID}
+	mockTx.On("QueryRow", ctx, mock.Anything, args).Return(&mockDb.MockRow{Value: 2})
+
+	err := repo.DecreaseStockTx(ctx, mockTx, 1, 3, saleOrigin)
+
+	assert.NoError(t, err)
+	mockTx.AssertExpectations(t)
+}
+
+var (
+	saleOrigin      = modelsMovement.NewOrigin(modelsMovement.ReasonSale, modelsMovement.RefSale, 7)
+	receiptOrigin   = modelsMovement.NewOrigin(modelsMovement.ReasonReceipt, modelsMovement.RefPurchaseOrder, 4)
+	receiptMovement = []interface{}{"receipt", "purchase_order", receiptOrigin.RefID, (*int64)(nil)}
+)
+
+// saleArgs são id e quantidade seguidos dos parâmetros do movimento de venda.
+func saleArgs(id int64, n int) []interface{} {
+	return []interface{}{id, n, "sale", "sale", saleOrigin.RefID, (*int64)(nil)}
+}
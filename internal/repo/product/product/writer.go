@@ -6,26 +6,44 @@ import (
 	"fmt"
 
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
 	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 
 	"github.com/jackc/pgx/v5"
 )
 
+// Create grava o produto, o preço de abertura no histórico de preços e,
+// havendo estoque inicial, o movimento de abertura.
 func (r *productRepo) Create(ctx context.Context, product *models.Product) (*models.Product, error) {
 	const query = `
-		INSERT INTO products (
-			supplier_id, product_name, manufacturer,
-			product_description, cost_price, sale_price,
-			stock_quantity, min_stock, max_stock,
-			barcode, status,
-			allow_discount, min_discount_percent, max_discount_percent,
-			created_at, updated_at
+		WITH created AS (
+			INSERT INTO products (
+				supplier_id, product_name, manufacturer,
+				product_description, cost_price, sale_price,
+				stock_quantity, min_stock, max_stock,
+				barcode, status,
+				allow_discount, min_discount_percent, max_discount_percent,
+				created_at, updated_at
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, NOW(), NOW())
+			RETURNING id, cost_price, sale_price, stock_quantity, version, created_at, updated_at
+		), movement AS (
+			INSERT INTO stock_movements (product_id, delta, quantity, reason, ref_type, ref_id, user_id)
+			SELECT id, stock_quantity, stock_quantity, $15::text, NULLIF($16::text, ''), $17::bigint, $18::int
+			FROM created
+			WHERE stock_quantity <> 0
+		), price AS (
+			INSERT INTO product_prices (product_id, cost_price, sale_price, effective_from, source, changed_by)
+			SELECT id, cost_price, sale_price, created_at, 'opening', $18::int
+			FROM created
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, NOW(), NOW())
-		RETURNING id, version, created_at, updated_at;
+		SELECT id, version, created_at, updated_at FROM created;
 	`
 
+	opening := modelsMovement.Origin{Reason: modelsMovement.ReasonAdjustment, RefType: modelsMovement.RefOpening}
+	movement := movementArgs(ctx, opening)
+
 	err := r.db.QueryRow(ctx, query,
 		product.SupplierID,
 		product.ProductName,
@@ -41,6 +59,7 @@ func (r *productRepo) Create(ctx context.Context, product *models.Product) (*mod
 		product.AllowDiscount,
 		product.MinDiscountPercent,
 		product.MaxDiscountPercent,
+		movement[0], movement[1], movement[2], movement[3],
 	).Scan(&product.ID, &product.Version, &product.CreatedAt, &product.UpdatedAt)
 
 	if err != nil {
@@ -62,30 +81,56 @@ func (r *productRepo) Create(ctx context.Context, product *models.Product) (*mod
 	return product, nil
 }
 
+// Update grava o produto inteiro. Se o saldo mudar, a diferença entra no
+// histórico de estoque como ajuste manual; se um dos preços mudar, o novo
+// par entra no histórico de preços.
 func (r *productRepo) Update(ctx context.Context, product *models.Product) error {
 	const query = `
-		UPDATE products
-		SET
-			supplier_id = $1,
-			product_name = $2,
-			manufacturer = $3,
-			product_description = $4,
-			cost_price = $5,
-			sale_price = $6,
-			stock_quantity = $7,
-			min_stock = $8,
-			max_stock = $9,
-			barcode = $10,
-			status = $11,
-			version = version + 1,
-			allow_discount = $12,
-			min_discount_percent = $13,
-			max_discount_percent = $14,
-			updated_at = NOW()
-		WHERE id = $15 AND version = $16
-		RETURNING updated_at, version;
+		WITH old AS (
+			SELECT id, stock_quantity, cost_price, sale_price
+			FROM products
+			WHERE id = $15 AND version = $16
+			FOR UPDATE
+		), updated AS (
+			UPDATE products p
+			SET
+				supplier_id = $1,
+				product_name = $2,
+				manufacturer = $3,
+				product_description = $4,
+				cost_price = $5,
+				sale_price = $6,
+				stock_quantity = $7,
+				min_stock = $8,
+				max_stock = $9,
+				barcode = $10,
+				status = $11,
+				version = version + 1,
+				allow_discount = $12,
+				min_discount_percent = $13,
+				max_discount_percent = $14,
+				updated_at = NOW()
+			FROM old
+			WHERE p.id = old.id
+			RETURNING p.id, p.stock_quantity, p.cost_price, p.sale_price, p.updated_at, p.version,
+				p.stock_quantity - old.stock_quantity AS delta,
+				(p.cost_price, p.sale_price) IS DISTINCT FROM (old.cost_price, old.sale_price) AS repriced
+		), movement AS (
+			INSERT INTO stock_movements (product_id, delta, quantity, reason, ref_type, ref_id, user_id)
+			SELECT id, delta, stock_quantity, $17::text, NULLIF($18::text, ''), $19::bigint, $20::int
+			FROM updated
+			WHERE delta <> 0
+		), price AS (
+			INSERT INTO product_prices (product_id, cost_price, sale_price, effective_from, source, changed_by)
+			SELECT id, cost_price, sale_price, updated_at, 'manual', $20::int
+			FROM updated
+			WHERE repriced
+		)
+		SELECT updated_at, version FROM updated;
 	`
 
+	movement := movementArgs(ctx, modelsMovement.Adjustment())
+
 	err := r.db.QueryRow(ctx, query,
 		product.SupplierID,
 		product.ProductName,
@@ -103,6 +148,7 @@ func (r *productRepo) Update(ctx context.Context, product *models.Product) error
 		product.MaxDiscountPercent,
 		product.ID,
 		product.Version,
+		movement[0], movement[1], movement[2], movement[3],
 	).Scan(&product.UpdatedAt, &product.Version)
 
 	if err != nil {
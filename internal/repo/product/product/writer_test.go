@@ -11,6 +11,7 @@ import (
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
 	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -29,8 +30,8 @@ func TestProductRepo_Create(t *testing.T) {
 			ProductName:        "Test Product",
 			Manufacturer:       "Test Manufacturer",
 			Description:        "Test Description",
-			CostPrice:          10.50,
-			SalePrice:          15.99,
+			CostPrice:          money.MustParse("10.50"),
+			SalePrice:          money.MustParse("15.99"),
 			StockQuantity:      100,
 			Barcode:            utils.StrToPtr("1234567890123"),
 			Status:             true,
@@ -91,8 +92,8 @@ func TestProductRepo_Create(t *testing.T) {
 			ProductName:        "Test Product",
 			Manufacturer:       "Test Manufacturer",
 			Description:        "Test Description",
-			CostPrice:          10.50,
-			SalePrice:          15.99,
+			CostPrice:          money.MustParse("10.50"),
+			SalePrice:          money.MustParse("15.99"),
 			StockQuantity:      100,
 			Barcode:            utils.StrToPtr("1234567890123"), // Barcode duplicado
 			Status:             true,
@@ -174,8 +175,8 @@ func TestProductRepo_Create(t *testing.T) {
 			ProductName:        "Test Product",
 			Manufacturer:       "Test Manufacturer",
 			Description:        "Test Description",
-			CostPrice:          10.50,
-			SalePrice:          -5.0, // Valor inválido (viola CHECK sale_price >= 0)
+			CostPrice:          money.MustParse("10.50"),
+			SalePrice:          money.New(-5), // Valor inválido (viola CHECK sale_price >= 0)
 			StockQuantity:      100,
 			MinStock:           0,
 			MaxStock:           nil,
@@ -219,8 +220,8 @@ func TestProductRepo_Create(t *testing.T) {
 			ProductName:        "Test Product",
 			Manufacturer:       "Test Manufacturer",
 			Description:        "Test Description",
-			CostPrice:          10.0,
-			SalePrice:          20.0,
+			CostPrice:          money.New(10),
+			SalePrice:          money.New(20),
 			StockQuantity:      100,
 			MinStock:           0,
 			MaxStock:           nil,
@@ -258,8 +259,8 @@ func TestProductRepo_Create(t *testing.T) {
 			ProductName:        "Test Product",
 			Manufacturer:       "Test Manufacturer",
 			Description:        "Test Description",
-			CostPrice:          20.0,
-			SalePrice:          15.0, // Menor que cost_price (viola constraint implícita)
+			CostPrice:          money.New(20),
+			SalePrice:          money.New(15), // Menor que cost_price (viola constraint implícita)
 			StockQuantity:      100,
 			MinStock:           0,
 			MaxStock:           nil,
@@ -300,8 +301,8 @@ func TestProductRepo_Update(t *testing.T) {
 			ProductName:        "Updated Product",
 			Manufacturer:       "Updated Manufacturer",
 			Description:        "Updated Description",
-			CostPrice:          12.50,
-			SalePrice:          18.99,
+			CostPrice:          money.MustParse("12.50"),
+			SalePrice:          money.MustParse("18.99"),
 			StockQuantity:      150,
 			MinStock:           10,
 			MaxStock:           utils.IntPtr(1000),
@@ -341,8 +342,8 @@ func TestProductRepo_Update(t *testing.T) {
 			ProductName:        "Product",
 			Manufacturer:       "Manufacturer",
 			Description:        "Description",
-			CostPrice:          10.0,
-			SalePrice:          15.0,
+			CostPrice:          money.New(10),
+			SalePrice:          money.New(15),
 			StockQuantity:      100,
 			MinStock:           5,
 			MaxStock:           utils.IntPtr(500),
@@ -381,8 +382,8 @@ func TestProductRepo_Update(t *testing.T) {
 			ProductName:        "Updated Product",
 			Manufacturer:       "Updated Manufacturer",
 			Description:        "Updated Description",
-			CostPrice:          12.50,
-			SalePrice:          18.99,
+			CostPrice:          money.MustParse("12.50"),
+			SalePrice:          money.MustParse("18.99"),
 			StockQuantity:      150,
 			MinStock:           10,
 			MaxStock:           utils.IntPtr(1000),
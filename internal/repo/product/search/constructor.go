@@ -0,0 +1,11 @@
+package repo
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+
+type productSearchRepo struct {
+	db repo.DBExecutor
+}
+
+func NewProductSearch(db repo.DBExecutor) ProductSearch {
+	return &productSearchRepo{db: db}
+}
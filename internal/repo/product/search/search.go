@@ -0,0 +1,167 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	search "github.com/WagaoCarvalho/backend_store_go/internal/model/product/search"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+)
+
+// Os candidatos vêm de ramos que usam, cada um, o seu índice (barcode,
+// search_vector, trigramas do nome e das categorias); a relevância só é
+// calculada para eles. f_unaccent e search_vector vêm da migração 000026.
+const searchQuery = `
+	WITH q AS (
+		SELECT
+			$1::text AS raw,
+			websearch_to_tsquery('portuguese', f_unaccent($1::text)) AS tsq,
+			f_unaccent(lower($1::text)) AS term
+	),
+	candidates AS (
+		SELECT p.id FROM products p, q WHERE p.barcode = q.raw
+		UNION
+		SELECT p.id FROM products p, q WHERE p.search_vector @@ q.tsq
+		UNION
+		SELECT p.id FROM products p, q WHERE f_unaccent(lower(p.product_name)) % q.term
+		UNION
+		SELECT r.product_id
+		FROM product_category_relations r
+		JOIN product_categories pc ON pc.id = r.category_id, q
+		WHERE f_unaccent(lower(pc.name)) % q.term
+		   OR to_tsvector('portuguese', f_unaccent(pc.name)) @@ q.tsq
+	)
+	SELECT
+		p.id,
+		p.supplier_id,
+		p.product_name,
+		p.manufacturer,
+		COALESCE(p.product_description, ''),
+		p.cost_price,
+		p.sale_price,
+		p.stock_quantity,
+		p.min_stock,
+		p.max_stock,
+		p.barcode,
+		p.status,
+		p.version,
+		p.allow_discount,
+		p.min_discount_percent,
+		p.max_discount_percent,
+		p.created_at,
+		p.updated_at,
+		COALESCE(p.barcode = q.raw, FALSE) AS barcode_match,
+		(
+			ts_rank(p.search_vector, q.tsq)
+			+ COALESCE(ts_rank(cat.vector, q.tsq), 0) * 0.5
+			+ similarity(f_unaccent(lower(p.product_name)), q.term)
+		)::float8 AS rank
+	FROM candidates c
+	JOIN products p ON p.id = c.id
+	CROSS JOIN q
+	LEFT JOIN LATERAL (
+		SELECT to_tsvector('portuguese', f_unaccent(string_agg(pc.name, ' '))) AS vector
+		FROM product_category_relations r
+		JOIN product_categories pc ON pc.id = r.category_id
+		WHERE r.product_id = p.id
+	) cat ON TRUE
+	ORDER BY barcode_match DESC, rank DESC, p.id
+	LIMIT $2
+`
+
+// O autocomplete do PDV lista só produtos ativos: prefixo do nome primeiro,
+// depois parecidos por trigramas (tolerando erros de digitação).
+const autocompleteQuery = `
+	WITH q AS (
+		SELECT $1::text AS raw, f_unaccent(lower($1::text)) AS term, f_unaccent(lower($2::text)) AS pattern
+	)
+	SELECT p.id, p.product_name, p.sale_price, p.stock_quantity
+	FROM products p, q
+	WHERE p.status = TRUE
+	  AND (
+		p.barcode = q.raw
+		OR f_unaccent(lower(p.product_name)) LIKE '%' || q.pattern || '%'
+		OR q.term <% f_unaccent(lower(p.product_name))
+	  )
+	ORDER BY
+		COALESCE(p.barcode = q.raw, FALSE) DESC,
+		(f_unaccent(lower(p.product_name)) LIKE q.pattern || '%') DESC,
+		word_similarity(q.term, f_unaccent(lower(p.product_name))) DESC,
+		p.product_name
+	LIMIT $3
+`
+
+func (r *productSearchRepo) Search(ctx context.Context, s *search.ProductSearch) ([]*search.Result, error) {
+	rows, err := r.db.Query(ctx, searchQuery, s.Query, s.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	results := make([]*search.Result, 0)
+
+	for rows.Next() {
+		var (
+			p   models.Product
+			res search.Result
+		)
+		if err := rows.Scan(
+			&p.ID,
+			&p.SupplierID,
+			&p.ProductName,
+			&p.Manufacturer,
+			&p.Description,
+			&p.CostPrice,
+			&p.SalePrice,
+			&p.StockQuantity,
+			&p.MinStock,
+			&p.MaxStock,
+			&p.Barcode,
+			&p.Status,
+			&p.Version,
+			&p.AllowDiscount,
+			&p.MinDiscountPercent,
+			&p.MaxDiscountPercent,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+			&res.BarcodeMatch,
+			&res.Rank,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		res.Product = &p
+		results = append(results, &res)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return results, nil
+}
+
+func (r *productSearchRepo) Autocomplete(ctx context.Context, s *search.ProductSearch) ([]*search.Suggestion, error) {
+	rows, err := r.db.Query(ctx, autocompleteQuery, s.Query, builder.EscapeLike(s.Query), s.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	suggestions := make([]*search.Suggestion, 0)
+
+	for rows.Next() {
+		var sg search.Suggestion
+		if err := rows.Scan(&sg.ID, &sg.ProductName, &sg.SalePrice, &sg.StockQuantity); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		suggestions = append(suggestions, &sg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return suggestions, nil
+}
@@ -0,0 +1,129 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	search "github.com/WagaoCarvalho/backend_store_go/internal/model/product/search"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProductSearch_Search(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("retorna produtos com relevância", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewProductSearch(mockDB)
+
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{int64(3), nil, "Café Torrado", "Pilão", "", 10.0, 18.5, 40, 5, nil, nil, true, 1, false, 0.0, 0.0, now, now, true, 1.7}},
+			{Values: []any{int64(9), nil, "Cafeteira", "Arno", "", 80.0, 120.0, 3, 1, nil, nil, true, 1, false, 0.0, 0.0, now, now, false, 0.4}},
+		}}
+		mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "websearch_to_tsquery('portuguese'") &&
+				strings.Contains(q, "ORDER BY barcode_match DESC, rank DESC")
+		}), []any{"cafe", 20}).Return(rows, nil)
+
+		results, err := repo.Search(ctx, &search.ProductSearch{Query: "cafe", Limit: 20})
+
+		assert.NoError(t, err)
+		assert.Len(t, results, 2)
+		assert.Equal(t, int64(3), results[0].Product.ID)
+		assert.Equal(t, "Café Torrado", results[0].Product.ProductName)
+		assert.True(t, results[0].BarcodeMatch)
+		assert.Equal(t, 1.7, results[0].Rank)
+		assert.Equal(t, money.New(120), results[1].Product.SalePrice)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewProductSearch(mockDB)
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.Search(ctx, &search.ProductSearch{Query: "cafe", Limit: 20})
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("erro no scan", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewProductSearch(mockDB)
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("bad row")}}}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
+
+		_, err := repo.Search(ctx, &search.ProductSearch{Query: "cafe", Limit: 20})
+
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+
+	t.Run("erro na iteração", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewProductSearch(mockDB)
+		rows := new(mockDb.MockRows)
+		rows.On("Next").Return(false)
+		rows.On("Err").Return(errors.New("conn lost"))
+		rows.On("Close").Return()
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
+
+		_, err := repo.Search(ctx, &search.ProductSearch{Query: "cafe", Limit: 20})
+
+		assert.ErrorIs(t, err, errMsg.ErrIterate)
+	})
+}
+
+func TestProductSearch_Autocomplete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("escapa curingas no padrão de prefixo", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewProductSearch(mockDB)
+
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{int64(7), "Leite 100% integral", 5.49, 12}},
+		}}
+		mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "p.status = TRUE") && strings.Contains(q, "LIMIT $3")
+		}), []any{"100%", `100\%`, 10}).Return(rows, nil)
+
+		suggestions, err := repo.Autocomplete(ctx, &search.ProductSearch{Query: "100%", Limit: 10})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*search.Suggestion{{ID: 7, ProductName: "Leite 100% integral", SalePrice: money.MustParse("5.49"), StockQuantity: 12}}, suggestions)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("sem resultados devolve slice vazio", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewProductSearch(mockDB)
+		rows := new(mockDb.MockRows)
+		rows.On("Next").Return(false)
+		rows.On("Err").Return(nil)
+		rows.On("Close").Return()
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
+
+		suggestions, err := repo.Autocomplete(ctx, &search.ProductSearch{Query: "xyz", Limit: 10})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, suggestions)
+		assert.Empty(t, suggestions)
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewProductSearch(mockDB)
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.Autocomplete(ctx, &search.ProductSearch{Query: "xyz", Limit: 10})
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
@@ -0,0 +1,11 @@
+package repo
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+
+type stockAlertRepo struct {
+	db repo.DBExecutor
+}
+
+func NewStockAlert(db repo.DBExecutor) StockAlert {
+	return &stockAlertRepo{db: db}
+}
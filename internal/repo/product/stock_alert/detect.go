@@ -0,0 +1,66 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_alert"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+// A resolução roda no mesmo comando que a abertura: um CTE com UPDATE é
+// executado mesmo sem ser lido. O índice parcial uq_stock_alerts_open evita
+// alertas duplicados quando mais de uma instância verifica ao mesmo tempo.
+const detectQuery = `
+	WITH resolved AS (
+		UPDATE stock_alerts a
+		SET resolved_at = NOW()
+		FROM products p
+		WHERE a.product_id = p.id
+		  AND a.resolved_at IS NULL
+		  AND (p.stock_quantity >= p.min_stock OR p.status = FALSE)
+		  AND ($1::bigint[] IS NULL OR p.id = ANY($1::bigint[]))
+	),
+	opened AS (
+		INSERT INTO stock_alerts (product_id, stock_quantity, min_stock)
+		SELECT p.id, p.stock_quantity, p.min_stock
+		FROM products p
+		WHERE p.status = TRUE
+		  AND p.stock_quantity < p.min_stock
+		  AND ($1::bigint[] IS NULL OR p.id = ANY($1::bigint[]))
+		ON CONFLICT (product_id) WHERE resolved_at IS NULL DO NOTHING
+		RETURNING id, product_id, stock_quantity, min_stock, created_at
+	)
+	SELECT o.id, o.product_id, p.product_name, o.stock_quantity, o.min_stock, o.created_at
+	FROM opened o
+	JOIN products p ON p.id = o.product_id
+	ORDER BY o.product_id;
+`
+
+func (r *stockAlertRepo) Detect(ctx context.Context, productIDs []int64) ([]*models.StockAlert, error) {
+	var ids []int64
+	if len(productIDs) > 0 {
+		ids = productIDs
+	}
+
+	rows, err := r.db.Query(ctx, detectQuery, ids)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+	defer rows.Close()
+
+	opened := make([]*models.StockAlert, 0)
+	for rows.Next() {
+		var a models.StockAlert
+		if err := rows.Scan(&a.ID, &a.ProductID, &a.ProductName, &a.StockQuantity, &a.MinStock, &a.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		opened = append(opened, &a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return opened, nil
+}
@@ -0,0 +1,15 @@
+package repo
+
+import (
+	"context"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_alert"
+)
+
+type StockAlert interface {
+	iface.StockAlertDetector
+	iface.StockAlertReader
+
+	ReplenishmentCandidates(ctx context.Context, q *models.ReplenishmentQuery) ([]*models.ReplenishmentItem, error)
+}
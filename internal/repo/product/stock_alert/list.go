@@ -0,0 +1,111 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_alert"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+	pagination "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/pagination"
+)
+
+var allowedStockAlertSortFields = map[string]string{
+	"id":          "id",
+	"created_at":  "created_at",
+	"resolved_at": "resolved_at",
+	"product_id":  "product_id",
+}
+
+var stockAlertOrderBy = builder.OrderBy{
+	Fields:       allowedStockAlertSortFields,
+	DefaultField: "id",
+	DefaultOrder: "desc",
+}
+
+func (r *stockAlertRepo) List(ctx context.Context, f *models.StockAlertFilter) (*commonFilter.Page[*models.StockAlert], error) {
+	base := f.BaseFilter.WithDefaults()
+
+	// O nome vem por subconsulta para que as colunas de stock_alerts não
+	// fiquem ambíguas na ordenação e no cursor.
+	query := `
+		SELECT
+			id,
+			product_id,
+			COALESCE((SELECT product_name FROM products WHERE products.id = stock_alerts.product_id), ''),
+			stock_quantity,
+			min_stock,
+			created_at,
+			resolved_at
+		FROM stock_alerts
+	`
+
+	b := builder.NewQueryBuilderSql(query)
+
+	b.Eq("product_id", f.ProductID)
+	switch f.Status {
+	case models.StatusOpen:
+		b.IsNull("resolved_at", true)
+	case models.StatusResolved:
+		b.IsNull("resolved_at", false)
+	}
+
+	sortField, sortOrder := stockAlertOrderBy.Resolve(f.SortBy, f.SortOrder)
+
+	args := b.GetArgs()
+	tail, pageArgs, err := pagination.Clause(base, sortField, sortOrder, b.NextArgPos())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, b.GetQuery()+tail, append(args, pageArgs...)...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	alerts := make([]*models.StockAlert, 0)
+	for rows.Next() {
+		var a models.StockAlert
+		if err := rows.Scan(
+			&a.ID,
+			&a.ProductID,
+			&a.ProductName,
+			&a.StockQuantity,
+			&a.MinStock,
+			&a.CreatedAt,
+			&a.ResolvedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		alerts = append(alerts, &a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	total, err := pagination.Count(ctx, r.db, "stock_alerts", b.Where(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonFilter.NewPage(alerts, total, base, func(a *models.StockAlert) (any, int64) {
+		return stockAlertSortValue(a, sortField), a.ID
+	}), nil
+}
+
+// stockAlertSortValue devolve o valor da coluna de ordenação field no alerta, gravado
+// no cursor da próxima página.
+func stockAlertSortValue(a *models.StockAlert, field string) any {
+	switch field {
+	case "created_at":
+		return a.CreatedAt
+	case "resolved_at":
+		return a.ResolvedAt
+	case "product_id":
+		return a.ProductID
+	}
+	return a.ID
+}
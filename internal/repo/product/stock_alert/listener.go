@@ -0,0 +1,50 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// StockChannel é o canal notificado pelo trigger trg_products_stock_changed
+// (migração 000027) com o ID do produto alterado.
+const StockChannel = "stock_changed"
+
+type stockListener struct {
+	pool *pgxpool.Pool
+}
+
+func NewStockListener(pool *pgxpool.Pool) *stockListener {
+	return &stockListener{pool: pool}
+}
+
+// Listen ocupa uma conexão própria (fora do pool) até ctx terminar ou a
+// conexão cair; notify recebe o ID de cada produto alterado.
+func (l *stockListener) Listen(ctx context.Context, notify func(productID int64)) error {
+	pooled, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao obter conexão: %w", err)
+	}
+
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+StockChannel); err != nil {
+		return fmt.Errorf("erro ao escutar %s: %w", StockChannel, err)
+	}
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		id, err := strconv.ParseInt(n.Payload, 10, 64)
+		if err != nil {
+			continue
+		}
+		notify(id)
+	}
+}
@@ -0,0 +1,125 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_alert"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+const lowStockQuery = `
+	SELECT
+		p.id,
+		p.product_name,
+		p.supplier_id,
+		COALESCE(s.name, ''),
+		p.stock_quantity,
+		p.min_stock,
+		p.max_stock
+	FROM products p
+	LEFT JOIN suppliers s ON s.id = p.supplier_id
+	WHERE p.status = TRUE
+	  AND p.stock_quantity < p.min_stock
+	  AND ($1::bigint IS NULL OR p.supplier_id = $1)
+	ORDER BY (p.min_stock - p.stock_quantity) DESC, p.id;
+`
+
+func (r *stockAlertRepo) LowStock(ctx context.Context, supplierID *int64) ([]*models.LowStockItem, error) {
+	rows, err := r.db.Query(ctx, lowStockQuery, supplierID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	items := make([]*models.LowStockItem, 0)
+	for rows.Next() {
+		var i models.LowStockItem
+		if err := rows.Scan(
+			&i.ProductID,
+			&i.ProductName,
+			&i.SupplierID,
+			&i.SupplierName,
+			&i.StockQuantity,
+			&i.MinStock,
+			&i.MaxStock,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		items = append(items, &i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return items, nil
+}
+
+// Vendas canceladas não contam; devoluções parciais são descontadas do
+// item vendido. O cálculo da quantidade fica em ReplenishmentItem.Suggest.
+const replenishmentQuery = `
+	WITH sold AS (
+		SELECT si.product_id, SUM(si.quantity - COALESCE(ri.returned, 0)) AS quantity
+		FROM sale_items si
+		JOIN sales sa ON sa.id = si.sale_id
+		LEFT JOIN (
+			SELECT sale_item_id, SUM(quantity) AS returned
+			FROM sale_return_items
+			GROUP BY sale_item_id
+		) ri ON ri.sale_item_id = si.id
+		WHERE sa.status <> 'canceled'
+		  AND sa.sale_date >= NOW() - make_interval(days => $1)
+		GROUP BY si.product_id
+	)
+	SELECT
+		p.id,
+		p.product_name,
+		p.supplier_id,
+		COALESCE(s.name, ''),
+		p.stock_quantity,
+		p.min_stock,
+		p.max_stock,
+		p.cost_price,
+		GREATEST(COALESCE(sold.quantity, 0), 0)::int
+	FROM products p
+	LEFT JOIN suppliers s ON s.id = p.supplier_id
+	LEFT JOIN sold ON sold.product_id = p.id
+	WHERE p.status = TRUE
+	  AND p.min_stock > 0
+	  AND ($2::bigint IS NULL OR p.supplier_id = $2)
+	ORDER BY s.name NULLS LAST, p.supplier_id, p.product_name, p.id;
+`
+
+func (r *stockAlertRepo) ReplenishmentCandidates(ctx context.Context, q *models.ReplenishmentQuery) ([]*models.ReplenishmentItem, error) {
+	rows, err := r.db.Query(ctx, replenishmentQuery, q.WindowDays, q.SupplierID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	items := make([]*models.ReplenishmentItem, 0)
+	for rows.Next() {
+		var i models.ReplenishmentItem
+		if err := rows.Scan(
+			&i.ProductID,
+			&i.ProductName,
+			&i.SupplierID,
+			&i.SupplierName,
+			&i.StockQuantity,
+			&i.MinStock,
+			&i.MaxStock,
+			&i.CostPrice,
+			&i.SoldQuantity,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		items = append(items, &i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,215 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_alert"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func containsAll(parts ...string) any {
+	return mock.MatchedBy(func(q string) bool {
+		for _, p := range parts {
+			if !strings.Contains(q, p) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func emptyRows() *mockDb.MockRows {
+	rows := new(mockDb.MockRows)
+	rows.On("Next").Return(false)
+	rows.On("Err").Return(nil)
+	rows.On("Close").Return()
+	return rows
+}
+
+func TestStockAlertRepo_Detect(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("abre alertas dos produtos informados", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockAlert(mockDB)
+
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{int64(1), int64(7), "Café", 2, 10, now}},
+		}}
+		mockDB.On("Query", ctx, containsAll("INSERT INTO stock_alerts", "SET resolved_at = NOW()"), []any{[]int64{7, 8}}).
+			Return(rows, nil)
+
+		alerts, err := repo.Detect(ctx, []int64{7, 8})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*models.StockAlert{{ID: 1, ProductID: 7, ProductName: "Café", StockQuantity: 2, MinStock: 10, CreatedAt: now}}, alerts)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("sem IDs verifica todo o catálogo", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockAlert(mockDB)
+
+		mockDB.On("Query", ctx, mock.Anything, []any{[]int64(nil)}).
+			Return(emptyRows(), nil)
+
+		alerts, err := repo.Detect(ctx, []int64{})
+
+		assert.NoError(t, err)
+		assert.Empty(t, alerts)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockAlert(mockDB)
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.Detect(ctx, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+
+	t.Run("erro no scan", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockAlert(mockDB)
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("bad row")}}}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
+
+		_, err := repo.Detect(ctx, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+}
+
+func TestStockAlertRepo_List(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	productID := int64(7)
+
+	t.Run("filtra alertas abertos do produto", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockAlert(mockDB)
+
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{int64(3), int64(7), "Café", 2, 10, now, nil}},
+		}}
+		mockDB.On("Query", ctx, containsAll("product_id = $1", "resolved_at IS NULL", "ORDER BY id desc LIMIT 50 OFFSET 0"), []any{int64(7)}).
+			Return(rows, nil)
+		mockDB.OnCount(1)
+
+		page, err := repo.List(ctx, &models.StockAlertFilter{ProductID: &productID, Status: models.StatusOpen})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), page.Total)
+		assert.Len(t, page.Items, 1)
+		assert.Equal(t, models.StatusOpen, page.Items[0].Status())
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("resolvidos", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockAlert(mockDB)
+
+		mockDB.On("Query", ctx, containsAll("resolved_at IS NOT NULL"), []any{}).
+			Return(emptyRows(), nil)
+		mockDB.OnCount(0)
+
+		page, err := repo.List(ctx, &models.StockAlertFilter{
+			BaseFilter: filter.BaseFilter{Limit: 10},
+			Status:     models.StatusResolved,
+		})
+
+		assert.NoError(t, err)
+		assert.Empty(t, page.Items)
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockAlert(mockDB)
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.List(ctx, &models.StockAlertFilter{})
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestStockAlertRepo_LowStock(t *testing.T) {
+	ctx := context.Background()
+	supplierID := int64(4)
+
+	t.Run("lista produtos abaixo do mínimo do fornecedor", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockAlert(mockDB)
+
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{int64(1), "Café", int64(4), "Alfa", 2, 10, nil}},
+		}}
+		mockDB.On("Query", ctx, containsAll("p.stock_quantity < p.min_stock"), []any{&supplierID}).Return(rows, nil)
+
+		items, err := repo.LowStock(ctx, &supplierID)
+
+		assert.NoError(t, err)
+		assert.Len(t, items, 1)
+		assert.Equal(t, "Alfa", items[0].SupplierName)
+		assert.Equal(t, 8, items[0].Shortage())
+	})
+
+	t.Run("erro na iteração", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockAlert(mockDB)
+		rows := new(mockDb.MockRows)
+		rows.On("Next").Return(false)
+		rows.On("Err").Return(errors.New("conn lost"))
+		rows.On("Close").Return()
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(rows, nil)
+
+		_, err := repo.LowStock(ctx, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrIterate)
+	})
+}
+
+func TestStockAlertRepo_ReplenishmentCandidates(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("lê vendas do período", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockAlert(mockDB)
+
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{int64(1), "Café", int64(4), "Alfa", 3, 10, nil, 12.5, 45}},
+		}}
+		q := &models.ReplenishmentQuery{WindowDays: 30, LeadDays: 7}
+		mockDB.On("Query", ctx, containsAll("sale_return_items", "make_interval(days => $1)"), []any{30, (*int64)(nil)}).
+			Return(rows, nil)
+
+		items, err := repo.ReplenishmentCandidates(ctx, q)
+
+		assert.NoError(t, err)
+		assert.Len(t, items, 1)
+		assert.Equal(t, 45, items[0].SoldQuantity)
+		assert.Equal(t, money.MustParse("12.50"), items[0].CostPrice)
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockAlert(mockDB)
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.ReplenishmentCandidates(ctx, &models.ReplenishmentQuery{WindowDays: 30})
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
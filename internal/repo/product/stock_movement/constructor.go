@@ -0,0 +1,14 @@
+package repo
+
+import (
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+)
+
+type stockMovementRepo struct {
+	db repo.DBExecutor
+}
+
+func NewStockMovement(db repo.DBExecutor) iface.StockMovementReader {
+	return &stockMovementRepo{db: db}
+}
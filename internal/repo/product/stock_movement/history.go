@@ -0,0 +1,138 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+	pagination "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/pagination"
+	"github.com/jackc/pgx/v5"
+)
+
+var allowedStockMovementSortFields = map[string]string{
+	"id":         "id",
+	"created_at": "created_at",
+	"delta":      "delta",
+}
+
+var stockMovementOrderBy = builder.OrderBy{
+	Fields:       allowedStockMovementSortFields,
+	DefaultField: "id",
+	DefaultOrder: "desc",
+}
+
+func (r *stockMovementRepo) History(ctx context.Context, f *models.StockMovementFilter) (*commonFilter.Page[*models.StockMovement], error) {
+	base := f.BaseFilter.WithDefaults()
+
+	query := `
+		SELECT
+			id,
+			product_id,
+			delta,
+			quantity,
+			reason,
+			COALESCE(ref_type, ''),
+			ref_id,
+			user_id,
+			created_at
+		FROM stock_movements
+	`
+
+	b := builder.NewQueryBuilderSql(query)
+
+	b.Eq("product_id", f.ProductID)
+	b.Eq("reason", f.Reason)
+	b.Range("created_at", f.CreatedFrom, f.CreatedTo)
+
+	sortField, sortOrder := stockMovementOrderBy.Resolve(f.SortBy, f.SortOrder)
+
+	args := b.GetArgs()
+	tail, pageArgs, err := pagination.Clause(base, sortField, sortOrder, b.NextArgPos())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, b.GetQuery()+tail, append(args, pageArgs...)...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	movements := make([]*models.StockMovement, 0)
+	for rows.Next() {
+		var m models.StockMovement
+		if err := rows.Scan(
+			&m.ID,
+			&m.ProductID,
+			&m.Delta,
+			&m.Quantity,
+			&m.Reason,
+			&m.RefType,
+			&m.RefID,
+			&m.UserID,
+			&m.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		movements = append(movements, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	total, err := pagination.Count(ctx, r.db, "stock_movements", b.Where(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonFilter.NewPage(movements, total, base, func(m *models.StockMovement) (any, int64) {
+		return stockMovementSortValue(m, sortField), m.ID
+	}), nil
+}
+
+// StockAt devolve o saldo resultante do último movimento até at. Produto sem
+// movimento no período tem saldo zero; produto inexistente é ErrNotFound.
+func (r *stockMovementRepo) StockAt(ctx context.Context, productID int64, at time.Time) (*models.StockAt, error) {
+	const query = `
+		SELECT COALESCE(m.quantity, 0), m.created_at
+		FROM products p
+		LEFT JOIN LATERAL (
+			SELECT quantity, created_at
+			FROM stock_movements
+			WHERE product_id = p.id
+			  AND created_at <= $2
+			ORDER BY created_at DESC, id DESC
+			LIMIT 1
+		) m ON TRUE
+		WHERE p.id = $1;
+	`
+
+	result := models.StockAt{ProductID: productID, At: at}
+	err := r.db.QueryRow(ctx, query, productID, at).Scan(&result.Quantity, &result.LastMovementAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return &result, nil
+}
+
+// stockMovementSortValue devolve o valor da coluna de ordenação field no movimento, gravado
+// no cursor da próxima página.
+func stockMovementSortValue(m *models.StockMovement, field string) any {
+	switch field {
+	case "created_at":
+		return m.CreatedAt
+	case "delta":
+		return m.Delta
+	}
+	return m.ID
+}
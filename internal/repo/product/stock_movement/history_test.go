@@ -0,0 +1,130 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func containsAll(parts ...string) any {
+	return mock.MatchedBy(func(q string) bool {
+		for _, p := range parts {
+			if !strings.Contains(q, p) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func emptyRows() *mockDb.MockRows {
+	rows := new(mockDb.MockRows)
+	rows.On("Next").Return(false)
+	rows.On("Err").Return(nil)
+	rows.On("Close").Return()
+	return rows
+}
+
+func TestStockMovementRepo_History(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("lista os movimentos do produto", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockMovement(mockDB)
+
+		rows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []any{int64(3), int64(7), -2, 8, "sale", "sale", int64(40), int64(5), now}},
+		}}
+		mockDB.On("Query", ctx, containsAll("FROM stock_movements", "product_id = $1", "reason = $2", "ORDER BY id desc LIMIT 50 OFFSET 0"), []any{int64(7), "sale"}).
+			Return(rows, nil)
+		mockDB.OnCount(1)
+
+		page, err := repo.History(ctx, &models.StockMovementFilter{ProductID: 7, Reason: models.ReasonSale})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), page.Total)
+		assert.Len(t, page.Items, 1)
+		m := page.Items[0]
+		assert.Equal(t, -2, m.Delta)
+		assert.Equal(t, 8, m.Quantity)
+		assert.Equal(t, int64(40), *m.RefID)
+		assert.Equal(t, int64(5), *m.UserID)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("filtra por período", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockMovement(mockDB)
+		from := now.Add(-24 * time.Hour)
+
+		mockDB.On("Query", ctx, containsAll("created_at >= $2", "created_at <= $3"), []any{int64(7), from, now}).
+			Return(emptyRows(), nil)
+		mockDB.OnCount(0)
+
+		page, err := repo.History(ctx, &models.StockMovementFilter{ProductID: 7, CreatedFrom: &from, CreatedTo: &now})
+
+		assert.NoError(t, err)
+		assert.Empty(t, page.Items)
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockMovement(mockDB)
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := repo.History(ctx, &models.StockMovementFilter{ProductID: 7})
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestStockMovementRepo_StockAt(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 3, 1, 23, 59, 59, 0, time.UTC)
+
+	t.Run("saldo do último movimento até a data", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockMovement(mockDB)
+		last := at.Add(-time.Hour)
+
+		mockDB.On("QueryRow", ctx, containsAll("LEFT JOIN LATERAL", "created_at <= $2"), []any{int64(7), at}).
+			Return(&mockDb.MockRow{Values: []any{12, last}})
+
+		result, err := repo.StockAt(ctx, 7, at)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 12, result.Quantity)
+		assert.Equal(t, last, *result.LastMovementAt)
+		assert.Equal(t, at, result.At)
+	})
+
+	t.Run("produto inexistente", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockMovement(mockDB)
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		_, err := repo.StockAt(ctx, 7, at)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := NewStockMovement(mockDB)
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.StockAt(ctx, 7, at)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
@@ -0,0 +1,11 @@
+package repo
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+
+type purchaseOrderFilterRepo struct {
+	db repo.DBExecutor
+}
+
+func NewFilterPurchaseOrder(db repo.DBExecutor) PurchaseOrderFilter {
+	return &purchaseOrderFilterRepo{db: db}
+}
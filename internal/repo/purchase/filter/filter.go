@@ -0,0 +1,128 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/filter"
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+	pagination "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/pagination"
+)
+
+var allowedPurchaseOrderSortFields = map[string]string{
+	"id":           "id",
+	"supplier_id":  "supplier_id",
+	"status":       "status",
+	"expected_at":  "expected_at",
+	"total_amount": "total_amount",
+	"created_at":   "created_at",
+	"updated_at":   "updated_at",
+}
+
+var purchaseOrderOrderBy = builder.OrderBy{
+	Fields:       allowedPurchaseOrderSortFields,
+	DefaultField: "id",
+	DefaultOrder: "desc",
+}
+
+// Filter lista apenas os cabeçalhos; as linhas vêm em GetByID.
+func (r *purchaseOrderFilterRepo) Filter(ctx context.Context, filter *filter.PurchaseOrderFilter) (*commonFilter.Page[*model.PurchaseOrder], error) {
+
+	base := filter.BaseFilter.WithDefaults()
+
+	query := `
+		SELECT
+			id,
+			supplier_id,
+			user_id,
+			status,
+			COALESCE(notes, ''),
+			expected_at,
+			total_amount,
+			version,
+			created_at,
+			updated_at
+		FROM purchase_orders
+	`
+
+	b := builder.NewQueryBuilderSql(query)
+
+	b.Eq("supplier_id", filter.SupplierID)
+	b.Eq("user_id", filter.UserID)
+	b.InSubquery("id", "purchase_order_items", "purchase_order_id", "product_id", filter.ProductID)
+	b.Eq("status", filter.Status)
+	b.Range("expected_at", filter.ExpectedFrom, filter.ExpectedTo)
+	b.Range("created_at", filter.CreatedFrom, filter.CreatedTo)
+	b.Search(base.SearchTerm, "notes", "status")
+
+	sortField, sortOrder := purchaseOrderOrderBy.Resolve(filter.SortBy, filter.SortOrder)
+
+	args := b.GetArgs()
+	tail, pageArgs, err := pagination.Clause(base, sortField, sortOrder, b.NextArgPos())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, b.GetQuery()+tail, append(args, pageArgs...)...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	orders := make([]*model.PurchaseOrder, 0)
+
+	for rows.Next() {
+		var o model.PurchaseOrder
+		if err := rows.Scan(
+			&o.ID,
+			&o.SupplierID,
+			&o.UserID,
+			&o.Status,
+			&o.Notes,
+			&o.ExpectedAt,
+			&o.TotalAmount,
+			&o.Version,
+			&o.CreatedAt,
+			&o.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		orders = append(orders, &o)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	total, err := pagination.Count(ctx, r.db, "purchase_orders", b.Where(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonFilter.NewPage(orders, total, base, func(m *model.PurchaseOrder) (any, int64) {
+		return purchaseOrderSortValue(m, sortField), m.ID
+	}), nil
+}
+
+// purchaseOrderSortValue devolve o valor da coluna de ordenação field no pedido de compra, gravado
+// no cursor da próxima página.
+func purchaseOrderSortValue(m *model.PurchaseOrder, field string) any {
+	switch field {
+	case "supplier_id":
+		return m.SupplierID
+	case "status":
+		return m.Status
+	case "expected_at":
+		return m.ExpectedAt
+	case "total_amount":
+		return m.TotalAmount
+	case "created_at":
+		return m.CreatedAt
+	case "updated_at":
+		return m.UpdatedAt
+	}
+	return m.ID
+}
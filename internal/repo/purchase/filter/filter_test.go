@@ -0,0 +1,156 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	filterPurchase "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPurchaseOrder_Filter(t *testing.T) {
+	t.Run("successfully filter by supplier and status", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &purchaseOrderFilterRepo{db: mockDB}
+		ctx := context.Background()
+		now := time.Now()
+		supplierID := int64(4)
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []interface{}{int64(1), int64(4), nil, "sent", "", nil, 150.0, 2, now, now}},
+		}}
+		mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "supplier_id = $1") &&
+				strings.Contains(q, "status = $2") &&
+				strings.Contains(q, "ORDER BY id desc LIMIT 50 OFFSET 0")
+		}), []interface{}{supplierID, "sent"}).Return(mockRows, nil)
+
+		mockDB.OnCount(1)
+		result, err := repo.Filter(ctx, &filterPurchase.PurchaseOrderFilter{SupplierID: &supplierID, Status: "sent"})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Items, 1)
+		assert.Equal(t, int64(4), result.Items[0].SupplierID)
+		assert.Equal(t, "sent", result.Items[0].Status)
+		assert.Nil(t, result.Items[0].UserID)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("apply every filter and explicit sort", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &purchaseOrderFilterRepo{db: mockDB}
+		ctx := context.Background()
+		userID := int64(2)
+		productID := int64(9)
+		from := time.Now().Add(-time.Hour)
+		to := time.Now()
+
+		mockRows := new(mockDb.MockRows)
+		mockRows.On("Next").Return(false)
+		mockRows.On("Close").Return()
+		mockRows.On("Err").Return(nil)
+		mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "user_id = $1") &&
+				strings.Contains(q, "id IN (SELECT purchase_order_id FROM purchase_order_items WHERE product_id = $2)") &&
+				strings.Contains(q, "expected_at >= $3") &&
+				strings.Contains(q, "expected_at <= $4") &&
+				strings.Contains(q, "created_at >= $5") &&
+				strings.Contains(q, "created_at <= $6") &&
+				strings.Contains(q, "ORDER BY expected_at asc, id asc LIMIT 10 OFFSET 20")
+		}), []interface{}{userID, productID, from, to, from, to}).Return(mockRows, nil)
+
+		mockDB.OnCount(0)
+		result, err := repo.Filter(ctx, &filterPurchase.PurchaseOrderFilter{
+			BaseFilter:   filter.BaseFilter{Limit: 10, Offset: 20, SortBy: "expected_at", SortOrder: "asc"},
+			UserID:       &userID,
+			ProductID:    &productID,
+			ExpectedFrom: &from,
+			ExpectedTo:   &to,
+			CreatedFrom:  &from,
+			CreatedTo:    &to,
+		})
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Items)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return ErrGet when query fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &purchaseOrderFilterRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{}).Return(nil, errors.New("db down"))
+
+		result, err := repo.Filter(ctx, &filterPurchase.PurchaseOrderFilter{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrScan when scan fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &purchaseOrderFilterRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan")}}}
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{}).Return(mockRows, nil)
+
+		result, err := repo.Filter(ctx, &filterPurchase.PurchaseOrderFilter{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+
+	t.Run("return ErrIterate when rows fail", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &purchaseOrderFilterRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := new(mockDb.MockRows)
+		mockRows.On("Next").Return(false)
+		mockRows.On("Close").Return()
+		mockRows.On("Err").Return(errors.New("iter"))
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{}).Return(mockRows, nil)
+
+		result, err := repo.Filter(ctx, &filterPurchase.PurchaseOrderFilter{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrIterate)
+	})
+}
+
+func TestPurchaseOrder_Filter_SearchTerm(t *testing.T) {
+	mockDB := new(mockDb.MockDatabase)
+	repo := &purchaseOrderFilterRepo{db: mockDB}
+	ctx := context.Background()
+
+	mockRows := new(mockDb.MockRows)
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Err").Return(nil)
+	mockRows.On("Close").Return()
+
+	where := " WHERE 1=1 AND (notes ILIKE $1 OR status ILIKE $1)"
+	args := []interface{}{`%50\%\_acme%`}
+
+	mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+		return strings.Contains(q, where+" ORDER BY")
+	}), args).Return(mockRows, nil)
+	mockDB.On("QueryRow", ctx, "SELECT COUNT(*) FROM purchase_orders"+where, args).
+		Return(&mockDb.MockRow{Values: []interface{}{int64(0)}})
+
+	result, err := repo.Filter(ctx, &filterPurchase.PurchaseOrderFilter{
+		BaseFilter: filter.BaseFilter{Limit: 10, SearchTerm: " 50%_acme "},
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Items)
+	mockDB.AssertExpectations(t)
+}
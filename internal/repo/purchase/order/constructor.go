@@ -0,0 +1,13 @@
+package repo
+
+import (
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+)
+
+type purchaseOrderRepo struct {
+	db repo.DBExecutor
+}
+
+func NewPurchaseOrder(db repo.DBExecutor) PurchaseOrder {
+	return &purchaseOrderRepo{db: db}
+}
@@ -0,0 +1,7 @@
+package repo
+
+import iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/purchase"
+
+type PurchaseOrder interface {
+	iface.PurchaseOrderReader
+}
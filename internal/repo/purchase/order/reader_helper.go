@@ -0,0 +1,81 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+const orderColumns = `
+	id,
+	supplier_id,
+	user_id,
+	status,
+	COALESCE(notes, ''),
+	expected_at,
+	total_amount,
+	version,
+	created_at,
+	updated_at
+`
+
+// querier é atendido tanto pelo pool quanto por pgx.Tx.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func scanOrderRow(row pgx.Row, o *models.PurchaseOrder) error {
+	return row.Scan(
+		&o.ID,
+		&o.SupplierID,
+		&o.UserID,
+		&o.Status,
+		&o.Notes,
+		&o.ExpectedAt,
+		&o.TotalAmount,
+		&o.Version,
+		&o.CreatedAt,
+		&o.UpdatedAt,
+	)
+}
+
+// loadItems preenche order.Items com as linhas do pedido, em ordem de inserção.
+func loadItems(ctx context.Context, q querier, order *models.PurchaseOrder) error {
+	const query = `
+		SELECT id, product_id, quantity, received_quantity, unit_cost, created_at
+		FROM purchase_order_items
+		WHERE purchase_order_id = $1
+		ORDER BY id ASC;
+	`
+
+	rows, err := q.Query(ctx, query, order.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	order.Items = make([]models.PurchaseOrderItem, 0)
+	for rows.Next() {
+		item := models.PurchaseOrderItem{PurchaseOrderID: order.ID}
+		if err := rows.Scan(
+			&item.ID,
+			&item.ProductID,
+			&item.Quantity,
+			&item.ReceivedQuantity,
+			&item.UnitCost,
+			&item.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		order.Items = append(order.Items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return nil
+}
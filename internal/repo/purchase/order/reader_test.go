@@ -0,0 +1,130 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewPurchaseOrder(t *testing.T) {
+	result := NewPurchaseOrder(nil)
+
+	assert.NotNil(t, result)
+	_, ok := result.(*purchaseOrderRepo)
+	assert.True(t, ok, "Expected result to be of type *purchaseOrderRepo")
+}
+
+func TestPurchaseOrder_GetByID(t *testing.T) {
+	t.Run("successfully get order with items", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &purchaseOrderRepo{db: mockDB}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(1), int64(4), int64(2), "sent", "urgente", now, 35.0, 3, now, now}})
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []interface{}{int64(10), int64(20), 2, 0, 10.0, now}},
+			{Values: []interface{}{int64(11), int64(21), 1, 1, 15.0, now}},
+		}}
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).Return(mockRows, nil)
+
+		result, err := repo.GetByID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), result.SupplierID)
+		assert.Equal(t, int64(2), *result.UserID)
+		assert.Equal(t, "urgente", result.Notes)
+		assert.Equal(t, 3, result.Version)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, int64(1), result.Items[1].PurchaseOrderID)
+		assert.Equal(t, 1, result.Items[1].ReceivedQuantity)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when order does not exist", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &purchaseOrderRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		result, err := repo.GetByID(ctx, 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrGet when query fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &purchaseOrderRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		result, err := repo.GetByID(ctx, 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrGet when items query fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &purchaseOrderRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(1), int64(4)}})
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).Return(nil, errors.New("db down"))
+
+		result, err := repo.GetByID(ctx, 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrScan when item scan fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &purchaseOrderRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(1), int64(4)}})
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan")}}}
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).Return(mockRows, nil)
+
+		result, err := repo.GetByID(ctx, 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+
+	t.Run("return ErrIterate when items iteration fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &purchaseOrderRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(1), int64(4)}})
+		mockRows := new(mockDb.MockRows)
+		mockRows.On("Next").Return(false)
+		mockRows.On("Close").Return()
+		mockRows.On("Err").Return(errors.New("iter"))
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).Return(mockRows, nil)
+
+		result, err := repo.GetByID(ctx, 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrIterate)
+	})
+}
@@ -0,0 +1,213 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/purchase"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+type purchaseOrderTxRepo struct {
+	db repo.DBTransactor
+}
+
+func NewPurchaseOrderTx(db repo.DBTransactor) iface.PurchaseOrderTx {
+	return &purchaseOrderTxRepo{db: db}
+}
+
+func (r *purchaseOrderTxRepo) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return r.db.BeginTx(ctx, pgx.TxOptions{})
+}
+
+func (r *purchaseOrderTxRepo) CreateTx(ctx context.Context, tx pgx.Tx, order *models.PurchaseOrder) (*models.PurchaseOrder, error) {
+	const query = `
+		INSERT INTO purchase_orders (
+			supplier_id,
+			user_id,
+			status,
+			notes,
+			expected_at,
+			total_amount,
+			created_at,
+			updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		RETURNING id, version, created_at, updated_at;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		order.SupplierID,
+		order.UserID,
+		order.Status,
+		order.Notes,
+		order.ExpectedAt,
+		order.TotalAmount,
+	).Scan(&order.ID, &order.Version, &order.CreatedAt, &order.UpdatedAt)
+	if err != nil {
+		if errMsgPg.IsForeignKeyViolation(err) {
+			return nil, errMsg.ErrDBInvalidForeignKey
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	if err := insertItemsTx(ctx, tx, order); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func insertItemsTx(ctx context.Context, tx pgx.Tx, order *models.PurchaseOrder) error {
+	const query = `
+		INSERT INTO purchase_order_items (purchase_order_id, product_id, quantity, unit_cost, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		RETURNING id, created_at;
+	`
+
+	for i := range order.Items {
+		item := &order.Items[i]
+		item.PurchaseOrderID = order.ID
+
+		err := tx.QueryRow(ctx, query,
+			item.PurchaseOrderID,
+			item.ProductID,
+			item.Quantity,
+			item.UnitCost,
+		).Scan(&item.ID, &item.CreatedAt)
+		if err != nil {
+			if errMsgPg.IsForeignKeyViolation(err) {
+				return errMsg.ErrDBInvalidForeignKey
+			}
+			if ok, constraint := errMsgPg.IsUniqueViolation(err); ok {
+				return fmt.Errorf("%w: %s", errMsg.ErrDuplicate, constraint)
+			}
+			return fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+		}
+	}
+
+	return nil
+}
+
+// GetByIDForUpdateTx bloqueia o pedido até o fim da transação e carrega as linhas.
+func (r *purchaseOrderTxRepo) GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.PurchaseOrder, error) {
+	query := `SELECT ` + orderColumns + ` FROM purchase_orders WHERE id = $1 FOR UPDATE;`
+
+	var order models.PurchaseOrder
+	if err := scanOrderRow(tx.QueryRow(ctx, query, id), &order); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	if err := loadItems(ctx, tx, &order); err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+// UpdateTx grava o cabeçalho e substitui todas as linhas do pedido.
+func (r *purchaseOrderTxRepo) UpdateTx(ctx context.Context, tx pgx.Tx, order *models.PurchaseOrder) error {
+	const query = `
+		UPDATE purchase_orders
+		SET supplier_id  = $2,
+		    notes        = $3,
+		    expected_at  = $4,
+		    total_amount = $5,
+		    version      = version + 1,
+		    updated_at   = NOW()
+		WHERE id = $1
+		RETURNING version, updated_at;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		order.ID,
+		order.SupplierID,
+		order.Notes,
+		order.ExpectedAt,
+		order.TotalAmount,
+	).Scan(&order.Version, &order.UpdatedAt)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return errMsg.ErrNotFound
+		case errMsgPg.IsForeignKeyViolation(err):
+			return errMsg.ErrDBInvalidForeignKey
+		default:
+			return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+		}
+	}
+
+	const deleteItems = `DELETE FROM purchase_order_items WHERE purchase_order_id = $1;`
+	if _, err := tx.Exec(ctx, deleteItems, order.ID); err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	return insertItemsTx(ctx, tx, order)
+}
+
+func (r *purchaseOrderTxRepo) UpdateStatusTx(ctx context.Context, tx pgx.Tx, order *models.PurchaseOrder) error {
+	const query = `
+		UPDATE purchase_orders
+		SET status     = $2,
+		    version    = version + 1,
+		    updated_at = NOW()
+		WHERE id = $1
+		RETURNING version, updated_at;
+	`
+
+	err := tx.QueryRow(ctx, query, order.ID, order.Status).Scan(&order.Version, &order.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errMsg.ErrNotFound
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	return nil
+}
+
+// ReceiveItemTx acumula quantity no recebido da linha, sem ultrapassar o pedido.
+func (r *purchaseOrderTxRepo) ReceiveItemTx(ctx context.Context, tx pgx.Tx, itemID int64, quantity int) error {
+	if quantity <= 0 {
+		return errMsg.ErrInvalidQuantity
+	}
+
+	const query = `
+		UPDATE purchase_order_items
+		SET received_quantity = received_quantity + $2
+		WHERE id = $1
+		  AND received_quantity + $2 <= quantity;
+	`
+
+	tag, err := tx.Exec(ctx, query, itemID, quantity)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: item %d não existe ou a quantidade excede o pedido", errMsg.ErrInvalidData, itemID)
+	}
+
+	return nil
+}
+
+func (r *purchaseOrderTxRepo) DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	const query = `DELETE FROM purchase_orders WHERE id = $1;`
+
+	tag, err := tx.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrDelete, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errMsg.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,409 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewPurchaseOrderTx(t *testing.T) {
+	result := NewPurchaseOrderTx(nil)
+
+	assert.NotNil(t, result)
+	_, ok := result.(*purchaseOrderTxRepo)
+	assert.True(t, ok, "Expected result to be of type *purchaseOrderTxRepo")
+}
+
+func TestPurchaseOrderTx_BeginTx(t *testing.T) {
+	mockDB := new(mockDb.MockDBTransactor)
+	repo := &purchaseOrderTxRepo{db: mockDB}
+	ctx := context.Background()
+
+	mockTx := new(mockDb.MockTx)
+	mockDB.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+
+	tx, err := repo.BeginTx(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, mockTx, tx)
+	mockDB.AssertExpectations(t)
+}
+
+func TestPurchaseOrderTx_CreateTx(t *testing.T) {
+	newOrder := func() *models.PurchaseOrder {
+		return &models.PurchaseOrder{
+			SupplierID:  4,
+			Status:      models.StatusDraft,
+			TotalAmount: money.New(20),
+			Items:       []models.PurchaseOrderItem{{ProductID: 7, Quantity: 2, UnitCost: money.New(10)}},
+		}
+	}
+
+	orderArgs := func(o *models.PurchaseOrder) []interface{} {
+		return []interface{}{o.SupplierID, o.UserID, o.Status, o.Notes, o.ExpectedAt, o.TotalAmount}
+	}
+
+	t.Run("successfully create order with items", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(1), 1, now, now}}).Once()
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), int64(7), 2, money.New(10)}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(30), now}}).Once()
+
+		result, err := repo.CreateTx(ctx, mockTx, order)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), result.ID)
+		assert.Equal(t, int64(30), result.Items[0].ID)
+		assert.Equal(t, int64(1), result.Items[0].PurchaseOrderID)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrDBInvalidForeignKey when supplier does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}})
+
+		result, err := repo.CreateTx(ctx, mockTx, order)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("return ErrCreate on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).
+			Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		result, err := repo.CreateTx(ctx, mockTx, order)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+
+	t.Run("return ErrDBInvalidForeignKey when product does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(1), 1, now, now}}).Once()
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), int64(7), 2, money.New(10)}).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}}).Once()
+
+		result, err := repo.CreateTx(ctx, mockTx, order)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("return ErrDuplicate when product is repeated", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(1), 1, now, now}}).Once()
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), int64(7), 2, money.New(10)}).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23505", ConstraintName: "uq_purchase_order_product"}}).Once()
+
+		result, err := repo.CreateTx(ctx, mockTx, order)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrDuplicate)
+	})
+}
+
+func TestPurchaseOrderTx_GetByIDForUpdateTx(t *testing.T) {
+	t.Run("successfully lock order and load items", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(1), int64(4), int64(2), "sent", "", now, 20.0, 2, now, now}})
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []interface{}{int64(10), int64(7), 2, 0, 10.0, now}},
+		}}
+		mockTx.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).Return(mockRows, nil)
+
+		result, err := repo.GetByIDForUpdateTx(ctx, mockTx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusSent, result.Status)
+		assert.Len(t, result.Items, 1)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when order does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		result, err := repo.GetByIDForUpdateTx(ctx, mockTx, 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrGet on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		result, err := repo.GetByIDForUpdateTx(ctx, mockTx, 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestPurchaseOrderTx_UpdateTx(t *testing.T) {
+	newOrder := func() *models.PurchaseOrder {
+		return &models.PurchaseOrder{
+			ID:          1,
+			SupplierID:  4,
+			TotalAmount: money.New(20),
+			Items:       []models.PurchaseOrderItem{{ProductID: 7, Quantity: 2, UnitCost: money.New(10)}},
+		}
+	}
+
+	orderArgs := func(o *models.PurchaseOrder) []interface{} {
+		return []interface{}{o.ID, o.SupplierID, o.Notes, o.ExpectedAt, o.TotalAmount}
+	}
+
+	t.Run("successfully update header and replace items", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).
+			Return(&mockDb.MockRow{Values: []interface{}{2, now}}).Once()
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(pgconn.NewCommandTag("DELETE 1"), nil).Once()
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), int64(7), 2, money.New(10)}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(31), now}}).Once()
+
+		err := repo.UpdateTx(ctx, mockTx, order)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, order.Version)
+		assert.Equal(t, int64(31), order.Items[0].ID)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when order does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.UpdateTx(ctx, mockTx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrDBInvalidForeignKey when supplier does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}})
+
+		err := repo.UpdateTx(ctx, mockTx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("return ErrUpdate when deleting items fails", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).
+			Return(&mockDb.MockRow{Values: []interface{}{2, time.Now()}}).Once()
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(pgconn.CommandTag{}, errors.New("db down")).Once()
+
+		err := repo.UpdateTx(ctx, mockTx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
+
+func TestPurchaseOrderTx_UpdateStatusTx(t *testing.T) {
+	t.Run("successfully update status", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+		order := &models.PurchaseOrder{ID: 1, Status: models.StatusSent}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), models.StatusSent}).
+			Return(&mockDb.MockRow{Values: []interface{}{3, time.Now()}})
+
+		err := repo.UpdateStatusTx(ctx, mockTx, order)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, order.Version)
+	})
+
+	t.Run("return ErrNotFound when order does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+		order := &models.PurchaseOrder{ID: 1, Status: models.StatusSent}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), models.StatusSent}).
+			Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.UpdateStatusTx(ctx, mockTx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrUpdate on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+		order := &models.PurchaseOrder{ID: 1, Status: models.StatusSent}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), models.StatusSent}).
+			Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		err := repo.UpdateStatusTx(ctx, mockTx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
+
+func TestPurchaseOrderTx_ReceiveItemTx(t *testing.T) {
+	t.Run("return ErrInvalidQuantity when quantity is not positive", func(t *testing.T) {
+		repo := &purchaseOrderTxRepo{}
+
+		err := repo.ReceiveItemTx(context.Background(), new(mockDb.MockTx), 10, 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidQuantity)
+	})
+
+	t.Run("successfully receive item", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(10), 2}).
+			Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+
+		err := repo.ReceiveItemTx(ctx, mockTx, 10, 2)
+
+		assert.NoError(t, err)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrInvalidData when quantity exceeds the order", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(10), 5}).
+			Return(pgconn.NewCommandTag("UPDATE 0"), nil)
+
+		err := repo.ReceiveItemTx(ctx, mockTx, 10, 5)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("return ErrUpdate on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(10), 2}).
+			Return(pgconn.CommandTag{}, errors.New("db down"))
+
+		err := repo.ReceiveItemTx(ctx, mockTx, 10, 2)
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
+
+func TestPurchaseOrderTx_DeleteTx(t *testing.T) {
+	t.Run("successfully delete order", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(pgconn.NewCommandTag("DELETE 1"), nil)
+
+		err := repo.DeleteTx(ctx, mockTx, 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("return ErrNotFound when order does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(pgconn.NewCommandTag("DELETE 0"), nil)
+
+		err := repo.DeleteTx(ctx, mockTx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrDelete on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseOrderTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(pgconn.CommandTag{}, errors.New("db down"))
+
+		err := repo.DeleteTx(ctx, mockTx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrDelete)
+	})
+}
@@ -0,0 +1,14 @@
+package repo
+
+import (
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/purchase"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+)
+
+type purchaseReceiptRepo struct {
+	db repo.DBExecutor
+}
+
+func NewPurchaseReceipt(db repo.DBExecutor) iface.PurchaseReceiptReader {
+	return &purchaseReceiptRepo{db: db}
+}
@@ -0,0 +1,70 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/receipt"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+func (r *purchaseReceiptRepo) GetByOrderID(ctx context.Context, orderID int64) ([]*models.PurchaseReceipt, error) {
+	const query = `
+		SELECT
+			r.id, r.purchase_order_id, r.user_id, COALESCE(r.notes, ''), r.cost_method, r.location_id, r.created_at,
+			ri.id, ri.purchase_order_item_id, ri.product_id, ri.quantity, ri.unit_cost, ri.created_at
+		FROM purchase_receipts r
+		JOIN purchase_receipt_items ri ON ri.purchase_receipt_id = r.id
+		WHERE r.purchase_order_id = $1
+		ORDER BY r.id ASC, ri.id ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	var result []*models.PurchaseReceipt
+	var current *models.PurchaseReceipt
+
+	for rows.Next() {
+		var receipt models.PurchaseReceipt
+		var item models.PurchaseReceiptItem
+		var unitCost money.Money
+
+		if err := rows.Scan(
+			&receipt.ID,
+			&receipt.PurchaseOrderID,
+			&receipt.UserID,
+			&receipt.Notes,
+			&receipt.CostMethod,
+			&receipt.LocationID,
+			&receipt.CreatedAt,
+			&item.ID,
+			&item.PurchaseOrderItemID,
+			&item.ProductID,
+			&item.Quantity,
+			&unitCost,
+			&item.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+		}
+
+		if current == nil || current.ID != receipt.ID {
+			current = &receipt
+			result = append(result, current)
+		}
+
+		item.UnitCost = &unitCost
+		item.PurchaseReceiptID = current.ID
+		current.Items = append(current.Items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,94 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewPurchaseReceipt(t *testing.T) {
+	result := NewPurchaseReceipt(nil)
+
+	assert.NotNil(t, result)
+	_, ok := result.(*purchaseReceiptRepo)
+	assert.True(t, ok, "Expected result to be of type *purchaseReceiptRepo")
+}
+
+func TestPurchaseReceipt_GetByOrderID(t *testing.T) {
+	t.Run("successfully group items by receipt", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &purchaseReceiptRepo{db: mockDB}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockRows := &mockDb.MockRows{
+			Rows: []*mockDb.MockRow{
+				{Values: []any{int64(1), int64(10), nil, "nota 123", "latest", int64(1), now, int64(100), int64(5), int64(20), 1, 10.0, now}},
+				{Values: []any{int64(1), int64(10), nil, "nota 123", "latest", int64(1), now, int64(101), int64(6), int64(21), 2, 20.0, now}},
+				{Values: []any{int64(2), int64(10), nil, "", "average", int64(1), now, int64(102), int64(5), int64(20), 1, 12.5, now}},
+			},
+		}
+		mockDB.On("Query", ctx, mock.Anything, []any{int64(10)}).Return(mockRows, nil)
+
+		result, err := repo.GetByOrderID(ctx, 10)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Len(t, result[0].Items, 2)
+		assert.Len(t, result[1].Items, 1)
+		assert.Equal(t, int64(1), result[0].Items[1].PurchaseReceiptID)
+		assert.Equal(t, money.New(20), *result[0].Items[1].UnitCost)
+		assert.Equal(t, "average", result[1].CostMethod)
+	})
+
+	t.Run("return empty when order has no receipts", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &purchaseReceiptRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := new(mockDb.MockRows)
+		mockRows.On("Next").Return(false)
+		mockRows.On("Close").Return()
+		mockRows.On("Err").Return(nil)
+		mockDB.On("Query", ctx, mock.Anything, []any{int64(10)}).Return(mockRows, nil)
+
+		result, err := repo.GetByOrderID(ctx, 10)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("return ErrGet when query fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &purchaseReceiptRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("Query", ctx, mock.Anything, []any{int64(10)}).Return(nil, errors.New("db down"))
+
+		result, err := repo.GetByOrderID(ctx, 10)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrGet when scan fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &purchaseReceiptRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan")}}}
+		mockDB.On("Query", ctx, mock.Anything, []any{int64(10)}).Return(mockRows, nil)
+
+		result, err := repo.GetByOrderID(ctx, 10)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
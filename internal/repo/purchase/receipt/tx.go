@@ -0,0 +1,73 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/purchase"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/receipt"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+type purchaseReceiptTx struct{}
+
+func NewPurchaseReceiptTx() iface.PurchaseReceiptTx {
+	return &purchaseReceiptTx{}
+}
+
+// CreateTx grava o recebimento e suas linhas. O custo de cada linha já deve
+// estar resolvido pelo serviço.
+func (r *purchaseReceiptTx) CreateTx(ctx context.Context, tx pgx.Tx, receipt *models.PurchaseReceipt) (*models.PurchaseReceipt, error) {
+	const queryReceipt = `
+		INSERT INTO purchase_receipts (purchase_order_id, user_id, notes, cost_method, location_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING id, created_at;
+	`
+
+	const queryItem = `
+		INSERT INTO purchase_receipt_items (purchase_receipt_id, purchase_order_item_id, product_id, quantity, unit_cost, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING id, created_at;
+	`
+
+	err := tx.QueryRow(ctx, queryReceipt,
+		receipt.PurchaseOrderID,
+		receipt.UserID,
+		receipt.Notes,
+		receipt.CostMethod,
+		receipt.LocationID,
+	).Scan(&receipt.ID, &receipt.CreatedAt)
+	if err != nil {
+		if errMsgPg.IsForeignKeyViolation(err) {
+			return nil, errMsg.ErrDBInvalidForeignKey
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	for i := range receipt.Items {
+		item := &receipt.Items[i]
+		item.PurchaseReceiptID = receipt.ID
+
+		if item.UnitCost == nil {
+			return nil, fmt.Errorf("%w: custo da linha %d não informado", errMsg.ErrInvalidData, item.PurchaseOrderItemID)
+		}
+
+		err := tx.QueryRow(ctx, queryItem,
+			item.PurchaseReceiptID,
+			item.PurchaseOrderItemID,
+			item.ProductID,
+			item.Quantity,
+			*item.UnitCost,
+		).Scan(&item.ID, &item.CreatedAt)
+		if err != nil {
+			if errMsgPg.IsForeignKeyViolation(err) {
+				return nil, errMsg.ErrDBInvalidForeignKey
+			}
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+		}
+	}
+
+	return receipt, nil
+}
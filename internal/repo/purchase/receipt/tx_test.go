@@ -0,0 +1,117 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/receipt"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPurchaseReceiptTx_CreateTx(t *testing.T) {
+	newReceipt := func() *models.PurchaseReceipt {
+		cost := money.New(10)
+		return &models.PurchaseReceipt{
+			PurchaseOrderID: 1,
+			LocationID:      2,
+			Notes:           "nota 123",
+			CostMethod:      models.CostLatest,
+			Items:           []models.PurchaseReceiptItem{{PurchaseOrderItemID: 30, ProductID: 7, Quantity: 2, UnitCost: &cost}},
+		}
+	}
+
+	receiptArgs := func(r *models.PurchaseReceipt) []interface{} {
+		return []interface{}{r.PurchaseOrderID, r.UserID, r.Notes, r.CostMethod, r.LocationID}
+	}
+
+	t.Run("successfully create receipt with items", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := NewPurchaseReceiptTx()
+		ctx := context.Background()
+		receipt := newReceipt()
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, receiptArgs(receipt)).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(5), now}}).Once()
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(5), int64(30), int64(7), 2, money.New(10)}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(50), now}}).Once()
+
+		result, err := repo.CreateTx(ctx, mockTx, receipt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), result.ID)
+		assert.Equal(t, int64(50), result.Items[0].ID)
+		assert.Equal(t, int64(5), result.Items[0].PurchaseReceiptID)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrDBInvalidForeignKey when order does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseReceiptTx{}
+		ctx := context.Background()
+		receipt := newReceipt()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, receiptArgs(receipt)).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}})
+
+		result, err := repo.CreateTx(ctx, mockTx, receipt)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("return ErrCreate on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseReceiptTx{}
+		ctx := context.Background()
+		receipt := newReceipt()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, receiptArgs(receipt)).
+			Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		result, err := repo.CreateTx(ctx, mockTx, receipt)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+
+	t.Run("return ErrInvalidData when item cost is not resolved", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseReceiptTx{}
+		ctx := context.Background()
+		receipt := newReceipt()
+		receipt.Items[0].UnitCost = nil
+
+		mockTx.On("QueryRow", ctx, mock.Anything, receiptArgs(receipt)).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(5), time.Now()}}).Once()
+
+		result, err := repo.CreateTx(ctx, mockTx, receipt)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("return ErrCreate when item insert fails", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &purchaseReceiptTx{}
+		ctx := context.Background()
+		receipt := newReceipt()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, receiptArgs(receipt)).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(5), time.Now()}}).Once()
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(5), int64(30), int64(7), 2, money.New(10)}).
+			Return(&mockDb.MockRow{Err: errors.New("db down")}).Once()
+
+		result, err := repo.CreateTx(ctx, mockTx, receipt)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
@@ -3,11 +3,13 @@ package repo
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/filter"
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+	pagination "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/pagination"
 )
 
 var allowedSaleSortFields = map[string]string{
@@ -21,7 +23,13 @@ var allowedSaleSortFields = map[string]string{
 	"updated_at":   "updated_at",
 }
 
-func (r *saleFilterRepo) Filter(ctx context.Context, filter *filter.SaleFilter) ([]*model.Sale, error) {
+var saleOrderBy = builder.OrderBy{
+	Fields:       allowedSaleSortFields,
+	DefaultField: "sale_date",
+	DefaultOrder: "asc",
+}
+
+func (r *saleFilterRepo) Filter(ctx context.Context, filter *filter.SaleFilter) (*commonFilter.Page[*model.Sale], error) {
 
 	base := filter.BaseFilter.WithDefaults()
 
@@ -40,82 +48,34 @@ func (r *saleFilterRepo) Filter(ctx context.Context, filter *filter.SaleFilter)
 			notes,
 			version,
 			created_at,
-			updated_at
+			updated_at,
+			client_cnpj_id,
+			location_id,
+			cash_session_id
 		FROM sales
-		WHERE 1=1
 	`
 
-	args := []any{}
-	argPos := 1
-
-	if filter.ClientID != nil {
-		query += fmt.Sprintf(" AND client_id = $%d", argPos)
-		args = append(args, *filter.ClientID)
-		argPos++
-	}
-
-	if filter.UserID != nil {
-		query += fmt.Sprintf(" AND user_id = $%d", argPos)
-		args = append(args, *filter.UserID)
-		argPos++
-	}
-
-	if filter.Status != "" {
-		query += fmt.Sprintf(" AND status = $%d", argPos)
-		args = append(args, filter.Status)
-		argPos++
-	}
-
-	if filter.PaymentType != "" {
-		query += fmt.Sprintf(" AND payment_type = $%d", argPos)
-		args = append(args, filter.PaymentType)
-		argPos++
-	}
-
-	if filter.SaleDateFrom != nil {
-		query += fmt.Sprintf(" AND sale_date >= $%d", argPos)
-		args = append(args, *filter.SaleDateFrom)
-		argPos++
-	}
-
-	if filter.SaleDateTo != nil {
-		query += fmt.Sprintf(" AND sale_date <= $%d", argPos)
-		args = append(args, *filter.SaleDateTo)
-		argPos++
-	}
+	b := builder.NewQueryBuilderSql(query)
 
-	if filter.CreatedFrom != nil {
-		query += fmt.Sprintf(" AND created_at >= $%d", argPos)
-		args = append(args, *filter.CreatedFrom)
-		argPos++
-	}
+	b.Eq("client_id", filter.ClientID)
+	b.Eq("client_cnpj_id", filter.ClientCnpjID)
+	b.Eq("user_id", filter.UserID)
+	b.Eq("status", filter.Status)
+	// Casa qualquer forma de pagamento usada na venda, não só a principal
+	b.InSubquery("id", "sale_payments", "sale_id", "method", filter.PaymentType)
+	b.Range("sale_date", filter.SaleDateFrom, filter.SaleDateTo)
+	b.Range("created_at", filter.CreatedFrom, filter.CreatedTo)
+	b.Search(base.SearchTerm, "notes", "payment_type", "status")
 
-	if filter.CreatedTo != nil {
-		query += fmt.Sprintf(" AND created_at <= $%d", argPos)
-		args = append(args, *filter.CreatedTo)
-		argPos++
-	}
+	sortField, sortOrder := saleOrderBy.Resolve(filter.SortBy, filter.SortOrder)
 
-	// ORDER BY seguro
-	sortField := "sale_date"
-	if v, ok := allowedSaleSortFields[strings.ToLower(base.SortBy)]; ok {
-		sortField = v
-	}
-
-	sortOrder := strings.ToLower(base.SortOrder)
-	if sortOrder != "asc" && sortOrder != "desc" {
-		sortOrder = "asc"
+	args := b.GetArgs()
+	tail, pageArgs, err := pagination.Clause(base, sortField, sortOrder, b.NextArgPos())
+	if err != nil {
+		return nil, err
 	}
 
-	query += fmt.Sprintf(
-		" ORDER BY %s %s LIMIT %d OFFSET %d",
-		sortField,
-		sortOrder,
-		base.Limit,
-		base.Offset,
-	)
-
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := r.db.Query(ctx, b.GetQuery()+tail, append(args, pageArgs...)...)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
 	}
@@ -141,6 +101,9 @@ func (r *saleFilterRepo) Filter(ctx context.Context, filter *filter.SaleFilter)
 			&s.Version,
 			&s.CreatedAt,
 			&s.UpdatedAt,
+			&s.ClientCnpjID,
+			&s.LocationID,
+			&s.CashSessionID,
 		); err != nil {
 			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
 		}
@@ -151,5 +114,34 @@ func (r *saleFilterRepo) Filter(ctx context.Context, filter *filter.SaleFilter)
 		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
 	}
 
-	return sales, nil
+	total, err := pagination.Count(ctx, r.db, "sales", b.Where(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonFilter.NewPage(sales, total, base, func(m *model.Sale) (any, int64) {
+		return saleSortValue(m, sortField), m.ID
+	}), nil
+}
+
+// saleSortValue devolve o valor da coluna de ordenação field na venda, gravado
+// no cursor da próxima página.
+func saleSortValue(m *model.Sale, field string) any {
+	switch field {
+	case "sale_date":
+		return m.SaleDate
+	case "total_amount":
+		return m.TotalAmount
+	case "payment_type":
+		return m.PaymentType
+	case "status":
+		return m.Status
+	case "version":
+		return m.Version
+	case "created_at":
+		return m.CreatedAt
+	case "updated_at":
+		return m.UpdatedAt
+	}
+	return m.ID
 }
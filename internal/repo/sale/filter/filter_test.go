@@ -11,6 +11,7 @@ import (
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 	filterSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/filter"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -27,20 +28,23 @@ func TestSale_Filter(t *testing.T) {
 
 		mockRows.On("Next").Return(true).Once()
 		mockRows.On("Scan",
-			mock.AnythingOfType("*int64"),     // id
-			mock.AnythingOfType("**int64"),    // client_id
-			mock.AnythingOfType("**int64"),    // user_id
-			mock.AnythingOfType("*time.Time"), // sale_date
-			mock.AnythingOfType("*float64"),   // total_items_amount
-			mock.AnythingOfType("*float64"),   // total_items_discount
-			mock.AnythingOfType("*float64"),   // total_sale_discount
-			mock.AnythingOfType("*float64"),   // total_amount
-			mock.AnythingOfType("*string"),    // payment_type
-			mock.AnythingOfType("*string"),    // status
-			mock.AnythingOfType("*string"),    // notes (string, não *string)
-			mock.AnythingOfType("*int"),       // version
-			mock.AnythingOfType("*time.Time"), // created_at
-			mock.AnythingOfType("*time.Time"), // updated_at
+			mock.AnythingOfType("*int64"),       // id
+			mock.AnythingOfType("**int64"),      // client_id
+			mock.AnythingOfType("**int64"),      // user_id
+			mock.AnythingOfType("*time.Time"),   // sale_date
+			mock.AnythingOfType("*money.Money"), // total_items_amount
+			mock.AnythingOfType("*money.Money"), // total_items_discount
+			mock.AnythingOfType("*money.Money"), // total_sale_discount
+			mock.AnythingOfType("*money.Money"), // total_amount
+			mock.AnythingOfType("*string"),      // payment_type
+			mock.AnythingOfType("*string"),      // status
+			mock.AnythingOfType("*string"),      // notes (string, não *string)
+			mock.AnythingOfType("*int"),         // version
+			mock.AnythingOfType("*time.Time"),   // created_at
+			mock.AnythingOfType("*time.Time"),   // updated_at
+			mock.AnythingOfType("**int64"),      // client_cnpj_id
+			mock.AnythingOfType("*int64"),       // location_id
+			mock.AnythingOfType("**int64"),      // cash_session_id
 		).Run(func(args mock.Arguments) {
 			*args[0].(*int64) = 1
 
@@ -51,10 +55,10 @@ func TestSale_Filter(t *testing.T) {
 			*args[2].(**int64) = &userID
 
 			*args[3].(*time.Time) = saleDate
-			*args[4].(*float64) = 1000.0
-			*args[5].(*float64) = 100.0
-			*args[6].(*float64) = 50.0
-			*args[7].(*float64) = 850.0
+			*args[4].(*money.Money) = money.New(1000)
+			*args[5].(*money.Money) = money.New(100)
+			*args[6].(*money.Money) = money.New(50)
+			*args[7].(*money.Money) = money.New(850)
 			*args[8].(*string) = "CREDIT_CARD"
 			*args[9].(*string) = "COMPLETED"
 			*args[10].(*string) = "Venda de teste" // string direta, não ponteiro
@@ -75,26 +79,27 @@ func TestSale_Filter(t *testing.T) {
 
 		mockDB.On("Query", ctx, mock.Anything, mock.AnythingOfType("[]interface {}")).Return(mockRows, nil)
 
+		mockDB.OnCount(1)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 1)
-		assert.Equal(t, int64(1), result[0].ID)
-		assert.NotNil(t, result[0].ClientID)
-		assert.Equal(t, int64(100), *result[0].ClientID)
-		assert.NotNil(t, result[0].UserID)
-		assert.Equal(t, int64(50), *result[0].UserID)
-		assert.Equal(t, saleDate, result[0].SaleDate)
-		assert.Equal(t, 1000.0, result[0].TotalItemsAmount)
-		assert.Equal(t, 100.0, result[0].TotalItemsDiscount)
-		assert.Equal(t, 50.0, result[0].TotalSaleDiscount)
-		assert.Equal(t, 850.0, result[0].TotalAmount)
-		assert.Equal(t, "CREDIT_CARD", result[0].PaymentType)
-		assert.Equal(t, "COMPLETED", result[0].Status)
-		assert.Equal(t, "Venda de teste", result[0].Notes) // string direta
-		assert.Equal(t, 1, result[0].Version)
-		assert.WithinDuration(t, now, result[0].CreatedAt, time.Second)
-		assert.WithinDuration(t, now, result[0].UpdatedAt, time.Second)
+		assert.Len(t, result.Items, 1)
+		assert.Equal(t, int64(1), result.Items[0].ID)
+		assert.NotNil(t, result.Items[0].ClientID)
+		assert.Equal(t, int64(100), *result.Items[0].ClientID)
+		assert.NotNil(t, result.Items[0].UserID)
+		assert.Equal(t, int64(50), *result.Items[0].UserID)
+		assert.Equal(t, saleDate, result.Items[0].SaleDate)
+		assert.Equal(t, money.New(1000), result.Items[0].TotalItemsAmount)
+		assert.Equal(t, money.New(100), result.Items[0].TotalItemsDiscount)
+		assert.Equal(t, money.New(50), result.Items[0].TotalSaleDiscount)
+		assert.Equal(t, money.New(850), result.Items[0].TotalAmount)
+		assert.Equal(t, "CREDIT_CARD", result.Items[0].PaymentType)
+		assert.Equal(t, "COMPLETED", result.Items[0].Status)
+		assert.Equal(t, "Venda de teste", result.Items[0].Notes) // string direta
+		assert.Equal(t, 1, result.Items[0].Version)
+		assert.WithinDuration(t, now, result.Items[0].CreatedAt, time.Second)
+		assert.WithinDuration(t, now, result.Items[0].UpdatedAt, time.Second)
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
 	})
@@ -113,16 +118,19 @@ func TestSale_Filter(t *testing.T) {
 			mock.AnythingOfType("**int64"),
 			mock.AnythingOfType("**int64"),
 			mock.AnythingOfType("*time.Time"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*int"),
 			mock.AnythingOfType("*time.Time"),
 			mock.AnythingOfType("*time.Time"),
+			mock.AnythingOfType("**int64"),
+			mock.AnythingOfType("*int64"),
+			mock.AnythingOfType("**int64"),
 		).Run(func(args mock.Arguments) {
 			*args[0].(*int64) = 1
 			clientID := int64(100)
@@ -130,7 +138,7 @@ func TestSale_Filter(t *testing.T) {
 			userID := int64(50)
 			*args[2].(**int64) = &userID
 			*args[3].(*time.Time) = now
-			*args[4].(*float64) = 1000.0
+			*args[4].(*money.Money) = money.New(1000)
 			*args[11].(*int) = 1
 			*args[12].(*time.Time) = now
 			*args[13].(*time.Time) = now
@@ -159,10 +167,11 @@ func TestSale_Filter(t *testing.T) {
 			).
 			Return(mockRows, nil)
 
+		mockDB.OnCount(1)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 1)
+		assert.Len(t, result.Items, 1)
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
 	})
@@ -181,16 +190,19 @@ func TestSale_Filter(t *testing.T) {
 			mock.AnythingOfType("**int64"),
 			mock.AnythingOfType("**int64"),
 			mock.AnythingOfType("*time.Time"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*int"),
 			mock.AnythingOfType("*time.Time"),
 			mock.AnythingOfType("*time.Time"),
+			mock.AnythingOfType("**int64"),
+			mock.AnythingOfType("*int64"),
+			mock.AnythingOfType("**int64"),
 		).Run(func(args mock.Arguments) {
 			*args[0].(*int64) = 1
 			clientID := int64(100)
@@ -226,6 +238,7 @@ func TestSale_Filter(t *testing.T) {
 			).
 			Return(mockRows, nil)
 
+		mockDB.OnCount(0)
 		_, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
@@ -270,16 +283,19 @@ func TestSale_Filter(t *testing.T) {
 			mock.AnythingOfType("**int64"),
 			mock.AnythingOfType("**int64"),
 			mock.AnythingOfType("*time.Time"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*int"),
 			mock.AnythingOfType("*time.Time"),
 			mock.AnythingOfType("*time.Time"),
+			mock.AnythingOfType("**int64"),
+			mock.AnythingOfType("*int64"),
+			mock.AnythingOfType("**int64"),
 		).Return(scanErr).Once()
 		mockRows.On("Close").Return()
 
@@ -344,16 +360,19 @@ func TestSale_Filter(t *testing.T) {
 			mock.AnythingOfType("**int64"),
 			mock.AnythingOfType("**int64"),
 			mock.AnythingOfType("*time.Time"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*int"),
 			mock.AnythingOfType("*time.Time"),
 			mock.AnythingOfType("*time.Time"),
+			mock.AnythingOfType("**int64"),
+			mock.AnythingOfType("*int64"),
+			mock.AnythingOfType("**int64"),
 		).Run(func(args mock.Arguments) {
 			*args[0].(*int64) = 42
 			clientID := int64(200)
@@ -392,15 +411,16 @@ func TestSale_Filter(t *testing.T) {
 			return cid == 200 && uid == 75
 		})).Return(mockRows, nil)
 
+		mockDB.OnCount(1)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 1)
-		assert.Equal(t, int64(42), result[0].ID)
-		assert.Equal(t, int64(200), *result[0].ClientID)
-		assert.Equal(t, int64(75), *result[0].UserID)
-		assert.Equal(t, "PIX", result[0].PaymentType)
-		assert.Equal(t, "PENDING", result[0].Status)
+		assert.Len(t, result.Items, 1)
+		assert.Equal(t, int64(42), result.Items[0].ID)
+		assert.Equal(t, int64(200), *result.Items[0].ClientID)
+		assert.Equal(t, int64(75), *result.Items[0].UserID)
+		assert.Equal(t, "PIX", result.Items[0].PaymentType)
+		assert.Equal(t, "PENDING", result.Items[0].Status)
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
 	})
@@ -419,16 +439,19 @@ func TestSale_Filter(t *testing.T) {
 			mock.AnythingOfType("**int64"),
 			mock.AnythingOfType("**int64"),
 			mock.AnythingOfType("*time.Time"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*int"),
 			mock.AnythingOfType("*time.Time"),
 			mock.AnythingOfType("*time.Time"),
+			mock.AnythingOfType("**int64"),
+			mock.AnythingOfType("*int64"),
+			mock.AnythingOfType("**int64"),
 		).Run(func(args mock.Arguments) {
 			*args[0].(*int64) = 7
 			clientID := int64(150)
@@ -456,7 +479,11 @@ func TestSale_Filter(t *testing.T) {
 			PaymentType: "CASH",
 		}
 
-		mockDB.On("Query", ctx, mock.Anything, mock.MatchedBy(func(args []interface{}) bool {
+		// payment_type casa qualquer forma de pagamento gravada em sale_payments
+		paymentQuery := mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "id IN (SELECT sale_id FROM sale_payments WHERE method = $2)")
+		})
+		mockDB.On("Query", ctx, paymentQuery, mock.MatchedBy(func(args []interface{}) bool {
 			if len(args) != 2 {
 				return false
 			}
@@ -465,14 +492,15 @@ func TestSale_Filter(t *testing.T) {
 			return status == "COMPLETED" && paymentType == "CASH"
 		})).Return(mockRows, nil)
 
+		mockDB.OnCount(1)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 1)
-		assert.Equal(t, int64(7), result[0].ID)
-		assert.Equal(t, "CASH", result[0].PaymentType)
-		assert.Equal(t, "COMPLETED", result[0].Status)
-		assert.Equal(t, 3, result[0].Version)
+		assert.Len(t, result.Items, 1)
+		assert.Equal(t, int64(7), result.Items[0].ID)
+		assert.Equal(t, "CASH", result.Items[0].PaymentType)
+		assert.Equal(t, "COMPLETED", result.Items[0].Status)
+		assert.Equal(t, 3, result.Items[0].Version)
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
 	})
@@ -495,16 +523,19 @@ func TestSale_Filter(t *testing.T) {
 			mock.AnythingOfType("**int64"),
 			mock.AnythingOfType("**int64"),
 			mock.AnythingOfType("*time.Time"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
-			mock.AnythingOfType("*float64"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
+			mock.AnythingOfType("*money.Money"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*string"),
 			mock.AnythingOfType("*int"),
 			mock.AnythingOfType("*time.Time"),
 			mock.AnythingOfType("*time.Time"),
+			mock.AnythingOfType("**int64"),
+			mock.AnythingOfType("*int64"),
+			mock.AnythingOfType("**int64"),
 		).Run(func(args mock.Arguments) {
 			*args[0].(*int64) = 99
 			clientID := int64(300)
@@ -558,14 +589,73 @@ func TestSale_Filter(t *testing.T) {
 			return hasSaleDateFrom && hasSaleDateTo && hasCreatedFrom && hasCreatedTo
 		})).Return(mockRows, nil)
 
+		mockDB.OnCount(1)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 1)
-		assert.Equal(t, int64(99), result[0].ID)
-		assert.Equal(t, "Filtro de data", result[0].Notes)
-		assert.Equal(t, 4, result[0].Version)
+		assert.Len(t, result.Items, 1)
+		assert.Equal(t, int64(99), result.Items[0].ID)
+		assert.Equal(t, "Filtro de data", result.Items[0].Notes)
+		assert.Equal(t, 4, result.Items[0].Version)
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
 	})
+
+	t.Run("cursor mode applies keyset predicate and counts without it", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &saleFilterRepo{db: mockDB}
+		ctx := context.Background()
+
+		base := filter.BaseFilter{Limit: 2, CursorMode: true, SortBy: "total_amount", SortOrder: "desc"}
+		base.Cursor = filter.NewCursor(base, money.New(150), 30).Encode()
+		saleFilter := &filterSale.SaleFilter{BaseFilter: base, Status: "active"}
+
+		mockRows := new(mockDb.MockRows)
+		mockRows.On("Next").Return(false).Once()
+		mockRows.On("Err").Return(nil)
+		mockRows.On("Close").Return()
+
+		mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "AND (total_amount, id) < ($2, $3)") &&
+				strings.HasSuffix(q, "ORDER BY total_amount desc, id desc LIMIT 3")
+		}), []interface{}{"active", money.New(150), int64(30)}).Return(mockRows, nil)
+		mockDB.On("QueryRow", ctx, "SELECT COUNT(*) FROM sales WHERE 1=1 AND status = $1", []interface{}{"active"}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(5)}})
+
+		result, err := repo.Filter(ctx, saleFilter)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Items)
+		assert.Equal(t, int64(5), result.Total)
+		assert.False(t, result.HasNext)
+		mockDB.AssertExpectations(t)
+	})
+}
+
+func TestSale_Filter_SearchTerm(t *testing.T) {
+	mockDB := new(mockDb.MockDatabase)
+	repo := &saleFilterRepo{db: mockDB}
+	ctx := context.Background()
+
+	mockRows := new(mockDb.MockRows)
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Err").Return(nil)
+	mockRows.On("Close").Return()
+
+	where := " WHERE 1=1 AND (notes ILIKE $1 OR payment_type ILIKE $1 OR status ILIKE $1)"
+	args := []interface{}{`%50\%\_acme%`}
+
+	mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+		return strings.Contains(q, where+" ORDER BY")
+	}), args).Return(mockRows, nil)
+	mockDB.On("QueryRow", ctx, "SELECT COUNT(*) FROM sales"+where, args).
+		Return(&mockDb.MockRow{Values: []interface{}{int64(0)}})
+
+	result, err := repo.Filter(ctx, &filterSale.SaleFilter{
+		BaseFilter: filter.BaseFilter{Limit: 10, SearchTerm: " 50%_acme "},
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Items)
+	mockDB.AssertExpectations(t)
 }
@@ -0,0 +1,85 @@
+package repo
+
+import (
+	"context"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	modelAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/jackc/pgx/v5"
+)
+
+const auditEntity = "sale_item"
+
+// auditedItemTx registra cada escrita de item na transação que a fez: se o
+// registro falhar, a escrita é desfeita.
+type auditedItemTx struct {
+	iface.SaleItemTx
+	rec audit.Recorder
+}
+
+func WithAuditTx(inner iface.SaleItemTx, rec audit.Recorder) iface.SaleItemTx {
+	return &auditedItemTx{SaleItemTx: inner, rec: rec}
+}
+
+func (r *auditedItemTx) load(ctx context.Context, tx pgx.Tx, id int64) (any, error) {
+	return r.SaleItemTx.GetByIDTx(ctx, tx, id)
+}
+
+func (r *auditedItemTx) CreateTx(ctx context.Context, tx pgx.Tx, item *models.SaleItem) (*models.SaleItem, error) {
+	created, err := r.SaleItemTx.CreateTx(ctx, tx, item)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.rec.RecordTx(ctx, tx, audit.Entry{
+		EntityType: auditEntity,
+		EntityID:   created.ID,
+		Action:     modelAudit.ActionCreate,
+		After:      created,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (r *auditedItemTx) UpdateTx(ctx context.Context, tx pgx.Tx, item *models.SaleItem) error {
+	return audit.TrackTx(ctx, tx, r.rec, auditEntity, item.ID, modelAudit.ActionUpdate, r.load, func() error {
+		return r.SaleItemTx.UpdateTx(ctx, tx, item)
+	})
+}
+
+func (r *auditedItemTx) DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	return audit.TrackTx(ctx, tx, r.rec, auditEntity, id, modelAudit.ActionDelete, r.load, func() error {
+		return r.SaleItemTx.DeleteTx(ctx, tx, id)
+	})
+}
+
+// DeleteBySaleIDTx registra a exclusão de cada item da venda.
+func (r *auditedItemTx) DeleteBySaleIDTx(ctx context.Context, tx pgx.Tx, saleID int64) error {
+	items, err := r.SaleItemTx.GetBySaleIDTx(ctx, tx, saleID)
+	if err != nil {
+		return err
+	}
+
+	if err := r.SaleItemTx.DeleteBySaleIDTx(ctx, tx, saleID); err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		err := r.rec.RecordTx(ctx, tx, audit.Entry{
+			EntityType: auditEntity,
+			EntityID:   item.ID,
+			Action:     modelAudit.ActionDelete,
+			Before:     item,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
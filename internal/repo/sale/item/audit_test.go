@@ -0,0 +1,99 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockAudit "github.com/WagaoCarvalho/backend_store_go/infra/mock/audit"
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	mockSale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	modelAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditedItemTx(t *testing.T) {
+	ctx := context.Background()
+	tx := new(mockDb.MockTx)
+
+	t.Run("create records the new item", func(t *testing.T) {
+		inner := new(mockSale.MockSaleItemTx)
+		rec := new(mockAudit.MockRecorder)
+		item := &models.SaleItem{SaleID: 1, ProductID: 2, Quantity: 3}
+		created := &models.SaleItem{ID: 5, SaleID: 1, ProductID: 2, Quantity: 3}
+
+		inner.On("CreateTx", ctx, tx, item).Return(created, nil).Once()
+		rec.On("RecordTx", ctx, tx, audit.Entry{EntityType: "sale_item", EntityID: 5, Action: modelAudit.ActionCreate, After: created}).Return(nil).Once()
+
+		result, err := WithAuditTx(inner, rec).CreateTx(ctx, tx, item)
+
+		assert.NoError(t, err)
+		assert.Same(t, created, result)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("audit failure fails the create", func(t *testing.T) {
+		inner := new(mockSale.MockSaleItemTx)
+		rec := new(mockAudit.MockRecorder)
+		item := &models.SaleItem{SaleID: 1}
+
+		inner.On("CreateTx", ctx, tx, item).Return(&models.SaleItem{ID: 5}, nil).Once()
+		rec.On("RecordTx", ctx, tx, mock.Anything).Return(errors.New("audit fail")).Once()
+
+		result, err := WithAuditTx(inner, rec).CreateTx(ctx, tx, item)
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "audit fail")
+	})
+
+	t.Run("update records before and after", func(t *testing.T) {
+		inner := new(mockSale.MockSaleItemTx)
+		rec := new(mockAudit.MockRecorder)
+		before := &models.SaleItem{ID: 5, Quantity: 3}
+		after := &models.SaleItem{ID: 5, Quantity: 4}
+
+		inner.On("GetByIDTx", ctx, tx, int64(5)).Return(before, nil).Once()
+		inner.On("UpdateTx", ctx, tx, after).Return(nil).Once()
+		inner.On("GetByIDTx", ctx, tx, int64(5)).Return(after, nil).Once()
+		rec.On("RecordTx", ctx, tx, audit.Entry{EntityType: "sale_item", EntityID: 5, Action: modelAudit.ActionUpdate, Before: before, After: after}).Return(nil).Once()
+
+		err := WithAuditTx(inner, rec).UpdateTx(ctx, tx, after)
+
+		assert.NoError(t, err)
+		inner.AssertExpectations(t)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("delete by sale records every item", func(t *testing.T) {
+		inner := new(mockSale.MockSaleItemTx)
+		rec := new(mockAudit.MockRecorder)
+		items := []*models.SaleItem{{ID: 5, SaleID: 1}, {ID: 6, SaleID: 1}}
+
+		inner.On("GetBySaleIDTx", ctx, tx, int64(1)).Return(items, nil).Once()
+		inner.On("DeleteBySaleIDTx", ctx, tx, int64(1)).Return(nil).Once()
+		rec.On("RecordTx", ctx, tx, audit.Entry{EntityType: "sale_item", EntityID: 5, Action: modelAudit.ActionDelete, Before: items[0]}).Return(nil).Once()
+		rec.On("RecordTx", ctx, tx, audit.Entry{EntityType: "sale_item", EntityID: 6, Action: modelAudit.ActionDelete, Before: items[1]}).Return(nil).Once()
+
+		err := WithAuditTx(inner, rec).DeleteBySaleIDTx(ctx, tx, 1)
+
+		assert.NoError(t, err)
+		inner.AssertExpectations(t)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("failed delete is not recorded", func(t *testing.T) {
+		inner := new(mockSale.MockSaleItemTx)
+		rec := new(mockAudit.MockRecorder)
+
+		inner.On("GetBySaleIDTx", ctx, tx, int64(1)).Return([]*models.SaleItem{{ID: 5}}, nil).Once()
+		inner.On("DeleteBySaleIDTx", ctx, tx, int64(1)).Return(errors.New("db fail")).Once()
+
+		err := WithAuditTx(inner, rec).DeleteBySaleIDTx(ctx, tx, 1)
+
+		assert.EqualError(t, err, "db fail")
+		rec.AssertNotCalled(t, "RecordTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -49,10 +50,10 @@ func TestItemSale_GetByID(t *testing.T) {
 		assert.Equal(t, int64(10), result.SaleID)
 		assert.Equal(t, int64(20), result.ProductID)
 		assert.Equal(t, 5, result.Quantity)
-		assert.Equal(t, 10.5, result.UnitPrice)
-		assert.Equal(t, 1.0, result.Discount)
-		assert.Equal(t, 0.5, result.Tax)
-		assert.Equal(t, 50.0, result.Subtotal)
+		assert.Equal(t, money.MustParse("10.50"), result.UnitPrice)
+		assert.Equal(t, money.New(1), result.Discount)
+		assert.Equal(t, money.MustParse("0.50"), result.Tax)
+		assert.Equal(t, money.New(50), result.Subtotal)
 		assert.Equal(t, "desc test", result.Description)
 		assert.Equal(t, expectedTime, result.CreatedAt)
 		assert.Equal(t, expectedTime, result.UpdatedAt)
@@ -163,10 +164,10 @@ func TestItemSale_GetBySaleID(t *testing.T) {
 		assert.Equal(t, saleID, results[0].SaleID)
 		assert.Equal(t, int64(20), results[0].ProductID)
 		assert.Equal(t, 5, results[0].Quantity)
-		assert.Equal(t, 10.5, results[0].UnitPrice)
-		assert.Equal(t, 1.0, results[0].Discount)
-		assert.Equal(t, 0.5, results[0].Tax)
-		assert.Equal(t, 50.0, results[0].Subtotal)
+		assert.Equal(t, money.MustParse("10.50"), results[0].UnitPrice)
+		assert.Equal(t, money.New(1), results[0].Discount)
+		assert.Equal(t, money.MustParse("0.50"), results[0].Tax)
+		assert.Equal(t, money.New(50), results[0].Subtotal)
 		assert.Equal(t, "desc test 1", results[0].Description)
 		assert.Equal(t, expectedTime, results[0].CreatedAt)
 		assert.Equal(t, expectedTime, results[0].UpdatedAt)
@@ -176,10 +177,10 @@ func TestItemSale_GetBySaleID(t *testing.T) {
 		assert.Equal(t, saleID, results[1].SaleID)
 		assert.Equal(t, int64(21), results[1].ProductID)
 		assert.Equal(t, 3, results[1].Quantity)
-		assert.Equal(t, 15.0, results[1].UnitPrice)
-		assert.Equal(t, 0.0, results[1].Discount)
-		assert.Equal(t, 1.0, results[1].Tax)
-		assert.Equal(t, 46.0, results[1].Subtotal)
+		assert.Equal(t, money.New(15), results[1].UnitPrice)
+		assert.Equal(t, money.New(0), results[1].Discount)
+		assert.Equal(t, money.New(1), results[1].Tax)
+		assert.Equal(t, money.New(46), results[1].Subtotal)
 		assert.Equal(t, "desc test 2", results[1].Description)
 		assert.Equal(t, expectedTime, results[1].CreatedAt)
 		assert.Equal(t, expectedTime, results[1].UpdatedAt)
@@ -252,10 +253,10 @@ func TestItemSale_GetBySaleID(t *testing.T) {
 		assert.Equal(t, saleID, results[0].SaleID)
 		assert.Equal(t, int64(30), results[0].ProductID)
 		assert.Equal(t, 2, results[0].Quantity)
-		assert.Equal(t, 8.0, results[0].UnitPrice)
-		assert.Equal(t, 0.5, results[0].Discount)
-		assert.Equal(t, 0.3, results[0].Tax)
-		assert.Equal(t, 15.8, results[0].Subtotal)
+		assert.Equal(t, money.New(8), results[0].UnitPrice)
+		assert.Equal(t, money.MustParse("0.50"), results[0].Discount)
+		assert.Equal(t, money.MustParse("0.30"), results[0].Tax)
+		assert.Equal(t, money.MustParse("15.80"), results[0].Subtotal)
 		assert.Equal(t, "desc test 3", results[0].Description)
 
 		mockDB.AssertExpectations(t)
@@ -366,10 +367,10 @@ func TestItemSale_GetByProductID(t *testing.T) {
 		assert.Equal(t, int64(10), results[0].SaleID)
 		assert.Equal(t, productID, results[0].ProductID)
 		assert.Equal(t, 5, results[0].Quantity)
-		assert.Equal(t, 10.5, results[0].UnitPrice)
-		assert.Equal(t, 1.0, results[0].Discount)
-		assert.Equal(t, 0.5, results[0].Tax)
-		assert.Equal(t, 50.0, results[0].Subtotal)
+		assert.Equal(t, money.MustParse("10.50"), results[0].UnitPrice)
+		assert.Equal(t, money.New(1), results[0].Discount)
+		assert.Equal(t, money.MustParse("0.50"), results[0].Tax)
+		assert.Equal(t, money.New(50), results[0].Subtotal)
 		assert.Equal(t, "desc test 1", results[0].Description)
 		assert.Equal(t, expectedTime, results[0].CreatedAt)
 		assert.Equal(t, expectedTime, results[0].UpdatedAt)
@@ -379,10 +380,10 @@ func TestItemSale_GetByProductID(t *testing.T) {
 		assert.Equal(t, int64(11), results[1].SaleID)
 		assert.Equal(t, productID, results[1].ProductID)
 		assert.Equal(t, 3, results[1].Quantity)
-		assert.Equal(t, 15.0, results[1].UnitPrice)
-		assert.Equal(t, 0.0, results[1].Discount)
-		assert.Equal(t, 1.0, results[1].Tax)
-		assert.Equal(t, 46.0, results[1].Subtotal)
+		assert.Equal(t, money.New(15), results[1].UnitPrice)
+		assert.Equal(t, money.New(0), results[1].Discount)
+		assert.Equal(t, money.New(1), results[1].Tax)
+		assert.Equal(t, money.New(46), results[1].Subtotal)
 		assert.Equal(t, "desc test 2", results[1].Description)
 		assert.Equal(t, expectedTime, results[1].CreatedAt)
 		assert.Equal(t, expectedTime, results[1].UpdatedAt)
@@ -491,10 +492,10 @@ func TestItemSale_GetByProductID(t *testing.T) {
 		assert.Equal(t, int64(15), results[0].SaleID)
 		assert.Equal(t, productID, results[0].ProductID)
 		assert.Equal(t, 2, results[0].Quantity)
-		assert.Equal(t, 8.0, results[0].UnitPrice)
-		assert.Equal(t, 0.5, results[0].Discount)
-		assert.Equal(t, 0.3, results[0].Tax)
-		assert.Equal(t, 15.8, results[0].Subtotal)
+		assert.Equal(t, money.New(8), results[0].UnitPrice)
+		assert.Equal(t, money.MustParse("0.50"), results[0].Discount)
+		assert.Equal(t, money.MustParse("0.30"), results[0].Tax)
+		assert.Equal(t, money.MustParse("15.80"), results[0].Subtotal)
 		assert.Equal(t, "desc test 3", results[0].Description)
 
 		mockDB.AssertExpectations(t)
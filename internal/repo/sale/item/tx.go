@@ -0,0 +1,194 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+type itemSaleTx struct{}
+
+func NewItemSaleTx() iface.SaleItemTx {
+	return &itemSaleTx{}
+}
+
+func (r *itemSaleTx) CreateTx(ctx context.Context, tx pgx.Tx, item *models.SaleItem) (*models.SaleItem, error) {
+	const query = `
+		INSERT INTO sale_items (
+			sale_id, product_id, quantity, unit_price, discount, tax, subtotal, description, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+		RETURNING id, created_at, updated_at;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		item.SaleID,
+		item.ProductID,
+		item.Quantity,
+		item.UnitPrice,
+		item.Discount,
+		item.Tax,
+		item.Subtotal,
+		item.Description,
+	).Scan(&item.ID, &item.CreatedAt, &item.UpdatedAt)
+
+	if err != nil {
+		if errMsgPg.IsForeignKeyViolation(err) {
+			return nil, errMsg.ErrDBInvalidForeignKey
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	return item, nil
+}
+
+func (r *itemSaleTx) GetByIDTx(ctx context.Context, tx pgx.Tx, id int64) (*models.SaleItem, error) {
+	const query = `
+		SELECT 
+			id, sale_id, product_id, quantity, unit_price, discount, tax, subtotal, description, created_at, updated_at
+		FROM sale_items
+		WHERE id = $1;
+	`
+
+	var item models.SaleItem
+
+	err := tx.QueryRow(ctx, query, id).Scan(
+		&item.ID,
+		&item.SaleID,
+		&item.ProductID,
+		&item.Quantity,
+		&item.UnitPrice,
+		&item.Discount,
+		&item.Tax,
+		&item.Subtotal,
+		&item.Description,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return &item, nil
+}
+
+func (r *itemSaleTx) GetBySaleIDTx(ctx context.Context, tx pgx.Tx, saleID int64) ([]*models.SaleItem, error) {
+	const query = `
+		SELECT 
+			id, sale_id, product_id, quantity, unit_price, discount, tax,
+			subtotal, description, created_at, updated_at
+		FROM sale_items
+		WHERE sale_id = $1
+		ORDER BY id ASC;
+	`
+
+	rows, err := tx.Query(ctx, query, saleID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	var items []*models.SaleItem
+	for rows.Next() {
+		var item models.SaleItem
+		if err := rows.Scan(
+			&item.ID,
+			&item.SaleID,
+			&item.ProductID,
+			&item.Quantity,
+			&item.UnitPrice,
+			&item.Discount,
+			&item.Tax,
+			&item.Subtotal,
+			&item.Description,
+			&item.CreatedAt,
+			&item.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+		}
+		items = append(items, &item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return items, nil
+}
+
+func (r *itemSaleTx) UpdateTx(ctx context.Context, tx pgx.Tx, item *models.SaleItem) error {
+	const query = `
+		UPDATE sale_items
+		SET 
+			product_id  = $1,
+			quantity    = $2,
+			unit_price  = $3,
+			discount    = $4,
+			tax         = $5,
+			subtotal    = $6,
+			description = $7,
+			updated_at  = NOW()
+		WHERE id = $8 AND sale_id = $9
+		RETURNING updated_at;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		item.ProductID,
+		item.Quantity,
+		item.UnitPrice,
+		item.Discount,
+		item.Tax,
+		item.Subtotal,
+		item.Description,
+		item.ID,
+		item.SaleID,
+	).Scan(&item.UpdatedAt)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return errMsg.ErrNotFound
+		case errMsgPg.IsForeignKeyViolation(err):
+			return errMsg.ErrDBInvalidForeignKey
+		default:
+			return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *itemSaleTx) DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	const query = `DELETE FROM sale_items WHERE id = $1;`
+
+	tag, err := tx.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrDelete, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errMsg.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *itemSaleTx) DeleteBySaleIDTx(ctx context.Context, tx pgx.Tx, saleID int64) error {
+	const query = `DELETE FROM sale_items WHERE sale_id = $1;`
+
+	if _, err := tx.Exec(ctx, query, saleID); err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrDelete, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,353 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewItemSaleTx(t *testing.T) {
+	result := NewItemSaleTx()
+
+	assert.NotNil(t, result)
+	_, ok := result.(*itemSaleTx)
+	assert.True(t, ok, "Expected result to be of type *itemSaleTx")
+}
+
+func TestItemSaleTx_CreateTx(t *testing.T) {
+	newItem := func() *models.SaleItem {
+		return &models.SaleItem{
+			SaleID:    1,
+			ProductID: 2,
+			Quantity:  3,
+			UnitPrice: money.New(10),
+			Discount:  money.New(1),
+			Subtotal:  money.New(29),
+		}
+	}
+
+	argsOf := func(i *models.SaleItem) []interface{} {
+		return []interface{}{
+			i.SaleID, i.ProductID, i.Quantity, i.UnitPrice,
+			i.Discount, i.Tax, i.Subtotal, i.Description,
+		}
+	}
+
+	t.Run("successfully create item within transaction", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+		item := newItem()
+
+		now := time.Now()
+		mockRow := &mockDb.MockRowWithIDArgs{Values: []interface{}{int64(5), now, now}}
+		mockTx.On("QueryRow", ctx, mock.Anything, argsOf(item)).Return(mockRow)
+
+		result, err := repo.CreateTx(ctx, mockTx, item)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), result.ID)
+		assert.Equal(t, now, result.CreatedAt)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrDBInvalidForeignKey on foreign key violation", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+		item := newItem()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, argsOf(item)).Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}})
+
+		result, err := repo.CreateTx(ctx, mockTx, item)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("return ErrCreate on generic database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+		item := newItem()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, argsOf(item)).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		result, err := repo.CreateTx(ctx, mockTx, item)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
+
+func TestItemSaleTx_GetByIDTx(t *testing.T) {
+	t.Run("successfully get item within transaction", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockRow := &mockDb.MockRowWithIDArgs{Values: []interface{}{
+			int64(5), int64(1), int64(2), 3, 10.0, 1.0, 0.0, 29.0, "item", now, now,
+		}}
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(5)}).Return(mockRow)
+
+		result, err := repo.GetByIDTx(ctx, mockTx, 5)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), result.ID)
+		assert.Equal(t, int64(1), result.SaleID)
+		assert.Equal(t, money.New(29), result.Subtotal)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when item does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(5)}).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		result, err := repo.GetByIDTx(ctx, mockTx, 5)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrGet on generic database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(5)}).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		result, err := repo.GetByIDTx(ctx, mockTx, 5)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestItemSaleTx_GetBySaleIDTx(t *testing.T) {
+	t.Run("successfully list items of sale within transaction", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockRows := &mockDb.MockRows{
+			Rows: []*mockDb.MockRow{
+				{Values: []any{int64(1), int64(10), int64(20), 5, 10.0, 1.0, 0.0, 49.0, "a", now, now}},
+				{Values: []any{int64(2), int64(10), int64(21), 3, 15.0, 0.0, 0.0, 45.0, "b", now, now}},
+			},
+		}
+		mockTx.On("Query", ctx, mock.Anything, []any{int64(10)}).Return(mockRows, nil)
+
+		items, err := repo.GetBySaleIDTx(ctx, mockTx, 10)
+
+		assert.NoError(t, err)
+		assert.Len(t, items, 2)
+		assert.Equal(t, int64(21), items[1].ProductID)
+		assert.Equal(t, 3, items[1].Quantity)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrGet when query fails", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+
+		mockTx.On("Query", ctx, mock.Anything, []any{int64(10)}).Return(&mockDb.MockRows{}, errors.New("db down"))
+
+		items, err := repo.GetBySaleIDTx(ctx, mockTx, 10)
+
+		assert.Nil(t, items)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrGet when scan fails", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{
+			Rows: []*mockDb.MockRow{{Err: errors.New("scan error")}},
+		}
+		mockTx.On("Query", ctx, mock.Anything, []any{int64(10)}).Return(mockRows, nil)
+
+		items, err := repo.GetBySaleIDTx(ctx, mockTx, 10)
+
+		assert.Nil(t, items)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrGet when rows iteration fails", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockRows := &mockDb.MockRows{
+			Rows:    []*mockDb.MockRow{{Values: []any{int64(1), int64(10), int64(20), 5, 10.0, 1.0, 0.0, 49.0, "a", now, now}}},
+			RowsErr: errors.New("rows error"),
+		}
+		mockTx.On("Query", ctx, mock.Anything, []any{int64(10)}).Return(mockRows, nil)
+
+		items, err := repo.GetBySaleIDTx(ctx, mockTx, 10)
+
+		assert.Nil(t, items)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestItemSaleTx_UpdateTx(t *testing.T) {
+	newItem := func() *models.SaleItem {
+		return &models.SaleItem{
+			ID:          5,
+			SaleID:      1,
+			ProductID:   2,
+			Quantity:    3,
+			UnitPrice:   money.New(10),
+			Discount:    money.New(1),
+			Subtotal:    money.New(29),
+			Description: "item",
+		}
+	}
+
+	argsOf := func(i *models.SaleItem) []interface{} {
+		return []interface{}{
+			i.ProductID, i.Quantity, i.UnitPrice, i.Discount, i.Tax, i.Subtotal, i.Description, i.ID, i.SaleID,
+		}
+	}
+
+	t.Run("successfully update item within transaction", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+		item := newItem()
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, argsOf(item)).Return(&mockDb.MockRow{Value: now})
+
+		err := repo.UpdateTx(ctx, mockTx, item)
+
+		assert.NoError(t, err)
+		assert.Equal(t, now, item.UpdatedAt)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when item does not belong to sale", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+		item := newItem()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, argsOf(item)).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.UpdateTx(ctx, mockTx, item)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrDBInvalidForeignKey on foreign key violation", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+		item := newItem()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, argsOf(item)).Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}})
+
+		err := repo.UpdateTx(ctx, mockTx, item)
+
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("return ErrUpdate on generic database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+		item := newItem()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, argsOf(item)).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		err := repo.UpdateTx(ctx, mockTx, item)
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
+
+func TestItemSaleTx_DeleteTx(t *testing.T) {
+	t.Run("successfully delete item within transaction", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(5)}).Return(pgconn.NewCommandTag("DELETE 1"), nil)
+
+		err := repo.DeleteTx(ctx, mockTx, 5)
+
+		assert.NoError(t, err)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when no row is deleted", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(5)}).Return(pgconn.NewCommandTag("DELETE 0"), nil)
+
+		err := repo.DeleteTx(ctx, mockTx, 5)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrDelete on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(5)}).Return(pgconn.CommandTag{}, errors.New("db down"))
+
+		err := repo.DeleteTx(ctx, mockTx, 5)
+
+		assert.ErrorIs(t, err, errMsg.ErrDelete)
+	})
+}
+
+func TestItemSaleTx_DeleteBySaleIDTx(t *testing.T) {
+	t.Run("successfully delete items by sale within transaction", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(1)}).Return(pgconn.NewCommandTag("DELETE 3"), nil)
+
+		err := repo.DeleteBySaleIDTx(ctx, mockTx, 1)
+
+		assert.NoError(t, err)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrDelete on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &itemSaleTx{}
+		ctx := context.Background()
+
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(1)}).Return(pgconn.CommandTag{}, errors.New("db down"))
+
+		err := repo.DeleteBySaleIDTx(ctx, mockTx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrDelete)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/assert"
@@ -26,10 +27,10 @@ func TestItemSale_Create(t *testing.T) {
 			SaleID:      int64(10),
 			ProductID:   int64(20),
 			Quantity:    5,
-			UnitPrice:   10.5,
-			Discount:    1.0,
-			Tax:         0.5,
-			Subtotal:    50.0,
+			UnitPrice:   money.MustParse("10.50"),
+			Discount:    money.New(1),
+			Tax:         money.MustParse("0.50"),
+			Subtotal:    money.New(50),
 			Description: "desc test",
 		}
 
@@ -62,10 +63,10 @@ func TestItemSale_Create(t *testing.T) {
 		assert.Equal(t, int64(10), result.SaleID)
 		assert.Equal(t, int64(20), result.ProductID)
 		assert.Equal(t, 5, result.Quantity)
-		assert.Equal(t, 10.5, result.UnitPrice)
-		assert.Equal(t, 1.0, result.Discount)
-		assert.Equal(t, 0.5, result.Tax)
-		assert.Equal(t, 50.0, result.Subtotal)
+		assert.Equal(t, money.MustParse("10.50"), result.UnitPrice)
+		assert.Equal(t, money.New(1), result.Discount)
+		assert.Equal(t, money.MustParse("0.50"), result.Tax)
+		assert.Equal(t, money.New(50), result.Subtotal)
 		assert.Equal(t, "desc test", result.Description)
 		assert.Equal(t, expectedTime, result.CreatedAt)
 		assert.Equal(t, expectedTime, result.UpdatedAt)
@@ -82,10 +83,10 @@ func TestItemSale_Create(t *testing.T) {
 			SaleID:      int64(10),
 			ProductID:   int64(20),
 			Quantity:    5,
-			UnitPrice:   10.5,
-			Discount:    1.0,
-			Tax:         0.5,
-			Subtotal:    50.0,
+			UnitPrice:   money.MustParse("10.50"),
+			Discount:    money.New(1),
+			Tax:         money.MustParse("0.50"),
+			Subtotal:    money.New(50),
 			Description: "desc test",
 		}
 
@@ -117,10 +118,10 @@ func TestItemSale_Create(t *testing.T) {
 			SaleID:      int64(10),
 			ProductID:   int64(20),
 			Quantity:    5,
-			UnitPrice:   10.5,
-			Discount:    1.0,
-			Tax:         0.5,
-			Subtotal:    50.0,
+			UnitPrice:   money.MustParse("10.50"),
+			Discount:    money.New(1),
+			Tax:         money.MustParse("0.50"),
+			Subtotal:    money.New(50),
 			Description: "desc test",
 		}
 
@@ -154,10 +155,10 @@ func TestItemSale_Update(t *testing.T) {
 			SaleID:      int64(10),
 			ProductID:   int64(20),
 			Quantity:    5,
-			UnitPrice:   10.5,
-			Discount:    1.0,
-			Tax:         0.5,
-			Subtotal:    50.0,
+			UnitPrice:   money.MustParse("10.50"),
+			Discount:    money.New(1),
+			Tax:         money.MustParse("0.50"),
+			Subtotal:    money.New(50),
 			Description: "desc test updated",
 		}
 
@@ -199,10 +200,10 @@ func TestItemSale_Update(t *testing.T) {
 			SaleID:      int64(10),
 			ProductID:   int64(20),
 			Quantity:    5,
-			UnitPrice:   10.5,
-			Discount:    1.0,
-			Tax:         0.5,
-			Subtotal:    50.0,
+			UnitPrice:   money.MustParse("10.50"),
+			Discount:    money.New(1),
+			Tax:         money.MustParse("0.50"),
+			Subtotal:    money.New(50),
 			Description: "desc test",
 		}
 
@@ -228,10 +229,10 @@ func TestItemSale_Update(t *testing.T) {
 			SaleID:      int64(999), // SaleID que não existe
 			ProductID:   int64(20),
 			Quantity:    5,
-			UnitPrice:   10.5,
-			Discount:    1.0,
-			Tax:         0.5,
-			Subtotal:    50.0,
+			UnitPrice:   money.MustParse("10.50"),
+			Discount:    money.New(1),
+			Tax:         money.MustParse("0.50"),
+			Subtotal:    money.New(50),
 			Description: "desc test",
 		}
 
@@ -262,10 +263,10 @@ func TestItemSale_Update(t *testing.T) {
 			SaleID:      int64(10),
 			ProductID:   int64(20),
 			Quantity:    5,
-			UnitPrice:   10.5,
-			Discount:    1.0,
-			Tax:         0.5,
-			Subtotal:    50.0,
+			UnitPrice:   money.MustParse("10.50"),
+			Discount:    money.New(1),
+			Tax:         money.MustParse("0.50"),
+			Subtotal:    money.New(50),
 			Description: "desc test",
 		}
 
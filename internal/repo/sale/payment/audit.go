@@ -0,0 +1,43 @@
+package repo
+
+import (
+	"context"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	modelAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/jackc/pgx/v5"
+)
+
+const auditEntity = "sale_payment"
+
+// auditedPaymentTx registra cada pagamento na transação que o grava: se o
+// registro falhar, a escrita é desfeita.
+type auditedPaymentTx struct {
+	iface.SalePaymentTx
+	rec audit.Recorder
+}
+
+func WithAuditTx(inner iface.SalePaymentTx, rec audit.Recorder) iface.SalePaymentTx {
+	return &auditedPaymentTx{SalePaymentTx: inner, rec: rec}
+}
+
+func (r *auditedPaymentTx) CreateTx(ctx context.Context, tx pgx.Tx, payment *models.SalePayment) (*models.SalePayment, error) {
+	created, err := r.SalePaymentTx.CreateTx(ctx, tx, payment)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.rec.RecordTx(ctx, tx, audit.Entry{
+		EntityType: auditEntity,
+		EntityID:   created.ID,
+		Action:     modelAudit.ActionCreate,
+		After:      created,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
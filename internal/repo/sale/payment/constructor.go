@@ -0,0 +1,20 @@
+package repo
+
+import (
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+)
+
+type salePaymentRepo struct {
+	db repo.DBExecutor
+}
+
+func NewSalePayment(db repo.DBExecutor) iface.SalePaymentReader {
+	return &salePaymentRepo{db: db}
+}
+
+type salePaymentTx struct{}
+
+func NewSalePaymentTx() iface.SalePaymentTx {
+	return &salePaymentTx{}
+}
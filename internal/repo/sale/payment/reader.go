@@ -0,0 +1,98 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+const selectPayments = `
+	SELECT id, sale_id, method, amount, installments,
+		COALESCE(authorization_code, ''), change_given, created_at,
+		received_by, cash_session_id
+	FROM sale_payments
+	WHERE sale_id = $1
+	ORDER BY id ASC;
+`
+
+func (r *salePaymentRepo) GetBySaleID(ctx context.Context, saleID int64) ([]*models.SalePayment, error) {
+	rows, err := r.db.Query(ctx, selectPayments, saleID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	return scanPayments(rows)
+}
+
+// TotalsByMethod soma os pagamentos por forma no período [from, to) pela data
+// da venda. Vendas canceladas ou devolvidas ficam de fora.
+func (r *salePaymentRepo) TotalsByMethod(ctx context.Context, from, to time.Time) ([]*models.MethodTotal, error) {
+	const query = `
+		SELECT
+			p.method,
+			COUNT(DISTINCT p.sale_id),
+			COALESCE(SUM(p.amount - p.change_given), 0),
+			COALESCE(SUM(p.change_given), 0)
+		FROM sale_payments p
+		JOIN sales s ON s.id = p.sale_id
+		WHERE s.status NOT IN ('canceled', 'returned')
+			AND s.sale_date >= $1
+			AND s.sale_date < $2
+		GROUP BY p.method
+		ORDER BY p.method ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	totals := make([]*models.MethodTotal, 0)
+	for rows.Next() {
+		var t models.MethodTotal
+		if err := rows.Scan(&t.Method, &t.Sales, &t.Amount, &t.ChangeGiven); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		totals = append(totals, &t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return totals, nil
+}
+
+func scanPayments(rows pgx.Rows) ([]*models.SalePayment, error) {
+	payments := make([]*models.SalePayment, 0)
+	for rows.Next() {
+		var p models.SalePayment
+		if err := rows.Scan(
+			&p.ID,
+			&p.SaleID,
+			&p.Method,
+			&p.Amount,
+			&p.Installments,
+			&p.AuthorizationCode,
+			&p.ChangeGiven,
+			&p.CreatedAt,
+			&p.ReceivedBy,
+			&p.CashSessionID,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		payments = append(payments, &p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return payments, nil
+}
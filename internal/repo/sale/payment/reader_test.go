@@ -0,0 +1,129 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewSalePayment(t *testing.T) {
+	result := NewSalePayment(nil)
+
+	assert.NotNil(t, result)
+	_, ok := result.(*salePaymentRepo)
+	assert.True(t, ok, "Expected result to be of type *salePaymentRepo")
+}
+
+func TestSalePayment_GetBySaleID(t *testing.T) {
+	t.Run("successfully list payments of the sale", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &salePaymentRepo{db: mockDB}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockRows := &mockDb.MockRows{
+			Rows: []*mockDb.MockRow{
+				{Values: []any{int64(1), int64(10), "card", money.New(50), 3, "AUT123", money.Zero, now}},
+				{Values: []any{int64(2), int64(10), "cash", money.New(40), 1, "", money.MustParse("2.70"), now}},
+			},
+		}
+		mockDB.On("Query", ctx, mock.Anything, []any{int64(10)}).Return(mockRows, nil)
+
+		result, err := repo.GetBySaleID(ctx, 10)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, 3, result[0].Installments)
+		assert.Equal(t, "AUT123", result[0].AuthorizationCode)
+		assert.Equal(t, money.MustParse("2.70"), result[1].ChangeGiven)
+	})
+
+	t.Run("return ErrGet when query fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &salePaymentRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("Query", ctx, mock.Anything, []any{int64(10)}).Return(&mockDb.MockRows{}, errors.New("db down"))
+
+		result, err := repo.GetBySaleID(ctx, 10)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrScan when scan fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &salePaymentRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan error")}}}
+		mockDB.On("Query", ctx, mock.Anything, []any{int64(10)}).Return(mockRows, nil)
+
+		result, err := repo.GetBySaleID(ctx, 10)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+}
+
+func TestSalePayment_TotalsByMethod(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	t.Run("successfully sum payments by method", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &salePaymentRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{
+			Rows: []*mockDb.MockRow{
+				{Values: []any{"cash", 4, money.MustParse("310.50"), money.MustParse("19.50")}},
+				{Values: []any{"pix", 2, money.New(90), money.Zero}},
+			},
+		}
+		mockDB.On("Query", ctx, mock.Anything, []any{from, to}).Return(mockRows, nil)
+
+		result, err := repo.TotalsByMethod(ctx, from, to)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "cash", result[0].Method)
+		assert.Equal(t, 4, result[0].Sales)
+		assert.Equal(t, money.MustParse("310.50"), result[0].Amount)
+		assert.Equal(t, money.MustParse("19.50"), result[0].ChangeGiven)
+	})
+
+	t.Run("return ErrGet when query fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &salePaymentRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("Query", ctx, mock.Anything, []any{from, to}).Return(&mockDb.MockRows{}, errors.New("db down"))
+
+		result, err := repo.TotalsByMethod(ctx, from, to)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrScan when scan fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &salePaymentRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan error")}}}
+		mockDB.On("Query", ctx, mock.Anything, []any{from, to}).Return(mockRows, nil)
+
+		result, err := repo.TotalsByMethod(ctx, from, to)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+}
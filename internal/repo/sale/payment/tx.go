@@ -0,0 +1,62 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+// CreateTx grava o pagamento no caixa aberto de payment.ReceivedBy. Sem caixa
+// aberto nada é inserido e o pagamento é recusado com ErrCashSessionNotOpen.
+func (r *salePaymentTx) CreateTx(ctx context.Context, tx pgx.Tx, payment *models.SalePayment) (*models.SalePayment, error) {
+	const query = `
+		INSERT INTO sale_payments (
+			sale_id, method, amount, installments, authorization_code, change_given,
+			received_by, cash_session_id, created_at
+		)
+		SELECT $1, $2, $3, $4, NULLIF($5, ''), $6, $7, cs.id, NOW()
+		FROM cash_sessions cs
+		WHERE cs.user_id = $7
+		  AND cs.status = 'open'
+		FOR SHARE OF cs
+		RETURNING id, cash_session_id, created_at;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		payment.SaleID,
+		payment.Method,
+		payment.Amount,
+		payment.Installments,
+		payment.AuthorizationCode,
+		payment.ChangeGiven,
+		payment.ReceivedBy,
+	).Scan(&payment.ID, &payment.CashSessionID, &payment.CreatedAt)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, errMsg.ErrCashSessionNotOpen
+		case errMsgPg.IsForeignKeyViolation(err):
+			return nil, errMsg.ErrDBInvalidForeignKey
+		case errMsgPg.IsCheckViolation(err):
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	return payment, nil
+}
+
+func (r *salePaymentTx) GetBySaleIDTx(ctx context.Context, tx pgx.Tx, saleID int64) ([]*models.SalePayment, error) {
+	rows, err := tx.Query(ctx, selectPayments, saleID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	return scanPayments(rows)
+}
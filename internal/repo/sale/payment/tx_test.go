@@ -0,0 +1,144 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewSalePaymentTx(t *testing.T) {
+	result := NewSalePaymentTx()
+
+	assert.NotNil(t, result)
+	_, ok := result.(*salePaymentTx)
+	assert.True(t, ok, "Expected result to be of type *salePaymentTx")
+}
+
+func TestSalePaymentTx_CreateTx(t *testing.T) {
+	cashier := int64(3)
+	newPayment := func() *models.SalePayment {
+		return &models.SalePayment{SaleID: 10, Method: "cash", Amount: money.New(50), Installments: 1, ChangeGiven: money.MustParse("2.70"), ReceivedBy: &cashier}
+	}
+	args := []interface{}{int64(10), "cash", money.New(50), 1, "", money.MustParse("2.70"), &cashier}
+
+	t.Run("successfully create payment in the cashier's open session", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &salePaymentTx{}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "FROM cash_sessions cs") && strings.Contains(q, "cs.user_id = $7")
+		}), args).
+			Return(&mockDb.MockRowWithIDArgs{Values: []interface{}{int64(7), int64(4), now}})
+
+		result, err := repo.CreateTx(ctx, mockTx, newPayment())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), result.ID)
+		assert.Equal(t, int64(4), *result.CashSessionID)
+		assert.Equal(t, now, result.CreatedAt)
+	})
+
+	t.Run("return ErrCashSessionNotOpen when the cashier has no open session", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &salePaymentTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).
+			Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		result, err := repo.CreateTx(ctx, mockTx, newPayment())
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCashSessionNotOpen)
+	})
+
+	t.Run("return ErrDBInvalidForeignKey when sale does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &salePaymentTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}})
+
+		result, err := repo.CreateTx(ctx, mockTx, newPayment())
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("return ErrInvalidData on check violation", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &salePaymentTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23514"}})
+
+		result, err := repo.CreateTx(ctx, mockTx, newPayment())
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("return ErrCreate on unexpected error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &salePaymentTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, args).
+			Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		result, err := repo.CreateTx(ctx, mockTx, newPayment())
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
+
+func TestSalePaymentTx_GetBySaleIDTx(t *testing.T) {
+	t.Run("successfully list payments inside the transaction", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &salePaymentTx{}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockRows := &mockDb.MockRows{
+			Rows: []*mockDb.MockRow{
+				{Values: []any{int64(1), int64(10), "pix", money.New(30), 1, "", money.Zero, now}},
+			},
+		}
+		mockTx.On("Query", ctx, mock.Anything, []any{int64(10)}).Return(mockRows, nil)
+
+		result, err := repo.GetBySaleIDTx(ctx, mockTx, 10)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, money.New(30), result[0].Amount)
+	})
+
+	t.Run("return ErrGet when query fails", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &salePaymentTx{}
+		ctx := context.Background()
+
+		mockTx.On("Query", ctx, mock.Anything, []any{int64(10)}).Return(&mockDb.MockRows{}, errors.New("db down"))
+
+		result, err := repo.GetBySaleIDTx(ctx, mockTx, 10)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
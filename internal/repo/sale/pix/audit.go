@@ -0,0 +1,54 @@
+package repo
+
+import (
+	"context"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/pix"
+	"github.com/jackc/pgx/v5"
+)
+
+const auditEntity = "pix_charge"
+
+// auditedPixTx registra a confirmação da cobrança na transação que a grava:
+// se o registro falhar, a cobrança continua pendente. Avisos repetidos, que
+// não alteram a cobrança, não são registrados.
+type auditedPixTx struct {
+	iface.PixChargeTx
+	rec audit.Recorder
+}
+
+func WithAuditTx(inner iface.PixChargeTx, rec audit.Recorder) iface.PixChargeTx {
+	return &auditedPixTx{PixChargeTx: inner, rec: rec}
+}
+
+func (r *auditedPixTx) ConfirmTx(ctx context.Context, tx pgx.Tx, confirmation pix.Confirmation) (*models.PixCharge, error) {
+	before, err := r.PixChargeTx.GetByTxIDTx(ctx, tx, confirmation.TxID)
+	if err != nil {
+		before = nil
+	}
+
+	charge, err := r.PixChargeTx.ConfirmTx(ctx, tx, confirmation)
+	if err != nil {
+		return nil, err
+	}
+
+	if before != nil && before.IsConfirmed() {
+		return charge, nil
+	}
+
+	err = r.rec.RecordTx(ctx, tx, audit.Entry{
+		EntityType: auditEntity,
+		EntityID:   charge.ID,
+		Action:     "confirm",
+		Before:     before,
+		After:      charge,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return charge, nil
+}
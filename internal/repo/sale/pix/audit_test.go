@@ -0,0 +1,80 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockAudit "github.com/WagaoCarvalho/backend_store_go/infra/mock/audit"
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	mockSale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/pix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditedPixTx(t *testing.T) {
+	ctx := context.Background()
+	tx := new(mockDb.MockTx)
+	confirmation := pix.Confirmation{TxID: "TX1", EndToEndID: "E123"}
+
+	t.Run("confirmation is recorded in the transaction", func(t *testing.T) {
+		inner := new(mockSale.MockPixChargeTx)
+		rec := new(mockAudit.MockRecorder)
+		before := &models.PixCharge{ID: 1, TxID: "TX1", Status: models.StatusPending}
+		after := &models.PixCharge{ID: 1, TxID: "TX1", Status: models.StatusConfirmed, EndToEndID: "E123"}
+
+		inner.On("GetByTxIDTx", ctx, tx, "TX1").Return(before, nil).Once()
+		inner.On("ConfirmTx", ctx, tx, confirmation).Return(after, nil).Once()
+		rec.On("RecordTx", ctx, tx, audit.Entry{EntityType: "pix_charge", EntityID: 1, Action: "confirm", Before: before, After: after}).Return(nil).Once()
+
+		charge, err := WithAuditTx(inner, rec).ConfirmTx(ctx, tx, confirmation)
+
+		assert.NoError(t, err)
+		assert.Same(t, after, charge)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("repeated notice is not recorded again", func(t *testing.T) {
+		inner := new(mockSale.MockPixChargeTx)
+		rec := new(mockAudit.MockRecorder)
+		confirmed := &models.PixCharge{ID: 1, Status: models.StatusConfirmed, EndToEndID: "E123"}
+
+		inner.On("GetByTxIDTx", ctx, tx, "TX1").Return(confirmed, nil).Once()
+		inner.On("ConfirmTx", ctx, tx, confirmation).Return(confirmed, nil).Once()
+
+		_, err := WithAuditTx(inner, rec).ConfirmTx(ctx, tx, confirmation)
+
+		assert.NoError(t, err)
+		rec.AssertNotCalled(t, "RecordTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("audit failure fails the confirmation", func(t *testing.T) {
+		inner := new(mockSale.MockPixChargeTx)
+		rec := new(mockAudit.MockRecorder)
+
+		inner.On("GetByTxIDTx", ctx, tx, "TX1").Return(&models.PixCharge{ID: 1, Status: models.StatusPending}, nil).Once()
+		inner.On("ConfirmTx", ctx, tx, confirmation).Return(&models.PixCharge{ID: 1, Status: models.StatusConfirmed}, nil).Once()
+		rec.On("RecordTx", ctx, tx, mock.Anything).Return(errors.New("audit fail")).Once()
+
+		charge, err := WithAuditTx(inner, rec).ConfirmTx(ctx, tx, confirmation)
+
+		assert.Nil(t, charge)
+		assert.EqualError(t, err, "audit fail")
+	})
+
+	t.Run("failed confirmation is not recorded", func(t *testing.T) {
+		inner := new(mockSale.MockPixChargeTx)
+		rec := new(mockAudit.MockRecorder)
+
+		inner.On("GetByTxIDTx", ctx, tx, "TX1").Return(nil, errors.New("not found")).Once()
+		inner.On("ConfirmTx", ctx, tx, confirmation).Return(nil, errors.New("db fail")).Once()
+
+		_, err := WithAuditTx(inner, rec).ConfirmTx(ctx, tx, confirmation)
+
+		assert.EqualError(t, err, "db fail")
+		rec.AssertNotCalled(t, "RecordTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
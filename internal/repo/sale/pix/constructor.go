@@ -0,0 +1,13 @@
+package repo
+
+import (
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+)
+
+type pixChargeRepo struct {
+	db repo.DBExecutor
+}
+
+func NewPixCharge(db repo.DBExecutor) PixCharge {
+	return &pixChargeRepo{db: db}
+}
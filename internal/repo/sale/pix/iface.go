@@ -0,0 +1,8 @@
+package repo
+
+import iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+
+type PixCharge interface {
+	iface.PixChargeReader
+	iface.PixChargeWriter
+}
@@ -0,0 +1,89 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+const chargeColumns = `
+	id,
+	sale_id,
+	txid,
+	amount,
+	status,
+	payload,
+	COALESCE(location, ''),
+	COALESCE(end_to_end_id, ''),
+	paid_amount,
+	created_by,
+	confirmed_at,
+	created_at,
+	updated_at
+`
+
+func scanCharge(row pgx.Row, c *models.PixCharge) error {
+	return row.Scan(
+		&c.ID,
+		&c.SaleID,
+		&c.TxID,
+		&c.Amount,
+		&c.Status,
+		&c.Payload,
+		&c.Location,
+		&c.EndToEndID,
+		&c.PaidAmount,
+		&c.CreatedBy,
+		&c.ConfirmedAt,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	)
+}
+
+func (r *pixChargeRepo) GetBySaleID(ctx context.Context, saleID int64) ([]*models.PixCharge, error) {
+	query := `SELECT ` + chargeColumns + ` FROM pix_charges WHERE sale_id = $1 ORDER BY id ASC;`
+
+	rows, err := r.db.Query(ctx, query, saleID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	charges := make([]*models.PixCharge, 0)
+	for rows.Next() {
+		var c models.PixCharge
+		if err := scanCharge(rows, &c); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		charges = append(charges, &c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return charges, nil
+}
+
+func (r *pixChargeRepo) GetByTxID(ctx context.Context, txID string) (*models.PixCharge, error) {
+	return getByTxID(ctx, r.db, txID)
+}
+
+func getByTxID(ctx context.Context, db repo.DBExecutor, txID string) (*models.PixCharge, error) {
+	query := `SELECT ` + chargeColumns + ` FROM pix_charges WHERE txid = $1;`
+
+	var c models.PixCharge
+	if err := scanCharge(db.QueryRow(ctx, query, txID), &c); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return &c, nil
+}
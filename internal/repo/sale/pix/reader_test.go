@@ -0,0 +1,130 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func containsAll(parts ...string) any {
+	return mock.MatchedBy(func(q string) bool {
+		for _, p := range parts {
+			if !strings.Contains(q, p) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// chargeRow segue a ordem de chargeColumns.
+func chargeRow(id int64, status, endToEndID string, now time.Time) *mockDb.MockRow {
+	return &mockDb.MockRow{Values: []any{
+		id, int64(10), "TX1", money.New(50), status, "000201...", "", endToEndID, nil, int64(5), nil, now, now,
+	}}
+}
+
+func TestNewPixCharge(t *testing.T) {
+	result := NewPixCharge(nil)
+
+	assert.NotNil(t, result)
+	_, ok := result.(*pixChargeRepo)
+	assert.True(t, ok, "Expected result to be of type *pixChargeRepo")
+}
+
+func TestPixChargeRepo_GetBySaleID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("lista as cobranças da venda", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &pixChargeRepo{db: mockDB}
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			chargeRow(1, models.StatusPending, "", now),
+			chargeRow(2, models.StatusConfirmed, "E123", now),
+		}}
+		mockDB.On("Query", ctx, containsAll("FROM pix_charges", "sale_id = $1"), []any{int64(10)}).Return(mockRows, nil)
+
+		charges, err := repo.GetBySaleID(ctx, 10)
+
+		assert.NoError(t, err)
+		assert.Len(t, charges, 2)
+		assert.Equal(t, money.New(50), charges[0].Amount)
+		assert.Equal(t, "E123", charges[1].EndToEndID)
+		assert.Equal(t, int64(5), *charges[0].CreatedBy)
+	})
+
+	t.Run("erro na consulta", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &pixChargeRepo{db: mockDB}
+
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRows{}, errors.New("db down"))
+
+		_, err := repo.GetBySaleID(ctx, 10)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("erro no scan", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &pixChargeRepo{db: mockDB}
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan error")}}}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+
+		_, err := repo.GetBySaleID(ctx, 10)
+
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+}
+
+func TestPixChargeRepo_GetByTxID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("retorna a cobrança", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &pixChargeRepo{db: mockDB}
+
+		mockDB.On("QueryRow", ctx, containsAll("txid = $1"), []any{"TX1"}).
+			Return(chargeRow(1, models.StatusPending, "", time.Now()))
+
+		charge, err := repo.GetByTxID(ctx, "TX1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "TX1", charge.TxID)
+		assert.False(t, charge.IsConfirmed())
+	})
+
+	t.Run("cobrança inexistente", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &pixChargeRepo{db: mockDB}
+
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		_, err := repo.GetByTxID(ctx, "TX1")
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &pixChargeRepo{db: mockDB}
+
+		mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.GetByTxID(ctx, "TX1")
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
@@ -0,0 +1,92 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/pix"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+type pixChargeTx struct {
+	db repo.DBTransactor
+}
+
+func NewPixChargeTx(db repo.DBTransactor) iface.PixChargeTx {
+	return &pixChargeTx{db: db}
+}
+
+func (r *pixChargeTx) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return r.db.BeginTx(ctx, pgx.TxOptions{})
+}
+
+func (r *pixChargeTx) GetByTxIDTx(ctx context.Context, tx pgx.Tx, txID string) (*models.PixCharge, error) {
+	return getByTxID(ctx, tx, txID)
+}
+
+// ConfirmTx marca como paga a cobrança pendente do txid. Um aviso repetido do
+// mesmo Pix devolve a cobrança já confirmada sem alterá-la; outro Pix para
+// uma cobrança já paga é recusado com ErrPixAlreadyConfirmed.
+func (r *pixChargeTx) ConfirmTx(ctx context.Context, tx pgx.Tx, confirmation pix.Confirmation) (*models.PixCharge, error) {
+	query := `
+		UPDATE pix_charges
+		SET status = 'confirmed',
+			end_to_end_id = $2,
+			paid_amount = $3,
+			confirmed_at = $4,
+			updated_at = NOW()
+		WHERE txid = $1
+		  AND status = 'pending'
+		RETURNING ` + chargeColumns + `;`
+
+	var c models.PixCharge
+	err := scanCharge(tx.QueryRow(ctx, query,
+		confirmation.TxID,
+		confirmation.EndToEndID,
+		confirmation.Amount,
+		confirmation.PaidAt,
+	), &c)
+	if err == nil {
+		return &c, nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		if errMsgPg.IsDuplicateKey(err) {
+			return nil, fmt.Errorf("%w: endToEndId %s", errMsg.ErrPixAlreadyConfirmed, confirmation.EndToEndID)
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	existing, err := getByTxID(ctx, tx, confirmation.TxID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.EndToEndID != confirmation.EndToEndID {
+		return nil, fmt.Errorf("%w: txid %s", errMsg.ErrPixAlreadyConfirmed, confirmation.TxID)
+	}
+
+	return existing, nil
+}
+
+// ConfirmedAmountTx soma o valor pago das cobranças Pix confirmadas da venda.
+func (r *pixChargeTx) ConfirmedAmountTx(ctx context.Context, tx pgx.Tx, saleID int64) (money.Money, error) {
+	const query = `
+		SELECT COALESCE(SUM(paid_amount), 0)
+		FROM pix_charges
+		WHERE sale_id = $1 AND status = 'confirmed';
+	`
+
+	var amount money.Money
+	if err := tx.QueryRow(ctx, query, saleID).Scan(&amount); err != nil {
+		return money.Zero, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,147 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/pix"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewPixChargeTx(t *testing.T) {
+	result := NewPixChargeTx(new(mockDb.MockDBTransactor))
+
+	assert.NotNil(t, result)
+	_, ok := result.(*pixChargeTx)
+	assert.True(t, ok, "Expected result to be of type *pixChargeTx")
+}
+
+func TestPixChargeTx_GetByTxIDTx(t *testing.T) {
+	ctx := context.Background()
+	mockTx := new(mockDb.MockTx)
+	now := time.Now()
+
+	mockTx.On("QueryRow", ctx, containsAll("SELECT", "WHERE txid = $1"), []any{"TX1"}).
+		Return(chargeRow(1, models.StatusPending, "", now))
+
+	charge, err := (&pixChargeTx{}).GetByTxIDTx(ctx, mockTx, "TX1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), charge.ID)
+	mockTx.AssertExpectations(t)
+}
+
+func TestPixChargeTx_ConfirmTx(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	confirmation := pix.Confirmation{TxID: "TX1", EndToEndID: "E123", Amount: money.New(50), PaidAt: now}
+
+	t.Run("confirma a cobrança pendente", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &pixChargeTx{}
+
+		mockTx.On("QueryRow", ctx, containsAll("UPDATE pix_charges", "status = 'pending'"),
+			[]any{"TX1", "E123", money.New(50), now}).
+			Return(chargeRow(1, models.StatusConfirmed, "E123", now))
+
+		charge, err := repo.ConfirmTx(ctx, mockTx, confirmation)
+
+		assert.NoError(t, err)
+		assert.True(t, charge.IsConfirmed())
+	})
+
+	t.Run("aviso repetido devolve a cobrança já confirmada", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &pixChargeTx{}
+
+		mockTx.On("QueryRow", ctx, containsAll("UPDATE pix_charges"), mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+		mockTx.On("QueryRow", ctx, containsAll("SELECT"), []any{"TX1"}).Return(chargeRow(1, models.StatusConfirmed, "E123", now))
+
+		charge, err := repo.ConfirmTx(ctx, mockTx, confirmation)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "E123", charge.EndToEndID)
+	})
+
+	t.Run("outro pix para cobrança já paga", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &pixChargeTx{}
+
+		mockTx.On("QueryRow", ctx, containsAll("UPDATE pix_charges"), mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+		mockTx.On("QueryRow", ctx, containsAll("SELECT"), []any{"TX1"}).Return(chargeRow(1, models.StatusConfirmed, "E999", now))
+
+		_, err := repo.ConfirmTx(ctx, mockTx, confirmation)
+
+		assert.ErrorIs(t, err, errMsg.ErrPixAlreadyConfirmed)
+	})
+
+	t.Run("txid desconhecido", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &pixChargeTx{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		_, err := repo.ConfirmTx(ctx, mockTx, confirmation)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("endToEndId já usado em outra cobrança", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &pixChargeTx{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).
+			Return(&mockDb.MockRow{Err: errMsgPg.NewUniqueViolation("pix_charges_end_to_end_id_key")})
+
+		_, err := repo.ConfirmTx(ctx, mockTx, confirmation)
+
+		assert.ErrorIs(t, err, errMsg.ErrPixAlreadyConfirmed)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &pixChargeTx{}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := repo.ConfirmTx(ctx, mockTx, confirmation)
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
+
+func TestPixChargeTx_ConfirmedAmountTx(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("soma as cobranças confirmadas", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+
+		mockTx.On("QueryRow", ctx, containsAll("SUM(paid_amount)", "status = 'confirmed'"), []any{int64(10)}).
+			Return(&mockDb.MockRow{Values: []any{money.New(80)}})
+
+		amount, err := (&pixChargeTx{}).ConfirmedAmountTx(ctx, mockTx, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, money.New(80), amount)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("erro no banco", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		_, err := (&pixChargeTx{}).ConfirmedAmountTx(ctx, mockTx, 10)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
@@ -0,0 +1,41 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (r *pixChargeRepo) Create(ctx context.Context, charge *models.PixCharge) (*models.PixCharge, error) {
+	const query = `
+		INSERT INTO pix_charges (
+			sale_id, txid, amount, status, payload, location, created_by, created_at, updated_at
+		) VALUES ($1, $2, $3, 'pending', $4, NULLIF($5, ''), $6, NOW(), NOW())
+		RETURNING id, status, created_at, updated_at;
+	`
+
+	err := r.db.QueryRow(ctx, query,
+		charge.SaleID,
+		charge.TxID,
+		charge.Amount,
+		charge.Payload,
+		charge.Location,
+		charge.CreatedBy,
+	).Scan(&charge.ID, &charge.Status, &charge.CreatedAt, &charge.UpdatedAt)
+	if err != nil {
+		switch {
+		case errMsgPg.IsForeignKeyViolation(err):
+			return nil, errMsg.ErrDBInvalidForeignKey
+		case errMsgPg.IsDuplicateKey(err):
+			return nil, fmt.Errorf("%w: txid %s", errMsg.ErrDuplicate, charge.TxID)
+		case errMsgPg.IsCheckViolation(err):
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	return charge, nil
+}
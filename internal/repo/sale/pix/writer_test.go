@@ -0,0 +1,59 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPixChargeRepo_Create(t *testing.T) {
+	ctx := context.Background()
+	charge := func() *models.PixCharge {
+		return &models.PixCharge{SaleID: 10, TxID: "TX1", Amount: money.New(50), Payload: "000201..."}
+	}
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &pixChargeRepo{db: mockDB}
+		now := time.Now()
+
+		mockDB.On("QueryRow", ctx, containsAll("INSERT INTO pix_charges"), mock.Anything).
+			Return(&mockDb.MockRow{Values: []any{int64(1), models.StatusPending, now, now}})
+
+		created, err := repo.Create(ctx, charge())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), created.ID)
+		assert.Equal(t, models.StatusPending, created.Status)
+	})
+
+	for name, tc := range map[string]struct {
+		dbErr error
+		want  error
+	}{
+		"venda inexistente": {errMsgPg.NewForeignKeyViolation("pix_charges_sale_id_fkey"), errMsg.ErrDBInvalidForeignKey},
+		"txid repetido":     {errMsgPg.NewUniqueViolation("pix_charges_txid_key"), errMsg.ErrDuplicate},
+		"check violado":     {errMsgPg.NewCheckViolation("pix_charges_amount_check"), errMsg.ErrInvalidData},
+		"erro no banco":     {errors.New("db down"), errMsg.ErrCreate},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockDB := new(mockDb.MockDatabase)
+			repo := &pixChargeRepo{db: mockDB}
+
+			mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: tc.dbErr})
+
+			_, err := repo.Create(ctx, charge())
+
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package repo
+
+import (
+	"context"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	modelAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/jackc/pgx/v5"
+)
+
+const auditEntity = "sale"
+
+// statusActions nomeia a ação registrada para cada status de destino.
+var statusActions = map[string]string{
+	"active":    "activate",
+	"canceled":  "cancel",
+	"completed": "complete",
+	"returned":  "returned",
+}
+
+// auditedSale registra na trilha de auditoria cada escrita feita pelo
+// repositório. As mudanças de status passam pela transação e são
+// registradas por auditedSaleTx.
+type auditedSale struct {
+	SaleRepo
+	rec audit.Recorder
+}
+
+func WithAudit(inner SaleRepo, rec audit.Recorder) SaleRepo {
+	return &auditedSale{SaleRepo: inner, rec: rec}
+}
+
+func (r *auditedSale) load(ctx context.Context, id int64) (any, error) {
+	return r.SaleRepo.GetByID(ctx, id)
+}
+
+func (r *auditedSale) track(ctx context.Context, id int64, action string, write func() error) error {
+	return audit.Track(ctx, r.rec, auditEntity, id, action, r.load, write)
+}
+
+func (r *auditedSale) Create(ctx context.Context, sale *models.Sale) (*models.Sale, error) {
+	created, err := r.SaleRepo.Create(ctx, sale)
+	if err != nil {
+		return nil, err
+	}
+
+	r.rec.Record(ctx, audit.Entry{
+		EntityType: auditEntity,
+		EntityID:   created.ID,
+		Action:     modelAudit.ActionCreate,
+		After:      created,
+	})
+
+	return created, nil
+}
+
+func (r *auditedSale) Update(ctx context.Context, sale *models.Sale) error {
+	return r.track(ctx, sale.ID, modelAudit.ActionUpdate, func() error {
+		return r.SaleRepo.Update(ctx, sale)
+	})
+}
+
+func (r *auditedSale) Delete(ctx context.Context, id int64) error {
+	return r.track(ctx, id, modelAudit.ActionDelete, func() error {
+		return r.SaleRepo.Delete(ctx, id)
+	})
+}
+
+// auditedSaleTx registra as escritas em transação (finalização, mudanças de
+// status e recálculo dos totais) na própria transação: se o registro falhar,
+// a escrita é desfeita.
+type auditedSaleTx struct {
+	iface.SaleTx
+	rec audit.Recorder
+}
+
+func WithAuditTx(inner iface.SaleTx, rec audit.Recorder) iface.SaleTx {
+	return &auditedSaleTx{SaleTx: inner, rec: rec}
+}
+
+func (r *auditedSaleTx) load(ctx context.Context, tx pgx.Tx, id int64) (any, error) {
+	return r.SaleTx.GetByIDForUpdateTx(ctx, tx, id)
+}
+
+func (r *auditedSaleTx) CreateTx(ctx context.Context, tx pgx.Tx, sale *models.Sale) (*models.Sale, error) {
+	created, err := r.SaleTx.CreateTx(ctx, tx, sale)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.rec.RecordTx(ctx, tx, audit.Entry{
+		EntityType: auditEntity,
+		EntityID:   created.ID,
+		Action:     modelAudit.ActionCreate,
+		After:      created,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (r *auditedSaleTx) UpdateStatusTx(ctx context.Context, tx pgx.Tx, sale *models.Sale) error {
+	action, ok := statusActions[sale.Status]
+	if !ok {
+		action = modelAudit.ActionUpdate
+	}
+
+	return audit.TrackTx(ctx, tx, r.rec, auditEntity, sale.ID, action, r.load, func() error {
+		return r.SaleTx.UpdateStatusTx(ctx, tx, sale)
+	})
+}
+
+func (r *auditedSaleTx) RecalculateTotalsTx(ctx context.Context, tx pgx.Tx, sale *models.Sale) error {
+	return audit.TrackTx(ctx, tx, r.rec, auditEntity, sale.ID, modelAudit.ActionUpdate, r.load, func() error {
+		return r.SaleTx.RecalculateTotalsTx(ctx, tx, sale)
+	})
+}
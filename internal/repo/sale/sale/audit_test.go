@@ -0,0 +1,120 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockAudit "github.com/WagaoCarvalho/backend_store_go/infra/mock/audit"
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	mockSale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	modelAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditedSale(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("delete records only the previous state", func(t *testing.T) {
+		inner := new(mockSale.MockSale)
+		rec := new(mockAudit.MockRecorder)
+		before := &models.Sale{ID: 1}
+
+		inner.On("GetByID", ctx, int64(1)).Return(before, nil).Once()
+		inner.On("Delete", ctx, int64(1)).Return(nil).Once()
+		rec.On("Record", ctx, audit.Entry{EntityType: "sale", EntityID: 1, Action: modelAudit.ActionDelete, Before: before}).Once()
+
+		err := WithAudit(inner, rec).Delete(ctx, 1)
+
+		assert.NoError(t, err)
+		inner.AssertExpectations(t)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("failed update is not recorded", func(t *testing.T) {
+		inner := new(mockSale.MockSale)
+		rec := new(mockAudit.MockRecorder)
+		sale := &models.Sale{ID: 1}
+
+		inner.On("GetByID", ctx, int64(1)).Return(sale, nil).Once()
+		inner.On("Update", ctx, sale).Return(errors.New("db fail")).Once()
+
+		err := WithAudit(inner, rec).Update(ctx, sale)
+
+		assert.EqualError(t, err, "db fail")
+		rec.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuditedSaleTx(t *testing.T) {
+	ctx := context.Background()
+	tx := new(mockDb.MockTx)
+
+	t.Run("cancel records the status change in the transaction", func(t *testing.T) {
+		inner := new(mockSale.MockSaleTx)
+		rec := new(mockAudit.MockRecorder)
+		before := &models.Sale{ID: 1, Status: "active"}
+		after := &models.Sale{ID: 1, Status: "canceled"}
+		sale := &models.Sale{ID: 1, Status: "canceled"}
+
+		inner.On("GetByIDForUpdateTx", ctx, tx, int64(1)).Return(before, nil).Once()
+		inner.On("UpdateStatusTx", ctx, tx, sale).Return(nil).Once()
+		inner.On("GetByIDForUpdateTx", ctx, tx, int64(1)).Return(after, nil).Once()
+		rec.On("RecordTx", ctx, tx, audit.Entry{EntityType: "sale", EntityID: 1, Action: "cancel", Before: before, After: after}).Return(nil).Once()
+
+		err := WithAuditTx(inner, rec).UpdateStatusTx(ctx, tx, sale)
+
+		assert.NoError(t, err)
+		inner.AssertExpectations(t)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("audit failure fails the status change", func(t *testing.T) {
+		inner := new(mockSale.MockSaleTx)
+		rec := new(mockAudit.MockRecorder)
+		sale := &models.Sale{ID: 1, Status: "completed"}
+
+		inner.On("GetByIDForUpdateTx", ctx, tx, int64(1)).Return(sale, nil)
+		inner.On("UpdateStatusTx", ctx, tx, sale).Return(nil).Once()
+		rec.On("RecordTx", ctx, tx, mock.MatchedBy(func(e audit.Entry) bool { return e.Action == "complete" })).
+			Return(errors.New("audit fail")).Once()
+
+		err := WithAuditTx(inner, rec).UpdateStatusTx(ctx, tx, sale)
+
+		assert.EqualError(t, err, "audit fail")
+	})
+
+	t.Run("create records the new sale", func(t *testing.T) {
+		inner := new(mockSale.MockSaleTx)
+		rec := new(mockAudit.MockRecorder)
+		sale := &models.Sale{TotalAmount: money.New(10)}
+		created := &models.Sale{ID: 9, TotalAmount: money.New(10)}
+
+		inner.On("CreateTx", ctx, tx, sale).Return(created, nil).Once()
+		rec.On("RecordTx", ctx, tx, audit.Entry{EntityType: "sale", EntityID: 9, Action: modelAudit.ActionCreate, After: created}).Return(nil).Once()
+
+		result, err := WithAuditTx(inner, rec).CreateTx(ctx, tx, sale)
+
+		assert.NoError(t, err)
+		assert.Same(t, created, result)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("failed create is not recorded", func(t *testing.T) {
+		inner := new(mockSale.MockSaleTx)
+		rec := new(mockAudit.MockRecorder)
+		sale := &models.Sale{}
+
+		inner.On("CreateTx", ctx, tx, sale).Return(nil, errors.New("db fail")).Once()
+
+		result, err := WithAuditTx(inner, rec).CreateTx(ctx, tx, sale)
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "db fail")
+		rec.AssertNotCalled(t, "RecordTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -28,7 +28,10 @@ func (r *saleRepo) GetByID(ctx context.Context, id int64) (*models.Sale, error)
 			notes,
 			version,
 			created_at,
-			updated_at
+			updated_at,
+			client_cnpj_id,
+			location_id,
+			cash_session_id
 		FROM sales
 		WHERE id = $1;
 	`
@@ -50,6 +53,9 @@ func (r *saleRepo) GetByID(ctx context.Context, id int64) (*models.Sale, error)
 		&sale.Version,
 		&sale.CreatedAt,
 		&sale.UpdatedAt,
+		&sale.ClientCnpjID,
+		&sale.LocationID,
+		&sale.CashSessionID,
 	)
 
 	if err != nil {
@@ -90,7 +96,10 @@ func (r *saleRepo) GetByDateRange(ctx context.Context, start, end time.Time, lim
 			notes,
 			version,
 			created_at,
-			updated_at
+			updated_at,
+			client_cnpj_id,
+			location_id,
+			cash_session_id
 		FROM sales
 		WHERE sale_date BETWEEN $1 AND $2
 		ORDER BY %s %s
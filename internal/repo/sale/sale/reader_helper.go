@@ -33,7 +33,10 @@ func (r *saleRepo) listByField(
 			notes,
 			version,
 			created_at,
-			updated_at
+			updated_at,
+			client_cnpj_id,
+			location_id,
+			cash_session_id
 		FROM sales
 		WHERE %s = $1
 		ORDER BY %s %s
@@ -70,6 +73,9 @@ func scanSales(rows pgx.Rows) ([]*models.Sale, error) {
 			&sale.Version,
 			&sale.CreatedAt,
 			&sale.UpdatedAt,
+			&sale.ClientCnpjID,
+			&sale.LocationID,
+			&sale.CashSessionID,
 		); err != nil {
 			return nil, fmt.Errorf("%w: erro ao scanear vendas: %v", errMsg.ErrGet, err)
 		}
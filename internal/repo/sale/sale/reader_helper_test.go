@@ -10,6 +10,7 @@ import (
 	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -48,10 +49,10 @@ func createTestSale() *models.Sale {
 		ClientID:           utils.Int64Ptr(100),
 		UserID:             utils.Int64Ptr(200),
 		SaleDate:           *utils.TimePtrFromString("2024-01-15"),
-		TotalItemsAmount:   200.00, // NOVO
-		TotalItemsDiscount: 20.00,  // NOVO
-		TotalSaleDiscount:  10.00,
-		TotalAmount:        170.00, // 200 - 20 - 10
+		TotalItemsAmount:   money.New(200), // NOVO
+		TotalItemsDiscount: money.New(20),  // NOVO
+		TotalSaleDiscount:  money.New(10),
+		TotalAmount:        money.New(170), // 200 - 20 - 10
 		PaymentType:        "credit",
 		Status:             "completed",
 		Notes:              "Test sale",
@@ -93,6 +94,9 @@ func TestSaleRepo_ListByField(t *testing.T) {
 			mock.Anything, // version (12)
 			mock.Anything, // created_at (13)
 			mock.Anything, // updated_at (14)
+			mock.Anything, // client_cnpj_id (15)
+			mock.Anything, // location_id (16)
+			mock.Anything, // cash_session_id (17)
 		).Run(func(args mock.Arguments) {
 			// Simular o scan preenchendo os valores com os tipos CORRETOS
 			if ptr, ok := args.Get(0).(*int64); ok {
@@ -117,16 +121,16 @@ func TestSaleRepo_ListByField(t *testing.T) {
 			if ptr, ok := args.Get(3).(*time.Time); ok {
 				*ptr = expectedSales[0].SaleDate
 			}
-			if ptr, ok := args.Get(4).(*float64); ok { // total_items_amount (NOVO)
+			if ptr, ok := args.Get(4).(*money.Money); ok { // total_items_amount (NOVO)
 				*ptr = expectedSales[0].TotalItemsAmount
 			}
-			if ptr, ok := args.Get(5).(*float64); ok { // total_items_discount (NOVO)
+			if ptr, ok := args.Get(5).(*money.Money); ok { // total_items_discount (NOVO)
 				*ptr = expectedSales[0].TotalItemsDiscount
 			}
-			if ptr, ok := args.Get(6).(*float64); ok { // total_sale_discount
+			if ptr, ok := args.Get(6).(*money.Money); ok { // total_sale_discount
 				*ptr = expectedSales[0].TotalSaleDiscount
 			}
-			if ptr, ok := args.Get(7).(*float64); ok { // total_amount
+			if ptr, ok := args.Get(7).(*money.Money); ok { // total_amount
 				*ptr = expectedSales[0].TotalAmount
 			}
 			if ptr, ok := args.Get(8).(*string); ok { // payment_type
@@ -222,7 +226,8 @@ func TestSaleRepo_ListByField(t *testing.T) {
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything,
 		).Return(scanError)
 		mockRows.On("Close").Return(nil)
 
@@ -8,6 +8,7 @@ import (
 
 	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -45,6 +46,9 @@ func TestSaleRepo_GetByID(t *testing.T) {
 					1,                 // version - int
 					createdAt,         // created_at - time.Time
 					updatedAt,         // updated_at - time.Time
+					nil,               // client_cnpj_id - *int64
+					int64(1),          // location_id - int64
+					nil,               // cash_session_id - *int64
 				},
 			})
 
@@ -56,10 +60,10 @@ func TestSaleRepo_GetByID(t *testing.T) {
 		assert.Equal(t, &clientID, result.ClientID) // A struct espera *int64
 		assert.Equal(t, &userID, result.UserID)     // A struct espera *int64
 		assert.Equal(t, saleDate, result.SaleDate)
-		assert.Equal(t, 200.00, result.TotalItemsAmount)
-		assert.Equal(t, 20.00, result.TotalItemsDiscount)
-		assert.Equal(t, 10.00, result.TotalSaleDiscount)
-		assert.Equal(t, 170.00, result.TotalAmount)
+		assert.Equal(t, money.New(200), result.TotalItemsAmount)
+		assert.Equal(t, money.New(20), result.TotalItemsDiscount)
+		assert.Equal(t, money.New(10), result.TotalSaleDiscount)
+		assert.Equal(t, money.New(170), result.TotalAmount)
 		assert.Equal(t, "credit", result.PaymentType)
 		assert.Equal(t, "completed", result.Status)
 		assert.Equal(t, "Test sale notes", result.Notes)
@@ -143,6 +147,9 @@ func TestSaleRepo_GetByID(t *testing.T) {
 					1,         // version - int
 					createdAt, // created_at - time.Time
 					updatedAt, // updated_at - time.Time
+					nil,       // client_cnpj_id - *int64
+					int64(1),  // location_id - int64
+					nil,       // cash_session_id - *int64
 				},
 			})
 
@@ -153,10 +160,10 @@ func TestSaleRepo_GetByID(t *testing.T) {
 		assert.Equal(t, saleID, result.ID)
 		assert.Nil(t, result.ClientID)
 		assert.Nil(t, result.UserID)
-		assert.Equal(t, 150.00, result.TotalItemsAmount)
-		assert.Equal(t, 15.00, result.TotalItemsDiscount)
-		assert.Equal(t, 5.00, result.TotalSaleDiscount)
-		assert.Equal(t, 130.00, result.TotalAmount)
+		assert.Equal(t, money.New(150), result.TotalItemsAmount)
+		assert.Equal(t, money.New(15), result.TotalItemsDiscount)
+		assert.Equal(t, money.New(5), result.TotalSaleDiscount)
+		assert.Equal(t, money.New(130), result.TotalAmount)
 		assert.Equal(t, "", result.Notes)
 		assert.Equal(t, "pending", result.Status)
 
@@ -193,6 +200,9 @@ func TestSaleRepo_GetByID(t *testing.T) {
 					1,              // version - int
 					createdAt,      // created_at - time.Time
 					updatedAt,      // updated_at - time.Time
+					nil,            // client_cnpj_id - *int64
+					int64(1),       // location_id - int64
+					nil,            // cash_session_id - *int64
 				},
 			})
 
@@ -203,10 +213,10 @@ func TestSaleRepo_GetByID(t *testing.T) {
 		assert.Equal(t, saleID, result.ID)
 		assert.Equal(t, &clientID, result.ClientID)
 		assert.Equal(t, &userID, result.UserID)
-		assert.Equal(t, 100.00, result.TotalItemsAmount)
-		assert.Equal(t, 0.00, result.TotalItemsDiscount)
-		assert.Equal(t, 0.00, result.TotalSaleDiscount)
-		assert.Equal(t, 100.00, result.TotalAmount)
+		assert.Equal(t, money.New(100), result.TotalItemsAmount)
+		assert.Equal(t, money.New(0), result.TotalItemsDiscount)
+		assert.Equal(t, money.New(0), result.TotalSaleDiscount)
+		assert.Equal(t, money.New(100), result.TotalAmount)
 		assert.Equal(t, "debit", result.PaymentType)
 		assert.Equal(t, "Sem desconto", result.Notes)
 
@@ -324,6 +334,9 @@ func TestSaleRepo_GetByDateRange(t *testing.T) {
 			mock.Anything, // version (12)
 			mock.Anything, // created_at (13)
 			mock.Anything, // updated_at (14)
+			mock.Anything, // client_cnpj_id (15)
+			mock.Anything, // location_id (16)
+			mock.Anything, // cash_session_id (17)
 		).Return(nil)
 		mockRows.On("Close").Return(nil)
 
@@ -357,7 +370,8 @@ func TestSaleRepo_GetByDateRange(t *testing.T) {
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything,
 		).Return(nil).Times(3)
 		mockRows.On("Close").Return(nil)
 
@@ -408,7 +422,8 @@ func TestSaleRepo_GetByDateRange(t *testing.T) {
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-			mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything,
 		).Return(errors.New("scan error"))
 		mockRows.On("Close").Return(nil)
 
@@ -0,0 +1,212 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+type saleTxRepo struct {
+	db repo.DBTransactor
+}
+
+func NewSaleTx(db repo.DBTransactor) iface.SaleTx {
+	return &saleTxRepo{db: db}
+}
+
+func (r *saleTxRepo) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return r.db.BeginTx(ctx, pgx.TxOptions{})
+}
+
+// CreateTx lança a venda no caixa aberto do operador (user_id). Sem caixa
+// aberto nada é inserido e a venda é recusada com ErrCashSessionNotOpen; o
+// FOR SHARE impede que o caixa seja fechado enquanto a venda não termina.
+func (r *saleTxRepo) CreateTx(ctx context.Context, tx pgx.Tx, sale *models.Sale) (*models.Sale, error) {
+	const query = `
+		INSERT INTO sales (
+			client_id,
+			user_id,
+			sale_date,
+			total_items_amount,
+			total_items_discount,
+			total_sale_discount,
+			total_amount,
+			payment_type,
+			status,
+			notes,
+			client_cnpj_id,
+			location_id,
+			cash_session_id,
+			created_at,
+			updated_at
+		)
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, COALESCE(NULLIF($12, 0), default_location_id()), cs.id, NOW(), NOW()
+		FROM cash_sessions cs
+		WHERE cs.user_id = $2
+		  AND cs.status = 'open'
+		FOR SHARE OF cs
+		RETURNING id, version, created_at, updated_at, location_id, cash_session_id;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		sale.ClientID,
+		sale.UserID,
+		sale.SaleDate,
+		sale.TotalItemsAmount,
+		sale.TotalItemsDiscount,
+		sale.TotalSaleDiscount,
+		sale.TotalAmount,
+		sale.PaymentType,
+		sale.Status,
+		sale.Notes,
+		sale.ClientCnpjID,
+		sale.LocationID,
+	).Scan(&sale.ID, &sale.Version, &sale.CreatedAt, &sale.UpdatedAt, &sale.LocationID, &sale.CashSessionID)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrCashSessionNotOpen
+		}
+		if errMsgPg.IsForeignKeyViolation(err) {
+			return nil, errMsg.ErrDBInvalidForeignKey
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	return sale, nil
+}
+
+func (r *saleTxRepo) GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Sale, error) {
+	const query = `
+		SELECT 
+			id,
+			client_id,
+			user_id,
+			sale_date,
+			total_items_amount,
+			total_items_discount,
+			total_sale_discount,
+			total_amount,
+			payment_type,
+			status,
+			notes,
+			version,
+			created_at,
+			updated_at,
+			client_cnpj_id,
+			location_id,
+			cash_session_id
+		FROM sales
+		WHERE id = $1
+		FOR UPDATE;
+	`
+
+	var sale models.Sale
+
+	err := tx.QueryRow(ctx, query, id).Scan(
+		&sale.ID,
+		&sale.ClientID,
+		&sale.UserID,
+		&sale.SaleDate,
+		&sale.TotalItemsAmount,
+		&sale.TotalItemsDiscount,
+		&sale.TotalSaleDiscount,
+		&sale.TotalAmount,
+		&sale.PaymentType,
+		&sale.Status,
+		&sale.Notes,
+		&sale.Version,
+		&sale.CreatedAt,
+		&sale.UpdatedAt,
+		&sale.ClientCnpjID,
+		&sale.LocationID,
+		&sale.CashSessionID,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return &sale, nil
+}
+
+// RecalculateTotalsTx recalcula os totais da venda a partir de sale_items e
+// incrementa a versão. Deve ser chamado após qualquer alteração nos itens.
+// Vendas faturadas de uma ordem de serviço mantêm a mão de obra da ordem no
+// total, já que ela não vira item.
+func (r *saleTxRepo) RecalculateTotalsTx(ctx context.Context, tx pgx.Tx, sale *models.Sale) error {
+	const query = `
+		UPDATE sales s
+		SET 
+			total_items_amount   = t.items_amount,
+			total_items_discount = t.items_discount,
+			total_amount         = t.items_subtotal + t.labor_amount - s.total_sale_discount,
+			version              = s.version + 1,
+			updated_at           = NOW()
+		FROM (
+			SELECT
+				COALESCE(SUM(quantity * unit_price), 0) AS items_amount,
+				COALESCE(SUM(discount), 0)              AS items_discount,
+				COALESCE(SUM(subtotal), 0)              AS items_subtotal,
+				COALESCE((
+					SELECT labor_amount FROM order_services WHERE sale_id = $1
+				), 0)                                   AS labor_amount
+			FROM sale_items
+			WHERE sale_id = $1
+		) t
+		WHERE s.id = $1
+		RETURNING s.total_items_amount, s.total_items_discount, s.total_amount, s.version, s.updated_at;
+	`
+
+	err := tx.QueryRow(ctx, query, sale.ID).Scan(
+		&sale.TotalItemsAmount,
+		&sale.TotalItemsDiscount,
+		&sale.TotalAmount,
+		&sale.Version,
+		&sale.UpdatedAt,
+	)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return errMsg.ErrNotFound
+		case errMsgPg.IsCheckViolation(err):
+			return fmt.Errorf("%w: desconto da venda excede o total dos itens", errMsg.ErrInvalidData)
+		default:
+			return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *saleTxRepo) UpdateStatusTx(ctx context.Context, tx pgx.Tx, sale *models.Sale) error {
+	const query = `
+		UPDATE sales
+		SET status     = $2,
+		    version    = version + 1,
+		    updated_at = NOW()
+		WHERE id = $1
+		RETURNING version, updated_at;
+	`
+
+	err := tx.QueryRow(ctx, query, sale.ID, sale.Status).Scan(&sale.Version, &sale.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errMsg.ErrNotFound
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,313 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewSaleTx(t *testing.T) {
+	t.Run("successfully create new SaleTx instance", func(t *testing.T) {
+		result := NewSaleTx(nil)
+
+		assert.NotNil(t, result)
+
+		_, ok := result.(*saleTxRepo)
+		assert.True(t, ok, "Expected result to be of type *saleTxRepo")
+	})
+}
+
+func TestSaleTxRepo_BeginTx(t *testing.T) {
+	t.Run("successfully begin transaction", func(t *testing.T) {
+		mockDB := new(mockDb.MockDBTransactor)
+		repo := &saleTxRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockTx := new(mockDb.MockTx)
+		mockDB.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+
+		tx, err := repo.BeginTx(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, mockTx, tx)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return error when begin transaction fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDBTransactor)
+		repo := &saleTxRepo{db: mockDB}
+		ctx := context.Background()
+
+		dbError := errors.New("transaction failed")
+		mockDB.On("BeginTx", ctx, pgx.TxOptions{}).Return(&mockDb.MockTx{}, dbError)
+
+		_, err := repo.BeginTx(ctx)
+
+		assert.Equal(t, dbError, err)
+		mockDB.AssertExpectations(t)
+	})
+}
+
+func TestSaleTxRepo_CreateTx(t *testing.T) {
+	newSale := func() *models.Sale {
+		return &models.Sale{
+			ClientID:           utils.Int64Ptr(1),
+			UserID:             utils.Int64Ptr(2),
+			SaleDate:           time.Now(),
+			TotalItemsAmount:   money.New(100),
+			TotalItemsDiscount: money.New(10),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(85),
+			PaymentType:        "cash",
+			Status:             "active",
+			Notes:              "checkout",
+		}
+	}
+
+	argsOf := func(s *models.Sale) []interface{} {
+		return []interface{}{
+			s.ClientID, s.UserID, s.SaleDate,
+			s.TotalItemsAmount, s.TotalItemsDiscount, s.TotalSaleDiscount, s.TotalAmount,
+			s.PaymentType, s.Status, s.Notes, s.ClientCnpjID, s.LocationID,
+		}
+	}
+
+	t.Run("successfully create sale within transaction", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleTxRepo{}
+		ctx := context.Background()
+		sale := newSale()
+
+		now := time.Now()
+		mockRow := &mockDb.MockRowWithIDArgs{Values: []interface{}{int64(10), 1, now, now, int64(1), int64(5)}}
+		mockTx.On("QueryRow", ctx, mock.Anything, argsOf(sale)).Return(mockRow)
+
+		result, err := repo.CreateTx(ctx, mockTx, sale)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), result.ID)
+		assert.Equal(t, 1, result.Version)
+		assert.Equal(t, now, result.CreatedAt)
+		assert.Equal(t, int64(5), *result.CashSessionID)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrCashSessionNotOpen when operator has no open cash session", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleTxRepo{}
+		ctx := context.Background()
+		sale := newSale()
+
+		mockTx.On("QueryRow", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "cs.status = 'open'")
+		}), argsOf(sale)).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		result, err := repo.CreateTx(ctx, mockTx, sale)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCashSessionNotOpen)
+	})
+
+	t.Run("return ErrDBInvalidForeignKey on foreign key violation", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleTxRepo{}
+		ctx := context.Background()
+		sale := newSale()
+
+		pgErr := &pgconn.PgError{Code: "23503"}
+		mockTx.On("QueryRow", ctx, mock.Anything, argsOf(sale)).Return(&mockDb.MockRow{Err: pgErr})
+
+		result, err := repo.CreateTx(ctx, mockTx, sale)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("return ErrCreate on generic database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleTxRepo{}
+		ctx := context.Background()
+		sale := newSale()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, argsOf(sale)).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		result, err := repo.CreateTx(ctx, mockTx, sale)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+		assert.Contains(t, err.Error(), "db down")
+	})
+}
+
+func TestSaleTxRepo_GetByIDForUpdateTx(t *testing.T) {
+	t.Run("successfully lock and return sale", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleTxRepo{}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockRow := &mockDb.MockRowWithIDArgs{Values: []interface{}{
+			int64(7), int64(1), int64(2), now,
+			100.0, 10.0, 5.0, 85.0,
+			"cash", "active", "obs", 3, now, now, nil, int64(1), nil,
+		}}
+		mockTx.On("QueryRow", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "FOR UPDATE")
+		}), []interface{}{int64(7)}).Return(mockRow)
+
+		result, err := repo.GetByIDForUpdateTx(ctx, mockTx, 7)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), result.ID)
+		assert.Equal(t, "active", result.Status)
+		assert.Equal(t, 3, result.Version)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when sale does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(7)}).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		result, err := repo.GetByIDForUpdateTx(ctx, mockTx, 7)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrGet on generic database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(7)}).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		result, err := repo.GetByIDForUpdateTx(ctx, mockTx, 7)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+		assert.Contains(t, err.Error(), "db down")
+	})
+}
+
+func TestSaleTxRepo_RecalculateTotalsTx(t *testing.T) {
+	t.Run("successfully recalculate totals and bump version", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleTxRepo{}
+		ctx := context.Background()
+		now := time.Now()
+		sale := &models.Sale{ID: 7, Version: 3}
+
+		mockRow := &mockDb.MockRowWithIDArgs{Values: []interface{}{60.0, 6.0, 50.0, 4, now}}
+		mockTx.On("QueryRow", ctx, mock.MatchedBy(func(q string) bool {
+			// a mão de obra de uma ordem de serviço faturada continua no total
+			return strings.Contains(q, "t.items_subtotal + t.labor_amount - s.total_sale_discount") &&
+				strings.Contains(q, "SELECT labor_amount FROM order_services WHERE sale_id = $1")
+		}), []interface{}{int64(7)}).Return(mockRow)
+
+		err := repo.RecalculateTotalsTx(ctx, mockTx, sale)
+
+		assert.NoError(t, err)
+		assert.Equal(t, money.New(60), sale.TotalItemsAmount)
+		assert.Equal(t, money.New(6), sale.TotalItemsDiscount)
+		assert.Equal(t, money.New(50), sale.TotalAmount)
+		assert.Equal(t, 4, sale.Version)
+		assert.Equal(t, now, sale.UpdatedAt)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when sale does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(7)}).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.RecalculateTotalsTx(ctx, mockTx, &models.Sale{ID: 7})
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrInvalidData when sale discount exceeds items total", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleTxRepo{}
+		ctx := context.Background()
+
+		pgErr := &pgconn.PgError{Code: "23514"}
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(7)}).Return(&mockDb.MockRow{Err: pgErr})
+
+		err := repo.RecalculateTotalsTx(ctx, mockTx, &models.Sale{ID: 7})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("return ErrUpdate on generic database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(7)}).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		err := repo.RecalculateTotalsTx(ctx, mockTx, &models.Sale{ID: 7})
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
+
+func TestSaleTxRepo_UpdateStatusTx(t *testing.T) {
+	t.Run("successfully update status and bump version", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleTxRepo{}
+		ctx := context.Background()
+		now := time.Now()
+		sale := &models.Sale{ID: 7, Status: "canceled", Version: 2}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(7), "canceled"}).
+			Return(&mockDb.MockRowWithIDArgs{Values: []interface{}{3, now}})
+
+		err := repo.UpdateStatusTx(ctx, mockTx, sale)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, sale.Version)
+		assert.Equal(t, now, sale.UpdatedAt)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when sale does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(7), "canceled"}).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.UpdateStatusTx(ctx, mockTx, &models.Sale{ID: 7, Status: "canceled"})
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrUpdate on generic database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(7), "canceled"}).Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		err := repo.UpdateStatusTx(ctx, mockTx, &models.Sale{ID: 7, Status: "canceled"})
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
+// Create segue a mesma regra de CreateTx: a venda exige caixa aberto do
+// operador.
 func (r *saleRepo) Create(ctx context.Context, sale *models.Sale) (*models.Sale, error) {
 	const query = `
 		INSERT INTO sales (
@@ -24,11 +26,18 @@ func (r *saleRepo) Create(ctx context.Context, sale *models.Sale) (*models.Sale,
 			payment_type,
 			status,
 			notes,
+			client_cnpj_id,
+			location_id,
+			cash_session_id,
 			created_at,
 			updated_at
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW(), NOW())
-		RETURNING id, created_at, updated_at;
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, COALESCE(NULLIF($12, 0), default_location_id()), cs.id, NOW(), NOW()
+		FROM cash_sessions cs
+		WHERE cs.user_id = $2
+		  AND cs.status = 'open'
+		FOR SHARE OF cs
+		RETURNING id, created_at, updated_at, location_id, cash_session_id;
 	`
 
 	err := r.db.QueryRow(ctx, query,
@@ -42,9 +51,15 @@ func (r *saleRepo) Create(ctx context.Context, sale *models.Sale) (*models.Sale,
 		sale.PaymentType,
 		sale.Status,
 		sale.Notes,
-	).Scan(&sale.ID, &sale.CreatedAt, &sale.UpdatedAt)
+		sale.ClientCnpjID,
+		sale.LocationID,
+	).Scan(&sale.ID, &sale.CreatedAt, &sale.UpdatedAt, &sale.LocationID, &sale.CashSessionID)
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrCashSessionNotOpen
+		}
+
 		if errMsgPg.IsForeignKeyViolation(err) {
 			return nil, errMsg.ErrDBInvalidForeignKey
 		}
@@ -97,9 +112,10 @@ func (r *saleRepo) Update(ctx context.Context, sale *models.Sale) error {
 			payment_type         = $8,
 			status               = $9,
 			notes                = $10,
+			client_cnpj_id       = $11,
 			updated_at           = NOW(),
 			version              = version + 1
-		WHERE id = $11
+		WHERE id = $12
 		RETURNING updated_at, version
 	`
 
@@ -114,6 +130,7 @@ func (r *saleRepo) Update(ctx context.Context, sale *models.Sale) error {
 		sale.PaymentType,
 		sale.Status,
 		sale.Notes,
+		sale.ClientCnpjID,
 		sale.ID,
 	).Scan(&sale.UpdatedAt, &sale.Version)
 
@@ -9,6 +9,7 @@ import (
 	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -27,10 +28,10 @@ func TestSaleRepo_Create(t *testing.T) {
 			ClientID:           utils.Int64Ptr(100),
 			UserID:             utils.Int64Ptr(200),
 			SaleDate:           time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
-			TotalItemsAmount:   120.00,
-			TotalItemsDiscount: 10.00,
-			TotalSaleDiscount:  5.00,
-			TotalAmount:        115.00,
+			TotalItemsAmount:   money.New(120),
+			TotalItemsDiscount: money.New(10),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(115),
 			PaymentType:        "credit",
 			Status:             "pending",
 			Notes:              "Test sale notes",
@@ -43,6 +44,8 @@ func TestSaleRepo_Create(t *testing.T) {
 				int64(1),     // id
 				expectedTime, // created_at
 				expectedTime, // updated_at
+				int64(1),     // location_id
+				int64(9),     // cash_session_id
 			},
 		}
 
@@ -58,6 +61,8 @@ func TestSaleRepo_Create(t *testing.T) {
 				sale.PaymentType,
 				sale.Status,
 				sale.Notes,
+				sale.ClientCnpjID,
+				sale.LocationID,
 			}).Return(mockRow)
 
 		result, err := repo.Create(ctx, sale)
@@ -67,6 +72,32 @@ func TestSaleRepo_Create(t *testing.T) {
 		assert.Equal(t, int64(1), sale.ID)
 		assert.Equal(t, expectedTime, sale.CreatedAt)
 		assert.Equal(t, expectedTime, sale.UpdatedAt)
+		assert.Equal(t, int64(9), *sale.CashSessionID)
+
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return ErrCashSessionNotOpen when operator has no open cash session", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &saleRepo{db: mockDB}
+		ctx := context.Background()
+
+		sale := &models.Sale{
+			UserID:      utils.Int64Ptr(200),
+			SaleDate:    time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			TotalAmount: money.New(115),
+			PaymentType: "cash",
+			Status:      "active",
+		}
+
+		mockDB.
+			On("QueryRow", ctx, mock.Anything, mock.Anything).
+			Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		result, err := repo.Create(ctx, sale)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCashSessionNotOpen)
 
 		mockDB.AssertExpectations(t)
 	})
@@ -80,10 +111,10 @@ func TestSaleRepo_Create(t *testing.T) {
 			ClientID:           utils.Int64Ptr(100),
 			UserID:             utils.Int64Ptr(200),
 			SaleDate:           time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
-			TotalItemsAmount:   120.00,
-			TotalItemsDiscount: 10.00,
-			TotalSaleDiscount:  5.00,
-			TotalAmount:        115.00,
+			TotalItemsAmount:   money.New(120),
+			TotalItemsDiscount: money.New(10),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(115),
 			PaymentType:        "credit",
 			Status:             "pending",
 			Notes:              "Test sale notes",
@@ -116,10 +147,10 @@ func TestSaleRepo_Create(t *testing.T) {
 			ClientID:           utils.Int64Ptr(100),
 			UserID:             utils.Int64Ptr(200),
 			SaleDate:           time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
-			TotalItemsAmount:   120.00,
-			TotalItemsDiscount: 10.00,
-			TotalSaleDiscount:  5.00,
-			TotalAmount:        115.00,
+			TotalItemsAmount:   money.New(120),
+			TotalItemsDiscount: money.New(10),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(115),
 			PaymentType:        "credit",
 			Status:             "pending",
 			Notes:              "Test sale notes",
@@ -154,10 +185,10 @@ func TestSaleRepo_Create(t *testing.T) {
 			ClientID:           utils.Int64Ptr(100),
 			UserID:             utils.Int64Ptr(200),
 			SaleDate:           time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
-			TotalItemsAmount:   120.00,
-			TotalItemsDiscount: 10.00,
-			TotalSaleDiscount:  5.00,
-			TotalAmount:        115.00,
+			TotalItemsAmount:   money.New(120),
+			TotalItemsDiscount: money.New(10),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(115),
 			PaymentType:        "credit",
 			Status:             "pending",
 			Notes:              "Test sale notes",
@@ -188,10 +219,10 @@ func TestSaleRepo_Create(t *testing.T) {
 		sale := &models.Sale{
 			// ClientID e UserID são nil
 			SaleDate:           time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
-			TotalItemsAmount:   120.00,
-			TotalItemsDiscount: 10.00,
-			TotalSaleDiscount:  5.00,
-			TotalAmount:        115.00,
+			TotalItemsAmount:   money.New(120),
+			TotalItemsDiscount: money.New(10),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(115),
 			PaymentType:        "credit",
 			Status:             "pending",
 			Notes:              "Test sale notes",
@@ -202,6 +233,8 @@ func TestSaleRepo_Create(t *testing.T) {
 				int64(1),     // id
 				expectedTime, // created_at
 				expectedTime, // updated_at
+				int64(1),     // location_id
+				int64(9),     // cash_session_id
 			},
 		}
 
@@ -217,6 +250,8 @@ func TestSaleRepo_Create(t *testing.T) {
 				sale.PaymentType,
 				sale.Status,
 				sale.Notes,
+				sale.ClientCnpjID,
+				sale.LocationID,
 			}).Return(mockRow)
 
 		result, err := repo.Create(ctx, sale)
@@ -241,10 +276,10 @@ func TestSaleRepo_Update(t *testing.T) {
 			ClientID:           utils.Int64Ptr(100),
 			UserID:             utils.Int64Ptr(200),
 			SaleDate:           time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
-			TotalItemsAmount:   200.00,
-			TotalItemsDiscount: 15.00,
-			TotalSaleDiscount:  5.00,
-			TotalAmount:        180.00,
+			TotalItemsAmount:   money.New(200),
+			TotalItemsDiscount: money.New(15),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(180),
 			PaymentType:        "credit",
 			Status:             "completed",
 			Notes:              "Updated sale notes",
@@ -286,9 +321,10 @@ func TestSaleRepo_Update(t *testing.T) {
 			payment_type         = $8,
 			status               = $9,
 			notes                = $10,
+			client_cnpj_id       = $11,
 			updated_at           = NOW(),
 			version              = version + 1
-		WHERE id = $11
+		WHERE id = $12
 		RETURNING updated_at, version
 	`
 		mockDB.On("QueryRow", ctx, updateQuery, []interface{}{
@@ -302,6 +338,7 @@ func TestSaleRepo_Update(t *testing.T) {
 			sale.PaymentType,
 			sale.Status,
 			sale.Notes,
+			sale.ClientCnpjID,
 			sale.ID,
 		}).Return(mockRowUpdate)
 
@@ -411,10 +448,10 @@ func TestSaleRepo_Update(t *testing.T) {
 			ClientID:           utils.Int64Ptr(999), // FK inválido
 			UserID:             utils.Int64Ptr(200),
 			SaleDate:           time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
-			TotalItemsAmount:   200.00,
-			TotalItemsDiscount: 15.00,
-			TotalSaleDiscount:  5.00,
-			TotalAmount:        180.00,
+			TotalItemsAmount:   money.New(200),
+			TotalItemsDiscount: money.New(15),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(180),
 			PaymentType:        "credit",
 			Status:             "completed",
 			Notes:              "Updated sale notes",
@@ -455,9 +492,10 @@ func TestSaleRepo_Update(t *testing.T) {
 			payment_type         = $8,
 			status               = $9,
 			notes                = $10,
+			client_cnpj_id       = $11,
 			updated_at           = NOW(),
 			version              = version + 1
-		WHERE id = $11
+		WHERE id = $12
 		RETURNING updated_at, version
 	`
 		mockDB.On("QueryRow", ctx, updateQuery, []interface{}{
@@ -471,6 +509,7 @@ func TestSaleRepo_Update(t *testing.T) {
 			sale.PaymentType,
 			sale.Status,
 			sale.Notes,
+			sale.ClientCnpjID,
 			sale.ID,
 		}).Return(mockRowUpdate)
 
@@ -490,10 +529,10 @@ func TestSaleRepo_Update(t *testing.T) {
 			ClientID:           utils.Int64Ptr(100),
 			UserID:             utils.Int64Ptr(200),
 			SaleDate:           time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
-			TotalItemsAmount:   200.00,
-			TotalItemsDiscount: 15.00,
-			TotalSaleDiscount:  5.00,
-			TotalAmount:        180.00,
+			TotalItemsAmount:   money.New(200),
+			TotalItemsDiscount: money.New(15),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(180),
 			PaymentType:        "credit",
 			Status:             "completed",
 			Notes:              "Updated sale notes",
@@ -531,9 +570,10 @@ func TestSaleRepo_Update(t *testing.T) {
 			payment_type         = $8,
 			status               = $9,
 			notes                = $10,
+			client_cnpj_id       = $11,
 			updated_at           = NOW(),
 			version              = version + 1
-		WHERE id = $11
+		WHERE id = $12
 		RETURNING updated_at, version
 	`
 		mockDB.On("QueryRow", ctx, updateQuery, []interface{}{
@@ -547,6 +587,7 @@ func TestSaleRepo_Update(t *testing.T) {
 			sale.PaymentType,
 			sale.Status,
 			sale.Notes,
+			sale.ClientCnpjID,
 			sale.ID,
 		}).Return(mockRowUpdate)
 
@@ -569,10 +610,10 @@ func TestSaleRepo_Update(t *testing.T) {
 			ID: 1,
 			// ClientID e UserID são nil
 			SaleDate:           time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
-			TotalItemsAmount:   200.00,
-			TotalItemsDiscount: 15.00,
-			TotalSaleDiscount:  5.00,
-			TotalAmount:        180.00,
+			TotalItemsAmount:   money.New(200),
+			TotalItemsDiscount: money.New(15),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(180),
 			PaymentType:        "credit",
 			Status:             "completed",
 			Notes:              "Updated sale notes",
@@ -612,9 +653,10 @@ func TestSaleRepo_Update(t *testing.T) {
 			payment_type         = $8,
 			status               = $9,
 			notes                = $10,
+			client_cnpj_id       = $11,
 			updated_at           = NOW(),
 			version              = version + 1
-		WHERE id = $11
+		WHERE id = $12
 		RETURNING updated_at, version
 	`
 		mockDB.On("QueryRow", ctx, updateQuery, []interface{}{
@@ -628,6 +670,7 @@ func TestSaleRepo_Update(t *testing.T) {
 			sale.PaymentType,
 			sale.Status,
 			sale.Notes,
+			sale.ClientCnpjID,
 			sale.ID,
 		}).Return(mockRowUpdate)
 
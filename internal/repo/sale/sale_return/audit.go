@@ -0,0 +1,43 @@
+package repo
+
+import (
+	"context"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	modelAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/jackc/pgx/v5"
+)
+
+const auditEntity = "sale_return"
+
+// auditedReturnTx registra cada devolução na transação que a grava: se o
+// registro falhar, a escrita é desfeita.
+type auditedReturnTx struct {
+	iface.SaleReturnTx
+	rec audit.Recorder
+}
+
+func WithAuditTx(inner iface.SaleReturnTx, rec audit.Recorder) iface.SaleReturnTx {
+	return &auditedReturnTx{SaleReturnTx: inner, rec: rec}
+}
+
+func (r *auditedReturnTx) CreateTx(ctx context.Context, tx pgx.Tx, saleReturn *models.SaleReturn) (*models.SaleReturn, error) {
+	created, err := r.SaleReturnTx.CreateTx(ctx, tx, saleReturn)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.rec.RecordTx(ctx, tx, audit.Entry{
+		EntityType: auditEntity,
+		EntityID:   created.ID,
+		Action:     modelAudit.ActionCreate,
+		After:      created,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
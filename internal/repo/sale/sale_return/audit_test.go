@@ -0,0 +1,64 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockAudit "github.com/WagaoCarvalho/backend_store_go/infra/mock/audit"
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	mockSale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	modelAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditedReturnTx(t *testing.T) {
+	ctx := context.Background()
+	tx := new(mockDb.MockTx)
+
+	t.Run("create records the new row in the transaction", func(t *testing.T) {
+		inner := new(mockSale.MockSaleReturnTx)
+		rec := new(mockAudit.MockRecorder)
+		saleReturn := &models.SaleReturn{SaleID: 1}
+		created := &models.SaleReturn{ID: 4, SaleID: 1}
+
+		inner.On("CreateTx", ctx, tx, saleReturn).Return(created, nil).Once()
+		rec.On("RecordTx", ctx, tx, audit.Entry{EntityType: "sale_return", EntityID: 4, Action: modelAudit.ActionCreate, After: created}).Return(nil).Once()
+
+		result, err := WithAuditTx(inner, rec).CreateTx(ctx, tx, saleReturn)
+
+		assert.NoError(t, err)
+		assert.Same(t, created, result)
+		rec.AssertExpectations(t)
+	})
+
+	t.Run("audit failure fails the create", func(t *testing.T) {
+		inner := new(mockSale.MockSaleReturnTx)
+		rec := new(mockAudit.MockRecorder)
+		saleReturn := &models.SaleReturn{SaleID: 1}
+
+		inner.On("CreateTx", ctx, tx, saleReturn).Return(&models.SaleReturn{ID: 4}, nil).Once()
+		rec.On("RecordTx", ctx, tx, mock.Anything).Return(errors.New("audit fail")).Once()
+
+		result, err := WithAuditTx(inner, rec).CreateTx(ctx, tx, saleReturn)
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "audit fail")
+	})
+
+	t.Run("failed create is not recorded", func(t *testing.T) {
+		inner := new(mockSale.MockSaleReturnTx)
+		rec := new(mockAudit.MockRecorder)
+		saleReturn := &models.SaleReturn{SaleID: 1}
+
+		inner.On("CreateTx", ctx, tx, saleReturn).Return(nil, errors.New("db fail")).Once()
+
+		_, err := WithAuditTx(inner, rec).CreateTx(ctx, tx, saleReturn)
+
+		assert.EqualError(t, err, "db fail")
+		rec.AssertNotCalled(t, "RecordTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
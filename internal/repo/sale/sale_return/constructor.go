@@ -0,0 +1,14 @@
+package repo
+
+import (
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+)
+
+type saleReturnRepo struct {
+	db repo.DBExecutor
+}
+
+func NewSaleReturn(db repo.DBExecutor) iface.SaleReturnReader {
+	return &saleReturnRepo{db: db}
+}
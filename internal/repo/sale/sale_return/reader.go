@@ -0,0 +1,66 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (r *saleReturnRepo) GetBySaleID(ctx context.Context, saleID int64) ([]*models.SaleReturn, error) {
+	const query = `
+		SELECT 
+			r.id, r.sale_id, r.user_id, COALESCE(r.reason, ''), r.total_amount, r.created_at,
+			ri.id, ri.sale_item_id, ri.product_id, ri.quantity, ri.amount, ri.created_at
+		FROM sale_returns r
+		JOIN sale_return_items ri ON ri.sale_return_id = r.id
+		WHERE r.sale_id = $1
+		ORDER BY r.id ASC, ri.id ASC;
+	`
+
+	rows, err := r.db.Query(ctx, query, saleID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	var result []*models.SaleReturn
+	var current *models.SaleReturn
+
+	for rows.Next() {
+		var ret models.SaleReturn
+		var item models.SaleReturnItem
+
+		if err := rows.Scan(
+			&ret.ID,
+			&ret.SaleID,
+			&ret.UserID,
+			&ret.Reason,
+			&ret.TotalAmount,
+			&ret.CreatedAt,
+			&item.ID,
+			&item.SaleItemID,
+			&item.ProductID,
+			&item.Quantity,
+			&item.Amount,
+			&item.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+		}
+
+		if current == nil || current.ID != ret.ID {
+			current = &ret
+			result = append(result, current)
+		}
+
+		item.SaleReturnID = current.ID
+		current.Items = append(current.Items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return result, nil
+}
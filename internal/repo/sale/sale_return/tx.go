@@ -0,0 +1,115 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+)
+
+type saleReturnTx struct{}
+
+func NewSaleReturnTx() iface.SaleReturnTx {
+	return &saleReturnTx{}
+}
+
+func (r *saleReturnTx) CreateTx(ctx context.Context, tx pgx.Tx, saleReturn *models.SaleReturn) (*models.SaleReturn, error) {
+	const queryReturn = `
+		INSERT INTO sale_returns (sale_id, user_id, reason, total_amount, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		RETURNING id, created_at;
+	`
+
+	const queryItem = `
+		INSERT INTO sale_return_items (sale_return_id, sale_item_id, product_id, quantity, amount, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING id, created_at;
+	`
+
+	err := tx.QueryRow(ctx, queryReturn,
+		saleReturn.SaleID,
+		saleReturn.UserID,
+		saleReturn.Reason,
+		saleReturn.TotalAmount,
+	).Scan(&saleReturn.ID, &saleReturn.CreatedAt)
+	if err != nil {
+		if errMsgPg.IsForeignKeyViolation(err) {
+			return nil, errMsg.ErrDBInvalidForeignKey
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	for i := range saleReturn.Items {
+		item := &saleReturn.Items[i]
+		item.SaleReturnID = saleReturn.ID
+
+		err := tx.QueryRow(ctx, queryItem,
+			item.SaleReturnID,
+			item.SaleItemID,
+			item.ProductID,
+			item.Quantity,
+			item.Amount,
+		).Scan(&item.ID, &item.CreatedAt)
+		if err != nil {
+			if errMsgPg.IsForeignKeyViolation(err) {
+				return nil, errMsg.ErrDBInvalidForeignKey
+			}
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+		}
+	}
+
+	return saleReturn, nil
+}
+
+// GetReturnedQuantitiesTx retorna, por sale_item_id, a quantidade já devolvida da venda.
+func (r *saleReturnTx) GetReturnedQuantitiesTx(ctx context.Context, tx pgx.Tx, saleID int64) (map[int64]int, error) {
+	const query = `
+		SELECT ri.sale_item_id, SUM(ri.quantity)
+		FROM sale_return_items ri
+		JOIN sale_returns r ON r.id = ri.sale_return_id
+		WHERE r.sale_id = $1
+		GROUP BY ri.sale_item_id;
+	`
+
+	rows, err := tx.Query(ctx, query, saleID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	returned := make(map[int64]int)
+	for rows.Next() {
+		var itemID int64
+		var quantity int
+		if err := rows.Scan(&itemID, &quantity); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+		}
+		returned[itemID] = quantity
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return returned, nil
+}
+
+func (r *saleReturnTx) GetRefundedAmountTx(ctx context.Context, tx pgx.Tx, saleID int64) (money.Money, error) {
+	const query = `
+		SELECT COALESCE(SUM(total_amount), 0)
+		FROM sale_returns
+		WHERE sale_id = $1;
+	`
+
+	var amount money.Money
+	if err := tx.QueryRow(ctx, query, saleID).Scan(&amount); err != nil {
+		return money.Zero, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return amount, nil
+}
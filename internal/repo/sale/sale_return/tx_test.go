@@ -0,0 +1,209 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewSaleReturnTx(t *testing.T) {
+	result := NewSaleReturnTx()
+
+	assert.NotNil(t, result)
+	_, ok := result.(*saleReturnTx)
+	assert.True(t, ok, "Expected result to be of type *saleReturnTx")
+}
+
+func TestSaleReturnTx_CreateTx(t *testing.T) {
+	newReturn := func() *models.SaleReturn {
+		return &models.SaleReturn{
+			SaleID:      10,
+			Reason:      "defeito",
+			TotalAmount: money.New(30),
+			Items: []models.SaleReturnItem{
+				{SaleItemID: 5, ProductID: 20, Quantity: 1, Amount: money.New(10)},
+				{SaleItemID: 6, ProductID: 21, Quantity: 2, Amount: money.New(20)},
+			},
+		}
+	}
+
+	headerArgs := func(r *models.SaleReturn) []interface{} {
+		return []interface{}{r.SaleID, r.UserID, r.Reason, r.TotalAmount}
+	}
+
+	t.Run("successfully create return with items", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleReturnTx{}
+		ctx := context.Background()
+		ret := newReturn()
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, headerArgs(ret)).
+			Return(&mockDb.MockRowWithIDArgs{Values: []interface{}{int64(1), now}}).Once()
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), int64(5), int64(20), 1, money.New(10)}).
+			Return(&mockDb.MockRowWithIDArgs{Values: []interface{}{int64(100), now}}).Once()
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), int64(6), int64(21), 2, money.New(20)}).
+			Return(&mockDb.MockRowWithIDArgs{Values: []interface{}{int64(101), now}}).Once()
+
+		result, err := repo.CreateTx(ctx, mockTx, ret)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), result.ID)
+		assert.Equal(t, int64(100), result.Items[0].ID)
+		assert.Equal(t, int64(1), result.Items[1].SaleReturnID)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrDBInvalidForeignKey when header insert violates foreign key", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleReturnTx{}
+		ctx := context.Background()
+		ret := newReturn()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, headerArgs(ret)).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}})
+
+		result, err := repo.CreateTx(ctx, mockTx, ret)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("return ErrCreate when header insert fails", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleReturnTx{}
+		ctx := context.Background()
+		ret := newReturn()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, headerArgs(ret)).
+			Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		result, err := repo.CreateTx(ctx, mockTx, ret)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+
+	t.Run("return ErrCreate when item insert fails", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleReturnTx{}
+		ctx := context.Background()
+		ret := newReturn()
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, headerArgs(ret)).
+			Return(&mockDb.MockRowWithIDArgs{Values: []interface{}{int64(1), now}}).Once()
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), int64(5), int64(20), 1, money.New(10)}).
+			Return(&mockDb.MockRow{Err: errors.New("db down")}).Once()
+
+		result, err := repo.CreateTx(ctx, mockTx, ret)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+
+	t.Run("return ErrDBInvalidForeignKey when item insert violates foreign key", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleReturnTx{}
+		ctx := context.Background()
+		ret := newReturn()
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, headerArgs(ret)).
+			Return(&mockDb.MockRowWithIDArgs{Values: []interface{}{int64(1), now}}).Once()
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), int64(5), int64(20), 1, money.New(10)}).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}}).Once()
+
+		result, err := repo.CreateTx(ctx, mockTx, ret)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+}
+
+func TestSaleReturnTx_GetReturnedQuantitiesTx(t *testing.T) {
+	t.Run("successfully sum returned quantities by item", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleReturnTx{}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{
+			Rows: []*mockDb.MockRow{
+				{Values: []any{int64(5), 2}},
+				{Values: []any{int64(6), 1}},
+			},
+		}
+		mockTx.On("Query", ctx, mock.Anything, []any{int64(10)}).Return(mockRows, nil)
+
+		result, err := repo.GetReturnedQuantitiesTx(ctx, mockTx, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[int64]int{5: 2, 6: 1}, result)
+	})
+
+	t.Run("return ErrGet when query fails", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleReturnTx{}
+		ctx := context.Background()
+
+		mockTx.On("Query", ctx, mock.Anything, []any{int64(10)}).Return(&mockDb.MockRows{}, errors.New("db down"))
+
+		result, err := repo.GetReturnedQuantitiesTx(ctx, mockTx, 10)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrGet when scan fails", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleReturnTx{}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan error")}}}
+		mockTx.On("Query", ctx, mock.Anything, []any{int64(10)}).Return(mockRows, nil)
+
+		result, err := repo.GetReturnedQuantitiesTx(ctx, mockTx, 10)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestSaleReturnTx_GetRefundedAmountTx(t *testing.T) {
+	t.Run("successfully sum refunded amount", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleReturnTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(10)}).
+			Return(&mockDb.MockRow{Values: []interface{}{45.5}})
+
+		amount, err := repo.GetRefundedAmountTx(ctx, mockTx, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, money.MustParse("45.50"), amount)
+	})
+
+	t.Run("return ErrGet on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &saleReturnTx{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(10)}).
+			Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		amount, err := repo.GetRefundedAmountTx(ctx, mockTx, 10)
+
+		assert.Zero(t, amount)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
@@ -0,0 +1,11 @@
+package repo
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+
+type serviceOrderFilterRepo struct {
+	db repo.DBExecutor
+}
+
+func NewFilterServiceOrder(db repo.DBExecutor) ServiceOrderFilter {
+	return &serviceOrderFilterRepo{db: db}
+}
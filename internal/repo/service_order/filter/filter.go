@@ -0,0 +1,139 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/filter"
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+	pagination "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/pagination"
+)
+
+var allowedServiceOrderSortFields = map[string]string{
+	"id":            "id",
+	"client_id":     "client_id",
+	"technician_id": "technician_id",
+	"status":        "status",
+	"total_amount":  "total_amount",
+	"created_at":    "created_at",
+	"updated_at":    "updated_at",
+}
+
+var serviceOrderOrderBy = builder.OrderBy{
+	Fields:       allowedServiceOrderSortFields,
+	DefaultField: "id",
+	DefaultOrder: "desc",
+}
+
+// Filter lista apenas os cabeçalhos; peças, mão de obra e categorias vêm em GetByID.
+func (r *serviceOrderFilterRepo) Filter(ctx context.Context, filter *filter.ServiceOrderFilter) (*commonFilter.Page[*model.ServiceOrder], error) {
+
+	base := filter.BaseFilter.WithDefaults()
+
+	query := `
+		SELECT
+			id,
+			client_id,
+			client_cnpj_id,
+			technician_id,
+			user_id,
+			status,
+			problem_description,
+			COALESCE(notes, ''),
+			parts_amount,
+			labor_amount,
+			total_amount,
+			sale_id,
+			version,
+			created_at,
+			updated_at
+		FROM order_services
+	`
+
+	b := builder.NewQueryBuilderSql(query)
+
+	b.Eq("client_id", filter.ClientID)
+	b.Eq("client_cnpj_id", filter.ClientCnpjID)
+	b.Eq("technician_id", filter.TechnicianID)
+	b.InSubquery("id", "order_service_parts", "order_service_id", "product_id", filter.ProductID)
+	b.InSubquery("id", "order_service_category_relations", "order_service_id", "category_id", filter.CategoryID)
+	b.Eq("status", filter.Status)
+	b.Range("created_at", filter.CreatedFrom, filter.CreatedTo)
+	b.Search(base.SearchTerm, "problem_description", "notes")
+
+	sortField, sortOrder := serviceOrderOrderBy.Resolve(filter.SortBy, filter.SortOrder)
+
+	args := b.GetArgs()
+	tail, pageArgs, err := pagination.Clause(base, sortField, sortOrder, b.NextArgPos())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, b.GetQuery()+tail, append(args, pageArgs...)...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	orders := make([]*model.ServiceOrder, 0)
+
+	for rows.Next() {
+		var o model.ServiceOrder
+		if err := rows.Scan(
+			&o.ID,
+			&o.ClientID,
+			&o.ClientCnpjID,
+			&o.TechnicianID,
+			&o.UserID,
+			&o.Status,
+			&o.ProblemDescription,
+			&o.Notes,
+			&o.PartsAmount,
+			&o.LaborAmount,
+			&o.TotalAmount,
+			&o.SaleID,
+			&o.Version,
+			&o.CreatedAt,
+			&o.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		orders = append(orders, &o)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	total, err := pagination.Count(ctx, r.db, "order_services", b.Where(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonFilter.NewPage(orders, total, base, func(m *model.ServiceOrder) (any, int64) {
+		return serviceOrderSortValue(m, sortField), m.ID
+	}), nil
+}
+
+// serviceOrderSortValue devolve o valor da coluna de ordenação field na ordem de serviço, gravado
+// no cursor da próxima página.
+func serviceOrderSortValue(m *model.ServiceOrder, field string) any {
+	switch field {
+	case "client_id":
+		return m.ClientID
+	case "technician_id":
+		return m.TechnicianID
+	case "status":
+		return m.Status
+	case "total_amount":
+		return m.TotalAmount
+	case "created_at":
+		return m.CreatedAt
+	case "updated_at":
+		return m.UpdatedAt
+	}
+	return m.ID
+}
@@ -0,0 +1,167 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	filterServiceOrder "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewFilterServiceOrder(t *testing.T) {
+	result := NewFilterServiceOrder(nil)
+
+	assert.NotNil(t, result)
+	_, ok := result.(*serviceOrderFilterRepo)
+	assert.True(t, ok, "Expected result to be of type *serviceOrderFilterRepo")
+}
+
+func TestServiceOrder_Filter(t *testing.T) {
+	t.Run("successfully filter by client and status", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &serviceOrderFilterRepo{db: mockDB}
+		ctx := context.Background()
+		now := time.Now()
+		clientID := int64(4)
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []interface{}{int64(1), int64(4), nil, int64(9), nil, "open", "não liga", "", 0.0, 0.0, 0.0, nil, 1, now, now}},
+		}}
+		mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "client_id = $1") &&
+				strings.Contains(q, "status = $2") &&
+				strings.Contains(q, "ORDER BY id desc LIMIT 50 OFFSET 0")
+		}), []interface{}{clientID, "open"}).Return(mockRows, nil)
+
+		mockDB.OnCount(1)
+		result, err := repo.Filter(ctx, &filterServiceOrder.ServiceOrderFilter{ClientID: &clientID, Status: "open"})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Items, 1)
+		assert.Equal(t, int64(4), *result.Items[0].ClientID)
+		assert.Equal(t, int64(9), *result.Items[0].TechnicianID)
+		assert.Equal(t, "não liga", result.Items[0].ProblemDescription)
+		assert.Nil(t, result.Items[0].SaleID)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("apply every filter and explicit sort", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &serviceOrderFilterRepo{db: mockDB}
+		ctx := context.Background()
+		cnpjID := int64(3)
+		technicianID := int64(9)
+		productID := int64(7)
+		categoryID := int64(2)
+		from := time.Now().Add(-time.Hour)
+		to := time.Now()
+
+		mockRows := new(mockDb.MockRows)
+		mockRows.On("Next").Return(false)
+		mockRows.On("Close").Return()
+		mockRows.On("Err").Return(nil)
+		mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "client_cnpj_id = $1") &&
+				strings.Contains(q, "technician_id = $2") &&
+				strings.Contains(q, "id IN (SELECT order_service_id FROM order_service_parts WHERE product_id = $3)") &&
+				strings.Contains(q, "id IN (SELECT order_service_id FROM order_service_category_relations WHERE category_id = $4)") &&
+				strings.Contains(q, "created_at >= $5") &&
+				strings.Contains(q, "created_at <= $6") &&
+				strings.Contains(q, "ORDER BY total_amount asc, id asc LIMIT 10 OFFSET 20")
+		}), []interface{}{cnpjID, technicianID, productID, categoryID, from, to}).Return(mockRows, nil)
+
+		mockDB.OnCount(0)
+		result, err := repo.Filter(ctx, &filterServiceOrder.ServiceOrderFilter{
+			BaseFilter:   filter.BaseFilter{Limit: 10, Offset: 20, SortBy: "total_amount", SortOrder: "asc"},
+			ClientCnpjID: &cnpjID,
+			TechnicianID: &technicianID,
+			ProductID:    &productID,
+			CategoryID:   &categoryID,
+			CreatedFrom:  &from,
+			CreatedTo:    &to,
+		})
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Items)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return ErrGet when query fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &serviceOrderFilterRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{}).Return(nil, errors.New("db down"))
+
+		result, err := repo.Filter(ctx, &filterServiceOrder.ServiceOrderFilter{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrScan when scan fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &serviceOrderFilterRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan")}}}
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{}).Return(mockRows, nil)
+
+		result, err := repo.Filter(ctx, &filterServiceOrder.ServiceOrderFilter{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+
+	t.Run("return ErrIterate when rows fail", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &serviceOrderFilterRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := new(mockDb.MockRows)
+		mockRows.On("Next").Return(false)
+		mockRows.On("Close").Return()
+		mockRows.On("Err").Return(errors.New("iter"))
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{}).Return(mockRows, nil)
+
+		result, err := repo.Filter(ctx, &filterServiceOrder.ServiceOrderFilter{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrIterate)
+	})
+}
+
+func TestServiceOrder_Filter_SearchTerm(t *testing.T) {
+	mockDB := new(mockDb.MockDatabase)
+	repo := &serviceOrderFilterRepo{db: mockDB}
+	ctx := context.Background()
+
+	mockRows := new(mockDb.MockRows)
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Err").Return(nil)
+	mockRows.On("Close").Return()
+
+	where := " WHERE 1=1 AND (problem_description ILIKE $1 OR notes ILIKE $1)"
+	args := []interface{}{`%50\%\_acme%`}
+
+	mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+		return strings.Contains(q, where+" ORDER BY")
+	}), args).Return(mockRows, nil)
+	mockDB.On("QueryRow", ctx, "SELECT COUNT(*) FROM order_services"+where, args).
+		Return(&mockDb.MockRow{Values: []interface{}{int64(0)}})
+
+	result, err := repo.Filter(ctx, &filterServiceOrder.ServiceOrderFilter{
+		BaseFilter: filter.BaseFilter{Limit: 10, SearchTerm: " 50%_acme "},
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Items)
+	mockDB.AssertExpectations(t)
+}
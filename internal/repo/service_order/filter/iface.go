@@ -0,0 +1,7 @@
+package repo
+
+import iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/service_order"
+
+type ServiceOrderFilter interface {
+	iface.ServiceOrderFilter
+}
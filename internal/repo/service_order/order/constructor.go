@@ -0,0 +1,13 @@
+package repo
+
+import (
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+)
+
+type serviceOrderRepo struct {
+	db repo.DBExecutor
+}
+
+func NewServiceOrder(db repo.DBExecutor) ServiceOrder {
+	return &serviceOrderRepo{db: db}
+}
@@ -0,0 +1,29 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *serviceOrderRepo) GetByID(ctx context.Context, id int64) (*models.ServiceOrder, error) {
+	query := `SELECT ` + orderColumns + ` FROM order_services WHERE id = $1;`
+
+	var order models.ServiceOrder
+	if err := scanOrderRow(r.db.QueryRow(ctx, query, id), &order); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	if err := loadDetails(ctx, r.db, &order); err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
@@ -0,0 +1,154 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+const orderColumns = `
+	id,
+	client_id,
+	client_cnpj_id,
+	technician_id,
+	user_id,
+	status,
+	problem_description,
+	COALESCE(notes, ''),
+	parts_amount,
+	labor_amount,
+	total_amount,
+	sale_id,
+	version,
+	created_at,
+	updated_at
+`
+
+// querier é atendido tanto pelo pool quanto por pgx.Tx.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func scanOrderRow(row pgx.Row, o *models.ServiceOrder) error {
+	return row.Scan(
+		&o.ID,
+		&o.ClientID,
+		&o.ClientCnpjID,
+		&o.TechnicianID,
+		&o.UserID,
+		&o.Status,
+		&o.ProblemDescription,
+		&o.Notes,
+		&o.PartsAmount,
+		&o.LaborAmount,
+		&o.TotalAmount,
+		&o.SaleID,
+		&o.Version,
+		&o.CreatedAt,
+		&o.UpdatedAt,
+	)
+}
+
+// loadDetails preenche peças, mão de obra e categorias da ordem.
+func loadDetails(ctx context.Context, q querier, order *models.ServiceOrder) error {
+	if err := loadParts(ctx, q, order); err != nil {
+		return err
+	}
+	if err := loadLabor(ctx, q, order); err != nil {
+		return err
+	}
+	return loadCategories(ctx, q, order)
+}
+
+func loadParts(ctx context.Context, q querier, order *models.ServiceOrder) error {
+	const query = `
+		SELECT id, product_id, quantity, unit_price, created_at
+		FROM order_service_parts
+		WHERE order_service_id = $1
+		ORDER BY id ASC;
+	`
+
+	rows, err := q.Query(ctx, query, order.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	order.Parts = make([]models.ServiceOrderPart, 0)
+	for rows.Next() {
+		part := models.ServiceOrderPart{ServiceOrderID: order.ID}
+		if err := rows.Scan(&part.ID, &part.ProductID, &part.Quantity, &part.UnitPrice, &part.CreatedAt); err != nil {
+			return fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		order.Parts = append(order.Parts, part)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return nil
+}
+
+func loadLabor(ctx context.Context, q querier, order *models.ServiceOrder) error {
+	const query = `
+		SELECT id, description, amount, created_at
+		FROM order_service_labor
+		WHERE order_service_id = $1
+		ORDER BY id ASC;
+	`
+
+	rows, err := q.Query(ctx, query, order.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	order.Labor = make([]models.ServiceOrderLabor, 0)
+	for rows.Next() {
+		labor := models.ServiceOrderLabor{ServiceOrderID: order.ID}
+		if err := rows.Scan(&labor.ID, &labor.Description, &labor.Amount, &labor.CreatedAt); err != nil {
+			return fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		order.Labor = append(order.Labor, labor)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return nil
+}
+
+func loadCategories(ctx context.Context, q querier, order *models.ServiceOrder) error {
+	const query = `
+		SELECT category_id
+		FROM order_service_category_relations
+		WHERE order_service_id = $1
+		ORDER BY category_id ASC;
+	`
+
+	rows, err := q.Query(ctx, query, order.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	order.CategoryIDs = make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		order.CategoryIDs = append(order.CategoryIDs, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return nil
+}
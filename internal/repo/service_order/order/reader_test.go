@@ -0,0 +1,160 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func orderRowValues(now time.Time) []interface{} {
+	return []interface{}{
+		int64(1), int64(4), nil, int64(9), int64(2), "in_progress", "tela quebrada", "urgente",
+		20.0, 50.0, 70.0, nil, 3, now, now,
+	}
+}
+
+func TestNewServiceOrder(t *testing.T) {
+	result := NewServiceOrder(nil)
+
+	assert.NotNil(t, result)
+	_, ok := result.(*serviceOrderRepo)
+	assert.True(t, ok, "Expected result to be of type *serviceOrderRepo")
+}
+
+func TestServiceOrder_GetByID(t *testing.T) {
+	t.Run("successfully get order with parts, labor and categories", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &serviceOrderRepo{db: mockDB}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Values: orderRowValues(now)})
+
+		parts := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []interface{}{int64(10), int64(7), 2, nil, now}},
+		}}
+		labor := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []interface{}{int64(20), "troca de tela", 50.0, now}},
+		}}
+		categories := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []interface{}{int64(1)}},
+			{Values: []interface{}{int64(3)}},
+		}}
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).Return(parts, nil).Once()
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).Return(labor, nil).Once()
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).Return(categories, nil).Once()
+
+		result, err := repo.GetByID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), *result.ClientID)
+		assert.Nil(t, result.ClientCnpjID)
+		assert.Equal(t, int64(9), *result.TechnicianID)
+		assert.Equal(t, "tela quebrada", result.ProblemDescription)
+		assert.Equal(t, money.New(70), result.TotalAmount)
+		assert.Equal(t, 3, result.Version)
+		assert.Len(t, result.Parts, 1)
+		assert.Equal(t, int64(1), result.Parts[0].ServiceOrderID)
+		assert.Len(t, result.Labor, 1)
+		assert.Equal(t, "troca de tela", result.Labor[0].Description)
+		assert.Equal(t, []int64{1, 3}, result.CategoryIDs)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when order does not exist", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &serviceOrderRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		result, err := repo.GetByID(ctx, 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrGet when query fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &serviceOrderRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		result, err := repo.GetByID(ctx, 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrGet when parts query fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &serviceOrderRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(1)}})
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).Return(nil, errors.New("db down"))
+
+		result, err := repo.GetByID(ctx, 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrScan when labor scan fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &serviceOrderRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(1)}})
+		emptyParts := new(mockDb.MockRows)
+		emptyParts.On("Next").Return(false)
+		emptyParts.On("Close").Return()
+		emptyParts.On("Err").Return(nil)
+		labor := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan")}}}
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).Return(emptyParts, nil).Once()
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).Return(labor, nil).Once()
+
+		result, err := repo.GetByID(ctx, 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+
+	t.Run("return ErrIterate when categories iteration fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &serviceOrderRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(1)}})
+		empty := func(iterErr error) *mockDb.MockRows {
+			rows := new(mockDb.MockRows)
+			rows.On("Next").Return(false)
+			rows.On("Close").Return()
+			rows.On("Err").Return(iterErr)
+			return rows
+		}
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).Return(empty(nil), nil).Once()
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).Return(empty(nil), nil).Once()
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).Return(empty(errors.New("iter")), nil).Once()
+
+		result, err := repo.GetByID(ctx, 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrIterate)
+	})
+}
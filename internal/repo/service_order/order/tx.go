@@ -0,0 +1,293 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/service_order"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+type serviceOrderTxRepo struct {
+	db repo.DBTransactor
+}
+
+func NewServiceOrderTx(db repo.DBTransactor) iface.ServiceOrderTx {
+	return &serviceOrderTxRepo{db: db}
+}
+
+func (r *serviceOrderTxRepo) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return r.db.BeginTx(ctx, pgx.TxOptions{})
+}
+
+func (r *serviceOrderTxRepo) CreateTx(ctx context.Context, tx pgx.Tx, order *models.ServiceOrder) (*models.ServiceOrder, error) {
+	const query = `
+		INSERT INTO order_services (
+			client_id,
+			client_cnpj_id,
+			technician_id,
+			user_id,
+			status,
+			problem_description,
+			notes,
+			created_at,
+			updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		RETURNING id, version, created_at, updated_at;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		order.ClientID,
+		order.ClientCnpjID,
+		order.TechnicianID,
+		order.UserID,
+		order.Status,
+		order.ProblemDescription,
+		order.Notes,
+	).Scan(&order.ID, &order.Version, &order.CreatedAt, &order.UpdatedAt)
+	if err != nil {
+		switch {
+		case errMsgPg.IsForeignKeyViolation(err):
+			return nil, errMsg.ErrDBInvalidForeignKey
+		case errMsgPg.IsCheckViolation(err):
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+		default:
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+		}
+	}
+
+	if err := insertCategoriesTx(ctx, tx, order); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func insertCategoriesTx(ctx context.Context, tx pgx.Tx, order *models.ServiceOrder) error {
+	const query = `
+		INSERT INTO order_service_category_relations (order_service_id, category_id, created_at)
+		VALUES ($1, $2, NOW());
+	`
+
+	for _, categoryID := range order.CategoryIDs {
+		if _, err := tx.Exec(ctx, query, order.ID, categoryID); err != nil {
+			if errMsgPg.IsForeignKeyViolation(err) {
+				return errMsg.ErrDBInvalidForeignKey
+			}
+			if ok, constraint := errMsgPg.IsUniqueViolation(err); ok {
+				return fmt.Errorf("%w: %s", errMsg.ErrDuplicate, constraint)
+			}
+			return fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+		}
+	}
+
+	return nil
+}
+
+// GetByIDForUpdateTx bloqueia a ordem até o fim da transação e carrega peças,
+// mão de obra e categorias.
+func (r *serviceOrderTxRepo) GetByIDForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.ServiceOrder, error) {
+	query := `SELECT ` + orderColumns + ` FROM order_services WHERE id = $1 FOR UPDATE;`
+
+	var order models.ServiceOrder
+	if err := scanOrderRow(tx.QueryRow(ctx, query, id), &order); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	if err := loadDetails(ctx, tx, &order); err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+// UpdateTx grava o cabeçalho e substitui as categorias da ordem.
+func (r *serviceOrderTxRepo) UpdateTx(ctx context.Context, tx pgx.Tx, order *models.ServiceOrder) error {
+	const query = `
+		UPDATE order_services
+		SET client_id           = $2,
+		    client_cnpj_id      = $3,
+		    technician_id       = $4,
+		    problem_description = $5,
+		    notes               = $6,
+		    version             = version + 1,
+		    updated_at          = NOW()
+		WHERE id = $1
+		RETURNING version, updated_at;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		order.ID,
+		order.ClientID,
+		order.ClientCnpjID,
+		order.TechnicianID,
+		order.ProblemDescription,
+		order.Notes,
+	).Scan(&order.Version, &order.UpdatedAt)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return errMsg.ErrNotFound
+		case errMsgPg.IsForeignKeyViolation(err):
+			return errMsg.ErrDBInvalidForeignKey
+		case errMsgPg.IsCheckViolation(err):
+			return fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+		default:
+			return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+		}
+	}
+
+	const deleteCategories = `DELETE FROM order_service_category_relations WHERE order_service_id = $1;`
+	if _, err := tx.Exec(ctx, deleteCategories, order.ID); err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	return insertCategoriesTx(ctx, tx, order)
+}
+
+func (r *serviceOrderTxRepo) UpdateStatusTx(ctx context.Context, tx pgx.Tx, order *models.ServiceOrder) error {
+	const query = `
+		UPDATE order_services
+		SET status     = $2,
+		    version    = version + 1,
+		    updated_at = NOW()
+		WHERE id = $1
+		RETURNING version, updated_at;
+	`
+
+	return r.updateReturningVersion(ctx, tx, order, query, order.ID, order.Status)
+}
+
+// UpdateTotalsTx grava os totais já calculados em order (ver CalculateTotals).
+func (r *serviceOrderTxRepo) UpdateTotalsTx(ctx context.Context, tx pgx.Tx, order *models.ServiceOrder) error {
+	const query = `
+		UPDATE order_services
+		SET parts_amount = $2,
+		    labor_amount = $3,
+		    total_amount = $4,
+		    version      = version + 1,
+		    updated_at   = NOW()
+		WHERE id = $1
+		RETURNING version, updated_at;
+	`
+
+	return r.updateReturningVersion(ctx, tx, order, query,
+		order.ID, order.PartsAmount, order.LaborAmount, order.TotalAmount)
+}
+
+// SetSaleTx vincula a venda gerada no faturamento da ordem.
+func (r *serviceOrderTxRepo) SetSaleTx(ctx context.Context, tx pgx.Tx, order *models.ServiceOrder) error {
+	const query = `
+		UPDATE order_services
+		SET sale_id    = $2,
+		    version    = version + 1,
+		    updated_at = NOW()
+		WHERE id = $1
+		RETURNING version, updated_at;
+	`
+
+	err := tx.QueryRow(ctx, query, order.ID, order.SaleID).Scan(&order.Version, &order.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errMsg.ErrNotFound
+		}
+		if ok, constraint := errMsgPg.IsUniqueViolation(err); ok {
+			return fmt.Errorf("%w: %s", errMsg.ErrDuplicate, constraint)
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	return nil
+}
+
+func (r *serviceOrderTxRepo) updateReturningVersion(
+	ctx context.Context,
+	tx pgx.Tx,
+	order *models.ServiceOrder,
+	query string,
+	args ...any,
+) error {
+	err := tx.QueryRow(ctx, query, args...).Scan(&order.Version, &order.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errMsg.ErrNotFound
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+	}
+
+	return nil
+}
+
+func (r *serviceOrderTxRepo) AddPartTx(ctx context.Context, tx pgx.Tx, part *models.ServiceOrderPart) (*models.ServiceOrderPart, error) {
+	const query = `
+		INSERT INTO order_service_parts (order_service_id, product_id, quantity, unit_price, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		RETURNING id, created_at;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		part.ServiceOrderID,
+		part.ProductID,
+		part.Quantity,
+		part.UnitPrice,
+	).Scan(&part.ID, &part.CreatedAt)
+	if err != nil {
+		if errMsgPg.IsForeignKeyViolation(err) {
+			return nil, errMsg.ErrDBInvalidForeignKey
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	return part, nil
+}
+
+func (r *serviceOrderTxRepo) DeletePartTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	return deleteByID(ctx, tx, `DELETE FROM order_service_parts WHERE id = $1;`, id)
+}
+
+func (r *serviceOrderTxRepo) AddLaborTx(ctx context.Context, tx pgx.Tx, labor *models.ServiceOrderLabor) (*models.ServiceOrderLabor, error) {
+	const query = `
+		INSERT INTO order_service_labor (order_service_id, description, amount, created_at)
+		VALUES ($1, $2, $3, NOW())
+		RETURNING id, created_at;
+	`
+
+	err := tx.QueryRow(ctx, query,
+		labor.ServiceOrderID,
+		labor.Description,
+		labor.Amount,
+	).Scan(&labor.ID, &labor.CreatedAt)
+	if err != nil {
+		if errMsgPg.IsForeignKeyViolation(err) {
+			return nil, errMsg.ErrDBInvalidForeignKey
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	return labor, nil
+}
+
+func (r *serviceOrderTxRepo) DeleteLaborTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	return deleteByID(ctx, tx, `DELETE FROM order_service_labor WHERE id = $1;`, id)
+}
+
+func deleteByID(ctx context.Context, tx pgx.Tx, query string, id int64) error {
+	tag, err := tx.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrDelete, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errMsg.ErrNotFound
+	}
+
+	return nil
+}
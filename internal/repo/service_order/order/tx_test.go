@@ -0,0 +1,504 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewServiceOrderTx(t *testing.T) {
+	result := NewServiceOrderTx(nil)
+
+	assert.NotNil(t, result)
+	_, ok := result.(*serviceOrderTxRepo)
+	assert.True(t, ok, "Expected result to be of type *serviceOrderTxRepo")
+}
+
+func TestServiceOrderTx_BeginTx(t *testing.T) {
+	mockDB := new(mockDb.MockDBTransactor)
+	repo := &serviceOrderTxRepo{db: mockDB}
+	ctx := context.Background()
+
+	mockTx := new(mockDb.MockTx)
+	mockDB.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+
+	tx, err := repo.BeginTx(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, mockTx, tx)
+	mockDB.AssertExpectations(t)
+}
+
+func TestServiceOrderTx_CreateTx(t *testing.T) {
+	newOrder := func() *models.ServiceOrder {
+		clientID := int64(4)
+		return &models.ServiceOrder{
+			ClientID:           &clientID,
+			Status:             models.StatusOpen,
+			ProblemDescription: "não liga",
+			CategoryIDs:        []int64{2},
+		}
+	}
+
+	orderArgs := func(o *models.ServiceOrder) []interface{} {
+		return []interface{}{o.ClientID, o.ClientCnpjID, o.TechnicianID, o.UserID, o.Status, o.ProblemDescription, o.Notes}
+	}
+
+	t.Run("successfully create order with categories", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(1), 1, now, now}}).Once()
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(1), int64(2)}).
+			Return(pgconn.NewCommandTag("INSERT 0 1"), nil).Once()
+
+		result, err := repo.CreateTx(ctx, mockTx, order)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), result.ID)
+		assert.Equal(t, 1, result.Version)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrDBInvalidForeignKey when client does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}})
+
+		result, err := repo.CreateTx(ctx, mockTx, order)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("return ErrInvalidData on check violation", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23514"}})
+
+		result, err := repo.CreateTx(ctx, mockTx, order)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("return ErrCreate on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).
+			Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		result, err := repo.CreateTx(ctx, mockTx, order)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+
+	t.Run("return ErrDBInvalidForeignKey when category does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(1), 1, time.Now(), time.Now()}}).Once()
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(1), int64(2)}).
+			Return(pgconn.CommandTag{}, &pgconn.PgError{Code: "23503"}).Once()
+
+		result, err := repo.CreateTx(ctx, mockTx, order)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+}
+
+func TestServiceOrderTx_GetByIDForUpdateTx(t *testing.T) {
+	t.Run("successfully lock order and load details", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Values: orderRowValues(now)})
+		mockTx.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRows{Rows: []*mockDb.MockRow{{Values: []interface{}{int64(10), int64(7), 2, nil, now}}}}, nil).Once()
+		mockTx.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRows{Rows: []*mockDb.MockRow{{Values: []interface{}{int64(20), "diagnóstico", 50.0, now}}}}, nil).Once()
+		mockTx.On("Query", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRows{Rows: []*mockDb.MockRow{{Values: []interface{}{int64(2)}}}}, nil).Once()
+
+		result, err := repo.GetByIDForUpdateTx(ctx, mockTx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusInProgress, result.Status)
+		assert.Len(t, result.Parts, 1)
+		assert.Len(t, result.Labor, 1)
+		assert.Equal(t, []int64{2}, result.CategoryIDs)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when order does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		result, err := repo.GetByIDForUpdateTx(ctx, mockTx, 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrGet on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		result, err := repo.GetByIDForUpdateTx(ctx, mockTx, 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestServiceOrderTx_UpdateTx(t *testing.T) {
+	newOrder := func() *models.ServiceOrder {
+		clientID := int64(4)
+		return &models.ServiceOrder{
+			ID:                 1,
+			ClientID:           &clientID,
+			ProblemDescription: "não liga",
+			CategoryIDs:        []int64{3},
+		}
+	}
+
+	orderArgs := func(o *models.ServiceOrder) []interface{} {
+		return []interface{}{o.ID, o.ClientID, o.ClientCnpjID, o.TechnicianID, o.ProblemDescription, o.Notes}
+	}
+
+	t.Run("successfully update header and replace categories", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).
+			Return(&mockDb.MockRow{Values: []interface{}{2, time.Now()}}).Once()
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(pgconn.NewCommandTag("DELETE 1"), nil).Once()
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(1), int64(3)}).
+			Return(pgconn.NewCommandTag("INSERT 0 1"), nil).Once()
+
+		err := repo.UpdateTx(ctx, mockTx, order)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, order.Version)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when order does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.UpdateTx(ctx, mockTx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrDBInvalidForeignKey when technician does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}})
+
+		err := repo.UpdateTx(ctx, mockTx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("return ErrUpdate when deleting categories fails", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		order := newOrder()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, orderArgs(order)).
+			Return(&mockDb.MockRow{Values: []interface{}{2, time.Now()}}).Once()
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(1)}).
+			Return(pgconn.CommandTag{}, errors.New("db down")).Once()
+
+		err := repo.UpdateTx(ctx, mockTx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
+
+func TestServiceOrderTx_UpdateStatusTx(t *testing.T) {
+	t.Run("successfully update status", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		order := &models.ServiceOrder{ID: 1, Status: models.StatusDone}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), models.StatusDone}).
+			Return(&mockDb.MockRow{Values: []interface{}{3, time.Now()}})
+
+		err := repo.UpdateStatusTx(ctx, mockTx, order)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, order.Version)
+	})
+
+	t.Run("return ErrNotFound when order does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		order := &models.ServiceOrder{ID: 1, Status: models.StatusDone}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), models.StatusDone}).
+			Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.UpdateStatusTx(ctx, mockTx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrUpdate on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		order := &models.ServiceOrder{ID: 1, Status: models.StatusDone}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), models.StatusDone}).
+			Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		err := repo.UpdateStatusTx(ctx, mockTx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+	})
+}
+
+func TestServiceOrderTx_UpdateTotalsTx(t *testing.T) {
+	mockTx := new(mockDb.MockTx)
+	repo := &serviceOrderTxRepo{}
+	ctx := context.Background()
+	order := &models.ServiceOrder{ID: 1, PartsAmount: money.New(20), LaborAmount: money.New(50), TotalAmount: money.New(70)}
+
+	mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), money.New(20), money.New(50), money.New(70)}).
+		Return(&mockDb.MockRow{Values: []interface{}{4, time.Now()}})
+
+	err := repo.UpdateTotalsTx(ctx, mockTx, order)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, order.Version)
+}
+
+func TestServiceOrderTx_SetSaleTx(t *testing.T) {
+	saleID := int64(99)
+
+	t.Run("successfully link sale", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		order := &models.ServiceOrder{ID: 1, SaleID: &saleID}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), &saleID}).
+			Return(&mockDb.MockRow{Values: []interface{}{5, time.Now()}})
+
+		err := repo.SetSaleTx(ctx, mockTx, order)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, order.Version)
+	})
+
+	t.Run("return ErrDuplicate when sale is already linked", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		order := &models.ServiceOrder{ID: 1, SaleID: &saleID}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), &saleID}).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23505", ConstraintName: "uq_order_services_sale_id"}})
+
+		err := repo.SetSaleTx(ctx, mockTx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrDuplicate)
+	})
+
+	t.Run("return ErrNotFound when order does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		order := &models.ServiceOrder{ID: 1, SaleID: &saleID}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), &saleID}).
+			Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		err := repo.SetSaleTx(ctx, mockTx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+}
+
+func TestServiceOrderTx_AddPartTx(t *testing.T) {
+	price := money.New(10)
+	newPart := func() *models.ServiceOrderPart {
+		return &models.ServiceOrderPart{ServiceOrderID: 1, ProductID: 7, Quantity: 2, UnitPrice: &price}
+	}
+
+	t.Run("successfully add part", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		part := newPart()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), int64(7), 2, &price}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(30), time.Now()}})
+
+		result, err := repo.AddPartTx(ctx, mockTx, part)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(30), result.ID)
+	})
+
+	t.Run("return ErrDBInvalidForeignKey when product does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		part := newPart()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), int64(7), 2, &price}).
+			Return(&mockDb.MockRow{Err: &pgconn.PgError{Code: "23503"}})
+
+		result, err := repo.AddPartTx(ctx, mockTx, part)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("return ErrCreate on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		part := newPart()
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), int64(7), 2, &price}).
+			Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		result, err := repo.AddPartTx(ctx, mockTx, part)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
+
+func TestServiceOrderTx_AddLaborTx(t *testing.T) {
+	t.Run("successfully add labor", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		labor := &models.ServiceOrderLabor{ServiceOrderID: 1, Description: "diagnóstico", Amount: money.New(50)}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), "diagnóstico", money.New(50)}).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(40), time.Now()}})
+
+		result, err := repo.AddLaborTx(ctx, mockTx, labor)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(40), result.ID)
+	})
+
+	t.Run("return ErrCreate on database error", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+		labor := &models.ServiceOrderLabor{ServiceOrderID: 1, Description: "diagnóstico", Amount: money.New(50)}
+
+		mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{int64(1), "diagnóstico", money.New(50)}).
+			Return(&mockDb.MockRow{Err: errors.New("db down")})
+
+		result, err := repo.AddLaborTx(ctx, mockTx, labor)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
+
+func TestServiceOrderTx_DeletePartTx(t *testing.T) {
+	t.Run("successfully delete part", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(30)}).
+			Return(pgconn.NewCommandTag("DELETE 1"), nil)
+
+		err := repo.DeletePartTx(ctx, mockTx, 30)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("return ErrNotFound when part does not exist", func(t *testing.T) {
+		mockTx := new(mockDb.MockTx)
+		repo := &serviceOrderTxRepo{}
+		ctx := context.Background()
+
+		mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(30)}).
+			Return(pgconn.NewCommandTag("DELETE 0"), nil)
+
+		err := repo.DeletePartTx(ctx, mockTx, 30)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+}
+
+func TestServiceOrderTx_DeleteLaborTx(t *testing.T) {
+	mockTx := new(mockDb.MockTx)
+	repo := &serviceOrderTxRepo{}
+	ctx := context.Background()
+
+	mockTx.On("Exec", ctx, mock.Anything, []interface{}{int64(40)}).
+		Return(pgconn.CommandTag{}, errors.New("db down"))
+
+	err := repo.DeleteLaborTx(ctx, mockTx, 40)
+
+	assert.ErrorIs(t, err, errMsg.ErrDelete)
+}
@@ -3,11 +3,13 @@ package repo
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/supplier/filter"
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/supplier/supplier"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+	pagination "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/pagination"
 )
 
 var allowedSupplierSortFields = map[string]string{
@@ -21,10 +23,16 @@ var allowedSupplierSortFields = map[string]string{
 	"updated_at": "updated_at",
 }
 
+var supplierOrderBy = builder.OrderBy{
+	Fields:       allowedSupplierSortFields,
+	DefaultField: "created_at",
+	DefaultOrder: "asc",
+}
+
 func (r *supplierFilterRepo) Filter(
 	ctx context.Context,
 	filter *filter.SupplierFilter,
-) ([]*model.Supplier, error) {
+) (*commonFilter.Page[*model.Supplier], error) {
 
 	base := filter.BaseFilter.WithDefaults()
 
@@ -40,80 +48,27 @@ func (r *supplierFilterRepo) Filter(
 			created_at,
 			updated_at
 		FROM suppliers
-		WHERE 1=1
 	`
 
-	args := []any{}
-	argPos := 1
-
-	if filter.Name != "" {
-		query += fmt.Sprintf(" AND name ILIKE $%d", argPos)
-		args = append(args, "%"+filter.Name+"%")
-		argPos++
-	}
-
-	if filter.CPF != "" {
-		query += fmt.Sprintf(" AND cpf = $%d", argPos)
-		args = append(args, filter.CPF)
-		argPos++
-	}
-
-	if filter.CNPJ != "" {
-		query += fmt.Sprintf(" AND cnpj = $%d", argPos)
-		args = append(args, filter.CNPJ)
-		argPos++
-	}
-
-	if filter.Status != nil {
-		query += fmt.Sprintf(" AND status = $%d", argPos)
-		args = append(args, *filter.Status)
-		argPos++
-	}
-
-	if filter.CreatedFrom != nil {
-		query += fmt.Sprintf(" AND created_at >= $%d", argPos)
-		args = append(args, *filter.CreatedFrom)
-		argPos++
-	}
-
-	if filter.CreatedTo != nil {
-		query += fmt.Sprintf(" AND created_at <= $%d", argPos)
-		args = append(args, *filter.CreatedTo)
-		argPos++
-	}
+	b := builder.NewQueryBuilderSql(query)
 
-	if filter.UpdatedFrom != nil {
-		query += fmt.Sprintf(" AND updated_at >= $%d", argPos)
-		args = append(args, *filter.UpdatedFrom)
-		argPos++
-	}
+	b.ILike("name", filter.Name)
+	b.Eq("cpf", filter.CPF)
+	b.Eq("cnpj", filter.CNPJ)
+	b.Eq("status", filter.Status)
+	b.Range("created_at", filter.CreatedFrom, filter.CreatedTo)
+	b.Range("updated_at", filter.UpdatedFrom, filter.UpdatedTo)
+	b.Search(base.SearchTerm, "name", "cpf", "cnpj", "description")
 
-	if filter.UpdatedTo != nil {
-		query += fmt.Sprintf(" AND updated_at <= $%d", argPos)
-		args = append(args, *filter.UpdatedTo)
-		argPos++
-	}
+	sortField, sortOrder := supplierOrderBy.Resolve(filter.SortBy, filter.SortOrder)
 
-	// ORDER BY seguro
-	sortField := "created_at"
-	if v, ok := allowedSupplierSortFields[strings.ToLower(base.SortBy)]; ok {
-		sortField = v
-	}
-
-	sortOrder := strings.ToLower(base.SortOrder)
-	if sortOrder != "asc" && sortOrder != "desc" {
-		sortOrder = "asc"
+	args := b.GetArgs()
+	tail, pageArgs, err := pagination.Clause(base, sortField, sortOrder, b.NextArgPos())
+	if err != nil {
+		return nil, err
 	}
 
-	query += fmt.Sprintf(
-		" ORDER BY %s %s LIMIT %d OFFSET %d",
-		sortField,
-		sortOrder,
-		base.Limit,
-		base.Offset,
-	)
-
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := r.db.Query(ctx, b.GetQuery()+tail, append(args, pageArgs...)...)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
 	}
@@ -144,5 +99,34 @@ func (r *supplierFilterRepo) Filter(
 		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
 	}
 
-	return suppliers, nil
+	total, err := pagination.Count(ctx, r.db, "suppliers", b.Where(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonFilter.NewPage(suppliers, total, base, func(m *model.Supplier) (any, int64) {
+		return supplierSortValue(m, sortField), m.ID
+	}), nil
+}
+
+// supplierSortValue devolve o valor da coluna de ordenação field no fornecedor, gravado
+// no cursor da próxima página.
+func supplierSortValue(m *model.Supplier, field string) any {
+	switch field {
+	case "name":
+		return m.Name
+	case "cpf":
+		return m.CPF
+	case "cnpj":
+		return m.CNPJ
+	case "status":
+		return m.Status
+	case "version":
+		return m.Version
+	case "created_at":
+		return m.CreatedAt
+	case "updated_at":
+		return m.UpdatedAt
+	}
+	return m.ID
 }
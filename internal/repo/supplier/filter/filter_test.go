@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -65,22 +66,23 @@ func TestSupplier_Filter(t *testing.T) {
 			On("Query", ctx, mock.Anything, mock.AnythingOfType("[]interface {}")).
 			Return(mockRows, nil)
 
+		mockDB.OnCount(1)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 1)
-
-		assert.Equal(t, int64(1), result[0].ID)
-		assert.Equal(t, "Fornecedor Teste", result[0].Name)
-		assert.NotNil(t, result[0].CPF)
-		assert.Equal(t, "123.456.789-00", *result[0].CPF)
-		assert.NotNil(t, result[0].CNPJ)
-		assert.Equal(t, "", *result[0].CNPJ)
-		assert.Equal(t, "Descrição do fornecedor", result[0].Description)
-		assert.True(t, result[0].Status)
-		assert.Equal(t, 1, result[0].Version)
-		assert.WithinDuration(t, now, result[0].CreatedAt, time.Second)
-		assert.WithinDuration(t, now, result[0].UpdatedAt, time.Second)
+		assert.Len(t, result.Items, 1)
+
+		assert.Equal(t, int64(1), result.Items[0].ID)
+		assert.Equal(t, "Fornecedor Teste", result.Items[0].Name)
+		assert.NotNil(t, result.Items[0].CPF)
+		assert.Equal(t, "123.456.789-00", *result.Items[0].CPF)
+		assert.NotNil(t, result.Items[0].CNPJ)
+		assert.Equal(t, "", *result.Items[0].CNPJ)
+		assert.Equal(t, "Descrição do fornecedor", result.Items[0].Description)
+		assert.True(t, result.Items[0].Status)
+		assert.Equal(t, 1, result.Items[0].Version)
+		assert.WithinDuration(t, now, result.Items[0].CreatedAt, time.Second)
+		assert.WithinDuration(t, now, result.Items[0].UpdatedAt, time.Second)
 
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
@@ -139,22 +141,23 @@ func TestSupplier_Filter(t *testing.T) {
 			On("Query", ctx, mock.Anything, mock.AnythingOfType("[]interface {}")).
 			Return(mockRows, nil)
 
+		mockDB.OnCount(1)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 1)
-
-		assert.Equal(t, int64(2), result[0].ID)
-		assert.Equal(t, "Fornecedor XYZ", result[0].Name)
-		assert.NotNil(t, result[0].CPF)
-		assert.Equal(t, "987.654.321-00", *result[0].CPF)
-		assert.NotNil(t, result[0].CNPJ)
-		assert.Equal(t, "12.345.678/0001-90", *result[0].CNPJ)
-		assert.Equal(t, "Outro fornecedor", result[0].Description)
-		assert.True(t, result[0].Status)
-		assert.Equal(t, 2, result[0].Version)
-		assert.WithinDuration(t, now.Add(-24*time.Hour), result[0].CreatedAt, time.Second)
-		assert.WithinDuration(t, now, result[0].UpdatedAt, time.Second)
+		assert.Len(t, result.Items, 1)
+
+		assert.Equal(t, int64(2), result.Items[0].ID)
+		assert.Equal(t, "Fornecedor XYZ", result.Items[0].Name)
+		assert.NotNil(t, result.Items[0].CPF)
+		assert.Equal(t, "987.654.321-00", *result.Items[0].CPF)
+		assert.NotNil(t, result.Items[0].CNPJ)
+		assert.Equal(t, "12.345.678/0001-90", *result.Items[0].CNPJ)
+		assert.Equal(t, "Outro fornecedor", result.Items[0].Description)
+		assert.True(t, result.Items[0].Status)
+		assert.Equal(t, 2, result.Items[0].Version)
+		assert.WithinDuration(t, now.Add(-24*time.Hour), result.Items[0].CreatedAt, time.Second)
+		assert.WithinDuration(t, now, result.Items[0].UpdatedAt, time.Second)
 
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
@@ -243,30 +246,31 @@ func TestSupplier_Filter(t *testing.T) {
 			On("Query", ctx, mock.Anything, mock.AnythingOfType("[]interface {}")).
 			Return(mockRows, nil)
 
+		mockDB.OnCount(2)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 2)
+		assert.Len(t, result.Items, 2)
 
 		// Verificar primeiro fornecedor
-		assert.Equal(t, int64(3), result[0].ID)
-		assert.Equal(t, "Fornecedor Inativo", result[0].Name)
-		assert.NotNil(t, result[0].CPF)
-		assert.Equal(t, "111.222.333-44", *result[0].CPF)
-		assert.Nil(t, result[0].CNPJ) // CNPJ deve ser nil
-		assert.Equal(t, "Fornecedor desativado", result[0].Description)
-		assert.False(t, result[0].Status)
-		assert.Equal(t, 3, result[0].Version)
+		assert.Equal(t, int64(3), result.Items[0].ID)
+		assert.Equal(t, "Fornecedor Inativo", result.Items[0].Name)
+		assert.NotNil(t, result.Items[0].CPF)
+		assert.Equal(t, "111.222.333-44", *result.Items[0].CPF)
+		assert.Nil(t, result.Items[0].CNPJ) // CNPJ deve ser nil
+		assert.Equal(t, "Fornecedor desativado", result.Items[0].Description)
+		assert.False(t, result.Items[0].Status)
+		assert.Equal(t, 3, result.Items[0].Version)
 
 		// Verificar segundo fornecedor
-		assert.Equal(t, int64(4), result[1].ID)
-		assert.Equal(t, "Fornecedor PJ", result[1].Name)
-		assert.Nil(t, result[1].CPF) // CPF deve ser nil
-		assert.NotNil(t, result[1].CNPJ)
-		assert.Equal(t, "98.765.432/0001-10", *result[1].CNPJ)
-		assert.Equal(t, "Fornecedor pessoa jurídica", result[1].Description)
-		assert.False(t, result[1].Status)
-		assert.Equal(t, 1, result[1].Version)
+		assert.Equal(t, int64(4), result.Items[1].ID)
+		assert.Equal(t, "Fornecedor PJ", result.Items[1].Name)
+		assert.Nil(t, result.Items[1].CPF) // CPF deve ser nil
+		assert.NotNil(t, result.Items[1].CNPJ)
+		assert.Equal(t, "98.765.432/0001-10", *result.Items[1].CNPJ)
+		assert.Equal(t, "Fornecedor pessoa jurídica", result.Items[1].Description)
+		assert.False(t, result.Items[1].Status)
+		assert.Equal(t, 1, result.Items[1].Version)
 
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
@@ -325,19 +329,20 @@ func TestSupplier_Filter(t *testing.T) {
 			On("Query", ctx, mock.Anything, mock.AnythingOfType("[]interface {}")).
 			Return(mockRows, nil)
 
+		mockDB.OnCount(1)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 1)
+		assert.Len(t, result.Items, 1)
 
-		assert.Equal(t, int64(1), result[0].ID)
-		assert.Equal(t, "Fornecedor Específico", result[0].Name)
-		assert.NotNil(t, result[0].CPF)
-		assert.Equal(t, "123.456.789-00", *result[0].CPF)
-		assert.NotNil(t, result[0].CNPJ)
-		assert.Equal(t, "12.345.678/0001-90", *result[0].CNPJ)
-		assert.Equal(t, "Fornecedor com CPF e CNPJ específicos", result[0].Description)
-		assert.True(t, result[0].Status)
+		assert.Equal(t, int64(1), result.Items[0].ID)
+		assert.Equal(t, "Fornecedor Específico", result.Items[0].Name)
+		assert.NotNil(t, result.Items[0].CPF)
+		assert.Equal(t, "123.456.789-00", *result.Items[0].CPF)
+		assert.NotNil(t, result.Items[0].CNPJ)
+		assert.Equal(t, "12.345.678/0001-90", *result.Items[0].CNPJ)
+		assert.Equal(t, "Fornecedor com CPF e CNPJ específicos", result.Items[0].Description)
+		assert.True(t, result.Items[0].Status)
 
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
@@ -429,20 +434,21 @@ func TestSupplier_Filter(t *testing.T) {
 			On("Query", ctx, mock.Anything, mock.AnythingOfType("[]interface {}")).
 			Return(mockRows, nil)
 
+		mockDB.OnCount(2)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 2)
+		assert.Len(t, result.Items, 2)
 
 		// Verificar ordenação por updated_at desc
-		assert.Equal(t, int64(1), result[0].ID) // updated_at mais recente primeiro
-		assert.Equal(t, "Fornecedor Atualizado Recente", result[0].Name)
-		assert.WithinDuration(t, now.Add(-48*time.Hour), result[0].UpdatedAt, time.Second)
+		assert.Equal(t, int64(1), result.Items[0].ID) // updated_at mais recente primeiro
+		assert.Equal(t, "Fornecedor Atualizado Recente", result.Items[0].Name)
+		assert.WithinDuration(t, now.Add(-48*time.Hour), result.Items[0].UpdatedAt, time.Second)
 
-		assert.Equal(t, int64(2), result[1].ID)
-		assert.Equal(t, "Fornecedor Atualizado Limite", result[1].Name)
-		assert.Nil(t, result[1].CNPJ) // CNPJ deve ser nil
-		assert.WithinDuration(t, now.Add(-72*time.Hour), result[1].UpdatedAt, time.Second)
+		assert.Equal(t, int64(2), result.Items[1].ID)
+		assert.Equal(t, "Fornecedor Atualizado Limite", result.Items[1].Name)
+		assert.Nil(t, result.Items[1].CNPJ) // CNPJ deve ser nil
+		assert.WithinDuration(t, now.Add(-72*time.Hour), result.Items[1].UpdatedAt, time.Second)
 
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
@@ -529,19 +535,20 @@ func TestSupplier_Filter(t *testing.T) {
 			On("Query", ctx, mock.Anything, mock.AnythingOfType("[]interface {}")).
 			Return(mockRows, nil)
 
+		mockDB.OnCount(2)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 2)
+		assert.Len(t, result.Items, 2)
 
 		// Verificar que ordenação é ASC (mais antigo primeiro)
-		assert.Equal(t, int64(1), result[0].ID) // Fornecedor A (mais antigo)
-		assert.Equal(t, "Fornecedor A", result[0].Name)
-		assert.WithinDuration(t, now.Add(-48*time.Hour), result[0].CreatedAt, time.Second)
+		assert.Equal(t, int64(1), result.Items[0].ID) // Fornecedor A (mais antigo)
+		assert.Equal(t, "Fornecedor A", result.Items[0].Name)
+		assert.WithinDuration(t, now.Add(-48*time.Hour), result.Items[0].CreatedAt, time.Second)
 
-		assert.Equal(t, int64(2), result[1].ID) // Fornecedor B (mais recente)
-		assert.Equal(t, "Fornecedor B", result[1].Name)
-		assert.WithinDuration(t, now.Add(-24*time.Hour), result[1].CreatedAt, time.Second)
+		assert.Equal(t, int64(2), result.Items[1].ID) // Fornecedor B (mais recente)
+		assert.Equal(t, "Fornecedor B", result.Items[1].Name)
+		assert.WithinDuration(t, now.Add(-24*time.Hour), result.Items[1].CreatedAt, time.Second)
 
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
@@ -679,4 +686,36 @@ func TestSupplier_Filter(t *testing.T) {
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
 	})
+
+	t.Run("search term looks up every text column with one argument", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &supplierFilterRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := new(mockDb.MockRows)
+		mockRows.On("Next").Return(false).Once()
+		mockRows.On("Err").Return(nil)
+		mockRows.On("Close").Return()
+
+		status := true
+		supplierFilter := &filterSupplier.SupplierFilter{
+			BaseFilter: filter.BaseFilter{Limit: 10, SearchTerm: "acme"},
+			Status:     &status,
+		}
+
+		where := " WHERE 1=1 AND status = $1 AND (name ILIKE $2 OR cpf ILIKE $2 OR cnpj ILIKE $2 OR description ILIKE $2)"
+		args := []interface{}{true, "%acme%"}
+
+		mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, where+" ORDER BY created_at asc, id asc LIMIT 10 OFFSET 0")
+		}), args).Return(mockRows, nil)
+		mockDB.On("QueryRow", ctx, "SELECT COUNT(*) FROM suppliers"+where, args).
+			Return(&mockDb.MockRow{Values: []interface{}{int64(0)}})
+
+		result, err := repo.Filter(ctx, supplierFilter)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Items)
+		mockDB.AssertExpectations(t)
+	})
 }
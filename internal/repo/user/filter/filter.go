@@ -3,11 +3,13 @@ package repo
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/user/filter"
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/user/user"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	builder "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/builder"
+	pagination "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/pagination"
 )
 
 var allowedUserSortFields = map[string]string{
@@ -20,10 +22,16 @@ var allowedUserSortFields = map[string]string{
 	"updated_at": "updated_at",
 }
 
+var userOrderBy = builder.OrderBy{
+	Fields:       allowedUserSortFields,
+	DefaultField: "created_at",
+	DefaultOrder: "asc",
+}
+
 func (r *userFilterRepo) Filter(
 	ctx context.Context,
 	filter *filter.UserFilter,
-) ([]*model.User, error) {
+) (*commonFilter.Page[*model.User], error) {
 
 	base := filter.BaseFilter.WithDefaults()
 
@@ -39,74 +47,26 @@ func (r *userFilterRepo) Filter(
 			created_at,
 			updated_at
 		FROM users
-		WHERE 1=1
 	`
 
-	args := []any{}
-	argPos := 1
-
-	if filter.Username != "" {
-		query += fmt.Sprintf(" AND username ILIKE $%d", argPos)
-		args = append(args, "%"+filter.Username+"%")
-		argPos++
-	}
-
-	if filter.Email != "" {
-		query += fmt.Sprintf(" AND email ILIKE $%d", argPos)
-		args = append(args, "%"+filter.Email+"%")
-		argPos++
-	}
-
-	if filter.Status != nil {
-		query += fmt.Sprintf(" AND status = $%d", argPos)
-		args = append(args, *filter.Status)
-		argPos++
-	}
-
-	if filter.CreatedFrom != nil {
-		query += fmt.Sprintf(" AND created_at >= $%d", argPos)
-		args = append(args, *filter.CreatedFrom)
-		argPos++
-	}
-
-	if filter.CreatedTo != nil {
-		query += fmt.Sprintf(" AND created_at <= $%d", argPos)
-		args = append(args, *filter.CreatedTo)
-		argPos++
-	}
-
-	if filter.UpdatedFrom != nil {
-		query += fmt.Sprintf(" AND updated_at >= $%d", argPos)
-		args = append(args, *filter.UpdatedFrom)
-		argPos++
-	}
+	b := builder.NewQueryBuilderSql(query)
 
-	if filter.UpdatedTo != nil {
-		query += fmt.Sprintf(" AND updated_at <= $%d", argPos)
-		args = append(args, *filter.UpdatedTo)
-		argPos++
-	}
+	b.ILike("username", filter.Username)
+	b.ILike("email", filter.Email)
+	b.Eq("status", filter.Status)
+	b.Range("created_at", filter.CreatedFrom, filter.CreatedTo)
+	b.Range("updated_at", filter.UpdatedFrom, filter.UpdatedTo)
+	b.Search(base.SearchTerm, "username", "email", "description")
 
-	// ORDER BY seguro
-	sortField := "created_at"
-	if v, ok := allowedUserSortFields[strings.ToLower(base.SortBy)]; ok {
-		sortField = v
-	}
+	sortField, sortOrder := userOrderBy.Resolve(filter.SortBy, filter.SortOrder)
 
-	sortOrder := strings.ToLower(base.SortOrder)
-	if sortOrder != "asc" && sortOrder != "desc" {
-		sortOrder = "asc"
+	args := b.GetArgs()
+	tail, pageArgs, err := pagination.Clause(base, sortField, sortOrder, b.NextArgPos())
+	if err != nil {
+		return nil, err
 	}
 
-	query += fmt.Sprintf(
-		" ORDER BY %s %s LIMIT %d OFFSET %d",
-		sortField,
-		sortOrder,
-		base.Limit,
-		base.Offset,
-	)
-
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := r.db.Query(ctx, b.GetQuery()+tail, append(args, pageArgs...)...)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
 	}
@@ -137,5 +97,32 @@ func (r *userFilterRepo) Filter(
 		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
 	}
 
-	return users, nil
+	total, err := pagination.Count(ctx, r.db, "users", b.Where(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonFilter.NewPage(users, total, base, func(m *model.User) (any, int64) {
+		return userSortValue(m, sortField), m.UID
+	}), nil
+}
+
+// userSortValue devolve o valor da coluna de ordenação field no usuário, gravado
+// no cursor da próxima página.
+func userSortValue(m *model.User, field string) any {
+	switch field {
+	case "username":
+		return m.Username
+	case "email":
+		return m.Email
+	case "status":
+		return m.Status
+	case "version":
+		return m.Version
+	case "created_at":
+		return m.CreatedAt
+	case "updated_at":
+		return m.UpdatedAt
+	}
+	return m.UID
 }
@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -64,20 +65,21 @@ func TestUser_Filter(t *testing.T) {
 			On("Query", ctx, mock.Anything, mock.AnythingOfType("[]interface {}")).
 			Return(mockRows, nil)
 
+		mockDB.OnCount(1)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 1)
-
-		assert.Equal(t, int64(1), result[0].UID)
-		assert.Equal(t, "john_doe", result[0].Username)
-		assert.Equal(t, "john@example.com", result[0].Email)
-		assert.Equal(t, "hashed_password_123", result[0].Password)
-		assert.Equal(t, description, result[0].Description)
-		assert.True(t, result[0].Status)
-		assert.Equal(t, 1, result[0].Version)
-		assert.WithinDuration(t, now, result[0].CreatedAt, time.Second)
-		assert.WithinDuration(t, now, result[0].UpdatedAt, time.Second)
+		assert.Len(t, result.Items, 1)
+
+		assert.Equal(t, int64(1), result.Items[0].UID)
+		assert.Equal(t, "john_doe", result.Items[0].Username)
+		assert.Equal(t, "john@example.com", result.Items[0].Email)
+		assert.Equal(t, "hashed_password_123", result.Items[0].Password)
+		assert.Equal(t, description, result.Items[0].Description)
+		assert.True(t, result.Items[0].Status)
+		assert.Equal(t, 1, result.Items[0].Version)
+		assert.WithinDuration(t, now, result.Items[0].CreatedAt, time.Second)
+		assert.WithinDuration(t, now, result.Items[0].UpdatedAt, time.Second)
 
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
@@ -134,20 +136,21 @@ func TestUser_Filter(t *testing.T) {
 			On("Query", ctx, mock.Anything, mock.AnythingOfType("[]interface {}")).
 			Return(mockRows, nil)
 
+		mockDB.OnCount(1)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 1)
-
-		assert.Equal(t, int64(2), result[0].UID)
-		assert.Equal(t, "admin_user", result[0].Username)
-		assert.Equal(t, "admin@example.com", result[0].Email)
-		assert.Equal(t, "hashed_password_admin", result[0].Password)
-		assert.Equal(t, description, result[0].Description)
-		assert.True(t, result[0].Status)
-		assert.Equal(t, 2, result[0].Version)
-		assert.WithinDuration(t, now.Add(-24*time.Hour), result[0].CreatedAt, time.Second)
-		assert.WithinDuration(t, now, result[0].UpdatedAt, time.Second)
+		assert.Len(t, result.Items, 1)
+
+		assert.Equal(t, int64(2), result.Items[0].UID)
+		assert.Equal(t, "admin_user", result.Items[0].Username)
+		assert.Equal(t, "admin@example.com", result.Items[0].Email)
+		assert.Equal(t, "hashed_password_admin", result.Items[0].Password)
+		assert.Equal(t, description, result.Items[0].Description)
+		assert.True(t, result.Items[0].Status)
+		assert.Equal(t, 2, result.Items[0].Version)
+		assert.WithinDuration(t, now.Add(-24*time.Hour), result.Items[0].CreatedAt, time.Second)
+		assert.WithinDuration(t, now, result.Items[0].UpdatedAt, time.Second)
 
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
@@ -202,18 +205,19 @@ func TestUser_Filter(t *testing.T) {
 			On("Query", ctx, mock.Anything, mock.AnythingOfType("[]interface {}")).
 			Return(mockRows, nil)
 
+		mockDB.OnCount(1)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 1)
-
-		assert.Equal(t, int64(3), result[0].UID)
-		assert.Equal(t, "jane_doe", result[0].Username)
-		assert.Equal(t, "jane@example.com", result[0].Email)
-		assert.Equal(t, "hashed_password_jane", result[0].Password)
-		assert.Equal(t, description, result[0].Description)
-		assert.True(t, result[0].Status)
-		assert.Equal(t, 1, result[0].Version)
+		assert.Len(t, result.Items, 1)
+
+		assert.Equal(t, int64(3), result.Items[0].UID)
+		assert.Equal(t, "jane_doe", result.Items[0].Username)
+		assert.Equal(t, "jane@example.com", result.Items[0].Email)
+		assert.Equal(t, "hashed_password_jane", result.Items[0].Password)
+		assert.Equal(t, description, result.Items[0].Description)
+		assert.True(t, result.Items[0].Status)
+		assert.Equal(t, 1, result.Items[0].Version)
 	})
 
 	t.Run("successfully filter users by status and date range", func(t *testing.T) {
@@ -297,28 +301,29 @@ func TestUser_Filter(t *testing.T) {
 			On("Query", ctx, mock.Anything, mock.AnythingOfType("[]interface {}")).
 			Return(mockRows, nil)
 
+		mockDB.OnCount(2)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 2)
+		assert.Len(t, result.Items, 2)
 
 		// Verificar primeiro usuário
-		assert.Equal(t, int64(4), result[0].UID)
-		assert.Equal(t, "inactive_user1", result[0].Username)
-		assert.Equal(t, "inactive1@example.com", result[0].Email)
-		assert.Equal(t, "hashed_password_1", result[0].Password)
-		assert.Equal(t, desc1, result[0].Description)
-		assert.False(t, result[0].Status)
-		assert.Equal(t, 3, result[0].Version)
+		assert.Equal(t, int64(4), result.Items[0].UID)
+		assert.Equal(t, "inactive_user1", result.Items[0].Username)
+		assert.Equal(t, "inactive1@example.com", result.Items[0].Email)
+		assert.Equal(t, "hashed_password_1", result.Items[0].Password)
+		assert.Equal(t, desc1, result.Items[0].Description)
+		assert.False(t, result.Items[0].Status)
+		assert.Equal(t, 3, result.Items[0].Version)
 
 		// Verificar segundo usuário
-		assert.Equal(t, int64(5), result[1].UID)
-		assert.Equal(t, "inactive_user2", result[1].Username)
-		assert.Equal(t, "inactive2@example.com", result[1].Email)
-		assert.Equal(t, "hashed_password_2", result[1].Password)
-		assert.Equal(t, desc2, result[1].Description)
-		assert.False(t, result[1].Status)
-		assert.Equal(t, 1, result[1].Version)
+		assert.Equal(t, int64(5), result.Items[1].UID)
+		assert.Equal(t, "inactive_user2", result.Items[1].Username)
+		assert.Equal(t, "inactive2@example.com", result.Items[1].Email)
+		assert.Equal(t, "hashed_password_2", result.Items[1].Password)
+		assert.Equal(t, desc2, result.Items[1].Description)
+		assert.False(t, result.Items[1].Status)
+		assert.Equal(t, 1, result.Items[1].Version)
 
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
@@ -406,19 +411,20 @@ func TestUser_Filter(t *testing.T) {
 			On("Query", ctx, mock.Anything, mock.AnythingOfType("[]interface {}")).
 			Return(mockRows, nil)
 
+		mockDB.OnCount(2)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 2)
+		assert.Len(t, result.Items, 2)
 
 		// Verificar ordenação por updated_at desc
-		assert.Equal(t, int64(6), result[0].UID)
-		assert.Equal(t, "recent_user1", result[0].Username)
-		assert.WithinDuration(t, now.Add(-48*time.Hour), result[0].UpdatedAt, time.Second)
+		assert.Equal(t, int64(6), result.Items[0].UID)
+		assert.Equal(t, "recent_user1", result.Items[0].Username)
+		assert.WithinDuration(t, now.Add(-48*time.Hour), result.Items[0].UpdatedAt, time.Second)
 
-		assert.Equal(t, int64(7), result[1].UID)
-		assert.Equal(t, "recent_user2", result[1].Username)
-		assert.WithinDuration(t, now.Add(-72*time.Hour), result[1].UpdatedAt, time.Second)
+		assert.Equal(t, int64(7), result.Items[1].UID)
+		assert.Equal(t, "recent_user2", result.Items[1].Username)
+		assert.WithinDuration(t, now.Add(-72*time.Hour), result.Items[1].UpdatedAt, time.Second)
 
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
@@ -501,19 +507,20 @@ func TestUser_Filter(t *testing.T) {
 			On("Query", ctx, mock.Anything, mock.AnythingOfType("[]interface {}")).
 			Return(mockRows, nil)
 
+		mockDB.OnCount(2)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 2)
+		assert.Len(t, result.Items, 2)
 
 		// Verificar que ordenação é ASC (mais antigo primeiro)
-		assert.Equal(t, int64(8), result[0].UID)
-		assert.Equal(t, "user_a", result[0].Username)
-		assert.WithinDuration(t, now.Add(-48*time.Hour), result[0].CreatedAt, time.Second)
+		assert.Equal(t, int64(8), result.Items[0].UID)
+		assert.Equal(t, "user_a", result.Items[0].Username)
+		assert.WithinDuration(t, now.Add(-48*time.Hour), result.Items[0].CreatedAt, time.Second)
 
-		assert.Equal(t, int64(9), result[1].UID)
-		assert.Equal(t, "user_b", result[1].Username)
-		assert.WithinDuration(t, now.Add(-24*time.Hour), result[1].CreatedAt, time.Second)
+		assert.Equal(t, int64(9), result.Items[1].UID)
+		assert.Equal(t, "user_b", result.Items[1].Username)
+		assert.WithinDuration(t, now.Add(-24*time.Hour), result.Items[1].CreatedAt, time.Second)
 
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
@@ -567,15 +574,16 @@ func TestUser_Filter(t *testing.T) {
 			On("Query", ctx, mock.Anything, mock.AnythingOfType("[]interface {}")).
 			Return(mockRows, nil)
 
+		mockDB.OnCount(1)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 1)
+		assert.Len(t, result.Items, 1)
 
-		assert.Equal(t, int64(10), result[0].UID)
-		assert.Equal(t, "no_description_user", result[0].Username)
-		assert.Equal(t, "nodesc@example.com", result[0].Email)
-		assert.Empty(t, result[0].Description)
+		assert.Equal(t, int64(10), result.Items[0].UID)
+		assert.Equal(t, "no_description_user", result.Items[0].Username)
+		assert.Equal(t, "nodesc@example.com", result.Items[0].Email)
+		assert.Empty(t, result.Items[0].Description)
 	})
 
 	t.Run("returns empty slice when no users found", func(t *testing.T) {
@@ -601,11 +609,12 @@ func TestUser_Filter(t *testing.T) {
 			On("Query", ctx, mock.Anything, mock.AnythingOfType("[]interface {}")).
 			Return(mockRows, nil)
 
+		mockDB.OnCount(0)
 		result, err := repo.Filter(ctx, filter)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
-		assert.Empty(t, result)
+		assert.Empty(t, result.Items)
 
 		mockDB.AssertExpectations(t)
 		mockRows.AssertExpectations(t)
@@ -743,3 +752,31 @@ func TestUser_Filter(t *testing.T) {
 		mockRows.AssertExpectations(t)
 	})
 }
+
+func TestUser_Filter_SearchTerm(t *testing.T) {
+	mockDB := new(mockDb.MockDatabase)
+	repo := &userFilterRepo{db: mockDB}
+	ctx := context.Background()
+
+	mockRows := new(mockDb.MockRows)
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Err").Return(nil)
+	mockRows.On("Close").Return()
+
+	where := " WHERE 1=1 AND (username ILIKE $1 OR email ILIKE $1 OR description ILIKE $1)"
+	args := []interface{}{`%50\%\_acme%`}
+
+	mockDB.On("Query", ctx, mock.MatchedBy(func(q string) bool {
+		return strings.Contains(q, where+" ORDER BY")
+	}), args).Return(mockRows, nil)
+	mockDB.On("QueryRow", ctx, "SELECT COUNT(*) FROM users"+where, args).
+		Return(&mockDb.MockRow{Values: []interface{}{int64(0)}})
+
+	result, err := repo.Filter(ctx, &filterUser.UserFilter{
+		BaseFilter: filter.BaseFilter{Limit: 10, SearchTerm: " 50%_acme "},
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Items)
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,11 @@
+package repo
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+
+type userRoleRepo struct {
+	db repo.DBExecutor
+}
+
+func NewUserRole(db repo.DBExecutor) UserRole {
+	return &userRoleRepo{db: db}
+}
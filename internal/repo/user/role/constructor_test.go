@@ -0,0 +1,18 @@
+package repo
+
+import (
+	"testing"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUserRole(t *testing.T) {
+	t.Run("successfully create new user role repo instance", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+
+		result := NewUserRole(mockDB)
+
+		assert.NotNil(t, result)
+	})
+}
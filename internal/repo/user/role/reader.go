@@ -0,0 +1,94 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/role"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+// Papéis com as permissões agregadas em ordem alfabética
+const selectRoles = `
+	SELECT
+		r.id,
+		r.name,
+		COALESCE(r.description, ''),
+		COALESCE(array_agg(p.code ORDER BY p.code) FILTER (WHERE p.code IS NOT NULL), '{}'),
+		r.created_at,
+		r.updated_at
+	FROM roles r
+	LEFT JOIN role_permissions rp ON rp.role_id = r.id
+	LEFT JOIN permissions p ON p.id = rp.permission_id
+`
+
+func (r *userRoleRepo) GetAllRoles(ctx context.Context) ([]*models.Role, error) {
+	const query = selectRoles + `
+		GROUP BY r.id
+		ORDER BY r.id;
+	`
+
+	return r.queryRoles(ctx, query)
+}
+
+func (r *userRoleRepo) GetRolesByUserID(ctx context.Context, userID int64) ([]*models.Role, error) {
+	const query = selectRoles + `
+		WHERE r.id IN (SELECT role_id FROM user_roles WHERE user_id = $1)
+		GROUP BY r.id
+		ORDER BY r.id;
+	`
+
+	return r.queryRoles(ctx, query, userID)
+}
+
+// GetAccessByUserID retorna os nomes dos papéis do usuário e a união das
+// permissões concedidas por eles; listas vazias quando não há papel.
+func (r *userRoleRepo) GetAccessByUserID(ctx context.Context, userID int64) (*models.Access, error) {
+	const query = `
+		SELECT
+			COALESCE(array_agg(DISTINCT r.name), '{}'),
+			COALESCE(array_agg(DISTINCT p.code) FILTER (WHERE p.code IS NOT NULL), '{}')
+		FROM user_roles ur
+		JOIN roles r ON r.id = ur.role_id
+		LEFT JOIN role_permissions rp ON rp.role_id = r.id
+		LEFT JOIN permissions p ON p.id = rp.permission_id
+		WHERE ur.user_id = $1;
+	`
+
+	var access models.Access
+	if err := r.db.QueryRow(ctx, query, userID).Scan(&access.Roles, &access.Permissions); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return &access, nil
+}
+
+func (r *userRoleRepo) queryRoles(ctx context.Context, query string, args ...any) ([]*models.Role, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	defer rows.Close()
+
+	roles := []*models.Role{}
+	for rows.Next() {
+		var role models.Role
+		if err := rows.Scan(
+			&role.ID,
+			&role.Name,
+			&role.Description,
+			&role.Permissions,
+			&role.CreatedAt,
+			&role.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrScan, err)
+		}
+		roles = append(roles, &role)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrIterate, err)
+	}
+
+	return roles, nil
+}
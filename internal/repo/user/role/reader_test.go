@@ -0,0 +1,167 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUserRoleRepo_GetAllRoles(t *testing.T) {
+	t.Run("successfully get roles with permissions", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &userRoleRepo{db: mockDB}
+		ctx := context.Background()
+		now := time.Now()
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []interface{}{int64(1), "admin", "Acesso total", []string{"sale:cancel", "sale:read"}, now, now}},
+			{Values: []interface{}{int64(3), "cashier", "", []string{"sale:read"}, now, now}},
+		}}
+		mockDB.On("Query", ctx, mock.Anything, []interface{}(nil)).Return(mockRows, nil)
+
+		result, err := repo.GetAllRoles(ctx)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "admin", result[0].Name)
+		assert.Equal(t, []string{"sale:cancel", "sale:read"}, result[0].Permissions)
+		assert.Equal(t, int64(3), result[1].ID)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return empty list when there are no roles", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &userRoleRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := new(mockDb.MockRows)
+		mockRows.On("Next").Return(false)
+		mockRows.On("Err").Return(nil)
+		mockRows.On("Close").Return()
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+
+		result, err := repo.GetAllRoles(ctx)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+	})
+
+	t.Run("return ErrGet when query fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &userRoleRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db fail"))
+
+		result, err := repo.GetAllRoles(ctx)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("return ErrScan when scan fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &userRoleRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{{Err: errors.New("scan failed")}}}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+
+		result, err := repo.GetAllRoles(ctx)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrScan)
+	})
+
+	t.Run("return ErrIterate when rows error occurs", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &userRoleRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{
+			Rows:    []*mockDb.MockRow{{Values: []interface{}{int64(1)}}},
+			RowsErr: errors.New("rows error"),
+		}
+		mockDB.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+
+		result, err := repo.GetAllRoles(ctx)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrIterate)
+	})
+}
+
+func TestUserRoleRepo_GetRolesByUserID(t *testing.T) {
+	t.Run("successfully get user roles", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &userRoleRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockRows := &mockDb.MockRows{Rows: []*mockDb.MockRow{
+			{Values: []interface{}{int64(3), "cashier", "Caixa", []string{"sale:create"}, time.Now(), time.Now()}},
+		}}
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(7)}).Return(mockRows, nil)
+
+		result, err := repo.GetRolesByUserID(ctx, 7)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "cashier", result[0].Name)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return ErrGet when query fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &userRoleRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("Query", ctx, mock.Anything, []interface{}{int64(7)}).Return(nil, errors.New("db fail"))
+
+		result, err := repo.GetRolesByUserID(ctx, 7)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestUserRoleRepo_GetAccessByUserID(t *testing.T) {
+	t.Run("successfully get roles and permissions", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &userRoleRepo{db: mockDB}
+		ctx := context.Background()
+
+		row := &mockDb.MockRow{Values: []interface{}{
+			[]string{"cashier", "stock_clerk"},
+			[]string{"product:read", "product:stock", "sale:create"},
+		}}
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(7)}).Return(row)
+
+		result, err := repo.GetAccessByUserID(ctx, 7)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"cashier", "stock_clerk"}, result.Roles)
+		assert.Equal(t, []string{"product:read", "product:stock", "sale:create"}, result.Permissions)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return ErrGet when scan fails", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &userRoleRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(7)}).
+			Return(&mockDb.MockRow{Err: errors.New("db fail")})
+
+		result, err := repo.GetAccessByUserID(ctx, 7)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
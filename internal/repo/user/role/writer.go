@@ -0,0 +1,50 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/role"
+	errMsgPg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (r *userRoleRepo) AssignRole(ctx context.Context, userRole *models.UserRole) (*models.UserRole, error) {
+	const query = `
+		INSERT INTO user_roles (user_id, role_id, created_at)
+		VALUES ($1, $2, NOW())
+		RETURNING created_at;
+	`
+
+	err := r.db.QueryRow(ctx, query, userRole.UserID, userRole.RoleID).Scan(&userRole.CreatedAt)
+	if err != nil {
+		switch {
+		case errMsgPg.IsDuplicateKey(err):
+			return nil, errMsg.ErrRelationExists
+		case errMsgPg.IsForeignKeyViolation(err):
+			return nil, errMsg.ErrDBInvalidForeignKey
+		default:
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+		}
+	}
+
+	return userRole, nil
+}
+
+func (r *userRoleRepo) RemoveRole(ctx context.Context, userID, roleID int64) error {
+	const query = `
+		DELETE FROM user_roles
+		WHERE user_id = $1 AND role_id = $2;
+	`
+
+	result, err := r.db.Exec(ctx, query, userID, roleID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrDelete, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return errMsg.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/role"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUserRoleRepo_AssignRole(t *testing.T) {
+	t.Run("successfully assign role", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &userRoleRepo{db: mockDB}
+		ctx := context.Background()
+		now := time.Now()
+
+		userRole := &models.UserRole{UserID: 7, RoleID: 3}
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{int64(7), int64(3)}).
+			Return(&mockDb.MockRow{Values: []interface{}{now}})
+
+		result, err := repo.AssignRole(ctx, userRole)
+
+		assert.NoError(t, err)
+		assert.Equal(t, now, result.CreatedAt)
+		mockDB.AssertExpectations(t)
+	})
+
+	cases := []struct {
+		name     string
+		dbErr    error
+		expected error
+	}{
+		{"return ErrRelationExists on duplicate", &pgconn.PgError{Code: "23505"}, errMsg.ErrRelationExists},
+		{"return ErrDBInvalidForeignKey on missing user or role", &pgconn.PgError{Code: "23503"}, errMsg.ErrDBInvalidForeignKey},
+		{"return ErrCreate on generic error", errors.New("db fail"), errMsg.ErrCreate},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDB := new(mockDb.MockDatabase)
+			repo := &userRoleRepo{db: mockDB}
+			ctx := context.Background()
+
+			mockDB.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(&mockDb.MockRow{Err: tc.dbErr})
+
+			result, err := repo.AssignRole(ctx, &models.UserRole{UserID: 7, RoleID: 3})
+
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
+
+func TestUserRoleRepo_RemoveRole(t *testing.T) {
+	t.Run("successfully remove role", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &userRoleRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("Exec", ctx, mock.Anything, []interface{}{int64(7), int64(3)}).
+			Return(mockDb.MockCommandTag{RowsAffectedCount: 1}, nil)
+
+		err := repo.RemoveRole(ctx, 7, 3)
+
+		assert.NoError(t, err)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("return ErrNotFound when user does not have the role", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &userRoleRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("Exec", ctx, mock.Anything, mock.Anything).
+			Return(mockDb.MockCommandTag{RowsAffectedCount: 0}, nil)
+
+		err := repo.RemoveRole(ctx, 7, 3)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("return ErrDelete on db error", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &userRoleRepo{db: mockDB}
+		ctx := context.Background()
+
+		mockDB.On("Exec", ctx, mock.Anything, mock.Anything).
+			Return(nil, errors.New("db fail"))
+
+		err := repo.RemoveRole(ctx, 7, 3)
+
+		assert.ErrorIs(t, err, errMsg.ErrDelete)
+	})
+}
@@ -0,0 +1,70 @@
+package repo
+
+import (
+	"context"
+
+	modelAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/user"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+)
+
+const auditEntity = "user"
+
+// auditedUser registra na trilha de auditoria cada escrita feita pelo
+// repositório. O cadastro completo (repo/user/full) não passa por aqui.
+type auditedUser struct {
+	User
+	rec audit.Recorder
+}
+
+func WithAudit(inner User, rec audit.Recorder) User {
+	return &auditedUser{User: inner, rec: rec}
+}
+
+func (r *auditedUser) load(ctx context.Context, id int64) (any, error) {
+	return r.User.GetByID(ctx, id)
+}
+
+func (r *auditedUser) track(ctx context.Context, id int64, action string, write func() error) error {
+	return audit.Track(ctx, r.rec, auditEntity, id, action, r.load, write)
+}
+
+func (r *auditedUser) Create(ctx context.Context, user *models.User) (*models.User, error) {
+	created, err := r.User.Create(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	r.rec.Record(ctx, audit.Entry{
+		EntityType: auditEntity,
+		EntityID:   created.UID,
+		Action:     modelAudit.ActionCreate,
+		After:      created,
+	})
+
+	return created, nil
+}
+
+func (r *auditedUser) Update(ctx context.Context, user *models.User) error {
+	return r.track(ctx, user.UID, modelAudit.ActionUpdate, func() error {
+		return r.User.Update(ctx, user)
+	})
+}
+
+func (r *auditedUser) Delete(ctx context.Context, id int64) error {
+	return r.track(ctx, id, modelAudit.ActionDelete, func() error {
+		return r.User.Delete(ctx, id)
+	})
+}
+
+func (r *auditedUser) Enable(ctx context.Context, id int64) error {
+	return r.track(ctx, id, "enable", func() error {
+		return r.User.Enable(ctx, id)
+	})
+}
+
+func (r *auditedUser) Disable(ctx context.Context, id int64) error {
+	return r.track(ctx, id, "disable", func() error {
+		return r.User.Disable(ctx, id)
+	})
+}
@@ -0,0 +1,35 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	mockAudit "github.com/WagaoCarvalho/backend_store_go/infra/mock/audit"
+	mockUser "github.com/WagaoCarvalho/backend_store_go/infra/mock/user"
+	modelAudit "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/user"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditedUser(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("update uses the user UID", func(t *testing.T) {
+		inner := new(mockUser.MockUser)
+		rec := new(mockAudit.MockRecorder)
+		user := &models.User{UID: 2, Username: "novo"}
+		before := &models.User{UID: 2, Username: "antigo"}
+
+		inner.On("GetByID", ctx, int64(2)).Return(before, nil).Once()
+		inner.On("Update", ctx, user).Return(nil).Once()
+		inner.On("GetByID", ctx, int64(2)).Return(user, nil).Once()
+		rec.On("Record", ctx, audit.Entry{EntityType: "user", EntityID: 2, Action: modelAudit.ActionUpdate, Before: before, After: user}).Once()
+
+		err := WithAudit(inner, rec).Update(ctx, user)
+
+		assert.NoError(t, err)
+		inner.AssertExpectations(t)
+		rec.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,52 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/user"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetByEmail compara o email inteiro, sem diferenciar maiúsculas, para que o
+// login nunca resolva um email parcial para a conta de outro usuário.
+func (r *userRepo) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	const query = `
+		SELECT
+			id,
+			username,
+			email,
+			password_hash,
+			description,
+			status,
+			version,
+			created_at,
+			updated_at
+		FROM users
+		WHERE LOWER(email) = LOWER($1)
+	`
+
+	user := &models.User{}
+	err := r.db.QueryRow(ctx, query, email).Scan(
+		&user.UID,
+		&user.Username,
+		&user.Email,
+		&user.Password,
+		&user.Description,
+		&user.Status,
+		&user.Version,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errMsg.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return user, nil
+}
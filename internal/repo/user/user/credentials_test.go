@@ -0,0 +1,66 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUserRepo_GetByEmail(t *testing.T) {
+	ctx := context.Background()
+	email := "User@Example.com"
+
+	t.Run("busca pelo email exato sem diferenciar maiúsculas", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &userRepo{db: mockDB}
+		now := time.Now()
+
+		mockRow := &mockDb.MockRow{
+			Values: []interface{}{
+				int64(1), "user", "user@example.com", "hashed", "desc", true, 2, now, now,
+			},
+		}
+		mockDB.On("QueryRow", ctx, mock.MatchedBy(func(q string) bool {
+			return strings.Contains(q, "WHERE LOWER(email) = LOWER($1)") && !strings.Contains(q, "ILIKE")
+		}), []interface{}{email}).Return(mockRow)
+
+		user, err := repo.GetByEmail(ctx, email)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), user.UID)
+		assert.Equal(t, "user@example.com", user.Email)
+		assert.Equal(t, "hashed", user.Password)
+		assert.Equal(t, 2, user.Version)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("retorna ErrNotFound quando o email não existe", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &userRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{email}).Return(&mockDb.MockRow{Err: pgx.ErrNoRows})
+
+		user, err := repo.GetByEmail(ctx, email)
+
+		assert.Nil(t, user)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("retorna ErrGet em erro do banco", func(t *testing.T) {
+		mockDB := new(mockDb.MockDatabase)
+		repo := &userRepo{db: mockDB}
+		mockDB.On("QueryRow", ctx, mock.Anything, []interface{}{email}).Return(&mockDb.MockRow{Err: errors.New("db error")})
+
+		user, err := repo.GetByEmail(ctx, email)
+
+		assert.Nil(t, user)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
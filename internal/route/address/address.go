@@ -7,6 +7,7 @@ import (
 	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/address/address"
 	handlerFilter "github.com/WagaoCarvalho/backend_store_go/internal/handler/address/filter"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwtMiddlewares "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
 	repoAddress "github.com/WagaoCarvalho/backend_store_go/internal/repo/address/address"
@@ -55,6 +56,7 @@ func RegisterAddressRoutes(
 	s := r.PathPrefix("/").Subrouter()
 
 	s.Use(jwtMiddlewares.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwtMiddlewares.Guard(log)
 
 	const (
 		addresses = "/addresses"
@@ -63,15 +65,15 @@ func RegisterAddressRoutes(
 		filter    = "/filter"
 	)
 
-	s.HandleFunc(baseURL+addresses, newHandlerAddress.Create).Methods(http.MethodPost)
+	s.Handle(baseURL+addresses, guard(permission.AddressWrite, newHandlerAddress.Create)).Methods(http.MethodPost)
 
-	s.HandleFunc(baseURL+addresses+idPath, newHandlerAddress.GetByID).Methods(http.MethodGet)
+	s.Handle(baseURL+addresses+idPath, guard(permission.AddressRead, newHandlerAddress.GetByID)).Methods(http.MethodGet)
 
-	s.HandleFunc(baseURL+addresses+idPath, newHandlerAddress.Update).Methods(http.MethodPut)
-	s.HandleFunc(baseURL+addresses+idPath, newHandlerAddress.Delete).Methods(http.MethodDelete)
+	s.Handle(baseURL+addresses+idPath, guard(permission.AddressWrite, newHandlerAddress.Update)).Methods(http.MethodPut)
+	s.Handle(baseURL+addresses+idPath, guard(permission.AddressDelete, newHandlerAddress.Delete)).Methods(http.MethodDelete)
 
-	s.HandleFunc(baseURL+addresses+idPath+enable, newHandlerAddress.Enable).Methods(http.MethodPatch)
-	s.HandleFunc(baseURL+addresses+idPath+disable, newHandlerAddress.Disable).Methods(http.MethodPatch)
+	s.Handle(baseURL+addresses+idPath+enable, guard(permission.AddressWrite, newHandlerAddress.Enable)).Methods(http.MethodPatch)
+	s.Handle(baseURL+addresses+idPath+disable, guard(permission.AddressWrite, newHandlerAddress.Disable)).Methods(http.MethodPatch)
 
-	s.HandleFunc(baseURL+addresses+filter, newHandlerFilter.Filter).Methods(http.MethodGet)
+	s.Handle(baseURL+addresses+filter, guard(permission.AddressRead, newHandlerFilter.Filter)).Methods(http.MethodGet)
 }
@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/WagaoCarvalho/backend_store_go/config"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/handler/audit/filter"
+	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	jwtMiddlewares "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repoFilter "github.com/WagaoCarvalho/backend_store_go/internal/repo/audit/filter"
+	serviceFilter "github.com/WagaoCarvalho/backend_store_go/internal/service/audit/filter"
+
+	"github.com/gorilla/mux"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func RegisterAuditRoutes(
+	r *mux.Router,
+	db *pgxpool.Pool,
+	log *logger.LogAdapter,
+	blacklist jwtMiddlewares.TokenBlacklist,
+) {
+	baseURL := config.LoadServerConfig().BaseURL
+
+	newRepoFilter := repoFilter.NewFilterAudit(db)
+	newServiceFilter := serviceFilter.NewAuditFilterService(newRepoFilter)
+	newHandlerFilter := filter.NewAuditFilterHandler(newServiceFilter, log)
+
+	jwtCfg := config.LoadJwtConfig()
+	jwtManager := jwtAuth.NewJWTManager(
+		jwtCfg.SecretKey,
+		jwtCfg.TokenDuration,
+		jwtCfg.Issuer,
+		jwtCfg.Audience,
+	)
+
+	s := r.PathPrefix("/").Subrouter()
+	s.Use(jwtMiddlewares.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwtMiddlewares.Guard(log)
+
+	s.Handle(baseURL+"/audit", guard(permission.AuditRead, newHandlerFilter.Filter)).Methods(http.MethodGet)
+}
@@ -0,0 +1,61 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/WagaoCarvalho/backend_store_go/config"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/handler/cash/filter"
+	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/cash/session"
+	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repoFilter "github.com/WagaoCarvalho/backend_store_go/internal/repo/cash/filter"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/cash/session"
+	serviceFilter "github.com/WagaoCarvalho/backend_store_go/internal/service/cash/filter"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/cash/session"
+
+	"github.com/gorilla/mux"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RegisterCashSessionRoutes expõe o caixa do operador: abertura, sangrias e
+// suprimentos, fechamento com conferência e o histórico filtrável.
+func RegisterCashSessionRoutes(
+	r *mux.Router,
+	db *pgxpool.Pool,
+	log *logger.LogAdapter,
+	blacklist jwt.TokenBlacklist,
+) {
+	sessionService := service.NewCashSessionService(
+		repo.NewCashSession(db),
+		repo.NewCashSessionTx(db),
+	)
+	handler := handler.NewCashSessionHandler(sessionService, log)
+
+	serviceFilter := serviceFilter.NewCashSessionFilterService(repoFilter.NewFilterCashSession(db))
+	filter := filter.NewCashSessionFilterHandler(serviceFilter, log)
+
+	// Config JWT
+	jwtCfg := config.LoadJwtConfig()
+	jwtManager := jwtAuth.NewJWTManager(
+		jwtCfg.SecretKey,
+		jwtCfg.TokenDuration,
+		jwtCfg.Issuer,
+		jwtCfg.Audience,
+	)
+
+	// Rotas protegidas
+	s := r.PathPrefix("/").Subrouter()
+	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwt.Guard(log)
+
+	s.Handle("/cash-session", guard(permission.CashOperate, handler.Open)).Methods(http.MethodPost)
+	s.Handle("/cash-session/current", guard(permission.CashRead, handler.Current)).Methods(http.MethodGet)
+	s.Handle("/cash-session/{id:[0-9]+}", guard(permission.CashRead, handler.GetByID)).Methods(http.MethodGet)
+	s.Handle("/cash-session/{id:[0-9]+}/movements", guard(permission.CashRead, handler.Movements)).Methods(http.MethodGet)
+	s.Handle("/cash-session/{id:[0-9]+}/movements", guard(permission.CashOperate, handler.AddMovement)).Methods(http.MethodPost)
+	s.Handle("/cash-session/{id:[0-9]+}/summary", guard(permission.CashRead, handler.Summary)).Methods(http.MethodGet)
+	s.Handle("/cash-session/{id:[0-9]+}/close", guard(permission.CashOperate, handler.Close)).Methods(http.MethodPatch)
+	s.Handle("/cash-sessions/filter", guard(permission.CashRead, filter.Filter)).Methods(http.MethodGet)
+}
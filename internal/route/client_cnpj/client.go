@@ -0,0 +1,90 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/WagaoCarvalho/backend_store_go/config"
+	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/client_cnpj/client_cnpj"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/handler/client_cnpj/filter"
+	credit "github.com/WagaoCarvalho/backend_store_go/internal/handler/client_cpf/credit"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	jwtMiddlewares "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repoAudit "github.com/WagaoCarvalho/backend_store_go/internal/repo/audit/audit"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/client_cnpj/client"
+	repoCredit "github.com/WagaoCarvalho/backend_store_go/internal/repo/client_cnpj/credit"
+	repoFilter "github.com/WagaoCarvalho/backend_store_go/internal/repo/client_cnpj/filter"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/client_cnpj/client"
+	serviceFilter "github.com/WagaoCarvalho/backend_store_go/internal/service/client_cnpj/filter"
+	serviceCredit "github.com/WagaoCarvalho/backend_store_go/internal/service/client_cpf/credit"
+
+	"github.com/gorilla/mux"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func RegisterClientCnpjRoutes(
+	r *mux.Router,
+	db *pgxpool.Pool,
+	log *logger.LogAdapter,
+	blacklist jwtMiddlewares.TokenBlacklist,
+) {
+	serverConfig := config.LoadServerConfig()
+	baseURL := serverConfig.BaseURL
+	idPath := serverConfig.IDPath
+
+	recorder := audit.NewRecorder(repoAudit.NewAudit(db), log)
+	newRepo := repo.WithAudit(repo.NewClientCnpjRepo(db), recorder)
+	newService := service.NewClientCnpjService(newRepo)
+	newHandler := handler.NewClientCnpjHandler(newService, log)
+
+	newRepoFilter := repoFilter.NewFilterClientCnpj(db)
+	newServiceFilter := serviceFilter.NewClientCnpjFilterService(newRepoFilter)
+	newFilter := filter.NewClientCnpjFilterHandler(newServiceFilter, log)
+
+	newServiceCredit := serviceCredit.NewClientCreditService(
+		repoCredit.NewClientCnpjCredit(db),
+		repoCredit.WithAuditTx(repoCredit.NewClientCnpjCreditTx(db), recorder),
+	)
+	newCredit := credit.NewClientCreditHandler(newServiceCredit, log)
+
+	jwtCfg := config.LoadJwtConfig()
+
+	jwtManager := jwtAuth.NewJWTManager(
+		jwtCfg.SecretKey,
+		jwtCfg.TokenDuration,
+		jwtCfg.Issuer,
+		jwtCfg.Audience,
+	)
+
+	s := r.PathPrefix("/").Subrouter()
+	s.Use(jwtMiddlewares.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwtMiddlewares.Guard(log)
+
+	const (
+		clients = "/clients-cnpj"
+		version = "/version"
+		enable  = "/enable"
+		disable = "/disable"
+		filter  = "/filter"
+		credits = "/credit"
+	)
+
+	s.Handle(baseURL+clients, guard(permission.ClientWrite, newHandler.Create)).Methods(http.MethodPost)
+	s.Handle(baseURL+clients+idPath, guard(permission.ClientRead, newHandler.GetByID)).Methods(http.MethodGet)
+	s.Handle(baseURL+clients+idPath+version, guard(permission.ClientRead, newHandler.GetVersionByID)).Methods(http.MethodGet)
+	s.Handle(baseURL+clients+idPath, guard(permission.ClientWrite, newHandler.Update)).Methods(http.MethodPut)
+	s.Handle(baseURL+clients+idPath, guard(permission.ClientDelete, newHandler.Delete)).Methods(http.MethodDelete)
+
+	s.Handle(baseURL+clients+idPath+disable, guard(permission.ClientWrite, newHandler.Disable)).Methods(http.MethodPatch)
+	s.Handle(baseURL+clients+idPath+enable, guard(permission.ClientWrite, newHandler.Enable)).Methods(http.MethodPatch)
+
+	s.Handle(baseURL+clients+filter, guard(permission.ClientRead, newFilter.Filter)).Methods(http.MethodGet)
+
+	s.Handle(baseURL+clients+idPath+credits, guard(permission.CreditRead, newCredit.GetByClientID)).Methods(http.MethodGet)
+	s.Handle(baseURL+clients+idPath+credits, guard(permission.CreditManage, newCredit.OpenOrAdjust)).Methods(http.MethodPut)
+	s.Handle(baseURL+clients+idPath+credits+"/charges", guard(permission.CreditPost, newCredit.Charge)).Methods(http.MethodPost)
+	s.Handle(baseURL+clients+idPath+credits+"/payments", guard(permission.CreditPost, newCredit.Payment)).Methods(http.MethodPost)
+	s.Handle(baseURL+clients+idPath+credits+"/statement", guard(permission.CreditRead, newCredit.GetStatement)).Methods(http.MethodGet)
+}
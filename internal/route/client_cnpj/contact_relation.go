@@ -0,0 +1,49 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/WagaoCarvalho/backend_store_go/config"
+	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/client_cnpj/contact_relation"
+	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/client_cnpj/contact_relation"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/client_cnpj/contact_relation"
+	"github.com/gorilla/mux"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func RegisterClientCnpjContactRelationRoutes(
+	r *mux.Router,
+	db *pgxpool.Pool,
+	log *logger.LogAdapter,
+	blacklist jwt.TokenBlacklist,
+) {
+	contactRepo := repo.NewClientCnpjContactRelation(db)
+	contactService := service.NewClientCnpjContactRelationService(contactRepo)
+	contactHandler := handler.NewClientCnpjContactRelationHandler(contactService, log)
+
+	// Carregar config JWT
+	jwtCfg := config.LoadJwtConfig()
+
+	// Criar jwtManager que implementa JWTService
+	jwtManager := jwtAuth.NewJWTManager(
+		jwtCfg.SecretKey,
+		jwtCfg.TokenDuration,
+		jwtCfg.Issuer,
+		jwtCfg.Audience,
+	)
+
+	s := r.PathPrefix("/").Subrouter()
+	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwt.Guard(log)
+
+	// Rotas Client CNPJ Contact Relations
+	s.Handle("/client-cnpj-contact-relation", guard(permission.ClientWrite, contactHandler.Create)).Methods(http.MethodPost)
+	s.Handle("/client-cnpj-contact-relations/{client_cnpj_id:[0-9]+}", guard(permission.ClientRead, contactHandler.GetAllByClientCnpjID)).Methods(http.MethodGet)
+	s.Handle("/client-cnpj-contact-relation/{client_cnpj_id:[0-9]+}/contact/{contact_id:[0-9]+}/exists", guard(permission.ClientRead, contactHandler.HasClientCnpjContactRelation)).Methods(http.MethodGet)
+	s.Handle("/client-cnpj-contact-relation/{client_cnpj_id:[0-9]+}/contact/{contact_id:[0-9]+}", guard(permission.ClientWrite, contactHandler.Delete)).Methods(http.MethodDelete)
+	s.Handle("/client-cnpj-contact-relation/{client_cnpj_id:[0-9]+}", guard(permission.ClientWrite, contactHandler.DeleteAll)).Methods(http.MethodDelete)
+}
@@ -5,13 +5,19 @@ import (
 
 	"github.com/WagaoCarvalho/backend_store_go/config"
 	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/client_cpf/client_cpf"
+	credit "github.com/WagaoCarvalho/backend_store_go/internal/handler/client_cpf/credit"
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/handler/client_cpf/filter"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwtMiddlewares "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repoAudit "github.com/WagaoCarvalho/backend_store_go/internal/repo/audit/audit"
 	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/client_cpf/client"
+	repoCredit "github.com/WagaoCarvalho/backend_store_go/internal/repo/client_cpf/credit"
 	repoFilter "github.com/WagaoCarvalho/backend_store_go/internal/repo/client_cpf/filter"
 	service "github.com/WagaoCarvalho/backend_store_go/internal/service/client_cpf/client"
+	serviceCredit "github.com/WagaoCarvalho/backend_store_go/internal/service/client_cpf/credit"
 	serviceFilter "github.com/WagaoCarvalho/backend_store_go/internal/service/client_cpf/filter"
 
 	"github.com/gorilla/mux"
@@ -28,7 +34,8 @@ func RegisterClientRoutes(
 	baseURL := serverConfig.BaseURL
 	idPath := serverConfig.IDPath
 
-	newRepo := repo.NewClientCpfRepo(db)
+	recorder := audit.NewRecorder(repoAudit.NewAudit(db), log)
+	newRepo := repo.WithAudit(repo.NewClientCpfRepo(db), recorder)
 	newService := service.NewClientCpfService(newRepo)
 	newHandler := handler.NewClientCpfHandler(newService, log)
 
@@ -36,6 +43,12 @@ func RegisterClientRoutes(
 	newServiceFilter := serviceFilter.NewClientCpfFilterService(newRepoFilter)
 	newFilter := filter.NewClientCpfFilterHandler(newServiceFilter, log)
 
+	newServiceCredit := serviceCredit.NewClientCreditService(
+		repoCredit.NewClientCredit(db),
+		repoCredit.WithAuditTx(repoCredit.NewClientCreditTx(db), recorder),
+	)
+	newCredit := credit.NewClientCreditHandler(newServiceCredit, log)
+
 	jwtCfg := config.LoadJwtConfig()
 
 	jwtManager := jwtAuth.NewJWTManager(
@@ -47,6 +60,7 @@ func RegisterClientRoutes(
 
 	s := r.PathPrefix("/").Subrouter()
 	s.Use(jwtMiddlewares.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwtMiddlewares.Guard(log)
 
 	const (
 		clients = "/clients-cpf"
@@ -54,16 +68,23 @@ func RegisterClientRoutes(
 		enable  = "/enable"
 		disable = "/disable"
 		filter  = "/filter"
+		credits = "/credit"
 	)
 
-	s.HandleFunc(baseURL+clients, newHandler.Create).Methods(http.MethodPost)
-	s.HandleFunc(baseURL+clients+idPath, newHandler.GetByID).Methods(http.MethodGet)
-	s.HandleFunc(baseURL+clients+idPath+version, newHandler.GetVersionByID).Methods(http.MethodGet)
-	s.HandleFunc(baseURL+clients+idPath, newHandler.Update).Methods(http.MethodPut)
-	s.HandleFunc(baseURL+clients+idPath, newHandler.Delete).Methods(http.MethodDelete)
+	s.Handle(baseURL+clients, guard(permission.ClientWrite, newHandler.Create)).Methods(http.MethodPost)
+	s.Handle(baseURL+clients+idPath, guard(permission.ClientRead, newHandler.GetByID)).Methods(http.MethodGet)
+	s.Handle(baseURL+clients+idPath+version, guard(permission.ClientRead, newHandler.GetVersionByID)).Methods(http.MethodGet)
+	s.Handle(baseURL+clients+idPath, guard(permission.ClientWrite, newHandler.Update)).Methods(http.MethodPut)
+	s.Handle(baseURL+clients+idPath, guard(permission.ClientDelete, newHandler.Delete)).Methods(http.MethodDelete)
+
+	s.Handle(baseURL+clients+idPath+disable, guard(permission.ClientWrite, newHandler.Disable)).Methods(http.MethodPatch)
+	s.Handle(baseURL+clients+idPath+enable, guard(permission.ClientWrite, newHandler.Enable)).Methods(http.MethodPatch)
 
-	s.HandleFunc(baseURL+clients+idPath+disable, newHandler.Disable).Methods(http.MethodPatch)
-	s.HandleFunc(baseURL+clients+idPath+enable, newHandler.Enable).Methods(http.MethodPatch)
+	s.Handle(baseURL+clients+filter, guard(permission.ClientRead, newFilter.Filter)).Methods(http.MethodGet)
 
-	s.HandleFunc(baseURL+clients+filter, newFilter.Filter).Methods(http.MethodGet)
+	s.Handle(baseURL+clients+idPath+credits, guard(permission.CreditRead, newCredit.GetByClientID)).Methods(http.MethodGet)
+	s.Handle(baseURL+clients+idPath+credits, guard(permission.CreditManage, newCredit.OpenOrAdjust)).Methods(http.MethodPut)
+	s.Handle(baseURL+clients+idPath+credits+"/charges", guard(permission.CreditPost, newCredit.Charge)).Methods(http.MethodPost)
+	s.Handle(baseURL+clients+idPath+credits+"/payments", guard(permission.CreditPost, newCredit.Payment)).Methods(http.MethodPost)
+	s.Handle(baseURL+clients+idPath+credits+"/statement", guard(permission.CreditRead, newCredit.GetStatement)).Methods(http.MethodGet)
 }
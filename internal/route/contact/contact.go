@@ -6,6 +6,7 @@ import (
 	"github.com/WagaoCarvalho/backend_store_go/config"
 	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/contact"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwtMiddleware "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
 
@@ -41,16 +42,17 @@ func RegisterContactRoutes(
 
 	s := r.PathPrefix("/").Subrouter()
 	s.Use(jwtMiddleware.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwtMiddleware.Guard(log)
 
 	const (
 		contact = "/contact"
 	)
 
-	s.HandleFunc(baseURL+contact, newHandler.Create).Methods(http.MethodPost)
+	s.Handle(baseURL+contact, guard(permission.ContactWrite, newHandler.Create)).Methods(http.MethodPost)
 
-	s.HandleFunc(baseURL+contact+idPath, newHandler.GetByID).Methods(http.MethodGet)
+	s.Handle(baseURL+contact+idPath, guard(permission.ContactRead, newHandler.GetByID)).Methods(http.MethodGet)
 
-	s.HandleFunc(baseURL+contact+idPath, newHandler.Update).Methods(http.MethodPut)
+	s.Handle(baseURL+contact+idPath, guard(permission.ContactWrite, newHandler.Update)).Methods(http.MethodPut)
 
-	s.HandleFunc(baseURL+contact+idPath, newHandler.Delete).Methods(http.MethodDelete)
+	s.Handle(baseURL+contact+idPath, guard(permission.ContactDelete, newHandler.Delete)).Methods(http.MethodDelete)
 }
@@ -0,0 +1,56 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/WagaoCarvalho/backend_store_go/config"
+	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/inventory/count"
+	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/inventory/count"
+	repoProduct "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/product"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/inventory/count"
+
+	"github.com/gorilla/mux"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RegisterInventoryCountRoutes expõe as sessões de inventário físico. Leituras
+// e fechamento alteram estoque e exigem a permissão de estoque.
+func RegisterInventoryCountRoutes(
+	r *mux.Router,
+	db *pgxpool.Pool,
+	log *logger.LogAdapter,
+	blacklist jwt.TokenBlacklist,
+) {
+	countService := service.NewInventoryCountService(
+		repo.NewInventoryCount(db),
+		repo.NewInventoryCountTx(db),
+		repoProduct.NewProductAdjustTx(),
+	)
+	handler := handler.NewInventoryCountHandler(countService, log)
+
+	// Config JWT
+	jwtCfg := config.LoadJwtConfig()
+	jwtManager := jwtAuth.NewJWTManager(
+		jwtCfg.SecretKey,
+		jwtCfg.TokenDuration,
+		jwtCfg.Issuer,
+		jwtCfg.Audience,
+	)
+
+	// Rotas protegidas
+	s := r.PathPrefix("/").Subrouter()
+	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwt.Guard(log)
+
+	s.Handle("/inventory-count", guard(permission.ProductStock, handler.Open)).Methods(http.MethodPost)
+	s.Handle("/inventory-counts", guard(permission.ProductRead, handler.List)).Methods(http.MethodGet)
+	s.Handle("/inventory-count/{id:[0-9]+}", guard(permission.ProductRead, handler.GetByID)).Methods(http.MethodGet)
+	s.Handle("/inventory-count/{id:[0-9]+}/items", guard(permission.ProductStock, handler.Record)).Methods(http.MethodPost)
+	s.Handle("/inventory-count/{id:[0-9]+}/variance", guard(permission.ProductRead, handler.Variance)).Methods(http.MethodGet)
+	s.Handle("/inventory-count/{id:[0-9]+}/close", guard(permission.ProductStock, handler.Close)).Methods(http.MethodPatch)
+	s.Handle("/inventory-count/{id:[0-9]+}/cancel", guard(permission.ProductStock, handler.Cancel)).Methods(http.MethodPatch)
+}
@@ -0,0 +1,47 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/WagaoCarvalho/backend_store_go/config"
+	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/inventory/location"
+	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/inventory/location"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/inventory/location"
+
+	"github.com/gorilla/mux"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RegisterLocationRoutes expõe o cadastro de lojas e depósitos.
+func RegisterLocationRoutes(
+	r *mux.Router,
+	db *pgxpool.Pool,
+	log *logger.LogAdapter,
+	blacklist jwt.TokenBlacklist,
+) {
+	locationService := service.NewLocationService(repo.NewLocation(db))
+	handler := handler.NewLocationHandler(locationService, log)
+
+	// Config JWT
+	jwtCfg := config.LoadJwtConfig()
+	jwtManager := jwtAuth.NewJWTManager(
+		jwtCfg.SecretKey,
+		jwtCfg.TokenDuration,
+		jwtCfg.Issuer,
+		jwtCfg.Audience,
+	)
+
+	// Rotas protegidas
+	s := r.PathPrefix("/").Subrouter()
+	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwt.Guard(log)
+
+	s.Handle("/location", guard(permission.ProductStock, handler.Create)).Methods(http.MethodPost)
+	s.Handle("/locations", guard(permission.ProductRead, handler.List)).Methods(http.MethodGet)
+	s.Handle("/location/{id:[0-9]+}", guard(permission.ProductRead, handler.GetByID)).Methods(http.MethodGet)
+	s.Handle("/location/{id:[0-9]+}", guard(permission.ProductStock, handler.Update)).Methods(http.MethodPut)
+}
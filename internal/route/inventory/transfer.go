@@ -0,0 +1,55 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/WagaoCarvalho/backend_store_go/config"
+	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/inventory/transfer"
+	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/inventory/transfer"
+	repoProduct "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/product"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/inventory/transfer"
+
+	"github.com/gorilla/mux"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RegisterTransferRoutes expõe as transferências de estoque entre locais.
+// Envio, recebimento e cancelamento movimentam saldo e exigem a permissão de
+// estoque.
+func RegisterTransferRoutes(
+	r *mux.Router,
+	db *pgxpool.Pool,
+	log *logger.LogAdapter,
+	blacklist jwt.TokenBlacklist,
+) {
+	transferService := service.NewTransferService(
+		repo.NewTransfer(db),
+		repo.NewTransferTx(db),
+		repoProduct.NewProductLocationTx(),
+	)
+	handler := handler.NewTransferHandler(transferService, log)
+
+	// Config JWT
+	jwtCfg := config.LoadJwtConfig()
+	jwtManager := jwtAuth.NewJWTManager(
+		jwtCfg.SecretKey,
+		jwtCfg.TokenDuration,
+		jwtCfg.Issuer,
+		jwtCfg.Audience,
+	)
+
+	// Rotas protegidas
+	s := r.PathPrefix("/").Subrouter()
+	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwt.Guard(log)
+
+	s.Handle("/stock-transfer", guard(permission.ProductStock, handler.Create)).Methods(http.MethodPost)
+	s.Handle("/stock-transfers", guard(permission.ProductRead, handler.List)).Methods(http.MethodGet)
+	s.Handle("/stock-transfer/{id:[0-9]+}", guard(permission.ProductRead, handler.GetByID)).Methods(http.MethodGet)
+	s.Handle("/stock-transfer/{id:[0-9]+}/receive", guard(permission.ProductStock, handler.Receive)).Methods(http.MethodPatch)
+	s.Handle("/stock-transfer/{id:[0-9]+}/cancel", guard(permission.ProductStock, handler.Cancel)).Methods(http.MethodPatch)
+}
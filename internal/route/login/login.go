@@ -6,27 +6,45 @@ import (
 	"github.com/WagaoCarvalho/backend_store_go/config"
 	loginHandler "github.com/WagaoCarvalho/backend_store_go/internal/handler/login"
 	logoutHandler "github.com/WagaoCarvalho/backend_store_go/internal/handler/logout"
+	sessionHandler "github.com/WagaoCarvalho/backend_store_go/internal/handler/session"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/lockout"
 	pass "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/password"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
-	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/user/filter"
+	jwtMiddlewares "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repoRole "github.com/WagaoCarvalho/backend_store_go/internal/repo/user/role"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/user/user"
 	login "github.com/WagaoCarvalho/backend_store_go/internal/service/login"
 	logout "github.com/WagaoCarvalho/backend_store_go/internal/service/logout"
+	session "github.com/WagaoCarvalho/backend_store_go/internal/service/session"
 
 	"github.com/gorilla/mux"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// TokenStore é o Redis que guarda a blacklist de tokens de acesso e as
+// sessões de refresh token e os contadores de falhas de login (ver
+// RedisTokenBlacklist).
+type TokenStore interface {
+	logout.TokenBlacklist
+	login.SessionStore
+	session.SessionStore
+	lockout.Store
+}
+
 func RegisterLoginRoutes(
 	r *mux.Router,
 	db *pgxpool.Pool,
 	log *logger.LogAdapter,
-	blacklist logout.TokenBlacklist,
+	store TokenStore,
 ) {
 	serverConfig := config.LoadServerConfig()
 	baseURL := serverConfig.BaseURL
+	idPath := serverConfig.IDPath
 
-	userRepo := repo.NewUserFilter(db)
+	userRepo := repo.NewUser(db)
+	roleRepo := repoRole.NewUserRole(db)
 
 	jwtCfg := config.LoadJwtConfig()
 
@@ -39,19 +57,51 @@ func RegisterLoginRoutes(
 
 	hasher := pass.BcryptHasher{}
 
-	newLoginService := login.NewLoginService(userRepo, jwtManager, hasher)
+	lockoutCfg := config.LoadLoginLockoutConfig()
+	loginGuard := lockout.NewGuard(store, lockout.Policy{
+		MaxFailures:   lockoutCfg.MaxFailures,
+		IPMaxFailures: lockoutCfg.IPMaxFailures,
+		Window:        lockoutCfg.Window,
+		LockDuration:  lockoutCfg.LockDuration,
+		BaseDelay:     lockoutCfg.BaseDelay,
+		MaxDelay:      lockoutCfg.MaxDelay,
+	})
+
+	ttl := login.TokenTTL{Access: jwtCfg.TokenDuration, Refresh: jwtCfg.RefreshTokenDuration}
+	newLoginService := login.NewLoginService(userRepo, roleRepo, jwtManager, hasher, store, loginGuard, ttl)
 	newLoginHandler := loginHandler.NewLoginHandler(newLoginService, log)
 
-	newLogoutService := logout.NewLogoutService(blacklist, jwtManager)
+	newLogoutService := logout.NewLogoutService(store, jwtManager, store)
 	newLogoutHandler := logoutHandler.NewLogoutHandler(newLogoutService, log)
 
+	newSessionService := session.NewSessionService(store)
+	newSessionHandler := sessionHandler.NewSessionHandler(newSessionService, log)
+
 	s := r.PathPrefix("/").Subrouter()
 
 	const (
-		loginPath  = "/login"
-		logoutPath = "/logout"
+		loginPath    = "/login"
+		logoutPath   = "/logout"
+		refreshPath  = "/refresh"
+		unlockPath   = "/login/unlock"
+		sessionsPath = "/sessions"
+		user         = "/user"
+		sessionID    = "/{session_id}"
 	)
 
 	s.HandleFunc(baseURL+loginPath, newLoginHandler.Login).Methods(http.MethodPost)
+	s.HandleFunc(baseURL+refreshPath, newLoginHandler.Refresh).Methods(http.MethodPost)
 	s.HandleFunc(baseURL+logoutPath, newLogoutHandler.Logout).Methods(http.MethodPost)
+
+	// Sessões: as próprias para qualquer usuário autenticado; as de terceiros
+	// e o desbloqueio de login apenas para quem administra usuários
+	p := r.PathPrefix("/").Subrouter()
+	p.Use(jwtMiddlewares.IsAuthByBearerToken(store, log, jwtManager))
+	guard := jwtMiddlewares.Guard(log)
+
+	p.HandleFunc(baseURL+sessionsPath, newSessionHandler.List).Methods(http.MethodGet)
+	p.HandleFunc(baseURL+sessionsPath+sessionID, newSessionHandler.Revoke).Methods(http.MethodDelete)
+	p.Handle(baseURL+unlockPath, guard(permission.UserWrite, newLoginHandler.Unlock)).Methods(http.MethodPost)
+	p.Handle(baseURL+user+idPath+sessionsPath, guard(permission.UserWrite, newSessionHandler.List)).Methods(http.MethodGet)
+	p.Handle(baseURL+user+idPath+sessionsPath+sessionID, guard(permission.UserWrite, newSessionHandler.Revoke)).Methods(http.MethodDelete)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/WagaoCarvalho/backend_store_go/config"
 	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/product/category"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwtMiddlewares "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
 	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/category"
@@ -46,6 +47,7 @@ func RegisterProductCategoryRoutes(
 	// Rotas protegidas
 	s := r.PathPrefix("/").Subrouter()
 	s.Use(jwtMiddlewares.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwtMiddlewares.Guard(log)
 
 	// Constantes para caminhos
 	const (
@@ -54,9 +56,9 @@ func RegisterProductCategoryRoutes(
 	)
 
 	// Rotas de categoria de produto
-	s.HandleFunc(baseURL+productCategory, newHandlerCategory.Create).Methods(http.MethodPost)
-	s.HandleFunc(baseURL+productCategories, newHandlerCategory.GetAll).Methods(http.MethodGet)
-	s.HandleFunc(baseURL+productCategory+idPath, newHandlerCategory.GetByID).Methods(http.MethodGet)
-	s.HandleFunc(baseURL+productCategory+idPath, newHandlerCategory.Update).Methods(http.MethodPut)
-	s.HandleFunc(baseURL+productCategory+idPath, newHandlerCategory.Delete).Methods(http.MethodDelete)
+	s.Handle(baseURL+productCategory, guard(permission.ProductWrite, newHandlerCategory.Create)).Methods(http.MethodPost)
+	s.Handle(baseURL+productCategories, guard(permission.ProductRead, newHandlerCategory.GetAll)).Methods(http.MethodGet)
+	s.Handle(baseURL+productCategory+idPath, guard(permission.ProductRead, newHandlerCategory.GetByID)).Methods(http.MethodGet)
+	s.Handle(baseURL+productCategory+idPath, guard(permission.ProductWrite, newHandlerCategory.Update)).Methods(http.MethodPut)
+	s.Handle(baseURL+productCategory+idPath, guard(permission.ProductDelete, newHandlerCategory.Delete)).Methods(http.MethodDelete)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/WagaoCarvalho/backend_store_go/config"
 	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/product/category_relation"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwtMiddlewares "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
 	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/category_relation"
@@ -38,6 +39,7 @@ func RegisterProductCategoryRelationRoutes(
 
 	s := r.PathPrefix("/").Subrouter()
 	s.Use(jwtMiddlewares.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwtMiddlewares.Guard(log)
 
 	const (
 		product                  = "/product"
@@ -48,8 +50,8 @@ func RegisterProductCategoryRelationRoutes(
 		category                 = "/category"
 	)
 
-	s.HandleFunc(baseURL+product+productIDParam+productCategoryRelations, newHandlerRelation.GetAllRelationsByProductID).Methods(http.MethodGet)
-	s.HandleFunc(baseURL+product+productIDParam+productCategoryRelations, newHandlerRelation.Create).Methods(http.MethodPost)
-	s.HandleFunc(baseURL+product+productIDParam+category+categoryIDParam, newHandlerRelation.Delete).Methods(http.MethodDelete)
-	s.HandleFunc(baseURL+product+productIDParam+productCategoryRelations, newHandlerRelation.DeleteAll).Methods(http.MethodDelete)
+	s.Handle(baseURL+product+productIDParam+productCategoryRelations, guard(permission.ProductRead, newHandlerRelation.GetAllRelationsByProductID)).Methods(http.MethodGet)
+	s.Handle(baseURL+product+productIDParam+productCategoryRelations, guard(permission.ProductWrite, newHandlerRelation.Create)).Methods(http.MethodPost)
+	s.Handle(baseURL+product+productIDParam+category+categoryIDParam, guard(permission.ProductWrite, newHandlerRelation.Delete)).Methods(http.MethodDelete)
+	s.Handle(baseURL+product+productIDParam+productCategoryRelations, guard(permission.ProductWrite, newHandlerRelation.DeleteAll)).Methods(http.MethodDelete)
 }
@@ -0,0 +1,70 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/WagaoCarvalho/backend_store_go/config"
+	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/product/price"
+	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	jwtMiddlewares "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/price"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/product/price"
+
+	"github.com/gorilla/mux"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RegisterPriceRoutes expõe o preço vigente, o histórico de preços e as
+// mudanças agendadas. A aplicação das mudanças fica com o agendador
+// iniciado em cmd/http.
+func RegisterPriceRoutes(
+	r *mux.Router,
+	db *pgxpool.Pool,
+	log *logger.LogAdapter,
+	blacklist jwtMiddlewares.TokenBlacklist,
+) {
+	serverConfig := config.LoadServerConfig()
+	baseURL := serverConfig.BaseURL
+	idPath := serverConfig.IDPath
+
+	// Repositórios
+	newRepoPrice := repo.NewPrice(db)
+
+	// Serviços
+	newServicePrice := service.NewPriceService(newRepoPrice)
+
+	// Handlers
+	newHandlerPrice := handler.NewPriceHandler(newServicePrice, log)
+
+	// Config JWT
+	jwtCfg := config.LoadJwtConfig()
+	jwtManager := jwtAuth.NewJWTManager(
+		jwtCfg.SecretKey,
+		jwtCfg.TokenDuration,
+		jwtCfg.Issuer,
+		jwtCfg.Audience,
+	)
+
+	// Rotas protegidas
+	s := r.PathPrefix("/").Subrouter()
+	s.Use(jwtMiddlewares.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwtMiddlewares.Guard(log)
+
+	// Constantes para caminhos
+	const (
+		product         = "/product"
+		price           = "/price"
+		priceHistory    = "/price-history"
+		scheduledPrice  = "/scheduled-price"
+		scheduledPrices = "/scheduled-prices"
+		cancel          = "/cancel"
+	)
+
+	s.Handle(baseURL+product+idPath+price, guard(permission.ProductRead, newHandlerPrice.EffectivePrice)).Methods(http.MethodGet)
+	s.Handle(baseURL+product+idPath+priceHistory, guard(permission.ProductRead, newHandlerPrice.History)).Methods(http.MethodGet)
+	s.Handle(baseURL+product+idPath+scheduledPrice, guard(permission.ProductWrite, newHandlerPrice.Schedule)).Methods(http.MethodPost)
+	s.Handle(baseURL+product+idPath+scheduledPrices, guard(permission.ProductRead, newHandlerPrice.ListScheduled)).Methods(http.MethodGet)
+	s.Handle(baseURL+scheduledPrice+idPath+cancel, guard(permission.ProductWrite, newHandlerPrice.CancelScheduled)).Methods(http.MethodPatch)
+}
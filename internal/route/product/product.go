@@ -6,13 +6,19 @@ import (
 	"github.com/WagaoCarvalho/backend_store_go/config"
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/handler/product/filter"
 	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/product/product"
+	search "github.com/WagaoCarvalho/backend_store_go/internal/handler/product/search"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwtMiddlewares "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repoAudit "github.com/WagaoCarvalho/backend_store_go/internal/repo/audit/audit"
 	repoFilter "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/filter"
 	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/product"
+	repoSearch "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/search"
 	serviceFilter "github.com/WagaoCarvalho/backend_store_go/internal/service/product/filter"
 	service "github.com/WagaoCarvalho/backend_store_go/internal/service/product/product"
+	serviceSearch "github.com/WagaoCarvalho/backend_store_go/internal/service/product/search"
 
 	"github.com/gorilla/mux"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -29,16 +35,20 @@ func RegisterProductRoutes(
 	idPath := serverConfig.IDPath
 
 	// Repositórios
-	newRepoProduct := repo.NewProduct(db)
+	recorder := audit.NewRecorder(repoAudit.NewAudit(db), log)
+	newRepoProduct := repo.WithAudit(repo.NewProduct(db), recorder)
 	newRepoFilter := repoFilter.NewFilterProduct(db)
+	newRepoSearch := repoSearch.NewProductSearch(db)
 
 	// Serviços
 	newServiceProduct := service.NewProductService(newRepoProduct)
 	newServiceFilter := serviceFilter.NewProductFilterService(newRepoFilter)
+	newServiceSearch := serviceSearch.NewProductSearchService(newRepoSearch)
 
 	// Handlers
 	newHandlerProduct := handler.NewProductHandler(newServiceProduct, log)
 	newHandlerFilter := filter.NewProductFilterHandler(newServiceFilter, log)
+	newHandlerSearch := search.NewProductSearchHandler(newServiceSearch, log)
 
 	// Config JWT
 	jwtCfg := config.LoadJwtConfig()
@@ -52,12 +62,14 @@ func RegisterProductRoutes(
 	// Rotas protegidas
 	s := r.PathPrefix("/").Subrouter()
 	s.Use(jwtMiddlewares.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwtMiddlewares.Guard(log)
 
 	// Constantes para caminhos
 	const (
 		product     = "/product"
 		products    = "/products"
 		filterPath  = "/filter"
+		searchPath  = "/search"
 		update      = "/update"
 		delete      = "/delete"
 		enable      = "/enable"
@@ -73,29 +85,32 @@ func RegisterProductRoutes(
 	)
 
 	// Rotas CRUD básicas
-	s.HandleFunc(baseURL+product, newHandlerProduct.Create).Methods(http.MethodPost)
-	s.HandleFunc(baseURL+product+idPath, newHandlerProduct.GetByID).Methods(http.MethodGet)
-	s.HandleFunc(baseURL+product+idPath, newHandlerProduct.Update).Methods(http.MethodPut)
-	s.HandleFunc(baseURL+product+idPath, newHandlerProduct.Delete).Methods(http.MethodDelete)
+	s.Handle(baseURL+product, guard(permission.ProductWrite, newHandlerProduct.Create)).Methods(http.MethodPost)
+	s.Handle(baseURL+product+idPath, guard(permission.ProductRead, newHandlerProduct.GetByID)).Methods(http.MethodGet)
+	s.Handle(baseURL+product+idPath, guard(permission.ProductWrite, newHandlerProduct.Update)).Methods(http.MethodPut)
+	s.Handle(baseURL+product+idPath, guard(permission.ProductDelete, newHandlerProduct.Delete)).Methods(http.MethodDelete)
 
 	// Rotas de status
-	s.HandleFunc(baseURL+product+idPath+enable, newHandlerProduct.EnableProduct).Methods(http.MethodPatch)
-	s.HandleFunc(baseURL+product+idPath+disable, newHandlerProduct.DisableProduct).Methods(http.MethodPatch)
+	s.Handle(baseURL+product+idPath+enable, guard(permission.ProductWrite, newHandlerProduct.EnableProduct)).Methods(http.MethodPatch)
+	s.Handle(baseURL+product+idPath+disable, guard(permission.ProductWrite, newHandlerProduct.DisableProduct)).Methods(http.MethodPatch)
 
 	// Rotas de versão
-	s.HandleFunc(baseURL+product+idPath+version, newHandlerProduct.GetVersionByID).Methods(http.MethodGet)
+	s.Handle(baseURL+product+idPath+version, guard(permission.ProductRead, newHandlerProduct.GetVersionByID)).Methods(http.MethodGet)
 
 	// Rotas de estoque
-	s.HandleFunc(baseURL+product+idPath+stock, newHandlerProduct.UpdateStock).Methods(http.MethodPatch)
-	s.HandleFunc(baseURL+product+idPath+increase, newHandlerProduct.IncreaseStock).Methods(http.MethodPatch)
-	s.HandleFunc(baseURL+product+idPath+decrease, newHandlerProduct.DecreaseStock).Methods(http.MethodPatch)
-	s.HandleFunc(baseURL+product+idPath+getStock, newHandlerProduct.GetStock).Methods(http.MethodGet)
+	s.Handle(baseURL+product+idPath+stock, guard(permission.ProductStock, newHandlerProduct.UpdateStock)).Methods(http.MethodPatch)
+	s.Handle(baseURL+product+idPath+increase, guard(permission.ProductStock, newHandlerProduct.IncreaseStock)).Methods(http.MethodPatch)
+	s.Handle(baseURL+product+idPath+decrease, guard(permission.ProductStock, newHandlerProduct.DecreaseStock)).Methods(http.MethodPatch)
+	s.Handle(baseURL+product+idPath+getStock, guard(permission.ProductRead, newHandlerProduct.GetStock)).Methods(http.MethodGet)
 
 	// Rotas de desconto
-	s.HandleFunc(baseURL+product+idPath+enableDisc, newHandlerProduct.EnableDiscount).Methods(http.MethodPatch)
-	s.HandleFunc(baseURL+product+idPath+disableDisc, newHandlerProduct.DisableDiscount).Methods(http.MethodPatch)
-	s.HandleFunc(baseURL+product+idPath+applyDisc, newHandlerProduct.ApplyDiscount).Methods(http.MethodPatch)
+	s.Handle(baseURL+product+idPath+enableDisc, guard(permission.ProductWrite, newHandlerProduct.EnableDiscount)).Methods(http.MethodPatch)
+	s.Handle(baseURL+product+idPath+disableDisc, guard(permission.ProductWrite, newHandlerProduct.DisableDiscount)).Methods(http.MethodPatch)
+	s.Handle(baseURL+product+idPath+applyDisc, guard(permission.ProductWrite, newHandlerProduct.ApplyDiscount)).Methods(http.MethodPatch)
 
 	// Rota de filtro
-	s.HandleFunc(baseURL+products+filterPath, newHandlerFilter.Filter).Methods(http.MethodGet)
+	s.Handle(baseURL+products+filterPath, guard(permission.ProductRead, newHandlerFilter.Filter)).Methods(http.MethodGet)
+
+	// Busca textual e autocomplete
+	s.Handle(baseURL+products+searchPath, guard(permission.ProductRead, newHandlerSearch.Search)).Methods(http.MethodGet)
 }
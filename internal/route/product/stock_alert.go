@@ -0,0 +1,64 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/WagaoCarvalho/backend_store_go/config"
+	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/product/stock_alert"
+	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	jwtMiddlewares "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/stock_alert"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/product/stock_alert"
+
+	"github.com/gorilla/mux"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RegisterStockAlertRoutes expõe os relatórios de estoque baixo. Os alertas
+// são gravados pelo stockalert.Checker iniciado em cmd/http.
+func RegisterStockAlertRoutes(
+	r *mux.Router,
+	db *pgxpool.Pool,
+	log *logger.LogAdapter,
+	blacklist jwtMiddlewares.TokenBlacklist,
+) {
+	serverConfig := config.LoadServerConfig()
+	baseURL := serverConfig.BaseURL
+
+	// Repositórios
+	newRepoStockAlert := repo.NewStockAlert(db)
+
+	// Serviços
+	newServiceStockAlert := service.NewStockAlertService(newRepoStockAlert)
+
+	// Handlers
+	newHandlerStockAlert := handler.NewStockAlertHandler(newServiceStockAlert, log)
+
+	// Config JWT
+	jwtCfg := config.LoadJwtConfig()
+	jwtManager := jwtAuth.NewJWTManager(
+		jwtCfg.SecretKey,
+		jwtCfg.TokenDuration,
+		jwtCfg.Issuer,
+		jwtCfg.Audience,
+	)
+
+	// Rotas protegidas
+	s := r.PathPrefix("/").Subrouter()
+	s.Use(jwtMiddlewares.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwtMiddlewares.Guard(log)
+
+	// Constantes para caminhos
+	const (
+		products      = "/products"
+		lowStock      = "/low-stock"
+		stockAlerts   = "/stock-alerts"
+		replenishment = "/replenishment"
+	)
+
+	s.Handle(baseURL+products+lowStock, guard(permission.ProductRead, newHandlerStockAlert.LowStock)).Methods(http.MethodGet)
+	s.Handle(baseURL+products+stockAlerts, guard(permission.ProductRead, newHandlerStockAlert.ListAlerts)).Methods(http.MethodGet)
+	s.Handle(baseURL+products+replenishment, guard(permission.ProductRead, newHandlerStockAlert.Replenishment)).Methods(http.MethodGet)
+}
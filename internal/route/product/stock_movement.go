@@ -0,0 +1,63 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/WagaoCarvalho/backend_store_go/config"
+	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/product/stock_movement"
+	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	jwtMiddlewares "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/stock_movement"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/product/stock_movement"
+
+	"github.com/gorilla/mux"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RegisterStockMovementRoutes expõe o histórico de estoque e o saldo em uma
+// data. Os movimentos são gravados pelos repositórios de produto.
+func RegisterStockMovementRoutes(
+	r *mux.Router,
+	db *pgxpool.Pool,
+	log *logger.LogAdapter,
+	blacklist jwtMiddlewares.TokenBlacklist,
+) {
+	serverConfig := config.LoadServerConfig()
+	baseURL := serverConfig.BaseURL
+	idPath := serverConfig.IDPath
+
+	// Repositórios
+	newRepoStockMovement := repo.NewStockMovement(db)
+
+	// Serviços
+	newServiceStockMovement := service.NewStockMovementService(newRepoStockMovement)
+
+	// Handlers
+	newHandlerStockMovement := handler.NewStockMovementHandler(newServiceStockMovement, log)
+
+	// Config JWT
+	jwtCfg := config.LoadJwtConfig()
+	jwtManager := jwtAuth.NewJWTManager(
+		jwtCfg.SecretKey,
+		jwtCfg.TokenDuration,
+		jwtCfg.Issuer,
+		jwtCfg.Audience,
+	)
+
+	// Rotas protegidas
+	s := r.PathPrefix("/").Subrouter()
+	s.Use(jwtMiddlewares.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwtMiddlewares.Guard(log)
+
+	// Constantes para caminhos
+	const (
+		product      = "/product"
+		stockHistory = "/stock-history"
+		stockAt      = "/stock-at"
+	)
+
+	s.Handle(baseURL+product+idPath+stockHistory, guard(permission.ProductRead, newHandlerStockMovement.History)).Methods(http.MethodGet)
+	s.Handle(baseURL+product+idPath+stockAt, guard(permission.ProductRead, newHandlerStockMovement.StockAt)).Methods(http.MethodGet)
+}
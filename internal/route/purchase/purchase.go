@@ -0,0 +1,67 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/WagaoCarvalho/backend_store_go/config"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/handler/purchase/filter"
+	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/purchase/order"
+	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repoProduct "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/product"
+	repoFilter "github.com/WagaoCarvalho/backend_store_go/internal/repo/purchase/filter"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/purchase/order"
+	repoReceipt "github.com/WagaoCarvalho/backend_store_go/internal/repo/purchase/receipt"
+	serviceFilter "github.com/WagaoCarvalho/backend_store_go/internal/service/purchase/filter"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/purchase/order"
+
+	"github.com/gorilla/mux"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func RegisterPurchaseRoutes(
+	r *mux.Router,
+	db *pgxpool.Pool,
+	log *logger.LogAdapter,
+	blacklist jwt.TokenBlacklist,
+) {
+	purchaseService := service.NewPurchaseOrderService(
+		repo.NewPurchaseOrder(db),
+		repoReceipt.NewPurchaseReceipt(db),
+		repo.NewPurchaseOrderTx(db),
+		repoReceipt.NewPurchaseReceiptTx(),
+		repoProduct.NewProductReceiveTx(),
+		config.LoadPurchaseConfig().CostMethod,
+	)
+	handler := handler.NewPurchaseOrderHandler(purchaseService, log)
+
+	serviceFilter := serviceFilter.NewPurchaseOrderFilterService(repoFilter.NewFilterPurchaseOrder(db))
+	filter := filter.NewPurchaseOrderFilterHandler(serviceFilter, log)
+
+	// Config JWT
+	jwtCfg := config.LoadJwtConfig()
+	jwtManager := jwtAuth.NewJWTManager(
+		jwtCfg.SecretKey,
+		jwtCfg.TokenDuration,
+		jwtCfg.Issuer,
+		jwtCfg.Audience,
+	)
+
+	// Rotas protegidas
+	s := r.PathPrefix("/").Subrouter()
+	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwt.Guard(log)
+
+	s.Handle("/purchase-order", guard(permission.PurchaseWrite, handler.Create)).Methods(http.MethodPost)
+	s.Handle("/purchase-order/{id:[0-9]+}", guard(permission.PurchaseRead, handler.GetByID)).Methods(http.MethodGet)
+	s.Handle("/purchase-order/{id:[0-9]+}", guard(permission.PurchaseWrite, handler.Update)).Methods(http.MethodPut)
+	s.Handle("/purchase-order/{id:[0-9]+}", guard(permission.PurchaseWrite, handler.Delete)).Methods(http.MethodDelete)
+	s.Handle("/purchase-order/{id:[0-9]+}/send", guard(permission.PurchaseWrite, handler.Send)).Methods(http.MethodPatch)
+	s.Handle("/purchase-order/{id:[0-9]+}/cancel", guard(permission.PurchaseWrite, handler.Cancel)).Methods(http.MethodPatch)
+	s.Handle("/purchase-order/{id:[0-9]+}/receipts", guard(permission.PurchaseReceive, handler.Receive)).Methods(http.MethodPost)
+	s.Handle("/purchase-order/{id:[0-9]+}/receipts", guard(permission.PurchaseRead, handler.GetReceipts)).Methods(http.MethodGet)
+
+	s.Handle("/purchase-orders/filter", guard(permission.PurchaseRead, filter.Filter)).Methods(http.MethodGet)
+}
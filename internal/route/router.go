@@ -4,21 +4,30 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/WagaoCarvalho/backend_store_go/config"
 	redis "github.com/WagaoCarvalho/backend_store_go/infra/db/redis"
 	handlers "github.com/WagaoCarvalho/backend_store_go/internal/handler/home"
+	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	cors "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/cors"
 	logging "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/logging"
 	rateLimiter "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/rate_limiter"
 	recover "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/recover"
 	request "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/request"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/ratelimit"
 	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
 	routesAddress "github.com/WagaoCarvalho/backend_store_go/internal/route/address"
+	routesAudit "github.com/WagaoCarvalho/backend_store_go/internal/route/audit"
+	routesCash "github.com/WagaoCarvalho/backend_store_go/internal/route/cash"
+	routesClientCnpj "github.com/WagaoCarvalho/backend_store_go/internal/route/client_cnpj"
 	routesClient "github.com/WagaoCarvalho/backend_store_go/internal/route/client_cpf"
 	routesContact "github.com/WagaoCarvalho/backend_store_go/internal/route/contact"
+	routesInventory "github.com/WagaoCarvalho/backend_store_go/internal/route/inventory"
 	routesLogin "github.com/WagaoCarvalho/backend_store_go/internal/route/login"
 	routesProduct "github.com/WagaoCarvalho/backend_store_go/internal/route/product"
+	routesPurchase "github.com/WagaoCarvalho/backend_store_go/internal/route/purchase"
 	routesSale "github.com/WagaoCarvalho/backend_store_go/internal/route/sale"
+	routesServiceOrder "github.com/WagaoCarvalho/backend_store_go/internal/route/service_order"
 	routesSupplier "github.com/WagaoCarvalho/backend_store_go/internal/route/supplier"
 	routesUser "github.com/WagaoCarvalho/backend_store_go/internal/route/user"
 	"github.com/gorilla/mux"
@@ -27,10 +36,12 @@ import (
 func NewRouter(log *logger.LogAdapter) *mux.Router {
 	r := mux.NewRouter().StrictSlash(true)
 
+	blacklist := redis.NewRedisTokenBlacklist("localhost:6379", "", 0)
+
 	r.Use(request.RequestIDMiddleware())
 	r.Use(recover.RecoverMiddleware(log))
 	r.Use(logging.LoggingMiddleware(log))
-	r.Use(rateLimiter.RateLimiter)
+	r.Use(newRateLimiter(log, blacklist))
 	r.Use(cors.CORS)
 
 	db, err := repo.Connect(&repo.RealPgxPool{})
@@ -38,8 +49,6 @@ func NewRouter(log *logger.LogAdapter) *mux.Router {
 		log.Error(context.TODO(), err, "Erro ao conectar ao banco de dados", nil)
 	}
 
-	blacklist := redis.NewRedisTokenBlacklist("localhost:6379", "", 0)
-
 	r.HandleFunc("/", handlers.GetHome).Methods(http.MethodGet)
 
 	//Login
@@ -58,23 +67,67 @@ func NewRouter(log *logger.LogAdapter) *mux.Router {
 	routesUser.RegisterUserCategoryRoutes(r, db, log, blacklist)
 	routesUser.RegisterUserCategoryRelationRoutes(r, db, log, blacklist)
 	routesUser.RegisterUserContactRelationRoutes(r, db, log, blacklist)
+	routesUser.RegisterUserRoleRoutes(r, db, log, blacklist)
 
 	//Clients
 	routesClient.RegisterClientRoutes(r, db, log, blacklist)
+	routesClientCnpj.RegisterClientCnpjRoutes(r, db, log, blacklist)
+	routesClientCnpj.RegisterClientCnpjContactRelationRoutes(r, db, log, blacklist)
 
 	//Products
 	routesProduct.RegisterProductRoutes(r, db, log, blacklist)
 	routesProduct.RegisterProductCategoryRoutes(r, db, log, blacklist)
 	routesProduct.RegisterProductCategoryRelationRoutes(r, db, log, blacklist)
+	routesProduct.RegisterStockAlertRoutes(r, db, log, blacklist)
+	routesProduct.RegisterStockMovementRoutes(r, db, log, blacklist)
+	routesProduct.RegisterPriceRoutes(r, db, log, blacklist)
+
+	//Inventário
+	routesInventory.RegisterInventoryCountRoutes(r, db, log, blacklist)
+	routesInventory.RegisterLocationRoutes(r, db, log, blacklist)
+	routesInventory.RegisterTransferRoutes(r, db, log, blacklist)
 
 	//Sale
 	routesSale.RegisterSaleRoutes(r, db, log, blacklist)
 
+	//Caixa
+	routesCash.RegisterCashSessionRoutes(r, db, log, blacklist)
+
+	//Compras
+	routesPurchase.RegisterPurchaseRoutes(r, db, log, blacklist)
+
+	//Ordens de serviço
+	routesServiceOrder.RegisterServiceOrderRoutes(r, db, log, blacklist)
+
 	//Adressess
 	routesAddress.RegisterAddressRoutes(r, db, log, blacklist)
 
 	//Contacts
 	routesContact.RegisterContactRoutes(r, db, log, blacklist)
 
+	//Auditoria
+	routesAudit.RegisterAuditRoutes(r, db, log, blacklist)
+
 	return r
 }
+
+// newRateLimiter monta o limitador configurado; com mais de uma instância da
+// API use RATE_LIMIT_BACKEND=redis para que o limite seja compartilhado.
+func newRateLimiter(log *logger.LogAdapter, store *redis.RedisTokenBlacklist) mux.MiddlewareFunc {
+	cfg := config.LoadRateLimitConfig()
+
+	var limiter ratelimit.Limiter = ratelimit.NewMemoryLimiter()
+	if cfg.Backend == "redis" {
+		limiter = store.RateLimiter()
+	}
+
+	jwtCfg := config.LoadJwtConfig()
+	jwtManager := jwtAuth.NewJWTManager(jwtCfg.SecretKey, jwtCfg.TokenDuration, jwtCfg.Issuer, jwtCfg.Audience)
+
+	return rateLimiter.RateLimiter(
+		limiter,
+		rateLimiter.PoliciesFromConfig(cfg),
+		rateLimiter.BearerUser(jwtManager),
+		log,
+	)
+}
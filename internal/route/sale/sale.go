@@ -1,17 +1,44 @@
 package routes
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/WagaoCarvalho/backend_store_go/config"
+	checkout "github.com/WagaoCarvalho/backend_store_go/internal/handler/sale/checkout"
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/handler/sale/filter"
+	item "github.com/WagaoCarvalho/backend_store_go/internal/handler/sale/item"
+	payment "github.com/WagaoCarvalho/backend_store_go/internal/handler/sale/payment"
+	pixHandler "github.com/WagaoCarvalho/backend_store_go/internal/handler/sale/pix"
+	receiptHandler "github.com/WagaoCarvalho/backend_store_go/internal/handler/sale/receipt"
 	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/sale/sale"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/pix"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/receipt"
+	repoAudit "github.com/WagaoCarvalho/backend_store_go/internal/repo/audit/audit"
+	repoCash "github.com/WagaoCarvalho/backend_store_go/internal/repo/cash/session"
+	repoClientCnpj "github.com/WagaoCarvalho/backend_store_go/internal/repo/client_cnpj/client"
+	repoCnpjCredit "github.com/WagaoCarvalho/backend_store_go/internal/repo/client_cnpj/credit"
+	repoClient "github.com/WagaoCarvalho/backend_store_go/internal/repo/client_cpf/client"
+	repoCredit "github.com/WagaoCarvalho/backend_store_go/internal/repo/client_cpf/credit"
+	repoPrice "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/price"
+	repoProduct "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/product"
 	repoFilter "github.com/WagaoCarvalho/backend_store_go/internal/repo/sale/filter"
+	repoItem "github.com/WagaoCarvalho/backend_store_go/internal/repo/sale/item"
+	repoPayment "github.com/WagaoCarvalho/backend_store_go/internal/repo/sale/payment"
+	repoPix "github.com/WagaoCarvalho/backend_store_go/internal/repo/sale/pix"
 	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/sale/sale"
+	repoReturn "github.com/WagaoCarvalho/backend_store_go/internal/repo/sale/sale_return"
+	serviceCheckout "github.com/WagaoCarvalho/backend_store_go/internal/service/sale/checkout"
 	serviceFilter "github.com/WagaoCarvalho/backend_store_go/internal/service/sale/filter"
+	serviceItem "github.com/WagaoCarvalho/backend_store_go/internal/service/sale/item"
+	servicePayment "github.com/WagaoCarvalho/backend_store_go/internal/service/sale/payment"
+	servicePix "github.com/WagaoCarvalho/backend_store_go/internal/service/sale/pix"
+	serviceReceipt "github.com/WagaoCarvalho/backend_store_go/internal/service/sale/receipt"
 	service "github.com/WagaoCarvalho/backend_store_go/internal/service/sale/sale"
 
 	"github.com/gorilla/mux"
@@ -24,14 +51,91 @@ func RegisterSaleRoutes(
 	log *logger.LogAdapter,
 	blacklist jwt.TokenBlacklist,
 ) {
-	repoSale := repo.NewSale(db)
-	saleService := service.NewSaleService(repoSale)
+	// As escritas em transação são auditadas na própria transação
+	recorder := audit.NewRecorder(repoAudit.NewAudit(db), log)
+	repoSaleTx := repo.WithAuditTx(repo.NewSaleTx(db), recorder)
+	repoItemTx := repoItem.WithAuditTx(repoItem.NewItemSaleTx(), recorder)
+	repoPaymentTx := repoPayment.WithAuditTx(repoPayment.NewSalePaymentTx(), recorder)
+	repoReturnTx := repoReturn.WithAuditTx(repoReturn.NewSaleReturnTx(), recorder)
+	repoStockTx := repoProduct.WithAuditStockTx(repoProduct.NewProductStockTx(), recorder)
+	repoCreditTx := repoCredit.WithAuditTx(repoCredit.NewClientCreditTx(db), recorder)
+	repoCnpjCreditTx := repoCnpjCredit.WithAuditTx(repoCnpjCredit.NewClientCnpjCreditTx(db), recorder)
+	repoPixTx := repoPix.WithAuditTx(repoPix.NewPixChargeTx(db), recorder)
+	repoPriceTx := repoPrice.NewPrice(db)
+
+	repoPix := repoPix.NewPixCharge(db)
+
+	repoSale := repo.WithAudit(repo.NewSale(db), recorder)
+	saleService := service.NewSaleService(
+		repoSale,
+		repoReturn.NewSaleReturn(db),
+		repoSaleTx,
+		repoItemTx,
+		repoReturnTx,
+		repoStockTx,
+		repoCreditTx,
+		repoCnpjCreditTx,
+		repoPaymentTx,
+		repoPixTx,
+		repoCash.NewCashRefundTx(db),
+	)
 	handler := handler.NewSaleHandler(saleService, log)
 
 	repoFilter := repoFilter.NewFilterSale(db)
 	serviceFilter := serviceFilter.NewSaleFilterService(repoFilter)
 	filter := filter.NewSaleFilterHandler(serviceFilter, log)
 
+	serviceCheckout := serviceCheckout.NewSaleCheckoutService(
+		repoSaleTx,
+		repoItemTx,
+		repoPaymentTx,
+		repoStockTx,
+		repoPriceTx,
+		repoCreditTx,
+		repoCnpjCreditTx,
+	)
+	checkout := checkout.NewSaleCheckoutHandler(serviceCheckout, log)
+
+	serviceItem := serviceItem.NewItemSaleService(repoItem.NewItemSale(db), repoSaleTx, repoItemTx, repoCreditTx, repoCnpjCreditTx, repoPriceTx, repoStockTx)
+	item := item.NewSaleItemHandler(serviceItem, log)
+
+	servicePayment := servicePayment.NewSalePaymentService(repoSale, repoSaleTx, repoPayment.NewSalePayment(db), repoPaymentTx)
+	payment := payment.NewSalePaymentHandler(servicePayment, log)
+
+	pixCfg := config.LoadPixConfig()
+	servicePix := servicePix.NewPixChargeService(
+		repoSale,
+		repoPix,
+		repoPixTx,
+		pix.NewStaticProvider(pixCfg.WebhookToken),
+		pix.Merchant{Key: pixCfg.Key, Name: pixCfg.MerchantName, City: pixCfg.MerchantCity},
+	)
+	pixCharge := pixHandler.NewPixChargeHandler(servicePix, log)
+
+	receiptCfg := config.LoadReceiptConfig()
+	renderer, err := receipt.LoadRenderer(receiptCfg.TemplatePath, receiptCfg.Columns)
+	if err != nil {
+		log.Error(context.TODO(), err, "Template de comprovante inválido; usando o padrão", nil)
+		renderer, _ = receipt.NewRenderer("", receiptCfg.Columns)
+	}
+	serviceReceipt := serviceReceipt.NewSaleReceiptService(
+		repoSale,
+		repoItem.NewItemSale(db),
+		repoPayment.NewSalePayment(db),
+		repoProduct.NewProduct(db),
+		repoClient.NewClientCpfRepo(db),
+		repoClientCnpj.NewClientCnpjRepo(db),
+		renderer,
+		receipt.Store{
+			Name:     receiptCfg.StoreName,
+			Document: receiptCfg.StoreDocument,
+			Address:  receiptCfg.StoreAddress,
+			Phone:    receiptCfg.StorePhone,
+			Footer:   receiptCfg.Footer,
+		},
+	)
+	saleReceipt := receiptHandler.NewSaleReceiptHandler(serviceReceipt, log)
+
 	// Config JWT
 	jwtCfg := config.LoadJwtConfig()
 	jwtManager := jwtAuth.NewJWTManager(
@@ -41,23 +145,52 @@ func RegisterSaleRoutes(
 		jwtCfg.Audience,
 	)
 
+	// Aviso de pagamento do PSP: autenticado pelo token do webhook, não por JWT
+	p := r.PathPrefix("/").Subrouter()
+	p.HandleFunc("/pix/webhook", pixCharge.Webhook).Methods(http.MethodPost)
+
 	// Rotas protegidas
 	s := r.PathPrefix("/").Subrouter()
 	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwt.Guard(log)
+
+	s.Handle("/sale", guard(permission.SaleCreate, handler.Create)).Methods(http.MethodPost)
+	s.Handle("/sale/checkout", guard(permission.SaleCreate, checkout.Checkout)).Methods(http.MethodPost)
+	s.Handle("/sale/{id:[0-9]+}", guard(permission.SaleRead, handler.GetByID)).Methods(http.MethodGet)
+	s.Handle("/sale/client/{client_id:[0-9]+}", guard(permission.SaleRead, handler.GetByClientID)).Methods(http.MethodGet)
+	s.Handle("/sale/user/{user_id:[0-9]+}", guard(permission.SaleRead, handler.GetByUserID)).Methods(http.MethodGet)
+	s.Handle("/sale/status/{status}", guard(permission.SaleRead, handler.GetByStatus)).Methods(http.MethodGet)
+	s.Handle("/sale/version/{id:[0-9]+}", guard(permission.SaleRead, handler.GetVersionByID)).Methods(http.MethodGet)
+	s.Handle("/sale/date-range/{start}/{end}", guard(permission.SaleRead, handler.GetByDateRange)).Methods(http.MethodGet)
+	s.Handle("/sale/{id:[0-9]+}", guard(permission.SaleUpdate, handler.Update)).Methods(http.MethodPut)
+	s.Handle("/sale/delete/{id:[0-9]+}", guard(permission.SaleDelete, handler.Delete)).Methods(http.MethodDelete)
+	s.Handle("/sale/{id:[0-9]+}/activate", guard(permission.SaleCancel, handler.Activate)).Methods(http.MethodPatch)
+	s.Handle("/sale/{id:[0-9]+}/cancel", guard(permission.SaleCancel, handler.Cancel)).Methods(http.MethodPatch)
+	s.Handle("/sale/{id:[0-9]+}/complete", guard(permission.SaleUpdate, handler.Complete)).Methods(http.MethodPatch)
+	s.Handle("/sale/{id:[0-9]+}/returned", guard(permission.SaleReturn, handler.Returned)).Methods(http.MethodPatch)
+	s.Handle("/sale/{id:[0-9]+}/returns", guard(permission.SaleReturn, handler.ReturnItems)).Methods(http.MethodPost)
+	s.Handle("/sale/{id:[0-9]+}/returns", guard(permission.SaleRead, handler.GetReturns)).Methods(http.MethodGet)
+	s.Handle("/sale/{id:[0-9]+}/receipt", guard(permission.SaleRead, saleReceipt.GetBySaleID)).Methods(http.MethodGet)
+
+	// Itens da venda
+	s.Handle("/sale/{sale_id:[0-9]+}/items", guard(permission.SaleRead, item.GetBySaleID)).Methods(http.MethodGet)
+	s.Handle("/sale/{sale_id:[0-9]+}/items", guard(permission.SaleUpdate, item.Create)).Methods(http.MethodPost)
+	s.Handle("/sale/{sale_id:[0-9]+}/items", guard(permission.SaleUpdate, item.DeleteBySaleID)).Methods(http.MethodDelete)
+	s.Handle("/sale/{sale_id:[0-9]+}/items/{id:[0-9]+}", guard(permission.SaleRead, item.GetByID)).Methods(http.MethodGet)
+	s.Handle("/sale/{sale_id:[0-9]+}/items/{id:[0-9]+}", guard(permission.SaleUpdate, item.Update)).Methods(http.MethodPut)
+	s.Handle("/sale/{sale_id:[0-9]+}/items/{id:[0-9]+}", guard(permission.SaleUpdate, item.Delete)).Methods(http.MethodDelete)
+	s.Handle("/sale/items/{id:[0-9]+}/exists", guard(permission.SaleRead, item.ItemExists)).Methods(http.MethodGet)
+	s.Handle("/sale/items/product/{product_id:[0-9]+}", guard(permission.SaleRead, item.GetByProductID)).Methods(http.MethodGet)
+
+	// Pagamentos da venda
+	s.Handle("/sale/{id:[0-9]+}/payments", guard(permission.SaleRead, payment.GetBySaleID)).Methods(http.MethodGet)
+	s.Handle("/sale/{id:[0-9]+}/payments", guard(permission.SaleUpdate, payment.Add)).Methods(http.MethodPost)
+	s.Handle("/sales/payments/totals", guard(permission.SaleRead, payment.TotalsByMethod)).Methods(http.MethodGet)
+
+	// Cobranças pix
+	s.Handle("/sale/{id:[0-9]+}/pix", guard(permission.SaleRead, pixCharge.GetBySaleID)).Methods(http.MethodGet)
+	s.Handle("/sale/{id:[0-9]+}/pix", guard(permission.SaleUpdate, pixCharge.Create)).Methods(http.MethodPost)
+	s.Handle("/pix/{txid:[0-9A-Za-z]+}/qrcode", guard(permission.SaleRead, pixCharge.QRCode)).Methods(http.MethodGet)
 
-	s.HandleFunc("/sale", handler.Create).Methods(http.MethodPost)
-	s.HandleFunc("/sale/{id:[0-9]+}", handler.GetByID).Methods(http.MethodGet)
-	s.HandleFunc("/sale/client/{client_id:[0-9]+}", handler.GetByClientID).Methods(http.MethodGet)
-	s.HandleFunc("/sale/user/{user_id:[0-9]+}", handler.GetByUserID).Methods(http.MethodGet)
-	s.HandleFunc("/sale/status/{status}", handler.GetByStatus).Methods(http.MethodGet)
-	s.HandleFunc("/sale/version/{id:[0-9]+}", handler.GetVersionByID).Methods(http.MethodGet)
-	s.HandleFunc("/sale/date-range/{start}/{end}", handler.GetByDateRange).Methods(http.MethodGet)
-	s.HandleFunc("/sale/{id:[0-9]+}", handler.Update).Methods(http.MethodPut)
-	s.HandleFunc("/sale/delete/{id:[0-9]+}", handler.Delete).Methods(http.MethodDelete)
-	s.HandleFunc("/sale/{id:[0-9]+}/activate", handler.Activate).Methods(http.MethodPatch)
-	s.HandleFunc("/sale/{id:[0-9]+}/cancel", handler.Cancel).Methods(http.MethodPatch)
-	s.HandleFunc("/sale/{id:[0-9]+}/complete", handler.Complete).Methods(http.MethodPatch)
-	s.HandleFunc("/sale/{id:[0-9]+}/returned", handler.Returned).Methods(http.MethodPatch)
-
-	s.HandleFunc("/sales/filter", filter.Filter).Methods(http.MethodGet)
+	s.Handle("/sales/filter", guard(permission.SaleRead, filter.Filter)).Methods(http.MethodGet)
 }
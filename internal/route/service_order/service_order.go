@@ -0,0 +1,76 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/WagaoCarvalho/backend_store_go/config"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/handler/service_order/filter"
+	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/service_order/order"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
+	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repoAudit "github.com/WagaoCarvalho/backend_store_go/internal/repo/audit/audit"
+	repoCnpjCredit "github.com/WagaoCarvalho/backend_store_go/internal/repo/client_cnpj/credit"
+	repoCredit "github.com/WagaoCarvalho/backend_store_go/internal/repo/client_cpf/credit"
+	repoProduct "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/product"
+	repoItem "github.com/WagaoCarvalho/backend_store_go/internal/repo/sale/item"
+	repoSale "github.com/WagaoCarvalho/backend_store_go/internal/repo/sale/sale"
+	repoFilter "github.com/WagaoCarvalho/backend_store_go/internal/repo/service_order/filter"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/service_order/order"
+	serviceFilter "github.com/WagaoCarvalho/backend_store_go/internal/service/service_order/filter"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/service_order/order"
+
+	"github.com/gorilla/mux"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func RegisterServiceOrderRoutes(
+	r *mux.Router,
+	db *pgxpool.Pool,
+	log *logger.LogAdapter,
+	blacklist jwt.TokenBlacklist,
+) {
+	// Peças, vendas geradas e crédito são auditados na transação da ordem
+	recorder := audit.NewRecorder(repoAudit.NewAudit(db), log)
+	orderService := service.NewServiceOrderService(
+		repo.NewServiceOrder(db),
+		repo.NewServiceOrderTx(db),
+		repoProduct.WithAuditStockTx(repoProduct.NewProductStockTx(), recorder),
+		repoSale.WithAuditTx(repoSale.NewSaleTx(db), recorder),
+		repoItem.WithAuditTx(repoItem.NewItemSaleTx(), recorder),
+		repoCredit.WithAuditTx(repoCredit.NewClientCreditTx(db), recorder),
+		repoCnpjCredit.WithAuditTx(repoCnpjCredit.NewClientCnpjCreditTx(db), recorder),
+	)
+	handler := handler.NewServiceOrderHandler(orderService, log)
+
+	serviceFilter := serviceFilter.NewServiceOrderFilterService(repoFilter.NewFilterServiceOrder(db))
+	filter := filter.NewServiceOrderFilterHandler(serviceFilter, log)
+
+	// Config JWT
+	jwtCfg := config.LoadJwtConfig()
+	jwtManager := jwtAuth.NewJWTManager(
+		jwtCfg.SecretKey,
+		jwtCfg.TokenDuration,
+		jwtCfg.Issuer,
+		jwtCfg.Audience,
+	)
+
+	// Rotas protegidas
+	s := r.PathPrefix("/").Subrouter()
+	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwt.Guard(log)
+
+	s.Handle("/order-service", guard(permission.ServiceOrderWrite, handler.Create)).Methods(http.MethodPost)
+	s.Handle("/order-service/{id:[0-9]+}", guard(permission.ServiceOrderRead, handler.GetByID)).Methods(http.MethodGet)
+	s.Handle("/order-service/{id:[0-9]+}", guard(permission.ServiceOrderWrite, handler.Update)).Methods(http.MethodPut)
+	s.Handle("/order-service/{id:[0-9]+}/status", guard(permission.ServiceOrderWrite, handler.ChangeStatus)).Methods(http.MethodPatch)
+	s.Handle("/order-service/{id:[0-9]+}/parts", guard(permission.ServiceOrderWrite, handler.AddPart)).Methods(http.MethodPost)
+	s.Handle("/order-service/{id:[0-9]+}/parts/{part_id:[0-9]+}", guard(permission.ServiceOrderWrite, handler.RemovePart)).Methods(http.MethodDelete)
+	s.Handle("/order-service/{id:[0-9]+}/labor", guard(permission.ServiceOrderWrite, handler.AddLabor)).Methods(http.MethodPost)
+	s.Handle("/order-service/{id:[0-9]+}/labor/{labor_id:[0-9]+}", guard(permission.ServiceOrderWrite, handler.RemoveLabor)).Methods(http.MethodDelete)
+	s.Handle("/order-service/{id:[0-9]+}/sale", guard(permission.ServiceOrderConvert, handler.ConvertToSale)).Methods(http.MethodPost)
+
+	s.Handle("/order-services/filter", guard(permission.ServiceOrderRead, filter.Filter)).Methods(http.MethodGet)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/WagaoCarvalho/backend_store_go/config"
 	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/supplier/category"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
 	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/supplier/category"
@@ -37,10 +38,11 @@ func RegisterSupplierCategoryRoutes(
 
 	s := r.PathPrefix("/").Subrouter()
 	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager)) // <- passe o jwtManager, não a string SecretKey
+	guard := jwt.Guard(log)
 
-	s.HandleFunc("/supplier-category", supplierCategoryHandler.Create).Methods(http.MethodPost)
-	s.HandleFunc("/supplier-category/{id:[0-9]+}", supplierCategoryHandler.GetByID).Methods(http.MethodGet)
-	s.HandleFunc("/supplier-categories", supplierCategoryHandler.GetAll).Methods(http.MethodGet)
-	s.HandleFunc("/supplier-category/{id:[0-9]+}", supplierCategoryHandler.Update).Methods(http.MethodPut)
-	s.HandleFunc("/supplier-category/{id:[0-9]+}", supplierCategoryHandler.Delete).Methods(http.MethodDelete)
+	s.Handle("/supplier-category", guard(permission.SupplierWrite, supplierCategoryHandler.Create)).Methods(http.MethodPost)
+	s.Handle("/supplier-category/{id:[0-9]+}", guard(permission.SupplierRead, supplierCategoryHandler.GetByID)).Methods(http.MethodGet)
+	s.Handle("/supplier-categories", guard(permission.SupplierRead, supplierCategoryHandler.GetAll)).Methods(http.MethodGet)
+	s.Handle("/supplier-category/{id:[0-9]+}", guard(permission.SupplierWrite, supplierCategoryHandler.Update)).Methods(http.MethodPut)
+	s.Handle("/supplier-category/{id:[0-9]+}", guard(permission.SupplierDelete, supplierCategoryHandler.Delete)).Methods(http.MethodDelete)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/WagaoCarvalho/backend_store_go/config"
 	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/supplier/category_relation"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
 	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/supplier/category_relation"
@@ -37,10 +38,11 @@ func RegisterSupplierCategoryRelationRoutes(
 
 	s := r.PathPrefix("/").Subrouter()
 	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwt.Guard(log)
 
-	s.HandleFunc("/supplier-category-relation", relationHandler.Create).Methods(http.MethodPost)
-	s.HandleFunc("/supplier-category-relations/{supplier_id:[0-9]+}", relationHandler.GetBySupplierID).Methods(http.MethodGet)
-	s.HandleFunc("/supplier-category-relation/{supplier_id:[0-9]+}/category/{category_id:[0-9]+}/exists", relationHandler.HasSupplierCategoryRelation).Methods(http.MethodGet)
-	s.HandleFunc("/supplier-category-relation/{supplier_id:[0-9]+}/category/{category_id:[0-9]+}", relationHandler.Delete).Methods(http.MethodDelete)
-	s.HandleFunc("/supplier-category-relation/{supplier_id:[0-9]+}", relationHandler.DeleteAllBySupplierID).Methods(http.MethodDelete)
+	s.Handle("/supplier-category-relation", guard(permission.SupplierWrite, relationHandler.Create)).Methods(http.MethodPost)
+	s.Handle("/supplier-category-relations/{supplier_id:[0-9]+}", guard(permission.SupplierRead, relationHandler.GetBySupplierID)).Methods(http.MethodGet)
+	s.Handle("/supplier-category-relation/{supplier_id:[0-9]+}/category/{category_id:[0-9]+}/exists", guard(permission.SupplierRead, relationHandler.HasSupplierCategoryRelation)).Methods(http.MethodGet)
+	s.Handle("/supplier-category-relation/{supplier_id:[0-9]+}/category/{category_id:[0-9]+}", guard(permission.SupplierWrite, relationHandler.Delete)).Methods(http.MethodDelete)
+	s.Handle("/supplier-category-relation/{supplier_id:[0-9]+}", guard(permission.SupplierWrite, relationHandler.DeleteAllBySupplierID)).Methods(http.MethodDelete)
 }
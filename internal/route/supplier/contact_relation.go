@@ -6,6 +6,7 @@ import (
 	"github.com/WagaoCarvalho/backend_store_go/config"
 	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/supplier/contact_relation"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
 	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/supplier/contact_relation"
@@ -37,11 +38,12 @@ func RegisterSupplierContactRelationRoutes(
 
 	s := r.PathPrefix("/").Subrouter()
 	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwt.Guard(log)
 
 	// Rotas Supplier Contact Relations
-	s.HandleFunc("/supplier-contact-relation", contactHandler.Create).Methods(http.MethodPost)
-	s.HandleFunc("/supplier-contact-relations/{supplier_id:[0-9]+}", contactHandler.GetAllBySupplierID).Methods(http.MethodGet)
-	s.HandleFunc("/supplier-contact-relation/{supplier_id:[0-9]+}/contact/{contact_id:[0-9]+}/exists", contactHandler.HasSupplierContactRelation).Methods(http.MethodGet)
-	s.HandleFunc("/supplier-contact-relation/{supplier_id:[0-9]+}/contact/{contact_id:[0-9]+}", contactHandler.Delete).Methods(http.MethodDelete)
-	s.HandleFunc("/supplier-contact-relation/{supplier_id:[0-9]+}", contactHandler.DeleteAll).Methods(http.MethodDelete)
+	s.Handle("/supplier-contact-relation", guard(permission.SupplierWrite, contactHandler.Create)).Methods(http.MethodPost)
+	s.Handle("/supplier-contact-relations/{supplier_id:[0-9]+}", guard(permission.SupplierRead, contactHandler.GetAllBySupplierID)).Methods(http.MethodGet)
+	s.Handle("/supplier-contact-relation/{supplier_id:[0-9]+}/contact/{contact_id:[0-9]+}/exists", guard(permission.SupplierRead, contactHandler.HasSupplierContactRelation)).Methods(http.MethodGet)
+	s.Handle("/supplier-contact-relation/{supplier_id:[0-9]+}/contact/{contact_id:[0-9]+}", guard(permission.SupplierWrite, contactHandler.Delete)).Methods(http.MethodDelete)
+	s.Handle("/supplier-contact-relation/{supplier_id:[0-9]+}", guard(permission.SupplierWrite, contactHandler.DeleteAll)).Methods(http.MethodDelete)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/WagaoCarvalho/backend_store_go/config"
 	handlers "github.com/WagaoCarvalho/backend_store_go/internal/handler/supplier/full"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
 	repoAddress "github.com/WagaoCarvalho/backend_store_go/internal/repo/address/address"
@@ -46,6 +47,7 @@ func RegisterSupplierFullRoutes(
 	// Rotas protegidas
 	s := r.PathPrefix("/").Subrouter()
 	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwt.Guard(log)
 
-	s.HandleFunc("/supplier-full", handler.CreateFull).Methods(http.MethodPost)
+	s.Handle("/supplier-full", guard(permission.SupplierWrite, handler.CreateFull)).Methods(http.MethodPost)
 }
@@ -7,6 +7,7 @@ import (
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/handler/supplier/filter"
 	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/supplier/supplier"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
 	repoFilter "github.com/WagaoCarvalho/backend_store_go/internal/repo/supplier/filter"
@@ -44,16 +45,17 @@ func RegisterSupplierRoutes(
 	// Rotas protegidas
 	s := r.PathPrefix("/").Subrouter()
 	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager))
-
-	s.HandleFunc("/supplier", handler.Create).Methods(http.MethodPost)
-	s.HandleFunc("/suppliers", handler.GetAll).Methods(http.MethodGet)
-	s.HandleFunc("/supplier/{id:[0-9]+}", handler.GetByID).Methods(http.MethodGet)
-	s.HandleFunc("/supplier/name/{name}", handler.GetByName).Methods(http.MethodGet)
-	s.HandleFunc("/supplier/version/{id:[0-9]+}", handler.GetVersionByID).Methods(http.MethodGet)
-	s.HandleFunc("/supplier/{id:[0-9]+}", handler.Update).Methods(http.MethodPut)
-	s.HandleFunc("/supplier/enable/{id:[0-9]+}", handler.Enable).Methods(http.MethodPatch)
-	s.HandleFunc("/supplier/disable/{id:[0-9]+}", handler.Disable).Methods(http.MethodPatch)
-	s.HandleFunc("/supplier/{id:[0-9]+}", handler.Delete).Methods(http.MethodDelete)
-
-	s.HandleFunc("/suppliers/filter", filter.Filter).Methods(http.MethodGet)
+	guard := jwt.Guard(log)
+
+	s.Handle("/supplier", guard(permission.SupplierWrite, handler.Create)).Methods(http.MethodPost)
+	s.Handle("/suppliers", guard(permission.SupplierRead, handler.GetAll)).Methods(http.MethodGet)
+	s.Handle("/supplier/{id:[0-9]+}", guard(permission.SupplierRead, handler.GetByID)).Methods(http.MethodGet)
+	s.Handle("/supplier/name/{name}", guard(permission.SupplierRead, handler.GetByName)).Methods(http.MethodGet)
+	s.Handle("/supplier/version/{id:[0-9]+}", guard(permission.SupplierRead, handler.GetVersionByID)).Methods(http.MethodGet)
+	s.Handle("/supplier/{id:[0-9]+}", guard(permission.SupplierWrite, handler.Update)).Methods(http.MethodPut)
+	s.Handle("/supplier/enable/{id:[0-9]+}", guard(permission.SupplierWrite, handler.Enable)).Methods(http.MethodPatch)
+	s.Handle("/supplier/disable/{id:[0-9]+}", guard(permission.SupplierWrite, handler.Disable)).Methods(http.MethodPatch)
+	s.Handle("/supplier/{id:[0-9]+}", guard(permission.SupplierDelete, handler.Delete)).Methods(http.MethodDelete)
+
+	s.Handle("/suppliers/filter", guard(permission.SupplierRead, filter.Filter)).Methods(http.MethodGet)
 }
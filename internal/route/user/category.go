@@ -6,6 +6,7 @@ import (
 	"github.com/WagaoCarvalho/backend_store_go/config"
 	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/user/category"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
 	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/user/category"
@@ -37,10 +38,11 @@ func RegisterUserCategoryRoutes(
 
 	s := r.PathPrefix("/").Subrouter()
 	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager)) // <- passe o jwtManager, não a string SecretKey
+	guard := jwt.Guard(log)
 
-	s.HandleFunc("/user-category", userCategoryHandler.Create).Methods(http.MethodPost)
-	s.HandleFunc("/user-category/{id:[0-9]+}", userCategoryHandler.GetByID).Methods(http.MethodGet)
-	s.HandleFunc("/user-categories", userCategoryHandler.GetAll).Methods(http.MethodGet)
-	s.HandleFunc("/user-category/{id:[0-9]+}", userCategoryHandler.Update).Methods(http.MethodPut)
-	s.HandleFunc("/user-category/{id:[0-9]+}", userCategoryHandler.Delete).Methods(http.MethodDelete)
+	s.Handle("/user-category", guard(permission.UserWrite, userCategoryHandler.Create)).Methods(http.MethodPost)
+	s.Handle("/user-category/{id:[0-9]+}", guard(permission.UserRead, userCategoryHandler.GetByID)).Methods(http.MethodGet)
+	s.Handle("/user-categories", guard(permission.UserRead, userCategoryHandler.GetAll)).Methods(http.MethodGet)
+	s.Handle("/user-category/{id:[0-9]+}", guard(permission.UserWrite, userCategoryHandler.Update)).Methods(http.MethodPut)
+	s.Handle("/user-category/{id:[0-9]+}", guard(permission.UserDelete, userCategoryHandler.Delete)).Methods(http.MethodDelete)
 }
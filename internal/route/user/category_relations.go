@@ -6,6 +6,7 @@ import (
 	"github.com/WagaoCarvalho/backend_store_go/config"
 	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/user/category_relation"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
 	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/user/category_relation"
@@ -37,10 +38,11 @@ func RegisterUserCategoryRelationRoutes(
 
 	s := r.PathPrefix("/").Subrouter()
 	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwt.Guard(log)
 
-	s.HandleFunc("/user-category-relation", relationHandler.Create).Methods(http.MethodPost)
-	s.HandleFunc("/user-category-relations/{user_id:[0-9]+}", relationHandler.GetAllRelationsByUserID).Methods(http.MethodGet)
-	s.HandleFunc("/user-category-relation/{user_id:[0-9]+}/category/{category_id:[0-9]+}/exists", relationHandler.HasUserCategoryRelation).Methods(http.MethodGet)
-	s.HandleFunc("/user-category-relation/{user_id:[0-9]+}/category/{category_id:[0-9]+}", relationHandler.Delete).Methods(http.MethodDelete)
-	s.HandleFunc("/user-category-relation/{user_id:[0-9]+}", relationHandler.DeleteAll).Methods(http.MethodDelete)
+	s.Handle("/user-category-relation", guard(permission.UserWrite, relationHandler.Create)).Methods(http.MethodPost)
+	s.Handle("/user-category-relations/{user_id:[0-9]+}", guard(permission.UserRead, relationHandler.GetAllRelationsByUserID)).Methods(http.MethodGet)
+	s.Handle("/user-category-relation/{user_id:[0-9]+}/category/{category_id:[0-9]+}/exists", guard(permission.UserRead, relationHandler.HasUserCategoryRelation)).Methods(http.MethodGet)
+	s.Handle("/user-category-relation/{user_id:[0-9]+}/category/{category_id:[0-9]+}", guard(permission.UserWrite, relationHandler.Delete)).Methods(http.MethodDelete)
+	s.Handle("/user-category-relation/{user_id:[0-9]+}", guard(permission.UserWrite, relationHandler.DeleteAll)).Methods(http.MethodDelete)
 }
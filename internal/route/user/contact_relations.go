@@ -6,6 +6,7 @@ import (
 	"github.com/WagaoCarvalho/backend_store_go/config"
 	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/user/contact_relation"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
 	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/user/contact_relation"
@@ -37,10 +38,11 @@ func RegisterUserContactRelationRoutes(
 
 	s := r.PathPrefix("/").Subrouter()
 	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwt.Guard(log)
 
-	s.HandleFunc("/user-contact-relation", relationHandler.Create).Methods(http.MethodPost)
-	s.HandleFunc("/user-contact-relations/{user_id:[0-9]+}", relationHandler.GetAllByUserID).Methods(http.MethodGet)
-	s.HandleFunc("/user-contact-relation/{user_id:[0-9]+}/contact/{contact_id:[0-9]+}/exists", relationHandler.HasRelation).Methods(http.MethodGet)
-	s.HandleFunc("/user-contact-relation/{user_id:[0-9]+}/contact/{contact_id:[0-9]+}", relationHandler.Delete).Methods(http.MethodDelete)
-	s.HandleFunc("/user-contact-relation/{user_id:[0-9]+}", relationHandler.DeleteAll).Methods(http.MethodDelete)
+	s.Handle("/user-contact-relation", guard(permission.UserWrite, relationHandler.Create)).Methods(http.MethodPost)
+	s.Handle("/user-contact-relations/{user_id:[0-9]+}", guard(permission.UserRead, relationHandler.GetAllByUserID)).Methods(http.MethodGet)
+	s.Handle("/user-contact-relation/{user_id:[0-9]+}/contact/{contact_id:[0-9]+}/exists", guard(permission.UserRead, relationHandler.HasRelation)).Methods(http.MethodGet)
+	s.Handle("/user-contact-relation/{user_id:[0-9]+}/contact/{contact_id:[0-9]+}", guard(permission.UserWrite, relationHandler.Delete)).Methods(http.MethodDelete)
+	s.Handle("/user-contact-relation/{user_id:[0-9]+}", guard(permission.UserWrite, relationHandler.DeleteAll)).Methods(http.MethodDelete)
 }
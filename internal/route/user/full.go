@@ -7,6 +7,7 @@ import (
 	handlers "github.com/WagaoCarvalho/backend_store_go/internal/handler/user/full"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
 	auth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/password"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwt "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
 	repoAddress "github.com/WagaoCarvalho/backend_store_go/internal/repo/address/address"
@@ -51,11 +52,12 @@ func RegisterUserFullRoutes(
 	// Rotas protegidas
 	s := r.PathPrefix("/").Subrouter()
 	s.Use(jwt.IsAuthByBearerToken(blacklist, log, jwtManager)) // <- passa jwtManager, não string SecretKey
+	guard := jwt.Guard(log)
 
 	// Constantes para caminhos
 	const (
 		userFull = "/user-full"
 	)
 
-	s.HandleFunc(baseURL+userFull, handler.CreateFull).Methods(http.MethodPost)
+	s.Handle(baseURL+userFull, guard(permission.UserWrite, handler.CreateFull)).Methods(http.MethodPost)
 }
@@ -0,0 +1,55 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/WagaoCarvalho/backend_store_go/config"
+	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/user/role"
+	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
+	jwtMiddlewares "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/user/role"
+	service "github.com/WagaoCarvalho/backend_store_go/internal/service/user/role"
+
+	"github.com/gorilla/mux"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func RegisterUserRoleRoutes(
+	r *mux.Router,
+	db *pgxpool.Pool,
+	log *logger.LogAdapter,
+	blacklist jwtMiddlewares.TokenBlacklist,
+) {
+	serverConfig := config.LoadServerConfig()
+	baseURL := serverConfig.BaseURL
+	idPath := serverConfig.IDPath
+
+	newRepo := repo.NewUserRole(db)
+	newService := service.NewUserRoleService(newRepo)
+	newHandler := handler.NewUserRoleHandler(newService, log)
+
+	jwtCfg := config.LoadJwtConfig()
+	jwtManager := jwtAuth.NewJWTManager(
+		jwtCfg.SecretKey,
+		jwtCfg.TokenDuration,
+		jwtCfg.Issuer,
+		jwtCfg.Audience,
+	)
+
+	s := r.PathPrefix("/").Subrouter()
+	s.Use(jwtMiddlewares.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwtMiddlewares.Guard(log)
+
+	const (
+		roles  = "/roles"
+		user   = "/user"
+		roleID = "/{role_id:[0-9]+}"
+	)
+
+	s.Handle(baseURL+roles, guard(permission.RoleRead, newHandler.GetAllRoles)).Methods(http.MethodGet)
+	s.Handle(baseURL+user+idPath+roles, guard(permission.RoleRead, newHandler.GetRolesByUserID)).Methods(http.MethodGet)
+	s.Handle(baseURL+user+idPath+roles+roleID, guard(permission.RoleAssign, newHandler.AssignRole)).Methods(http.MethodPost)
+	s.Handle(baseURL+user+idPath+roles+roleID, guard(permission.RoleAssign, newHandler.RemoveRole)).Methods(http.MethodDelete)
+}
@@ -6,10 +6,13 @@ import (
 	"github.com/WagaoCarvalho/backend_store_go/config"
 	handlerFilter "github.com/WagaoCarvalho/backend_store_go/internal/handler/user/filter"
 	handler "github.com/WagaoCarvalho/backend_store_go/internal/handler/user/user"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/audit"
 	jwtAuth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/jwt"
 	auth "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/password"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/permission"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/logger"
 	jwtMiddlewares "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/jwt"
+	repoAudit "github.com/WagaoCarvalho/backend_store_go/internal/repo/audit/audit"
 	repoFilter "github.com/WagaoCarvalho/backend_store_go/internal/repo/user/filter"
 	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/user/user"
 	serviceFilter "github.com/WagaoCarvalho/backend_store_go/internal/service/user/filter"
@@ -30,7 +33,8 @@ func RegisterUserRoutes(
 	idPath := serverConfig.IDPath
 
 	// Repositórios
-	newRepoUser := repo.NewUser(db)
+	recorder := audit.NewRecorder(repoAudit.NewAudit(db), log)
+	newRepoUser := repo.WithAudit(repo.NewUser(db), recorder)
 	newRepoFilter := repoFilter.NewUserFilter(db)
 
 	// Dependências
@@ -59,6 +63,7 @@ func RegisterUserRoutes(
 	// Rotas protegidas
 	s := r.PathPrefix("/").Subrouter()
 	s.Use(jwtMiddlewares.IsAuthByBearerToken(blacklist, log, jwtManager))
+	guard := jwtMiddlewares.Guard(log)
 
 	// Constantes para caminhos
 	const (
@@ -71,15 +76,15 @@ func RegisterUserRoutes(
 	)
 
 	// Rotas de listagem e busca
-	s.HandleFunc(baseURL+users+filter, newHandlerFilter.Filter).Methods(http.MethodGet)
+	s.Handle(baseURL+users+filter, guard(permission.UserRead, newHandlerFilter.Filter)).Methods(http.MethodGet)
 
 	// Rotas por ID
-	s.HandleFunc(baseURL+user+idPath, newHandlerUser.GetByID).Methods(http.MethodGet)
-	s.HandleFunc(baseURL+user+version+idPath, newHandlerUser.GetVersionByID).Methods(http.MethodGet)
-	s.HandleFunc(baseURL+user+idPath, newHandlerUser.Update).Methods(http.MethodPut)
-	s.HandleFunc(baseURL+user+idPath, newHandlerUser.Delete).Methods(http.MethodDelete)
+	s.Handle(baseURL+user+idPath, guard(permission.UserRead, newHandlerUser.GetByID)).Methods(http.MethodGet)
+	s.Handle(baseURL+user+version+idPath, guard(permission.UserRead, newHandlerUser.GetVersionByID)).Methods(http.MethodGet)
+	s.Handle(baseURL+user+idPath, guard(permission.UserWrite, newHandlerUser.Update)).Methods(http.MethodPut)
+	s.Handle(baseURL+user+idPath, guard(permission.UserDelete, newHandlerUser.Delete)).Methods(http.MethodDelete)
 
 	// Rotas de status
-	s.HandleFunc(baseURL+user+enable+idPath, newHandlerUser.Enable).Methods(http.MethodPatch)
-	s.HandleFunc(baseURL+user+disable+idPath, newHandlerUser.Disable).Methods(http.MethodPatch)
+	s.Handle(baseURL+user+enable+idPath, guard(permission.UserWrite, newHandlerUser.Enable)).Methods(http.MethodPatch)
+	s.Handle(baseURL+user+disable+idPath, guard(permission.UserWrite, newHandlerUser.Disable)).Methods(http.MethodPatch)
 }
@@ -3,13 +3,14 @@ package services
 import (
 	"context"
 	"fmt"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/address/address"
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/address/filter"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 )
 
-func (s *addressFiltertService) Filter(ctx context.Context, filter *filter.AddressFilter) ([]*model.Address, error) {
+func (s *addressFiltertService) Filter(ctx context.Context, filter *filter.AddressFilter) (*commonFilter.Page[*model.Address], error) {
 	if filter == nil {
 		return nil, fmt.Errorf("%w: filtro não pode ser nulo", errMsg.ErrInvalidFilter)
 	}
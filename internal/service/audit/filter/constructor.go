@@ -0,0 +1,13 @@
+package services
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/audit/filter"
+
+type auditFilterService struct {
+	repo repo.AuditFilter
+}
+
+func NewAuditFilterService(repo repo.AuditFilter) AuditFilter {
+	return &auditFilterService{
+		repo: repo,
+	}
+}
@@ -0,0 +1,28 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *auditFilterService) Filter(ctx context.Context, filter *filter.AuditFilter) (*commonFilter.Page[*model.AuditLog], error) {
+	if filter == nil {
+		return nil, fmt.Errorf("%w: filtro não pode ser nulo", errMsg.ErrInvalidFilter)
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidFilter, err)
+	}
+
+	logs, err := s.repo.Filter(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return logs, nil
+}
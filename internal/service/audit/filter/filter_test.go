@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockAudit "github.com/WagaoCarvalho/backend_store_go/infra/mock/audit"
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/audit"
+	auditFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/audit/filter"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditService_Filter(t *testing.T) {
+
+	t.Run("falha quando filtro é nulo", func(t *testing.T) {
+		mockRepo := new(mockAudit.MockAudit)
+		service := NewAuditFilterService(mockRepo)
+
+		result, err := service.Filter(context.Background(), nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "Filter", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha na validação do filtro", func(t *testing.T) {
+		mockRepo := new(mockAudit.MockAudit)
+		service := NewAuditFilterService(mockRepo)
+
+		entityID := int64(1)
+		invalidFilter := &auditFilter.AuditFilter{
+			BaseFilter: filter.BaseFilter{Limit: 10},
+			EntityID:   &entityID, // sem EntityType
+		}
+
+		result, err := service.Filter(context.Background(), invalidFilter)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "Filter", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha ao buscar no repositório", func(t *testing.T) {
+		mockRepo := new(mockAudit.MockAudit)
+		service := NewAuditFilterService(mockRepo)
+
+		validFilter := &auditFilter.AuditFilter{BaseFilter: filter.BaseFilter{Limit: 10}}
+		dbErr := errors.New("falha no banco de dados")
+
+		mockRepo.On("Filter", mock.Anything, validFilter).Return(nil, dbErr).Once()
+
+		result, err := service.Filter(context.Background(), validFilter)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+		assert.ErrorContains(t, err, dbErr.Error())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("sucesso ao retornar registros", func(t *testing.T) {
+		mockRepo := new(mockAudit.MockAudit)
+		service := NewAuditFilterService(mockRepo)
+
+		validFilter := &auditFilter.AuditFilter{
+			BaseFilter: filter.BaseFilter{Limit: 10},
+			EntityType: "product",
+		}
+		expected := []*model.AuditLog{{ID: 1, EntityType: "product", EntityID: 2, Action: model.ActionUpdate}}
+
+		mockRepo.On("Filter", mock.Anything, validFilter).Return(expected, nil).Once()
+
+		result, err := service.Filter(context.Background(), validFilter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result.Items)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,13 @@
+package services
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/cash/filter"
+
+type cashSessionFilterService struct {
+	repo repo.CashSessionFilter
+}
+
+func NewCashSessionFilterService(repo repo.CashSessionFilter) CashSessionFilter {
+	return &cashSessionFilterService{
+		repo: repo,
+	}
+}
@@ -0,0 +1,28 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/filter"
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *cashSessionFilterService) Filter(ctx context.Context, filter *filter.CashSessionFilter) (*commonFilter.Page[*model.CashSession], error) {
+	if filter == nil {
+		return nil, fmt.Errorf("%w: filtro não pode ser nulo", errMsg.ErrInvalidFilter)
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidFilter, err)
+	}
+
+	sessions, err := s.repo.Filter(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockCash "github.com/WagaoCarvalho/backend_store_go/infra/mock/cash"
+	cashFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/filter"
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCashSessionFilterService_Filter(t *testing.T) {
+	t.Run("falha quando filtro é nulo", func(t *testing.T) {
+		mockRepo := new(mockCash.MockCashSessionFilter)
+		service := NewCashSessionFilterService(mockRepo)
+
+		result, err := service.Filter(context.Background(), nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "Filter", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha na validação do filtro", func(t *testing.T) {
+		mockRepo := new(mockCash.MockCashSessionFilter)
+		service := NewCashSessionFilterService(mockRepo)
+
+		invalidFilter := &cashFilter.CashSessionFilter{
+			BaseFilter: filter.BaseFilter{Limit: 10},
+			Result:     "missing",
+		}
+
+		result, err := service.Filter(context.Background(), invalidFilter)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "Filter", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha ao buscar no repositório", func(t *testing.T) {
+		mockRepo := new(mockCash.MockCashSessionFilter)
+		service := NewCashSessionFilterService(mockRepo)
+
+		validFilter := &cashFilter.CashSessionFilter{BaseFilter: filter.BaseFilter{Limit: 10}}
+		mockRepo.On("Filter", mock.Anything, validFilter).Return(nil, errors.New("falha no banco de dados")).Once()
+
+		result, err := service.Filter(context.Background(), validFilter)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("sucesso ao retornar lista de caixas", func(t *testing.T) {
+		mockRepo := new(mockCash.MockCashSessionFilter)
+		service := NewCashSessionFilterService(mockRepo)
+
+		validFilter := &cashFilter.CashSessionFilter{BaseFilter: filter.BaseFilter{Limit: 10}, Status: "closed"}
+		sessions := []*model.CashSession{{ID: 1, Status: "closed"}, {ID: 2, Status: "closed"}}
+		mockRepo.On("Filter", mock.Anything, validFilter).Return(sessions, nil).Once()
+
+		result, err := service.Filter(context.Background(), validFilter)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Items, 2)
+		mockRepo.AssertExpectations(t)
+	})
+}
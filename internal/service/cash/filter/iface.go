@@ -0,0 +1,7 @@
+package services
+
+import iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/cash"
+
+type CashSessionFilter interface {
+	iface.CashSessionFilter
+}
@@ -0,0 +1,18 @@
+package services
+
+import (
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/cash"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/cash/session"
+)
+
+type cashSessionService struct {
+	repo   repo.CashSession
+	repoTx iface.CashSessionTx
+}
+
+func NewCashSessionService(repo repo.CashSession, repoTx iface.CashSessionTx) CashSessionService {
+	return &cashSessionService{
+		repo:   repo,
+		repoTx: repoTx,
+	}
+}
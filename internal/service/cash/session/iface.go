@@ -0,0 +1,19 @@
+package services
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+)
+
+type CashSessionService interface {
+	Open(ctx context.Context, session *models.CashSession) (*models.CashSession, error)
+	GetByID(ctx context.Context, id int64) (*models.CashSession, error)
+	Current(ctx context.Context, userID int64) (*models.CashSession, error)
+
+	Movements(ctx context.Context, id int64) ([]*models.CashMovement, error)
+	AddMovement(ctx context.Context, movement *models.CashMovement) (*models.CashMovement, error)
+
+	Summary(ctx context.Context, id int64) (*models.Summary, error)
+	Close(ctx context.Context, id int64, closing *models.Closing) (*models.Summary, error)
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *cashSessionService) GetByID(ctx context.Context, id int64) (*models.CashSession, error) {
+	if id <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	return s.repo.GetByID(ctx, id)
+}
+
+// Current devolve o caixa aberto do operador.
+func (s *cashSessionService) Current(ctx context.Context, userID int64) (*models.CashSession, error) {
+	if userID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	return s.repo.GetOpenByUser(ctx, userID)
+}
+
+func (s *cashSessionService) Movements(ctx context.Context, id int64) ([]*models.CashMovement, error) {
+	if id <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Movements(ctx, id)
+}
+
+// Summary resume o caixa por forma de pagamento. Aberto, serve de parcial;
+// fechado, traz também o contado e a diferença gravados no fechamento.
+func (s *cashSessionService) Summary(ctx context.Context, id int64) (*models.Summary, error) {
+	if id <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	session, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	tenders, err := s.repo.Tenders(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	movements, err := s.repo.MovementTotals(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewSummary(session, tenders, *movements), nil
+}
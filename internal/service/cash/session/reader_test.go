@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCashSessionService_GetByID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newSessionService()
+
+		_, err := svc.GetByID(ctx, 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("retorna o caixa", func(t *testing.T) {
+		svc, m := newSessionService()
+		m.repo.On("GetByID", ctx, int64(1)).Return(openSession(), nil).Once()
+
+		session, err := svc.GetByID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), session.UserID)
+	})
+}
+
+func TestCashSessionService_Current(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("operador inválido", func(t *testing.T) {
+		svc, _ := newSessionService()
+
+		_, err := svc.Current(ctx, 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("operador sem caixa aberto", func(t *testing.T) {
+		svc, m := newSessionService()
+		m.repo.On("GetOpenByUser", ctx, int64(5)).Return(nil, errMsg.ErrCashSessionNotOpen).Once()
+
+		_, err := svc.Current(ctx, 5)
+
+		assert.ErrorIs(t, err, errMsg.ErrCashSessionNotOpen)
+	})
+}
+
+func TestCashSessionService_Movements(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("caixa inexistente", func(t *testing.T) {
+		svc, m := newSessionService()
+		m.repo.On("GetByID", ctx, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+
+		_, err := svc.Movements(ctx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+		m.repo.AssertNotCalled(t, "Movements", ctx, int64(1))
+	})
+
+	t.Run("lista os movimentos", func(t *testing.T) {
+		svc, m := newSessionService()
+		m.repo.On("GetByID", ctx, int64(1)).Return(openSession(), nil).Once()
+		m.repo.On("Movements", ctx, int64(1)).Return([]*models.CashMovement{{ID: 3}}, nil).Once()
+
+		movements, err := svc.Movements(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, movements, 1)
+	})
+}
+
+func TestCashSessionService_Summary(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("parcial do caixa aberto", func(t *testing.T) {
+		svc, m := newSessionService()
+		m.repo.On("GetByID", ctx, int64(1)).Return(openSession(), nil).Once()
+		m.repo.On("Tenders", ctx, int64(1)).Return(cashTenders(), nil).Once()
+		m.repo.On("MovementTotals", ctx, int64(1)).Return(&models.MovementTotals{Withdrawals: money.New(50)}, nil).Once()
+
+		summary, err := svc.Summary(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, money.MustParse("107.30"), summary.Expected)
+		assert.Nil(t, summary.Difference)
+	})
+
+	t.Run("caixa fechado traz a conferência", func(t *testing.T) {
+		svc, m := newSessionService()
+		counted, difference := money.New(110), money.MustParse("2.70")
+		closed := openSession()
+		closed.Status = models.StatusClosed
+		closed.CountedAmount = &counted
+		closed.Difference = &difference
+		m.repo.On("GetByID", ctx, int64(1)).Return(closed, nil).Once()
+		m.repo.On("Tenders", ctx, int64(1)).Return(cashTenders(), nil).Once()
+		m.repo.On("MovementTotals", ctx, int64(1)).Return(&models.MovementTotals{Withdrawals: money.New(50)}, nil).Once()
+
+		summary, err := svc.Summary(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.ResultOver, summary.Result())
+	})
+
+	t.Run("erro nos movimentos", func(t *testing.T) {
+		svc, m := newSessionService()
+		m.repo.On("GetByID", ctx, int64(1)).Return(openSession(), nil).Once()
+		m.repo.On("Tenders", ctx, int64(1)).Return(cashTenders(), nil).Once()
+		m.repo.On("MovementTotals", ctx, int64(1)).Return(nil, errMsg.ErrGet).Once()
+
+		_, err := svc.Summary(ctx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// Close confere o dinheiro contado com o esperado e encerra o caixa. O caixa
+// fica bloqueado durante a conferência, então nenhuma venda ou pagamento
+// entra entre o cálculo do esperado e o fechamento.
+func (s *cashSessionService) Close(ctx context.Context, id int64, closing *models.Closing) (*models.Summary, error) {
+	if id <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	if closing == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if err := closing.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	var summary *models.Summary
+
+	err := repoDb.RunInTx(ctx, s.repoTx, func(tx pgx.Tx) error {
+		session, err := s.lockOpen(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		summary, err = s.summaryTx(ctx, tx, session)
+		if err != nil {
+			return err
+		}
+		summary.Reconcile(closing.CountedAmount)
+
+		session.ExpectedAmount = &summary.Expected
+		session.CountedAmount = summary.Counted
+		session.Difference = summary.Difference
+		session.ClosingNotes = closing.Notes
+		session.ClosedBy = closing.ClosedBy
+
+		return s.repoTx.CloseTx(ctx, tx, session)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+func (s *cashSessionService) lockOpen(ctx context.Context, tx pgx.Tx, id int64) (*models.CashSession, error) {
+	session, err := s.repoTx.GetByIDForUpdateTx(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.IsOpen() {
+		return nil, errMsg.ErrCashSessionClosed
+	}
+
+	return session, nil
+}
+
+func (s *cashSessionService) summaryTx(ctx context.Context, tx pgx.Tx, session *models.CashSession) (*models.Summary, error) {
+	tenders, err := s.repoTx.TendersTx(ctx, tx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	movements, err := s.repoTx.MovementTotalsTx(ctx, tx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewSummary(session, tenders, *movements), nil
+}
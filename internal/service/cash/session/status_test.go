@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCashSessionService_Close(t *testing.T) {
+	ctx := context.Background()
+	user := int64(5)
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newSessionService()
+
+		_, err := svc.Close(ctx, 0, &models.Closing{})
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("valor contado negativo", func(t *testing.T) {
+		svc, _ := newSessionService()
+
+		_, err := svc.Close(ctx, 1, &models.Closing{CountedAmount: money.New(-1)})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("confere e encerra com falta", func(t *testing.T) {
+		svc, m := newSessionService()
+		m.repoTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openSession(), nil).Once()
+		m.repoTx.On("TendersTx", ctx, m.tx, int64(1)).Return(cashTenders(), nil).Once()
+		m.repoTx.On("MovementTotalsTx", ctx, m.tx, int64(1)).
+			Return(&models.MovementTotals{Deposits: money.New(20), Withdrawals: money.New(50)}, nil).Once()
+		m.repoTx.On("CloseTx", ctx, m.tx, mock.MatchedBy(func(s *models.CashSession) bool {
+			return *s.ExpectedAmount == money.MustParse("127.30") &&
+				*s.CountedAmount == money.New(127) &&
+				*s.Difference == money.MustParse("-0.30") &&
+				s.ClosingNotes == "faltou troco" &&
+				*s.ClosedBy == user
+		})).Run(func(args mock.Arguments) {
+			args.Get(2).(*models.CashSession).Status = models.StatusClosed
+		}).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		summary, err := svc.Close(ctx, 1, &models.Closing{CountedAmount: money.New(127), Notes: "faltou troco", ClosedBy: &user})
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.ResultShort, summary.Result())
+		assert.Equal(t, money.MustParse("57.30"), summary.CashSales)
+		assert.Len(t, summary.Tenders, 2)
+		assert.Equal(t, models.StatusClosed, summary.Session.Status)
+		m.repoTx.AssertExpectations(t)
+	})
+
+	t.Run("caixa já fechado", func(t *testing.T) {
+		svc, m := newSessionService()
+		m.repoTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.CashSession{ID: 1, Status: models.StatusClosed}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Close(ctx, 1, &models.Closing{})
+
+		assert.ErrorIs(t, err, errMsg.ErrCashSessionClosed)
+		m.repoTx.AssertNotCalled(t, "CloseTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha ao somar pagamentos", func(t *testing.T) {
+		svc, m := newSessionService()
+		m.repoTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openSession(), nil).Once()
+		m.repoTx.On("TendersTx", ctx, m.tx, int64(1)).Return(nil, errMsg.ErrGet).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Close(ctx, 1, &models.Closing{})
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// Open abre o caixa do operador com o fundo de troco informado.
+func (s *cashSessionService) Open(ctx context.Context, session *models.CashSession) (*models.CashSession, error) {
+	if session == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if err := session.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	session.Status = models.StatusOpen
+
+	var created *models.CashSession
+
+	err := repoDb.RunInTx(ctx, s.repoTx, func(tx pgx.Tx) error {
+		var err error
+		created, err = s.repoTx.CreateTx(ctx, tx, session)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// AddMovement lança uma sangria ou um suprimento no caixa aberto. A sangria
+// não pode passar do dinheiro que o caixa deveria ter na gaveta.
+func (s *cashSessionService) AddMovement(ctx context.Context, movement *models.CashMovement) (*models.CashMovement, error) {
+	if movement == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if movement.CashSessionID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	if err := movement.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	var created *models.CashMovement
+
+	err := repoDb.RunInTx(ctx, s.repoTx, func(tx pgx.Tx) error {
+		session, err := s.lockOpen(ctx, tx, movement.CashSessionID)
+		if err != nil {
+			return err
+		}
+
+		if movement.Type == models.MovementWithdrawal {
+			summary, err := s.summaryTx(ctx, tx, session)
+			if err != nil {
+				return err
+			}
+			if movement.Amount.GreaterThan(summary.Expected) {
+				return fmt.Errorf("%w: sangria de %s com %s em dinheiro", errMsg.ErrCashInsufficient, movement.Amount, summary.Expected)
+			}
+		}
+
+		created, err = s.repoTx.CreateMovementTx(ctx, tx, movement)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockCash "github.com/WagaoCarvalho/backend_store_go/infra/mock/cash"
+	mockTX "github.com/WagaoCarvalho/backend_store_go/infra/mock/repo"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type sessionMocks struct {
+	repo   *mockCash.MockCashSessionRepo
+	repoTx *mockCash.MockCashSessionTx
+	tx     *mockTX.MockTx
+}
+
+func newSessionService() (CashSessionService, sessionMocks) {
+	m := sessionMocks{
+		repo:   new(mockCash.MockCashSessionRepo),
+		repoTx: new(mockCash.MockCashSessionTx),
+		tx:     new(mockTX.MockTx),
+	}
+	return NewCashSessionService(m.repo, m.repoTx), m
+}
+
+func openSession() *models.CashSession {
+	return &models.CashSession{ID: 1, UserID: 5, Status: models.StatusOpen, OpeningAmount: money.New(100)}
+}
+
+// cashTenders: 57,30 em dinheiro (líquido de troco) e 80,00 em cartão.
+func cashTenders() []*modelsPayment.MethodTotal {
+	return []*modelsPayment.MethodTotal{
+		{Method: modelsPayment.MethodCard, Sales: 2, Amount: money.New(80)},
+		{Method: modelsPayment.MethodCash, Sales: 3, Amount: money.MustParse("57.30"), ChangeGiven: money.MustParse("2.70")},
+	}
+}
+
+func TestCashSessionService_Open(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("caixa nil", func(t *testing.T) {
+		svc, _ := newSessionService()
+
+		_, err := svc.Open(ctx, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("fundo de troco negativo", func(t *testing.T) {
+		svc, _ := newSessionService()
+
+		_, err := svc.Open(ctx, &models.CashSession{UserID: 5, OpeningAmount: money.New(-1)})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("abre como open", func(t *testing.T) {
+		svc, m := newSessionService()
+		m.repoTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoTx.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(s *models.CashSession) bool {
+			return s.Status == models.StatusOpen && s.UserID == 5
+		})).Return(openSession(), nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		created, err := svc.Open(ctx, &models.CashSession{UserID: 5, OpeningAmount: money.New(100)})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), created.ID)
+		m.repoTx.AssertExpectations(t)
+	})
+
+	t.Run("operador já tem caixa aberto", func(t *testing.T) {
+		svc, m := newSessionService()
+		m.repoTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoTx.On("CreateTx", ctx, m.tx, mock.Anything).Return(nil, errMsg.ErrCashSessionAlreadyOpen).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Open(ctx, &models.CashSession{UserID: 5})
+
+		assert.ErrorIs(t, err, errMsg.ErrCashSessionAlreadyOpen)
+	})
+
+	t.Run("falha ao iniciar transação", func(t *testing.T) {
+		svc, m := newSessionService()
+		m.repoTx.On("BeginTx", ctx).Return(nil, errors.New("db down")).Once()
+
+		_, err := svc.Open(ctx, &models.CashSession{UserID: 5})
+
+		assert.ErrorContains(t, err, "erro ao iniciar transação")
+	})
+}
+
+func TestCashSessionService_AddMovement(t *testing.T) {
+	ctx := context.Background()
+	user := int64(5)
+
+	t.Run("caixa inválido", func(t *testing.T) {
+		svc, _ := newSessionService()
+
+		_, err := svc.AddMovement(ctx, &models.CashMovement{Type: models.MovementDeposit, Amount: money.New(10)})
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("movimento inválido", func(t *testing.T) {
+		svc, _ := newSessionService()
+
+		_, err := svc.AddMovement(ctx, &models.CashMovement{CashSessionID: 1, Type: "transfer", Amount: money.New(10)})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("suprimento não confere saldo", func(t *testing.T) {
+		svc, m := newSessionService()
+		movement := &models.CashMovement{CashSessionID: 1, Type: models.MovementDeposit, Amount: money.New(50), UserID: &user}
+		m.repoTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openSession(), nil).Once()
+		m.repoTx.On("CreateMovementTx", ctx, m.tx, movement).Return(movement, nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		_, err := svc.AddMovement(ctx, movement)
+
+		assert.NoError(t, err)
+		m.repoTx.AssertNotCalled(t, "TendersTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("sangria dentro do dinheiro em caixa", func(t *testing.T) {
+		svc, m := newSessionService()
+		movement := &models.CashMovement{CashSessionID: 1, Type: models.MovementWithdrawal, Amount: money.MustParse("127.30")}
+		m.repoTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openSession(), nil).Once()
+		m.repoTx.On("TendersTx", ctx, m.tx, int64(1)).Return(cashTenders(), nil).Once()
+		m.repoTx.On("MovementTotalsTx", ctx, m.tx, int64(1)).
+			Return(&models.MovementTotals{Deposits: money.New(20), Withdrawals: money.New(50)}, nil).Once()
+		m.repoTx.On("CreateMovementTx", ctx, m.tx, movement).Return(movement, nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		_, err := svc.AddMovement(ctx, movement)
+
+		assert.NoError(t, err)
+		m.repoTx.AssertExpectations(t)
+	})
+
+	t.Run("sangria maior que o dinheiro em caixa", func(t *testing.T) {
+		svc, m := newSessionService()
+		movement := &models.CashMovement{CashSessionID: 1, Type: models.MovementWithdrawal, Amount: money.MustParse("127.31")}
+		m.repoTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openSession(), nil).Once()
+		m.repoTx.On("TendersTx", ctx, m.tx, int64(1)).Return(cashTenders(), nil).Once()
+		m.repoTx.On("MovementTotalsTx", ctx, m.tx, int64(1)).
+			Return(&models.MovementTotals{Deposits: money.New(20), Withdrawals: money.New(50)}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.AddMovement(ctx, movement)
+
+		assert.ErrorIs(t, err, errMsg.ErrCashInsufficient)
+		m.repoTx.AssertNotCalled(t, "CreateMovementTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("caixa fechado", func(t *testing.T) {
+		svc, m := newSessionService()
+		m.repoTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.CashSession{ID: 1, Status: models.StatusClosed}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.AddMovement(ctx, &models.CashMovement{CashSessionID: 1, Type: models.MovementDeposit, Amount: money.New(5)})
+
+		assert.ErrorIs(t, err, errMsg.ErrCashSessionClosed)
+	})
+}
@@ -0,0 +1,13 @@
+package services
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/client_cnpj/client"
+
+type clientCnpjService struct {
+	repo repo.ClientCnpj
+}
+
+func NewClientCnpjService(repo repo.ClientCnpj) Client {
+	return &clientCnpjService{
+		repo: repo,
+	}
+}
@@ -0,0 +1,10 @@
+package services
+
+import iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cnpj"
+
+type Client interface {
+	iface.ClientCnpjReader
+	iface.ClientCnpjWriter
+	iface.ClientCnpjStatus
+	iface.ClientCnpjVersion
+}
@@ -0,0 +1,21 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *clientCnpjService) GetByID(ctx context.Context, id int64) (*models.ClientCnpj, error) {
+	if id <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	client, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+	return client, nil
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cnpj"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClientCnpjService_GetByID(t *testing.T) {
+	t.Run("falha com ID inválido", func(t *testing.T) {
+		service := NewClientCnpjService(new(mockClient.MockClientCnpj))
+
+		result, err := service.GetByID(context.Background(), 0)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("erro do repositório", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+		mockRepo.On("GetByID", mock.Anything, int64(1)).Return(nil, errors.New("db down")).Once()
+
+		result, err := service.GetByID(context.Background(), 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+		mockRepo.On("GetByID", mock.Anything, int64(1)).Return(validClient(), nil).Once()
+
+		result, err := service.GetByID(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Empresa Teste", result.TradeName)
+	})
+}
+
+func TestClientCnpjService_GetVersionByID(t *testing.T) {
+	t.Run("falha com ID inválido", func(t *testing.T) {
+		service := NewClientCnpjService(new(mockClient.MockClientCnpj))
+
+		_, err := service.GetVersionByID(context.Background(), 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("erro do repositório", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+		mockRepo.On("GetVersionByID", mock.Anything, int64(1)).Return(0, errMsg.ErrNotFound).Once()
+
+		_, err := service.GetVersionByID(context.Background(), 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+		mockRepo.On("GetVersionByID", mock.Anything, int64(1)).Return(3, nil).Once()
+
+		version, err := service.GetVersionByID(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, version)
+	})
+}
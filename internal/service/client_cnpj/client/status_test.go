@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cnpj"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClientCnpjService_Status(t *testing.T) {
+	t.Run("disable com ID inválido", func(t *testing.T) {
+		service := NewClientCnpjService(new(mockClient.MockClientCnpj))
+
+		assert.ErrorIs(t, service.Disable(context.Background(), 0), errMsg.ErrZeroID)
+	})
+
+	t.Run("enable com ID inválido", func(t *testing.T) {
+		service := NewClientCnpjService(new(mockClient.MockClientCnpj))
+
+		assert.ErrorIs(t, service.Enable(context.Background(), 0), errMsg.ErrZeroID)
+	})
+
+	t.Run("disable erro do repositório", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+		mockRepo.On("Disable", mock.Anything, int64(1)).Return(errMsg.ErrNotFound).Once()
+
+		assert.ErrorIs(t, service.Disable(context.Background(), 1), errMsg.ErrUpdate)
+	})
+
+	t.Run("enable sucesso", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+		mockRepo.On("Enable", mock.Anything, int64(1)).Return(nil).Once()
+
+		assert.NoError(t, service.Enable(context.Background(), 1))
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cnpj"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func validClient() *models.ClientCnpj {
+	return &models.ClientCnpj{
+		ID:        1,
+		Name:      "Empresa Teste LTDA",
+		TradeName: "Empresa Teste",
+		Email:     "contato@empresa.com",
+		CNPJ:      "11.222.333/0001-81",
+		Version:   1,
+		Status:    true,
+	}
+}
+
+func TestClientCnpjService_Create(t *testing.T) {
+	t.Run("falha quando client é nil", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+
+		result, err := service.Create(context.Background(), nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("falha quando CNPJ é inválido", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+
+		client := validClient()
+		client.CNPJ = "11.111.111/1111-11"
+
+		result, err := service.Create(context.Background(), client)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("erro do repositório é propagado", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+
+		client := validClient()
+		mockRepo.On("Create", mock.Anything, client).Return(nil, errMsg.ErrDuplicate).Once()
+
+		result, err := service.Create(context.Background(), client)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrDuplicate)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("sucesso normaliza CNPJ", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+
+		client := validClient()
+		mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(c *models.ClientCnpj) bool {
+			return c.CNPJ == "11222333000181"
+		})).Return(client, nil).Once()
+
+		result, err := service.Create(context.Background(), client)
+
+		assert.NoError(t, err)
+		assert.Equal(t, client, result)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestClientCnpjService_Update(t *testing.T) {
+	t.Run("falha com ID inválido", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+
+		client := validClient()
+		client.ID = 0
+
+		assert.ErrorIs(t, service.Update(context.Background(), client), errMsg.ErrZeroID)
+	})
+
+	t.Run("falha com versão inválida", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+
+		client := validClient()
+		client.Version = 0
+
+		assert.ErrorIs(t, service.Update(context.Background(), client), errMsg.ErrVersionConflict)
+	})
+
+	t.Run("falha na validação", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+
+		client := validClient()
+		client.Name = ""
+
+		assert.ErrorIs(t, service.Update(context.Background(), client), errMsg.ErrInvalidData)
+		mockRepo.AssertNotCalled(t, "Update")
+	})
+
+	t.Run("conflito de versão é propagado", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+
+		client := validClient()
+		mockRepo.On("Update", mock.Anything, client).Return(errMsg.ErrVersionConflict).Once()
+
+		assert.ErrorIs(t, service.Update(context.Background(), client), errMsg.ErrVersionConflict)
+	})
+
+	t.Run("erro genérico vira ErrUpdate", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+
+		client := validClient()
+		mockRepo.On("Update", mock.Anything, client).Return(errors.New("db down")).Once()
+
+		assert.ErrorIs(t, service.Update(context.Background(), client), errMsg.ErrUpdate)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+
+		client := validClient()
+		mockRepo.On("Update", mock.Anything, client).Return(nil).Once()
+
+		assert.NoError(t, service.Update(context.Background(), client))
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestClientCnpjService_Delete(t *testing.T) {
+	t.Run("falha com ID inválido", func(t *testing.T) {
+		service := NewClientCnpjService(new(mockClient.MockClientCnpj))
+
+		assert.ErrorIs(t, service.Delete(context.Background(), 0), errMsg.ErrZeroID)
+	})
+
+	t.Run("erro do repositório", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+		mockRepo.On("Delete", mock.Anything, int64(1)).Return(errMsg.ErrNotFound).Once()
+
+		assert.ErrorIs(t, service.Delete(context.Background(), 1), errMsg.ErrDelete)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjService(mockRepo)
+		mockRepo.On("Delete", mock.Anything, int64(1)).Return(nil).Once()
+
+		assert.NoError(t, service.Delete(context.Background(), 1))
+	})
+}
@@ -0,0 +1,13 @@
+package services
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/client_cnpj/contact_relation"
+
+type clientCnpjContactRelationService struct {
+	relationRepo repo.ClientCnpjContactRelation
+}
+
+func NewClientCnpjContactRelationService(repo repo.ClientCnpjContactRelation) ClientCnpjContactRelation {
+	return &clientCnpjContactRelationService{
+		relationRepo: repo,
+	}
+}
@@ -0,0 +1,8 @@
+package services
+
+import iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cnpj"
+
+type ClientCnpjContactRelation interface {
+	iface.ClientCnpjContactRelationReader
+	iface.ClientCnpjContactRelationWriter
+}
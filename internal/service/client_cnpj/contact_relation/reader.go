@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/contact_relation"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *clientCnpjContactRelationService) GetAllRelationsByClientCnpjID(ctx context.Context, clientCnpjID int64) ([]*models.ClientCnpjContactRelation, error) {
+	if clientCnpjID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	relations, err := s.relationRepo.GetAllRelationsByClientCnpjID(ctx, clientCnpjID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return relations, nil
+}
+
+func (s *clientCnpjContactRelationService) HasClientCnpjContactRelation(ctx context.Context, clientCnpjID, contactID int64) (bool, error) {
+	if clientCnpjID <= 0 || contactID <= 0 {
+		return false, errMsg.ErrZeroID
+	}
+
+	exists, err := s.relationRepo.HasClientCnpjContactRelation(ctx, clientCnpjID, contactID)
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return exists, nil
+}
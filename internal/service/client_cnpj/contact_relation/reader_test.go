@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cnpj"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/contact_relation"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientCnpjContactRelationService_Reader(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("GetAll com ID inválido", func(t *testing.T) {
+		service := NewClientCnpjContactRelationService(new(mockClient.MockClientCnpjContactRelation))
+
+		_, err := service.GetAllRelationsByClientCnpjID(ctx, 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("GetAll erro do repositório", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpjContactRelation)
+		service := NewClientCnpjContactRelationService(mockRepo)
+		mockRepo.On("GetAllRelationsByClientCnpjID", ctx, int64(1)).Return(nil, errors.New("db down")).Once()
+
+		_, err := service.GetAllRelationsByClientCnpjID(ctx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("GetAll sucesso", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpjContactRelation)
+		service := NewClientCnpjContactRelationService(mockRepo)
+		relations := []*models.ClientCnpjContactRelation{{ClientCnpjID: 1, ContactID: 2}}
+		mockRepo.On("GetAllRelationsByClientCnpjID", ctx, int64(1)).Return(relations, nil).Once()
+
+		result, err := service.GetAllRelationsByClientCnpjID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, relations, result)
+	})
+
+	t.Run("Has com ID inválido", func(t *testing.T) {
+		service := NewClientCnpjContactRelationService(new(mockClient.MockClientCnpjContactRelation))
+
+		_, err := service.HasClientCnpjContactRelation(ctx, 1, 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("Has erro do repositório", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpjContactRelation)
+		service := NewClientCnpjContactRelationService(mockRepo)
+		mockRepo.On("HasClientCnpjContactRelation", ctx, int64(1), int64(2)).Return(false, errors.New("db down")).Once()
+
+		_, err := service.HasClientCnpjContactRelation(ctx, 1, 2)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("Has sucesso", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpjContactRelation)
+		service := NewClientCnpjContactRelationService(mockRepo)
+		mockRepo.On("HasClientCnpjContactRelation", ctx, int64(1), int64(2)).Return(true, nil).Once()
+
+		exists, err := service.HasClientCnpjContactRelation(ctx, 1, 2)
+
+		assert.NoError(t, err)
+		assert.True(t, exists)
+	})
+}
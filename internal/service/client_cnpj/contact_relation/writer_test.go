@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cnpj"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/contact_relation"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientCnpjContactRelationService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Erro: relação nula", func(t *testing.T) {
+		service := NewClientCnpjContactRelationService(new(mockClient.MockClientCnpjContactRelation))
+
+		result, err := service.Create(ctx, nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrNilModel)
+	})
+
+	t.Run("Erro: ID inválido", func(t *testing.T) {
+		service := NewClientCnpjContactRelationService(new(mockClient.MockClientCnpjContactRelation))
+
+		result, err := service.Create(ctx, &models.ClientCnpjContactRelation{ClientCnpjID: 0, ContactID: 2})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("Relação existente é retornada", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpjContactRelation)
+		service := NewClientCnpjContactRelationService(mockRepo)
+		relation := &models.ClientCnpjContactRelation{ClientCnpjID: 1, ContactID: 2}
+		existing := &models.ClientCnpjContactRelation{ClientCnpjID: 1, ContactID: 2}
+
+		mockRepo.On("Create", ctx, relation).Return(nil, errMsg.ErrRelationExists).Once()
+		mockRepo.On("GetAllRelationsByClientCnpjID", ctx, int64(1)).
+			Return([]*models.ClientCnpjContactRelation{existing}, nil).Once()
+
+		result, err := service.Create(ctx, relation)
+
+		assert.NoError(t, err)
+		assert.Same(t, existing, result)
+	})
+
+	t.Run("Erro: falha ao verificar relação existente", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpjContactRelation)
+		service := NewClientCnpjContactRelationService(mockRepo)
+		relation := &models.ClientCnpjContactRelation{ClientCnpjID: 1, ContactID: 2}
+
+		mockRepo.On("Create", ctx, relation).Return(nil, errMsg.ErrRelationExists).Once()
+		mockRepo.On("GetAllRelationsByClientCnpjID", ctx, int64(1)).Return(nil, errors.New("db down")).Once()
+
+		_, err := service.Create(ctx, relation)
+
+		assert.ErrorIs(t, err, errMsg.ErrRelationCheck)
+	})
+
+	t.Run("Erro: chave estrangeira inválida", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpjContactRelation)
+		service := NewClientCnpjContactRelationService(mockRepo)
+		relation := &models.ClientCnpjContactRelation{ClientCnpjID: 1, ContactID: 2}
+
+		mockRepo.On("Create", ctx, relation).Return(nil, errMsg.ErrDBInvalidForeignKey).Once()
+
+		_, err := service.Create(ctx, relation)
+
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+
+	t.Run("Erro genérico", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpjContactRelation)
+		service := NewClientCnpjContactRelationService(mockRepo)
+		relation := &models.ClientCnpjContactRelation{ClientCnpjID: 1, ContactID: 2}
+
+		mockRepo.On("Create", ctx, relation).Return(nil, errors.New("db down")).Once()
+
+		_, err := service.Create(ctx, relation)
+
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+
+	t.Run("Sucesso", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpjContactRelation)
+		service := NewClientCnpjContactRelationService(mockRepo)
+		relation := &models.ClientCnpjContactRelation{ClientCnpjID: 1, ContactID: 2}
+
+		mockRepo.On("Create", ctx, relation).Return(relation, nil).Once()
+
+		result, err := service.Create(ctx, relation)
+
+		assert.NoError(t, err)
+		assert.Equal(t, relation, result)
+	})
+}
+
+func TestClientCnpjContactRelationService_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Erro: ID inválido", func(t *testing.T) {
+		service := NewClientCnpjContactRelationService(new(mockClient.MockClientCnpjContactRelation))
+
+		assert.ErrorIs(t, service.Delete(ctx, 1, 0), errMsg.ErrZeroID)
+		assert.ErrorIs(t, service.DeleteAll(ctx, 0), errMsg.ErrZeroID)
+	})
+
+	t.Run("Erro do repositório", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpjContactRelation)
+		service := NewClientCnpjContactRelationService(mockRepo)
+		mockRepo.On("Delete", ctx, int64(1), int64(2)).Return(errMsg.ErrNotFound).Once()
+		mockRepo.On("DeleteAll", ctx, int64(1)).Return(errors.New("db down")).Once()
+
+		assert.ErrorIs(t, service.Delete(ctx, 1, 2), errMsg.ErrDelete)
+		assert.ErrorIs(t, service.DeleteAll(ctx, 1), errMsg.ErrDelete)
+	})
+
+	t.Run("Sucesso", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpjContactRelation)
+		service := NewClientCnpjContactRelationService(mockRepo)
+		mockRepo.On("Delete", ctx, int64(1), int64(2)).Return(nil).Once()
+		mockRepo.On("DeleteAll", ctx, int64(1)).Return(nil).Once()
+
+		assert.NoError(t, service.Delete(ctx, 1, 2))
+		assert.NoError(t, service.DeleteAll(ctx, 1))
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,13 @@
+package services
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/client_cnpj/filter"
+
+type clientCnpjFilterService struct {
+	repo repo.ClientCnpjFilter
+}
+
+func NewClientCnpjFilterService(repo repo.ClientCnpjFilter) ClientCnpjFilter {
+	return &clientCnpjFilterService{
+		repo: repo,
+	}
+}
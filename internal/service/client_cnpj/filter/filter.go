@@ -0,0 +1,28 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *clientCnpjFilterService) Filter(ctx context.Context, filter *filter.ClientCnpjFilter) (*commonFilter.Page[*model.ClientCnpj], error) {
+	if filter == nil {
+		return nil, fmt.Errorf("%w: filtro não pode ser nulo", errMsg.ErrInvalidFilter)
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidFilter, err)
+	}
+
+	clients, err := s.repo.Filter(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return clients, nil
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cnpj"
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	clientFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/filter"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClientCnpjService_Filter(t *testing.T) {
+	t.Run("falha quando filtro é nulo", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjFilterService(mockRepo)
+
+		result, err := service.Filter(context.Background(), nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "Filter", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha na validação do filtro", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjFilterService(mockRepo)
+
+		result, err := service.Filter(context.Background(), &clientFilter.ClientCnpjFilter{
+			BaseFilter: filter.BaseFilter{Limit: -10},
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "Filter", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha ao buscar no repositório", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjFilterService(mockRepo)
+
+		validFilter := &clientFilter.ClientCnpjFilter{BaseFilter: filter.BaseFilter{Limit: 10}}
+		mockRepo.On("Filter", mock.Anything, validFilter).Return(nil, errors.New("falha no banco de dados")).Once()
+
+		result, err := service.Filter(context.Background(), validFilter)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("sucesso ao retornar lista de clientes", func(t *testing.T) {
+		mockRepo := new(mockClient.MockClientCnpj)
+		service := NewClientCnpjFilterService(mockRepo)
+
+		validFilter := &clientFilter.ClientCnpjFilter{BaseFilter: filter.BaseFilter{Limit: 10}}
+		mockRepo.On("Filter", mock.Anything, validFilter).Return([]*model.ClientCnpj{
+			{ID: 1, Name: "Empresa A LTDA"},
+			{ID: 2, Name: "Empresa B SA"},
+		}, nil).Once()
+
+		result, err := service.Filter(context.Background(), validFilter)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Items, 2)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,21 @@
+package services
+
+import (
+	ifaceClient "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cpf"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/common/credit"
+)
+
+type clientCreditService struct {
+	repo   repo.ClientCredit
+	repoTx ifaceClient.ClientCreditTx
+}
+
+func NewClientCreditService(
+	repo repo.ClientCredit,
+	repoTx ifaceClient.ClientCreditTx,
+) ClientCreditService {
+	return &clientCreditService{
+		repo:   repo,
+		repoTx: repoTx,
+	}
+}
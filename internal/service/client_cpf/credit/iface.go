@@ -0,0 +1,16 @@
+package services
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+)
+
+type ClientCreditService interface {
+	GetByClientID(ctx context.Context, clientID int64) (*models.ClientCredit, error)
+	GetStatement(ctx context.Context, clientID int64, limit, offset int) (*models.CreditStatement, error)
+
+	OpenOrAdjust(ctx context.Context, credit *models.ClientCredit) (*models.ClientCredit, error)
+	Charge(ctx context.Context, entry *models.CreditEntry) (*models.CreditEntry, error)
+	Payment(ctx context.Context, entry *models.CreditEntry) (*models.CreditEntry, error)
+}
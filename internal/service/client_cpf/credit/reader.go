@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	validate "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+)
+
+func (s *clientCreditService) GetByClientID(ctx context.Context, clientID int64) (*models.ClientCredit, error) {
+	if clientID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	return s.repo.GetByClientID(ctx, clientID)
+}
+
+// GetStatement retorna a conta de crédito do cliente e uma página dos seus
+// lançamentos, em ordem cronológica. Cada lançamento carrega o saldo
+// resultante (balance_after), o que dá o saldo corrente do extrato.
+func (s *clientCreditService) GetStatement(ctx context.Context, clientID int64, limit, offset int) (*models.CreditStatement, error) {
+	if clientID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	if err := validate.ValidatePagination(limit, offset); err != nil {
+		return nil, err
+	}
+
+	credit, err := s.repo.GetByClientID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.repo.GetEntriesByClientID(ctx, clientID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.CreditStatement{
+		Credit:  credit,
+		Entries: entries,
+	}, nil
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientCreditService_GetByClientID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newCreditService()
+
+		result, err := svc.GetByClientID(ctx, 0)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("conta inexistente", func(t *testing.T) {
+		svc, m := newCreditService()
+		m.repo.On("GetByClientID", ctx, int64(7)).Return(nil, errMsg.ErrNotFound).Once()
+
+		result, err := svc.GetByClientID(ctx, 7)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newCreditService()
+		credit := &models.ClientCredit{ID: 1, ClientID: 7, AllowCredit: true, CreditLimit: money.New(100)}
+		m.repo.On("GetByClientID", ctx, int64(7)).Return(credit, nil).Once()
+
+		result, err := svc.GetByClientID(ctx, 7)
+
+		assert.NoError(t, err)
+		assert.Equal(t, credit, result)
+		m.repo.AssertExpectations(t)
+	})
+}
+
+func TestClientCreditService_GetStatement(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newCreditService()
+
+		_, err := svc.GetStatement(ctx, 0, 10, 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("paginação inválida", func(t *testing.T) {
+		svc, _ := newCreditService()
+
+		_, err := svc.GetStatement(ctx, 7, 0, 0)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidLimit)
+
+		_, err = svc.GetStatement(ctx, 7, 10, -1)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidOffset)
+	})
+
+	t.Run("conta inexistente", func(t *testing.T) {
+		svc, m := newCreditService()
+		m.repo.On("GetByClientID", ctx, int64(7)).Return(nil, errMsg.ErrNotFound).Once()
+
+		result, err := svc.GetStatement(ctx, 7, 10, 0)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+		m.repo.AssertNotCalled(t, "GetEntriesByClientID", ctx, int64(7), 10, 0)
+	})
+
+	t.Run("erro ao buscar lançamentos", func(t *testing.T) {
+		svc, m := newCreditService()
+		m.repo.On("GetByClientID", ctx, int64(7)).Return(&models.ClientCredit{ClientID: 7}, nil).Once()
+		m.repo.On("GetEntriesByClientID", ctx, int64(7), 10, 0).Return(nil, errors.New("db error")).Once()
+
+		result, err := svc.GetStatement(ctx, 7, 10, 0)
+
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "db error")
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newCreditService()
+		credit := &models.ClientCredit{ClientID: 7, CreditBalance: money.New(30)}
+		entries := []*models.CreditEntry{
+			{ID: 1, ClientID: 7, EntryType: models.EntryCharge, Amount: money.New(50), BalanceAfter: money.New(50)},
+			{ID: 2, ClientID: 7, EntryType: models.EntryPayment, Amount: money.New(20), BalanceAfter: money.New(30)},
+		}
+		m.repo.On("GetByClientID", ctx, int64(7)).Return(credit, nil).Once()
+		m.repo.On("GetEntriesByClientID", ctx, int64(7), 10, 0).Return(entries, nil).Once()
+
+		result, err := svc.GetStatement(ctx, 7, 10, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, credit, result.Credit)
+		assert.Equal(t, entries, result.Entries)
+		m.repo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// OpenOrAdjust abre a linha de crédito do cliente ou ajusta a existente. O
+// saldo devedor nunca é alterado aqui, apenas pelos lançamentos do razão.
+func (s *clientCreditService) OpenOrAdjust(ctx context.Context, credit *models.ClientCredit) (*models.ClientCredit, error) {
+	if credit == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if err := credit.ValidateCreditLine(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	return s.repo.Upsert(ctx, credit)
+}
+
+func (s *clientCreditService) Charge(ctx context.Context, entry *models.CreditEntry) (*models.CreditEntry, error) {
+	return s.post(ctx, entry, s.repoTx.ChargeTx)
+}
+
+func (s *clientCreditService) Payment(ctx context.Context, entry *models.CreditEntry) (*models.CreditEntry, error) {
+	return s.post(ctx, entry, s.repoTx.PaymentTx)
+}
+
+// post valida o lançamento e o grava com postTx em uma transação própria.
+func (s *clientCreditService) post(
+	ctx context.Context,
+	entry *models.CreditEntry,
+	postTx func(ctx context.Context, tx pgx.Tx, entry *models.CreditEntry) error,
+) (*models.CreditEntry, error) {
+	if entry == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if err := entry.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	err := repoDb.RunInTx(ctx, s.repoTx, func(tx pgx.Tx) error {
+		return postTx(ctx, tx, entry)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cpf"
+	mockTX "github.com/WagaoCarvalho/backend_store_go/infra/mock/repo"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type creditMocks struct {
+	repo   *mockClient.MockClientCredit
+	repoTx *mockClient.MockClientCreditTx
+	tx     *mockTX.MockTx
+}
+
+func newCreditService() (ClientCreditService, creditMocks) {
+	m := creditMocks{
+		repo:   new(mockClient.MockClientCredit),
+		repoTx: new(mockClient.MockClientCreditTx),
+		tx:     new(mockTX.MockTx),
+	}
+	return NewClientCreditService(m.repo, m.repoTx), m
+}
+
+func TestClientCreditService_OpenOrAdjust(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("crédito nil", func(t *testing.T) {
+		svc, _ := newCreditService()
+
+		result, err := svc.OpenOrAdjust(ctx, nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("limite sem crédito habilitado", func(t *testing.T) {
+		svc, m := newCreditService()
+
+		result, err := svc.OpenOrAdjust(ctx, &models.ClientCredit{ClientID: 7, CreditLimit: money.New(100)})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.repo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+	})
+
+	t.Run("erro do repositório é propagado", func(t *testing.T) {
+		svc, m := newCreditService()
+		credit := &models.ClientCredit{ClientID: 7, AllowCredit: true, CreditLimit: money.New(10)}
+		m.repo.On("Upsert", ctx, credit).Return(nil, errMsg.ErrInvalidData).Once()
+
+		result, err := svc.OpenOrAdjust(ctx, credit)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newCreditService()
+		credit := &models.ClientCredit{ClientID: 7, AllowCredit: true, CreditLimit: money.New(100)}
+		saved := &models.ClientCredit{ID: 1, ClientID: 7, AllowCredit: true, CreditLimit: money.New(100), CreditBalance: money.New(30)}
+		m.repo.On("Upsert", ctx, credit).Return(saved, nil).Once()
+
+		result, err := svc.OpenOrAdjust(ctx, credit)
+
+		assert.NoError(t, err)
+		assert.Equal(t, saved, result)
+		m.repo.AssertExpectations(t)
+	})
+}
+
+func TestClientCreditService_Charge(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("lançamento nil", func(t *testing.T) {
+		svc, _ := newCreditService()
+
+		result, err := svc.Charge(ctx, nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("valor inválido", func(t *testing.T) {
+		svc, m := newCreditService()
+
+		result, err := svc.Charge(ctx, &models.CreditEntry{ClientID: 7, Amount: money.New(0)})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.repoTx.AssertNotCalled(t, "BeginTx", ctx)
+	})
+
+	t.Run("limite excedido faz rollback", func(t *testing.T) {
+		svc, m := newCreditService()
+		entry := &models.CreditEntry{ClientID: 7, Amount: money.New(500)}
+		m.repoTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoTx.On("ChargeTx", ctx, m.tx, entry).Return(errMsg.ErrCreditLimitExceeded).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.Charge(ctx, entry)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreditLimitExceeded)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newCreditService()
+		entry := &models.CreditEntry{ClientID: 7, Amount: money.New(50)}
+		m.repoTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoTx.On("ChargeTx", ctx, m.tx, entry).Run(func(args mock.Arguments) {
+			e := args.Get(2).(*models.CreditEntry)
+			e.ID, e.EntryType, e.BalanceAfter = 1, models.EntryCharge, money.New(50)
+		}).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		result, err := svc.Charge(ctx, entry)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), result.ID)
+		assert.Equal(t, money.New(50), result.BalanceAfter)
+		m.repoTx.AssertExpectations(t)
+		m.tx.AssertExpectations(t)
+	})
+}
+
+func TestClientCreditService_Payment(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pagamento acima do saldo faz rollback", func(t *testing.T) {
+		svc, m := newCreditService()
+		entry := &models.CreditEntry{ClientID: 7, Amount: money.New(80)}
+		m.repoTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoTx.On("PaymentTx", ctx, m.tx, entry).Return(errMsg.ErrInvalidData).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.Payment(ctx, entry)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newCreditService()
+		entry := &models.CreditEntry{ClientID: 7, Amount: money.New(20)}
+		m.repoTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoTx.On("PaymentTx", ctx, m.tx, entry).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		result, err := svc.Payment(ctx, entry)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entry, result)
+		m.repoTx.AssertNotCalled(t, "ChargeTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestClientCreditService_RunInTx(t *testing.T) {
+	ctx := context.Background()
+	entry := func() *models.CreditEntry { return &models.CreditEntry{ClientID: 7, Amount: money.New(10)} }
+
+	t.Run("erro ao iniciar transação", func(t *testing.T) {
+		svc, m := newCreditService()
+		m.repoTx.On("BeginTx", ctx).Return(nil, errors.New("db down")).Once()
+
+		_, err := svc.Charge(ctx, entry())
+
+		assert.ErrorContains(t, err, "erro ao iniciar transação")
+	})
+
+	t.Run("falha no rollback é anexada ao erro", func(t *testing.T) {
+		svc, m := newCreditService()
+		m.repoTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoTx.On("ChargeTx", ctx, m.tx, mock.Anything).Return(errMsg.ErrUpdate).Once()
+		m.tx.On("Rollback", ctx).Return(errors.New("rollback failed")).Once()
+
+		_, err := svc.Charge(ctx, entry())
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+		assert.ErrorContains(t, err, "rollback failed")
+	})
+
+	t.Run("falha no commit", func(t *testing.T) {
+		svc, m := newCreditService()
+		m.repoTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoTx.On("ChargeTx", ctx, m.tx, mock.Anything).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(errors.New("commit failed")).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Charge(ctx, entry())
+
+		assert.ErrorContains(t, err, "erro ao commitar transação")
+	})
+
+	t.Run("panic faz rollback e é repropagado", func(t *testing.T) {
+		svc, m := newCreditService()
+		m.repoTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoTx.On("ChargeTx", ctx, m.tx, mock.Anything).Run(func(mock.Arguments) { panic("boom") }).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		assert.Panics(t, func() { _, _ = svc.Charge(ctx, entry()) })
+		m.tx.AssertExpectations(t)
+	})
+}
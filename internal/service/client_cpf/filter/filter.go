@@ -3,13 +3,14 @@ package services
 import (
 	"context"
 	"fmt"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/client"
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/filter"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 )
 
-func (s *clientCpfFiltertService) Filter(ctx context.Context, filter *filter.ClientCpfFilter) ([]*model.ClientCpf, error) {
+func (s *clientCpfFiltertService) Filter(ctx context.Context, filter *filter.ClientCpfFilter) (*commonFilter.Page[*model.ClientCpf], error) {
 	if filter == nil {
 		return nil, fmt.Errorf("%w: filtro não pode ser nulo", errMsg.ErrInvalidFilter)
 	}
@@ -88,9 +88,9 @@ func TestClientService_Filter(t *testing.T) {
 		result, err := service.Filter(context.Background(), validFilter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 2)
-		assert.Equal(t, "João Silva", result[0].Name)
-		assert.Equal(t, "Maria Souza", result[1].Name)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, "João Silva", result.Items[0].Name)
+		assert.Equal(t, "Maria Souza", result.Items[1].Name)
 		mockRepo.AssertExpectations(t)
 	})
 }
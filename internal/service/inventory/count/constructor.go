@@ -0,0 +1,25 @@
+package services
+
+import (
+	ifaceInventory "github.com/WagaoCarvalho/backend_store_go/internal/iface/inventory"
+	ifaceProduct "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/inventory/count"
+)
+
+type inventoryCountService struct {
+	repo        repo.InventoryCount
+	repoCountTx ifaceInventory.InventoryCountTx
+	repoStockTx ifaceProduct.ProductAdjustTx
+}
+
+func NewInventoryCountService(
+	repo repo.InventoryCount,
+	repoCountTx ifaceInventory.InventoryCountTx,
+	repoStockTx ifaceProduct.ProductAdjustTx,
+) InventoryCountService {
+	return &inventoryCountService{
+		repo:        repo,
+		repoCountTx: repoCountTx,
+		repoStockTx: repoStockTx,
+	}
+}
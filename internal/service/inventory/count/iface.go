@@ -0,0 +1,20 @@
+package services
+
+import (
+	"context"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+)
+
+type InventoryCountService interface {
+	Open(ctx context.Context, count *models.InventoryCount) (*models.InventoryCount, error)
+	GetByID(ctx context.Context, id int64) (*models.InventoryCount, error)
+	List(ctx context.Context, f *models.InventoryCountFilter) (*commonFilter.Page[*models.InventoryCount], error)
+
+	Record(ctx context.Context, id int64, entries []models.CountEntry) ([]*models.CountItem, error)
+	Variance(ctx context.Context, id int64) (*models.VarianceReport, error)
+
+	Close(ctx context.Context, id int64, closedBy *int64) (*models.InventoryCount, error)
+	Cancel(ctx context.Context, id int64, canceledBy *int64) (*models.InventoryCount, error)
+}
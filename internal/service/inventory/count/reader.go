@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *inventoryCountService) GetByID(ctx context.Context, id int64) (*models.InventoryCount, error) {
+	if id <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *inventoryCountService) List(ctx context.Context, f *models.InventoryCountFilter) (*commonFilter.Page[*models.InventoryCount], error) {
+	if f == nil {
+		return nil, errMsg.ErrInvalidFilter
+	}
+
+	if err := f.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidFilter, err)
+	}
+
+	return s.repo.List(ctx, f)
+}
+
+// Variance compara o contado com o saldo atual. Depois do fechamento o saldo
+// já reflete a contagem; os ajustes feitos ficam no histórico de estoque.
+func (s *inventoryCountService) Variance(ctx context.Context, id int64) (*models.VarianceReport, error) {
+	if id <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	lines, err := s.repo.Variance(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewVarianceReport(id, lines), nil
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInventoryCountService_GetByID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newCountService()
+
+		_, err := svc.GetByID(ctx, 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newCountService()
+		m.repo.On("GetByID", ctx, int64(1)).Return(openCount(), nil).Once()
+
+		count, err := svc.GetByID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count.ID)
+	})
+}
+
+func TestInventoryCountService_List(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("filtro nil", func(t *testing.T) {
+		svc, _ := newCountService()
+
+		_, err := svc.List(ctx, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+	})
+
+	t.Run("status inválido", func(t *testing.T) {
+		svc, _ := newCountService()
+
+		_, err := svc.List(ctx, &models.InventoryCountFilter{Status: "draft"})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newCountService()
+		f := &models.InventoryCountFilter{Status: models.StatusOpen}
+		page := &commonFilter.Page[*models.InventoryCount]{Items: []*models.InventoryCount{openCount()}, Total: 1}
+		m.repo.On("List", ctx, f).Return(page, nil).Once()
+
+		result, err := svc.List(ctx, f)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), result.Total)
+	})
+}
+
+func TestInventoryCountService_Variance(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newCountService()
+
+		_, err := svc.Variance(ctx, -1)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("contagem inexistente", func(t *testing.T) {
+		svc, m := newCountService()
+		m.repo.On("GetByID", ctx, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+
+		_, err := svc.Variance(ctx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+		m.repo.AssertNotCalled(t, "Variance", ctx, int64(1))
+	})
+
+	t.Run("monta o relatório", func(t *testing.T) {
+		svc, m := newCountService()
+		counted := 8
+		m.repo.On("GetByID", ctx, int64(1)).Return(openCount(), nil).Once()
+		m.repo.On("Variance", ctx, int64(1)).Return([]*models.VarianceLine{
+			{ProductID: 7, SystemQuantity: 10, CountedQuantity: &counted, CostPrice: money.New(2)},
+			{ProductID: 8, SystemQuantity: 3},
+		}, nil).Once()
+
+		report, err := svc.Variance(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), report.InventoryCountID)
+		assert.Equal(t, 1, report.Uncounted)
+		assert.Equal(t, -2, report.NetVariance)
+		assert.Equal(t, money.New(-4), report.NetVarianceCost)
+	})
+}
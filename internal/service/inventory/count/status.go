@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// Close encerra a contagem e leva o saldo de cada produto contado com
+// divergência para a quantidade contada, tudo na mesma transação. Produtos
+// do escopo que não foram contados ficam como estão.
+func (s *inventoryCountService) Close(ctx context.Context, id int64, closedBy *int64) (*models.InventoryCount, error) {
+	if id <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	var closed *models.InventoryCount
+
+	err := repoDb.RunInTx(ctx, s.repoCountTx, func(tx pgx.Tx) error {
+		count, err := s.lockOpen(ctx, tx, id, "somente contagens abertas podem ser fechadas")
+		if err != nil {
+			return err
+		}
+
+		lines, err := s.repoCountTx.CountedLinesForUpdateTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		origin := modelsMovement.NewOrigin(modelsMovement.ReasonCount, modelsMovement.RefInventoryCount, id)
+
+		adjusted := 0
+		for _, line := range lines {
+			if line.Variance() == 0 {
+				continue
+			}
+			if err := s.repoStockTx.SetStockTx(ctx, tx, line.ProductID, *line.CountedQuantity, origin); err != nil {
+				return err
+			}
+			adjusted++
+		}
+
+		count.Status = models.StatusClosed
+		count.ClosedBy = closedBy
+		count.AdjustedProducts = adjusted
+		if err := s.repoCountTx.UpdateStatusTx(ctx, tx, count); err != nil {
+			return err
+		}
+
+		closed = count
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return closed, nil
+}
+
+// Cancel descarta a contagem sem alterar o estoque.
+func (s *inventoryCountService) Cancel(ctx context.Context, id int64, canceledBy *int64) (*models.InventoryCount, error) {
+	if id <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	var canceled *models.InventoryCount
+
+	err := repoDb.RunInTx(ctx, s.repoCountTx, func(tx pgx.Tx) error {
+		count, err := s.lockOpen(ctx, tx, id, "somente contagens abertas podem ser canceladas")
+		if err != nil {
+			return err
+		}
+
+		count.Status = models.StatusCanceled
+		count.ClosedBy = canceledBy
+		if err := s.repoCountTx.UpdateStatusTx(ctx, tx, count); err != nil {
+			return err
+		}
+
+		canceled = count
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return canceled, nil
+}
+
+func (s *inventoryCountService) lockOpen(ctx context.Context, tx pgx.Tx, id int64, reason string) (*models.InventoryCount, error) {
+	count, err := s.repoCountTx.GetByIDForUpdateTx(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !count.IsOpen() {
+		return nil, fmt.Errorf("%w: %s", errMsg.ErrInvalidData, reason)
+	}
+
+	return count, nil
+}
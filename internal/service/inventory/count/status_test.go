@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestInventoryCountService_Close(t *testing.T) {
+	ctx := context.Background()
+	user := int64(5)
+	origin := modelsMovement.NewOrigin(modelsMovement.ReasonCount, modelsMovement.RefInventoryCount, 1)
+	qty := func(v int) *int { return &v }
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newCountService()
+
+		_, err := svc.Close(ctx, 0, &user)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("contagem já cancelada", func(t *testing.T) {
+		svc, m := newCountService()
+		m.countTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.countTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.InventoryCount{ID: 1, Status: models.StatusCanceled}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Close(ctx, 1, &user)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("ajusta só os produtos com divergência", func(t *testing.T) {
+		svc, m := newCountService()
+		m.countTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.countTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openCount(), nil).Once()
+		m.countTx.On("CountedLinesForUpdateTx", ctx, m.tx, int64(1)).Return([]*models.VarianceLine{
+			{ProductID: 7, SystemQuantity: 10, CountedQuantity: qty(8)},
+			{ProductID: 8, SystemQuantity: 4, CountedQuantity: qty(4)},
+			{ProductID: 9, SystemQuantity: 0, CountedQuantity: qty(3)},
+		}, nil).Once()
+		m.stockTx.On("SetStockTx", ctx, m.tx, int64(7), 8, origin).Return(nil).Once()
+		m.stockTx.On("SetStockTx", ctx, m.tx, int64(9), 3, origin).Return(nil).Once()
+		m.countTx.On("UpdateStatusTx", ctx, m.tx, mock.MatchedBy(func(c *models.InventoryCount) bool {
+			return c.Status == models.StatusClosed && c.AdjustedProducts == 2 && *c.ClosedBy == user
+		})).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		closed, err := svc.Close(ctx, 1, &user)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusClosed, closed.Status)
+		m.stockTx.AssertExpectations(t)
+		m.countTx.AssertExpectations(t)
+	})
+
+	t.Run("falha no ajuste desfaz o fechamento", func(t *testing.T) {
+		svc, m := newCountService()
+		m.countTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.countTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openCount(), nil).Once()
+		m.countTx.On("CountedLinesForUpdateTx", ctx, m.tx, int64(1)).Return([]*models.VarianceLine{
+			{ProductID: 7, SystemQuantity: 10, CountedQuantity: qty(8)},
+		}, nil).Once()
+		m.stockTx.On("SetStockTx", ctx, m.tx, int64(7), 8, origin).Return(errMsg.ErrUpdate).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Close(ctx, 1, &user)
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+		m.countTx.AssertNotCalled(t, "UpdateStatusTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestInventoryCountService_Cancel(t *testing.T) {
+	ctx := context.Background()
+	user := int64(5)
+
+	t.Run("contagem já fechada", func(t *testing.T) {
+		svc, m := newCountService()
+		m.countTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.countTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.InventoryCount{ID: 1, Status: models.StatusClosed}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Cancel(ctx, 1, &user)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("cancela sem ajustar estoque", func(t *testing.T) {
+		svc, m := newCountService()
+		m.countTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.countTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openCount(), nil).Once()
+		m.countTx.On("UpdateStatusTx", ctx, m.tx, mock.MatchedBy(func(c *models.InventoryCount) bool {
+			return c.Status == models.StatusCanceled
+		})).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		canceled, err := svc.Cancel(ctx, 1, &user)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusCanceled, canceled.Status)
+		m.stockTx.AssertNotCalled(t, "SetStockTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
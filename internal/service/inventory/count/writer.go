@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// Open abre uma sessão de contagem, opcionalmente restrita a uma categoria.
+func (s *inventoryCountService) Open(ctx context.Context, count *models.InventoryCount) (*models.InventoryCount, error) {
+	if count == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if err := count.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	count.Status = models.StatusOpen
+
+	var created *models.InventoryCount
+
+	err := repoDb.RunInTx(ctx, s.repoCountTx, func(tx pgx.Tx) error {
+		var err error
+		created, err = s.repoCountTx.CreateTx(ctx, tx, count)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// Record grava um lote de leituras. O lote é aplicado por inteiro ou
+// recusado por inteiro; cada item devolvido traz o total acumulado do
+// produto após a leitura correspondente.
+func (s *inventoryCountService) Record(ctx context.Context, id int64, entries []models.CountEntry) ([]*models.CountItem, error) {
+	if id <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	if err := models.ValidateEntries(entries); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	items := make([]*models.CountItem, 0, len(entries))
+
+	err := repoDb.RunInTx(ctx, s.repoCountTx, func(tx pgx.Tx) error {
+		if _, err := s.lockOpen(ctx, tx, id, "somente contagens abertas aceitam leituras"); err != nil {
+			return err
+		}
+
+		for i := range entries {
+			item, err := s.repoCountTx.RecordTx(ctx, tx, id, &entries[i])
+			if err != nil {
+				return err
+			}
+			items = append(items, item)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
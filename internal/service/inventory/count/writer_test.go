@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockInventory "github.com/WagaoCarvalho/backend_store_go/infra/mock/inventory"
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	mockTX "github.com/WagaoCarvalho/backend_store_go/infra/mock/repo"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/count"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type countMocks struct {
+	repo    *mockInventory.MockInventoryCountRepo
+	countTx *mockInventory.MockInventoryCountTx
+	stockTx *mockProduct.MockProductStockTx
+	tx      *mockTX.MockTx
+}
+
+func newCountService() (InventoryCountService, countMocks) {
+	m := countMocks{
+		repo:    new(mockInventory.MockInventoryCountRepo),
+		countTx: new(mockInventory.MockInventoryCountTx),
+		stockTx: new(mockProduct.MockProductStockTx),
+		tx:      new(mockTX.MockTx),
+	}
+	return NewInventoryCountService(m.repo, m.countTx, m.stockTx), m
+}
+
+func openCount() *models.InventoryCount {
+	return &models.InventoryCount{ID: 1, Status: models.StatusOpen}
+}
+
+func TestInventoryCountService_Open(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("contagem nil", func(t *testing.T) {
+		svc, _ := newCountService()
+
+		_, err := svc.Open(ctx, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("categoria inválida", func(t *testing.T) {
+		svc, _ := newCountService()
+		category := int64(0)
+
+		_, err := svc.Open(ctx, &models.InventoryCount{CategoryID: &category})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("abre como open", func(t *testing.T) {
+		svc, m := newCountService()
+		m.countTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.countTx.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(c *models.InventoryCount) bool {
+			return c.Status == models.StatusOpen
+		})).Return(openCount(), nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		created, err := svc.Open(ctx, &models.InventoryCount{Status: models.StatusClosed})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), created.ID)
+		m.countTx.AssertExpectations(t)
+	})
+
+	t.Run("categoria inexistente", func(t *testing.T) {
+		svc, m := newCountService()
+		m.countTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.countTx.On("CreateTx", ctx, m.tx, mock.Anything).Return(nil, errMsg.ErrDBInvalidForeignKey).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Open(ctx, &models.InventoryCount{})
+
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+	})
+}
+
+func TestInventoryCountService_Record(t *testing.T) {
+	ctx := context.Background()
+	id := int64(7)
+	entries := []models.CountEntry{{ProductID: &id, Quantity: 2}, {Barcode: "789", Quantity: 1}}
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newCountService()
+
+		_, err := svc.Record(ctx, 0, entries)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("lote vazio", func(t *testing.T) {
+		svc, _ := newCountService()
+
+		_, err := svc.Record(ctx, 1, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("contagem fechada", func(t *testing.T) {
+		svc, m := newCountService()
+		m.countTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.countTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.InventoryCount{ID: 1, Status: models.StatusClosed}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Record(ctx, 1, entries)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.countTx.AssertNotCalled(t, "RecordTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("grava todas as leituras", func(t *testing.T) {
+		svc, m := newCountService()
+		m.countTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.countTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openCount(), nil).Once()
+		m.countTx.On("RecordTx", ctx, m.tx, int64(1), &entries[0]).Return(&models.CountItem{ProductID: 7, CountedQuantity: 2}, nil).Once()
+		m.countTx.On("RecordTx", ctx, m.tx, int64(1), &entries[1]).Return(&models.CountItem{ProductID: 8, CountedQuantity: 5}, nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		items, err := svc.Record(ctx, 1, entries)
+
+		assert.NoError(t, err)
+		assert.Len(t, items, 2)
+		assert.Equal(t, 5, items[1].CountedQuantity)
+		m.countTx.AssertExpectations(t)
+	})
+
+	t.Run("produto fora do escopo desfaz o lote", func(t *testing.T) {
+		svc, m := newCountService()
+		m.countTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.countTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openCount(), nil).Once()
+		m.countTx.On("RecordTx", ctx, m.tx, int64(1), &entries[0]).Return(&models.CountItem{ProductID: 7}, nil).Once()
+		m.countTx.On("RecordTx", ctx, m.tx, int64(1), &entries[1]).Return(nil, errMsg.ErrNotFound).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Record(ctx, 1, entries)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+		m.tx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("falha ao iniciar transação", func(t *testing.T) {
+		svc, m := newCountService()
+		m.countTx.On("BeginTx", ctx).Return(nil, errors.New("db down")).Once()
+
+		_, err := svc.Record(ctx, 1, entries)
+
+		assert.ErrorContains(t, err, "erro ao iniciar transação")
+	})
+}
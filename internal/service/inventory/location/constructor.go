@@ -0,0 +1,13 @@
+package services
+
+import (
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/inventory/location"
+)
+
+type locationService struct {
+	repo repo.Location
+}
+
+func NewLocationService(repo repo.Location) LocationService {
+	return &locationService{repo: repo}
+}
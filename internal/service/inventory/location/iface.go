@@ -0,0 +1,14 @@
+package services
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+)
+
+type LocationService interface {
+	Create(ctx context.Context, location *models.Location) (*models.Location, error)
+	GetByID(ctx context.Context, id int64) (*models.Location, error)
+	List(ctx context.Context) ([]*models.Location, error)
+	Update(ctx context.Context, location *models.Location) (*models.Location, error)
+}
@@ -0,0 +1,20 @@
+package services
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *locationService) GetByID(ctx context.Context, id int64) (*models.Location, error) {
+	if id <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *locationService) List(ctx context.Context) ([]*models.Location, error) {
+	return s.repo.List(ctx)
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocationService_GetByID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newLocationService()
+
+		_, err := svc.GetByID(ctx, 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, repo := newLocationService()
+		repo.On("GetByID", ctx, int64(2)).Return(validLocation(), nil).Once()
+
+		location, err := svc.GetByID(ctx, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), location.ID)
+	})
+}
+
+func TestLocationService_List(t *testing.T) {
+	ctx := context.Background()
+	svc, repo := newLocationService()
+	repo.On("List", ctx).Return([]*models.Location{validLocation()}, nil).Once()
+
+	locations, err := svc.List(ctx)
+
+	assert.NoError(t, err)
+	assert.Len(t, locations, 1)
+}
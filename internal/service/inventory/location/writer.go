@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *locationService) Create(ctx context.Context, location *models.Location) (*models.Location, error) {
+	if location == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if err := location.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	return s.repo.Create(ctx, location)
+}
+
+// Update exige a versão lida pelo cliente; edições concorrentes resultam em
+// ErrVersionConflict.
+func (s *locationService) Update(ctx context.Context, location *models.Location) (*models.Location, error) {
+	if location == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if location.ID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	if location.Version <= 0 {
+		return nil, errMsg.ErrVersionConflict
+	}
+
+	if err := location.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	if err := s.repo.Update(ctx, location); err != nil {
+		return nil, err
+	}
+
+	return location, nil
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	mockInventory "github.com/WagaoCarvalho/backend_store_go/infra/mock/inventory"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+)
+
+func newLocationService() (LocationService, *mockInventory.MockLocationRepo) {
+	repo := new(mockInventory.MockLocationRepo)
+	return NewLocationService(repo), repo
+}
+
+func validLocation() *models.Location {
+	return &models.Location{ID: 2, Code: "DEP1", Name: "Depósito", Kind: models.KindWarehouse, Version: 1}
+}
+
+func TestLocationService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("local nil", func(t *testing.T) {
+		svc, _ := newLocationService()
+
+		_, err := svc.Create(ctx, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("dados inválidos", func(t *testing.T) {
+		svc, repo := newLocationService()
+
+		_, err := svc.Create(ctx, &models.Location{Kind: "loja"})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		repo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, repo := newLocationService()
+		location := validLocation()
+		repo.On("Create", ctx, location).Return(location, nil).Once()
+
+		created, err := svc.Create(ctx, location)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "DEP1", created.Code)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("código repetido", func(t *testing.T) {
+		svc, repo := newLocationService()
+		location := validLocation()
+		repo.On("Create", ctx, location).Return(nil, errMsg.ErrDuplicate).Once()
+
+		_, err := svc.Create(ctx, location)
+
+		assert.ErrorIs(t, err, errMsg.ErrDuplicate)
+	})
+}
+
+func TestLocationService_Update(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("local nil", func(t *testing.T) {
+		svc, _ := newLocationService()
+
+		_, err := svc.Update(ctx, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newLocationService()
+		location := validLocation()
+		location.ID = 0
+
+		_, err := svc.Update(ctx, location)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("sem versão", func(t *testing.T) {
+		svc, _ := newLocationService()
+		location := validLocation()
+		location.Version = 0
+
+		_, err := svc.Update(ctx, location)
+
+		assert.ErrorIs(t, err, errMsg.ErrVersionConflict)
+	})
+
+	t.Run("dados inválidos", func(t *testing.T) {
+		svc, _ := newLocationService()
+		location := validLocation()
+		location.Name = ""
+
+		_, err := svc.Update(ctx, location)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, repo := newLocationService()
+		location := validLocation()
+		repo.On("Update", ctx, location).Return(nil).Once()
+
+		updated, err := svc.Update(ctx, location)
+
+		assert.NoError(t, err)
+		assert.Same(t, location, updated)
+	})
+
+	t.Run("conflito de versão", func(t *testing.T) {
+		svc, repo := newLocationService()
+		location := validLocation()
+		repo.On("Update", ctx, location).Return(errMsg.ErrVersionConflict).Once()
+
+		_, err := svc.Update(ctx, location)
+
+		assert.ErrorIs(t, err, errMsg.ErrVersionConflict)
+	})
+}
@@ -0,0 +1,25 @@
+package services
+
+import (
+	ifaceInventory "github.com/WagaoCarvalho/backend_store_go/internal/iface/inventory"
+	ifaceProduct "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/inventory/transfer"
+)
+
+type transferService struct {
+	repo           repo.Transfer
+	repoTransferTx ifaceInventory.TransferTx
+	repoStockTx    ifaceProduct.ProductLocationTx
+}
+
+func NewTransferService(
+	repo repo.Transfer,
+	repoTransferTx ifaceInventory.TransferTx,
+	repoStockTx ifaceProduct.ProductLocationTx,
+) TransferService {
+	return &transferService{
+		repo:           repo,
+		repoTransferTx: repoTransferTx,
+		repoStockTx:    repoStockTx,
+	}
+}
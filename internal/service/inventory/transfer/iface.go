@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+)
+
+type TransferService interface {
+	Create(ctx context.Context, transfer *models.Transfer) (*models.Transfer, error)
+	GetByID(ctx context.Context, id int64) (*models.Transfer, error)
+	List(ctx context.Context, f *models.TransferFilter) (*commonFilter.Page[*models.Transfer], error)
+
+	Receive(ctx context.Context, id int64, receivedBy *int64) (*models.Transfer, error)
+	Cancel(ctx context.Context, id int64, canceledBy *int64) (*models.Transfer, error)
+}
@@ -0,0 +1,30 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *transferService) GetByID(ctx context.Context, id int64) (*models.Transfer, error) {
+	if id <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *transferService) List(ctx context.Context, f *models.TransferFilter) (*commonFilter.Page[*models.Transfer], error) {
+	if f == nil {
+		return nil, errMsg.ErrInvalidFilter
+	}
+
+	if err := f.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidFilter, err)
+	}
+
+	return s.repo.List(ctx, f)
+}
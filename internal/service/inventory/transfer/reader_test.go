@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferService_GetByID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newTransferService()
+
+		_, err := svc.GetByID(ctx, 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newTransferService()
+		m.repo.On("GetByID", ctx, int64(1)).Return(inTransit(), nil).Once()
+
+		transfer, err := svc.GetByID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, transfer.Items, 2)
+	})
+}
+
+func TestTransferService_List(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("filtro nil", func(t *testing.T) {
+		svc, _ := newTransferService()
+
+		_, err := svc.List(ctx, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+	})
+
+	t.Run("status inválido", func(t *testing.T) {
+		svc, _ := newTransferService()
+
+		_, err := svc.List(ctx, &models.TransferFilter{Status: "draft"})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newTransferService()
+		f := &models.TransferFilter{Status: models.StatusInTransit}
+		page := &commonFilter.Page[*models.Transfer]{Items: []*models.Transfer{inTransit()}, Total: 1}
+		m.repo.On("List", ctx, f).Return(page, nil).Once()
+
+		result, err := svc.List(ctx, f)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), result.Total)
+	})
+}
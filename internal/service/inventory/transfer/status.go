@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// Receive confirma a chegada e soma os itens ao destino.
+func (s *transferService) Receive(ctx context.Context, id int64, receivedBy *int64) (*models.Transfer, error) {
+	return s.finish(ctx, id, receivedBy, models.StatusReceived, "somente transferências em trânsito podem ser recebidas")
+}
+
+// Cancel desfaz o envio e devolve os itens à origem.
+func (s *transferService) Cancel(ctx context.Context, id int64, canceledBy *int64) (*models.Transfer, error) {
+	return s.finish(ctx, id, canceledBy, models.StatusCanceled, "somente transferências em trânsito podem ser canceladas")
+}
+
+// finish tira a transferência do trânsito. O status é gravado antes de
+// movimentar os saldos, para que o trânsito já não conte no recálculo do
+// local padrão.
+func (s *transferService) finish(ctx context.Context, id int64, userID *int64, status, reason string) (*models.Transfer, error) {
+	if id <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	var finished *models.Transfer
+
+	err := repoDb.RunInTx(ctx, s.repoTransferTx, func(tx pgx.Tx) error {
+		transfer, err := s.repoTransferTx.GetByIDForUpdateTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if !transfer.InTransit() {
+			return fmt.Errorf("%w: %s", errMsg.ErrInvalidData, reason)
+		}
+
+		transfer.Status = status
+		target := transfer.FromLocationID
+		if status == models.StatusReceived {
+			transfer.ReceivedBy = userID
+			target = transfer.ToLocationID
+		}
+
+		if err := s.repoTransferTx.UpdateStatusTx(ctx, tx, transfer); err != nil {
+			return err
+		}
+
+		if err := s.shiftTx(ctx, tx, target, transfer.Items, 1); err != nil {
+			return err
+		}
+
+		finished = transfer
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return finished, nil
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTransferService_Receive(t *testing.T) {
+	ctx := context.Background()
+	user := int64(6)
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newTransferService()
+
+		_, err := svc.Receive(ctx, 0, &user)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("transferência já recebida", func(t *testing.T) {
+		svc, m := newTransferService()
+		transfer := inTransit()
+		transfer.Status = models.StatusReceived
+		m.transferTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.transferTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(transfer, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Receive(ctx, 1, &user)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.transferTx.AssertNotCalled(t, "UpdateStatusTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("soma os itens ao destino", func(t *testing.T) {
+		svc, m := newTransferService()
+		m.transferTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.transferTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(inTransit(), nil).Once()
+		m.transferTx.On("UpdateStatusTx", ctx, m.tx, mock.MatchedBy(func(tr *models.Transfer) bool {
+			return tr.Status == models.StatusReceived && *tr.ReceivedBy == user
+		})).Return(nil).Once()
+		m.stockTx.On("ShiftStockTx", ctx, m.tx, int64(7), int64(2), 4).Return(nil).Once()
+		m.stockTx.On("ShiftStockTx", ctx, m.tx, int64(8), int64(2), 1).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		received, err := svc.Receive(ctx, 1, &user)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusReceived, received.Status)
+		m.stockTx.AssertExpectations(t)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("transferência inexistente", func(t *testing.T) {
+		svc, m := newTransferService()
+		m.transferTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.transferTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Receive(ctx, 1, &user)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+}
+
+func TestTransferService_Cancel(t *testing.T) {
+	ctx := context.Background()
+	user := int64(6)
+
+	t.Run("transferência já cancelada", func(t *testing.T) {
+		svc, m := newTransferService()
+		transfer := inTransit()
+		transfer.Status = models.StatusCanceled
+		m.transferTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.transferTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(transfer, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Cancel(ctx, 1, &user)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("devolve os itens à origem", func(t *testing.T) {
+		svc, m := newTransferService()
+		m.transferTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.transferTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(inTransit(), nil).Once()
+		m.transferTx.On("UpdateStatusTx", ctx, m.tx, mock.MatchedBy(func(tr *models.Transfer) bool {
+			return tr.Status == models.StatusCanceled && tr.ReceivedBy == nil
+		})).Return(nil).Once()
+		m.stockTx.On("ShiftStockTx", ctx, m.tx, int64(7), int64(1), 4).Return(nil).Once()
+		m.stockTx.On("ShiftStockTx", ctx, m.tx, int64(8), int64(1), 1).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		canceled, err := svc.Cancel(ctx, 1, &user)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusCanceled, canceled.Status)
+		m.stockTx.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+	"sort"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	"github.com/jackc/pgx/v5"
+)
+
+// shiftTx movimenta o saldo de cada item no local, em ordem de produto para
+// que transferências concorrentes bloqueiem as linhas na mesma sequência.
+func (s *transferService) shiftTx(ctx context.Context, tx pgx.Tx, locationID int64, items []models.TransferItem, sign int) error {
+	sorted := make([]models.TransferItem, len(items))
+	copy(sorted, items)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ProductID < sorted[j].ProductID })
+
+	for _, item := range sorted {
+		if err := s.repoStockTx.ShiftStockTx(ctx, tx, item.ProductID, locationID, sign*item.Quantity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
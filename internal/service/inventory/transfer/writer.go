@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// Create registra o envio: grava a transferência em trânsito e tira os itens
+// da origem na mesma transação. Falta de saldo na origem recusa o envio
+// inteiro com ErrInsufficientStock.
+func (s *transferService) Create(ctx context.Context, transfer *models.Transfer) (*models.Transfer, error) {
+	if transfer == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if err := transfer.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	transfer.Status = models.StatusInTransit
+	transfer.ReceivedBy = nil
+
+	var created *models.Transfer
+
+	err := repoDb.RunInTx(ctx, s.repoTransferTx, func(tx pgx.Tx) error {
+		var err error
+		// A transferência é gravada antes da baixa para que o trânsito já
+		// conte no recálculo do local padrão.
+		created, err = s.repoTransferTx.CreateTx(ctx, tx, transfer)
+		if err != nil {
+			return err
+		}
+
+		return s.shiftTx(ctx, tx, created.FromLocationID, created.Items, -1)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
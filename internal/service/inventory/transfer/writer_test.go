@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockInventory "github.com/WagaoCarvalho/backend_store_go/infra/mock/inventory"
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	mockTX "github.com/WagaoCarvalho/backend_store_go/infra/mock/repo"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/transfer"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type transferMocks struct {
+	repo       *mockInventory.MockTransferRepo
+	transferTx *mockInventory.MockTransferTx
+	stockTx    *mockProduct.MockProductStockTx
+	tx         *mockTX.MockTx
+}
+
+func newTransferService() (TransferService, transferMocks) {
+	m := transferMocks{
+		repo:       new(mockInventory.MockTransferRepo),
+		transferTx: new(mockInventory.MockTransferTx),
+		stockTx:    new(mockProduct.MockProductStockTx),
+		tx:         new(mockTX.MockTx),
+	}
+	return NewTransferService(m.repo, m.transferTx, m.stockTx), m
+}
+
+// inTransit leva os produtos 8 e 7 do local 1 para o 2, fora de ordem de
+// propósito.
+func inTransit() *models.Transfer {
+	return &models.Transfer{
+		ID:             1,
+		FromLocationID: 1,
+		ToLocationID:   2,
+		Status:         models.StatusInTransit,
+		Items: []models.TransferItem{
+			{ProductID: 8, Quantity: 1},
+			{ProductID: 7, Quantity: 4},
+		},
+	}
+}
+
+func TestTransferService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("transferência nil", func(t *testing.T) {
+		svc, _ := newTransferService()
+
+		_, err := svc.Create(ctx, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("origem igual ao destino", func(t *testing.T) {
+		svc, m := newTransferService()
+		transfer := inTransit()
+		transfer.ToLocationID = 1
+
+		_, err := svc.Create(ctx, transfer)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.transferTx.AssertNotCalled(t, "BeginTx", ctx)
+	})
+
+	t.Run("envia e baixa da origem em ordem de produto", func(t *testing.T) {
+		svc, m := newTransferService()
+		transfer := inTransit()
+		transfer.Status = ""
+
+		var order []int64
+		m.transferTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.transferTx.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(tr *models.Transfer) bool {
+			return tr.Status == models.StatusInTransit
+		})).Return(transfer, nil).Once()
+		m.stockTx.On("ShiftStockTx", ctx, m.tx, int64(7), int64(1), -4).Return(nil).Once().
+			Run(func(mock.Arguments) { order = append(order, 7) })
+		m.stockTx.On("ShiftStockTx", ctx, m.tx, int64(8), int64(1), -1).Return(nil).Once().
+			Run(func(mock.Arguments) { order = append(order, 8) })
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		created, err := svc.Create(ctx, transfer)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusInTransit, created.Status)
+		assert.Equal(t, []int64{7, 8}, order)
+		assert.Equal(t, int64(8), created.Items[0].ProductID, "a ordem dos itens do documento não muda")
+		m.stockTx.AssertExpectations(t)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("saldo insuficiente na origem desfaz o envio", func(t *testing.T) {
+		svc, m := newTransferService()
+		transfer := inTransit()
+
+		m.transferTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.transferTx.On("CreateTx", ctx, m.tx, transfer).Return(transfer, nil).Once()
+		m.stockTx.On("ShiftStockTx", ctx, m.tx, int64(7), int64(1), -4).Return(errMsg.ErrInsufficientStock).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Create(ctx, transfer)
+
+		assert.ErrorIs(t, err, errMsg.ErrInsufficientStock)
+		m.stockTx.AssertNotCalled(t, "ShiftStockTx", ctx, m.tx, int64(8), int64(1), -1)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("erro ao gravar", func(t *testing.T) {
+		svc, m := newTransferService()
+		transfer := inTransit()
+
+		m.transferTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.transferTx.On("CreateTx", ctx, m.tx, transfer).Return(nil, errors.New("db down")).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Create(ctx, transfer)
+
+		assert.Error(t, err)
+		m.stockTx.AssertNotCalled(t, "ShiftStockTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
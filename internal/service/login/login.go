@@ -2,38 +2,97 @@ package auth
 
 import (
 	"context"
+	"strings"
 	"time"
 
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/user"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/login"
-	modelFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/user/filter"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/lockout"
 	pass "github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/password"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/refresh"
 	err_msg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
-	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/user/filter"
+	repoRole "github.com/WagaoCarvalho/backend_store_go/internal/repo/user/role"
 )
 
 type LoginService interface {
-	Login(ctx context.Context, email, password string) (*models.AuthResponse, error)
+	Login(ctx context.Context, email, password string, client models.ClientInfo) (*models.AuthResponse, error)
+	Refresh(ctx context.Context, refreshToken string, client models.ClientInfo) (*models.AuthResponse, error)
+	Unlock(ctx context.Context, email, ip string) error
 }
 
 type TokenGenerator interface {
-	Generate(uid int64, email string) (string, error)
+	Generate(uid int64, email string, roles, permissions []string) (string, error)
+}
+
+// SessionStore guarda as sessões de refresh token (ver RedisTokenBlacklist).
+type SessionStore interface {
+	SaveSession(ctx context.Context, session *models.Session) error
+	RotateSession(ctx context.Context, session *models.Session, previousHash string) error
+	GetSession(ctx context.Context, sessionID string) (*models.Session, error)
+	DeleteSession(ctx context.Context, userID int64, sessionID string) error
+}
+
+// LoginGuard controla as tentativas de login (ver lockout.Guard).
+type LoginGuard interface {
+	Check(ctx context.Context, email, ip string) (time.Duration, error)
+	Fail(ctx context.Context, email, ip string) (lockout.Result, error)
+	Succeed(ctx context.Context, email string) error
+	Unlock(ctx context.Context, email, ip string) error
+}
+
+// LockedError indica que a tentativa foi recusada por espera ou bloqueio.
+// Triggered marca a falha que acabou de gerar o bloqueio, para auditoria.
+type LockedError struct {
+	Err        error
+	RetryAfter time.Duration
+	Scope      string
+	Triggered  bool
+}
+
+func (e *LockedError) Error() string { return e.Err.Error() }
+func (e *LockedError) Unwrap() error { return e.Err }
+
+// dummyHash é comparado quando o email não existe, para que a resposta leve o
+// mesmo tempo de uma senha errada e não revele quais emails estão cadastrados.
+const dummyHash = "$2a$10$nbVq/NCkyGUiaFQlUVeAyepOLbUBSIkeNincLp/VpI0YeTE11wYf6"
+
+// TokenTTL é a validade do token de acesso e a validade máxima da sessão.
+type TokenTTL struct {
+	Access  time.Duration
+	Refresh time.Duration
 }
 
 type loginService struct {
-	userRepo   repo.UserFilter
+	userRepo   iface.UserCredentials
+	roleRepo   repoRole.UserRole
 	jwtManager TokenGenerator
 	hasher     pass.PasswordHasher
+	sessions   SessionStore
+	guard      LoginGuard
+	ttl        TokenTTL
 }
 
-func NewLoginService(repo repo.UserFilter, jwt TokenGenerator, hasher pass.PasswordHasher) LoginService {
+func NewLoginService(
+	repo iface.UserCredentials,
+	roleRepo repoRole.UserRole,
+	jwt TokenGenerator,
+	hasher pass.PasswordHasher,
+	sessions SessionStore,
+	guard LoginGuard,
+	ttl TokenTTL,
+) LoginService {
 	return &loginService{
 		userRepo:   repo,
+		roleRepo:   roleRepo,
 		jwtManager: jwt,
 		hasher:     hasher,
+		sessions:   sessions,
+		guard:      guard,
+		ttl:        ttl,
 	}
 }
 
-func (s *loginService) Login(ctx context.Context, email, password string) (*models.AuthResponse, error) {
+func (s *loginService) Login(ctx context.Context, email, password string, client models.ClientInfo) (*models.AuthResponse, error) {
 	creds := &models.LoginCredential{
 		Email:    email,
 		Password: password,
@@ -43,30 +102,28 @@ func (s *loginService) Login(ctx context.Context, email, password string) (*mode
 		return nil, err
 	}
 
-	// 🔄 Substituído GetByEmail por Filter
-	userFilter := &modelFilter.UserFilter{
-		Email: email,
-		// Opcional: já filtrar por status ativo para evitar uma consulta extra
-		// Status: utils.BoolPtr(true),
-	}
+	// A conta é identificada pelo email inteiro, na mesma forma usada na
+	// busca e nas chaves de bloqueio; um trecho do email não é outra forma de
+	// chegar ao mesmo usuário
+	email = strings.ToLower(strings.TrimSpace(email))
 
-	users, err := s.userRepo.Filter(ctx, userFilter)
+	retryAfter, err := s.guard.Check(ctx, email, client.IP)
 	if err != nil {
-		time.Sleep(time.Second)
-		return nil, err_msg.ErrCredentials
+		return nil, err_msg.ErrLoginAttempts
 	}
-
-	if len(users) == 0 {
-		time.Sleep(time.Second)
-		return nil, err_msg.ErrCredentials
+	if retryAfter > 0 {
+		return nil, &LockedError{Err: err_msg.ErrTooManyAttempts, RetryAfter: retryAfter}
 	}
 
-	user := users[0]
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		_ = s.hasher.Compare(dummyHash, password)
+		return nil, s.failed(ctx, email, client.IP)
+	}
 
 	// ✅ Verificação da senha
 	if err := s.hasher.Compare(user.Password, password); err != nil {
-		time.Sleep(time.Second)
-		return nil, err_msg.ErrCredentials
+		return nil, s.failed(ctx, email, client.IP)
 	}
 
 	// ✅ Verificação se o usuário está ativo (já existente no seu código)
@@ -74,14 +131,92 @@ func (s *loginService) Login(ctx context.Context, email, password string) (*mode
 		return nil, err_msg.ErrAccountDisabled
 	}
 
-	token, err := s.jwtManager.Generate(user.UID, user.Email)
+	// Falha ao zerar a contagem não impede o login; ela expira com a janela
+	_ = s.guard.Succeed(ctx, email)
+
+	sessionID, err := refresh.NewSessionID()
 	if err != nil {
 		return nil, err_msg.ErrTokenGeneration
 	}
 
+	now := time.Now()
+	session := &models.Session{
+		ID:         sessionID,
+		UserID:     user.UID,
+		Email:      user.Email,
+		Device:     client.Device,
+		IP:         client.IP,
+		CreatedAt:  now,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(s.ttl.Refresh),
+	}
+
+	return s.issueTokens(ctx, session, "")
+}
+
+// failed registra a falha e decide o erro devolvido ao cliente.
+func (s *loginService) failed(ctx context.Context, email, ip string) error {
+	result, err := s.guard.Fail(ctx, email, ip)
+	if err != nil {
+		// A senha já foi recusada; o erro do contador não muda a resposta
+		return err_msg.ErrCredentials
+	}
+
+	if result.Locked {
+		return &LockedError{
+			Err:        err_msg.ErrTooManyAttempts,
+			RetryAfter: result.RetryAfter,
+			Scope:      result.Scope,
+			Triggered:  true,
+		}
+	}
+
+	return err_msg.ErrCredentials
+}
+
+// Unlock libera a conta e/ou o IP bloqueados.
+func (s *loginService) Unlock(ctx context.Context, email, ip string) error {
+	if email == "" && ip == "" {
+		return err_msg.ErrInvalidData
+	}
+
+	if err := s.guard.Unlock(ctx, email, ip); err != nil {
+		return err_msg.ErrLoginAttempts
+	}
+
+	return nil
+}
+
+// issueTokens emite um novo token de acesso e rotaciona o refresh token da
+// sessão, guardando apenas o hash do novo segredo. Sem previousHash a sessão
+// é nova e apenas gravada; com ele, só é regravada se ainda estiver com o
+// refresh token apresentado.
+func (s *loginService) issueTokens(ctx context.Context, session *models.Session, previousHash string) (*models.AuthResponse, error) {
+	// Papéis e permissões vão no token para que as rotas não consultem o banco
+	access, err := s.roleRepo.GetAccessByUserID(ctx, session.UserID)
+	if err != nil {
+		return nil, err_msg.ErrTokenGeneration
+	}
+
+	token, err := s.jwtManager.Generate(session.UserID, session.Email, access.Roles, access.Permissions)
+	if err != nil {
+		return nil, err_msg.ErrTokenGeneration
+	}
+
+	refreshToken, hash, err := refresh.New(session.ID)
+	if err != nil {
+		return nil, err_msg.ErrTokenGeneration
+	}
+	session.RefreshHash = hash
+
+	if err := s.storeSession(ctx, session, previousHash); err != nil {
+		return nil, err
+	}
+
 	return &models.AuthResponse{
-		AccessToken: token,
-		TokenType:   "Bearer",
-		ExpiresIn:   3600,
+		AccessToken:  token,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(s.ttl.Access.Seconds()),
 	}, nil
 }
@@ -9,9 +9,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	mockLogin "github.com/WagaoCarvalho/backend_store_go/infra/mock/login"
 	mockUser "github.com/WagaoCarvalho/backend_store_go/infra/mock/user"
-	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/user/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/login"
+	modelsRole "github.com/WagaoCarvalho/backend_store_go/internal/model/user/role"
 	modelsUser "github.com/WagaoCarvalho/backend_store_go/internal/model/user/user"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/lockout"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 )
 
@@ -31,17 +34,23 @@ func (m *mockHasher) Compare(hashed, plain string) error {
 
 type mockTokenGen struct{ mock.Mock }
 
-func (m *mockTokenGen) Generate(uid int64, email string) (string, error) {
-	args := m.Called(uid, email)
+func (m *mockTokenGen) Generate(uid int64, email string, roles, permissions []string) (string, error) {
+	args := m.Called(uid, email, roles, permissions)
 	return args.String(0), args.Error(1)
 }
 
 func TestLoginService_Login(t *testing.T) {
 	mockRepo := new(mockUser.MockUser)
 	mockHasher := new(mockHasher)
+	mockRoles := new(mockUser.MockUserRole)
 	mockToken := new(mockTokenGen)
+	mockSessions := new(mockLogin.MockSession)
+	mockGuard := new(mockLogin.MockLoginGuard)
+	mockGuard.On("Check", mock.Anything, mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+	mockGuard.On("Fail", mock.Anything, mock.Anything, mock.Anything).Return(lockout.Result{}, nil)
+	mockGuard.On("Succeed", mock.Anything, mock.Anything).Return(nil)
 
-	service := NewLoginService(mockRepo, mockToken, mockHasher)
+	service := NewLoginService(mockRepo, mockRoles, mockToken, mockHasher, mockSessions, mockGuard, TokenTTL{Access: 15 * time.Minute, Refresh: 24 * time.Hour})
 
 	t.Run("sucesso", func(t *testing.T) {
 		ctx := context.Background()
@@ -49,19 +58,26 @@ func TestLoginService_Login(t *testing.T) {
 		password := "123456"
 		user := &modelsUser.User{UID: 1, Email: email, Password: "hashed", Status: true}
 
-		// 🔄 Substituído GetByEmail por Filter
-		mockRepo.On("Filter", ctx, mock.MatchedBy(func(f *filter.UserFilter) bool {
-			return f.Email == email
-		})).Return([]*modelsUser.User{user}, nil)
+		mockRepo.On("GetByEmail", ctx, email).Return(user, nil)
 
 		mockHasher.On("Compare", "hashed", password).Return(nil)
-		mockToken.On("Generate", int64(1), email).Return("valid-token", nil)
+		access := &modelsRole.Access{Roles: []string{"cashier"}, Permissions: []string{"sale:create", "sale:read"}}
+		mockRoles.On("GetAccessByUserID", ctx, int64(1)).Return(access, nil).Once()
+		mockToken.On("Generate", int64(1), email, access.Roles, access.Permissions).Return("valid-token", nil)
+		mockSessions.On("SaveSession", ctx, mock.MatchedBy(func(s *models.Session) bool {
+			return s.UserID == 1 && s.Email == email && s.Device == "curl/8" && s.IP == "10.0.0.1" &&
+				s.RefreshHash != "" && s.ExpiresAt.After(time.Now().Add(23*time.Hour))
+		})).Return(nil).Once()
 
-		authResp, err := service.Login(ctx, email, password)
+		authResp, err := service.Login(ctx, email, password, models.ClientInfo{Device: "curl/8", IP: "10.0.0.1"})
 
 		assert.NoError(t, err)
 		assert.Equal(t, "valid-token", authResp.AccessToken)
+		assert.NotEmpty(t, authResp.RefreshToken)
+		assert.Equal(t, int64(900), authResp.ExpiresIn)
 		assert.Equal(t, "Bearer", authResp.TokenType)
+		mockSessions.AssertExpectations(t)
+		mockGuard.AssertCalled(t, "Succeed", ctx, email)
 
 		mockRepo.AssertExpectations(t)
 		mockHasher.AssertExpectations(t)
@@ -69,7 +85,7 @@ func TestLoginService_Login(t *testing.T) {
 	})
 
 	t.Run("email inválido", func(t *testing.T) {
-		authResp, err := service.Login(context.Background(), "invalid", "123")
+		authResp, err := service.Login(context.Background(), "invalid", "123", models.ClientInfo{})
 		assert.Nil(t, authResp)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "email inválido")
@@ -79,37 +95,32 @@ func TestLoginService_Login(t *testing.T) {
 		ctx := context.Background()
 		email := "notfound@example.com"
 
-		// 🔄 Retorna slice vazia em vez de nil
-		mockRepo.On("Filter", ctx, mock.MatchedBy(func(f *filter.UserFilter) bool {
-			return f.Email == email
-		})).Return([]*modelsUser.User{}, nil)
+		mockRepo.On("GetByEmail", ctx, email).Return(nil, errMsg.ErrNotFound)
+		// Mesmo custo de uma senha errada, para não revelar que o email não existe
+		mockHasher.On("Compare", dummyHash, "123").Return(errors.New("mismatch")).Once()
 
-		start := time.Now()
-		authResp, err := service.Login(ctx, email, "123")
-		elapsed := time.Since(start)
+		authResp, err := service.Login(ctx, email, "123", models.ClientInfo{IP: "10.0.0.9"})
 
 		assert.ErrorIs(t, err, errMsg.ErrCredentials)
 		assert.Nil(t, authResp)
-		assert.GreaterOrEqual(t, elapsed.Milliseconds(), int64(1000))
 		mockRepo.AssertExpectations(t)
+		mockHasher.AssertExpectations(t)
+		mockGuard.AssertCalled(t, "Fail", ctx, email, "10.0.0.9")
 	})
 
 	t.Run("erro no repositório", func(t *testing.T) {
 		ctx := context.Background()
 		email := "error@example.com"
 
-		mockRepo.On("Filter", ctx, mock.MatchedBy(func(f *filter.UserFilter) bool {
-			return f.Email == email
-		})).Return(nil, errors.New("db error"))
+		mockRepo.On("GetByEmail", ctx, email).Return(nil, errors.New("db error"))
+		mockHasher.On("Compare", dummyHash, "123").Return(errors.New("mismatch")).Once()
 
-		start := time.Now()
-		authResp, err := service.Login(ctx, email, "123")
-		elapsed := time.Since(start)
+		authResp, err := service.Login(ctx, email, "123", models.ClientInfo{})
 
 		assert.ErrorIs(t, err, errMsg.ErrCredentials)
 		assert.Nil(t, authResp)
-		assert.GreaterOrEqual(t, elapsed.Milliseconds(), int64(1000))
 		mockRepo.AssertExpectations(t)
+		mockHasher.AssertExpectations(t)
 	})
 
 	t.Run("senha inválida", func(t *testing.T) {
@@ -117,12 +128,10 @@ func TestLoginService_Login(t *testing.T) {
 		email := "user@example.com"
 		user := &modelsUser.User{UID: 1, Email: email, Password: "hashed", Status: true}
 
-		mockRepo.On("Filter", ctx, mock.MatchedBy(func(f *filter.UserFilter) bool {
-			return f.Email == email
-		})).Return([]*modelsUser.User{user}, nil)
+		mockRepo.On("GetByEmail", ctx, email).Return(user, nil)
 		mockHasher.On("Compare", "hashed", "wrong").Return(errors.New("wrong password"))
 
-		authResp, err := service.Login(ctx, email, "wrong")
+		authResp, err := service.Login(ctx, email, "wrong", models.ClientInfo{})
 
 		assert.ErrorIs(t, err, errMsg.ErrCredentials)
 		assert.Nil(t, authResp)
@@ -135,12 +144,10 @@ func TestLoginService_Login(t *testing.T) {
 		email := "inactive@example.com"
 		user := &modelsUser.User{UID: 2, Email: email, Password: "hashed", Status: false}
 
-		mockRepo.On("Filter", ctx, mock.MatchedBy(func(f *filter.UserFilter) bool {
-			return f.Email == email
-		})).Return([]*modelsUser.User{user}, nil)
+		mockRepo.On("GetByEmail", ctx, email).Return(user, nil)
 		mockHasher.On("Compare", "hashed", "123").Return(nil)
 
-		authResp, err := service.Login(ctx, email, "123")
+		authResp, err := service.Login(ctx, email, "123", models.ClientInfo{})
 
 		assert.ErrorIs(t, err, errMsg.ErrAccountDisabled)
 		assert.Nil(t, authResp)
@@ -153,13 +160,12 @@ func TestLoginService_Login(t *testing.T) {
 		email := "failtoken@example.com"
 		user := &modelsUser.User{UID: 3, Email: email, Password: "hashed", Status: true}
 
-		mockRepo.On("Filter", ctx, mock.MatchedBy(func(f *filter.UserFilter) bool {
-			return f.Email == email
-		})).Return([]*modelsUser.User{user}, nil)
+		mockRepo.On("GetByEmail", ctx, email).Return(user, nil)
 		mockHasher.On("Compare", "hashed", "123").Return(nil)
-		mockToken.On("Generate", int64(3), email).Return("", errors.New("gen error"))
+		mockRoles.On("GetAccessByUserID", ctx, int64(3)).Return(&modelsRole.Access{}, nil)
+		mockToken.On("Generate", int64(3), email, []string(nil), []string(nil)).Return("", errors.New("gen error"))
 
-		authResp, err := service.Login(ctx, email, "123")
+		authResp, err := service.Login(ctx, email, "123", models.ClientInfo{})
 
 		assert.ErrorIs(t, err, errMsg.ErrTokenGeneration)
 		assert.Nil(t, authResp)
@@ -167,4 +173,191 @@ func TestLoginService_Login(t *testing.T) {
 		mockHasher.AssertExpectations(t)
 		mockToken.AssertExpectations(t)
 	})
+
+	t.Run("erro ao buscar permissões", func(t *testing.T) {
+		ctx := context.Background()
+		email := "noroles@example.com"
+		user := &modelsUser.User{UID: 4, Email: email, Password: "hashed", Status: true}
+
+		mockRepo.On("GetByEmail", ctx, email).Return(user, nil)
+		mockHasher.On("Compare", "hashed", "123").Return(nil)
+		mockRoles.On("GetAccessByUserID", ctx, int64(4)).Return(nil, errors.New("db error"))
+
+		authResp, err := service.Login(ctx, email, "123", models.ClientInfo{})
+
+		assert.ErrorIs(t, err, errMsg.ErrTokenGeneration)
+		assert.Nil(t, authResp)
+		mockToken.AssertNotCalled(t, "Generate", int64(4), email, mock.Anything, mock.Anything)
+	})
+}
+
+func TestLoginService_Login_Lockout(t *testing.T) {
+	ctx := context.Background()
+	email := "user@example.com"
+	user := &modelsUser.User{UID: 1, Email: email, Password: "hashed", Status: true}
+
+	newService := func(guard *mockLogin.MockLoginGuard, repo *mockUser.MockUser, hasher *mockHasher) LoginService {
+		return NewLoginService(repo, new(mockUser.MockUserRole), new(mockTokenGen), hasher, new(mockLogin.MockSession), guard, TokenTTL{})
+	}
+
+	t.Run("bloqueado antes de consultar o usuário", func(t *testing.T) {
+		guard := new(mockLogin.MockLoginGuard)
+		repo := new(mockUser.MockUser)
+		guard.On("Check", ctx, email, "10.0.0.1").Return(90*time.Second, nil).Once()
+
+		authResp, err := newService(guard, repo, new(mockHasher)).Login(ctx, email, "123456", models.ClientInfo{IP: "10.0.0.1"})
+
+		var locked *LockedError
+		assert.Nil(t, authResp)
+		assert.ErrorAs(t, err, &locked)
+		assert.ErrorIs(t, err, errMsg.ErrTooManyAttempts)
+		assert.Equal(t, 90*time.Second, locked.RetryAfter)
+		assert.False(t, locked.Triggered)
+		repo.AssertNotCalled(t, "GetByEmail", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha que atinge o limite", func(t *testing.T) {
+		guard := new(mockLogin.MockLoginGuard)
+		repo := new(mockUser.MockUser)
+		hasher := new(mockHasher)
+		guard.On("Check", ctx, email, "10.0.0.1").Return(time.Duration(0), nil).Once()
+		repo.On("GetByEmail", ctx, mock.Anything).Return(user, nil).Once()
+		hasher.On("Compare", "hashed", "wrong").Return(errors.New("mismatch")).Once()
+		guard.On("Fail", ctx, email, "10.0.0.1").
+			Return(lockout.Result{Locked: true, Scope: lockout.ScopeAccount, RetryAfter: 15 * time.Minute}, nil).Once()
+
+		_, err := newService(guard, repo, hasher).Login(ctx, email, "wrong", models.ClientInfo{IP: "10.0.0.1"})
+
+		var locked *LockedError
+		assert.ErrorAs(t, err, &locked)
+		assert.True(t, locked.Triggered)
+		assert.Equal(t, lockout.ScopeAccount, locked.Scope)
+		assert.Equal(t, 15*time.Minute, locked.RetryAfter)
+		guard.AssertExpectations(t)
+	})
+
+	t.Run("erro ao consultar tentativas", func(t *testing.T) {
+		guard := new(mockLogin.MockLoginGuard)
+		guard.On("Check", ctx, email, "").Return(time.Duration(0), errors.New("redis down")).Once()
+
+		_, err := newService(guard, new(mockUser.MockUser), new(mockHasher)).Login(ctx, email, "123456", models.ClientInfo{})
+
+		assert.ErrorIs(t, err, errMsg.ErrLoginAttempts)
+	})
+
+	t.Run("erro ao registrar falha mantém credenciais inválidas", func(t *testing.T) {
+		guard := new(mockLogin.MockLoginGuard)
+		repo := new(mockUser.MockUser)
+		hasher := new(mockHasher)
+		guard.On("Check", ctx, email, "").Return(time.Duration(0), nil).Once()
+		repo.On("GetByEmail", ctx, mock.Anything).Return(user, nil).Once()
+		hasher.On("Compare", "hashed", "wrong").Return(errors.New("mismatch")).Once()
+		guard.On("Fail", ctx, email, "").Return(lockout.Result{}, errors.New("redis down")).Once()
+
+		_, err := newService(guard, repo, hasher).Login(ctx, email, "wrong", models.ClientInfo{})
+
+		assert.ErrorIs(t, err, errMsg.ErrCredentials)
+	})
+}
+
+// memoryStore guarda as contagens do lockout.Guard em memória, sem expiração.
+type memoryStore struct {
+	failures map[string]int64
+	locks    map[string]time.Duration
+}
+
+func (m *memoryStore) IncrFailures(_ context.Context, key string, _ time.Duration) (int64, error) {
+	m.failures[key]++
+	return m.failures[key], nil
+}
+
+func (m *memoryStore) Lock(_ context.Context, key string, d time.Duration) error {
+	m.locks[key] = d
+	return nil
+}
+
+func (m *memoryStore) LockTTL(_ context.Context, key string) (time.Duration, error) {
+	return m.locks[key], nil
+}
+
+func (m *memoryStore) Reset(_ context.Context, key string) error {
+	delete(m.failures, key)
+	delete(m.locks, key)
+	return nil
+}
+
+func TestLoginService_Login_ExactEmail(t *testing.T) {
+	ctx := context.Background()
+	email := "user@example.com"
+	user := &modelsUser.User{UID: 1, Email: email, Password: "hashed", Status: true}
+
+	store := &memoryStore{failures: map[string]int64{}, locks: map[string]time.Duration{}}
+	guard := lockout.NewGuard(store, lockout.Policy{MaxFailures: 3, IPMaxFailures: 100, Window: time.Hour, LockDuration: time.Hour})
+
+	repo := new(mockUser.MockUser)
+	repo.On("GetByEmail", ctx, email).Return(user, nil)
+	repo.On("GetByEmail", ctx, "ser@example.com").Return(nil, errMsg.ErrNotFound)
+
+	hasher := new(mockHasher)
+	hasher.On("Compare", "hashed", "wrong").Return(errors.New("mismatch"))
+	hasher.On("Compare", dummyHash, "123456").Return(errors.New("mismatch"))
+
+	service := NewLoginService(repo, new(mockUser.MockUserRole), new(mockTokenGen), hasher, new(mockLogin.MockSession), guard, TokenTTL{})
+	client := models.ClientInfo{IP: "10.0.0.1"}
+
+	for i := 0; i < 2; i++ {
+		_, err := service.Login(ctx, email, "wrong", client)
+		assert.ErrorIs(t, err, errMsg.ErrCredentials)
+	}
+
+	// Um trecho do email, mesmo com a senha certa, não encontra a conta e
+	// não zera a contagem dela
+	_, err := service.Login(ctx, "ser@example.com", "123456", client)
+	assert.ErrorIs(t, err, errMsg.ErrCredentials)
+	assert.Equal(t, int64(2), store.failures[lockout.AccountKey(email)])
+
+	// Variações de caixa são a mesma conta e completam o bloqueio
+	_, err = service.Login(ctx, "USER@Example.com", "wrong", client)
+
+	var locked *LockedError
+	assert.ErrorAs(t, err, &locked)
+	assert.True(t, locked.Triggered)
+	assert.Equal(t, lockout.ScopeAccount, locked.Scope)
+	repo.AssertNotCalled(t, "GetByEmail", ctx, "USER@Example.com")
+}
+
+func TestLoginService_Unlock(t *testing.T) {
+	ctx := context.Background()
+
+	newService := func(guard *mockLogin.MockLoginGuard) LoginService {
+		return NewLoginService(new(mockUser.MockUser), new(mockUser.MockUserRole), new(mockTokenGen), new(mockHasher), new(mockLogin.MockSession), guard, TokenTTL{})
+	}
+
+	t.Run("sucesso", func(t *testing.T) {
+		guard := new(mockLogin.MockLoginGuard)
+		guard.On("Unlock", ctx, "user@example.com", "10.0.0.1").Return(nil).Once()
+
+		err := newService(guard).Unlock(ctx, "user@example.com", "10.0.0.1")
+
+		assert.NoError(t, err)
+		guard.AssertExpectations(t)
+	})
+
+	t.Run("sem email nem ip", func(t *testing.T) {
+		guard := new(mockLogin.MockLoginGuard)
+
+		err := newService(guard).Unlock(ctx, "", "")
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		guard.AssertNotCalled(t, "Unlock", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("erro no armazenamento", func(t *testing.T) {
+		guard := new(mockLogin.MockLoginGuard)
+		guard.On("Unlock", ctx, "", "10.0.0.1").Return(errors.New("redis down")).Once()
+
+		err := newService(guard).Unlock(ctx, "", "10.0.0.1")
+
+		assert.ErrorIs(t, err, errMsg.ErrLoginAttempts)
+	})
 }
@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/login"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/refresh"
+	err_msg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+// Refresh troca um refresh token válido por um novo par de tokens. O token
+// apresentado deixa de valer; se um token já rotacionado voltar a aparecer,
+// a sessão inteira é revogada, pois alguém guardou uma cópia dele. Dois
+// refresh simultâneos com o mesmo token também contam como reuso.
+func (s *loginService) Refresh(ctx context.Context, refreshToken string, client models.ClientInfo) (*models.AuthResponse, error) {
+	sessionID, hash, err := refresh.Split(refreshToken)
+	if err != nil {
+		return nil, err_msg.ErrRefreshTokenInvalid
+	}
+
+	session, err := s.sessions.GetSession(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, err_msg.ErrNotFound) {
+			return nil, err_msg.ErrRefreshTokenInvalid
+		}
+		return nil, err_msg.ErrSessionStore
+	}
+
+	if !refresh.Matches(session.RefreshHash, hash) {
+		if err := s.revoke(ctx, session); err != nil {
+			return nil, err
+		}
+		return nil, err_msg.ErrRefreshTokenReused
+	}
+
+	now := time.Now()
+	if session.IsExpired(now) {
+		if err := s.revoke(ctx, session); err != nil {
+			return nil, err
+		}
+		return nil, err_msg.ErrTokenExpired
+	}
+
+	// O usuário pode ter sido desativado depois do login
+	active, err := s.isActive(ctx, session)
+	if err != nil {
+		return nil, err_msg.ErrTokenGeneration
+	}
+	if !active {
+		if err := s.revoke(ctx, session); err != nil {
+			return nil, err
+		}
+		return nil, err_msg.ErrAccountDisabled
+	}
+
+	session.LastSeenAt = now
+	if client.Device != "" {
+		session.Device = client.Device
+	}
+	if client.IP != "" {
+		session.IP = client.IP
+	}
+
+	return s.issueTokens(ctx, session, session.RefreshHash)
+}
+
+// storeSession grava a sessão nova ou, na rotação, troca o hash de forma
+// atômica. Perder a troca para outro refresh com o mesmo token é reuso e
+// revoga a sessão.
+func (s *loginService) storeSession(ctx context.Context, session *models.Session, previousHash string) error {
+	if previousHash == "" {
+		if err := s.sessions.SaveSession(ctx, session); err != nil {
+			return err_msg.ErrSessionStore
+		}
+		return nil
+	}
+
+	err := s.sessions.RotateSession(ctx, session, previousHash)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, err_msg.ErrRefreshTokenReused):
+		if err := s.revoke(ctx, session); err != nil {
+			return err
+		}
+		return err_msg.ErrRefreshTokenReused
+	case errors.Is(err, err_msg.ErrNotFound):
+		return err_msg.ErrRefreshTokenInvalid
+	case errors.Is(err, err_msg.ErrTokenExpired):
+		return err_msg.ErrTokenExpired
+	}
+	return err_msg.ErrSessionStore
+}
+
+// isActive confere se o email da sessão ainda pertence ao mesmo usuário e se
+// ele continua ativo.
+func (s *loginService) isActive(ctx context.Context, session *models.Session) (bool, error) {
+	user, err := s.userRepo.GetByEmail(ctx, session.Email)
+	if err != nil {
+		if errors.Is(err, err_msg.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return user.UID == session.UserID && user.Status, nil
+}
+
+func (s *loginService) revoke(ctx context.Context, session *models.Session) error {
+	err := s.sessions.DeleteSession(ctx, session.UserID, session.ID)
+	if err != nil && !errors.Is(err, err_msg.ErrNotFound) {
+		return err_msg.ErrSessionStore
+	}
+	return nil
+}
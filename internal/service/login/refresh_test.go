@@ -0,0 +1,287 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	redisStore "github.com/WagaoCarvalho/backend_store_go/infra/db/redis"
+	mockLogin "github.com/WagaoCarvalho/backend_store_go/infra/mock/login"
+	mockUser "github.com/WagaoCarvalho/backend_store_go/infra/mock/user"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/login"
+	modelsRole "github.com/WagaoCarvalho/backend_store_go/internal/model/user/role"
+	modelsUser "github.com/WagaoCarvalho/backend_store_go/internal/model/user/user"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/refresh"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+type refreshFixture struct {
+	users    *mockUser.MockUser
+	roles    *mockUser.MockUserRole
+	token    *mockTokenGen
+	sessions *mockLogin.MockSession
+	service  LoginService
+}
+
+func newRefreshFixture() *refreshFixture {
+	f := &refreshFixture{
+		users:    new(mockUser.MockUser),
+		roles:    new(mockUser.MockUserRole),
+		token:    new(mockTokenGen),
+		sessions: new(mockLogin.MockSession),
+	}
+	f.service = NewLoginService(f.users, f.roles, f.token, new(mockHasher), f.sessions, new(mockLogin.MockLoginGuard), TokenTTL{Access: 15 * time.Minute, Refresh: 24 * time.Hour})
+	return f
+}
+
+// activeSession devolve uma sessão válida e o refresh token vigente dela.
+func activeSession(t *testing.T) (*models.Session, string) {
+	token, hash, err := refresh.New("sess-1")
+	require.NoError(t, err)
+
+	return &models.Session{
+		ID:          "sess-1",
+		UserID:      7,
+		Email:       "caixa@loja.com",
+		RefreshHash: hash,
+		Device:      "android",
+		IP:          "10.0.0.1",
+		CreatedAt:   time.Now().Add(-time.Hour),
+		LastSeenAt:  time.Now().Add(-time.Hour),
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}, token
+}
+
+func TestLoginService_Refresh(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sucesso rotaciona o refresh token", func(t *testing.T) {
+		f := newRefreshFixture()
+		session, token := activeSession(t)
+		oldHash := session.RefreshHash
+
+		f.sessions.On("GetSession", ctx, "sess-1").Return(session, nil)
+		f.users.On("GetByEmail", ctx, mock.Anything).Return(&modelsUser.User{UID: 7, Email: session.Email, Status: true}, nil)
+		f.roles.On("GetAccessByUserID", ctx, int64(7)).Return(&modelsRole.Access{Roles: []string{"cashier"}}, nil)
+		f.token.On("Generate", int64(7), session.Email, []string{"cashier"}, []string(nil)).Return("new-access", nil)
+		f.sessions.On("RotateSession", ctx, mock.MatchedBy(func(s *models.Session) bool {
+			return s.ID == "sess-1" && s.RefreshHash != oldHash && s.IP == "10.0.0.2" && s.Device == "android"
+		}), oldHash).Return(nil)
+
+		resp, err := f.service.Refresh(ctx, token, models.ClientInfo{IP: "10.0.0.2"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "new-access", resp.AccessToken)
+		assert.NotEqual(t, token, resp.RefreshToken)
+		assert.Equal(t, int64(900), resp.ExpiresIn)
+		f.sessions.AssertExpectations(t)
+	})
+
+	t.Run("token mal formatado", func(t *testing.T) {
+		f := newRefreshFixture()
+
+		_, err := f.service.Refresh(ctx, "lixo", models.ClientInfo{})
+
+		assert.ErrorIs(t, err, errMsg.ErrRefreshTokenInvalid)
+	})
+
+	t.Run("sessão inexistente", func(t *testing.T) {
+		f := newRefreshFixture()
+		f.sessions.On("GetSession", ctx, "sess-1").Return(nil, errMsg.ErrNotFound)
+
+		_, err := f.service.Refresh(ctx, "sess-1.segredo", models.ClientInfo{})
+
+		assert.ErrorIs(t, err, errMsg.ErrRefreshTokenInvalid)
+	})
+
+	t.Run("erro no armazenamento de sessões", func(t *testing.T) {
+		f := newRefreshFixture()
+		f.sessions.On("GetSession", ctx, "sess-1").Return(nil, errors.New("redis down"))
+
+		_, err := f.service.Refresh(ctx, "sess-1.segredo", models.ClientInfo{})
+
+		assert.ErrorIs(t, err, errMsg.ErrSessionStore)
+	})
+
+	t.Run("reuso de token rotacionado revoga a sessão", func(t *testing.T) {
+		f := newRefreshFixture()
+		session, _ := activeSession(t)
+
+		f.sessions.On("GetSession", ctx, "sess-1").Return(session, nil)
+		f.sessions.On("DeleteSession", ctx, int64(7), "sess-1").Return(nil).Once()
+
+		_, err := f.service.Refresh(ctx, "sess-1.segredo-antigo", models.ClientInfo{})
+
+		assert.ErrorIs(t, err, errMsg.ErrRefreshTokenReused)
+		f.sessions.AssertExpectations(t)
+		f.token.AssertNotCalled(t, "Generate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha ao revogar após reuso", func(t *testing.T) {
+		f := newRefreshFixture()
+		session, _ := activeSession(t)
+
+		f.sessions.On("GetSession", ctx, "sess-1").Return(session, nil)
+		f.sessions.On("DeleteSession", ctx, int64(7), "sess-1").Return(errors.New("redis down"))
+
+		_, err := f.service.Refresh(ctx, "sess-1.segredo-antigo", models.ClientInfo{})
+
+		assert.ErrorIs(t, err, errMsg.ErrSessionStore)
+	})
+
+	t.Run("sessão expirada", func(t *testing.T) {
+		f := newRefreshFixture()
+		session, token := activeSession(t)
+		session.ExpiresAt = time.Now().Add(-time.Minute)
+
+		f.sessions.On("GetSession", ctx, "sess-1").Return(session, nil)
+		f.sessions.On("DeleteSession", ctx, int64(7), "sess-1").Return(errMsg.ErrNotFound)
+
+		_, err := f.service.Refresh(ctx, token, models.ClientInfo{})
+
+		assert.ErrorIs(t, err, errMsg.ErrTokenExpired)
+	})
+
+	t.Run("usuário desativado após o login", func(t *testing.T) {
+		f := newRefreshFixture()
+		session, token := activeSession(t)
+
+		f.sessions.On("GetSession", ctx, "sess-1").Return(session, nil)
+		f.users.On("GetByEmail", ctx, mock.Anything).Return(&modelsUser.User{UID: 7, Status: false}, nil)
+		f.sessions.On("DeleteSession", ctx, int64(7), "sess-1").Return(nil).Once()
+
+		_, err := f.service.Refresh(ctx, token, models.ClientInfo{})
+
+		assert.ErrorIs(t, err, errMsg.ErrAccountDisabled)
+		f.sessions.AssertExpectations(t)
+	})
+
+	t.Run("email da sessão pertence a outro usuário", func(t *testing.T) {
+		f := newRefreshFixture()
+		session, token := activeSession(t)
+
+		f.sessions.On("GetSession", ctx, "sess-1").Return(session, nil)
+		f.users.On("GetByEmail", ctx, session.Email).Return(&modelsUser.User{UID: 8, Email: session.Email, Status: true}, nil)
+		f.sessions.On("DeleteSession", ctx, int64(7), "sess-1").Return(nil).Once()
+
+		_, err := f.service.Refresh(ctx, token, models.ClientInfo{})
+
+		assert.ErrorIs(t, err, errMsg.ErrAccountDisabled)
+		f.sessions.AssertExpectations(t)
+	})
+
+	t.Run("erro ao consultar usuário", func(t *testing.T) {
+		f := newRefreshFixture()
+		session, token := activeSession(t)
+
+		f.sessions.On("GetSession", ctx, "sess-1").Return(session, nil)
+		f.users.On("GetByEmail", ctx, mock.Anything).Return(nil, errors.New("db error"))
+
+		_, err := f.service.Refresh(ctx, token, models.ClientInfo{})
+
+		assert.ErrorIs(t, err, errMsg.ErrTokenGeneration)
+	})
+
+	t.Run("erro ao gravar sessão rotacionada", func(t *testing.T) {
+		f := newRefreshFixture()
+		session, token := activeSession(t)
+
+		f.sessions.On("GetSession", ctx, "sess-1").Return(session, nil)
+		f.users.On("GetByEmail", ctx, mock.Anything).Return(&modelsUser.User{UID: 7, Status: true}, nil)
+		f.roles.On("GetAccessByUserID", ctx, int64(7)).Return(&modelsRole.Access{}, nil)
+		f.token.On("Generate", int64(7), session.Email, []string(nil), []string(nil)).Return("new-access", nil)
+		f.sessions.On("RotateSession", ctx, mock.Anything, mock.Anything).Return(errors.New("redis down"))
+
+		resp, err := f.service.Refresh(ctx, token, models.ClientInfo{})
+
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, errMsg.ErrSessionStore)
+	})
+
+	t.Run("rotação perdida para outro refresh revoga a sessão", func(t *testing.T) {
+		f := newRefreshFixture()
+		session, token := activeSession(t)
+
+		f.sessions.On("GetSession", ctx, "sess-1").Return(session, nil)
+		f.users.On("GetByEmail", ctx, mock.Anything).Return(&modelsUser.User{UID: 7, Status: true}, nil)
+		f.roles.On("GetAccessByUserID", ctx, int64(7)).Return(&modelsRole.Access{}, nil)
+		f.token.On("Generate", int64(7), session.Email, []string(nil), []string(nil)).Return("new-access", nil)
+		f.sessions.On("RotateSession", ctx, mock.Anything, mock.Anything).Return(errMsg.ErrRefreshTokenReused)
+		f.sessions.On("DeleteSession", ctx, int64(7), "sess-1").Return(nil).Once()
+
+		resp, err := f.service.Refresh(ctx, token, models.ClientInfo{})
+
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, errMsg.ErrRefreshTokenReused)
+		f.sessions.AssertExpectations(t)
+	})
+
+	t.Run("sessão removida durante a rotação", func(t *testing.T) {
+		f := newRefreshFixture()
+		session, token := activeSession(t)
+
+		f.sessions.On("GetSession", ctx, "sess-1").Return(session, nil)
+		f.users.On("GetByEmail", ctx, mock.Anything).Return(&modelsUser.User{UID: 7, Status: true}, nil)
+		f.roles.On("GetAccessByUserID", ctx, int64(7)).Return(&modelsRole.Access{}, nil)
+		f.token.On("Generate", int64(7), session.Email, []string(nil), []string(nil)).Return("new-access", nil)
+		f.sessions.On("RotateSession", ctx, mock.Anything, mock.Anything).Return(errMsg.ErrNotFound)
+
+		_, err := f.service.Refresh(ctx, token, models.ClientInfo{})
+
+		assert.ErrorIs(t, err, errMsg.ErrRefreshTokenInvalid)
+		f.sessions.AssertNotCalled(t, "DeleteSession", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// Dois clientes com o mesmo refresh token (ex.: cópia vazada) disputando a
+// rotação: só um recebe tokens novos e a sessão acaba revogada.
+func TestLoginService_Refresh_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	store := redisStore.NewRedisTokenBlacklist(server.Addr(), "", 0)
+
+	users, roles, token := new(mockUser.MockUser), new(mockUser.MockUserRole), new(mockTokenGen)
+	users.On("GetByEmail", ctx, mock.Anything).Return(&modelsUser.User{UID: 7, Status: true}, nil)
+	roles.On("GetAccessByUserID", ctx, int64(7)).Return(&modelsRole.Access{}, nil)
+	token.On("Generate", int64(7), mock.Anything, []string(nil), []string(nil)).Return("new-access", nil)
+	service := NewLoginService(users, roles, token, new(mockHasher), store, new(mockLogin.MockLoginGuard), TokenTTL{Access: 15 * time.Minute, Refresh: 24 * time.Hour})
+
+	session, refreshToken := activeSession(t)
+	require.NoError(t, store.SaveSession(ctx, session))
+
+	const clients = 10
+	errs := make([]error, clients)
+	var wg sync.WaitGroup
+	for i := 0; i < clients; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = service.Refresh(ctx, refreshToken, models.ClientInfo{})
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded, reused := 0, 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case errors.Is(err, errMsg.ErrRefreshTokenReused):
+			reused++
+		default:
+			assert.ErrorIs(t, err, errMsg.ErrRefreshTokenInvalid)
+		}
+	}
+	assert.Equal(t, 1, succeeded)
+	assert.GreaterOrEqual(t, reused, 1)
+
+	_, err := store.GetSession(ctx, session.ID)
+	assert.ErrorIs(t, err, errMsg.ErrNotFound)
+}
@@ -2,9 +2,12 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"time"
 
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/login"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/refresh"
 	err_msg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/golang-jwt/jwt/v5"
 )
@@ -14,8 +17,14 @@ type TokenBlacklist interface {
 	IsBlacklisted(ctx context.Context, token string) (bool, error)
 }
 
+type SessionStore interface {
+	GetSession(ctx context.Context, sessionID string) (*models.Session, error)
+	DeleteSession(ctx context.Context, userID int64, sessionID string) error
+}
+
 type LogoutService interface {
 	Logout(ctx context.Context, token string) error
+	EndSession(ctx context.Context, refreshToken string) error
 }
 
 type JWTService interface {
@@ -26,15 +35,18 @@ type JWTService interface {
 type logoutService struct {
 	blacklist  TokenBlacklist
 	jwtService JWTService
+	sessions   SessionStore
 }
 
 func NewLogoutService(
 	blacklist TokenBlacklist,
 	jwtService JWTService,
+	sessions SessionStore,
 ) LogoutService {
 	return &logoutService{
 		blacklist:  blacklist,
 		jwtService: jwtService,
+		sessions:   sessions,
 	}
 }
 
@@ -67,3 +79,30 @@ func (s *logoutService) Logout(ctx context.Context, tokenString string) error {
 
 	return nil
 }
+
+// EndSession revoga a sessão dona do refresh token, invalidando a família toda.
+func (s *logoutService) EndSession(ctx context.Context, refreshToken string) error {
+	sessionID, hash, err := refresh.Split(refreshToken)
+	if err != nil {
+		return err_msg.ErrRefreshTokenInvalid
+	}
+
+	session, err := s.sessions.GetSession(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, err_msg.ErrNotFound) {
+			return err_msg.ErrRefreshTokenInvalid
+		}
+		return err_msg.ErrSessionStore
+	}
+
+	// Só quem tem o token vigente encerra a sessão
+	if !refresh.Matches(session.RefreshHash, hash) {
+		return err_msg.ErrRefreshTokenInvalid
+	}
+
+	if err := s.sessions.DeleteSession(ctx, session.UserID, session.ID); err != nil && !errors.Is(err, err_msg.ErrNotFound) {
+		return err_msg.ErrSessionStore
+	}
+
+	return nil
+}
@@ -6,6 +6,9 @@ import (
 	"testing"
 	"time"
 
+	mockLogin "github.com/WagaoCarvalho/backend_store_go/infra/mock/login"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/login"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/auth/refresh"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
@@ -59,7 +62,7 @@ func TestLogoutService_Logout(t *testing.T) {
 	t.Run("falha: token vazio", func(t *testing.T) {
 		mockBL := new(mockBlacklist)
 		mockJWT := new(mockJWTService)
-		service := NewLogoutService(mockBL, mockJWT)
+		service := NewLogoutService(mockBL, mockJWT, nil)
 
 		err := service.Logout(ctx, "")
 
@@ -76,7 +79,7 @@ func TestLogoutService_Logout(t *testing.T) {
 		mockJWT.On("GetExpiration", token).Return(1*time.Hour, nil)
 		mockBL.On("Add", ctx, tokenStr, 1*time.Hour).Return(nil)
 
-		service := NewLogoutService(mockBL, mockJWT)
+		service := NewLogoutService(mockBL, mockJWT, nil)
 		err := service.Logout(ctx, tokenStr)
 
 		assert.NoError(t, err)
@@ -90,7 +93,7 @@ func TestLogoutService_Logout(t *testing.T) {
 
 		mockJWT.On("Parse", "invalid-token").Return(nil, errors.New("malformed token"))
 
-		service := NewLogoutService(mockBL, mockJWT)
+		service := NewLogoutService(mockBL, mockJWT, nil)
 		err := service.Logout(ctx, "invalid-token")
 
 		assert.ErrorIs(t, err, errMsg.ErrTokenValidation)
@@ -105,7 +108,7 @@ func TestLogoutService_Logout(t *testing.T) {
 		token := &jwt.Token{Valid: false}
 		mockJWT.On("Parse", tokenStr).Return(token, nil)
 
-		service := NewLogoutService(mockBL, mockJWT)
+		service := NewLogoutService(mockBL, mockJWT, nil)
 		err := service.Logout(ctx, tokenStr)
 
 		assert.ErrorIs(t, err, errMsg.ErrInvalidToken)
@@ -121,7 +124,7 @@ func TestLogoutService_Logout(t *testing.T) {
 		mockJWT.On("Parse", tokenStr).Return(token, nil)
 		mockJWT.On("GetExpiration", token).Return(time.Duration(0), errors.New("claim exp faltando"))
 
-		service := NewLogoutService(mockBL, mockJWT)
+		service := NewLogoutService(mockBL, mockJWT, nil)
 		err := service.Logout(ctx, tokenStr)
 
 		assert.ErrorIs(t, err, errMsg.ErrClaimExpInvalid)
@@ -137,7 +140,7 @@ func TestLogoutService_Logout(t *testing.T) {
 		mockJWT.On("Parse", tokenStr).Return(token, nil)
 		mockJWT.On("GetExpiration", token).Return(-1*time.Minute, nil)
 
-		service := NewLogoutService(mockBL, mockJWT)
+		service := NewLogoutService(mockBL, mockJWT, nil)
 		err := service.Logout(ctx, tokenStr)
 
 		assert.ErrorIs(t, err, errMsg.ErrTokenExpired)
@@ -154,7 +157,7 @@ func TestLogoutService_Logout(t *testing.T) {
 		mockJWT.On("GetExpiration", token).Return(1*time.Hour, nil)
 		mockBL.On("Add", ctx, tokenStr, 1*time.Hour).Return(errors.New("falha redis"))
 
-		service := NewLogoutService(mockBL, mockJWT)
+		service := NewLogoutService(mockBL, mockJWT, nil)
 		err := service.Logout(ctx, tokenStr)
 
 		assert.ErrorIs(t, err, errMsg.ErrBlacklistAdd)
@@ -162,3 +165,72 @@ func TestLogoutService_Logout(t *testing.T) {
 		mockJWT.AssertExpectations(t)
 	})
 }
+
+func TestLogoutService_EndSession(t *testing.T) {
+	ctx := context.Background()
+
+	newSession := func(t *testing.T) (*models.Session, string) {
+		token, hash, err := refresh.New("sess-1")
+		assert.NoError(t, err)
+		return &models.Session{ID: "sess-1", UserID: 7, RefreshHash: hash}, token
+	}
+
+	t.Run("encerra a sessão", func(t *testing.T) {
+		mockSessions := new(mockLogin.MockSession)
+		service := NewLogoutService(nil, nil, mockSessions)
+		session, token := newSession(t)
+
+		mockSessions.On("GetSession", ctx, "sess-1").Return(session, nil)
+		mockSessions.On("DeleteSession", ctx, int64(7), "sess-1").Return(nil)
+
+		err := service.EndSession(ctx, token)
+
+		assert.NoError(t, err)
+		mockSessions.AssertExpectations(t)
+	})
+
+	t.Run("token mal formatado", func(t *testing.T) {
+		service := NewLogoutService(nil, nil, new(mockLogin.MockSession))
+
+		err := service.EndSession(ctx, "invalido")
+
+		assert.ErrorIs(t, err, errMsg.ErrRefreshTokenInvalid)
+	})
+
+	t.Run("sessão inexistente", func(t *testing.T) {
+		mockSessions := new(mockLogin.MockSession)
+		service := NewLogoutService(nil, nil, mockSessions)
+
+		mockSessions.On("GetSession", ctx, "sess-1").Return(nil, errMsg.ErrNotFound)
+
+		err := service.EndSession(ctx, "sess-1.segredo")
+
+		assert.ErrorIs(t, err, errMsg.ErrRefreshTokenInvalid)
+	})
+
+	t.Run("token que não é o vigente não encerra a sessão", func(t *testing.T) {
+		mockSessions := new(mockLogin.MockSession)
+		service := NewLogoutService(nil, nil, mockSessions)
+		session, _ := newSession(t)
+
+		mockSessions.On("GetSession", ctx, "sess-1").Return(session, nil)
+
+		err := service.EndSession(ctx, "sess-1.outro-segredo")
+
+		assert.ErrorIs(t, err, errMsg.ErrRefreshTokenInvalid)
+		mockSessions.AssertNotCalled(t, "DeleteSession", ctx, int64(7), "sess-1")
+	})
+
+	t.Run("erro no armazenamento", func(t *testing.T) {
+		mockSessions := new(mockLogin.MockSession)
+		service := NewLogoutService(nil, nil, mockSessions)
+		session, token := newSession(t)
+
+		mockSessions.On("GetSession", ctx, "sess-1").Return(session, nil)
+		mockSessions.On("DeleteSession", ctx, int64(7), "sess-1").Return(errors.New("redis down"))
+
+		err := service.EndSession(ctx, token)
+
+		assert.ErrorIs(t, err, errMsg.ErrSessionStore)
+	})
+}
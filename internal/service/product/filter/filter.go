@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/product/filter"
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
@@ -10,7 +11,7 @@ import (
 )
 
 // No serviço:
-func (s *productFilterService) Filter(ctx context.Context, filter *filter.ProductFilter) ([]*model.Product, error) {
+func (s *productFilterService) Filter(ctx context.Context, filter *filter.ProductFilter) (*commonFilter.Page[*model.Product], error) {
 	if filter == nil {
 		return nil, errMsg.ErrInvalidFilter
 	}
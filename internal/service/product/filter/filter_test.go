@@ -132,9 +132,9 @@ func TestProductService_Filter(t *testing.T) {
 		result, err := service.Filter(context.Background(), validFilter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 2)
-		assert.Equal(t, "Produto A", result[0].ProductName)
-		assert.Equal(t, "Produto B", result[1].ProductName)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, "Produto A", result.Items[0].ProductName)
+		assert.Equal(t, "Produto B", result.Items[1].ProductName)
 		mockRepo.AssertExpectations(t)
 	})
 }
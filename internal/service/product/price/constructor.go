@@ -0,0 +1,13 @@
+package services
+
+import (
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/price"
+)
+
+type priceService struct {
+	repo repo.Price
+}
+
+func NewPriceService(repo repo.Price) PriceService {
+	return &priceService{repo: repo}
+}
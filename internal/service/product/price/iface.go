@@ -0,0 +1,8 @@
+package services
+
+import iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+
+type PriceService interface {
+	iface.PriceReader
+	iface.ScheduledPriceWriter
+}
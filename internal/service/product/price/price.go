@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *priceService) History(ctx context.Context, f *models.PriceFilter) (*commonFilter.Page[*models.ProductPrice], error) {
+	if f == nil {
+		return nil, errMsg.ErrInvalidFilter
+	}
+
+	if f.ProductID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	if err := f.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidFilter, err)
+	}
+
+	return s.repo.History(ctx, f)
+}
+
+// EffectiveAt responde quais preços valem para o produto no instante at,
+// que pode estar no futuro.
+func (s *priceService) EffectiveAt(ctx context.Context, productID int64, at time.Time) (*models.EffectivePrice, error) {
+	if productID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	if at.IsZero() {
+		return nil, fmt.Errorf("%w: data obrigatória", errMsg.ErrInvalidFilter)
+	}
+
+	return s.repo.EffectiveAt(ctx, productID, at)
+}
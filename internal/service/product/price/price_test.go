@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newPriceService() (PriceService, *mockProduct.PriceMock) {
+	repo := new(mockProduct.PriceMock)
+	return NewPriceService(repo), repo
+}
+
+func TestPriceService_History(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("falha quando filtro é nulo", func(t *testing.T) {
+		service, repo := newPriceService()
+
+		_, err := service.History(ctx, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		repo.AssertNotCalled(t, "History", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha sem produto", func(t *testing.T) {
+		service, _ := newPriceService()
+
+		_, err := service.History(ctx, &models.PriceFilter{})
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("falha com origem inválida", func(t *testing.T) {
+		service, repo := newPriceService()
+
+		_, err := service.History(ctx, &models.PriceFilter{ProductID: 1, Source: "promo"})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		repo.AssertNotCalled(t, "History", mock.Anything, mock.Anything)
+	})
+
+	t.Run("retorna a página do repositório", func(t *testing.T) {
+		service, repo := newPriceService()
+		f := &models.PriceFilter{ProductID: 1}
+		page := &commonFilter.Page[*models.ProductPrice]{Items: []*models.ProductPrice{{ID: 1}}, Total: 1}
+		repo.On("History", ctx, f).Return(page, nil).Once()
+
+		result, err := service.History(ctx, f)
+
+		assert.NoError(t, err)
+		assert.Equal(t, page, result)
+	})
+}
+
+func TestPriceService_EffectiveAt(t *testing.T) {
+	ctx := context.Background()
+	at := time.Now()
+
+	t.Run("falha sem produto", func(t *testing.T) {
+		service, _ := newPriceService()
+
+		_, err := service.EffectiveAt(ctx, 0, at)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("falha sem data", func(t *testing.T) {
+		service, _ := newPriceService()
+
+		_, err := service.EffectiveAt(ctx, 1, time.Time{})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+	})
+
+	t.Run("consulta o repositório", func(t *testing.T) {
+		service, repo := newPriceService()
+		repo.On("EffectiveAt", ctx, int64(1), at).Return(&models.EffectivePrice{ProductID: 1, SalePrice: money.MustParse("9.90")}, nil).Once()
+
+		price, err := service.EffectiveAt(ctx, 1, at)
+
+		assert.NoError(t, err)
+		assert.Equal(t, money.MustParse("9.90"), price.SalePrice)
+	})
+}
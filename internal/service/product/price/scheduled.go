@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+// Schedule agenda uma mudança de preço. A data precisa estar no futuro;
+// mudanças imediatas são feitas na atualização do produto.
+func (s *priceService) Schedule(ctx context.Context, change *models.ScheduledPrice) (*models.ScheduledPrice, error) {
+	if change == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if err := change.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	if !change.EffectiveFrom.After(time.Now()) {
+		return nil, fmt.Errorf("%w: effective_from deve estar no futuro", errMsg.ErrInvalidData)
+	}
+
+	change.Status = models.StatusPending
+	change.AppliedAt = nil
+
+	return s.repo.Schedule(ctx, change)
+}
+
+func (s *priceService) ListScheduled(ctx context.Context, productID int64, status string) ([]*models.ScheduledPrice, error) {
+	if productID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	if status != "" && !models.IsValidStatus(status) {
+		return nil, fmt.Errorf("%w: status inválido", errMsg.ErrInvalidFilter)
+	}
+
+	return s.repo.ListScheduled(ctx, productID, status)
+}
+
+func (s *priceService) CancelScheduled(ctx context.Context, id int64) (*models.ScheduledPrice, error) {
+	if id <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	return s.repo.CancelScheduled(ctx, id)
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPriceService_Schedule(t *testing.T) {
+	ctx := context.Background()
+	price := money.New(12)
+
+	t.Run("falha quando a mudança é nula", func(t *testing.T) {
+		service, _ := newPriceService()
+
+		_, err := service.Schedule(ctx, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("falha sem preço", func(t *testing.T) {
+		service, repo := newPriceService()
+
+		_, err := service.Schedule(ctx, &models.ScheduledPrice{ProductID: 1, EffectiveFrom: time.Now().Add(time.Hour)})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		repo.AssertNotCalled(t, "Schedule", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha com data no passado", func(t *testing.T) {
+		service, repo := newPriceService()
+
+		_, err := service.Schedule(ctx, &models.ScheduledPrice{ProductID: 1, SalePrice: &price, EffectiveFrom: time.Now().Add(-time.Minute)})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		repo.AssertNotCalled(t, "Schedule", mock.Anything, mock.Anything)
+	})
+
+	t.Run("grava como pendente", func(t *testing.T) {
+		service, repo := newPriceService()
+		applied := time.Now()
+		change := &models.ScheduledPrice{ProductID: 1, SalePrice: &price, EffectiveFrom: time.Now().Add(time.Hour), Status: "applied", AppliedAt: &applied}
+		repo.On("Schedule", ctx, mock.MatchedBy(func(c *models.ScheduledPrice) bool {
+			return c.Status == models.StatusPending && c.AppliedAt == nil
+		})).Return(change, nil).Once()
+
+		_, err := service.Schedule(ctx, change)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestPriceService_ListScheduled(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("falha sem produto", func(t *testing.T) {
+		service, _ := newPriceService()
+
+		_, err := service.ListScheduled(ctx, 0, "")
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("falha com status inválido", func(t *testing.T) {
+		service, _ := newPriceService()
+
+		_, err := service.ListScheduled(ctx, 1, "done")
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+	})
+
+	t.Run("lista pelo repositório", func(t *testing.T) {
+		service, repo := newPriceService()
+		repo.On("ListScheduled", ctx, int64(1), "pending").Return([]*models.ScheduledPrice{{ID: 3}}, nil).Once()
+
+		changes, err := service.ListScheduled(ctx, 1, "pending")
+
+		assert.NoError(t, err)
+		assert.Len(t, changes, 1)
+	})
+}
+
+func TestPriceService_CancelScheduled(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("falha com ID inválido", func(t *testing.T) {
+		service, _ := newPriceService()
+
+		_, err := service.CancelScheduled(ctx, 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("cancela pelo repositório", func(t *testing.T) {
+		service, repo := newPriceService()
+		repo.On("CancelScheduled", ctx, int64(3)).Return(&models.ScheduledPrice{ID: 3, Status: models.StatusCanceled}, nil).Once()
+
+		change, err := service.CancelScheduled(ctx, 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusCanceled, change.Status)
+	})
+}
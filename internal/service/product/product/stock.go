@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	modelsLocation "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 )
 
@@ -59,14 +60,14 @@ func (s *productService) DecreaseStock(ctx context.Context, id int64, amount int
 	return nil
 }
 
-func (s *productService) GetStock(ctx context.Context, id int64) (int, error) {
+func (s *productService) GetStock(ctx context.Context, id int64) (*modelsLocation.ProductStock, error) {
 	if id <= 0 {
-		return 0, errMsg.ErrZeroID
+		return nil, errMsg.ErrZeroID
 	}
 
 	stock, err := s.repo.GetStock(ctx, id)
 	if err != nil {
-		return 0, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
 	}
 
 	return stock, nil
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	modelsLocation "github.com/WagaoCarvalho/backend_store_go/internal/model/inventory/location"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -172,12 +173,12 @@ func TestProductService_GetStock(t *testing.T) {
 
 		service := productService{repo: repoMock}
 
-		repoMock.On("GetStock", ctx, int64(1)).Return(0, fmt.Errorf("erro inesperado"))
+		repoMock.On("GetStock", ctx, int64(1)).Return(nil, fmt.Errorf("erro inesperado"))
 
 		stock, err := service.GetStock(ctx, 1)
 
 		assert.Error(t, err)
-		assert.Equal(t, 0, stock)
+		assert.Nil(t, stock)
 		assert.ErrorIs(t, err, errMsg.ErrGet)
 		repoMock.AssertExpectations(t)
 	})
@@ -188,7 +189,7 @@ func TestProductService_GetStock(t *testing.T) {
 
 		stock, err := service.GetStock(ctx, 0)
 
-		assert.Equal(t, 0, stock)
+		assert.Nil(t, stock)
 		assert.ErrorIs(t, err, errMsg.ErrZeroID)
 		repoMock.AssertNotCalled(t, "GetStock")
 	})
@@ -198,12 +199,12 @@ func TestProductService_GetStock(t *testing.T) {
 
 		service := productService{repo: repoMock}
 
-		repoMock.On("GetStock", ctx, int64(1)).Return(25, nil)
+		repoMock.On("GetStock", ctx, int64(1)).Return(&modelsLocation.ProductStock{ProductID: 1, Total: 25}, nil)
 
 		stock, err := service.GetStock(ctx, 1)
 
 		assert.NoError(t, err)
-		assert.Equal(t, 25, stock)
+		assert.Equal(t, 25, stock.Total)
 		repoMock.AssertExpectations(t)
 	})
 }
@@ -8,6 +8,7 @@ import (
 	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -20,8 +21,8 @@ func TestProductService_Create(t *testing.T) {
 			ProductName:   "Produto Teste",
 			Manufacturer:  "Fabricante X",
 			SupplierID:    utils.Int64Ptr(1),
-			CostPrice:     10.0,
-			SalePrice:     15.0,
+			CostPrice:     money.New(10),
+			SalePrice:     money.New(15),
 			StockQuantity: 5,
 			Status:        true,
 		}
@@ -106,8 +107,8 @@ func TestProductService_Update(t *testing.T) {
 			ProductName:   "Produto Atualizado",
 			Manufacturer:  "Fabricante X",
 			SupplierID:    utils.Int64Ptr(1),
-			CostPrice:     10.0,
-			SalePrice:     15.0,
+			CostPrice:     money.New(10),
+			SalePrice:     money.New(15),
 			StockQuantity: 5,
 			Status:        true,
 			Version:       1,
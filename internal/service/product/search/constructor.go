@@ -0,0 +1,13 @@
+package services
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/search"
+
+type productSearchService struct {
+	repo repo.ProductSearch
+}
+
+func NewProductSearchService(repo repo.ProductSearch) ProductSearch {
+	return &productSearchService{
+		repo: repo,
+	}
+}
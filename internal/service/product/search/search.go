@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	search "github.com/WagaoCarvalho/backend_store_go/internal/model/product/search"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *productSearchService) Search(ctx context.Context, query *search.ProductSearch) ([]*search.Result, error) {
+	if err := prepare(query, search.DefaultLimit); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Search(ctx, query)
+}
+
+// Autocomplete usa um limite menor, pois alimenta a caixa de busca do PDV.
+func (s *productSearchService) Autocomplete(ctx context.Context, query *search.ProductSearch) ([]*search.Suggestion, error) {
+	if err := prepare(query, search.DefaultAutocompleteLimit); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Autocomplete(ctx, query)
+}
+
+func prepare(query *search.ProductSearch, defaultLimit int) error {
+	if query == nil {
+		return errMsg.ErrInvalidFilter
+	}
+
+	query.Normalize(defaultLimit)
+	if err := query.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrInvalidFilter, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	search "github.com/WagaoCarvalho/backend_store_go/internal/model/product/search"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProductSearchService_Search(t *testing.T) {
+	setup := func() (*mockProduct.ProductSearchMock, ProductSearch) {
+		mockRepo := new(mockProduct.ProductSearchMock)
+		return mockRepo, NewProductSearchService(mockRepo)
+	}
+
+	t.Run("falha quando busca é nula", func(t *testing.T) {
+		mockRepo, service := setup()
+
+		result, err := service.Search(context.Background(), nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha com termo curto demais", func(t *testing.T) {
+		mockRepo, service := setup()
+
+		result, err := service.Search(context.Background(), &search.ProductSearch{Query: "  a "})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		assert.Contains(t, err.Error(), "q")
+		mockRepo.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+
+	t.Run("normaliza termo e limite antes de consultar", func(t *testing.T) {
+		mockRepo, service := setup()
+
+		expected := []*search.Result{{Product: &models.Product{ID: 1, ProductName: "Café"}, Rank: 0.9}}
+		mockRepo.On("Search", mock.Anything, &search.ProductSearch{Query: "café", Limit: search.DefaultLimit}).
+			Return(expected, nil).Once()
+
+		result, err := service.Search(context.Background(), &search.ProductSearch{Query: " café "})
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("limita ao máximo permitido", func(t *testing.T) {
+		mockRepo, service := setup()
+
+		mockRepo.On("Search", mock.Anything, &search.ProductSearch{Query: "arroz", Limit: search.MaxLimit}).
+			Return([]*search.Result{}, nil).Once()
+
+		_, err := service.Search(context.Background(), &search.ProductSearch{Query: "arroz", Limit: 500})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("propaga erro do repositório", func(t *testing.T) {
+		mockRepo, service := setup()
+
+		mockRepo.On("Search", mock.Anything, mock.Anything).Return(nil, errMsg.ErrGet).Once()
+
+		result, err := service.Search(context.Background(), &search.ProductSearch{Query: "arroz"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestProductSearchService_Autocomplete(t *testing.T) {
+	t.Run("usa o limite padrão do autocomplete", func(t *testing.T) {
+		mockRepo := new(mockProduct.ProductSearchMock)
+		service := NewProductSearchService(mockRepo)
+
+		expected := []*search.Suggestion{{ID: 2, ProductName: "Arroz", SalePrice: money.New(25), StockQuantity: 8}}
+		mockRepo.On("Autocomplete", mock.Anything, &search.ProductSearch{Query: "arr", Limit: search.DefaultAutocompleteLimit}).
+			Return(expected, nil).Once()
+
+		result, err := service.Autocomplete(context.Background(), &search.ProductSearch{Query: "arr"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("falha com termo inválido", func(t *testing.T) {
+		mockRepo := new(mockProduct.ProductSearchMock)
+		service := NewProductSearchService(mockRepo)
+
+		result, err := service.Autocomplete(context.Background(), &search.ProductSearch{Query: ""})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "Autocomplete", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,13 @@
+package services
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/product/stock_alert"
+
+type stockAlertService struct {
+	repo repo.StockAlert
+}
+
+func NewStockAlertService(repo repo.StockAlert) StockAlert {
+	return &stockAlertService{
+		repo: repo,
+	}
+}
@@ -0,0 +1,14 @@
+package services
+
+import (
+	"context"
+
+	iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_alert"
+)
+
+type StockAlert interface {
+	iface.StockAlertReader
+
+	Replenishment(ctx context.Context, q *models.ReplenishmentQuery) ([]*models.SupplierReplenishment, error)
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_alert"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *stockAlertService) List(ctx context.Context, f *models.StockAlertFilter) (*commonFilter.Page[*models.StockAlert], error) {
+	if f == nil {
+		return nil, errMsg.ErrInvalidFilter
+	}
+
+	if err := f.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidFilter, err)
+	}
+
+	return s.repo.List(ctx, f)
+}
+
+func (s *stockAlertService) LowStock(ctx context.Context, supplierID *int64) ([]*models.LowStockItem, error) {
+	if supplierID != nil && *supplierID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	return s.repo.LowStock(ctx, supplierID)
+}
+
+// Replenishment sugere o pedido de cada fornecedor; produtos cuja projeção
+// não fica abaixo do mínimo são descartados.
+func (s *stockAlertService) Replenishment(ctx context.Context, q *models.ReplenishmentQuery) ([]*models.SupplierReplenishment, error) {
+	if q == nil {
+		return nil, errMsg.ErrInvalidFilter
+	}
+
+	if q.SupplierID != nil && *q.SupplierID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	if err := q.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidFilter, err)
+	}
+
+	candidates, err := s.repo.ReplenishmentCandidates(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*models.ReplenishmentItem, 0, len(candidates))
+	for _, item := range candidates {
+		if item.Suggest(q.WindowDays, q.LeadDays) {
+			items = append(items, item)
+		}
+	}
+
+	return models.GroupBySupplier(items), nil
+}
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_alert"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStockAlertService_List(t *testing.T) {
+	setup := func() (*mockProduct.StockAlertMock, StockAlert) {
+		mockRepo := new(mockProduct.StockAlertMock)
+		return mockRepo, NewStockAlertService(mockRepo)
+	}
+
+	t.Run("falha quando filtro é nulo", func(t *testing.T) {
+		mockRepo, service := setup()
+
+		result, err := service.List(context.Background(), nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha com status inválido", func(t *testing.T) {
+		mockRepo, service := setup()
+
+		_, err := service.List(context.Background(), &models.StockAlertFilter{Status: "closed"})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+	})
+
+	t.Run("retorna a página do repositório", func(t *testing.T) {
+		mockRepo, service := setup()
+
+		f := &models.StockAlertFilter{Status: models.StatusOpen}
+		page := &commonFilter.Page[*models.StockAlert]{Items: []*models.StockAlert{{ID: 1}}, Total: 1}
+		mockRepo.On("List", mock.Anything, f).Return(page, nil).Once()
+
+		result, err := service.List(context.Background(), f)
+
+		assert.NoError(t, err)
+		assert.Equal(t, page, result)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestStockAlertService_LowStock(t *testing.T) {
+	t.Run("falha com fornecedor inválido", func(t *testing.T) {
+		mockRepo := new(mockProduct.StockAlertMock)
+		service := NewStockAlertService(mockRepo)
+		zero := int64(0)
+
+		_, err := service.LowStock(context.Background(), &zero)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+		mockRepo.AssertNotCalled(t, "LowStock", mock.Anything, mock.Anything)
+	})
+
+	t.Run("propaga o resultado do repositório", func(t *testing.T) {
+		mockRepo := new(mockProduct.StockAlertMock)
+		service := NewStockAlertService(mockRepo)
+		items := []*models.LowStockItem{{ProductID: 1, StockQuantity: 1, MinStock: 5}}
+		mockRepo.On("LowStock", mock.Anything, (*int64)(nil)).Return(items, nil).Once()
+
+		result, err := service.LowStock(context.Background(), nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, items, result)
+	})
+}
+
+func TestStockAlertService_Replenishment(t *testing.T) {
+	maxStock := 50
+	supplierID := int64(3)
+
+	t.Run("falha com período inválido", func(t *testing.T) {
+		mockRepo := new(mockProduct.StockAlertMock)
+		service := NewStockAlertService(mockRepo)
+
+		_, err := service.Replenishment(context.Background(), &models.ReplenishmentQuery{WindowDays: 0})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "ReplenishmentCandidates", mock.Anything, mock.Anything)
+	})
+
+	t.Run("descarta quem não precisa de reposição e agrupa por fornecedor", func(t *testing.T) {
+		mockRepo := new(mockProduct.StockAlertMock)
+		service := NewStockAlertService(mockRepo)
+
+		q := &models.ReplenishmentQuery{WindowDays: 30, LeadDays: 7}
+		mockRepo.On("ReplenishmentCandidates", mock.Anything, q).Return([]*models.ReplenishmentItem{
+			{ProductID: 1, SupplierID: &supplierID, SupplierName: "Alfa", StockQuantity: 20, MinStock: 10, MaxStock: &maxStock, SoldQuantity: 60, CostPrice: money.New(1)},
+			{ProductID: 2, SupplierID: &supplierID, SupplierName: "Alfa", StockQuantity: 45, MinStock: 10, MaxStock: &maxStock, SoldQuantity: 30},
+		}, nil).Once()
+
+		groups, err := service.Replenishment(context.Background(), q)
+
+		assert.NoError(t, err)
+		assert.Len(t, groups, 1)
+		assert.Len(t, groups[0].Items, 1)
+		assert.Equal(t, int64(1), groups[0].Items[0].ProductID)
+		assert.Equal(t, 44, groups[0].TotalQuantity)
+		assert.Equal(t, money.New(44), groups[0].TotalCost)
+	})
+
+	t.Run("propaga erro do repositório", func(t *testing.T) {
+		mockRepo := new(mockProduct.StockAlertMock)
+		service := NewStockAlertService(mockRepo)
+		mockRepo.On("ReplenishmentCandidates", mock.Anything, mock.Anything).Return(nil, errMsg.ErrGet).Once()
+
+		_, err := service.Replenishment(context.Background(), &models.ReplenishmentQuery{WindowDays: 30, LeadDays: 7})
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
@@ -0,0 +1,13 @@
+package services
+
+import iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+
+type stockMovementService struct {
+	repo iface.StockMovementReader
+}
+
+func NewStockMovementService(repo iface.StockMovementReader) iface.StockMovementReader {
+	return &stockMovementService{
+		repo: repo,
+	}
+}
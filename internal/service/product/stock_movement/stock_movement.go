@@ -0,0 +1,40 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *stockMovementService) History(ctx context.Context, f *models.StockMovementFilter) (*commonFilter.Page[*models.StockMovement], error) {
+	if f == nil {
+		return nil, errMsg.ErrInvalidFilter
+	}
+
+	if f.ProductID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	if err := f.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidFilter, err)
+	}
+
+	return s.repo.History(ctx, f)
+}
+
+// StockAt responde qual era o saldo do produto no instante at.
+func (s *stockMovementService) StockAt(ctx context.Context, productID int64, at time.Time) (*models.StockAt, error) {
+	if productID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	if at.IsZero() {
+		return nil, fmt.Errorf("%w: data obrigatória", errMsg.ErrInvalidFilter)
+	}
+
+	return s.repo.StockAt(ctx, productID, at)
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStockMovementService_History(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("falha quando filtro é nulo", func(t *testing.T) {
+		mockRepo := new(mockProduct.StockMovementMock)
+		service := NewStockMovementService(mockRepo)
+
+		_, err := service.History(ctx, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "History", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha sem produto", func(t *testing.T) {
+		mockRepo := new(mockProduct.StockMovementMock)
+		service := NewStockMovementService(mockRepo)
+
+		_, err := service.History(ctx, &models.StockMovementFilter{})
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("falha com motivo inválido", func(t *testing.T) {
+		mockRepo := new(mockProduct.StockMovementMock)
+		service := NewStockMovementService(mockRepo)
+
+		_, err := service.History(ctx, &models.StockMovementFilter{ProductID: 1, Reason: "loss"})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "History", mock.Anything, mock.Anything)
+	})
+
+	t.Run("retorna a página do repositório", func(t *testing.T) {
+		mockRepo := new(mockProduct.StockMovementMock)
+		service := NewStockMovementService(mockRepo)
+
+		f := &models.StockMovementFilter{ProductID: 1}
+		page := &commonFilter.Page[*models.StockMovement]{Items: []*models.StockMovement{{ID: 1}}, Total: 1}
+		mockRepo.On("History", ctx, f).Return(page, nil).Once()
+
+		result, err := service.History(ctx, f)
+
+		assert.NoError(t, err)
+		assert.Equal(t, page, result)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestStockMovementService_StockAt(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("falha com ID inválido", func(t *testing.T) {
+		service := NewStockMovementService(new(mockProduct.StockMovementMock))
+
+		_, err := service.StockAt(ctx, 0, at)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("falha sem data", func(t *testing.T) {
+		service := NewStockMovementService(new(mockProduct.StockMovementMock))
+
+		_, err := service.StockAt(ctx, 1, time.Time{})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+	})
+
+	t.Run("delega ao repositório", func(t *testing.T) {
+		mockRepo := new(mockProduct.StockMovementMock)
+		service := NewStockMovementService(mockRepo)
+
+		expected := &models.StockAt{ProductID: 1, At: at, Quantity: 4}
+		mockRepo.On("StockAt", ctx, int64(1), at).Return(expected, nil).Once()
+
+		result, err := service.StockAt(ctx, 1, at)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+		mockRepo.AssertExpectations(t)
+	})
+}
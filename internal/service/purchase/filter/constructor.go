@@ -0,0 +1,13 @@
+package services
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/purchase/filter"
+
+type purchaseOrderFilterService struct {
+	repo repo.PurchaseOrderFilter
+}
+
+func NewPurchaseOrderFilterService(repo repo.PurchaseOrderFilter) PurchaseOrderFilter {
+	return &purchaseOrderFilterService{
+		repo: repo,
+	}
+}
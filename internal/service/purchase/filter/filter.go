@@ -0,0 +1,28 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/filter"
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *purchaseOrderFilterService) Filter(ctx context.Context, filter *filter.PurchaseOrderFilter) (*commonFilter.Page[*model.PurchaseOrder], error) {
+	if filter == nil {
+		return nil, fmt.Errorf("%w: filtro não pode ser nulo", errMsg.ErrInvalidFilter)
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidFilter, err)
+	}
+
+	orders, err := s.repo.Filter(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return orders, nil
+}
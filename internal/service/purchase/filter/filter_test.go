@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockPurchase "github.com/WagaoCarvalho/backend_store_go/infra/mock/purchase"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	purchaseFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/filter"
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPurchaseOrderFilterService_Filter(t *testing.T) {
+	t.Run("falha quando filtro é nulo", func(t *testing.T) {
+		mockRepo := new(mockPurchase.MockPurchaseOrder)
+		service := NewPurchaseOrderFilterService(mockRepo)
+
+		result, err := service.Filter(context.Background(), nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "Filter", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha na validação do filtro", func(t *testing.T) {
+		mockRepo := new(mockPurchase.MockPurchaseOrder)
+		service := NewPurchaseOrderFilterService(mockRepo)
+
+		invalidFilter := &purchaseFilter.PurchaseOrderFilter{
+			BaseFilter: filter.BaseFilter{Limit: 10},
+			Status:     "approved",
+		}
+
+		result, err := service.Filter(context.Background(), invalidFilter)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "Filter", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha ao buscar no repositório", func(t *testing.T) {
+		mockRepo := new(mockPurchase.MockPurchaseOrder)
+		service := NewPurchaseOrderFilterService(mockRepo)
+
+		validFilter := &purchaseFilter.PurchaseOrderFilter{BaseFilter: filter.BaseFilter{Limit: 10}}
+		mockRepo.On("Filter", mock.Anything, validFilter).Return(nil, errors.New("falha no banco de dados")).Once()
+
+		result, err := service.Filter(context.Background(), validFilter)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("sucesso ao retornar lista de pedidos", func(t *testing.T) {
+		mockRepo := new(mockPurchase.MockPurchaseOrder)
+		service := NewPurchaseOrderFilterService(mockRepo)
+
+		validFilter := &purchaseFilter.PurchaseOrderFilter{
+			BaseFilter: filter.BaseFilter{Limit: 10},
+			Status:     model.StatusSent,
+		}
+		orders := []*model.PurchaseOrder{
+			{ID: 1, SupplierID: 4, Status: model.StatusSent},
+			{ID: 2, SupplierID: 5, Status: model.StatusSent},
+		}
+		mockRepo.On("Filter", mock.Anything, validFilter).Return(orders, nil).Once()
+
+		result, err := service.Filter(context.Background(), validFilter)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, int64(2), result.Items[1].ID)
+		mockRepo.AssertExpectations(t)
+	})
+}
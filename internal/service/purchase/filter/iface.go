@@ -0,0 +1,7 @@
+package services
+
+import iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/purchase"
+
+type PurchaseOrderFilter interface {
+	iface.PurchaseOrderFilter
+}
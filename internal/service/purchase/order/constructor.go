@@ -0,0 +1,36 @@
+package services
+
+import (
+	ifaceProduct "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	ifacePurchase "github.com/WagaoCarvalho/backend_store_go/internal/iface/purchase"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/purchase/order"
+)
+
+type purchaseOrderService struct {
+	repo          repo.PurchaseOrder
+	repoReceipt   ifacePurchase.PurchaseReceiptReader
+	repoOrderTx   ifacePurchase.PurchaseOrderTx
+	repoReceiptTx ifacePurchase.PurchaseReceiptTx
+	repoStockTx   ifaceProduct.ProductReceiveTx
+	costMethod    string
+}
+
+// NewPurchaseOrderService recebe em costMethod como o recebimento atualiza
+// o custo dos produtos ("latest" ou "average", ver config.Purchase).
+func NewPurchaseOrderService(
+	repo repo.PurchaseOrder,
+	repoReceipt ifacePurchase.PurchaseReceiptReader,
+	repoOrderTx ifacePurchase.PurchaseOrderTx,
+	repoReceiptTx ifacePurchase.PurchaseReceiptTx,
+	repoStockTx ifaceProduct.ProductReceiveTx,
+	costMethod string,
+) PurchaseOrderService {
+	return &purchaseOrderService{
+		repo:          repo,
+		repoReceipt:   repoReceipt,
+		repoOrderTx:   repoOrderTx,
+		repoReceiptTx: repoReceiptTx,
+		repoStockTx:   repoStockTx,
+		costMethod:    costMethod,
+	}
+}
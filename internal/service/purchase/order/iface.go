@@ -0,0 +1,23 @@
+package services
+
+import (
+	"context"
+
+	ifacePurchase "github.com/WagaoCarvalho/backend_store_go/internal/iface/purchase"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	modelsReceipt "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/receipt"
+)
+
+type PurchaseOrderService interface {
+	ifacePurchase.PurchaseOrderReader
+
+	Create(ctx context.Context, order *models.PurchaseOrder) (*models.PurchaseOrder, error)
+	Update(ctx context.Context, order *models.PurchaseOrder) error
+	Delete(ctx context.Context, id int64) error
+
+	Send(ctx context.Context, id int64) error
+	Cancel(ctx context.Context, id int64) error
+
+	Receive(ctx context.Context, receipt *modelsReceipt.PurchaseReceipt) (*modelsReceipt.PurchaseReceipt, error)
+	GetReceipts(ctx context.Context, orderID int64) ([]*modelsReceipt.PurchaseReceipt, error)
+}
@@ -0,0 +1,27 @@
+package services
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	modelsReceipt "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/receipt"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *purchaseOrderService) GetByID(ctx context.Context, id int64) (*models.PurchaseOrder, error) {
+	if id <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	return s.repo.GetByID(ctx, id)
+}
+
+// GetReceipts retorna o histórico de recebimentos do pedido, do mais antigo
+// para o mais recente.
+func (s *purchaseOrderService) GetReceipts(ctx context.Context, orderID int64) ([]*modelsReceipt.PurchaseReceipt, error) {
+	if orderID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	return s.repoReceipt.GetByOrderID(ctx, orderID)
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	modelsReceipt "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/receipt"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPurchaseOrderService_GetByID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newPurchaseService("latest")
+
+		result, err := svc.GetByID(ctx, 0)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		order := &models.PurchaseOrder{ID: 1, SupplierID: 4}
+		m.repo.On("GetByID", ctx, int64(1)).Return(order, nil).Once()
+
+		result, err := svc.GetByID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, order, result)
+	})
+}
+
+func TestPurchaseOrderService_GetReceipts(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newPurchaseService("latest")
+
+		result, err := svc.GetReceipts(ctx, 0)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		receipts := []*modelsReceipt.PurchaseReceipt{{ID: 1, PurchaseOrderID: 1}}
+		m.receipt.On("GetByOrderID", ctx, int64(1)).Return(receipts, nil).Once()
+
+		result, err := svc.GetReceipts(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, receipts, result)
+	})
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	modelsReceipt "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/receipt"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// Receive registra a entrada de mercadorias de um pedido enviado. Na mesma
+// transação acumula o recebido em cada linha, soma ao estoque do local de
+// entrada, atualiza o custo do produto, grava o recebimento e recalcula o status do pedido.
+func (s *purchaseOrderService) Receive(ctx context.Context, receipt *modelsReceipt.PurchaseReceipt) (*modelsReceipt.PurchaseReceipt, error) {
+	if receipt == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if err := receipt.ValidateStructural(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	receipt.CostMethod = s.costMethod
+
+	var created *modelsReceipt.PurchaseReceipt
+
+	err := repoDb.RunInTx(ctx, s.repoOrderTx, func(tx pgx.Tx) error {
+		order, err := s.repoOrderTx.GetByIDForUpdateTx(ctx, tx, receipt.PurchaseOrderID)
+		if err != nil {
+			return err
+		}
+
+		if !order.Receivable() {
+			return fmt.Errorf("%w: somente pedidos enviados podem ser recebidos", errMsg.ErrInvalidData)
+		}
+
+		if err := s.applyReceiptTx(ctx, tx, order, receipt); err != nil {
+			return err
+		}
+
+		created, err = s.repoReceiptTx.CreateTx(ctx, tx, receipt)
+		if err != nil {
+			return err
+		}
+
+		order.Status = order.ReceivedStatus()
+		return s.repoOrderTx.UpdateStatusTx(ctx, tx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// applyReceiptTx confere cada linha recebida contra o pedido e dá entrada no
+// estoque. Sem custo informado, vale o custo combinado na linha do pedido.
+func (s *purchaseOrderService) applyReceiptTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	order *models.PurchaseOrder,
+	receipt *modelsReceipt.PurchaseReceipt,
+) error {
+	lines := make(map[int64]*models.PurchaseOrderItem, len(order.Items))
+	for i := range order.Items {
+		lines[order.Items[i].ID] = &order.Items[i]
+	}
+	origin := modelsMovement.NewOrigin(modelsMovement.ReasonReceipt, modelsMovement.RefPurchaseOrder, order.ID)
+
+	for i := range receipt.Items {
+		item := &receipt.Items[i]
+
+		line, ok := lines[item.PurchaseOrderItemID]
+		if !ok {
+			return fmt.Errorf("%w: item %d não pertence ao pedido", errMsg.ErrInvalidData, item.PurchaseOrderItemID)
+		}
+
+		if item.Quantity > line.Remaining() {
+			return fmt.Errorf("%w: item %d aceita no máximo %d unidades", errMsg.ErrInvalidQuantity, line.ID, line.Remaining())
+		}
+
+		if item.UnitCost == nil {
+			cost := line.UnitCost
+			item.UnitCost = &cost
+		}
+		item.ProductID = line.ProductID
+
+		if err := s.repoOrderTx.ReceiveItemTx(ctx, tx, line.ID, item.Quantity); err != nil {
+			return err
+		}
+
+		if err := s.repoStockTx.ReceiveStockAtTx(ctx, tx, line.ProductID, receipt.LocationID, item.Quantity, *item.UnitCost, s.costMethod, origin); err != nil {
+			return err
+		}
+
+		line.ReceivedQuantity += item.Quantity
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	modelsReceipt "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/receipt"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func sentOrder() *models.PurchaseOrder {
+	return &models.PurchaseOrder{
+		ID:     1,
+		Status: models.StatusSent,
+		Items: []models.PurchaseOrderItem{
+			{ID: 10, ProductID: 7, Quantity: 5, UnitCost: money.New(10)},
+			{ID: 11, ProductID: 8, Quantity: 2, ReceivedQuantity: 1, UnitCost: money.New(4)},
+		},
+	}
+}
+
+func TestPurchaseOrderService_Receive(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("recebimento nil", func(t *testing.T) {
+		svc, _ := newPurchaseService("latest")
+
+		result, err := svc.Receive(ctx, nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("recebimento sem linhas", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+
+		result, err := svc.Receive(ctx, &modelsReceipt.PurchaseReceipt{PurchaseOrderID: 1})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.orderTx.AssertNotCalled(t, "BeginTx", ctx)
+	})
+
+	t.Run("pedido em rascunho não pode ser recebido", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		receipt := &modelsReceipt.PurchaseReceipt{
+			PurchaseOrderID: 1,
+			LocationID:      3,
+			Items:           []modelsReceipt.PurchaseReceiptItem{{PurchaseOrderItemID: 10, Quantity: 1}},
+		}
+		order := sentOrder()
+		order.Status = models.StatusDraft
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(order, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.Receive(ctx, receipt)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("item que não pertence ao pedido", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		receipt := &modelsReceipt.PurchaseReceipt{
+			PurchaseOrderID: 1,
+			LocationID:      3,
+			Items:           []modelsReceipt.PurchaseReceiptItem{{PurchaseOrderItemID: 99, Quantity: 1}},
+		}
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(sentOrder(), nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.Receive(ctx, receipt)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("quantidade acima do pendente", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		receipt := &modelsReceipt.PurchaseReceipt{
+			PurchaseOrderID: 1,
+			LocationID:      3,
+			Items:           []modelsReceipt.PurchaseReceiptItem{{PurchaseOrderItemID: 11, Quantity: 2}},
+		}
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(sentOrder(), nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.Receive(ctx, receipt)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidQuantity)
+		m.stockTx.AssertNotCalled(t, "ReceiveStockAtTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("erro no estoque faz rollback", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		receipt := &modelsReceipt.PurchaseReceipt{
+			PurchaseOrderID: 1,
+			LocationID:      3,
+			Items:           []modelsReceipt.PurchaseReceiptItem{{PurchaseOrderItemID: 10, Quantity: 2}},
+		}
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(sentOrder(), nil).Once()
+		m.orderTx.On("ReceiveItemTx", ctx, m.tx, int64(10), 2).Return(nil).Once()
+		m.stockTx.On("ReceiveStockAtTx", ctx, m.tx, int64(7), int64(3), 2, money.New(10), "latest", mock.Anything).Return(errMsg.ErrNotFound).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.Receive(ctx, receipt)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+		m.receiptTx.AssertNotCalled(t, "CreateTx", mock.Anything, mock.Anything, mock.Anything)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("recebimento parcial usa custo informado e média ponderada", func(t *testing.T) {
+		svc, m := newPurchaseService("average")
+		cost := money.New(12)
+		receipt := &modelsReceipt.PurchaseReceipt{
+			PurchaseOrderID: 1,
+			LocationID:      3,
+			Items:           []modelsReceipt.PurchaseReceiptItem{{PurchaseOrderItemID: 10, Quantity: 2, UnitCost: &cost}},
+		}
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(sentOrder(), nil).Once()
+		m.orderTx.On("ReceiveItemTx", ctx, m.tx, int64(10), 2).Return(nil).Once()
+		m.stockTx.On("ReceiveStockAtTx", ctx, m.tx, int64(7), int64(3), 2, money.New(12), "average", mock.Anything).Return(nil).Once()
+		m.receiptTx.On("CreateTx", ctx, m.tx, receipt).Return(receipt, nil).Once()
+		m.orderTx.On("UpdateStatusTx", ctx, m.tx, mock.MatchedBy(func(o *models.PurchaseOrder) bool {
+			return o.Status == models.StatusPartiallyReceived
+		})).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		result, err := svc.Receive(ctx, receipt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "average", result.CostMethod)
+		assert.Equal(t, int64(7), result.Items[0].ProductID)
+		m.orderTx.AssertExpectations(t)
+		m.stockTx.AssertExpectations(t)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("recebimento total sem custo usa o custo do pedido", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		receipt := &modelsReceipt.PurchaseReceipt{
+			PurchaseOrderID: 1,
+			LocationID:      3,
+			Items: []modelsReceipt.PurchaseReceiptItem{
+				{PurchaseOrderItemID: 10, Quantity: 5},
+				{PurchaseOrderItemID: 11, Quantity: 1},
+			},
+		}
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(sentOrder(), nil).Once()
+		m.orderTx.On("ReceiveItemTx", ctx, m.tx, int64(10), 5).Return(nil).Once()
+		m.orderTx.On("ReceiveItemTx", ctx, m.tx, int64(11), 1).Return(nil).Once()
+		origin := modelsMovement.NewOrigin(modelsMovement.ReasonReceipt, modelsMovement.RefPurchaseOrder, 1)
+		m.stockTx.On("ReceiveStockAtTx", ctx, m.tx, int64(7), int64(3), 5, money.New(10), "latest", origin).Return(nil).Once()
+		m.stockTx.On("ReceiveStockAtTx", ctx, m.tx, int64(8), int64(3), 1, money.New(4), "latest", origin).Return(nil).Once()
+		m.receiptTx.On("CreateTx", ctx, m.tx, receipt).Return(receipt, nil).Once()
+		m.orderTx.On("UpdateStatusTx", ctx, m.tx, mock.MatchedBy(func(o *models.PurchaseOrder) bool {
+			return o.Status == models.StatusReceived
+		})).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		result, err := svc.Receive(ctx, receipt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, money.New(4), *result.Items[1].UnitCost)
+		m.orderTx.AssertExpectations(t)
+		m.stockTx.AssertExpectations(t)
+	})
+}
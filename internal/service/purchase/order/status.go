@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// Send envia o pedido ao fornecedor; a partir daqui ele não é mais editável
+// e passa a aceitar recebimentos.
+func (s *purchaseOrderService) Send(ctx context.Context, id int64) error {
+	return s.changeStatus(ctx, id, models.StatusSent, (*models.PurchaseOrder).Editable,
+		"somente pedidos em rascunho podem ser enviados")
+}
+
+// Cancel cancela o pedido enquanto nada tiver sido recebido.
+func (s *purchaseOrderService) Cancel(ctx context.Context, id int64) error {
+	return s.changeStatus(ctx, id, models.StatusCanceled, (*models.PurchaseOrder).Cancelable,
+		"somente pedidos sem recebimentos podem ser cancelados")
+}
+
+func (s *purchaseOrderService) changeStatus(
+	ctx context.Context,
+	id int64,
+	status string,
+	allowed func(*models.PurchaseOrder) bool,
+	reason string,
+) error {
+	if id <= 0 {
+		return errMsg.ErrZeroID
+	}
+
+	return repoDb.RunInTx(ctx, s.repoOrderTx, func(tx pgx.Tx) error {
+		order, err := s.repoOrderTx.GetByIDForUpdateTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if !allowed(order) {
+			return fmt.Errorf("%w: %s", errMsg.ErrInvalidData, reason)
+		}
+
+		order.Status = status
+		return s.repoOrderTx.UpdateStatusTx(ctx, tx, order)
+	})
+}
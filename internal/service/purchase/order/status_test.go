@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPurchaseOrderService_Send(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newPurchaseService("latest")
+
+		assert.ErrorIs(t, svc.Send(ctx, 0), errMsg.ErrZeroID)
+	})
+
+	t.Run("pedido inexistente", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		assert.ErrorIs(t, svc.Send(ctx, 1), errMsg.ErrNotFound)
+	})
+
+	t.Run("pedido já enviado", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.PurchaseOrder{ID: 1, Status: models.StatusSent}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		assert.ErrorIs(t, svc.Send(ctx, 1), errMsg.ErrInvalidData)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.PurchaseOrder{ID: 1, Status: models.StatusDraft}, nil).Once()
+		m.orderTx.On("UpdateStatusTx", ctx, m.tx, mock.MatchedBy(func(o *models.PurchaseOrder) bool {
+			return o.Status == models.StatusSent
+		})).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		assert.NoError(t, svc.Send(ctx, 1))
+		m.orderTx.AssertExpectations(t)
+	})
+}
+
+func TestPurchaseOrderService_Cancel(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pedido com recebimentos", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.PurchaseOrder{ID: 1, Status: models.StatusPartiallyReceived}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		assert.ErrorIs(t, svc.Cancel(ctx, 1), errMsg.ErrInvalidData)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.PurchaseOrder{ID: 1, Status: models.StatusSent}, nil).Once()
+		m.orderTx.On("UpdateStatusTx", ctx, m.tx, mock.MatchedBy(func(o *models.PurchaseOrder) bool {
+			return o.Status == models.StatusCanceled
+		})).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		assert.NoError(t, svc.Cancel(ctx, 1))
+		m.orderTx.AssertExpectations(t)
+	})
+}
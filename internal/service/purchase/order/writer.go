@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// Create grava o pedido como rascunho; o total é sempre recalculado a partir
+// das linhas.
+func (s *purchaseOrderService) Create(ctx context.Context, order *models.PurchaseOrder) (*models.PurchaseOrder, error) {
+	if order == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if err := order.ValidateStructural(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	order.Status = models.StatusDraft
+	order.CalculateTotal()
+
+	var created *models.PurchaseOrder
+
+	err := repoDb.RunInTx(ctx, s.repoOrderTx, func(tx pgx.Tx) error {
+		var err error
+		created, err = s.repoOrderTx.CreateTx(ctx, tx, order)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// Update substitui cabeçalho e linhas de um pedido ainda em rascunho.
+func (s *purchaseOrderService) Update(ctx context.Context, order *models.PurchaseOrder) error {
+	if order == nil {
+		return errMsg.ErrInvalidData
+	}
+
+	if order.ID <= 0 {
+		return errMsg.ErrZeroID
+	}
+
+	if order.Version <= 0 {
+		return fmt.Errorf("%w: versão obrigatória", errMsg.ErrInvalidData)
+	}
+
+	if err := order.ValidateStructural(); err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	order.CalculateTotal()
+
+	return repoDb.RunInTx(ctx, s.repoOrderTx, func(tx pgx.Tx) error {
+		current, err := s.repoOrderTx.GetByIDForUpdateTx(ctx, tx, order.ID)
+		if err != nil {
+			return err
+		}
+
+		if current.Version != order.Version {
+			return errMsg.ErrVersionConflict
+		}
+
+		if !current.Editable() {
+			return fmt.Errorf("%w: somente pedidos em rascunho podem ser alterados", errMsg.ErrInvalidData)
+		}
+
+		order.Status = current.Status
+		return s.repoOrderTx.UpdateTx(ctx, tx, order)
+	})
+}
+
+func (s *purchaseOrderService) Delete(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return errMsg.ErrZeroID
+	}
+
+	return repoDb.RunInTx(ctx, s.repoOrderTx, func(tx pgx.Tx) error {
+		current, err := s.repoOrderTx.GetByIDForUpdateTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if !current.Editable() {
+			return fmt.Errorf("%w: somente pedidos em rascunho podem ser excluídos", errMsg.ErrInvalidData)
+		}
+
+		return s.repoOrderTx.DeleteTx(ctx, tx, id)
+	})
+}
@@ -0,0 +1,220 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	mockPurchase "github.com/WagaoCarvalho/backend_store_go/infra/mock/purchase"
+	mockTX "github.com/WagaoCarvalho/backend_store_go/infra/mock/repo"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/purchase/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type purchaseMocks struct {
+	repo      *mockPurchase.MockPurchaseOrder
+	receipt   *mockPurchase.MockPurchaseReceipt
+	orderTx   *mockPurchase.MockPurchaseOrderTx
+	receiptTx *mockPurchase.MockPurchaseReceiptTx
+	stockTx   *mockProduct.MockProductStockTx
+	tx        *mockTX.MockTx
+}
+
+func newPurchaseService(costMethod string) (PurchaseOrderService, purchaseMocks) {
+	m := purchaseMocks{
+		repo:      new(mockPurchase.MockPurchaseOrder),
+		receipt:   new(mockPurchase.MockPurchaseReceipt),
+		orderTx:   new(mockPurchase.MockPurchaseOrderTx),
+		receiptTx: new(mockPurchase.MockPurchaseReceiptTx),
+		stockTx:   new(mockProduct.MockProductStockTx),
+		tx:        new(mockTX.MockTx),
+	}
+	return NewPurchaseOrderService(m.repo, m.receipt, m.orderTx, m.receiptTx, m.stockTx, costMethod), m
+}
+
+func validOrder() *models.PurchaseOrder {
+	return &models.PurchaseOrder{
+		SupplierID: 4,
+		Items: []models.PurchaseOrderItem{
+			{ProductID: 7, Quantity: 2, UnitCost: money.New(10)},
+			{ProductID: 8, Quantity: 3, UnitCost: money.MustParse("1.50")},
+		},
+	}
+}
+
+func TestPurchaseOrderService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pedido nil", func(t *testing.T) {
+		svc, _ := newPurchaseService("latest")
+
+		result, err := svc.Create(ctx, nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("pedido sem linhas", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+
+		result, err := svc.Create(ctx, &models.PurchaseOrder{SupplierID: 4})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.orderTx.AssertNotCalled(t, "BeginTx", ctx)
+	})
+
+	t.Run("erro do repositório faz rollback", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		order := validOrder()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("CreateTx", ctx, m.tx, order).Return(nil, errMsg.ErrDBInvalidForeignKey).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.Create(ctx, order)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("sucesso grava rascunho com total calculado", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		order := validOrder()
+		order.Status = models.StatusReceived
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(o *models.PurchaseOrder) bool {
+			return o.Status == models.StatusDraft && o.TotalAmount == money.MustParse("24.50")
+		})).Return(order, nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		result, err := svc.Create(ctx, order)
+
+		assert.NoError(t, err)
+		assert.Equal(t, order, result)
+		m.orderTx.AssertExpectations(t)
+		m.tx.AssertExpectations(t)
+	})
+}
+
+func TestPurchaseOrderService_Update(t *testing.T) {
+	ctx := context.Background()
+
+	newUpdate := func() *models.PurchaseOrder {
+		o := validOrder()
+		o.ID, o.Version = 1, 2
+		return o
+	}
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newPurchaseService("latest")
+
+		err := svc.Update(ctx, validOrder())
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("versão obrigatória", func(t *testing.T) {
+		svc, _ := newPurchaseService("latest")
+		order := newUpdate()
+		order.Version = 0
+
+		err := svc.Update(ctx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("conflito de versão", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		order := newUpdate()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.PurchaseOrder{ID: 1, Status: models.StatusDraft, Version: 3}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		err := svc.Update(ctx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrVersionConflict)
+		m.orderTx.AssertNotCalled(t, "UpdateTx", ctx, m.tx, order)
+	})
+
+	t.Run("pedido já enviado", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		order := newUpdate()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.PurchaseOrder{ID: 1, Status: models.StatusSent, Version: 2}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		err := svc.Update(ctx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		order := newUpdate()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.PurchaseOrder{ID: 1, Status: models.StatusDraft, Version: 2}, nil).Once()
+		m.orderTx.On("UpdateTx", ctx, m.tx, order).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		err := svc.Update(ctx, order)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusDraft, order.Status)
+		assert.Equal(t, money.MustParse("24.50"), order.TotalAmount)
+		m.orderTx.AssertExpectations(t)
+	})
+}
+
+func TestPurchaseOrderService_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newPurchaseService("latest")
+
+		assert.ErrorIs(t, svc.Delete(ctx, 0), errMsg.ErrZeroID)
+	})
+
+	t.Run("pedido não é rascunho", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.PurchaseOrder{ID: 1, Status: models.StatusSent}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		err := svc.Delete(ctx, 1)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.orderTx.AssertNotCalled(t, "DeleteTx", ctx, m.tx, int64(1))
+	})
+
+	t.Run("erro na transação é propagado", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		m.orderTx.On("BeginTx", ctx).Return(nil, errors.New("db down")).Once()
+
+		err := svc.Delete(ctx, 1)
+
+		assert.ErrorContains(t, err, "db down")
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newPurchaseService("latest")
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.PurchaseOrder{ID: 1, Status: models.StatusDraft}, nil).Once()
+		m.orderTx.On("DeleteTx", ctx, m.tx, int64(1)).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		err := svc.Delete(ctx, 1)
+
+		assert.NoError(t, err)
+		m.orderTx.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,254 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	modelsCredit "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	modelsProduct "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/checkout"
+	modelsItem "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	modelsSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+func (s *saleCheckoutService) Checkout(ctx context.Context, checkout *models.Checkout) (*models.CheckoutResult, error) {
+	if checkout == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if err := checkout.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	// Inicia transação
+	tx, err := s.repoSale.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao iniciar transação: %w", err)
+	}
+	if tx == nil {
+		return nil, errors.New("transação inválida")
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback(ctx)
+			panic(p)
+		}
+	}()
+
+	commitOrRollback := func(err error) error {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				return fmt.Errorf("%w; rollback error: %v", err, rbErr)
+			}
+			return err
+		}
+		if cErr := tx.Commit(ctx); cErr != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				return fmt.Errorf("erro ao commitar transação: %v; rollback error: %w", cErr, rbErr)
+			}
+			return fmt.Errorf("erro ao commitar transação: %w", cErr)
+		}
+		return nil
+	}
+
+	// Bloqueia os produtos sempre em ordem crescente de ID para evitar deadlock
+	// entre checkouts concorrentes que disputam os mesmos produtos.
+	requested := checkout.QuantitiesByProduct()
+	productIDs := make([]int64, 0, len(requested))
+	for id := range requested {
+		productIDs = append(productIDs, id)
+	}
+	sort.Slice(productIDs, func(i, j int) bool { return productIDs[i] < productIDs[j] })
+
+	// O saldo conferido é o do local da venda, não o total da empresa: estoque
+	// em outra loja ou depósito não atende este checkout. O preço é o vigente
+	// agora, já considerando mudanças agendadas vencidas, como nos itens
+	// incluídos depois na venda.
+	now := time.Now()
+	products := make(map[int64]*modelsProduct.Product, len(productIDs))
+	available := make(map[int64]int, len(productIDs))
+	prices := make(map[int64]money.Money, len(productIDs))
+	for _, id := range productIDs {
+		product, err := s.repoProductStock.GetByIDForUpdateTx(ctx, tx, id)
+		if err != nil {
+			if errors.Is(err, errMsg.ErrNotFound) {
+				continue // reportado por linha abaixo
+			}
+			return nil, commitOrRollback(err)
+		}
+		products[id] = product
+
+		quantity, err := s.repoProductStock.GetStockAtForUpdateTx(ctx, tx, id, checkout.LocationID)
+		if err != nil {
+			return nil, commitOrRollback(err)
+		}
+		available[id] = quantity
+
+		price, err := s.repoPrice.EffectiveAtTx(ctx, tx, id, now)
+		if err != nil {
+			return nil, commitOrRollback(err)
+		}
+		prices[id] = price.SalePrice
+	}
+
+	// Valida e precifica cada linha com os dados do banco
+	var (
+		lineErrs      []models.LineError
+		items         = make([]*modelsItem.SaleItem, 0, len(checkout.Items))
+		itemsAmount   money.Money
+		itemsDiscount money.Money
+	)
+
+	for i, line := range checkout.Items {
+		product, found := products[line.ProductID]
+		unitPrice := prices[line.ProductID]
+		if msg := checkLine(line, product, found, unitPrice, available[line.ProductID], requested[line.ProductID]); msg != "" {
+			lineErrs = append(lineErrs, models.LineError{Line: i, ProductID: line.ProductID, Message: msg})
+			continue
+		}
+
+		gross := unitPrice.Mul(int64(line.Quantity))
+
+		items = append(items, &modelsItem.SaleItem{
+			ProductID:   line.ProductID,
+			Quantity:    line.Quantity,
+			UnitPrice:   unitPrice,
+			Discount:    line.Discount,
+			Subtotal:    gross.Sub(line.Discount),
+			Description: line.Description,
+		})
+
+		itemsAmount = itemsAmount.Add(gross)
+		itemsDiscount = itemsDiscount.Add(line.Discount)
+	}
+
+	if len(lineErrs) > 0 {
+		return nil, commitOrRollback(&models.CheckoutError{Lines: lineErrs})
+	}
+
+	totalAmount := itemsAmount.Sub(itemsDiscount).Sub(checkout.TotalSaleDiscount)
+	if totalAmount.IsNegative() {
+		return nil, commitOrRollback(fmt.Errorf("%w: desconto da venda excede o valor dos itens", errMsg.ErrInvalidData))
+	}
+
+	// Os pagamentos precisam quitar o total; o troco sai do dinheiro
+	payments := checkout.Tenders(totalAmount)
+	if err := modelsPayment.Settle(payments, totalAmount); err != nil {
+		return nil, commitOrRollback(fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err))
+	}
+	paymentType := checkout.PaymentType
+	if primary := modelsPayment.PrimaryMethod(payments); primary != "" {
+		paymentType = primary
+	}
+
+	// Criação da venda
+	sale := &modelsSale.Sale{
+		ClientID:           checkout.ClientID,
+		ClientCnpjID:       checkout.ClientCnpjID,
+		UserID:             checkout.UserID,
+		LocationID:         checkout.LocationID,
+		SaleDate:           now,
+		TotalItemsAmount:   itemsAmount,
+		TotalItemsDiscount: itemsDiscount,
+		TotalSaleDiscount:  checkout.TotalSaleDiscount,
+		TotalAmount:        totalAmount,
+		PaymentType:        paymentType,
+		Status:             "active",
+		Notes:              checkout.Notes,
+		Version:            1,
+	}
+	if err := sale.ValidateStructural(); err != nil {
+		return nil, commitOrRollback(fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err))
+	}
+
+	createdSale, err := s.repoSale.CreateTx(ctx, tx, sale)
+	if err != nil {
+		return nil, commitOrRollback(err)
+	}
+
+	// Criação dos itens
+	createdItems := make([]*modelsItem.SaleItem, 0, len(items))
+	for _, item := range items {
+		item.SaleID = createdSale.ID
+		createdItem, err := s.repoItem.CreateTx(ctx, tx, item)
+		if err != nil {
+			return nil, commitOrRollback(err)
+		}
+		createdItems = append(createdItems, createdItem)
+	}
+
+	// Baixa de estoque (linhas já bloqueadas acima)
+	origin := modelsMovement.NewOrigin(modelsMovement.ReasonSale, modelsMovement.RefSale, createdSale.ID)
+	for _, id := range productIDs {
+		if err := s.repoProductStock.DecreaseStockAtTx(ctx, tx, id, checkout.LocationID, requested[id], origin); err != nil {
+			return nil, commitOrRollback(err)
+		}
+	}
+
+	// Venda a crédito: lança a cobrança no razão do cliente. Limite excedido
+	// ou crédito desabilitado desfazem a venda inteira.
+	if createdSale.PaymentType == "credit" && createdSale.TotalAmount.IsPositive() {
+		ledger, clientID := s.repoCredit, checkout.ClientID
+		if checkout.ClientCnpjID != nil {
+			ledger, clientID = s.repoCnpjCredit, checkout.ClientCnpjID
+		}
+		entry := modelsCredit.NewSaleEntry(*clientID, createdSale.ID, createdSale.TotalAmount,
+			fmt.Sprintf("venda %d", createdSale.ID))
+		if err := ledger.ChargeTx(ctx, tx, entry); err != nil {
+			return nil, commitOrRollback(err)
+		}
+	}
+
+	// Pagamentos, no mesmo caixa da venda
+	createdPayments := make([]*modelsPayment.SalePayment, 0, len(payments))
+	for _, payment := range payments {
+		payment.SaleID = createdSale.ID
+		payment.ReceivedBy = createdSale.UserID
+		createdPayment, err := s.repoPayment.CreateTx(ctx, tx, payment)
+		if err != nil {
+			return nil, commitOrRollback(err)
+		}
+		createdPayments = append(createdPayments, createdPayment)
+	}
+
+	// Commit final
+	if err := commitOrRollback(nil); err != nil {
+		return nil, err
+	}
+
+	return &models.CheckoutResult{
+		Sale:     createdSale,
+		Items:    createdItems,
+		Payments: createdPayments,
+	}, nil
+}
+
+// checkLine retorna a mensagem de recusa da linha ou "" quando ela é válida.
+// unitPrice é o preço vigente do produto, available é o saldo no local da
+// venda e totalRequested, a soma das quantidades do mesmo produto em todo o
+// pedido.
+func checkLine(line models.CheckoutItem, product *modelsProduct.Product, found bool, unitPrice money.Money, available, totalRequested int) string {
+	if !found {
+		return errMsg.ErrNotFound.Error()
+	}
+
+	item := modelsItem.SaleItem{Quantity: line.Quantity, UnitPrice: unitPrice, Discount: line.Discount}
+	if err := item.CheckProduct(product); err != nil {
+		return err.Error()
+	}
+
+	if available < totalRequested {
+		return fmt.Sprintf("%s: disponível %d, solicitado %d",
+			errMsg.ErrInsufficientStock.Error(), available, totalRequested)
+	}
+
+	return ""
+}
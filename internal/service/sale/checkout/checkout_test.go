@@ -0,0 +1,573 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cpf"
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	mockTX "github.com/WagaoCarvalho/backend_store_go/infra/mock/repo"
+	mockSale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	modelsCredit "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	modelsPrice "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	modelsProduct "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/checkout"
+	modelsItem "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	modelsSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type checkoutMocks struct {
+	sale    *mockSale.MockSaleTx
+	item    *mockSale.MockSaleItemTx
+	payment *mockSale.MockSalePaymentTx
+	product *mockProduct.MockProductStockTx
+	price   *mockProduct.PriceMock
+	credit  *mockClient.MockClientCreditTx
+	cnpj    *mockClient.MockClientCreditTx
+	tx      *mockTX.MockTx
+}
+
+func newCheckoutService() (SaleCheckout, checkoutMocks) {
+	m := checkoutMocks{
+		sale:    new(mockSale.MockSaleTx),
+		item:    new(mockSale.MockSaleItemTx),
+		payment: new(mockSale.MockSalePaymentTx),
+		product: new(mockProduct.MockProductStockTx),
+		price:   new(mockProduct.PriceMock),
+		credit:  new(mockClient.MockClientCreditTx),
+		cnpj:    new(mockClient.MockClientCreditTx),
+		tx:      new(mockTX.MockTx),
+	}
+	return NewSaleCheckoutService(m.sale, m.item, m.payment, m.product, m.price, m.credit, m.cnpj), m
+}
+
+func product(id int64, price float64, stock int) *modelsProduct.Product {
+	return &modelsProduct.Product{ID: id, SalePrice: money.MustFromFloat(price), StockQuantity: stock, Status: true, AllowDiscount: true}
+}
+
+// lock simula o bloqueio do produto e do seu saldo no local 1, com o preço
+// vigente igual ao do cadastro.
+func (m checkoutMocks) lock(p *modelsProduct.Product, available int) {
+	m.product.On("GetByIDForUpdateTx", mock.Anything, m.tx, p.ID).Return(p, nil)
+	m.product.On("GetStockAtForUpdateTx", mock.Anything, m.tx, p.ID, int64(1)).Return(available, nil)
+	m.price.On("EffectiveAtTx", mock.Anything, m.tx, p.ID, mock.Anything).
+		Return(&modelsPrice.EffectivePrice{ProductID: p.ID, SalePrice: p.SalePrice}, nil)
+}
+
+func TestSaleCheckoutService_Checkout(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("falha quando checkout é nil", func(t *testing.T) {
+		service, _ := newCheckoutService()
+
+		result, err := service.Checkout(ctx, nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("falha na validação estrutural", func(t *testing.T) {
+		service, _ := newCheckoutService()
+
+		result, err := service.Checkout(ctx, &models.Checkout{PaymentType: "cash"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("falha ao iniciar transação", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(nil, errors.New("db down"))
+
+		result, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:  1,
+			PaymentType: "cash",
+			Items:       []models.CheckoutItem{{ProductID: 1, Quantity: 1}},
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "erro ao iniciar transação")
+	})
+
+	t.Run("sucesso calcula totais no servidor e baixa estoque agregado", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.lock(product(1, 10, 5), 5)
+		m.lock(product(2, 2.5, 10), 10)
+
+		m.sale.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(s *modelsSale.Sale) bool {
+			return s.TotalItemsAmount == money.MustParse("47.50") &&
+				s.TotalItemsDiscount == money.New(2) &&
+				s.TotalSaleDiscount == money.MustParse("0.50") &&
+				s.TotalAmount == money.New(45) &&
+				s.Status == "active" &&
+				s.PaymentType == "pix"
+		})).Return(&modelsSale.Sale{ID: 99, TotalAmount: money.New(45)}, nil)
+
+		m.item.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(i *modelsItem.SaleItem) bool {
+			return i.SaleID == 99
+		})).Return(&modelsItem.SaleItem{ID: 1, SaleID: 99}, nil).Times(3)
+
+		origin := modelsMovement.NewOrigin(modelsMovement.ReasonSale, modelsMovement.RefSale, 99)
+		m.product.On("DecreaseStockAtTx", ctx, m.tx, int64(1), int64(1), 4, origin).Return(nil).Once()
+		m.product.On("DecreaseStockAtTx", ctx, m.tx, int64(2), int64(1), 3, origin).Return(nil).Once()
+		m.payment.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(p *modelsPayment.SalePayment) bool {
+			return p.SaleID == 99 && p.Method == "pix" && p.Amount == money.New(45)
+		})).Return(&modelsPayment.SalePayment{ID: 1, SaleID: 99, Method: "pix", Amount: money.New(45)}, nil).Once()
+		m.tx.On("Commit", ctx).Return(nil)
+
+		result, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:        1,
+			PaymentType:       "pix",
+			TotalSaleDiscount: money.MustParse("0.50"),
+			Items: []models.CheckoutItem{
+				{ProductID: 1, Quantity: 3, Discount: money.New(2)},
+				{ProductID: 2, Quantity: 3},
+				{ProductID: 1, Quantity: 1},
+			},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(99), result.Sale.ID)
+		assert.Len(t, result.Items, 3)
+		assert.Len(t, result.Payments, 1)
+		m.product.AssertExpectations(t)
+		m.payment.AssertExpectations(t)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("precifica pelo preço vigente, não pelo cadastro", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.product.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(product(1, 10, 10), nil)
+		m.product.On("GetStockAtForUpdateTx", ctx, m.tx, int64(1), int64(1)).Return(10, nil)
+		// Mudança agendada já vencida, ainda não aplicada ao cadastro
+		m.price.On("EffectiveAtTx", ctx, m.tx, int64(1), mock.AnythingOfType("time.Time")).
+			Return(&modelsPrice.EffectivePrice{ProductID: 1, SalePrice: money.New(12)}, nil)
+
+		m.sale.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(s *modelsSale.Sale) bool {
+			return s.TotalItemsAmount == money.New(24) && s.TotalAmount == money.New(24)
+		})).Return(&modelsSale.Sale{ID: 99, TotalAmount: money.New(24)}, nil)
+		m.item.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(i *modelsItem.SaleItem) bool {
+			return i.UnitPrice == money.New(12) && i.Subtotal == money.New(24)
+		})).Return(&modelsItem.SaleItem{ID: 1, SaleID: 99}, nil).Once()
+		m.product.On("DecreaseStockAtTx", ctx, m.tx, int64(1), int64(1), 2, mock.Anything).Return(nil).Once()
+		m.payment.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsPayment.SalePayment{ID: 1}, nil).Once()
+		m.tx.On("Commit", ctx).Return(nil)
+
+		_, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:  1,
+			PaymentType: "pix",
+			Items:       []models.CheckoutItem{{ProductID: 1, Quantity: 2}},
+		})
+
+		assert.NoError(t, err)
+		m.price.AssertExpectations(t)
+		m.item.AssertExpectations(t)
+	})
+
+	t.Run("erro ao consultar preço vigente faz rollback", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.product.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(product(1, 10, 10), nil)
+		m.product.On("GetStockAtForUpdateTx", ctx, m.tx, int64(1), int64(1)).Return(10, nil)
+		m.price.On("EffectiveAtTx", ctx, m.tx, int64(1), mock.Anything).Return(nil, errMsg.ErrGet)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		_, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:  1,
+			PaymentType: "cash",
+			Items:       []models.CheckoutItem{{ProductID: 1, Quantity: 1}},
+		})
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+		m.sale.AssertNotCalled(t, "CreateTx", mock.Anything, mock.Anything, mock.Anything)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("estoque insuficiente, produto desativado ou inexistente fazem rollback com erro por linha", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+
+		disabled := product(2, 5, 10)
+		disabled.Status = false
+
+		m.lock(product(1, 10, 1), 1)
+		m.lock(disabled, 10)
+		m.product.On("GetByIDForUpdateTx", ctx, m.tx, int64(3)).Return(nil, errMsg.ErrNotFound)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		result, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:  1,
+			PaymentType: "cash",
+			Items: []models.CheckoutItem{
+				{ProductID: 1, Quantity: 2},
+				{ProductID: 2, Quantity: 1},
+				{ProductID: 3, Quantity: 1},
+			},
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrSaleCheckoutRejected)
+
+		var checkoutErr *models.CheckoutError
+		assert.True(t, errors.As(err, &checkoutErr))
+		assert.Len(t, checkoutErr.Lines, 3)
+		assert.Equal(t, 0, checkoutErr.Lines[0].Line)
+		assert.Contains(t, checkoutErr.Lines[0].Message, errMsg.ErrInsufficientStock.Error())
+		assert.Equal(t, errMsg.ErrProductDisabled.Error(), checkoutErr.Lines[1].Message)
+		assert.Equal(t, errMsg.ErrNotFound.Error(), checkoutErr.Lines[2].Message)
+
+		m.sale.AssertNotCalled(t, "CreateTx", mock.Anything, mock.Anything, mock.Anything)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("saldo em outros locais não atende a venda", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.lock(product(1, 10, 50), 2)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		_, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:  1,
+			PaymentType: "cash",
+			Items:       []models.CheckoutItem{{ProductID: 1, Quantity: 3}},
+		})
+
+		var checkoutErr *models.CheckoutError
+		assert.True(t, errors.As(err, &checkoutErr))
+		assert.Len(t, checkoutErr.Lines, 1)
+		assert.Equal(t, int64(1), checkoutErr.Lines[0].ProductID)
+		assert.Equal(t, errMsg.ErrInsufficientStock.Error()+": disponível 2, solicitado 3", checkoutErr.Lines[0].Message)
+		m.product.AssertNotCalled(t, "DecreaseStockAtTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("desconto não permitido ou acima do máximo é recusado", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+
+		noDiscount := product(1, 10, 10)
+		noDiscount.AllowDiscount = false
+		capped := product(2, 10, 10)
+		capped.MaxDiscountPercent = 10
+
+		m.lock(noDiscount, 10)
+		m.lock(capped, 10)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		_, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:  1,
+			PaymentType: "cash",
+			Items: []models.CheckoutItem{
+				{ProductID: 1, Quantity: 1, Discount: money.New(1)},
+				{ProductID: 2, Quantity: 1, Discount: money.New(2)},
+			},
+		})
+
+		var checkoutErr *models.CheckoutError
+		assert.True(t, errors.As(err, &checkoutErr))
+		assert.Equal(t, errMsg.ErrProductDiscountNotAllowed.Error(), checkoutErr.Lines[0].Message)
+		assert.Contains(t, checkoutErr.Lines[1].Message, errMsg.ErrInvalidDiscountPercent.Error())
+	})
+
+	t.Run("desconto da venda maior que os itens faz rollback", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.lock(product(1, 10, 10), 10)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		_, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:        1,
+			PaymentType:       "cash",
+			TotalSaleDiscount: money.New(11),
+			Items:             []models.CheckoutItem{{ProductID: 1, Quantity: 1}},
+		})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("erro inesperado ao bloquear produto faz rollback", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.product.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(nil, errMsg.ErrGet)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		_, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:  1,
+			PaymentType: "cash",
+			Items:       []models.CheckoutItem{{ProductID: 1, Quantity: 1}},
+		})
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("erro ao bloquear saldo do local faz rollback", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.product.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(product(1, 10, 10), nil)
+		m.product.On("GetStockAtForUpdateTx", ctx, m.tx, int64(1), int64(1)).Return(0, errMsg.ErrGet)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		_, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:  1,
+			PaymentType: "cash",
+			Items:       []models.CheckoutItem{{ProductID: 1, Quantity: 1}},
+		})
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+		m.sale.AssertNotCalled(t, "CreateTx", mock.Anything, mock.Anything, mock.Anything)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("falha ao criar venda faz rollback", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.lock(product(1, 10, 10), 10)
+		m.sale.On("CreateTx", ctx, m.tx, mock.Anything).Return(nil, errMsg.ErrDBInvalidForeignKey)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		_, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:  1,
+			PaymentType: "cash",
+			Items:       []models.CheckoutItem{{ProductID: 1, Quantity: 1}},
+		})
+
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("falha ao criar item faz rollback", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.lock(product(1, 10, 10), 10)
+		m.sale.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsSale.Sale{ID: 1}, nil)
+		m.item.On("CreateTx", ctx, m.tx, mock.Anything).Return(nil, errMsg.ErrCreate)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		_, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:  1,
+			PaymentType: "cash",
+			Items:       []models.CheckoutItem{{ProductID: 1, Quantity: 1}},
+		})
+
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("falha ao baixar estoque faz rollback", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.lock(product(1, 10, 10), 10)
+		m.sale.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsSale.Sale{ID: 1}, nil)
+		m.item.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsItem.SaleItem{ID: 1}, nil)
+		m.product.On("DecreaseStockAtTx", ctx, m.tx, int64(1), int64(1), 1, mock.Anything).Return(errMsg.ErrInsufficientStock)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		_, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:  1,
+			PaymentType: "cash",
+			Items:       []models.CheckoutItem{{ProductID: 1, Quantity: 1}},
+		})
+
+		assert.ErrorIs(t, err, errMsg.ErrInsufficientStock)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("venda a crédito lança cobrança no razão do cliente", func(t *testing.T) {
+		service, m := newCheckoutService()
+		clientID := int64(7)
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.lock(product(1, 10, 10), 10)
+		m.sale.On("CreateTx", ctx, m.tx, mock.Anything).
+			Return(&modelsSale.Sale{ID: 5, PaymentType: "credit", TotalAmount: money.New(20)}, nil)
+		m.item.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsItem.SaleItem{ID: 1}, nil)
+		m.product.On("DecreaseStockAtTx", ctx, m.tx, int64(1), int64(1), 2, mock.Anything).Return(nil)
+		m.credit.On("ChargeTx", ctx, m.tx, mock.MatchedBy(func(e *modelsCredit.CreditEntry) bool {
+			return e.ClientID == clientID && e.Amount == money.New(20) && *e.SaleID == 5
+		})).Return(nil)
+		m.payment.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsPayment.SalePayment{ID: 1}, nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		result, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:  1,
+			ClientID:    &clientID,
+			PaymentType: "credit",
+			Items:       []models.CheckoutItem{{ProductID: 1, Quantity: 2}},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), result.Sale.ID)
+		m.credit.AssertExpectations(t)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("venda a crédito de cliente CNPJ lança no razão de pessoa jurídica", func(t *testing.T) {
+		service, m := newCheckoutService()
+		clientID := int64(9)
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.lock(product(1, 10, 10), 10)
+		m.sale.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(s *modelsSale.Sale) bool {
+			return s.ClientID == nil && *s.ClientCnpjID == clientID
+		})).Return(&modelsSale.Sale{ID: 5, PaymentType: "credit", TotalAmount: money.New(20)}, nil)
+		m.item.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsItem.SaleItem{ID: 1}, nil)
+		m.product.On("DecreaseStockAtTx", ctx, m.tx, int64(1), int64(1), 2, mock.Anything).Return(nil)
+		m.cnpj.On("ChargeTx", ctx, m.tx, mock.MatchedBy(func(e *modelsCredit.CreditEntry) bool {
+			return e.ClientID == clientID && e.Amount == money.New(20)
+		})).Return(nil)
+		m.payment.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsPayment.SalePayment{ID: 1}, nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		_, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:   1,
+			ClientCnpjID: &clientID,
+			PaymentType:  "credit",
+			Items:        []models.CheckoutItem{{ProductID: 1, Quantity: 2}},
+		})
+
+		assert.NoError(t, err)
+		m.cnpj.AssertExpectations(t)
+		m.credit.AssertNotCalled(t, "ChargeTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("venda a crédito acima do limite faz rollback", func(t *testing.T) {
+		service, m := newCheckoutService()
+		clientID := int64(7)
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.lock(product(1, 10, 10), 10)
+		m.sale.On("CreateTx", ctx, m.tx, mock.Anything).
+			Return(&modelsSale.Sale{ID: 5, PaymentType: "credit", TotalAmount: money.New(10)}, nil)
+		m.item.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsItem.SaleItem{ID: 1}, nil)
+		m.product.On("DecreaseStockAtTx", ctx, m.tx, int64(1), int64(1), 1, mock.Anything).Return(nil)
+		m.credit.On("ChargeTx", ctx, m.tx, mock.Anything).Return(errMsg.ErrCreditLimitExceeded)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		result, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:  1,
+			ClientID:    &clientID,
+			PaymentType: "credit",
+			Items:       []models.CheckoutItem{{ProductID: 1, Quantity: 1}},
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreditLimitExceeded)
+		m.tx.AssertExpectations(t)
+		m.tx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("pagamento dividido calcula o troco e grava cada forma", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.lock(product(1, 43.65, 10), 10)
+		m.sale.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(s *modelsSale.Sale) bool {
+			return s.TotalAmount == money.MustParse("87.30") && s.PaymentType == "card"
+		})).Return(&modelsSale.Sale{ID: 8, PaymentType: "card", TotalAmount: money.MustParse("87.30")}, nil)
+		m.item.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsItem.SaleItem{ID: 1}, nil)
+		m.product.On("DecreaseStockAtTx", ctx, m.tx, int64(1), int64(1), 2, mock.Anything).Return(nil)
+		m.payment.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(p *modelsPayment.SalePayment) bool {
+			return p.SaleID == 8 && p.Method == "card" && p.Installments == 2 && p.ChangeGiven.IsZero()
+		})).Return(&modelsPayment.SalePayment{ID: 1}, nil).Once()
+		m.payment.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(p *modelsPayment.SalePayment) bool {
+			return p.SaleID == 8 && p.Method == "cash" && p.ChangeGiven == money.MustParse("2.70")
+		})).Return(&modelsPayment.SalePayment{ID: 2}, nil).Once()
+		m.tx.On("Commit", ctx).Return(nil)
+
+		result, err := service.Checkout(ctx, &models.Checkout{
+			LocationID: 1,
+			Items:      []models.CheckoutItem{{ProductID: 1, Quantity: 2}},
+			Payments: []modelsPayment.SalePayment{
+				{Method: "card", Amount: money.New(50), Installments: 2},
+				{Method: "cash", Amount: money.New(40)},
+			},
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Payments, 2)
+		m.payment.AssertExpectations(t)
+		m.credit.AssertNotCalled(t, "ChargeTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("pagamentos que não cobrem o total fazem rollback", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.lock(product(1, 10, 10), 10)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		result, err := service.Checkout(ctx, &models.Checkout{
+			LocationID: 1,
+			Items:      []models.CheckoutItem{{ProductID: 1, Quantity: 2}},
+			Payments:   []modelsPayment.SalePayment{{Method: "pix", Amount: money.New(15)}},
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		assert.ErrorContains(t, err, errMsg.ErrPaymentInsufficient.Error())
+		m.sale.AssertNotCalled(t, "CreateTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha ao gravar pagamento faz rollback", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.lock(product(1, 10, 10), 10)
+		m.sale.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsSale.Sale{ID: 1}, nil)
+		m.item.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsItem.SaleItem{ID: 1}, nil)
+		m.product.On("DecreaseStockAtTx", ctx, m.tx, int64(1), int64(1), 1, mock.Anything).Return(nil)
+		m.payment.On("CreateTx", ctx, m.tx, mock.Anything).Return(nil, errMsg.ErrCreate)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		result, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:  1,
+			PaymentType: "cash",
+			Items:       []models.CheckoutItem{{ProductID: 1, Quantity: 1}},
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+		m.tx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("falha no commit", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.lock(product(1, 10, 10), 10)
+		m.sale.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsSale.Sale{ID: 1}, nil)
+		m.item.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsItem.SaleItem{ID: 1}, nil)
+		m.product.On("DecreaseStockAtTx", ctx, m.tx, int64(1), int64(1), 1, mock.Anything).Return(nil)
+		m.payment.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsPayment.SalePayment{ID: 1}, nil)
+		m.tx.On("Commit", ctx).Return(errors.New("commit failed"))
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		result, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:  1,
+			PaymentType: "cash",
+			Items:       []models.CheckoutItem{{ProductID: 1, Quantity: 1}},
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "erro ao commitar transação")
+	})
+
+	t.Run("falha no rollback é anexada ao erro original", func(t *testing.T) {
+		service, m := newCheckoutService()
+		m.sale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.lock(product(1, 10, 0), 0)
+		m.tx.On("Rollback", ctx).Return(errors.New("rollback failed"))
+
+		_, err := service.Checkout(ctx, &models.Checkout{
+			LocationID:  1,
+			PaymentType: "cash",
+			Items:       []models.CheckoutItem{{ProductID: 1, Quantity: 1}},
+		})
+
+		assert.ErrorIs(t, err, errMsg.ErrSaleCheckoutRejected)
+		assert.ErrorContains(t, err, "rollback failed")
+	})
+}
@@ -0,0 +1,37 @@
+package services
+
+import (
+	ifaceClient "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cpf"
+	ifaceProduct "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	ifaceSale "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+)
+
+type saleCheckoutService struct {
+	repoSale         ifaceSale.SaleTx
+	repoItem         ifaceSale.SaleItemTx
+	repoPayment      ifaceSale.SalePaymentTx
+	repoProductStock ifaceProduct.ProductStockTx
+	repoPrice        ifaceProduct.PriceTx
+	repoCredit       ifaceClient.ClientCreditTx
+	repoCnpjCredit   ifaceClient.ClientCreditTx
+}
+
+func NewSaleCheckoutService(
+	repoSale ifaceSale.SaleTx,
+	repoItem ifaceSale.SaleItemTx,
+	repoPayment ifaceSale.SalePaymentTx,
+	repoProductStock ifaceProduct.ProductStockTx,
+	repoPrice ifaceProduct.PriceTx,
+	repoCredit ifaceClient.ClientCreditTx,
+	repoCnpjCredit ifaceClient.ClientCreditTx,
+) SaleCheckout {
+	return &saleCheckoutService{
+		repoSale:         repoSale,
+		repoItem:         repoItem,
+		repoPayment:      repoPayment,
+		repoProductStock: repoProductStock,
+		repoPrice:        repoPrice,
+		repoCredit:       repoCredit,
+		repoCnpjCredit:   repoCnpjCredit,
+	}
+}
@@ -0,0 +1,11 @@
+package services
+
+import (
+	"context"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/checkout"
+)
+
+type SaleCheckout interface {
+	Checkout(ctx context.Context, checkout *models.Checkout) (*models.CheckoutResult, error)
+}
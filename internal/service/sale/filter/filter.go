@@ -3,13 +3,14 @@ package services
 import (
 	"context"
 	"fmt"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/filter"
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
 )
 
-func (s *saleFilterService) Filter(ctx context.Context, filter *filter.SaleFilter) ([]*model.Sale, error) {
+func (s *saleFilterService) Filter(ctx context.Context, filter *filter.SaleFilter) (*commonFilter.Page[*model.Sale], error) {
 	if filter == nil {
 		return nil, fmt.Errorf("%w: filtro não pode ser nulo", errMsg.ErrInvalidFilter)
 	}
@@ -11,6 +11,7 @@ import (
 	saleFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/filter"
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -88,7 +89,7 @@ func TestSaleService_Filter(t *testing.T) {
 			{
 				ID:          1,
 				SaleDate:    now,
-				TotalAmount: 100,
+				TotalAmount: money.New(100),
 				PaymentType: "cash",
 				Status:      "completed",
 				Version:     1,
@@ -96,7 +97,7 @@ func TestSaleService_Filter(t *testing.T) {
 			{
 				ID:          2,
 				SaleDate:    now,
-				TotalAmount: 250,
+				TotalAmount: money.New(250),
 				PaymentType: "card",
 				Status:      "active",
 				Version:     1,
@@ -111,11 +112,11 @@ func TestSaleService_Filter(t *testing.T) {
 		result, err := service.Filter(context.Background(), validFilter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 2)
-		assert.Equal(t, int64(1), result[0].ID)
-		assert.Equal(t, "cash", result[0].PaymentType)
-		assert.Equal(t, int64(2), result[1].ID)
-		assert.Equal(t, "card", result[1].PaymentType)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, int64(1), result.Items[0].ID)
+		assert.Equal(t, "cash", result.Items[0].PaymentType)
+		assert.Equal(t, int64(2), result.Items[1].ID)
+		assert.Equal(t, "card", result.Items[1].PaymentType)
 
 		mockRepo.AssertExpectations(t)
 	})
@@ -1,13 +1,38 @@
 package services
 
-import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/sale/item"
+import (
+	ifaceClient "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cpf"
+	ifaceProduct "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	ifaceSale "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/sale/item"
+)
 
 type saleItemService struct {
-	repo repo.SaleItemRepo
+	repo       repo.SaleItemRepo
+	repoSale   ifaceSale.SaleTx
+	repoItem   ifaceSale.SaleItemTx
+	repoCredit ifaceClient.ClientCreditTx
+	repoCnpj   ifaceClient.ClientCreditTx
+	repoPrice  ifaceProduct.PriceTx
+	repoStock  ifaceProduct.ProductStockTx
 }
 
-func NewItemSaleService(repo repo.SaleItemRepo) SaleItemService {
+func NewItemSaleService(
+	repo repo.SaleItemRepo,
+	repoSale ifaceSale.SaleTx,
+	repoItem ifaceSale.SaleItemTx,
+	repoCredit ifaceClient.ClientCreditTx,
+	repoCnpj ifaceClient.ClientCreditTx,
+	repoPrice ifaceProduct.PriceTx,
+	repoStock ifaceProduct.ProductStockTx,
+) SaleItemService {
 	return &saleItemService{
-		repo: repo,
+		repo:       repo,
+		repoSale:   repoSale,
+		repoItem:   repoItem,
+		repoCredit: repoCredit,
+		repoCnpj:   repoCnpj,
+		repoPrice:  repoPrice,
+		repoStock:  repoStock,
 	}
 }
@@ -17,7 +17,7 @@ func TestSaleItemService_GetByID(t *testing.T) {
 
 	t.Run("id inválido retorna erro", func(t *testing.T) {
 		mockRepo := new(mock_item.MockSaleItem)
-		service := NewItemSaleService(mockRepo)
+		service := NewItemSaleService(mockRepo, nil, nil, nil, nil, nil, nil)
 
 		result, err := service.GetByID(ctx, 0)
 
@@ -29,7 +29,7 @@ func TestSaleItemService_GetByID(t *testing.T) {
 	t.Run("erro do repositório é propagado", func(t *testing.T) {
 		mockRepo := new(mock_item.MockSaleItem)
 		mockRepo.On("GetByID", ctx, int64(1)).Return(nil, errors.New("db error"))
-		service := NewItemSaleService(mockRepo)
+		service := NewItemSaleService(mockRepo, nil, nil, nil, nil, nil, nil)
 
 		result, err := service.GetByID(ctx, 1)
 
@@ -42,7 +42,7 @@ func TestSaleItemService_GetByID(t *testing.T) {
 		mockRepo := new(mock_item.MockSaleItem)
 		item := &models.SaleItem{ID: 1}
 		mockRepo.On("GetByID", ctx, int64(1)).Return(item, nil)
-		service := NewItemSaleService(mockRepo)
+		service := NewItemSaleService(mockRepo, nil, nil, nil, nil, nil, nil)
 
 		result, err := service.GetByID(ctx, 1)
 
@@ -57,7 +57,7 @@ func TestSaleItemService_GetBySaleID(t *testing.T) {
 
 	t.Run("saleID inválido retorna erro", func(t *testing.T) {
 		mockRepo := new(mock_item.MockSaleItem)
-		service := NewItemSaleService(mockRepo)
+		service := NewItemSaleService(mockRepo, nil, nil, nil, nil, nil, nil)
 
 		result, err := service.GetBySaleID(ctx, 0, 10, 0)
 
@@ -68,7 +68,7 @@ func TestSaleItemService_GetBySaleID(t *testing.T) {
 
 	t.Run("paginação inválida retorna erro", func(t *testing.T) {
 		mockRepo := new(mock_item.MockSaleItem)
-		service := NewItemSaleService(mockRepo)
+		service := NewItemSaleService(mockRepo, nil, nil, nil, nil, nil, nil)
 
 		result, err := service.GetBySaleID(ctx, 1, 0, -1)
 
@@ -80,7 +80,7 @@ func TestSaleItemService_GetBySaleID(t *testing.T) {
 	t.Run("erro do repositório é propagado", func(t *testing.T) {
 		mockRepo := new(mock_item.MockSaleItem)
 		mockRepo.On("GetBySaleID", ctx, int64(1), 10, 0).Return(nil, errors.New("db error"))
-		service := NewItemSaleService(mockRepo)
+		service := NewItemSaleService(mockRepo, nil, nil, nil, nil, nil, nil)
 
 		result, err := service.GetBySaleID(ctx, 1, 10, 0)
 
@@ -93,7 +93,7 @@ func TestSaleItemService_GetBySaleID(t *testing.T) {
 		mockRepo := new(mock_item.MockSaleItem)
 		items := []*models.SaleItem{{ID: 1}, {ID: 2}}
 		mockRepo.On("GetBySaleID", ctx, int64(1), 10, 0).Return(items, nil)
-		service := NewItemSaleService(mockRepo)
+		service := NewItemSaleService(mockRepo, nil, nil, nil, nil, nil, nil)
 
 		result, err := service.GetBySaleID(ctx, 1, 10, 0)
 
@@ -108,7 +108,7 @@ func TestSaleItemService_GetByProductID(t *testing.T) {
 
 	t.Run("productID inválido retorna erro", func(t *testing.T) {
 		mockRepo := new(mock_item.MockSaleItem)
-		service := NewItemSaleService(mockRepo)
+		service := NewItemSaleService(mockRepo, nil, nil, nil, nil, nil, nil)
 
 		result, err := service.GetByProductID(ctx, 0, 10, 0)
 
@@ -119,7 +119,7 @@ func TestSaleItemService_GetByProductID(t *testing.T) {
 
 	t.Run("paginação inválida retorna erro", func(t *testing.T) {
 		mockRepo := new(mock_item.MockSaleItem)
-		service := NewItemSaleService(mockRepo)
+		service := NewItemSaleService(mockRepo, nil, nil, nil, nil, nil, nil)
 
 		result, err := service.GetByProductID(ctx, 1, -5, -1)
 
@@ -131,7 +131,7 @@ func TestSaleItemService_GetByProductID(t *testing.T) {
 	t.Run("erro do repositório é propagado", func(t *testing.T) {
 		mockRepo := new(mock_item.MockSaleItem)
 		mockRepo.On("GetByProductID", ctx, int64(1), 10, 0).Return(nil, errors.New("db error"))
-		service := NewItemSaleService(mockRepo)
+		service := NewItemSaleService(mockRepo, nil, nil, nil, nil, nil, nil)
 
 		result, err := service.GetByProductID(ctx, 1, 10, 0)
 
@@ -144,7 +144,7 @@ func TestSaleItemService_GetByProductID(t *testing.T) {
 		mockRepo := new(mock_item.MockSaleItem)
 		items := []*models.SaleItem{{ID: 1}, {ID: 2}}
 		mockRepo.On("GetByProductID", ctx, int64(1), 10, 0).Return(items, nil)
-		service := NewItemSaleService(mockRepo)
+		service := NewItemSaleService(mockRepo, nil, nil, nil, nil, nil, nil)
 
 		result, err := service.GetByProductID(ctx, 1, 10, 0)
 
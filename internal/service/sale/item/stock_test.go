@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	mockSale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	modelsPrice "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	modelsSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	saleService "github.com/WagaoCarvalho/backend_store_go/internal/service/sale/sale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const saleLocation = int64(3)
+
+// newStockItemService troca o mock de estoque permissivo de newItemService
+// por um sem expectativas de movimento, para conferir cada um.
+func newStockItemService() (SaleItemService, itemMocks) {
+	_, m := newItemService()
+	m.repoStock = new(mockProduct.MockProductStockTx)
+	m.repoStock.On("GetByIDForUpdateTx", mock.Anything, mock.Anything, mock.Anything).Return(sellableProduct(), nil).Maybe()
+	return NewItemSaleService(m.repo, m.repoSale, m.repoItem, m.repoCredit, m.repoCnpj, m.repoPrice, m.repoStock), m
+}
+
+func saleAtLocation() *modelsSale.Sale {
+	return &modelsSale.Sale{ID: 1, Status: "active", LocationID: saleLocation, Version: 1}
+}
+
+func saleMovement(reason string) interface{} {
+	return mock.MatchedBy(func(o modelsMovement.Origin) bool {
+		return o.Reason == reason && o.RefType == modelsMovement.RefSale && *o.RefID == 1
+	})
+}
+
+func TestSaleItemService_Stock(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("criar baixa a quantidade no local da venda", func(t *testing.T) {
+		svc, m := newStockItemService()
+		i := validItem()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(saleAtLocation(), nil)
+		m.repoItem.On("CreateTx", ctx, m.tx, i).Return(i, nil)
+		m.repoStock.On("DecreaseStockAtTx", ctx, m.tx, int64(1), saleLocation, 2, saleMovement(modelsMovement.ReasonSale)).Return(nil).Once()
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		_, err := svc.Create(ctx, i)
+
+		require.NoError(t, err)
+		m.repoStock.AssertExpectations(t)
+	})
+
+	t.Run("estoque insuficiente faz rollback", func(t *testing.T) {
+		svc, m := newStockItemService()
+		i := validItem()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(saleAtLocation(), nil)
+		m.repoItem.On("CreateTx", ctx, m.tx, i).Return(i, nil)
+		m.repoStock.On("DecreaseStockAtTx", ctx, m.tx, int64(1), saleLocation, 2, mock.Anything).Return(errMsg.ErrInsufficientStock)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		_, err := svc.Create(ctx, i)
+
+		assert.ErrorIs(t, err, errMsg.ErrInsufficientStock)
+		m.tx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("alterar a quantidade movimenta só a diferença", func(t *testing.T) {
+		svc, m := newStockItemService()
+		i := validItem()
+		i.Quantity = 5
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(saleAtLocation(), nil)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(validItem(), nil) // quantidade 2
+		m.repoItem.On("UpdateTx", ctx, m.tx, i).Return(nil)
+		m.repoStock.On("DecreaseStockAtTx", ctx, m.tx, int64(1), saleLocation, 3, saleMovement(modelsMovement.ReasonSale)).Return(nil).Once()
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		require.NoError(t, svc.Update(ctx, i))
+		m.repoStock.AssertExpectations(t)
+		m.repoStock.AssertNotCalled(t, "IncreaseStockAtTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("trocar o produto devolve o anterior e baixa o novo", func(t *testing.T) {
+		svc, m := newStockItemService()
+		i := validItem()
+		i.ProductID = 2
+		m.repoPrice.On("EffectiveAtTx", ctx, m.tx, int64(2), mock.Anything).
+			Return(&modelsPrice.EffectivePrice{ProductID: 2, SalePrice: money.New(10)}, nil)
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(saleAtLocation(), nil)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(validItem(), nil)
+		m.repoItem.On("UpdateTx", ctx, m.tx, i).Return(nil)
+		m.repoStock.On("IncreaseStockAtTx", ctx, m.tx, int64(1), saleLocation, 2, saleMovement(modelsMovement.ReasonReturn)).Return(nil).Once()
+		m.repoStock.On("DecreaseStockAtTx", ctx, m.tx, int64(2), saleLocation, 2, saleMovement(modelsMovement.ReasonSale)).Return(nil).Once()
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		require.NoError(t, svc.Update(ctx, i))
+		m.repoStock.AssertExpectations(t)
+	})
+
+	t.Run("item de outra venda não é alterado", func(t *testing.T) {
+		svc, m := newStockItemService()
+		other := validItem()
+		other.SaleID = 9
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(saleAtLocation(), nil)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(other, nil)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		err := svc.Update(ctx, validItem())
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+		m.repoItem.AssertNotCalled(t, "UpdateTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("excluir devolve a quantidade ao local da venda", func(t *testing.T) {
+		svc, m := newStockItemService()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(validItem(), nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(saleAtLocation(), nil)
+		m.repoItem.On("DeleteTx", ctx, m.tx, int64(1)).Return(nil)
+		m.repoStock.On("IncreaseStockAtTx", ctx, m.tx, int64(1), saleLocation, 2, saleMovement(modelsMovement.ReasonReturn)).Return(nil).Once()
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		require.NoError(t, svc.Delete(ctx, 1))
+		m.repoStock.AssertExpectations(t)
+	})
+
+	t.Run("excluir todos devolve a soma por produto", func(t *testing.T) {
+		svc, m := newStockItemService()
+		second := validItem()
+		second.ID, second.Quantity = 2, 4
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(saleAtLocation(), nil)
+		m.repoItem.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return([]*models.SaleItem{validItem(), second}, nil)
+		m.repoItem.On("DeleteBySaleIDTx", ctx, m.tx, int64(1)).Return(nil)
+		m.repoStock.On("IncreaseStockAtTx", ctx, m.tx, int64(1), saleLocation, 6, saleMovement(modelsMovement.ReasonReturn)).Return(nil).Once()
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		require.NoError(t, svc.DeleteBySaleID(ctx, 1))
+		m.repoStock.AssertExpectations(t)
+	})
+}
+
+// TestSaleItemService_StockThenCancel confere, com um saldo em memória, que
+// o cancelamento devolve exatamente o que saiu do estoque mesmo depois de
+// itens incluídos ou excluídos na venda ativa.
+func TestSaleItemService_StockThenCancel(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (SaleItemService, saleService.SaleService, itemMocks, map[int64]int) {
+		svc, m := newStockItemService()
+		// Estoque depois do checkout da venda com 2 unidades do produto 1
+		stock := map[int64]int{1: 8, 2: 5}
+
+		m.repoStock.On("DecreaseStockAtTx", ctx, m.tx, mock.Anything, saleLocation, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { stock[args.Get(2).(int64)] -= args.Int(4) }).Return(nil)
+		m.repoStock.On("IncreaseStockAtTx", ctx, m.tx, mock.Anything, saleLocation, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { stock[args.Get(2).(int64)] += args.Int(4) }).Return(nil)
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(saleAtLocation(), nil)
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Return(nil)
+		m.repoSale.On("UpdateStatusTx", ctx, m.tx, mock.Anything).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+		// Venda sem pagamentos: nada a devolver em dinheiro
+		payments := new(mockSale.MockSalePaymentTx)
+		payments.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(nil, nil)
+
+		sales := saleService.NewSaleService(nil, nil, m.repoSale, m.repoItem, nil, m.repoStock, m.repoCredit, m.repoCnpj, payments, nil, nil)
+		return svc, sales, m, stock
+	}
+
+	t.Run("incluir e cancelar", func(t *testing.T) {
+		svc, sales, m, stock := setup(t)
+		added := &models.SaleItem{ID: 2, SaleID: 1, ProductID: 1, Quantity: 3}
+		m.repoItem.On("CreateTx", ctx, m.tx, added).Return(added, nil)
+
+		_, err := svc.Create(ctx, added)
+		require.NoError(t, err)
+		assert.Equal(t, 5, stock[1])
+
+		m.repoItem.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return([]*models.SaleItem{validItem(), added}, nil)
+		require.NoError(t, sales.Cancel(ctx, 1))
+
+		assert.Equal(t, map[int64]int{1: 10, 2: 5}, stock)
+	})
+
+	t.Run("excluir e cancelar", func(t *testing.T) {
+		svc, sales, m, stock := setup(t)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(validItem(), nil)
+		m.repoItem.On("DeleteTx", ctx, m.tx, int64(1)).Return(nil)
+
+		require.NoError(t, svc.Delete(ctx, 1))
+		assert.Equal(t, 10, stock[1])
+
+		m.repoItem.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return([]*models.SaleItem{}, nil)
+		require.NoError(t, sales.Cancel(ctx, 1))
+
+		assert.Equal(t, map[int64]int{1: 10, 2: 5}, stock)
+	})
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	ifaceClient "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cpf"
+	modelsCredit "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	modelsSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/jackc/pgx/v5"
+)
+
+// lockActiveSale bloqueia a venda até o fim da transação e garante que ela
+// ainda aceita alterações nos itens.
+func (s *saleItemService) lockActiveSale(ctx context.Context, tx pgx.Tx, saleID int64) (*modelsSale.Sale, error) {
+	sale, err := s.repoSale.GetByIDForUpdateTx(ctx, tx, saleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sale.Status != "active" {
+		return nil, fmt.Errorf("%w: status atual %q", errMsg.ErrSaleNotActive, sale.Status)
+	}
+
+	return sale, nil
+}
+
+// priceItemTx aplica ao item o preço de venda vigente agora, já considerando
+// mudanças agendadas vencidas, e confere as mesmas regras de produto e
+// desconto do checkout sobre o produto bloqueado na transação.
+func (s *saleItemService) priceItemTx(ctx context.Context, tx pgx.Tx, item *models.SaleItem) error {
+	product, err := s.repoStock.GetByIDForUpdateTx(ctx, tx, item.ProductID)
+	if err != nil {
+		return err
+	}
+
+	price, err := s.repoPrice.EffectiveAtTx(ctx, tx, item.ProductID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	item.ApplyUnitPrice(price.SalePrice)
+
+	if err := item.CheckProduct(product); err != nil {
+		return fmt.Errorf("%w: %w", errMsg.ErrInvalidData, err)
+	}
+
+	if err := item.ValidateStructural(); err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	return nil
+}
+
+// moveStockTx acerta o estoque do local da venda pela variação de quantidade
+// de cada produto: positiva baixa, negativa devolve. Assim os itens da venda
+// ativa correspondem sempre ao que saiu do estoque, que é o que cancelamento,
+// devolução e reativação movimentam. Os produtos seguem a ordem crescente de
+// ID, como no checkout, para evitar deadlock.
+func (s *saleItemService) moveStockTx(ctx context.Context, tx pgx.Tx, sale *modelsSale.Sale, deltas map[int64]int) error {
+	ids := make([]int64, 0, len(deltas))
+	for id := range deltas {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, productID := range ids {
+		delta := deltas[productID]
+		switch {
+		case delta > 0:
+			origin := modelsMovement.NewOrigin(modelsMovement.ReasonSale, modelsMovement.RefSale, sale.ID)
+			if err := s.repoStock.DecreaseStockAtTx(ctx, tx, productID, sale.LocationID, delta, origin); err != nil {
+				return err
+			}
+		case delta < 0:
+			origin := modelsMovement.NewOrigin(modelsMovement.ReasonReturn, modelsMovement.RefSale, sale.ID)
+			if err := s.repoStock.IncreaseStockAtTx(ctx, tx, productID, sale.LocationID, -delta, origin); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// recalculateTotalsTx recalcula os totais da venda e, quando ela é a crédito,
+// lança no razão do cliente a diferença do total: cobrança quando aumenta,
+// estorno quando diminui.
+func (s *saleItemService) recalculateTotalsTx(ctx context.Context, tx pgx.Tx, sale *modelsSale.Sale) error {
+	previousTotal := sale.TotalAmount
+
+	if err := s.repoSale.RecalculateTotalsTx(ctx, tx, sale); err != nil {
+		return err
+	}
+
+	ledger, clientID, ok := s.creditLedger(sale)
+	if sale.PaymentType != "credit" || !ok {
+		return nil
+	}
+
+	delta := sale.TotalAmount.Sub(previousTotal)
+	switch {
+	case delta.IsPositive():
+		entry := modelsCredit.NewSaleEntry(clientID, sale.ID, delta, fmt.Sprintf("ajuste da venda %d", sale.ID))
+		return ledger.ChargeTx(ctx, tx, entry)
+	case delta.IsNegative():
+		entry := modelsCredit.NewSaleEntry(clientID, sale.ID, delta.Neg(), fmt.Sprintf("ajuste da venda %d", sale.ID))
+		return ledger.RefundTx(ctx, tx, entry)
+	}
+
+	return nil
+}
+
+// creditLedger escolhe o razão de crédito pelo tipo de cliente da venda
+// (pessoa física ou jurídica). ok é falso quando a venda não tem cliente.
+func (s *saleItemService) creditLedger(sale *modelsSale.Sale) (ifaceClient.ClientCreditTx, int64, bool) {
+	switch {
+	case sale.ClientCnpjID != nil:
+		return s.repoCnpj, *sale.ClientCnpjID, true
+	case sale.ClientID != nil:
+		return s.repoCredit, *sale.ClientID, true
+	}
+	return nil, 0, false
+}
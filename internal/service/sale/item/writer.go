@@ -6,8 +6,13 @@ import (
 
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
 )
 
+// Create adiciona o item à venda pelo preço vigente do produto; unit_price
+// e subtotal enviados pelo cliente são ignorados. A quantidade sai do
+// estoque do local da venda na mesma transação.
 func (s *saleItemService) Create(ctx context.Context, item *models.SaleItem) (*models.SaleItem, error) {
 	if item == nil {
 		return nil, errMsg.ErrInvalidData
@@ -17,11 +22,29 @@ func (s *saleItemService) Create(ctx context.Context, item *models.SaleItem) (*m
 		return nil, fmt.Errorf("%w", errMsg.ErrInvalidData)
 	}
 
-	if err := item.ValidateBusinessRules(); err != nil {
-		return nil, fmt.Errorf("%w", errMsg.ErrInvalidData)
-	}
+	var createdItem *models.SaleItem
+
+	err := repoDb.RunInTx(ctx, s.repoSale, func(tx pgx.Tx) error {
+		sale, err := s.lockActiveSale(ctx, tx, item.SaleID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.priceItemTx(ctx, tx, item); err != nil {
+			return err
+		}
+
+		createdItem, err = s.repoItem.CreateTx(ctx, tx, item)
+		if err != nil {
+			return err
+		}
 
-	createdItem, err := s.repo.Create(ctx, item)
+		if err := s.moveStockTx(ctx, tx, sale, map[int64]int{item.ProductID: item.Quantity}); err != nil {
+			return err
+		}
+
+		return s.recalculateTotalsTx(ctx, tx, sale)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -29,6 +52,9 @@ func (s *saleItemService) Create(ctx context.Context, item *models.SaleItem) (*m
 	return createdItem, nil
 }
 
+// Update regrava o item, também pelo preço vigente do produto. O estoque
+// acompanha a diferença de quantidade; trocar o produto devolve o anterior e
+// baixa o novo.
 func (s *saleItemService) Update(ctx context.Context, item *models.SaleItem) error {
 	if item == nil {
 		return errMsg.ErrInvalidData
@@ -38,11 +64,36 @@ func (s *saleItemService) Update(ctx context.Context, item *models.SaleItem) err
 		return fmt.Errorf("%w", errMsg.ErrInvalidData)
 	}
 
-	if err := item.ValidateBusinessRules(); err != nil {
-		return fmt.Errorf("%w", errMsg.ErrInvalidData)
-	}
+	return repoDb.RunInTx(ctx, s.repoSale, func(tx pgx.Tx) error {
+		sale, err := s.lockActiveSale(ctx, tx, item.SaleID)
+		if err != nil {
+			return err
+		}
+
+		current, err := s.repoItem.GetByIDTx(ctx, tx, item.ID)
+		if err != nil {
+			return err
+		}
+		if current.SaleID != item.SaleID {
+			return fmt.Errorf("%w: item %d não pertence à venda %d", errMsg.ErrNotFound, item.ID, item.SaleID)
+		}
+
+		if err := s.priceItemTx(ctx, tx, item); err != nil {
+			return err
+		}
 
-	return s.repo.Update(ctx, item)
+		if err := s.repoItem.UpdateTx(ctx, tx, item); err != nil {
+			return err
+		}
+
+		deltas := map[int64]int{current.ProductID: -current.Quantity}
+		deltas[item.ProductID] += item.Quantity
+		if err := s.moveStockTx(ctx, tx, sale, deltas); err != nil {
+			return err
+		}
+
+		return s.recalculateTotalsTx(ctx, tx, sale)
+	})
 }
 
 func (s *saleItemService) Delete(ctx context.Context, id int64) error {
@@ -50,7 +101,27 @@ func (s *saleItemService) Delete(ctx context.Context, id int64) error {
 		return errMsg.ErrZeroID
 	}
 
-	return s.repo.Delete(ctx, id)
+	return repoDb.RunInTx(ctx, s.repoSale, func(tx pgx.Tx) error {
+		item, err := s.repoItem.GetByIDTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		sale, err := s.lockActiveSale(ctx, tx, item.SaleID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.repoItem.DeleteTx(ctx, tx, id); err != nil {
+			return err
+		}
+
+		if err := s.moveStockTx(ctx, tx, sale, map[int64]int{item.ProductID: -item.Quantity}); err != nil {
+			return err
+		}
+
+		return s.recalculateTotalsTx(ctx, tx, sale)
+	})
 }
 
 func (s *saleItemService) DeleteBySaleID(ctx context.Context, saleID int64) error {
@@ -58,5 +129,29 @@ func (s *saleItemService) DeleteBySaleID(ctx context.Context, saleID int64) erro
 		return errMsg.ErrZeroID
 	}
 
-	return s.repo.DeleteBySaleID(ctx, saleID)
+	return repoDb.RunInTx(ctx, s.repoSale, func(tx pgx.Tx) error {
+		sale, err := s.lockActiveSale(ctx, tx, saleID)
+		if err != nil {
+			return err
+		}
+
+		items, err := s.repoItem.GetBySaleIDTx(ctx, tx, saleID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.repoItem.DeleteBySaleIDTx(ctx, tx, saleID); err != nil {
+			return err
+		}
+
+		deltas := make(map[int64]int, len(items))
+		for _, item := range items {
+			deltas[item.ProductID] -= item.Quantity
+		}
+		if err := s.moveStockTx(ctx, tx, sale, deltas); err != nil {
+			return err
+		}
+
+		return s.recalculateTotalsTx(ctx, tx, sale)
+	})
 }
@@ -5,226 +5,594 @@ import (
 	"errors"
 	"testing"
 
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cpf"
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	mockTX "github.com/WagaoCarvalho/backend_store_go/infra/mock/repo"
 	mockItem "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	modelsCredit "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	modelsPrice "github.com/WagaoCarvalho/backend_store_go/internal/model/product/price"
+	modelsProduct "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	modelsSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
+type itemMocks struct {
+	repo       *mockItem.MockSaleItem
+	repoSale   *mockItem.MockSaleTx
+	repoItem   *mockItem.MockSaleItemTx
+	repoCredit *mockClient.MockClientCreditTx
+	repoCnpj   *mockClient.MockClientCreditTx
+	repoPrice  *mockProduct.PriceMock
+	repoStock  *mockProduct.MockProductStockTx
+	tx         *mockTX.MockTx
+}
+
+func newItemService() (SaleItemService, itemMocks) {
+	m := itemMocks{
+		repo:       new(mockItem.MockSaleItem),
+		repoSale:   new(mockItem.MockSaleTx),
+		repoItem:   new(mockItem.MockSaleItemTx),
+		repoCredit: new(mockClient.MockClientCreditTx),
+		repoCnpj:   new(mockClient.MockClientCreditTx),
+		repoPrice:  new(mockProduct.PriceMock),
+		repoStock:  new(mockProduct.MockProductStockTx),
+		tx:         new(mockTX.MockTx),
+	}
+	// O produto 1 custa 10, o preço usado em validItem
+	m.repoPrice.On("EffectiveAtTx", mock.Anything, mock.Anything, int64(1), mock.Anything).
+		Return(&modelsPrice.EffectivePrice{ProductID: 1, SalePrice: money.New(10)}, nil).Maybe()
+	m.repoStock.On("GetByIDForUpdateTx", mock.Anything, mock.Anything, mock.Anything).Return(sellableProduct(), nil).Maybe()
+	// Movimentos de estoque conferidos nos testes de estoque
+	m.repoStock.On("DecreaseStockAtTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	m.repoStock.On("IncreaseStockAtTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return NewItemSaleService(m.repo, m.repoSale, m.repoItem, m.repoCredit, m.repoCnpj, m.repoPrice, m.repoStock), m
+}
+
+// sellableProduct é um produto ativo que aceita desconto de até 10%.
+func sellableProduct() *modelsProduct.Product {
+	return &modelsProduct.Product{Status: true, AllowDiscount: true, MaxDiscountPercent: 10}
+}
+
+func validItem() *models.SaleItem {
+	return &models.SaleItem{
+		ID:        1,
+		SaleID:    1,
+		ProductID: 1,
+		Quantity:  2,
+		UnitPrice: money.New(10),
+		Discount:  money.New(1),
+		Tax:       money.New(0),
+		Subtotal:  money.New(19), // 2*10 - 1 + 0
+	}
+}
+
+func activeSale() *modelsSale.Sale {
+	return &modelsSale.Sale{ID: 1, Status: "active", Version: 1}
+}
+
 func TestSaleItemService_Create(t *testing.T) {
-	mockRepo := new(mockItem.MockSaleItem)
-	svc := NewItemSaleService(mockRepo)
 	ctx := context.Background()
 
 	t.Run("item nil", func(t *testing.T) {
+		svc, _ := newItemService()
 		result, err := svc.Create(ctx, nil)
 		assert.Nil(t, result)
 		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
 	})
 
 	t.Run("structural validation fails", func(t *testing.T) {
+		svc, _ := newItemService()
 		i := &models.SaleItem{
 			SaleID:    0,
 			ProductID: -1,
 			Quantity:  0,
-			UnitPrice: -10,
-			Discount:  -1,
-			Tax:       -1,
-			Subtotal:  -1,
+			UnitPrice: money.New(-10),
+			Discount:  money.New(-1),
+			Tax:       money.New(-1),
+			Subtotal:  money.New(-1),
 		}
 		result, err := svc.Create(ctx, i)
 		assert.Nil(t, result)
 		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
 	})
 
-	t.Run("business validation fails", func(t *testing.T) {
-		i := &models.SaleItem{
-			SaleID:    1,
-			ProductID: 1,
-			Quantity:  2,
-			UnitPrice: 10,
-			Discount:  1,
-			Tax:       0,
-			Subtotal:  10, // errado: deveria ser 19
-		}
+	t.Run("begin tx fails", func(t *testing.T) {
+		svc, m := newItemService()
+		m.repoSale.On("BeginTx", ctx).Return(nil, errors.New("db down"))
+
+		result, err := svc.Create(ctx, validItem())
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "erro ao iniciar transação")
+	})
+
+	t.Run("sale not found rolls back", func(t *testing.T) {
+		svc, m := newItemService()
+		i := validItem()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(nil, errMsg.ErrNotFound)
+		m.tx.On("Rollback", ctx).Return(nil)
+
 		result, err := svc.Create(ctx, i)
 		assert.Nil(t, result)
-		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("sale not active rolls back", func(t *testing.T) {
+		svc, m := newItemService()
+		i := validItem()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&modelsSale.Sale{ID: 1, Status: "completed"}, nil)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		result, err := svc.Create(ctx, i)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrSaleNotActive)
+		m.repoItem.AssertNotCalled(t, "CreateTx", mock.Anything, mock.Anything, mock.Anything)
+		m.tx.AssertExpectations(t)
 	})
 
 	t.Run("repo returns error", func(t *testing.T) {
-		i := &models.SaleItem{
-			SaleID:    1,
-			ProductID: 1,
-			Quantity:  2,
-			UnitPrice: 10,
-			Discount:  1,
-			Tax:       0,
-			Subtotal:  19, // correto: 2*10 - 1 + 0
-		}
+		svc, m := newItemService()
+		i := validItem()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(activeSale(), nil)
+		m.repoItem.On("CreateTx", ctx, m.tx, i).Return(nil, errors.New("repo error"))
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		result, err := svc.Create(ctx, i)
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "repo error")
+		m.repoSale.AssertNotCalled(t, "RecalculateTotalsTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("recalculate totals fails rolls back", func(t *testing.T) {
+		svc, m := newItemService()
+		i := validItem()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(activeSale(), nil)
+		m.repoItem.On("CreateTx", ctx, m.tx, i).Return(i, nil)
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Return(errMsg.ErrInvalidData)
+		m.tx.On("Rollback", ctx).Return(errors.New("rollback failed"))
+
+		result, err := svc.Create(ctx, i)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		assert.ErrorContains(t, err, "rollback error")
+	})
 
-		mockRepo.On("Create", ctx, i).Return(nil, errors.New("repo error")).Once()
+	t.Run("commit fails", func(t *testing.T) {
+		svc, m := newItemService()
+		i := validItem()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(activeSale(), nil)
+		m.repoItem.On("CreateTx", ctx, m.tx, i).Return(i, nil)
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Return(nil)
+		m.tx.On("Commit", ctx).Return(errors.New("commit failed"))
+		m.tx.On("Rollback", ctx).Return(nil)
 
 		result, err := svc.Create(ctx, i)
 		assert.Nil(t, result)
-		assert.Error(t, err)
-		mockRepo.AssertExpectations(t)
+		assert.ErrorContains(t, err, "erro ao commitar transação")
 	})
 
 	t.Run("success", func(t *testing.T) {
-		i := &models.SaleItem{
-			SaleID:    1,
-			ProductID: 1,
-			Quantity:  2,
-			UnitPrice: 10,
-			Discount:  1,
-			Tax:       0,
-			Subtotal:  19, // válido
+		svc, m := newItemService()
+		i := validItem()
+		created := validItem()
+		created.ID = 10
+
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(activeSale(), nil)
+		m.repoItem.On("CreateTx", ctx, m.tx, i).Return(created, nil)
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.MatchedBy(func(s *modelsSale.Sale) bool {
+			return s.ID == 1
+		})).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		result, err := svc.Create(ctx, i)
+		assert.NoError(t, err)
+		assert.Equal(t, created, result)
+		m.repoSale.AssertExpectations(t)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("preço enviado pelo cliente é trocado pelo preço vigente", func(t *testing.T) {
+		svc, m := newItemService()
+		i := validItem()
+		i.ProductID = 2
+		i.UnitPrice, i.Subtotal = money.New(1), money.New(1) // valores do cliente, ignorados
+
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(activeSale(), nil)
+		m.repoPrice.On("EffectiveAtTx", ctx, m.tx, int64(2), mock.Anything).
+			Return(&modelsPrice.EffectivePrice{ProductID: 2, SalePrice: money.MustParse("12.50")}, nil).Once()
+		m.repoItem.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(item *models.SaleItem) bool {
+			return item.UnitPrice == money.MustParse("12.50") && item.Subtotal == money.New(24) // 2 * 12.5 - 1
+		})).Return(i, nil)
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		_, err := svc.Create(ctx, i)
+		assert.NoError(t, err)
+		m.repoItem.AssertExpectations(t)
+	})
+
+	t.Run("regras do produto travado na transação", func(t *testing.T) {
+		cases := []struct {
+			name    string
+			product *modelsProduct.Product
+			want    error
+		}{
+			{"produto desativado", &modelsProduct.Product{Status: false, AllowDiscount: true}, errMsg.ErrProductDisabled},
+			{"produto sem desconto", &modelsProduct.Product{Status: true}, errMsg.ErrProductDiscountNotAllowed},
+			{"desconto acima do limite", &modelsProduct.Product{Status: true, AllowDiscount: true, MaxDiscountPercent: 4}, errMsg.ErrInvalidDiscountPercent},
 		}
 
-		created := &models.SaleItem{
-			ID:        1,
-			SaleID:    i.SaleID,
-			ProductID: i.ProductID,
-			Quantity:  i.Quantity,
-			UnitPrice: i.UnitPrice,
-			Discount:  i.Discount,
-			Tax:       i.Tax,
-			Subtotal:  i.Subtotal,
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, m := newItemService()
+				m.repoStock = new(mockProduct.MockProductStockTx)
+				svc := NewItemSaleService(m.repo, m.repoSale, m.repoItem, m.repoCredit, m.repoCnpj, m.repoPrice, m.repoStock)
+
+				m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+				m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(activeSale(), nil)
+				m.repoStock.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(tc.product, nil).Once()
+				m.tx.On("Rollback", ctx).Return(nil)
+
+				result, err := svc.Create(ctx, validItem()) // desconto de 1 em 20 (5%)
+				assert.Nil(t, result)
+				assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+				assert.ErrorIs(t, err, tc.want)
+				m.repoItem.AssertNotCalled(t, "CreateTx", mock.Anything, mock.Anything, mock.Anything)
+				m.repoStock.AssertNotCalled(t, "DecreaseStockAtTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			})
 		}
+	})
 
-		mockRepo.On("Create", ctx, i).Return(created, nil).Once()
+	t.Run("produto sem preço vigente faz rollback", func(t *testing.T) {
+		svc, m := newItemService()
+		i := validItem()
+		i.ProductID = 3
+
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(activeSale(), nil)
+		m.repoPrice.On("EffectiveAtTx", ctx, m.tx, int64(3), mock.Anything).Return(nil, errMsg.ErrNotFound).Once()
+		m.tx.On("Rollback", ctx).Return(nil)
 
 		result, err := svc.Create(ctx, i)
-		assert.NoError(t, err)
-		assert.Equal(t, created, result)
-		mockRepo.AssertExpectations(t)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+		m.repoItem.AssertNotCalled(t, "CreateTx", mock.Anything, mock.Anything, mock.Anything)
 	})
 }
 
 func TestSaleItemService_Update(t *testing.T) {
-	mockRepo := new(mockItem.MockSaleItem)
-	svc := NewItemSaleService(mockRepo)
 	ctx := context.Background()
 
 	t.Run("item nil", func(t *testing.T) {
+		svc, _ := newItemService()
 		err := svc.Update(ctx, nil)
 		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
 	})
 
 	t.Run("structural validation fails", func(t *testing.T) {
+		svc, _ := newItemService()
 		i := &models.SaleItem{
 			SaleID:    0,
 			ProductID: -1,
 			Quantity:  0,
-			UnitPrice: -10,
-			Discount:  -1,
-			Tax:       -1,
-			Subtotal:  -1,
+			UnitPrice: money.New(-10),
+			Discount:  money.New(-1),
+			Tax:       money.New(-1),
+			Subtotal:  money.New(-1),
 		}
 		err := svc.Update(ctx, i)
 		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
 	})
 
-	t.Run("business validation fails", func(t *testing.T) {
-		i := &models.SaleItem{
-			SaleID:    1,
-			ProductID: 1,
-			Quantity:  2,
-			UnitPrice: 10,
-			Discount:  1,
-			Tax:       0,
-			Subtotal:  0, // incorreto
-		}
+	t.Run("desconto maior que o valor do item faz rollback", func(t *testing.T) {
+		svc, m := newItemService()
+		i := validItem()
+		i.Discount = money.New(25) // 2 * 10 = 20
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(activeSale(), nil)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(validItem(), nil)
+		m.tx.On("Rollback", ctx).Return(nil)
+
 		err := svc.Update(ctx, i)
 		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.repoItem.AssertNotCalled(t, "UpdateTx", mock.Anything, mock.Anything, mock.Anything)
 	})
 
-	t.Run("repo returns error", func(t *testing.T) {
-		i := &models.SaleItem{
-			ID:        1,
-			SaleID:    1,
-			ProductID: 1,
-			Quantity:  2,
-			UnitPrice: 10,
-			Discount:  1,
-			Tax:       0,
-			Subtotal:  19,
-		}
+	t.Run("desconto acima do limite do produto faz rollback", func(t *testing.T) {
+		svc, m := newItemService()
+		i := validItem()
+		i.Discount = money.MustParse("2.01") // 10% de 2 * 10 = 2
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(activeSale(), nil)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(validItem(), nil)
+		m.tx.On("Rollback", ctx).Return(nil)
 
-		mockRepo.On("Update", ctx, i).Return(errors.New("repo error")).Once()
+		err := svc.Update(ctx, i)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidDiscountPercent)
+		m.repoItem.AssertNotCalled(t, "UpdateTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("sale not active", func(t *testing.T) {
+		svc, m := newItemService()
+		i := validItem()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&modelsSale.Sale{ID: 1, Status: "canceled"}, nil)
+		m.tx.On("Rollback", ctx).Return(nil)
 
 		err := svc.Update(ctx, i)
-		assert.Error(t, err)
-		mockRepo.AssertExpectations(t)
+		assert.ErrorIs(t, err, errMsg.ErrSaleNotActive)
+		m.repoItem.AssertNotCalled(t, "UpdateTx", mock.Anything, mock.Anything, mock.Anything)
 	})
 
-	t.Run("success", func(t *testing.T) {
-		i := &models.SaleItem{
-			ID:        1,
-			SaleID:    1,
-			ProductID: 1,
-			Quantity:  2,
-			UnitPrice: 10,
-			Discount:  1,
-			Tax:       0,
-			Subtotal:  19,
-		}
+	t.Run("repo returns error", func(t *testing.T) {
+		svc, m := newItemService()
+		i := validItem()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(activeSale(), nil)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(validItem(), nil)
+		m.repoItem.On("UpdateTx", ctx, m.tx, i).Return(errMsg.ErrNotFound)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		err := svc.Update(ctx, i)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
 
-		mockRepo.On("Update", ctx, i).Return(nil).Once()
+	t.Run("success", func(t *testing.T) {
+		svc, m := newItemService()
+		i := validItem()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(activeSale(), nil)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(validItem(), nil)
+		m.repoItem.On("UpdateTx", ctx, m.tx, i).Return(nil)
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
 
 		err := svc.Update(ctx, i)
 		assert.NoError(t, err)
-		mockRepo.AssertExpectations(t)
+		m.repoItem.AssertExpectations(t)
+		m.repoSale.AssertExpectations(t)
 	})
 }
 
 func TestSaleItemService_Delete(t *testing.T) {
-	mockRepo := new(mockItem.MockSaleItem)
-	svc := NewItemSaleService(mockRepo)
 	ctx := context.Background()
 
 	t.Run("id zero", func(t *testing.T) {
+		svc, _ := newItemService()
 		err := svc.Delete(ctx, 0)
 		assert.ErrorIs(t, err, errMsg.ErrZeroID)
 	})
 
+	t.Run("item not found", func(t *testing.T) {
+		svc, m := newItemService()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(nil, errMsg.ErrNotFound)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		err := svc.Delete(ctx, 1)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("sale not active", func(t *testing.T) {
+		svc, m := newItemService()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(validItem(), nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&modelsSale.Sale{ID: 1, Status: "returned"}, nil)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		err := svc.Delete(ctx, 1)
+		assert.ErrorIs(t, err, errMsg.ErrSaleNotActive)
+		m.repoItem.AssertNotCalled(t, "DeleteTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
 	t.Run("repo returns error", func(t *testing.T) {
-		mockRepo.On("Delete", ctx, int64(1)).Return(errors.New("repo error")).Once()
+		svc, m := newItemService()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(validItem(), nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(activeSale(), nil)
+		m.repoItem.On("DeleteTx", ctx, m.tx, int64(1)).Return(errors.New("repo error"))
+		m.tx.On("Rollback", ctx).Return(nil)
+
 		err := svc.Delete(ctx, 1)
-		assert.Error(t, err)
-		mockRepo.AssertExpectations(t)
+		assert.ErrorContains(t, err, "repo error")
 	})
 
 	t.Run("success", func(t *testing.T) {
-		mockRepo.On("Delete", ctx, int64(2)).Return(nil).Once()
-		err := svc.Delete(ctx, 2)
+		svc, m := newItemService()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(validItem(), nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(activeSale(), nil)
+		m.repoItem.On("DeleteTx", ctx, m.tx, int64(1)).Return(nil)
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		err := svc.Delete(ctx, 1)
 		assert.NoError(t, err)
-		mockRepo.AssertExpectations(t)
+		m.repoItem.AssertExpectations(t)
+		m.repoSale.AssertExpectations(t)
 	})
 }
 
 func TestSaleItemService_DeleteBySaleID(t *testing.T) {
-	mockRepo := new(mockItem.MockSaleItem)
-	svc := NewItemSaleService(mockRepo)
 	ctx := context.Background()
 
 	t.Run("saleID zero", func(t *testing.T) {
+		svc, _ := newItemService()
 		err := svc.DeleteBySaleID(ctx, 0)
 		assert.ErrorIs(t, err, errMsg.ErrZeroID)
 	})
 
+	t.Run("sale not active", func(t *testing.T) {
+		svc, m := newItemService()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&modelsSale.Sale{ID: 1, Status: "completed"}, nil)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		err := svc.DeleteBySaleID(ctx, 1)
+		assert.ErrorIs(t, err, errMsg.ErrSaleNotActive)
+	})
+
 	t.Run("repo returns error", func(t *testing.T) {
-		mockRepo.On("DeleteBySaleID", ctx, int64(1)).Return(errors.New("repo error")).Once()
+		svc, m := newItemService()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(activeSale(), nil)
+		m.repoItem.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return([]*models.SaleItem{validItem()}, nil)
+		m.repoItem.On("DeleteBySaleIDTx", ctx, m.tx, int64(1)).Return(errors.New("repo error"))
+		m.tx.On("Rollback", ctx).Return(nil)
+
 		err := svc.DeleteBySaleID(ctx, 1)
-		assert.Error(t, err)
-		mockRepo.AssertExpectations(t)
+		assert.ErrorContains(t, err, "repo error")
 	})
 
 	t.Run("success", func(t *testing.T) {
-		mockRepo.On("DeleteBySaleID", ctx, int64(2)).Return(nil).Once()
-		err := svc.DeleteBySaleID(ctx, 2)
+		svc, m := newItemService()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(activeSale(), nil)
+		m.repoItem.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return([]*models.SaleItem{validItem()}, nil)
+		m.repoItem.On("DeleteBySaleIDTx", ctx, m.tx, int64(1)).Return(nil)
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		err := svc.DeleteBySaleID(ctx, 1)
+		assert.NoError(t, err)
+		m.repoSale.AssertExpectations(t)
+	})
+}
+
+func TestSaleItemService_CreditSale(t *testing.T) {
+	ctx := context.Background()
+	clientID := int64(7)
+
+	creditSale := func() *modelsSale.Sale {
+		return &modelsSale.Sale{ID: 1, ClientID: &clientID, PaymentType: "credit", Status: "active", TotalAmount: money.New(50), Version: 1}
+	}
+	recalculateTo := func(total float64) func(mock.Arguments) {
+		return func(args mock.Arguments) {
+			args.Get(2).(*modelsSale.Sale).TotalAmount = money.MustFromFloat(total)
+		}
+	}
+	entry := func(amount float64) interface{} {
+		return mock.MatchedBy(func(e *modelsCredit.CreditEntry) bool {
+			return e.ClientID == clientID && e.Amount == money.MustFromFloat(amount) && *e.SaleID == 1
+		})
+	}
+
+	t.Run("aumento do total lança cobrança da diferença", func(t *testing.T) {
+		svc, m := newItemService()
+		i := validItem()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(creditSale(), nil)
+		m.repoItem.On("CreateTx", ctx, m.tx, i).Return(i, nil)
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Run(recalculateTo(69)).Return(nil)
+		m.repoCredit.On("ChargeTx", ctx, m.tx, entry(19)).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil)
+
+		_, err := svc.Create(ctx, i)
+		assert.NoError(t, err)
+		m.repoCredit.AssertExpectations(t)
+	})
+
+	t.Run("venda de cliente CNPJ movimenta o razão de pessoa jurídica", func(t *testing.T) {
+		svc, m := newItemService()
+		i := validItem()
+		sale := creditSale()
+		sale.ClientID, sale.ClientCnpjID = nil, &clientID
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(sale, nil)
+		m.repoItem.On("CreateTx", ctx, m.tx, i).Return(i, nil)
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Run(recalculateTo(69)).Return(nil)
+		m.repoCnpj.On("ChargeTx", ctx, m.tx, entry(19)).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil)
+
+		_, err := svc.Create(ctx, i)
+		assert.NoError(t, err)
+		m.repoCnpj.AssertExpectations(t)
+		m.repoCredit.AssertNotCalled(t, "ChargeTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("aumento acima do limite faz rollback", func(t *testing.T) {
+		svc, m := newItemService()
+		i := validItem()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(creditSale(), nil)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(validItem(), nil)
+		m.repoItem.On("UpdateTx", ctx, m.tx, i).Return(nil)
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Run(recalculateTo(60)).Return(nil)
+		m.repoCredit.On("ChargeTx", ctx, m.tx, entry(10)).Return(errMsg.ErrCreditLimitExceeded).Once()
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		err := svc.Update(ctx, i)
+		assert.ErrorIs(t, err, errMsg.ErrCreditLimitExceeded)
+		m.tx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("redução do total estorna a diferença", func(t *testing.T) {
+		svc, m := newItemService()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(validItem(), nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(creditSale(), nil)
+		m.repoItem.On("DeleteTx", ctx, m.tx, int64(1)).Return(nil)
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Run(recalculateTo(31)).Return(nil)
+		m.repoCredit.On("RefundTx", ctx, m.tx, entry(19)).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil)
+
+		err := svc.Delete(ctx, 1)
+		assert.NoError(t, err)
+		m.repoCredit.AssertExpectations(t)
+	})
+
+	t.Run("venda faturada de ordem de serviço mantém a mão de obra ao editar item", func(t *testing.T) {
+		svc, m := newItemService()
+		// Peça de 19,00 mais 50,00 de mão de obra, que não é item da venda
+		sale := creditSale()
+		sale.TotalItemsAmount, sale.TotalAmount = money.New(20), money.New(69)
+		i := validItem()
+		i.Quantity, i.Subtotal = 3, money.New(29)
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(sale, nil)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(validItem(), nil)
+		m.repoItem.On("UpdateTx", ctx, m.tx, i).Return(nil)
+		// O recálculo soma a mão de obra da ordem aos subtotais: 29 + 50
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, sale).Run(recalculateTo(79)).Return(nil)
+		m.repoCredit.On("ChargeTx", ctx, m.tx, entry(10)).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil)
+
+		err := svc.Update(ctx, i)
+		assert.NoError(t, err)
+		assert.Equal(t, money.New(79), sale.TotalAmount)
+		m.repoCredit.AssertExpectations(t)
+		m.repoCredit.AssertNotCalled(t, "RefundTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("total inalterado não movimenta crédito", func(t *testing.T) {
+		svc, m := newItemService()
+		i := validItem()
+		m.repoSale.On("BeginTx", ctx).Return(m.tx, nil)
+		m.repoSale.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(creditSale(), nil)
+		m.repoItem.On("GetByIDTx", ctx, m.tx, int64(1)).Return(validItem(), nil)
+		m.repoItem.On("UpdateTx", ctx, m.tx, i).Return(nil)
+		m.repoSale.On("RecalculateTotalsTx", ctx, m.tx, mock.Anything).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		err := svc.Update(ctx, i)
 		assert.NoError(t, err)
-		mockRepo.AssertExpectations(t)
+		m.repoCredit.AssertNotCalled(t, "ChargeTx", mock.Anything, mock.Anything, mock.Anything)
+		m.repoCredit.AssertNotCalled(t, "RefundTx", mock.Anything, mock.Anything, mock.Anything)
 	})
 }
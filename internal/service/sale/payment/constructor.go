@@ -0,0 +1,26 @@
+package services
+
+import (
+	ifaceSale "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+)
+
+type salePaymentService struct {
+	repoSale   ifaceSale.SaleReader
+	repoSaleTx ifaceSale.SaleTx
+	repo       ifaceSale.SalePaymentReader
+	repoTx     ifaceSale.SalePaymentTx
+}
+
+func NewSalePaymentService(
+	repoSale ifaceSale.SaleReader,
+	repoSaleTx ifaceSale.SaleTx,
+	repo ifaceSale.SalePaymentReader,
+	repoTx ifaceSale.SalePaymentTx,
+) SalePaymentService {
+	return &salePaymentService{
+		repoSale:   repoSale,
+		repoSaleTx: repoSaleTx,
+		repo:       repo,
+		repoTx:     repoTx,
+	}
+}
@@ -0,0 +1,14 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+)
+
+type SalePaymentService interface {
+	GetBySaleID(ctx context.Context, saleID int64) (*models.Summary, error)
+	Add(ctx context.Context, payment *models.SalePayment) (*models.Summary, error)
+	TotalsByMethod(ctx context.Context, from, to time.Time) ([]*models.MethodTotal, error)
+}
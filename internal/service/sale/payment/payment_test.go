@@ -0,0 +1,236 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockTX "github.com/WagaoCarvalho/backend_store_go/infra/mock/repo"
+	mockSale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	modelsSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type paymentMocks struct {
+	sale   *mockSale.MockSale
+	saleTx *mockSale.MockSaleTx
+	repo   *mockSale.MockSalePaymentReader
+	repoTx *mockSale.MockSalePaymentTx
+	tx     *mockTX.MockTx
+}
+
+func newPaymentService() (SalePaymentService, paymentMocks) {
+	m := paymentMocks{
+		sale:   new(mockSale.MockSale),
+		saleTx: new(mockSale.MockSaleTx),
+		repo:   new(mockSale.MockSalePaymentReader),
+		repoTx: new(mockSale.MockSalePaymentTx),
+		tx:     new(mockTX.MockTx),
+	}
+	return NewSalePaymentService(m.sale, m.saleTx, m.repo, m.repoTx), m
+}
+
+func TestSalePaymentService_GetBySaleID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("resume pagamentos e saldo", func(t *testing.T) {
+		svc, m := newPaymentService()
+		m.sale.On("GetByID", ctx, int64(10)).Return(&modelsSale.Sale{ID: 10, TotalAmount: money.New(100)}, nil)
+		m.repo.On("GetBySaleID", ctx, int64(10)).Return([]*models.SalePayment{
+			{Method: "pix", Amount: money.New(60)},
+		}, nil)
+
+		summary, err := svc.GetBySaleID(ctx, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, money.New(60), summary.PaidAmount)
+		assert.Equal(t, money.New(40), summary.Balance())
+		assert.False(t, summary.IsPaid())
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		svc, _ := newPaymentService()
+
+		_, err := svc.GetBySaleID(ctx, 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("venda inexistente", func(t *testing.T) {
+		svc, m := newPaymentService()
+		m.sale.On("GetByID", ctx, int64(10)).Return(nil, errMsg.ErrNotFound)
+
+		_, err := svc.GetBySaleID(ctx, 10)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+		m.repo.AssertNotCalled(t, "GetBySaleID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("erro ao buscar pagamentos", func(t *testing.T) {
+		svc, m := newPaymentService()
+		m.sale.On("GetByID", ctx, int64(10)).Return(&modelsSale.Sale{ID: 10}, nil)
+		m.repo.On("GetBySaleID", ctx, int64(10)).Return(nil, errMsg.ErrGet)
+
+		_, err := svc.GetBySaleID(ctx, 10)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestSalePaymentService_TotalsByMethod(t *testing.T) {
+	ctx := context.Background()
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	t.Run("repassa o período ao repositório", func(t *testing.T) {
+		svc, m := newPaymentService()
+		totals := []*models.MethodTotal{{Method: "cash", Sales: 3, Amount: money.New(120)}}
+		m.repo.On("TotalsByMethod", ctx, from, to).Return(totals, nil)
+
+		result, err := svc.TotalsByMethod(ctx, from, to)
+
+		assert.NoError(t, err)
+		assert.Equal(t, totals, result)
+	})
+
+	t.Run("período invertido ou vazio é recusado", func(t *testing.T) {
+		svc, _ := newPaymentService()
+
+		_, err := svc.TotalsByMethod(ctx, to, from)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+
+		_, err = svc.TotalsByMethod(ctx, time.Time{}, to)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+	})
+}
+
+func TestSalePaymentService_Add(t *testing.T) {
+	ctx := context.Background()
+
+	openSale := func(m paymentMocks, status string, paid ...*models.SalePayment) {
+		m.saleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.saleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(10)).
+			Return(&modelsSale.Sale{ID: 10, Status: status, TotalAmount: money.New(100)}, nil).Once()
+		if status == "canceled" {
+			return
+		}
+		m.repoTx.On("GetBySaleIDTx", ctx, m.tx, int64(10)).Return(paid, nil).Once()
+	}
+
+	t.Run("dinheiro acima do saldo gera troco e quita a venda", func(t *testing.T) {
+		svc, m := newPaymentService()
+		openSale(m, "active", &models.SalePayment{Method: "card", Amount: money.New(70)})
+		m.repoTx.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(p *models.SalePayment) bool {
+			return p.Method == "cash" && p.ChangeGiven == money.New(20) && p.Installments == 1
+		})).Return(&models.SalePayment{ID: 2, SaleID: 10, Method: "cash", Amount: money.New(50), ChangeGiven: money.New(20)}, nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		summary, err := svc.Add(ctx, &models.SalePayment{SaleID: 10, Method: "cash", Amount: money.New(50)})
+
+		assert.NoError(t, err)
+		assert.True(t, summary.IsPaid())
+		assert.Len(t, summary.Payments, 2)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("pagamento parcial é aceito", func(t *testing.T) {
+		svc, m := newPaymentService()
+		openSale(m, "active")
+		m.repoTx.On("CreateTx", ctx, m.tx, mock.Anything).
+			Return(&models.SalePayment{ID: 1, SaleID: 10, Method: "pix", Amount: money.New(30)}, nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		summary, err := svc.Add(ctx, &models.SalePayment{SaleID: 10, Method: "pix", Amount: money.New(30)})
+
+		assert.NoError(t, err)
+		assert.Equal(t, money.New(70), summary.Balance())
+	})
+
+	t.Run("dados inválidos ou crédito não abrem transação", func(t *testing.T) {
+		svc, m := newPaymentService()
+
+		_, err := svc.Add(ctx, nil)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+
+		_, err = svc.Add(ctx, &models.SalePayment{Method: "pix", Amount: money.New(1)})
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+
+		_, err = svc.Add(ctx, &models.SalePayment{SaleID: 10, Method: "pix"})
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+
+		_, err = svc.Add(ctx, &models.SalePayment{SaleID: 10, Method: "credit", Amount: money.New(1)})
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+
+		m.saleTx.AssertNotCalled(t, "BeginTx", mock.Anything)
+	})
+
+	t.Run("venda cancelada", func(t *testing.T) {
+		svc, m := newPaymentService()
+		openSale(m, "canceled")
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		_, err := svc.Add(ctx, &models.SalePayment{SaleID: 10, Method: "pix", Amount: money.New(10)})
+
+		assert.ErrorIs(t, err, errMsg.ErrSaleNotActive)
+	})
+
+	t.Run("venda já quitada", func(t *testing.T) {
+		svc, m := newPaymentService()
+		openSale(m, "completed", &models.SalePayment{Method: "pix", Amount: money.New(100)})
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		_, err := svc.Add(ctx, &models.SalePayment{SaleID: 10, Method: "pix", Amount: money.New(10)})
+
+		assert.ErrorIs(t, err, errMsg.ErrSaleAlreadyPaid)
+		m.repoTx.AssertNotCalled(t, "CreateTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("cartão acima do saldo é recusado", func(t *testing.T) {
+		svc, m := newPaymentService()
+		openSale(m, "active", &models.SalePayment{Method: "pix", Amount: money.New(60)})
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		_, err := svc.Add(ctx, &models.SalePayment{SaleID: 10, Method: "card", Amount: money.New(50)})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		assert.ErrorContains(t, err, errMsg.ErrPaymentExceedsDue.Error())
+	})
+
+	t.Run("falha ao gravar faz rollback", func(t *testing.T) {
+		svc, m := newPaymentService()
+		openSale(m, "active")
+		m.repoTx.On("CreateTx", ctx, m.tx, mock.Anything).Return(nil, errMsg.ErrCreate)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		_, err := svc.Add(ctx, &models.SalePayment{SaleID: 10, Method: "pix", Amount: money.New(10)})
+
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+		m.tx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("falha ao iniciar transação", func(t *testing.T) {
+		svc, m := newPaymentService()
+		m.saleTx.On("BeginTx", ctx).Return(nil, errors.New("db down"))
+
+		_, err := svc.Add(ctx, &models.SalePayment{SaleID: 10, Method: "pix", Amount: money.New(10)})
+
+		assert.ErrorContains(t, err, "erro ao iniciar transação")
+	})
+
+	t.Run("falha no commit", func(t *testing.T) {
+		svc, m := newPaymentService()
+		openSale(m, "active")
+		m.repoTx.On("CreateTx", ctx, m.tx, mock.Anything).Return(&models.SalePayment{ID: 1}, nil)
+		m.tx.On("Commit", ctx).Return(errors.New("commit failed"))
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		_, err := svc.Add(ctx, &models.SalePayment{SaleID: 10, Method: "pix", Amount: money.New(10)})
+
+		assert.ErrorContains(t, err, "erro ao commitar transação")
+	})
+}
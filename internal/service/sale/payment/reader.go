@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+// GetBySaleID devolve os pagamentos da venda e quanto falta para quitá-la.
+func (s *salePaymentService) GetBySaleID(ctx context.Context, saleID int64) (*models.Summary, error) {
+	if saleID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	sale, err := s.repoSale.GetByID(ctx, saleID)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := s.repo.GetBySaleID(ctx, saleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewSummary(sale.ID, sale.TotalAmount, payments), nil
+}
+
+// TotalsByMethod soma o recebido por forma de pagamento no período [from, to).
+func (s *salePaymentService) TotalsByMethod(ctx context.Context, from, to time.Time) ([]*models.MethodTotal, error) {
+	if from.IsZero() || to.IsZero() || !from.Before(to) {
+		return nil, fmt.Errorf("%w: período inválido", errMsg.ErrInvalidFilter)
+	}
+
+	return s.repo.TotalsByMethod(ctx, from, to)
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// Add registra mais um pagamento numa venda que ainda não está quitada, como
+// o restante de uma venda que mudou de total. Pagamentos parciais são
+// aceitos; dinheiro acima do saldo volta como troco. Crédito só entra pelo
+// checkout, que lança a cobrança no razão do cliente.
+func (s *salePaymentService) Add(ctx context.Context, payment *models.SalePayment) (*models.Summary, error) {
+	if payment == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+	if payment.SaleID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	if err := payment.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+	if payment.Method == models.MethodCredit {
+		return nil, fmt.Errorf("%w: pagamento a crédito só é aceito no checkout", errMsg.ErrInvalidData)
+	}
+
+	var summary *models.Summary
+
+	err := repoDb.RunInTx(ctx, s.repoSaleTx, func(tx pgx.Tx) error {
+		sale, err := s.repoSaleTx.GetByIDForUpdateTx(ctx, tx, payment.SaleID)
+		if err != nil {
+			return err
+		}
+		if sale.Status == "canceled" || sale.Status == "returned" {
+			return errMsg.ErrSaleNotActive
+		}
+
+		payments, err := s.repoTx.GetBySaleIDTx(ctx, tx, sale.ID)
+		if err != nil {
+			return err
+		}
+
+		balance := models.NewSummary(sale.ID, sale.TotalAmount, payments).Balance()
+		if !balance.IsPositive() {
+			return errMsg.ErrSaleAlreadyPaid
+		}
+		if err := models.AllocateChange([]*models.SalePayment{payment}, balance); err != nil {
+			return fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+		}
+
+		created, err := s.repoTx.CreateTx(ctx, tx, payment)
+		if err != nil {
+			return err
+		}
+
+		summary = models.NewSummary(sale.ID, sale.TotalAmount, append(payments, created))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	ifaceSale "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/pix"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/sale/pix"
+)
+
+type pixChargeService struct {
+	repoSale ifaceSale.SaleReader
+	repo     repo.PixCharge
+	repoTx   ifaceSale.PixChargeTx
+	provider pix.Provider
+	merchant pix.Merchant
+}
+
+func NewPixChargeService(
+	repoSale ifaceSale.SaleReader,
+	repo repo.PixCharge,
+	repoTx ifaceSale.PixChargeTx,
+	provider pix.Provider,
+	merchant pix.Merchant,
+) PixChargeService {
+	return &pixChargeService{
+		repoSale: repoSale,
+		repo:     repo,
+		repoTx:   repoTx,
+		provider: provider,
+		merchant: merchant,
+	}
+}
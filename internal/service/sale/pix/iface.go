@@ -0,0 +1,16 @@
+package services
+
+import (
+	"context"
+	"net/http"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+)
+
+type PixChargeService interface {
+	Create(ctx context.Context, saleID int64, amount *money.Money, createdBy *int64) (*models.PixCharge, error)
+	GetBySaleID(ctx context.Context, saleID int64) ([]*models.PixCharge, error)
+	QRCode(ctx context.Context, txID string, size int) ([]byte, error)
+	Notify(ctx context.Context, header http.Header, body []byte) ([]*models.PixCharge, error)
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// Notify registra o aviso de pagamento do PSP e devolve as cobranças
+// confirmadas. Pix de txid desconhecido ou abaixo do valor cobrado é
+// ignorado, e a cobrança continua pendente; avisos repetidos não alteram a
+// cobrança já confirmada. Cada confirmação é gravada em sua própria
+// transação, junto com o registro de auditoria.
+func (s *pixChargeService) Notify(ctx context.Context, header http.Header, body []byte) ([]*models.PixCharge, error) {
+	confirmations, err := s.provider.ParseNotification(header, body)
+	if err != nil {
+		return nil, err
+	}
+
+	confirmed := make([]*models.PixCharge, 0, len(confirmations))
+	for _, c := range confirmations {
+		var charge *models.PixCharge
+		err := repoDb.RunInTx(ctx, s.repoTx, func(tx pgx.Tx) error {
+			pending, err := s.repoTx.GetByTxIDTx(ctx, tx, c.TxID)
+			if err != nil {
+				return err
+			}
+			if c.Amount.LessThan(pending.Amount) {
+				return nil
+			}
+
+			charge, err = s.repoTx.ConfirmTx(ctx, tx, c)
+			return err
+		})
+		if err != nil {
+			if errors.Is(err, errMsg.ErrNotFound) || errors.Is(err, errMsg.ErrPixAlreadyConfirmed) {
+				continue
+			}
+			return nil, err
+		}
+		if charge != nil {
+			confirmed = append(confirmed, charge)
+		}
+	}
+
+	return confirmed, nil
+}
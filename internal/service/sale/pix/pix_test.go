@@ -0,0 +1,300 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	mockDb "github.com/WagaoCarvalho/backend_store_go/infra/mock/db"
+	mockSale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	modelsSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/pix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var merchant = pix.Merchant{Key: "loja@example.com", Name: "Loja Exemplo", City: "Recife"}
+
+func newService(provider pix.Provider) (*mockSale.MockSale, *mockSale.MockPixChargeRepo, PixChargeService) {
+	repoSale := new(mockSale.MockSale)
+	repo := new(mockSale.MockPixChargeRepo)
+	return repoSale, repo, NewPixChargeService(repoSale, repo, new(mockSale.MockPixChargeTx), provider, merchant)
+}
+
+// expectCreate devolve a cobrança recebida pelo repositório.
+func expectCreate(repo *mockSale.MockPixChargeRepo) *models.PixCharge {
+	charge := &models.PixCharge{}
+	repo.On("Create", mock.Anything, mock.AnythingOfType("*model.PixCharge")).
+		Run(func(args mock.Arguments) { *charge = *args.Get(1).(*models.PixCharge) }).
+		Return(charge, nil).Once()
+	return charge
+}
+
+func TestPixChargeService_Create(t *testing.T) {
+	ctx := context.Background()
+	userID := int64(5)
+	activeSale := &modelsSale.Sale{ID: 10, Status: "active", PaymentType: "pix", TotalAmount: money.MustParse("42.50")}
+
+	t.Run("dinâmico pelo PSP cobra o total da venda", func(t *testing.T) {
+		fake := pix.NewFakeProvider()
+		repoSale, repo, svc := newService(fake)
+		repoSale.On("GetByID", ctx, int64(10)).Return(activeSale, nil).Once()
+		charge := expectCreate(repo)
+
+		_, err := svc.Create(ctx, 10, nil, &userID)
+
+		require.NoError(t, err)
+		assert.Len(t, charge.TxID, 25)
+		assert.Equal(t, money.MustParse("42.50"), charge.Amount)
+		assert.Equal(t, "pix.fake.local/qr/v2/"+charge.TxID, charge.Location)
+		assert.Contains(t, charge.Payload, "010212")
+		assert.Contains(t, charge.Payload, "540542.50")
+		assert.Equal(t, &userID, charge.CreatedBy)
+
+		registered, ok := fake.Charge(charge.TxID)
+		assert.True(t, ok)
+		assert.Equal(t, "Venda 10", registered.Description)
+	})
+
+	t.Run("estático com valor parcial", func(t *testing.T) {
+		repoSale, repo, svc := newService(pix.NewStaticProvider("token"))
+		repoSale.On("GetByID", ctx, int64(10)).Return(activeSale, nil).Once()
+		charge := expectCreate(repo)
+
+		amount := money.New(20)
+		_, err := svc.Create(ctx, 10, &amount, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, charge.Location)
+		assert.Contains(t, charge.Payload, "0116loja@example.com")
+		assert.Contains(t, charge.Payload, "540520.00")
+		assert.Contains(t, charge.Payload, "0525"+charge.TxID)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		_, _, svc := newService(pix.NewFakeProvider())
+		_, err := svc.Create(ctx, 0, nil, nil)
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("venda inexistente", func(t *testing.T) {
+		repoSale, _, svc := newService(pix.NewFakeProvider())
+		repoSale.On("GetByID", ctx, int64(99)).Return(nil, errMsg.ErrNotFound).Once()
+
+		_, err := svc.Create(ctx, 99, nil, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("venda não ativa", func(t *testing.T) {
+		repoSale, _, svc := newService(pix.NewFakeProvider())
+		repoSale.On("GetByID", ctx, int64(11)).Return(&modelsSale.Sale{ID: 11, Status: "completed", TotalAmount: money.New(10)}, nil).Once()
+
+		_, err := svc.Create(ctx, 11, nil, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrSaleNotActive)
+	})
+
+	t.Run("valor acima do total", func(t *testing.T) {
+		repoSale, _, svc := newService(pix.NewFakeProvider())
+		repoSale.On("GetByID", ctx, int64(10)).Return(activeSale, nil).Once()
+
+		amount := money.New(50)
+		_, err := svc.Create(ctx, 10, &amount, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrPaymentExceedsDue)
+	})
+
+	t.Run("venda sem valor a cobrar", func(t *testing.T) {
+		repoSale, _, svc := newService(pix.NewFakeProvider())
+		repoSale.On("GetByID", ctx, int64(12)).Return(&modelsSale.Sale{ID: 12, Status: "active"}, nil).Once()
+
+		_, err := svc.Create(ctx, 12, nil, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("recebedor não configurado", func(t *testing.T) {
+		repoSale := new(mockSale.MockSale)
+		svc := NewPixChargeService(repoSale, new(mockSale.MockPixChargeRepo), new(mockSale.MockPixChargeTx), pix.NewStaticProvider(""), pix.Merchant{})
+		repoSale.On("GetByID", ctx, int64(10)).Return(activeSale, nil).Once()
+
+		_, err := svc.Create(ctx, 10, nil, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+		assert.ErrorContains(t, err, pix.ErrInvalidBRCode.Error())
+	})
+
+	t.Run("erro ao gravar", func(t *testing.T) {
+		repoSale, repo, svc := newService(pix.NewFakeProvider())
+		repoSale.On("GetByID", ctx, int64(10)).Return(activeSale, nil).Once()
+		repo.On("Create", ctx, mock.Anything).Return(nil, errMsg.ErrCreate).Once()
+
+		_, err := svc.Create(ctx, 10, nil, nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+	})
+}
+
+func TestPixChargeService_GetBySaleID(t *testing.T) {
+	ctx := context.Background()
+
+	_, repo, svc := newService(pix.NewFakeProvider())
+	repo.On("GetBySaleID", ctx, int64(10)).Return([]*models.PixCharge{{ID: 1}}, nil).Once()
+
+	charges, err := svc.GetBySaleID(ctx, 10)
+	assert.NoError(t, err)
+	assert.Len(t, charges, 1)
+
+	_, err = svc.GetBySaleID(ctx, 0)
+	assert.ErrorIs(t, err, errMsg.ErrZeroID)
+}
+
+func TestPixChargeService_QRCode(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sucesso", func(t *testing.T) {
+		_, repo, svc := newService(pix.NewFakeProvider())
+		repo.On("GetByTxID", ctx, "TX1").Return(&models.PixCharge{TxID: "TX1", Payload: "00020126580014br.gov.bcb.pix"}, nil).Once()
+
+		png, err := svc.QRCode(ctx, "TX1", 128)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "\x89PNG", string(png[:4]))
+	})
+
+	t.Run("txid vazio", func(t *testing.T) {
+		_, _, svc := newService(pix.NewFakeProvider())
+		_, err := svc.QRCode(ctx, " ", 0)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("cobrança inexistente", func(t *testing.T) {
+		_, repo, svc := newService(pix.NewFakeProvider())
+		repo.On("GetByTxID", ctx, "TX9").Return(nil, errMsg.ErrNotFound).Once()
+
+		_, err := svc.QRCode(ctx, "TX9", 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+}
+
+func TestPixChargeService_Notify(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T, amount money.Money) (*mockSale.MockPixChargeTx, *mockDb.MockTx, PixChargeService, []byte) {
+		fake := pix.NewFakeProvider()
+		_, err := fake.CreateCharge(ctx, pix.Charge{TxID: "TX1", Amount: amount})
+		require.NoError(t, err)
+		body, err := fake.Pay("TX1")
+		require.NoError(t, err)
+
+		repoTx := new(mockSale.MockPixChargeTx)
+		tx := new(mockDb.MockTx)
+		repoTx.On("BeginTx", ctx).Return(tx, nil)
+		svc := NewPixChargeService(new(mockSale.MockSale), new(mockSale.MockPixChargeRepo), repoTx, fake, merchant)
+		return repoTx, tx, svc, body
+	}
+
+	t.Run("confirma a cobrança paga na transação", func(t *testing.T) {
+		repoTx, tx, svc, body := setup(t, money.New(30))
+		repoTx.On("GetByTxIDTx", ctx, tx, "TX1").Return(&models.PixCharge{TxID: "TX1", Amount: money.New(30), Status: models.StatusPending}, nil).Once()
+		repoTx.On("ConfirmTx", ctx, tx, mock.MatchedBy(func(c pix.Confirmation) bool {
+			return c.TxID == "TX1" && c.Amount == money.New(30) && c.EndToEndID != ""
+		})).Return(&models.PixCharge{TxID: "TX1", Status: models.StatusConfirmed}, nil).Once()
+		tx.On("Commit", ctx).Return(nil).Once()
+
+		confirmed, err := svc.Notify(ctx, http.Header{}, body)
+
+		assert.NoError(t, err)
+		assert.Len(t, confirmed, 1)
+		repoTx.AssertExpectations(t)
+		tx.AssertExpectations(t)
+	})
+
+	t.Run("pix abaixo do valor cobrado fica pendente", func(t *testing.T) {
+		repoTx, tx, svc, body := setup(t, money.New(30))
+		repoTx.On("GetByTxIDTx", ctx, tx, "TX1").Return(&models.PixCharge{TxID: "TX1", Amount: money.New(31)}, nil).Once()
+		tx.On("Commit", ctx).Return(nil).Once()
+
+		confirmed, err := svc.Notify(ctx, nil, body)
+
+		assert.NoError(t, err)
+		assert.Empty(t, confirmed)
+		repoTx.AssertNotCalled(t, "ConfirmTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("txid sem cobrança gravada é ignorado", func(t *testing.T) {
+		repoTx, tx, svc, body := setup(t, money.New(30))
+		repoTx.On("GetByTxIDTx", ctx, tx, "TX1").Return(nil, errMsg.ErrNotFound).Once()
+		tx.On("Rollback", ctx).Return(nil).Once()
+
+		confirmed, err := svc.Notify(ctx, nil, body)
+
+		assert.NoError(t, err)
+		assert.Empty(t, confirmed)
+	})
+
+	t.Run("outro pix para cobrança já paga é ignorado", func(t *testing.T) {
+		repoTx, tx, svc, body := setup(t, money.New(30))
+		repoTx.On("GetByTxIDTx", ctx, tx, "TX1").Return(&models.PixCharge{TxID: "TX1", Amount: money.New(30)}, nil).Once()
+		repoTx.On("ConfirmTx", ctx, tx, mock.Anything).Return(nil, errMsg.ErrPixAlreadyConfirmed).Once()
+		tx.On("Rollback", ctx).Return(nil).Once()
+
+		confirmed, err := svc.Notify(ctx, nil, body)
+
+		assert.NoError(t, err)
+		assert.Empty(t, confirmed)
+	})
+
+	t.Run("aviso inválido", func(t *testing.T) {
+		_, _, svc := newService(pix.NewStaticProvider("token"))
+
+		_, err := svc.Notify(ctx, http.Header{}, []byte(`{"pix":[]}`))
+
+		assert.ErrorIs(t, err, pix.ErrInvalidNotification)
+	})
+
+	t.Run("falha na confirmação ou na auditoria desfaz a transação", func(t *testing.T) {
+		repoTx, tx, svc, body := setup(t, money.New(30))
+		repoTx.On("GetByTxIDTx", ctx, tx, "TX1").Return(&models.PixCharge{TxID: "TX1", Amount: money.New(30)}, nil).Once()
+		repoTx.On("ConfirmTx", ctx, tx, mock.Anything).Return(nil, errors.New("audit fail")).Once()
+		tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Notify(ctx, nil, body)
+
+		assert.EqualError(t, err, "audit fail")
+		tx.AssertExpectations(t)
+		tx.AssertNotCalled(t, "Commit", mock.Anything)
+	})
+
+	t.Run("erro ao buscar a cobrança", func(t *testing.T) {
+		repoTx, tx, svc, body := setup(t, money.New(30))
+		repoTx.On("GetByTxIDTx", ctx, tx, "TX1").Return(nil, errMsg.ErrGet).Once()
+		tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.Notify(ctx, nil, body)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+
+	t.Run("erro ao iniciar a transação", func(t *testing.T) {
+		repoTx := new(mockSale.MockPixChargeTx)
+		fake := pix.NewFakeProvider()
+		_, err := fake.CreateCharge(ctx, pix.Charge{TxID: "TX1", Amount: money.New(30)})
+		require.NoError(t, err)
+		body, err := fake.Pay("TX1")
+		require.NoError(t, err)
+		repoTx.On("BeginTx", ctx).Return(nil, errors.New("pool")).Once()
+		svc := NewPixChargeService(new(mockSale.MockSale), new(mockSale.MockPixChargeRepo), repoTx, fake, merchant)
+
+		_, err = svc.Notify(ctx, nil, body)
+
+		assert.Error(t, err)
+	})
+}
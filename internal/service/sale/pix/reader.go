@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/pix"
+)
+
+func (s *pixChargeService) GetBySaleID(ctx context.Context, saleID int64) ([]*models.PixCharge, error) {
+	if saleID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	return s.repo.GetBySaleID(ctx, saleID)
+}
+
+// QRCode desenha o BR Code da cobrança como PNG com size pixels de lado.
+func (s *pixChargeService) QRCode(ctx context.Context, txID string, size int) ([]byte, error) {
+	if strings.TrimSpace(txID) == "" {
+		return nil, fmt.Errorf("%w: txid é obrigatório", errMsg.ErrInvalidData)
+	}
+
+	charge, err := s.repo.GetByTxID(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+
+	png, err := pix.PNG(charge.Payload, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInternal, err)
+	}
+
+	return png, nil
+}
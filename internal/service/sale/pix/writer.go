@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/pix"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/pix"
+	"github.com/google/uuid"
+)
+
+// Create gera a cobrança Pix de uma venda ativa. Sem amount cobra o total da
+// venda; um valor menor serve para a parte em Pix de um pagamento dividido.
+// A cobrança é registrada no PSP antes de montar o BR Code, que fica dinâmico
+// quando o PSP devolve uma location.
+func (s *pixChargeService) Create(ctx context.Context, saleID int64, amount *money.Money, createdBy *int64) (*models.PixCharge, error) {
+	if saleID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	sale, err := s.repoSale.GetByID(ctx, saleID)
+	if err != nil {
+		return nil, err
+	}
+	if sale.Status != "active" {
+		return nil, errMsg.ErrSaleNotActive
+	}
+
+	charge := &models.PixCharge{
+		SaleID:    saleID,
+		TxID:      newTxID(),
+		Amount:    sale.TotalAmount,
+		CreatedBy: createdBy,
+	}
+	if amount != nil {
+		if amount.GreaterThan(sale.TotalAmount) {
+			return nil, fmt.Errorf("%w: %s em Pix para %s da venda", errMsg.ErrPaymentExceedsDue, amount, sale.TotalAmount)
+		}
+		charge.Amount = *amount
+	}
+
+	if err := charge.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	description := fmt.Sprintf("Venda %d", saleID)
+
+	location, err := s.provider.CreateCharge(ctx, pix.Charge{
+		TxID:        charge.TxID,
+		Amount:      charge.Amount,
+		Description: description,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: PSP recusou a cobrança: %v", errMsg.ErrCreate, err)
+	}
+	charge.Location = location
+
+	charge.Payload, err = pix.BRCode{
+		Merchant:    s.merchant,
+		Location:    location,
+		Amount:      charge.Amount,
+		TxID:        charge.TxID,
+		Description: description,
+	}.Encode()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+	}
+
+	return s.repo.Create(ctx, charge)
+}
+
+// newTxID gera o identificador da cobrança: 25 letras e números, o maior
+// txid aceito no QR estático.
+func newTxID() string {
+	return strings.ReplaceAll(uuid.NewString(), "-", "")[:25]
+}
@@ -0,0 +1,42 @@
+package services
+
+import (
+	ifaceClientCnpj "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cnpj"
+	ifaceClient "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cpf"
+	ifaceProduct "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	ifaceSale "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/receipt"
+)
+
+type saleReceiptService struct {
+	repoSale       ifaceSale.SaleReader
+	repoItem       ifaceSale.SaleItemReader
+	repoPayment    ifaceSale.SalePaymentReader
+	repoProduct    ifaceProduct.ProductReader
+	repoClient     ifaceClient.ClientCpfReader
+	repoClientCnpj ifaceClientCnpj.ClientCnpjReader
+	renderer       *receipt.Renderer
+	store          receipt.Store
+}
+
+func NewSaleReceiptService(
+	repoSale ifaceSale.SaleReader,
+	repoItem ifaceSale.SaleItemReader,
+	repoPayment ifaceSale.SalePaymentReader,
+	repoProduct ifaceProduct.ProductReader,
+	repoClient ifaceClient.ClientCpfReader,
+	repoClientCnpj ifaceClientCnpj.ClientCnpjReader,
+	renderer *receipt.Renderer,
+	store receipt.Store,
+) SaleReceiptService {
+	return &saleReceiptService{
+		repoSale:       repoSale,
+		repoItem:       repoItem,
+		repoPayment:    repoPayment,
+		repoProduct:    repoProduct,
+		repoClient:     repoClient,
+		repoClientCnpj: repoClientCnpj,
+		renderer:       renderer,
+		store:          store,
+	}
+}
@@ -0,0 +1,12 @@
+package services
+
+import (
+	"context"
+
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/receipt"
+)
+
+type SaleReceiptService interface {
+	Build(ctx context.Context, saleID int64) (*receipt.Receipt, error)
+	Render(ctx context.Context, saleID int64, format string) ([]byte, error)
+}
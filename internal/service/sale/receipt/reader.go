@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	modelsItem "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/receipt"
+)
+
+// itemsPageSize é o tamanho da página usada para ler todos os itens da venda.
+const itemsPageSize = 200
+
+// Render monta o comprovante da venda e o desenha no formato pedido.
+func (s *saleReceiptService) Render(ctx context.Context, saleID int64, format string) ([]byte, error) {
+	if !receipt.IsValidFormat(format) {
+		return nil, fmt.Errorf("%w: formato deve ser %s, %s ou %s", errMsg.ErrInvalidData,
+			receipt.FormatText, receipt.FormatPDF, receipt.FormatESCPOS)
+	}
+
+	r, err := s.Build(ctx, saleID)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := s.renderer.Render(format, r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInternal, err)
+	}
+
+	return out, nil
+}
+
+// Build reúne venda, itens, pagamentos e cliente. Vendas sem pagamentos
+// registrados mostram a forma de pagamento da venda pelo total.
+func (s *saleReceiptService) Build(ctx context.Context, saleID int64) (*receipt.Receipt, error) {
+	if saleID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	sale, err := s.repoSale.GetByID(ctx, saleID)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &receipt.Receipt{
+		Store:         s.store,
+		SaleID:        sale.ID,
+		SaleDate:      sale.SaleDate,
+		Status:        sale.Status,
+		ItemsAmount:   sale.TotalItemsAmount,
+		ItemsDiscount: sale.TotalItemsDiscount,
+		SaleDiscount:  sale.TotalSaleDiscount,
+		Total:         sale.TotalAmount,
+		Notes:         sale.Notes,
+	}
+
+	if r.ClientName, err = s.clientName(ctx, sale); err != nil {
+		return nil, err
+	}
+
+	if r.Items, err = s.items(ctx, saleID); err != nil {
+		return nil, err
+	}
+
+	payments, err := s.repoPayment.GetBySaleID(ctx, saleID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range payments {
+		r.Tenders = append(r.Tenders, receipt.Tender{
+			Method:       p.Method,
+			Amount:       p.Amount,
+			ChangeGiven:  p.ChangeGiven,
+			Installments: p.Installments,
+		})
+		r.Paid = r.Paid.Add(p.Amount)
+		r.Change = r.Change.Add(p.ChangeGiven)
+	}
+	if len(r.Tenders) == 0 {
+		r.Tenders = []receipt.Tender{{Method: sale.PaymentType, Amount: sale.TotalAmount, Installments: 1}}
+		r.Paid = sale.TotalAmount
+	}
+
+	return r, nil
+}
+
+// clientName devolve o nome do cliente pessoa física ou jurídica da venda.
+// Cliente removido não impede o comprovante.
+func (s *saleReceiptService) clientName(ctx context.Context, sale *models.Sale) (string, error) {
+	switch {
+	case sale.ClientID != nil:
+		client, err := s.repoClient.GetByID(ctx, *sale.ClientID)
+		if err != nil {
+			return "", ignoreNotFound(err)
+		}
+		return client.Name, nil
+	case sale.ClientCnpjID != nil:
+		client, err := s.repoClientCnpj.GetByID(ctx, *sale.ClientCnpjID)
+		if err != nil {
+			return "", ignoreNotFound(err)
+		}
+		return client.Name, nil
+	}
+	return "", nil
+}
+
+// items lê todos os itens da venda. Itens sem descrição levam o nome do
+// produto.
+func (s *saleReceiptService) items(ctx context.Context, saleID int64) ([]receipt.Item, error) {
+	var saleItems []*modelsItem.SaleItem
+	for offset := 0; ; offset += itemsPageSize {
+		page, err := s.repoItem.GetBySaleID(ctx, saleID, itemsPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		saleItems = append(saleItems, page...)
+		if len(page) < itemsPageSize {
+			break
+		}
+	}
+
+	names := make(map[int64]string)
+	items := make([]receipt.Item, 0, len(saleItems))
+	for _, it := range saleItems {
+		description := strings.TrimSpace(it.Description)
+		if description == "" {
+			name, err := s.productName(ctx, it.ProductID, names)
+			if err != nil {
+				return nil, err
+			}
+			description = name
+		}
+
+		items = append(items, receipt.Item{
+			Description: description,
+			Quantity:    it.Quantity,
+			UnitPrice:   it.UnitPrice,
+			Discount:    it.Discount,
+			Subtotal:    it.Subtotal,
+		})
+	}
+
+	return items, nil
+}
+
+func (s *saleReceiptService) productName(ctx context.Context, productID int64, cache map[int64]string) (string, error) {
+	if name, ok := cache[productID]; ok {
+		return name, nil
+	}
+
+	name := fmt.Sprintf("Produto %d", productID)
+	product, err := s.repoProduct.GetByID(ctx, productID)
+	switch {
+	case err == nil:
+		name = product.ProductName
+	case !errors.Is(err, errMsg.ErrNotFound):
+		return "", err
+	}
+
+	cache[productID] = name
+	return name, nil
+}
+
+func ignoreNotFound(err error) error {
+	if errors.Is(err, errMsg.ErrNotFound) {
+		return nil
+	}
+	return err
+}
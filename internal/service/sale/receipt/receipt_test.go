@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockClientCnpj "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cnpj"
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cpf"
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	mockSale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	modelsClientCnpj "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cnpj/client"
+	modelsClient "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/client"
+	modelsProduct "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	modelsItem "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/receipt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type receiptMocks struct {
+	sale       *mockSale.MockSale
+	item       *mockSale.MockSaleItem
+	payment    *mockSale.MockSalePaymentReader
+	product    *mockProduct.ProductMock
+	client     *mockClient.MockClientCpf
+	clientCnpj *mockClientCnpj.MockClientCnpj
+}
+
+func newReceiptService(t *testing.T) (SaleReceiptService, receiptMocks) {
+	renderer, err := receipt.NewRenderer("", 0)
+	require.NoError(t, err)
+
+	m := receiptMocks{
+		sale:       new(mockSale.MockSale),
+		item:       new(mockSale.MockSaleItem),
+		payment:    new(mockSale.MockSalePaymentReader),
+		product:    new(mockProduct.ProductMock),
+		client:     new(mockClient.MockClientCpf),
+		clientCnpj: new(mockClientCnpj.MockClientCnpj),
+	}
+	svc := NewSaleReceiptService(m.sale, m.item, m.payment, m.product, m.client, m.clientCnpj, renderer, receipt.Store{Name: "Loja Centro"})
+	return svc, m
+}
+
+func sampleSale() *models.Sale {
+	clientID := int64(7)
+	return &models.Sale{
+		ID:               10,
+		ClientID:         &clientID,
+		SaleDate:         time.Date(2026, 5, 2, 9, 15, 0, 0, time.UTC),
+		TotalItemsAmount: money.New(30),
+		TotalAmount:      money.New(30),
+		PaymentType:      "cash",
+		Status:           "completed",
+	}
+}
+
+func TestSaleReceiptService_Build(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("monta itens, pagamentos e cliente", func(t *testing.T) {
+		svc, m := newReceiptService(t)
+		m.sale.On("GetByID", ctx, int64(10)).Return(sampleSale(), nil)
+		m.client.On("GetByID", ctx, int64(7)).Return(&modelsClient.ClientCpf{ID: 7, Name: "Maria"}, nil)
+		m.item.On("GetBySaleID", ctx, int64(10), itemsPageSize, 0).Return([]*modelsItem.SaleItem{
+			{ProductID: 1, Quantity: 2, UnitPrice: money.New(10), Subtotal: money.New(20)},
+			{ProductID: 1, Quantity: 1, UnitPrice: money.New(10), Subtotal: money.New(10), Description: "Brinde embalado"},
+		}, nil)
+		m.product.On("GetByID", ctx, int64(1)).Return(&modelsProduct.Product{ID: 1, ProductName: "Caneca"}, nil).Once()
+		m.payment.On("GetBySaleID", ctx, int64(10)).Return([]*modelsPayment.SalePayment{
+			{Method: "cash", Amount: money.New(50), ChangeGiven: money.New(20), Installments: 1},
+		}, nil)
+
+		r, err := svc.Build(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Loja Centro", r.Store.Name)
+		assert.Equal(t, "Maria", r.ClientName)
+		require.Len(t, r.Items, 2)
+		assert.Equal(t, "Caneca", r.Items[0].Description)
+		assert.Equal(t, "Brinde embalado", r.Items[1].Description)
+		assert.Equal(t, money.New(50), r.Paid)
+		assert.Equal(t, money.New(20), r.Change)
+		m.product.AssertExpectations(t)
+	})
+
+	t.Run("lê todas as páginas de itens", func(t *testing.T) {
+		svc, m := newReceiptService(t)
+		sale := sampleSale()
+		sale.ClientID = nil
+		page := make([]*modelsItem.SaleItem, itemsPageSize)
+		for i := range page {
+			page[i] = &modelsItem.SaleItem{Description: "Item", Quantity: 1}
+		}
+		m.sale.On("GetByID", ctx, int64(10)).Return(sale, nil)
+		m.item.On("GetBySaleID", ctx, int64(10), itemsPageSize, 0).Return(page, nil)
+		m.item.On("GetBySaleID", ctx, int64(10), itemsPageSize, itemsPageSize).Return(page[:1], nil)
+		m.payment.On("GetBySaleID", ctx, int64(10)).Return(nil, nil)
+
+		r, err := svc.Build(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Len(t, r.Items, itemsPageSize+1)
+	})
+
+	t.Run("sem pagamentos usa a forma da venda", func(t *testing.T) {
+		svc, m := newReceiptService(t)
+		sale := sampleSale()
+		sale.ClientID = nil
+		cnpjID := int64(3)
+		sale.ClientCnpjID = &cnpjID
+		sale.PaymentType = "pix"
+		m.sale.On("GetByID", ctx, int64(10)).Return(sale, nil)
+		m.clientCnpj.On("GetByID", ctx, int64(3)).Return(&modelsClientCnpj.ClientCnpj{ID: 3, Name: "ACME Ltda"}, nil)
+		m.item.On("GetBySaleID", ctx, int64(10), itemsPageSize, 0).Return(nil, nil)
+		m.payment.On("GetBySaleID", ctx, int64(10)).Return(nil, nil)
+
+		r, err := svc.Build(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, "ACME Ltda", r.ClientName)
+		assert.Equal(t, []receipt.Tender{{Method: "pix", Amount: money.New(30), Installments: 1}}, r.Tenders)
+		assert.Equal(t, money.New(30), r.Paid)
+	})
+
+	t.Run("cliente e produto removidos não impedem o comprovante", func(t *testing.T) {
+		svc, m := newReceiptService(t)
+		m.sale.On("GetByID", ctx, int64(10)).Return(sampleSale(), nil)
+		m.client.On("GetByID", ctx, int64(7)).Return(nil, errMsg.ErrNotFound)
+		m.item.On("GetBySaleID", ctx, int64(10), itemsPageSize, 0).Return([]*modelsItem.SaleItem{{ProductID: 5, Quantity: 1}}, nil)
+		m.product.On("GetByID", ctx, int64(5)).Return(nil, errMsg.ErrNotFound)
+		m.payment.On("GetBySaleID", ctx, int64(10)).Return(nil, nil)
+
+		r, err := svc.Build(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Empty(t, r.ClientName)
+		assert.Equal(t, "Produto 5", r.Items[0].Description)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		svc, _ := newReceiptService(t)
+		_, err := svc.Build(ctx, 0)
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("venda inexistente", func(t *testing.T) {
+		svc, m := newReceiptService(t)
+		m.sale.On("GetByID", ctx, int64(10)).Return(nil, errMsg.ErrNotFound)
+
+		_, err := svc.Build(ctx, 10)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("erro ao buscar cliente", func(t *testing.T) {
+		svc, m := newReceiptService(t)
+		m.sale.On("GetByID", ctx, int64(10)).Return(sampleSale(), nil)
+		m.client.On("GetByID", ctx, int64(7)).Return(nil, errors.New("db down"))
+
+		_, err := svc.Build(ctx, 10)
+		assert.ErrorContains(t, err, "db down")
+	})
+
+	t.Run("erro ao buscar itens", func(t *testing.T) {
+		svc, m := newReceiptService(t)
+		sale := sampleSale()
+		sale.ClientID = nil
+		m.sale.On("GetByID", ctx, int64(10)).Return(sale, nil)
+		m.item.On("GetBySaleID", ctx, int64(10), itemsPageSize, 0).Return(nil, errMsg.ErrGet)
+
+		_, err := svc.Build(ctx, 10)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestSaleReceiptService_Render(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("desenha no formato pedido", func(t *testing.T) {
+		svc, m := newReceiptService(t)
+		sale := sampleSale()
+		sale.ClientID = nil
+		m.sale.On("GetByID", ctx, int64(10)).Return(sale, nil)
+		m.item.On("GetBySaleID", ctx, int64(10), itemsPageSize, 0).Return(nil, nil)
+		m.payment.On("GetBySaleID", ctx, int64(10)).Return(nil, nil)
+
+		out, err := svc.Render(ctx, 10, receipt.FormatText)
+
+		require.NoError(t, err)
+		assert.Contains(t, string(out), "Loja Centro")
+		assert.Contains(t, string(out), "Venda nº 10")
+	})
+
+	t.Run("formato inválido", func(t *testing.T) {
+		svc, m := newReceiptService(t)
+
+		_, err := svc.Render(ctx, 10, "html")
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.sale.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+}
@@ -1,13 +1,51 @@
 package services
 
-import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/sale/sale"
+import (
+	ifaceCash "github.com/WagaoCarvalho/backend_store_go/internal/iface/cash"
+	ifaceClient "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cpf"
+	ifaceProduct "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	ifaceSale "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/sale/sale"
+)
 
 type saleService struct {
-	repo repo.SaleRepo
+	repo          repo.SaleRepo
+	repoReturn    ifaceSale.SaleReturnReader
+	repoSaleTx    ifaceSale.SaleTx
+	repoItemTx    ifaceSale.SaleItemTx
+	repoReturnTx  ifaceSale.SaleReturnTx
+	repoStockTx   ifaceProduct.ProductStockTx
+	repoCreditTx  ifaceClient.ClientCreditTx
+	repoCnpjTx    ifaceClient.ClientCreditTx
+	repoPaymentTx ifaceSale.SalePaymentTx
+	repoPixTx     ifaceSale.PixChargeTx
+	repoCashTx    ifaceCash.CashRefundTx
 }
 
-func NewSaleService(repo repo.SaleRepo) SaleService {
+func NewSaleService(
+	repo repo.SaleRepo,
+	repoReturn ifaceSale.SaleReturnReader,
+	repoSaleTx ifaceSale.SaleTx,
+	repoItemTx ifaceSale.SaleItemTx,
+	repoReturnTx ifaceSale.SaleReturnTx,
+	repoStockTx ifaceProduct.ProductStockTx,
+	repoCreditTx ifaceClient.ClientCreditTx,
+	repoCnpjTx ifaceClient.ClientCreditTx,
+	repoPaymentTx ifaceSale.SalePaymentTx,
+	repoPixTx ifaceSale.PixChargeTx,
+	repoCashTx ifaceCash.CashRefundTx,
+) SaleService {
 	return &saleService{
-		repo: repo,
+		repo:          repo,
+		repoReturn:    repoReturn,
+		repoSaleTx:    repoSaleTx,
+		repoItemTx:    repoItemTx,
+		repoReturnTx:  repoReturnTx,
+		repoStockTx:   repoStockTx,
+		repoCreditTx:  repoCreditTx,
+		repoCnpjTx:    repoCnpjTx,
+		repoPaymentTx: repoPaymentTx,
+		repoPixTx:     repoPixTx,
+		repoCashTx:    repoCashTx,
 	}
 }
@@ -1,10 +1,18 @@
 package services
 
-import sale_iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+import (
+	"context"
+
+	sale_iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	modelsReturn "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
+)
 
 type SaleService interface {
 	sale_iface.SaleReader
 	sale_iface.SaleWriter
 	sale_iface.SaleStatus
 	sale_iface.SaleVersion
+
+	ReturnItems(ctx context.Context, saleReturn *modelsReturn.SaleReturn) (*modelsReturn.SaleReturn, error)
+	GetReturns(ctx context.Context, saleID int64) ([]*modelsReturn.SaleReturn, error)
 }
@@ -0,0 +1,200 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	modelsItem "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	modelsReturn "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// returnState reúne, dentro da transação, os itens da venda e o que já foi devolvido.
+type returnState struct {
+	items         []*modelsItem.SaleItem
+	byID          map[int64]*modelsItem.SaleItem
+	returned      map[int64]int
+	refunded      money.Money
+	itemsSubtotal money.Money
+	goodsTotal    money.Money
+}
+
+func (s *saleService) ReturnItems(ctx context.Context, saleReturn *modelsReturn.SaleReturn) (*modelsReturn.SaleReturn, error) {
+	if saleReturn == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if err := saleReturn.ValidateStructural(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	var created *modelsReturn.SaleReturn
+
+	err := repoDb.RunInTx(ctx, s.repoSaleTx, func(tx pgx.Tx) error {
+		saleModel, err := s.repoSaleTx.GetByIDForUpdateTx(ctx, tx, saleReturn.SaleID)
+		if err != nil {
+			return err
+		}
+
+		if saleModel.Status != "completed" {
+			return fmt.Errorf("%w: somente vendas concluídas podem ser devolvidas", errMsg.ErrInvalidData)
+		}
+
+		state, err := s.loadReturnStateTx(ctx, tx, saleModel)
+		if err != nil {
+			return err
+		}
+
+		created, err = s.applyReturnTx(ctx, tx, saleModel, state, saleReturn)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *saleService) GetReturns(ctx context.Context, saleID int64) ([]*modelsReturn.SaleReturn, error) {
+	if saleID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	return s.repoReturn.GetBySaleID(ctx, saleID)
+}
+
+func (s *saleService) loadReturnStateTx(ctx context.Context, tx pgx.Tx, sale *models.Sale) (*returnState, error) {
+	items, err := s.repoItemTx.GetBySaleIDTx(ctx, tx, sale.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	returned, err := s.repoReturnTx.GetReturnedQuantitiesTx(ctx, tx, sale.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	refunded, err := s.repoReturnTx.GetRefundedAmountTx(ctx, tx, sale.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &returnState{
+		items:    items,
+		byID:     make(map[int64]*modelsItem.SaleItem, len(items)),
+		returned: returned,
+		refunded: refunded,
+	}
+	if state.returned == nil {
+		state.returned = make(map[int64]int)
+	}
+	for _, item := range items {
+		state.byID[item.ID] = item
+		state.itemsSubtotal = state.itemsSubtotal.Add(item.Subtotal)
+	}
+	// Só os itens são devolvíveis: a mão de obra de uma venda faturada de
+	// ordem de serviço fica fora do rateio.
+	state.goodsTotal = money.Max(state.itemsSubtotal.Sub(sale.TotalSaleDiscount), money.Zero)
+
+	return state, nil
+}
+
+// applyReturnTx valida as quantidades devolvidas, calcula o valor de cada linha
+// (proporcional ao subtotal do item, já considerando o desconto da venda),
+// grava a devolução, repõe o estoque, estorna o crédito e lança a devolução em
+// dinheiro no caixa de quem a registra. Quando todos os itens foram
+// devolvidos, a venda passa para "returned"; a mão de obra de uma venda
+// faturada de ordem de serviço continua cobrada.
+func (s *saleService) applyReturnTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	sale *models.Sale,
+	state *returnState,
+	saleReturn *modelsReturn.SaleReturn,
+) (*modelsReturn.SaleReturn, error) {
+	quantities := make(map[int64]int, len(saleReturn.Items))
+	var total money.Money
+
+	for i := range saleReturn.Items {
+		line := &saleReturn.Items[i]
+
+		item, ok := state.byID[line.SaleItemID]
+		if !ok {
+			return nil, fmt.Errorf("%w: item %d não pertence à venda %d", errMsg.ErrInvalidData, line.SaleItemID, sale.ID)
+		}
+
+		remaining := item.Quantity - state.returned[item.ID]
+		if line.Quantity > remaining {
+			return nil, fmt.Errorf("%w: item %d: quantidade devolvida excede o restante (restante %d, solicitado %d)",
+				errMsg.ErrInvalidData, item.ID, remaining, line.Quantity)
+		}
+
+		line.ProductID = item.ProductID
+		// subtotal × quantidade devolvida/vendida × total dos itens/soma dos subtotais
+		amount, err := item.Subtotal.MulFrac(
+			int64(line.Quantity)*state.goodsTotal.Cents(),
+			int64(item.Quantity)*state.itemsSubtotal.Cents(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w: item %d: %w", errMsg.ErrInvalidData, item.ID, err)
+		}
+		line.Amount = amount
+
+		total = total.Add(line.Amount)
+		quantities[item.ProductID] += line.Quantity
+		state.returned[item.ID] += line.Quantity
+	}
+
+	full := true
+	for _, item := range state.items {
+		if state.returned[item.ID] < item.Quantity {
+			full = false
+			break
+		}
+	}
+
+	// Na última devolução (ou se o arredondamento ultrapassar o saldo) o
+	// restante é ajustado na última linha para fechar com o total dos itens.
+	remainingRefund := money.Max(state.goodsTotal.Sub(state.refunded), money.Zero)
+	if full || total.GreaterThan(remainingRefund) {
+		last := &saleReturn.Items[len(saleReturn.Items)-1]
+		last.Amount = money.Max(last.Amount.Add(remainingRefund).Sub(total), money.Zero)
+		total = remainingRefund
+	}
+	saleReturn.TotalAmount = total
+
+	created, err := s.repoReturnTx.CreateTx(ctx, tx, saleReturn)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.restockTx(ctx, tx, sale.LocationID, quantities, modelsMovement.NewOrigin(modelsMovement.ReasonReturn, modelsMovement.RefSaleReturn, created.ID)); err != nil {
+		return nil, err
+	}
+
+	if err := s.refundCreditTx(ctx, tx, sale, total); err != nil {
+		return nil, err
+	}
+
+	if saleReturn.UserID == nil {
+		saleReturn.UserID = operator(ctx)
+	}
+	reason := fmt.Sprintf("devolução %d da venda %d", created.ID, sale.ID)
+	if err := s.refundCashTx(ctx, tx, sale, total, full, saleReturn.UserID, reason); err != nil {
+		return nil, err
+	}
+
+	if full {
+		sale.Status = "returned"
+		if err := s.repoSaleTx.UpdateStatusTx(ctx, tx, sale); err != nil {
+			return nil, err
+		}
+	}
+
+	return created, nil
+}
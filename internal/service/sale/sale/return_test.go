@@ -0,0 +1,308 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	modelsCash "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	modelsReturn "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func partialReturn(items ...modelsReturn.SaleReturnItem) *modelsReturn.SaleReturn {
+	return &modelsReturn.SaleReturn{SaleID: 1, Reason: "defeito", Items: items}
+}
+
+func TestSaleService_ReturnItems(t *testing.T) {
+	ctx := context.Background()
+	clientID := int64(7)
+
+	// Carrega a venda concluída e o estado das devoluções dentro da transação.
+	expectState := func(m saleMocks, sale *models.Sale, returned map[int64]int, refunded float64) {
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(sale, nil).Once()
+		m.repoItemTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(saleItems(), nil).Once()
+		m.repoReturnTx.On("GetReturnedQuantitiesTx", ctx, m.tx, int64(1)).Return(returned, nil).Once()
+		m.repoReturnTx.On("GetRefundedAmountTx", ctx, m.tx, int64(1)).Return(money.MustFromFloat(refunded), nil).Once()
+	}
+
+	t.Run("devolução nil", func(t *testing.T) {
+		svc, _ := newSaleServiceWithMocks()
+		result, err := svc.ReturnItems(ctx, nil)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("validação estrutural falha", func(t *testing.T) {
+		svc, _ := newSaleServiceWithMocks()
+		result, err := svc.ReturnItems(ctx, &modelsReturn.SaleReturn{SaleID: 1})
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("venda não encontrada", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.ReturnItems(ctx, partialReturn(modelsReturn.SaleReturnItem{SaleItemID: 10, Quantity: 1}))
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+		m.assertAll(t)
+	})
+
+	t.Run("venda não concluída", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(&models.Sale{ID: 1, LocationID: 2, Status: "active"}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.ReturnItems(ctx, partialReturn(modelsReturn.SaleReturnItem{SaleItemID: 10, Quantity: 1}))
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.assertAll(t)
+	})
+
+	t.Run("erro ao buscar itens", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(&models.Sale{ID: 1, LocationID: 2, Status: "completed"}, nil).Once()
+		m.repoItemTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(nil, errors.New("db error")).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.ReturnItems(ctx, partialReturn(modelsReturn.SaleReturnItem{SaleItemID: 10, Quantity: 1}))
+		assert.ErrorContains(t, err, "db error")
+		m.assertAll(t)
+	})
+
+	t.Run("erro ao buscar valor estornado", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(&models.Sale{ID: 1, LocationID: 2, Status: "completed"}, nil).Once()
+		m.repoItemTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(saleItems(), nil).Once()
+		m.repoReturnTx.On("GetReturnedQuantitiesTx", ctx, m.tx, int64(1)).Return(nil, nil).Once()
+		m.repoReturnTx.On("GetRefundedAmountTx", ctx, m.tx, int64(1)).Return(money.Zero, errors.New("db error")).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.ReturnItems(ctx, partialReturn(modelsReturn.SaleReturnItem{SaleItemID: 10, Quantity: 1}))
+		assert.ErrorContains(t, err, "db error")
+		m.assertAll(t)
+	})
+
+	t.Run("item de outra venda", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		expectState(m, &models.Sale{ID: 1, LocationID: 2, Status: "completed", TotalAmount: money.New(50)}, nil, 0)
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.ReturnItems(ctx, partialReturn(modelsReturn.SaleReturnItem{SaleItemID: 99, Quantity: 1}))
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		assert.Contains(t, err.Error(), "não pertence à venda")
+		m.assertAll(t)
+	})
+
+	t.Run("quantidade excede o restante", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		expectState(m, &models.Sale{ID: 1, LocationID: 2, Status: "completed", TotalAmount: money.New(50)}, map[int64]int{10: 1}, 10)
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.ReturnItems(ctx, partialReturn(modelsReturn.SaleReturnItem{SaleItemID: 10, Quantity: 2}))
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		assert.Contains(t, err.Error(), "excede o restante")
+		m.assertAll(t)
+	})
+
+	t.Run("erro ao gravar devolução", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		expectState(m, &models.Sale{ID: 1, LocationID: 2, Status: "completed", TotalAmount: money.New(50)}, nil, 0)
+		m.repoReturnTx.On("CreateTx", ctx, m.tx, mock.Anything).Return(nil, errors.New("insert error")).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.ReturnItems(ctx, partialReturn(modelsReturn.SaleReturnItem{SaleItemID: 10, Quantity: 1}))
+		assert.ErrorContains(t, err, "insert error")
+		m.assertAll(t)
+	})
+
+	t.Run("erro ao estornar crédito", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := &models.Sale{ID: 1, LocationID: 2, Status: "completed", TotalAmount: money.New(50), PaymentType: "credit", ClientID: &clientID}
+		expectState(m, sale, nil, 0)
+		m.repoReturnTx.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsReturn.SaleReturn{ID: 1}, nil).Once()
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, int64(2), int64(2), 1, mock.Anything).Return(nil).Once()
+		m.repoCreditTx.On("RefundTx", ctx, m.tx, creditEntry(clientID, 10.0)).Return(errMsg.ErrNotFound).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.ReturnItems(ctx, partialReturn(modelsReturn.SaleReturnItem{SaleItemID: 10, Quantity: 1}))
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+		m.assertAll(t)
+	})
+
+	t.Run("devolução parcial com desconto na venda", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := &models.Sale{ID: 1, LocationID: 2, Status: "completed", TotalSaleDiscount: money.New(5), TotalAmount: money.New(45), PaymentType: "credit", ClientID: &clientID}
+		expectState(m, sale, nil, 0)
+		m.repoReturnTx.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(r *modelsReturn.SaleReturn) bool {
+			return r.TotalAmount == money.New(9) && r.Items[0].ProductID == 2 && r.Items[0].Amount == money.New(9)
+		})).Return(&modelsReturn.SaleReturn{ID: 5, SaleID: 1, TotalAmount: money.New(9)}, nil).Once()
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, int64(2), int64(2), 1, mock.Anything).Return(nil).Once()
+		m.repoCreditTx.On("RefundTx", ctx, m.tx, creditEntry(clientID, 9.0)).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		result, err := svc.ReturnItems(ctx, partialReturn(modelsReturn.SaleReturnItem{SaleItemID: 10, Quantity: 1}))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), result.ID)
+		assert.Equal(t, "completed", sale.Status)
+		m.repoSaleTx.AssertNotCalled(t, "UpdateStatusTx", mock.Anything, mock.Anything, mock.Anything)
+		m.assertAll(t)
+	})
+
+	t.Run("devolução parcial de venda em dinheiro sai do caixa na proporção do dinheiro", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		operatorID := int64(3)
+		// 30,00 em dinheiro e 15,00 no cartão: dos 9,00 devolvidos, 6,00 saem da gaveta.
+		sale := &models.Sale{ID: 1, LocationID: 2, Status: "completed", TotalSaleDiscount: money.New(5), TotalAmount: money.New(45), PaymentType: "cash"}
+		expectState(m, sale, nil, 0)
+		m.repoReturnTx.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsReturn.SaleReturn{ID: 5, SaleID: 1, TotalAmount: money.New(9)}, nil).Once()
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, int64(2), int64(2), 1, mock.Anything).Return(nil).Once()
+		m.repoPaymentTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return([]*modelsPayment.SalePayment{
+			{SaleID: 1, Method: modelsPayment.MethodCash, Amount: money.New(40), ChangeGiven: money.New(10)},
+			{SaleID: 1, Method: modelsPayment.MethodCard, Amount: money.New(15)},
+		}, nil).Once()
+		m.repoCashTx.On("RefundedTx", ctx, m.tx, int64(1)).Return(money.Zero, nil).Once()
+		m.repoCashTx.On("CreateRefundTx", ctx, m.tx, mock.MatchedBy(func(c *modelsCash.CashMovement) bool {
+			return c.Type == modelsCash.MovementRefund && c.Amount == money.New(6) &&
+				*c.SaleID == 1 && *c.UserID == operatorID
+		})).Return(&modelsCash.CashMovement{ID: 1}, nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		saleReturn := partialReturn(modelsReturn.SaleReturnItem{SaleItemID: 10, Quantity: 1})
+		saleReturn.UserID = &operatorID
+		_, err := svc.ReturnItems(ctx, saleReturn)
+		assert.NoError(t, err)
+		assert.Equal(t, "completed", sale.Status)
+		m.assertAll(t)
+	})
+
+	t.Run("caixa fechado impede a devolução em dinheiro", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := &models.Sale{ID: 1, LocationID: 2, Status: "completed", TotalAmount: money.New(50), PaymentType: "cash"}
+		expectState(m, sale, nil, 0)
+		m.repoReturnTx.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsReturn.SaleReturn{ID: 5}, nil).Once()
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, int64(2), int64(2), 1, mock.Anything).Return(nil).Once()
+		m.repoPaymentTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return([]*modelsPayment.SalePayment{
+			{SaleID: 1, Method: modelsPayment.MethodCash, Amount: money.New(50)},
+		}, nil).Once()
+		m.repoCashTx.On("RefundedTx", ctx, m.tx, int64(1)).Return(money.New(4), nil).Once()
+		m.repoCashTx.On("CreateRefundTx", ctx, m.tx, mock.MatchedBy(func(c *modelsCash.CashMovement) bool {
+			return c.Amount == money.New(10)
+		})).Return(nil, errMsg.ErrCashSessionNotOpen).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		_, err := svc.ReturnItems(ctx, partialReturn(modelsReturn.SaleReturnItem{SaleItemID: 10, Quantity: 1}))
+		assert.ErrorIs(t, err, errMsg.ErrCashSessionNotOpen)
+		m.assertAll(t)
+	})
+
+	t.Run("última devolução fecha o valor e marca a venda como devolvida", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		// Desconto de 0,01 na venda: as linhas arredondam para 10,00 + 29,99, mas
+		// restam 40,00 a estornar, então a última linha é ajustada para 30,00.
+		sale := &models.Sale{ID: 1, LocationID: 2, Status: "completed", TotalSaleDiscount: money.MustParse("0.01"), TotalAmount: money.MustParse("49.99")}
+		expectState(m, sale, map[int64]int{10: 1}, 9.99)
+		m.repoReturnTx.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(r *modelsReturn.SaleReturn) bool {
+			return r.TotalAmount == money.New(40) && r.Items[0].Amount == money.New(10) && r.Items[1].Amount == money.New(30)
+		})).Return(&modelsReturn.SaleReturn{ID: 6}, nil).Once()
+		origin := modelsMovement.NewOrigin(modelsMovement.ReasonReturn, modelsMovement.RefSaleReturn, 6)
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, int64(1), int64(2), 1, origin).Return(nil).Once()
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, int64(2), int64(2), 1, origin).Return(nil).Once()
+		m.repoPaymentTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(nil, nil).Once()
+		m.repoSaleTx.On("UpdateStatusTx", ctx, m.tx, sale).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		result, err := svc.ReturnItems(ctx, partialReturn(
+			modelsReturn.SaleReturnItem{SaleItemID: 10, Quantity: 1},
+			modelsReturn.SaleReturnItem{SaleItemID: 11, Quantity: 1},
+		))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(6), result.ID)
+		assert.Equal(t, "returned", sale.Status)
+		m.assertAll(t)
+	})
+
+	t.Run("venda faturada de ordem de serviço não devolve a mão de obra", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		// Peças somam 50,00; a mão de obra de 30,00 entrou só no total.
+		sale := &models.Sale{ID: 1, LocationID: 2, Status: "completed", TotalItemsAmount: money.New(50), TotalAmount: money.New(80), PaymentType: "credit", ClientID: &clientID}
+		expectState(m, sale, nil, 0)
+		m.repoReturnTx.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(r *modelsReturn.SaleReturn) bool {
+			return r.TotalAmount == money.New(50) && r.Items[0].Amount == money.New(20) && r.Items[1].Amount == money.New(30)
+		})).Return(&modelsReturn.SaleReturn{ID: 8}, nil).Once()
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, mock.Anything, int64(2), mock.Anything, mock.Anything).Return(nil).Twice()
+		m.repoCreditTx.On("RefundTx", ctx, m.tx, creditEntry(clientID, 50.0)).Return(nil).Once()
+		m.repoSaleTx.On("UpdateStatusTx", ctx, m.tx, sale).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		_, err := svc.ReturnItems(ctx, partialReturn(
+			modelsReturn.SaleReturnItem{SaleItemID: 10, Quantity: 2},
+			modelsReturn.SaleReturnItem{SaleItemID: 11, Quantity: 1},
+		))
+		assert.NoError(t, err)
+		assert.Equal(t, "returned", sale.Status)
+		m.assertAll(t)
+	})
+
+	t.Run("erro ao atualizar status na devolução total", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := &models.Sale{ID: 1, LocationID: 2, Status: "completed", TotalAmount: money.New(50)}
+		expectState(m, sale, map[int64]int{10: 2}, 20)
+		m.repoReturnTx.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsReturn.SaleReturn{ID: 7}, nil).Once()
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, int64(1), int64(2), 1, mock.Anything).Return(nil).Once()
+		m.repoPaymentTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(nil, nil).Once()
+		m.repoSaleTx.On("UpdateStatusTx", ctx, m.tx, sale).Return(errMsg.ErrVersionConflict).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.ReturnItems(ctx, partialReturn(modelsReturn.SaleReturnItem{SaleItemID: 11, Quantity: 1}))
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrVersionConflict)
+		m.assertAll(t)
+	})
+}
+
+func TestSaleService_GetReturns(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("id inválido", func(t *testing.T) {
+		svc, _ := newSaleServiceWithMocks()
+		result, err := svc.GetReturns(ctx, 0)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("erro do repo", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		m.repoReturn.On("GetBySaleID", ctx, int64(1)).Return(nil, errors.New("db error")).Once()
+
+		result, err := svc.GetReturns(ctx, 1)
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "db error")
+		m.repoReturn.AssertExpectations(t)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		expected := []*modelsReturn.SaleReturn{{ID: 1, SaleID: 1}}
+		m.repoReturn.On("GetBySaleID", ctx, int64(1)).Return(expected, nil).Once()
+
+		result, err := svc.GetReturns(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+		m.repoReturn.AssertExpectations(t)
+	})
+}
@@ -2,13 +2,18 @@ package services
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
 
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	modelsReturn "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	validate "github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils/validators/validator"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
 )
 
 func (s *saleService) GetByStatus(ctx context.Context, status string, limit, offset int, orderBy, orderDir string) ([]*models.Sale, error) {
@@ -33,107 +38,193 @@ func (s *saleService) GetByStatus(ctx context.Context, status string, limit, off
 	return s.repo.GetByStatus(ctx, status, limit, offset, orderBy, orderDir)
 }
 
-// Cancelar venda
+// Cancelar venda: devolve ao estoque todos os itens, estorna o crédito do
+// cliente e devolve o dinheiro recebido, do caixa de quem cancela, na mesma
+// transação que altera o status.
 func (s *saleService) Cancel(ctx context.Context, id int64) error {
 	if id <= 0 {
 		return errMsg.ErrZeroID
 	}
 
-	saleModel, err := s.repo.GetByID(ctx, id)
-	if err != nil {
-		if errors.Is(err, errMsg.ErrNotFound) {
-			return errMsg.ErrNotFound
+	return repoDb.RunInTx(ctx, s.repoSaleTx, func(tx pgx.Tx) error {
+		saleModel, err := s.repoSaleTx.GetByIDForUpdateTx(ctx, tx, id)
+		if err != nil {
+			return err
 		}
-		return fmt.Errorf("%w: %v", errMsg.ErrGet, err)
-	}
 
-	if saleModel.Status != "active" {
-		return fmt.Errorf("%w: somente vendas ativas podem ser canceladas", errMsg.ErrInvalidData)
-	}
+		if saleModel.Status != "active" {
+			return fmt.Errorf("%w: somente vendas ativas podem ser canceladas", errMsg.ErrInvalidData)
+		}
 
-	saleModel.Status = "canceled"
-	if err := s.repo.Update(ctx, saleModel); err != nil {
-		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
-	}
+		items, err := s.repoItemTx.GetBySaleIDTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
 
-	return nil
+		if err := s.restockTx(ctx, tx, saleModel.LocationID, quantitiesByProduct(items), saleOrigin(modelsMovement.ReasonReturn, id)); err != nil {
+			return err
+		}
+
+		if err := s.refundCreditTx(ctx, tx, saleModel, saleModel.TotalAmount); err != nil {
+			return err
+		}
+
+		reason := fmt.Sprintf("cancelamento da venda %d", id)
+		if err := s.refundCashTx(ctx, tx, saleModel, saleModel.TotalAmount, true, operator(ctx), reason); err != nil {
+			return err
+		}
+
+		saleModel.Status = "canceled"
+		return s.repoSaleTx.UpdateStatusTx(ctx, tx, saleModel)
+	})
 }
 
-// Concluir venda
+// Concluir venda. A venda trancada só é concluída quando os pagamentos
+// registrados quitam o total e o Pix confirmado pelo PSP cobre os
+// pagamentos em Pix.
 func (s *saleService) Complete(ctx context.Context, id int64) error {
 	if id <= 0 {
 		return errMsg.ErrZeroID
 	}
 
-	saleModel, err := s.repo.GetByID(ctx, id)
-	if err != nil {
-		if errors.Is(err, errMsg.ErrNotFound) {
-			return errMsg.ErrNotFound
+	return repoDb.RunInTx(ctx, s.repoSaleTx, func(tx pgx.Tx) error {
+		saleModel, err := s.repoSaleTx.GetByIDForUpdateTx(ctx, tx, id)
+		if err != nil {
+			return err
 		}
-		return fmt.Errorf("%w: %v", errMsg.ErrGet, err)
-	}
 
-	if saleModel.Status != "active" {
-		return fmt.Errorf("%w: somente vendas ativas podem ser concluídas", errMsg.ErrInvalidData)
-	}
+		if saleModel.Status != "active" {
+			return fmt.Errorf("%w: somente vendas ativas podem ser concluídas", errMsg.ErrInvalidData)
+		}
+
+		payments, err := s.repoPaymentTx.GetBySaleIDTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		summary := modelsPayment.NewSummary(id, saleModel.TotalAmount, payments)
+		if !summary.IsPaid() {
+			return fmt.Errorf("%w: pago %s de %s", errMsg.ErrPaymentInsufficient, summary.PaidAmount, summary.TotalAmount)
+		}
 
-	saleModel.Status = "completed"
-	if err := s.repo.Update(ctx, saleModel); err != nil {
-		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
+		if err := s.checkPixTx(ctx, tx, id, payments); err != nil {
+			return err
+		}
+
+		saleModel.Status = "completed"
+		return s.repoSaleTx.UpdateStatusTx(ctx, tx, saleModel)
+	})
+}
+
+// checkPixTx recusa a conclusão enquanto o valor das cobranças Pix
+// confirmadas não cobrir os pagamentos em Pix da venda.
+func (s *saleService) checkPixTx(ctx context.Context, tx pgx.Tx, saleID int64, payments []*modelsPayment.SalePayment) error {
+	var due money.Money
+	for _, p := range payments {
+		if p.Method == modelsPayment.MethodPix {
+			due = due.Add(p.Net())
+		}
+	}
+	if !due.IsPositive() {
+		return nil
 	}
 
+	confirmed, err := s.repoPixTx.ConfirmedAmountTx(ctx, tx, saleID)
+	if err != nil {
+		return err
+	}
+	if confirmed.LessThan(due) {
+		return fmt.Errorf("%w: confirmado %s de %s", errMsg.ErrPixNotConfirmed, confirmed, due)
+	}
 	return nil
 }
 
-// Marcar venda como devolvida
+// Marcar venda como devolvida: registra a devolução de tudo o que ainda não
+// foi devolvido, repõe o estoque e estorna o crédito restante.
 func (s *saleService) Returned(ctx context.Context, id int64) error {
 	if id <= 0 {
 		return errMsg.ErrZeroID
 	}
 
-	saleModel, err := s.repo.GetByID(ctx, id)
-	if err != nil {
-		if errors.Is(err, errMsg.ErrNotFound) {
-			return errMsg.ErrNotFound
+	return repoDb.RunInTx(ctx, s.repoSaleTx, func(tx pgx.Tx) error {
+		saleModel, err := s.repoSaleTx.GetByIDForUpdateTx(ctx, tx, id)
+		if err != nil {
+			return err
 		}
-		return fmt.Errorf("%w: %v", errMsg.ErrGet, err)
-	}
 
-	if saleModel.Status != "completed" {
-		return fmt.Errorf("%w: somente vendas concluídas podem ser devolvidas", errMsg.ErrInvalidData)
-	}
+		if saleModel.Status != "completed" {
+			return fmt.Errorf("%w: somente vendas concluídas podem ser devolvidas", errMsg.ErrInvalidData)
+		}
 
-	saleModel.Status = "returned"
-	if err := s.repo.Update(ctx, saleModel); err != nil {
-		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
-	}
+		state, err := s.loadReturnStateTx(ctx, tx, saleModel)
+		if err != nil {
+			return err
+		}
 
-	return nil
+		saleReturn := &modelsReturn.SaleReturn{
+			SaleID: id,
+			UserID: operator(ctx),
+			Reason: "devolução total",
+		}
+		for _, item := range state.items {
+			if remaining := item.Quantity - state.returned[item.ID]; remaining > 0 {
+				saleReturn.Items = append(saleReturn.Items, modelsReturn.SaleReturnItem{
+					SaleItemID: item.ID,
+					Quantity:   remaining,
+				})
+			}
+		}
+
+		if len(saleReturn.Items) == 0 {
+			saleModel.Status = "returned"
+			return s.repoSaleTx.UpdateStatusTx(ctx, tx, saleModel)
+		}
+
+		_, err = s.applyReturnTx(ctx, tx, saleModel, state, saleReturn)
+		return err
+	})
 }
 
-// Reativar venda (transformar em active novamente)
+// Reativar venda cancelada: baixa novamente o estoque e relança o crédito.
+// Vendas devolvidas não podem ser reativadas, pois a devolução já foi registrada,
+// nem canceladas cujo dinheiro já saiu do caixa: os pagamentos não valem mais.
 func (s *saleService) Activate(ctx context.Context, id int64) error {
 	if id <= 0 {
 		return errMsg.ErrZeroID
 	}
 
-	saleModel, err := s.repo.GetByID(ctx, id)
-	if err != nil {
-		if errors.Is(err, errMsg.ErrNotFound) {
-			return errMsg.ErrNotFound
+	return repoDb.RunInTx(ctx, s.repoSaleTx, func(tx pgx.Tx) error {
+		saleModel, err := s.repoSaleTx.GetByIDForUpdateTx(ctx, tx, id)
+		if err != nil {
+			return err
 		}
-		return fmt.Errorf("%w: %v", errMsg.ErrGet, err)
-	}
 
-	// Somente vendas canceladas ou devolvidas podem ser reativadas
-	if saleModel.Status != "canceled" && saleModel.Status != "returned" {
-		return fmt.Errorf("%w: somente vendas canceladas ou devolvidas podem ser reativadas", errMsg.ErrInvalidData)
-	}
+		if saleModel.Status != "canceled" {
+			return fmt.Errorf("%w: somente vendas canceladas podem ser reativadas", errMsg.ErrInvalidData)
+		}
 
-	saleModel.Status = "active"
-	if err := s.repo.Update(ctx, saleModel); err != nil {
-		return fmt.Errorf("%w: %v", errMsg.ErrUpdate, err)
-	}
+		refunded, err := s.repoCashTx.RefundedTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if refunded.IsPositive() {
+			return fmt.Errorf("%w: %s em dinheiro já devolvidos no cancelamento", errMsg.ErrInvalidData, refunded)
+		}
 
-	return nil
+		items, err := s.repoItemTx.GetBySaleIDTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.unstockTx(ctx, tx, saleModel.LocationID, quantitiesByProduct(items), saleOrigin(modelsMovement.ReasonSale, id)); err != nil {
+			return err
+		}
+
+		if err := s.chargeCreditTx(ctx, tx, saleModel, saleModel.TotalAmount); err != nil {
+			return err
+		}
+
+		saleModel.Status = "active"
+		return s.repoSaleTx.UpdateStatusTx(ctx, tx, saleModel)
+	})
 }
@@ -6,14 +6,21 @@ import (
 	"testing"
 
 	mockSale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	modelsCash "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	modelsReturn "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale_return"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestSaleService_GetByStatus(t *testing.T) {
 	mockRepo := new(mockSale.MockSale)
-	svc := NewSaleService(mockRepo)
+	svc := NewSaleService(mockRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	t.Run("status inválido", func(t *testing.T) {
@@ -60,299 +67,537 @@ func TestSaleService_GetByStatus(t *testing.T) {
 }
 
 func TestSaleService_Cancel(t *testing.T) {
-	mockRepo := new(mockSale.MockSale)
-	svc := NewSaleService(mockRepo)
 	ctx := context.Background()
+	clientID := int64(7)
 
 	t.Run("id inválido", func(t *testing.T) {
+		svc, _ := newSaleServiceWithMocks()
 		err := svc.Cancel(ctx, 0)
 		assert.ErrorIs(t, err, errMsg.ErrZeroID)
 	})
 
 	t.Run("sale não encontrado", func(t *testing.T) {
-		mockRepo.On("GetByID", ctx, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+		svc, m := newSaleServiceWithMocks()
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
 		err := svc.Cancel(ctx, 1)
 		assert.ErrorIs(t, err, errMsg.ErrNotFound)
-		mockRepo.AssertExpectations(t)
+		m.assertAll(t)
 	})
 
 	t.Run("sale não ativa", func(t *testing.T) {
-		sale := &models.Sale{ID: 2, Status: "completed"}
-		mockRepo.On("GetByID", ctx, int64(2)).Return(sale, nil).Once()
+		svc, m := newSaleServiceWithMocks()
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(2)).Return(&models.Sale{ID: 2, LocationID: 2, Status: "completed"}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
 		err := svc.Cancel(ctx, 2)
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
 		assert.Contains(t, err.Error(), "somente vendas ativas podem ser canceladas")
-		mockRepo.AssertExpectations(t)
+		m.assertAll(t)
 	})
 
-	t.Run("erro repo update", func(t *testing.T) {
-		sale := &models.Sale{ID: 3, Status: "active"}
-		mockRepo.On("GetByID", ctx, int64(3)).Return(sale, nil).Once()
-		mockRepo.On("Update", ctx, sale).Return(errors.New("update error")).Once()
+	t.Run("erro ao buscar itens", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(&models.Sale{ID: 1, LocationID: 2, Status: "active"}, nil).Once()
+		m.repoItemTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(nil, errors.New("db error")).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
 
-		err := svc.Cancel(ctx, 3)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), errMsg.ErrUpdate.Error())
-		mockRepo.AssertExpectations(t)
+		err := svc.Cancel(ctx, 1)
+		assert.ErrorContains(t, err, "db error")
+		m.assertAll(t)
 	})
 
-	t.Run("sucesso", func(t *testing.T) {
-		sale := &models.Sale{ID: 4, Status: "active"}
-		mockRepo.On("GetByID", ctx, int64(4)).Return(sale, nil).Once()
-		mockRepo.On("Update", ctx, sale).Return(nil).Once()
+	t.Run("erro ao repor estoque", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(&models.Sale{ID: 1, LocationID: 2, Status: "active"}, nil).Once()
+		m.repoItemTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(saleItems(), nil).Once()
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, int64(1), int64(2), 1, mock.Anything).Return(errMsg.ErrNotFound).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
 
-		err := svc.Cancel(ctx, 4)
-		assert.NoError(t, err)
-		assert.Equal(t, "canceled", sale.Status)
-		mockRepo.AssertExpectations(t)
+		err := svc.Cancel(ctx, 1)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+		m.assertAll(t)
 	})
 
-	t.Run("erro repo get", func(t *testing.T) {
-		mockRepo := new(mockSale.MockSale)
-		svc := NewSaleService(mockRepo)
-		ctx := context.Background()
+	t.Run("erro ao estornar crédito", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := &models.Sale{ID: 1, LocationID: 2, Status: "active", PaymentType: "credit", ClientID: &clientID, TotalAmount: money.New(50)}
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(sale, nil).Once()
+		m.repoItemTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(saleItems(), nil).Once()
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, int64(1), int64(2), 1, mock.Anything).Return(nil).Once()
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, int64(2), int64(2), 2, mock.Anything).Return(nil).Once()
+		m.repoCreditTx.On("RefundTx", ctx, m.tx, creditEntry(clientID, 50.0)).Return(errors.New("credit error")).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
 
-		mockRepo.On("GetByID", ctx, int64(5)).Return(nil, errors.New("db error")).Once()
+		err := svc.Cancel(ctx, 1)
+		assert.ErrorContains(t, err, "credit error")
+		m.assertAll(t)
+	})
+
+	t.Run("erro ao atualizar status", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := &models.Sale{ID: 1, LocationID: 2, Status: "active", PaymentType: "cash", TotalAmount: money.New(50)}
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(sale, nil).Once()
+		m.repoItemTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(saleItems(), nil).Once()
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, int64(1), int64(2), 1, mock.Anything).Return(nil).Once()
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, int64(2), int64(2), 2, mock.Anything).Return(nil).Once()
+		m.repoPaymentTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(nil, nil).Once()
+		m.repoSaleTx.On("UpdateStatusTx", ctx, m.tx, sale).Return(errMsg.ErrVersionConflict).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
 
-		err := svc.Cancel(ctx, 5)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), errMsg.ErrGet.Error())
-		mockRepo.AssertExpectations(t)
-	})
+		err := svc.Cancel(ctx, 1)
+		assert.ErrorIs(t, err, errMsg.ErrVersionConflict)
+		m.assertAll(t)
+	})
+
+	t.Run("sucesso com venda a crédito", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := &models.Sale{ID: 1, LocationID: 2, Status: "active", PaymentType: "credit", ClientID: &clientID, TotalAmount: money.New(50)}
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(sale, nil).Once()
+		m.repoItemTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(saleItems(), nil).Once()
+		origin := modelsMovement.NewOrigin(modelsMovement.ReasonReturn, modelsMovement.RefSale, 1)
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, int64(1), int64(2), 1, origin).Return(nil).Once()
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, int64(2), int64(2), 2, origin).Return(nil).Once()
+		m.repoCreditTx.On("RefundTx", ctx, m.tx, creditEntry(clientID, 50.0)).Return(nil).Once()
+		m.repoSaleTx.On("UpdateStatusTx", ctx, m.tx, sale).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
 
+		err := svc.Cancel(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "canceled", sale.Status)
+		m.assertAll(t)
+	})
+
+	t.Run("sucesso devolve o dinheiro do caixa de quem cancela", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		userCtx := contextUtils.SetUserID(ctx, "3")
+		sale := &models.Sale{ID: 1, LocationID: 2, Status: "active", PaymentType: "cash", TotalAmount: money.New(50)}
+		m.repoSaleTx.On("BeginTx", userCtx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", userCtx, m.tx, int64(1)).Return(sale, nil).Once()
+		m.repoItemTx.On("GetBySaleIDTx", userCtx, m.tx, int64(1)).Return(saleItems(), nil).Once()
+		m.repoStockTx.On("IncreaseStockAtTx", userCtx, m.tx, mock.Anything, int64(2), mock.Anything, mock.Anything).Return(nil).Twice()
+		m.repoPaymentTx.On("GetBySaleIDTx", userCtx, m.tx, int64(1)).Return([]*modelsPayment.SalePayment{
+			{SaleID: 1, Method: modelsPayment.MethodCash, Amount: money.New(20)},
+			{SaleID: 1, Method: modelsPayment.MethodPix, Amount: money.New(30)},
+		}, nil).Once()
+		m.repoCashTx.On("RefundedTx", userCtx, m.tx, int64(1)).Return(money.Zero, nil).Once()
+		m.repoCashTx.On("CreateRefundTx", userCtx, m.tx, mock.MatchedBy(func(c *modelsCash.CashMovement) bool {
+			return c.Amount == money.New(20) && *c.SaleID == 1 && *c.UserID == 3
+		})).Return(&modelsCash.CashMovement{ID: 1}, nil).Once()
+		m.repoSaleTx.On("UpdateStatusTx", userCtx, m.tx, sale).Return(nil).Once()
+		m.tx.On("Commit", userCtx).Return(nil).Once()
+
+		err := svc.Cancel(userCtx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "canceled", sale.Status)
+		m.assertAll(t)
+	})
 }
 
 func TestSaleService_Complete(t *testing.T) {
-	mockRepo := new(mockSale.MockSale)
-	svc := NewSaleService(mockRepo)
 	ctx := context.Background()
 
+	// begin prepara a transação que tranca a venda id com o status informado.
+	begin := func(m saleMocks, id int64, status string) *models.Sale {
+		sale := &models.Sale{ID: id, LocationID: 2, Status: status, TotalAmount: money.New(100)}
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, id).Return(sale, nil).Once()
+		return sale
+	}
+
 	t.Run("id inválido", func(t *testing.T) {
+		svc, _ := newSaleServiceWithMocks()
 		err := svc.Complete(ctx, 0)
 		assert.ErrorIs(t, err, errMsg.ErrZeroID)
 	})
 
 	t.Run("sale não encontrado", func(t *testing.T) {
-		mockRepo.On("GetByID", ctx, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+		svc, m := newSaleServiceWithMocks()
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
 		err := svc.Complete(ctx, 1)
+
 		assert.ErrorIs(t, err, errMsg.ErrNotFound)
-		mockRepo.AssertExpectations(t)
+		m.assertAll(t)
 	})
 
 	t.Run("sale não ativa", func(t *testing.T) {
-		sale := &models.Sale{ID: 2, Status: "canceled"}
-		mockRepo.On("GetByID", ctx, int64(2)).Return(sale, nil).Once()
+		svc, m := newSaleServiceWithMocks()
+		begin(m, 2, "canceled")
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
 		err := svc.Complete(ctx, 2)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "somente vendas ativas podem ser concluídas")
-		mockRepo.AssertExpectations(t)
+
+		assert.ErrorContains(t, err, "somente vendas ativas podem ser concluídas")
+		m.assertAll(t)
 	})
 
-	t.Run("erro repo update", func(t *testing.T) {
-		sale := &models.Sale{ID: 3, Status: "active"}
-		mockRepo.On("GetByID", ctx, int64(3)).Return(sale, nil).Once()
-		mockRepo.On("Update", ctx, sale).Return(errors.New("update error")).Once()
+	t.Run("erro ao carregar pagamentos", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		begin(m, 3, "active")
+		m.repoPaymentTx.On("GetBySaleIDTx", ctx, m.tx, int64(3)).Return(nil, errMsg.ErrGet).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
 
 		err := svc.Complete(ctx, 3)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), errMsg.ErrUpdate.Error())
-		mockRepo.AssertExpectations(t)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+		m.assertAll(t)
 	})
 
-	t.Run("sucesso", func(t *testing.T) {
-		sale := &models.Sale{ID: 4, Status: "active"}
-		mockRepo.On("GetByID", ctx, int64(4)).Return(sale, nil).Once()
-		mockRepo.On("Update", ctx, sale).Return(nil).Once()
+	t.Run("pagamentos não quitam a venda", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := begin(m, 9, "active")
+		m.repoPaymentTx.On("GetBySaleIDTx", ctx, m.tx, int64(9)).
+			Return([]*modelsPayment.SalePayment{{Method: "cash", Amount: money.New(70)}}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
 
-		err := svc.Complete(ctx, 4)
-		assert.NoError(t, err)
-		assert.Equal(t, "completed", sale.Status)
-		mockRepo.AssertExpectations(t)
-	})
+		err := svc.Complete(ctx, 9)
 
-	t.Run("erro repo get genérico", func(t *testing.T) {
-		mockRepo := new(mockSale.MockSale)
-		svc := NewSaleService(mockRepo)
-		ctx := context.Background()
+		assert.ErrorIs(t, err, errMsg.ErrPaymentInsufficient)
+		assert.Equal(t, "active", sale.Status)
+		m.repoSaleTx.AssertNotCalled(t, "UpdateStatusTx", mock.Anything, mock.Anything, mock.Anything)
+		m.assertAll(t)
+	})
 
-		mockRepo.On("GetByID", ctx, int64(10)).Return(nil, errors.New("erro de banco")).Once()
+	t.Run("venda sem pagamentos", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		begin(m, 10, "active")
+		m.repoPaymentTx.On("GetBySaleIDTx", ctx, m.tx, int64(10)).Return([]*modelsPayment.SalePayment{}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
 
 		err := svc.Complete(ctx, 10)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), errMsg.ErrGet.Error())
-		mockRepo.AssertExpectations(t)
+
+		assert.ErrorIs(t, err, errMsg.ErrPaymentInsufficient)
+		m.assertAll(t)
 	})
 
-}
+	t.Run("pagamentos não quitam a venda", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := begin(m, 9, "active")
+		m.repoPaymentTx.On("GetBySaleIDTx", ctx, m.tx, int64(9)).
+			Return([]*modelsPayment.SalePayment{{Method: "cash", Amount: money.New(70)}}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
 
-func TestSaleService_Returned(t *testing.T) {
-	mockRepo := new(mockSale.MockSale)
-	svc := NewSaleService(mockRepo)
-	ctx := context.Background()
+		err := svc.Complete(ctx, 9)
 
-	t.Run("id inválido", func(t *testing.T) {
-		err := svc.Returned(ctx, 0)
-		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+		assert.ErrorIs(t, err, errMsg.ErrPaymentInsufficient)
+		assert.Equal(t, "active", sale.Status)
+		m.repoSaleTx.AssertNotCalled(t, "UpdateStatusTx", mock.Anything, mock.Anything, mock.Anything)
+		m.assertAll(t)
 	})
 
-	t.Run("sale não encontrado", func(t *testing.T) {
-		mockRepo.On("GetByID", ctx, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
-		err := svc.Returned(ctx, 1)
-		assert.ErrorIs(t, err, errMsg.ErrNotFound)
-		mockRepo.AssertExpectations(t)
+	t.Run("venda sem pagamentos", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		begin(m, 10, "active")
+		m.repoPaymentTx.On("GetBySaleIDTx", ctx, m.tx, int64(10)).Return([]*modelsPayment.SalePayment{}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		err := svc.Complete(ctx, 10)
+
+		assert.ErrorIs(t, err, errMsg.ErrPaymentInsufficient)
+		m.assertAll(t)
 	})
 
-	t.Run("erro repo get", func(t *testing.T) {
-		mockRepo.On("GetByID", ctx, int64(2)).Return(nil, errors.New("db error")).Once()
+	t.Run("erro ao atualizar status", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := begin(m, 4, "active")
+		m.repoPaymentTx.On("GetBySaleIDTx", ctx, m.tx, int64(4)).
+			Return([]*modelsPayment.SalePayment{{Method: "cash", Amount: money.New(100)}}, nil).Once()
+		m.repoSaleTx.On("UpdateStatusTx", ctx, m.tx, sale).Return(errMsg.ErrUpdate).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
 
-		err := svc.Returned(ctx, 2)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), errMsg.ErrGet.Error())
-		mockRepo.AssertExpectations(t)
+		err := svc.Complete(ctx, 4)
+
+		assert.ErrorIs(t, err, errMsg.ErrUpdate)
+		m.assertAll(t)
 	})
 
-	t.Run("sale não concluída", func(t *testing.T) {
-		sale := &models.Sale{ID: 3, Status: "active"}
-		mockRepo.On("GetByID", ctx, int64(3)).Return(sale, nil).Once()
-		err := svc.Returned(ctx, 3)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "somente vendas concluídas podem ser devolvidas")
-		mockRepo.AssertExpectations(t)
+	t.Run("sucesso sem pix", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := begin(m, 5, "active")
+		m.repoPaymentTx.On("GetBySaleIDTx", ctx, m.tx, int64(5)).
+			Return([]*modelsPayment.SalePayment{{Method: "cash", Amount: money.New(100)}}, nil).Once()
+		m.repoSaleTx.On("UpdateStatusTx", ctx, m.tx, sale).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		err := svc.Complete(ctx, 5)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "completed", sale.Status)
+		m.repoPixTx.AssertNotCalled(t, "ConfirmedAmountTx", mock.Anything, mock.Anything, mock.Anything)
+		m.assertAll(t)
 	})
 
-	t.Run("sale status pending", func(t *testing.T) {
-		sale := &models.Sale{ID: 4, Status: "pending"}
-		mockRepo.On("GetByID", ctx, int64(4)).Return(sale, nil).Once()
-		err := svc.Returned(ctx, 4)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "somente vendas concluídas podem ser devolvidas")
-		mockRepo.AssertExpectations(t)
+	t.Run("pix confirmado abaixo do pagamento em pix", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := begin(m, 6, "active")
+		sale.PaymentType = "cash"
+		m.repoPaymentTx.On("GetBySaleIDTx", ctx, m.tx, int64(6)).Return([]*modelsPayment.SalePayment{
+			{Method: "cash", Amount: money.New(60)},
+			{Method: "pix", Amount: money.New(40)},
+		}, nil).Once()
+		m.repoPixTx.On("ConfirmedAmountTx", ctx, m.tx, int64(6)).Return(money.New(30), nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		err := svc.Complete(ctx, 6)
+
+		assert.ErrorIs(t, err, errMsg.ErrPixNotConfirmed)
+		assert.Equal(t, "active", sale.Status)
+		m.repoSaleTx.AssertNotCalled(t, "UpdateStatusTx", mock.Anything, mock.Anything, mock.Anything)
+		m.assertAll(t)
 	})
 
-	t.Run("sale status canceled", func(t *testing.T) {
-		sale := &models.Sale{ID: 5, Status: "canceled"}
-		mockRepo.On("GetByID", ctx, int64(5)).Return(sale, nil).Once()
-		err := svc.Returned(ctx, 5)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "somente vendas concluídas podem ser devolvidas")
-		mockRepo.AssertExpectations(t)
+	t.Run("pix confirmado cobre o pagamento em pix", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := begin(m, 7, "active")
+		m.repoPaymentTx.On("GetBySaleIDTx", ctx, m.tx, int64(7)).Return([]*modelsPayment.SalePayment{
+			{Method: "cash", Amount: money.New(60)},
+			{Method: "pix", Amount: money.New(40)},
+		}, nil).Once()
+		m.repoPixTx.On("ConfirmedAmountTx", ctx, m.tx, int64(7)).Return(money.New(40), nil).Once()
+		m.repoSaleTx.On("UpdateStatusTx", ctx, m.tx, sale).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		err := svc.Complete(ctx, 7)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "completed", sale.Status)
+		m.assertAll(t)
 	})
 
-	t.Run("sale status returned", func(t *testing.T) {
-		sale := &models.Sale{ID: 6, Status: "returned"}
-		mockRepo.On("GetByID", ctx, int64(6)).Return(sale, nil).Once()
-		err := svc.Returned(ctx, 6)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "somente vendas concluídas podem ser devolvidas")
-		mockRepo.AssertExpectations(t)
+	t.Run("erro ao somar cobranças pix", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		begin(m, 8, "active")
+		m.repoPaymentTx.On("GetBySaleIDTx", ctx, m.tx, int64(8)).
+			Return([]*modelsPayment.SalePayment{{Method: "pix", Amount: money.New(100)}}, nil).Once()
+		m.repoPixTx.On("ConfirmedAmountTx", ctx, m.tx, int64(8)).Return(money.Zero, errMsg.ErrGet).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		err := svc.Complete(ctx, 8)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+		m.assertAll(t)
+	})
+}
+
+func TestSaleService_Returned(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("id inválido", func(t *testing.T) {
+		svc, _ := newSaleServiceWithMocks()
+		err := svc.Returned(ctx, 0)
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
 	})
 
-	t.Run("erro repo update", func(t *testing.T) {
-		sale := &models.Sale{ID: 7, Status: "completed"}
-		mockRepo.On("GetByID", ctx, int64(7)).Return(sale, nil).Once()
-		mockRepo.On("Update", ctx, sale).Return(errors.New("update error")).Once()
+	t.Run("sale não encontrado", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
 
-		err := svc.Returned(ctx, 7)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), errMsg.ErrUpdate.Error())
-		mockRepo.AssertExpectations(t)
+		err := svc.Returned(ctx, 1)
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+		m.assertAll(t)
+	})
+
+	for _, status := range []string{"active", "canceled", "returned"} {
+		t.Run("sale status "+status, func(t *testing.T) {
+			svc, m := newSaleServiceWithMocks()
+			m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+			m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(3)).Return(&models.Sale{ID: 3, LocationID: 2, Status: status}, nil).Once()
+			m.tx.On("Rollback", ctx).Return(nil).Once()
+
+			err := svc.Returned(ctx, 3)
+			assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+			assert.Contains(t, err.Error(), "somente vendas concluídas podem ser devolvidas")
+			m.assertAll(t)
+		})
+	}
+
+	t.Run("erro ao carregar devoluções", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(&models.Sale{ID: 1, LocationID: 2, Status: "completed"}, nil).Once()
+		m.repoItemTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(saleItems(), nil).Once()
+		m.repoReturnTx.On("GetReturnedQuantitiesTx", ctx, m.tx, int64(1)).Return(nil, errors.New("db error")).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		err := svc.Returned(ctx, 1)
+		assert.ErrorContains(t, err, "db error")
+		m.assertAll(t)
 	})
 
-	t.Run("sucesso", func(t *testing.T) {
-		sale := &models.Sale{ID: 8, Status: "completed"}
-		mockRepo.On("GetByID", ctx, int64(8)).Return(sale, nil).Once()
-		mockRepo.On("Update", ctx, sale).Return(nil).Once()
+	t.Run("tudo já devolvido apenas altera status", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := &models.Sale{ID: 1, LocationID: 2, Status: "completed", TotalAmount: money.New(50)}
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(sale, nil).Once()
+		m.repoItemTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(saleItems(), nil).Once()
+		m.repoReturnTx.On("GetReturnedQuantitiesTx", ctx, m.tx, int64(1)).Return(map[int64]int{10: 2, 11: 1}, nil).Once()
+		m.repoReturnTx.On("GetRefundedAmountTx", ctx, m.tx, int64(1)).Return(money.New(50), nil).Once()
+		m.repoSaleTx.On("UpdateStatusTx", ctx, m.tx, sale).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		err := svc.Returned(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "returned", sale.Status)
+		m.assertAll(t)
+	})
+
+	t.Run("sucesso devolve o restante", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := &models.Sale{ID: 1, LocationID: 2, Status: "completed", TotalSaleDiscount: money.New(5), TotalAmount: money.New(45), PaymentType: "cash"}
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(sale, nil).Once()
+		m.repoItemTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(saleItems(), nil).Once()
+		m.repoReturnTx.On("GetReturnedQuantitiesTx", ctx, m.tx, int64(1)).Return(map[int64]int{10: 1}, nil).Once()
+		m.repoReturnTx.On("GetRefundedAmountTx", ctx, m.tx, int64(1)).Return(money.New(9), nil).Once()
+		m.repoReturnTx.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(r *modelsReturn.SaleReturn) bool {
+			return len(r.Items) == 2 &&
+				r.Items[0].SaleItemID == 10 && r.Items[0].Quantity == 1 &&
+				r.Items[1].SaleItemID == 11 && r.Items[1].Quantity == 1 &&
+				r.TotalAmount == money.New(36)
+		})).Return(&modelsReturn.SaleReturn{ID: 1}, nil).Once()
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, int64(1), int64(2), 1, mock.Anything).Return(nil).Once()
+		m.repoStockTx.On("IncreaseStockAtTx", ctx, m.tx, int64(2), int64(2), 1, mock.Anything).Return(nil).Once()
+		// A devolução anterior de 9,00 já saiu do caixa; o restante em dinheiro sai agora.
+		m.repoPaymentTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return([]*modelsPayment.SalePayment{
+			{SaleID: 1, Method: modelsPayment.MethodCash, Amount: money.New(45)},
+		}, nil).Once()
+		m.repoCashTx.On("RefundedTx", ctx, m.tx, int64(1)).Return(money.New(9), nil).Once()
+		m.repoCashTx.On("CreateRefundTx", ctx, m.tx, mock.MatchedBy(func(c *modelsCash.CashMovement) bool {
+			return c.Amount == money.New(36)
+		})).Return(&modelsCash.CashMovement{ID: 2}, nil).Once()
+		m.repoSaleTx.On("UpdateStatusTx", ctx, m.tx, sale).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
 
-		err := svc.Returned(ctx, 8)
+		err := svc.Returned(ctx, 1)
 		assert.NoError(t, err)
 		assert.Equal(t, "returned", sale.Status)
-		mockRepo.AssertExpectations(t)
+		m.assertAll(t)
 	})
 }
 
 func TestSaleService_Activate(t *testing.T) {
-	mockRepo := new(mockSale.MockSale)
-	svc := NewSaleService(mockRepo)
 	ctx := context.Background()
+	clientID := int64(7)
 
 	t.Run("id inválido", func(t *testing.T) {
+		svc, _ := newSaleServiceWithMocks()
 		err := svc.Activate(ctx, 0)
 		assert.ErrorIs(t, err, errMsg.ErrZeroID)
 	})
 
 	t.Run("sale não encontrado", func(t *testing.T) {
-		mockRepo.On("GetByID", ctx, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+		svc, m := newSaleServiceWithMocks()
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
 		err := svc.Activate(ctx, 1)
 		assert.ErrorIs(t, err, errMsg.ErrNotFound)
-		mockRepo.AssertExpectations(t)
-	})
-
-	t.Run("erro repo get", func(t *testing.T) {
-		mockRepo.On("GetByID", ctx, int64(2)).Return(nil, errors.New("db error")).Once()
+		m.assertAll(t)
+	})
+
+	for _, status := range []string{"active", "completed", "returned"} {
+		t.Run("sale status "+status, func(t *testing.T) {
+			svc, m := newSaleServiceWithMocks()
+			m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+			m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(3)).Return(&models.Sale{ID: 3, LocationID: 2, Status: status}, nil).Once()
+			m.tx.On("Rollback", ctx).Return(nil).Once()
+
+			err := svc.Activate(ctx, 3)
+			assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+			assert.Contains(t, err.Error(), "somente vendas canceladas podem ser reativadas")
+			m.assertAll(t)
+		})
+	}
+
+	t.Run("dinheiro já devolvido no cancelamento", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(&models.Sale{ID: 1, LocationID: 2, Status: "canceled", PaymentType: "cash"}, nil).Once()
+		m.repoCashTx.On("RefundedTx", ctx, m.tx, int64(1)).Return(money.New(50), nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
 
-		err := svc.Activate(ctx, 2)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), errMsg.ErrGet.Error())
-		mockRepo.AssertExpectations(t)
+		err := svc.Activate(ctx, 1)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		assert.Contains(t, err.Error(), "já devolvidos no cancelamento")
+		m.assertAll(t)
 	})
 
-	t.Run("sale não cancelada nem devolvida", func(t *testing.T) {
-		sale := &models.Sale{ID: 3, Status: "active"}
-		mockRepo.On("GetByID", ctx, int64(3)).Return(sale, nil).Once()
-		err := svc.Activate(ctx, 3)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "somente vendas canceladas ou devolvidas podem ser reativadas")
-		mockRepo.AssertExpectations(t)
-	})
+	t.Run("erro ao buscar itens", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(&models.Sale{ID: 1, LocationID: 2, Status: "canceled"}, nil).Once()
+		m.repoCashTx.On("RefundedTx", ctx, m.tx, int64(1)).Return(money.Zero, nil).Once()
+		m.repoItemTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(nil, errors.New("db error")).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
 
-	t.Run("sale status completed", func(t *testing.T) {
-		sale := &models.Sale{ID: 4, Status: "completed"}
-		mockRepo.On("GetByID", ctx, int64(4)).Return(sale, nil).Once()
-		err := svc.Activate(ctx, 4)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "somente vendas canceladas ou devolvidas podem ser reativadas")
-		mockRepo.AssertExpectations(t)
+		err := svc.Activate(ctx, 1)
+		assert.ErrorContains(t, err, "db error")
+		m.assertAll(t)
 	})
 
-	t.Run("sale status pending", func(t *testing.T) {
-		sale := &models.Sale{ID: 5, Status: "pending"}
-		mockRepo.On("GetByID", ctx, int64(5)).Return(sale, nil).Once()
-		err := svc.Activate(ctx, 5)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "somente vendas canceladas ou devolvidas podem ser reativadas")
-		mockRepo.AssertExpectations(t)
-	})
+	t.Run("estoque insuficiente", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(&models.Sale{ID: 1, LocationID: 2, Status: "canceled"}, nil).Once()
+		m.repoCashTx.On("RefundedTx", ctx, m.tx, int64(1)).Return(money.Zero, nil).Once()
+		m.repoItemTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(saleItems(), nil).Once()
+		m.repoStockTx.On("DecreaseStockAtTx", ctx, m.tx, int64(1), int64(2), 1, mock.Anything).Return(errMsg.ErrInsufficientStock).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
 
-	t.Run("sucesso com sale cancelada", func(t *testing.T) {
-		sale := &models.Sale{ID: 6, Status: "canceled"}
-		mockRepo.On("GetByID", ctx, int64(6)).Return(sale, nil).Once()
-		mockRepo.On("Update", ctx, sale).Return(nil).Once()
+		err := svc.Activate(ctx, 1)
+		assert.ErrorIs(t, err, errMsg.ErrInsufficientStock)
+		m.assertAll(t)
+	})
+
+	t.Run("limite de crédito excedido", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := &models.Sale{ID: 1, LocationID: 2, Status: "canceled", PaymentType: "credit", ClientID: &clientID, TotalAmount: money.New(50)}
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(sale, nil).Once()
+		m.repoCashTx.On("RefundedTx", ctx, m.tx, int64(1)).Return(money.Zero, nil).Once()
+		m.repoItemTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(saleItems(), nil).Once()
+		m.repoStockTx.On("DecreaseStockAtTx", ctx, m.tx, int64(1), int64(2), 1, mock.Anything).Return(nil).Once()
+		m.repoStockTx.On("DecreaseStockAtTx", ctx, m.tx, int64(2), int64(2), 2, mock.Anything).Return(nil).Once()
+		m.repoCreditTx.On("ChargeTx", ctx, m.tx, creditEntry(clientID, 50.0)).Return(errMsg.ErrCreditLimitExceeded).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
 
-		err := svc.Activate(ctx, 6)
-		assert.NoError(t, err)
-		assert.Equal(t, "active", sale.Status)
-		mockRepo.AssertExpectations(t)
+		err := svc.Activate(ctx, 1)
+		assert.ErrorIs(t, err, errMsg.ErrCreditLimitExceeded)
+		assert.Equal(t, "canceled", sale.Status)
+		m.assertAll(t)
 	})
 
-	t.Run("sucesso com sale devolvida", func(t *testing.T) {
-		sale := &models.Sale{ID: 7, Status: "returned"}
-		mockRepo.On("GetByID", ctx, int64(7)).Return(sale, nil).Once()
-		mockRepo.On("Update", ctx, sale).Return(nil).Once()
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := &models.Sale{ID: 1, LocationID: 2, Status: "canceled", PaymentType: "credit", ClientID: &clientID, TotalAmount: money.New(50)}
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(sale, nil).Once()
+		m.repoCashTx.On("RefundedTx", ctx, m.tx, int64(1)).Return(money.Zero, nil).Once()
+		m.repoItemTx.On("GetBySaleIDTx", ctx, m.tx, int64(1)).Return(saleItems(), nil).Once()
+		origin := modelsMovement.NewOrigin(modelsMovement.ReasonSale, modelsMovement.RefSale, 1)
+		m.repoStockTx.On("DecreaseStockAtTx", ctx, m.tx, int64(1), int64(2), 1, origin).Return(nil).Once()
+		m.repoStockTx.On("DecreaseStockAtTx", ctx, m.tx, int64(2), int64(2), 2, origin).Return(nil).Once()
+		m.repoCreditTx.On("ChargeTx", ctx, m.tx, creditEntry(clientID, 50.0)).Return(nil).Once()
+		m.repoSaleTx.On("UpdateStatusTx", ctx, m.tx, sale).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
 
-		err := svc.Activate(ctx, 7)
+		err := svc.Activate(ctx, 1)
 		assert.NoError(t, err)
 		assert.Equal(t, "active", sale.Status)
-		mockRepo.AssertExpectations(t)
-	})
-
-	t.Run("erro repo update", func(t *testing.T) {
-		sale := &models.Sale{ID: 8, Status: "canceled"}
-		mockRepo.On("GetByID", ctx, int64(8)).Return(sale, nil).Once()
-		mockRepo.On("Update", ctx, sale).Return(errors.New("update error")).Once()
-
-		err := svc.Activate(ctx, 8)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), errMsg.ErrUpdate.Error())
-		mockRepo.AssertExpectations(t)
+		m.assertAll(t)
 	})
 }
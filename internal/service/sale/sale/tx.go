@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	ifaceClient "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cpf"
+	modelsCash "github.com/WagaoCarvalho/backend_store_go/internal/model/cash/session"
+	modelsCredit "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	modelsItem "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	modelsPayment "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/payment"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	contextUtils "github.com/WagaoCarvalho/backend_store_go/internal/pkg/middleware/context_utils"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/jackc/pgx/v5"
+)
+
+// restockTx devolve ao estoque as quantidades informadas por produto. Os
+// produtos são atualizados em ordem crescente de ID, a mesma ordem usada pelo
+// checkout, para evitar deadlock. A mercadoria volta ao local da venda;
+// origin identifica o documento no histórico.
+func (s *saleService) restockTx(ctx context.Context, tx pgx.Tx, locationID int64, quantities map[int64]int, origin modelsMovement.Origin) error {
+	for _, productID := range sortedProductIDs(quantities) {
+		if quantities[productID] <= 0 {
+			continue
+		}
+		if err := s.repoStockTx.IncreaseStockAtTx(ctx, tx, productID, locationID, quantities[productID], origin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saleOrigin é a origem dos movimentos de cancelamento e reativação, que
+// apontam para a própria venda.
+func saleOrigin(reason string, saleID int64) modelsMovement.Origin {
+	return modelsMovement.NewOrigin(reason, modelsMovement.RefSale, saleID)
+}
+
+// unstockTx baixa novamente do local da venda as quantidades informadas por produto.
+func (s *saleService) unstockTx(ctx context.Context, tx pgx.Tx, locationID int64, quantities map[int64]int, origin modelsMovement.Origin) error {
+	for _, productID := range sortedProductIDs(quantities) {
+		if quantities[productID] <= 0 {
+			continue
+		}
+		if err := s.repoStockTx.DecreaseStockAtTx(ctx, tx, productID, locationID, quantities[productID], origin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// refundCreditTx abate amount do saldo devedor do cliente quando a venda foi a crédito.
+func (s *saleService) refundCreditTx(ctx context.Context, tx pgx.Tx, sale *models.Sale, amount money.Money) error {
+	ledger, clientID, ok := s.creditLedger(sale)
+	if sale.PaymentType != "credit" || !ok || !amount.IsPositive() {
+		return nil
+	}
+	entry := modelsCredit.NewSaleEntry(clientID, sale.ID, amount, fmt.Sprintf("estorno da venda %d", sale.ID))
+	return ledger.RefundTx(ctx, tx, entry)
+}
+
+// chargeCreditTx volta a lançar amount no saldo devedor do cliente quando a venda é a crédito.
+func (s *saleService) chargeCreditTx(ctx context.Context, tx pgx.Tx, sale *models.Sale, amount money.Money) error {
+	ledger, clientID, ok := s.creditLedger(sale)
+	if sale.PaymentType != "credit" || !ok || !amount.IsPositive() {
+		return nil
+	}
+	entry := modelsCredit.NewSaleEntry(clientID, sale.ID, amount, fmt.Sprintf("venda %d", sale.ID))
+	return ledger.ChargeTx(ctx, tx, entry)
+}
+
+// refundCashTx devolve em dinheiro, do caixa aberto do operador, a parte de
+// amount que o cliente pagou em dinheiro: a proporção do dinheiro no total
+// pago ou, com all, todo o dinheiro ainda não devolvido. Cartão e Pix são
+// estornados fora da gaveta, e a venda a crédito, no razão do cliente.
+func (s *saleService) refundCashTx(ctx context.Context, tx pgx.Tx, sale *models.Sale, amount money.Money, all bool, operator *int64, reason string) error {
+	if sale.PaymentType == "credit" || (!all && !amount.IsPositive()) {
+		return nil
+	}
+
+	payments, err := s.repoPaymentTx.GetBySaleIDTx(ctx, tx, sale.ID)
+	if err != nil {
+		return err
+	}
+
+	var cash, paid money.Money
+	for _, p := range payments {
+		paid = paid.Add(p.Net())
+		if p.Method == modelsPayment.MethodCash {
+			cash = cash.Add(p.Net())
+		}
+	}
+	if !cash.IsPositive() {
+		return nil
+	}
+
+	refunded, err := s.repoCashTx.RefundedTx(ctx, tx, sale.ID)
+	if err != nil {
+		return err
+	}
+
+	due := cash.Sub(refunded)
+	if !all {
+		share, err := amount.MulFrac(cash.Cents(), paid.Cents())
+		if err != nil {
+			return err
+		}
+		due = money.Min(due, share)
+	}
+	if !due.IsPositive() {
+		return nil
+	}
+
+	_, err = s.repoCashTx.CreateRefundTx(ctx, tx, modelsCash.NewRefund(sale.ID, operator, due, reason))
+	return err
+}
+
+// operator é o usuário autenticado que registra a operação.
+func operator(ctx context.Context) *int64 {
+	id, err := strconv.ParseInt(contextUtils.GetUserID(ctx), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
+
+// creditLedger escolhe o razão de crédito pelo tipo de cliente da venda
+// (pessoa física ou jurídica). ok é falso quando a venda não tem cliente.
+func (s *saleService) creditLedger(sale *models.Sale) (ifaceClient.ClientCreditTx, int64, bool) {
+	switch {
+	case sale.ClientCnpjID != nil:
+		return s.repoCnpjTx, *sale.ClientCnpjID, true
+	case sale.ClientID != nil:
+		return s.repoCreditTx, *sale.ClientID, true
+	}
+	return nil, 0, false
+}
+
+func quantitiesByProduct(items []*modelsItem.SaleItem) map[int64]int {
+	quantities := make(map[int64]int, len(items))
+	for _, item := range items {
+		quantities[item.ProductID] += item.Quantity
+	}
+	return quantities
+}
+
+func sortedProductIDs(quantities map[int64]int) []int64 {
+	ids := make([]int64, 0, len(quantities))
+	for id := range quantities {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	mockCash "github.com/WagaoCarvalho/backend_store_go/infra/mock/cash"
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cpf"
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	mockTX "github.com/WagaoCarvalho/backend_store_go/infra/mock/repo"
+	mockSale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	modelsCredit "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	modelsItem "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type saleMocks struct {
+	repo          *mockSale.MockSale
+	repoReturn    *mockSale.MockSaleReturnReader
+	repoSaleTx    *mockSale.MockSaleTx
+	repoItemTx    *mockSale.MockSaleItemTx
+	repoReturnTx  *mockSale.MockSaleReturnTx
+	repoStockTx   *mockProduct.MockProductStockTx
+	repoCreditTx  *mockClient.MockClientCreditTx
+	repoCnpjTx    *mockClient.MockClientCreditTx
+	repoPaymentTx *mockSale.MockSalePaymentTx
+	repoPixTx     *mockSale.MockPixChargeTx
+	repoCashTx    *mockCash.MockCashSessionTx
+	tx            *mockTX.MockTx
+}
+
+func newSaleServiceWithMocks() (*saleService, saleMocks) {
+	m := saleMocks{
+		repo:          new(mockSale.MockSale),
+		repoReturn:    new(mockSale.MockSaleReturnReader),
+		repoSaleTx:    new(mockSale.MockSaleTx),
+		repoItemTx:    new(mockSale.MockSaleItemTx),
+		repoReturnTx:  new(mockSale.MockSaleReturnTx),
+		repoStockTx:   new(mockProduct.MockProductStockTx),
+		repoCreditTx:  new(mockClient.MockClientCreditTx),
+		repoCnpjTx:    new(mockClient.MockClientCreditTx),
+		repoPaymentTx: new(mockSale.MockSalePaymentTx),
+		repoPixTx:     new(mockSale.MockPixChargeTx),
+		repoCashTx:    new(mockCash.MockCashSessionTx),
+		tx:            new(mockTX.MockTx),
+	}
+	svc := NewSaleService(m.repo, m.repoReturn, m.repoSaleTx, m.repoItemTx, m.repoReturnTx, m.repoStockTx, m.repoCreditTx, m.repoCnpjTx, m.repoPaymentTx, m.repoPixTx, m.repoCashTx)
+	return svc.(*saleService), m
+}
+
+func (m saleMocks) assertAll(t *testing.T) {
+	m.repoSaleTx.AssertExpectations(t)
+	m.repoItemTx.AssertExpectations(t)
+	m.repoReturnTx.AssertExpectations(t)
+	m.repoStockTx.AssertExpectations(t)
+	m.repoCreditTx.AssertExpectations(t)
+	m.repoPaymentTx.AssertExpectations(t)
+	m.repoPixTx.AssertExpectations(t)
+	m.repoCashTx.AssertExpectations(t)
+	m.tx.AssertExpectations(t)
+}
+
+func saleItems() []*modelsItem.SaleItem {
+	return []*modelsItem.SaleItem{
+		{ID: 10, SaleID: 1, ProductID: 2, Quantity: 2, Subtotal: money.New(20)},
+		{ID: 11, SaleID: 1, ProductID: 1, Quantity: 1, Subtotal: money.New(30)},
+	}
+}
+
+// creditEntry casa o lançamento de razão gerado para a venda 1 do cliente.
+func creditEntry(clientID int64, amount float64) interface{} {
+	return mock.MatchedBy(func(e *modelsCredit.CreditEntry) bool {
+		return e.ClientID == clientID && e.Amount == money.MustFromFloat(amount) && e.SaleID != nil && *e.SaleID == 1
+	})
+}
+
+func TestSaleService_CreditHelpers(t *testing.T) {
+	ctx := context.Background()
+	clientID := int64(7)
+
+	t.Run("venda não a crédito não movimenta saldo", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := &models.Sale{PaymentType: "pix", ClientID: &clientID}
+
+		assert.NoError(t, svc.refundCreditTx(ctx, m.tx, sale, money.New(10)))
+		assert.NoError(t, svc.chargeCreditTx(ctx, m.tx, sale, money.New(10)))
+		m.repoCreditTx.AssertNotCalled(t, "RefundTx")
+		m.repoCreditTx.AssertNotCalled(t, "ChargeTx")
+	})
+
+	t.Run("venda a crédito sem cliente", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := &models.Sale{PaymentType: "credit"}
+
+		assert.NoError(t, svc.refundCreditTx(ctx, m.tx, sale, money.New(10)))
+		m.repoCreditTx.AssertNotCalled(t, "RefundTx")
+	})
+
+	t.Run("venda a crédito", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := &models.Sale{ID: 1, PaymentType: "credit", ClientID: &clientID}
+		m.repoCreditTx.On("RefundTx", ctx, m.tx, creditEntry(clientID, 10.0)).Return(nil).Once()
+		m.repoCreditTx.On("ChargeTx", ctx, m.tx, creditEntry(clientID, 5.0)).Return(nil).Once()
+
+		assert.NoError(t, svc.refundCreditTx(ctx, m.tx, sale, money.New(10)))
+		assert.NoError(t, svc.chargeCreditTx(ctx, m.tx, sale, money.New(5)))
+		m.repoCreditTx.AssertExpectations(t)
+	})
+
+	t.Run("venda a crédito de cliente CNPJ usa o razão de pessoa jurídica", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := &models.Sale{ID: 1, PaymentType: "credit", ClientCnpjID: &clientID}
+		m.repoCnpjTx.On("RefundTx", ctx, m.tx, creditEntry(clientID, 10.0)).Return(nil).Once()
+		m.repoCnpjTx.On("ChargeTx", ctx, m.tx, creditEntry(clientID, 5.0)).Return(nil).Once()
+
+		assert.NoError(t, svc.refundCreditTx(ctx, m.tx, sale, money.New(10)))
+		assert.NoError(t, svc.chargeCreditTx(ctx, m.tx, sale, money.New(5)))
+		m.repoCnpjTx.AssertExpectations(t)
+		m.repoCreditTx.AssertNotCalled(t, "RefundTx")
+		m.repoCreditTx.AssertNotCalled(t, "ChargeTx")
+	})
+}
+
+func TestQuantitiesByProduct(t *testing.T) {
+	items := append(saleItems(), &modelsItem.SaleItem{ID: 12, ProductID: 2, Quantity: 3})
+
+	quantities := quantitiesByProduct(items)
+
+	assert.Equal(t, map[int64]int{1: 1, 2: 5}, quantities)
+	assert.Equal(t, []int64{1, 2}, sortedProductIDs(quantities))
+}
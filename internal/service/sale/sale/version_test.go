@@ -17,7 +17,7 @@ func TestSaleService_GetVersionByID(t *testing.T) {
 	newService := func() (*mockSale.MockSale, SaleService) {
 		mr := new(mockSale.MockSale)
 
-		return mr, NewSaleService(mr)
+		return mr, NewSaleService(mr, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	}
 
 	t.Run("falha: ID inválido", func(t *testing.T) {
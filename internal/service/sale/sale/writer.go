@@ -7,6 +7,8 @@ import (
 
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
 )
 
 func (s *saleService) Create(ctx context.Context, sale *models.Sale) (*models.Sale, error) {
@@ -22,7 +24,26 @@ func (s *saleService) Create(ctx context.Context, sale *models.Sale) (*models.Sa
 		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
 	}
 
-	createdSale, err := s.repo.Create(ctx, sale)
+	// Vendas já canceladas ou devolvidas não geram dívida para o cliente
+	if sale.PaymentType != "credit" || sale.Status == "canceled" || sale.Status == "returned" {
+		createdSale, err := s.repo.Create(ctx, sale)
+		if err != nil {
+			return nil, err
+		}
+		return createdSale, nil
+	}
+
+	// Venda a crédito: a cobrança no razão do cliente é lançada na mesma
+	// transação, e a venda é recusada quando excede o limite.
+	var createdSale *models.Sale
+	err := repoDb.RunInTx(ctx, s.repoSaleTx, func(tx pgx.Tx) error {
+		var err error
+		createdSale, err = s.repoSaleTx.CreateTx(ctx, tx, sale)
+		if err != nil {
+			return err
+		}
+		return s.chargeCreditTx(ctx, tx, createdSale, createdSale.TotalAmount)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -11,13 +11,14 @@ import (
 	mockSale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
 	models "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
 	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
 	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/utils"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestSaleService_Create(t *testing.T) {
 	mockRepo := new(mockSale.MockSale)
-	svc := NewSaleService(mockRepo)
+	svc := NewSaleService(mockRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	t.Run("should return ErrInvalidData when sale is nil", func(t *testing.T) {
@@ -33,10 +34,10 @@ func TestSaleService_Create(t *testing.T) {
 			ClientID:           utils.Int64Ptr(1),
 			UserID:             utils.Int64Ptr(1),
 			SaleDate:           time.Now(),
-			TotalItemsAmount:   100.00,
-			TotalItemsDiscount: -10.00,
-			TotalSaleDiscount:  5.00,
-			TotalAmount:        95.00,
+			TotalItemsAmount:   money.New(100),
+			TotalItemsDiscount: money.New(-10),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(95),
 			PaymentType:        "invalid_payment",
 			Status:             "active",
 			Notes:              "Test sale",
@@ -55,10 +56,10 @@ func TestSaleService_Create(t *testing.T) {
 			ClientID:           utils.Int64Ptr(1),
 			UserID:             utils.Int64Ptr(1),
 			SaleDate:           time.Time{},
-			TotalItemsAmount:   100.00,
-			TotalItemsDiscount: 50.00,
-			TotalSaleDiscount:  60.00,
-			TotalAmount:        95.00,
+			TotalItemsAmount:   money.New(100),
+			TotalItemsDiscount: money.New(50),
+			TotalSaleDiscount:  money.New(60),
+			TotalAmount:        money.New(95),
 			PaymentType:        "cash",
 			Status:             "active",
 			Notes:              "Test sale",
@@ -77,10 +78,10 @@ func TestSaleService_Create(t *testing.T) {
 			ClientID:           utils.Int64Ptr(1),
 			UserID:             utils.Int64Ptr(1),
 			SaleDate:           time.Now(),
-			TotalItemsAmount:   100.00,
-			TotalItemsDiscount: 10.00,
-			TotalSaleDiscount:  5.00,
-			TotalAmount:        85.00,
+			TotalItemsAmount:   money.New(100),
+			TotalItemsDiscount: money.New(10),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(85),
 			PaymentType:        "cash",
 			Status:             "active",
 			Notes:              "Test sale",
@@ -105,10 +106,10 @@ func TestSaleService_Create(t *testing.T) {
 			ClientID:           utils.Int64Ptr(1),
 			UserID:             utils.Int64Ptr(1),
 			SaleDate:           time.Now(),
-			TotalItemsAmount:   100.00,
-			TotalItemsDiscount: 10.00,
-			TotalSaleDiscount:  5.00,
-			TotalAmount:        85.00,
+			TotalItemsAmount:   money.New(100),
+			TotalItemsDiscount: money.New(10),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(85),
 			PaymentType:        "cash",
 			Status:             "active",
 			Notes:              "Test sale",
@@ -132,10 +133,10 @@ func TestSaleService_Create(t *testing.T) {
 			ClientID:           utils.Int64Ptr(1),
 			UserID:             utils.Int64Ptr(2),
 			SaleDate:           now,
-			TotalItemsAmount:   200.00,
-			TotalItemsDiscount: 20.00,
-			TotalSaleDiscount:  10.00,
-			TotalAmount:        170.00,
+			TotalItemsAmount:   money.New(200),
+			TotalItemsDiscount: money.New(20),
+			TotalSaleDiscount:  money.New(10),
+			TotalAmount:        money.New(170),
 			PaymentType:        "cash",
 			Status:             "active",
 			Notes:              "Test sale with valid data",
@@ -181,7 +182,6 @@ func TestSaleService_Create(t *testing.T) {
 		}{
 			{"cash payment", "cash"},
 			{"card payment", "card"},
-			{"credit payment", "credit"},
 			{"pix payment", "pix"},
 		}
 
@@ -191,10 +191,10 @@ func TestSaleService_Create(t *testing.T) {
 					ClientID:           utils.Int64Ptr(1),
 					UserID:             utils.Int64Ptr(1),
 					SaleDate:           time.Now(),
-					TotalItemsAmount:   100.00,
-					TotalItemsDiscount: 10.00,
-					TotalSaleDiscount:  5.00,
-					TotalAmount:        85.00,
+					TotalItemsAmount:   money.New(100),
+					TotalItemsDiscount: money.New(10),
+					TotalSaleDiscount:  money.New(5),
+					TotalAmount:        money.New(85),
 					PaymentType:        tc.paymentType,
 					Status:             "active",
 					Notes:              "Test sale",
@@ -236,10 +236,10 @@ func TestSaleService_Create(t *testing.T) {
 					ClientID:           utils.Int64Ptr(1),
 					UserID:             utils.Int64Ptr(1),
 					SaleDate:           time.Now(),
-					TotalItemsAmount:   100.00,
-					TotalItemsDiscount: 10.00,
-					TotalSaleDiscount:  5.00,
-					TotalAmount:        85.00,
+					TotalItemsAmount:   money.New(100),
+					TotalItemsDiscount: money.New(10),
+					TotalSaleDiscount:  money.New(5),
+					TotalAmount:        money.New(85),
 					PaymentType:        "cash",
 					Status:             tc.status,
 					Notes:              "Test sale",
@@ -269,10 +269,10 @@ func TestSaleService_Create(t *testing.T) {
 			ClientID:           utils.Int64Ptr(1),
 			UserID:             utils.Int64Ptr(1),
 			SaleDate:           time.Now(),
-			TotalItemsAmount:   100.00,
-			TotalItemsDiscount: 10.00,
-			TotalSaleDiscount:  5.00,
-			TotalAmount:        85.00,
+			TotalItemsAmount:   money.New(100),
+			TotalItemsDiscount: money.New(10),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(85),
 			PaymentType:        "cash",
 			Status:             "active",
 			Notes:              "",
@@ -302,10 +302,10 @@ func TestSaleService_Create(t *testing.T) {
 			ClientID:           utils.Int64Ptr(1),
 			UserID:             utils.Int64Ptr(1),
 			SaleDate:           time.Now(),
-			TotalItemsAmount:   100.00,
-			TotalItemsDiscount: 10.00,
-			TotalSaleDiscount:  5.00,
-			TotalAmount:        85.00,
+			TotalItemsAmount:   money.New(100),
+			TotalItemsDiscount: money.New(10),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(85),
 			PaymentType:        "cash",
 			Status:             "active",
 			Notes:              longNotes,
@@ -335,10 +335,10 @@ func TestSaleService_Create(t *testing.T) {
 			ClientID:           utils.Int64Ptr(1),
 			UserID:             utils.Int64Ptr(1),
 			SaleDate:           time.Now(),
-			TotalItemsAmount:   100.00,
-			TotalItemsDiscount: 10.00,
-			TotalSaleDiscount:  5.00,
-			TotalAmount:        85.00,
+			TotalItemsAmount:   money.New(100),
+			TotalItemsDiscount: money.New(10),
+			TotalSaleDiscount:  money.New(5),
+			TotalAmount:        money.New(85),
 			PaymentType:        "cash",
 			Status:             "active",
 			Notes:              longNotes,
@@ -359,10 +359,10 @@ func createValidSaleForTest(id int64) *models.Sale {
 		ClientID:           utils.Int64Ptr(100),
 		UserID:             utils.Int64Ptr(200),
 		SaleDate:           time.Now(),
-		TotalItemsAmount:   300.00,
-		TotalItemsDiscount: 30.00,
-		TotalSaleDiscount:  10.00,
-		TotalAmount:        260.00,
+		TotalItemsAmount:   money.New(300),
+		TotalItemsDiscount: money.New(30),
+		TotalSaleDiscount:  money.New(10),
+		TotalAmount:        money.New(260),
 		PaymentType:        "cash",
 		Status:             "active",
 		Notes:              "Test sale",
@@ -371,9 +371,95 @@ func createValidSaleForTest(id int64) *models.Sale {
 	}
 }
 
+func TestSaleService_CreateCredit(t *testing.T) {
+	ctx := context.Background()
+	clientID := int64(7)
+
+	newCreditSale := func() *models.Sale {
+		return &models.Sale{
+			ClientID:         &clientID,
+			UserID:           utils.Int64Ptr(1),
+			SaleDate:         time.Now(),
+			TotalItemsAmount: money.New(50),
+			TotalAmount:      money.New(50),
+			PaymentType:      "credit",
+			Status:           "active",
+			Version:          1,
+		}
+	}
+
+	t.Run("lança cobrança na mesma transação da venda", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := newCreditSale()
+		created := *sale
+		created.ID = 1
+
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("CreateTx", ctx, m.tx, sale).Return(&created, nil).Once()
+		m.repoCreditTx.On("ChargeTx", ctx, m.tx, creditEntry(clientID, 50.0)).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		result, err := svc.Create(ctx, sale)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), result.ID)
+		m.repo.AssertNotCalled(t, "Create", ctx, sale)
+		m.assertAll(t)
+	})
+
+	t.Run("recusa venda acima do limite de crédito", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := newCreditSale()
+		created := *sale
+		created.ID = 1
+
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("CreateTx", ctx, m.tx, sale).Return(&created, nil).Once()
+		m.repoCreditTx.On("ChargeTx", ctx, m.tx, creditEntry(clientID, 50.0)).Return(errMsg.ErrCreditLimitExceeded).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.Create(ctx, sale)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreditLimitExceeded)
+		m.assertAll(t)
+	})
+
+	t.Run("erro ao criar venda faz rollback", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := newCreditSale()
+
+		m.repoSaleTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.repoSaleTx.On("CreateTx", ctx, m.tx, sale).Return(nil, errMsg.ErrCreate).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.Create(ctx, sale)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreate)
+		m.repoCreditTx.AssertNotCalled(t, "ChargeTx")
+		m.assertAll(t)
+	})
+
+	t.Run("venda a crédito já cancelada não gera cobrança", func(t *testing.T) {
+		svc, m := newSaleServiceWithMocks()
+		sale := newCreditSale()
+		sale.Status = "canceled"
+
+		m.repo.On("Create", ctx, sale).Return(&models.Sale{ID: 1}, nil).Once()
+
+		result, err := svc.Create(ctx, sale)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), result.ID)
+		m.repoSaleTx.AssertNotCalled(t, "BeginTx", ctx)
+		m.assertAll(t)
+	})
+}
+
 func TestSaleService_Update(t *testing.T) {
 	mockRepo := new(mockSale.MockSale)
-	svc := NewSaleService(mockRepo)
+	svc := NewSaleService(mockRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	t.Run("sale nil", func(t *testing.T) {
@@ -385,8 +471,8 @@ func TestSaleService_Update(t *testing.T) {
 		s := &models.Sale{
 			ID:                0,
 			Version:           1,
-			TotalAmount:       100,
-			TotalSaleDiscount: 10,
+			TotalAmount:       money.New(100),
+			TotalSaleDiscount: money.New(10),
 			PaymentType:       "cash",
 			Status:            "active",
 			SaleDate:          time.Now(),
@@ -399,8 +485,8 @@ func TestSaleService_Update(t *testing.T) {
 		s := &models.Sale{
 			ID:                1,
 			Version:           0,
-			TotalAmount:       100,
-			TotalSaleDiscount: 10,
+			TotalAmount:       money.New(100),
+			TotalSaleDiscount: money.New(10),
 			PaymentType:       "cash",
 			Status:            "active",
 			SaleDate:          time.Now(),
@@ -413,8 +499,8 @@ func TestSaleService_Update(t *testing.T) {
 		s := &models.Sale{
 			ID:                1,
 			Version:           1,
-			TotalAmount:       -1,
-			TotalSaleDiscount: -1,
+			TotalAmount:       money.New(-1),
+			TotalSaleDiscount: money.New(-1),
 			PaymentType:       "",
 			Status:            "",
 		}
@@ -426,8 +512,8 @@ func TestSaleService_Update(t *testing.T) {
 		s := &models.Sale{
 			ID:                1,
 			Version:           1,
-			TotalAmount:       10,
-			TotalSaleDiscount: 20,
+			TotalAmount:       money.New(10),
+			TotalSaleDiscount: money.New(20),
 			PaymentType:       "cash",
 			Status:            "active",
 			SaleDate:          time.Now(),
@@ -440,8 +526,8 @@ func TestSaleService_Update(t *testing.T) {
 		s := &models.Sale{
 			ID:                1,
 			Version:           1,
-			TotalAmount:       100,
-			TotalSaleDiscount: 10,
+			TotalAmount:       money.New(100),
+			TotalSaleDiscount: money.New(10),
 			PaymentType:       "cash",
 			Status:            "active",
 			SaleDate:          time.Now(),
@@ -458,8 +544,8 @@ func TestSaleService_Update(t *testing.T) {
 		s := &models.Sale{
 			ID:                1,
 			Version:           1,
-			TotalAmount:       100,
-			TotalSaleDiscount: 10,
+			TotalAmount:       money.New(100),
+			TotalSaleDiscount: money.New(10),
 			PaymentType:       "cash",
 			Status:            "active",
 			SaleDate:          time.Now(),
@@ -507,7 +593,7 @@ func TestSaleService_Update(t *testing.T) {
 
 func TestSaleService_Delete(t *testing.T) {
 	mockRepo := new(mockSale.MockSale)
-	svc := NewSaleService(mockRepo)
+	svc := NewSaleService(mockRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	t.Run("id zero", func(t *testing.T) {
@@ -0,0 +1,13 @@
+package services
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/service_order/filter"
+
+type serviceOrderFilterService struct {
+	repo repo.ServiceOrderFilter
+}
+
+func NewServiceOrderFilterService(repo repo.ServiceOrderFilter) ServiceOrderFilter {
+	return &serviceOrderFilterService{
+		repo: repo,
+	}
+}
@@ -0,0 +1,28 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/filter"
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *serviceOrderFilterService) Filter(ctx context.Context, filter *filter.ServiceOrderFilter) (*commonFilter.Page[*model.ServiceOrder], error) {
+	if filter == nil {
+		return nil, fmt.Errorf("%w: filtro não pode ser nulo", errMsg.ErrInvalidFilter)
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidFilter, err)
+	}
+
+	orders, err := s.repo.Filter(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return orders, nil
+}
@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockServiceOrder "github.com/WagaoCarvalho/backend_store_go/infra/mock/service_order"
+	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
+	serviceOrderFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/filter"
+	model "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServiceOrderFilterService_Filter(t *testing.T) {
+	t.Run("falha quando filtro é nulo", func(t *testing.T) {
+		mockRepo := new(mockServiceOrder.MockServiceOrder)
+		service := NewServiceOrderFilterService(mockRepo)
+
+		result, err := service.Filter(context.Background(), nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "Filter", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha na validação do filtro", func(t *testing.T) {
+		mockRepo := new(mockServiceOrder.MockServiceOrder)
+		service := NewServiceOrderFilterService(mockRepo)
+
+		invalidFilter := &serviceOrderFilter.ServiceOrderFilter{
+			BaseFilter: filter.BaseFilter{Limit: 10},
+			Status:     "approved",
+		}
+
+		result, err := service.Filter(context.Background(), invalidFilter)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "Filter", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falha ao buscar no repositório", func(t *testing.T) {
+		mockRepo := new(mockServiceOrder.MockServiceOrder)
+		service := NewServiceOrderFilterService(mockRepo)
+
+		validFilter := &serviceOrderFilter.ServiceOrderFilter{BaseFilter: filter.BaseFilter{Limit: 10}}
+		mockRepo.On("Filter", mock.Anything, validFilter).Return(nil, errors.New("falha no banco de dados")).Once()
+
+		result, err := service.Filter(context.Background(), validFilter)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("sucesso ao retornar lista de ordens", func(t *testing.T) {
+		mockRepo := new(mockServiceOrder.MockServiceOrder)
+		service := NewServiceOrderFilterService(mockRepo)
+
+		validFilter := &serviceOrderFilter.ServiceOrderFilter{
+			BaseFilter: filter.BaseFilter{Limit: 10},
+			Status:     model.StatusDone,
+		}
+		tech := int64(9)
+		orders := []*model.ServiceOrder{
+			{ID: 1, TechnicianID: &tech, Status: model.StatusDone},
+			{ID: 2, TechnicianID: &tech, Status: model.StatusDone},
+		}
+		mockRepo.On("Filter", mock.Anything, validFilter).Return(orders, nil).Once()
+
+		result, err := service.Filter(context.Background(), validFilter)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, int64(2), result.Items[1].ID)
+		mockRepo.AssertExpectations(t)
+	})
+}
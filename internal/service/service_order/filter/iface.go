@@ -0,0 +1,7 @@
+package services
+
+import iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/service_order"
+
+type ServiceOrderFilter interface {
+	iface.ServiceOrderFilter
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	ifaceClient "github.com/WagaoCarvalho/backend_store_go/internal/iface/client_cpf"
+	ifaceProduct "github.com/WagaoCarvalho/backend_store_go/internal/iface/product"
+	ifaceSale "github.com/WagaoCarvalho/backend_store_go/internal/iface/sale"
+	ifaceServiceOrder "github.com/WagaoCarvalho/backend_store_go/internal/iface/service_order"
+	repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/service_order/order"
+)
+
+type serviceOrderService struct {
+	repo             repo.ServiceOrder
+	repoOrderTx      ifaceServiceOrder.ServiceOrderTx
+	repoStockTx      ifaceProduct.ProductStockTx
+	repoSaleTx       ifaceSale.SaleTx
+	repoItemTx       ifaceSale.SaleItemTx
+	repoCreditTx     ifaceClient.ClientCreditTx
+	repoCnpjCreditTx ifaceClient.ClientCreditTx
+}
+
+// NewServiceOrderService recebe os repositórios de venda e de crédito usados
+// no faturamento da ordem (ver ConvertToSale).
+func NewServiceOrderService(
+	repo repo.ServiceOrder,
+	repoOrderTx ifaceServiceOrder.ServiceOrderTx,
+	repoStockTx ifaceProduct.ProductStockTx,
+	repoSaleTx ifaceSale.SaleTx,
+	repoItemTx ifaceSale.SaleItemTx,
+	repoCreditTx ifaceClient.ClientCreditTx,
+	repoCnpjCreditTx ifaceClient.ClientCreditTx,
+) ServiceOrderService {
+	return &serviceOrderService{
+		repo:             repo,
+		repoOrderTx:      repoOrderTx,
+		repoStockTx:      repoStockTx,
+		repoSaleTx:       repoSaleTx,
+		repoItemTx:       repoItemTx,
+		repoCreditTx:     repoCreditTx,
+		repoCnpjCreditTx: repoCnpjCreditTx,
+	}
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	modelsCredit "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	modelsItem "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	modelsSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// ConvertToSale fatura uma ordem concluída gerando um registro em sales.
+// As peças viram itens da venda sem nova baixa de estoque (já baixado em
+// AddPart); a mão de obra entra apenas no total da venda, e o recálculo dos
+// totais após editar itens a mantém. Pagamento a crédito é lançado no razão
+// do cliente da ordem.
+func (s *serviceOrderService) ConvertToSale(ctx context.Context, conversion *models.SaleConversion) (*modelsSale.Sale, error) {
+	if conversion == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if conversion.ServiceOrderID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	var created *modelsSale.Sale
+
+	err := repoDb.RunInTx(ctx, s.repoOrderTx, func(tx pgx.Tx) error {
+		order, err := s.repoOrderTx.GetByIDForUpdateTx(ctx, tx, conversion.ServiceOrderID)
+		if err != nil {
+			return err
+		}
+
+		if !order.Convertible() {
+			return fmt.Errorf("%w: somente ordens concluídas e ainda não faturadas podem virar venda", errMsg.ErrInvalidData)
+		}
+
+		order.CalculateTotals()
+
+		notes := conversion.Notes
+		if notes == "" {
+			notes = fmt.Sprintf("ordem de serviço %d", order.ID)
+		}
+
+		sale := &modelsSale.Sale{
+			ClientID:         order.ClientID,
+			ClientCnpjID:     order.ClientCnpjID,
+			UserID:           conversion.UserID,
+			SaleDate:         time.Now(),
+			TotalItemsAmount: order.PartsAmount,
+			TotalAmount:      order.TotalAmount,
+			PaymentType:      conversion.PaymentType,
+			Status:           "active",
+			Notes:            notes,
+			Version:          1,
+		}
+		if err := sale.ValidateStructural(); err != nil {
+			return fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+		}
+
+		created, err = s.repoSaleTx.CreateTx(ctx, tx, sale)
+		if err != nil {
+			return err
+		}
+
+		for _, part := range order.Parts {
+			item := &modelsItem.SaleItem{
+				SaleID:    created.ID,
+				ProductID: part.ProductID,
+				Quantity:  part.Quantity,
+				Subtotal:  part.Subtotal(),
+			}
+			if part.UnitPrice != nil {
+				item.UnitPrice = *part.UnitPrice
+			}
+			if _, err := s.repoItemTx.CreateTx(ctx, tx, item); err != nil {
+				return err
+			}
+		}
+
+		if created.PaymentType == "credit" && created.TotalAmount.IsPositive() {
+			ledger, clientID := s.repoCreditTx, order.ClientID
+			if order.ClientCnpjID != nil {
+				ledger, clientID = s.repoCnpjCreditTx, order.ClientCnpjID
+			}
+			entry := modelsCredit.NewSaleEntry(*clientID, created.ID, created.TotalAmount,
+				fmt.Sprintf("venda %d (ordem de serviço %d)", created.ID, order.ID))
+			if err := ledger.ChargeTx(ctx, tx, entry); err != nil {
+				return err
+			}
+		}
+
+		order.SaleID = &created.ID
+		return s.repoOrderTx.SetSaleTx(ctx, tx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	modelsCredit "github.com/WagaoCarvalho/backend_store_go/internal/model/client_cpf/credit"
+	modelsItem "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/item"
+	modelsSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func doneOrder() *models.ServiceOrder {
+	order := openOrder()
+	clientID := int64(4)
+	order.ClientID = &clientID
+	order.Status = models.StatusDone
+	return order
+}
+
+func TestServiceOrderService_ConvertToSale(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("conversão nil", func(t *testing.T) {
+		svc, _ := newServiceOrderService()
+
+		result, err := svc.ConvertToSale(ctx, nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newServiceOrderService()
+
+		result, err := svc.ConvertToSale(ctx, &models.SaleConversion{PaymentType: "cash"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("ordem em andamento não pode ser faturada", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openOrder(), nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.ConvertToSale(ctx, &models.SaleConversion{ServiceOrderID: 1, PaymentType: "cash"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.saleTx.AssertNotCalled(t, "CreateTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ordem já faturada", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		order := doneOrder()
+		saleID := int64(50)
+		order.SaleID = &saleID
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(order, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.ConvertToSale(ctx, &models.SaleConversion{ServiceOrderID: 1, PaymentType: "cash"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("forma de pagamento inválida", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(doneOrder(), nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.ConvertToSale(ctx, &models.SaleConversion{ServiceOrderID: 1, PaymentType: "boleto"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("sucesso gera venda com peças e mão de obra", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		order := doneOrder()
+		created := &modelsSale.Sale{ID: 99, PaymentType: "pix", TotalAmount: money.New(70)}
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(order, nil).Once()
+		m.saleTx.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(s *modelsSale.Sale) bool {
+			// só as peças viram itens; a mão de obra entra no total
+			return *s.ClientID == 4 && s.TotalItemsAmount == money.New(20) && s.TotalAmount == money.New(70) &&
+				s.Status == "active" && s.Notes == "ordem de serviço 1"
+		})).Return(created, nil).Once()
+		m.itemTx.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(i *modelsItem.SaleItem) bool {
+			return i.SaleID == 99 && i.ProductID == 7 && i.Quantity == 2 && i.UnitPrice == money.New(10) && i.Subtotal == money.New(20)
+		})).Return(&modelsItem.SaleItem{ID: 1}, nil).Once()
+		m.orderTx.On("SetSaleTx", ctx, m.tx, mock.MatchedBy(func(o *models.ServiceOrder) bool {
+			return o.SaleID != nil && *o.SaleID == 99
+		})).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		result, err := svc.ConvertToSale(ctx, &models.SaleConversion{ServiceOrderID: 1, PaymentType: "pix"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, created, result)
+		m.credit.AssertNotCalled(t, "ChargeTx", mock.Anything, mock.Anything, mock.Anything)
+		m.stockTx.AssertNotCalled(t, "DecreaseStockTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		m.saleTx.AssertExpectations(t)
+		m.itemTx.AssertExpectations(t)
+		m.orderTx.AssertExpectations(t)
+	})
+
+	t.Run("venda a crédito de cliente CNPJ lança no razão CNPJ", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		order := doneOrder()
+		cnpjID := int64(6)
+		order.ClientID, order.ClientCnpjID = nil, &cnpjID
+		created := &modelsSale.Sale{ID: 99, PaymentType: "credit", TotalAmount: money.New(70)}
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(order, nil).Once()
+		m.saleTx.On("CreateTx", ctx, m.tx, mock.Anything).Return(created, nil).Once()
+		m.itemTx.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsItem.SaleItem{ID: 1}, nil).Once()
+		m.cnpj.On("ChargeTx", ctx, m.tx, mock.MatchedBy(func(e *modelsCredit.CreditEntry) bool {
+			return e.ClientID == 6 && e.Amount == money.New(70)
+		})).Return(nil).Once()
+		m.orderTx.On("SetSaleTx", ctx, m.tx, order).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		result, err := svc.ConvertToSale(ctx, &models.SaleConversion{ServiceOrderID: 1, PaymentType: "credit"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(99), result.ID)
+		m.cnpj.AssertExpectations(t)
+		m.credit.AssertNotCalled(t, "ChargeTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("limite de crédito excedido desfaz a conversão", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		created := &modelsSale.Sale{ID: 99, PaymentType: "credit", TotalAmount: money.New(70)}
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(doneOrder(), nil).Once()
+		m.saleTx.On("CreateTx", ctx, m.tx, mock.Anything).Return(created, nil).Once()
+		m.itemTx.On("CreateTx", ctx, m.tx, mock.Anything).Return(&modelsItem.SaleItem{ID: 1}, nil).Once()
+		m.credit.On("ChargeTx", ctx, m.tx, mock.Anything).Return(errMsg.ErrCreditLimitExceeded).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.ConvertToSale(ctx, &models.SaleConversion{ServiceOrderID: 1, PaymentType: "credit"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrCreditLimitExceeded)
+		m.orderTx.AssertNotCalled(t, "SetSaleTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,21 @@
+package services
+
+import (
+	"context"
+
+	ifaceServiceOrder "github.com/WagaoCarvalho/backend_store_go/internal/iface/service_order"
+	modelsSale "github.com/WagaoCarvalho/backend_store_go/internal/model/sale/sale"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+)
+
+type ServiceOrderService interface {
+	ifaceServiceOrder.ServiceOrderReader
+	Create(ctx context.Context, order *models.ServiceOrder) (*models.ServiceOrder, error)
+	Update(ctx context.Context, order *models.ServiceOrder) error
+	ChangeStatus(ctx context.Context, id int64, status string) error
+	AddPart(ctx context.Context, part *models.ServiceOrderPart) (*models.ServiceOrderPart, error)
+	RemovePart(ctx context.Context, orderID, partID int64) error
+	AddLabor(ctx context.Context, labor *models.ServiceOrderLabor) (*models.ServiceOrderLabor, error)
+	RemoveLabor(ctx context.Context, orderID, laborID int64) error
+	ConvertToSale(ctx context.Context, conversion *models.SaleConversion) (*modelsSale.Sale, error)
+}
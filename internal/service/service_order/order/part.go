@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// AddPart lança uma peça na ordem e baixa o estoque do produto na mesma
+// transação. Sem preço informado, vale o preço de venda atual do produto.
+// A peça sai do local padrão, de onde DecreaseStockTx baixa, e é o saldo
+// desse local que precisa cobrir a quantidade; estoque em outra loja ou
+// depósito não atende a ordem.
+func (s *serviceOrderService) AddPart(ctx context.Context, part *models.ServiceOrderPart) (*models.ServiceOrderPart, error) {
+	if part == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if part.ServiceOrderID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	if err := part.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	var created *models.ServiceOrderPart
+
+	err := repoDb.RunInTx(ctx, s.repoOrderTx, func(tx pgx.Tx) error {
+		order, err := s.lockEditableTx(ctx, tx, part.ServiceOrderID)
+		if err != nil {
+			return err
+		}
+
+		product, err := s.repoStockTx.GetByIDForUpdateTx(ctx, tx, part.ProductID)
+		if err != nil {
+			return err
+		}
+		if !product.Status {
+			return errMsg.ErrProductDisabled
+		}
+
+		available, err := s.repoStockTx.GetStockAtForUpdateTx(ctx, tx, part.ProductID, defaultLocation)
+		if err != nil {
+			return err
+		}
+		if available < part.Quantity {
+			return fmt.Errorf("%w: disponível %d, solicitado %d",
+				errMsg.ErrInsufficientStock, available, part.Quantity)
+		}
+
+		if part.UnitPrice == nil {
+			price := product.SalePrice
+			part.UnitPrice = &price
+		}
+
+		if err := s.repoStockTx.DecreaseStockTx(ctx, tx, part.ProductID, part.Quantity, partOrigin(modelsMovement.ReasonSale, order.ID)); err != nil {
+			return err
+		}
+
+		created, err = s.repoOrderTx.AddPartTx(ctx, tx, part)
+		if err != nil {
+			return err
+		}
+
+		order.Parts = append(order.Parts, *created)
+		order.CalculateTotals()
+		return s.repoOrderTx.UpdateTotalsTx(ctx, tx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// RemovePart estorna a peça: devolve a quantidade ao estoque e recalcula os
+// totais da ordem.
+func (s *serviceOrderService) RemovePart(ctx context.Context, orderID, partID int64) error {
+	if orderID <= 0 || partID <= 0 {
+		return errMsg.ErrZeroID
+	}
+
+	return repoDb.RunInTx(ctx, s.repoOrderTx, func(tx pgx.Tx) error {
+		order, err := s.lockEditableTx(ctx, tx, orderID)
+		if err != nil {
+			return err
+		}
+
+		part, ok := order.FindPart(partID)
+		if !ok {
+			return errMsg.ErrNotFound
+		}
+
+		if err := s.repoStockTx.IncreaseStockTx(ctx, tx, part.ProductID, part.Quantity, partOrigin(modelsMovement.ReasonReturn, orderID)); err != nil {
+			return err
+		}
+
+		if err := s.repoOrderTx.DeletePartTx(ctx, tx, partID); err != nil {
+			return err
+		}
+
+		order.Parts = slices.DeleteFunc(order.Parts, func(p models.ServiceOrderPart) bool { return p.ID == partID })
+		order.CalculateTotals()
+		return s.repoOrderTx.UpdateTotalsTx(ctx, tx, order)
+	})
+}
+
+func (s *serviceOrderService) AddLabor(ctx context.Context, labor *models.ServiceOrderLabor) (*models.ServiceOrderLabor, error) {
+	if labor == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if labor.ServiceOrderID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	if err := labor.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	var created *models.ServiceOrderLabor
+
+	err := repoDb.RunInTx(ctx, s.repoOrderTx, func(tx pgx.Tx) error {
+		order, err := s.lockEditableTx(ctx, tx, labor.ServiceOrderID)
+		if err != nil {
+			return err
+		}
+
+		created, err = s.repoOrderTx.AddLaborTx(ctx, tx, labor)
+		if err != nil {
+			return err
+		}
+
+		order.Labor = append(order.Labor, *created)
+		order.CalculateTotals()
+		return s.repoOrderTx.UpdateTotalsTx(ctx, tx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *serviceOrderService) RemoveLabor(ctx context.Context, orderID, laborID int64) error {
+	if orderID <= 0 || laborID <= 0 {
+		return errMsg.ErrZeroID
+	}
+
+	return repoDb.RunInTx(ctx, s.repoOrderTx, func(tx pgx.Tx) error {
+		order, err := s.lockEditableTx(ctx, tx, orderID)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := order.FindLabor(laborID); !ok {
+			return errMsg.ErrNotFound
+		}
+
+		if err := s.repoOrderTx.DeleteLaborTx(ctx, tx, laborID); err != nil {
+			return err
+		}
+
+		order.Labor = slices.DeleteFunc(order.Labor, func(l models.ServiceOrderLabor) bool { return l.ID == laborID })
+		order.CalculateTotals()
+		return s.repoOrderTx.UpdateTotalsTx(ctx, tx, order)
+	})
+}
+
+// lockEditableTx bloqueia a ordem e recusa alterações depois de concluída
+// ou cancelada.
+func (s *serviceOrderService) lockEditableTx(ctx context.Context, tx pgx.Tx, id int64) (*models.ServiceOrder, error) {
+	order, err := s.repoOrderTx.GetByIDForUpdateTx(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !order.Editable() {
+		return nil, fmt.Errorf("%w: ordem concluída ou cancelada não pode ser alterada", errMsg.ErrInvalidData)
+	}
+
+	return order, nil
+}
+
+// defaultLocation pede a GetStockAtForUpdateTx o saldo do local padrão.
+const defaultLocation int64 = 0
+
+// partOrigin é a origem dos movimentos de peças: saída ao lançar a peça na
+// ordem e devolução ao estorná-la ou cancelar a ordem.
+func partOrigin(reason string, orderID int64) modelsMovement.Origin {
+	return modelsMovement.NewOrigin(reason, modelsMovement.RefServiceOrder, orderID)
+}
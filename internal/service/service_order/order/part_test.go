@@ -0,0 +1,218 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	modelsProduct "github.com/WagaoCarvalho/backend_store_go/internal/model/product/product"
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func openOrder() *models.ServiceOrder {
+	price := money.New(10)
+	return &models.ServiceOrder{
+		ID:     1,
+		Status: models.StatusInProgress,
+		Parts:  []models.ServiceOrderPart{{ID: 10, ServiceOrderID: 1, ProductID: 7, Quantity: 2, UnitPrice: &price}},
+		Labor:  []models.ServiceOrderLabor{{ID: 20, ServiceOrderID: 1, Description: "diagnóstico", Amount: money.New(50)}},
+	}
+}
+
+func TestServiceOrderService_AddPart(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("peça nil", func(t *testing.T) {
+		svc, _ := newServiceOrderService()
+
+		result, err := svc.AddPart(ctx, nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("quantidade inválida", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+
+		result, err := svc.AddPart(ctx, &models.ServiceOrderPart{ServiceOrderID: 1, ProductID: 8})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.orderTx.AssertNotCalled(t, "BeginTx", ctx)
+	})
+
+	t.Run("ordem concluída não aceita peças", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		order := openOrder()
+		order.Status = models.StatusDone
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(order, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.AddPart(ctx, &models.ServiceOrderPart{ServiceOrderID: 1, ProductID: 8, Quantity: 1})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.stockTx.AssertNotCalled(t, "GetByIDForUpdateTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("estoque insuficiente no local padrão mesmo com saldo em outro local", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openOrder(), nil).Once()
+		m.stockTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(8)).
+			Return(&modelsProduct.Product{ID: 8, Status: true, StockQuantity: 10, SalePrice: money.New(5)}, nil).Once()
+		m.stockTx.On("GetStockAtForUpdateTx", ctx, m.tx, int64(8), int64(0)).Return(1, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.AddPart(ctx, &models.ServiceOrderPart{ServiceOrderID: 1, ProductID: 8, Quantity: 3})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInsufficientStock)
+		m.stockTx.AssertNotCalled(t, "DecreaseStockTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("produto desativado", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openOrder(), nil).Once()
+		m.stockTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(8)).
+			Return(&modelsProduct.Product{ID: 8, Status: false, StockQuantity: 10}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.AddPart(ctx, &models.ServiceOrderPart{ServiceOrderID: 1, ProductID: 8, Quantity: 1})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrProductDisabled)
+	})
+
+	t.Run("sucesso usa preço de venda e baixa estoque", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		order := openOrder()
+		part := &models.ServiceOrderPart{ServiceOrderID: 1, ProductID: 8, Quantity: 3}
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(order, nil).Once()
+		m.stockTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(8)).
+			Return(&modelsProduct.Product{ID: 8, Status: true, StockQuantity: 5, SalePrice: money.MustParse("2.50")}, nil).Once()
+		m.stockTx.On("GetStockAtForUpdateTx", ctx, m.tx, int64(8), int64(0)).Return(3, nil).Once()
+		origin := modelsMovement.NewOrigin(modelsMovement.ReasonSale, modelsMovement.RefServiceOrder, 1)
+		m.stockTx.On("DecreaseStockTx", ctx, m.tx, int64(8), 3, origin).Return(nil).Once()
+		m.orderTx.On("AddPartTx", ctx, m.tx, part).Return(part, nil).Once()
+		m.orderTx.On("UpdateTotalsTx", ctx, m.tx, mock.MatchedBy(func(o *models.ServiceOrder) bool {
+			return o.PartsAmount == money.MustParse("27.50") && o.LaborAmount == money.New(50) && o.TotalAmount == money.MustParse("77.50")
+		})).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		result, err := svc.AddPart(ctx, part)
+
+		assert.NoError(t, err)
+		assert.Equal(t, money.MustParse("2.50"), *result.UnitPrice)
+		m.stockTx.AssertExpectations(t)
+		m.orderTx.AssertExpectations(t)
+	})
+}
+
+func TestServiceOrderService_RemovePart(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newServiceOrderService()
+
+		assert.ErrorIs(t, svc.RemovePart(ctx, 1, 0), errMsg.ErrZeroID)
+	})
+
+	t.Run("peça de outra ordem", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openOrder(), nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		err := svc.RemovePart(ctx, 1, 99)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("sucesso devolve ao estoque e recalcula", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openOrder(), nil).Once()
+		m.stockTx.On("IncreaseStockTx", ctx, m.tx, int64(7), 2, mock.Anything).Return(nil).Once()
+		m.orderTx.On("DeletePartTx", ctx, m.tx, int64(10)).Return(nil).Once()
+		m.orderTx.On("UpdateTotalsTx", ctx, m.tx, mock.MatchedBy(func(o *models.ServiceOrder) bool {
+			return len(o.Parts) == 0 && o.PartsAmount == money.New(0) && o.TotalAmount == money.New(50)
+		})).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		err := svc.RemovePart(ctx, 1, 10)
+
+		assert.NoError(t, err)
+		m.stockTx.AssertExpectations(t)
+		m.orderTx.AssertExpectations(t)
+	})
+}
+
+func TestServiceOrderService_AddLabor(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("descrição obrigatória", func(t *testing.T) {
+		svc, _ := newServiceOrderService()
+
+		result, err := svc.AddLabor(ctx, &models.ServiceOrderLabor{ServiceOrderID: 1, Amount: money.New(10)})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("sucesso recalcula totais", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		labor := &models.ServiceOrderLabor{ServiceOrderID: 1, Description: "troca de tela", Amount: money.New(120)}
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openOrder(), nil).Once()
+		m.orderTx.On("AddLaborTx", ctx, m.tx, labor).Return(labor, nil).Once()
+		m.orderTx.On("UpdateTotalsTx", ctx, m.tx, mock.MatchedBy(func(o *models.ServiceOrder) bool {
+			return o.LaborAmount == money.New(170) && o.TotalAmount == money.New(190)
+		})).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		result, err := svc.AddLabor(ctx, labor)
+
+		assert.NoError(t, err)
+		assert.Equal(t, labor, result)
+		m.orderTx.AssertExpectations(t)
+	})
+}
+
+func TestServiceOrderService_RemoveLabor(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("linha inexistente", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openOrder(), nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		err := svc.RemoveLabor(ctx, 1, 99)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(openOrder(), nil).Once()
+		m.orderTx.On("DeleteLaborTx", ctx, m.tx, int64(20)).Return(nil).Once()
+		m.orderTx.On("UpdateTotalsTx", ctx, m.tx, mock.MatchedBy(func(o *models.ServiceOrder) bool {
+			return len(o.Labor) == 0 && o.TotalAmount == money.New(20)
+		})).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		err := svc.RemoveLabor(ctx, 1, 20)
+
+		assert.NoError(t, err)
+		m.orderTx.AssertExpectations(t)
+	})
+}
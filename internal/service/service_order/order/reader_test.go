@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceOrderService_GetByID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newServiceOrderService()
+
+		result, err := svc.GetByID(ctx, 0)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("sucesso", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		order := &models.ServiceOrder{ID: 1, Status: models.StatusOpen}
+		m.repo.On("GetByID", ctx, int64(1)).Return(order, nil).Once()
+
+		result, err := svc.GetByID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, order, result)
+	})
+}
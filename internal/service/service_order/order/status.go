@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	modelsMovement "github.com/WagaoCarvalho/backend_store_go/internal/model/product/stock_movement"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// ChangeStatus move a ordem no ciclo de vida. Ao cancelar, as peças lançadas
+// voltam ao estoque na mesma transação.
+func (s *serviceOrderService) ChangeStatus(ctx context.Context, id int64, status string) error {
+	if id <= 0 {
+		return errMsg.ErrZeroID
+	}
+
+	if !models.IsValidStatus(status) {
+		return fmt.Errorf("%w: status inválido", errMsg.ErrInvalidData)
+	}
+
+	return repoDb.RunInTx(ctx, s.repoOrderTx, func(tx pgx.Tx) error {
+		order, err := s.repoOrderTx.GetByIDForUpdateTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if !order.CanTransitionTo(status) {
+			return fmt.Errorf("%w: transição de %s para %s não permitida", errMsg.ErrInvalidData, order.Status, status)
+		}
+
+		if status == models.StatusCanceled {
+			for _, part := range order.Parts {
+				if err := s.repoStockTx.IncreaseStockTx(ctx, tx, part.ProductID, part.Quantity, partOrigin(modelsMovement.ReasonReturn, order.ID)); err != nil {
+					return err
+				}
+			}
+		}
+
+		order.Status = status
+		return s.repoOrderTx.UpdateStatusTx(ctx, tx, order)
+	})
+}
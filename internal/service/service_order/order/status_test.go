@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServiceOrderService_ChangeStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newServiceOrderService()
+
+		assert.ErrorIs(t, svc.ChangeStatus(ctx, 0, models.StatusDone), errMsg.ErrZeroID)
+	})
+
+	t.Run("status desconhecido", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+
+		err := svc.ChangeStatus(ctx, 1, "paused")
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.orderTx.AssertNotCalled(t, "BeginTx", ctx)
+	})
+
+	t.Run("transição não permitida", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.ServiceOrder{ID: 1, Status: models.StatusOpen}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		err := svc.ChangeStatus(ctx, 1, models.StatusDelivered)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.orderTx.AssertNotCalled(t, "UpdateStatusTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ordem não encontrada", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(nil, errMsg.ErrNotFound).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		err := svc.ChangeStatus(ctx, 1, models.StatusInProgress)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("sucesso ao concluir", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		order := &models.ServiceOrder{ID: 1, Status: models.StatusInProgress}
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(order, nil).Once()
+		m.orderTx.On("UpdateStatusTx", ctx, m.tx, order).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		err := svc.ChangeStatus(ctx, 1, models.StatusDone)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusDone, order.Status)
+		m.stockTx.AssertNotCalled(t, "IncreaseStockTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("cancelamento devolve as peças ao estoque", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		order := &models.ServiceOrder{
+			ID:     1,
+			Status: models.StatusWaitingParts,
+			Parts: []models.ServiceOrderPart{
+				{ID: 10, ProductID: 7, Quantity: 2},
+				{ID: 11, ProductID: 8, Quantity: 1},
+			},
+		}
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).Return(order, nil).Once()
+		m.stockTx.On("IncreaseStockTx", ctx, m.tx, int64(7), 2, mock.Anything).Return(nil).Once()
+		m.stockTx.On("IncreaseStockTx", ctx, m.tx, int64(8), 1, mock.Anything).Return(nil).Once()
+		m.orderTx.On("UpdateStatusTx", ctx, m.tx, order).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		err := svc.ChangeStatus(ctx, 1, models.StatusCanceled)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusCanceled, order.Status)
+		m.stockTx.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	repoDb "github.com/WagaoCarvalho/backend_store_go/internal/repo/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// Create abre a ordem sem peças nem mão de obra; elas são lançadas depois
+// com AddPart e AddLabor.
+func (s *serviceOrderService) Create(ctx context.Context, order *models.ServiceOrder) (*models.ServiceOrder, error) {
+	if order == nil {
+		return nil, errMsg.ErrInvalidData
+	}
+
+	if err := order.ValidateStructural(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	order.Status = models.StatusOpen
+	order.Parts = nil
+	order.Labor = nil
+	order.CalculateTotals()
+
+	var created *models.ServiceOrder
+
+	err := repoDb.RunInTx(ctx, s.repoOrderTx, func(tx pgx.Tx) error {
+		var err error
+		created, err = s.repoOrderTx.CreateTx(ctx, tx, order)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// Update altera cliente, técnico, descrição, observações e categorias de uma
+// ordem ainda em andamento.
+func (s *serviceOrderService) Update(ctx context.Context, order *models.ServiceOrder) error {
+	if order == nil {
+		return errMsg.ErrInvalidData
+	}
+
+	if order.ID <= 0 {
+		return errMsg.ErrZeroID
+	}
+
+	if order.Version <= 0 {
+		return fmt.Errorf("%w: versão obrigatória", errMsg.ErrInvalidData)
+	}
+
+	if err := order.ValidateStructural(); err != nil {
+		return fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	return repoDb.RunInTx(ctx, s.repoOrderTx, func(tx pgx.Tx) error {
+		current, err := s.repoOrderTx.GetByIDForUpdateTx(ctx, tx, order.ID)
+		if err != nil {
+			return err
+		}
+
+		if current.Version != order.Version {
+			return errMsg.ErrVersionConflict
+		}
+
+		if !current.Editable() {
+			return fmt.Errorf("%w: ordem concluída ou cancelada não pode ser alterada", errMsg.ErrInvalidData)
+		}
+
+		order.Status = current.Status
+		return s.repoOrderTx.UpdateTx(ctx, tx, order)
+	})
+}
@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockClient "github.com/WagaoCarvalho/backend_store_go/infra/mock/client_cpf"
+	mockProduct "github.com/WagaoCarvalho/backend_store_go/infra/mock/product"
+	mockTX "github.com/WagaoCarvalho/backend_store_go/infra/mock/repo"
+	mockSale "github.com/WagaoCarvalho/backend_store_go/infra/mock/sale"
+	mockServiceOrder "github.com/WagaoCarvalho/backend_store_go/infra/mock/service_order"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/service_order/order"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+	"github.com/WagaoCarvalho/backend_store_go/internal/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type serviceOrderMocks struct {
+	repo    *mockServiceOrder.MockServiceOrder
+	orderTx *mockServiceOrder.MockServiceOrderTx
+	stockTx *mockProduct.MockProductStockTx
+	saleTx  *mockSale.MockSaleTx
+	itemTx  *mockSale.MockSaleItemTx
+	credit  *mockClient.MockClientCreditTx
+	cnpj    *mockClient.MockClientCreditTx
+	tx      *mockTX.MockTx
+}
+
+func newServiceOrderService() (ServiceOrderService, serviceOrderMocks) {
+	m := serviceOrderMocks{
+		repo:    new(mockServiceOrder.MockServiceOrder),
+		orderTx: new(mockServiceOrder.MockServiceOrderTx),
+		stockTx: new(mockProduct.MockProductStockTx),
+		saleTx:  new(mockSale.MockSaleTx),
+		itemTx:  new(mockSale.MockSaleItemTx),
+		credit:  new(mockClient.MockClientCreditTx),
+		cnpj:    new(mockClient.MockClientCreditTx),
+		tx:      new(mockTX.MockTx),
+	}
+	return NewServiceOrderService(m.repo, m.orderTx, m.stockTx, m.saleTx, m.itemTx, m.credit, m.cnpj), m
+}
+
+func validOrder() *models.ServiceOrder {
+	clientID := int64(4)
+	return &models.ServiceOrder{
+		ClientID:           &clientID,
+		ProblemDescription: "notebook não liga",
+		CategoryIDs:        []int64{1},
+	}
+}
+
+func TestServiceOrderService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ordem nil", func(t *testing.T) {
+		svc, _ := newServiceOrderService()
+
+		result, err := svc.Create(ctx, nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("ordem sem cliente", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+
+		result, err := svc.Create(ctx, &models.ServiceOrder{ProblemDescription: "não liga"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		m.orderTx.AssertNotCalled(t, "BeginTx", ctx)
+	})
+
+	t.Run("erro do repositório faz rollback", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		order := validOrder()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("CreateTx", ctx, m.tx, order).Return(nil, errMsg.ErrDBInvalidForeignKey).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		result, err := svc.Create(ctx, order)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrDBInvalidForeignKey)
+		m.tx.AssertExpectations(t)
+	})
+
+	t.Run("sucesso abre a ordem zerada", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		order := validOrder()
+		order.Status = models.StatusDone
+		order.TotalAmount = money.New(99)
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("CreateTx", ctx, m.tx, mock.MatchedBy(func(o *models.ServiceOrder) bool {
+			return o.Status == models.StatusOpen && o.TotalAmount == money.New(0)
+		})).Return(order, nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		result, err := svc.Create(ctx, order)
+
+		assert.NoError(t, err)
+		assert.Equal(t, order, result)
+		m.orderTx.AssertExpectations(t)
+		m.tx.AssertExpectations(t)
+	})
+}
+
+func TestServiceOrderService_Update(t *testing.T) {
+	ctx := context.Background()
+
+	newUpdate := func() *models.ServiceOrder {
+		o := validOrder()
+		o.ID, o.Version = 1, 2
+		return o
+	}
+
+	t.Run("ID inválido", func(t *testing.T) {
+		svc, _ := newServiceOrderService()
+
+		err := svc.Update(ctx, validOrder())
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("versão obrigatória", func(t *testing.T) {
+		svc, _ := newServiceOrderService()
+		order := newUpdate()
+		order.Version = 0
+
+		err := svc.Update(ctx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("conflito de versão", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		order := newUpdate()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.ServiceOrder{ID: 1, Status: models.StatusOpen, Version: 3}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		err := svc.Update(ctx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrVersionConflict)
+		m.orderTx.AssertNotCalled(t, "UpdateTx", ctx, m.tx, order)
+	})
+
+	t.Run("ordem já concluída", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		order := newUpdate()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.ServiceOrder{ID: 1, Status: models.StatusDone, Version: 2}, nil).Once()
+		m.tx.On("Rollback", ctx).Return(nil).Once()
+
+		err := svc.Update(ctx, order)
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+	})
+
+	t.Run("erro na transação é propagado", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		m.orderTx.On("BeginTx", ctx).Return(nil, errors.New("db down")).Once()
+
+		err := svc.Update(ctx, newUpdate())
+
+		assert.ErrorContains(t, err, "erro ao iniciar transação")
+	})
+
+	t.Run("sucesso mantém o status atual", func(t *testing.T) {
+		svc, m := newServiceOrderService()
+		order := newUpdate()
+		m.orderTx.On("BeginTx", ctx).Return(m.tx, nil).Once()
+		m.orderTx.On("GetByIDForUpdateTx", ctx, m.tx, int64(1)).
+			Return(&models.ServiceOrder{ID: 1, Status: models.StatusWaitingParts, Version: 2}, nil).Once()
+		m.orderTx.On("UpdateTx", ctx, m.tx, order).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil).Once()
+
+		err := svc.Update(ctx, order)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.StatusWaitingParts, order.Status)
+		m.orderTx.AssertExpectations(t)
+	})
+}
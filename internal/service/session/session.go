@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/login"
+	err_msg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+type SessionStore interface {
+	ListSessions(ctx context.Context, userID int64) ([]*models.Session, error)
+	DeleteSession(ctx context.Context, userID int64, sessionID string) error
+}
+
+type SessionService interface {
+	List(ctx context.Context, userID int64) ([]*models.Session, error)
+	Revoke(ctx context.Context, userID int64, sessionID string) error
+}
+
+type sessionService struct {
+	store SessionStore
+}
+
+func NewSessionService(store SessionStore) SessionService {
+	return &sessionService{store: store}
+}
+
+// List devolve as sessões ativas do usuário, da mais recente para a mais antiga.
+func (s *sessionService) List(ctx context.Context, userID int64) ([]*models.Session, error) {
+	if userID <= 0 {
+		return nil, err_msg.ErrZeroID
+	}
+
+	sessions, err := s.store.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, err_msg.ErrSessionStore
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt)
+	})
+
+	return sessions, nil
+}
+
+// Revoke encerra uma sessão do usuário; o refresh token dela deixa de valer.
+func (s *sessionService) Revoke(ctx context.Context, userID int64, sessionID string) error {
+	if userID <= 0 {
+		return err_msg.ErrZeroID
+	}
+	if strings.TrimSpace(sessionID) == "" {
+		return err_msg.ErrInvalidData
+	}
+
+	if err := s.store.DeleteSession(ctx, userID, sessionID); err != nil {
+		if errors.Is(err, err_msg.ErrNotFound) {
+			return err_msg.ErrNotFound
+		}
+		return err_msg.ErrSessionStore
+	}
+
+	return nil
+}
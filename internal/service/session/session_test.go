@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	mockLogin "github.com/WagaoCarvalho/backend_store_go/infra/mock/login"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/login"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func TestSessionService_List(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ordena pela última atividade", func(t *testing.T) {
+		mockStore := new(mockLogin.MockSession)
+		service := NewSessionService(mockStore)
+
+		now := time.Now()
+		mockStore.On("ListSessions", ctx, int64(7)).Return([]*models.Session{
+			{ID: "antiga", LastSeenAt: now.Add(-time.Hour)},
+			{ID: "recente", LastSeenAt: now},
+		}, nil)
+
+		sessions, err := service.List(ctx, 7)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "recente", sessions[0].ID)
+		assert.Equal(t, "antiga", sessions[1].ID)
+	})
+
+	t.Run("id inválido", func(t *testing.T) {
+		service := NewSessionService(new(mockLogin.MockSession))
+
+		_, err := service.List(ctx, 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("erro no armazenamento", func(t *testing.T) {
+		mockStore := new(mockLogin.MockSession)
+		service := NewSessionService(mockStore)
+
+		mockStore.On("ListSessions", ctx, int64(7)).Return(nil, errors.New("redis down"))
+
+		_, err := service.List(ctx, 7)
+
+		assert.ErrorIs(t, err, errMsg.ErrSessionStore)
+	})
+}
+
+func TestSessionService_Revoke(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sucesso", func(t *testing.T) {
+		mockStore := new(mockLogin.MockSession)
+		service := NewSessionService(mockStore)
+
+		mockStore.On("DeleteSession", ctx, int64(7), "sess-1").Return(nil)
+
+		assert.NoError(t, service.Revoke(ctx, 7, "sess-1"))
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("dados inválidos", func(t *testing.T) {
+		service := NewSessionService(new(mockLogin.MockSession))
+
+		assert.ErrorIs(t, service.Revoke(ctx, 0, "sess-1"), errMsg.ErrZeroID)
+		assert.ErrorIs(t, service.Revoke(ctx, 7, " "), errMsg.ErrInvalidData)
+	})
+
+	t.Run("sessão não encontrada", func(t *testing.T) {
+		mockStore := new(mockLogin.MockSession)
+		service := NewSessionService(mockStore)
+
+		mockStore.On("DeleteSession", ctx, int64(7), "sess-1").Return(errMsg.ErrNotFound)
+
+		assert.ErrorIs(t, service.Revoke(ctx, 7, "sess-1"), errMsg.ErrNotFound)
+	})
+
+	t.Run("erro no armazenamento", func(t *testing.T) {
+		mockStore := new(mockLogin.MockSession)
+		service := NewSessionService(mockStore)
+
+		mockStore.On("DeleteSession", ctx, int64(7), "sess-1").Return(errors.New("redis down"))
+
+		assert.ErrorIs(t, service.Revoke(ctx, 7, "sess-1"), errMsg.ErrSessionStore)
+	})
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/supplier/filter"
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/supplier/supplier"
@@ -12,7 +13,7 @@ import (
 func (s *supplierFilterService) Filter(
 	ctx context.Context,
 	filter *filter.SupplierFilter,
-) ([]*model.Supplier, error) {
+) (*commonFilter.Page[*model.Supplier], error) {
 
 	if filter == nil {
 		return nil, fmt.Errorf("%w: filtro não pode ser nulo", errMsg.ErrInvalidFilter)
@@ -114,15 +114,15 @@ func TestSupplierService_Filter(t *testing.T) {
 		result, err := service.Filter(context.Background(), validFilter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 2)
+		assert.Len(t, result.Items, 2)
 
-		assert.Equal(t, int64(1), result[0].ID)
-		assert.Equal(t, "Fornecedor A", result[0].Name)
-		assert.True(t, result[0].Status)
+		assert.Equal(t, int64(1), result.Items[0].ID)
+		assert.Equal(t, "Fornecedor A", result.Items[0].Name)
+		assert.True(t, result.Items[0].Status)
 
-		assert.Equal(t, int64(2), result[1].ID)
-		assert.Equal(t, "Fornecedor B", result[1].Name)
-		assert.False(t, result[1].Status)
+		assert.Equal(t, int64(2), result.Items[1].ID)
+		assert.Equal(t, "Fornecedor B", result.Items[1].Name)
+		assert.False(t, result.Items[1].Status)
 
 		mockRepo.AssertExpectations(t)
 	})
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	commonFilter "github.com/WagaoCarvalho/backend_store_go/internal/model/common/filter"
 
 	filter "github.com/WagaoCarvalho/backend_store_go/internal/model/user/filter"
 	model "github.com/WagaoCarvalho/backend_store_go/internal/model/user/user"
@@ -12,7 +13,7 @@ import (
 func (s *userFilterService) Filter(
 	ctx context.Context,
 	filter *filter.UserFilter,
-) ([]*model.User, error) {
+) (*commonFilter.Page[*model.User], error) {
 
 	if filter == nil {
 		return nil, fmt.Errorf("%w: filtro não pode ser nulo", errMsg.ErrInvalidFilter)
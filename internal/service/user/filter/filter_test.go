@@ -119,31 +119,31 @@ func TestUserService_Filter(t *testing.T) {
 		result, err := service.Filter(context.Background(), validFilter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 2)
+		assert.Len(t, result.Items, 2)
 
 		// Verificar primeiro usuário
-		assert.Equal(t, int64(1), result[0].UID)
-		assert.Equal(t, "admin", result[0].Username)
-		assert.Equal(t, "admin@example.com", result[0].Email)
-		assert.Equal(t, "hashed_password_1", result[0].Password)
-		assert.NotNil(t, result[0].Description)
-		assert.Equal(t, "Usuário administrador", result[0].Description)
-		assert.True(t, result[0].Status)
-		assert.Equal(t, 1, result[0].Version)
-		assert.WithinDuration(t, now, result[0].CreatedAt, time.Second)
-		assert.WithinDuration(t, now, result[0].UpdatedAt, time.Second)
+		assert.Equal(t, int64(1), result.Items[0].UID)
+		assert.Equal(t, "admin", result.Items[0].Username)
+		assert.Equal(t, "admin@example.com", result.Items[0].Email)
+		assert.Equal(t, "hashed_password_1", result.Items[0].Password)
+		assert.NotNil(t, result.Items[0].Description)
+		assert.Equal(t, "Usuário administrador", result.Items[0].Description)
+		assert.True(t, result.Items[0].Status)
+		assert.Equal(t, 1, result.Items[0].Version)
+		assert.WithinDuration(t, now, result.Items[0].CreatedAt, time.Second)
+		assert.WithinDuration(t, now, result.Items[0].UpdatedAt, time.Second)
 
 		// Verificar segundo usuário
-		assert.Equal(t, int64(2), result[1].UID)
-		assert.Equal(t, "guest", result[1].Username)
-		assert.Equal(t, "guest@example.com", result[1].Email)
-		assert.Equal(t, "hashed_password_2", result[1].Password)
-		assert.NotNil(t, result[1].Description)
-		assert.Equal(t, "", result[1].Description)
-		assert.False(t, result[1].Status)
-		assert.Equal(t, 1, result[1].Version)
-		assert.WithinDuration(t, now, result[1].CreatedAt, time.Second)
-		assert.WithinDuration(t, now, result[1].UpdatedAt, time.Second)
+		assert.Equal(t, int64(2), result.Items[1].UID)
+		assert.Equal(t, "guest", result.Items[1].Username)
+		assert.Equal(t, "guest@example.com", result.Items[1].Email)
+		assert.Equal(t, "hashed_password_2", result.Items[1].Password)
+		assert.NotNil(t, result.Items[1].Description)
+		assert.Equal(t, "", result.Items[1].Description)
+		assert.False(t, result.Items[1].Status)
+		assert.Equal(t, 1, result.Items[1].Version)
+		assert.WithinDuration(t, now, result.Items[1].CreatedAt, time.Second)
+		assert.WithinDuration(t, now, result.Items[1].UpdatedAt, time.Second)
 
 		mockRepo.AssertExpectations(t)
 	})
@@ -186,14 +186,14 @@ func TestUserService_Filter(t *testing.T) {
 		result, err := service.Filter(context.Background(), validFilter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 1)
+		assert.Len(t, result.Items, 1)
 
-		assert.Equal(t, int64(10), result[0].UID)
-		assert.Equal(t, "john_doe", result[0].Username)
-		assert.Equal(t, "john@example.com", result[0].Email)
-		assert.Equal(t, "hashed_password_john", result[0].Password)
-		assert.True(t, result[0].Status)
-		assert.Equal(t, 2, result[0].Version)
+		assert.Equal(t, int64(10), result.Items[0].UID)
+		assert.Equal(t, "john_doe", result.Items[0].Username)
+		assert.Equal(t, "john@example.com", result.Items[0].Email)
+		assert.Equal(t, "hashed_password_john", result.Items[0].Password)
+		assert.True(t, result.Items[0].Status)
+		assert.Equal(t, 2, result.Items[0].Version)
 
 		mockRepo.AssertExpectations(t)
 	})
@@ -218,7 +218,7 @@ func TestUserService_Filter(t *testing.T) {
 		result, err := service.Filter(context.Background(), validFilter)
 
 		assert.NoError(t, err)
-		assert.Len(t, result, 0)
+		assert.Len(t, result.Items, 0)
 		assert.NotNil(t, result) // Verifica que não é nil
 
 		mockRepo.AssertExpectations(t)
@@ -0,0 +1,13 @@
+package services
+
+import repo "github.com/WagaoCarvalho/backend_store_go/internal/repo/user/role"
+
+type userRoleService struct {
+	repo repo.UserRole
+}
+
+func NewUserRoleService(repo repo.UserRole) UserRole {
+	return &userRoleService{
+		repo: repo,
+	}
+}
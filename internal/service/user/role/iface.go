@@ -0,0 +1,8 @@
+package services
+
+import iface "github.com/WagaoCarvalho/backend_store_go/internal/iface/user"
+
+type UserRole interface {
+	iface.UserRoleReader
+	iface.UserRoleWriter
+}
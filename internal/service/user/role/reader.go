@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/role"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *userRoleService) GetAllRoles(ctx context.Context) ([]*models.Role, error) {
+	roles, err := s.repo.GetAllRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	if roles == nil {
+		roles = []*models.Role{}
+	}
+
+	return roles, nil
+}
+
+func (s *userRoleService) GetRolesByUserID(ctx context.Context, userID int64) ([]*models.Role, error) {
+	if userID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	roles, err := s.repo.GetRolesByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	if roles == nil {
+		roles = []*models.Role{}
+	}
+
+	return roles, nil
+}
+
+func (s *userRoleService) GetAccessByUserID(ctx context.Context, userID int64) (*models.Access, error) {
+	if userID <= 0 {
+		return nil, errMsg.ErrZeroID
+	}
+
+	access, err := s.repo.GetAccessByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrGet, err)
+	}
+
+	return access, nil
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mockUser "github.com/WagaoCarvalho/backend_store_go/infra/mock/user"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/role"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func TestUserRoleService_GetAllRoles(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(mockUser.MockUserRole)
+		svc := NewUserRoleService(mockRepo)
+
+		roles := []*models.Role{{ID: 1, Name: "admin", Permissions: []string{"sale:cancel"}}}
+		mockRepo.On("GetAllRoles", mock.Anything).Return(roles, nil)
+
+		result, err := svc.GetAllRoles(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, roles, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("NilBecomesEmpty", func(t *testing.T) {
+		mockRepo := new(mockUser.MockUserRole)
+		svc := NewUserRoleService(mockRepo)
+
+		mockRepo.On("GetAllRoles", mock.Anything).Return(nil, nil)
+
+		result, err := svc.GetAllRoles(context.Background())
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockRepo := new(mockUser.MockUserRole)
+		svc := NewUserRoleService(mockRepo)
+
+		mockRepo.On("GetAllRoles", mock.Anything).Return(nil, errors.New("db fail"))
+
+		result, err := svc.GetAllRoles(context.Background())
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestUserRoleService_GetRolesByUserID(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(mockUser.MockUserRole)
+		svc := NewUserRoleService(mockRepo)
+
+		roles := []*models.Role{{ID: 3, Name: "cashier"}}
+		mockRepo.On("GetRolesByUserID", mock.Anything, int64(7)).Return(roles, nil)
+
+		result, err := svc.GetRolesByUserID(context.Background(), 7)
+
+		assert.NoError(t, err)
+		assert.Equal(t, roles, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ZeroID", func(t *testing.T) {
+		mockRepo := new(mockUser.MockUserRole)
+		svc := NewUserRoleService(mockRepo)
+
+		_, err := svc.GetRolesByUserID(context.Background(), 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+		mockRepo.AssertNotCalled(t, "GetRolesByUserID")
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockRepo := new(mockUser.MockUserRole)
+		svc := NewUserRoleService(mockRepo)
+
+		mockRepo.On("GetRolesByUserID", mock.Anything, int64(7)).Return(nil, errors.New("db fail"))
+
+		_, err := svc.GetRolesByUserID(context.Background(), 7)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
+
+func TestUserRoleService_GetAccessByUserID(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(mockUser.MockUserRole)
+		svc := NewUserRoleService(mockRepo)
+
+		access := &models.Access{Roles: []string{"cashier"}, Permissions: []string{"sale:create"}}
+		mockRepo.On("GetAccessByUserID", mock.Anything, int64(7)).Return(access, nil)
+
+		result, err := svc.GetAccessByUserID(context.Background(), 7)
+
+		assert.NoError(t, err)
+		assert.Equal(t, access, result)
+	})
+
+	t.Run("ZeroID", func(t *testing.T) {
+		mockRepo := new(mockUser.MockUserRole)
+		svc := NewUserRoleService(mockRepo)
+
+		_, err := svc.GetAccessByUserID(context.Background(), 0)
+
+		assert.ErrorIs(t, err, errMsg.ErrZeroID)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockRepo := new(mockUser.MockUserRole)
+		svc := NewUserRoleService(mockRepo)
+
+		mockRepo.On("GetAccessByUserID", mock.Anything, int64(7)).Return(nil, errors.New("db fail"))
+
+		_, err := svc.GetAccessByUserID(context.Background(), 7)
+
+		assert.ErrorIs(t, err, errMsg.ErrGet)
+	})
+}
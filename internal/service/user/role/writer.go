@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/role"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func (s *userRoleService) AssignRole(ctx context.Context, userRole *models.UserRole) (*models.UserRole, error) {
+	if userRole == nil {
+		return nil, errMsg.ErrNilModel
+	}
+
+	if err := userRole.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errMsg.ErrInvalidData, err)
+	}
+
+	created, err := s.repo.AssignRole(ctx, userRole)
+	if err != nil {
+		switch {
+		case errors.Is(err, errMsg.ErrRelationExists):
+			return nil, errMsg.ErrRelationExists
+		case errors.Is(err, errMsg.ErrDBInvalidForeignKey):
+			return nil, errMsg.ErrDBInvalidForeignKey
+		default:
+			return nil, fmt.Errorf("%w: %v", errMsg.ErrCreate, err)
+		}
+	}
+
+	return created, nil
+}
+
+func (s *userRoleService) RemoveRole(ctx context.Context, userID, roleID int64) error {
+	if userID <= 0 || roleID <= 0 {
+		return errMsg.ErrZeroID
+	}
+
+	if err := s.repo.RemoveRole(ctx, userID, roleID); err != nil {
+		if errors.Is(err, errMsg.ErrNotFound) {
+			return err
+		}
+		return fmt.Errorf("%w: %v", errMsg.ErrDelete, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mockUser "github.com/WagaoCarvalho/backend_store_go/infra/mock/user"
+	models "github.com/WagaoCarvalho/backend_store_go/internal/model/user/role"
+	errMsg "github.com/WagaoCarvalho/backend_store_go/internal/pkg/err/message"
+)
+
+func TestUserRoleService_AssignRole(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(mockUser.MockUserRole)
+		svc := NewUserRoleService(mockRepo)
+
+		input := &models.UserRole{UserID: 7, RoleID: 3}
+		mockRepo.On("AssignRole", mock.Anything, input).Return(input, nil)
+
+		result, err := svc.AssignRole(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, input, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("NilModel", func(t *testing.T) {
+		mockRepo := new(mockUser.MockUserRole)
+		svc := NewUserRoleService(mockRepo)
+
+		_, err := svc.AssignRole(context.Background(), nil)
+
+		assert.ErrorIs(t, err, errMsg.ErrNilModel)
+	})
+
+	t.Run("InvalidData", func(t *testing.T) {
+		mockRepo := new(mockUser.MockUserRole)
+		svc := NewUserRoleService(mockRepo)
+
+		_, err := svc.AssignRole(context.Background(), &models.UserRole{UserID: 7})
+
+		assert.ErrorIs(t, err, errMsg.ErrInvalidData)
+		mockRepo.AssertNotCalled(t, "AssignRole")
+	})
+
+	cases := []struct {
+		name     string
+		repoErr  error
+		expected error
+	}{
+		{"AlreadyAssigned", errMsg.ErrRelationExists, errMsg.ErrRelationExists},
+		{"InvalidForeignKey", errMsg.ErrDBInvalidForeignKey, errMsg.ErrDBInvalidForeignKey},
+		{"GenericError", errors.New("db fail"), errMsg.ErrCreate},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(mockUser.MockUserRole)
+			svc := NewUserRoleService(mockRepo)
+
+			mockRepo.On("AssignRole", mock.Anything, mock.Anything).Return(nil, tc.repoErr)
+
+			result, err := svc.AssignRole(context.Background(), &models.UserRole{UserID: 7, RoleID: 3})
+
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
+
+func TestUserRoleService_RemoveRole(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(mockUser.MockUserRole)
+		svc := NewUserRoleService(mockRepo)
+
+		mockRepo.On("RemoveRole", mock.Anything, int64(7), int64(3)).Return(nil)
+
+		err := svc.RemoveRole(context.Background(), 7, 3)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ZeroID", func(t *testing.T) {
+		mockRepo := new(mockUser.MockUserRole)
+		svc := NewUserRoleService(mockRepo)
+
+		assert.ErrorIs(t, svc.RemoveRole(context.Background(), 7, 0), errMsg.ErrZeroID)
+		assert.ErrorIs(t, svc.RemoveRole(context.Background(), 0, 3), errMsg.ErrZeroID)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo := new(mockUser.MockUserRole)
+		svc := NewUserRoleService(mockRepo)
+
+		mockRepo.On("RemoveRole", mock.Anything, int64(7), int64(3)).Return(errMsg.ErrNotFound)
+
+		err := svc.RemoveRole(context.Background(), 7, 3)
+
+		assert.ErrorIs(t, err, errMsg.ErrNotFound)
+	})
+
+	t.Run("GenericError", func(t *testing.T) {
+		mockRepo := new(mockUser.MockUserRole)
+		svc := NewUserRoleService(mockRepo)
+
+		mockRepo.On("RemoveRole", mock.Anything, int64(7), int64(3)).Return(errors.New("db fail"))
+
+		err := svc.RemoveRole(context.Background(), 7, 3)
+
+		assert.ErrorIs(t, err, errMsg.ErrDelete)
+	})
+}